@@ -70,7 +70,7 @@ func Comment(ctx context.Context, c *app.RequestContext) {
 		defaultValue := int64(-1)
 		req.ParentID = &defaultValue
 	}
-	err = rpc.CommentVideoRPC(ctx, &interaction.CommentRequest{
+	commentID, err := rpc.CommentVideoRPC(ctx, &interaction.CommentRequest{
 		VideoId:  req.VideoID,
 		Content:  req.Content,
 		ParentId: req.ParentID,
@@ -79,7 +79,9 @@ func Comment(ctx context.Context, c *app.RequestContext) {
 		pack.RespError(c, err)
 		return
 	}
-	pack.RespSuccess(c)
+	pack.RespData(c, map[string]any{
+		"comment_id": commentID,
+	})
 }
 
 // GetComments .
@@ -146,3 +148,375 @@ func LikeComment(ctx context.Context, c *app.RequestContext) {
 	}
 	pack.RespSuccess(c)
 }
+
+// GetFlaggedComments .
+// @router /api/v1/admin/comments/flagged [GET]
+func GetFlaggedComments(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req api.GetFlaggedCommentsRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		pack.RespError(c, errno.ParamVerifyError.WithError(err))
+		return
+	}
+
+	comments, total, err := rpc.GetFlaggedCommentsRPC(ctx, &interaction.GetFlaggedCommentsRequest{
+		Status: req.Status,
+		Page:   req.Page,
+		Size:   req.Size,
+	})
+	if err != nil {
+		pack.RespError(c, err)
+		return
+	}
+	pack.RespData(c, map[string]any{
+		"comments": comments,
+		"total":    total,
+	})
+}
+
+// ReviewComment .
+// @router /api/v1/admin/comment/:comment_id/review [POST]
+func ReviewComment(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req api.ReviewCommentRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		pack.RespError(c, errno.ParamVerifyError.WithError(err))
+		return
+	}
+
+	err = rpc.ReviewCommentRPC(ctx, &interaction.ReviewCommentRequest{
+		CommentId: req.CommentID,
+		Action:    req.Action,
+		Reason:    req.Reason,
+	})
+	if err != nil {
+		pack.RespError(c, err)
+		return
+	}
+	pack.RespSuccess(c)
+}
+
+// EditComment .
+// @router /api/v1/comment/:comment_id [PUT]
+func EditComment(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req api.EditCommentRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		pack.RespError(c, errno.ParamVerifyError.WithError(err))
+		return
+	}
+
+	err = rpc.EditCommentRPC(ctx, &interaction.EditCommentRequest{
+		CommentId: req.CommentID,
+		Content:   req.Content,
+	})
+	if err != nil {
+		pack.RespError(c, err)
+		return
+	}
+	pack.RespSuccess(c)
+}
+
+// GetCommentEditHistory .
+// @router /api/v1/comment/:comment_id/history [GET]
+func GetCommentEditHistory(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req api.GetCommentEditHistoryRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		pack.RespError(c, errno.ParamVerifyError.WithError(err))
+		return
+	}
+
+	resp, err := rpc.GetCommentEditHistoryRPC(ctx, &interaction.GetCommentEditHistoryRequest{
+		CommentId: req.CommentID,
+	})
+	if err != nil {
+		pack.RespError(c, err)
+		return
+	}
+	pack.RespData(c, resp)
+}
+
+// PinComment .
+// @router /api/v1/comment/:comment_id/pin [POST]
+func PinComment(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req api.PinCommentRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		pack.RespError(c, errno.ParamVerifyError.WithError(err))
+		return
+	}
+
+	err = rpc.PinCommentRPC(ctx, &interaction.PinCommentRequest{
+		CommentId: req.CommentID,
+		Pin:       req.Pin,
+	})
+	if err != nil {
+		pack.RespError(c, err)
+		return
+	}
+	pack.RespSuccess(c)
+}
+
+// HeartComment .
+// @router /api/v1/comment/:comment_id/heart [POST]
+func HeartComment(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req api.HeartCommentRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		pack.RespError(c, errno.ParamVerifyError.WithError(err))
+		return
+	}
+
+	err = rpc.HeartCommentRPC(ctx, &interaction.HeartCommentRequest{
+		CommentId: req.CommentID,
+		Heart:     req.Heart,
+	})
+	if err != nil {
+		pack.RespError(c, err)
+		return
+	}
+	pack.RespSuccess(c)
+}
+
+// React .
+// @router /api/v1/reaction [POST]
+func React(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req api.ReactRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		pack.RespError(c, errno.ParamVerifyError.WithError(err))
+		return
+	}
+
+	resp, err := rpc.ReactRPC(ctx, &interaction.ReactRequest{
+		TargetType: req.TargetType,
+		TargetId:   req.TargetID,
+		Reaction:   req.Reaction,
+	})
+	if err != nil {
+		pack.RespError(c, err)
+		return
+	}
+	pack.RespData(c, map[string]any{
+		"counts":      resp.Counts,
+		"my_reaction": resp.GetMyReaction(),
+	})
+}
+
+// GetReactions .
+// @router /api/v1/reactions [GET]
+func GetReactions(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req api.GetReactionsRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		pack.RespError(c, errno.ParamVerifyError.WithError(err))
+		return
+	}
+
+	resp, err := rpc.GetReactionsRPC(ctx, &interaction.GetReactionsRequest{
+		TargetType: req.TargetType,
+		TargetId:   req.TargetID,
+		Reaction:   req.Reaction,
+		Page:       req.Page,
+		Size:       req.Size,
+	})
+	if err != nil {
+		pack.RespError(c, err)
+		return
+	}
+	pack.RespData(c, map[string]any{
+		"reactions":   resp.ReactionList,
+		"counts":      resp.Counts,
+		"my_reaction": resp.GetMyReaction(),
+		"total":       resp.Total,
+	})
+}
+
+// CreateCollection .
+// @router /api/v1/collection [POST]
+func CreateCollection(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req api.CreateCollectionRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		pack.RespError(c, errno.ParamVerifyError.WithError(err))
+		return
+	}
+
+	collection, err := rpc.CreateCollectionRPC(ctx, &interaction.CreateCollectionRequest{
+		Name:      req.Name,
+		IsPrivate: req.IsPrivate,
+	})
+	if err != nil {
+		pack.RespError(c, err)
+		return
+	}
+	pack.RespData(c, collection)
+}
+
+// UpdateCollection .
+// @router /api/v1/collection/:collection_id [PUT]
+func UpdateCollection(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req api.UpdateCollectionRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		pack.RespError(c, errno.ParamVerifyError.WithError(err))
+		return
+	}
+
+	err = rpc.UpdateCollectionRPC(ctx, &interaction.UpdateCollectionRequest{
+		CollectionId: req.CollectionID,
+		Name:         req.Name,
+		IsPrivate:    req.IsPrivate,
+	})
+	if err != nil {
+		pack.RespError(c, err)
+		return
+	}
+	pack.RespSuccess(c)
+}
+
+// DeleteCollection .
+// @router /api/v1/collection/:collection_id [DELETE]
+func DeleteCollection(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req api.DeleteCollectionRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		pack.RespError(c, errno.ParamVerifyError.WithError(err))
+		return
+	}
+
+	err = rpc.DeleteCollectionRPC(ctx, &interaction.DeleteCollectionRequest{
+		CollectionId: req.CollectionID,
+	})
+	if err != nil {
+		pack.RespError(c, err)
+		return
+	}
+	pack.RespSuccess(c)
+}
+
+// ReorderCollections .
+// @router /api/v1/collections/order [PUT]
+func ReorderCollections(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req api.ReorderCollectionsRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		pack.RespError(c, errno.ParamVerifyError.WithError(err))
+		return
+	}
+
+	err = rpc.ReorderCollectionsRPC(ctx, &interaction.ReorderCollectionsRequest{
+		CollectionIds: req.CollectionIds,
+	})
+	if err != nil {
+		pack.RespError(c, err)
+		return
+	}
+	pack.RespSuccess(c)
+}
+
+// GetCollections .
+// @router /api/v1/collections [GET]
+func GetCollections(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req api.GetCollectionsRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		pack.RespError(c, errno.ParamVerifyError.WithError(err))
+		return
+	}
+
+	collections, err := rpc.GetCollectionsRPC(ctx, &interaction.GetCollectionsRequest{
+		UserId: req.UserID,
+	})
+	if err != nil {
+		pack.RespError(c, err)
+		return
+	}
+	pack.RespData(c, collections)
+}
+
+// AddToCollection .
+// @router /api/v1/collection/video [POST]
+func AddToCollection(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req api.AddToCollectionRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		pack.RespError(c, errno.ParamVerifyError.WithError(err))
+		return
+	}
+
+	collectionID, err := rpc.AddToCollectionRPC(ctx, &interaction.AddToCollectionRequest{
+		VideoId:      req.VideoID,
+		CollectionId: req.CollectionID,
+	})
+	if err != nil {
+		pack.RespError(c, err)
+		return
+	}
+	pack.RespData(c, map[string]any{
+		"collection_id": collectionID,
+	})
+}
+
+// RemoveFromCollection .
+// @router /api/v1/collection/video [DELETE]
+func RemoveFromCollection(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req api.RemoveFromCollectionRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		pack.RespError(c, errno.ParamVerifyError.WithError(err))
+		return
+	}
+
+	err = rpc.RemoveFromCollectionRPC(ctx, &interaction.RemoveFromCollectionRequest{
+		VideoId:      req.VideoID,
+		CollectionId: req.CollectionID,
+	})
+	if err != nil {
+		pack.RespError(c, err)
+		return
+	}
+	pack.RespSuccess(c)
+}
+
+// GetCollectionVideos .
+// @router /api/v1/collection/:collection_id/videos [GET]
+func GetCollectionVideos(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req api.GetCollectionVideosRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		pack.RespError(c, errno.ParamVerifyError.WithError(err))
+		return
+	}
+
+	videoIDs, total, err := rpc.GetCollectionVideosRPC(ctx, &interaction.GetCollectionVideosRequest{
+		CollectionId: req.CollectionID,
+		Page:         req.Page,
+		Size:         req.Size,
+	})
+	if err != nil {
+		pack.RespError(c, err)
+		return
+	}
+	pack.RespData(c, map[string]any{
+		"video_ids": videoIDs,
+		"total":     total,
+	})
+}
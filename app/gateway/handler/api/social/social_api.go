@@ -4,8 +4,12 @@ package social
 
 import (
 	"context"
+	"crypto/rand"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
+	"io"
+	"os"
 	"strconv"
 	"sync"
 	"time"
@@ -18,9 +22,16 @@ import (
 	"github.com/yxrxy/videoHub/app/gateway/pack"
 	"github.com/yxrxy/videoHub/app/gateway/rpc"
 	"github.com/yxrxy/videoHub/app/gateway/service/ws"
+	"github.com/yxrxy/videoHub/config"
+	"github.com/yxrxy/videoHub/kitex_gen/model"
 	"github.com/yxrxy/videoHub/kitex_gen/social"
+	"github.com/yxrxy/videoHub/kitex_gen/video"
+	"github.com/yxrxy/videoHub/pkg/base/client"
+	metainfoContext "github.com/yxrxy/videoHub/pkg/base/context"
+	"github.com/yxrxy/videoHub/pkg/constants"
 	"github.com/yxrxy/videoHub/pkg/errno"
 	"github.com/yxrxy/videoHub/pkg/jwt"
+	"github.com/yxrxy/videoHub/pkg/kafka"
 )
 
 var (
@@ -31,12 +42,82 @@ var (
 
 func initGlobalManager() {
 	once.Do(func() {
-		globalManager = ws.NewManager()
-		globalService = ws.NewWsService(globalManager)
+		var cursors ws.CursorStore
+		globalManager, cursors = newManager()
+		configureSendQueue(globalManager)
+		globalService = ws.NewWsService(globalManager, ws.NewRPCStore(), cursors)
 		go globalService.Start(context.Background())
+		go consumeNotifications(context.Background())
+		go consumePresence(context.Background())
 	})
 }
 
+// consumeNotifications 消费社交服务投递的通知推送，多个网关实例共用一个消费组
+func consumeNotifications(ctx context.Context) {
+	msgCh := kafka.NewKafkaInstance().Consume(ctx,
+		constants.NotificationPushTopic,
+		constants.NotificationConsumerNum,
+		constants.NotificationPushGroupID,
+		constants.NotificationConsumerChanCap)
+	globalService.ConsumeNotifications(ctx, msgCh)
+}
+
+// consumePresence 消费社交服务投递的在线状态变化，多个网关实例共用一个消费组
+func consumePresence(ctx context.Context) {
+	msgCh := kafka.NewKafkaInstance().Consume(ctx,
+		constants.PresencePushTopic,
+		constants.PresenceConsumerNum,
+		constants.PresencePushGroupID,
+		constants.PresenceConsumerChanCap)
+	globalService.ConsumePresence(ctx, msgCh)
+}
+
+// newManager Redis 可用时创建集群模式的管理器，多个网关实例通过 Redis pub/sub 转发消息，
+// 设备游标也保存在 Redis 中
+func newManager() (*ws.Manager, ws.CursorStore) {
+	redisClient, err := client.NewRedisClient(config.Redis.DB.Social)
+	if err != nil {
+		klog.Warnf("Redis不可用，WebSocket以单机模式运行: %v", err)
+		return ws.NewManager(), ws.NewMemoryCursorStore()
+	}
+	nodeID := config.Gateway.NodeID
+	if nodeID == "" {
+		hostname, _ := os.Hostname()
+		nodeID = fmt.Sprintf("%s-%d", hostname, os.Getpid())
+	}
+	manager := ws.NewClusterManager(nodeID, ws.NewRedisBroker(redisClient), ws.NewRedisPresence(redisClient))
+	return manager, ws.NewRedisCursorStore(redisClient)
+}
+
+// configureSendQueue 按配置设置客户端发送队列并注册队列指标
+func configureSendQueue(manager *ws.Manager) {
+	policy, err := ws.ParseSlowConsumerPolicy(config.Gateway.SlowConsumerPolicy)
+	if err != nil {
+		klog.Warnf("慢消费者策略配置无效，使用默认策略: %v", err)
+		policy = ws.SlowConsumerDropOldest
+	}
+	manager.SetSendQueue(config.Gateway.SendQueueSize, policy)
+	if err := manager.RegisterMetrics(); err != nil {
+		klog.Warnf("注册WebSocket发送队列指标失败: %v", err)
+	}
+}
+
+// deviceID 读取客户端传入的设备ID，未传入时生成随机ID
+func deviceID(c *app.RequestContext) (string, error) {
+	id := c.Query("device_id")
+	if len(id) > constants.WebSocketMaxDeviceIDLen {
+		return "", errno.ParamVerifyError.WithMessage("device_id too long")
+	}
+	if id != "" {
+		return id, nil
+	}
+	buf := make([]byte, 8)
+	if _, err := rand.Read(buf); err != nil {
+		return "", errno.InternalServiceError.WithError(err)
+	}
+	return hex.EncodeToString(buf), nil
+}
+
 // SendPrivateMessage .
 // @router /api/v1/social/private/message [POST]
 func SendPrivateMessage(ctx context.Context, c *app.RequestContext) {
@@ -47,12 +128,18 @@ func SendPrivateMessage(ctx context.Context, c *app.RequestContext) {
 		pack.RespError(c, errno.ParamVerifyError.WithError(err))
 		return
 	}
-	err = globalService.SendPrivateMessage(req.SenderID, req.ReceiverID, req.Content)
+	userID, err := metainfoContext.GetUserID(ctx)
 	if err != nil {
 		pack.RespError(c, err)
 		return
 	}
-	pack.RespSuccess(c)
+	initGlobalManager()
+	msg, err := globalService.SendPrivateMessage(ctx, userID, req.ReceiverID, req.Content, req.GetReplyToID())
+	if err != nil {
+		pack.RespError(c, err)
+		return
+	}
+	pack.RespData(c, msg)
 }
 
 // GetPrivateMessages .
@@ -90,7 +177,6 @@ func CreateChatRoom(ctx context.Context, c *app.RequestContext) {
 	}
 
 	resp, err := rpc.CreateChatRoomRPC(ctx, &social.CreateChatRoomRequest{
-		CreatorId: req.CreatorID,
 		Name:      req.Name,
 		Type:      req.Type,
 		MemberIds: req.MemberIds,
@@ -156,17 +242,24 @@ func SendChatMessage(ctx context.Context, c *app.RequestContext) {
 		pack.RespError(c, errno.ParamVerifyError.WithError(err))
 		return
 	}
+	userID, err := metainfoContext.GetUserID(ctx)
+	if err != nil {
+		pack.RespError(c, err)
+		return
+	}
+	// 视频分享卡片由 ShareVideo 根据视频详情生成，不接受客户端构造
+	if req.GetType() < 0 || req.GetType() > int8(social.MessageType_FILE) {
+		pack.RespError(c, errno.ParamVerifyError.WithMessage("invalid message type"))
+		return
+	}
 
-	resp, err := rpc.SendChatMessageRPC(ctx, &social.SendChatMessageRequest{
-		RoomId:   req.RoomID,
-		SenderId: req.SenderID,
-		Content:  req.Content,
-	})
+	initGlobalManager()
+	msg, err := globalService.SendChatMessage(ctx, userID, req.RoomID, req.Content, req.GetType(), req.GetReplyToID())
 	if err != nil {
 		pack.RespError(c, err)
 		return
 	}
-	pack.RespData(c, resp)
+	pack.RespData(c, msg)
 }
 
 // GetChatMessages .
@@ -202,16 +295,22 @@ func AddFriend(ctx context.Context, c *app.RequestContext) {
 		pack.RespError(c, errno.ParamVerifyError.WithError(err))
 		return
 	}
+	userID, err := metainfoContext.GetUserID(ctx)
+	if err != nil {
+		pack.RespError(c, err)
+		return
+	}
 
-	err = rpc.AddFriendRPC(ctx, &social.AddFriendRequest{
-		UserId:   req.UserID,
+	request, err := rpc.AddFriendRPC(ctx, &social.AddFriendRequest{
 		FriendId: req.FriendID,
 	})
 	if err != nil {
 		pack.RespError(c, err)
 		return
 	}
-	pack.RespSuccess(c)
+	initGlobalManager()
+	globalService.PublishFriendRequest(userID, request)
+	pack.RespData(c, request)
 }
 
 // GetFriendship .
@@ -268,15 +367,22 @@ func CreateFriendRequest(ctx context.Context, c *app.RequestContext) {
 		pack.RespError(c, errno.ParamVerifyError.WithError(err))
 		return
 	}
+	userID, err := metainfoContext.GetUserID(ctx)
+	if err != nil {
+		pack.RespError(c, err)
+		return
+	}
 
 	resp, err := rpc.CreateFriendRequestRPC(ctx, &social.CreateFriendRequestRequest{
-		SenderId:   req.SenderID,
 		ReceiverId: req.ReceiverID,
+		Message:    req.Message,
 	})
 	if err != nil {
 		pack.RespError(c, err)
 		return
 	}
+	initGlobalManager()
+	globalService.PublishFriendRequest(userID, resp)
 	pack.RespData(c, resp)
 }
 
@@ -292,10 +398,9 @@ func GetFriendRequests(ctx context.Context, c *app.RequestContext) {
 	}
 
 	resp, _, err := rpc.GetFriendRequestsRPC(ctx, &social.GetFriendRequestsRequest{
-		UserId: req.UserID,
-		Type:   req.Type,
-		Page:   req.Page,
-		Size:   req.Size,
+		Type: req.Type,
+		Page: req.Page,
+		Size: req.Size,
 	})
 	if err != nil {
 		pack.RespError(c, err)
@@ -314,17 +419,23 @@ func HandleFriendRequest(ctx context.Context, c *app.RequestContext) {
 		pack.RespError(c, errno.ParamVerifyError.WithError(err))
 		return
 	}
+	userID, err := metainfoContext.GetUserID(ctx)
+	if err != nil {
+		pack.RespError(c, err)
+		return
+	}
 
-	err = rpc.HandleFriendRequestRPC(ctx, &social.HandleFriendRequestRequest{
+	request, err := rpc.HandleFriendRequestRPC(ctx, &social.HandleFriendRequestRequest{
 		RequestId: req.RequestID,
-		UserId:    req.UserID,
 		Action:    req.Action,
 	})
 	if err != nil {
 		pack.RespError(c, err)
 		return
 	}
-	pack.RespSuccess(c)
+	initGlobalManager()
+	globalService.PublishFriendRequest(userID, request)
+	pack.RespData(c, request)
 }
 
 // MarkMessageRead .
@@ -337,16 +448,22 @@ func MarkMessageRead(ctx context.Context, c *app.RequestContext) {
 		pack.RespError(c, errno.ParamVerifyError.WithError(err))
 		return
 	}
+	userID, err := metainfoContext.GetUserID(ctx)
+	if err != nil {
+		pack.RespError(c, err)
+		return
+	}
 
-	err = rpc.MarkMessageReadRPC(ctx, &social.MarkMessageReadRequest{
+	cursor, err := rpc.MarkMessageReadRPC(ctx, &social.MarkMessageReadRequest{
 		MessageId: req.MessageID,
-		UserId:    req.UserID,
 	})
 	if err != nil {
 		pack.RespError(c, err)
 		return
 	}
-	pack.RespSuccess(c)
+	initGlobalManager()
+	globalService.PublishReadReceipt(userID, cursor.GetPeerId(), 0, cursor.ReadSeq)
+	pack.RespData(c, cursor)
 }
 
 // GetUnreadMessageCount .
@@ -360,9 +477,7 @@ func GetUnreadMessageCount(ctx context.Context, c *app.RequestContext) {
 		return
 	}
 
-	resp, err := rpc.GetUnreadMessageCountRPC(ctx, &social.GetUnreadMessageCountRequest{
-		UserId: req.UserID,
-	})
+	resp, err := rpc.GetUnreadMessageCountRPC(ctx, &social.GetUnreadMessageCountRequest{})
 	if err != nil {
 		pack.RespError(c, err)
 		return
@@ -381,12 +496,12 @@ func ConnectWebSocket(ctx context.Context, c *app.RequestContext) {
 	initGlobalManager()
 
 	token := c.Query("token")
+	// room_id 大于 0 时推送该聊天室的最近消息，为 -1 时推送与 to_user 的最近私信
 	roomID, _ := strconv.ParseInt(c.Query("room_id"), 10, 64)
-	var toUserID int64 = -1
+	var toUserID int64
 	if roomID == -1 {
 		toUserID, _ = strconv.ParseInt(c.Query("to_user"), 10, 64)
 	}
-	// roomID可以为0
 
 	claims, err := jwt.ParseToken(token)
 	if err != nil {
@@ -395,6 +510,13 @@ func ConnectWebSocket(ctx context.Context, c *app.RequestContext) {
 	}
 
 	userID := claims.UserID
+	// WebSocket 路由不经过鉴权中间件，由 token 解析出的用户写入 ctx 供后续 RPC 使用
+	ctx = metainfoContext.WithUserID(ctx, userID)
+	device, err := deviceID(c)
+	if err != nil {
+		pack.RespError(c, err)
+		return
+	}
 
 	upgrader := websocket.HertzUpgrader{
 		CheckOrigin: func(ctx *app.RequestContext) bool {
@@ -406,44 +528,27 @@ func ConnectWebSocket(ctx context.Context, c *app.RequestContext) {
 	}
 
 	if err := upgrader.Upgrade(c, func(conn *websocket.Conn) {
-		defer conn.Close()
-		defer func() {
-			if roomID != -1 {
-				if err := globalService.LeaveChatRoom(userID, roomID); err != nil {
-					klog.Errorf("离开聊天室失败: %v", err)
-				}
-			}
-		}()
-
-		if err := globalService.RegisterClient(userID, conn); err != nil {
+		client, err := globalService.RegisterClient(ctx, userID, device, conn)
+		if err != nil {
 			conn.WriteJSON(map[string]interface{}{
 				"type":    "error",
 				"message": "注册WebSocket客户端失败: " + err.Error(),
 			})
+			conn.Close()
 			return
 		}
+		// 初始化完成后进入读循环，指定聊天室加入失败时客户端仍可通过 join 帧加入其他聊天室
+		// 连接断开时由 ReadPump 注销客户端，客户端所在的聊天室随之清理
+		defer client.ReadPump(ctx, globalService)
 
-		if roomID != -1 {
-			// 验证聊天室是否存在
-			_, err := rpc.GetChatRoomRPC(ctx, &social.GetChatRoomRequest{
-				RoomId: roomID,
-				UserId: userID,
-			})
-			if err != nil {
-				klog.Errorf("聊天室不存在: %v", err)
-				conn.WriteJSON(map[string]interface{}{
-					"type":    "error",
-					"message": "聊天室不存在",
-				})
-				return
-			}
-
-			// 加入聊天室
-			if err := globalService.JoinChatRoom(userID, roomID); err != nil {
+		switch {
+		case roomID > 0:
+			// 当前连接订阅指定的聊天室，用户已加入的聊天室在注册时已自动订阅
+			if err := globalService.JoinRoom(ctx, client, roomID); err != nil {
 				klog.Errorf("加入聊天室失败: %v", err)
-				conn.WriteJSON(map[string]interface{}{
+				sendJSON(client, map[string]interface{}{
 					"type":    "error",
-					"message": "加入聊天室失败: " + err.Error(),
+					"message": "无权加入该聊天室",
 				})
 				return
 			}
@@ -454,33 +559,28 @@ func ConnectWebSocket(ctx context.Context, c *app.RequestContext) {
 			// 获取最近的消息历史
 			msgResp, _, err := rpc.GetChatMessagesRPC(ctx, &social.GetChatMessagesRequest{
 				RoomId: roomID,
-				UserId: userID,
 				Page:   &page,
 				Size:   &size,
 			})
 			if err != nil {
 				klog.Errorf("获取历史消息失败: %v", err)
 			} else {
-				if err := conn.WriteJSON(map[string]interface{}{
+				sendJSON(client, map[string]interface{}{
 					"type":     "history",
 					"messages": msgResp,
-				}); err != nil {
-					klog.Errorf("发送历史消息失败: %v", err)
-				}
+				})
 			}
 
 			// 发送欢迎消息
-			if err := conn.WriteJSON(map[string]interface{}{
+			sendJSON(client, map[string]interface{}{
 				"type":    "system",
 				"message": "欢迎加入聊天室",
 				"room_id": roomID,
-			}); err != nil {
-				klog.Errorf("发送欢迎消息失败: %v", err)
-			}
-		} else {
+			})
+		case toUserID > 0:
 			page := int32(1)
 			size := int32(10)
-			// 获取未读私信数量
+			// 获取最近的私信
 			resp, _, err := rpc.GetPrivateMessagesRPC(ctx, &social.GetPrivateMessagesRequest{
 				UserId:      userID,
 				OtherUserId: toUserID,
@@ -488,109 +588,19 @@ func ConnectWebSocket(ctx context.Context, c *app.RequestContext) {
 				Size:        &size,
 			})
 			if err != nil {
-				klog.Errorf("获取未读消息数失败: %v", err)
+				klog.Errorf("获取私信历史失败: %v", err)
 			} else {
-				if err := conn.WriteJSON(map[string]interface{}{
+				sendJSON(client, map[string]interface{}{
 					"type":     "history",
 					"messages": resp,
-				}); err != nil {
-					klog.Errorf("发送未读消息数失败: %v", err)
-				}
+				})
 			}
 
 			// 发送欢迎消息
-			if err := conn.WriteJSON(map[string]interface{}{
+			sendJSON(client, map[string]interface{}{
 				"type":    "system",
 				"message": "和用户" + strconv.FormatInt(toUserID, 10) + "建立连接",
-			}); err != nil {
-				klog.Errorf("发送欢迎消息失败: %v", err)
-			}
-		}
-
-		// 持续读取消息
-		for {
-			messageType, message, err := conn.ReadMessage()
-			if err != nil {
-				klog.Errorf("读取消息错误: %v", err)
-				return
-			}
-
-			if messageType != websocket.TextMessage {
-				klog.Warnf("忽略非文本消息: type=%d", messageType)
-				continue
-			}
-
-			var content interface{}
-			if err := json.Unmarshal(message, &content); err != nil {
-				content = string(message)
-			}
-			msg := ws.Message{
-				RoomID:  roomID,
-				Content: fmt.Sprint(content),
-				Extra: map[string]any{
-					"type": "text",
-				},
-			}
-			if roomID != -1 {
-				msg.Type = ws.MessageTypeGroup
-			} else {
-				msg.Type = ws.MessageTypePrivate
-			}
-			switch msg.Type {
-			case "private":
-				// 私聊消息处理
-				msg := ws.Message{
-					Type:      ws.MessageTypePrivate,
-					From:      userID,
-					To:        toUserID,
-					Content:   msg.Content,
-					Timestamp: time.Now().Unix(),
-					Extra:     msg.Extra,
-				}
-
-				// 发送私聊消息
-				msgBytes, _ := json.Marshal(msg)
-				if err := globalManager.SendToUser(msg.To, msgBytes); err != nil {
-					klog.Errorf("发送私聊消息失败: %v", err)
-					conn.WriteJSON(map[string]interface{}{
-						"type":    "error",
-						"message": "发送私聊消息失败: " + err.Error(),
-					})
-				}
-
-				// 将消息保存到数据库
-				_, err := rpc.SendPrivateMessageRPC(ctx, &social.SendPrivateMessageRequest{
-					SenderId:   userID,
-					ReceiverId: msg.To,
-					Content:    msg.Content,
-				})
-				if err != nil {
-					klog.Errorf("保存私聊消息失败: %v", err)
-				}
-
-			case "group":
-				msg := ws.Message{
-					Type:      ws.MessageTypeGroup,
-					From:      userID,
-					RoomID:    msg.RoomID,
-					Content:   msg.Content,
-					Timestamp: time.Now().Unix(),
-					Extra:     msg.Extra,
-				}
-
-				// 发送群聊消息
-				globalManager.SendToRoom(userID, msg.RoomID, &msg)
-
-				// 将消息保存到数据库
-				_, err := rpc.SendChatMessageRPC(ctx, &social.SendChatMessageRequest{
-					RoomId:   msg.RoomID,
-					SenderId: userID,
-					Content:  msg.Content,
-				})
-				if err != nil {
-					klog.Errorf("保存群聊消息失败: %v", err)
-				}
-			}
+			})
 		}
 	}); err != nil {
 		klog.Errorf("升级WebSocket连接失败: %v, 请求头: %s", err, c.Request.Header.String())
@@ -598,3 +608,798 @@ func ConnectWebSocket(ctx context.Context, c *app.RequestContext) {
 		return
 	}
 }
+
+// sendJSON 通过客户端发送队列发送 JSON 消息
+func sendJSON(client *ws.Client, v interface{}) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		klog.Errorf("消息序列化失败: %v", err)
+		return
+	}
+	if err := client.SendMessage(data); err != nil {
+		klog.Errorf("发送消息失败: %v", err)
+	}
+}
+
+// SyncMessages .
+// @router /api/v1/social/messages/sync [GET]
+func SyncMessages(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req api.SyncMessagesRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		pack.RespError(c, errno.ParamVerifyError.WithError(err))
+		return
+	}
+
+	resp, err := rpc.SyncMessagesRPC(ctx, &social.SyncMessagesRequest{
+		PeerId:   req.PeerID,
+		RoomId:   req.RoomID,
+		AfterSeq: req.AfterSeq,
+		Limit:    req.Limit,
+	})
+	if err != nil {
+		pack.RespError(c, err)
+		return
+	}
+	pack.RespData(c, resp)
+}
+
+// AddChatRoomMembers .
+// @router /api/v1/social/chatroom/:room_id/members [POST]
+func AddChatRoomMembers(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req api.AddChatRoomMembersRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		pack.RespError(c, errno.ParamVerifyError.WithError(err))
+		return
+	}
+
+	resp, err := rpc.AddChatRoomMembersRPC(ctx, &social.AddChatRoomMembersRequest{
+		RoomId:    req.RoomID,
+		MemberIds: req.MemberIds,
+	})
+	if err != nil {
+		pack.RespError(c, err)
+		return
+	}
+	// 新成员的在线连接立即订阅聊天室
+	initGlobalManager()
+	globalService.AddRoomMembers(req.RoomID, resp.AddedIds, resp.SystemMessage)
+	pack.RespData(c, resp.AddedIds)
+}
+
+// RemoveChatRoomMember .
+// @router /api/v1/social/chatroom/:room_id/members/:user_id [DELETE]
+func RemoveChatRoomMember(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req api.RemoveChatRoomMemberRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		pack.RespError(c, errno.ParamVerifyError.WithError(err))
+		return
+	}
+	userID, err := metainfoContext.GetUserID(ctx)
+	if err != nil {
+		pack.RespError(c, err)
+		return
+	}
+
+	resp, err := rpc.RemoveChatRoomMemberRPC(ctx, &social.RemoveChatRoomMemberRequest{
+		RoomId: req.RoomID,
+		UserId: req.UserID,
+	})
+	if err != nil {
+		pack.RespError(c, err)
+		return
+	}
+	initGlobalManager()
+	globalService.RemoveRoomMember(userID, req.RoomID, req.UserID, resp.SystemMessage)
+	pack.RespSuccess(c)
+}
+
+// LeaveChatRoom .
+// @router /api/v1/social/chatroom/:room_id/leave [POST]
+func LeaveChatRoom(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req api.LeaveChatRoomRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		pack.RespError(c, errno.ParamVerifyError.WithError(err))
+		return
+	}
+	userID, err := metainfoContext.GetUserID(ctx)
+	if err != nil {
+		pack.RespError(c, err)
+		return
+	}
+
+	resp, err := rpc.LeaveChatRoomRPC(ctx, &social.LeaveChatRoomRequest{
+		RoomId: req.RoomID,
+	})
+	if err != nil {
+		pack.RespError(c, err)
+		return
+	}
+	initGlobalManager()
+	globalService.RemoveRoomMember(userID, req.RoomID, userID, resp.SystemMessage)
+	pack.RespSuccess(c)
+}
+
+// SetChatRoomAdmin .
+// @router /api/v1/social/chatroom/:room_id/admins/:user_id [PUT]
+func SetChatRoomAdmin(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req api.SetChatRoomAdminRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		pack.RespError(c, errno.ParamVerifyError.WithError(err))
+		return
+	}
+
+	resp, err := rpc.SetChatRoomAdminRPC(ctx, &social.SetChatRoomAdminRequest{
+		RoomId:  req.RoomID,
+		UserId:  req.UserID,
+		IsAdmin: req.IsAdmin,
+	})
+	if err != nil {
+		pack.RespError(c, err)
+		return
+	}
+	initGlobalManager()
+	globalService.PublishRoomEvent(resp.SystemMessage)
+	pack.RespSuccess(c)
+}
+
+// TransferChatRoom .
+// @router /api/v1/social/chatroom/:room_id/owner [PUT]
+func TransferChatRoom(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req api.TransferChatRoomRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		pack.RespError(c, errno.ParamVerifyError.WithError(err))
+		return
+	}
+
+	resp, err := rpc.TransferChatRoomRPC(ctx, &social.TransferChatRoomRequest{
+		RoomId:      req.RoomID,
+		NewOwnerId_: req.NewOwnerID,
+	})
+	if err != nil {
+		pack.RespError(c, err)
+		return
+	}
+	initGlobalManager()
+	globalService.PublishRoomEvent(resp.SystemMessage)
+	pack.RespSuccess(c)
+}
+
+// MuteChatRoomMember .
+// @router /api/v1/social/chatroom/:room_id/members/:user_id/mute [PUT]
+func MuteChatRoomMember(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req api.MuteChatRoomMemberRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		pack.RespError(c, errno.ParamVerifyError.WithError(err))
+		return
+	}
+
+	resp, err := rpc.MuteChatRoomMemberRPC(ctx, &social.MuteChatRoomMemberRequest{
+		RoomId:   req.RoomID,
+		UserId:   req.UserID,
+		Duration: req.Duration,
+	})
+	if err != nil {
+		pack.RespError(c, err)
+		return
+	}
+	initGlobalManager()
+	globalService.PublishRoomEvent(resp.SystemMessage)
+	pack.RespData(c, map[string]int64{"muted_until": resp.MutedUntil})
+}
+
+// RenameChatRoom .
+// @router /api/v1/social/chatroom/:room_id [PUT]
+func RenameChatRoom(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req api.RenameChatRoomRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		pack.RespError(c, errno.ParamVerifyError.WithError(err))
+		return
+	}
+
+	resp, err := rpc.RenameChatRoomRPC(ctx, &social.RenameChatRoomRequest{
+		RoomId: req.RoomID,
+		Name:   req.Name,
+	})
+	if err != nil {
+		pack.RespError(c, err)
+		return
+	}
+	initGlobalManager()
+	globalService.PublishRoomEvent(resp.SystemMessage)
+	pack.RespSuccess(c)
+}
+
+// DisbandChatRoom .
+// @router /api/v1/social/chatroom/:room_id [DELETE]
+func DisbandChatRoom(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req api.DisbandChatRoomRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		pack.RespError(c, errno.ParamVerifyError.WithError(err))
+		return
+	}
+	userID, err := metainfoContext.GetUserID(ctx)
+	if err != nil {
+		pack.RespError(c, err)
+		return
+	}
+
+	resp, err := rpc.DisbandChatRoomRPC(ctx, &social.DisbandChatRoomRequest{
+		RoomId: req.RoomID,
+	})
+	if err != nil {
+		pack.RespError(c, err)
+		return
+	}
+	initGlobalManager()
+	globalService.DisbandRoom(userID, req.RoomID, resp.MemberIds, resp.SystemMessage)
+	pack.RespSuccess(c)
+}
+
+// CreateChatRoomInvite .
+// @router /api/v1/social/chatroom/:room_id/invites [POST]
+func CreateChatRoomInvite(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req api.CreateChatRoomInviteRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		pack.RespError(c, errno.ParamVerifyError.WithError(err))
+		return
+	}
+
+	resp, err := rpc.CreateChatRoomInviteRPC(ctx, &social.CreateChatRoomInviteRequest{
+		RoomId: req.RoomID,
+		Ttl:    req.TTL,
+	})
+	if err != nil {
+		pack.RespError(c, err)
+		return
+	}
+	pack.RespData(c, resp.Invite)
+}
+
+// JoinChatRoomByInvite .
+// @router /api/v1/social/chatroom/invite/:code/join [POST]
+func JoinChatRoomByInvite(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req api.JoinChatRoomByInviteRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		pack.RespError(c, errno.ParamVerifyError.WithError(err))
+		return
+	}
+	userID, err := metainfoContext.GetUserID(ctx)
+	if err != nil {
+		pack.RespError(c, err)
+		return
+	}
+
+	resp, err := rpc.JoinChatRoomByInviteRPC(ctx, &social.JoinChatRoomByInviteRequest{
+		Code: req.Code,
+	})
+	if err != nil {
+		pack.RespError(c, err)
+		return
+	}
+	// 已是成员时没有系统消息，也无需重新订阅
+	if resp.SystemMessage != nil {
+		initGlobalManager()
+		globalService.AddRoomMembers(resp.RoomId, []int64{userID}, resp.SystemMessage)
+	}
+	pack.RespData(c, map[string]int64{"room_id": resp.RoomId})
+}
+
+// UploadChatAttachment .
+// @router /api/v1/social/chat/attachment [POST]
+func UploadChatAttachment(ctx context.Context, c *app.RequestContext) {
+	var err error
+
+	fileHeader, err := c.FormFile("file")
+	if err != nil {
+		pack.RespError(c, errno.ParamVerifyError.WithError(err))
+		return
+	}
+	if fileHeader.Size > int64(chatFileMaxSize()) {
+		pack.RespError(c, errno.ParamVerifyError.WithMessage("file too large"))
+		return
+	}
+
+	file, err := fileHeader.Open()
+	if err != nil {
+		pack.RespError(c, errno.ParamVerifyError.WithError(err))
+		return
+	}
+	defer file.Close()
+
+	data, err := io.ReadAll(file)
+	if err != nil {
+		pack.RespError(c, errno.ParamVerifyError.WithError(err))
+		return
+	}
+
+	resp, err := rpc.UploadChatAttachmentRPC(ctx, &social.UploadChatAttachmentRequest{
+		FileName:    fileHeader.Filename,
+		ContentType: fileHeader.Header.Get("Content-Type"),
+		Data:        data,
+	})
+	if err != nil {
+		pack.RespError(c, err)
+		return
+	}
+	pack.RespData(c, resp.Attachment)
+}
+
+// chatFileMaxSize 网关提前拒绝超过附件大小上限的文件，避免无效的 RPC 传输
+func chatFileMaxSize() int {
+	if config.Social != nil && config.Social.File.MaxSize > 0 {
+		return config.Social.File.MaxSize
+	}
+	return constants.DefaultChatFileMaxSize
+}
+
+// EditChatMessage .
+// @router /api/v1/social/chatroom/message/:message_id [PUT]
+func EditChatMessage(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req api.EditChatMessageRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		pack.RespError(c, errno.ParamVerifyError.WithError(err))
+		return
+	}
+
+	resp, err := rpc.EditChatMessageRPC(ctx, &social.EditChatMessageRequest{
+		MessageId: req.MessageID,
+		Content:   req.Content,
+	})
+	if err != nil {
+		pack.RespError(c, err)
+		return
+	}
+	initGlobalManager()
+	globalService.PublishMessageUpdate(ws.MessageTypeMessageEdited, resp.Message)
+	pack.RespData(c, resp.Message)
+}
+
+// RecallChatMessage .
+// @router /api/v1/social/chatroom/message/:message_id/recall [POST]
+func RecallChatMessage(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req api.RecallChatMessageRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		pack.RespError(c, errno.ParamVerifyError.WithError(err))
+		return
+	}
+
+	resp, err := rpc.RecallChatMessageRPC(ctx, &social.RecallChatMessageRequest{
+		MessageId: req.MessageID,
+	})
+	if err != nil {
+		pack.RespError(c, err)
+		return
+	}
+	initGlobalManager()
+	globalService.PublishMessageUpdate(ws.MessageTypeMessageRecalled, resp.Message)
+	pack.RespData(c, resp.Message)
+}
+
+// ShareVideo .
+// @router /api/v1/social/chatroom/:room_id/share/video [POST]
+func ShareVideo(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req api.ShareVideoRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		pack.RespError(c, errno.ParamVerifyError.WithError(err))
+		return
+	}
+	userID, err := metainfoContext.GetUserID(ctx)
+	if err != nil {
+		pack.RespError(c, err)
+		return
+	}
+
+	v, err := rpc.GetVideoDetailRPC(ctx, &video.DetailRequest{VideoId: req.VideoID})
+	if err != nil {
+		pack.RespError(c, err)
+		return
+	}
+	card, err := json.Marshal(map[string]any{
+		"video_id":  v.Id,
+		"title":     v.Title,
+		"cover_url": v.CoverUrl,
+		"author_id": v.AuthorId,
+	})
+	if err != nil {
+		pack.RespError(c, errno.InternalServiceError.WithError(err))
+		return
+	}
+
+	initGlobalManager()
+	msg, err := globalService.SendChatMessage(ctx, userID, req.RoomID, string(card), int8(social.MessageType_VIDEO_CARD), req.GetReplyToID())
+	if err != nil {
+		pack.RespError(c, err)
+		return
+	}
+	pack.RespData(c, msg)
+}
+
+// MarkConversationsRead .
+// @router /api/v1/social/conversations/read [POST]
+func MarkConversationsRead(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req api.MarkConversationsReadRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		pack.RespError(c, errno.ParamVerifyError.WithError(err))
+		return
+	}
+	userID, err := metainfoContext.GetUserID(ctx)
+	if err != nil {
+		pack.RespError(c, err)
+		return
+	}
+
+	conversations := make([]*model.ReadCursor, 0, len(req.Conversations))
+	for _, conv := range req.Conversations {
+		if conv == nil {
+			continue
+		}
+		conversations = append(conversations, &model.ReadCursor{
+			PeerId:  conv.PeerID,
+			RoomId:  conv.RoomID,
+			ReadSeq: conv.ReadSeq,
+		})
+	}
+	resp, err := rpc.MarkConversationsReadRPC(ctx, &social.MarkConversationsReadRequest{
+		Conversations: conversations,
+	})
+	if err != nil {
+		pack.RespError(c, err)
+		return
+	}
+	initGlobalManager()
+	for _, cursor := range resp.Cursors {
+		globalService.PublishReadReceipt(userID, cursor.GetPeerId(), cursor.GetRoomId(), cursor.ReadSeq)
+	}
+	pack.RespData(c, resp.Cursors)
+}
+
+// GetUnreadCounts .
+// @router /api/v1/social/conversations/unread [GET]
+func GetUnreadCounts(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req api.GetUnreadCountsRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		pack.RespError(c, errno.ParamVerifyError.WithError(err))
+		return
+	}
+
+	resp, err := rpc.GetUnreadCountsRPC(ctx, &social.GetUnreadCountsRequest{})
+	if err != nil {
+		pack.RespError(c, err)
+		return
+	}
+	pack.RespData(c, map[string]any{"counts": resp.Counts, "total": resp.Total})
+}
+
+// GetInbox .
+// @router /api/v1/social/conversations [GET]
+func GetInbox(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req api.GetInboxRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		pack.RespError(c, errno.ParamVerifyError.WithError(err))
+		return
+	}
+
+	resp, err := rpc.GetInboxRPC(ctx, &social.GetInboxRequest{
+		Cursor:   req.Cursor,
+		Limit:    req.Limit,
+		Archived: req.Archived,
+	})
+	if err != nil {
+		pack.RespError(c, err)
+		return
+	}
+	pack.RespData(c, map[string]any{
+		"conversations": resp.Conversations,
+		"next_cursor":   resp.GetNextCursor(),
+		"has_more":      resp.HasMore,
+	})
+}
+
+// UpdateConversation .
+// @router /api/v1/social/conversations/settings [PUT]
+func UpdateConversation(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req api.UpdateConversationRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		pack.RespError(c, errno.ParamVerifyError.WithError(err))
+		return
+	}
+
+	conversation, err := rpc.UpdateConversationRPC(ctx, &social.UpdateConversationRequest{
+		PeerId:   req.PeerID,
+		RoomId:   req.RoomID,
+		Muted:    req.Muted,
+		Pinned:   req.Pinned,
+		Archived: req.Archived,
+	})
+	if err != nil {
+		pack.RespError(c, err)
+		return
+	}
+	pack.RespData(c, conversation)
+}
+
+// GetFriendRecommendations .
+// @router /api/v1/social/friend/recommendations [GET]
+func GetFriendRecommendations(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req api.GetFriendRecommendationsRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		pack.RespError(c, errno.ParamVerifyError.WithError(err))
+		return
+	}
+
+	recommendations, err := rpc.GetFriendRecommendationsRPC(ctx, &social.GetFriendRecommendationsRequest{
+		Limit: req.Limit,
+	})
+	if err != nil {
+		pack.RespError(c, err)
+		return
+	}
+	pack.RespData(c, recommendations)
+}
+
+// GetNotifications .
+// @router /api/v1/social/notifications [GET]
+func GetNotifications(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req api.GetNotificationsRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		pack.RespError(c, errno.ParamVerifyError.WithError(err))
+		return
+	}
+
+	resp, err := rpc.GetNotificationsRPC(ctx, &social.GetNotificationsRequest{
+		Type: req.Type,
+		Page: req.Page,
+		Size: req.Size,
+	})
+	if err != nil {
+		pack.RespError(c, err)
+		return
+	}
+	pack.RespData(c, map[string]any{"notifications": resp.Notifications, "total": resp.Total})
+}
+
+// GetNotificationUnreadCount .
+// @router /api/v1/social/notifications/unread/count [GET]
+func GetNotificationUnreadCount(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req api.GetNotificationUnreadCountRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		pack.RespError(c, errno.ParamVerifyError.WithError(err))
+		return
+	}
+
+	resp, err := rpc.GetNotificationUnreadCountRPC(ctx, &social.GetNotificationUnreadCountRequest{})
+	if err != nil {
+		pack.RespError(c, err)
+		return
+	}
+	pack.RespData(c, map[string]any{"counts": resp.Counts, "total": resp.Total})
+}
+
+// MarkNotificationsRead .
+// @router /api/v1/social/notifications/read [POST]
+func MarkNotificationsRead(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req api.MarkNotificationsReadRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		pack.RespError(c, errno.ParamVerifyError.WithError(err))
+		return
+	}
+
+	count, err := rpc.MarkNotificationsReadRPC(ctx, &social.MarkNotificationsReadRequest{
+		NotificationIds: req.NotificationIds,
+		Type:            req.Type,
+	})
+	if err != nil {
+		pack.RespError(c, err)
+		return
+	}
+	pack.RespData(c, map[string]any{"count": count})
+}
+
+// GetNotificationPreferences .
+// @router /api/v1/social/notifications/preferences [GET]
+func GetNotificationPreferences(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req api.GetNotificationPreferencesRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		pack.RespError(c, errno.ParamVerifyError.WithError(err))
+		return
+	}
+
+	preferences, err := rpc.GetNotificationPreferencesRPC(ctx, &social.GetNotificationPreferencesRequest{})
+	if err != nil {
+		pack.RespError(c, err)
+		return
+	}
+	pack.RespData(c, preferences)
+}
+
+// UpdateNotificationPreferences .
+// @router /api/v1/social/notifications/preferences [PUT]
+func UpdateNotificationPreferences(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req api.UpdateNotificationPreferencesRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		pack.RespError(c, errno.ParamVerifyError.WithError(err))
+		return
+	}
+
+	updates := make([]*model.NotificationPreference, 0, len(req.Preferences))
+	for _, p := range req.Preferences {
+		if p == nil {
+			continue
+		}
+		updates = append(updates, &model.NotificationPreference{Type: p.Type, Enabled: p.Enabled})
+	}
+	preferences, err := rpc.UpdateNotificationPreferencesRPC(ctx, &social.UpdateNotificationPreferencesRequest{
+		Preferences: updates,
+	})
+	if err != nil {
+		pack.RespError(c, err)
+		return
+	}
+	pack.RespData(c, preferences)
+}
+
+// GetPresence .
+// @router /api/v1/social/presence [GET]
+func GetPresence(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req api.GetPresenceRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		pack.RespError(c, errno.ParamVerifyError.WithError(err))
+		return
+	}
+
+	presences, err := rpc.GetPresenceRPC(ctx, &social.GetPresenceRequest{
+		UserIds: req.UserIds,
+	})
+	if err != nil {
+		pack.RespError(c, err)
+		return
+	}
+	pack.RespData(c, presences)
+}
+
+// SearchMessages .
+// @router /api/v1/social/messages/search [GET]
+func SearchMessages(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req api.SearchMessagesRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		pack.RespError(c, errno.ParamVerifyError.WithError(err))
+		return
+	}
+
+	resp, err := rpc.SearchMessagesRPC(ctx, &social.SearchMessagesRequest{
+		Keyword:  req.Keyword,
+		SenderId: req.SenderID,
+		PeerId:   req.PeerID,
+		RoomId:   req.RoomID,
+		FromDate: req.FromDate,
+		ToDate:   req.ToDate,
+		Page:     req.Page,
+		Size:     req.Size,
+	})
+	if err != nil {
+		pack.RespError(c, err)
+		return
+	}
+	pack.RespData(c, map[string]any{"messages": resp.Messages, "total": resp.Total})
+}
+
+// ExportChatHistory .
+// @router /api/v1/social/messages/export [POST]
+func ExportChatHistory(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req api.ExportChatHistoryRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		pack.RespError(c, errno.ParamVerifyError.WithError(err))
+		return
+	}
+
+	resp, err := rpc.ExportChatHistoryRPC(ctx, &social.ExportChatHistoryRequest{
+		PeerId: req.PeerID,
+		RoomId: req.RoomID,
+	})
+	if err != nil {
+		pack.RespError(c, err)
+		return
+	}
+	pack.RespData(c, map[string]any{"url": resp.Url, "message_count": resp.MessageCount})
+}
+
+// EditPrivateMessage .
+// @router /api/v1/social/private/message/:message_id [PUT]
+func EditPrivateMessage(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req api.EditPrivateMessageRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		pack.RespError(c, errno.ParamVerifyError.WithError(err))
+		return
+	}
+
+	resp, err := rpc.EditPrivateMessageRPC(ctx, &social.EditPrivateMessageRequest{
+		MessageId: req.MessageID,
+		Content:   req.Content,
+	})
+	if err != nil {
+		pack.RespError(c, err)
+		return
+	}
+	initGlobalManager()
+	globalService.PublishPrivateMessageUpdate(ws.MessageTypeMessageEdited, resp.Message)
+	pack.RespData(c, resp.Message)
+}
+
+// RecallPrivateMessage .
+// @router /api/v1/social/private/message/:message_id/recall [POST]
+func RecallPrivateMessage(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req api.RecallPrivateMessageRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		pack.RespError(c, errno.ParamVerifyError.WithError(err))
+		return
+	}
+
+	resp, err := rpc.RecallPrivateMessageRPC(ctx, &social.RecallPrivateMessageRequest{
+		MessageId: req.MessageID,
+	})
+	if err != nil {
+		pack.RespError(c, err)
+		return
+	}
+	initGlobalManager()
+	globalService.PublishPrivateMessageUpdate(ws.MessageTypeMessageRecalled, resp.Message)
+	pack.RespData(c, resp.Message)
+}
@@ -5,12 +5,14 @@ package user
 import (
 	"context"
 	"io"
+	"log"
 
 	"github.com/cloudwego/hertz/pkg/app"
 	api "github.com/yxrxy/videoHub/app/gateway/model/user"
 	"github.com/yxrxy/videoHub/app/gateway/pack"
 	"github.com/yxrxy/videoHub/app/gateway/rpc"
 	"github.com/yxrxy/videoHub/kitex_gen/user"
+	"github.com/yxrxy/videoHub/kitex_gen/video"
 	"github.com/yxrxy/videoHub/pkg/errno"
 )
 
@@ -140,3 +142,181 @@ func UploadAvatar(ctx context.Context, c *app.RequestContext) {
 
 	pack.RespData(c, resp)
 }
+
+// Follow .
+// @router /api/v1/user/follow [POST]
+func Follow(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req api.FollowRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		pack.RespError(c, errno.ParamVerifyError.WithError(err))
+		return
+	}
+	mutual, err := rpc.FollowRPC(ctx, &user.FollowRequest{
+		FolloweeId: req.FolloweeID,
+	})
+	if err != nil {
+		pack.RespError(c, err)
+		return
+	}
+	// 回填关注流失败不影响关注结果
+	if err := rpc.BackfillFeedRPC(ctx, &video.BackfillFeedRequest{CreatorId: req.FolloweeID}); err != nil {
+		log.Printf("回填关注流失败: %v", err)
+	}
+	pack.RespData(c, map[string]any{
+		"is_mutual": mutual,
+	})
+}
+
+// Unfollow .
+// @router /api/v1/user/follow/:followee_id [DELETE]
+func Unfollow(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req api.UnfollowRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		pack.RespError(c, errno.ParamVerifyError.WithError(err))
+		return
+	}
+	if err = rpc.UnfollowRPC(ctx, &user.UnfollowRequest{
+		FolloweeId: req.FolloweeID,
+	}); err != nil {
+		pack.RespError(c, err)
+		return
+	}
+	pack.RespSuccess(c)
+}
+
+// GetFollowing .
+// @router /api/v1/user/following [GET]
+func GetFollowing(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req api.FollowListRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		pack.RespError(c, errno.ParamVerifyError.WithError(err))
+		return
+	}
+	resp, err := rpc.GetFollowingRPC(ctx, &user.FollowListRequest{
+		UserId: req.UserID,
+		Cursor: req.Cursor,
+		Limit:  req.Limit,
+	})
+	if err != nil {
+		pack.RespError(c, err)
+		return
+	}
+	pack.RespData(c, resp)
+}
+
+// GetFollowers .
+// @router /api/v1/user/followers [GET]
+func GetFollowers(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req api.FollowListRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		pack.RespError(c, errno.ParamVerifyError.WithError(err))
+		return
+	}
+	resp, err := rpc.GetFollowersRPC(ctx, &user.FollowListRequest{
+		UserId: req.UserID,
+		Cursor: req.Cursor,
+		Limit:  req.Limit,
+	})
+	if err != nil {
+		pack.RespError(c, err)
+		return
+	}
+	pack.RespData(c, resp)
+}
+
+// Block .
+// @router /api/v1/user/block [POST]
+func Block(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req api.BlockRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		pack.RespError(c, errno.ParamVerifyError.WithError(err))
+		return
+	}
+	if err = rpc.BlockRPC(ctx, &user.BlockRequest{UserId: req.UserID}); err != nil {
+		pack.RespError(c, err)
+		return
+	}
+	pack.RespSuccess(c)
+}
+
+// Unblock .
+// @router /api/v1/user/block/:user_id [DELETE]
+func Unblock(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req api.UnblockRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		pack.RespError(c, errno.ParamVerifyError.WithError(err))
+		return
+	}
+	if err = rpc.UnblockRPC(ctx, &user.UnblockRequest{UserId: req.UserID}); err != nil {
+		pack.RespError(c, err)
+		return
+	}
+	pack.RespSuccess(c)
+}
+
+// GetBlockList .
+// @router /api/v1/user/blocks [GET]
+func GetBlockList(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req api.BlockListRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		pack.RespError(c, errno.ParamVerifyError.WithError(err))
+		return
+	}
+	resp, err := rpc.GetBlockListRPC(ctx, &user.BlockListRequest{
+		Cursor: req.Cursor,
+		Limit:  req.Limit,
+	})
+	if err != nil {
+		pack.RespError(c, err)
+		return
+	}
+	pack.RespData(c, resp)
+}
+
+// GetPrivacySettings .
+// @router /api/v1/user/privacy [GET]
+func GetPrivacySettings(ctx context.Context, c *app.RequestContext) {
+	settings, err := rpc.GetPrivacySettingsRPC(ctx, &user.GetPrivacySettingsRequest{})
+	if err != nil {
+		pack.RespError(c, err)
+		return
+	}
+	pack.RespData(c, settings)
+}
+
+// UpdatePrivacySettings .
+// @router /api/v1/user/privacy [PUT]
+func UpdatePrivacySettings(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req api.UpdatePrivacySettingsRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		pack.RespError(c, errno.ParamVerifyError.WithError(err))
+		return
+	}
+	settings, err := rpc.UpdatePrivacySettingsRPC(ctx, &user.UpdatePrivacySettingsRequest{
+		DmPolicy:              req.DmPolicy,
+		LikesVisibility:       req.LikesVisibility,
+		CollectionsVisibility: req.CollectionsVisibility,
+		HideOnline:            req.HideOnline,
+	})
+	if err != nil {
+		pack.RespError(c, err)
+		return
+	}
+	pack.RespData(c, settings)
+}
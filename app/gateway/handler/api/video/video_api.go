@@ -12,7 +12,10 @@ import (
 	api "github.com/yxrxy/videoHub/app/gateway/model/video"
 	"github.com/yxrxy/videoHub/app/gateway/pack"
 	"github.com/yxrxy/videoHub/app/gateway/rpc"
+	"github.com/yxrxy/videoHub/kitex_gen/interaction"
+	"github.com/yxrxy/videoHub/kitex_gen/model"
 	"github.com/yxrxy/videoHub/kitex_gen/video"
+	"github.com/yxrxy/videoHub/pkg/constants"
 	"github.com/yxrxy/videoHub/pkg/errno"
 )
 
@@ -76,6 +79,7 @@ func GetVideoList(ctx context.Context, c *app.RequestContext) {
 		pack.RespError(c, err)
 		return
 	}
+	attachInteractionState(ctx, resp)
 	pack.RespData(c, resp)
 }
 
@@ -101,6 +105,7 @@ func GetHotVideos(ctx context.Context, c *app.RequestContext) {
 		pack.RespError(c, err)
 		return
 	}
+	attachInteractionState(ctx, resp)
 	pack.RespData(c, resp)
 }
 
@@ -176,6 +181,7 @@ func GetVideoDetail(ctx context.Context, c *app.RequestContext) {
 		pack.RespError(c, err)
 		return
 	}
+	attachInteractionState(ctx, []*model.Video{resp})
 	pack.RespData(c, resp)
 }
 
@@ -226,6 +232,7 @@ func SearchVideo(ctx context.Context, c *app.RequestContext) {
 		return
 	}
 
+	attachInteractionState(ctx, resp)
 	pack.RespData(c, resp)
 }
 
@@ -250,5 +257,63 @@ func SemanticSearch(ctx context.Context, c *app.RequestContext) {
 		pack.RespError(c, err)
 		return
 	}
+	var videos []*model.Video
+	for _, item := range resp {
+		videos = append(videos, item.Videos...)
+	}
+	attachInteractionState(ctx, videos)
 	pack.RespData(c, resp)
 }
+
+// attachInteractionState 批量查询并填充视频的互动状态，失败时不影响视频列表返回
+func attachInteractionState(ctx context.Context, videos []*model.Video) {
+	if len(videos) == 0 {
+		return
+	}
+	// 语义搜索的多组结果可能包含同一视频，去重后按批量上限分批查询
+	seen := make(map[int64]bool, len(videos))
+	videoIDs := make([]int64, 0, len(videos))
+	for _, v := range videos {
+		if !seen[v.Id] {
+			seen[v.Id] = true
+			videoIDs = append(videoIDs, v.Id)
+		}
+	}
+	for start := 0; start < len(videoIDs); start += constants.MaxStateBatchSize {
+		end := min(start+constants.MaxStateBatchSize, len(videoIDs))
+		states, err := rpc.GetInteractionStateRPC(ctx, &interaction.GetInteractionStateRequest{
+			VideoIds: videoIDs[start:end],
+		})
+		if err != nil {
+			return
+		}
+		pack.AttachInteractionState(videos, states)
+	}
+}
+
+// GetFollowingFeed .
+// @router /api/v1/video/feed/following [GET]
+func GetFollowingFeed(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req api.FollowingFeedRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		pack.RespError(c, errno.ParamVerifyError.WithError(err))
+		return
+	}
+
+	resp, err := rpc.GetFollowingFeedRPC(ctx, &video.FollowingFeedRequest{
+		Cursor: req.Cursor,
+		Limit:  req.Limit,
+	})
+	if err != nil {
+		pack.RespError(c, err)
+		return
+	}
+	attachInteractionState(ctx, resp.Videos)
+	pack.RespData(c, map[string]any{
+		"videos":      resp.Videos,
+		"next_cursor": resp.GetNextCursor(),
+		"has_more":    resp.HasMore,
+	})
+}
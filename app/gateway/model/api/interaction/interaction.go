@@ -24,6 +24,38 @@ type InteractionAPI interface {
 	DeleteComment(ctx context.Context, req *interaction.DeleteCommentRequest) (r *interaction.DeleteCommentResponse, err error)
 
 	LikeComment(ctx context.Context, req *interaction.LikeCommentRequest) (r *interaction.LikeCommentResponse, err error)
+
+	EditComment(ctx context.Context, req *interaction.EditCommentRequest) (r *interaction.EditCommentResponse, err error)
+
+	GetCommentEditHistory(ctx context.Context, req *interaction.GetCommentEditHistoryRequest) (r *interaction.GetCommentEditHistoryResponse, err error)
+
+	PinComment(ctx context.Context, req *interaction.PinCommentRequest) (r *interaction.PinCommentResponse, err error)
+
+	HeartComment(ctx context.Context, req *interaction.HeartCommentRequest) (r *interaction.HeartCommentResponse, err error)
+	// 表情回应接口
+	React(ctx context.Context, req *interaction.ReactRequest) (r *interaction.ReactResponse, err error)
+
+	GetReactions(ctx context.Context, req *interaction.GetReactionsRequest) (r *interaction.GetReactionsResponse, err error)
+	// 评论审核接口（管理员）
+	GetFlaggedComments(ctx context.Context, req *interaction.GetFlaggedCommentsRequest) (r *interaction.GetFlaggedCommentsResponse, err error)
+
+	ReviewComment(ctx context.Context, req *interaction.ReviewCommentRequest) (r *interaction.ReviewCommentResponse, err error)
+	// 收藏夹接口
+	CreateCollection(ctx context.Context, req *interaction.CreateCollectionRequest) (r *interaction.CreateCollectionResponse, err error)
+
+	UpdateCollection(ctx context.Context, req *interaction.UpdateCollectionRequest) (r *interaction.UpdateCollectionResponse, err error)
+
+	DeleteCollection(ctx context.Context, req *interaction.DeleteCollectionRequest) (r *interaction.DeleteCollectionResponse, err error)
+
+	ReorderCollections(ctx context.Context, req *interaction.ReorderCollectionsRequest) (r *interaction.ReorderCollectionsResponse, err error)
+
+	GetCollections(ctx context.Context, req *interaction.GetCollectionsRequest) (r *interaction.GetCollectionsResponse, err error)
+
+	AddToCollection(ctx context.Context, req *interaction.AddToCollectionRequest) (r *interaction.AddToCollectionResponse, err error)
+
+	RemoveFromCollection(ctx context.Context, req *interaction.RemoveFromCollectionRequest) (r *interaction.RemoveFromCollectionResponse, err error)
+
+	GetCollectionVideos(ctx context.Context, req *interaction.GetCollectionVideosRequest) (r *interaction.GetCollectionVideosResponse, err error)
 }
 
 type InteractionAPIClient struct {
@@ -106,6 +138,150 @@ func (p *InteractionAPIClient) LikeComment(ctx context.Context, req *interaction
 	}
 	return _result.GetSuccess(), nil
 }
+func (p *InteractionAPIClient) EditComment(ctx context.Context, req *interaction.EditCommentRequest) (r *interaction.EditCommentResponse, err error) {
+	var _args InteractionAPIEditCommentArgs
+	_args.Req = req
+	var _result InteractionAPIEditCommentResult
+	if err = p.Client_().Call(ctx, "EditComment", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *InteractionAPIClient) GetCommentEditHistory(ctx context.Context, req *interaction.GetCommentEditHistoryRequest) (r *interaction.GetCommentEditHistoryResponse, err error) {
+	var _args InteractionAPIGetCommentEditHistoryArgs
+	_args.Req = req
+	var _result InteractionAPIGetCommentEditHistoryResult
+	if err = p.Client_().Call(ctx, "GetCommentEditHistory", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *InteractionAPIClient) PinComment(ctx context.Context, req *interaction.PinCommentRequest) (r *interaction.PinCommentResponse, err error) {
+	var _args InteractionAPIPinCommentArgs
+	_args.Req = req
+	var _result InteractionAPIPinCommentResult
+	if err = p.Client_().Call(ctx, "PinComment", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *InteractionAPIClient) HeartComment(ctx context.Context, req *interaction.HeartCommentRequest) (r *interaction.HeartCommentResponse, err error) {
+	var _args InteractionAPIHeartCommentArgs
+	_args.Req = req
+	var _result InteractionAPIHeartCommentResult
+	if err = p.Client_().Call(ctx, "HeartComment", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *InteractionAPIClient) React(ctx context.Context, req *interaction.ReactRequest) (r *interaction.ReactResponse, err error) {
+	var _args InteractionAPIReactArgs
+	_args.Req = req
+	var _result InteractionAPIReactResult
+	if err = p.Client_().Call(ctx, "React", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *InteractionAPIClient) GetReactions(ctx context.Context, req *interaction.GetReactionsRequest) (r *interaction.GetReactionsResponse, err error) {
+	var _args InteractionAPIGetReactionsArgs
+	_args.Req = req
+	var _result InteractionAPIGetReactionsResult
+	if err = p.Client_().Call(ctx, "GetReactions", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *InteractionAPIClient) GetFlaggedComments(ctx context.Context, req *interaction.GetFlaggedCommentsRequest) (r *interaction.GetFlaggedCommentsResponse, err error) {
+	var _args InteractionAPIGetFlaggedCommentsArgs
+	_args.Req = req
+	var _result InteractionAPIGetFlaggedCommentsResult
+	if err = p.Client_().Call(ctx, "GetFlaggedComments", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *InteractionAPIClient) ReviewComment(ctx context.Context, req *interaction.ReviewCommentRequest) (r *interaction.ReviewCommentResponse, err error) {
+	var _args InteractionAPIReviewCommentArgs
+	_args.Req = req
+	var _result InteractionAPIReviewCommentResult
+	if err = p.Client_().Call(ctx, "ReviewComment", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *InteractionAPIClient) CreateCollection(ctx context.Context, req *interaction.CreateCollectionRequest) (r *interaction.CreateCollectionResponse, err error) {
+	var _args InteractionAPICreateCollectionArgs
+	_args.Req = req
+	var _result InteractionAPICreateCollectionResult
+	if err = p.Client_().Call(ctx, "CreateCollection", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *InteractionAPIClient) UpdateCollection(ctx context.Context, req *interaction.UpdateCollectionRequest) (r *interaction.UpdateCollectionResponse, err error) {
+	var _args InteractionAPIUpdateCollectionArgs
+	_args.Req = req
+	var _result InteractionAPIUpdateCollectionResult
+	if err = p.Client_().Call(ctx, "UpdateCollection", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *InteractionAPIClient) DeleteCollection(ctx context.Context, req *interaction.DeleteCollectionRequest) (r *interaction.DeleteCollectionResponse, err error) {
+	var _args InteractionAPIDeleteCollectionArgs
+	_args.Req = req
+	var _result InteractionAPIDeleteCollectionResult
+	if err = p.Client_().Call(ctx, "DeleteCollection", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *InteractionAPIClient) ReorderCollections(ctx context.Context, req *interaction.ReorderCollectionsRequest) (r *interaction.ReorderCollectionsResponse, err error) {
+	var _args InteractionAPIReorderCollectionsArgs
+	_args.Req = req
+	var _result InteractionAPIReorderCollectionsResult
+	if err = p.Client_().Call(ctx, "ReorderCollections", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *InteractionAPIClient) GetCollections(ctx context.Context, req *interaction.GetCollectionsRequest) (r *interaction.GetCollectionsResponse, err error) {
+	var _args InteractionAPIGetCollectionsArgs
+	_args.Req = req
+	var _result InteractionAPIGetCollectionsResult
+	if err = p.Client_().Call(ctx, "GetCollections", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *InteractionAPIClient) AddToCollection(ctx context.Context, req *interaction.AddToCollectionRequest) (r *interaction.AddToCollectionResponse, err error) {
+	var _args InteractionAPIAddToCollectionArgs
+	_args.Req = req
+	var _result InteractionAPIAddToCollectionResult
+	if err = p.Client_().Call(ctx, "AddToCollection", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *InteractionAPIClient) RemoveFromCollection(ctx context.Context, req *interaction.RemoveFromCollectionRequest) (r *interaction.RemoveFromCollectionResponse, err error) {
+	var _args InteractionAPIRemoveFromCollectionArgs
+	_args.Req = req
+	var _result InteractionAPIRemoveFromCollectionResult
+	if err = p.Client_().Call(ctx, "RemoveFromCollection", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *InteractionAPIClient) GetCollectionVideos(ctx context.Context, req *interaction.GetCollectionVideosRequest) (r *interaction.GetCollectionVideosResponse, err error) {
+	var _args InteractionAPIGetCollectionVideosArgs
+	_args.Req = req
+	var _result InteractionAPIGetCollectionVideosResult
+	if err = p.Client_().Call(ctx, "GetCollectionVideos", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
 
 type InteractionAPIProcessor struct {
 	processorMap map[string]thrift.TProcessorFunction
@@ -133,6 +309,22 @@ func NewInteractionAPIProcessor(handler InteractionAPI) *InteractionAPIProcessor
 	self.AddToProcessorMap("GetComments", &interactionAPIProcessorGetComments{handler: handler})
 	self.AddToProcessorMap("DeleteComment", &interactionAPIProcessorDeleteComment{handler: handler})
 	self.AddToProcessorMap("LikeComment", &interactionAPIProcessorLikeComment{handler: handler})
+	self.AddToProcessorMap("EditComment", &interactionAPIProcessorEditComment{handler: handler})
+	self.AddToProcessorMap("GetCommentEditHistory", &interactionAPIProcessorGetCommentEditHistory{handler: handler})
+	self.AddToProcessorMap("PinComment", &interactionAPIProcessorPinComment{handler: handler})
+	self.AddToProcessorMap("HeartComment", &interactionAPIProcessorHeartComment{handler: handler})
+	self.AddToProcessorMap("React", &interactionAPIProcessorReact{handler: handler})
+	self.AddToProcessorMap("GetReactions", &interactionAPIProcessorGetReactions{handler: handler})
+	self.AddToProcessorMap("GetFlaggedComments", &interactionAPIProcessorGetFlaggedComments{handler: handler})
+	self.AddToProcessorMap("ReviewComment", &interactionAPIProcessorReviewComment{handler: handler})
+	self.AddToProcessorMap("CreateCollection", &interactionAPIProcessorCreateCollection{handler: handler})
+	self.AddToProcessorMap("UpdateCollection", &interactionAPIProcessorUpdateCollection{handler: handler})
+	self.AddToProcessorMap("DeleteCollection", &interactionAPIProcessorDeleteCollection{handler: handler})
+	self.AddToProcessorMap("ReorderCollections", &interactionAPIProcessorReorderCollections{handler: handler})
+	self.AddToProcessorMap("GetCollections", &interactionAPIProcessorGetCollections{handler: handler})
+	self.AddToProcessorMap("AddToCollection", &interactionAPIProcessorAddToCollection{handler: handler})
+	self.AddToProcessorMap("RemoveFromCollection", &interactionAPIProcessorRemoveFromCollection{handler: handler})
+	self.AddToProcessorMap("GetCollectionVideos", &interactionAPIProcessorGetCollectionVideos{handler: handler})
 	return self
 }
 func (p *InteractionAPIProcessor) Process(ctx context.Context, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
@@ -435,41 +627,5481 @@ func (p *interactionAPIProcessorLikeComment) Process(ctx context.Context, seqId
 	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
 		err = err2
 	}
-	if err != nil {
-		return
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type interactionAPIProcessorEditComment struct {
+	handler InteractionAPI
+}
+
+func (p *interactionAPIProcessorEditComment) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := InteractionAPIEditCommentArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("EditComment", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := InteractionAPIEditCommentResult{}
+	var retval *interaction.EditCommentResponse
+	if retval, err2 = p.handler.EditComment(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing EditComment: "+err2.Error())
+		oprot.WriteMessageBegin("EditComment", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("EditComment", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type interactionAPIProcessorGetCommentEditHistory struct {
+	handler InteractionAPI
+}
+
+func (p *interactionAPIProcessorGetCommentEditHistory) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := InteractionAPIGetCommentEditHistoryArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("GetCommentEditHistory", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := InteractionAPIGetCommentEditHistoryResult{}
+	var retval *interaction.GetCommentEditHistoryResponse
+	if retval, err2 = p.handler.GetCommentEditHistory(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetCommentEditHistory: "+err2.Error())
+		oprot.WriteMessageBegin("GetCommentEditHistory", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("GetCommentEditHistory", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type interactionAPIProcessorPinComment struct {
+	handler InteractionAPI
+}
+
+func (p *interactionAPIProcessorPinComment) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := InteractionAPIPinCommentArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("PinComment", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := InteractionAPIPinCommentResult{}
+	var retval *interaction.PinCommentResponse
+	if retval, err2 = p.handler.PinComment(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing PinComment: "+err2.Error())
+		oprot.WriteMessageBegin("PinComment", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("PinComment", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type interactionAPIProcessorHeartComment struct {
+	handler InteractionAPI
+}
+
+func (p *interactionAPIProcessorHeartComment) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := InteractionAPIHeartCommentArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("HeartComment", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := InteractionAPIHeartCommentResult{}
+	var retval *interaction.HeartCommentResponse
+	if retval, err2 = p.handler.HeartComment(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing HeartComment: "+err2.Error())
+		oprot.WriteMessageBegin("HeartComment", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("HeartComment", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type interactionAPIProcessorReact struct {
+	handler InteractionAPI
+}
+
+func (p *interactionAPIProcessorReact) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := InteractionAPIReactArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("React", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := InteractionAPIReactResult{}
+	var retval *interaction.ReactResponse
+	if retval, err2 = p.handler.React(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing React: "+err2.Error())
+		oprot.WriteMessageBegin("React", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("React", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type interactionAPIProcessorGetReactions struct {
+	handler InteractionAPI
+}
+
+func (p *interactionAPIProcessorGetReactions) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := InteractionAPIGetReactionsArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("GetReactions", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := InteractionAPIGetReactionsResult{}
+	var retval *interaction.GetReactionsResponse
+	if retval, err2 = p.handler.GetReactions(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetReactions: "+err2.Error())
+		oprot.WriteMessageBegin("GetReactions", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("GetReactions", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type interactionAPIProcessorGetFlaggedComments struct {
+	handler InteractionAPI
+}
+
+func (p *interactionAPIProcessorGetFlaggedComments) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := InteractionAPIGetFlaggedCommentsArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("GetFlaggedComments", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := InteractionAPIGetFlaggedCommentsResult{}
+	var retval *interaction.GetFlaggedCommentsResponse
+	if retval, err2 = p.handler.GetFlaggedComments(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetFlaggedComments: "+err2.Error())
+		oprot.WriteMessageBegin("GetFlaggedComments", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("GetFlaggedComments", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type interactionAPIProcessorReviewComment struct {
+	handler InteractionAPI
+}
+
+func (p *interactionAPIProcessorReviewComment) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := InteractionAPIReviewCommentArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("ReviewComment", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := InteractionAPIReviewCommentResult{}
+	var retval *interaction.ReviewCommentResponse
+	if retval, err2 = p.handler.ReviewComment(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing ReviewComment: "+err2.Error())
+		oprot.WriteMessageBegin("ReviewComment", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("ReviewComment", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type interactionAPIProcessorCreateCollection struct {
+	handler InteractionAPI
+}
+
+func (p *interactionAPIProcessorCreateCollection) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := InteractionAPICreateCollectionArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("CreateCollection", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := InteractionAPICreateCollectionResult{}
+	var retval *interaction.CreateCollectionResponse
+	if retval, err2 = p.handler.CreateCollection(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing CreateCollection: "+err2.Error())
+		oprot.WriteMessageBegin("CreateCollection", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("CreateCollection", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type interactionAPIProcessorUpdateCollection struct {
+	handler InteractionAPI
+}
+
+func (p *interactionAPIProcessorUpdateCollection) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := InteractionAPIUpdateCollectionArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("UpdateCollection", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := InteractionAPIUpdateCollectionResult{}
+	var retval *interaction.UpdateCollectionResponse
+	if retval, err2 = p.handler.UpdateCollection(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing UpdateCollection: "+err2.Error())
+		oprot.WriteMessageBegin("UpdateCollection", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("UpdateCollection", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type interactionAPIProcessorDeleteCollection struct {
+	handler InteractionAPI
+}
+
+func (p *interactionAPIProcessorDeleteCollection) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := InteractionAPIDeleteCollectionArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("DeleteCollection", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := InteractionAPIDeleteCollectionResult{}
+	var retval *interaction.DeleteCollectionResponse
+	if retval, err2 = p.handler.DeleteCollection(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing DeleteCollection: "+err2.Error())
+		oprot.WriteMessageBegin("DeleteCollection", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("DeleteCollection", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type interactionAPIProcessorReorderCollections struct {
+	handler InteractionAPI
+}
+
+func (p *interactionAPIProcessorReorderCollections) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := InteractionAPIReorderCollectionsArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("ReorderCollections", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := InteractionAPIReorderCollectionsResult{}
+	var retval *interaction.ReorderCollectionsResponse
+	if retval, err2 = p.handler.ReorderCollections(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing ReorderCollections: "+err2.Error())
+		oprot.WriteMessageBegin("ReorderCollections", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("ReorderCollections", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type interactionAPIProcessorGetCollections struct {
+	handler InteractionAPI
+}
+
+func (p *interactionAPIProcessorGetCollections) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := InteractionAPIGetCollectionsArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("GetCollections", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := InteractionAPIGetCollectionsResult{}
+	var retval *interaction.GetCollectionsResponse
+	if retval, err2 = p.handler.GetCollections(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetCollections: "+err2.Error())
+		oprot.WriteMessageBegin("GetCollections", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("GetCollections", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type interactionAPIProcessorAddToCollection struct {
+	handler InteractionAPI
+}
+
+func (p *interactionAPIProcessorAddToCollection) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := InteractionAPIAddToCollectionArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("AddToCollection", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := InteractionAPIAddToCollectionResult{}
+	var retval *interaction.AddToCollectionResponse
+	if retval, err2 = p.handler.AddToCollection(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing AddToCollection: "+err2.Error())
+		oprot.WriteMessageBegin("AddToCollection", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("AddToCollection", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type interactionAPIProcessorRemoveFromCollection struct {
+	handler InteractionAPI
+}
+
+func (p *interactionAPIProcessorRemoveFromCollection) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := InteractionAPIRemoveFromCollectionArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("RemoveFromCollection", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := InteractionAPIRemoveFromCollectionResult{}
+	var retval *interaction.RemoveFromCollectionResponse
+	if retval, err2 = p.handler.RemoveFromCollection(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing RemoveFromCollection: "+err2.Error())
+		oprot.WriteMessageBegin("RemoveFromCollection", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("RemoveFromCollection", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type interactionAPIProcessorGetCollectionVideos struct {
+	handler InteractionAPI
+}
+
+func (p *interactionAPIProcessorGetCollectionVideos) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := InteractionAPIGetCollectionVideosArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("GetCollectionVideos", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := InteractionAPIGetCollectionVideosResult{}
+	var retval *interaction.GetCollectionVideosResponse
+	if retval, err2 = p.handler.GetCollectionVideos(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetCollectionVideos: "+err2.Error())
+		oprot.WriteMessageBegin("GetCollectionVideos", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("GetCollectionVideos", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type InteractionAPILikeArgs struct {
+	Req *interaction.LikeRequest `thrift:"req,1"`
+}
+
+func NewInteractionAPILikeArgs() *InteractionAPILikeArgs {
+	return &InteractionAPILikeArgs{}
+}
+
+func (p *InteractionAPILikeArgs) InitDefault() {
+}
+
+var InteractionAPILikeArgs_Req_DEFAULT *interaction.LikeRequest
+
+func (p *InteractionAPILikeArgs) GetReq() (v *interaction.LikeRequest) {
+	if !p.IsSetReq() {
+		return InteractionAPILikeArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+var fieldIDToName_InteractionAPILikeArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *InteractionAPILikeArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *InteractionAPILikeArgs) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_InteractionAPILikeArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *InteractionAPILikeArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := interaction.NewLikeRequest()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Req = _field
+	return nil
+}
+
+func (p *InteractionAPILikeArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("Like_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *InteractionAPILikeArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *InteractionAPILikeArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("InteractionAPILikeArgs(%+v)", *p)
+
+}
+
+type InteractionAPILikeResult struct {
+	Success *interaction.LikeResponse `thrift:"success,0,optional"`
+}
+
+func NewInteractionAPILikeResult() *InteractionAPILikeResult {
+	return &InteractionAPILikeResult{}
+}
+
+func (p *InteractionAPILikeResult) InitDefault() {
+}
+
+var InteractionAPILikeResult_Success_DEFAULT *interaction.LikeResponse
+
+func (p *InteractionAPILikeResult) GetSuccess() (v *interaction.LikeResponse) {
+	if !p.IsSetSuccess() {
+		return InteractionAPILikeResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var fieldIDToName_InteractionAPILikeResult = map[int16]string{
+	0: "success",
+}
+
+func (p *InteractionAPILikeResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *InteractionAPILikeResult) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_InteractionAPILikeResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *InteractionAPILikeResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := interaction.NewLikeResponse()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *InteractionAPILikeResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("Like_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *InteractionAPILikeResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *InteractionAPILikeResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("InteractionAPILikeResult(%+v)", *p)
+
+}
+
+type InteractionAPIGetLikesArgs struct {
+	Req *interaction.GetLikesRequest `thrift:"req,1"`
+}
+
+func NewInteractionAPIGetLikesArgs() *InteractionAPIGetLikesArgs {
+	return &InteractionAPIGetLikesArgs{}
+}
+
+func (p *InteractionAPIGetLikesArgs) InitDefault() {
+}
+
+var InteractionAPIGetLikesArgs_Req_DEFAULT *interaction.GetLikesRequest
+
+func (p *InteractionAPIGetLikesArgs) GetReq() (v *interaction.GetLikesRequest) {
+	if !p.IsSetReq() {
+		return InteractionAPIGetLikesArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+var fieldIDToName_InteractionAPIGetLikesArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *InteractionAPIGetLikesArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *InteractionAPIGetLikesArgs) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_InteractionAPIGetLikesArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *InteractionAPIGetLikesArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := interaction.NewGetLikesRequest()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Req = _field
+	return nil
+}
+
+func (p *InteractionAPIGetLikesArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("GetLikes_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *InteractionAPIGetLikesArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *InteractionAPIGetLikesArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("InteractionAPIGetLikesArgs(%+v)", *p)
+
+}
+
+type InteractionAPIGetLikesResult struct {
+	Success *interaction.GetLikesResponse `thrift:"success,0,optional"`
+}
+
+func NewInteractionAPIGetLikesResult() *InteractionAPIGetLikesResult {
+	return &InteractionAPIGetLikesResult{}
+}
+
+func (p *InteractionAPIGetLikesResult) InitDefault() {
+}
+
+var InteractionAPIGetLikesResult_Success_DEFAULT *interaction.GetLikesResponse
+
+func (p *InteractionAPIGetLikesResult) GetSuccess() (v *interaction.GetLikesResponse) {
+	if !p.IsSetSuccess() {
+		return InteractionAPIGetLikesResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var fieldIDToName_InteractionAPIGetLikesResult = map[int16]string{
+	0: "success",
+}
+
+func (p *InteractionAPIGetLikesResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *InteractionAPIGetLikesResult) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_InteractionAPIGetLikesResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *InteractionAPIGetLikesResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := interaction.NewGetLikesResponse()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *InteractionAPIGetLikesResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("GetLikes_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *InteractionAPIGetLikesResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *InteractionAPIGetLikesResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("InteractionAPIGetLikesResult(%+v)", *p)
+
+}
+
+type InteractionAPICommentArgs struct {
+	Req *interaction.CommentRequest `thrift:"req,1"`
+}
+
+func NewInteractionAPICommentArgs() *InteractionAPICommentArgs {
+	return &InteractionAPICommentArgs{}
+}
+
+func (p *InteractionAPICommentArgs) InitDefault() {
+}
+
+var InteractionAPICommentArgs_Req_DEFAULT *interaction.CommentRequest
+
+func (p *InteractionAPICommentArgs) GetReq() (v *interaction.CommentRequest) {
+	if !p.IsSetReq() {
+		return InteractionAPICommentArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+var fieldIDToName_InteractionAPICommentArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *InteractionAPICommentArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *InteractionAPICommentArgs) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_InteractionAPICommentArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *InteractionAPICommentArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := interaction.NewCommentRequest()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Req = _field
+	return nil
+}
+
+func (p *InteractionAPICommentArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("Comment_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *InteractionAPICommentArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *InteractionAPICommentArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("InteractionAPICommentArgs(%+v)", *p)
+
+}
+
+type InteractionAPICommentResult struct {
+	Success *interaction.CommentResponse `thrift:"success,0,optional"`
+}
+
+func NewInteractionAPICommentResult() *InteractionAPICommentResult {
+	return &InteractionAPICommentResult{}
+}
+
+func (p *InteractionAPICommentResult) InitDefault() {
+}
+
+var InteractionAPICommentResult_Success_DEFAULT *interaction.CommentResponse
+
+func (p *InteractionAPICommentResult) GetSuccess() (v *interaction.CommentResponse) {
+	if !p.IsSetSuccess() {
+		return InteractionAPICommentResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var fieldIDToName_InteractionAPICommentResult = map[int16]string{
+	0: "success",
+}
+
+func (p *InteractionAPICommentResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *InteractionAPICommentResult) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_InteractionAPICommentResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *InteractionAPICommentResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := interaction.NewCommentResponse()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *InteractionAPICommentResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("Comment_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *InteractionAPICommentResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *InteractionAPICommentResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("InteractionAPICommentResult(%+v)", *p)
+
+}
+
+type InteractionAPIGetCommentsArgs struct {
+	Req *interaction.GetCommentsRequest `thrift:"req,1"`
+}
+
+func NewInteractionAPIGetCommentsArgs() *InteractionAPIGetCommentsArgs {
+	return &InteractionAPIGetCommentsArgs{}
+}
+
+func (p *InteractionAPIGetCommentsArgs) InitDefault() {
+}
+
+var InteractionAPIGetCommentsArgs_Req_DEFAULT *interaction.GetCommentsRequest
+
+func (p *InteractionAPIGetCommentsArgs) GetReq() (v *interaction.GetCommentsRequest) {
+	if !p.IsSetReq() {
+		return InteractionAPIGetCommentsArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+var fieldIDToName_InteractionAPIGetCommentsArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *InteractionAPIGetCommentsArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *InteractionAPIGetCommentsArgs) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_InteractionAPIGetCommentsArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *InteractionAPIGetCommentsArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := interaction.NewGetCommentsRequest()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Req = _field
+	return nil
+}
+
+func (p *InteractionAPIGetCommentsArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("GetComments_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *InteractionAPIGetCommentsArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *InteractionAPIGetCommentsArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("InteractionAPIGetCommentsArgs(%+v)", *p)
+
+}
+
+type InteractionAPIGetCommentsResult struct {
+	Success *interaction.CommentListResponse `thrift:"success,0,optional"`
+}
+
+func NewInteractionAPIGetCommentsResult() *InteractionAPIGetCommentsResult {
+	return &InteractionAPIGetCommentsResult{}
+}
+
+func (p *InteractionAPIGetCommentsResult) InitDefault() {
+}
+
+var InteractionAPIGetCommentsResult_Success_DEFAULT *interaction.CommentListResponse
+
+func (p *InteractionAPIGetCommentsResult) GetSuccess() (v *interaction.CommentListResponse) {
+	if !p.IsSetSuccess() {
+		return InteractionAPIGetCommentsResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var fieldIDToName_InteractionAPIGetCommentsResult = map[int16]string{
+	0: "success",
+}
+
+func (p *InteractionAPIGetCommentsResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *InteractionAPIGetCommentsResult) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_InteractionAPIGetCommentsResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *InteractionAPIGetCommentsResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := interaction.NewCommentListResponse()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *InteractionAPIGetCommentsResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("GetComments_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *InteractionAPIGetCommentsResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *InteractionAPIGetCommentsResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("InteractionAPIGetCommentsResult(%+v)", *p)
+
+}
+
+type InteractionAPIDeleteCommentArgs struct {
+	Req *interaction.DeleteCommentRequest `thrift:"req,1"`
+}
+
+func NewInteractionAPIDeleteCommentArgs() *InteractionAPIDeleteCommentArgs {
+	return &InteractionAPIDeleteCommentArgs{}
+}
+
+func (p *InteractionAPIDeleteCommentArgs) InitDefault() {
+}
+
+var InteractionAPIDeleteCommentArgs_Req_DEFAULT *interaction.DeleteCommentRequest
+
+func (p *InteractionAPIDeleteCommentArgs) GetReq() (v *interaction.DeleteCommentRequest) {
+	if !p.IsSetReq() {
+		return InteractionAPIDeleteCommentArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+var fieldIDToName_InteractionAPIDeleteCommentArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *InteractionAPIDeleteCommentArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *InteractionAPIDeleteCommentArgs) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_InteractionAPIDeleteCommentArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *InteractionAPIDeleteCommentArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := interaction.NewDeleteCommentRequest()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Req = _field
+	return nil
+}
+
+func (p *InteractionAPIDeleteCommentArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("DeleteComment_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *InteractionAPIDeleteCommentArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *InteractionAPIDeleteCommentArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("InteractionAPIDeleteCommentArgs(%+v)", *p)
+
+}
+
+type InteractionAPIDeleteCommentResult struct {
+	Success *interaction.DeleteCommentResponse `thrift:"success,0,optional"`
+}
+
+func NewInteractionAPIDeleteCommentResult() *InteractionAPIDeleteCommentResult {
+	return &InteractionAPIDeleteCommentResult{}
+}
+
+func (p *InteractionAPIDeleteCommentResult) InitDefault() {
+}
+
+var InteractionAPIDeleteCommentResult_Success_DEFAULT *interaction.DeleteCommentResponse
+
+func (p *InteractionAPIDeleteCommentResult) GetSuccess() (v *interaction.DeleteCommentResponse) {
+	if !p.IsSetSuccess() {
+		return InteractionAPIDeleteCommentResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var fieldIDToName_InteractionAPIDeleteCommentResult = map[int16]string{
+	0: "success",
+}
+
+func (p *InteractionAPIDeleteCommentResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *InteractionAPIDeleteCommentResult) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_InteractionAPIDeleteCommentResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *InteractionAPIDeleteCommentResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := interaction.NewDeleteCommentResponse()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *InteractionAPIDeleteCommentResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("DeleteComment_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *InteractionAPIDeleteCommentResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *InteractionAPIDeleteCommentResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("InteractionAPIDeleteCommentResult(%+v)", *p)
+
+}
+
+type InteractionAPILikeCommentArgs struct {
+	Req *interaction.LikeCommentRequest `thrift:"req,1"`
+}
+
+func NewInteractionAPILikeCommentArgs() *InteractionAPILikeCommentArgs {
+	return &InteractionAPILikeCommentArgs{}
+}
+
+func (p *InteractionAPILikeCommentArgs) InitDefault() {
+}
+
+var InteractionAPILikeCommentArgs_Req_DEFAULT *interaction.LikeCommentRequest
+
+func (p *InteractionAPILikeCommentArgs) GetReq() (v *interaction.LikeCommentRequest) {
+	if !p.IsSetReq() {
+		return InteractionAPILikeCommentArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+var fieldIDToName_InteractionAPILikeCommentArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *InteractionAPILikeCommentArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *InteractionAPILikeCommentArgs) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_InteractionAPILikeCommentArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *InteractionAPILikeCommentArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := interaction.NewLikeCommentRequest()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Req = _field
+	return nil
+}
+
+func (p *InteractionAPILikeCommentArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("LikeComment_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *InteractionAPILikeCommentArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *InteractionAPILikeCommentArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("InteractionAPILikeCommentArgs(%+v)", *p)
+
+}
+
+type InteractionAPILikeCommentResult struct {
+	Success *interaction.LikeCommentResponse `thrift:"success,0,optional"`
+}
+
+func NewInteractionAPILikeCommentResult() *InteractionAPILikeCommentResult {
+	return &InteractionAPILikeCommentResult{}
+}
+
+func (p *InteractionAPILikeCommentResult) InitDefault() {
+}
+
+var InteractionAPILikeCommentResult_Success_DEFAULT *interaction.LikeCommentResponse
+
+func (p *InteractionAPILikeCommentResult) GetSuccess() (v *interaction.LikeCommentResponse) {
+	if !p.IsSetSuccess() {
+		return InteractionAPILikeCommentResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var fieldIDToName_InteractionAPILikeCommentResult = map[int16]string{
+	0: "success",
+}
+
+func (p *InteractionAPILikeCommentResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *InteractionAPILikeCommentResult) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_InteractionAPILikeCommentResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *InteractionAPILikeCommentResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := interaction.NewLikeCommentResponse()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *InteractionAPILikeCommentResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("LikeComment_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *InteractionAPILikeCommentResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *InteractionAPILikeCommentResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("InteractionAPILikeCommentResult(%+v)", *p)
+
+}
+
+type InteractionAPIEditCommentArgs struct {
+	Req *interaction.EditCommentRequest `thrift:"req,1"`
+}
+
+func NewInteractionAPIEditCommentArgs() *InteractionAPIEditCommentArgs {
+	return &InteractionAPIEditCommentArgs{}
+}
+
+func (p *InteractionAPIEditCommentArgs) InitDefault() {
+}
+
+var InteractionAPIEditCommentArgs_Req_DEFAULT *interaction.EditCommentRequest
+
+func (p *InteractionAPIEditCommentArgs) GetReq() (v *interaction.EditCommentRequest) {
+	if !p.IsSetReq() {
+		return InteractionAPIEditCommentArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+var fieldIDToName_InteractionAPIEditCommentArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *InteractionAPIEditCommentArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *InteractionAPIEditCommentArgs) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_InteractionAPIEditCommentArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *InteractionAPIEditCommentArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := interaction.NewEditCommentRequest()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Req = _field
+	return nil
+}
+
+func (p *InteractionAPIEditCommentArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("EditComment_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *InteractionAPIEditCommentArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *InteractionAPIEditCommentArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("InteractionAPIEditCommentArgs(%+v)", *p)
+
+}
+
+type InteractionAPIEditCommentResult struct {
+	Success *interaction.EditCommentResponse `thrift:"success,0,optional"`
+}
+
+func NewInteractionAPIEditCommentResult() *InteractionAPIEditCommentResult {
+	return &InteractionAPIEditCommentResult{}
+}
+
+func (p *InteractionAPIEditCommentResult) InitDefault() {
+}
+
+var InteractionAPIEditCommentResult_Success_DEFAULT *interaction.EditCommentResponse
+
+func (p *InteractionAPIEditCommentResult) GetSuccess() (v *interaction.EditCommentResponse) {
+	if !p.IsSetSuccess() {
+		return InteractionAPIEditCommentResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var fieldIDToName_InteractionAPIEditCommentResult = map[int16]string{
+	0: "success",
+}
+
+func (p *InteractionAPIEditCommentResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *InteractionAPIEditCommentResult) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_InteractionAPIEditCommentResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *InteractionAPIEditCommentResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := interaction.NewEditCommentResponse()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *InteractionAPIEditCommentResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("EditComment_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *InteractionAPIEditCommentResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *InteractionAPIEditCommentResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("InteractionAPIEditCommentResult(%+v)", *p)
+
+}
+
+type InteractionAPIGetCommentEditHistoryArgs struct {
+	Req *interaction.GetCommentEditHistoryRequest `thrift:"req,1"`
+}
+
+func NewInteractionAPIGetCommentEditHistoryArgs() *InteractionAPIGetCommentEditHistoryArgs {
+	return &InteractionAPIGetCommentEditHistoryArgs{}
+}
+
+func (p *InteractionAPIGetCommentEditHistoryArgs) InitDefault() {
+}
+
+var InteractionAPIGetCommentEditHistoryArgs_Req_DEFAULT *interaction.GetCommentEditHistoryRequest
+
+func (p *InteractionAPIGetCommentEditHistoryArgs) GetReq() (v *interaction.GetCommentEditHistoryRequest) {
+	if !p.IsSetReq() {
+		return InteractionAPIGetCommentEditHistoryArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+var fieldIDToName_InteractionAPIGetCommentEditHistoryArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *InteractionAPIGetCommentEditHistoryArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *InteractionAPIGetCommentEditHistoryArgs) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_InteractionAPIGetCommentEditHistoryArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *InteractionAPIGetCommentEditHistoryArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := interaction.NewGetCommentEditHistoryRequest()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Req = _field
+	return nil
+}
+
+func (p *InteractionAPIGetCommentEditHistoryArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("GetCommentEditHistory_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *InteractionAPIGetCommentEditHistoryArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *InteractionAPIGetCommentEditHistoryArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("InteractionAPIGetCommentEditHistoryArgs(%+v)", *p)
+
+}
+
+type InteractionAPIGetCommentEditHistoryResult struct {
+	Success *interaction.GetCommentEditHistoryResponse `thrift:"success,0,optional"`
+}
+
+func NewInteractionAPIGetCommentEditHistoryResult() *InteractionAPIGetCommentEditHistoryResult {
+	return &InteractionAPIGetCommentEditHistoryResult{}
+}
+
+func (p *InteractionAPIGetCommentEditHistoryResult) InitDefault() {
+}
+
+var InteractionAPIGetCommentEditHistoryResult_Success_DEFAULT *interaction.GetCommentEditHistoryResponse
+
+func (p *InteractionAPIGetCommentEditHistoryResult) GetSuccess() (v *interaction.GetCommentEditHistoryResponse) {
+	if !p.IsSetSuccess() {
+		return InteractionAPIGetCommentEditHistoryResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var fieldIDToName_InteractionAPIGetCommentEditHistoryResult = map[int16]string{
+	0: "success",
+}
+
+func (p *InteractionAPIGetCommentEditHistoryResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *InteractionAPIGetCommentEditHistoryResult) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_InteractionAPIGetCommentEditHistoryResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *InteractionAPIGetCommentEditHistoryResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := interaction.NewGetCommentEditHistoryResponse()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *InteractionAPIGetCommentEditHistoryResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("GetCommentEditHistory_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *InteractionAPIGetCommentEditHistoryResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *InteractionAPIGetCommentEditHistoryResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("InteractionAPIGetCommentEditHistoryResult(%+v)", *p)
+
+}
+
+type InteractionAPIPinCommentArgs struct {
+	Req *interaction.PinCommentRequest `thrift:"req,1"`
+}
+
+func NewInteractionAPIPinCommentArgs() *InteractionAPIPinCommentArgs {
+	return &InteractionAPIPinCommentArgs{}
+}
+
+func (p *InteractionAPIPinCommentArgs) InitDefault() {
+}
+
+var InteractionAPIPinCommentArgs_Req_DEFAULT *interaction.PinCommentRequest
+
+func (p *InteractionAPIPinCommentArgs) GetReq() (v *interaction.PinCommentRequest) {
+	if !p.IsSetReq() {
+		return InteractionAPIPinCommentArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+var fieldIDToName_InteractionAPIPinCommentArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *InteractionAPIPinCommentArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *InteractionAPIPinCommentArgs) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_InteractionAPIPinCommentArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *InteractionAPIPinCommentArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := interaction.NewPinCommentRequest()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Req = _field
+	return nil
+}
+
+func (p *InteractionAPIPinCommentArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("PinComment_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *InteractionAPIPinCommentArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *InteractionAPIPinCommentArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("InteractionAPIPinCommentArgs(%+v)", *p)
+
+}
+
+type InteractionAPIPinCommentResult struct {
+	Success *interaction.PinCommentResponse `thrift:"success,0,optional"`
+}
+
+func NewInteractionAPIPinCommentResult() *InteractionAPIPinCommentResult {
+	return &InteractionAPIPinCommentResult{}
+}
+
+func (p *InteractionAPIPinCommentResult) InitDefault() {
+}
+
+var InteractionAPIPinCommentResult_Success_DEFAULT *interaction.PinCommentResponse
+
+func (p *InteractionAPIPinCommentResult) GetSuccess() (v *interaction.PinCommentResponse) {
+	if !p.IsSetSuccess() {
+		return InteractionAPIPinCommentResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var fieldIDToName_InteractionAPIPinCommentResult = map[int16]string{
+	0: "success",
+}
+
+func (p *InteractionAPIPinCommentResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *InteractionAPIPinCommentResult) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_InteractionAPIPinCommentResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *InteractionAPIPinCommentResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := interaction.NewPinCommentResponse()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *InteractionAPIPinCommentResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("PinComment_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *InteractionAPIPinCommentResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *InteractionAPIPinCommentResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("InteractionAPIPinCommentResult(%+v)", *p)
+
+}
+
+type InteractionAPIHeartCommentArgs struct {
+	Req *interaction.HeartCommentRequest `thrift:"req,1"`
+}
+
+func NewInteractionAPIHeartCommentArgs() *InteractionAPIHeartCommentArgs {
+	return &InteractionAPIHeartCommentArgs{}
+}
+
+func (p *InteractionAPIHeartCommentArgs) InitDefault() {
+}
+
+var InteractionAPIHeartCommentArgs_Req_DEFAULT *interaction.HeartCommentRequest
+
+func (p *InteractionAPIHeartCommentArgs) GetReq() (v *interaction.HeartCommentRequest) {
+	if !p.IsSetReq() {
+		return InteractionAPIHeartCommentArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+var fieldIDToName_InteractionAPIHeartCommentArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *InteractionAPIHeartCommentArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *InteractionAPIHeartCommentArgs) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_InteractionAPIHeartCommentArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *InteractionAPIHeartCommentArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := interaction.NewHeartCommentRequest()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Req = _field
+	return nil
+}
+
+func (p *InteractionAPIHeartCommentArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("HeartComment_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *InteractionAPIHeartCommentArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *InteractionAPIHeartCommentArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("InteractionAPIHeartCommentArgs(%+v)", *p)
+
+}
+
+type InteractionAPIHeartCommentResult struct {
+	Success *interaction.HeartCommentResponse `thrift:"success,0,optional"`
+}
+
+func NewInteractionAPIHeartCommentResult() *InteractionAPIHeartCommentResult {
+	return &InteractionAPIHeartCommentResult{}
+}
+
+func (p *InteractionAPIHeartCommentResult) InitDefault() {
+}
+
+var InteractionAPIHeartCommentResult_Success_DEFAULT *interaction.HeartCommentResponse
+
+func (p *InteractionAPIHeartCommentResult) GetSuccess() (v *interaction.HeartCommentResponse) {
+	if !p.IsSetSuccess() {
+		return InteractionAPIHeartCommentResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var fieldIDToName_InteractionAPIHeartCommentResult = map[int16]string{
+	0: "success",
+}
+
+func (p *InteractionAPIHeartCommentResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *InteractionAPIHeartCommentResult) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_InteractionAPIHeartCommentResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *InteractionAPIHeartCommentResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := interaction.NewHeartCommentResponse()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *InteractionAPIHeartCommentResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("HeartComment_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *InteractionAPIHeartCommentResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *InteractionAPIHeartCommentResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("InteractionAPIHeartCommentResult(%+v)", *p)
+
+}
+
+type InteractionAPIReactArgs struct {
+	Req *interaction.ReactRequest `thrift:"req,1"`
+}
+
+func NewInteractionAPIReactArgs() *InteractionAPIReactArgs {
+	return &InteractionAPIReactArgs{}
+}
+
+func (p *InteractionAPIReactArgs) InitDefault() {
+}
+
+var InteractionAPIReactArgs_Req_DEFAULT *interaction.ReactRequest
+
+func (p *InteractionAPIReactArgs) GetReq() (v *interaction.ReactRequest) {
+	if !p.IsSetReq() {
+		return InteractionAPIReactArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+var fieldIDToName_InteractionAPIReactArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *InteractionAPIReactArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *InteractionAPIReactArgs) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_InteractionAPIReactArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *InteractionAPIReactArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := interaction.NewReactRequest()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Req = _field
+	return nil
+}
+
+func (p *InteractionAPIReactArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("React_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *InteractionAPIReactArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *InteractionAPIReactArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("InteractionAPIReactArgs(%+v)", *p)
+
+}
+
+type InteractionAPIReactResult struct {
+	Success *interaction.ReactResponse `thrift:"success,0,optional"`
+}
+
+func NewInteractionAPIReactResult() *InteractionAPIReactResult {
+	return &InteractionAPIReactResult{}
+}
+
+func (p *InteractionAPIReactResult) InitDefault() {
+}
+
+var InteractionAPIReactResult_Success_DEFAULT *interaction.ReactResponse
+
+func (p *InteractionAPIReactResult) GetSuccess() (v *interaction.ReactResponse) {
+	if !p.IsSetSuccess() {
+		return InteractionAPIReactResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var fieldIDToName_InteractionAPIReactResult = map[int16]string{
+	0: "success",
+}
+
+func (p *InteractionAPIReactResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *InteractionAPIReactResult) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_InteractionAPIReactResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *InteractionAPIReactResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := interaction.NewReactResponse()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *InteractionAPIReactResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("React_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *InteractionAPIReactResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *InteractionAPIReactResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("InteractionAPIReactResult(%+v)", *p)
+
+}
+
+type InteractionAPIGetReactionsArgs struct {
+	Req *interaction.GetReactionsRequest `thrift:"req,1"`
+}
+
+func NewInteractionAPIGetReactionsArgs() *InteractionAPIGetReactionsArgs {
+	return &InteractionAPIGetReactionsArgs{}
+}
+
+func (p *InteractionAPIGetReactionsArgs) InitDefault() {
+}
+
+var InteractionAPIGetReactionsArgs_Req_DEFAULT *interaction.GetReactionsRequest
+
+func (p *InteractionAPIGetReactionsArgs) GetReq() (v *interaction.GetReactionsRequest) {
+	if !p.IsSetReq() {
+		return InteractionAPIGetReactionsArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+var fieldIDToName_InteractionAPIGetReactionsArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *InteractionAPIGetReactionsArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *InteractionAPIGetReactionsArgs) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_InteractionAPIGetReactionsArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *InteractionAPIGetReactionsArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := interaction.NewGetReactionsRequest()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Req = _field
+	return nil
+}
+
+func (p *InteractionAPIGetReactionsArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("GetReactions_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *InteractionAPIGetReactionsArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *InteractionAPIGetReactionsArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("InteractionAPIGetReactionsArgs(%+v)", *p)
+
+}
+
+type InteractionAPIGetReactionsResult struct {
+	Success *interaction.GetReactionsResponse `thrift:"success,0,optional"`
+}
+
+func NewInteractionAPIGetReactionsResult() *InteractionAPIGetReactionsResult {
+	return &InteractionAPIGetReactionsResult{}
+}
+
+func (p *InteractionAPIGetReactionsResult) InitDefault() {
+}
+
+var InteractionAPIGetReactionsResult_Success_DEFAULT *interaction.GetReactionsResponse
+
+func (p *InteractionAPIGetReactionsResult) GetSuccess() (v *interaction.GetReactionsResponse) {
+	if !p.IsSetSuccess() {
+		return InteractionAPIGetReactionsResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var fieldIDToName_InteractionAPIGetReactionsResult = map[int16]string{
+	0: "success",
+}
+
+func (p *InteractionAPIGetReactionsResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *InteractionAPIGetReactionsResult) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_InteractionAPIGetReactionsResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *InteractionAPIGetReactionsResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := interaction.NewGetReactionsResponse()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *InteractionAPIGetReactionsResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("GetReactions_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *InteractionAPIGetReactionsResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *InteractionAPIGetReactionsResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("InteractionAPIGetReactionsResult(%+v)", *p)
+
+}
+
+type InteractionAPIGetFlaggedCommentsArgs struct {
+	Req *interaction.GetFlaggedCommentsRequest `thrift:"req,1"`
+}
+
+func NewInteractionAPIGetFlaggedCommentsArgs() *InteractionAPIGetFlaggedCommentsArgs {
+	return &InteractionAPIGetFlaggedCommentsArgs{}
+}
+
+func (p *InteractionAPIGetFlaggedCommentsArgs) InitDefault() {
+}
+
+var InteractionAPIGetFlaggedCommentsArgs_Req_DEFAULT *interaction.GetFlaggedCommentsRequest
+
+func (p *InteractionAPIGetFlaggedCommentsArgs) GetReq() (v *interaction.GetFlaggedCommentsRequest) {
+	if !p.IsSetReq() {
+		return InteractionAPIGetFlaggedCommentsArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+var fieldIDToName_InteractionAPIGetFlaggedCommentsArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *InteractionAPIGetFlaggedCommentsArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *InteractionAPIGetFlaggedCommentsArgs) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_InteractionAPIGetFlaggedCommentsArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *InteractionAPIGetFlaggedCommentsArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := interaction.NewGetFlaggedCommentsRequest()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Req = _field
+	return nil
+}
+
+func (p *InteractionAPIGetFlaggedCommentsArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("GetFlaggedComments_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *InteractionAPIGetFlaggedCommentsArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *InteractionAPIGetFlaggedCommentsArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("InteractionAPIGetFlaggedCommentsArgs(%+v)", *p)
+
+}
+
+type InteractionAPIGetFlaggedCommentsResult struct {
+	Success *interaction.GetFlaggedCommentsResponse `thrift:"success,0,optional"`
+}
+
+func NewInteractionAPIGetFlaggedCommentsResult() *InteractionAPIGetFlaggedCommentsResult {
+	return &InteractionAPIGetFlaggedCommentsResult{}
+}
+
+func (p *InteractionAPIGetFlaggedCommentsResult) InitDefault() {
+}
+
+var InteractionAPIGetFlaggedCommentsResult_Success_DEFAULT *interaction.GetFlaggedCommentsResponse
+
+func (p *InteractionAPIGetFlaggedCommentsResult) GetSuccess() (v *interaction.GetFlaggedCommentsResponse) {
+	if !p.IsSetSuccess() {
+		return InteractionAPIGetFlaggedCommentsResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var fieldIDToName_InteractionAPIGetFlaggedCommentsResult = map[int16]string{
+	0: "success",
+}
+
+func (p *InteractionAPIGetFlaggedCommentsResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *InteractionAPIGetFlaggedCommentsResult) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_InteractionAPIGetFlaggedCommentsResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *InteractionAPIGetFlaggedCommentsResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := interaction.NewGetFlaggedCommentsResponse()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *InteractionAPIGetFlaggedCommentsResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("GetFlaggedComments_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *InteractionAPIGetFlaggedCommentsResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *InteractionAPIGetFlaggedCommentsResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("InteractionAPIGetFlaggedCommentsResult(%+v)", *p)
+
+}
+
+type InteractionAPIReviewCommentArgs struct {
+	Req *interaction.ReviewCommentRequest `thrift:"req,1"`
+}
+
+func NewInteractionAPIReviewCommentArgs() *InteractionAPIReviewCommentArgs {
+	return &InteractionAPIReviewCommentArgs{}
+}
+
+func (p *InteractionAPIReviewCommentArgs) InitDefault() {
+}
+
+var InteractionAPIReviewCommentArgs_Req_DEFAULT *interaction.ReviewCommentRequest
+
+func (p *InteractionAPIReviewCommentArgs) GetReq() (v *interaction.ReviewCommentRequest) {
+	if !p.IsSetReq() {
+		return InteractionAPIReviewCommentArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+var fieldIDToName_InteractionAPIReviewCommentArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *InteractionAPIReviewCommentArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *InteractionAPIReviewCommentArgs) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_InteractionAPIReviewCommentArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *InteractionAPIReviewCommentArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := interaction.NewReviewCommentRequest()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Req = _field
+	return nil
+}
+
+func (p *InteractionAPIReviewCommentArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ReviewComment_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *InteractionAPIReviewCommentArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *InteractionAPIReviewCommentArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("InteractionAPIReviewCommentArgs(%+v)", *p)
+
+}
+
+type InteractionAPIReviewCommentResult struct {
+	Success *interaction.ReviewCommentResponse `thrift:"success,0,optional"`
+}
+
+func NewInteractionAPIReviewCommentResult() *InteractionAPIReviewCommentResult {
+	return &InteractionAPIReviewCommentResult{}
+}
+
+func (p *InteractionAPIReviewCommentResult) InitDefault() {
+}
+
+var InteractionAPIReviewCommentResult_Success_DEFAULT *interaction.ReviewCommentResponse
+
+func (p *InteractionAPIReviewCommentResult) GetSuccess() (v *interaction.ReviewCommentResponse) {
+	if !p.IsSetSuccess() {
+		return InteractionAPIReviewCommentResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var fieldIDToName_InteractionAPIReviewCommentResult = map[int16]string{
+	0: "success",
+}
+
+func (p *InteractionAPIReviewCommentResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *InteractionAPIReviewCommentResult) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_InteractionAPIReviewCommentResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *InteractionAPIReviewCommentResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := interaction.NewReviewCommentResponse()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *InteractionAPIReviewCommentResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ReviewComment_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *InteractionAPIReviewCommentResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *InteractionAPIReviewCommentResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("InteractionAPIReviewCommentResult(%+v)", *p)
+
+}
+
+type InteractionAPICreateCollectionArgs struct {
+	Req *interaction.CreateCollectionRequest `thrift:"req,1"`
+}
+
+func NewInteractionAPICreateCollectionArgs() *InteractionAPICreateCollectionArgs {
+	return &InteractionAPICreateCollectionArgs{}
+}
+
+func (p *InteractionAPICreateCollectionArgs) InitDefault() {
+}
+
+var InteractionAPICreateCollectionArgs_Req_DEFAULT *interaction.CreateCollectionRequest
+
+func (p *InteractionAPICreateCollectionArgs) GetReq() (v *interaction.CreateCollectionRequest) {
+	if !p.IsSetReq() {
+		return InteractionAPICreateCollectionArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+var fieldIDToName_InteractionAPICreateCollectionArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *InteractionAPICreateCollectionArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *InteractionAPICreateCollectionArgs) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_InteractionAPICreateCollectionArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *InteractionAPICreateCollectionArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := interaction.NewCreateCollectionRequest()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Req = _field
+	return nil
+}
+
+func (p *InteractionAPICreateCollectionArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("CreateCollection_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *InteractionAPICreateCollectionArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *InteractionAPICreateCollectionArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("InteractionAPICreateCollectionArgs(%+v)", *p)
+
+}
+
+type InteractionAPICreateCollectionResult struct {
+	Success *interaction.CreateCollectionResponse `thrift:"success,0,optional"`
+}
+
+func NewInteractionAPICreateCollectionResult() *InteractionAPICreateCollectionResult {
+	return &InteractionAPICreateCollectionResult{}
+}
+
+func (p *InteractionAPICreateCollectionResult) InitDefault() {
+}
+
+var InteractionAPICreateCollectionResult_Success_DEFAULT *interaction.CreateCollectionResponse
+
+func (p *InteractionAPICreateCollectionResult) GetSuccess() (v *interaction.CreateCollectionResponse) {
+	if !p.IsSetSuccess() {
+		return InteractionAPICreateCollectionResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var fieldIDToName_InteractionAPICreateCollectionResult = map[int16]string{
+	0: "success",
+}
+
+func (p *InteractionAPICreateCollectionResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *InteractionAPICreateCollectionResult) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_InteractionAPICreateCollectionResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *InteractionAPICreateCollectionResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := interaction.NewCreateCollectionResponse()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *InteractionAPICreateCollectionResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("CreateCollection_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *InteractionAPICreateCollectionResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *InteractionAPICreateCollectionResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("InteractionAPICreateCollectionResult(%+v)", *p)
+
+}
+
+type InteractionAPIUpdateCollectionArgs struct {
+	Req *interaction.UpdateCollectionRequest `thrift:"req,1"`
+}
+
+func NewInteractionAPIUpdateCollectionArgs() *InteractionAPIUpdateCollectionArgs {
+	return &InteractionAPIUpdateCollectionArgs{}
+}
+
+func (p *InteractionAPIUpdateCollectionArgs) InitDefault() {
+}
+
+var InteractionAPIUpdateCollectionArgs_Req_DEFAULT *interaction.UpdateCollectionRequest
+
+func (p *InteractionAPIUpdateCollectionArgs) GetReq() (v *interaction.UpdateCollectionRequest) {
+	if !p.IsSetReq() {
+		return InteractionAPIUpdateCollectionArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+var fieldIDToName_InteractionAPIUpdateCollectionArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *InteractionAPIUpdateCollectionArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *InteractionAPIUpdateCollectionArgs) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_InteractionAPIUpdateCollectionArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *InteractionAPIUpdateCollectionArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := interaction.NewUpdateCollectionRequest()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Req = _field
+	return nil
+}
+
+func (p *InteractionAPIUpdateCollectionArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("UpdateCollection_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *InteractionAPIUpdateCollectionArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *InteractionAPIUpdateCollectionArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("InteractionAPIUpdateCollectionArgs(%+v)", *p)
+
+}
+
+type InteractionAPIUpdateCollectionResult struct {
+	Success *interaction.UpdateCollectionResponse `thrift:"success,0,optional"`
+}
+
+func NewInteractionAPIUpdateCollectionResult() *InteractionAPIUpdateCollectionResult {
+	return &InteractionAPIUpdateCollectionResult{}
+}
+
+func (p *InteractionAPIUpdateCollectionResult) InitDefault() {
+}
+
+var InteractionAPIUpdateCollectionResult_Success_DEFAULT *interaction.UpdateCollectionResponse
+
+func (p *InteractionAPIUpdateCollectionResult) GetSuccess() (v *interaction.UpdateCollectionResponse) {
+	if !p.IsSetSuccess() {
+		return InteractionAPIUpdateCollectionResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var fieldIDToName_InteractionAPIUpdateCollectionResult = map[int16]string{
+	0: "success",
+}
+
+func (p *InteractionAPIUpdateCollectionResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *InteractionAPIUpdateCollectionResult) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_InteractionAPIUpdateCollectionResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *InteractionAPIUpdateCollectionResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := interaction.NewUpdateCollectionResponse()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *InteractionAPIUpdateCollectionResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("UpdateCollection_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *InteractionAPIUpdateCollectionResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *InteractionAPIUpdateCollectionResult) String() string {
+	if p == nil {
+		return "<nil>"
 	}
-	return true, err
+	return fmt.Sprintf("InteractionAPIUpdateCollectionResult(%+v)", *p)
+
 }
 
-type InteractionAPILikeArgs struct {
-	Req *interaction.LikeRequest `thrift:"req,1"`
+type InteractionAPIDeleteCollectionArgs struct {
+	Req *interaction.DeleteCollectionRequest `thrift:"req,1"`
 }
 
-func NewInteractionAPILikeArgs() *InteractionAPILikeArgs {
-	return &InteractionAPILikeArgs{}
+func NewInteractionAPIDeleteCollectionArgs() *InteractionAPIDeleteCollectionArgs {
+	return &InteractionAPIDeleteCollectionArgs{}
 }
 
-func (p *InteractionAPILikeArgs) InitDefault() {
+func (p *InteractionAPIDeleteCollectionArgs) InitDefault() {
 }
 
-var InteractionAPILikeArgs_Req_DEFAULT *interaction.LikeRequest
+var InteractionAPIDeleteCollectionArgs_Req_DEFAULT *interaction.DeleteCollectionRequest
 
-func (p *InteractionAPILikeArgs) GetReq() (v *interaction.LikeRequest) {
+func (p *InteractionAPIDeleteCollectionArgs) GetReq() (v *interaction.DeleteCollectionRequest) {
 	if !p.IsSetReq() {
-		return InteractionAPILikeArgs_Req_DEFAULT
+		return InteractionAPIDeleteCollectionArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-var fieldIDToName_InteractionAPILikeArgs = map[int16]string{
+var fieldIDToName_InteractionAPIDeleteCollectionArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *InteractionAPILikeArgs) IsSetReq() bool {
+func (p *InteractionAPIDeleteCollectionArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *InteractionAPILikeArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *InteractionAPIDeleteCollectionArgs) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -514,7 +6146,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_InteractionAPILikeArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_InteractionAPIDeleteCollectionArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -524,8 +6156,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *InteractionAPILikeArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := interaction.NewLikeRequest()
+func (p *InteractionAPIDeleteCollectionArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := interaction.NewDeleteCollectionRequest()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -533,9 +6165,9 @@ func (p *InteractionAPILikeArgs) ReadField1(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *InteractionAPILikeArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *InteractionAPIDeleteCollectionArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("Like_args"); err != nil {
+	if err = oprot.WriteStructBegin("DeleteCollection_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -561,7 +6193,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *InteractionAPILikeArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *InteractionAPIDeleteCollectionArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -578,43 +6210,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *InteractionAPILikeArgs) String() string {
+func (p *InteractionAPIDeleteCollectionArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("InteractionAPILikeArgs(%+v)", *p)
+	return fmt.Sprintf("InteractionAPIDeleteCollectionArgs(%+v)", *p)
 
 }
 
-type InteractionAPILikeResult struct {
-	Success *interaction.LikeResponse `thrift:"success,0,optional"`
+type InteractionAPIDeleteCollectionResult struct {
+	Success *interaction.DeleteCollectionResponse `thrift:"success,0,optional"`
 }
 
-func NewInteractionAPILikeResult() *InteractionAPILikeResult {
-	return &InteractionAPILikeResult{}
+func NewInteractionAPIDeleteCollectionResult() *InteractionAPIDeleteCollectionResult {
+	return &InteractionAPIDeleteCollectionResult{}
 }
 
-func (p *InteractionAPILikeResult) InitDefault() {
+func (p *InteractionAPIDeleteCollectionResult) InitDefault() {
 }
 
-var InteractionAPILikeResult_Success_DEFAULT *interaction.LikeResponse
+var InteractionAPIDeleteCollectionResult_Success_DEFAULT *interaction.DeleteCollectionResponse
 
-func (p *InteractionAPILikeResult) GetSuccess() (v *interaction.LikeResponse) {
+func (p *InteractionAPIDeleteCollectionResult) GetSuccess() (v *interaction.DeleteCollectionResponse) {
 	if !p.IsSetSuccess() {
-		return InteractionAPILikeResult_Success_DEFAULT
+		return InteractionAPIDeleteCollectionResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_InteractionAPILikeResult = map[int16]string{
+var fieldIDToName_InteractionAPIDeleteCollectionResult = map[int16]string{
 	0: "success",
 }
 
-func (p *InteractionAPILikeResult) IsSetSuccess() bool {
+func (p *InteractionAPIDeleteCollectionResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *InteractionAPILikeResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *InteractionAPIDeleteCollectionResult) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -659,7 +6291,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_InteractionAPILikeResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_InteractionAPIDeleteCollectionResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -669,8 +6301,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *InteractionAPILikeResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := interaction.NewLikeResponse()
+func (p *InteractionAPIDeleteCollectionResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := interaction.NewDeleteCollectionResponse()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -678,9 +6310,9 @@ func (p *InteractionAPILikeResult) ReadField0(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *InteractionAPILikeResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *InteractionAPIDeleteCollectionResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("Like_result"); err != nil {
+	if err = oprot.WriteStructBegin("DeleteCollection_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -706,7 +6338,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *InteractionAPILikeResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *InteractionAPIDeleteCollectionResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -725,43 +6357,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *InteractionAPILikeResult) String() string {
+func (p *InteractionAPIDeleteCollectionResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("InteractionAPILikeResult(%+v)", *p)
+	return fmt.Sprintf("InteractionAPIDeleteCollectionResult(%+v)", *p)
 
 }
 
-type InteractionAPIGetLikesArgs struct {
-	Req *interaction.GetLikesRequest `thrift:"req,1"`
+type InteractionAPIReorderCollectionsArgs struct {
+	Req *interaction.ReorderCollectionsRequest `thrift:"req,1"`
 }
 
-func NewInteractionAPIGetLikesArgs() *InteractionAPIGetLikesArgs {
-	return &InteractionAPIGetLikesArgs{}
+func NewInteractionAPIReorderCollectionsArgs() *InteractionAPIReorderCollectionsArgs {
+	return &InteractionAPIReorderCollectionsArgs{}
 }
 
-func (p *InteractionAPIGetLikesArgs) InitDefault() {
+func (p *InteractionAPIReorderCollectionsArgs) InitDefault() {
 }
 
-var InteractionAPIGetLikesArgs_Req_DEFAULT *interaction.GetLikesRequest
+var InteractionAPIReorderCollectionsArgs_Req_DEFAULT *interaction.ReorderCollectionsRequest
 
-func (p *InteractionAPIGetLikesArgs) GetReq() (v *interaction.GetLikesRequest) {
+func (p *InteractionAPIReorderCollectionsArgs) GetReq() (v *interaction.ReorderCollectionsRequest) {
 	if !p.IsSetReq() {
-		return InteractionAPIGetLikesArgs_Req_DEFAULT
+		return InteractionAPIReorderCollectionsArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-var fieldIDToName_InteractionAPIGetLikesArgs = map[int16]string{
+var fieldIDToName_InteractionAPIReorderCollectionsArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *InteractionAPIGetLikesArgs) IsSetReq() bool {
+func (p *InteractionAPIReorderCollectionsArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *InteractionAPIGetLikesArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *InteractionAPIReorderCollectionsArgs) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -806,7 +6438,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_InteractionAPIGetLikesArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_InteractionAPIReorderCollectionsArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -816,8 +6448,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *InteractionAPIGetLikesArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := interaction.NewGetLikesRequest()
+func (p *InteractionAPIReorderCollectionsArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := interaction.NewReorderCollectionsRequest()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -825,9 +6457,9 @@ func (p *InteractionAPIGetLikesArgs) ReadField1(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *InteractionAPIGetLikesArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *InteractionAPIReorderCollectionsArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("GetLikes_args"); err != nil {
+	if err = oprot.WriteStructBegin("ReorderCollections_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -853,7 +6485,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *InteractionAPIGetLikesArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *InteractionAPIReorderCollectionsArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -870,43 +6502,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *InteractionAPIGetLikesArgs) String() string {
+func (p *InteractionAPIReorderCollectionsArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("InteractionAPIGetLikesArgs(%+v)", *p)
+	return fmt.Sprintf("InteractionAPIReorderCollectionsArgs(%+v)", *p)
 
 }
 
-type InteractionAPIGetLikesResult struct {
-	Success *interaction.GetLikesResponse `thrift:"success,0,optional"`
+type InteractionAPIReorderCollectionsResult struct {
+	Success *interaction.ReorderCollectionsResponse `thrift:"success,0,optional"`
 }
 
-func NewInteractionAPIGetLikesResult() *InteractionAPIGetLikesResult {
-	return &InteractionAPIGetLikesResult{}
+func NewInteractionAPIReorderCollectionsResult() *InteractionAPIReorderCollectionsResult {
+	return &InteractionAPIReorderCollectionsResult{}
 }
 
-func (p *InteractionAPIGetLikesResult) InitDefault() {
+func (p *InteractionAPIReorderCollectionsResult) InitDefault() {
 }
 
-var InteractionAPIGetLikesResult_Success_DEFAULT *interaction.GetLikesResponse
+var InteractionAPIReorderCollectionsResult_Success_DEFAULT *interaction.ReorderCollectionsResponse
 
-func (p *InteractionAPIGetLikesResult) GetSuccess() (v *interaction.GetLikesResponse) {
+func (p *InteractionAPIReorderCollectionsResult) GetSuccess() (v *interaction.ReorderCollectionsResponse) {
 	if !p.IsSetSuccess() {
-		return InteractionAPIGetLikesResult_Success_DEFAULT
+		return InteractionAPIReorderCollectionsResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_InteractionAPIGetLikesResult = map[int16]string{
+var fieldIDToName_InteractionAPIReorderCollectionsResult = map[int16]string{
 	0: "success",
 }
 
-func (p *InteractionAPIGetLikesResult) IsSetSuccess() bool {
+func (p *InteractionAPIReorderCollectionsResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *InteractionAPIGetLikesResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *InteractionAPIReorderCollectionsResult) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -951,7 +6583,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_InteractionAPIGetLikesResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_InteractionAPIReorderCollectionsResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -961,8 +6593,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *InteractionAPIGetLikesResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := interaction.NewGetLikesResponse()
+func (p *InteractionAPIReorderCollectionsResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := interaction.NewReorderCollectionsResponse()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -970,9 +6602,9 @@ func (p *InteractionAPIGetLikesResult) ReadField0(iprot thrift.TProtocol) error
 	return nil
 }
 
-func (p *InteractionAPIGetLikesResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *InteractionAPIReorderCollectionsResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("GetLikes_result"); err != nil {
+	if err = oprot.WriteStructBegin("ReorderCollections_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -998,7 +6630,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *InteractionAPIGetLikesResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *InteractionAPIReorderCollectionsResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -1017,43 +6649,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *InteractionAPIGetLikesResult) String() string {
+func (p *InteractionAPIReorderCollectionsResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("InteractionAPIGetLikesResult(%+v)", *p)
+	return fmt.Sprintf("InteractionAPIReorderCollectionsResult(%+v)", *p)
 
 }
 
-type InteractionAPICommentArgs struct {
-	Req *interaction.CommentRequest `thrift:"req,1"`
+type InteractionAPIGetCollectionsArgs struct {
+	Req *interaction.GetCollectionsRequest `thrift:"req,1"`
 }
 
-func NewInteractionAPICommentArgs() *InteractionAPICommentArgs {
-	return &InteractionAPICommentArgs{}
+func NewInteractionAPIGetCollectionsArgs() *InteractionAPIGetCollectionsArgs {
+	return &InteractionAPIGetCollectionsArgs{}
 }
 
-func (p *InteractionAPICommentArgs) InitDefault() {
+func (p *InteractionAPIGetCollectionsArgs) InitDefault() {
 }
 
-var InteractionAPICommentArgs_Req_DEFAULT *interaction.CommentRequest
+var InteractionAPIGetCollectionsArgs_Req_DEFAULT *interaction.GetCollectionsRequest
 
-func (p *InteractionAPICommentArgs) GetReq() (v *interaction.CommentRequest) {
+func (p *InteractionAPIGetCollectionsArgs) GetReq() (v *interaction.GetCollectionsRequest) {
 	if !p.IsSetReq() {
-		return InteractionAPICommentArgs_Req_DEFAULT
+		return InteractionAPIGetCollectionsArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-var fieldIDToName_InteractionAPICommentArgs = map[int16]string{
+var fieldIDToName_InteractionAPIGetCollectionsArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *InteractionAPICommentArgs) IsSetReq() bool {
+func (p *InteractionAPIGetCollectionsArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *InteractionAPICommentArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *InteractionAPIGetCollectionsArgs) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -1098,7 +6730,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_InteractionAPICommentArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_InteractionAPIGetCollectionsArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -1108,8 +6740,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *InteractionAPICommentArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := interaction.NewCommentRequest()
+func (p *InteractionAPIGetCollectionsArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := interaction.NewGetCollectionsRequest()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -1117,9 +6749,9 @@ func (p *InteractionAPICommentArgs) ReadField1(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *InteractionAPICommentArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *InteractionAPIGetCollectionsArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("Comment_args"); err != nil {
+	if err = oprot.WriteStructBegin("GetCollections_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -1145,7 +6777,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *InteractionAPICommentArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *InteractionAPIGetCollectionsArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -1162,43 +6794,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *InteractionAPICommentArgs) String() string {
+func (p *InteractionAPIGetCollectionsArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("InteractionAPICommentArgs(%+v)", *p)
+	return fmt.Sprintf("InteractionAPIGetCollectionsArgs(%+v)", *p)
 
 }
 
-type InteractionAPICommentResult struct {
-	Success *interaction.CommentResponse `thrift:"success,0,optional"`
+type InteractionAPIGetCollectionsResult struct {
+	Success *interaction.GetCollectionsResponse `thrift:"success,0,optional"`
 }
 
-func NewInteractionAPICommentResult() *InteractionAPICommentResult {
-	return &InteractionAPICommentResult{}
+func NewInteractionAPIGetCollectionsResult() *InteractionAPIGetCollectionsResult {
+	return &InteractionAPIGetCollectionsResult{}
 }
 
-func (p *InteractionAPICommentResult) InitDefault() {
+func (p *InteractionAPIGetCollectionsResult) InitDefault() {
 }
 
-var InteractionAPICommentResult_Success_DEFAULT *interaction.CommentResponse
+var InteractionAPIGetCollectionsResult_Success_DEFAULT *interaction.GetCollectionsResponse
 
-func (p *InteractionAPICommentResult) GetSuccess() (v *interaction.CommentResponse) {
+func (p *InteractionAPIGetCollectionsResult) GetSuccess() (v *interaction.GetCollectionsResponse) {
 	if !p.IsSetSuccess() {
-		return InteractionAPICommentResult_Success_DEFAULT
+		return InteractionAPIGetCollectionsResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_InteractionAPICommentResult = map[int16]string{
+var fieldIDToName_InteractionAPIGetCollectionsResult = map[int16]string{
 	0: "success",
 }
 
-func (p *InteractionAPICommentResult) IsSetSuccess() bool {
+func (p *InteractionAPIGetCollectionsResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *InteractionAPICommentResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *InteractionAPIGetCollectionsResult) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -1243,7 +6875,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_InteractionAPICommentResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_InteractionAPIGetCollectionsResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -1253,8 +6885,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *InteractionAPICommentResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := interaction.NewCommentResponse()
+func (p *InteractionAPIGetCollectionsResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := interaction.NewGetCollectionsResponse()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -1262,9 +6894,9 @@ func (p *InteractionAPICommentResult) ReadField0(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *InteractionAPICommentResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *InteractionAPIGetCollectionsResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("Comment_result"); err != nil {
+	if err = oprot.WriteStructBegin("GetCollections_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -1290,7 +6922,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *InteractionAPICommentResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *InteractionAPIGetCollectionsResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -1309,43 +6941,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *InteractionAPICommentResult) String() string {
+func (p *InteractionAPIGetCollectionsResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("InteractionAPICommentResult(%+v)", *p)
+	return fmt.Sprintf("InteractionAPIGetCollectionsResult(%+v)", *p)
 
 }
 
-type InteractionAPIGetCommentsArgs struct {
-	Req *interaction.GetCommentsRequest `thrift:"req,1"`
+type InteractionAPIAddToCollectionArgs struct {
+	Req *interaction.AddToCollectionRequest `thrift:"req,1"`
 }
 
-func NewInteractionAPIGetCommentsArgs() *InteractionAPIGetCommentsArgs {
-	return &InteractionAPIGetCommentsArgs{}
+func NewInteractionAPIAddToCollectionArgs() *InteractionAPIAddToCollectionArgs {
+	return &InteractionAPIAddToCollectionArgs{}
 }
 
-func (p *InteractionAPIGetCommentsArgs) InitDefault() {
+func (p *InteractionAPIAddToCollectionArgs) InitDefault() {
 }
 
-var InteractionAPIGetCommentsArgs_Req_DEFAULT *interaction.GetCommentsRequest
+var InteractionAPIAddToCollectionArgs_Req_DEFAULT *interaction.AddToCollectionRequest
 
-func (p *InteractionAPIGetCommentsArgs) GetReq() (v *interaction.GetCommentsRequest) {
+func (p *InteractionAPIAddToCollectionArgs) GetReq() (v *interaction.AddToCollectionRequest) {
 	if !p.IsSetReq() {
-		return InteractionAPIGetCommentsArgs_Req_DEFAULT
+		return InteractionAPIAddToCollectionArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-var fieldIDToName_InteractionAPIGetCommentsArgs = map[int16]string{
+var fieldIDToName_InteractionAPIAddToCollectionArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *InteractionAPIGetCommentsArgs) IsSetReq() bool {
+func (p *InteractionAPIAddToCollectionArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *InteractionAPIGetCommentsArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *InteractionAPIAddToCollectionArgs) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -1390,7 +7022,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_InteractionAPIGetCommentsArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_InteractionAPIAddToCollectionArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -1400,8 +7032,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *InteractionAPIGetCommentsArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := interaction.NewGetCommentsRequest()
+func (p *InteractionAPIAddToCollectionArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := interaction.NewAddToCollectionRequest()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -1409,9 +7041,9 @@ func (p *InteractionAPIGetCommentsArgs) ReadField1(iprot thrift.TProtocol) error
 	return nil
 }
 
-func (p *InteractionAPIGetCommentsArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *InteractionAPIAddToCollectionArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("GetComments_args"); err != nil {
+	if err = oprot.WriteStructBegin("AddToCollection_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -1437,7 +7069,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *InteractionAPIGetCommentsArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *InteractionAPIAddToCollectionArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -1454,43 +7086,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *InteractionAPIGetCommentsArgs) String() string {
+func (p *InteractionAPIAddToCollectionArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("InteractionAPIGetCommentsArgs(%+v)", *p)
+	return fmt.Sprintf("InteractionAPIAddToCollectionArgs(%+v)", *p)
 
 }
 
-type InteractionAPIGetCommentsResult struct {
-	Success *interaction.CommentListResponse `thrift:"success,0,optional"`
+type InteractionAPIAddToCollectionResult struct {
+	Success *interaction.AddToCollectionResponse `thrift:"success,0,optional"`
 }
 
-func NewInteractionAPIGetCommentsResult() *InteractionAPIGetCommentsResult {
-	return &InteractionAPIGetCommentsResult{}
+func NewInteractionAPIAddToCollectionResult() *InteractionAPIAddToCollectionResult {
+	return &InteractionAPIAddToCollectionResult{}
 }
 
-func (p *InteractionAPIGetCommentsResult) InitDefault() {
+func (p *InteractionAPIAddToCollectionResult) InitDefault() {
 }
 
-var InteractionAPIGetCommentsResult_Success_DEFAULT *interaction.CommentListResponse
+var InteractionAPIAddToCollectionResult_Success_DEFAULT *interaction.AddToCollectionResponse
 
-func (p *InteractionAPIGetCommentsResult) GetSuccess() (v *interaction.CommentListResponse) {
+func (p *InteractionAPIAddToCollectionResult) GetSuccess() (v *interaction.AddToCollectionResponse) {
 	if !p.IsSetSuccess() {
-		return InteractionAPIGetCommentsResult_Success_DEFAULT
+		return InteractionAPIAddToCollectionResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_InteractionAPIGetCommentsResult = map[int16]string{
+var fieldIDToName_InteractionAPIAddToCollectionResult = map[int16]string{
 	0: "success",
 }
 
-func (p *InteractionAPIGetCommentsResult) IsSetSuccess() bool {
+func (p *InteractionAPIAddToCollectionResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *InteractionAPIGetCommentsResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *InteractionAPIAddToCollectionResult) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -1535,7 +7167,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_InteractionAPIGetCommentsResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_InteractionAPIAddToCollectionResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -1545,8 +7177,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *InteractionAPIGetCommentsResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := interaction.NewCommentListResponse()
+func (p *InteractionAPIAddToCollectionResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := interaction.NewAddToCollectionResponse()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -1554,9 +7186,9 @@ func (p *InteractionAPIGetCommentsResult) ReadField0(iprot thrift.TProtocol) err
 	return nil
 }
 
-func (p *InteractionAPIGetCommentsResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *InteractionAPIAddToCollectionResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("GetComments_result"); err != nil {
+	if err = oprot.WriteStructBegin("AddToCollection_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -1582,7 +7214,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *InteractionAPIGetCommentsResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *InteractionAPIAddToCollectionResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -1601,43 +7233,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *InteractionAPIGetCommentsResult) String() string {
+func (p *InteractionAPIAddToCollectionResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("InteractionAPIGetCommentsResult(%+v)", *p)
+	return fmt.Sprintf("InteractionAPIAddToCollectionResult(%+v)", *p)
 
 }
 
-type InteractionAPIDeleteCommentArgs struct {
-	Req *interaction.DeleteCommentRequest `thrift:"req,1"`
+type InteractionAPIRemoveFromCollectionArgs struct {
+	Req *interaction.RemoveFromCollectionRequest `thrift:"req,1"`
 }
 
-func NewInteractionAPIDeleteCommentArgs() *InteractionAPIDeleteCommentArgs {
-	return &InteractionAPIDeleteCommentArgs{}
+func NewInteractionAPIRemoveFromCollectionArgs() *InteractionAPIRemoveFromCollectionArgs {
+	return &InteractionAPIRemoveFromCollectionArgs{}
 }
 
-func (p *InteractionAPIDeleteCommentArgs) InitDefault() {
+func (p *InteractionAPIRemoveFromCollectionArgs) InitDefault() {
 }
 
-var InteractionAPIDeleteCommentArgs_Req_DEFAULT *interaction.DeleteCommentRequest
+var InteractionAPIRemoveFromCollectionArgs_Req_DEFAULT *interaction.RemoveFromCollectionRequest
 
-func (p *InteractionAPIDeleteCommentArgs) GetReq() (v *interaction.DeleteCommentRequest) {
+func (p *InteractionAPIRemoveFromCollectionArgs) GetReq() (v *interaction.RemoveFromCollectionRequest) {
 	if !p.IsSetReq() {
-		return InteractionAPIDeleteCommentArgs_Req_DEFAULT
+		return InteractionAPIRemoveFromCollectionArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-var fieldIDToName_InteractionAPIDeleteCommentArgs = map[int16]string{
+var fieldIDToName_InteractionAPIRemoveFromCollectionArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *InteractionAPIDeleteCommentArgs) IsSetReq() bool {
+func (p *InteractionAPIRemoveFromCollectionArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *InteractionAPIDeleteCommentArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *InteractionAPIRemoveFromCollectionArgs) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -1682,7 +7314,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_InteractionAPIDeleteCommentArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_InteractionAPIRemoveFromCollectionArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -1692,8 +7324,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *InteractionAPIDeleteCommentArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := interaction.NewDeleteCommentRequest()
+func (p *InteractionAPIRemoveFromCollectionArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := interaction.NewRemoveFromCollectionRequest()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -1701,9 +7333,9 @@ func (p *InteractionAPIDeleteCommentArgs) ReadField1(iprot thrift.TProtocol) err
 	return nil
 }
 
-func (p *InteractionAPIDeleteCommentArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *InteractionAPIRemoveFromCollectionArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("DeleteComment_args"); err != nil {
+	if err = oprot.WriteStructBegin("RemoveFromCollection_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -1729,7 +7361,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *InteractionAPIDeleteCommentArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *InteractionAPIRemoveFromCollectionArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -1746,43 +7378,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *InteractionAPIDeleteCommentArgs) String() string {
+func (p *InteractionAPIRemoveFromCollectionArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("InteractionAPIDeleteCommentArgs(%+v)", *p)
+	return fmt.Sprintf("InteractionAPIRemoveFromCollectionArgs(%+v)", *p)
 
 }
 
-type InteractionAPIDeleteCommentResult struct {
-	Success *interaction.DeleteCommentResponse `thrift:"success,0,optional"`
+type InteractionAPIRemoveFromCollectionResult struct {
+	Success *interaction.RemoveFromCollectionResponse `thrift:"success,0,optional"`
 }
 
-func NewInteractionAPIDeleteCommentResult() *InteractionAPIDeleteCommentResult {
-	return &InteractionAPIDeleteCommentResult{}
+func NewInteractionAPIRemoveFromCollectionResult() *InteractionAPIRemoveFromCollectionResult {
+	return &InteractionAPIRemoveFromCollectionResult{}
 }
 
-func (p *InteractionAPIDeleteCommentResult) InitDefault() {
+func (p *InteractionAPIRemoveFromCollectionResult) InitDefault() {
 }
 
-var InteractionAPIDeleteCommentResult_Success_DEFAULT *interaction.DeleteCommentResponse
+var InteractionAPIRemoveFromCollectionResult_Success_DEFAULT *interaction.RemoveFromCollectionResponse
 
-func (p *InteractionAPIDeleteCommentResult) GetSuccess() (v *interaction.DeleteCommentResponse) {
+func (p *InteractionAPIRemoveFromCollectionResult) GetSuccess() (v *interaction.RemoveFromCollectionResponse) {
 	if !p.IsSetSuccess() {
-		return InteractionAPIDeleteCommentResult_Success_DEFAULT
+		return InteractionAPIRemoveFromCollectionResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_InteractionAPIDeleteCommentResult = map[int16]string{
+var fieldIDToName_InteractionAPIRemoveFromCollectionResult = map[int16]string{
 	0: "success",
 }
 
-func (p *InteractionAPIDeleteCommentResult) IsSetSuccess() bool {
+func (p *InteractionAPIRemoveFromCollectionResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *InteractionAPIDeleteCommentResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *InteractionAPIRemoveFromCollectionResult) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -1827,7 +7459,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_InteractionAPIDeleteCommentResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_InteractionAPIRemoveFromCollectionResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -1837,8 +7469,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *InteractionAPIDeleteCommentResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := interaction.NewDeleteCommentResponse()
+func (p *InteractionAPIRemoveFromCollectionResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := interaction.NewRemoveFromCollectionResponse()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -1846,9 +7478,9 @@ func (p *InteractionAPIDeleteCommentResult) ReadField0(iprot thrift.TProtocol) e
 	return nil
 }
 
-func (p *InteractionAPIDeleteCommentResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *InteractionAPIRemoveFromCollectionResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("DeleteComment_result"); err != nil {
+	if err = oprot.WriteStructBegin("RemoveFromCollection_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -1874,7 +7506,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *InteractionAPIDeleteCommentResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *InteractionAPIRemoveFromCollectionResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -1893,43 +7525,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *InteractionAPIDeleteCommentResult) String() string {
+func (p *InteractionAPIRemoveFromCollectionResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("InteractionAPIDeleteCommentResult(%+v)", *p)
+	return fmt.Sprintf("InteractionAPIRemoveFromCollectionResult(%+v)", *p)
 
 }
 
-type InteractionAPILikeCommentArgs struct {
-	Req *interaction.LikeCommentRequest `thrift:"req,1"`
+type InteractionAPIGetCollectionVideosArgs struct {
+	Req *interaction.GetCollectionVideosRequest `thrift:"req,1"`
 }
 
-func NewInteractionAPILikeCommentArgs() *InteractionAPILikeCommentArgs {
-	return &InteractionAPILikeCommentArgs{}
+func NewInteractionAPIGetCollectionVideosArgs() *InteractionAPIGetCollectionVideosArgs {
+	return &InteractionAPIGetCollectionVideosArgs{}
 }
 
-func (p *InteractionAPILikeCommentArgs) InitDefault() {
+func (p *InteractionAPIGetCollectionVideosArgs) InitDefault() {
 }
 
-var InteractionAPILikeCommentArgs_Req_DEFAULT *interaction.LikeCommentRequest
+var InteractionAPIGetCollectionVideosArgs_Req_DEFAULT *interaction.GetCollectionVideosRequest
 
-func (p *InteractionAPILikeCommentArgs) GetReq() (v *interaction.LikeCommentRequest) {
+func (p *InteractionAPIGetCollectionVideosArgs) GetReq() (v *interaction.GetCollectionVideosRequest) {
 	if !p.IsSetReq() {
-		return InteractionAPILikeCommentArgs_Req_DEFAULT
+		return InteractionAPIGetCollectionVideosArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-var fieldIDToName_InteractionAPILikeCommentArgs = map[int16]string{
+var fieldIDToName_InteractionAPIGetCollectionVideosArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *InteractionAPILikeCommentArgs) IsSetReq() bool {
+func (p *InteractionAPIGetCollectionVideosArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *InteractionAPILikeCommentArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *InteractionAPIGetCollectionVideosArgs) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -1974,7 +7606,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_InteractionAPILikeCommentArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_InteractionAPIGetCollectionVideosArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -1984,8 +7616,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *InteractionAPILikeCommentArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := interaction.NewLikeCommentRequest()
+func (p *InteractionAPIGetCollectionVideosArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := interaction.NewGetCollectionVideosRequest()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -1993,9 +7625,9 @@ func (p *InteractionAPILikeCommentArgs) ReadField1(iprot thrift.TProtocol) error
 	return nil
 }
 
-func (p *InteractionAPILikeCommentArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *InteractionAPIGetCollectionVideosArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("LikeComment_args"); err != nil {
+	if err = oprot.WriteStructBegin("GetCollectionVideos_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -2021,7 +7653,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *InteractionAPILikeCommentArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *InteractionAPIGetCollectionVideosArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -2038,43 +7670,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *InteractionAPILikeCommentArgs) String() string {
+func (p *InteractionAPIGetCollectionVideosArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("InteractionAPILikeCommentArgs(%+v)", *p)
+	return fmt.Sprintf("InteractionAPIGetCollectionVideosArgs(%+v)", *p)
 
 }
 
-type InteractionAPILikeCommentResult struct {
-	Success *interaction.LikeCommentResponse `thrift:"success,0,optional"`
+type InteractionAPIGetCollectionVideosResult struct {
+	Success *interaction.GetCollectionVideosResponse `thrift:"success,0,optional"`
 }
 
-func NewInteractionAPILikeCommentResult() *InteractionAPILikeCommentResult {
-	return &InteractionAPILikeCommentResult{}
+func NewInteractionAPIGetCollectionVideosResult() *InteractionAPIGetCollectionVideosResult {
+	return &InteractionAPIGetCollectionVideosResult{}
 }
 
-func (p *InteractionAPILikeCommentResult) InitDefault() {
+func (p *InteractionAPIGetCollectionVideosResult) InitDefault() {
 }
 
-var InteractionAPILikeCommentResult_Success_DEFAULT *interaction.LikeCommentResponse
+var InteractionAPIGetCollectionVideosResult_Success_DEFAULT *interaction.GetCollectionVideosResponse
 
-func (p *InteractionAPILikeCommentResult) GetSuccess() (v *interaction.LikeCommentResponse) {
+func (p *InteractionAPIGetCollectionVideosResult) GetSuccess() (v *interaction.GetCollectionVideosResponse) {
 	if !p.IsSetSuccess() {
-		return InteractionAPILikeCommentResult_Success_DEFAULT
+		return InteractionAPIGetCollectionVideosResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_InteractionAPILikeCommentResult = map[int16]string{
+var fieldIDToName_InteractionAPIGetCollectionVideosResult = map[int16]string{
 	0: "success",
 }
 
-func (p *InteractionAPILikeCommentResult) IsSetSuccess() bool {
+func (p *InteractionAPIGetCollectionVideosResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *InteractionAPILikeCommentResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *InteractionAPIGetCollectionVideosResult) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -2119,7 +7751,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_InteractionAPILikeCommentResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_InteractionAPIGetCollectionVideosResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -2129,8 +7761,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *InteractionAPILikeCommentResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := interaction.NewLikeCommentResponse()
+func (p *InteractionAPIGetCollectionVideosResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := interaction.NewGetCollectionVideosResponse()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -2138,9 +7770,9 @@ func (p *InteractionAPILikeCommentResult) ReadField0(iprot thrift.TProtocol) err
 	return nil
 }
 
-func (p *InteractionAPILikeCommentResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *InteractionAPIGetCollectionVideosResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("LikeComment_result"); err != nil {
+	if err = oprot.WriteStructBegin("GetCollectionVideos_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -2166,7 +7798,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *InteractionAPILikeCommentResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *InteractionAPIGetCollectionVideosResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -2185,10 +7817,10 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *InteractionAPILikeCommentResult) String() string {
+func (p *InteractionAPIGetCollectionVideosResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("InteractionAPILikeCommentResult(%+v)", *p)
+	return fmt.Sprintf("InteractionAPIGetCollectionVideosResult(%+v)", *p)
 
 }
@@ -5,6 +5,7 @@ package social
 import (
 	"context"
 	"fmt"
+
 	"github.com/apache/thrift/lib/go/thrift"
 	"github.com/yxrxy/videoHub/app/gateway/model/social"
 )
@@ -15,6 +16,10 @@ type SocialAPI interface {
 	SendPrivateMessage(ctx context.Context, request *social.SendPrivateMessageRequest) (r *social.SendPrivateMessageResponse, err error)
 
 	GetPrivateMessages(ctx context.Context, request *social.GetPrivateMessagesRequest) (r *social.GetPrivateMessagesResponse, err error)
+
+	EditPrivateMessage(ctx context.Context, request *social.EditPrivateMessageRequest) (r *social.EditPrivateMessageResponse, err error)
+
+	RecallPrivateMessage(ctx context.Context, request *social.RecallPrivateMessageRequest) (r *social.RecallPrivateMessageResponse, err error)
 	// 聊天室相关接口
 	CreateChatRoom(ctx context.Context, request *social.CreateChatRoomRequest) (r *social.CreateChatRoomResponse, err error)
 
@@ -25,6 +30,34 @@ type SocialAPI interface {
 	SendChatMessage(ctx context.Context, request *social.SendChatMessageRequest) (r *social.SendChatMessageResponse, err error)
 
 	GetChatMessages(ctx context.Context, request *social.GetChatMessagesRequest) (r *social.GetChatMessagesResponse, err error)
+
+	UploadChatAttachment(ctx context.Context, request *social.UploadChatAttachmentRequest) (r *social.UploadChatAttachmentResponse, err error)
+
+	EditChatMessage(ctx context.Context, request *social.EditChatMessageRequest) (r *social.EditChatMessageResponse, err error)
+
+	RecallChatMessage(ctx context.Context, request *social.RecallChatMessageRequest) (r *social.RecallChatMessageResponse, err error)
+
+	ShareVideo(ctx context.Context, request *social.ShareVideoRequest) (r *social.SendChatMessageResponse, err error)
+	// 聊天室成员相关接口
+	AddChatRoomMembers(ctx context.Context, request *social.AddChatRoomMembersRequest) (r *social.AddChatRoomMembersResponse, err error)
+
+	RemoveChatRoomMember(ctx context.Context, request *social.RemoveChatRoomMemberRequest) (r *social.RemoveChatRoomMemberResponse, err error)
+
+	LeaveChatRoom(ctx context.Context, request *social.LeaveChatRoomRequest) (r *social.LeaveChatRoomResponse, err error)
+	// 群管理相关接口
+	SetChatRoomAdmin(ctx context.Context, request *social.SetChatRoomAdminRequest) (r *social.SetChatRoomAdminResponse, err error)
+
+	TransferChatRoom(ctx context.Context, request *social.TransferChatRoomRequest) (r *social.TransferChatRoomResponse, err error)
+
+	MuteChatRoomMember(ctx context.Context, request *social.MuteChatRoomMemberRequest) (r *social.MuteChatRoomMemberResponse, err error)
+
+	RenameChatRoom(ctx context.Context, request *social.RenameChatRoomRequest) (r *social.RenameChatRoomResponse, err error)
+
+	DisbandChatRoom(ctx context.Context, request *social.DisbandChatRoomRequest) (r *social.DisbandChatRoomResponse, err error)
+
+	CreateChatRoomInvite(ctx context.Context, request *social.CreateChatRoomInviteRequest) (r *social.CreateChatRoomInviteResponse, err error)
+
+	JoinChatRoomByInvite(ctx context.Context, request *social.JoinChatRoomByInviteRequest) (r *social.JoinChatRoomByInviteResponse, err error)
 	// 好友相关接口
 	AddFriend(ctx context.Context, request *social.AddFriendRequest) (r *social.AddFriendResponse, err error)
 
@@ -37,10 +70,38 @@ type SocialAPI interface {
 	GetFriendRequests(ctx context.Context, request *social.GetFriendRequestsRequest) (r *social.GetFriendRequestsResponse, err error)
 
 	HandleFriendRequest(ctx context.Context, request *social.HandleFriendRequestRequest) (r *social.HandleFriendRequestResponse, err error)
+
+	GetFriendRecommendations(ctx context.Context, request *social.GetFriendRecommendationsRequest) (r *social.GetFriendRecommendationsResponse, err error)
 	// 消息状态相关接口
 	MarkMessageRead(ctx context.Context, request *social.MarkMessageReadRequest) (r *social.MarkMessageReadResponse, err error)
 
 	GetUnreadMessageCount(ctx context.Context, request *social.GetUnreadMessageCountRequest) (r *social.GetUnreadMessageCountResponse, err error)
+
+	MarkConversationsRead(ctx context.Context, request *social.MarkConversationsReadRequest) (r *social.MarkConversationsReadResponse, err error)
+
+	GetUnreadCounts(ctx context.Context, request *social.GetUnreadCountsRequest) (r *social.GetUnreadCountsResponse, err error)
+	// 收件箱相关接口
+	GetInbox(ctx context.Context, request *social.GetInboxRequest) (r *social.GetInboxResponse, err error)
+
+	UpdateConversation(ctx context.Context, request *social.UpdateConversationRequest) (r *social.UpdateConversationResponse, err error)
+	// 消息同步接口
+	SyncMessages(ctx context.Context, request *social.SyncMessagesRequest) (r *social.SyncMessagesResponse, err error)
+	// 通知相关接口
+	GetNotifications(ctx context.Context, request *social.GetNotificationsRequest) (r *social.GetNotificationsResponse, err error)
+
+	GetNotificationUnreadCount(ctx context.Context, request *social.GetNotificationUnreadCountRequest) (r *social.GetNotificationUnreadCountResponse, err error)
+
+	MarkNotificationsRead(ctx context.Context, request *social.MarkNotificationsReadRequest) (r *social.MarkNotificationsReadResponse, err error)
+
+	GetNotificationPreferences(ctx context.Context, request *social.GetNotificationPreferencesRequest) (r *social.GetNotificationPreferencesResponse, err error)
+
+	UpdateNotificationPreferences(ctx context.Context, request *social.UpdateNotificationPreferencesRequest) (r *social.UpdateNotificationPreferencesResponse, err error)
+
+	GetPresence(ctx context.Context, request *social.GetPresenceRequest) (r *social.GetPresenceResponse, err error)
+	// 聊天记录搜索
+	SearchMessages(ctx context.Context, request *social.SearchMessagesRequest) (r *social.SearchMessagesResponse, err error)
+
+	ExportChatHistory(ctx context.Context, request *social.ExportChatHistoryRequest) (r *social.ExportChatHistoryResponse, err error)
 }
 
 type SocialAPIClient struct {
@@ -87,6 +148,24 @@ func (p *SocialAPIClient) GetPrivateMessages(ctx context.Context, request *socia
 	}
 	return _result.GetSuccess(), nil
 }
+func (p *SocialAPIClient) EditPrivateMessage(ctx context.Context, request *social.EditPrivateMessageRequest) (r *social.EditPrivateMessageResponse, err error) {
+	var _args SocialAPIEditPrivateMessageArgs
+	_args.Request = request
+	var _result SocialAPIEditPrivateMessageResult
+	if err = p.Client_().Call(ctx, "EditPrivateMessage", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *SocialAPIClient) RecallPrivateMessage(ctx context.Context, request *social.RecallPrivateMessageRequest) (r *social.RecallPrivateMessageResponse, err error) {
+	var _args SocialAPIRecallPrivateMessageArgs
+	_args.Request = request
+	var _result SocialAPIRecallPrivateMessageResult
+	if err = p.Client_().Call(ctx, "RecallPrivateMessage", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
 func (p *SocialAPIClient) CreateChatRoom(ctx context.Context, request *social.CreateChatRoomRequest) (r *social.CreateChatRoomResponse, err error) {
 	var _args SocialAPICreateChatRoomArgs
 	_args.Request = request
@@ -132,6 +211,132 @@ func (p *SocialAPIClient) GetChatMessages(ctx context.Context, request *social.G
 	}
 	return _result.GetSuccess(), nil
 }
+func (p *SocialAPIClient) UploadChatAttachment(ctx context.Context, request *social.UploadChatAttachmentRequest) (r *social.UploadChatAttachmentResponse, err error) {
+	var _args SocialAPIUploadChatAttachmentArgs
+	_args.Request = request
+	var _result SocialAPIUploadChatAttachmentResult
+	if err = p.Client_().Call(ctx, "UploadChatAttachment", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *SocialAPIClient) EditChatMessage(ctx context.Context, request *social.EditChatMessageRequest) (r *social.EditChatMessageResponse, err error) {
+	var _args SocialAPIEditChatMessageArgs
+	_args.Request = request
+	var _result SocialAPIEditChatMessageResult
+	if err = p.Client_().Call(ctx, "EditChatMessage", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *SocialAPIClient) RecallChatMessage(ctx context.Context, request *social.RecallChatMessageRequest) (r *social.RecallChatMessageResponse, err error) {
+	var _args SocialAPIRecallChatMessageArgs
+	_args.Request = request
+	var _result SocialAPIRecallChatMessageResult
+	if err = p.Client_().Call(ctx, "RecallChatMessage", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *SocialAPIClient) ShareVideo(ctx context.Context, request *social.ShareVideoRequest) (r *social.SendChatMessageResponse, err error) {
+	var _args SocialAPIShareVideoArgs
+	_args.Request = request
+	var _result SocialAPIShareVideoResult
+	if err = p.Client_().Call(ctx, "ShareVideo", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *SocialAPIClient) AddChatRoomMembers(ctx context.Context, request *social.AddChatRoomMembersRequest) (r *social.AddChatRoomMembersResponse, err error) {
+	var _args SocialAPIAddChatRoomMembersArgs
+	_args.Request = request
+	var _result SocialAPIAddChatRoomMembersResult
+	if err = p.Client_().Call(ctx, "AddChatRoomMembers", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *SocialAPIClient) RemoveChatRoomMember(ctx context.Context, request *social.RemoveChatRoomMemberRequest) (r *social.RemoveChatRoomMemberResponse, err error) {
+	var _args SocialAPIRemoveChatRoomMemberArgs
+	_args.Request = request
+	var _result SocialAPIRemoveChatRoomMemberResult
+	if err = p.Client_().Call(ctx, "RemoveChatRoomMember", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *SocialAPIClient) LeaveChatRoom(ctx context.Context, request *social.LeaveChatRoomRequest) (r *social.LeaveChatRoomResponse, err error) {
+	var _args SocialAPILeaveChatRoomArgs
+	_args.Request = request
+	var _result SocialAPILeaveChatRoomResult
+	if err = p.Client_().Call(ctx, "LeaveChatRoom", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *SocialAPIClient) SetChatRoomAdmin(ctx context.Context, request *social.SetChatRoomAdminRequest) (r *social.SetChatRoomAdminResponse, err error) {
+	var _args SocialAPISetChatRoomAdminArgs
+	_args.Request = request
+	var _result SocialAPISetChatRoomAdminResult
+	if err = p.Client_().Call(ctx, "SetChatRoomAdmin", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *SocialAPIClient) TransferChatRoom(ctx context.Context, request *social.TransferChatRoomRequest) (r *social.TransferChatRoomResponse, err error) {
+	var _args SocialAPITransferChatRoomArgs
+	_args.Request = request
+	var _result SocialAPITransferChatRoomResult
+	if err = p.Client_().Call(ctx, "TransferChatRoom", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *SocialAPIClient) MuteChatRoomMember(ctx context.Context, request *social.MuteChatRoomMemberRequest) (r *social.MuteChatRoomMemberResponse, err error) {
+	var _args SocialAPIMuteChatRoomMemberArgs
+	_args.Request = request
+	var _result SocialAPIMuteChatRoomMemberResult
+	if err = p.Client_().Call(ctx, "MuteChatRoomMember", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *SocialAPIClient) RenameChatRoom(ctx context.Context, request *social.RenameChatRoomRequest) (r *social.RenameChatRoomResponse, err error) {
+	var _args SocialAPIRenameChatRoomArgs
+	_args.Request = request
+	var _result SocialAPIRenameChatRoomResult
+	if err = p.Client_().Call(ctx, "RenameChatRoom", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *SocialAPIClient) DisbandChatRoom(ctx context.Context, request *social.DisbandChatRoomRequest) (r *social.DisbandChatRoomResponse, err error) {
+	var _args SocialAPIDisbandChatRoomArgs
+	_args.Request = request
+	var _result SocialAPIDisbandChatRoomResult
+	if err = p.Client_().Call(ctx, "DisbandChatRoom", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *SocialAPIClient) CreateChatRoomInvite(ctx context.Context, request *social.CreateChatRoomInviteRequest) (r *social.CreateChatRoomInviteResponse, err error) {
+	var _args SocialAPICreateChatRoomInviteArgs
+	_args.Request = request
+	var _result SocialAPICreateChatRoomInviteResult
+	if err = p.Client_().Call(ctx, "CreateChatRoomInvite", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *SocialAPIClient) JoinChatRoomByInvite(ctx context.Context, request *social.JoinChatRoomByInviteRequest) (r *social.JoinChatRoomByInviteResponse, err error) {
+	var _args SocialAPIJoinChatRoomByInviteArgs
+	_args.Request = request
+	var _result SocialAPIJoinChatRoomByInviteResult
+	if err = p.Client_().Call(ctx, "JoinChatRoomByInvite", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
 func (p *SocialAPIClient) AddFriend(ctx context.Context, request *social.AddFriendRequest) (r *social.AddFriendResponse, err error) {
 	var _args SocialAPIAddFriendArgs
 	_args.Request = request
@@ -186,6 +391,15 @@ func (p *SocialAPIClient) HandleFriendRequest(ctx context.Context, request *soci
 	}
 	return _result.GetSuccess(), nil
 }
+func (p *SocialAPIClient) GetFriendRecommendations(ctx context.Context, request *social.GetFriendRecommendationsRequest) (r *social.GetFriendRecommendationsResponse, err error) {
+	var _args SocialAPIGetFriendRecommendationsArgs
+	_args.Request = request
+	var _result SocialAPIGetFriendRecommendationsResult
+	if err = p.Client_().Call(ctx, "GetFriendRecommendations", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
 func (p *SocialAPIClient) MarkMessageRead(ctx context.Context, request *social.MarkMessageReadRequest) (r *social.MarkMessageReadResponse, err error) {
 	var _args SocialAPIMarkMessageReadArgs
 	_args.Request = request
@@ -204,6 +418,123 @@ func (p *SocialAPIClient) GetUnreadMessageCount(ctx context.Context, request *so
 	}
 	return _result.GetSuccess(), nil
 }
+func (p *SocialAPIClient) MarkConversationsRead(ctx context.Context, request *social.MarkConversationsReadRequest) (r *social.MarkConversationsReadResponse, err error) {
+	var _args SocialAPIMarkConversationsReadArgs
+	_args.Request = request
+	var _result SocialAPIMarkConversationsReadResult
+	if err = p.Client_().Call(ctx, "MarkConversationsRead", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *SocialAPIClient) GetUnreadCounts(ctx context.Context, request *social.GetUnreadCountsRequest) (r *social.GetUnreadCountsResponse, err error) {
+	var _args SocialAPIGetUnreadCountsArgs
+	_args.Request = request
+	var _result SocialAPIGetUnreadCountsResult
+	if err = p.Client_().Call(ctx, "GetUnreadCounts", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *SocialAPIClient) GetInbox(ctx context.Context, request *social.GetInboxRequest) (r *social.GetInboxResponse, err error) {
+	var _args SocialAPIGetInboxArgs
+	_args.Request = request
+	var _result SocialAPIGetInboxResult
+	if err = p.Client_().Call(ctx, "GetInbox", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *SocialAPIClient) UpdateConversation(ctx context.Context, request *social.UpdateConversationRequest) (r *social.UpdateConversationResponse, err error) {
+	var _args SocialAPIUpdateConversationArgs
+	_args.Request = request
+	var _result SocialAPIUpdateConversationResult
+	if err = p.Client_().Call(ctx, "UpdateConversation", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *SocialAPIClient) SyncMessages(ctx context.Context, request *social.SyncMessagesRequest) (r *social.SyncMessagesResponse, err error) {
+	var _args SocialAPISyncMessagesArgs
+	_args.Request = request
+	var _result SocialAPISyncMessagesResult
+	if err = p.Client_().Call(ctx, "SyncMessages", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *SocialAPIClient) GetNotifications(ctx context.Context, request *social.GetNotificationsRequest) (r *social.GetNotificationsResponse, err error) {
+	var _args SocialAPIGetNotificationsArgs
+	_args.Request = request
+	var _result SocialAPIGetNotificationsResult
+	if err = p.Client_().Call(ctx, "GetNotifications", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *SocialAPIClient) GetNotificationUnreadCount(ctx context.Context, request *social.GetNotificationUnreadCountRequest) (r *social.GetNotificationUnreadCountResponse, err error) {
+	var _args SocialAPIGetNotificationUnreadCountArgs
+	_args.Request = request
+	var _result SocialAPIGetNotificationUnreadCountResult
+	if err = p.Client_().Call(ctx, "GetNotificationUnreadCount", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *SocialAPIClient) MarkNotificationsRead(ctx context.Context, request *social.MarkNotificationsReadRequest) (r *social.MarkNotificationsReadResponse, err error) {
+	var _args SocialAPIMarkNotificationsReadArgs
+	_args.Request = request
+	var _result SocialAPIMarkNotificationsReadResult
+	if err = p.Client_().Call(ctx, "MarkNotificationsRead", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *SocialAPIClient) GetNotificationPreferences(ctx context.Context, request *social.GetNotificationPreferencesRequest) (r *social.GetNotificationPreferencesResponse, err error) {
+	var _args SocialAPIGetNotificationPreferencesArgs
+	_args.Request = request
+	var _result SocialAPIGetNotificationPreferencesResult
+	if err = p.Client_().Call(ctx, "GetNotificationPreferences", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *SocialAPIClient) UpdateNotificationPreferences(ctx context.Context, request *social.UpdateNotificationPreferencesRequest) (r *social.UpdateNotificationPreferencesResponse, err error) {
+	var _args SocialAPIUpdateNotificationPreferencesArgs
+	_args.Request = request
+	var _result SocialAPIUpdateNotificationPreferencesResult
+	if err = p.Client_().Call(ctx, "UpdateNotificationPreferences", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *SocialAPIClient) GetPresence(ctx context.Context, request *social.GetPresenceRequest) (r *social.GetPresenceResponse, err error) {
+	var _args SocialAPIGetPresenceArgs
+	_args.Request = request
+	var _result SocialAPIGetPresenceResult
+	if err = p.Client_().Call(ctx, "GetPresence", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *SocialAPIClient) SearchMessages(ctx context.Context, request *social.SearchMessagesRequest) (r *social.SearchMessagesResponse, err error) {
+	var _args SocialAPISearchMessagesArgs
+	_args.Request = request
+	var _result SocialAPISearchMessagesResult
+	if err = p.Client_().Call(ctx, "SearchMessages", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *SocialAPIClient) ExportChatHistory(ctx context.Context, request *social.ExportChatHistoryRequest) (r *social.ExportChatHistoryResponse, err error) {
+	var _args SocialAPIExportChatHistoryArgs
+	_args.Request = request
+	var _result SocialAPIExportChatHistoryResult
+	if err = p.Client_().Call(ctx, "ExportChatHistory", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
 
 type SocialAPIProcessor struct {
 	processorMap map[string]thrift.TProcessorFunction
@@ -227,19 +558,49 @@ func NewSocialAPIProcessor(handler SocialAPI) *SocialAPIProcessor {
 	self := &SocialAPIProcessor{handler: handler, processorMap: make(map[string]thrift.TProcessorFunction)}
 	self.AddToProcessorMap("SendPrivateMessage", &socialAPIProcessorSendPrivateMessage{handler: handler})
 	self.AddToProcessorMap("GetPrivateMessages", &socialAPIProcessorGetPrivateMessages{handler: handler})
+	self.AddToProcessorMap("EditPrivateMessage", &socialAPIProcessorEditPrivateMessage{handler: handler})
+	self.AddToProcessorMap("RecallPrivateMessage", &socialAPIProcessorRecallPrivateMessage{handler: handler})
 	self.AddToProcessorMap("CreateChatRoom", &socialAPIProcessorCreateChatRoom{handler: handler})
 	self.AddToProcessorMap("GetChatRoom", &socialAPIProcessorGetChatRoom{handler: handler})
 	self.AddToProcessorMap("GetUserChatRooms", &socialAPIProcessorGetUserChatRooms{handler: handler})
 	self.AddToProcessorMap("SendChatMessage", &socialAPIProcessorSendChatMessage{handler: handler})
 	self.AddToProcessorMap("GetChatMessages", &socialAPIProcessorGetChatMessages{handler: handler})
+	self.AddToProcessorMap("UploadChatAttachment", &socialAPIProcessorUploadChatAttachment{handler: handler})
+	self.AddToProcessorMap("EditChatMessage", &socialAPIProcessorEditChatMessage{handler: handler})
+	self.AddToProcessorMap("RecallChatMessage", &socialAPIProcessorRecallChatMessage{handler: handler})
+	self.AddToProcessorMap("ShareVideo", &socialAPIProcessorShareVideo{handler: handler})
+	self.AddToProcessorMap("AddChatRoomMembers", &socialAPIProcessorAddChatRoomMembers{handler: handler})
+	self.AddToProcessorMap("RemoveChatRoomMember", &socialAPIProcessorRemoveChatRoomMember{handler: handler})
+	self.AddToProcessorMap("LeaveChatRoom", &socialAPIProcessorLeaveChatRoom{handler: handler})
+	self.AddToProcessorMap("SetChatRoomAdmin", &socialAPIProcessorSetChatRoomAdmin{handler: handler})
+	self.AddToProcessorMap("TransferChatRoom", &socialAPIProcessorTransferChatRoom{handler: handler})
+	self.AddToProcessorMap("MuteChatRoomMember", &socialAPIProcessorMuteChatRoomMember{handler: handler})
+	self.AddToProcessorMap("RenameChatRoom", &socialAPIProcessorRenameChatRoom{handler: handler})
+	self.AddToProcessorMap("DisbandChatRoom", &socialAPIProcessorDisbandChatRoom{handler: handler})
+	self.AddToProcessorMap("CreateChatRoomInvite", &socialAPIProcessorCreateChatRoomInvite{handler: handler})
+	self.AddToProcessorMap("JoinChatRoomByInvite", &socialAPIProcessorJoinChatRoomByInvite{handler: handler})
 	self.AddToProcessorMap("AddFriend", &socialAPIProcessorAddFriend{handler: handler})
 	self.AddToProcessorMap("GetFriendship", &socialAPIProcessorGetFriendship{handler: handler})
 	self.AddToProcessorMap("GetUserFriends", &socialAPIProcessorGetUserFriends{handler: handler})
 	self.AddToProcessorMap("CreateFriendRequest", &socialAPIProcessorCreateFriendRequest{handler: handler})
 	self.AddToProcessorMap("GetFriendRequests", &socialAPIProcessorGetFriendRequests{handler: handler})
 	self.AddToProcessorMap("HandleFriendRequest", &socialAPIProcessorHandleFriendRequest{handler: handler})
+	self.AddToProcessorMap("GetFriendRecommendations", &socialAPIProcessorGetFriendRecommendations{handler: handler})
 	self.AddToProcessorMap("MarkMessageRead", &socialAPIProcessorMarkMessageRead{handler: handler})
 	self.AddToProcessorMap("GetUnreadMessageCount", &socialAPIProcessorGetUnreadMessageCount{handler: handler})
+	self.AddToProcessorMap("MarkConversationsRead", &socialAPIProcessorMarkConversationsRead{handler: handler})
+	self.AddToProcessorMap("GetUnreadCounts", &socialAPIProcessorGetUnreadCounts{handler: handler})
+	self.AddToProcessorMap("GetInbox", &socialAPIProcessorGetInbox{handler: handler})
+	self.AddToProcessorMap("UpdateConversation", &socialAPIProcessorUpdateConversation{handler: handler})
+	self.AddToProcessorMap("SyncMessages", &socialAPIProcessorSyncMessages{handler: handler})
+	self.AddToProcessorMap("GetNotifications", &socialAPIProcessorGetNotifications{handler: handler})
+	self.AddToProcessorMap("GetNotificationUnreadCount", &socialAPIProcessorGetNotificationUnreadCount{handler: handler})
+	self.AddToProcessorMap("MarkNotificationsRead", &socialAPIProcessorMarkNotificationsRead{handler: handler})
+	self.AddToProcessorMap("GetNotificationPreferences", &socialAPIProcessorGetNotificationPreferences{handler: handler})
+	self.AddToProcessorMap("UpdateNotificationPreferences", &socialAPIProcessorUpdateNotificationPreferences{handler: handler})
+	self.AddToProcessorMap("GetPresence", &socialAPIProcessorGetPresence{handler: handler})
+	self.AddToProcessorMap("SearchMessages", &socialAPIProcessorSearchMessages{handler: handler})
+	self.AddToProcessorMap("ExportChatHistory", &socialAPIProcessorExportChatHistory{handler: handler})
 	return self
 }
 func (p *SocialAPIProcessor) Process(ctx context.Context, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
@@ -356,6 +717,102 @@ func (p *socialAPIProcessorGetPrivateMessages) Process(ctx context.Context, seqI
 	return true, err
 }
 
+type socialAPIProcessorEditPrivateMessage struct {
+	handler SocialAPI
+}
+
+func (p *socialAPIProcessorEditPrivateMessage) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := SocialAPIEditPrivateMessageArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("EditPrivateMessage", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := SocialAPIEditPrivateMessageResult{}
+	var retval *social.EditPrivateMessageResponse
+	if retval, err2 = p.handler.EditPrivateMessage(ctx, args.Request); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing EditPrivateMessage: "+err2.Error())
+		oprot.WriteMessageBegin("EditPrivateMessage", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("EditPrivateMessage", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type socialAPIProcessorRecallPrivateMessage struct {
+	handler SocialAPI
+}
+
+func (p *socialAPIProcessorRecallPrivateMessage) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := SocialAPIRecallPrivateMessageArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("RecallPrivateMessage", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := SocialAPIRecallPrivateMessageResult{}
+	var retval *social.RecallPrivateMessageResponse
+	if retval, err2 = p.handler.RecallPrivateMessage(ctx, args.Request); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing RecallPrivateMessage: "+err2.Error())
+		oprot.WriteMessageBegin("RecallPrivateMessage", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("RecallPrivateMessage", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
 type socialAPIProcessorCreateChatRoom struct {
 	handler SocialAPI
 }
@@ -596,16 +1053,16 @@ func (p *socialAPIProcessorGetChatMessages) Process(ctx context.Context, seqId i
 	return true, err
 }
 
-type socialAPIProcessorAddFriend struct {
+type socialAPIProcessorUploadChatAttachment struct {
 	handler SocialAPI
 }
 
-func (p *socialAPIProcessorAddFriend) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := SocialAPIAddFriendArgs{}
+func (p *socialAPIProcessorUploadChatAttachment) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := SocialAPIUploadChatAttachmentArgs{}
 	if err = args.Read(iprot); err != nil {
 		iprot.ReadMessageEnd()
 		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("AddFriend", thrift.EXCEPTION, seqId)
+		oprot.WriteMessageBegin("UploadChatAttachment", thrift.EXCEPTION, seqId)
 		x.Write(oprot)
 		oprot.WriteMessageEnd()
 		oprot.Flush(ctx)
@@ -614,11 +1071,11 @@ func (p *socialAPIProcessorAddFriend) Process(ctx context.Context, seqId int32,
 
 	iprot.ReadMessageEnd()
 	var err2 error
-	result := SocialAPIAddFriendResult{}
-	var retval *social.AddFriendResponse
-	if retval, err2 = p.handler.AddFriend(ctx, args.Request); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing AddFriend: "+err2.Error())
-		oprot.WriteMessageBegin("AddFriend", thrift.EXCEPTION, seqId)
+	result := SocialAPIUploadChatAttachmentResult{}
+	var retval *social.UploadChatAttachmentResponse
+	if retval, err2 = p.handler.UploadChatAttachment(ctx, args.Request); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing UploadChatAttachment: "+err2.Error())
+		oprot.WriteMessageBegin("UploadChatAttachment", thrift.EXCEPTION, seqId)
 		x.Write(oprot)
 		oprot.WriteMessageEnd()
 		oprot.Flush(ctx)
@@ -626,7 +1083,7 @@ func (p *socialAPIProcessorAddFriend) Process(ctx context.Context, seqId int32,
 	} else {
 		result.Success = retval
 	}
-	if err2 = oprot.WriteMessageBegin("AddFriend", thrift.REPLY, seqId); err2 != nil {
+	if err2 = oprot.WriteMessageBegin("UploadChatAttachment", thrift.REPLY, seqId); err2 != nil {
 		err = err2
 	}
 	if err2 = result.Write(oprot); err == nil && err2 != nil {
@@ -644,16 +1101,16 @@ func (p *socialAPIProcessorAddFriend) Process(ctx context.Context, seqId int32,
 	return true, err
 }
 
-type socialAPIProcessorGetFriendship struct {
+type socialAPIProcessorEditChatMessage struct {
 	handler SocialAPI
 }
 
-func (p *socialAPIProcessorGetFriendship) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := SocialAPIGetFriendshipArgs{}
+func (p *socialAPIProcessorEditChatMessage) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := SocialAPIEditChatMessageArgs{}
 	if err = args.Read(iprot); err != nil {
 		iprot.ReadMessageEnd()
 		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("GetFriendship", thrift.EXCEPTION, seqId)
+		oprot.WriteMessageBegin("EditChatMessage", thrift.EXCEPTION, seqId)
 		x.Write(oprot)
 		oprot.WriteMessageEnd()
 		oprot.Flush(ctx)
@@ -662,11 +1119,11 @@ func (p *socialAPIProcessorGetFriendship) Process(ctx context.Context, seqId int
 
 	iprot.ReadMessageEnd()
 	var err2 error
-	result := SocialAPIGetFriendshipResult{}
-	var retval *social.GetFriendshipResponse
-	if retval, err2 = p.handler.GetFriendship(ctx, args.Request); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetFriendship: "+err2.Error())
-		oprot.WriteMessageBegin("GetFriendship", thrift.EXCEPTION, seqId)
+	result := SocialAPIEditChatMessageResult{}
+	var retval *social.EditChatMessageResponse
+	if retval, err2 = p.handler.EditChatMessage(ctx, args.Request); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing EditChatMessage: "+err2.Error())
+		oprot.WriteMessageBegin("EditChatMessage", thrift.EXCEPTION, seqId)
 		x.Write(oprot)
 		oprot.WriteMessageEnd()
 		oprot.Flush(ctx)
@@ -674,7 +1131,7 @@ func (p *socialAPIProcessorGetFriendship) Process(ctx context.Context, seqId int
 	} else {
 		result.Success = retval
 	}
-	if err2 = oprot.WriteMessageBegin("GetFriendship", thrift.REPLY, seqId); err2 != nil {
+	if err2 = oprot.WriteMessageBegin("EditChatMessage", thrift.REPLY, seqId); err2 != nil {
 		err = err2
 	}
 	if err2 = result.Write(oprot); err == nil && err2 != nil {
@@ -692,16 +1149,16 @@ func (p *socialAPIProcessorGetFriendship) Process(ctx context.Context, seqId int
 	return true, err
 }
 
-type socialAPIProcessorGetUserFriends struct {
+type socialAPIProcessorRecallChatMessage struct {
 	handler SocialAPI
 }
 
-func (p *socialAPIProcessorGetUserFriends) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := SocialAPIGetUserFriendsArgs{}
+func (p *socialAPIProcessorRecallChatMessage) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := SocialAPIRecallChatMessageArgs{}
 	if err = args.Read(iprot); err != nil {
 		iprot.ReadMessageEnd()
 		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("GetUserFriends", thrift.EXCEPTION, seqId)
+		oprot.WriteMessageBegin("RecallChatMessage", thrift.EXCEPTION, seqId)
 		x.Write(oprot)
 		oprot.WriteMessageEnd()
 		oprot.Flush(ctx)
@@ -710,11 +1167,11 @@ func (p *socialAPIProcessorGetUserFriends) Process(ctx context.Context, seqId in
 
 	iprot.ReadMessageEnd()
 	var err2 error
-	result := SocialAPIGetUserFriendsResult{}
-	var retval *social.GetUserFriendsResponse
-	if retval, err2 = p.handler.GetUserFriends(ctx, args.Request); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetUserFriends: "+err2.Error())
-		oprot.WriteMessageBegin("GetUserFriends", thrift.EXCEPTION, seqId)
+	result := SocialAPIRecallChatMessageResult{}
+	var retval *social.RecallChatMessageResponse
+	if retval, err2 = p.handler.RecallChatMessage(ctx, args.Request); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing RecallChatMessage: "+err2.Error())
+		oprot.WriteMessageBegin("RecallChatMessage", thrift.EXCEPTION, seqId)
 		x.Write(oprot)
 		oprot.WriteMessageEnd()
 		oprot.Flush(ctx)
@@ -722,7 +1179,7 @@ func (p *socialAPIProcessorGetUserFriends) Process(ctx context.Context, seqId in
 	} else {
 		result.Success = retval
 	}
-	if err2 = oprot.WriteMessageBegin("GetUserFriends", thrift.REPLY, seqId); err2 != nil {
+	if err2 = oprot.WriteMessageBegin("RecallChatMessage", thrift.REPLY, seqId); err2 != nil {
 		err = err2
 	}
 	if err2 = result.Write(oprot); err == nil && err2 != nil {
@@ -740,16 +1197,16 @@ func (p *socialAPIProcessorGetUserFriends) Process(ctx context.Context, seqId in
 	return true, err
 }
 
-type socialAPIProcessorCreateFriendRequest struct {
+type socialAPIProcessorShareVideo struct {
 	handler SocialAPI
 }
 
-func (p *socialAPIProcessorCreateFriendRequest) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := SocialAPICreateFriendRequestArgs{}
+func (p *socialAPIProcessorShareVideo) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := SocialAPIShareVideoArgs{}
 	if err = args.Read(iprot); err != nil {
 		iprot.ReadMessageEnd()
 		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("CreateFriendRequest", thrift.EXCEPTION, seqId)
+		oprot.WriteMessageBegin("ShareVideo", thrift.EXCEPTION, seqId)
 		x.Write(oprot)
 		oprot.WriteMessageEnd()
 		oprot.Flush(ctx)
@@ -758,11 +1215,11 @@ func (p *socialAPIProcessorCreateFriendRequest) Process(ctx context.Context, seq
 
 	iprot.ReadMessageEnd()
 	var err2 error
-	result := SocialAPICreateFriendRequestResult{}
-	var retval *social.CreateFriendRequestResponse
-	if retval, err2 = p.handler.CreateFriendRequest(ctx, args.Request); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing CreateFriendRequest: "+err2.Error())
-		oprot.WriteMessageBegin("CreateFriendRequest", thrift.EXCEPTION, seqId)
+	result := SocialAPIShareVideoResult{}
+	var retval *social.SendChatMessageResponse
+	if retval, err2 = p.handler.ShareVideo(ctx, args.Request); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing ShareVideo: "+err2.Error())
+		oprot.WriteMessageBegin("ShareVideo", thrift.EXCEPTION, seqId)
 		x.Write(oprot)
 		oprot.WriteMessageEnd()
 		oprot.Flush(ctx)
@@ -770,7 +1227,7 @@ func (p *socialAPIProcessorCreateFriendRequest) Process(ctx context.Context, seq
 	} else {
 		result.Success = retval
 	}
-	if err2 = oprot.WriteMessageBegin("CreateFriendRequest", thrift.REPLY, seqId); err2 != nil {
+	if err2 = oprot.WriteMessageBegin("ShareVideo", thrift.REPLY, seqId); err2 != nil {
 		err = err2
 	}
 	if err2 = result.Write(oprot); err == nil && err2 != nil {
@@ -788,16 +1245,16 @@ func (p *socialAPIProcessorCreateFriendRequest) Process(ctx context.Context, seq
 	return true, err
 }
 
-type socialAPIProcessorGetFriendRequests struct {
+type socialAPIProcessorAddChatRoomMembers struct {
 	handler SocialAPI
 }
 
-func (p *socialAPIProcessorGetFriendRequests) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := SocialAPIGetFriendRequestsArgs{}
+func (p *socialAPIProcessorAddChatRoomMembers) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := SocialAPIAddChatRoomMembersArgs{}
 	if err = args.Read(iprot); err != nil {
 		iprot.ReadMessageEnd()
 		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("GetFriendRequests", thrift.EXCEPTION, seqId)
+		oprot.WriteMessageBegin("AddChatRoomMembers", thrift.EXCEPTION, seqId)
 		x.Write(oprot)
 		oprot.WriteMessageEnd()
 		oprot.Flush(ctx)
@@ -806,11 +1263,11 @@ func (p *socialAPIProcessorGetFriendRequests) Process(ctx context.Context, seqId
 
 	iprot.ReadMessageEnd()
 	var err2 error
-	result := SocialAPIGetFriendRequestsResult{}
-	var retval *social.GetFriendRequestsResponse
-	if retval, err2 = p.handler.GetFriendRequests(ctx, args.Request); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetFriendRequests: "+err2.Error())
-		oprot.WriteMessageBegin("GetFriendRequests", thrift.EXCEPTION, seqId)
+	result := SocialAPIAddChatRoomMembersResult{}
+	var retval *social.AddChatRoomMembersResponse
+	if retval, err2 = p.handler.AddChatRoomMembers(ctx, args.Request); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing AddChatRoomMembers: "+err2.Error())
+		oprot.WriteMessageBegin("AddChatRoomMembers", thrift.EXCEPTION, seqId)
 		x.Write(oprot)
 		oprot.WriteMessageEnd()
 		oprot.Flush(ctx)
@@ -818,7 +1275,7 @@ func (p *socialAPIProcessorGetFriendRequests) Process(ctx context.Context, seqId
 	} else {
 		result.Success = retval
 	}
-	if err2 = oprot.WriteMessageBegin("GetFriendRequests", thrift.REPLY, seqId); err2 != nil {
+	if err2 = oprot.WriteMessageBegin("AddChatRoomMembers", thrift.REPLY, seqId); err2 != nil {
 		err = err2
 	}
 	if err2 = result.Write(oprot); err == nil && err2 != nil {
@@ -836,16 +1293,16 @@ func (p *socialAPIProcessorGetFriendRequests) Process(ctx context.Context, seqId
 	return true, err
 }
 
-type socialAPIProcessorHandleFriendRequest struct {
+type socialAPIProcessorRemoveChatRoomMember struct {
 	handler SocialAPI
 }
 
-func (p *socialAPIProcessorHandleFriendRequest) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := SocialAPIHandleFriendRequestArgs{}
+func (p *socialAPIProcessorRemoveChatRoomMember) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := SocialAPIRemoveChatRoomMemberArgs{}
 	if err = args.Read(iprot); err != nil {
 		iprot.ReadMessageEnd()
 		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("HandleFriendRequest", thrift.EXCEPTION, seqId)
+		oprot.WriteMessageBegin("RemoveChatRoomMember", thrift.EXCEPTION, seqId)
 		x.Write(oprot)
 		oprot.WriteMessageEnd()
 		oprot.Flush(ctx)
@@ -854,11 +1311,11 @@ func (p *socialAPIProcessorHandleFriendRequest) Process(ctx context.Context, seq
 
 	iprot.ReadMessageEnd()
 	var err2 error
-	result := SocialAPIHandleFriendRequestResult{}
-	var retval *social.HandleFriendRequestResponse
-	if retval, err2 = p.handler.HandleFriendRequest(ctx, args.Request); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing HandleFriendRequest: "+err2.Error())
-		oprot.WriteMessageBegin("HandleFriendRequest", thrift.EXCEPTION, seqId)
+	result := SocialAPIRemoveChatRoomMemberResult{}
+	var retval *social.RemoveChatRoomMemberResponse
+	if retval, err2 = p.handler.RemoveChatRoomMember(ctx, args.Request); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing RemoveChatRoomMember: "+err2.Error())
+		oprot.WriteMessageBegin("RemoveChatRoomMember", thrift.EXCEPTION, seqId)
 		x.Write(oprot)
 		oprot.WriteMessageEnd()
 		oprot.Flush(ctx)
@@ -866,7 +1323,7 @@ func (p *socialAPIProcessorHandleFriendRequest) Process(ctx context.Context, seq
 	} else {
 		result.Success = retval
 	}
-	if err2 = oprot.WriteMessageBegin("HandleFriendRequest", thrift.REPLY, seqId); err2 != nil {
+	if err2 = oprot.WriteMessageBegin("RemoveChatRoomMember", thrift.REPLY, seqId); err2 != nil {
 		err = err2
 	}
 	if err2 = result.Write(oprot); err == nil && err2 != nil {
@@ -884,131 +1341,10235 @@ func (p *socialAPIProcessorHandleFriendRequest) Process(ctx context.Context, seq
 	return true, err
 }
 
-type socialAPIProcessorMarkMessageRead struct {
+type socialAPIProcessorLeaveChatRoom struct {
+	handler SocialAPI
+}
+
+func (p *socialAPIProcessorLeaveChatRoom) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := SocialAPILeaveChatRoomArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("LeaveChatRoom", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := SocialAPILeaveChatRoomResult{}
+	var retval *social.LeaveChatRoomResponse
+	if retval, err2 = p.handler.LeaveChatRoom(ctx, args.Request); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing LeaveChatRoom: "+err2.Error())
+		oprot.WriteMessageBegin("LeaveChatRoom", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("LeaveChatRoom", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type socialAPIProcessorSetChatRoomAdmin struct {
+	handler SocialAPI
+}
+
+func (p *socialAPIProcessorSetChatRoomAdmin) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := SocialAPISetChatRoomAdminArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("SetChatRoomAdmin", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := SocialAPISetChatRoomAdminResult{}
+	var retval *social.SetChatRoomAdminResponse
+	if retval, err2 = p.handler.SetChatRoomAdmin(ctx, args.Request); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing SetChatRoomAdmin: "+err2.Error())
+		oprot.WriteMessageBegin("SetChatRoomAdmin", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("SetChatRoomAdmin", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type socialAPIProcessorTransferChatRoom struct {
+	handler SocialAPI
+}
+
+func (p *socialAPIProcessorTransferChatRoom) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := SocialAPITransferChatRoomArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("TransferChatRoom", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := SocialAPITransferChatRoomResult{}
+	var retval *social.TransferChatRoomResponse
+	if retval, err2 = p.handler.TransferChatRoom(ctx, args.Request); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing TransferChatRoom: "+err2.Error())
+		oprot.WriteMessageBegin("TransferChatRoom", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("TransferChatRoom", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type socialAPIProcessorMuteChatRoomMember struct {
+	handler SocialAPI
+}
+
+func (p *socialAPIProcessorMuteChatRoomMember) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := SocialAPIMuteChatRoomMemberArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("MuteChatRoomMember", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := SocialAPIMuteChatRoomMemberResult{}
+	var retval *social.MuteChatRoomMemberResponse
+	if retval, err2 = p.handler.MuteChatRoomMember(ctx, args.Request); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing MuteChatRoomMember: "+err2.Error())
+		oprot.WriteMessageBegin("MuteChatRoomMember", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("MuteChatRoomMember", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type socialAPIProcessorRenameChatRoom struct {
+	handler SocialAPI
+}
+
+func (p *socialAPIProcessorRenameChatRoom) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := SocialAPIRenameChatRoomArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("RenameChatRoom", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := SocialAPIRenameChatRoomResult{}
+	var retval *social.RenameChatRoomResponse
+	if retval, err2 = p.handler.RenameChatRoom(ctx, args.Request); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing RenameChatRoom: "+err2.Error())
+		oprot.WriteMessageBegin("RenameChatRoom", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("RenameChatRoom", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type socialAPIProcessorDisbandChatRoom struct {
 	handler SocialAPI
 }
 
-func (p *socialAPIProcessorMarkMessageRead) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := SocialAPIMarkMessageReadArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("MarkMessageRead", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
+func (p *socialAPIProcessorDisbandChatRoom) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := SocialAPIDisbandChatRoomArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("DisbandChatRoom", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := SocialAPIDisbandChatRoomResult{}
+	var retval *social.DisbandChatRoomResponse
+	if retval, err2 = p.handler.DisbandChatRoom(ctx, args.Request); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing DisbandChatRoom: "+err2.Error())
+		oprot.WriteMessageBegin("DisbandChatRoom", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("DisbandChatRoom", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type socialAPIProcessorCreateChatRoomInvite struct {
+	handler SocialAPI
+}
+
+func (p *socialAPIProcessorCreateChatRoomInvite) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := SocialAPICreateChatRoomInviteArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("CreateChatRoomInvite", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := SocialAPICreateChatRoomInviteResult{}
+	var retval *social.CreateChatRoomInviteResponse
+	if retval, err2 = p.handler.CreateChatRoomInvite(ctx, args.Request); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing CreateChatRoomInvite: "+err2.Error())
+		oprot.WriteMessageBegin("CreateChatRoomInvite", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("CreateChatRoomInvite", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type socialAPIProcessorJoinChatRoomByInvite struct {
+	handler SocialAPI
+}
+
+func (p *socialAPIProcessorJoinChatRoomByInvite) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := SocialAPIJoinChatRoomByInviteArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("JoinChatRoomByInvite", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := SocialAPIJoinChatRoomByInviteResult{}
+	var retval *social.JoinChatRoomByInviteResponse
+	if retval, err2 = p.handler.JoinChatRoomByInvite(ctx, args.Request); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing JoinChatRoomByInvite: "+err2.Error())
+		oprot.WriteMessageBegin("JoinChatRoomByInvite", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("JoinChatRoomByInvite", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type socialAPIProcessorAddFriend struct {
+	handler SocialAPI
+}
+
+func (p *socialAPIProcessorAddFriend) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := SocialAPIAddFriendArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("AddFriend", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := SocialAPIAddFriendResult{}
+	var retval *social.AddFriendResponse
+	if retval, err2 = p.handler.AddFriend(ctx, args.Request); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing AddFriend: "+err2.Error())
+		oprot.WriteMessageBegin("AddFriend", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("AddFriend", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type socialAPIProcessorGetFriendship struct {
+	handler SocialAPI
+}
+
+func (p *socialAPIProcessorGetFriendship) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := SocialAPIGetFriendshipArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("GetFriendship", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := SocialAPIGetFriendshipResult{}
+	var retval *social.GetFriendshipResponse
+	if retval, err2 = p.handler.GetFriendship(ctx, args.Request); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetFriendship: "+err2.Error())
+		oprot.WriteMessageBegin("GetFriendship", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("GetFriendship", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type socialAPIProcessorGetUserFriends struct {
+	handler SocialAPI
+}
+
+func (p *socialAPIProcessorGetUserFriends) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := SocialAPIGetUserFriendsArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("GetUserFriends", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := SocialAPIGetUserFriendsResult{}
+	var retval *social.GetUserFriendsResponse
+	if retval, err2 = p.handler.GetUserFriends(ctx, args.Request); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetUserFriends: "+err2.Error())
+		oprot.WriteMessageBegin("GetUserFriends", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("GetUserFriends", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type socialAPIProcessorCreateFriendRequest struct {
+	handler SocialAPI
+}
+
+func (p *socialAPIProcessorCreateFriendRequest) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := SocialAPICreateFriendRequestArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("CreateFriendRequest", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := SocialAPICreateFriendRequestResult{}
+	var retval *social.CreateFriendRequestResponse
+	if retval, err2 = p.handler.CreateFriendRequest(ctx, args.Request); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing CreateFriendRequest: "+err2.Error())
+		oprot.WriteMessageBegin("CreateFriendRequest", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("CreateFriendRequest", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type socialAPIProcessorGetFriendRequests struct {
+	handler SocialAPI
+}
+
+func (p *socialAPIProcessorGetFriendRequests) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := SocialAPIGetFriendRequestsArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("GetFriendRequests", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := SocialAPIGetFriendRequestsResult{}
+	var retval *social.GetFriendRequestsResponse
+	if retval, err2 = p.handler.GetFriendRequests(ctx, args.Request); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetFriendRequests: "+err2.Error())
+		oprot.WriteMessageBegin("GetFriendRequests", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("GetFriendRequests", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type socialAPIProcessorHandleFriendRequest struct {
+	handler SocialAPI
+}
+
+func (p *socialAPIProcessorHandleFriendRequest) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := SocialAPIHandleFriendRequestArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("HandleFriendRequest", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := SocialAPIHandleFriendRequestResult{}
+	var retval *social.HandleFriendRequestResponse
+	if retval, err2 = p.handler.HandleFriendRequest(ctx, args.Request); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing HandleFriendRequest: "+err2.Error())
+		oprot.WriteMessageBegin("HandleFriendRequest", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("HandleFriendRequest", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type socialAPIProcessorGetFriendRecommendations struct {
+	handler SocialAPI
+}
+
+func (p *socialAPIProcessorGetFriendRecommendations) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := SocialAPIGetFriendRecommendationsArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("GetFriendRecommendations", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := SocialAPIGetFriendRecommendationsResult{}
+	var retval *social.GetFriendRecommendationsResponse
+	if retval, err2 = p.handler.GetFriendRecommendations(ctx, args.Request); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetFriendRecommendations: "+err2.Error())
+		oprot.WriteMessageBegin("GetFriendRecommendations", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("GetFriendRecommendations", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type socialAPIProcessorMarkMessageRead struct {
+	handler SocialAPI
+}
+
+func (p *socialAPIProcessorMarkMessageRead) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := SocialAPIMarkMessageReadArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("MarkMessageRead", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := SocialAPIMarkMessageReadResult{}
+	var retval *social.MarkMessageReadResponse
+	if retval, err2 = p.handler.MarkMessageRead(ctx, args.Request); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing MarkMessageRead: "+err2.Error())
+		oprot.WriteMessageBegin("MarkMessageRead", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("MarkMessageRead", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type socialAPIProcessorGetUnreadMessageCount struct {
+	handler SocialAPI
+}
+
+func (p *socialAPIProcessorGetUnreadMessageCount) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := SocialAPIGetUnreadMessageCountArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("GetUnreadMessageCount", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := SocialAPIGetUnreadMessageCountResult{}
+	var retval *social.GetUnreadMessageCountResponse
+	if retval, err2 = p.handler.GetUnreadMessageCount(ctx, args.Request); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetUnreadMessageCount: "+err2.Error())
+		oprot.WriteMessageBegin("GetUnreadMessageCount", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("GetUnreadMessageCount", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type socialAPIProcessorMarkConversationsRead struct {
+	handler SocialAPI
+}
+
+func (p *socialAPIProcessorMarkConversationsRead) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := SocialAPIMarkConversationsReadArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("MarkConversationsRead", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := SocialAPIMarkConversationsReadResult{}
+	var retval *social.MarkConversationsReadResponse
+	if retval, err2 = p.handler.MarkConversationsRead(ctx, args.Request); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing MarkConversationsRead: "+err2.Error())
+		oprot.WriteMessageBegin("MarkConversationsRead", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("MarkConversationsRead", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type socialAPIProcessorGetUnreadCounts struct {
+	handler SocialAPI
+}
+
+func (p *socialAPIProcessorGetUnreadCounts) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := SocialAPIGetUnreadCountsArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("GetUnreadCounts", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := SocialAPIGetUnreadCountsResult{}
+	var retval *social.GetUnreadCountsResponse
+	if retval, err2 = p.handler.GetUnreadCounts(ctx, args.Request); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetUnreadCounts: "+err2.Error())
+		oprot.WriteMessageBegin("GetUnreadCounts", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("GetUnreadCounts", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type socialAPIProcessorGetInbox struct {
+	handler SocialAPI
+}
+
+func (p *socialAPIProcessorGetInbox) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := SocialAPIGetInboxArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("GetInbox", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := SocialAPIGetInboxResult{}
+	var retval *social.GetInboxResponse
+	if retval, err2 = p.handler.GetInbox(ctx, args.Request); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetInbox: "+err2.Error())
+		oprot.WriteMessageBegin("GetInbox", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("GetInbox", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type socialAPIProcessorUpdateConversation struct {
+	handler SocialAPI
+}
+
+func (p *socialAPIProcessorUpdateConversation) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := SocialAPIUpdateConversationArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("UpdateConversation", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := SocialAPIUpdateConversationResult{}
+	var retval *social.UpdateConversationResponse
+	if retval, err2 = p.handler.UpdateConversation(ctx, args.Request); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing UpdateConversation: "+err2.Error())
+		oprot.WriteMessageBegin("UpdateConversation", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("UpdateConversation", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type socialAPIProcessorSyncMessages struct {
+	handler SocialAPI
+}
+
+func (p *socialAPIProcessorSyncMessages) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := SocialAPISyncMessagesArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("SyncMessages", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := SocialAPISyncMessagesResult{}
+	var retval *social.SyncMessagesResponse
+	if retval, err2 = p.handler.SyncMessages(ctx, args.Request); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing SyncMessages: "+err2.Error())
+		oprot.WriteMessageBegin("SyncMessages", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("SyncMessages", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type socialAPIProcessorGetNotifications struct {
+	handler SocialAPI
+}
+
+func (p *socialAPIProcessorGetNotifications) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := SocialAPIGetNotificationsArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("GetNotifications", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := SocialAPIGetNotificationsResult{}
+	var retval *social.GetNotificationsResponse
+	if retval, err2 = p.handler.GetNotifications(ctx, args.Request); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetNotifications: "+err2.Error())
+		oprot.WriteMessageBegin("GetNotifications", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("GetNotifications", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type socialAPIProcessorGetNotificationUnreadCount struct {
+	handler SocialAPI
+}
+
+func (p *socialAPIProcessorGetNotificationUnreadCount) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := SocialAPIGetNotificationUnreadCountArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("GetNotificationUnreadCount", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := SocialAPIGetNotificationUnreadCountResult{}
+	var retval *social.GetNotificationUnreadCountResponse
+	if retval, err2 = p.handler.GetNotificationUnreadCount(ctx, args.Request); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetNotificationUnreadCount: "+err2.Error())
+		oprot.WriteMessageBegin("GetNotificationUnreadCount", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("GetNotificationUnreadCount", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type socialAPIProcessorMarkNotificationsRead struct {
+	handler SocialAPI
+}
+
+func (p *socialAPIProcessorMarkNotificationsRead) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := SocialAPIMarkNotificationsReadArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("MarkNotificationsRead", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := SocialAPIMarkNotificationsReadResult{}
+	var retval *social.MarkNotificationsReadResponse
+	if retval, err2 = p.handler.MarkNotificationsRead(ctx, args.Request); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing MarkNotificationsRead: "+err2.Error())
+		oprot.WriteMessageBegin("MarkNotificationsRead", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("MarkNotificationsRead", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type socialAPIProcessorGetNotificationPreferences struct {
+	handler SocialAPI
+}
+
+func (p *socialAPIProcessorGetNotificationPreferences) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := SocialAPIGetNotificationPreferencesArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("GetNotificationPreferences", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := SocialAPIGetNotificationPreferencesResult{}
+	var retval *social.GetNotificationPreferencesResponse
+	if retval, err2 = p.handler.GetNotificationPreferences(ctx, args.Request); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetNotificationPreferences: "+err2.Error())
+		oprot.WriteMessageBegin("GetNotificationPreferences", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("GetNotificationPreferences", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type socialAPIProcessorUpdateNotificationPreferences struct {
+	handler SocialAPI
+}
+
+func (p *socialAPIProcessorUpdateNotificationPreferences) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := SocialAPIUpdateNotificationPreferencesArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("UpdateNotificationPreferences", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := SocialAPIUpdateNotificationPreferencesResult{}
+	var retval *social.UpdateNotificationPreferencesResponse
+	if retval, err2 = p.handler.UpdateNotificationPreferences(ctx, args.Request); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing UpdateNotificationPreferences: "+err2.Error())
+		oprot.WriteMessageBegin("UpdateNotificationPreferences", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("UpdateNotificationPreferences", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type socialAPIProcessorGetPresence struct {
+	handler SocialAPI
+}
+
+func (p *socialAPIProcessorGetPresence) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := SocialAPIGetPresenceArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("GetPresence", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := SocialAPIGetPresenceResult{}
+	var retval *social.GetPresenceResponse
+	if retval, err2 = p.handler.GetPresence(ctx, args.Request); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetPresence: "+err2.Error())
+		oprot.WriteMessageBegin("GetPresence", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("GetPresence", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type socialAPIProcessorSearchMessages struct {
+	handler SocialAPI
+}
+
+func (p *socialAPIProcessorSearchMessages) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := SocialAPISearchMessagesArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("SearchMessages", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := SocialAPISearchMessagesResult{}
+	var retval *social.SearchMessagesResponse
+	if retval, err2 = p.handler.SearchMessages(ctx, args.Request); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing SearchMessages: "+err2.Error())
+		oprot.WriteMessageBegin("SearchMessages", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("SearchMessages", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type socialAPIProcessorExportChatHistory struct {
+	handler SocialAPI
+}
+
+func (p *socialAPIProcessorExportChatHistory) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := SocialAPIExportChatHistoryArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("ExportChatHistory", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := SocialAPIExportChatHistoryResult{}
+	var retval *social.ExportChatHistoryResponse
+	if retval, err2 = p.handler.ExportChatHistory(ctx, args.Request); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing ExportChatHistory: "+err2.Error())
+		oprot.WriteMessageBegin("ExportChatHistory", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("ExportChatHistory", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type SocialAPISendPrivateMessageArgs struct {
+	Request *social.SendPrivateMessageRequest `thrift:"request,1"`
+}
+
+func NewSocialAPISendPrivateMessageArgs() *SocialAPISendPrivateMessageArgs {
+	return &SocialAPISendPrivateMessageArgs{}
+}
+
+func (p *SocialAPISendPrivateMessageArgs) InitDefault() {
+}
+
+var SocialAPISendPrivateMessageArgs_Request_DEFAULT *social.SendPrivateMessageRequest
+
+func (p *SocialAPISendPrivateMessageArgs) GetRequest() (v *social.SendPrivateMessageRequest) {
+	if !p.IsSetRequest() {
+		return SocialAPISendPrivateMessageArgs_Request_DEFAULT
+	}
+	return p.Request
+}
+
+var fieldIDToName_SocialAPISendPrivateMessageArgs = map[int16]string{
+	1: "request",
+}
+
+func (p *SocialAPISendPrivateMessageArgs) IsSetRequest() bool {
+	return p.Request != nil
+}
+
+func (p *SocialAPISendPrivateMessageArgs) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_SocialAPISendPrivateMessageArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *SocialAPISendPrivateMessageArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := social.NewSendPrivateMessageRequest()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Request = _field
+	return nil
+}
+
+func (p *SocialAPISendPrivateMessageArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("SendPrivateMessage_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *SocialAPISendPrivateMessageArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("request", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Request.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *SocialAPISendPrivateMessageArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SocialAPISendPrivateMessageArgs(%+v)", *p)
+
+}
+
+type SocialAPISendPrivateMessageResult struct {
+	Success *social.SendPrivateMessageResponse `thrift:"success,0,optional"`
+}
+
+func NewSocialAPISendPrivateMessageResult() *SocialAPISendPrivateMessageResult {
+	return &SocialAPISendPrivateMessageResult{}
+}
+
+func (p *SocialAPISendPrivateMessageResult) InitDefault() {
+}
+
+var SocialAPISendPrivateMessageResult_Success_DEFAULT *social.SendPrivateMessageResponse
+
+func (p *SocialAPISendPrivateMessageResult) GetSuccess() (v *social.SendPrivateMessageResponse) {
+	if !p.IsSetSuccess() {
+		return SocialAPISendPrivateMessageResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var fieldIDToName_SocialAPISendPrivateMessageResult = map[int16]string{
+	0: "success",
+}
+
+func (p *SocialAPISendPrivateMessageResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *SocialAPISendPrivateMessageResult) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_SocialAPISendPrivateMessageResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *SocialAPISendPrivateMessageResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := social.NewSendPrivateMessageResponse()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *SocialAPISendPrivateMessageResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("SendPrivateMessage_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *SocialAPISendPrivateMessageResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *SocialAPISendPrivateMessageResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SocialAPISendPrivateMessageResult(%+v)", *p)
+
+}
+
+type SocialAPIGetPrivateMessagesArgs struct {
+	Request *social.GetPrivateMessagesRequest `thrift:"request,1"`
+}
+
+func NewSocialAPIGetPrivateMessagesArgs() *SocialAPIGetPrivateMessagesArgs {
+	return &SocialAPIGetPrivateMessagesArgs{}
+}
+
+func (p *SocialAPIGetPrivateMessagesArgs) InitDefault() {
+}
+
+var SocialAPIGetPrivateMessagesArgs_Request_DEFAULT *social.GetPrivateMessagesRequest
+
+func (p *SocialAPIGetPrivateMessagesArgs) GetRequest() (v *social.GetPrivateMessagesRequest) {
+	if !p.IsSetRequest() {
+		return SocialAPIGetPrivateMessagesArgs_Request_DEFAULT
+	}
+	return p.Request
+}
+
+var fieldIDToName_SocialAPIGetPrivateMessagesArgs = map[int16]string{
+	1: "request",
+}
+
+func (p *SocialAPIGetPrivateMessagesArgs) IsSetRequest() bool {
+	return p.Request != nil
+}
+
+func (p *SocialAPIGetPrivateMessagesArgs) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_SocialAPIGetPrivateMessagesArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *SocialAPIGetPrivateMessagesArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := social.NewGetPrivateMessagesRequest()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Request = _field
+	return nil
+}
+
+func (p *SocialAPIGetPrivateMessagesArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("GetPrivateMessages_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *SocialAPIGetPrivateMessagesArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("request", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Request.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *SocialAPIGetPrivateMessagesArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SocialAPIGetPrivateMessagesArgs(%+v)", *p)
+
+}
+
+type SocialAPIGetPrivateMessagesResult struct {
+	Success *social.GetPrivateMessagesResponse `thrift:"success,0,optional"`
+}
+
+func NewSocialAPIGetPrivateMessagesResult() *SocialAPIGetPrivateMessagesResult {
+	return &SocialAPIGetPrivateMessagesResult{}
+}
+
+func (p *SocialAPIGetPrivateMessagesResult) InitDefault() {
+}
+
+var SocialAPIGetPrivateMessagesResult_Success_DEFAULT *social.GetPrivateMessagesResponse
+
+func (p *SocialAPIGetPrivateMessagesResult) GetSuccess() (v *social.GetPrivateMessagesResponse) {
+	if !p.IsSetSuccess() {
+		return SocialAPIGetPrivateMessagesResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var fieldIDToName_SocialAPIGetPrivateMessagesResult = map[int16]string{
+	0: "success",
+}
+
+func (p *SocialAPIGetPrivateMessagesResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *SocialAPIGetPrivateMessagesResult) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_SocialAPIGetPrivateMessagesResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *SocialAPIGetPrivateMessagesResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := social.NewGetPrivateMessagesResponse()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *SocialAPIGetPrivateMessagesResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("GetPrivateMessages_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *SocialAPIGetPrivateMessagesResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *SocialAPIGetPrivateMessagesResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SocialAPIGetPrivateMessagesResult(%+v)", *p)
+
+}
+
+type SocialAPIEditPrivateMessageArgs struct {
+	Request *social.EditPrivateMessageRequest `thrift:"request,1"`
+}
+
+func NewSocialAPIEditPrivateMessageArgs() *SocialAPIEditPrivateMessageArgs {
+	return &SocialAPIEditPrivateMessageArgs{}
+}
+
+func (p *SocialAPIEditPrivateMessageArgs) InitDefault() {
+}
+
+var SocialAPIEditPrivateMessageArgs_Request_DEFAULT *social.EditPrivateMessageRequest
+
+func (p *SocialAPIEditPrivateMessageArgs) GetRequest() (v *social.EditPrivateMessageRequest) {
+	if !p.IsSetRequest() {
+		return SocialAPIEditPrivateMessageArgs_Request_DEFAULT
+	}
+	return p.Request
+}
+
+var fieldIDToName_SocialAPIEditPrivateMessageArgs = map[int16]string{
+	1: "request",
+}
+
+func (p *SocialAPIEditPrivateMessageArgs) IsSetRequest() bool {
+	return p.Request != nil
+}
+
+func (p *SocialAPIEditPrivateMessageArgs) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_SocialAPIEditPrivateMessageArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *SocialAPIEditPrivateMessageArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := social.NewEditPrivateMessageRequest()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Request = _field
+	return nil
+}
+
+func (p *SocialAPIEditPrivateMessageArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("EditPrivateMessage_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *SocialAPIEditPrivateMessageArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("request", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Request.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *SocialAPIEditPrivateMessageArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SocialAPIEditPrivateMessageArgs(%+v)", *p)
+
+}
+
+type SocialAPIEditPrivateMessageResult struct {
+	Success *social.EditPrivateMessageResponse `thrift:"success,0,optional"`
+}
+
+func NewSocialAPIEditPrivateMessageResult() *SocialAPIEditPrivateMessageResult {
+	return &SocialAPIEditPrivateMessageResult{}
+}
+
+func (p *SocialAPIEditPrivateMessageResult) InitDefault() {
+}
+
+var SocialAPIEditPrivateMessageResult_Success_DEFAULT *social.EditPrivateMessageResponse
+
+func (p *SocialAPIEditPrivateMessageResult) GetSuccess() (v *social.EditPrivateMessageResponse) {
+	if !p.IsSetSuccess() {
+		return SocialAPIEditPrivateMessageResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var fieldIDToName_SocialAPIEditPrivateMessageResult = map[int16]string{
+	0: "success",
+}
+
+func (p *SocialAPIEditPrivateMessageResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *SocialAPIEditPrivateMessageResult) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_SocialAPIEditPrivateMessageResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *SocialAPIEditPrivateMessageResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := social.NewEditPrivateMessageResponse()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *SocialAPIEditPrivateMessageResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("EditPrivateMessage_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *SocialAPIEditPrivateMessageResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *SocialAPIEditPrivateMessageResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SocialAPIEditPrivateMessageResult(%+v)", *p)
+
+}
+
+type SocialAPIRecallPrivateMessageArgs struct {
+	Request *social.RecallPrivateMessageRequest `thrift:"request,1"`
+}
+
+func NewSocialAPIRecallPrivateMessageArgs() *SocialAPIRecallPrivateMessageArgs {
+	return &SocialAPIRecallPrivateMessageArgs{}
+}
+
+func (p *SocialAPIRecallPrivateMessageArgs) InitDefault() {
+}
+
+var SocialAPIRecallPrivateMessageArgs_Request_DEFAULT *social.RecallPrivateMessageRequest
+
+func (p *SocialAPIRecallPrivateMessageArgs) GetRequest() (v *social.RecallPrivateMessageRequest) {
+	if !p.IsSetRequest() {
+		return SocialAPIRecallPrivateMessageArgs_Request_DEFAULT
+	}
+	return p.Request
+}
+
+var fieldIDToName_SocialAPIRecallPrivateMessageArgs = map[int16]string{
+	1: "request",
+}
+
+func (p *SocialAPIRecallPrivateMessageArgs) IsSetRequest() bool {
+	return p.Request != nil
+}
+
+func (p *SocialAPIRecallPrivateMessageArgs) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_SocialAPIRecallPrivateMessageArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *SocialAPIRecallPrivateMessageArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := social.NewRecallPrivateMessageRequest()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Request = _field
+	return nil
+}
+
+func (p *SocialAPIRecallPrivateMessageArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("RecallPrivateMessage_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *SocialAPIRecallPrivateMessageArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("request", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Request.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *SocialAPIRecallPrivateMessageArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SocialAPIRecallPrivateMessageArgs(%+v)", *p)
+
+}
+
+type SocialAPIRecallPrivateMessageResult struct {
+	Success *social.RecallPrivateMessageResponse `thrift:"success,0,optional"`
+}
+
+func NewSocialAPIRecallPrivateMessageResult() *SocialAPIRecallPrivateMessageResult {
+	return &SocialAPIRecallPrivateMessageResult{}
+}
+
+func (p *SocialAPIRecallPrivateMessageResult) InitDefault() {
+}
+
+var SocialAPIRecallPrivateMessageResult_Success_DEFAULT *social.RecallPrivateMessageResponse
+
+func (p *SocialAPIRecallPrivateMessageResult) GetSuccess() (v *social.RecallPrivateMessageResponse) {
+	if !p.IsSetSuccess() {
+		return SocialAPIRecallPrivateMessageResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var fieldIDToName_SocialAPIRecallPrivateMessageResult = map[int16]string{
+	0: "success",
+}
+
+func (p *SocialAPIRecallPrivateMessageResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *SocialAPIRecallPrivateMessageResult) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_SocialAPIRecallPrivateMessageResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *SocialAPIRecallPrivateMessageResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := social.NewRecallPrivateMessageResponse()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *SocialAPIRecallPrivateMessageResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("RecallPrivateMessage_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *SocialAPIRecallPrivateMessageResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *SocialAPIRecallPrivateMessageResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SocialAPIRecallPrivateMessageResult(%+v)", *p)
+
+}
+
+type SocialAPICreateChatRoomArgs struct {
+	Request *social.CreateChatRoomRequest `thrift:"request,1"`
+}
+
+func NewSocialAPICreateChatRoomArgs() *SocialAPICreateChatRoomArgs {
+	return &SocialAPICreateChatRoomArgs{}
+}
+
+func (p *SocialAPICreateChatRoomArgs) InitDefault() {
+}
+
+var SocialAPICreateChatRoomArgs_Request_DEFAULT *social.CreateChatRoomRequest
+
+func (p *SocialAPICreateChatRoomArgs) GetRequest() (v *social.CreateChatRoomRequest) {
+	if !p.IsSetRequest() {
+		return SocialAPICreateChatRoomArgs_Request_DEFAULT
+	}
+	return p.Request
+}
+
+var fieldIDToName_SocialAPICreateChatRoomArgs = map[int16]string{
+	1: "request",
+}
+
+func (p *SocialAPICreateChatRoomArgs) IsSetRequest() bool {
+	return p.Request != nil
+}
+
+func (p *SocialAPICreateChatRoomArgs) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_SocialAPICreateChatRoomArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *SocialAPICreateChatRoomArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := social.NewCreateChatRoomRequest()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Request = _field
+	return nil
+}
+
+func (p *SocialAPICreateChatRoomArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("CreateChatRoom_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *SocialAPICreateChatRoomArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("request", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Request.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *SocialAPICreateChatRoomArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SocialAPICreateChatRoomArgs(%+v)", *p)
+
+}
+
+type SocialAPICreateChatRoomResult struct {
+	Success *social.CreateChatRoomResponse `thrift:"success,0,optional"`
+}
+
+func NewSocialAPICreateChatRoomResult() *SocialAPICreateChatRoomResult {
+	return &SocialAPICreateChatRoomResult{}
+}
+
+func (p *SocialAPICreateChatRoomResult) InitDefault() {
+}
+
+var SocialAPICreateChatRoomResult_Success_DEFAULT *social.CreateChatRoomResponse
+
+func (p *SocialAPICreateChatRoomResult) GetSuccess() (v *social.CreateChatRoomResponse) {
+	if !p.IsSetSuccess() {
+		return SocialAPICreateChatRoomResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var fieldIDToName_SocialAPICreateChatRoomResult = map[int16]string{
+	0: "success",
+}
+
+func (p *SocialAPICreateChatRoomResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *SocialAPICreateChatRoomResult) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_SocialAPICreateChatRoomResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *SocialAPICreateChatRoomResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := social.NewCreateChatRoomResponse()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *SocialAPICreateChatRoomResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("CreateChatRoom_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *SocialAPICreateChatRoomResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *SocialAPICreateChatRoomResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SocialAPICreateChatRoomResult(%+v)", *p)
+
+}
+
+type SocialAPIGetChatRoomArgs struct {
+	Request *social.GetChatRoomRequest `thrift:"request,1"`
+}
+
+func NewSocialAPIGetChatRoomArgs() *SocialAPIGetChatRoomArgs {
+	return &SocialAPIGetChatRoomArgs{}
+}
+
+func (p *SocialAPIGetChatRoomArgs) InitDefault() {
+}
+
+var SocialAPIGetChatRoomArgs_Request_DEFAULT *social.GetChatRoomRequest
+
+func (p *SocialAPIGetChatRoomArgs) GetRequest() (v *social.GetChatRoomRequest) {
+	if !p.IsSetRequest() {
+		return SocialAPIGetChatRoomArgs_Request_DEFAULT
+	}
+	return p.Request
+}
+
+var fieldIDToName_SocialAPIGetChatRoomArgs = map[int16]string{
+	1: "request",
+}
+
+func (p *SocialAPIGetChatRoomArgs) IsSetRequest() bool {
+	return p.Request != nil
+}
+
+func (p *SocialAPIGetChatRoomArgs) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_SocialAPIGetChatRoomArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *SocialAPIGetChatRoomArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := social.NewGetChatRoomRequest()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Request = _field
+	return nil
+}
+
+func (p *SocialAPIGetChatRoomArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("GetChatRoom_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *SocialAPIGetChatRoomArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("request", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Request.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *SocialAPIGetChatRoomArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SocialAPIGetChatRoomArgs(%+v)", *p)
+
+}
+
+type SocialAPIGetChatRoomResult struct {
+	Success *social.GetChatRoomResponse `thrift:"success,0,optional"`
+}
+
+func NewSocialAPIGetChatRoomResult() *SocialAPIGetChatRoomResult {
+	return &SocialAPIGetChatRoomResult{}
+}
+
+func (p *SocialAPIGetChatRoomResult) InitDefault() {
+}
+
+var SocialAPIGetChatRoomResult_Success_DEFAULT *social.GetChatRoomResponse
+
+func (p *SocialAPIGetChatRoomResult) GetSuccess() (v *social.GetChatRoomResponse) {
+	if !p.IsSetSuccess() {
+		return SocialAPIGetChatRoomResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var fieldIDToName_SocialAPIGetChatRoomResult = map[int16]string{
+	0: "success",
+}
+
+func (p *SocialAPIGetChatRoomResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *SocialAPIGetChatRoomResult) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_SocialAPIGetChatRoomResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *SocialAPIGetChatRoomResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := social.NewGetChatRoomResponse()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *SocialAPIGetChatRoomResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("GetChatRoom_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *SocialAPIGetChatRoomResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *SocialAPIGetChatRoomResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SocialAPIGetChatRoomResult(%+v)", *p)
+
+}
+
+type SocialAPIGetUserChatRoomsArgs struct {
+	Request *social.GetUserChatRoomsRequest `thrift:"request,1"`
+}
+
+func NewSocialAPIGetUserChatRoomsArgs() *SocialAPIGetUserChatRoomsArgs {
+	return &SocialAPIGetUserChatRoomsArgs{}
+}
+
+func (p *SocialAPIGetUserChatRoomsArgs) InitDefault() {
+}
+
+var SocialAPIGetUserChatRoomsArgs_Request_DEFAULT *social.GetUserChatRoomsRequest
+
+func (p *SocialAPIGetUserChatRoomsArgs) GetRequest() (v *social.GetUserChatRoomsRequest) {
+	if !p.IsSetRequest() {
+		return SocialAPIGetUserChatRoomsArgs_Request_DEFAULT
+	}
+	return p.Request
+}
+
+var fieldIDToName_SocialAPIGetUserChatRoomsArgs = map[int16]string{
+	1: "request",
+}
+
+func (p *SocialAPIGetUserChatRoomsArgs) IsSetRequest() bool {
+	return p.Request != nil
+}
+
+func (p *SocialAPIGetUserChatRoomsArgs) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_SocialAPIGetUserChatRoomsArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *SocialAPIGetUserChatRoomsArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := social.NewGetUserChatRoomsRequest()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Request = _field
+	return nil
+}
+
+func (p *SocialAPIGetUserChatRoomsArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("GetUserChatRooms_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *SocialAPIGetUserChatRoomsArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("request", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Request.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *SocialAPIGetUserChatRoomsArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SocialAPIGetUserChatRoomsArgs(%+v)", *p)
+
+}
+
+type SocialAPIGetUserChatRoomsResult struct {
+	Success *social.GetUserChatRoomsResponse `thrift:"success,0,optional"`
+}
+
+func NewSocialAPIGetUserChatRoomsResult() *SocialAPIGetUserChatRoomsResult {
+	return &SocialAPIGetUserChatRoomsResult{}
+}
+
+func (p *SocialAPIGetUserChatRoomsResult) InitDefault() {
+}
+
+var SocialAPIGetUserChatRoomsResult_Success_DEFAULT *social.GetUserChatRoomsResponse
+
+func (p *SocialAPIGetUserChatRoomsResult) GetSuccess() (v *social.GetUserChatRoomsResponse) {
+	if !p.IsSetSuccess() {
+		return SocialAPIGetUserChatRoomsResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var fieldIDToName_SocialAPIGetUserChatRoomsResult = map[int16]string{
+	0: "success",
+}
+
+func (p *SocialAPIGetUserChatRoomsResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *SocialAPIGetUserChatRoomsResult) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_SocialAPIGetUserChatRoomsResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *SocialAPIGetUserChatRoomsResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := social.NewGetUserChatRoomsResponse()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *SocialAPIGetUserChatRoomsResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("GetUserChatRooms_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *SocialAPIGetUserChatRoomsResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *SocialAPIGetUserChatRoomsResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SocialAPIGetUserChatRoomsResult(%+v)", *p)
+
+}
+
+type SocialAPISendChatMessageArgs struct {
+	Request *social.SendChatMessageRequest `thrift:"request,1"`
+}
+
+func NewSocialAPISendChatMessageArgs() *SocialAPISendChatMessageArgs {
+	return &SocialAPISendChatMessageArgs{}
+}
+
+func (p *SocialAPISendChatMessageArgs) InitDefault() {
+}
+
+var SocialAPISendChatMessageArgs_Request_DEFAULT *social.SendChatMessageRequest
+
+func (p *SocialAPISendChatMessageArgs) GetRequest() (v *social.SendChatMessageRequest) {
+	if !p.IsSetRequest() {
+		return SocialAPISendChatMessageArgs_Request_DEFAULT
+	}
+	return p.Request
+}
+
+var fieldIDToName_SocialAPISendChatMessageArgs = map[int16]string{
+	1: "request",
+}
+
+func (p *SocialAPISendChatMessageArgs) IsSetRequest() bool {
+	return p.Request != nil
+}
+
+func (p *SocialAPISendChatMessageArgs) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_SocialAPISendChatMessageArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *SocialAPISendChatMessageArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := social.NewSendChatMessageRequest()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Request = _field
+	return nil
+}
+
+func (p *SocialAPISendChatMessageArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("SendChatMessage_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *SocialAPISendChatMessageArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("request", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Request.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *SocialAPISendChatMessageArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SocialAPISendChatMessageArgs(%+v)", *p)
+
+}
+
+type SocialAPISendChatMessageResult struct {
+	Success *social.SendChatMessageResponse `thrift:"success,0,optional"`
+}
+
+func NewSocialAPISendChatMessageResult() *SocialAPISendChatMessageResult {
+	return &SocialAPISendChatMessageResult{}
+}
+
+func (p *SocialAPISendChatMessageResult) InitDefault() {
+}
+
+var SocialAPISendChatMessageResult_Success_DEFAULT *social.SendChatMessageResponse
+
+func (p *SocialAPISendChatMessageResult) GetSuccess() (v *social.SendChatMessageResponse) {
+	if !p.IsSetSuccess() {
+		return SocialAPISendChatMessageResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var fieldIDToName_SocialAPISendChatMessageResult = map[int16]string{
+	0: "success",
+}
+
+func (p *SocialAPISendChatMessageResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *SocialAPISendChatMessageResult) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_SocialAPISendChatMessageResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *SocialAPISendChatMessageResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := social.NewSendChatMessageResponse()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *SocialAPISendChatMessageResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("SendChatMessage_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *SocialAPISendChatMessageResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *SocialAPISendChatMessageResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SocialAPISendChatMessageResult(%+v)", *p)
+
+}
+
+type SocialAPIGetChatMessagesArgs struct {
+	Request *social.GetChatMessagesRequest `thrift:"request,1"`
+}
+
+func NewSocialAPIGetChatMessagesArgs() *SocialAPIGetChatMessagesArgs {
+	return &SocialAPIGetChatMessagesArgs{}
+}
+
+func (p *SocialAPIGetChatMessagesArgs) InitDefault() {
+}
+
+var SocialAPIGetChatMessagesArgs_Request_DEFAULT *social.GetChatMessagesRequest
+
+func (p *SocialAPIGetChatMessagesArgs) GetRequest() (v *social.GetChatMessagesRequest) {
+	if !p.IsSetRequest() {
+		return SocialAPIGetChatMessagesArgs_Request_DEFAULT
+	}
+	return p.Request
+}
+
+var fieldIDToName_SocialAPIGetChatMessagesArgs = map[int16]string{
+	1: "request",
+}
+
+func (p *SocialAPIGetChatMessagesArgs) IsSetRequest() bool {
+	return p.Request != nil
+}
+
+func (p *SocialAPIGetChatMessagesArgs) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_SocialAPIGetChatMessagesArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *SocialAPIGetChatMessagesArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := social.NewGetChatMessagesRequest()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Request = _field
+	return nil
+}
+
+func (p *SocialAPIGetChatMessagesArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("GetChatMessages_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *SocialAPIGetChatMessagesArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("request", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Request.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *SocialAPIGetChatMessagesArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SocialAPIGetChatMessagesArgs(%+v)", *p)
+
+}
+
+type SocialAPIGetChatMessagesResult struct {
+	Success *social.GetChatMessagesResponse `thrift:"success,0,optional"`
+}
+
+func NewSocialAPIGetChatMessagesResult() *SocialAPIGetChatMessagesResult {
+	return &SocialAPIGetChatMessagesResult{}
+}
+
+func (p *SocialAPIGetChatMessagesResult) InitDefault() {
+}
+
+var SocialAPIGetChatMessagesResult_Success_DEFAULT *social.GetChatMessagesResponse
+
+func (p *SocialAPIGetChatMessagesResult) GetSuccess() (v *social.GetChatMessagesResponse) {
+	if !p.IsSetSuccess() {
+		return SocialAPIGetChatMessagesResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var fieldIDToName_SocialAPIGetChatMessagesResult = map[int16]string{
+	0: "success",
+}
+
+func (p *SocialAPIGetChatMessagesResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *SocialAPIGetChatMessagesResult) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_SocialAPIGetChatMessagesResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *SocialAPIGetChatMessagesResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := social.NewGetChatMessagesResponse()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *SocialAPIGetChatMessagesResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("GetChatMessages_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *SocialAPIGetChatMessagesResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *SocialAPIGetChatMessagesResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SocialAPIGetChatMessagesResult(%+v)", *p)
+
+}
+
+type SocialAPIUploadChatAttachmentArgs struct {
+	Request *social.UploadChatAttachmentRequest `thrift:"request,1"`
+}
+
+func NewSocialAPIUploadChatAttachmentArgs() *SocialAPIUploadChatAttachmentArgs {
+	return &SocialAPIUploadChatAttachmentArgs{}
+}
+
+func (p *SocialAPIUploadChatAttachmentArgs) InitDefault() {
+}
+
+var SocialAPIUploadChatAttachmentArgs_Request_DEFAULT *social.UploadChatAttachmentRequest
+
+func (p *SocialAPIUploadChatAttachmentArgs) GetRequest() (v *social.UploadChatAttachmentRequest) {
+	if !p.IsSetRequest() {
+		return SocialAPIUploadChatAttachmentArgs_Request_DEFAULT
+	}
+	return p.Request
+}
+
+var fieldIDToName_SocialAPIUploadChatAttachmentArgs = map[int16]string{
+	1: "request",
+}
+
+func (p *SocialAPIUploadChatAttachmentArgs) IsSetRequest() bool {
+	return p.Request != nil
+}
+
+func (p *SocialAPIUploadChatAttachmentArgs) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_SocialAPIUploadChatAttachmentArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *SocialAPIUploadChatAttachmentArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := social.NewUploadChatAttachmentRequest()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Request = _field
+	return nil
+}
+
+func (p *SocialAPIUploadChatAttachmentArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("UploadChatAttachment_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *SocialAPIUploadChatAttachmentArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("request", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Request.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *SocialAPIUploadChatAttachmentArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SocialAPIUploadChatAttachmentArgs(%+v)", *p)
+
+}
+
+type SocialAPIUploadChatAttachmentResult struct {
+	Success *social.UploadChatAttachmentResponse `thrift:"success,0,optional"`
+}
+
+func NewSocialAPIUploadChatAttachmentResult() *SocialAPIUploadChatAttachmentResult {
+	return &SocialAPIUploadChatAttachmentResult{}
+}
+
+func (p *SocialAPIUploadChatAttachmentResult) InitDefault() {
+}
+
+var SocialAPIUploadChatAttachmentResult_Success_DEFAULT *social.UploadChatAttachmentResponse
+
+func (p *SocialAPIUploadChatAttachmentResult) GetSuccess() (v *social.UploadChatAttachmentResponse) {
+	if !p.IsSetSuccess() {
+		return SocialAPIUploadChatAttachmentResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var fieldIDToName_SocialAPIUploadChatAttachmentResult = map[int16]string{
+	0: "success",
+}
+
+func (p *SocialAPIUploadChatAttachmentResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *SocialAPIUploadChatAttachmentResult) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_SocialAPIUploadChatAttachmentResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *SocialAPIUploadChatAttachmentResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := social.NewUploadChatAttachmentResponse()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *SocialAPIUploadChatAttachmentResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("UploadChatAttachment_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *SocialAPIUploadChatAttachmentResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *SocialAPIUploadChatAttachmentResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SocialAPIUploadChatAttachmentResult(%+v)", *p)
+
+}
+
+type SocialAPIEditChatMessageArgs struct {
+	Request *social.EditChatMessageRequest `thrift:"request,1"`
+}
+
+func NewSocialAPIEditChatMessageArgs() *SocialAPIEditChatMessageArgs {
+	return &SocialAPIEditChatMessageArgs{}
+}
+
+func (p *SocialAPIEditChatMessageArgs) InitDefault() {
+}
+
+var SocialAPIEditChatMessageArgs_Request_DEFAULT *social.EditChatMessageRequest
+
+func (p *SocialAPIEditChatMessageArgs) GetRequest() (v *social.EditChatMessageRequest) {
+	if !p.IsSetRequest() {
+		return SocialAPIEditChatMessageArgs_Request_DEFAULT
+	}
+	return p.Request
+}
+
+var fieldIDToName_SocialAPIEditChatMessageArgs = map[int16]string{
+	1: "request",
+}
+
+func (p *SocialAPIEditChatMessageArgs) IsSetRequest() bool {
+	return p.Request != nil
+}
+
+func (p *SocialAPIEditChatMessageArgs) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_SocialAPIEditChatMessageArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *SocialAPIEditChatMessageArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := social.NewEditChatMessageRequest()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Request = _field
+	return nil
+}
+
+func (p *SocialAPIEditChatMessageArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("EditChatMessage_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *SocialAPIEditChatMessageArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("request", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Request.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *SocialAPIEditChatMessageArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SocialAPIEditChatMessageArgs(%+v)", *p)
+
+}
+
+type SocialAPIEditChatMessageResult struct {
+	Success *social.EditChatMessageResponse `thrift:"success,0,optional"`
+}
+
+func NewSocialAPIEditChatMessageResult() *SocialAPIEditChatMessageResult {
+	return &SocialAPIEditChatMessageResult{}
+}
+
+func (p *SocialAPIEditChatMessageResult) InitDefault() {
+}
+
+var SocialAPIEditChatMessageResult_Success_DEFAULT *social.EditChatMessageResponse
+
+func (p *SocialAPIEditChatMessageResult) GetSuccess() (v *social.EditChatMessageResponse) {
+	if !p.IsSetSuccess() {
+		return SocialAPIEditChatMessageResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var fieldIDToName_SocialAPIEditChatMessageResult = map[int16]string{
+	0: "success",
+}
+
+func (p *SocialAPIEditChatMessageResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *SocialAPIEditChatMessageResult) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_SocialAPIEditChatMessageResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *SocialAPIEditChatMessageResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := social.NewEditChatMessageResponse()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *SocialAPIEditChatMessageResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("EditChatMessage_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *SocialAPIEditChatMessageResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *SocialAPIEditChatMessageResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SocialAPIEditChatMessageResult(%+v)", *p)
+
+}
+
+type SocialAPIRecallChatMessageArgs struct {
+	Request *social.RecallChatMessageRequest `thrift:"request,1"`
+}
+
+func NewSocialAPIRecallChatMessageArgs() *SocialAPIRecallChatMessageArgs {
+	return &SocialAPIRecallChatMessageArgs{}
+}
+
+func (p *SocialAPIRecallChatMessageArgs) InitDefault() {
+}
+
+var SocialAPIRecallChatMessageArgs_Request_DEFAULT *social.RecallChatMessageRequest
+
+func (p *SocialAPIRecallChatMessageArgs) GetRequest() (v *social.RecallChatMessageRequest) {
+	if !p.IsSetRequest() {
+		return SocialAPIRecallChatMessageArgs_Request_DEFAULT
+	}
+	return p.Request
+}
+
+var fieldIDToName_SocialAPIRecallChatMessageArgs = map[int16]string{
+	1: "request",
+}
+
+func (p *SocialAPIRecallChatMessageArgs) IsSetRequest() bool {
+	return p.Request != nil
+}
+
+func (p *SocialAPIRecallChatMessageArgs) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_SocialAPIRecallChatMessageArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *SocialAPIRecallChatMessageArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := social.NewRecallChatMessageRequest()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Request = _field
+	return nil
+}
+
+func (p *SocialAPIRecallChatMessageArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("RecallChatMessage_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *SocialAPIRecallChatMessageArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("request", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Request.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *SocialAPIRecallChatMessageArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SocialAPIRecallChatMessageArgs(%+v)", *p)
+
+}
+
+type SocialAPIRecallChatMessageResult struct {
+	Success *social.RecallChatMessageResponse `thrift:"success,0,optional"`
+}
+
+func NewSocialAPIRecallChatMessageResult() *SocialAPIRecallChatMessageResult {
+	return &SocialAPIRecallChatMessageResult{}
+}
+
+func (p *SocialAPIRecallChatMessageResult) InitDefault() {
+}
+
+var SocialAPIRecallChatMessageResult_Success_DEFAULT *social.RecallChatMessageResponse
+
+func (p *SocialAPIRecallChatMessageResult) GetSuccess() (v *social.RecallChatMessageResponse) {
+	if !p.IsSetSuccess() {
+		return SocialAPIRecallChatMessageResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var fieldIDToName_SocialAPIRecallChatMessageResult = map[int16]string{
+	0: "success",
+}
+
+func (p *SocialAPIRecallChatMessageResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *SocialAPIRecallChatMessageResult) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_SocialAPIRecallChatMessageResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *SocialAPIRecallChatMessageResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := social.NewRecallChatMessageResponse()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *SocialAPIRecallChatMessageResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("RecallChatMessage_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *SocialAPIRecallChatMessageResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *SocialAPIRecallChatMessageResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SocialAPIRecallChatMessageResult(%+v)", *p)
+
+}
+
+type SocialAPIShareVideoArgs struct {
+	Request *social.ShareVideoRequest `thrift:"request,1"`
+}
+
+func NewSocialAPIShareVideoArgs() *SocialAPIShareVideoArgs {
+	return &SocialAPIShareVideoArgs{}
+}
+
+func (p *SocialAPIShareVideoArgs) InitDefault() {
+}
+
+var SocialAPIShareVideoArgs_Request_DEFAULT *social.ShareVideoRequest
+
+func (p *SocialAPIShareVideoArgs) GetRequest() (v *social.ShareVideoRequest) {
+	if !p.IsSetRequest() {
+		return SocialAPIShareVideoArgs_Request_DEFAULT
+	}
+	return p.Request
+}
+
+var fieldIDToName_SocialAPIShareVideoArgs = map[int16]string{
+	1: "request",
+}
+
+func (p *SocialAPIShareVideoArgs) IsSetRequest() bool {
+	return p.Request != nil
+}
+
+func (p *SocialAPIShareVideoArgs) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_SocialAPIShareVideoArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *SocialAPIShareVideoArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := social.NewShareVideoRequest()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Request = _field
+	return nil
+}
+
+func (p *SocialAPIShareVideoArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ShareVideo_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *SocialAPIShareVideoArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("request", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Request.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *SocialAPIShareVideoArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SocialAPIShareVideoArgs(%+v)", *p)
+
+}
+
+type SocialAPIShareVideoResult struct {
+	Success *social.SendChatMessageResponse `thrift:"success,0,optional"`
+}
+
+func NewSocialAPIShareVideoResult() *SocialAPIShareVideoResult {
+	return &SocialAPIShareVideoResult{}
+}
+
+func (p *SocialAPIShareVideoResult) InitDefault() {
+}
+
+var SocialAPIShareVideoResult_Success_DEFAULT *social.SendChatMessageResponse
+
+func (p *SocialAPIShareVideoResult) GetSuccess() (v *social.SendChatMessageResponse) {
+	if !p.IsSetSuccess() {
+		return SocialAPIShareVideoResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var fieldIDToName_SocialAPIShareVideoResult = map[int16]string{
+	0: "success",
+}
+
+func (p *SocialAPIShareVideoResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *SocialAPIShareVideoResult) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_SocialAPIShareVideoResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *SocialAPIShareVideoResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := social.NewSendChatMessageResponse()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *SocialAPIShareVideoResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ShareVideo_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *SocialAPIShareVideoResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *SocialAPIShareVideoResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SocialAPIShareVideoResult(%+v)", *p)
+
+}
+
+type SocialAPIAddChatRoomMembersArgs struct {
+	Request *social.AddChatRoomMembersRequest `thrift:"request,1"`
+}
+
+func NewSocialAPIAddChatRoomMembersArgs() *SocialAPIAddChatRoomMembersArgs {
+	return &SocialAPIAddChatRoomMembersArgs{}
+}
+
+func (p *SocialAPIAddChatRoomMembersArgs) InitDefault() {
+}
+
+var SocialAPIAddChatRoomMembersArgs_Request_DEFAULT *social.AddChatRoomMembersRequest
+
+func (p *SocialAPIAddChatRoomMembersArgs) GetRequest() (v *social.AddChatRoomMembersRequest) {
+	if !p.IsSetRequest() {
+		return SocialAPIAddChatRoomMembersArgs_Request_DEFAULT
+	}
+	return p.Request
+}
+
+var fieldIDToName_SocialAPIAddChatRoomMembersArgs = map[int16]string{
+	1: "request",
+}
+
+func (p *SocialAPIAddChatRoomMembersArgs) IsSetRequest() bool {
+	return p.Request != nil
+}
+
+func (p *SocialAPIAddChatRoomMembersArgs) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_SocialAPIAddChatRoomMembersArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *SocialAPIAddChatRoomMembersArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := social.NewAddChatRoomMembersRequest()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Request = _field
+	return nil
+}
+
+func (p *SocialAPIAddChatRoomMembersArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("AddChatRoomMembers_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *SocialAPIAddChatRoomMembersArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("request", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Request.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *SocialAPIAddChatRoomMembersArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SocialAPIAddChatRoomMembersArgs(%+v)", *p)
+
+}
+
+type SocialAPIAddChatRoomMembersResult struct {
+	Success *social.AddChatRoomMembersResponse `thrift:"success,0,optional"`
+}
+
+func NewSocialAPIAddChatRoomMembersResult() *SocialAPIAddChatRoomMembersResult {
+	return &SocialAPIAddChatRoomMembersResult{}
+}
+
+func (p *SocialAPIAddChatRoomMembersResult) InitDefault() {
+}
+
+var SocialAPIAddChatRoomMembersResult_Success_DEFAULT *social.AddChatRoomMembersResponse
+
+func (p *SocialAPIAddChatRoomMembersResult) GetSuccess() (v *social.AddChatRoomMembersResponse) {
+	if !p.IsSetSuccess() {
+		return SocialAPIAddChatRoomMembersResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var fieldIDToName_SocialAPIAddChatRoomMembersResult = map[int16]string{
+	0: "success",
+}
+
+func (p *SocialAPIAddChatRoomMembersResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *SocialAPIAddChatRoomMembersResult) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_SocialAPIAddChatRoomMembersResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *SocialAPIAddChatRoomMembersResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := social.NewAddChatRoomMembersResponse()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *SocialAPIAddChatRoomMembersResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("AddChatRoomMembers_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *SocialAPIAddChatRoomMembersResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *SocialAPIAddChatRoomMembersResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SocialAPIAddChatRoomMembersResult(%+v)", *p)
+
+}
+
+type SocialAPIRemoveChatRoomMemberArgs struct {
+	Request *social.RemoveChatRoomMemberRequest `thrift:"request,1"`
+}
+
+func NewSocialAPIRemoveChatRoomMemberArgs() *SocialAPIRemoveChatRoomMemberArgs {
+	return &SocialAPIRemoveChatRoomMemberArgs{}
+}
+
+func (p *SocialAPIRemoveChatRoomMemberArgs) InitDefault() {
+}
+
+var SocialAPIRemoveChatRoomMemberArgs_Request_DEFAULT *social.RemoveChatRoomMemberRequest
+
+func (p *SocialAPIRemoveChatRoomMemberArgs) GetRequest() (v *social.RemoveChatRoomMemberRequest) {
+	if !p.IsSetRequest() {
+		return SocialAPIRemoveChatRoomMemberArgs_Request_DEFAULT
+	}
+	return p.Request
+}
+
+var fieldIDToName_SocialAPIRemoveChatRoomMemberArgs = map[int16]string{
+	1: "request",
+}
+
+func (p *SocialAPIRemoveChatRoomMemberArgs) IsSetRequest() bool {
+	return p.Request != nil
+}
+
+func (p *SocialAPIRemoveChatRoomMemberArgs) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_SocialAPIRemoveChatRoomMemberArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *SocialAPIRemoveChatRoomMemberArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := social.NewRemoveChatRoomMemberRequest()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Request = _field
+	return nil
+}
+
+func (p *SocialAPIRemoveChatRoomMemberArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("RemoveChatRoomMember_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *SocialAPIRemoveChatRoomMemberArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("request", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Request.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *SocialAPIRemoveChatRoomMemberArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SocialAPIRemoveChatRoomMemberArgs(%+v)", *p)
+
+}
+
+type SocialAPIRemoveChatRoomMemberResult struct {
+	Success *social.RemoveChatRoomMemberResponse `thrift:"success,0,optional"`
+}
+
+func NewSocialAPIRemoveChatRoomMemberResult() *SocialAPIRemoveChatRoomMemberResult {
+	return &SocialAPIRemoveChatRoomMemberResult{}
+}
+
+func (p *SocialAPIRemoveChatRoomMemberResult) InitDefault() {
+}
+
+var SocialAPIRemoveChatRoomMemberResult_Success_DEFAULT *social.RemoveChatRoomMemberResponse
+
+func (p *SocialAPIRemoveChatRoomMemberResult) GetSuccess() (v *social.RemoveChatRoomMemberResponse) {
+	if !p.IsSetSuccess() {
+		return SocialAPIRemoveChatRoomMemberResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var fieldIDToName_SocialAPIRemoveChatRoomMemberResult = map[int16]string{
+	0: "success",
+}
+
+func (p *SocialAPIRemoveChatRoomMemberResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *SocialAPIRemoveChatRoomMemberResult) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_SocialAPIRemoveChatRoomMemberResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *SocialAPIRemoveChatRoomMemberResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := social.NewRemoveChatRoomMemberResponse()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *SocialAPIRemoveChatRoomMemberResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("RemoveChatRoomMember_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *SocialAPIRemoveChatRoomMemberResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *SocialAPIRemoveChatRoomMemberResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SocialAPIRemoveChatRoomMemberResult(%+v)", *p)
+
+}
+
+type SocialAPILeaveChatRoomArgs struct {
+	Request *social.LeaveChatRoomRequest `thrift:"request,1"`
+}
+
+func NewSocialAPILeaveChatRoomArgs() *SocialAPILeaveChatRoomArgs {
+	return &SocialAPILeaveChatRoomArgs{}
+}
+
+func (p *SocialAPILeaveChatRoomArgs) InitDefault() {
+}
+
+var SocialAPILeaveChatRoomArgs_Request_DEFAULT *social.LeaveChatRoomRequest
+
+func (p *SocialAPILeaveChatRoomArgs) GetRequest() (v *social.LeaveChatRoomRequest) {
+	if !p.IsSetRequest() {
+		return SocialAPILeaveChatRoomArgs_Request_DEFAULT
+	}
+	return p.Request
+}
+
+var fieldIDToName_SocialAPILeaveChatRoomArgs = map[int16]string{
+	1: "request",
+}
+
+func (p *SocialAPILeaveChatRoomArgs) IsSetRequest() bool {
+	return p.Request != nil
+}
+
+func (p *SocialAPILeaveChatRoomArgs) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_SocialAPILeaveChatRoomArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *SocialAPILeaveChatRoomArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := social.NewLeaveChatRoomRequest()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Request = _field
+	return nil
+}
+
+func (p *SocialAPILeaveChatRoomArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("LeaveChatRoom_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *SocialAPILeaveChatRoomArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("request", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Request.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *SocialAPILeaveChatRoomArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SocialAPILeaveChatRoomArgs(%+v)", *p)
+
+}
+
+type SocialAPILeaveChatRoomResult struct {
+	Success *social.LeaveChatRoomResponse `thrift:"success,0,optional"`
+}
+
+func NewSocialAPILeaveChatRoomResult() *SocialAPILeaveChatRoomResult {
+	return &SocialAPILeaveChatRoomResult{}
+}
+
+func (p *SocialAPILeaveChatRoomResult) InitDefault() {
+}
+
+var SocialAPILeaveChatRoomResult_Success_DEFAULT *social.LeaveChatRoomResponse
+
+func (p *SocialAPILeaveChatRoomResult) GetSuccess() (v *social.LeaveChatRoomResponse) {
+	if !p.IsSetSuccess() {
+		return SocialAPILeaveChatRoomResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var fieldIDToName_SocialAPILeaveChatRoomResult = map[int16]string{
+	0: "success",
+}
+
+func (p *SocialAPILeaveChatRoomResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *SocialAPILeaveChatRoomResult) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_SocialAPILeaveChatRoomResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *SocialAPILeaveChatRoomResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := social.NewLeaveChatRoomResponse()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *SocialAPILeaveChatRoomResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("LeaveChatRoom_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *SocialAPILeaveChatRoomResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *SocialAPILeaveChatRoomResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SocialAPILeaveChatRoomResult(%+v)", *p)
+
+}
+
+type SocialAPISetChatRoomAdminArgs struct {
+	Request *social.SetChatRoomAdminRequest `thrift:"request,1"`
+}
+
+func NewSocialAPISetChatRoomAdminArgs() *SocialAPISetChatRoomAdminArgs {
+	return &SocialAPISetChatRoomAdminArgs{}
+}
+
+func (p *SocialAPISetChatRoomAdminArgs) InitDefault() {
+}
+
+var SocialAPISetChatRoomAdminArgs_Request_DEFAULT *social.SetChatRoomAdminRequest
+
+func (p *SocialAPISetChatRoomAdminArgs) GetRequest() (v *social.SetChatRoomAdminRequest) {
+	if !p.IsSetRequest() {
+		return SocialAPISetChatRoomAdminArgs_Request_DEFAULT
+	}
+	return p.Request
+}
+
+var fieldIDToName_SocialAPISetChatRoomAdminArgs = map[int16]string{
+	1: "request",
+}
+
+func (p *SocialAPISetChatRoomAdminArgs) IsSetRequest() bool {
+	return p.Request != nil
+}
+
+func (p *SocialAPISetChatRoomAdminArgs) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_SocialAPISetChatRoomAdminArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *SocialAPISetChatRoomAdminArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := social.NewSetChatRoomAdminRequest()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Request = _field
+	return nil
+}
+
+func (p *SocialAPISetChatRoomAdminArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("SetChatRoomAdmin_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *SocialAPISetChatRoomAdminArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("request", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Request.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *SocialAPISetChatRoomAdminArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SocialAPISetChatRoomAdminArgs(%+v)", *p)
+
+}
+
+type SocialAPISetChatRoomAdminResult struct {
+	Success *social.SetChatRoomAdminResponse `thrift:"success,0,optional"`
+}
+
+func NewSocialAPISetChatRoomAdminResult() *SocialAPISetChatRoomAdminResult {
+	return &SocialAPISetChatRoomAdminResult{}
+}
+
+func (p *SocialAPISetChatRoomAdminResult) InitDefault() {
+}
+
+var SocialAPISetChatRoomAdminResult_Success_DEFAULT *social.SetChatRoomAdminResponse
+
+func (p *SocialAPISetChatRoomAdminResult) GetSuccess() (v *social.SetChatRoomAdminResponse) {
+	if !p.IsSetSuccess() {
+		return SocialAPISetChatRoomAdminResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var fieldIDToName_SocialAPISetChatRoomAdminResult = map[int16]string{
+	0: "success",
+}
+
+func (p *SocialAPISetChatRoomAdminResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *SocialAPISetChatRoomAdminResult) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_SocialAPISetChatRoomAdminResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *SocialAPISetChatRoomAdminResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := social.NewSetChatRoomAdminResponse()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *SocialAPISetChatRoomAdminResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("SetChatRoomAdmin_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *SocialAPISetChatRoomAdminResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *SocialAPISetChatRoomAdminResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SocialAPISetChatRoomAdminResult(%+v)", *p)
+
+}
+
+type SocialAPITransferChatRoomArgs struct {
+	Request *social.TransferChatRoomRequest `thrift:"request,1"`
+}
+
+func NewSocialAPITransferChatRoomArgs() *SocialAPITransferChatRoomArgs {
+	return &SocialAPITransferChatRoomArgs{}
+}
+
+func (p *SocialAPITransferChatRoomArgs) InitDefault() {
+}
+
+var SocialAPITransferChatRoomArgs_Request_DEFAULT *social.TransferChatRoomRequest
+
+func (p *SocialAPITransferChatRoomArgs) GetRequest() (v *social.TransferChatRoomRequest) {
+	if !p.IsSetRequest() {
+		return SocialAPITransferChatRoomArgs_Request_DEFAULT
+	}
+	return p.Request
+}
+
+var fieldIDToName_SocialAPITransferChatRoomArgs = map[int16]string{
+	1: "request",
+}
+
+func (p *SocialAPITransferChatRoomArgs) IsSetRequest() bool {
+	return p.Request != nil
+}
+
+func (p *SocialAPITransferChatRoomArgs) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_SocialAPITransferChatRoomArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *SocialAPITransferChatRoomArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := social.NewTransferChatRoomRequest()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Request = _field
+	return nil
+}
+
+func (p *SocialAPITransferChatRoomArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("TransferChatRoom_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *SocialAPITransferChatRoomArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("request", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Request.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *SocialAPITransferChatRoomArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SocialAPITransferChatRoomArgs(%+v)", *p)
+
+}
+
+type SocialAPITransferChatRoomResult struct {
+	Success *social.TransferChatRoomResponse `thrift:"success,0,optional"`
+}
+
+func NewSocialAPITransferChatRoomResult() *SocialAPITransferChatRoomResult {
+	return &SocialAPITransferChatRoomResult{}
+}
+
+func (p *SocialAPITransferChatRoomResult) InitDefault() {
+}
+
+var SocialAPITransferChatRoomResult_Success_DEFAULT *social.TransferChatRoomResponse
+
+func (p *SocialAPITransferChatRoomResult) GetSuccess() (v *social.TransferChatRoomResponse) {
+	if !p.IsSetSuccess() {
+		return SocialAPITransferChatRoomResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var fieldIDToName_SocialAPITransferChatRoomResult = map[int16]string{
+	0: "success",
+}
+
+func (p *SocialAPITransferChatRoomResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *SocialAPITransferChatRoomResult) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_SocialAPITransferChatRoomResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *SocialAPITransferChatRoomResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := social.NewTransferChatRoomResponse()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *SocialAPITransferChatRoomResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("TransferChatRoom_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *SocialAPITransferChatRoomResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *SocialAPITransferChatRoomResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SocialAPITransferChatRoomResult(%+v)", *p)
+
+}
+
+type SocialAPIMuteChatRoomMemberArgs struct {
+	Request *social.MuteChatRoomMemberRequest `thrift:"request,1"`
+}
+
+func NewSocialAPIMuteChatRoomMemberArgs() *SocialAPIMuteChatRoomMemberArgs {
+	return &SocialAPIMuteChatRoomMemberArgs{}
+}
+
+func (p *SocialAPIMuteChatRoomMemberArgs) InitDefault() {
+}
+
+var SocialAPIMuteChatRoomMemberArgs_Request_DEFAULT *social.MuteChatRoomMemberRequest
+
+func (p *SocialAPIMuteChatRoomMemberArgs) GetRequest() (v *social.MuteChatRoomMemberRequest) {
+	if !p.IsSetRequest() {
+		return SocialAPIMuteChatRoomMemberArgs_Request_DEFAULT
+	}
+	return p.Request
+}
+
+var fieldIDToName_SocialAPIMuteChatRoomMemberArgs = map[int16]string{
+	1: "request",
+}
+
+func (p *SocialAPIMuteChatRoomMemberArgs) IsSetRequest() bool {
+	return p.Request != nil
+}
+
+func (p *SocialAPIMuteChatRoomMemberArgs) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_SocialAPIMuteChatRoomMemberArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *SocialAPIMuteChatRoomMemberArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := social.NewMuteChatRoomMemberRequest()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Request = _field
+	return nil
+}
+
+func (p *SocialAPIMuteChatRoomMemberArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("MuteChatRoomMember_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *SocialAPIMuteChatRoomMemberArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("request", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Request.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *SocialAPIMuteChatRoomMemberArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SocialAPIMuteChatRoomMemberArgs(%+v)", *p)
+
+}
+
+type SocialAPIMuteChatRoomMemberResult struct {
+	Success *social.MuteChatRoomMemberResponse `thrift:"success,0,optional"`
+}
+
+func NewSocialAPIMuteChatRoomMemberResult() *SocialAPIMuteChatRoomMemberResult {
+	return &SocialAPIMuteChatRoomMemberResult{}
+}
+
+func (p *SocialAPIMuteChatRoomMemberResult) InitDefault() {
+}
+
+var SocialAPIMuteChatRoomMemberResult_Success_DEFAULT *social.MuteChatRoomMemberResponse
+
+func (p *SocialAPIMuteChatRoomMemberResult) GetSuccess() (v *social.MuteChatRoomMemberResponse) {
+	if !p.IsSetSuccess() {
+		return SocialAPIMuteChatRoomMemberResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var fieldIDToName_SocialAPIMuteChatRoomMemberResult = map[int16]string{
+	0: "success",
+}
+
+func (p *SocialAPIMuteChatRoomMemberResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *SocialAPIMuteChatRoomMemberResult) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_SocialAPIMuteChatRoomMemberResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *SocialAPIMuteChatRoomMemberResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := social.NewMuteChatRoomMemberResponse()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *SocialAPIMuteChatRoomMemberResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("MuteChatRoomMember_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *SocialAPIMuteChatRoomMemberResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *SocialAPIMuteChatRoomMemberResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SocialAPIMuteChatRoomMemberResult(%+v)", *p)
+
+}
+
+type SocialAPIRenameChatRoomArgs struct {
+	Request *social.RenameChatRoomRequest `thrift:"request,1"`
+}
+
+func NewSocialAPIRenameChatRoomArgs() *SocialAPIRenameChatRoomArgs {
+	return &SocialAPIRenameChatRoomArgs{}
+}
+
+func (p *SocialAPIRenameChatRoomArgs) InitDefault() {
+}
+
+var SocialAPIRenameChatRoomArgs_Request_DEFAULT *social.RenameChatRoomRequest
+
+func (p *SocialAPIRenameChatRoomArgs) GetRequest() (v *social.RenameChatRoomRequest) {
+	if !p.IsSetRequest() {
+		return SocialAPIRenameChatRoomArgs_Request_DEFAULT
+	}
+	return p.Request
+}
+
+var fieldIDToName_SocialAPIRenameChatRoomArgs = map[int16]string{
+	1: "request",
+}
+
+func (p *SocialAPIRenameChatRoomArgs) IsSetRequest() bool {
+	return p.Request != nil
+}
+
+func (p *SocialAPIRenameChatRoomArgs) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_SocialAPIRenameChatRoomArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *SocialAPIRenameChatRoomArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := social.NewRenameChatRoomRequest()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Request = _field
+	return nil
+}
+
+func (p *SocialAPIRenameChatRoomArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("RenameChatRoom_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *SocialAPIRenameChatRoomArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("request", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Request.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *SocialAPIRenameChatRoomArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SocialAPIRenameChatRoomArgs(%+v)", *p)
+
+}
+
+type SocialAPIRenameChatRoomResult struct {
+	Success *social.RenameChatRoomResponse `thrift:"success,0,optional"`
+}
+
+func NewSocialAPIRenameChatRoomResult() *SocialAPIRenameChatRoomResult {
+	return &SocialAPIRenameChatRoomResult{}
+}
+
+func (p *SocialAPIRenameChatRoomResult) InitDefault() {
+}
+
+var SocialAPIRenameChatRoomResult_Success_DEFAULT *social.RenameChatRoomResponse
+
+func (p *SocialAPIRenameChatRoomResult) GetSuccess() (v *social.RenameChatRoomResponse) {
+	if !p.IsSetSuccess() {
+		return SocialAPIRenameChatRoomResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var fieldIDToName_SocialAPIRenameChatRoomResult = map[int16]string{
+	0: "success",
+}
+
+func (p *SocialAPIRenameChatRoomResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *SocialAPIRenameChatRoomResult) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_SocialAPIRenameChatRoomResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *SocialAPIRenameChatRoomResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := social.NewRenameChatRoomResponse()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *SocialAPIRenameChatRoomResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("RenameChatRoom_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *SocialAPIRenameChatRoomResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *SocialAPIRenameChatRoomResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SocialAPIRenameChatRoomResult(%+v)", *p)
+
+}
+
+type SocialAPIDisbandChatRoomArgs struct {
+	Request *social.DisbandChatRoomRequest `thrift:"request,1"`
+}
+
+func NewSocialAPIDisbandChatRoomArgs() *SocialAPIDisbandChatRoomArgs {
+	return &SocialAPIDisbandChatRoomArgs{}
+}
+
+func (p *SocialAPIDisbandChatRoomArgs) InitDefault() {
+}
+
+var SocialAPIDisbandChatRoomArgs_Request_DEFAULT *social.DisbandChatRoomRequest
+
+func (p *SocialAPIDisbandChatRoomArgs) GetRequest() (v *social.DisbandChatRoomRequest) {
+	if !p.IsSetRequest() {
+		return SocialAPIDisbandChatRoomArgs_Request_DEFAULT
+	}
+	return p.Request
+}
+
+var fieldIDToName_SocialAPIDisbandChatRoomArgs = map[int16]string{
+	1: "request",
+}
+
+func (p *SocialAPIDisbandChatRoomArgs) IsSetRequest() bool {
+	return p.Request != nil
+}
+
+func (p *SocialAPIDisbandChatRoomArgs) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_SocialAPIDisbandChatRoomArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *SocialAPIDisbandChatRoomArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := social.NewDisbandChatRoomRequest()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Request = _field
+	return nil
+}
+
+func (p *SocialAPIDisbandChatRoomArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("DisbandChatRoom_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *SocialAPIDisbandChatRoomArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("request", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Request.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *SocialAPIDisbandChatRoomArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SocialAPIDisbandChatRoomArgs(%+v)", *p)
+
+}
+
+type SocialAPIDisbandChatRoomResult struct {
+	Success *social.DisbandChatRoomResponse `thrift:"success,0,optional"`
+}
+
+func NewSocialAPIDisbandChatRoomResult() *SocialAPIDisbandChatRoomResult {
+	return &SocialAPIDisbandChatRoomResult{}
+}
+
+func (p *SocialAPIDisbandChatRoomResult) InitDefault() {
+}
+
+var SocialAPIDisbandChatRoomResult_Success_DEFAULT *social.DisbandChatRoomResponse
+
+func (p *SocialAPIDisbandChatRoomResult) GetSuccess() (v *social.DisbandChatRoomResponse) {
+	if !p.IsSetSuccess() {
+		return SocialAPIDisbandChatRoomResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var fieldIDToName_SocialAPIDisbandChatRoomResult = map[int16]string{
+	0: "success",
+}
+
+func (p *SocialAPIDisbandChatRoomResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *SocialAPIDisbandChatRoomResult) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_SocialAPIDisbandChatRoomResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *SocialAPIDisbandChatRoomResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := social.NewDisbandChatRoomResponse()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *SocialAPIDisbandChatRoomResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("DisbandChatRoom_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *SocialAPIDisbandChatRoomResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *SocialAPIDisbandChatRoomResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SocialAPIDisbandChatRoomResult(%+v)", *p)
+
+}
+
+type SocialAPICreateChatRoomInviteArgs struct {
+	Request *social.CreateChatRoomInviteRequest `thrift:"request,1"`
+}
+
+func NewSocialAPICreateChatRoomInviteArgs() *SocialAPICreateChatRoomInviteArgs {
+	return &SocialAPICreateChatRoomInviteArgs{}
+}
+
+func (p *SocialAPICreateChatRoomInviteArgs) InitDefault() {
+}
+
+var SocialAPICreateChatRoomInviteArgs_Request_DEFAULT *social.CreateChatRoomInviteRequest
+
+func (p *SocialAPICreateChatRoomInviteArgs) GetRequest() (v *social.CreateChatRoomInviteRequest) {
+	if !p.IsSetRequest() {
+		return SocialAPICreateChatRoomInviteArgs_Request_DEFAULT
+	}
+	return p.Request
+}
+
+var fieldIDToName_SocialAPICreateChatRoomInviteArgs = map[int16]string{
+	1: "request",
+}
+
+func (p *SocialAPICreateChatRoomInviteArgs) IsSetRequest() bool {
+	return p.Request != nil
+}
+
+func (p *SocialAPICreateChatRoomInviteArgs) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_SocialAPICreateChatRoomInviteArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *SocialAPICreateChatRoomInviteArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := social.NewCreateChatRoomInviteRequest()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Request = _field
+	return nil
+}
+
+func (p *SocialAPICreateChatRoomInviteArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("CreateChatRoomInvite_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *SocialAPICreateChatRoomInviteArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("request", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Request.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *SocialAPICreateChatRoomInviteArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SocialAPICreateChatRoomInviteArgs(%+v)", *p)
+
+}
+
+type SocialAPICreateChatRoomInviteResult struct {
+	Success *social.CreateChatRoomInviteResponse `thrift:"success,0,optional"`
+}
+
+func NewSocialAPICreateChatRoomInviteResult() *SocialAPICreateChatRoomInviteResult {
+	return &SocialAPICreateChatRoomInviteResult{}
+}
+
+func (p *SocialAPICreateChatRoomInviteResult) InitDefault() {
+}
+
+var SocialAPICreateChatRoomInviteResult_Success_DEFAULT *social.CreateChatRoomInviteResponse
+
+func (p *SocialAPICreateChatRoomInviteResult) GetSuccess() (v *social.CreateChatRoomInviteResponse) {
+	if !p.IsSetSuccess() {
+		return SocialAPICreateChatRoomInviteResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var fieldIDToName_SocialAPICreateChatRoomInviteResult = map[int16]string{
+	0: "success",
+}
+
+func (p *SocialAPICreateChatRoomInviteResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *SocialAPICreateChatRoomInviteResult) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_SocialAPICreateChatRoomInviteResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *SocialAPICreateChatRoomInviteResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := social.NewCreateChatRoomInviteResponse()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *SocialAPICreateChatRoomInviteResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("CreateChatRoomInvite_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *SocialAPICreateChatRoomInviteResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *SocialAPICreateChatRoomInviteResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SocialAPICreateChatRoomInviteResult(%+v)", *p)
+
+}
+
+type SocialAPIJoinChatRoomByInviteArgs struct {
+	Request *social.JoinChatRoomByInviteRequest `thrift:"request,1"`
+}
+
+func NewSocialAPIJoinChatRoomByInviteArgs() *SocialAPIJoinChatRoomByInviteArgs {
+	return &SocialAPIJoinChatRoomByInviteArgs{}
+}
+
+func (p *SocialAPIJoinChatRoomByInviteArgs) InitDefault() {
+}
+
+var SocialAPIJoinChatRoomByInviteArgs_Request_DEFAULT *social.JoinChatRoomByInviteRequest
+
+func (p *SocialAPIJoinChatRoomByInviteArgs) GetRequest() (v *social.JoinChatRoomByInviteRequest) {
+	if !p.IsSetRequest() {
+		return SocialAPIJoinChatRoomByInviteArgs_Request_DEFAULT
+	}
+	return p.Request
+}
+
+var fieldIDToName_SocialAPIJoinChatRoomByInviteArgs = map[int16]string{
+	1: "request",
+}
+
+func (p *SocialAPIJoinChatRoomByInviteArgs) IsSetRequest() bool {
+	return p.Request != nil
+}
+
+func (p *SocialAPIJoinChatRoomByInviteArgs) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_SocialAPIJoinChatRoomByInviteArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *SocialAPIJoinChatRoomByInviteArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := social.NewJoinChatRoomByInviteRequest()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Request = _field
+	return nil
+}
+
+func (p *SocialAPIJoinChatRoomByInviteArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("JoinChatRoomByInvite_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *SocialAPIJoinChatRoomByInviteArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("request", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Request.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *SocialAPIJoinChatRoomByInviteArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SocialAPIJoinChatRoomByInviteArgs(%+v)", *p)
+
+}
+
+type SocialAPIJoinChatRoomByInviteResult struct {
+	Success *social.JoinChatRoomByInviteResponse `thrift:"success,0,optional"`
+}
+
+func NewSocialAPIJoinChatRoomByInviteResult() *SocialAPIJoinChatRoomByInviteResult {
+	return &SocialAPIJoinChatRoomByInviteResult{}
+}
+
+func (p *SocialAPIJoinChatRoomByInviteResult) InitDefault() {
+}
+
+var SocialAPIJoinChatRoomByInviteResult_Success_DEFAULT *social.JoinChatRoomByInviteResponse
+
+func (p *SocialAPIJoinChatRoomByInviteResult) GetSuccess() (v *social.JoinChatRoomByInviteResponse) {
+	if !p.IsSetSuccess() {
+		return SocialAPIJoinChatRoomByInviteResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var fieldIDToName_SocialAPIJoinChatRoomByInviteResult = map[int16]string{
+	0: "success",
+}
+
+func (p *SocialAPIJoinChatRoomByInviteResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *SocialAPIJoinChatRoomByInviteResult) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_SocialAPIJoinChatRoomByInviteResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *SocialAPIJoinChatRoomByInviteResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := social.NewJoinChatRoomByInviteResponse()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *SocialAPIJoinChatRoomByInviteResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("JoinChatRoomByInvite_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *SocialAPIJoinChatRoomByInviteResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *SocialAPIJoinChatRoomByInviteResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SocialAPIJoinChatRoomByInviteResult(%+v)", *p)
+
+}
+
+type SocialAPIAddFriendArgs struct {
+	Request *social.AddFriendRequest `thrift:"request,1"`
+}
+
+func NewSocialAPIAddFriendArgs() *SocialAPIAddFriendArgs {
+	return &SocialAPIAddFriendArgs{}
+}
+
+func (p *SocialAPIAddFriendArgs) InitDefault() {
+}
+
+var SocialAPIAddFriendArgs_Request_DEFAULT *social.AddFriendRequest
+
+func (p *SocialAPIAddFriendArgs) GetRequest() (v *social.AddFriendRequest) {
+	if !p.IsSetRequest() {
+		return SocialAPIAddFriendArgs_Request_DEFAULT
+	}
+	return p.Request
+}
+
+var fieldIDToName_SocialAPIAddFriendArgs = map[int16]string{
+	1: "request",
+}
+
+func (p *SocialAPIAddFriendArgs) IsSetRequest() bool {
+	return p.Request != nil
+}
+
+func (p *SocialAPIAddFriendArgs) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_SocialAPIAddFriendArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *SocialAPIAddFriendArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := social.NewAddFriendRequest()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Request = _field
+	return nil
+}
+
+func (p *SocialAPIAddFriendArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("AddFriend_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *SocialAPIAddFriendArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("request", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Request.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *SocialAPIAddFriendArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SocialAPIAddFriendArgs(%+v)", *p)
+
+}
+
+type SocialAPIAddFriendResult struct {
+	Success *social.AddFriendResponse `thrift:"success,0,optional"`
+}
+
+func NewSocialAPIAddFriendResult() *SocialAPIAddFriendResult {
+	return &SocialAPIAddFriendResult{}
+}
+
+func (p *SocialAPIAddFriendResult) InitDefault() {
+}
+
+var SocialAPIAddFriendResult_Success_DEFAULT *social.AddFriendResponse
+
+func (p *SocialAPIAddFriendResult) GetSuccess() (v *social.AddFriendResponse) {
+	if !p.IsSetSuccess() {
+		return SocialAPIAddFriendResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var fieldIDToName_SocialAPIAddFriendResult = map[int16]string{
+	0: "success",
+}
+
+func (p *SocialAPIAddFriendResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *SocialAPIAddFriendResult) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_SocialAPIAddFriendResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *SocialAPIAddFriendResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := social.NewAddFriendResponse()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *SocialAPIAddFriendResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("AddFriend_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *SocialAPIAddFriendResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *SocialAPIAddFriendResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SocialAPIAddFriendResult(%+v)", *p)
+
+}
+
+type SocialAPIGetFriendshipArgs struct {
+	Request *social.GetFriendshipRequest `thrift:"request,1"`
+}
+
+func NewSocialAPIGetFriendshipArgs() *SocialAPIGetFriendshipArgs {
+	return &SocialAPIGetFriendshipArgs{}
+}
+
+func (p *SocialAPIGetFriendshipArgs) InitDefault() {
+}
+
+var SocialAPIGetFriendshipArgs_Request_DEFAULT *social.GetFriendshipRequest
+
+func (p *SocialAPIGetFriendshipArgs) GetRequest() (v *social.GetFriendshipRequest) {
+	if !p.IsSetRequest() {
+		return SocialAPIGetFriendshipArgs_Request_DEFAULT
+	}
+	return p.Request
+}
+
+var fieldIDToName_SocialAPIGetFriendshipArgs = map[int16]string{
+	1: "request",
+}
+
+func (p *SocialAPIGetFriendshipArgs) IsSetRequest() bool {
+	return p.Request != nil
+}
+
+func (p *SocialAPIGetFriendshipArgs) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_SocialAPIGetFriendshipArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *SocialAPIGetFriendshipArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := social.NewGetFriendshipRequest()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Request = _field
+	return nil
+}
+
+func (p *SocialAPIGetFriendshipArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("GetFriendship_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *SocialAPIGetFriendshipArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("request", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Request.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *SocialAPIGetFriendshipArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SocialAPIGetFriendshipArgs(%+v)", *p)
+
+}
+
+type SocialAPIGetFriendshipResult struct {
+	Success *social.GetFriendshipResponse `thrift:"success,0,optional"`
+}
+
+func NewSocialAPIGetFriendshipResult() *SocialAPIGetFriendshipResult {
+	return &SocialAPIGetFriendshipResult{}
+}
+
+func (p *SocialAPIGetFriendshipResult) InitDefault() {
+}
+
+var SocialAPIGetFriendshipResult_Success_DEFAULT *social.GetFriendshipResponse
+
+func (p *SocialAPIGetFriendshipResult) GetSuccess() (v *social.GetFriendshipResponse) {
+	if !p.IsSetSuccess() {
+		return SocialAPIGetFriendshipResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var fieldIDToName_SocialAPIGetFriendshipResult = map[int16]string{
+	0: "success",
+}
+
+func (p *SocialAPIGetFriendshipResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *SocialAPIGetFriendshipResult) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_SocialAPIGetFriendshipResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *SocialAPIGetFriendshipResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := social.NewGetFriendshipResponse()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *SocialAPIGetFriendshipResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("GetFriendship_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *SocialAPIGetFriendshipResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *SocialAPIGetFriendshipResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SocialAPIGetFriendshipResult(%+v)", *p)
+
+}
+
+type SocialAPIGetUserFriendsArgs struct {
+	Request *social.GetUserFriendsRequest `thrift:"request,1"`
+}
+
+func NewSocialAPIGetUserFriendsArgs() *SocialAPIGetUserFriendsArgs {
+	return &SocialAPIGetUserFriendsArgs{}
+}
+
+func (p *SocialAPIGetUserFriendsArgs) InitDefault() {
+}
+
+var SocialAPIGetUserFriendsArgs_Request_DEFAULT *social.GetUserFriendsRequest
+
+func (p *SocialAPIGetUserFriendsArgs) GetRequest() (v *social.GetUserFriendsRequest) {
+	if !p.IsSetRequest() {
+		return SocialAPIGetUserFriendsArgs_Request_DEFAULT
+	}
+	return p.Request
+}
+
+var fieldIDToName_SocialAPIGetUserFriendsArgs = map[int16]string{
+	1: "request",
+}
+
+func (p *SocialAPIGetUserFriendsArgs) IsSetRequest() bool {
+	return p.Request != nil
+}
+
+func (p *SocialAPIGetUserFriendsArgs) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_SocialAPIGetUserFriendsArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *SocialAPIGetUserFriendsArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := social.NewGetUserFriendsRequest()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Request = _field
+	return nil
+}
+
+func (p *SocialAPIGetUserFriendsArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("GetUserFriends_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *SocialAPIGetUserFriendsArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("request", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Request.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *SocialAPIGetUserFriendsArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SocialAPIGetUserFriendsArgs(%+v)", *p)
+
+}
+
+type SocialAPIGetUserFriendsResult struct {
+	Success *social.GetUserFriendsResponse `thrift:"success,0,optional"`
+}
+
+func NewSocialAPIGetUserFriendsResult() *SocialAPIGetUserFriendsResult {
+	return &SocialAPIGetUserFriendsResult{}
+}
+
+func (p *SocialAPIGetUserFriendsResult) InitDefault() {
+}
+
+var SocialAPIGetUserFriendsResult_Success_DEFAULT *social.GetUserFriendsResponse
+
+func (p *SocialAPIGetUserFriendsResult) GetSuccess() (v *social.GetUserFriendsResponse) {
+	if !p.IsSetSuccess() {
+		return SocialAPIGetUserFriendsResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var fieldIDToName_SocialAPIGetUserFriendsResult = map[int16]string{
+	0: "success",
+}
+
+func (p *SocialAPIGetUserFriendsResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *SocialAPIGetUserFriendsResult) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_SocialAPIGetUserFriendsResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *SocialAPIGetUserFriendsResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := social.NewGetUserFriendsResponse()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *SocialAPIGetUserFriendsResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("GetUserFriends_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *SocialAPIGetUserFriendsResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *SocialAPIGetUserFriendsResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SocialAPIGetUserFriendsResult(%+v)", *p)
+
+}
+
+type SocialAPICreateFriendRequestArgs struct {
+	Request *social.CreateFriendRequestRequest `thrift:"request,1"`
+}
+
+func NewSocialAPICreateFriendRequestArgs() *SocialAPICreateFriendRequestArgs {
+	return &SocialAPICreateFriendRequestArgs{}
+}
+
+func (p *SocialAPICreateFriendRequestArgs) InitDefault() {
+}
+
+var SocialAPICreateFriendRequestArgs_Request_DEFAULT *social.CreateFriendRequestRequest
+
+func (p *SocialAPICreateFriendRequestArgs) GetRequest() (v *social.CreateFriendRequestRequest) {
+	if !p.IsSetRequest() {
+		return SocialAPICreateFriendRequestArgs_Request_DEFAULT
+	}
+	return p.Request
+}
+
+var fieldIDToName_SocialAPICreateFriendRequestArgs = map[int16]string{
+	1: "request",
+}
+
+func (p *SocialAPICreateFriendRequestArgs) IsSetRequest() bool {
+	return p.Request != nil
+}
+
+func (p *SocialAPICreateFriendRequestArgs) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_SocialAPICreateFriendRequestArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *SocialAPICreateFriendRequestArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := social.NewCreateFriendRequestRequest()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Request = _field
+	return nil
+}
+
+func (p *SocialAPICreateFriendRequestArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("CreateFriendRequest_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *SocialAPICreateFriendRequestArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("request", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Request.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *SocialAPICreateFriendRequestArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SocialAPICreateFriendRequestArgs(%+v)", *p)
+
+}
+
+type SocialAPICreateFriendRequestResult struct {
+	Success *social.CreateFriendRequestResponse `thrift:"success,0,optional"`
+}
+
+func NewSocialAPICreateFriendRequestResult() *SocialAPICreateFriendRequestResult {
+	return &SocialAPICreateFriendRequestResult{}
+}
+
+func (p *SocialAPICreateFriendRequestResult) InitDefault() {
+}
+
+var SocialAPICreateFriendRequestResult_Success_DEFAULT *social.CreateFriendRequestResponse
+
+func (p *SocialAPICreateFriendRequestResult) GetSuccess() (v *social.CreateFriendRequestResponse) {
+	if !p.IsSetSuccess() {
+		return SocialAPICreateFriendRequestResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var fieldIDToName_SocialAPICreateFriendRequestResult = map[int16]string{
+	0: "success",
+}
+
+func (p *SocialAPICreateFriendRequestResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *SocialAPICreateFriendRequestResult) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_SocialAPICreateFriendRequestResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *SocialAPICreateFriendRequestResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := social.NewCreateFriendRequestResponse()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *SocialAPICreateFriendRequestResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("CreateFriendRequest_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *SocialAPICreateFriendRequestResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *SocialAPICreateFriendRequestResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SocialAPICreateFriendRequestResult(%+v)", *p)
+
+}
+
+type SocialAPIGetFriendRequestsArgs struct {
+	Request *social.GetFriendRequestsRequest `thrift:"request,1"`
+}
+
+func NewSocialAPIGetFriendRequestsArgs() *SocialAPIGetFriendRequestsArgs {
+	return &SocialAPIGetFriendRequestsArgs{}
+}
+
+func (p *SocialAPIGetFriendRequestsArgs) InitDefault() {
+}
+
+var SocialAPIGetFriendRequestsArgs_Request_DEFAULT *social.GetFriendRequestsRequest
+
+func (p *SocialAPIGetFriendRequestsArgs) GetRequest() (v *social.GetFriendRequestsRequest) {
+	if !p.IsSetRequest() {
+		return SocialAPIGetFriendRequestsArgs_Request_DEFAULT
+	}
+	return p.Request
+}
+
+var fieldIDToName_SocialAPIGetFriendRequestsArgs = map[int16]string{
+	1: "request",
+}
+
+func (p *SocialAPIGetFriendRequestsArgs) IsSetRequest() bool {
+	return p.Request != nil
+}
+
+func (p *SocialAPIGetFriendRequestsArgs) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_SocialAPIGetFriendRequestsArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *SocialAPIGetFriendRequestsArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := social.NewGetFriendRequestsRequest()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Request = _field
+	return nil
+}
+
+func (p *SocialAPIGetFriendRequestsArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("GetFriendRequests_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *SocialAPIGetFriendRequestsArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("request", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Request.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *SocialAPIGetFriendRequestsArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SocialAPIGetFriendRequestsArgs(%+v)", *p)
+
+}
+
+type SocialAPIGetFriendRequestsResult struct {
+	Success *social.GetFriendRequestsResponse `thrift:"success,0,optional"`
+}
+
+func NewSocialAPIGetFriendRequestsResult() *SocialAPIGetFriendRequestsResult {
+	return &SocialAPIGetFriendRequestsResult{}
+}
+
+func (p *SocialAPIGetFriendRequestsResult) InitDefault() {
+}
+
+var SocialAPIGetFriendRequestsResult_Success_DEFAULT *social.GetFriendRequestsResponse
+
+func (p *SocialAPIGetFriendRequestsResult) GetSuccess() (v *social.GetFriendRequestsResponse) {
+	if !p.IsSetSuccess() {
+		return SocialAPIGetFriendRequestsResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var fieldIDToName_SocialAPIGetFriendRequestsResult = map[int16]string{
+	0: "success",
+}
+
+func (p *SocialAPIGetFriendRequestsResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *SocialAPIGetFriendRequestsResult) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_SocialAPIGetFriendRequestsResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *SocialAPIGetFriendRequestsResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := social.NewGetFriendRequestsResponse()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *SocialAPIGetFriendRequestsResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("GetFriendRequests_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *SocialAPIGetFriendRequestsResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *SocialAPIGetFriendRequestsResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SocialAPIGetFriendRequestsResult(%+v)", *p)
+
+}
+
+type SocialAPIHandleFriendRequestArgs struct {
+	Request *social.HandleFriendRequestRequest `thrift:"request,1"`
+}
+
+func NewSocialAPIHandleFriendRequestArgs() *SocialAPIHandleFriendRequestArgs {
+	return &SocialAPIHandleFriendRequestArgs{}
+}
+
+func (p *SocialAPIHandleFriendRequestArgs) InitDefault() {
+}
+
+var SocialAPIHandleFriendRequestArgs_Request_DEFAULT *social.HandleFriendRequestRequest
+
+func (p *SocialAPIHandleFriendRequestArgs) GetRequest() (v *social.HandleFriendRequestRequest) {
+	if !p.IsSetRequest() {
+		return SocialAPIHandleFriendRequestArgs_Request_DEFAULT
+	}
+	return p.Request
+}
+
+var fieldIDToName_SocialAPIHandleFriendRequestArgs = map[int16]string{
+	1: "request",
+}
+
+func (p *SocialAPIHandleFriendRequestArgs) IsSetRequest() bool {
+	return p.Request != nil
+}
+
+func (p *SocialAPIHandleFriendRequestArgs) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_SocialAPIHandleFriendRequestArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *SocialAPIHandleFriendRequestArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := social.NewHandleFriendRequestRequest()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Request = _field
+	return nil
+}
+
+func (p *SocialAPIHandleFriendRequestArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("HandleFriendRequest_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *SocialAPIHandleFriendRequestArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("request", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Request.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *SocialAPIHandleFriendRequestArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SocialAPIHandleFriendRequestArgs(%+v)", *p)
+
+}
+
+type SocialAPIHandleFriendRequestResult struct {
+	Success *social.HandleFriendRequestResponse `thrift:"success,0,optional"`
+}
+
+func NewSocialAPIHandleFriendRequestResult() *SocialAPIHandleFriendRequestResult {
+	return &SocialAPIHandleFriendRequestResult{}
+}
+
+func (p *SocialAPIHandleFriendRequestResult) InitDefault() {
+}
+
+var SocialAPIHandleFriendRequestResult_Success_DEFAULT *social.HandleFriendRequestResponse
+
+func (p *SocialAPIHandleFriendRequestResult) GetSuccess() (v *social.HandleFriendRequestResponse) {
+	if !p.IsSetSuccess() {
+		return SocialAPIHandleFriendRequestResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var fieldIDToName_SocialAPIHandleFriendRequestResult = map[int16]string{
+	0: "success",
+}
+
+func (p *SocialAPIHandleFriendRequestResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *SocialAPIHandleFriendRequestResult) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_SocialAPIHandleFriendRequestResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *SocialAPIHandleFriendRequestResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := social.NewHandleFriendRequestResponse()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *SocialAPIHandleFriendRequestResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("HandleFriendRequest_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *SocialAPIHandleFriendRequestResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *SocialAPIHandleFriendRequestResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SocialAPIHandleFriendRequestResult(%+v)", *p)
+
+}
+
+type SocialAPIGetFriendRecommendationsArgs struct {
+	Request *social.GetFriendRecommendationsRequest `thrift:"request,1"`
+}
+
+func NewSocialAPIGetFriendRecommendationsArgs() *SocialAPIGetFriendRecommendationsArgs {
+	return &SocialAPIGetFriendRecommendationsArgs{}
+}
+
+func (p *SocialAPIGetFriendRecommendationsArgs) InitDefault() {
+}
+
+var SocialAPIGetFriendRecommendationsArgs_Request_DEFAULT *social.GetFriendRecommendationsRequest
+
+func (p *SocialAPIGetFriendRecommendationsArgs) GetRequest() (v *social.GetFriendRecommendationsRequest) {
+	if !p.IsSetRequest() {
+		return SocialAPIGetFriendRecommendationsArgs_Request_DEFAULT
+	}
+	return p.Request
+}
+
+var fieldIDToName_SocialAPIGetFriendRecommendationsArgs = map[int16]string{
+	1: "request",
+}
+
+func (p *SocialAPIGetFriendRecommendationsArgs) IsSetRequest() bool {
+	return p.Request != nil
+}
+
+func (p *SocialAPIGetFriendRecommendationsArgs) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_SocialAPIGetFriendRecommendationsArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *SocialAPIGetFriendRecommendationsArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := social.NewGetFriendRecommendationsRequest()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Request = _field
+	return nil
+}
+
+func (p *SocialAPIGetFriendRecommendationsArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("GetFriendRecommendations_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *SocialAPIGetFriendRecommendationsArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("request", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Request.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *SocialAPIGetFriendRecommendationsArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SocialAPIGetFriendRecommendationsArgs(%+v)", *p)
+
+}
+
+type SocialAPIGetFriendRecommendationsResult struct {
+	Success *social.GetFriendRecommendationsResponse `thrift:"success,0,optional"`
+}
+
+func NewSocialAPIGetFriendRecommendationsResult() *SocialAPIGetFriendRecommendationsResult {
+	return &SocialAPIGetFriendRecommendationsResult{}
+}
+
+func (p *SocialAPIGetFriendRecommendationsResult) InitDefault() {
+}
+
+var SocialAPIGetFriendRecommendationsResult_Success_DEFAULT *social.GetFriendRecommendationsResponse
+
+func (p *SocialAPIGetFriendRecommendationsResult) GetSuccess() (v *social.GetFriendRecommendationsResponse) {
+	if !p.IsSetSuccess() {
+		return SocialAPIGetFriendRecommendationsResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var fieldIDToName_SocialAPIGetFriendRecommendationsResult = map[int16]string{
+	0: "success",
+}
+
+func (p *SocialAPIGetFriendRecommendationsResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *SocialAPIGetFriendRecommendationsResult) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_SocialAPIGetFriendRecommendationsResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *SocialAPIGetFriendRecommendationsResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := social.NewGetFriendRecommendationsResponse()
+	if err := _field.Read(iprot); err != nil {
+		return err
 	}
+	p.Success = _field
+	return nil
+}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := SocialAPIMarkMessageReadResult{}
-	var retval *social.MarkMessageReadResponse
-	if retval, err2 = p.handler.MarkMessageRead(ctx, args.Request); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing MarkMessageRead: "+err2.Error())
-		oprot.WriteMessageBegin("MarkMessageRead", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
-	}
-	if err2 = oprot.WriteMessageBegin("MarkMessageRead", thrift.REPLY, seqId); err2 != nil {
-		err = err2
-	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
+func (p *SocialAPIGetFriendRecommendationsResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("GetFriendRecommendations_result"); err != nil {
+		goto WriteStructBeginError
 	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
 	}
-	if err != nil {
-		return
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
 	}
-	return true, err
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-type socialAPIProcessorGetUnreadMessageCount struct {
-	handler SocialAPI
+func (p *SocialAPIGetFriendRecommendationsResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *socialAPIProcessorGetUnreadMessageCount) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := SocialAPIGetUnreadMessageCountArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("GetUnreadMessageCount", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
+func (p *SocialAPIGetFriendRecommendationsResult) String() string {
+	if p == nil {
+		return "<nil>"
 	}
+	return fmt.Sprintf("SocialAPIGetFriendRecommendationsResult(%+v)", *p)
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := SocialAPIGetUnreadMessageCountResult{}
-	var retval *social.GetUnreadMessageCountResponse
-	if retval, err2 = p.handler.GetUnreadMessageCount(ctx, args.Request); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetUnreadMessageCount: "+err2.Error())
-		oprot.WriteMessageBegin("GetUnreadMessageCount", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
-	}
-	if err2 = oprot.WriteMessageBegin("GetUnreadMessageCount", thrift.REPLY, seqId); err2 != nil {
-		err = err2
-	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
-	}
-	if err != nil {
-		return
-	}
-	return true, err
 }
 
-type SocialAPISendPrivateMessageArgs struct {
-	Request *social.SendPrivateMessageRequest `thrift:"request,1"`
+type SocialAPIMarkMessageReadArgs struct {
+	Request *social.MarkMessageReadRequest `thrift:"request,1"`
 }
 
-func NewSocialAPISendPrivateMessageArgs() *SocialAPISendPrivateMessageArgs {
-	return &SocialAPISendPrivateMessageArgs{}
+func NewSocialAPIMarkMessageReadArgs() *SocialAPIMarkMessageReadArgs {
+	return &SocialAPIMarkMessageReadArgs{}
 }
 
-func (p *SocialAPISendPrivateMessageArgs) InitDefault() {
+func (p *SocialAPIMarkMessageReadArgs) InitDefault() {
 }
 
-var SocialAPISendPrivateMessageArgs_Request_DEFAULT *social.SendPrivateMessageRequest
+var SocialAPIMarkMessageReadArgs_Request_DEFAULT *social.MarkMessageReadRequest
 
-func (p *SocialAPISendPrivateMessageArgs) GetRequest() (v *social.SendPrivateMessageRequest) {
+func (p *SocialAPIMarkMessageReadArgs) GetRequest() (v *social.MarkMessageReadRequest) {
 	if !p.IsSetRequest() {
-		return SocialAPISendPrivateMessageArgs_Request_DEFAULT
+		return SocialAPIMarkMessageReadArgs_Request_DEFAULT
 	}
 	return p.Request
 }
 
-var fieldIDToName_SocialAPISendPrivateMessageArgs = map[int16]string{
+var fieldIDToName_SocialAPIMarkMessageReadArgs = map[int16]string{
 	1: "request",
 }
 
-func (p *SocialAPISendPrivateMessageArgs) IsSetRequest() bool {
+func (p *SocialAPIMarkMessageReadArgs) IsSetRequest() bool {
 	return p.Request != nil
 }
 
-func (p *SocialAPISendPrivateMessageArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *SocialAPIMarkMessageReadArgs) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -1053,7 +11614,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_SocialAPISendPrivateMessageArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_SocialAPIMarkMessageReadArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -1063,8 +11624,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *SocialAPISendPrivateMessageArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := social.NewSendPrivateMessageRequest()
+func (p *SocialAPIMarkMessageReadArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := social.NewMarkMessageReadRequest()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -1072,9 +11633,9 @@ func (p *SocialAPISendPrivateMessageArgs) ReadField1(iprot thrift.TProtocol) err
 	return nil
 }
 
-func (p *SocialAPISendPrivateMessageArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *SocialAPIMarkMessageReadArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("SendPrivateMessage_args"); err != nil {
+	if err = oprot.WriteStructBegin("MarkMessageRead_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -1100,7 +11661,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *SocialAPISendPrivateMessageArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *SocialAPIMarkMessageReadArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("request", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -1117,43 +11678,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *SocialAPISendPrivateMessageArgs) String() string {
+func (p *SocialAPIMarkMessageReadArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("SocialAPISendPrivateMessageArgs(%+v)", *p)
+	return fmt.Sprintf("SocialAPIMarkMessageReadArgs(%+v)", *p)
 
 }
 
-type SocialAPISendPrivateMessageResult struct {
-	Success *social.SendPrivateMessageResponse `thrift:"success,0,optional"`
+type SocialAPIMarkMessageReadResult struct {
+	Success *social.MarkMessageReadResponse `thrift:"success,0,optional"`
 }
 
-func NewSocialAPISendPrivateMessageResult() *SocialAPISendPrivateMessageResult {
-	return &SocialAPISendPrivateMessageResult{}
+func NewSocialAPIMarkMessageReadResult() *SocialAPIMarkMessageReadResult {
+	return &SocialAPIMarkMessageReadResult{}
 }
 
-func (p *SocialAPISendPrivateMessageResult) InitDefault() {
+func (p *SocialAPIMarkMessageReadResult) InitDefault() {
 }
 
-var SocialAPISendPrivateMessageResult_Success_DEFAULT *social.SendPrivateMessageResponse
+var SocialAPIMarkMessageReadResult_Success_DEFAULT *social.MarkMessageReadResponse
 
-func (p *SocialAPISendPrivateMessageResult) GetSuccess() (v *social.SendPrivateMessageResponse) {
+func (p *SocialAPIMarkMessageReadResult) GetSuccess() (v *social.MarkMessageReadResponse) {
 	if !p.IsSetSuccess() {
-		return SocialAPISendPrivateMessageResult_Success_DEFAULT
+		return SocialAPIMarkMessageReadResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_SocialAPISendPrivateMessageResult = map[int16]string{
+var fieldIDToName_SocialAPIMarkMessageReadResult = map[int16]string{
 	0: "success",
 }
 
-func (p *SocialAPISendPrivateMessageResult) IsSetSuccess() bool {
+func (p *SocialAPIMarkMessageReadResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *SocialAPISendPrivateMessageResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *SocialAPIMarkMessageReadResult) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -1198,7 +11759,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_SocialAPISendPrivateMessageResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_SocialAPIMarkMessageReadResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -1208,8 +11769,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *SocialAPISendPrivateMessageResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := social.NewSendPrivateMessageResponse()
+func (p *SocialAPIMarkMessageReadResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := social.NewMarkMessageReadResponse()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -1217,9 +11778,9 @@ func (p *SocialAPISendPrivateMessageResult) ReadField0(iprot thrift.TProtocol) e
 	return nil
 }
 
-func (p *SocialAPISendPrivateMessageResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *SocialAPIMarkMessageReadResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("SendPrivateMessage_result"); err != nil {
+	if err = oprot.WriteStructBegin("MarkMessageRead_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -1245,7 +11806,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *SocialAPISendPrivateMessageResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *SocialAPIMarkMessageReadResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -1264,43 +11825,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *SocialAPISendPrivateMessageResult) String() string {
+func (p *SocialAPIMarkMessageReadResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("SocialAPISendPrivateMessageResult(%+v)", *p)
+	return fmt.Sprintf("SocialAPIMarkMessageReadResult(%+v)", *p)
 
 }
 
-type SocialAPIGetPrivateMessagesArgs struct {
-	Request *social.GetPrivateMessagesRequest `thrift:"request,1"`
+type SocialAPIGetUnreadMessageCountArgs struct {
+	Request *social.GetUnreadMessageCountRequest `thrift:"request,1"`
 }
 
-func NewSocialAPIGetPrivateMessagesArgs() *SocialAPIGetPrivateMessagesArgs {
-	return &SocialAPIGetPrivateMessagesArgs{}
+func NewSocialAPIGetUnreadMessageCountArgs() *SocialAPIGetUnreadMessageCountArgs {
+	return &SocialAPIGetUnreadMessageCountArgs{}
 }
 
-func (p *SocialAPIGetPrivateMessagesArgs) InitDefault() {
+func (p *SocialAPIGetUnreadMessageCountArgs) InitDefault() {
 }
 
-var SocialAPIGetPrivateMessagesArgs_Request_DEFAULT *social.GetPrivateMessagesRequest
+var SocialAPIGetUnreadMessageCountArgs_Request_DEFAULT *social.GetUnreadMessageCountRequest
 
-func (p *SocialAPIGetPrivateMessagesArgs) GetRequest() (v *social.GetPrivateMessagesRequest) {
+func (p *SocialAPIGetUnreadMessageCountArgs) GetRequest() (v *social.GetUnreadMessageCountRequest) {
 	if !p.IsSetRequest() {
-		return SocialAPIGetPrivateMessagesArgs_Request_DEFAULT
+		return SocialAPIGetUnreadMessageCountArgs_Request_DEFAULT
 	}
 	return p.Request
 }
 
-var fieldIDToName_SocialAPIGetPrivateMessagesArgs = map[int16]string{
+var fieldIDToName_SocialAPIGetUnreadMessageCountArgs = map[int16]string{
 	1: "request",
 }
 
-func (p *SocialAPIGetPrivateMessagesArgs) IsSetRequest() bool {
+func (p *SocialAPIGetUnreadMessageCountArgs) IsSetRequest() bool {
 	return p.Request != nil
 }
 
-func (p *SocialAPIGetPrivateMessagesArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *SocialAPIGetUnreadMessageCountArgs) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -1345,7 +11906,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_SocialAPIGetPrivateMessagesArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_SocialAPIGetUnreadMessageCountArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -1355,8 +11916,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *SocialAPIGetPrivateMessagesArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := social.NewGetPrivateMessagesRequest()
+func (p *SocialAPIGetUnreadMessageCountArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := social.NewGetUnreadMessageCountRequest()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -1364,9 +11925,9 @@ func (p *SocialAPIGetPrivateMessagesArgs) ReadField1(iprot thrift.TProtocol) err
 	return nil
 }
 
-func (p *SocialAPIGetPrivateMessagesArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *SocialAPIGetUnreadMessageCountArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("GetPrivateMessages_args"); err != nil {
+	if err = oprot.WriteStructBegin("GetUnreadMessageCount_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -1392,7 +11953,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *SocialAPIGetPrivateMessagesArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *SocialAPIGetUnreadMessageCountArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("request", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -1409,43 +11970,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *SocialAPIGetPrivateMessagesArgs) String() string {
+func (p *SocialAPIGetUnreadMessageCountArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("SocialAPIGetPrivateMessagesArgs(%+v)", *p)
+	return fmt.Sprintf("SocialAPIGetUnreadMessageCountArgs(%+v)", *p)
 
 }
 
-type SocialAPIGetPrivateMessagesResult struct {
-	Success *social.GetPrivateMessagesResponse `thrift:"success,0,optional"`
+type SocialAPIGetUnreadMessageCountResult struct {
+	Success *social.GetUnreadMessageCountResponse `thrift:"success,0,optional"`
 }
 
-func NewSocialAPIGetPrivateMessagesResult() *SocialAPIGetPrivateMessagesResult {
-	return &SocialAPIGetPrivateMessagesResult{}
+func NewSocialAPIGetUnreadMessageCountResult() *SocialAPIGetUnreadMessageCountResult {
+	return &SocialAPIGetUnreadMessageCountResult{}
 }
 
-func (p *SocialAPIGetPrivateMessagesResult) InitDefault() {
+func (p *SocialAPIGetUnreadMessageCountResult) InitDefault() {
 }
 
-var SocialAPIGetPrivateMessagesResult_Success_DEFAULT *social.GetPrivateMessagesResponse
+var SocialAPIGetUnreadMessageCountResult_Success_DEFAULT *social.GetUnreadMessageCountResponse
 
-func (p *SocialAPIGetPrivateMessagesResult) GetSuccess() (v *social.GetPrivateMessagesResponse) {
+func (p *SocialAPIGetUnreadMessageCountResult) GetSuccess() (v *social.GetUnreadMessageCountResponse) {
 	if !p.IsSetSuccess() {
-		return SocialAPIGetPrivateMessagesResult_Success_DEFAULT
+		return SocialAPIGetUnreadMessageCountResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_SocialAPIGetPrivateMessagesResult = map[int16]string{
+var fieldIDToName_SocialAPIGetUnreadMessageCountResult = map[int16]string{
 	0: "success",
 }
 
-func (p *SocialAPIGetPrivateMessagesResult) IsSetSuccess() bool {
+func (p *SocialAPIGetUnreadMessageCountResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *SocialAPIGetPrivateMessagesResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *SocialAPIGetUnreadMessageCountResult) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -1490,7 +12051,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_SocialAPIGetPrivateMessagesResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_SocialAPIGetUnreadMessageCountResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -1500,8 +12061,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *SocialAPIGetPrivateMessagesResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := social.NewGetPrivateMessagesResponse()
+func (p *SocialAPIGetUnreadMessageCountResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := social.NewGetUnreadMessageCountResponse()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -1509,9 +12070,9 @@ func (p *SocialAPIGetPrivateMessagesResult) ReadField0(iprot thrift.TProtocol) e
 	return nil
 }
 
-func (p *SocialAPIGetPrivateMessagesResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *SocialAPIGetUnreadMessageCountResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("GetPrivateMessages_result"); err != nil {
+	if err = oprot.WriteStructBegin("GetUnreadMessageCount_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -1537,7 +12098,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *SocialAPIGetPrivateMessagesResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *SocialAPIGetUnreadMessageCountResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -1556,43 +12117,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *SocialAPIGetPrivateMessagesResult) String() string {
+func (p *SocialAPIGetUnreadMessageCountResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("SocialAPIGetPrivateMessagesResult(%+v)", *p)
+	return fmt.Sprintf("SocialAPIGetUnreadMessageCountResult(%+v)", *p)
 
 }
 
-type SocialAPICreateChatRoomArgs struct {
-	Request *social.CreateChatRoomRequest `thrift:"request,1"`
+type SocialAPIMarkConversationsReadArgs struct {
+	Request *social.MarkConversationsReadRequest `thrift:"request,1"`
 }
 
-func NewSocialAPICreateChatRoomArgs() *SocialAPICreateChatRoomArgs {
-	return &SocialAPICreateChatRoomArgs{}
+func NewSocialAPIMarkConversationsReadArgs() *SocialAPIMarkConversationsReadArgs {
+	return &SocialAPIMarkConversationsReadArgs{}
 }
 
-func (p *SocialAPICreateChatRoomArgs) InitDefault() {
+func (p *SocialAPIMarkConversationsReadArgs) InitDefault() {
 }
 
-var SocialAPICreateChatRoomArgs_Request_DEFAULT *social.CreateChatRoomRequest
+var SocialAPIMarkConversationsReadArgs_Request_DEFAULT *social.MarkConversationsReadRequest
 
-func (p *SocialAPICreateChatRoomArgs) GetRequest() (v *social.CreateChatRoomRequest) {
+func (p *SocialAPIMarkConversationsReadArgs) GetRequest() (v *social.MarkConversationsReadRequest) {
 	if !p.IsSetRequest() {
-		return SocialAPICreateChatRoomArgs_Request_DEFAULT
+		return SocialAPIMarkConversationsReadArgs_Request_DEFAULT
 	}
 	return p.Request
 }
 
-var fieldIDToName_SocialAPICreateChatRoomArgs = map[int16]string{
+var fieldIDToName_SocialAPIMarkConversationsReadArgs = map[int16]string{
 	1: "request",
 }
 
-func (p *SocialAPICreateChatRoomArgs) IsSetRequest() bool {
+func (p *SocialAPIMarkConversationsReadArgs) IsSetRequest() bool {
 	return p.Request != nil
 }
 
-func (p *SocialAPICreateChatRoomArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *SocialAPIMarkConversationsReadArgs) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -1637,7 +12198,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_SocialAPICreateChatRoomArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_SocialAPIMarkConversationsReadArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -1647,8 +12208,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *SocialAPICreateChatRoomArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := social.NewCreateChatRoomRequest()
+func (p *SocialAPIMarkConversationsReadArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := social.NewMarkConversationsReadRequest()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -1656,9 +12217,9 @@ func (p *SocialAPICreateChatRoomArgs) ReadField1(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *SocialAPICreateChatRoomArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *SocialAPIMarkConversationsReadArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("CreateChatRoom_args"); err != nil {
+	if err = oprot.WriteStructBegin("MarkConversationsRead_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -1684,7 +12245,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *SocialAPICreateChatRoomArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *SocialAPIMarkConversationsReadArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("request", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -1701,43 +12262,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *SocialAPICreateChatRoomArgs) String() string {
+func (p *SocialAPIMarkConversationsReadArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("SocialAPICreateChatRoomArgs(%+v)", *p)
+	return fmt.Sprintf("SocialAPIMarkConversationsReadArgs(%+v)", *p)
 
 }
 
-type SocialAPICreateChatRoomResult struct {
-	Success *social.CreateChatRoomResponse `thrift:"success,0,optional"`
+type SocialAPIMarkConversationsReadResult struct {
+	Success *social.MarkConversationsReadResponse `thrift:"success,0,optional"`
 }
 
-func NewSocialAPICreateChatRoomResult() *SocialAPICreateChatRoomResult {
-	return &SocialAPICreateChatRoomResult{}
+func NewSocialAPIMarkConversationsReadResult() *SocialAPIMarkConversationsReadResult {
+	return &SocialAPIMarkConversationsReadResult{}
 }
 
-func (p *SocialAPICreateChatRoomResult) InitDefault() {
+func (p *SocialAPIMarkConversationsReadResult) InitDefault() {
 }
 
-var SocialAPICreateChatRoomResult_Success_DEFAULT *social.CreateChatRoomResponse
+var SocialAPIMarkConversationsReadResult_Success_DEFAULT *social.MarkConversationsReadResponse
 
-func (p *SocialAPICreateChatRoomResult) GetSuccess() (v *social.CreateChatRoomResponse) {
+func (p *SocialAPIMarkConversationsReadResult) GetSuccess() (v *social.MarkConversationsReadResponse) {
 	if !p.IsSetSuccess() {
-		return SocialAPICreateChatRoomResult_Success_DEFAULT
+		return SocialAPIMarkConversationsReadResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_SocialAPICreateChatRoomResult = map[int16]string{
+var fieldIDToName_SocialAPIMarkConversationsReadResult = map[int16]string{
 	0: "success",
 }
 
-func (p *SocialAPICreateChatRoomResult) IsSetSuccess() bool {
+func (p *SocialAPIMarkConversationsReadResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *SocialAPICreateChatRoomResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *SocialAPIMarkConversationsReadResult) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -1782,7 +12343,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_SocialAPICreateChatRoomResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_SocialAPIMarkConversationsReadResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -1792,8 +12353,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *SocialAPICreateChatRoomResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := social.NewCreateChatRoomResponse()
+func (p *SocialAPIMarkConversationsReadResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := social.NewMarkConversationsReadResponse()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -1801,9 +12362,9 @@ func (p *SocialAPICreateChatRoomResult) ReadField0(iprot thrift.TProtocol) error
 	return nil
 }
 
-func (p *SocialAPICreateChatRoomResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *SocialAPIMarkConversationsReadResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("CreateChatRoom_result"); err != nil {
+	if err = oprot.WriteStructBegin("MarkConversationsRead_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -1829,7 +12390,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *SocialAPICreateChatRoomResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *SocialAPIMarkConversationsReadResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -1848,43 +12409,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *SocialAPICreateChatRoomResult) String() string {
+func (p *SocialAPIMarkConversationsReadResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("SocialAPICreateChatRoomResult(%+v)", *p)
+	return fmt.Sprintf("SocialAPIMarkConversationsReadResult(%+v)", *p)
 
 }
 
-type SocialAPIGetChatRoomArgs struct {
-	Request *social.GetChatRoomRequest `thrift:"request,1"`
+type SocialAPIGetUnreadCountsArgs struct {
+	Request *social.GetUnreadCountsRequest `thrift:"request,1"`
 }
 
-func NewSocialAPIGetChatRoomArgs() *SocialAPIGetChatRoomArgs {
-	return &SocialAPIGetChatRoomArgs{}
+func NewSocialAPIGetUnreadCountsArgs() *SocialAPIGetUnreadCountsArgs {
+	return &SocialAPIGetUnreadCountsArgs{}
 }
 
-func (p *SocialAPIGetChatRoomArgs) InitDefault() {
+func (p *SocialAPIGetUnreadCountsArgs) InitDefault() {
 }
 
-var SocialAPIGetChatRoomArgs_Request_DEFAULT *social.GetChatRoomRequest
+var SocialAPIGetUnreadCountsArgs_Request_DEFAULT *social.GetUnreadCountsRequest
 
-func (p *SocialAPIGetChatRoomArgs) GetRequest() (v *social.GetChatRoomRequest) {
+func (p *SocialAPIGetUnreadCountsArgs) GetRequest() (v *social.GetUnreadCountsRequest) {
 	if !p.IsSetRequest() {
-		return SocialAPIGetChatRoomArgs_Request_DEFAULT
+		return SocialAPIGetUnreadCountsArgs_Request_DEFAULT
 	}
 	return p.Request
 }
 
-var fieldIDToName_SocialAPIGetChatRoomArgs = map[int16]string{
+var fieldIDToName_SocialAPIGetUnreadCountsArgs = map[int16]string{
 	1: "request",
 }
 
-func (p *SocialAPIGetChatRoomArgs) IsSetRequest() bool {
+func (p *SocialAPIGetUnreadCountsArgs) IsSetRequest() bool {
 	return p.Request != nil
 }
 
-func (p *SocialAPIGetChatRoomArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *SocialAPIGetUnreadCountsArgs) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -1929,7 +12490,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_SocialAPIGetChatRoomArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_SocialAPIGetUnreadCountsArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -1939,8 +12500,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *SocialAPIGetChatRoomArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := social.NewGetChatRoomRequest()
+func (p *SocialAPIGetUnreadCountsArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := social.NewGetUnreadCountsRequest()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -1948,9 +12509,9 @@ func (p *SocialAPIGetChatRoomArgs) ReadField1(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *SocialAPIGetChatRoomArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *SocialAPIGetUnreadCountsArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("GetChatRoom_args"); err != nil {
+	if err = oprot.WriteStructBegin("GetUnreadCounts_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -1976,7 +12537,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *SocialAPIGetChatRoomArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *SocialAPIGetUnreadCountsArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("request", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -1993,43 +12554,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *SocialAPIGetChatRoomArgs) String() string {
+func (p *SocialAPIGetUnreadCountsArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("SocialAPIGetChatRoomArgs(%+v)", *p)
+	return fmt.Sprintf("SocialAPIGetUnreadCountsArgs(%+v)", *p)
 
 }
 
-type SocialAPIGetChatRoomResult struct {
-	Success *social.GetChatRoomResponse `thrift:"success,0,optional"`
+type SocialAPIGetUnreadCountsResult struct {
+	Success *social.GetUnreadCountsResponse `thrift:"success,0,optional"`
 }
 
-func NewSocialAPIGetChatRoomResult() *SocialAPIGetChatRoomResult {
-	return &SocialAPIGetChatRoomResult{}
+func NewSocialAPIGetUnreadCountsResult() *SocialAPIGetUnreadCountsResult {
+	return &SocialAPIGetUnreadCountsResult{}
 }
 
-func (p *SocialAPIGetChatRoomResult) InitDefault() {
+func (p *SocialAPIGetUnreadCountsResult) InitDefault() {
 }
 
-var SocialAPIGetChatRoomResult_Success_DEFAULT *social.GetChatRoomResponse
+var SocialAPIGetUnreadCountsResult_Success_DEFAULT *social.GetUnreadCountsResponse
 
-func (p *SocialAPIGetChatRoomResult) GetSuccess() (v *social.GetChatRoomResponse) {
+func (p *SocialAPIGetUnreadCountsResult) GetSuccess() (v *social.GetUnreadCountsResponse) {
 	if !p.IsSetSuccess() {
-		return SocialAPIGetChatRoomResult_Success_DEFAULT
+		return SocialAPIGetUnreadCountsResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_SocialAPIGetChatRoomResult = map[int16]string{
+var fieldIDToName_SocialAPIGetUnreadCountsResult = map[int16]string{
 	0: "success",
 }
 
-func (p *SocialAPIGetChatRoomResult) IsSetSuccess() bool {
+func (p *SocialAPIGetUnreadCountsResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *SocialAPIGetChatRoomResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *SocialAPIGetUnreadCountsResult) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -2074,7 +12635,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_SocialAPIGetChatRoomResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_SocialAPIGetUnreadCountsResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -2084,8 +12645,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *SocialAPIGetChatRoomResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := social.NewGetChatRoomResponse()
+func (p *SocialAPIGetUnreadCountsResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := social.NewGetUnreadCountsResponse()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -2093,9 +12654,9 @@ func (p *SocialAPIGetChatRoomResult) ReadField0(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *SocialAPIGetChatRoomResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *SocialAPIGetUnreadCountsResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("GetChatRoom_result"); err != nil {
+	if err = oprot.WriteStructBegin("GetUnreadCounts_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -2121,7 +12682,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *SocialAPIGetChatRoomResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *SocialAPIGetUnreadCountsResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -2140,43 +12701,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *SocialAPIGetChatRoomResult) String() string {
+func (p *SocialAPIGetUnreadCountsResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("SocialAPIGetChatRoomResult(%+v)", *p)
+	return fmt.Sprintf("SocialAPIGetUnreadCountsResult(%+v)", *p)
 
 }
 
-type SocialAPIGetUserChatRoomsArgs struct {
-	Request *social.GetUserChatRoomsRequest `thrift:"request,1"`
+type SocialAPIGetInboxArgs struct {
+	Request *social.GetInboxRequest `thrift:"request,1"`
 }
 
-func NewSocialAPIGetUserChatRoomsArgs() *SocialAPIGetUserChatRoomsArgs {
-	return &SocialAPIGetUserChatRoomsArgs{}
+func NewSocialAPIGetInboxArgs() *SocialAPIGetInboxArgs {
+	return &SocialAPIGetInboxArgs{}
 }
 
-func (p *SocialAPIGetUserChatRoomsArgs) InitDefault() {
+func (p *SocialAPIGetInboxArgs) InitDefault() {
 }
 
-var SocialAPIGetUserChatRoomsArgs_Request_DEFAULT *social.GetUserChatRoomsRequest
+var SocialAPIGetInboxArgs_Request_DEFAULT *social.GetInboxRequest
 
-func (p *SocialAPIGetUserChatRoomsArgs) GetRequest() (v *social.GetUserChatRoomsRequest) {
+func (p *SocialAPIGetInboxArgs) GetRequest() (v *social.GetInboxRequest) {
 	if !p.IsSetRequest() {
-		return SocialAPIGetUserChatRoomsArgs_Request_DEFAULT
+		return SocialAPIGetInboxArgs_Request_DEFAULT
 	}
 	return p.Request
 }
 
-var fieldIDToName_SocialAPIGetUserChatRoomsArgs = map[int16]string{
+var fieldIDToName_SocialAPIGetInboxArgs = map[int16]string{
 	1: "request",
 }
 
-func (p *SocialAPIGetUserChatRoomsArgs) IsSetRequest() bool {
+func (p *SocialAPIGetInboxArgs) IsSetRequest() bool {
 	return p.Request != nil
 }
 
-func (p *SocialAPIGetUserChatRoomsArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *SocialAPIGetInboxArgs) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -2221,7 +12782,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_SocialAPIGetUserChatRoomsArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_SocialAPIGetInboxArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -2231,8 +12792,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *SocialAPIGetUserChatRoomsArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := social.NewGetUserChatRoomsRequest()
+func (p *SocialAPIGetInboxArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := social.NewGetInboxRequest()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -2240,9 +12801,9 @@ func (p *SocialAPIGetUserChatRoomsArgs) ReadField1(iprot thrift.TProtocol) error
 	return nil
 }
 
-func (p *SocialAPIGetUserChatRoomsArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *SocialAPIGetInboxArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("GetUserChatRooms_args"); err != nil {
+	if err = oprot.WriteStructBegin("GetInbox_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -2268,7 +12829,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *SocialAPIGetUserChatRoomsArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *SocialAPIGetInboxArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("request", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -2285,43 +12846,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *SocialAPIGetUserChatRoomsArgs) String() string {
+func (p *SocialAPIGetInboxArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("SocialAPIGetUserChatRoomsArgs(%+v)", *p)
+	return fmt.Sprintf("SocialAPIGetInboxArgs(%+v)", *p)
 
 }
 
-type SocialAPIGetUserChatRoomsResult struct {
-	Success *social.GetUserChatRoomsResponse `thrift:"success,0,optional"`
+type SocialAPIGetInboxResult struct {
+	Success *social.GetInboxResponse `thrift:"success,0,optional"`
 }
 
-func NewSocialAPIGetUserChatRoomsResult() *SocialAPIGetUserChatRoomsResult {
-	return &SocialAPIGetUserChatRoomsResult{}
+func NewSocialAPIGetInboxResult() *SocialAPIGetInboxResult {
+	return &SocialAPIGetInboxResult{}
 }
 
-func (p *SocialAPIGetUserChatRoomsResult) InitDefault() {
+func (p *SocialAPIGetInboxResult) InitDefault() {
 }
 
-var SocialAPIGetUserChatRoomsResult_Success_DEFAULT *social.GetUserChatRoomsResponse
+var SocialAPIGetInboxResult_Success_DEFAULT *social.GetInboxResponse
 
-func (p *SocialAPIGetUserChatRoomsResult) GetSuccess() (v *social.GetUserChatRoomsResponse) {
+func (p *SocialAPIGetInboxResult) GetSuccess() (v *social.GetInboxResponse) {
 	if !p.IsSetSuccess() {
-		return SocialAPIGetUserChatRoomsResult_Success_DEFAULT
+		return SocialAPIGetInboxResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_SocialAPIGetUserChatRoomsResult = map[int16]string{
+var fieldIDToName_SocialAPIGetInboxResult = map[int16]string{
 	0: "success",
 }
 
-func (p *SocialAPIGetUserChatRoomsResult) IsSetSuccess() bool {
+func (p *SocialAPIGetInboxResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *SocialAPIGetUserChatRoomsResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *SocialAPIGetInboxResult) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -2366,7 +12927,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_SocialAPIGetUserChatRoomsResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_SocialAPIGetInboxResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -2376,8 +12937,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *SocialAPIGetUserChatRoomsResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := social.NewGetUserChatRoomsResponse()
+func (p *SocialAPIGetInboxResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := social.NewGetInboxResponse()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -2385,9 +12946,9 @@ func (p *SocialAPIGetUserChatRoomsResult) ReadField0(iprot thrift.TProtocol) err
 	return nil
 }
 
-func (p *SocialAPIGetUserChatRoomsResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *SocialAPIGetInboxResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("GetUserChatRooms_result"); err != nil {
+	if err = oprot.WriteStructBegin("GetInbox_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -2413,7 +12974,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *SocialAPIGetUserChatRoomsResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *SocialAPIGetInboxResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -2432,43 +12993,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *SocialAPIGetUserChatRoomsResult) String() string {
+func (p *SocialAPIGetInboxResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("SocialAPIGetUserChatRoomsResult(%+v)", *p)
+	return fmt.Sprintf("SocialAPIGetInboxResult(%+v)", *p)
 
 }
 
-type SocialAPISendChatMessageArgs struct {
-	Request *social.SendChatMessageRequest `thrift:"request,1"`
+type SocialAPIUpdateConversationArgs struct {
+	Request *social.UpdateConversationRequest `thrift:"request,1"`
 }
 
-func NewSocialAPISendChatMessageArgs() *SocialAPISendChatMessageArgs {
-	return &SocialAPISendChatMessageArgs{}
+func NewSocialAPIUpdateConversationArgs() *SocialAPIUpdateConversationArgs {
+	return &SocialAPIUpdateConversationArgs{}
 }
 
-func (p *SocialAPISendChatMessageArgs) InitDefault() {
+func (p *SocialAPIUpdateConversationArgs) InitDefault() {
 }
 
-var SocialAPISendChatMessageArgs_Request_DEFAULT *social.SendChatMessageRequest
+var SocialAPIUpdateConversationArgs_Request_DEFAULT *social.UpdateConversationRequest
 
-func (p *SocialAPISendChatMessageArgs) GetRequest() (v *social.SendChatMessageRequest) {
+func (p *SocialAPIUpdateConversationArgs) GetRequest() (v *social.UpdateConversationRequest) {
 	if !p.IsSetRequest() {
-		return SocialAPISendChatMessageArgs_Request_DEFAULT
+		return SocialAPIUpdateConversationArgs_Request_DEFAULT
 	}
 	return p.Request
 }
 
-var fieldIDToName_SocialAPISendChatMessageArgs = map[int16]string{
+var fieldIDToName_SocialAPIUpdateConversationArgs = map[int16]string{
 	1: "request",
 }
 
-func (p *SocialAPISendChatMessageArgs) IsSetRequest() bool {
+func (p *SocialAPIUpdateConversationArgs) IsSetRequest() bool {
 	return p.Request != nil
 }
 
-func (p *SocialAPISendChatMessageArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *SocialAPIUpdateConversationArgs) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -2513,7 +13074,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_SocialAPISendChatMessageArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_SocialAPIUpdateConversationArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -2523,8 +13084,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *SocialAPISendChatMessageArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := social.NewSendChatMessageRequest()
+func (p *SocialAPIUpdateConversationArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := social.NewUpdateConversationRequest()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -2532,9 +13093,9 @@ func (p *SocialAPISendChatMessageArgs) ReadField1(iprot thrift.TProtocol) error
 	return nil
 }
 
-func (p *SocialAPISendChatMessageArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *SocialAPIUpdateConversationArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("SendChatMessage_args"); err != nil {
+	if err = oprot.WriteStructBegin("UpdateConversation_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -2560,7 +13121,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *SocialAPISendChatMessageArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *SocialAPIUpdateConversationArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("request", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -2577,43 +13138,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *SocialAPISendChatMessageArgs) String() string {
+func (p *SocialAPIUpdateConversationArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("SocialAPISendChatMessageArgs(%+v)", *p)
+	return fmt.Sprintf("SocialAPIUpdateConversationArgs(%+v)", *p)
 
 }
 
-type SocialAPISendChatMessageResult struct {
-	Success *social.SendChatMessageResponse `thrift:"success,0,optional"`
+type SocialAPIUpdateConversationResult struct {
+	Success *social.UpdateConversationResponse `thrift:"success,0,optional"`
 }
 
-func NewSocialAPISendChatMessageResult() *SocialAPISendChatMessageResult {
-	return &SocialAPISendChatMessageResult{}
+func NewSocialAPIUpdateConversationResult() *SocialAPIUpdateConversationResult {
+	return &SocialAPIUpdateConversationResult{}
 }
 
-func (p *SocialAPISendChatMessageResult) InitDefault() {
+func (p *SocialAPIUpdateConversationResult) InitDefault() {
 }
 
-var SocialAPISendChatMessageResult_Success_DEFAULT *social.SendChatMessageResponse
+var SocialAPIUpdateConversationResult_Success_DEFAULT *social.UpdateConversationResponse
 
-func (p *SocialAPISendChatMessageResult) GetSuccess() (v *social.SendChatMessageResponse) {
+func (p *SocialAPIUpdateConversationResult) GetSuccess() (v *social.UpdateConversationResponse) {
 	if !p.IsSetSuccess() {
-		return SocialAPISendChatMessageResult_Success_DEFAULT
+		return SocialAPIUpdateConversationResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_SocialAPISendChatMessageResult = map[int16]string{
+var fieldIDToName_SocialAPIUpdateConversationResult = map[int16]string{
 	0: "success",
 }
 
-func (p *SocialAPISendChatMessageResult) IsSetSuccess() bool {
+func (p *SocialAPIUpdateConversationResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *SocialAPISendChatMessageResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *SocialAPIUpdateConversationResult) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -2658,7 +13219,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_SocialAPISendChatMessageResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_SocialAPIUpdateConversationResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -2668,8 +13229,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *SocialAPISendChatMessageResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := social.NewSendChatMessageResponse()
+func (p *SocialAPIUpdateConversationResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := social.NewUpdateConversationResponse()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -2677,9 +13238,9 @@ func (p *SocialAPISendChatMessageResult) ReadField0(iprot thrift.TProtocol) erro
 	return nil
 }
 
-func (p *SocialAPISendChatMessageResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *SocialAPIUpdateConversationResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("SendChatMessage_result"); err != nil {
+	if err = oprot.WriteStructBegin("UpdateConversation_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -2705,7 +13266,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *SocialAPISendChatMessageResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *SocialAPIUpdateConversationResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -2724,43 +13285,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *SocialAPISendChatMessageResult) String() string {
+func (p *SocialAPIUpdateConversationResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("SocialAPISendChatMessageResult(%+v)", *p)
+	return fmt.Sprintf("SocialAPIUpdateConversationResult(%+v)", *p)
 
 }
 
-type SocialAPIGetChatMessagesArgs struct {
-	Request *social.GetChatMessagesRequest `thrift:"request,1"`
+type SocialAPISyncMessagesArgs struct {
+	Request *social.SyncMessagesRequest `thrift:"request,1"`
 }
 
-func NewSocialAPIGetChatMessagesArgs() *SocialAPIGetChatMessagesArgs {
-	return &SocialAPIGetChatMessagesArgs{}
+func NewSocialAPISyncMessagesArgs() *SocialAPISyncMessagesArgs {
+	return &SocialAPISyncMessagesArgs{}
 }
 
-func (p *SocialAPIGetChatMessagesArgs) InitDefault() {
+func (p *SocialAPISyncMessagesArgs) InitDefault() {
 }
 
-var SocialAPIGetChatMessagesArgs_Request_DEFAULT *social.GetChatMessagesRequest
+var SocialAPISyncMessagesArgs_Request_DEFAULT *social.SyncMessagesRequest
 
-func (p *SocialAPIGetChatMessagesArgs) GetRequest() (v *social.GetChatMessagesRequest) {
+func (p *SocialAPISyncMessagesArgs) GetRequest() (v *social.SyncMessagesRequest) {
 	if !p.IsSetRequest() {
-		return SocialAPIGetChatMessagesArgs_Request_DEFAULT
+		return SocialAPISyncMessagesArgs_Request_DEFAULT
 	}
 	return p.Request
 }
 
-var fieldIDToName_SocialAPIGetChatMessagesArgs = map[int16]string{
+var fieldIDToName_SocialAPISyncMessagesArgs = map[int16]string{
 	1: "request",
 }
 
-func (p *SocialAPIGetChatMessagesArgs) IsSetRequest() bool {
+func (p *SocialAPISyncMessagesArgs) IsSetRequest() bool {
 	return p.Request != nil
 }
 
-func (p *SocialAPIGetChatMessagesArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *SocialAPISyncMessagesArgs) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -2805,7 +13366,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_SocialAPIGetChatMessagesArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_SocialAPISyncMessagesArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -2815,8 +13376,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *SocialAPIGetChatMessagesArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := social.NewGetChatMessagesRequest()
+func (p *SocialAPISyncMessagesArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := social.NewSyncMessagesRequest()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -2824,9 +13385,9 @@ func (p *SocialAPIGetChatMessagesArgs) ReadField1(iprot thrift.TProtocol) error
 	return nil
 }
 
-func (p *SocialAPIGetChatMessagesArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *SocialAPISyncMessagesArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("GetChatMessages_args"); err != nil {
+	if err = oprot.WriteStructBegin("SyncMessages_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -2852,7 +13413,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *SocialAPIGetChatMessagesArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *SocialAPISyncMessagesArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("request", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -2869,43 +13430,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *SocialAPIGetChatMessagesArgs) String() string {
+func (p *SocialAPISyncMessagesArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("SocialAPIGetChatMessagesArgs(%+v)", *p)
+	return fmt.Sprintf("SocialAPISyncMessagesArgs(%+v)", *p)
 
 }
 
-type SocialAPIGetChatMessagesResult struct {
-	Success *social.GetChatMessagesResponse `thrift:"success,0,optional"`
+type SocialAPISyncMessagesResult struct {
+	Success *social.SyncMessagesResponse `thrift:"success,0,optional"`
 }
 
-func NewSocialAPIGetChatMessagesResult() *SocialAPIGetChatMessagesResult {
-	return &SocialAPIGetChatMessagesResult{}
+func NewSocialAPISyncMessagesResult() *SocialAPISyncMessagesResult {
+	return &SocialAPISyncMessagesResult{}
 }
 
-func (p *SocialAPIGetChatMessagesResult) InitDefault() {
+func (p *SocialAPISyncMessagesResult) InitDefault() {
 }
 
-var SocialAPIGetChatMessagesResult_Success_DEFAULT *social.GetChatMessagesResponse
+var SocialAPISyncMessagesResult_Success_DEFAULT *social.SyncMessagesResponse
 
-func (p *SocialAPIGetChatMessagesResult) GetSuccess() (v *social.GetChatMessagesResponse) {
+func (p *SocialAPISyncMessagesResult) GetSuccess() (v *social.SyncMessagesResponse) {
 	if !p.IsSetSuccess() {
-		return SocialAPIGetChatMessagesResult_Success_DEFAULT
+		return SocialAPISyncMessagesResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_SocialAPIGetChatMessagesResult = map[int16]string{
+var fieldIDToName_SocialAPISyncMessagesResult = map[int16]string{
 	0: "success",
 }
 
-func (p *SocialAPIGetChatMessagesResult) IsSetSuccess() bool {
+func (p *SocialAPISyncMessagesResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *SocialAPIGetChatMessagesResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *SocialAPISyncMessagesResult) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -2950,7 +13511,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_SocialAPIGetChatMessagesResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_SocialAPISyncMessagesResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -2960,8 +13521,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *SocialAPIGetChatMessagesResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := social.NewGetChatMessagesResponse()
+func (p *SocialAPISyncMessagesResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := social.NewSyncMessagesResponse()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -2969,9 +13530,9 @@ func (p *SocialAPIGetChatMessagesResult) ReadField0(iprot thrift.TProtocol) erro
 	return nil
 }
 
-func (p *SocialAPIGetChatMessagesResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *SocialAPISyncMessagesResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("GetChatMessages_result"); err != nil {
+	if err = oprot.WriteStructBegin("SyncMessages_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -2997,7 +13558,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *SocialAPIGetChatMessagesResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *SocialAPISyncMessagesResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -3016,43 +13577,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *SocialAPIGetChatMessagesResult) String() string {
+func (p *SocialAPISyncMessagesResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("SocialAPIGetChatMessagesResult(%+v)", *p)
+	return fmt.Sprintf("SocialAPISyncMessagesResult(%+v)", *p)
 
 }
 
-type SocialAPIAddFriendArgs struct {
-	Request *social.AddFriendRequest `thrift:"request,1"`
+type SocialAPIGetNotificationsArgs struct {
+	Request *social.GetNotificationsRequest `thrift:"request,1"`
 }
 
-func NewSocialAPIAddFriendArgs() *SocialAPIAddFriendArgs {
-	return &SocialAPIAddFriendArgs{}
+func NewSocialAPIGetNotificationsArgs() *SocialAPIGetNotificationsArgs {
+	return &SocialAPIGetNotificationsArgs{}
 }
 
-func (p *SocialAPIAddFriendArgs) InitDefault() {
+func (p *SocialAPIGetNotificationsArgs) InitDefault() {
 }
 
-var SocialAPIAddFriendArgs_Request_DEFAULT *social.AddFriendRequest
+var SocialAPIGetNotificationsArgs_Request_DEFAULT *social.GetNotificationsRequest
 
-func (p *SocialAPIAddFriendArgs) GetRequest() (v *social.AddFriendRequest) {
+func (p *SocialAPIGetNotificationsArgs) GetRequest() (v *social.GetNotificationsRequest) {
 	if !p.IsSetRequest() {
-		return SocialAPIAddFriendArgs_Request_DEFAULT
+		return SocialAPIGetNotificationsArgs_Request_DEFAULT
 	}
 	return p.Request
 }
 
-var fieldIDToName_SocialAPIAddFriendArgs = map[int16]string{
+var fieldIDToName_SocialAPIGetNotificationsArgs = map[int16]string{
 	1: "request",
 }
 
-func (p *SocialAPIAddFriendArgs) IsSetRequest() bool {
+func (p *SocialAPIGetNotificationsArgs) IsSetRequest() bool {
 	return p.Request != nil
 }
 
-func (p *SocialAPIAddFriendArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *SocialAPIGetNotificationsArgs) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -3097,7 +13658,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_SocialAPIAddFriendArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_SocialAPIGetNotificationsArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -3107,8 +13668,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *SocialAPIAddFriendArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := social.NewAddFriendRequest()
+func (p *SocialAPIGetNotificationsArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := social.NewGetNotificationsRequest()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -3116,9 +13677,9 @@ func (p *SocialAPIAddFriendArgs) ReadField1(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *SocialAPIAddFriendArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *SocialAPIGetNotificationsArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("AddFriend_args"); err != nil {
+	if err = oprot.WriteStructBegin("GetNotifications_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -3144,7 +13705,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *SocialAPIAddFriendArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *SocialAPIGetNotificationsArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("request", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -3161,43 +13722,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *SocialAPIAddFriendArgs) String() string {
+func (p *SocialAPIGetNotificationsArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("SocialAPIAddFriendArgs(%+v)", *p)
+	return fmt.Sprintf("SocialAPIGetNotificationsArgs(%+v)", *p)
 
 }
 
-type SocialAPIAddFriendResult struct {
-	Success *social.AddFriendResponse `thrift:"success,0,optional"`
+type SocialAPIGetNotificationsResult struct {
+	Success *social.GetNotificationsResponse `thrift:"success,0,optional"`
 }
 
-func NewSocialAPIAddFriendResult() *SocialAPIAddFriendResult {
-	return &SocialAPIAddFriendResult{}
+func NewSocialAPIGetNotificationsResult() *SocialAPIGetNotificationsResult {
+	return &SocialAPIGetNotificationsResult{}
 }
 
-func (p *SocialAPIAddFriendResult) InitDefault() {
+func (p *SocialAPIGetNotificationsResult) InitDefault() {
 }
 
-var SocialAPIAddFriendResult_Success_DEFAULT *social.AddFriendResponse
+var SocialAPIGetNotificationsResult_Success_DEFAULT *social.GetNotificationsResponse
 
-func (p *SocialAPIAddFriendResult) GetSuccess() (v *social.AddFriendResponse) {
+func (p *SocialAPIGetNotificationsResult) GetSuccess() (v *social.GetNotificationsResponse) {
 	if !p.IsSetSuccess() {
-		return SocialAPIAddFriendResult_Success_DEFAULT
+		return SocialAPIGetNotificationsResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_SocialAPIAddFriendResult = map[int16]string{
+var fieldIDToName_SocialAPIGetNotificationsResult = map[int16]string{
 	0: "success",
 }
 
-func (p *SocialAPIAddFriendResult) IsSetSuccess() bool {
+func (p *SocialAPIGetNotificationsResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *SocialAPIAddFriendResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *SocialAPIGetNotificationsResult) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -3242,7 +13803,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_SocialAPIAddFriendResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_SocialAPIGetNotificationsResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -3252,8 +13813,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *SocialAPIAddFriendResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := social.NewAddFriendResponse()
+func (p *SocialAPIGetNotificationsResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := social.NewGetNotificationsResponse()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -3261,9 +13822,9 @@ func (p *SocialAPIAddFriendResult) ReadField0(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *SocialAPIAddFriendResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *SocialAPIGetNotificationsResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("AddFriend_result"); err != nil {
+	if err = oprot.WriteStructBegin("GetNotifications_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -3289,7 +13850,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *SocialAPIAddFriendResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *SocialAPIGetNotificationsResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -3308,43 +13869,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *SocialAPIAddFriendResult) String() string {
+func (p *SocialAPIGetNotificationsResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("SocialAPIAddFriendResult(%+v)", *p)
+	return fmt.Sprintf("SocialAPIGetNotificationsResult(%+v)", *p)
 
 }
 
-type SocialAPIGetFriendshipArgs struct {
-	Request *social.GetFriendshipRequest `thrift:"request,1"`
+type SocialAPIGetNotificationUnreadCountArgs struct {
+	Request *social.GetNotificationUnreadCountRequest `thrift:"request,1"`
 }
 
-func NewSocialAPIGetFriendshipArgs() *SocialAPIGetFriendshipArgs {
-	return &SocialAPIGetFriendshipArgs{}
+func NewSocialAPIGetNotificationUnreadCountArgs() *SocialAPIGetNotificationUnreadCountArgs {
+	return &SocialAPIGetNotificationUnreadCountArgs{}
 }
 
-func (p *SocialAPIGetFriendshipArgs) InitDefault() {
+func (p *SocialAPIGetNotificationUnreadCountArgs) InitDefault() {
 }
 
-var SocialAPIGetFriendshipArgs_Request_DEFAULT *social.GetFriendshipRequest
+var SocialAPIGetNotificationUnreadCountArgs_Request_DEFAULT *social.GetNotificationUnreadCountRequest
 
-func (p *SocialAPIGetFriendshipArgs) GetRequest() (v *social.GetFriendshipRequest) {
+func (p *SocialAPIGetNotificationUnreadCountArgs) GetRequest() (v *social.GetNotificationUnreadCountRequest) {
 	if !p.IsSetRequest() {
-		return SocialAPIGetFriendshipArgs_Request_DEFAULT
+		return SocialAPIGetNotificationUnreadCountArgs_Request_DEFAULT
 	}
 	return p.Request
 }
 
-var fieldIDToName_SocialAPIGetFriendshipArgs = map[int16]string{
+var fieldIDToName_SocialAPIGetNotificationUnreadCountArgs = map[int16]string{
 	1: "request",
 }
 
-func (p *SocialAPIGetFriendshipArgs) IsSetRequest() bool {
+func (p *SocialAPIGetNotificationUnreadCountArgs) IsSetRequest() bool {
 	return p.Request != nil
 }
 
-func (p *SocialAPIGetFriendshipArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *SocialAPIGetNotificationUnreadCountArgs) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -3389,7 +13950,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_SocialAPIGetFriendshipArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_SocialAPIGetNotificationUnreadCountArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -3399,8 +13960,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *SocialAPIGetFriendshipArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := social.NewGetFriendshipRequest()
+func (p *SocialAPIGetNotificationUnreadCountArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := social.NewGetNotificationUnreadCountRequest()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -3408,9 +13969,9 @@ func (p *SocialAPIGetFriendshipArgs) ReadField1(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *SocialAPIGetFriendshipArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *SocialAPIGetNotificationUnreadCountArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("GetFriendship_args"); err != nil {
+	if err = oprot.WriteStructBegin("GetNotificationUnreadCount_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -3436,7 +13997,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *SocialAPIGetFriendshipArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *SocialAPIGetNotificationUnreadCountArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("request", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -3453,43 +14014,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *SocialAPIGetFriendshipArgs) String() string {
+func (p *SocialAPIGetNotificationUnreadCountArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("SocialAPIGetFriendshipArgs(%+v)", *p)
+	return fmt.Sprintf("SocialAPIGetNotificationUnreadCountArgs(%+v)", *p)
 
 }
 
-type SocialAPIGetFriendshipResult struct {
-	Success *social.GetFriendshipResponse `thrift:"success,0,optional"`
+type SocialAPIGetNotificationUnreadCountResult struct {
+	Success *social.GetNotificationUnreadCountResponse `thrift:"success,0,optional"`
 }
 
-func NewSocialAPIGetFriendshipResult() *SocialAPIGetFriendshipResult {
-	return &SocialAPIGetFriendshipResult{}
+func NewSocialAPIGetNotificationUnreadCountResult() *SocialAPIGetNotificationUnreadCountResult {
+	return &SocialAPIGetNotificationUnreadCountResult{}
 }
 
-func (p *SocialAPIGetFriendshipResult) InitDefault() {
+func (p *SocialAPIGetNotificationUnreadCountResult) InitDefault() {
 }
 
-var SocialAPIGetFriendshipResult_Success_DEFAULT *social.GetFriendshipResponse
+var SocialAPIGetNotificationUnreadCountResult_Success_DEFAULT *social.GetNotificationUnreadCountResponse
 
-func (p *SocialAPIGetFriendshipResult) GetSuccess() (v *social.GetFriendshipResponse) {
+func (p *SocialAPIGetNotificationUnreadCountResult) GetSuccess() (v *social.GetNotificationUnreadCountResponse) {
 	if !p.IsSetSuccess() {
-		return SocialAPIGetFriendshipResult_Success_DEFAULT
+		return SocialAPIGetNotificationUnreadCountResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_SocialAPIGetFriendshipResult = map[int16]string{
+var fieldIDToName_SocialAPIGetNotificationUnreadCountResult = map[int16]string{
 	0: "success",
 }
 
-func (p *SocialAPIGetFriendshipResult) IsSetSuccess() bool {
+func (p *SocialAPIGetNotificationUnreadCountResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *SocialAPIGetFriendshipResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *SocialAPIGetNotificationUnreadCountResult) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -3534,7 +14095,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_SocialAPIGetFriendshipResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_SocialAPIGetNotificationUnreadCountResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -3544,8 +14105,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *SocialAPIGetFriendshipResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := social.NewGetFriendshipResponse()
+func (p *SocialAPIGetNotificationUnreadCountResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := social.NewGetNotificationUnreadCountResponse()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -3553,9 +14114,9 @@ func (p *SocialAPIGetFriendshipResult) ReadField0(iprot thrift.TProtocol) error
 	return nil
 }
 
-func (p *SocialAPIGetFriendshipResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *SocialAPIGetNotificationUnreadCountResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("GetFriendship_result"); err != nil {
+	if err = oprot.WriteStructBegin("GetNotificationUnreadCount_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -3581,7 +14142,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *SocialAPIGetFriendshipResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *SocialAPIGetNotificationUnreadCountResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -3600,43 +14161,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *SocialAPIGetFriendshipResult) String() string {
+func (p *SocialAPIGetNotificationUnreadCountResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("SocialAPIGetFriendshipResult(%+v)", *p)
+	return fmt.Sprintf("SocialAPIGetNotificationUnreadCountResult(%+v)", *p)
 
 }
 
-type SocialAPIGetUserFriendsArgs struct {
-	Request *social.GetUserFriendsRequest `thrift:"request,1"`
+type SocialAPIMarkNotificationsReadArgs struct {
+	Request *social.MarkNotificationsReadRequest `thrift:"request,1"`
 }
 
-func NewSocialAPIGetUserFriendsArgs() *SocialAPIGetUserFriendsArgs {
-	return &SocialAPIGetUserFriendsArgs{}
+func NewSocialAPIMarkNotificationsReadArgs() *SocialAPIMarkNotificationsReadArgs {
+	return &SocialAPIMarkNotificationsReadArgs{}
 }
 
-func (p *SocialAPIGetUserFriendsArgs) InitDefault() {
+func (p *SocialAPIMarkNotificationsReadArgs) InitDefault() {
 }
 
-var SocialAPIGetUserFriendsArgs_Request_DEFAULT *social.GetUserFriendsRequest
+var SocialAPIMarkNotificationsReadArgs_Request_DEFAULT *social.MarkNotificationsReadRequest
 
-func (p *SocialAPIGetUserFriendsArgs) GetRequest() (v *social.GetUserFriendsRequest) {
+func (p *SocialAPIMarkNotificationsReadArgs) GetRequest() (v *social.MarkNotificationsReadRequest) {
 	if !p.IsSetRequest() {
-		return SocialAPIGetUserFriendsArgs_Request_DEFAULT
+		return SocialAPIMarkNotificationsReadArgs_Request_DEFAULT
 	}
 	return p.Request
 }
 
-var fieldIDToName_SocialAPIGetUserFriendsArgs = map[int16]string{
+var fieldIDToName_SocialAPIMarkNotificationsReadArgs = map[int16]string{
 	1: "request",
 }
 
-func (p *SocialAPIGetUserFriendsArgs) IsSetRequest() bool {
+func (p *SocialAPIMarkNotificationsReadArgs) IsSetRequest() bool {
 	return p.Request != nil
 }
 
-func (p *SocialAPIGetUserFriendsArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *SocialAPIMarkNotificationsReadArgs) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -3681,7 +14242,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_SocialAPIGetUserFriendsArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_SocialAPIMarkNotificationsReadArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -3691,8 +14252,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *SocialAPIGetUserFriendsArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := social.NewGetUserFriendsRequest()
+func (p *SocialAPIMarkNotificationsReadArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := social.NewMarkNotificationsReadRequest()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -3700,9 +14261,9 @@ func (p *SocialAPIGetUserFriendsArgs) ReadField1(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *SocialAPIGetUserFriendsArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *SocialAPIMarkNotificationsReadArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("GetUserFriends_args"); err != nil {
+	if err = oprot.WriteStructBegin("MarkNotificationsRead_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -3728,7 +14289,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *SocialAPIGetUserFriendsArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *SocialAPIMarkNotificationsReadArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("request", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -3745,43 +14306,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *SocialAPIGetUserFriendsArgs) String() string {
+func (p *SocialAPIMarkNotificationsReadArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("SocialAPIGetUserFriendsArgs(%+v)", *p)
+	return fmt.Sprintf("SocialAPIMarkNotificationsReadArgs(%+v)", *p)
 
 }
 
-type SocialAPIGetUserFriendsResult struct {
-	Success *social.GetUserFriendsResponse `thrift:"success,0,optional"`
+type SocialAPIMarkNotificationsReadResult struct {
+	Success *social.MarkNotificationsReadResponse `thrift:"success,0,optional"`
 }
 
-func NewSocialAPIGetUserFriendsResult() *SocialAPIGetUserFriendsResult {
-	return &SocialAPIGetUserFriendsResult{}
+func NewSocialAPIMarkNotificationsReadResult() *SocialAPIMarkNotificationsReadResult {
+	return &SocialAPIMarkNotificationsReadResult{}
 }
 
-func (p *SocialAPIGetUserFriendsResult) InitDefault() {
+func (p *SocialAPIMarkNotificationsReadResult) InitDefault() {
 }
 
-var SocialAPIGetUserFriendsResult_Success_DEFAULT *social.GetUserFriendsResponse
+var SocialAPIMarkNotificationsReadResult_Success_DEFAULT *social.MarkNotificationsReadResponse
 
-func (p *SocialAPIGetUserFriendsResult) GetSuccess() (v *social.GetUserFriendsResponse) {
+func (p *SocialAPIMarkNotificationsReadResult) GetSuccess() (v *social.MarkNotificationsReadResponse) {
 	if !p.IsSetSuccess() {
-		return SocialAPIGetUserFriendsResult_Success_DEFAULT
+		return SocialAPIMarkNotificationsReadResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_SocialAPIGetUserFriendsResult = map[int16]string{
+var fieldIDToName_SocialAPIMarkNotificationsReadResult = map[int16]string{
 	0: "success",
 }
 
-func (p *SocialAPIGetUserFriendsResult) IsSetSuccess() bool {
+func (p *SocialAPIMarkNotificationsReadResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *SocialAPIGetUserFriendsResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *SocialAPIMarkNotificationsReadResult) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -3826,7 +14387,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_SocialAPIGetUserFriendsResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_SocialAPIMarkNotificationsReadResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -3836,8 +14397,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *SocialAPIGetUserFriendsResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := social.NewGetUserFriendsResponse()
+func (p *SocialAPIMarkNotificationsReadResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := social.NewMarkNotificationsReadResponse()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -3845,9 +14406,9 @@ func (p *SocialAPIGetUserFriendsResult) ReadField0(iprot thrift.TProtocol) error
 	return nil
 }
 
-func (p *SocialAPIGetUserFriendsResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *SocialAPIMarkNotificationsReadResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("GetUserFriends_result"); err != nil {
+	if err = oprot.WriteStructBegin("MarkNotificationsRead_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -3873,7 +14434,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *SocialAPIGetUserFriendsResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *SocialAPIMarkNotificationsReadResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -3892,43 +14453,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *SocialAPIGetUserFriendsResult) String() string {
+func (p *SocialAPIMarkNotificationsReadResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("SocialAPIGetUserFriendsResult(%+v)", *p)
+	return fmt.Sprintf("SocialAPIMarkNotificationsReadResult(%+v)", *p)
 
 }
 
-type SocialAPICreateFriendRequestArgs struct {
-	Request *social.CreateFriendRequestRequest `thrift:"request,1"`
+type SocialAPIGetNotificationPreferencesArgs struct {
+	Request *social.GetNotificationPreferencesRequest `thrift:"request,1"`
 }
 
-func NewSocialAPICreateFriendRequestArgs() *SocialAPICreateFriendRequestArgs {
-	return &SocialAPICreateFriendRequestArgs{}
+func NewSocialAPIGetNotificationPreferencesArgs() *SocialAPIGetNotificationPreferencesArgs {
+	return &SocialAPIGetNotificationPreferencesArgs{}
 }
 
-func (p *SocialAPICreateFriendRequestArgs) InitDefault() {
+func (p *SocialAPIGetNotificationPreferencesArgs) InitDefault() {
 }
 
-var SocialAPICreateFriendRequestArgs_Request_DEFAULT *social.CreateFriendRequestRequest
+var SocialAPIGetNotificationPreferencesArgs_Request_DEFAULT *social.GetNotificationPreferencesRequest
 
-func (p *SocialAPICreateFriendRequestArgs) GetRequest() (v *social.CreateFriendRequestRequest) {
+func (p *SocialAPIGetNotificationPreferencesArgs) GetRequest() (v *social.GetNotificationPreferencesRequest) {
 	if !p.IsSetRequest() {
-		return SocialAPICreateFriendRequestArgs_Request_DEFAULT
+		return SocialAPIGetNotificationPreferencesArgs_Request_DEFAULT
 	}
 	return p.Request
 }
 
-var fieldIDToName_SocialAPICreateFriendRequestArgs = map[int16]string{
+var fieldIDToName_SocialAPIGetNotificationPreferencesArgs = map[int16]string{
 	1: "request",
 }
 
-func (p *SocialAPICreateFriendRequestArgs) IsSetRequest() bool {
+func (p *SocialAPIGetNotificationPreferencesArgs) IsSetRequest() bool {
 	return p.Request != nil
 }
 
-func (p *SocialAPICreateFriendRequestArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *SocialAPIGetNotificationPreferencesArgs) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -3973,7 +14534,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_SocialAPICreateFriendRequestArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_SocialAPIGetNotificationPreferencesArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -3983,8 +14544,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *SocialAPICreateFriendRequestArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := social.NewCreateFriendRequestRequest()
+func (p *SocialAPIGetNotificationPreferencesArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := social.NewGetNotificationPreferencesRequest()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -3992,9 +14553,9 @@ func (p *SocialAPICreateFriendRequestArgs) ReadField1(iprot thrift.TProtocol) er
 	return nil
 }
 
-func (p *SocialAPICreateFriendRequestArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *SocialAPIGetNotificationPreferencesArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("CreateFriendRequest_args"); err != nil {
+	if err = oprot.WriteStructBegin("GetNotificationPreferences_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -4020,7 +14581,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *SocialAPICreateFriendRequestArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *SocialAPIGetNotificationPreferencesArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("request", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -4037,43 +14598,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *SocialAPICreateFriendRequestArgs) String() string {
+func (p *SocialAPIGetNotificationPreferencesArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("SocialAPICreateFriendRequestArgs(%+v)", *p)
+	return fmt.Sprintf("SocialAPIGetNotificationPreferencesArgs(%+v)", *p)
 
 }
 
-type SocialAPICreateFriendRequestResult struct {
-	Success *social.CreateFriendRequestResponse `thrift:"success,0,optional"`
+type SocialAPIGetNotificationPreferencesResult struct {
+	Success *social.GetNotificationPreferencesResponse `thrift:"success,0,optional"`
 }
 
-func NewSocialAPICreateFriendRequestResult() *SocialAPICreateFriendRequestResult {
-	return &SocialAPICreateFriendRequestResult{}
+func NewSocialAPIGetNotificationPreferencesResult() *SocialAPIGetNotificationPreferencesResult {
+	return &SocialAPIGetNotificationPreferencesResult{}
 }
 
-func (p *SocialAPICreateFriendRequestResult) InitDefault() {
+func (p *SocialAPIGetNotificationPreferencesResult) InitDefault() {
 }
 
-var SocialAPICreateFriendRequestResult_Success_DEFAULT *social.CreateFriendRequestResponse
+var SocialAPIGetNotificationPreferencesResult_Success_DEFAULT *social.GetNotificationPreferencesResponse
 
-func (p *SocialAPICreateFriendRequestResult) GetSuccess() (v *social.CreateFriendRequestResponse) {
+func (p *SocialAPIGetNotificationPreferencesResult) GetSuccess() (v *social.GetNotificationPreferencesResponse) {
 	if !p.IsSetSuccess() {
-		return SocialAPICreateFriendRequestResult_Success_DEFAULT
+		return SocialAPIGetNotificationPreferencesResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_SocialAPICreateFriendRequestResult = map[int16]string{
+var fieldIDToName_SocialAPIGetNotificationPreferencesResult = map[int16]string{
 	0: "success",
 }
 
-func (p *SocialAPICreateFriendRequestResult) IsSetSuccess() bool {
+func (p *SocialAPIGetNotificationPreferencesResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *SocialAPICreateFriendRequestResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *SocialAPIGetNotificationPreferencesResult) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -4118,7 +14679,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_SocialAPICreateFriendRequestResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_SocialAPIGetNotificationPreferencesResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -4128,8 +14689,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *SocialAPICreateFriendRequestResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := social.NewCreateFriendRequestResponse()
+func (p *SocialAPIGetNotificationPreferencesResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := social.NewGetNotificationPreferencesResponse()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -4137,9 +14698,9 @@ func (p *SocialAPICreateFriendRequestResult) ReadField0(iprot thrift.TProtocol)
 	return nil
 }
 
-func (p *SocialAPICreateFriendRequestResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *SocialAPIGetNotificationPreferencesResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("CreateFriendRequest_result"); err != nil {
+	if err = oprot.WriteStructBegin("GetNotificationPreferences_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -4165,7 +14726,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *SocialAPICreateFriendRequestResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *SocialAPIGetNotificationPreferencesResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -4184,43 +14745,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *SocialAPICreateFriendRequestResult) String() string {
+func (p *SocialAPIGetNotificationPreferencesResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("SocialAPICreateFriendRequestResult(%+v)", *p)
+	return fmt.Sprintf("SocialAPIGetNotificationPreferencesResult(%+v)", *p)
 
 }
 
-type SocialAPIGetFriendRequestsArgs struct {
-	Request *social.GetFriendRequestsRequest `thrift:"request,1"`
+type SocialAPIUpdateNotificationPreferencesArgs struct {
+	Request *social.UpdateNotificationPreferencesRequest `thrift:"request,1"`
 }
 
-func NewSocialAPIGetFriendRequestsArgs() *SocialAPIGetFriendRequestsArgs {
-	return &SocialAPIGetFriendRequestsArgs{}
+func NewSocialAPIUpdateNotificationPreferencesArgs() *SocialAPIUpdateNotificationPreferencesArgs {
+	return &SocialAPIUpdateNotificationPreferencesArgs{}
 }
 
-func (p *SocialAPIGetFriendRequestsArgs) InitDefault() {
+func (p *SocialAPIUpdateNotificationPreferencesArgs) InitDefault() {
 }
 
-var SocialAPIGetFriendRequestsArgs_Request_DEFAULT *social.GetFriendRequestsRequest
+var SocialAPIUpdateNotificationPreferencesArgs_Request_DEFAULT *social.UpdateNotificationPreferencesRequest
 
-func (p *SocialAPIGetFriendRequestsArgs) GetRequest() (v *social.GetFriendRequestsRequest) {
+func (p *SocialAPIUpdateNotificationPreferencesArgs) GetRequest() (v *social.UpdateNotificationPreferencesRequest) {
 	if !p.IsSetRequest() {
-		return SocialAPIGetFriendRequestsArgs_Request_DEFAULT
+		return SocialAPIUpdateNotificationPreferencesArgs_Request_DEFAULT
 	}
 	return p.Request
 }
 
-var fieldIDToName_SocialAPIGetFriendRequestsArgs = map[int16]string{
+var fieldIDToName_SocialAPIUpdateNotificationPreferencesArgs = map[int16]string{
 	1: "request",
 }
 
-func (p *SocialAPIGetFriendRequestsArgs) IsSetRequest() bool {
+func (p *SocialAPIUpdateNotificationPreferencesArgs) IsSetRequest() bool {
 	return p.Request != nil
 }
 
-func (p *SocialAPIGetFriendRequestsArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *SocialAPIUpdateNotificationPreferencesArgs) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -4265,7 +14826,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_SocialAPIGetFriendRequestsArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_SocialAPIUpdateNotificationPreferencesArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -4275,8 +14836,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *SocialAPIGetFriendRequestsArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := social.NewGetFriendRequestsRequest()
+func (p *SocialAPIUpdateNotificationPreferencesArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := social.NewUpdateNotificationPreferencesRequest()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -4284,9 +14845,9 @@ func (p *SocialAPIGetFriendRequestsArgs) ReadField1(iprot thrift.TProtocol) erro
 	return nil
 }
 
-func (p *SocialAPIGetFriendRequestsArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *SocialAPIUpdateNotificationPreferencesArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("GetFriendRequests_args"); err != nil {
+	if err = oprot.WriteStructBegin("UpdateNotificationPreferences_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -4312,7 +14873,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *SocialAPIGetFriendRequestsArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *SocialAPIUpdateNotificationPreferencesArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("request", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -4329,43 +14890,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *SocialAPIGetFriendRequestsArgs) String() string {
+func (p *SocialAPIUpdateNotificationPreferencesArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("SocialAPIGetFriendRequestsArgs(%+v)", *p)
+	return fmt.Sprintf("SocialAPIUpdateNotificationPreferencesArgs(%+v)", *p)
 
 }
 
-type SocialAPIGetFriendRequestsResult struct {
-	Success *social.GetFriendRequestsResponse `thrift:"success,0,optional"`
+type SocialAPIUpdateNotificationPreferencesResult struct {
+	Success *social.UpdateNotificationPreferencesResponse `thrift:"success,0,optional"`
 }
 
-func NewSocialAPIGetFriendRequestsResult() *SocialAPIGetFriendRequestsResult {
-	return &SocialAPIGetFriendRequestsResult{}
+func NewSocialAPIUpdateNotificationPreferencesResult() *SocialAPIUpdateNotificationPreferencesResult {
+	return &SocialAPIUpdateNotificationPreferencesResult{}
 }
 
-func (p *SocialAPIGetFriendRequestsResult) InitDefault() {
+func (p *SocialAPIUpdateNotificationPreferencesResult) InitDefault() {
 }
 
-var SocialAPIGetFriendRequestsResult_Success_DEFAULT *social.GetFriendRequestsResponse
+var SocialAPIUpdateNotificationPreferencesResult_Success_DEFAULT *social.UpdateNotificationPreferencesResponse
 
-func (p *SocialAPIGetFriendRequestsResult) GetSuccess() (v *social.GetFriendRequestsResponse) {
+func (p *SocialAPIUpdateNotificationPreferencesResult) GetSuccess() (v *social.UpdateNotificationPreferencesResponse) {
 	if !p.IsSetSuccess() {
-		return SocialAPIGetFriendRequestsResult_Success_DEFAULT
+		return SocialAPIUpdateNotificationPreferencesResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_SocialAPIGetFriendRequestsResult = map[int16]string{
+var fieldIDToName_SocialAPIUpdateNotificationPreferencesResult = map[int16]string{
 	0: "success",
 }
 
-func (p *SocialAPIGetFriendRequestsResult) IsSetSuccess() bool {
+func (p *SocialAPIUpdateNotificationPreferencesResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *SocialAPIGetFriendRequestsResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *SocialAPIUpdateNotificationPreferencesResult) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -4410,7 +14971,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_SocialAPIGetFriendRequestsResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_SocialAPIUpdateNotificationPreferencesResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -4420,8 +14981,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *SocialAPIGetFriendRequestsResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := social.NewGetFriendRequestsResponse()
+func (p *SocialAPIUpdateNotificationPreferencesResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := social.NewUpdateNotificationPreferencesResponse()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -4429,9 +14990,9 @@ func (p *SocialAPIGetFriendRequestsResult) ReadField0(iprot thrift.TProtocol) er
 	return nil
 }
 
-func (p *SocialAPIGetFriendRequestsResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *SocialAPIUpdateNotificationPreferencesResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("GetFriendRequests_result"); err != nil {
+	if err = oprot.WriteStructBegin("UpdateNotificationPreferences_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -4457,7 +15018,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *SocialAPIGetFriendRequestsResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *SocialAPIUpdateNotificationPreferencesResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -4476,43 +15037,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *SocialAPIGetFriendRequestsResult) String() string {
+func (p *SocialAPIUpdateNotificationPreferencesResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("SocialAPIGetFriendRequestsResult(%+v)", *p)
+	return fmt.Sprintf("SocialAPIUpdateNotificationPreferencesResult(%+v)", *p)
 
 }
 
-type SocialAPIHandleFriendRequestArgs struct {
-	Request *social.HandleFriendRequestRequest `thrift:"request,1"`
+type SocialAPIGetPresenceArgs struct {
+	Request *social.GetPresenceRequest `thrift:"request,1"`
 }
 
-func NewSocialAPIHandleFriendRequestArgs() *SocialAPIHandleFriendRequestArgs {
-	return &SocialAPIHandleFriendRequestArgs{}
+func NewSocialAPIGetPresenceArgs() *SocialAPIGetPresenceArgs {
+	return &SocialAPIGetPresenceArgs{}
 }
 
-func (p *SocialAPIHandleFriendRequestArgs) InitDefault() {
+func (p *SocialAPIGetPresenceArgs) InitDefault() {
 }
 
-var SocialAPIHandleFriendRequestArgs_Request_DEFAULT *social.HandleFriendRequestRequest
+var SocialAPIGetPresenceArgs_Request_DEFAULT *social.GetPresenceRequest
 
-func (p *SocialAPIHandleFriendRequestArgs) GetRequest() (v *social.HandleFriendRequestRequest) {
+func (p *SocialAPIGetPresenceArgs) GetRequest() (v *social.GetPresenceRequest) {
 	if !p.IsSetRequest() {
-		return SocialAPIHandleFriendRequestArgs_Request_DEFAULT
+		return SocialAPIGetPresenceArgs_Request_DEFAULT
 	}
 	return p.Request
 }
 
-var fieldIDToName_SocialAPIHandleFriendRequestArgs = map[int16]string{
+var fieldIDToName_SocialAPIGetPresenceArgs = map[int16]string{
 	1: "request",
 }
 
-func (p *SocialAPIHandleFriendRequestArgs) IsSetRequest() bool {
+func (p *SocialAPIGetPresenceArgs) IsSetRequest() bool {
 	return p.Request != nil
 }
 
-func (p *SocialAPIHandleFriendRequestArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *SocialAPIGetPresenceArgs) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -4557,7 +15118,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_SocialAPIHandleFriendRequestArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_SocialAPIGetPresenceArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -4567,8 +15128,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *SocialAPIHandleFriendRequestArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := social.NewHandleFriendRequestRequest()
+func (p *SocialAPIGetPresenceArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := social.NewGetPresenceRequest()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -4576,9 +15137,9 @@ func (p *SocialAPIHandleFriendRequestArgs) ReadField1(iprot thrift.TProtocol) er
 	return nil
 }
 
-func (p *SocialAPIHandleFriendRequestArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *SocialAPIGetPresenceArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("HandleFriendRequest_args"); err != nil {
+	if err = oprot.WriteStructBegin("GetPresence_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -4604,7 +15165,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *SocialAPIHandleFriendRequestArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *SocialAPIGetPresenceArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("request", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -4621,43 +15182,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *SocialAPIHandleFriendRequestArgs) String() string {
+func (p *SocialAPIGetPresenceArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("SocialAPIHandleFriendRequestArgs(%+v)", *p)
+	return fmt.Sprintf("SocialAPIGetPresenceArgs(%+v)", *p)
 
 }
 
-type SocialAPIHandleFriendRequestResult struct {
-	Success *social.HandleFriendRequestResponse `thrift:"success,0,optional"`
+type SocialAPIGetPresenceResult struct {
+	Success *social.GetPresenceResponse `thrift:"success,0,optional"`
 }
 
-func NewSocialAPIHandleFriendRequestResult() *SocialAPIHandleFriendRequestResult {
-	return &SocialAPIHandleFriendRequestResult{}
+func NewSocialAPIGetPresenceResult() *SocialAPIGetPresenceResult {
+	return &SocialAPIGetPresenceResult{}
 }
 
-func (p *SocialAPIHandleFriendRequestResult) InitDefault() {
+func (p *SocialAPIGetPresenceResult) InitDefault() {
 }
 
-var SocialAPIHandleFriendRequestResult_Success_DEFAULT *social.HandleFriendRequestResponse
+var SocialAPIGetPresenceResult_Success_DEFAULT *social.GetPresenceResponse
 
-func (p *SocialAPIHandleFriendRequestResult) GetSuccess() (v *social.HandleFriendRequestResponse) {
+func (p *SocialAPIGetPresenceResult) GetSuccess() (v *social.GetPresenceResponse) {
 	if !p.IsSetSuccess() {
-		return SocialAPIHandleFriendRequestResult_Success_DEFAULT
+		return SocialAPIGetPresenceResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_SocialAPIHandleFriendRequestResult = map[int16]string{
+var fieldIDToName_SocialAPIGetPresenceResult = map[int16]string{
 	0: "success",
 }
 
-func (p *SocialAPIHandleFriendRequestResult) IsSetSuccess() bool {
+func (p *SocialAPIGetPresenceResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *SocialAPIHandleFriendRequestResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *SocialAPIGetPresenceResult) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -4702,7 +15263,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_SocialAPIHandleFriendRequestResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_SocialAPIGetPresenceResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -4712,8 +15273,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *SocialAPIHandleFriendRequestResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := social.NewHandleFriendRequestResponse()
+func (p *SocialAPIGetPresenceResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := social.NewGetPresenceResponse()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -4721,9 +15282,9 @@ func (p *SocialAPIHandleFriendRequestResult) ReadField0(iprot thrift.TProtocol)
 	return nil
 }
 
-func (p *SocialAPIHandleFriendRequestResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *SocialAPIGetPresenceResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("HandleFriendRequest_result"); err != nil {
+	if err = oprot.WriteStructBegin("GetPresence_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -4749,7 +15310,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *SocialAPIHandleFriendRequestResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *SocialAPIGetPresenceResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -4768,43 +15329,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *SocialAPIHandleFriendRequestResult) String() string {
+func (p *SocialAPIGetPresenceResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("SocialAPIHandleFriendRequestResult(%+v)", *p)
+	return fmt.Sprintf("SocialAPIGetPresenceResult(%+v)", *p)
 
 }
 
-type SocialAPIMarkMessageReadArgs struct {
-	Request *social.MarkMessageReadRequest `thrift:"request,1"`
+type SocialAPISearchMessagesArgs struct {
+	Request *social.SearchMessagesRequest `thrift:"request,1"`
 }
 
-func NewSocialAPIMarkMessageReadArgs() *SocialAPIMarkMessageReadArgs {
-	return &SocialAPIMarkMessageReadArgs{}
+func NewSocialAPISearchMessagesArgs() *SocialAPISearchMessagesArgs {
+	return &SocialAPISearchMessagesArgs{}
 }
 
-func (p *SocialAPIMarkMessageReadArgs) InitDefault() {
+func (p *SocialAPISearchMessagesArgs) InitDefault() {
 }
 
-var SocialAPIMarkMessageReadArgs_Request_DEFAULT *social.MarkMessageReadRequest
+var SocialAPISearchMessagesArgs_Request_DEFAULT *social.SearchMessagesRequest
 
-func (p *SocialAPIMarkMessageReadArgs) GetRequest() (v *social.MarkMessageReadRequest) {
+func (p *SocialAPISearchMessagesArgs) GetRequest() (v *social.SearchMessagesRequest) {
 	if !p.IsSetRequest() {
-		return SocialAPIMarkMessageReadArgs_Request_DEFAULT
+		return SocialAPISearchMessagesArgs_Request_DEFAULT
 	}
 	return p.Request
 }
 
-var fieldIDToName_SocialAPIMarkMessageReadArgs = map[int16]string{
+var fieldIDToName_SocialAPISearchMessagesArgs = map[int16]string{
 	1: "request",
 }
 
-func (p *SocialAPIMarkMessageReadArgs) IsSetRequest() bool {
+func (p *SocialAPISearchMessagesArgs) IsSetRequest() bool {
 	return p.Request != nil
 }
 
-func (p *SocialAPIMarkMessageReadArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *SocialAPISearchMessagesArgs) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -4849,7 +15410,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_SocialAPIMarkMessageReadArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_SocialAPISearchMessagesArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -4859,8 +15420,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *SocialAPIMarkMessageReadArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := social.NewMarkMessageReadRequest()
+func (p *SocialAPISearchMessagesArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := social.NewSearchMessagesRequest()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -4868,9 +15429,9 @@ func (p *SocialAPIMarkMessageReadArgs) ReadField1(iprot thrift.TProtocol) error
 	return nil
 }
 
-func (p *SocialAPIMarkMessageReadArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *SocialAPISearchMessagesArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("MarkMessageRead_args"); err != nil {
+	if err = oprot.WriteStructBegin("SearchMessages_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -4896,7 +15457,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *SocialAPIMarkMessageReadArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *SocialAPISearchMessagesArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("request", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -4913,43 +15474,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *SocialAPIMarkMessageReadArgs) String() string {
+func (p *SocialAPISearchMessagesArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("SocialAPIMarkMessageReadArgs(%+v)", *p)
+	return fmt.Sprintf("SocialAPISearchMessagesArgs(%+v)", *p)
 
 }
 
-type SocialAPIMarkMessageReadResult struct {
-	Success *social.MarkMessageReadResponse `thrift:"success,0,optional"`
+type SocialAPISearchMessagesResult struct {
+	Success *social.SearchMessagesResponse `thrift:"success,0,optional"`
 }
 
-func NewSocialAPIMarkMessageReadResult() *SocialAPIMarkMessageReadResult {
-	return &SocialAPIMarkMessageReadResult{}
+func NewSocialAPISearchMessagesResult() *SocialAPISearchMessagesResult {
+	return &SocialAPISearchMessagesResult{}
 }
 
-func (p *SocialAPIMarkMessageReadResult) InitDefault() {
+func (p *SocialAPISearchMessagesResult) InitDefault() {
 }
 
-var SocialAPIMarkMessageReadResult_Success_DEFAULT *social.MarkMessageReadResponse
+var SocialAPISearchMessagesResult_Success_DEFAULT *social.SearchMessagesResponse
 
-func (p *SocialAPIMarkMessageReadResult) GetSuccess() (v *social.MarkMessageReadResponse) {
+func (p *SocialAPISearchMessagesResult) GetSuccess() (v *social.SearchMessagesResponse) {
 	if !p.IsSetSuccess() {
-		return SocialAPIMarkMessageReadResult_Success_DEFAULT
+		return SocialAPISearchMessagesResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_SocialAPIMarkMessageReadResult = map[int16]string{
+var fieldIDToName_SocialAPISearchMessagesResult = map[int16]string{
 	0: "success",
 }
 
-func (p *SocialAPIMarkMessageReadResult) IsSetSuccess() bool {
+func (p *SocialAPISearchMessagesResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *SocialAPIMarkMessageReadResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *SocialAPISearchMessagesResult) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -4994,7 +15555,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_SocialAPIMarkMessageReadResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_SocialAPISearchMessagesResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -5004,8 +15565,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *SocialAPIMarkMessageReadResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := social.NewMarkMessageReadResponse()
+func (p *SocialAPISearchMessagesResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := social.NewSearchMessagesResponse()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -5013,9 +15574,9 @@ func (p *SocialAPIMarkMessageReadResult) ReadField0(iprot thrift.TProtocol) erro
 	return nil
 }
 
-func (p *SocialAPIMarkMessageReadResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *SocialAPISearchMessagesResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("MarkMessageRead_result"); err != nil {
+	if err = oprot.WriteStructBegin("SearchMessages_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -5041,7 +15602,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *SocialAPIMarkMessageReadResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *SocialAPISearchMessagesResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -5060,43 +15621,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *SocialAPIMarkMessageReadResult) String() string {
+func (p *SocialAPISearchMessagesResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("SocialAPIMarkMessageReadResult(%+v)", *p)
+	return fmt.Sprintf("SocialAPISearchMessagesResult(%+v)", *p)
 
 }
 
-type SocialAPIGetUnreadMessageCountArgs struct {
-	Request *social.GetUnreadMessageCountRequest `thrift:"request,1"`
+type SocialAPIExportChatHistoryArgs struct {
+	Request *social.ExportChatHistoryRequest `thrift:"request,1"`
 }
 
-func NewSocialAPIGetUnreadMessageCountArgs() *SocialAPIGetUnreadMessageCountArgs {
-	return &SocialAPIGetUnreadMessageCountArgs{}
+func NewSocialAPIExportChatHistoryArgs() *SocialAPIExportChatHistoryArgs {
+	return &SocialAPIExportChatHistoryArgs{}
 }
 
-func (p *SocialAPIGetUnreadMessageCountArgs) InitDefault() {
+func (p *SocialAPIExportChatHistoryArgs) InitDefault() {
 }
 
-var SocialAPIGetUnreadMessageCountArgs_Request_DEFAULT *social.GetUnreadMessageCountRequest
+var SocialAPIExportChatHistoryArgs_Request_DEFAULT *social.ExportChatHistoryRequest
 
-func (p *SocialAPIGetUnreadMessageCountArgs) GetRequest() (v *social.GetUnreadMessageCountRequest) {
+func (p *SocialAPIExportChatHistoryArgs) GetRequest() (v *social.ExportChatHistoryRequest) {
 	if !p.IsSetRequest() {
-		return SocialAPIGetUnreadMessageCountArgs_Request_DEFAULT
+		return SocialAPIExportChatHistoryArgs_Request_DEFAULT
 	}
 	return p.Request
 }
 
-var fieldIDToName_SocialAPIGetUnreadMessageCountArgs = map[int16]string{
+var fieldIDToName_SocialAPIExportChatHistoryArgs = map[int16]string{
 	1: "request",
 }
 
-func (p *SocialAPIGetUnreadMessageCountArgs) IsSetRequest() bool {
+func (p *SocialAPIExportChatHistoryArgs) IsSetRequest() bool {
 	return p.Request != nil
 }
 
-func (p *SocialAPIGetUnreadMessageCountArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *SocialAPIExportChatHistoryArgs) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -5141,7 +15702,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_SocialAPIGetUnreadMessageCountArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_SocialAPIExportChatHistoryArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -5151,8 +15712,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *SocialAPIGetUnreadMessageCountArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := social.NewGetUnreadMessageCountRequest()
+func (p *SocialAPIExportChatHistoryArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := social.NewExportChatHistoryRequest()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -5160,9 +15721,9 @@ func (p *SocialAPIGetUnreadMessageCountArgs) ReadField1(iprot thrift.TProtocol)
 	return nil
 }
 
-func (p *SocialAPIGetUnreadMessageCountArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *SocialAPIExportChatHistoryArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("GetUnreadMessageCount_args"); err != nil {
+	if err = oprot.WriteStructBegin("ExportChatHistory_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -5188,7 +15749,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *SocialAPIGetUnreadMessageCountArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *SocialAPIExportChatHistoryArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("request", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -5205,43 +15766,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *SocialAPIGetUnreadMessageCountArgs) String() string {
+func (p *SocialAPIExportChatHistoryArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("SocialAPIGetUnreadMessageCountArgs(%+v)", *p)
+	return fmt.Sprintf("SocialAPIExportChatHistoryArgs(%+v)", *p)
 
 }
 
-type SocialAPIGetUnreadMessageCountResult struct {
-	Success *social.GetUnreadMessageCountResponse `thrift:"success,0,optional"`
+type SocialAPIExportChatHistoryResult struct {
+	Success *social.ExportChatHistoryResponse `thrift:"success,0,optional"`
 }
 
-func NewSocialAPIGetUnreadMessageCountResult() *SocialAPIGetUnreadMessageCountResult {
-	return &SocialAPIGetUnreadMessageCountResult{}
+func NewSocialAPIExportChatHistoryResult() *SocialAPIExportChatHistoryResult {
+	return &SocialAPIExportChatHistoryResult{}
 }
 
-func (p *SocialAPIGetUnreadMessageCountResult) InitDefault() {
+func (p *SocialAPIExportChatHistoryResult) InitDefault() {
 }
 
-var SocialAPIGetUnreadMessageCountResult_Success_DEFAULT *social.GetUnreadMessageCountResponse
+var SocialAPIExportChatHistoryResult_Success_DEFAULT *social.ExportChatHistoryResponse
 
-func (p *SocialAPIGetUnreadMessageCountResult) GetSuccess() (v *social.GetUnreadMessageCountResponse) {
+func (p *SocialAPIExportChatHistoryResult) GetSuccess() (v *social.ExportChatHistoryResponse) {
 	if !p.IsSetSuccess() {
-		return SocialAPIGetUnreadMessageCountResult_Success_DEFAULT
+		return SocialAPIExportChatHistoryResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_SocialAPIGetUnreadMessageCountResult = map[int16]string{
+var fieldIDToName_SocialAPIExportChatHistoryResult = map[int16]string{
 	0: "success",
 }
 
-func (p *SocialAPIGetUnreadMessageCountResult) IsSetSuccess() bool {
+func (p *SocialAPIExportChatHistoryResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *SocialAPIGetUnreadMessageCountResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *SocialAPIExportChatHistoryResult) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -5286,7 +15847,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_SocialAPIGetUnreadMessageCountResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_SocialAPIExportChatHistoryResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -5296,8 +15857,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *SocialAPIGetUnreadMessageCountResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := social.NewGetUnreadMessageCountResponse()
+func (p *SocialAPIExportChatHistoryResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := social.NewExportChatHistoryResponse()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -5305,9 +15866,9 @@ func (p *SocialAPIGetUnreadMessageCountResult) ReadField0(iprot thrift.TProtocol
 	return nil
 }
 
-func (p *SocialAPIGetUnreadMessageCountResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *SocialAPIExportChatHistoryResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("GetUnreadMessageCount_result"); err != nil {
+	if err = oprot.WriteStructBegin("ExportChatHistory_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -5333,7 +15894,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *SocialAPIGetUnreadMessageCountResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *SocialAPIExportChatHistoryResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -5352,10 +15913,10 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *SocialAPIGetUnreadMessageCountResult) String() string {
+func (p *SocialAPIExportChatHistoryResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("SocialAPIGetUnreadMessageCountResult(%+v)", *p)
+	return fmt.Sprintf("SocialAPIExportChatHistoryResult(%+v)", *p)
 
 }
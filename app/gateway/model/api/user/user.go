@@ -22,6 +22,24 @@ type UserAPI interface {
 	GetUserInfo(ctx context.Context, request *user.UserInfoRequest) (r *user.UserInfoResponse, err error)
 
 	UploadAvatar(ctx context.Context, request *user.UploadAvatarRequest) (r *user.UploadAvatarResponse, err error)
+	// 关注相关接口
+	Follow(ctx context.Context, request *user.FollowRequest) (r *user.FollowResponse, err error)
+
+	Unfollow(ctx context.Context, request *user.UnfollowRequest) (r *user.UnfollowResponse, err error)
+
+	GetFollowing(ctx context.Context, request *user.FollowListRequest) (r *user.FollowListResponse, err error)
+
+	GetFollowers(ctx context.Context, request *user.FollowListRequest) (r *user.FollowListResponse, err error)
+
+	Block(ctx context.Context, request *user.BlockRequest) (r *user.BlockResponse, err error)
+
+	Unblock(ctx context.Context, request *user.UnblockRequest) (r *user.UnblockResponse, err error)
+
+	GetBlockList(ctx context.Context, request *user.BlockListRequest) (r *user.BlockListResponse, err error)
+
+	GetPrivacySettings(ctx context.Context, request *user.GetPrivacySettingsRequest) (r *user.GetPrivacySettingsResponse, err error)
+
+	UpdatePrivacySettings(ctx context.Context, request *user.UpdatePrivacySettingsRequest) (r *user.UpdatePrivacySettingsResponse, err error)
 }
 
 type UserAPIClient struct {
@@ -95,6 +113,87 @@ func (p *UserAPIClient) UploadAvatar(ctx context.Context, request *user.UploadAv
 	}
 	return _result.GetSuccess(), nil
 }
+func (p *UserAPIClient) Follow(ctx context.Context, request *user.FollowRequest) (r *user.FollowResponse, err error) {
+	var _args UserAPIFollowArgs
+	_args.Request = request
+	var _result UserAPIFollowResult
+	if err = p.Client_().Call(ctx, "Follow", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *UserAPIClient) Unfollow(ctx context.Context, request *user.UnfollowRequest) (r *user.UnfollowResponse, err error) {
+	var _args UserAPIUnfollowArgs
+	_args.Request = request
+	var _result UserAPIUnfollowResult
+	if err = p.Client_().Call(ctx, "Unfollow", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *UserAPIClient) GetFollowing(ctx context.Context, request *user.FollowListRequest) (r *user.FollowListResponse, err error) {
+	var _args UserAPIGetFollowingArgs
+	_args.Request = request
+	var _result UserAPIGetFollowingResult
+	if err = p.Client_().Call(ctx, "GetFollowing", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *UserAPIClient) GetFollowers(ctx context.Context, request *user.FollowListRequest) (r *user.FollowListResponse, err error) {
+	var _args UserAPIGetFollowersArgs
+	_args.Request = request
+	var _result UserAPIGetFollowersResult
+	if err = p.Client_().Call(ctx, "GetFollowers", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *UserAPIClient) Block(ctx context.Context, request *user.BlockRequest) (r *user.BlockResponse, err error) {
+	var _args UserAPIBlockArgs
+	_args.Request = request
+	var _result UserAPIBlockResult
+	if err = p.Client_().Call(ctx, "Block", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *UserAPIClient) Unblock(ctx context.Context, request *user.UnblockRequest) (r *user.UnblockResponse, err error) {
+	var _args UserAPIUnblockArgs
+	_args.Request = request
+	var _result UserAPIUnblockResult
+	if err = p.Client_().Call(ctx, "Unblock", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *UserAPIClient) GetBlockList(ctx context.Context, request *user.BlockListRequest) (r *user.BlockListResponse, err error) {
+	var _args UserAPIGetBlockListArgs
+	_args.Request = request
+	var _result UserAPIGetBlockListResult
+	if err = p.Client_().Call(ctx, "GetBlockList", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *UserAPIClient) GetPrivacySettings(ctx context.Context, request *user.GetPrivacySettingsRequest) (r *user.GetPrivacySettingsResponse, err error) {
+	var _args UserAPIGetPrivacySettingsArgs
+	_args.Request = request
+	var _result UserAPIGetPrivacySettingsResult
+	if err = p.Client_().Call(ctx, "GetPrivacySettings", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *UserAPIClient) UpdatePrivacySettings(ctx context.Context, request *user.UpdatePrivacySettingsRequest) (r *user.UpdatePrivacySettingsResponse, err error) {
+	var _args UserAPIUpdatePrivacySettingsArgs
+	_args.Request = request
+	var _result UserAPIUpdatePrivacySettingsResult
+	if err = p.Client_().Call(ctx, "UpdatePrivacySettings", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
 
 type UserAPIProcessor struct {
 	processorMap map[string]thrift.TProcessorFunction
@@ -121,6 +220,15 @@ func NewUserAPIProcessor(handler UserAPI) *UserAPIProcessor {
 	self.AddToProcessorMap("RefreshToken", &userAPIProcessorRefreshToken{handler: handler})
 	self.AddToProcessorMap("GetUserInfo", &userAPIProcessorGetUserInfo{handler: handler})
 	self.AddToProcessorMap("UploadAvatar", &userAPIProcessorUploadAvatar{handler: handler})
+	self.AddToProcessorMap("Follow", &userAPIProcessorFollow{handler: handler})
+	self.AddToProcessorMap("Unfollow", &userAPIProcessorUnfollow{handler: handler})
+	self.AddToProcessorMap("GetFollowing", &userAPIProcessorGetFollowing{handler: handler})
+	self.AddToProcessorMap("GetFollowers", &userAPIProcessorGetFollowers{handler: handler})
+	self.AddToProcessorMap("Block", &userAPIProcessorBlock{handler: handler})
+	self.AddToProcessorMap("Unblock", &userAPIProcessorUnblock{handler: handler})
+	self.AddToProcessorMap("GetBlockList", &userAPIProcessorGetBlockList{handler: handler})
+	self.AddToProcessorMap("GetPrivacySettings", &userAPIProcessorGetPrivacySettings{handler: handler})
+	self.AddToProcessorMap("UpdatePrivacySettings", &userAPIProcessorUpdatePrivacySettings{handler: handler})
 	return self
 }
 func (p *UserAPIProcessor) Process(ctx context.Context, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
@@ -349,67 +457,3127 @@ func (p *userAPIProcessorUploadAvatar) Process(ctx context.Context, seqId int32,
 		return false, err
 	}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := UserAPIUploadAvatarResult{}
-	var retval *user.UploadAvatarResponse
-	if retval, err2 = p.handler.UploadAvatar(ctx, args.Request); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing UploadAvatar: "+err2.Error())
-		oprot.WriteMessageBegin("UploadAvatar", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := UserAPIUploadAvatarResult{}
+	var retval *user.UploadAvatarResponse
+	if retval, err2 = p.handler.UploadAvatar(ctx, args.Request); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing UploadAvatar: "+err2.Error())
+		oprot.WriteMessageBegin("UploadAvatar", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("UploadAvatar", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type userAPIProcessorFollow struct {
+	handler UserAPI
+}
+
+func (p *userAPIProcessorFollow) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := UserAPIFollowArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("Follow", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := UserAPIFollowResult{}
+	var retval *user.FollowResponse
+	if retval, err2 = p.handler.Follow(ctx, args.Request); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing Follow: "+err2.Error())
+		oprot.WriteMessageBegin("Follow", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("Follow", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type userAPIProcessorUnfollow struct {
+	handler UserAPI
+}
+
+func (p *userAPIProcessorUnfollow) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := UserAPIUnfollowArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("Unfollow", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := UserAPIUnfollowResult{}
+	var retval *user.UnfollowResponse
+	if retval, err2 = p.handler.Unfollow(ctx, args.Request); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing Unfollow: "+err2.Error())
+		oprot.WriteMessageBegin("Unfollow", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("Unfollow", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type userAPIProcessorGetFollowing struct {
+	handler UserAPI
+}
+
+func (p *userAPIProcessorGetFollowing) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := UserAPIGetFollowingArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("GetFollowing", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := UserAPIGetFollowingResult{}
+	var retval *user.FollowListResponse
+	if retval, err2 = p.handler.GetFollowing(ctx, args.Request); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetFollowing: "+err2.Error())
+		oprot.WriteMessageBegin("GetFollowing", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("GetFollowing", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type userAPIProcessorGetFollowers struct {
+	handler UserAPI
+}
+
+func (p *userAPIProcessorGetFollowers) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := UserAPIGetFollowersArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("GetFollowers", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := UserAPIGetFollowersResult{}
+	var retval *user.FollowListResponse
+	if retval, err2 = p.handler.GetFollowers(ctx, args.Request); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetFollowers: "+err2.Error())
+		oprot.WriteMessageBegin("GetFollowers", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("GetFollowers", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type userAPIProcessorBlock struct {
+	handler UserAPI
+}
+
+func (p *userAPIProcessorBlock) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := UserAPIBlockArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("Block", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := UserAPIBlockResult{}
+	var retval *user.BlockResponse
+	if retval, err2 = p.handler.Block(ctx, args.Request); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing Block: "+err2.Error())
+		oprot.WriteMessageBegin("Block", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("Block", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type userAPIProcessorUnblock struct {
+	handler UserAPI
+}
+
+func (p *userAPIProcessorUnblock) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := UserAPIUnblockArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("Unblock", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := UserAPIUnblockResult{}
+	var retval *user.UnblockResponse
+	if retval, err2 = p.handler.Unblock(ctx, args.Request); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing Unblock: "+err2.Error())
+		oprot.WriteMessageBegin("Unblock", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("Unblock", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type userAPIProcessorGetBlockList struct {
+	handler UserAPI
+}
+
+func (p *userAPIProcessorGetBlockList) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := UserAPIGetBlockListArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("GetBlockList", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := UserAPIGetBlockListResult{}
+	var retval *user.BlockListResponse
+	if retval, err2 = p.handler.GetBlockList(ctx, args.Request); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetBlockList: "+err2.Error())
+		oprot.WriteMessageBegin("GetBlockList", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("GetBlockList", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type userAPIProcessorGetPrivacySettings struct {
+	handler UserAPI
+}
+
+func (p *userAPIProcessorGetPrivacySettings) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := UserAPIGetPrivacySettingsArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("GetPrivacySettings", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := UserAPIGetPrivacySettingsResult{}
+	var retval *user.GetPrivacySettingsResponse
+	if retval, err2 = p.handler.GetPrivacySettings(ctx, args.Request); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetPrivacySettings: "+err2.Error())
+		oprot.WriteMessageBegin("GetPrivacySettings", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("GetPrivacySettings", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type userAPIProcessorUpdatePrivacySettings struct {
+	handler UserAPI
+}
+
+func (p *userAPIProcessorUpdatePrivacySettings) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := UserAPIUpdatePrivacySettingsArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("UpdatePrivacySettings", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := UserAPIUpdatePrivacySettingsResult{}
+	var retval *user.UpdatePrivacySettingsResponse
+	if retval, err2 = p.handler.UpdatePrivacySettings(ctx, args.Request); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing UpdatePrivacySettings: "+err2.Error())
+		oprot.WriteMessageBegin("UpdatePrivacySettings", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("UpdatePrivacySettings", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type UserAPIRegisterArgs struct {
+	Request *user.RegisterRequest `thrift:"request,1"`
+}
+
+func NewUserAPIRegisterArgs() *UserAPIRegisterArgs {
+	return &UserAPIRegisterArgs{}
+}
+
+func (p *UserAPIRegisterArgs) InitDefault() {
+}
+
+var UserAPIRegisterArgs_Request_DEFAULT *user.RegisterRequest
+
+func (p *UserAPIRegisterArgs) GetRequest() (v *user.RegisterRequest) {
+	if !p.IsSetRequest() {
+		return UserAPIRegisterArgs_Request_DEFAULT
+	}
+	return p.Request
+}
+
+var fieldIDToName_UserAPIRegisterArgs = map[int16]string{
+	1: "request",
+}
+
+func (p *UserAPIRegisterArgs) IsSetRequest() bool {
+	return p.Request != nil
+}
+
+func (p *UserAPIRegisterArgs) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserAPIRegisterArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *UserAPIRegisterArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := user.NewRegisterRequest()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Request = _field
+	return nil
+}
+
+func (p *UserAPIRegisterArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("Register_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *UserAPIRegisterArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("request", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Request.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *UserAPIRegisterArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserAPIRegisterArgs(%+v)", *p)
+
+}
+
+type UserAPIRegisterResult struct {
+	Success *user.RegisterResponse `thrift:"success,0,optional"`
+}
+
+func NewUserAPIRegisterResult() *UserAPIRegisterResult {
+	return &UserAPIRegisterResult{}
+}
+
+func (p *UserAPIRegisterResult) InitDefault() {
+}
+
+var UserAPIRegisterResult_Success_DEFAULT *user.RegisterResponse
+
+func (p *UserAPIRegisterResult) GetSuccess() (v *user.RegisterResponse) {
+	if !p.IsSetSuccess() {
+		return UserAPIRegisterResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var fieldIDToName_UserAPIRegisterResult = map[int16]string{
+	0: "success",
+}
+
+func (p *UserAPIRegisterResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *UserAPIRegisterResult) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserAPIRegisterResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *UserAPIRegisterResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := user.NewRegisterResponse()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *UserAPIRegisterResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("Register_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *UserAPIRegisterResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *UserAPIRegisterResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserAPIRegisterResult(%+v)", *p)
+
+}
+
+type UserAPILoginArgs struct {
+	Request *user.LoginRequest `thrift:"request,1"`
+}
+
+func NewUserAPILoginArgs() *UserAPILoginArgs {
+	return &UserAPILoginArgs{}
+}
+
+func (p *UserAPILoginArgs) InitDefault() {
+}
+
+var UserAPILoginArgs_Request_DEFAULT *user.LoginRequest
+
+func (p *UserAPILoginArgs) GetRequest() (v *user.LoginRequest) {
+	if !p.IsSetRequest() {
+		return UserAPILoginArgs_Request_DEFAULT
+	}
+	return p.Request
+}
+
+var fieldIDToName_UserAPILoginArgs = map[int16]string{
+	1: "request",
+}
+
+func (p *UserAPILoginArgs) IsSetRequest() bool {
+	return p.Request != nil
+}
+
+func (p *UserAPILoginArgs) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserAPILoginArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *UserAPILoginArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := user.NewLoginRequest()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Request = _field
+	return nil
+}
+
+func (p *UserAPILoginArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("Login_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *UserAPILoginArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("request", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Request.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *UserAPILoginArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserAPILoginArgs(%+v)", *p)
+
+}
+
+type UserAPILoginResult struct {
+	Success *user.LoginResponse `thrift:"success,0,optional"`
+}
+
+func NewUserAPILoginResult() *UserAPILoginResult {
+	return &UserAPILoginResult{}
+}
+
+func (p *UserAPILoginResult) InitDefault() {
+}
+
+var UserAPILoginResult_Success_DEFAULT *user.LoginResponse
+
+func (p *UserAPILoginResult) GetSuccess() (v *user.LoginResponse) {
+	if !p.IsSetSuccess() {
+		return UserAPILoginResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var fieldIDToName_UserAPILoginResult = map[int16]string{
+	0: "success",
+}
+
+func (p *UserAPILoginResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *UserAPILoginResult) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserAPILoginResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *UserAPILoginResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := user.NewLoginResponse()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *UserAPILoginResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("Login_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *UserAPILoginResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *UserAPILoginResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserAPILoginResult(%+v)", *p)
+
+}
+
+type UserAPIRefreshTokenArgs struct {
+	Request *user.RefreshTokenRequest `thrift:"request,1"`
+}
+
+func NewUserAPIRefreshTokenArgs() *UserAPIRefreshTokenArgs {
+	return &UserAPIRefreshTokenArgs{}
+}
+
+func (p *UserAPIRefreshTokenArgs) InitDefault() {
+}
+
+var UserAPIRefreshTokenArgs_Request_DEFAULT *user.RefreshTokenRequest
+
+func (p *UserAPIRefreshTokenArgs) GetRequest() (v *user.RefreshTokenRequest) {
+	if !p.IsSetRequest() {
+		return UserAPIRefreshTokenArgs_Request_DEFAULT
+	}
+	return p.Request
+}
+
+var fieldIDToName_UserAPIRefreshTokenArgs = map[int16]string{
+	1: "request",
+}
+
+func (p *UserAPIRefreshTokenArgs) IsSetRequest() bool {
+	return p.Request != nil
+}
+
+func (p *UserAPIRefreshTokenArgs) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserAPIRefreshTokenArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *UserAPIRefreshTokenArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := user.NewRefreshTokenRequest()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Request = _field
+	return nil
+}
+
+func (p *UserAPIRefreshTokenArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("RefreshToken_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *UserAPIRefreshTokenArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("request", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Request.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *UserAPIRefreshTokenArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserAPIRefreshTokenArgs(%+v)", *p)
+
+}
+
+type UserAPIRefreshTokenResult struct {
+	Success *user.RefreshTokenResponse `thrift:"success,0,optional"`
+}
+
+func NewUserAPIRefreshTokenResult() *UserAPIRefreshTokenResult {
+	return &UserAPIRefreshTokenResult{}
+}
+
+func (p *UserAPIRefreshTokenResult) InitDefault() {
+}
+
+var UserAPIRefreshTokenResult_Success_DEFAULT *user.RefreshTokenResponse
+
+func (p *UserAPIRefreshTokenResult) GetSuccess() (v *user.RefreshTokenResponse) {
+	if !p.IsSetSuccess() {
+		return UserAPIRefreshTokenResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var fieldIDToName_UserAPIRefreshTokenResult = map[int16]string{
+	0: "success",
+}
+
+func (p *UserAPIRefreshTokenResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *UserAPIRefreshTokenResult) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserAPIRefreshTokenResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *UserAPIRefreshTokenResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := user.NewRefreshTokenResponse()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *UserAPIRefreshTokenResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("RefreshToken_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *UserAPIRefreshTokenResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *UserAPIRefreshTokenResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserAPIRefreshTokenResult(%+v)", *p)
+
+}
+
+type UserAPIGetUserInfoArgs struct {
+	Request *user.UserInfoRequest `thrift:"request,1"`
+}
+
+func NewUserAPIGetUserInfoArgs() *UserAPIGetUserInfoArgs {
+	return &UserAPIGetUserInfoArgs{}
+}
+
+func (p *UserAPIGetUserInfoArgs) InitDefault() {
+}
+
+var UserAPIGetUserInfoArgs_Request_DEFAULT *user.UserInfoRequest
+
+func (p *UserAPIGetUserInfoArgs) GetRequest() (v *user.UserInfoRequest) {
+	if !p.IsSetRequest() {
+		return UserAPIGetUserInfoArgs_Request_DEFAULT
+	}
+	return p.Request
+}
+
+var fieldIDToName_UserAPIGetUserInfoArgs = map[int16]string{
+	1: "request",
+}
+
+func (p *UserAPIGetUserInfoArgs) IsSetRequest() bool {
+	return p.Request != nil
+}
+
+func (p *UserAPIGetUserInfoArgs) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserAPIGetUserInfoArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *UserAPIGetUserInfoArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := user.NewUserInfoRequest()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Request = _field
+	return nil
+}
+
+func (p *UserAPIGetUserInfoArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("GetUserInfo_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *UserAPIGetUserInfoArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("request", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Request.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *UserAPIGetUserInfoArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserAPIGetUserInfoArgs(%+v)", *p)
+
+}
+
+type UserAPIGetUserInfoResult struct {
+	Success *user.UserInfoResponse `thrift:"success,0,optional"`
+}
+
+func NewUserAPIGetUserInfoResult() *UserAPIGetUserInfoResult {
+	return &UserAPIGetUserInfoResult{}
+}
+
+func (p *UserAPIGetUserInfoResult) InitDefault() {
+}
+
+var UserAPIGetUserInfoResult_Success_DEFAULT *user.UserInfoResponse
+
+func (p *UserAPIGetUserInfoResult) GetSuccess() (v *user.UserInfoResponse) {
+	if !p.IsSetSuccess() {
+		return UserAPIGetUserInfoResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var fieldIDToName_UserAPIGetUserInfoResult = map[int16]string{
+	0: "success",
+}
+
+func (p *UserAPIGetUserInfoResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *UserAPIGetUserInfoResult) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserAPIGetUserInfoResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *UserAPIGetUserInfoResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := user.NewUserInfoResponse()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *UserAPIGetUserInfoResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("GetUserInfo_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *UserAPIGetUserInfoResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *UserAPIGetUserInfoResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserAPIGetUserInfoResult(%+v)", *p)
+
+}
+
+type UserAPIUploadAvatarArgs struct {
+	Request *user.UploadAvatarRequest `thrift:"request,1"`
+}
+
+func NewUserAPIUploadAvatarArgs() *UserAPIUploadAvatarArgs {
+	return &UserAPIUploadAvatarArgs{}
+}
+
+func (p *UserAPIUploadAvatarArgs) InitDefault() {
+}
+
+var UserAPIUploadAvatarArgs_Request_DEFAULT *user.UploadAvatarRequest
+
+func (p *UserAPIUploadAvatarArgs) GetRequest() (v *user.UploadAvatarRequest) {
+	if !p.IsSetRequest() {
+		return UserAPIUploadAvatarArgs_Request_DEFAULT
+	}
+	return p.Request
+}
+
+var fieldIDToName_UserAPIUploadAvatarArgs = map[int16]string{
+	1: "request",
+}
+
+func (p *UserAPIUploadAvatarArgs) IsSetRequest() bool {
+	return p.Request != nil
+}
+
+func (p *UserAPIUploadAvatarArgs) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserAPIUploadAvatarArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *UserAPIUploadAvatarArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := user.NewUploadAvatarRequest()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Request = _field
+	return nil
+}
+
+func (p *UserAPIUploadAvatarArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("UploadAvatar_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *UserAPIUploadAvatarArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("request", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Request.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *UserAPIUploadAvatarArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserAPIUploadAvatarArgs(%+v)", *p)
+
+}
+
+type UserAPIUploadAvatarResult struct {
+	Success *user.UploadAvatarResponse `thrift:"success,0,optional"`
+}
+
+func NewUserAPIUploadAvatarResult() *UserAPIUploadAvatarResult {
+	return &UserAPIUploadAvatarResult{}
+}
+
+func (p *UserAPIUploadAvatarResult) InitDefault() {
+}
+
+var UserAPIUploadAvatarResult_Success_DEFAULT *user.UploadAvatarResponse
+
+func (p *UserAPIUploadAvatarResult) GetSuccess() (v *user.UploadAvatarResponse) {
+	if !p.IsSetSuccess() {
+		return UserAPIUploadAvatarResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var fieldIDToName_UserAPIUploadAvatarResult = map[int16]string{
+	0: "success",
+}
+
+func (p *UserAPIUploadAvatarResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *UserAPIUploadAvatarResult) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserAPIUploadAvatarResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *UserAPIUploadAvatarResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := user.NewUploadAvatarResponse()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *UserAPIUploadAvatarResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("UploadAvatar_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *UserAPIUploadAvatarResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *UserAPIUploadAvatarResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserAPIUploadAvatarResult(%+v)", *p)
+
+}
+
+type UserAPIFollowArgs struct {
+	Request *user.FollowRequest `thrift:"request,1"`
+}
+
+func NewUserAPIFollowArgs() *UserAPIFollowArgs {
+	return &UserAPIFollowArgs{}
+}
+
+func (p *UserAPIFollowArgs) InitDefault() {
+}
+
+var UserAPIFollowArgs_Request_DEFAULT *user.FollowRequest
+
+func (p *UserAPIFollowArgs) GetRequest() (v *user.FollowRequest) {
+	if !p.IsSetRequest() {
+		return UserAPIFollowArgs_Request_DEFAULT
+	}
+	return p.Request
+}
+
+var fieldIDToName_UserAPIFollowArgs = map[int16]string{
+	1: "request",
+}
+
+func (p *UserAPIFollowArgs) IsSetRequest() bool {
+	return p.Request != nil
+}
+
+func (p *UserAPIFollowArgs) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserAPIFollowArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *UserAPIFollowArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := user.NewFollowRequest()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Request = _field
+	return nil
+}
+
+func (p *UserAPIFollowArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("Follow_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *UserAPIFollowArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("request", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Request.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *UserAPIFollowArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserAPIFollowArgs(%+v)", *p)
+
+}
+
+type UserAPIFollowResult struct {
+	Success *user.FollowResponse `thrift:"success,0,optional"`
+}
+
+func NewUserAPIFollowResult() *UserAPIFollowResult {
+	return &UserAPIFollowResult{}
+}
+
+func (p *UserAPIFollowResult) InitDefault() {
+}
+
+var UserAPIFollowResult_Success_DEFAULT *user.FollowResponse
+
+func (p *UserAPIFollowResult) GetSuccess() (v *user.FollowResponse) {
+	if !p.IsSetSuccess() {
+		return UserAPIFollowResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var fieldIDToName_UserAPIFollowResult = map[int16]string{
+	0: "success",
+}
+
+func (p *UserAPIFollowResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *UserAPIFollowResult) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserAPIFollowResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *UserAPIFollowResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := user.NewFollowResponse()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *UserAPIFollowResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("Follow_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *UserAPIFollowResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *UserAPIFollowResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserAPIFollowResult(%+v)", *p)
+
+}
+
+type UserAPIUnfollowArgs struct {
+	Request *user.UnfollowRequest `thrift:"request,1"`
+}
+
+func NewUserAPIUnfollowArgs() *UserAPIUnfollowArgs {
+	return &UserAPIUnfollowArgs{}
+}
+
+func (p *UserAPIUnfollowArgs) InitDefault() {
+}
+
+var UserAPIUnfollowArgs_Request_DEFAULT *user.UnfollowRequest
+
+func (p *UserAPIUnfollowArgs) GetRequest() (v *user.UnfollowRequest) {
+	if !p.IsSetRequest() {
+		return UserAPIUnfollowArgs_Request_DEFAULT
+	}
+	return p.Request
+}
+
+var fieldIDToName_UserAPIUnfollowArgs = map[int16]string{
+	1: "request",
+}
+
+func (p *UserAPIUnfollowArgs) IsSetRequest() bool {
+	return p.Request != nil
+}
+
+func (p *UserAPIUnfollowArgs) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserAPIUnfollowArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *UserAPIUnfollowArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := user.NewUnfollowRequest()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Request = _field
+	return nil
+}
+
+func (p *UserAPIUnfollowArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("Unfollow_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *UserAPIUnfollowArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("request", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Request.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *UserAPIUnfollowArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserAPIUnfollowArgs(%+v)", *p)
+
+}
+
+type UserAPIUnfollowResult struct {
+	Success *user.UnfollowResponse `thrift:"success,0,optional"`
+}
+
+func NewUserAPIUnfollowResult() *UserAPIUnfollowResult {
+	return &UserAPIUnfollowResult{}
+}
+
+func (p *UserAPIUnfollowResult) InitDefault() {
+}
+
+var UserAPIUnfollowResult_Success_DEFAULT *user.UnfollowResponse
+
+func (p *UserAPIUnfollowResult) GetSuccess() (v *user.UnfollowResponse) {
+	if !p.IsSetSuccess() {
+		return UserAPIUnfollowResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var fieldIDToName_UserAPIUnfollowResult = map[int16]string{
+	0: "success",
+}
+
+func (p *UserAPIUnfollowResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *UserAPIUnfollowResult) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserAPIUnfollowResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *UserAPIUnfollowResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := user.NewUnfollowResponse()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *UserAPIUnfollowResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("Unfollow_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *UserAPIUnfollowResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *UserAPIUnfollowResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserAPIUnfollowResult(%+v)", *p)
+
+}
+
+type UserAPIGetFollowingArgs struct {
+	Request *user.FollowListRequest `thrift:"request,1"`
+}
+
+func NewUserAPIGetFollowingArgs() *UserAPIGetFollowingArgs {
+	return &UserAPIGetFollowingArgs{}
+}
+
+func (p *UserAPIGetFollowingArgs) InitDefault() {
+}
+
+var UserAPIGetFollowingArgs_Request_DEFAULT *user.FollowListRequest
+
+func (p *UserAPIGetFollowingArgs) GetRequest() (v *user.FollowListRequest) {
+	if !p.IsSetRequest() {
+		return UserAPIGetFollowingArgs_Request_DEFAULT
+	}
+	return p.Request
+}
+
+var fieldIDToName_UserAPIGetFollowingArgs = map[int16]string{
+	1: "request",
+}
+
+func (p *UserAPIGetFollowingArgs) IsSetRequest() bool {
+	return p.Request != nil
+}
+
+func (p *UserAPIGetFollowingArgs) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserAPIGetFollowingArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *UserAPIGetFollowingArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := user.NewFollowListRequest()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Request = _field
+	return nil
+}
+
+func (p *UserAPIGetFollowingArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("GetFollowing_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *UserAPIGetFollowingArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("request", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Request.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *UserAPIGetFollowingArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserAPIGetFollowingArgs(%+v)", *p)
+
+}
+
+type UserAPIGetFollowingResult struct {
+	Success *user.FollowListResponse `thrift:"success,0,optional"`
+}
+
+func NewUserAPIGetFollowingResult() *UserAPIGetFollowingResult {
+	return &UserAPIGetFollowingResult{}
+}
+
+func (p *UserAPIGetFollowingResult) InitDefault() {
+}
+
+var UserAPIGetFollowingResult_Success_DEFAULT *user.FollowListResponse
+
+func (p *UserAPIGetFollowingResult) GetSuccess() (v *user.FollowListResponse) {
+	if !p.IsSetSuccess() {
+		return UserAPIGetFollowingResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var fieldIDToName_UserAPIGetFollowingResult = map[int16]string{
+	0: "success",
+}
+
+func (p *UserAPIGetFollowingResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *UserAPIGetFollowingResult) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserAPIGetFollowingResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *UserAPIGetFollowingResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := user.NewFollowListResponse()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *UserAPIGetFollowingResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("GetFollowing_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *UserAPIGetFollowingResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *UserAPIGetFollowingResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserAPIGetFollowingResult(%+v)", *p)
+
+}
+
+type UserAPIGetFollowersArgs struct {
+	Request *user.FollowListRequest `thrift:"request,1"`
+}
+
+func NewUserAPIGetFollowersArgs() *UserAPIGetFollowersArgs {
+	return &UserAPIGetFollowersArgs{}
+}
+
+func (p *UserAPIGetFollowersArgs) InitDefault() {
+}
+
+var UserAPIGetFollowersArgs_Request_DEFAULT *user.FollowListRequest
+
+func (p *UserAPIGetFollowersArgs) GetRequest() (v *user.FollowListRequest) {
+	if !p.IsSetRequest() {
+		return UserAPIGetFollowersArgs_Request_DEFAULT
+	}
+	return p.Request
+}
+
+var fieldIDToName_UserAPIGetFollowersArgs = map[int16]string{
+	1: "request",
+}
+
+func (p *UserAPIGetFollowersArgs) IsSetRequest() bool {
+	return p.Request != nil
+}
+
+func (p *UserAPIGetFollowersArgs) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserAPIGetFollowersArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *UserAPIGetFollowersArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := user.NewFollowListRequest()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Request = _field
+	return nil
+}
+
+func (p *UserAPIGetFollowersArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("GetFollowers_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *UserAPIGetFollowersArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("request", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Request.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *UserAPIGetFollowersArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserAPIGetFollowersArgs(%+v)", *p)
+
+}
+
+type UserAPIGetFollowersResult struct {
+	Success *user.FollowListResponse `thrift:"success,0,optional"`
+}
+
+func NewUserAPIGetFollowersResult() *UserAPIGetFollowersResult {
+	return &UserAPIGetFollowersResult{}
+}
+
+func (p *UserAPIGetFollowersResult) InitDefault() {
+}
+
+var UserAPIGetFollowersResult_Success_DEFAULT *user.FollowListResponse
+
+func (p *UserAPIGetFollowersResult) GetSuccess() (v *user.FollowListResponse) {
+	if !p.IsSetSuccess() {
+		return UserAPIGetFollowersResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var fieldIDToName_UserAPIGetFollowersResult = map[int16]string{
+	0: "success",
+}
+
+func (p *UserAPIGetFollowersResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *UserAPIGetFollowersResult) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserAPIGetFollowersResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *UserAPIGetFollowersResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := user.NewFollowListResponse()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *UserAPIGetFollowersResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("GetFollowers_result"); err != nil {
+		goto WriteStructBeginError
 	}
-	if err2 = oprot.WriteMessageBegin("UploadAvatar", thrift.REPLY, seqId); err2 != nil {
-		err = err2
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
 	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
 	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *UserAPIGetFollowersResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
 	}
-	if err != nil {
-		return
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *UserAPIGetFollowersResult) String() string {
+	if p == nil {
+		return "<nil>"
 	}
-	return true, err
+	return fmt.Sprintf("UserAPIGetFollowersResult(%+v)", *p)
+
 }
 
-type UserAPIRegisterArgs struct {
-	Request *user.RegisterRequest `thrift:"request,1"`
+type UserAPIBlockArgs struct {
+	Request *user.BlockRequest `thrift:"request,1"`
 }
 
-func NewUserAPIRegisterArgs() *UserAPIRegisterArgs {
-	return &UserAPIRegisterArgs{}
+func NewUserAPIBlockArgs() *UserAPIBlockArgs {
+	return &UserAPIBlockArgs{}
 }
 
-func (p *UserAPIRegisterArgs) InitDefault() {
+func (p *UserAPIBlockArgs) InitDefault() {
 }
 
-var UserAPIRegisterArgs_Request_DEFAULT *user.RegisterRequest
+var UserAPIBlockArgs_Request_DEFAULT *user.BlockRequest
 
-func (p *UserAPIRegisterArgs) GetRequest() (v *user.RegisterRequest) {
+func (p *UserAPIBlockArgs) GetRequest() (v *user.BlockRequest) {
 	if !p.IsSetRequest() {
-		return UserAPIRegisterArgs_Request_DEFAULT
+		return UserAPIBlockArgs_Request_DEFAULT
 	}
 	return p.Request
 }
 
-var fieldIDToName_UserAPIRegisterArgs = map[int16]string{
+var fieldIDToName_UserAPIBlockArgs = map[int16]string{
 	1: "request",
 }
 
-func (p *UserAPIRegisterArgs) IsSetRequest() bool {
+func (p *UserAPIBlockArgs) IsSetRequest() bool {
 	return p.Request != nil
 }
 
-func (p *UserAPIRegisterArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *UserAPIBlockArgs) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -454,7 +3622,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserAPIRegisterArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserAPIBlockArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -464,8 +3632,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *UserAPIRegisterArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := user.NewRegisterRequest()
+func (p *UserAPIBlockArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := user.NewBlockRequest()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -473,9 +3641,9 @@ func (p *UserAPIRegisterArgs) ReadField1(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *UserAPIRegisterArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *UserAPIBlockArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("Register_args"); err != nil {
+	if err = oprot.WriteStructBegin("Block_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -501,7 +3669,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *UserAPIRegisterArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *UserAPIBlockArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("request", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -518,43 +3686,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *UserAPIRegisterArgs) String() string {
+func (p *UserAPIBlockArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("UserAPIRegisterArgs(%+v)", *p)
+	return fmt.Sprintf("UserAPIBlockArgs(%+v)", *p)
 
 }
 
-type UserAPIRegisterResult struct {
-	Success *user.RegisterResponse `thrift:"success,0,optional"`
+type UserAPIBlockResult struct {
+	Success *user.BlockResponse `thrift:"success,0,optional"`
 }
 
-func NewUserAPIRegisterResult() *UserAPIRegisterResult {
-	return &UserAPIRegisterResult{}
+func NewUserAPIBlockResult() *UserAPIBlockResult {
+	return &UserAPIBlockResult{}
 }
 
-func (p *UserAPIRegisterResult) InitDefault() {
+func (p *UserAPIBlockResult) InitDefault() {
 }
 
-var UserAPIRegisterResult_Success_DEFAULT *user.RegisterResponse
+var UserAPIBlockResult_Success_DEFAULT *user.BlockResponse
 
-func (p *UserAPIRegisterResult) GetSuccess() (v *user.RegisterResponse) {
+func (p *UserAPIBlockResult) GetSuccess() (v *user.BlockResponse) {
 	if !p.IsSetSuccess() {
-		return UserAPIRegisterResult_Success_DEFAULT
+		return UserAPIBlockResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_UserAPIRegisterResult = map[int16]string{
+var fieldIDToName_UserAPIBlockResult = map[int16]string{
 	0: "success",
 }
 
-func (p *UserAPIRegisterResult) IsSetSuccess() bool {
+func (p *UserAPIBlockResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *UserAPIRegisterResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *UserAPIBlockResult) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -599,7 +3767,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserAPIRegisterResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserAPIBlockResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -609,8 +3777,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *UserAPIRegisterResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := user.NewRegisterResponse()
+func (p *UserAPIBlockResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := user.NewBlockResponse()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -618,9 +3786,9 @@ func (p *UserAPIRegisterResult) ReadField0(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *UserAPIRegisterResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *UserAPIBlockResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("Register_result"); err != nil {
+	if err = oprot.WriteStructBegin("Block_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -646,7 +3814,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *UserAPIRegisterResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *UserAPIBlockResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -665,43 +3833,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *UserAPIRegisterResult) String() string {
+func (p *UserAPIBlockResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("UserAPIRegisterResult(%+v)", *p)
+	return fmt.Sprintf("UserAPIBlockResult(%+v)", *p)
 
 }
 
-type UserAPILoginArgs struct {
-	Request *user.LoginRequest `thrift:"request,1"`
+type UserAPIUnblockArgs struct {
+	Request *user.UnblockRequest `thrift:"request,1"`
 }
 
-func NewUserAPILoginArgs() *UserAPILoginArgs {
-	return &UserAPILoginArgs{}
+func NewUserAPIUnblockArgs() *UserAPIUnblockArgs {
+	return &UserAPIUnblockArgs{}
 }
 
-func (p *UserAPILoginArgs) InitDefault() {
+func (p *UserAPIUnblockArgs) InitDefault() {
 }
 
-var UserAPILoginArgs_Request_DEFAULT *user.LoginRequest
+var UserAPIUnblockArgs_Request_DEFAULT *user.UnblockRequest
 
-func (p *UserAPILoginArgs) GetRequest() (v *user.LoginRequest) {
+func (p *UserAPIUnblockArgs) GetRequest() (v *user.UnblockRequest) {
 	if !p.IsSetRequest() {
-		return UserAPILoginArgs_Request_DEFAULT
+		return UserAPIUnblockArgs_Request_DEFAULT
 	}
 	return p.Request
 }
 
-var fieldIDToName_UserAPILoginArgs = map[int16]string{
+var fieldIDToName_UserAPIUnblockArgs = map[int16]string{
 	1: "request",
 }
 
-func (p *UserAPILoginArgs) IsSetRequest() bool {
+func (p *UserAPIUnblockArgs) IsSetRequest() bool {
 	return p.Request != nil
 }
 
-func (p *UserAPILoginArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *UserAPIUnblockArgs) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -746,7 +3914,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserAPILoginArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserAPIUnblockArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -756,8 +3924,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *UserAPILoginArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := user.NewLoginRequest()
+func (p *UserAPIUnblockArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := user.NewUnblockRequest()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -765,9 +3933,9 @@ func (p *UserAPILoginArgs) ReadField1(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *UserAPILoginArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *UserAPIUnblockArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("Login_args"); err != nil {
+	if err = oprot.WriteStructBegin("Unblock_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -793,7 +3961,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *UserAPILoginArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *UserAPIUnblockArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("request", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -810,43 +3978,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *UserAPILoginArgs) String() string {
+func (p *UserAPIUnblockArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("UserAPILoginArgs(%+v)", *p)
+	return fmt.Sprintf("UserAPIUnblockArgs(%+v)", *p)
 
 }
 
-type UserAPILoginResult struct {
-	Success *user.LoginResponse `thrift:"success,0,optional"`
+type UserAPIUnblockResult struct {
+	Success *user.UnblockResponse `thrift:"success,0,optional"`
 }
 
-func NewUserAPILoginResult() *UserAPILoginResult {
-	return &UserAPILoginResult{}
+func NewUserAPIUnblockResult() *UserAPIUnblockResult {
+	return &UserAPIUnblockResult{}
 }
 
-func (p *UserAPILoginResult) InitDefault() {
+func (p *UserAPIUnblockResult) InitDefault() {
 }
 
-var UserAPILoginResult_Success_DEFAULT *user.LoginResponse
+var UserAPIUnblockResult_Success_DEFAULT *user.UnblockResponse
 
-func (p *UserAPILoginResult) GetSuccess() (v *user.LoginResponse) {
+func (p *UserAPIUnblockResult) GetSuccess() (v *user.UnblockResponse) {
 	if !p.IsSetSuccess() {
-		return UserAPILoginResult_Success_DEFAULT
+		return UserAPIUnblockResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_UserAPILoginResult = map[int16]string{
+var fieldIDToName_UserAPIUnblockResult = map[int16]string{
 	0: "success",
 }
 
-func (p *UserAPILoginResult) IsSetSuccess() bool {
+func (p *UserAPIUnblockResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *UserAPILoginResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *UserAPIUnblockResult) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -891,7 +4059,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserAPILoginResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserAPIUnblockResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -901,8 +4069,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *UserAPILoginResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := user.NewLoginResponse()
+func (p *UserAPIUnblockResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := user.NewUnblockResponse()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -910,9 +4078,9 @@ func (p *UserAPILoginResult) ReadField0(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *UserAPILoginResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *UserAPIUnblockResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("Login_result"); err != nil {
+	if err = oprot.WriteStructBegin("Unblock_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -938,7 +4106,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *UserAPILoginResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *UserAPIUnblockResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -957,43 +4125,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *UserAPILoginResult) String() string {
+func (p *UserAPIUnblockResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("UserAPILoginResult(%+v)", *p)
+	return fmt.Sprintf("UserAPIUnblockResult(%+v)", *p)
 
 }
 
-type UserAPIRefreshTokenArgs struct {
-	Request *user.RefreshTokenRequest `thrift:"request,1"`
+type UserAPIGetBlockListArgs struct {
+	Request *user.BlockListRequest `thrift:"request,1"`
 }
 
-func NewUserAPIRefreshTokenArgs() *UserAPIRefreshTokenArgs {
-	return &UserAPIRefreshTokenArgs{}
+func NewUserAPIGetBlockListArgs() *UserAPIGetBlockListArgs {
+	return &UserAPIGetBlockListArgs{}
 }
 
-func (p *UserAPIRefreshTokenArgs) InitDefault() {
+func (p *UserAPIGetBlockListArgs) InitDefault() {
 }
 
-var UserAPIRefreshTokenArgs_Request_DEFAULT *user.RefreshTokenRequest
+var UserAPIGetBlockListArgs_Request_DEFAULT *user.BlockListRequest
 
-func (p *UserAPIRefreshTokenArgs) GetRequest() (v *user.RefreshTokenRequest) {
+func (p *UserAPIGetBlockListArgs) GetRequest() (v *user.BlockListRequest) {
 	if !p.IsSetRequest() {
-		return UserAPIRefreshTokenArgs_Request_DEFAULT
+		return UserAPIGetBlockListArgs_Request_DEFAULT
 	}
 	return p.Request
 }
 
-var fieldIDToName_UserAPIRefreshTokenArgs = map[int16]string{
+var fieldIDToName_UserAPIGetBlockListArgs = map[int16]string{
 	1: "request",
 }
 
-func (p *UserAPIRefreshTokenArgs) IsSetRequest() bool {
+func (p *UserAPIGetBlockListArgs) IsSetRequest() bool {
 	return p.Request != nil
 }
 
-func (p *UserAPIRefreshTokenArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *UserAPIGetBlockListArgs) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -1038,7 +4206,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserAPIRefreshTokenArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserAPIGetBlockListArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -1048,8 +4216,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *UserAPIRefreshTokenArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := user.NewRefreshTokenRequest()
+func (p *UserAPIGetBlockListArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := user.NewBlockListRequest()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -1057,9 +4225,9 @@ func (p *UserAPIRefreshTokenArgs) ReadField1(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *UserAPIRefreshTokenArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *UserAPIGetBlockListArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("RefreshToken_args"); err != nil {
+	if err = oprot.WriteStructBegin("GetBlockList_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -1085,7 +4253,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *UserAPIRefreshTokenArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *UserAPIGetBlockListArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("request", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -1102,43 +4270,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *UserAPIRefreshTokenArgs) String() string {
+func (p *UserAPIGetBlockListArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("UserAPIRefreshTokenArgs(%+v)", *p)
+	return fmt.Sprintf("UserAPIGetBlockListArgs(%+v)", *p)
 
 }
 
-type UserAPIRefreshTokenResult struct {
-	Success *user.RefreshTokenResponse `thrift:"success,0,optional"`
+type UserAPIGetBlockListResult struct {
+	Success *user.BlockListResponse `thrift:"success,0,optional"`
 }
 
-func NewUserAPIRefreshTokenResult() *UserAPIRefreshTokenResult {
-	return &UserAPIRefreshTokenResult{}
+func NewUserAPIGetBlockListResult() *UserAPIGetBlockListResult {
+	return &UserAPIGetBlockListResult{}
 }
 
-func (p *UserAPIRefreshTokenResult) InitDefault() {
+func (p *UserAPIGetBlockListResult) InitDefault() {
 }
 
-var UserAPIRefreshTokenResult_Success_DEFAULT *user.RefreshTokenResponse
+var UserAPIGetBlockListResult_Success_DEFAULT *user.BlockListResponse
 
-func (p *UserAPIRefreshTokenResult) GetSuccess() (v *user.RefreshTokenResponse) {
+func (p *UserAPIGetBlockListResult) GetSuccess() (v *user.BlockListResponse) {
 	if !p.IsSetSuccess() {
-		return UserAPIRefreshTokenResult_Success_DEFAULT
+		return UserAPIGetBlockListResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_UserAPIRefreshTokenResult = map[int16]string{
+var fieldIDToName_UserAPIGetBlockListResult = map[int16]string{
 	0: "success",
 }
 
-func (p *UserAPIRefreshTokenResult) IsSetSuccess() bool {
+func (p *UserAPIGetBlockListResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *UserAPIRefreshTokenResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *UserAPIGetBlockListResult) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -1183,7 +4351,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserAPIRefreshTokenResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserAPIGetBlockListResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -1193,8 +4361,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *UserAPIRefreshTokenResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := user.NewRefreshTokenResponse()
+func (p *UserAPIGetBlockListResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := user.NewBlockListResponse()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -1202,9 +4370,9 @@ func (p *UserAPIRefreshTokenResult) ReadField0(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *UserAPIRefreshTokenResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *UserAPIGetBlockListResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("RefreshToken_result"); err != nil {
+	if err = oprot.WriteStructBegin("GetBlockList_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -1230,7 +4398,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *UserAPIRefreshTokenResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *UserAPIGetBlockListResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -1249,43 +4417,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *UserAPIRefreshTokenResult) String() string {
+func (p *UserAPIGetBlockListResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("UserAPIRefreshTokenResult(%+v)", *p)
+	return fmt.Sprintf("UserAPIGetBlockListResult(%+v)", *p)
 
 }
 
-type UserAPIGetUserInfoArgs struct {
-	Request *user.UserInfoRequest `thrift:"request,1"`
+type UserAPIGetPrivacySettingsArgs struct {
+	Request *user.GetPrivacySettingsRequest `thrift:"request,1"`
 }
 
-func NewUserAPIGetUserInfoArgs() *UserAPIGetUserInfoArgs {
-	return &UserAPIGetUserInfoArgs{}
+func NewUserAPIGetPrivacySettingsArgs() *UserAPIGetPrivacySettingsArgs {
+	return &UserAPIGetPrivacySettingsArgs{}
 }
 
-func (p *UserAPIGetUserInfoArgs) InitDefault() {
+func (p *UserAPIGetPrivacySettingsArgs) InitDefault() {
 }
 
-var UserAPIGetUserInfoArgs_Request_DEFAULT *user.UserInfoRequest
+var UserAPIGetPrivacySettingsArgs_Request_DEFAULT *user.GetPrivacySettingsRequest
 
-func (p *UserAPIGetUserInfoArgs) GetRequest() (v *user.UserInfoRequest) {
+func (p *UserAPIGetPrivacySettingsArgs) GetRequest() (v *user.GetPrivacySettingsRequest) {
 	if !p.IsSetRequest() {
-		return UserAPIGetUserInfoArgs_Request_DEFAULT
+		return UserAPIGetPrivacySettingsArgs_Request_DEFAULT
 	}
 	return p.Request
 }
 
-var fieldIDToName_UserAPIGetUserInfoArgs = map[int16]string{
+var fieldIDToName_UserAPIGetPrivacySettingsArgs = map[int16]string{
 	1: "request",
 }
 
-func (p *UserAPIGetUserInfoArgs) IsSetRequest() bool {
+func (p *UserAPIGetPrivacySettingsArgs) IsSetRequest() bool {
 	return p.Request != nil
 }
 
-func (p *UserAPIGetUserInfoArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *UserAPIGetPrivacySettingsArgs) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -1330,7 +4498,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserAPIGetUserInfoArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserAPIGetPrivacySettingsArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -1340,8 +4508,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *UserAPIGetUserInfoArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := user.NewUserInfoRequest()
+func (p *UserAPIGetPrivacySettingsArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := user.NewGetPrivacySettingsRequest()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -1349,9 +4517,9 @@ func (p *UserAPIGetUserInfoArgs) ReadField1(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *UserAPIGetUserInfoArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *UserAPIGetPrivacySettingsArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("GetUserInfo_args"); err != nil {
+	if err = oprot.WriteStructBegin("GetPrivacySettings_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -1377,7 +4545,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *UserAPIGetUserInfoArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *UserAPIGetPrivacySettingsArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("request", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -1394,43 +4562,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *UserAPIGetUserInfoArgs) String() string {
+func (p *UserAPIGetPrivacySettingsArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("UserAPIGetUserInfoArgs(%+v)", *p)
+	return fmt.Sprintf("UserAPIGetPrivacySettingsArgs(%+v)", *p)
 
 }
 
-type UserAPIGetUserInfoResult struct {
-	Success *user.UserInfoResponse `thrift:"success,0,optional"`
+type UserAPIGetPrivacySettingsResult struct {
+	Success *user.GetPrivacySettingsResponse `thrift:"success,0,optional"`
 }
 
-func NewUserAPIGetUserInfoResult() *UserAPIGetUserInfoResult {
-	return &UserAPIGetUserInfoResult{}
+func NewUserAPIGetPrivacySettingsResult() *UserAPIGetPrivacySettingsResult {
+	return &UserAPIGetPrivacySettingsResult{}
 }
 
-func (p *UserAPIGetUserInfoResult) InitDefault() {
+func (p *UserAPIGetPrivacySettingsResult) InitDefault() {
 }
 
-var UserAPIGetUserInfoResult_Success_DEFAULT *user.UserInfoResponse
+var UserAPIGetPrivacySettingsResult_Success_DEFAULT *user.GetPrivacySettingsResponse
 
-func (p *UserAPIGetUserInfoResult) GetSuccess() (v *user.UserInfoResponse) {
+func (p *UserAPIGetPrivacySettingsResult) GetSuccess() (v *user.GetPrivacySettingsResponse) {
 	if !p.IsSetSuccess() {
-		return UserAPIGetUserInfoResult_Success_DEFAULT
+		return UserAPIGetPrivacySettingsResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_UserAPIGetUserInfoResult = map[int16]string{
+var fieldIDToName_UserAPIGetPrivacySettingsResult = map[int16]string{
 	0: "success",
 }
 
-func (p *UserAPIGetUserInfoResult) IsSetSuccess() bool {
+func (p *UserAPIGetPrivacySettingsResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *UserAPIGetUserInfoResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *UserAPIGetPrivacySettingsResult) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -1475,7 +4643,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserAPIGetUserInfoResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserAPIGetPrivacySettingsResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -1485,8 +4653,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *UserAPIGetUserInfoResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := user.NewUserInfoResponse()
+func (p *UserAPIGetPrivacySettingsResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := user.NewGetPrivacySettingsResponse()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -1494,9 +4662,9 @@ func (p *UserAPIGetUserInfoResult) ReadField0(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *UserAPIGetUserInfoResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *UserAPIGetPrivacySettingsResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("GetUserInfo_result"); err != nil {
+	if err = oprot.WriteStructBegin("GetPrivacySettings_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -1522,7 +4690,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *UserAPIGetUserInfoResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *UserAPIGetPrivacySettingsResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -1541,43 +4709,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *UserAPIGetUserInfoResult) String() string {
+func (p *UserAPIGetPrivacySettingsResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("UserAPIGetUserInfoResult(%+v)", *p)
+	return fmt.Sprintf("UserAPIGetPrivacySettingsResult(%+v)", *p)
 
 }
 
-type UserAPIUploadAvatarArgs struct {
-	Request *user.UploadAvatarRequest `thrift:"request,1"`
+type UserAPIUpdatePrivacySettingsArgs struct {
+	Request *user.UpdatePrivacySettingsRequest `thrift:"request,1"`
 }
 
-func NewUserAPIUploadAvatarArgs() *UserAPIUploadAvatarArgs {
-	return &UserAPIUploadAvatarArgs{}
+func NewUserAPIUpdatePrivacySettingsArgs() *UserAPIUpdatePrivacySettingsArgs {
+	return &UserAPIUpdatePrivacySettingsArgs{}
 }
 
-func (p *UserAPIUploadAvatarArgs) InitDefault() {
+func (p *UserAPIUpdatePrivacySettingsArgs) InitDefault() {
 }
 
-var UserAPIUploadAvatarArgs_Request_DEFAULT *user.UploadAvatarRequest
+var UserAPIUpdatePrivacySettingsArgs_Request_DEFAULT *user.UpdatePrivacySettingsRequest
 
-func (p *UserAPIUploadAvatarArgs) GetRequest() (v *user.UploadAvatarRequest) {
+func (p *UserAPIUpdatePrivacySettingsArgs) GetRequest() (v *user.UpdatePrivacySettingsRequest) {
 	if !p.IsSetRequest() {
-		return UserAPIUploadAvatarArgs_Request_DEFAULT
+		return UserAPIUpdatePrivacySettingsArgs_Request_DEFAULT
 	}
 	return p.Request
 }
 
-var fieldIDToName_UserAPIUploadAvatarArgs = map[int16]string{
+var fieldIDToName_UserAPIUpdatePrivacySettingsArgs = map[int16]string{
 	1: "request",
 }
 
-func (p *UserAPIUploadAvatarArgs) IsSetRequest() bool {
+func (p *UserAPIUpdatePrivacySettingsArgs) IsSetRequest() bool {
 	return p.Request != nil
 }
 
-func (p *UserAPIUploadAvatarArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *UserAPIUpdatePrivacySettingsArgs) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -1622,7 +4790,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserAPIUploadAvatarArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserAPIUpdatePrivacySettingsArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -1632,8 +4800,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *UserAPIUploadAvatarArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := user.NewUploadAvatarRequest()
+func (p *UserAPIUpdatePrivacySettingsArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := user.NewUpdatePrivacySettingsRequest()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -1641,9 +4809,9 @@ func (p *UserAPIUploadAvatarArgs) ReadField1(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *UserAPIUploadAvatarArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *UserAPIUpdatePrivacySettingsArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("UploadAvatar_args"); err != nil {
+	if err = oprot.WriteStructBegin("UpdatePrivacySettings_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -1669,7 +4837,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *UserAPIUploadAvatarArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *UserAPIUpdatePrivacySettingsArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("request", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -1686,43 +4854,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *UserAPIUploadAvatarArgs) String() string {
+func (p *UserAPIUpdatePrivacySettingsArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("UserAPIUploadAvatarArgs(%+v)", *p)
+	return fmt.Sprintf("UserAPIUpdatePrivacySettingsArgs(%+v)", *p)
 
 }
 
-type UserAPIUploadAvatarResult struct {
-	Success *user.UploadAvatarResponse `thrift:"success,0,optional"`
+type UserAPIUpdatePrivacySettingsResult struct {
+	Success *user.UpdatePrivacySettingsResponse `thrift:"success,0,optional"`
 }
 
-func NewUserAPIUploadAvatarResult() *UserAPIUploadAvatarResult {
-	return &UserAPIUploadAvatarResult{}
+func NewUserAPIUpdatePrivacySettingsResult() *UserAPIUpdatePrivacySettingsResult {
+	return &UserAPIUpdatePrivacySettingsResult{}
 }
 
-func (p *UserAPIUploadAvatarResult) InitDefault() {
+func (p *UserAPIUpdatePrivacySettingsResult) InitDefault() {
 }
 
-var UserAPIUploadAvatarResult_Success_DEFAULT *user.UploadAvatarResponse
+var UserAPIUpdatePrivacySettingsResult_Success_DEFAULT *user.UpdatePrivacySettingsResponse
 
-func (p *UserAPIUploadAvatarResult) GetSuccess() (v *user.UploadAvatarResponse) {
+func (p *UserAPIUpdatePrivacySettingsResult) GetSuccess() (v *user.UpdatePrivacySettingsResponse) {
 	if !p.IsSetSuccess() {
-		return UserAPIUploadAvatarResult_Success_DEFAULT
+		return UserAPIUpdatePrivacySettingsResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_UserAPIUploadAvatarResult = map[int16]string{
+var fieldIDToName_UserAPIUpdatePrivacySettingsResult = map[int16]string{
 	0: "success",
 }
 
-func (p *UserAPIUploadAvatarResult) IsSetSuccess() bool {
+func (p *UserAPIUpdatePrivacySettingsResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *UserAPIUploadAvatarResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *UserAPIUpdatePrivacySettingsResult) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -1767,7 +4935,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserAPIUploadAvatarResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserAPIUpdatePrivacySettingsResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -1777,8 +4945,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *UserAPIUploadAvatarResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := user.NewUploadAvatarResponse()
+func (p *UserAPIUpdatePrivacySettingsResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := user.NewUpdatePrivacySettingsResponse()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -1786,9 +4954,9 @@ func (p *UserAPIUploadAvatarResult) ReadField0(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *UserAPIUploadAvatarResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *UserAPIUpdatePrivacySettingsResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("UploadAvatar_result"); err != nil {
+	if err = oprot.WriteStructBegin("UpdatePrivacySettings_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -1814,7 +4982,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *UserAPIUploadAvatarResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *UserAPIUpdatePrivacySettingsResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -1833,10 +5001,10 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *UserAPIUploadAvatarResult) String() string {
+func (p *UserAPIUpdatePrivacySettingsResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("UserAPIUploadAvatarResult(%+v)", *p)
+	return fmt.Sprintf("UserAPIUpdatePrivacySettingsResult(%+v)", *p)
 
 }
@@ -5,6 +5,7 @@ package video
 import (
 	"context"
 	"fmt"
+
 	"github.com/apache/thrift/lib/go/thrift"
 	"github.com/yxrxy/videoHub/app/gateway/model/video"
 )
@@ -20,6 +21,8 @@ type VideoAPI interface {
 
 	GetHotVideos(ctx context.Context, request *video.HotVideoRequest) (r *video.HotVideoResponse, err error)
 
+	GetFollowingFeed(ctx context.Context, request *video.FollowingFeedRequest) (r *video.FollowingFeedResponse, err error)
+
 	DeleteVideo(ctx context.Context, request *video.DeleteRequest) (r *video.DeleteResponse, err error)
 
 	SearchVideo(ctx context.Context, request *video.SearchRequest) (r *video.SearchResponse, err error)
@@ -93,6 +96,15 @@ func (p *VideoAPIClient) GetHotVideos(ctx context.Context, request *video.HotVid
 	}
 	return _result.GetSuccess(), nil
 }
+func (p *VideoAPIClient) GetFollowingFeed(ctx context.Context, request *video.FollowingFeedRequest) (r *video.FollowingFeedResponse, err error) {
+	var _args VideoAPIGetFollowingFeedArgs
+	_args.Request = request
+	var _result VideoAPIGetFollowingFeedResult
+	if err = p.Client_().Call(ctx, "GetFollowingFeed", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
 func (p *VideoAPIClient) DeleteVideo(ctx context.Context, request *video.DeleteRequest) (r *video.DeleteResponse, err error) {
 	var _args VideoAPIDeleteVideoArgs
 	_args.Request = request
@@ -163,6 +175,7 @@ func NewVideoAPIProcessor(handler VideoAPI) *VideoAPIProcessor {
 	self.AddToProcessorMap("GetVideoList", &videoAPIProcessorGetVideoList{handler: handler})
 	self.AddToProcessorMap("GetVideoDetail", &videoAPIProcessorGetVideoDetail{handler: handler})
 	self.AddToProcessorMap("GetHotVideos", &videoAPIProcessorGetHotVideos{handler: handler})
+	self.AddToProcessorMap("GetFollowingFeed", &videoAPIProcessorGetFollowingFeed{handler: handler})
 	self.AddToProcessorMap("DeleteVideo", &videoAPIProcessorDeleteVideo{handler: handler})
 	self.AddToProcessorMap("SearchVideo", &videoAPIProcessorSearchVideo{handler: handler})
 	self.AddToProcessorMap("SemanticSearch", &videoAPIProcessorSemanticSearch{handler: handler})
@@ -380,6 +393,54 @@ func (p *videoAPIProcessorGetHotVideos) Process(ctx context.Context, seqId int32
 	return true, err
 }
 
+type videoAPIProcessorGetFollowingFeed struct {
+	handler VideoAPI
+}
+
+func (p *videoAPIProcessorGetFollowingFeed) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := VideoAPIGetFollowingFeedArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("GetFollowingFeed", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := VideoAPIGetFollowingFeedResult{}
+	var retval *video.FollowingFeedResponse
+	if retval, err2 = p.handler.GetFollowingFeed(ctx, args.Request); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetFollowingFeed: "+err2.Error())
+		oprot.WriteMessageBegin("GetFollowingFeed", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("GetFollowingFeed", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
 type videoAPIProcessorDeleteVideo struct {
 	handler VideoAPI
 }
@@ -1788,6 +1849,298 @@ func (p *VideoAPIGetHotVideosResult) String() string {
 
 }
 
+type VideoAPIGetFollowingFeedArgs struct {
+	Request *video.FollowingFeedRequest `thrift:"request,1"`
+}
+
+func NewVideoAPIGetFollowingFeedArgs() *VideoAPIGetFollowingFeedArgs {
+	return &VideoAPIGetFollowingFeedArgs{}
+}
+
+func (p *VideoAPIGetFollowingFeedArgs) InitDefault() {
+}
+
+var VideoAPIGetFollowingFeedArgs_Request_DEFAULT *video.FollowingFeedRequest
+
+func (p *VideoAPIGetFollowingFeedArgs) GetRequest() (v *video.FollowingFeedRequest) {
+	if !p.IsSetRequest() {
+		return VideoAPIGetFollowingFeedArgs_Request_DEFAULT
+	}
+	return p.Request
+}
+
+var fieldIDToName_VideoAPIGetFollowingFeedArgs = map[int16]string{
+	1: "request",
+}
+
+func (p *VideoAPIGetFollowingFeedArgs) IsSetRequest() bool {
+	return p.Request != nil
+}
+
+func (p *VideoAPIGetFollowingFeedArgs) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VideoAPIGetFollowingFeedArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *VideoAPIGetFollowingFeedArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := video.NewFollowingFeedRequest()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Request = _field
+	return nil
+}
+
+func (p *VideoAPIGetFollowingFeedArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("GetFollowingFeed_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *VideoAPIGetFollowingFeedArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("request", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Request.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *VideoAPIGetFollowingFeedArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("VideoAPIGetFollowingFeedArgs(%+v)", *p)
+
+}
+
+type VideoAPIGetFollowingFeedResult struct {
+	Success *video.FollowingFeedResponse `thrift:"success,0,optional"`
+}
+
+func NewVideoAPIGetFollowingFeedResult() *VideoAPIGetFollowingFeedResult {
+	return &VideoAPIGetFollowingFeedResult{}
+}
+
+func (p *VideoAPIGetFollowingFeedResult) InitDefault() {
+}
+
+var VideoAPIGetFollowingFeedResult_Success_DEFAULT *video.FollowingFeedResponse
+
+func (p *VideoAPIGetFollowingFeedResult) GetSuccess() (v *video.FollowingFeedResponse) {
+	if !p.IsSetSuccess() {
+		return VideoAPIGetFollowingFeedResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var fieldIDToName_VideoAPIGetFollowingFeedResult = map[int16]string{
+	0: "success",
+}
+
+func (p *VideoAPIGetFollowingFeedResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *VideoAPIGetFollowingFeedResult) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VideoAPIGetFollowingFeedResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *VideoAPIGetFollowingFeedResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := video.NewFollowingFeedResponse()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *VideoAPIGetFollowingFeedResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("GetFollowingFeed_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *VideoAPIGetFollowingFeedResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *VideoAPIGetFollowingFeedResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("VideoAPIGetFollowingFeedResult(%+v)", *p)
+
+}
+
 type VideoAPIDeleteVideoArgs struct {
 	Request *video.DeleteRequest `thrift:"request,1"`
 }
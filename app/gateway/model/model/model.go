@@ -4,6 +4,7 @@ package model
 
 import (
 	"fmt"
+
 	"github.com/apache/thrift/lib/go/thrift"
 )
 
@@ -738,175 +739,71 @@ func (p *User) String() string {
 
 }
 
-// 视频模型
-type Video struct {
-	// 视频ID
+// 关注列表中的用户
+type FollowUser struct {
+	// 用户ID
 	ID int64 `thrift:"id,1,required" form:"id,required" json:"id,required" query:"id,required"`
-	// 作者ID
-	AuthorId int64 `thrift:"authorId,2,required" form:"authorId,required" json:"authorId,required" query:"authorId,required"`
-	// 标题
-	Title string `thrift:"title,3,required" form:"title,required" json:"title,required" query:"title,required"`
-	// 播放地址
-	PlayUrl string `thrift:"playUrl,4,required" form:"playUrl,required" json:"playUrl,required" query:"playUrl,required"`
-	// 封面地址
-	CoverUrl string `thrift:"coverUrl,5,required" form:"coverUrl,required" json:"coverUrl,required" query:"coverUrl,required"`
-	// 点赞数
-	FavoriteCount *int64 `thrift:"favoriteCount,6,optional" form:"favoriteCount" json:"favoriteCount,omitempty" query:"favoriteCount"`
-	// 评论数
-	CommentCount *int64 `thrift:"commentCount,7,optional" form:"commentCount" json:"commentCount,omitempty" query:"commentCount"`
-	// 是否已点赞
-	IsFavorite *bool `thrift:"isFavorite,8,optional" form:"isFavorite" json:"isFavorite,omitempty" query:"isFavorite"`
-	// 作者信息
-	Author *User `thrift:"author,9,optional" form:"author" json:"author,omitempty" query:"author"`
-	// 视频描述
-	Description *string `thrift:"description,10,optional" form:"description" json:"description,omitempty" query:"description"`
-	// 创建时间
-	CreatedAt *int64 `thrift:"createdAt,11,optional" form:"createdAt" json:"createdAt,omitempty" query:"createdAt"`
-	// 更新时间
-	UpdatedAt *int64 `thrift:"updatedAt,12,optional" form:"updatedAt" json:"updatedAt,omitempty" query:"updatedAt"`
+	// 用户名
+	Username string `thrift:"username,2,required" form:"username,required" json:"username,required" query:"username,required"`
+	// 头像URL
+	Avatar *string `thrift:"avatar,3,optional" form:"avatar" json:"avatar,omitempty" query:"avatar"`
+	// 关注时间
+	FollowedAt int64 `thrift:"followed_at,4,required" form:"followed_at,required" json:"followed_at,required" query:"followed_at,required"`
+	// 是否互相关注
+	IsMutual bool `thrift:"is_mutual,5,required" form:"is_mutual,required" json:"is_mutual,required" query:"is_mutual,required"`
 }
 
-func NewVideo() *Video {
-	return &Video{}
+func NewFollowUser() *FollowUser {
+	return &FollowUser{}
 }
 
-func (p *Video) InitDefault() {
+func (p *FollowUser) InitDefault() {
 }
 
-func (p *Video) GetID() (v int64) {
+func (p *FollowUser) GetID() (v int64) {
 	return p.ID
 }
 
-func (p *Video) GetAuthorId() (v int64) {
-	return p.AuthorId
-}
-
-func (p *Video) GetTitle() (v string) {
-	return p.Title
-}
-
-func (p *Video) GetPlayUrl() (v string) {
-	return p.PlayUrl
-}
-
-func (p *Video) GetCoverUrl() (v string) {
-	return p.CoverUrl
-}
-
-var Video_FavoriteCount_DEFAULT int64
-
-func (p *Video) GetFavoriteCount() (v int64) {
-	if !p.IsSetFavoriteCount() {
-		return Video_FavoriteCount_DEFAULT
-	}
-	return *p.FavoriteCount
-}
-
-var Video_CommentCount_DEFAULT int64
-
-func (p *Video) GetCommentCount() (v int64) {
-	if !p.IsSetCommentCount() {
-		return Video_CommentCount_DEFAULT
-	}
-	return *p.CommentCount
-}
-
-var Video_IsFavorite_DEFAULT bool
-
-func (p *Video) GetIsFavorite() (v bool) {
-	if !p.IsSetIsFavorite() {
-		return Video_IsFavorite_DEFAULT
-	}
-	return *p.IsFavorite
-}
-
-var Video_Author_DEFAULT *User
-
-func (p *Video) GetAuthor() (v *User) {
-	if !p.IsSetAuthor() {
-		return Video_Author_DEFAULT
-	}
-	return p.Author
-}
-
-var Video_Description_DEFAULT string
-
-func (p *Video) GetDescription() (v string) {
-	if !p.IsSetDescription() {
-		return Video_Description_DEFAULT
-	}
-	return *p.Description
-}
-
-var Video_CreatedAt_DEFAULT int64
-
-func (p *Video) GetCreatedAt() (v int64) {
-	if !p.IsSetCreatedAt() {
-		return Video_CreatedAt_DEFAULT
-	}
-	return *p.CreatedAt
+func (p *FollowUser) GetUsername() (v string) {
+	return p.Username
 }
 
-var Video_UpdatedAt_DEFAULT int64
+var FollowUser_Avatar_DEFAULT string
 
-func (p *Video) GetUpdatedAt() (v int64) {
-	if !p.IsSetUpdatedAt() {
-		return Video_UpdatedAt_DEFAULT
+func (p *FollowUser) GetAvatar() (v string) {
+	if !p.IsSetAvatar() {
+		return FollowUser_Avatar_DEFAULT
 	}
-	return *p.UpdatedAt
-}
-
-var fieldIDToName_Video = map[int16]string{
-	1:  "id",
-	2:  "authorId",
-	3:  "title",
-	4:  "playUrl",
-	5:  "coverUrl",
-	6:  "favoriteCount",
-	7:  "commentCount",
-	8:  "isFavorite",
-	9:  "author",
-	10: "description",
-	11: "createdAt",
-	12: "updatedAt",
-}
-
-func (p *Video) IsSetFavoriteCount() bool {
-	return p.FavoriteCount != nil
-}
-
-func (p *Video) IsSetCommentCount() bool {
-	return p.CommentCount != nil
-}
-
-func (p *Video) IsSetIsFavorite() bool {
-	return p.IsFavorite != nil
+	return *p.Avatar
 }
 
-func (p *Video) IsSetAuthor() bool {
-	return p.Author != nil
+func (p *FollowUser) GetFollowedAt() (v int64) {
+	return p.FollowedAt
 }
 
-func (p *Video) IsSetDescription() bool {
-	return p.Description != nil
+func (p *FollowUser) GetIsMutual() (v bool) {
+	return p.IsMutual
 }
 
-func (p *Video) IsSetCreatedAt() bool {
-	return p.CreatedAt != nil
+var fieldIDToName_FollowUser = map[int16]string{
+	1: "id",
+	2: "username",
+	3: "avatar",
+	4: "followed_at",
+	5: "is_mutual",
 }
 
-func (p *Video) IsSetUpdatedAt() bool {
-	return p.UpdatedAt != nil
+func (p *FollowUser) IsSetAvatar() bool {
+	return p.Avatar != nil
 }
 
-func (p *Video) Read(iprot thrift.TProtocol) (err error) {
+func (p *FollowUser) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 	var issetID bool = false
-	var issetAuthorId bool = false
-	var issetTitle bool = false
-	var issetPlayUrl bool = false
-	var issetCoverUrl bool = false
+	var issetUsername bool = false
+	var issetFollowedAt bool = false
+	var issetIsMutual bool = false
 
 	if _, err = iprot.ReadStructBegin(); err != nil {
 		goto ReadStructBeginError
@@ -932,11 +829,11 @@ func (p *Video) Read(iprot thrift.TProtocol) (err error) {
 				goto SkipFieldError
 			}
 		case 2:
-			if fieldTypeId == thrift.I64 {
+			if fieldTypeId == thrift.STRING {
 				if err = p.ReadField2(iprot); err != nil {
 					goto ReadFieldError
 				}
-				issetAuthorId = true
+				issetUsername = true
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
@@ -945,81 +842,24 @@ func (p *Video) Read(iprot thrift.TProtocol) (err error) {
 				if err = p.ReadField3(iprot); err != nil {
 					goto ReadFieldError
 				}
-				issetTitle = true
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
 		case 4:
-			if fieldTypeId == thrift.STRING {
+			if fieldTypeId == thrift.I64 {
 				if err = p.ReadField4(iprot); err != nil {
 					goto ReadFieldError
 				}
-				issetPlayUrl = true
+				issetFollowedAt = true
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
 		case 5:
-			if fieldTypeId == thrift.STRING {
-				if err = p.ReadField5(iprot); err != nil {
-					goto ReadFieldError
-				}
-				issetCoverUrl = true
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 6:
-			if fieldTypeId == thrift.I64 {
-				if err = p.ReadField6(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 7:
-			if fieldTypeId == thrift.I64 {
-				if err = p.ReadField7(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 8:
 			if fieldTypeId == thrift.BOOL {
-				if err = p.ReadField8(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 9:
-			if fieldTypeId == thrift.STRUCT {
-				if err = p.ReadField9(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 10:
-			if fieldTypeId == thrift.STRING {
-				if err = p.ReadField10(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 11:
-			if fieldTypeId == thrift.I64 {
-				if err = p.ReadField11(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 12:
-			if fieldTypeId == thrift.I64 {
-				if err = p.ReadField12(iprot); err != nil {
+				if err = p.ReadField5(iprot); err != nil {
 					goto ReadFieldError
 				}
+				issetIsMutual = true
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
@@ -1041,22 +881,17 @@ func (p *Video) Read(iprot thrift.TProtocol) (err error) {
 		goto RequiredFieldNotSetError
 	}
 
-	if !issetAuthorId {
+	if !issetUsername {
 		fieldId = 2
 		goto RequiredFieldNotSetError
 	}
 
-	if !issetTitle {
-		fieldId = 3
-		goto RequiredFieldNotSetError
-	}
-
-	if !issetPlayUrl {
+	if !issetFollowedAt {
 		fieldId = 4
 		goto RequiredFieldNotSetError
 	}
 
-	if !issetCoverUrl {
+	if !issetIsMutual {
 		fieldId = 5
 		goto RequiredFieldNotSetError
 	}
@@ -1066,7 +901,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_Video[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_FollowUser[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -1075,10 +910,10 @@ ReadFieldEndError:
 ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 RequiredFieldNotSetError:
-	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_Video[fieldId]))
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_FollowUser[fieldId]))
 }
 
-func (p *Video) ReadField1(iprot thrift.TProtocol) error {
+func (p *FollowUser) ReadField1(iprot thrift.TProtocol) error {
 
 	var _field int64
 	if v, err := iprot.ReadI64(); err != nil {
@@ -1089,128 +924,54 @@ func (p *Video) ReadField1(iprot thrift.TProtocol) error {
 	p.ID = _field
 	return nil
 }
-func (p *Video) ReadField2(iprot thrift.TProtocol) error {
+func (p *FollowUser) ReadField2(iprot thrift.TProtocol) error {
 
-	var _field int64
-	if v, err := iprot.ReadI64(); err != nil {
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
 		return err
 	} else {
 		_field = v
 	}
-	p.AuthorId = _field
+	p.Username = _field
 	return nil
 }
-func (p *Video) ReadField3(iprot thrift.TProtocol) error {
+func (p *FollowUser) ReadField3(iprot thrift.TProtocol) error {
 
-	var _field string
+	var _field *string
 	if v, err := iprot.ReadString(); err != nil {
 		return err
 	} else {
-		_field = v
+		_field = &v
 	}
-	p.Title = _field
+	p.Avatar = _field
 	return nil
 }
-func (p *Video) ReadField4(iprot thrift.TProtocol) error {
+func (p *FollowUser) ReadField4(iprot thrift.TProtocol) error {
 
-	var _field string
-	if v, err := iprot.ReadString(); err != nil {
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
 		return err
 	} else {
 		_field = v
 	}
-	p.PlayUrl = _field
+	p.FollowedAt = _field
 	return nil
 }
-func (p *Video) ReadField5(iprot thrift.TProtocol) error {
+func (p *FollowUser) ReadField5(iprot thrift.TProtocol) error {
 
-	var _field string
-	if v, err := iprot.ReadString(); err != nil {
-		return err
-	} else {
-		_field = v
-	}
-	p.CoverUrl = _field
-	return nil
-}
-func (p *Video) ReadField6(iprot thrift.TProtocol) error {
-
-	var _field *int64
-	if v, err := iprot.ReadI64(); err != nil {
-		return err
-	} else {
-		_field = &v
-	}
-	p.FavoriteCount = _field
-	return nil
-}
-func (p *Video) ReadField7(iprot thrift.TProtocol) error {
-
-	var _field *int64
-	if v, err := iprot.ReadI64(); err != nil {
-		return err
-	} else {
-		_field = &v
-	}
-	p.CommentCount = _field
-	return nil
-}
-func (p *Video) ReadField8(iprot thrift.TProtocol) error {
-
-	var _field *bool
+	var _field bool
 	if v, err := iprot.ReadBool(); err != nil {
 		return err
 	} else {
-		_field = &v
-	}
-	p.IsFavorite = _field
-	return nil
-}
-func (p *Video) ReadField9(iprot thrift.TProtocol) error {
-	_field := NewUser()
-	if err := _field.Read(iprot); err != nil {
-		return err
-	}
-	p.Author = _field
-	return nil
-}
-func (p *Video) ReadField10(iprot thrift.TProtocol) error {
-
-	var _field *string
-	if v, err := iprot.ReadString(); err != nil {
-		return err
-	} else {
-		_field = &v
-	}
-	p.Description = _field
-	return nil
-}
-func (p *Video) ReadField11(iprot thrift.TProtocol) error {
-
-	var _field *int64
-	if v, err := iprot.ReadI64(); err != nil {
-		return err
-	} else {
-		_field = &v
-	}
-	p.CreatedAt = _field
-	return nil
-}
-func (p *Video) ReadField12(iprot thrift.TProtocol) error {
-
-	var _field *int64
-	if v, err := iprot.ReadI64(); err != nil {
-		return err
-	} else {
-		_field = &v
+		_field = v
 	}
-	p.UpdatedAt = _field
+	p.IsMutual = _field
 	return nil
 }
 
-func (p *Video) Write(oprot thrift.TProtocol) (err error) {
+func (p *FollowUser) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("Video"); err != nil {
+	if err = oprot.WriteStructBegin("FollowUser"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -1234,34 +995,6 @@ func (p *Video) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 5
 			goto WriteFieldError
 		}
-		if err = p.writeField6(oprot); err != nil {
-			fieldId = 6
-			goto WriteFieldError
-		}
-		if err = p.writeField7(oprot); err != nil {
-			fieldId = 7
-			goto WriteFieldError
-		}
-		if err = p.writeField8(oprot); err != nil {
-			fieldId = 8
-			goto WriteFieldError
-		}
-		if err = p.writeField9(oprot); err != nil {
-			fieldId = 9
-			goto WriteFieldError
-		}
-		if err = p.writeField10(oprot); err != nil {
-			fieldId = 10
-			goto WriteFieldError
-		}
-		if err = p.writeField11(oprot); err != nil {
-			fieldId = 11
-			goto WriteFieldError
-		}
-		if err = p.writeField12(oprot); err != nil {
-			fieldId = 12
-			goto WriteFieldError
-		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -1280,7 +1013,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *Video) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *FollowUser) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("id", thrift.I64, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -1296,11 +1029,11 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
-func (p *Video) writeField2(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("authorId", thrift.I64, 2); err != nil {
+func (p *FollowUser) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("username", thrift.STRING, 2); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteI64(p.AuthorId); err != nil {
+	if err := oprot.WriteString(p.Username); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -1312,15 +1045,17 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
-func (p *Video) writeField3(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("title", thrift.STRING, 3); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteString(p.Title); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
+func (p *FollowUser) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetAvatar() {
+		if err = oprot.WriteFieldBegin("avatar", thrift.STRING, 3); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Avatar); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
 	}
 	return nil
 WriteFieldBeginError:
@@ -1328,11 +1063,11 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
-func (p *Video) writeField4(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("playUrl", thrift.STRING, 4); err != nil {
+func (p *FollowUser) writeField4(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("followed_at", thrift.I64, 4); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteString(p.PlayUrl); err != nil {
+	if err := oprot.WriteI64(p.FollowedAt); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -1344,11 +1079,11 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
 }
-func (p *Video) writeField5(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("coverUrl", thrift.STRING, 5); err != nil {
+func (p *FollowUser) writeField5(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("is_mutual", thrift.BOOL, 5); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteString(p.CoverUrl); err != nil {
+	if err := oprot.WriteBool(p.IsMutual); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -1360,250 +1095,84 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
 }
-func (p *Video) writeField6(oprot thrift.TProtocol) (err error) {
-	if p.IsSetFavoriteCount() {
-		if err = oprot.WriteFieldBegin("favoriteCount", thrift.I64, 6); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteI64(*p.FavoriteCount); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
+
+func (p *FollowUser) String() string {
+	if p == nil {
+		return "<nil>"
 	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
+	return fmt.Sprintf("FollowUser(%+v)", *p)
+
 }
-func (p *Video) writeField7(oprot thrift.TProtocol) (err error) {
-	if p.IsSetCommentCount() {
-		if err = oprot.WriteFieldBegin("commentCount", thrift.I64, 7); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteI64(*p.CommentCount); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 7 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 7 end error: ", p), err)
+
+// 黑名单中的用户
+type BlockedUser struct {
+	// 用户ID
+	ID int64 `thrift:"id,1,required" form:"id,required" json:"id,required" query:"id,required"`
+	// 用户名
+	Username string `thrift:"username,2,required" form:"username,required" json:"username,required" query:"username,required"`
+	// 头像URL
+	Avatar *string `thrift:"avatar,3,optional" form:"avatar" json:"avatar,omitempty" query:"avatar"`
+	// 拉黑时间
+	BlockedAt int64 `thrift:"blocked_at,4,required" form:"blocked_at,required" json:"blocked_at,required" query:"blocked_at,required"`
 }
-func (p *Video) writeField8(oprot thrift.TProtocol) (err error) {
-	if p.IsSetIsFavorite() {
-		if err = oprot.WriteFieldBegin("isFavorite", thrift.BOOL, 8); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteBool(*p.IsFavorite); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 8 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 8 end error: ", p), err)
+
+func NewBlockedUser() *BlockedUser {
+	return &BlockedUser{}
 }
-func (p *Video) writeField9(oprot thrift.TProtocol) (err error) {
-	if p.IsSetAuthor() {
-		if err = oprot.WriteFieldBegin("author", thrift.STRUCT, 9); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := p.Author.Write(oprot); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
+
+func (p *BlockedUser) InitDefault() {
+}
+
+func (p *BlockedUser) GetID() (v int64) {
+	return p.ID
+}
+
+func (p *BlockedUser) GetUsername() (v string) {
+	return p.Username
+}
+
+var BlockedUser_Avatar_DEFAULT string
+
+func (p *BlockedUser) GetAvatar() (v string) {
+	if !p.IsSetAvatar() {
+		return BlockedUser_Avatar_DEFAULT
 	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 9 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 9 end error: ", p), err)
+	return *p.Avatar
 }
-func (p *Video) writeField10(oprot thrift.TProtocol) (err error) {
-	if p.IsSetDescription() {
-		if err = oprot.WriteFieldBegin("description", thrift.STRING, 10); err != nil {
-			goto WriteFieldBeginError
+
+func (p *BlockedUser) GetBlockedAt() (v int64) {
+	return p.BlockedAt
+}
+
+var fieldIDToName_BlockedUser = map[int16]string{
+	1: "id",
+	2: "username",
+	3: "avatar",
+	4: "blocked_at",
+}
+
+func (p *BlockedUser) IsSetAvatar() bool {
+	return p.Avatar != nil
+}
+
+func (p *BlockedUser) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetID bool = false
+	var issetUsername bool = false
+	var issetBlockedAt bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
 		}
-		if err := oprot.WriteString(*p.Description); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 10 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 10 end error: ", p), err)
-}
-func (p *Video) writeField11(oprot thrift.TProtocol) (err error) {
-	if p.IsSetCreatedAt() {
-		if err = oprot.WriteFieldBegin("createdAt", thrift.I64, 11); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteI64(*p.CreatedAt); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 11 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 11 end error: ", p), err)
-}
-func (p *Video) writeField12(oprot thrift.TProtocol) (err error) {
-	if p.IsSetUpdatedAt() {
-		if err = oprot.WriteFieldBegin("updatedAt", thrift.I64, 12); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteI64(*p.UpdatedAt); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 12 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 12 end error: ", p), err)
-}
-
-func (p *Video) String() string {
-	if p == nil {
-		return "<nil>"
-	}
-	return fmt.Sprintf("Video(%+v)", *p)
-
-}
-
-// 评论模型
-type Comment struct {
-	// 评论ID
-	ID int64 `thrift:"id,1,required" form:"id,required" json:"id,required" query:"id,required"`
-	// 用户ID
-	UserId int64 `thrift:"userId,2,required" form:"userId,required" json:"userId,required" query:"userId,required"`
-	// 视频ID
-	VideoId int64 `thrift:"videoId,3,required" form:"videoId,required" json:"videoId,required" query:"videoId,required"`
-	// 评论内容
-	Content string `thrift:"content,4,required" form:"content,required" json:"content,required" query:"content,required"`
-	// 用户信息
-	User *User `thrift:"user,5,optional" form:"user" json:"user,omitempty" query:"user"`
-	// 创建时间
-	CreatedAt *int64 `thrift:"createdAt,6,optional" form:"createdAt" json:"createdAt,omitempty" query:"createdAt"`
-	// 更新时间
-	UpdatedAt *int64 `thrift:"updatedAt,7,optional" form:"updatedAt" json:"updatedAt,omitempty" query:"updatedAt"`
-}
-
-func NewComment() *Comment {
-	return &Comment{}
-}
-
-func (p *Comment) InitDefault() {
-}
-
-func (p *Comment) GetID() (v int64) {
-	return p.ID
-}
-
-func (p *Comment) GetUserId() (v int64) {
-	return p.UserId
-}
-
-func (p *Comment) GetVideoId() (v int64) {
-	return p.VideoId
-}
-
-func (p *Comment) GetContent() (v string) {
-	return p.Content
-}
-
-var Comment_User_DEFAULT *User
-
-func (p *Comment) GetUser() (v *User) {
-	if !p.IsSetUser() {
-		return Comment_User_DEFAULT
-	}
-	return p.User
-}
-
-var Comment_CreatedAt_DEFAULT int64
-
-func (p *Comment) GetCreatedAt() (v int64) {
-	if !p.IsSetCreatedAt() {
-		return Comment_CreatedAt_DEFAULT
-	}
-	return *p.CreatedAt
-}
-
-var Comment_UpdatedAt_DEFAULT int64
-
-func (p *Comment) GetUpdatedAt() (v int64) {
-	if !p.IsSetUpdatedAt() {
-		return Comment_UpdatedAt_DEFAULT
-	}
-	return *p.UpdatedAt
-}
-
-var fieldIDToName_Comment = map[int16]string{
-	1: "id",
-	2: "userId",
-	3: "videoId",
-	4: "content",
-	5: "user",
-	6: "createdAt",
-	7: "updatedAt",
-}
-
-func (p *Comment) IsSetUser() bool {
-	return p.User != nil
-}
-
-func (p *Comment) IsSetCreatedAt() bool {
-	return p.CreatedAt != nil
-}
-
-func (p *Comment) IsSetUpdatedAt() bool {
-	return p.UpdatedAt != nil
-}
-
-func (p *Comment) Read(iprot thrift.TProtocol) (err error) {
-	var fieldTypeId thrift.TType
-	var fieldId int16
-	var issetID bool = false
-	var issetUserId bool = false
-	var issetVideoId bool = false
-	var issetContent bool = false
-
-	if _, err = iprot.ReadStructBegin(); err != nil {
-		goto ReadStructBeginError
-	}
-
-	for {
-		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
-		if err != nil {
-			goto ReadFieldBeginError
-		}
-		if fieldTypeId == thrift.STOP {
-			break
+		if fieldTypeId == thrift.STOP {
+			break
 		}
 
 		switch fieldId {
@@ -1617,53 +1186,28 @@ func (p *Comment) Read(iprot thrift.TProtocol) (err error) {
 				goto SkipFieldError
 			}
 		case 2:
-			if fieldTypeId == thrift.I64 {
+			if fieldTypeId == thrift.STRING {
 				if err = p.ReadField2(iprot); err != nil {
 					goto ReadFieldError
 				}
-				issetUserId = true
+				issetUsername = true
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
 		case 3:
-			if fieldTypeId == thrift.I64 {
+			if fieldTypeId == thrift.STRING {
 				if err = p.ReadField3(iprot); err != nil {
 					goto ReadFieldError
 				}
-				issetVideoId = true
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
 		case 4:
-			if fieldTypeId == thrift.STRING {
-				if err = p.ReadField4(iprot); err != nil {
-					goto ReadFieldError
-				}
-				issetContent = true
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 5:
-			if fieldTypeId == thrift.STRUCT {
-				if err = p.ReadField5(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 6:
-			if fieldTypeId == thrift.I64 {
-				if err = p.ReadField6(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 7:
 			if fieldTypeId == thrift.I64 {
-				if err = p.ReadField7(iprot); err != nil {
+				if err = p.ReadField4(iprot); err != nil {
 					goto ReadFieldError
 				}
+				issetBlockedAt = true
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
@@ -1685,17 +1229,12 @@ func (p *Comment) Read(iprot thrift.TProtocol) (err error) {
 		goto RequiredFieldNotSetError
 	}
 
-	if !issetUserId {
+	if !issetUsername {
 		fieldId = 2
 		goto RequiredFieldNotSetError
 	}
 
-	if !issetVideoId {
-		fieldId = 3
-		goto RequiredFieldNotSetError
-	}
-
-	if !issetContent {
+	if !issetBlockedAt {
 		fieldId = 4
 		goto RequiredFieldNotSetError
 	}
@@ -1705,7 +1244,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_Comment[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_BlockedUser[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -1714,10 +1253,10 @@ ReadFieldEndError:
 ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 RequiredFieldNotSetError:
-	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_Comment[fieldId]))
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_BlockedUser[fieldId]))
 }
 
-func (p *Comment) ReadField1(iprot thrift.TProtocol) error {
+func (p *BlockedUser) ReadField1(iprot thrift.TProtocol) error {
 
 	var _field int64
 	if v, err := iprot.ReadI64(); err != nil {
@@ -1728,29 +1267,7 @@ func (p *Comment) ReadField1(iprot thrift.TProtocol) error {
 	p.ID = _field
 	return nil
 }
-func (p *Comment) ReadField2(iprot thrift.TProtocol) error {
-
-	var _field int64
-	if v, err := iprot.ReadI64(); err != nil {
-		return err
-	} else {
-		_field = v
-	}
-	p.UserId = _field
-	return nil
-}
-func (p *Comment) ReadField3(iprot thrift.TProtocol) error {
-
-	var _field int64
-	if v, err := iprot.ReadI64(); err != nil {
-		return err
-	} else {
-		_field = v
-	}
-	p.VideoId = _field
-	return nil
-}
-func (p *Comment) ReadField4(iprot thrift.TProtocol) error {
+func (p *BlockedUser) ReadField2(iprot thrift.TProtocol) error {
 
 	var _field string
 	if v, err := iprot.ReadString(); err != nil {
@@ -1758,43 +1275,35 @@ func (p *Comment) ReadField4(iprot thrift.TProtocol) error {
 	} else {
 		_field = v
 	}
-	p.Content = _field
-	return nil
-}
-func (p *Comment) ReadField5(iprot thrift.TProtocol) error {
-	_field := NewUser()
-	if err := _field.Read(iprot); err != nil {
-		return err
-	}
-	p.User = _field
+	p.Username = _field
 	return nil
 }
-func (p *Comment) ReadField6(iprot thrift.TProtocol) error {
+func (p *BlockedUser) ReadField3(iprot thrift.TProtocol) error {
 
-	var _field *int64
-	if v, err := iprot.ReadI64(); err != nil {
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
 		return err
 	} else {
 		_field = &v
 	}
-	p.CreatedAt = _field
+	p.Avatar = _field
 	return nil
 }
-func (p *Comment) ReadField7(iprot thrift.TProtocol) error {
+func (p *BlockedUser) ReadField4(iprot thrift.TProtocol) error {
 
-	var _field *int64
+	var _field int64
 	if v, err := iprot.ReadI64(); err != nil {
 		return err
 	} else {
-		_field = &v
+		_field = v
 	}
-	p.UpdatedAt = _field
+	p.BlockedAt = _field
 	return nil
 }
 
-func (p *Comment) Write(oprot thrift.TProtocol) (err error) {
+func (p *BlockedUser) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("Comment"); err != nil {
+	if err = oprot.WriteStructBegin("BlockedUser"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -1814,18 +1323,6 @@ func (p *Comment) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 4
 			goto WriteFieldError
 		}
-		if err = p.writeField5(oprot); err != nil {
-			fieldId = 5
-			goto WriteFieldError
-		}
-		if err = p.writeField6(oprot); err != nil {
-			fieldId = 6
-			goto WriteFieldError
-		}
-		if err = p.writeField7(oprot); err != nil {
-			fieldId = 7
-			goto WriteFieldError
-		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -1844,7 +1341,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *Comment) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *BlockedUser) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("id", thrift.I64, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -1860,11 +1357,11 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
-func (p *Comment) writeField2(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("userId", thrift.I64, 2); err != nil {
+func (p *BlockedUser) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("username", thrift.STRING, 2); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteI64(p.UserId); err != nil {
+	if err := oprot.WriteString(p.Username); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -1876,15 +1373,17 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
-func (p *Comment) writeField3(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("videoId", thrift.I64, 3); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteI64(p.VideoId); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
+func (p *BlockedUser) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetAvatar() {
+		if err = oprot.WriteFieldBegin("avatar", thrift.STRING, 3); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Avatar); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
 	}
 	return nil
 WriteFieldBeginError:
@@ -1892,11 +1391,11 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
-func (p *Comment) writeField4(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("content", thrift.STRING, 4); err != nil {
+func (p *BlockedUser) writeField4(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("blocked_at", thrift.I64, 4); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteString(p.Content); err != nil {
+	if err := oprot.WriteI64(p.BlockedAt); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -1908,128 +1407,64 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
 }
-func (p *Comment) writeField5(oprot thrift.TProtocol) (err error) {
-	if p.IsSetUser() {
-		if err = oprot.WriteFieldBegin("user", thrift.STRUCT, 5); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := p.User.Write(oprot); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
-}
-func (p *Comment) writeField6(oprot thrift.TProtocol) (err error) {
-	if p.IsSetCreatedAt() {
-		if err = oprot.WriteFieldBegin("createdAt", thrift.I64, 6); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteI64(*p.CreatedAt); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
-}
-func (p *Comment) writeField7(oprot thrift.TProtocol) (err error) {
-	if p.IsSetUpdatedAt() {
-		if err = oprot.WriteFieldBegin("updatedAt", thrift.I64, 7); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteI64(*p.UpdatedAt); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 7 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 7 end error: ", p), err)
-}
 
-func (p *Comment) String() string {
+func (p *BlockedUser) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("Comment(%+v)", *p)
+	return fmt.Sprintf("BlockedUser(%+v)", *p)
 
 }
 
-type LikeInfo struct {
-	ID        int64  `thrift:"id,1,required" form:"id,required" json:"id,required" query:"id,required"`
-	UserID    int64  `thrift:"user_id,2,required" form:"user_id,required" json:"user_id,required" query:"user_id,required"`
-	VideoID   int64  `thrift:"video_id,3,required" form:"video_id,required" json:"video_id,required" query:"video_id,required"`
-	CreatedAt int64  `thrift:"created_at,4,required" form:"created_at,required" json:"created_at,required" query:"created_at,required"`
-	DeletedAt *int64 `thrift:"deleted_at,5,optional" form:"deleted_at" json:"deleted_at,omitempty" query:"deleted_at"`
-}
-
-func NewLikeInfo() *LikeInfo {
-	return &LikeInfo{}
-}
-
-func (p *LikeInfo) InitDefault() {
+// 隐私设置
+type PrivacySettings struct {
+	// 谁可以私信我：0=所有人 1=我关注的人 2=任何人都不可以
+	DmPolicy int8 `thrift:"dm_policy,1,required" form:"dm_policy,required" json:"dm_policy,required" query:"dm_policy,required"`
+	// 谁可以看我的点赞：0=所有人 1=我的粉丝 2=仅自己
+	LikesVisibility int8 `thrift:"likes_visibility,2,required" form:"likes_visibility,required" json:"likes_visibility,required" query:"likes_visibility,required"`
+	// 谁可以看我的收藏：0=所有人 1=我的粉丝 2=仅自己
+	CollectionsVisibility int8 `thrift:"collections_visibility,3,required" form:"collections_visibility,required" json:"collections_visibility,required" query:"collections_visibility,required"`
+	// 是否对他人隐藏在线状态和最后在线时间
+	HideOnline bool `thrift:"hide_online,4,required" form:"hide_online,required" json:"hide_online,required" query:"hide_online,required"`
 }
 
-func (p *LikeInfo) GetID() (v int64) {
-	return p.ID
+func NewPrivacySettings() *PrivacySettings {
+	return &PrivacySettings{}
 }
 
-func (p *LikeInfo) GetUserID() (v int64) {
-	return p.UserID
+func (p *PrivacySettings) InitDefault() {
 }
 
-func (p *LikeInfo) GetVideoID() (v int64) {
-	return p.VideoID
+func (p *PrivacySettings) GetDmPolicy() (v int8) {
+	return p.DmPolicy
 }
 
-func (p *LikeInfo) GetCreatedAt() (v int64) {
-	return p.CreatedAt
+func (p *PrivacySettings) GetLikesVisibility() (v int8) {
+	return p.LikesVisibility
 }
 
-var LikeInfo_DeletedAt_DEFAULT int64
-
-func (p *LikeInfo) GetDeletedAt() (v int64) {
-	if !p.IsSetDeletedAt() {
-		return LikeInfo_DeletedAt_DEFAULT
-	}
-	return *p.DeletedAt
+func (p *PrivacySettings) GetCollectionsVisibility() (v int8) {
+	return p.CollectionsVisibility
 }
 
-var fieldIDToName_LikeInfo = map[int16]string{
-	1: "id",
-	2: "user_id",
-	3: "video_id",
-	4: "created_at",
-	5: "deleted_at",
+func (p *PrivacySettings) GetHideOnline() (v bool) {
+	return p.HideOnline
 }
 
-func (p *LikeInfo) IsSetDeletedAt() bool {
-	return p.DeletedAt != nil
+var fieldIDToName_PrivacySettings = map[int16]string{
+	1: "dm_policy",
+	2: "likes_visibility",
+	3: "collections_visibility",
+	4: "hide_online",
 }
 
-func (p *LikeInfo) Read(iprot thrift.TProtocol) (err error) {
+func (p *PrivacySettings) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
-	var issetID bool = false
-	var issetUserID bool = false
-	var issetVideoID bool = false
-	var issetCreatedAt bool = false
+	var issetDmPolicy bool = false
+	var issetLikesVisibility bool = false
+	var issetCollectionsVisibility bool = false
+	var issetHideOnline bool = false
 
 	if _, err = iprot.ReadStructBegin(); err != nil {
 		goto ReadStructBeginError
@@ -2046,46 +1481,38 @@ func (p *LikeInfo) Read(iprot thrift.TProtocol) (err error) {
 
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.I64 {
+			if fieldTypeId == thrift.BYTE {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
-				issetID = true
+				issetDmPolicy = true
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
 		case 2:
-			if fieldTypeId == thrift.I64 {
+			if fieldTypeId == thrift.BYTE {
 				if err = p.ReadField2(iprot); err != nil {
 					goto ReadFieldError
 				}
-				issetUserID = true
+				issetLikesVisibility = true
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
 		case 3:
-			if fieldTypeId == thrift.I64 {
+			if fieldTypeId == thrift.BYTE {
 				if err = p.ReadField3(iprot); err != nil {
 					goto ReadFieldError
 				}
-				issetVideoID = true
+				issetCollectionsVisibility = true
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
 		case 4:
-			if fieldTypeId == thrift.I64 {
+			if fieldTypeId == thrift.BOOL {
 				if err = p.ReadField4(iprot); err != nil {
 					goto ReadFieldError
 				}
-				issetCreatedAt = true
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 5:
-			if fieldTypeId == thrift.I64 {
-				if err = p.ReadField5(iprot); err != nil {
-					goto ReadFieldError
-				}
+				issetHideOnline = true
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
@@ -2102,22 +1529,22 @@ func (p *LikeInfo) Read(iprot thrift.TProtocol) (err error) {
 		goto ReadStructEndError
 	}
 
-	if !issetID {
+	if !issetDmPolicy {
 		fieldId = 1
 		goto RequiredFieldNotSetError
 	}
 
-	if !issetUserID {
+	if !issetLikesVisibility {
 		fieldId = 2
 		goto RequiredFieldNotSetError
 	}
 
-	if !issetVideoID {
+	if !issetCollectionsVisibility {
 		fieldId = 3
 		goto RequiredFieldNotSetError
 	}
 
-	if !issetCreatedAt {
+	if !issetHideOnline {
 		fieldId = 4
 		goto RequiredFieldNotSetError
 	}
@@ -2127,7 +1554,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_LikeInfo[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PrivacySettings[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -2136,68 +1563,57 @@ ReadFieldEndError:
 ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 RequiredFieldNotSetError:
-	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_LikeInfo[fieldId]))
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_PrivacySettings[fieldId]))
 }
 
-func (p *LikeInfo) ReadField1(iprot thrift.TProtocol) error {
+func (p *PrivacySettings) ReadField1(iprot thrift.TProtocol) error {
 
-	var _field int64
-	if v, err := iprot.ReadI64(); err != nil {
+	var _field int8
+	if v, err := iprot.ReadByte(); err != nil {
 		return err
 	} else {
 		_field = v
 	}
-	p.ID = _field
+	p.DmPolicy = _field
 	return nil
 }
-func (p *LikeInfo) ReadField2(iprot thrift.TProtocol) error {
+func (p *PrivacySettings) ReadField2(iprot thrift.TProtocol) error {
 
-	var _field int64
-	if v, err := iprot.ReadI64(); err != nil {
+	var _field int8
+	if v, err := iprot.ReadByte(); err != nil {
 		return err
 	} else {
 		_field = v
 	}
-	p.UserID = _field
+	p.LikesVisibility = _field
 	return nil
 }
-func (p *LikeInfo) ReadField3(iprot thrift.TProtocol) error {
+func (p *PrivacySettings) ReadField3(iprot thrift.TProtocol) error {
 
-	var _field int64
-	if v, err := iprot.ReadI64(); err != nil {
+	var _field int8
+	if v, err := iprot.ReadByte(); err != nil {
 		return err
 	} else {
 		_field = v
 	}
-	p.VideoID = _field
+	p.CollectionsVisibility = _field
 	return nil
 }
-func (p *LikeInfo) ReadField4(iprot thrift.TProtocol) error {
+func (p *PrivacySettings) ReadField4(iprot thrift.TProtocol) error {
 
-	var _field int64
-	if v, err := iprot.ReadI64(); err != nil {
+	var _field bool
+	if v, err := iprot.ReadBool(); err != nil {
 		return err
 	} else {
 		_field = v
 	}
-	p.CreatedAt = _field
-	return nil
-}
-func (p *LikeInfo) ReadField5(iprot thrift.TProtocol) error {
-
-	var _field *int64
-	if v, err := iprot.ReadI64(); err != nil {
-		return err
-	} else {
-		_field = &v
-	}
-	p.DeletedAt = _field
+	p.HideOnline = _field
 	return nil
 }
 
-func (p *LikeInfo) Write(oprot thrift.TProtocol) (err error) {
+func (p *PrivacySettings) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("LikeInfo"); err != nil {
+	if err = oprot.WriteStructBegin("PrivacySettings"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -2217,10 +1633,6 @@ func (p *LikeInfo) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 4
 			goto WriteFieldError
 		}
-		if err = p.writeField5(oprot); err != nil {
-			fieldId = 5
-			goto WriteFieldError
-		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -2239,11 +1651,11 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *LikeInfo) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("id", thrift.I64, 1); err != nil {
+func (p *PrivacySettings) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("dm_policy", thrift.BYTE, 1); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteI64(p.ID); err != nil {
+	if err := oprot.WriteByte(p.DmPolicy); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -2255,11 +1667,11 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
-func (p *LikeInfo) writeField2(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("user_id", thrift.I64, 2); err != nil {
+func (p *PrivacySettings) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("likes_visibility", thrift.BYTE, 2); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteI64(p.UserID); err != nil {
+	if err := oprot.WriteByte(p.LikesVisibility); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -2271,11 +1683,11 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
-func (p *LikeInfo) writeField3(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("video_id", thrift.I64, 3); err != nil {
+func (p *PrivacySettings) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("collections_visibility", thrift.BYTE, 3); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteI64(p.VideoID); err != nil {
+	if err := oprot.WriteByte(p.CollectionsVisibility); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -2287,11 +1699,11 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
-func (p *LikeInfo) writeField4(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("created_at", thrift.I64, 4); err != nil {
+func (p *PrivacySettings) writeField4(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("hide_online", thrift.BOOL, 4); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteI64(p.CreatedAt); err != nil {
+	if err := oprot.WriteBool(p.HideOnline); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -2303,144 +1715,216 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
 }
-func (p *LikeInfo) writeField5(oprot thrift.TProtocol) (err error) {
-	if p.IsSetDeletedAt() {
-		if err = oprot.WriteFieldBegin("deleted_at", thrift.I64, 5); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteI64(*p.DeletedAt); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
-}
 
-func (p *LikeInfo) String() string {
+func (p *PrivacySettings) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("LikeInfo(%+v)", *p)
-
-}
-
-type CommentInfo struct {
-	ID        int64  `thrift:"id,1,required" form:"id,required" json:"id,required" query:"id,required"`
-	UserID    int64  `thrift:"user_id,2,required" form:"user_id,required" json:"user_id,required" query:"user_id,required"`
-	VideoID   int64  `thrift:"video_id,3,required" form:"video_id,required" json:"video_id,required" query:"video_id,required"`
-	Content   string `thrift:"content,4,required" form:"content,required" json:"content,required" query:"content,required"`
-	ParentID  *int64 `thrift:"parent_id,5,optional" form:"parent_id" json:"parent_id,omitempty" query:"parent_id"`
-	CreatedAt int64  `thrift:"created_at,6,required" form:"created_at,required" json:"created_at,required" query:"created_at,required"`
-	DeletedAt *int64 `thrift:"deleted_at,7,optional" form:"deleted_at" json:"deleted_at,omitempty" query:"deleted_at"`
-	LikeCount *int32 `thrift:"like_count,8,optional" form:"like_count" json:"like_count,omitempty" query:"like_count"`
-	IsLiked   *bool  `thrift:"is_liked,9,optional" form:"is_liked" json:"is_liked,omitempty" query:"is_liked"`
-}
-
-func NewCommentInfo() *CommentInfo {
-	return &CommentInfo{}
-}
+	return fmt.Sprintf("PrivacySettings(%+v)", *p)
 
-func (p *CommentInfo) InitDefault() {
 }
 
-func (p *CommentInfo) GetID() (v int64) {
+// 视频模型
+type Video struct {
+	// 视频ID
+	ID int64 `thrift:"id,1,required" form:"id,required" json:"id,required" query:"id,required"`
+	// 作者ID
+	AuthorId int64 `thrift:"authorId,2,required" form:"authorId,required" json:"authorId,required" query:"authorId,required"`
+	// 标题
+	Title string `thrift:"title,3,required" form:"title,required" json:"title,required" query:"title,required"`
+	// 播放地址
+	PlayUrl string `thrift:"playUrl,4,required" form:"playUrl,required" json:"playUrl,required" query:"playUrl,required"`
+	// 封面地址
+	CoverUrl string `thrift:"coverUrl,5,required" form:"coverUrl,required" json:"coverUrl,required" query:"coverUrl,required"`
+	// 点赞数
+	FavoriteCount *int64 `thrift:"favoriteCount,6,optional" form:"favoriteCount" json:"favoriteCount,omitempty" query:"favoriteCount"`
+	// 评论数
+	CommentCount *int64 `thrift:"commentCount,7,optional" form:"commentCount" json:"commentCount,omitempty" query:"commentCount"`
+	// 是否已点赞
+	IsFavorite *bool `thrift:"isFavorite,8,optional" form:"isFavorite" json:"isFavorite,omitempty" query:"isFavorite"`
+	// 作者信息
+	Author *User `thrift:"author,9,optional" form:"author" json:"author,omitempty" query:"author"`
+	// 视频描述
+	Description *string `thrift:"description,10,optional" form:"description" json:"description,omitempty" query:"description"`
+	// 创建时间
+	CreatedAt *int64 `thrift:"createdAt,11,optional" form:"createdAt" json:"createdAt,omitempty" query:"createdAt"`
+	// 更新时间
+	UpdatedAt *int64 `thrift:"updatedAt,12,optional" form:"updatedAt" json:"updatedAt,omitempty" query:"updatedAt"`
+	// 收藏数
+	CollectCount *int64 `thrift:"collectCount,13,optional" form:"collectCount" json:"collectCount,omitempty" query:"collectCount"`
+	// 是否已收藏
+	IsCollected *bool `thrift:"isCollected,14,optional" form:"isCollected" json:"isCollected,omitempty" query:"isCollected"`
+}
+
+func NewVideo() *Video {
+	return &Video{}
+}
+
+func (p *Video) InitDefault() {
+}
+
+func (p *Video) GetID() (v int64) {
 	return p.ID
 }
 
-func (p *CommentInfo) GetUserID() (v int64) {
-	return p.UserID
+func (p *Video) GetAuthorId() (v int64) {
+	return p.AuthorId
 }
 
-func (p *CommentInfo) GetVideoID() (v int64) {
-	return p.VideoID
+func (p *Video) GetTitle() (v string) {
+	return p.Title
 }
 
-func (p *CommentInfo) GetContent() (v string) {
-	return p.Content
+func (p *Video) GetPlayUrl() (v string) {
+	return p.PlayUrl
 }
 
-var CommentInfo_ParentID_DEFAULT int64
+func (p *Video) GetCoverUrl() (v string) {
+	return p.CoverUrl
+}
 
-func (p *CommentInfo) GetParentID() (v int64) {
-	if !p.IsSetParentID() {
-		return CommentInfo_ParentID_DEFAULT
+var Video_FavoriteCount_DEFAULT int64
+
+func (p *Video) GetFavoriteCount() (v int64) {
+	if !p.IsSetFavoriteCount() {
+		return Video_FavoriteCount_DEFAULT
 	}
-	return *p.ParentID
+	return *p.FavoriteCount
 }
 
-func (p *CommentInfo) GetCreatedAt() (v int64) {
-	return p.CreatedAt
+var Video_CommentCount_DEFAULT int64
+
+func (p *Video) GetCommentCount() (v int64) {
+	if !p.IsSetCommentCount() {
+		return Video_CommentCount_DEFAULT
+	}
+	return *p.CommentCount
 }
 
-var CommentInfo_DeletedAt_DEFAULT int64
+var Video_IsFavorite_DEFAULT bool
 
-func (p *CommentInfo) GetDeletedAt() (v int64) {
-	if !p.IsSetDeletedAt() {
-		return CommentInfo_DeletedAt_DEFAULT
+func (p *Video) GetIsFavorite() (v bool) {
+	if !p.IsSetIsFavorite() {
+		return Video_IsFavorite_DEFAULT
 	}
-	return *p.DeletedAt
+	return *p.IsFavorite
 }
 
-var CommentInfo_LikeCount_DEFAULT int32
+var Video_Author_DEFAULT *User
 
-func (p *CommentInfo) GetLikeCount() (v int32) {
-	if !p.IsSetLikeCount() {
-		return CommentInfo_LikeCount_DEFAULT
+func (p *Video) GetAuthor() (v *User) {
+	if !p.IsSetAuthor() {
+		return Video_Author_DEFAULT
 	}
-	return *p.LikeCount
+	return p.Author
 }
 
-var CommentInfo_IsLiked_DEFAULT bool
+var Video_Description_DEFAULT string
 
-func (p *CommentInfo) GetIsLiked() (v bool) {
-	if !p.IsSetIsLiked() {
-		return CommentInfo_IsLiked_DEFAULT
+func (p *Video) GetDescription() (v string) {
+	if !p.IsSetDescription() {
+		return Video_Description_DEFAULT
 	}
-	return *p.IsLiked
+	return *p.Description
 }
 
-var fieldIDToName_CommentInfo = map[int16]string{
-	1: "id",
-	2: "user_id",
-	3: "video_id",
-	4: "content",
-	5: "parent_id",
-	6: "created_at",
-	7: "deleted_at",
-	8: "like_count",
-	9: "is_liked",
+var Video_CreatedAt_DEFAULT int64
+
+func (p *Video) GetCreatedAt() (v int64) {
+	if !p.IsSetCreatedAt() {
+		return Video_CreatedAt_DEFAULT
+	}
+	return *p.CreatedAt
 }
 
-func (p *CommentInfo) IsSetParentID() bool {
-	return p.ParentID != nil
+var Video_UpdatedAt_DEFAULT int64
+
+func (p *Video) GetUpdatedAt() (v int64) {
+	if !p.IsSetUpdatedAt() {
+		return Video_UpdatedAt_DEFAULT
+	}
+	return *p.UpdatedAt
 }
 
-func (p *CommentInfo) IsSetDeletedAt() bool {
-	return p.DeletedAt != nil
+var Video_CollectCount_DEFAULT int64
+
+func (p *Video) GetCollectCount() (v int64) {
+	if !p.IsSetCollectCount() {
+		return Video_CollectCount_DEFAULT
+	}
+	return *p.CollectCount
 }
 
-func (p *CommentInfo) IsSetLikeCount() bool {
-	return p.LikeCount != nil
+var Video_IsCollected_DEFAULT bool
+
+func (p *Video) GetIsCollected() (v bool) {
+	if !p.IsSetIsCollected() {
+		return Video_IsCollected_DEFAULT
+	}
+	return *p.IsCollected
 }
 
-func (p *CommentInfo) IsSetIsLiked() bool {
-	return p.IsLiked != nil
+var fieldIDToName_Video = map[int16]string{
+	1:  "id",
+	2:  "authorId",
+	3:  "title",
+	4:  "playUrl",
+	5:  "coverUrl",
+	6:  "favoriteCount",
+	7:  "commentCount",
+	8:  "isFavorite",
+	9:  "author",
+	10: "description",
+	11: "createdAt",
+	12: "updatedAt",
+	13: "collectCount",
+	14: "isCollected",
 }
 
-func (p *CommentInfo) Read(iprot thrift.TProtocol) (err error) {
+func (p *Video) IsSetFavoriteCount() bool {
+	return p.FavoriteCount != nil
+}
+
+func (p *Video) IsSetCommentCount() bool {
+	return p.CommentCount != nil
+}
+
+func (p *Video) IsSetIsFavorite() bool {
+	return p.IsFavorite != nil
+}
+
+func (p *Video) IsSetAuthor() bool {
+	return p.Author != nil
+}
+
+func (p *Video) IsSetDescription() bool {
+	return p.Description != nil
+}
+
+func (p *Video) IsSetCreatedAt() bool {
+	return p.CreatedAt != nil
+}
+
+func (p *Video) IsSetUpdatedAt() bool {
+	return p.UpdatedAt != nil
+}
+
+func (p *Video) IsSetCollectCount() bool {
+	return p.CollectCount != nil
+}
+
+func (p *Video) IsSetIsCollected() bool {
+	return p.IsCollected != nil
+}
+
+func (p *Video) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 	var issetID bool = false
-	var issetUserID bool = false
-	var issetVideoID bool = false
-	var issetContent bool = false
-	var issetCreatedAt bool = false
+	var issetAuthorId bool = false
+	var issetTitle bool = false
+	var issetPlayUrl bool = false
+	var issetCoverUrl bool = false
 
 	if _, err = iprot.ReadStructBegin(); err != nil {
 		goto ReadStructBeginError
@@ -2470,16 +1954,16 @@ func (p *CommentInfo) Read(iprot thrift.TProtocol) (err error) {
 				if err = p.ReadField2(iprot); err != nil {
 					goto ReadFieldError
 				}
-				issetUserID = true
+				issetAuthorId = true
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
 		case 3:
-			if fieldTypeId == thrift.I64 {
+			if fieldTypeId == thrift.STRING {
 				if err = p.ReadField3(iprot); err != nil {
 					goto ReadFieldError
 				}
-				issetVideoID = true
+				issetTitle = true
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
@@ -2488,15 +1972,16 @@ func (p *CommentInfo) Read(iprot thrift.TProtocol) (err error) {
 				if err = p.ReadField4(iprot); err != nil {
 					goto ReadFieldError
 				}
-				issetContent = true
+				issetPlayUrl = true
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
 		case 5:
-			if fieldTypeId == thrift.I64 {
+			if fieldTypeId == thrift.STRING {
 				if err = p.ReadField5(iprot); err != nil {
 					goto ReadFieldError
 				}
+				issetCoverUrl = true
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
@@ -2505,7 +1990,6 @@ func (p *CommentInfo) Read(iprot thrift.TProtocol) (err error) {
 				if err = p.ReadField6(iprot); err != nil {
 					goto ReadFieldError
 				}
-				issetCreatedAt = true
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
@@ -2518,7 +2002,7 @@ func (p *CommentInfo) Read(iprot thrift.TProtocol) (err error) {
 				goto SkipFieldError
 			}
 		case 8:
-			if fieldTypeId == thrift.I32 {
+			if fieldTypeId == thrift.BOOL {
 				if err = p.ReadField8(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -2526,13 +2010,53 @@ func (p *CommentInfo) Read(iprot thrift.TProtocol) (err error) {
 				goto SkipFieldError
 			}
 		case 9:
-			if fieldTypeId == thrift.BOOL {
+			if fieldTypeId == thrift.STRUCT {
 				if err = p.ReadField9(iprot); err != nil {
 					goto ReadFieldError
 				}
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
+		case 10:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField10(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 11:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField11(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 12:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField12(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 13:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField13(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 14:
+			if fieldTypeId == thrift.BOOL {
+				if err = p.ReadField14(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -2551,23 +2075,23 @@ func (p *CommentInfo) Read(iprot thrift.TProtocol) (err error) {
 		goto RequiredFieldNotSetError
 	}
 
-	if !issetUserID {
+	if !issetAuthorId {
 		fieldId = 2
 		goto RequiredFieldNotSetError
 	}
 
-	if !issetVideoID {
+	if !issetTitle {
 		fieldId = 3
 		goto RequiredFieldNotSetError
 	}
 
-	if !issetContent {
+	if !issetPlayUrl {
 		fieldId = 4
 		goto RequiredFieldNotSetError
 	}
 
-	if !issetCreatedAt {
-		fieldId = 6
+	if !issetCoverUrl {
+		fieldId = 5
 		goto RequiredFieldNotSetError
 	}
 	return nil
@@ -2576,7 +2100,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_CommentInfo[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_Video[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -2585,10 +2109,10 @@ ReadFieldEndError:
 ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 RequiredFieldNotSetError:
-	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_CommentInfo[fieldId]))
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_Video[fieldId]))
 }
 
-func (p *CommentInfo) ReadField1(iprot thrift.TProtocol) error {
+func (p *Video) ReadField1(iprot thrift.TProtocol) error {
 
 	var _field int64
 	if v, err := iprot.ReadI64(); err != nil {
@@ -2599,7 +2123,7 @@ func (p *CommentInfo) ReadField1(iprot thrift.TProtocol) error {
 	p.ID = _field
 	return nil
 }
-func (p *CommentInfo) ReadField2(iprot thrift.TProtocol) error {
+func (p *Video) ReadField2(iprot thrift.TProtocol) error {
 
 	var _field int64
 	if v, err := iprot.ReadI64(); err != nil {
@@ -2607,21 +2131,21 @@ func (p *CommentInfo) ReadField2(iprot thrift.TProtocol) error {
 	} else {
 		_field = v
 	}
-	p.UserID = _field
+	p.AuthorId = _field
 	return nil
 }
-func (p *CommentInfo) ReadField3(iprot thrift.TProtocol) error {
+func (p *Video) ReadField3(iprot thrift.TProtocol) error {
 
-	var _field int64
-	if v, err := iprot.ReadI64(); err != nil {
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
 		return err
 	} else {
 		_field = v
 	}
-	p.VideoID = _field
+	p.Title = _field
 	return nil
 }
-func (p *CommentInfo) ReadField4(iprot thrift.TProtocol) error {
+func (p *Video) ReadField4(iprot thrift.TProtocol) error {
 
 	var _field string
 	if v, err := iprot.ReadString(); err != nil {
@@ -2629,10 +2153,21 @@ func (p *CommentInfo) ReadField4(iprot thrift.TProtocol) error {
 	} else {
 		_field = v
 	}
-	p.Content = _field
+	p.PlayUrl = _field
 	return nil
 }
-func (p *CommentInfo) ReadField5(iprot thrift.TProtocol) error {
+func (p *Video) ReadField5(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.CoverUrl = _field
+	return nil
+}
+func (p *Video) ReadField6(iprot thrift.TProtocol) error {
 
 	var _field *int64
 	if v, err := iprot.ReadI64(); err != nil {
@@ -2640,21 +2175,62 @@ func (p *CommentInfo) ReadField5(iprot thrift.TProtocol) error {
 	} else {
 		_field = &v
 	}
-	p.ParentID = _field
+	p.FavoriteCount = _field
 	return nil
 }
-func (p *CommentInfo) ReadField6(iprot thrift.TProtocol) error {
+func (p *Video) ReadField7(iprot thrift.TProtocol) error {
 
-	var _field int64
+	var _field *int64
 	if v, err := iprot.ReadI64(); err != nil {
 		return err
 	} else {
-		_field = v
+		_field = &v
+	}
+	p.CommentCount = _field
+	return nil
+}
+func (p *Video) ReadField8(iprot thrift.TProtocol) error {
+
+	var _field *bool
+	if v, err := iprot.ReadBool(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.IsFavorite = _field
+	return nil
+}
+func (p *Video) ReadField9(iprot thrift.TProtocol) error {
+	_field := NewUser()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Author = _field
+	return nil
+}
+func (p *Video) ReadField10(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Description = _field
+	return nil
+}
+func (p *Video) ReadField11(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
 	}
 	p.CreatedAt = _field
 	return nil
 }
-func (p *CommentInfo) ReadField7(iprot thrift.TProtocol) error {
+func (p *Video) ReadField12(iprot thrift.TProtocol) error {
 
 	var _field *int64
 	if v, err := iprot.ReadI64(); err != nil {
@@ -2662,21 +2238,21 @@ func (p *CommentInfo) ReadField7(iprot thrift.TProtocol) error {
 	} else {
 		_field = &v
 	}
-	p.DeletedAt = _field
+	p.UpdatedAt = _field
 	return nil
 }
-func (p *CommentInfo) ReadField8(iprot thrift.TProtocol) error {
+func (p *Video) ReadField13(iprot thrift.TProtocol) error {
 
-	var _field *int32
-	if v, err := iprot.ReadI32(); err != nil {
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
 		return err
 	} else {
 		_field = &v
 	}
-	p.LikeCount = _field
+	p.CollectCount = _field
 	return nil
 }
-func (p *CommentInfo) ReadField9(iprot thrift.TProtocol) error {
+func (p *Video) ReadField14(iprot thrift.TProtocol) error {
 
 	var _field *bool
 	if v, err := iprot.ReadBool(); err != nil {
@@ -2684,13 +2260,13 @@ func (p *CommentInfo) ReadField9(iprot thrift.TProtocol) error {
 	} else {
 		_field = &v
 	}
-	p.IsLiked = _field
+	p.IsCollected = _field
 	return nil
 }
 
-func (p *CommentInfo) Write(oprot thrift.TProtocol) (err error) {
+func (p *Video) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("CommentInfo"); err != nil {
+	if err = oprot.WriteStructBegin("Video"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -2730,6 +2306,26 @@ func (p *CommentInfo) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 9
 			goto WriteFieldError
 		}
+		if err = p.writeField10(oprot); err != nil {
+			fieldId = 10
+			goto WriteFieldError
+		}
+		if err = p.writeField11(oprot); err != nil {
+			fieldId = 11
+			goto WriteFieldError
+		}
+		if err = p.writeField12(oprot); err != nil {
+			fieldId = 12
+			goto WriteFieldError
+		}
+		if err = p.writeField13(oprot); err != nil {
+			fieldId = 13
+			goto WriteFieldError
+		}
+		if err = p.writeField14(oprot); err != nil {
+			fieldId = 14
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -2748,7 +2344,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *CommentInfo) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *Video) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("id", thrift.I64, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -2764,11 +2360,11 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
-func (p *CommentInfo) writeField2(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("user_id", thrift.I64, 2); err != nil {
+func (p *Video) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("authorId", thrift.I64, 2); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteI64(p.UserID); err != nil {
+	if err := oprot.WriteI64(p.AuthorId); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -2780,11 +2376,11 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
-func (p *CommentInfo) writeField3(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("video_id", thrift.I64, 3); err != nil {
+func (p *Video) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("title", thrift.STRING, 3); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteI64(p.VideoID); err != nil {
+	if err := oprot.WriteString(p.Title); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -2796,11 +2392,11 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
-func (p *CommentInfo) writeField4(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("content", thrift.STRING, 4); err != nil {
+func (p *Video) writeField4(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("playUrl", thrift.STRING, 4); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteString(p.Content); err != nil {
+	if err := oprot.WriteString(p.PlayUrl); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -2812,17 +2408,15 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
 }
-func (p *CommentInfo) writeField5(oprot thrift.TProtocol) (err error) {
-	if p.IsSetParentID() {
-		if err = oprot.WriteFieldBegin("parent_id", thrift.I64, 5); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteI64(*p.ParentID); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
+func (p *Video) writeField5(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("coverUrl", thrift.STRING, 5); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.CoverUrl); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
 	}
 	return nil
 WriteFieldBeginError:
@@ -2830,15 +2424,17 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
 }
-func (p *CommentInfo) writeField6(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("created_at", thrift.I64, 6); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteI64(p.CreatedAt); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
+func (p *Video) writeField6(oprot thrift.TProtocol) (err error) {
+	if p.IsSetFavoriteCount() {
+		if err = oprot.WriteFieldBegin("favoriteCount", thrift.I64, 6); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.FavoriteCount); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
 	}
 	return nil
 WriteFieldBeginError:
@@ -2846,12 +2442,12 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
 }
-func (p *CommentInfo) writeField7(oprot thrift.TProtocol) (err error) {
-	if p.IsSetDeletedAt() {
-		if err = oprot.WriteFieldBegin("deleted_at", thrift.I64, 7); err != nil {
+func (p *Video) writeField7(oprot thrift.TProtocol) (err error) {
+	if p.IsSetCommentCount() {
+		if err = oprot.WriteFieldBegin("commentCount", thrift.I64, 7); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteI64(*p.DeletedAt); err != nil {
+		if err := oprot.WriteI64(*p.CommentCount); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -2864,12 +2460,12 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 7 end error: ", p), err)
 }
-func (p *CommentInfo) writeField8(oprot thrift.TProtocol) (err error) {
-	if p.IsSetLikeCount() {
-		if err = oprot.WriteFieldBegin("like_count", thrift.I32, 8); err != nil {
+func (p *Video) writeField8(oprot thrift.TProtocol) (err error) {
+	if p.IsSetIsFavorite() {
+		if err = oprot.WriteFieldBegin("isFavorite", thrift.BOOL, 8); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteI32(*p.LikeCount); err != nil {
+		if err := oprot.WriteBool(*p.IsFavorite); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -2882,12 +2478,12 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 8 end error: ", p), err)
 }
-func (p *CommentInfo) writeField9(oprot thrift.TProtocol) (err error) {
-	if p.IsSetIsLiked() {
-		if err = oprot.WriteFieldBegin("is_liked", thrift.BOOL, 9); err != nil {
+func (p *Video) writeField9(oprot thrift.TProtocol) (err error) {
+	if p.IsSetAuthor() {
+		if err = oprot.WriteFieldBegin("author", thrift.STRUCT, 9); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteBool(*p.IsLiked); err != nil {
+		if err := p.Author.Write(oprot); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -2900,104 +2496,234 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 9 end error: ", p), err)
 }
-
-func (p *CommentInfo) String() string {
-	if p == nil {
-		return "<nil>"
+func (p *Video) writeField10(oprot thrift.TProtocol) (err error) {
+	if p.IsSetDescription() {
+		if err = oprot.WriteFieldBegin("description", thrift.STRING, 10); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Description); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
 	}
-	return fmt.Sprintf("CommentInfo(%+v)", *p)
-
-}
-
-// 私信消息
-type PrivateMessage struct {
-	// 消息ID
-	ID int64 `thrift:"id,1,required" form:"id,required" json:"id,required" query:"id,required"`
-	// 发送者ID
-	SenderID int64 `thrift:"sender_id,2,required" form:"sender_id,required" json:"sender_id,required" query:"sender_id,required"`
-	// 接收者ID
-	ReceiverID int64 `thrift:"receiver_id,3,required" form:"receiver_id,required" json:"receiver_id,required" query:"receiver_id,required"`
-	// 消息内容
-	Content string `thrift:"content,4,required" form:"content,required" json:"content,required" query:"content,required"`
-	// 是否已读
-	IsRead bool `thrift:"is_read,5,required" form:"is_read,required" json:"is_read,required" query:"is_read,required"`
-	// 创建时间
-	CreatedAt int64 `thrift:"created_at,6,required" form:"created_at,required" json:"created_at,required" query:"created_at,required"`
-	// 更新时间
-	UpdatedAt int64 `thrift:"updated_at,7,required" form:"updated_at,required" json:"updated_at,required" query:"updated_at,required"`
-	// 删除时间
-	DeletedAt *int64 `thrift:"deleted_at,8,optional" form:"deleted_at" json:"deleted_at,omitempty" query:"deleted_at"`
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 10 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 10 end error: ", p), err)
 }
-
-func NewPrivateMessage() *PrivateMessage {
-	return &PrivateMessage{}
+func (p *Video) writeField11(oprot thrift.TProtocol) (err error) {
+	if p.IsSetCreatedAt() {
+		if err = oprot.WriteFieldBegin("createdAt", thrift.I64, 11); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.CreatedAt); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 11 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 11 end error: ", p), err)
+}
+func (p *Video) writeField12(oprot thrift.TProtocol) (err error) {
+	if p.IsSetUpdatedAt() {
+		if err = oprot.WriteFieldBegin("updatedAt", thrift.I64, 12); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.UpdatedAt); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 12 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 12 end error: ", p), err)
+}
+func (p *Video) writeField13(oprot thrift.TProtocol) (err error) {
+	if p.IsSetCollectCount() {
+		if err = oprot.WriteFieldBegin("collectCount", thrift.I64, 13); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.CollectCount); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 13 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 13 end error: ", p), err)
+}
+func (p *Video) writeField14(oprot thrift.TProtocol) (err error) {
+	if p.IsSetIsCollected() {
+		if err = oprot.WriteFieldBegin("isCollected", thrift.BOOL, 14); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteBool(*p.IsCollected); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 14 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 14 end error: ", p), err)
 }
 
-func (p *PrivateMessage) InitDefault() {
+func (p *Video) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("Video(%+v)", *p)
+
 }
 
-func (p *PrivateMessage) GetID() (v int64) {
+// 评论模型
+type Comment struct {
+	// 评论ID
+	ID int64 `thrift:"id,1,required" form:"id,required" json:"id,required" query:"id,required"`
+	// 用户ID
+	UserId int64 `thrift:"userId,2,required" form:"userId,required" json:"userId,required" query:"userId,required"`
+	// 视频ID
+	VideoId int64 `thrift:"videoId,3,required" form:"videoId,required" json:"videoId,required" query:"videoId,required"`
+	// 评论内容
+	Content string `thrift:"content,4,required" form:"content,required" json:"content,required" query:"content,required"`
+	// 用户信息
+	User *User `thrift:"user,5,optional" form:"user" json:"user,omitempty" query:"user"`
+	// 创建时间
+	CreatedAt *int64 `thrift:"createdAt,6,optional" form:"createdAt" json:"createdAt,omitempty" query:"createdAt"`
+	// 更新时间
+	UpdatedAt *int64 `thrift:"updatedAt,7,optional" form:"updatedAt" json:"updatedAt,omitempty" query:"updatedAt"`
+	// 审核状态：0=可见,1=待审核,2=已拒绝
+	Status *int8 `thrift:"status,8,optional" form:"status" json:"status,omitempty" query:"status"`
+	// 审核原因
+	ModerationReason *string `thrift:"moderationReason,9,optional" form:"moderationReason" json:"moderationReason,omitempty" query:"moderationReason"`
+}
+
+func NewComment() *Comment {
+	return &Comment{}
+}
+
+func (p *Comment) InitDefault() {
+}
+
+func (p *Comment) GetID() (v int64) {
 	return p.ID
 }
 
-func (p *PrivateMessage) GetSenderID() (v int64) {
-	return p.SenderID
+func (p *Comment) GetUserId() (v int64) {
+	return p.UserId
 }
 
-func (p *PrivateMessage) GetReceiverID() (v int64) {
-	return p.ReceiverID
+func (p *Comment) GetVideoId() (v int64) {
+	return p.VideoId
 }
 
-func (p *PrivateMessage) GetContent() (v string) {
+func (p *Comment) GetContent() (v string) {
 	return p.Content
 }
 
-func (p *PrivateMessage) GetIsRead() (v bool) {
-	return p.IsRead
+var Comment_User_DEFAULT *User
+
+func (p *Comment) GetUser() (v *User) {
+	if !p.IsSetUser() {
+		return Comment_User_DEFAULT
+	}
+	return p.User
 }
 
-func (p *PrivateMessage) GetCreatedAt() (v int64) {
-	return p.CreatedAt
+var Comment_CreatedAt_DEFAULT int64
+
+func (p *Comment) GetCreatedAt() (v int64) {
+	if !p.IsSetCreatedAt() {
+		return Comment_CreatedAt_DEFAULT
+	}
+	return *p.CreatedAt
 }
 
-func (p *PrivateMessage) GetUpdatedAt() (v int64) {
-	return p.UpdatedAt
+var Comment_UpdatedAt_DEFAULT int64
+
+func (p *Comment) GetUpdatedAt() (v int64) {
+	if !p.IsSetUpdatedAt() {
+		return Comment_UpdatedAt_DEFAULT
+	}
+	return *p.UpdatedAt
 }
 
-var PrivateMessage_DeletedAt_DEFAULT int64
+var Comment_Status_DEFAULT int8
 
-func (p *PrivateMessage) GetDeletedAt() (v int64) {
-	if !p.IsSetDeletedAt() {
-		return PrivateMessage_DeletedAt_DEFAULT
+func (p *Comment) GetStatus() (v int8) {
+	if !p.IsSetStatus() {
+		return Comment_Status_DEFAULT
 	}
-	return *p.DeletedAt
+	return *p.Status
 }
 
-var fieldIDToName_PrivateMessage = map[int16]string{
+var Comment_ModerationReason_DEFAULT string
+
+func (p *Comment) GetModerationReason() (v string) {
+	if !p.IsSetModerationReason() {
+		return Comment_ModerationReason_DEFAULT
+	}
+	return *p.ModerationReason
+}
+
+var fieldIDToName_Comment = map[int16]string{
 	1: "id",
-	2: "sender_id",
-	3: "receiver_id",
+	2: "userId",
+	3: "videoId",
 	4: "content",
-	5: "is_read",
-	6: "created_at",
-	7: "updated_at",
-	8: "deleted_at",
+	5: "user",
+	6: "createdAt",
+	7: "updatedAt",
+	8: "status",
+	9: "moderationReason",
 }
 
-func (p *PrivateMessage) IsSetDeletedAt() bool {
-	return p.DeletedAt != nil
+func (p *Comment) IsSetUser() bool {
+	return p.User != nil
 }
 
-func (p *PrivateMessage) Read(iprot thrift.TProtocol) (err error) {
+func (p *Comment) IsSetCreatedAt() bool {
+	return p.CreatedAt != nil
+}
+
+func (p *Comment) IsSetUpdatedAt() bool {
+	return p.UpdatedAt != nil
+}
+
+func (p *Comment) IsSetStatus() bool {
+	return p.Status != nil
+}
+
+func (p *Comment) IsSetModerationReason() bool {
+	return p.ModerationReason != nil
+}
+
+func (p *Comment) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 	var issetID bool = false
-	var issetSenderID bool = false
-	var issetReceiverID bool = false
+	var issetUserId bool = false
+	var issetVideoId bool = false
 	var issetContent bool = false
-	var issetIsRead bool = false
-	var issetCreatedAt bool = false
-	var issetUpdatedAt bool = false
 
 	if _, err = iprot.ReadStructBegin(); err != nil {
 		goto ReadStructBeginError
@@ -3027,7 +2753,7 @@ func (p *PrivateMessage) Read(iprot thrift.TProtocol) (err error) {
 				if err = p.ReadField2(iprot); err != nil {
 					goto ReadFieldError
 				}
-				issetSenderID = true
+				issetUserId = true
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
@@ -3036,7 +2762,7 @@ func (p *PrivateMessage) Read(iprot thrift.TProtocol) (err error) {
 				if err = p.ReadField3(iprot); err != nil {
 					goto ReadFieldError
 				}
-				issetReceiverID = true
+				issetVideoId = true
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
@@ -3050,11 +2776,10 @@ func (p *PrivateMessage) Read(iprot thrift.TProtocol) (err error) {
 				goto SkipFieldError
 			}
 		case 5:
-			if fieldTypeId == thrift.BOOL {
+			if fieldTypeId == thrift.STRUCT {
 				if err = p.ReadField5(iprot); err != nil {
 					goto ReadFieldError
 				}
-				issetIsRead = true
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
@@ -3063,7 +2788,6 @@ func (p *PrivateMessage) Read(iprot thrift.TProtocol) (err error) {
 				if err = p.ReadField6(iprot); err != nil {
 					goto ReadFieldError
 				}
-				issetCreatedAt = true
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
@@ -3072,18 +2796,25 @@ func (p *PrivateMessage) Read(iprot thrift.TProtocol) (err error) {
 				if err = p.ReadField7(iprot); err != nil {
 					goto ReadFieldError
 				}
-				issetUpdatedAt = true
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
 		case 8:
-			if fieldTypeId == thrift.I64 {
+			if fieldTypeId == thrift.BYTE {
 				if err = p.ReadField8(iprot); err != nil {
 					goto ReadFieldError
 				}
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
+		case 9:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField9(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -3102,12 +2833,12 @@ func (p *PrivateMessage) Read(iprot thrift.TProtocol) (err error) {
 		goto RequiredFieldNotSetError
 	}
 
-	if !issetSenderID {
+	if !issetUserId {
 		fieldId = 2
 		goto RequiredFieldNotSetError
 	}
 
-	if !issetReceiverID {
+	if !issetVideoId {
 		fieldId = 3
 		goto RequiredFieldNotSetError
 	}
@@ -3116,28 +2847,13 @@ func (p *PrivateMessage) Read(iprot thrift.TProtocol) (err error) {
 		fieldId = 4
 		goto RequiredFieldNotSetError
 	}
-
-	if !issetIsRead {
-		fieldId = 5
-		goto RequiredFieldNotSetError
-	}
-
-	if !issetCreatedAt {
-		fieldId = 6
-		goto RequiredFieldNotSetError
-	}
-
-	if !issetUpdatedAt {
-		fieldId = 7
-		goto RequiredFieldNotSetError
-	}
 	return nil
 ReadStructBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PrivateMessage[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_Comment[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -3146,10 +2862,10 @@ ReadFieldEndError:
 ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 RequiredFieldNotSetError:
-	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_PrivateMessage[fieldId]))
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_Comment[fieldId]))
 }
 
-func (p *PrivateMessage) ReadField1(iprot thrift.TProtocol) error {
+func (p *Comment) ReadField1(iprot thrift.TProtocol) error {
 
 	var _field int64
 	if v, err := iprot.ReadI64(); err != nil {
@@ -3160,7 +2876,7 @@ func (p *PrivateMessage) ReadField1(iprot thrift.TProtocol) error {
 	p.ID = _field
 	return nil
 }
-func (p *PrivateMessage) ReadField2(iprot thrift.TProtocol) error {
+func (p *Comment) ReadField2(iprot thrift.TProtocol) error {
 
 	var _field int64
 	if v, err := iprot.ReadI64(); err != nil {
@@ -3168,10 +2884,10 @@ func (p *PrivateMessage) ReadField2(iprot thrift.TProtocol) error {
 	} else {
 		_field = v
 	}
-	p.SenderID = _field
+	p.UserId = _field
 	return nil
 }
-func (p *PrivateMessage) ReadField3(iprot thrift.TProtocol) error {
+func (p *Comment) ReadField3(iprot thrift.TProtocol) error {
 
 	var _field int64
 	if v, err := iprot.ReadI64(); err != nil {
@@ -3179,10 +2895,10 @@ func (p *PrivateMessage) ReadField3(iprot thrift.TProtocol) error {
 	} else {
 		_field = v
 	}
-	p.ReceiverID = _field
+	p.VideoId = _field
 	return nil
 }
-func (p *PrivateMessage) ReadField4(iprot thrift.TProtocol) error {
+func (p *Comment) ReadField4(iprot thrift.TProtocol) error {
 
 	var _field string
 	if v, err := iprot.ReadString(); err != nil {
@@ -3193,54 +2909,62 @@ func (p *PrivateMessage) ReadField4(iprot thrift.TProtocol) error {
 	p.Content = _field
 	return nil
 }
-func (p *PrivateMessage) ReadField5(iprot thrift.TProtocol) error {
-
-	var _field bool
-	if v, err := iprot.ReadBool(); err != nil {
+func (p *Comment) ReadField5(iprot thrift.TProtocol) error {
+	_field := NewUser()
+	if err := _field.Read(iprot); err != nil {
 		return err
-	} else {
-		_field = v
 	}
-	p.IsRead = _field
+	p.User = _field
 	return nil
 }
-func (p *PrivateMessage) ReadField6(iprot thrift.TProtocol) error {
+func (p *Comment) ReadField6(iprot thrift.TProtocol) error {
 
-	var _field int64
+	var _field *int64
 	if v, err := iprot.ReadI64(); err != nil {
 		return err
 	} else {
-		_field = v
+		_field = &v
 	}
 	p.CreatedAt = _field
 	return nil
 }
-func (p *PrivateMessage) ReadField7(iprot thrift.TProtocol) error {
+func (p *Comment) ReadField7(iprot thrift.TProtocol) error {
 
-	var _field int64
+	var _field *int64
 	if v, err := iprot.ReadI64(); err != nil {
 		return err
 	} else {
-		_field = v
+		_field = &v
 	}
 	p.UpdatedAt = _field
 	return nil
 }
-func (p *PrivateMessage) ReadField8(iprot thrift.TProtocol) error {
+func (p *Comment) ReadField8(iprot thrift.TProtocol) error {
 
-	var _field *int64
-	if v, err := iprot.ReadI64(); err != nil {
+	var _field *int8
+	if v, err := iprot.ReadByte(); err != nil {
 		return err
 	} else {
 		_field = &v
 	}
-	p.DeletedAt = _field
+	p.Status = _field
 	return nil
 }
+func (p *Comment) ReadField9(iprot thrift.TProtocol) error {
 
-func (p *PrivateMessage) Write(oprot thrift.TProtocol) (err error) {
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.ModerationReason = _field
+	return nil
+}
+
+func (p *Comment) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("PrivateMessage"); err != nil {
+	if err = oprot.WriteStructBegin("Comment"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -3276,7 +3000,11 @@ func (p *PrivateMessage) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 8
 			goto WriteFieldError
 		}
-	}
+		if err = p.writeField9(oprot); err != nil {
+			fieldId = 9
+			goto WriteFieldError
+		}
+	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
 	}
@@ -3294,7 +3022,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *PrivateMessage) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *Comment) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("id", thrift.I64, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -3310,11 +3038,11 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
-func (p *PrivateMessage) writeField2(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("sender_id", thrift.I64, 2); err != nil {
+func (p *Comment) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("userId", thrift.I64, 2); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteI64(p.SenderID); err != nil {
+	if err := oprot.WriteI64(p.UserId); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -3326,11 +3054,11 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
-func (p *PrivateMessage) writeField3(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("receiver_id", thrift.I64, 3); err != nil {
+func (p *Comment) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("videoId", thrift.I64, 3); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteI64(p.ReceiverID); err != nil {
+	if err := oprot.WriteI64(p.VideoId); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -3342,7 +3070,7 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
-func (p *PrivateMessage) writeField4(oprot thrift.TProtocol) (err error) {
+func (p *Comment) writeField4(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("content", thrift.STRING, 4); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -3358,15 +3086,17 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
 }
-func (p *PrivateMessage) writeField5(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("is_read", thrift.BOOL, 5); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteBool(p.IsRead); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
+func (p *Comment) writeField5(oprot thrift.TProtocol) (err error) {
+	if p.IsSetUser() {
+		if err = oprot.WriteFieldBegin("user", thrift.STRUCT, 5); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.User.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
 	}
 	return nil
 WriteFieldBeginError:
@@ -3374,15 +3104,17 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
 }
-func (p *PrivateMessage) writeField6(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("created_at", thrift.I64, 6); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteI64(p.CreatedAt); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
+func (p *Comment) writeField6(oprot thrift.TProtocol) (err error) {
+	if p.IsSetCreatedAt() {
+		if err = oprot.WriteFieldBegin("createdAt", thrift.I64, 6); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.CreatedAt); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
 	}
 	return nil
 WriteFieldBeginError:
@@ -3390,15 +3122,17 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
 }
-func (p *PrivateMessage) writeField7(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("updated_at", thrift.I64, 7); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteI64(p.UpdatedAt); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
+func (p *Comment) writeField7(oprot thrift.TProtocol) (err error) {
+	if p.IsSetUpdatedAt() {
+		if err = oprot.WriteFieldBegin("updatedAt", thrift.I64, 7); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.UpdatedAt); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
 	}
 	return nil
 WriteFieldBeginError:
@@ -3406,12 +3140,12 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 7 end error: ", p), err)
 }
-func (p *PrivateMessage) writeField8(oprot thrift.TProtocol) (err error) {
-	if p.IsSetDeletedAt() {
-		if err = oprot.WriteFieldBegin("deleted_at", thrift.I64, 8); err != nil {
+func (p *Comment) writeField8(oprot thrift.TProtocol) (err error) {
+	if p.IsSetStatus() {
+		if err = oprot.WriteFieldBegin("status", thrift.BYTE, 8); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteI64(*p.DeletedAt); err != nil {
+		if err := oprot.WriteByte(*p.Status); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -3424,80 +3158,92 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 8 end error: ", p), err)
 }
+func (p *Comment) writeField9(oprot thrift.TProtocol) (err error) {
+	if p.IsSetModerationReason() {
+		if err = oprot.WriteFieldBegin("moderationReason", thrift.STRING, 9); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.ModerationReason); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 9 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 9 end error: ", p), err)
+}
 
-func (p *PrivateMessage) String() string {
+func (p *Comment) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("PrivateMessage(%+v)", *p)
+	return fmt.Sprintf("Comment(%+v)", *p)
 
 }
 
-// 聊天室
-type ChatRoom struct {
-	// 聊天室ID
-	ID int64 `thrift:"id,1,required" form:"id,required" json:"id,required" query:"id,required"`
-	// 聊天室名称
-	Name string `thrift:"name,2,required" form:"name,required" json:"name,required" query:"name,required"`
-	// 创建者ID
-	CreatorID int64 `thrift:"creator_id,3,required" form:"creator_id,required" json:"creator_id,required" query:"creator_id,required"`
-	// 类型：1=私聊,2=群聊
-	Type int8 `thrift:"type,4,required" form:"type,required" json:"type,required" query:"type,required"`
-	// 成员列表
-	Members []*ChatRoomMember `thrift:"members,8,optional" form:"members" json:"members,omitempty" query:"members"`
+type LikeInfo struct {
+	ID        int64  `thrift:"id,1,required" form:"id,required" json:"id,required" query:"id,required"`
+	UserID    int64  `thrift:"user_id,2,required" form:"user_id,required" json:"user_id,required" query:"user_id,required"`
+	VideoID   int64  `thrift:"video_id,3,required" form:"video_id,required" json:"video_id,required" query:"video_id,required"`
+	CreatedAt int64  `thrift:"created_at,4,required" form:"created_at,required" json:"created_at,required" query:"created_at,required"`
+	DeletedAt *int64 `thrift:"deleted_at,5,optional" form:"deleted_at" json:"deleted_at,omitempty" query:"deleted_at"`
 }
 
-func NewChatRoom() *ChatRoom {
-	return &ChatRoom{}
+func NewLikeInfo() *LikeInfo {
+	return &LikeInfo{}
 }
 
-func (p *ChatRoom) InitDefault() {
+func (p *LikeInfo) InitDefault() {
 }
 
-func (p *ChatRoom) GetID() (v int64) {
+func (p *LikeInfo) GetID() (v int64) {
 	return p.ID
 }
 
-func (p *ChatRoom) GetName() (v string) {
-	return p.Name
+func (p *LikeInfo) GetUserID() (v int64) {
+	return p.UserID
 }
 
-func (p *ChatRoom) GetCreatorID() (v int64) {
-	return p.CreatorID
+func (p *LikeInfo) GetVideoID() (v int64) {
+	return p.VideoID
 }
 
-func (p *ChatRoom) GetType() (v int8) {
-	return p.Type
+func (p *LikeInfo) GetCreatedAt() (v int64) {
+	return p.CreatedAt
 }
 
-var ChatRoom_Members_DEFAULT []*ChatRoomMember
+var LikeInfo_DeletedAt_DEFAULT int64
 
-func (p *ChatRoom) GetMembers() (v []*ChatRoomMember) {
-	if !p.IsSetMembers() {
-		return ChatRoom_Members_DEFAULT
+func (p *LikeInfo) GetDeletedAt() (v int64) {
+	if !p.IsSetDeletedAt() {
+		return LikeInfo_DeletedAt_DEFAULT
 	}
-	return p.Members
+	return *p.DeletedAt
 }
 
-var fieldIDToName_ChatRoom = map[int16]string{
+var fieldIDToName_LikeInfo = map[int16]string{
 	1: "id",
-	2: "name",
-	3: "creator_id",
-	4: "type",
-	8: "members",
+	2: "user_id",
+	3: "video_id",
+	4: "created_at",
+	5: "deleted_at",
 }
 
-func (p *ChatRoom) IsSetMembers() bool {
-	return p.Members != nil
+func (p *LikeInfo) IsSetDeletedAt() bool {
+	return p.DeletedAt != nil
 }
 
-func (p *ChatRoom) Read(iprot thrift.TProtocol) (err error) {
+func (p *LikeInfo) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 	var issetID bool = false
-	var issetName bool = false
-	var issetCreatorID bool = false
-	var issetType bool = false
+	var issetUserID bool = false
+	var issetVideoID bool = false
+	var issetCreatedAt bool = false
 
 	if _, err = iprot.ReadStructBegin(); err != nil {
 		goto ReadStructBeginError
@@ -3523,11 +3269,11 @@ func (p *ChatRoom) Read(iprot thrift.TProtocol) (err error) {
 				goto SkipFieldError
 			}
 		case 2:
-			if fieldTypeId == thrift.STRING {
+			if fieldTypeId == thrift.I64 {
 				if err = p.ReadField2(iprot); err != nil {
 					goto ReadFieldError
 				}
-				issetName = true
+				issetUserID = true
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
@@ -3536,22 +3282,22 @@ func (p *ChatRoom) Read(iprot thrift.TProtocol) (err error) {
 				if err = p.ReadField3(iprot); err != nil {
 					goto ReadFieldError
 				}
-				issetCreatorID = true
+				issetVideoID = true
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
 		case 4:
-			if fieldTypeId == thrift.BYTE {
+			if fieldTypeId == thrift.I64 {
 				if err = p.ReadField4(iprot); err != nil {
 					goto ReadFieldError
 				}
-				issetType = true
+				issetCreatedAt = true
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
-		case 8:
-			if fieldTypeId == thrift.LIST {
-				if err = p.ReadField8(iprot); err != nil {
+		case 5:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField5(iprot); err != nil {
 					goto ReadFieldError
 				}
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
@@ -3575,17 +3321,17 @@ func (p *ChatRoom) Read(iprot thrift.TProtocol) (err error) {
 		goto RequiredFieldNotSetError
 	}
 
-	if !issetName {
+	if !issetUserID {
 		fieldId = 2
 		goto RequiredFieldNotSetError
 	}
 
-	if !issetCreatorID {
+	if !issetVideoID {
 		fieldId = 3
 		goto RequiredFieldNotSetError
 	}
 
-	if !issetType {
+	if !issetCreatedAt {
 		fieldId = 4
 		goto RequiredFieldNotSetError
 	}
@@ -3595,7 +3341,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ChatRoom[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_LikeInfo[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -3604,10 +3350,10 @@ ReadFieldEndError:
 ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 RequiredFieldNotSetError:
-	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_ChatRoom[fieldId]))
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_LikeInfo[fieldId]))
 }
 
-func (p *ChatRoom) ReadField1(iprot thrift.TProtocol) error {
+func (p *LikeInfo) ReadField1(iprot thrift.TProtocol) error {
 
 	var _field int64
 	if v, err := iprot.ReadI64(); err != nil {
@@ -3618,18 +3364,18 @@ func (p *ChatRoom) ReadField1(iprot thrift.TProtocol) error {
 	p.ID = _field
 	return nil
 }
-func (p *ChatRoom) ReadField2(iprot thrift.TProtocol) error {
+func (p *LikeInfo) ReadField2(iprot thrift.TProtocol) error {
 
-	var _field string
-	if v, err := iprot.ReadString(); err != nil {
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
 		return err
 	} else {
 		_field = v
 	}
-	p.Name = _field
+	p.UserID = _field
 	return nil
 }
-func (p *ChatRoom) ReadField3(iprot thrift.TProtocol) error {
+func (p *LikeInfo) ReadField3(iprot thrift.TProtocol) error {
 
 	var _field int64
 	if v, err := iprot.ReadI64(); err != nil {
@@ -3637,47 +3383,35 @@ func (p *ChatRoom) ReadField3(iprot thrift.TProtocol) error {
 	} else {
 		_field = v
 	}
-	p.CreatorID = _field
+	p.VideoID = _field
 	return nil
 }
-func (p *ChatRoom) ReadField4(iprot thrift.TProtocol) error {
+func (p *LikeInfo) ReadField4(iprot thrift.TProtocol) error {
 
-	var _field int8
-	if v, err := iprot.ReadByte(); err != nil {
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
 		return err
 	} else {
 		_field = v
 	}
-	p.Type = _field
+	p.CreatedAt = _field
 	return nil
 }
-func (p *ChatRoom) ReadField8(iprot thrift.TProtocol) error {
-	_, size, err := iprot.ReadListBegin()
-	if err != nil {
-		return err
-	}
-	_field := make([]*ChatRoomMember, 0, size)
-	values := make([]ChatRoomMember, size)
-	for i := 0; i < size; i++ {
-		_elem := &values[i]
-		_elem.InitDefault()
-
-		if err := _elem.Read(iprot); err != nil {
-			return err
-		}
+func (p *LikeInfo) ReadField5(iprot thrift.TProtocol) error {
 
-		_field = append(_field, _elem)
-	}
-	if err := iprot.ReadListEnd(); err != nil {
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
 		return err
+	} else {
+		_field = &v
 	}
-	p.Members = _field
+	p.DeletedAt = _field
 	return nil
 }
 
-func (p *ChatRoom) Write(oprot thrift.TProtocol) (err error) {
+func (p *LikeInfo) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("ChatRoom"); err != nil {
+	if err = oprot.WriteStructBegin("LikeInfo"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -3697,8 +3431,8 @@ func (p *ChatRoom) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 4
 			goto WriteFieldError
 		}
-		if err = p.writeField8(oprot); err != nil {
-			fieldId = 8
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
 			goto WriteFieldError
 		}
 	}
@@ -3719,7 +3453,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *ChatRoom) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *LikeInfo) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("id", thrift.I64, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -3735,11 +3469,11 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
-func (p *ChatRoom) writeField2(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("name", thrift.STRING, 2); err != nil {
+func (p *LikeInfo) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("user_id", thrift.I64, 2); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteString(p.Name); err != nil {
+	if err := oprot.WriteI64(p.UserID); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -3751,11 +3485,11 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
-func (p *ChatRoom) writeField3(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("creator_id", thrift.I64, 3); err != nil {
+func (p *LikeInfo) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("video_id", thrift.I64, 3); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteI64(p.CreatorID); err != nil {
+	if err := oprot.WriteI64(p.VideoID); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -3767,11 +3501,11 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
-func (p *ChatRoom) writeField4(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("type", thrift.BYTE, 4); err != nil {
+func (p *LikeInfo) writeField4(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("created_at", thrift.I64, 4); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteByte(p.Type); err != nil {
+	if err := oprot.WriteI64(p.CreatedAt); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -3783,20 +3517,12 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
 }
-func (p *ChatRoom) writeField8(oprot thrift.TProtocol) (err error) {
-	if p.IsSetMembers() {
-		if err = oprot.WriteFieldBegin("members", thrift.LIST, 8); err != nil {
+func (p *LikeInfo) writeField5(oprot thrift.TProtocol) (err error) {
+	if p.IsSetDeletedAt() {
+		if err = oprot.WriteFieldBegin("deleted_at", thrift.I64, 5); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Members)); err != nil {
-			return err
-		}
-		for _, v := range p.Members {
-			if err := v.Write(oprot); err != nil {
-				return err
-			}
-		}
-		if err := oprot.WriteListEnd(); err != nil {
+		if err := oprot.WriteI64(*p.DeletedAt); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -3805,116 +3531,194 @@ func (p *ChatRoom) writeField8(oprot thrift.TProtocol) (err error) {
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 8 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 8 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
 }
 
-func (p *ChatRoom) String() string {
+func (p *LikeInfo) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ChatRoom(%+v)", *p)
+	return fmt.Sprintf("LikeInfo(%+v)", *p)
 
 }
 
-// 聊天室成员
-type ChatRoomMember struct {
-	// 成员ID
-	ID int64 `thrift:"id,1,required" form:"id,required" json:"id,required" query:"id,required"`
-	// 聊天室ID
-	RoomID int64 `thrift:"room_id,2,required" form:"room_id,required" json:"room_id,required" query:"room_id,required"`
-	// 用户ID
-	UserID int64 `thrift:"user_id,3,required" form:"user_id,required" json:"user_id,required" query:"user_id,required"`
-	// 在群里的昵称
-	Nickname *string `thrift:"nickname,4,optional" form:"nickname" json:"nickname,omitempty" query:"nickname"`
-	// 角色：0=普通成员,1=管理员,2=群主
-	Role int8 `thrift:"role,5,required" form:"role,required" json:"role,required" query:"role,required"`
-	// 创建时间
-	CreatedAt int64 `thrift:"created_at,6,required" form:"created_at,required" json:"created_at,required" query:"created_at,required"`
-	// 更新时间
-	UpdatedAt int64 `thrift:"updated_at,7,required" form:"updated_at,required" json:"updated_at,required" query:"updated_at,required"`
-	// 删除时间
-	DeletedAt *int64 `thrift:"deleted_at,8,optional" form:"deleted_at" json:"deleted_at,omitempty" query:"deleted_at"`
+type CommentInfo struct {
+	ID        int64  `thrift:"id,1,required" form:"id,required" json:"id,required" query:"id,required"`
+	UserID    int64  `thrift:"user_id,2,required" form:"user_id,required" json:"user_id,required" query:"user_id,required"`
+	VideoID   int64  `thrift:"video_id,3,required" form:"video_id,required" json:"video_id,required" query:"video_id,required"`
+	Content   string `thrift:"content,4,required" form:"content,required" json:"content,required" query:"content,required"`
+	ParentID  *int64 `thrift:"parent_id,5,optional" form:"parent_id" json:"parent_id,omitempty" query:"parent_id"`
+	CreatedAt int64  `thrift:"created_at,6,required" form:"created_at,required" json:"created_at,required" query:"created_at,required"`
+	DeletedAt *int64 `thrift:"deleted_at,7,optional" form:"deleted_at" json:"deleted_at,omitempty" query:"deleted_at"`
+	LikeCount *int32 `thrift:"like_count,8,optional" form:"like_count" json:"like_count,omitempty" query:"like_count"`
+	IsLiked   *bool  `thrift:"is_liked,9,optional" form:"is_liked" json:"is_liked,omitempty" query:"is_liked"`
+	// 是否编辑过
+	IsEdited *bool `thrift:"is_edited,10,optional" form:"is_edited" json:"is_edited,omitempty" query:"is_edited"`
+	// 最后编辑时间
+	EditedAt *int64 `thrift:"edited_at,11,optional" form:"edited_at" json:"edited_at,omitempty" query:"edited_at"`
+	// 是否被视频作者置顶
+	IsPinned *bool `thrift:"is_pinned,12,optional" form:"is_pinned" json:"is_pinned,omitempty" query:"is_pinned"`
+	// 是否被视频作者点亮
+	IsHearted *bool `thrift:"is_hearted,13,optional" form:"is_hearted" json:"is_hearted,omitempty" query:"is_hearted"`
 }
 
-func NewChatRoomMember() *ChatRoomMember {
-	return &ChatRoomMember{}
+func NewCommentInfo() *CommentInfo {
+	return &CommentInfo{}
 }
 
-func (p *ChatRoomMember) InitDefault() {
+func (p *CommentInfo) InitDefault() {
 }
 
-func (p *ChatRoomMember) GetID() (v int64) {
+func (p *CommentInfo) GetID() (v int64) {
 	return p.ID
 }
 
-func (p *ChatRoomMember) GetRoomID() (v int64) {
-	return p.RoomID
+func (p *CommentInfo) GetUserID() (v int64) {
+	return p.UserID
 }
 
-func (p *ChatRoomMember) GetUserID() (v int64) {
-	return p.UserID
+func (p *CommentInfo) GetVideoID() (v int64) {
+	return p.VideoID
 }
 
-var ChatRoomMember_Nickname_DEFAULT string
+func (p *CommentInfo) GetContent() (v string) {
+	return p.Content
+}
 
-func (p *ChatRoomMember) GetNickname() (v string) {
-	if !p.IsSetNickname() {
-		return ChatRoomMember_Nickname_DEFAULT
+var CommentInfo_ParentID_DEFAULT int64
+
+func (p *CommentInfo) GetParentID() (v int64) {
+	if !p.IsSetParentID() {
+		return CommentInfo_ParentID_DEFAULT
 	}
-	return *p.Nickname
+	return *p.ParentID
 }
 
-func (p *ChatRoomMember) GetRole() (v int8) {
-	return p.Role
+func (p *CommentInfo) GetCreatedAt() (v int64) {
+	return p.CreatedAt
 }
 
-func (p *ChatRoomMember) GetCreatedAt() (v int64) {
-	return p.CreatedAt
+var CommentInfo_DeletedAt_DEFAULT int64
+
+func (p *CommentInfo) GetDeletedAt() (v int64) {
+	if !p.IsSetDeletedAt() {
+		return CommentInfo_DeletedAt_DEFAULT
+	}
+	return *p.DeletedAt
 }
 
-func (p *ChatRoomMember) GetUpdatedAt() (v int64) {
-	return p.UpdatedAt
+var CommentInfo_LikeCount_DEFAULT int32
+
+func (p *CommentInfo) GetLikeCount() (v int32) {
+	if !p.IsSetLikeCount() {
+		return CommentInfo_LikeCount_DEFAULT
+	}
+	return *p.LikeCount
 }
 
-var ChatRoomMember_DeletedAt_DEFAULT int64
+var CommentInfo_IsLiked_DEFAULT bool
 
-func (p *ChatRoomMember) GetDeletedAt() (v int64) {
-	if !p.IsSetDeletedAt() {
-		return ChatRoomMember_DeletedAt_DEFAULT
+func (p *CommentInfo) GetIsLiked() (v bool) {
+	if !p.IsSetIsLiked() {
+		return CommentInfo_IsLiked_DEFAULT
 	}
-	return *p.DeletedAt
+	return *p.IsLiked
 }
 
-var fieldIDToName_ChatRoomMember = map[int16]string{
-	1: "id",
-	2: "room_id",
-	3: "user_id",
-	4: "nickname",
-	5: "role",
-	6: "created_at",
-	7: "updated_at",
-	8: "deleted_at",
+var CommentInfo_IsEdited_DEFAULT bool
+
+func (p *CommentInfo) GetIsEdited() (v bool) {
+	if !p.IsSetIsEdited() {
+		return CommentInfo_IsEdited_DEFAULT
+	}
+	return *p.IsEdited
 }
 
-func (p *ChatRoomMember) IsSetNickname() bool {
-	return p.Nickname != nil
+var CommentInfo_EditedAt_DEFAULT int64
+
+func (p *CommentInfo) GetEditedAt() (v int64) {
+	if !p.IsSetEditedAt() {
+		return CommentInfo_EditedAt_DEFAULT
+	}
+	return *p.EditedAt
 }
 
-func (p *ChatRoomMember) IsSetDeletedAt() bool {
+var CommentInfo_IsPinned_DEFAULT bool
+
+func (p *CommentInfo) GetIsPinned() (v bool) {
+	if !p.IsSetIsPinned() {
+		return CommentInfo_IsPinned_DEFAULT
+	}
+	return *p.IsPinned
+}
+
+var CommentInfo_IsHearted_DEFAULT bool
+
+func (p *CommentInfo) GetIsHearted() (v bool) {
+	if !p.IsSetIsHearted() {
+		return CommentInfo_IsHearted_DEFAULT
+	}
+	return *p.IsHearted
+}
+
+var fieldIDToName_CommentInfo = map[int16]string{
+	1:  "id",
+	2:  "user_id",
+	3:  "video_id",
+	4:  "content",
+	5:  "parent_id",
+	6:  "created_at",
+	7:  "deleted_at",
+	8:  "like_count",
+	9:  "is_liked",
+	10: "is_edited",
+	11: "edited_at",
+	12: "is_pinned",
+	13: "is_hearted",
+}
+
+func (p *CommentInfo) IsSetParentID() bool {
+	return p.ParentID != nil
+}
+
+func (p *CommentInfo) IsSetDeletedAt() bool {
 	return p.DeletedAt != nil
 }
 
-func (p *ChatRoomMember) Read(iprot thrift.TProtocol) (err error) {
+func (p *CommentInfo) IsSetLikeCount() bool {
+	return p.LikeCount != nil
+}
+
+func (p *CommentInfo) IsSetIsLiked() bool {
+	return p.IsLiked != nil
+}
+
+func (p *CommentInfo) IsSetIsEdited() bool {
+	return p.IsEdited != nil
+}
+
+func (p *CommentInfo) IsSetEditedAt() bool {
+	return p.EditedAt != nil
+}
+
+func (p *CommentInfo) IsSetIsPinned() bool {
+	return p.IsPinned != nil
+}
+
+func (p *CommentInfo) IsSetIsHearted() bool {
+	return p.IsHearted != nil
+}
+
+func (p *CommentInfo) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 	var issetID bool = false
-	var issetRoomID bool = false
 	var issetUserID bool = false
-	var issetRole bool = false
+	var issetVideoID bool = false
+	var issetContent bool = false
 	var issetCreatedAt bool = false
-	var issetUpdatedAt bool = false
 
 	if _, err = iprot.ReadStructBegin(); err != nil {
 		goto ReadStructBeginError
@@ -3944,7 +3748,7 @@ func (p *ChatRoomMember) Read(iprot thrift.TProtocol) (err error) {
 				if err = p.ReadField2(iprot); err != nil {
 					goto ReadFieldError
 				}
-				issetRoomID = true
+				issetUserID = true
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
@@ -3953,7 +3757,7 @@ func (p *ChatRoomMember) Read(iprot thrift.TProtocol) (err error) {
 				if err = p.ReadField3(iprot); err != nil {
 					goto ReadFieldError
 				}
-				issetUserID = true
+				issetVideoID = true
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
@@ -3962,15 +3766,15 @@ func (p *ChatRoomMember) Read(iprot thrift.TProtocol) (err error) {
 				if err = p.ReadField4(iprot); err != nil {
 					goto ReadFieldError
 				}
+				issetContent = true
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
 		case 5:
-			if fieldTypeId == thrift.BYTE {
+			if fieldTypeId == thrift.I64 {
 				if err = p.ReadField5(iprot); err != nil {
 					goto ReadFieldError
 				}
-				issetRole = true
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
@@ -3988,18 +3792,57 @@ func (p *ChatRoomMember) Read(iprot thrift.TProtocol) (err error) {
 				if err = p.ReadField7(iprot); err != nil {
 					goto ReadFieldError
 				}
-				issetUpdatedAt = true
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
 		case 8:
-			if fieldTypeId == thrift.I64 {
+			if fieldTypeId == thrift.I32 {
 				if err = p.ReadField8(iprot); err != nil {
 					goto ReadFieldError
 				}
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
+		case 9:
+			if fieldTypeId == thrift.BOOL {
+				if err = p.ReadField9(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 10:
+			if fieldTypeId == thrift.BOOL {
+				if err = p.ReadField10(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 11:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField11(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 12:
+			if fieldTypeId == thrift.BOOL {
+				if err = p.ReadField12(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 13:
+			if fieldTypeId == thrift.BOOL {
+				if err = p.ReadField13(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -4018,18 +3861,18 @@ func (p *ChatRoomMember) Read(iprot thrift.TProtocol) (err error) {
 		goto RequiredFieldNotSetError
 	}
 
-	if !issetRoomID {
+	if !issetUserID {
 		fieldId = 2
 		goto RequiredFieldNotSetError
 	}
 
-	if !issetUserID {
+	if !issetVideoID {
 		fieldId = 3
 		goto RequiredFieldNotSetError
 	}
 
-	if !issetRole {
-		fieldId = 5
+	if !issetContent {
+		fieldId = 4
 		goto RequiredFieldNotSetError
 	}
 
@@ -4037,18 +3880,13 @@ func (p *ChatRoomMember) Read(iprot thrift.TProtocol) (err error) {
 		fieldId = 6
 		goto RequiredFieldNotSetError
 	}
-
-	if !issetUpdatedAt {
-		fieldId = 7
-		goto RequiredFieldNotSetError
-	}
 	return nil
 ReadStructBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ChatRoomMember[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_CommentInfo[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -4057,10 +3895,10 @@ ReadFieldEndError:
 ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 RequiredFieldNotSetError:
-	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_ChatRoomMember[fieldId]))
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_CommentInfo[fieldId]))
 }
 
-func (p *ChatRoomMember) ReadField1(iprot thrift.TProtocol) error {
+func (p *CommentInfo) ReadField1(iprot thrift.TProtocol) error {
 
 	var _field int64
 	if v, err := iprot.ReadI64(); err != nil {
@@ -4071,7 +3909,7 @@ func (p *ChatRoomMember) ReadField1(iprot thrift.TProtocol) error {
 	p.ID = _field
 	return nil
 }
-func (p *ChatRoomMember) ReadField2(iprot thrift.TProtocol) error {
+func (p *CommentInfo) ReadField2(iprot thrift.TProtocol) error {
 
 	var _field int64
 	if v, err := iprot.ReadI64(); err != nil {
@@ -4079,10 +3917,10 @@ func (p *ChatRoomMember) ReadField2(iprot thrift.TProtocol) error {
 	} else {
 		_field = v
 	}
-	p.RoomID = _field
+	p.UserID = _field
 	return nil
 }
-func (p *ChatRoomMember) ReadField3(iprot thrift.TProtocol) error {
+func (p *CommentInfo) ReadField3(iprot thrift.TProtocol) error {
 
 	var _field int64
 	if v, err := iprot.ReadI64(); err != nil {
@@ -4090,32 +3928,32 @@ func (p *ChatRoomMember) ReadField3(iprot thrift.TProtocol) error {
 	} else {
 		_field = v
 	}
-	p.UserID = _field
+	p.VideoID = _field
 	return nil
 }
-func (p *ChatRoomMember) ReadField4(iprot thrift.TProtocol) error {
+func (p *CommentInfo) ReadField4(iprot thrift.TProtocol) error {
 
-	var _field *string
+	var _field string
 	if v, err := iprot.ReadString(); err != nil {
 		return err
 	} else {
-		_field = &v
+		_field = v
 	}
-	p.Nickname = _field
+	p.Content = _field
 	return nil
 }
-func (p *ChatRoomMember) ReadField5(iprot thrift.TProtocol) error {
+func (p *CommentInfo) ReadField5(iprot thrift.TProtocol) error {
 
-	var _field int8
-	if v, err := iprot.ReadByte(); err != nil {
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
 		return err
 	} else {
-		_field = v
+		_field = &v
 	}
-	p.Role = _field
+	p.ParentID = _field
 	return nil
 }
-func (p *ChatRoomMember) ReadField6(iprot thrift.TProtocol) error {
+func (p *CommentInfo) ReadField6(iprot thrift.TProtocol) error {
 
 	var _field int64
 	if v, err := iprot.ReadI64(); err != nil {
@@ -4126,18 +3964,51 @@ func (p *ChatRoomMember) ReadField6(iprot thrift.TProtocol) error {
 	p.CreatedAt = _field
 	return nil
 }
-func (p *ChatRoomMember) ReadField7(iprot thrift.TProtocol) error {
+func (p *CommentInfo) ReadField7(iprot thrift.TProtocol) error {
 
-	var _field int64
+	var _field *int64
 	if v, err := iprot.ReadI64(); err != nil {
 		return err
 	} else {
-		_field = v
+		_field = &v
 	}
-	p.UpdatedAt = _field
+	p.DeletedAt = _field
 	return nil
 }
-func (p *ChatRoomMember) ReadField8(iprot thrift.TProtocol) error {
+func (p *CommentInfo) ReadField8(iprot thrift.TProtocol) error {
+
+	var _field *int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.LikeCount = _field
+	return nil
+}
+func (p *CommentInfo) ReadField9(iprot thrift.TProtocol) error {
+
+	var _field *bool
+	if v, err := iprot.ReadBool(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.IsLiked = _field
+	return nil
+}
+func (p *CommentInfo) ReadField10(iprot thrift.TProtocol) error {
+
+	var _field *bool
+	if v, err := iprot.ReadBool(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.IsEdited = _field
+	return nil
+}
+func (p *CommentInfo) ReadField11(iprot thrift.TProtocol) error {
 
 	var _field *int64
 	if v, err := iprot.ReadI64(); err != nil {
@@ -4145,13 +4016,35 @@ func (p *ChatRoomMember) ReadField8(iprot thrift.TProtocol) error {
 	} else {
 		_field = &v
 	}
-	p.DeletedAt = _field
+	p.EditedAt = _field
 	return nil
 }
+func (p *CommentInfo) ReadField12(iprot thrift.TProtocol) error {
 
-func (p *ChatRoomMember) Write(oprot thrift.TProtocol) (err error) {
+	var _field *bool
+	if v, err := iprot.ReadBool(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.IsPinned = _field
+	return nil
+}
+func (p *CommentInfo) ReadField13(iprot thrift.TProtocol) error {
+
+	var _field *bool
+	if v, err := iprot.ReadBool(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.IsHearted = _field
+	return nil
+}
+
+func (p *CommentInfo) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("ChatRoomMember"); err != nil {
+	if err = oprot.WriteStructBegin("CommentInfo"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -4187,6 +4080,26 @@ func (p *ChatRoomMember) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 8
 			goto WriteFieldError
 		}
+		if err = p.writeField9(oprot); err != nil {
+			fieldId = 9
+			goto WriteFieldError
+		}
+		if err = p.writeField10(oprot); err != nil {
+			fieldId = 10
+			goto WriteFieldError
+		}
+		if err = p.writeField11(oprot); err != nil {
+			fieldId = 11
+			goto WriteFieldError
+		}
+		if err = p.writeField12(oprot); err != nil {
+			fieldId = 12
+			goto WriteFieldError
+		}
+		if err = p.writeField13(oprot); err != nil {
+			fieldId = 13
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -4205,7 +4118,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *ChatRoomMember) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *CommentInfo) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("id", thrift.I64, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -4221,11 +4134,11 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
-func (p *ChatRoomMember) writeField2(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("room_id", thrift.I64, 2); err != nil {
+func (p *CommentInfo) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("user_id", thrift.I64, 2); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteI64(p.RoomID); err != nil {
+	if err := oprot.WriteI64(p.UserID); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -4237,11 +4150,11 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
-func (p *ChatRoomMember) writeField3(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("user_id", thrift.I64, 3); err != nil {
+func (p *CommentInfo) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("video_id", thrift.I64, 3); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteI64(p.UserID); err != nil {
+	if err := oprot.WriteI64(p.VideoID); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -4253,17 +4166,15 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
-func (p *ChatRoomMember) writeField4(oprot thrift.TProtocol) (err error) {
-	if p.IsSetNickname() {
-		if err = oprot.WriteFieldBegin("nickname", thrift.STRING, 4); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteString(*p.Nickname); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
+func (p *CommentInfo) writeField4(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("content", thrift.STRING, 4); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Content); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
 	}
 	return nil
 WriteFieldBeginError:
@@ -4271,15 +4182,17 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
 }
-func (p *ChatRoomMember) writeField5(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("role", thrift.BYTE, 5); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteByte(p.Role); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
+func (p *CommentInfo) writeField5(oprot thrift.TProtocol) (err error) {
+	if p.IsSetParentID() {
+		if err = oprot.WriteFieldBegin("parent_id", thrift.I64, 5); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.ParentID); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
 	}
 	return nil
 WriteFieldBeginError:
@@ -4287,7 +4200,7 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
 }
-func (p *ChatRoomMember) writeField6(oprot thrift.TProtocol) (err error) {
+func (p *CommentInfo) writeField6(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("created_at", thrift.I64, 6); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -4303,15 +4216,17 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
 }
-func (p *ChatRoomMember) writeField7(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("updated_at", thrift.I64, 7); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteI64(p.UpdatedAt); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
+func (p *CommentInfo) writeField7(oprot thrift.TProtocol) (err error) {
+	if p.IsSetDeletedAt() {
+		if err = oprot.WriteFieldBegin("deleted_at", thrift.I64, 7); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.DeletedAt); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
 	}
 	return nil
 WriteFieldBeginError:
@@ -4319,12 +4234,12 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 7 end error: ", p), err)
 }
-func (p *ChatRoomMember) writeField8(oprot thrift.TProtocol) (err error) {
-	if p.IsSetDeletedAt() {
-		if err = oprot.WriteFieldBegin("deleted_at", thrift.I64, 8); err != nil {
+func (p *CommentInfo) writeField8(oprot thrift.TProtocol) (err error) {
+	if p.IsSetLikeCount() {
+		if err = oprot.WriteFieldBegin("like_count", thrift.I32, 8); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteI64(*p.DeletedAt); err != nil {
+		if err := oprot.WriteI32(*p.LikeCount); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -4337,104 +4252,138 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 8 end error: ", p), err)
 }
-
-func (p *ChatRoomMember) String() string {
-	if p == nil {
-		return "<nil>"
+func (p *CommentInfo) writeField9(oprot thrift.TProtocol) (err error) {
+	if p.IsSetIsLiked() {
+		if err = oprot.WriteFieldBegin("is_liked", thrift.BOOL, 9); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteBool(*p.IsLiked); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
 	}
-	return fmt.Sprintf("ChatRoomMember(%+v)", *p)
-
-}
-
-// 聊天消息
-type ChatMessage struct {
-	// 消息ID
-	ID int64 `thrift:"id,1,required" form:"id,required" json:"id,required" query:"id,required"`
-	// 聊天室ID
-	RoomID int64 `thrift:"room_id,2,required" form:"room_id,required" json:"room_id,required" query:"room_id,required"`
-	// 发送者ID
-	SenderID int64 `thrift:"sender_id,3,required" form:"sender_id,required" json:"sender_id,required" query:"sender_id,required"`
-	// 消息内容
-	Content string `thrift:"content,4,required" form:"content,required" json:"content,required" query:"content,required"`
-	// 消息类型：0=文本,1=图片,2=视频,3=文件
-	Type int8 `thrift:"type,5,required" form:"type,required" json:"type,required" query:"type,required"`
-	// 创建时间
-	CreatedAt int64 `thrift:"created_at,6,required" form:"created_at,required" json:"created_at,required" query:"created_at,required"`
-	// 更新时间
-	UpdatedAt int64 `thrift:"updated_at,7,required" form:"updated_at,required" json:"updated_at,required" query:"updated_at,required"`
-	// 删除时间
-	DeletedAt *int64 `thrift:"deleted_at,8,optional" form:"deleted_at" json:"deleted_at,omitempty" query:"deleted_at"`
-}
-
-func NewChatMessage() *ChatMessage {
-	return &ChatMessage{}
-}
-
-func (p *ChatMessage) InitDefault() {
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 9 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 9 end error: ", p), err)
 }
-
-func (p *ChatMessage) GetID() (v int64) {
-	return p.ID
+func (p *CommentInfo) writeField10(oprot thrift.TProtocol) (err error) {
+	if p.IsSetIsEdited() {
+		if err = oprot.WriteFieldBegin("is_edited", thrift.BOOL, 10); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteBool(*p.IsEdited); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 10 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 10 end error: ", p), err)
 }
-
-func (p *ChatMessage) GetRoomID() (v int64) {
-	return p.RoomID
+func (p *CommentInfo) writeField11(oprot thrift.TProtocol) (err error) {
+	if p.IsSetEditedAt() {
+		if err = oprot.WriteFieldBegin("edited_at", thrift.I64, 11); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.EditedAt); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 11 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 11 end error: ", p), err)
 }
-
-func (p *ChatMessage) GetSenderID() (v int64) {
-	return p.SenderID
+func (p *CommentInfo) writeField12(oprot thrift.TProtocol) (err error) {
+	if p.IsSetIsPinned() {
+		if err = oprot.WriteFieldBegin("is_pinned", thrift.BOOL, 12); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteBool(*p.IsPinned); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 12 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 12 end error: ", p), err)
 }
-
-func (p *ChatMessage) GetContent() (v string) {
-	return p.Content
+func (p *CommentInfo) writeField13(oprot thrift.TProtocol) (err error) {
+	if p.IsSetIsHearted() {
+		if err = oprot.WriteFieldBegin("is_hearted", thrift.BOOL, 13); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteBool(*p.IsHearted); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 13 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 13 end error: ", p), err)
 }
 
-func (p *ChatMessage) GetType() (v int8) {
-	return p.Type
+func (p *CommentInfo) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CommentInfo(%+v)", *p)
+
 }
 
-func (p *ChatMessage) GetCreatedAt() (v int64) {
-	return p.CreatedAt
+// 表情回应统计
+type ReactionCount struct {
+	// 表情
+	Reaction string `thrift:"reaction,1,required" form:"reaction,required" json:"reaction,required" query:"reaction,required"`
+	// 数量
+	Count int64 `thrift:"count,2,required" form:"count,required" json:"count,required" query:"count,required"`
 }
 
-func (p *ChatMessage) GetUpdatedAt() (v int64) {
-	return p.UpdatedAt
+func NewReactionCount() *ReactionCount {
+	return &ReactionCount{}
 }
 
-var ChatMessage_DeletedAt_DEFAULT int64
+func (p *ReactionCount) InitDefault() {
+}
 
-func (p *ChatMessage) GetDeletedAt() (v int64) {
-	if !p.IsSetDeletedAt() {
-		return ChatMessage_DeletedAt_DEFAULT
-	}
-	return *p.DeletedAt
+func (p *ReactionCount) GetReaction() (v string) {
+	return p.Reaction
 }
 
-var fieldIDToName_ChatMessage = map[int16]string{
-	1: "id",
-	2: "room_id",
-	3: "sender_id",
-	4: "content",
-	5: "type",
-	6: "created_at",
-	7: "updated_at",
-	8: "deleted_at",
+func (p *ReactionCount) GetCount() (v int64) {
+	return p.Count
 }
 
-func (p *ChatMessage) IsSetDeletedAt() bool {
-	return p.DeletedAt != nil
+var fieldIDToName_ReactionCount = map[int16]string{
+	1: "reaction",
+	2: "count",
 }
 
-func (p *ChatMessage) Read(iprot thrift.TProtocol) (err error) {
+func (p *ReactionCount) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
-	var issetID bool = false
-	var issetRoomID bool = false
-	var issetSenderID bool = false
-	var issetContent bool = false
-	var issetType bool = false
-	var issetCreatedAt bool = false
-	var issetUpdatedAt bool = false
+	var issetReaction bool = false
+	var issetCount bool = false
 
 	if _, err = iprot.ReadStructBegin(); err != nil {
 		goto ReadStructBeginError
@@ -4451,11 +4400,11 @@ func (p *ChatMessage) Read(iprot thrift.TProtocol) (err error) {
 
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.I64 {
+			if fieldTypeId == thrift.STRING {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
-				issetID = true
+				issetReaction = true
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
@@ -4464,60 +4413,7 @@ func (p *ChatMessage) Read(iprot thrift.TProtocol) (err error) {
 				if err = p.ReadField2(iprot); err != nil {
 					goto ReadFieldError
 				}
-				issetRoomID = true
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 3:
-			if fieldTypeId == thrift.I64 {
-				if err = p.ReadField3(iprot); err != nil {
-					goto ReadFieldError
-				}
-				issetSenderID = true
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 4:
-			if fieldTypeId == thrift.STRING {
-				if err = p.ReadField4(iprot); err != nil {
-					goto ReadFieldError
-				}
-				issetContent = true
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 5:
-			if fieldTypeId == thrift.BYTE {
-				if err = p.ReadField5(iprot); err != nil {
-					goto ReadFieldError
-				}
-				issetType = true
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 6:
-			if fieldTypeId == thrift.I64 {
-				if err = p.ReadField6(iprot); err != nil {
-					goto ReadFieldError
-				}
-				issetCreatedAt = true
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 7:
-			if fieldTypeId == thrift.I64 {
-				if err = p.ReadField7(iprot); err != nil {
-					goto ReadFieldError
-				}
-				issetUpdatedAt = true
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 8:
-			if fieldTypeId == thrift.I64 {
-				if err = p.ReadField8(iprot); err != nil {
-					goto ReadFieldError
-				}
+				issetCount = true
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
@@ -4534,47 +4430,22 @@ func (p *ChatMessage) Read(iprot thrift.TProtocol) (err error) {
 		goto ReadStructEndError
 	}
 
-	if !issetID {
+	if !issetReaction {
 		fieldId = 1
 		goto RequiredFieldNotSetError
 	}
 
-	if !issetRoomID {
+	if !issetCount {
 		fieldId = 2
 		goto RequiredFieldNotSetError
 	}
-
-	if !issetSenderID {
-		fieldId = 3
-		goto RequiredFieldNotSetError
-	}
-
-	if !issetContent {
-		fieldId = 4
-		goto RequiredFieldNotSetError
-	}
-
-	if !issetType {
-		fieldId = 5
-		goto RequiredFieldNotSetError
-	}
-
-	if !issetCreatedAt {
-		fieldId = 6
-		goto RequiredFieldNotSetError
-	}
-
-	if !issetUpdatedAt {
-		fieldId = 7
-		goto RequiredFieldNotSetError
-	}
 	return nil
 ReadStructBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ChatMessage[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ReactionCount[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -4583,21 +4454,21 @@ ReadFieldEndError:
 ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 RequiredFieldNotSetError:
-	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_ChatMessage[fieldId]))
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_ReactionCount[fieldId]))
 }
 
-func (p *ChatMessage) ReadField1(iprot thrift.TProtocol) error {
+func (p *ReactionCount) ReadField1(iprot thrift.TProtocol) error {
 
-	var _field int64
-	if v, err := iprot.ReadI64(); err != nil {
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
 		return err
 	} else {
 		_field = v
 	}
-	p.ID = _field
+	p.Reaction = _field
 	return nil
 }
-func (p *ChatMessage) ReadField2(iprot thrift.TProtocol) error {
+func (p *ReactionCount) ReadField2(iprot thrift.TProtocol) error {
 
 	var _field int64
 	if v, err := iprot.ReadI64(); err != nil {
@@ -4605,79 +4476,245 @@ func (p *ChatMessage) ReadField2(iprot thrift.TProtocol) error {
 	} else {
 		_field = v
 	}
-	p.RoomID = _field
+	p.Count = _field
 	return nil
 }
-func (p *ChatMessage) ReadField3(iprot thrift.TProtocol) error {
 
-	var _field int64
-	if v, err := iprot.ReadI64(); err != nil {
-		return err
-	} else {
-		_field = v
+func (p *ReactionCount) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ReactionCount"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
 	}
-	p.SenderID = _field
 	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
-func (p *ChatMessage) ReadField4(iprot thrift.TProtocol) error {
 
-	var _field string
-	if v, err := iprot.ReadString(); err != nil {
+func (p *ReactionCount) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("reaction", thrift.STRING, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Reaction); err != nil {
 		return err
-	} else {
-		_field = v
 	}
-	p.Content = _field
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
 	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
-func (p *ChatMessage) ReadField5(iprot thrift.TProtocol) error {
-
-	var _field int8
-	if v, err := iprot.ReadByte(); err != nil {
+func (p *ReactionCount) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("count", thrift.I64, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.Count); err != nil {
 		return err
-	} else {
-		_field = v
 	}
-	p.Type = _field
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
 	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
-func (p *ChatMessage) ReadField6(iprot thrift.TProtocol) error {
 
-	var _field int64
-	if v, err := iprot.ReadI64(); err != nil {
-		return err
-	} else {
-		_field = v
+func (p *ReactionCount) String() string {
+	if p == nil {
+		return "<nil>"
 	}
-	p.CreatedAt = _field
-	return nil
+	return fmt.Sprintf("ReactionCount(%+v)", *p)
+
 }
-func (p *ChatMessage) ReadField7(iprot thrift.TProtocol) error {
 
-	var _field int64
-	if v, err := iprot.ReadI64(); err != nil {
-		return err
+// 表情回应记录
+type ReactionInfo struct {
+	UserID    int64  `thrift:"user_id,1,required" form:"user_id,required" json:"user_id,required" query:"user_id,required"`
+	Reaction  string `thrift:"reaction,2,required" form:"reaction,required" json:"reaction,required" query:"reaction,required"`
+	CreatedAt int64  `thrift:"created_at,3,required" form:"created_at,required" json:"created_at,required" query:"created_at,required"`
+}
+
+func NewReactionInfo() *ReactionInfo {
+	return &ReactionInfo{}
+}
+
+func (p *ReactionInfo) InitDefault() {
+}
+
+func (p *ReactionInfo) GetUserID() (v int64) {
+	return p.UserID
+}
+
+func (p *ReactionInfo) GetReaction() (v string) {
+	return p.Reaction
+}
+
+func (p *ReactionInfo) GetCreatedAt() (v int64) {
+	return p.CreatedAt
+}
+
+var fieldIDToName_ReactionInfo = map[int16]string{
+	1: "user_id",
+	2: "reaction",
+	3: "created_at",
+}
+
+func (p *ReactionInfo) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetUserID bool = false
+	var issetReaction bool = false
+	var issetCreatedAt bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetUserID = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetReaction = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetCreatedAt = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	if !issetUserID {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetReaction {
+		fieldId = 2
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetCreatedAt {
+		fieldId = 3
+		goto RequiredFieldNotSetError
+	}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ReactionInfo[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_ReactionInfo[fieldId]))
+}
+
+func (p *ReactionInfo) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
 	} else {
 		_field = v
 	}
-	p.UpdatedAt = _field
+	p.UserID = _field
 	return nil
 }
-func (p *ChatMessage) ReadField8(iprot thrift.TProtocol) error {
+func (p *ReactionInfo) ReadField2(iprot thrift.TProtocol) error {
 
-	var _field *int64
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Reaction = _field
+	return nil
+}
+func (p *ReactionInfo) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field int64
 	if v, err := iprot.ReadI64(); err != nil {
 		return err
 	} else {
-		_field = &v
+		_field = v
 	}
-	p.DeletedAt = _field
+	p.CreatedAt = _field
 	return nil
 }
 
-func (p *ChatMessage) Write(oprot thrift.TProtocol) (err error) {
+func (p *ReactionInfo) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("ChatMessage"); err != nil {
+	if err = oprot.WriteStructBegin("ReactionInfo"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -4693,26 +4730,6 @@ func (p *ChatMessage) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 3
 			goto WriteFieldError
 		}
-		if err = p.writeField4(oprot); err != nil {
-			fieldId = 4
-			goto WriteFieldError
-		}
-		if err = p.writeField5(oprot); err != nil {
-			fieldId = 5
-			goto WriteFieldError
-		}
-		if err = p.writeField6(oprot); err != nil {
-			fieldId = 6
-			goto WriteFieldError
-		}
-		if err = p.writeField7(oprot); err != nil {
-			fieldId = 7
-			goto WriteFieldError
-		}
-		if err = p.writeField8(oprot); err != nil {
-			fieldId = 8
-			goto WriteFieldError
-		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -4731,11 +4748,11 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *ChatMessage) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("id", thrift.I64, 1); err != nil {
+func (p *ReactionInfo) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("user_id", thrift.I64, 1); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteI64(p.ID); err != nil {
+	if err := oprot.WriteI64(p.UserID); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -4747,11 +4764,11 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
-func (p *ChatMessage) writeField2(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("room_id", thrift.I64, 2); err != nil {
+func (p *ReactionInfo) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("reaction", thrift.STRING, 2); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteI64(p.RoomID); err != nil {
+	if err := oprot.WriteString(p.Reaction); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -4763,11 +4780,11 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
-func (p *ChatMessage) writeField3(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("sender_id", thrift.I64, 3); err != nil {
+func (p *ReactionInfo) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("created_at", thrift.I64, 3); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteI64(p.SenderID); err != nil {
+	if err := oprot.WriteI64(p.CreatedAt); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -4779,103 +4796,5854 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
-func (p *ChatMessage) writeField4(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("content", thrift.STRING, 4); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteString(p.Content); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
+
+func (p *ReactionInfo) String() string {
+	if p == nil {
+		return "<nil>"
 	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+	return fmt.Sprintf("ReactionInfo(%+v)", *p)
+
 }
-func (p *ChatMessage) writeField5(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("type", thrift.BYTE, 5); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteByte(p.Type); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+
+// 视频互动状态
+type VideoInteractionState struct {
+	VideoID int64 `thrift:"video_id,1,required" form:"video_id,required" json:"video_id,required" query:"video_id,required"`
+	// 当前用户是否点赞
+	IsLiked bool `thrift:"is_liked,2,required" form:"is_liked,required" json:"is_liked,required" query:"is_liked,required"`
+	// 点赞数
+	LikeCount int64 `thrift:"like_count,3,required" form:"like_count,required" json:"like_count,required" query:"like_count,required"`
+	// 可见评论数
+	CommentCount int64 `thrift:"comment_count,4,required" form:"comment_count,required" json:"comment_count,required" query:"comment_count,required"`
+	// 各表情数量
+	Reactions []*ReactionCount `thrift:"reactions,5,required" form:"reactions,required" json:"reactions,required" query:"reactions,required"`
+	// 当前用户的表情
+	MyReaction *string `thrift:"my_reaction,6,optional" form:"my_reaction" json:"my_reaction,omitempty" query:"my_reaction"`
+	// 被收藏次数
+	CollectCount *int64 `thrift:"collect_count,7,optional" form:"collect_count" json:"collect_count,omitempty" query:"collect_count"`
+	// 当前用户是否已收藏
+	IsCollected *bool `thrift:"is_collected,8,optional" form:"is_collected" json:"is_collected,omitempty" query:"is_collected"`
 }
-func (p *ChatMessage) writeField6(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("created_at", thrift.I64, 6); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteI64(p.CreatedAt); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
+
+func NewVideoInteractionState() *VideoInteractionState {
+	return &VideoInteractionState{}
 }
-func (p *ChatMessage) writeField7(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("updated_at", thrift.I64, 7); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteI64(p.UpdatedAt); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 7 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 7 end error: ", p), err)
+
+func (p *VideoInteractionState) InitDefault() {
 }
-func (p *ChatMessage) writeField8(oprot thrift.TProtocol) (err error) {
-	if p.IsSetDeletedAt() {
-		if err = oprot.WriteFieldBegin("deleted_at", thrift.I64, 8); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteI64(*p.DeletedAt); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 8 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 8 end error: ", p), err)
+
+func (p *VideoInteractionState) GetVideoID() (v int64) {
+	return p.VideoID
 }
 
-func (p *ChatMessage) String() string {
-	if p == nil {
-		return "<nil>"
-	}
-	return fmt.Sprintf("ChatMessage(%+v)", *p)
+func (p *VideoInteractionState) GetIsLiked() (v bool) {
+	return p.IsLiked
+}
 
+func (p *VideoInteractionState) GetLikeCount() (v int64) {
+	return p.LikeCount
 }
 
-// 好友关系
-type Friendship struct {
-	// 关系ID
-	ID int64 `thrift:"id,1,required" form:"id,required" json:"id,required" query:"id,required"`
-	// 用户ID
-	UserID int64 `thrift:"user_id,2,required" form:"user_id,required" json:"user_id,required" query:"user_id,required"`
+func (p *VideoInteractionState) GetCommentCount() (v int64) {
+	return p.CommentCount
+}
+
+func (p *VideoInteractionState) GetReactions() (v []*ReactionCount) {
+	return p.Reactions
+}
+
+var VideoInteractionState_MyReaction_DEFAULT string
+
+func (p *VideoInteractionState) GetMyReaction() (v string) {
+	if !p.IsSetMyReaction() {
+		return VideoInteractionState_MyReaction_DEFAULT
+	}
+	return *p.MyReaction
+}
+
+var VideoInteractionState_CollectCount_DEFAULT int64
+
+func (p *VideoInteractionState) GetCollectCount() (v int64) {
+	if !p.IsSetCollectCount() {
+		return VideoInteractionState_CollectCount_DEFAULT
+	}
+	return *p.CollectCount
+}
+
+var VideoInteractionState_IsCollected_DEFAULT bool
+
+func (p *VideoInteractionState) GetIsCollected() (v bool) {
+	if !p.IsSetIsCollected() {
+		return VideoInteractionState_IsCollected_DEFAULT
+	}
+	return *p.IsCollected
+}
+
+var fieldIDToName_VideoInteractionState = map[int16]string{
+	1: "video_id",
+	2: "is_liked",
+	3: "like_count",
+	4: "comment_count",
+	5: "reactions",
+	6: "my_reaction",
+	7: "collect_count",
+	8: "is_collected",
+}
+
+func (p *VideoInteractionState) IsSetMyReaction() bool {
+	return p.MyReaction != nil
+}
+
+func (p *VideoInteractionState) IsSetCollectCount() bool {
+	return p.CollectCount != nil
+}
+
+func (p *VideoInteractionState) IsSetIsCollected() bool {
+	return p.IsCollected != nil
+}
+
+func (p *VideoInteractionState) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetVideoID bool = false
+	var issetIsLiked bool = false
+	var issetLikeCount bool = false
+	var issetCommentCount bool = false
+	var issetReactions bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetVideoID = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.BOOL {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetIsLiked = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetLikeCount = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetCommentCount = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 5:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetReactions = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 6:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField6(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 7:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField7(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 8:
+			if fieldTypeId == thrift.BOOL {
+				if err = p.ReadField8(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	if !issetVideoID {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetIsLiked {
+		fieldId = 2
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetLikeCount {
+		fieldId = 3
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetCommentCount {
+		fieldId = 4
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetReactions {
+		fieldId = 5
+		goto RequiredFieldNotSetError
+	}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VideoInteractionState[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_VideoInteractionState[fieldId]))
+}
+
+func (p *VideoInteractionState) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.VideoID = _field
+	return nil
+}
+func (p *VideoInteractionState) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field bool
+	if v, err := iprot.ReadBool(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.IsLiked = _field
+	return nil
+}
+func (p *VideoInteractionState) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.LikeCount = _field
+	return nil
+}
+func (p *VideoInteractionState) ReadField4(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.CommentCount = _field
+	return nil
+}
+func (p *VideoInteractionState) ReadField5(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*ReactionCount, 0, size)
+	values := make([]ReactionCount, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.Reactions = _field
+	return nil
+}
+func (p *VideoInteractionState) ReadField6(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.MyReaction = _field
+	return nil
+}
+func (p *VideoInteractionState) ReadField7(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.CollectCount = _field
+	return nil
+}
+func (p *VideoInteractionState) ReadField8(iprot thrift.TProtocol) error {
+
+	var _field *bool
+	if v, err := iprot.ReadBool(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.IsCollected = _field
+	return nil
+}
+
+func (p *VideoInteractionState) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("VideoInteractionState"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+		if err = p.writeField6(oprot); err != nil {
+			fieldId = 6
+			goto WriteFieldError
+		}
+		if err = p.writeField7(oprot); err != nil {
+			fieldId = 7
+			goto WriteFieldError
+		}
+		if err = p.writeField8(oprot); err != nil {
+			fieldId = 8
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *VideoInteractionState) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("video_id", thrift.I64, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.VideoID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *VideoInteractionState) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("is_liked", thrift.BOOL, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteBool(p.IsLiked); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+func (p *VideoInteractionState) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("like_count", thrift.I64, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.LikeCount); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+func (p *VideoInteractionState) writeField4(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("comment_count", thrift.I64, 4); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.CommentCount); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+func (p *VideoInteractionState) writeField5(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("reactions", thrift.LIST, 5); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Reactions)); err != nil {
+		return err
+	}
+	for _, v := range p.Reactions {
+		if err := v.Write(oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteListEnd(); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+}
+func (p *VideoInteractionState) writeField6(oprot thrift.TProtocol) (err error) {
+	if p.IsSetMyReaction() {
+		if err = oprot.WriteFieldBegin("my_reaction", thrift.STRING, 6); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.MyReaction); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
+}
+func (p *VideoInteractionState) writeField7(oprot thrift.TProtocol) (err error) {
+	if p.IsSetCollectCount() {
+		if err = oprot.WriteFieldBegin("collect_count", thrift.I64, 7); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.CollectCount); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 end error: ", p), err)
+}
+func (p *VideoInteractionState) writeField8(oprot thrift.TProtocol) (err error) {
+	if p.IsSetIsCollected() {
+		if err = oprot.WriteFieldBegin("is_collected", thrift.BOOL, 8); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteBool(*p.IsCollected); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 end error: ", p), err)
+}
+
+func (p *VideoInteractionState) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("VideoInteractionState(%+v)", *p)
+
+}
+
+// 收藏夹
+type Collection struct {
+	ID     int64  `thrift:"id,1,required" form:"id,required" json:"id,required" query:"id,required"`
+	UserID int64  `thrift:"user_id,2,required" form:"user_id,required" json:"user_id,required" query:"user_id,required"`
+	Name   string `thrift:"name,3,required" form:"name,required" json:"name,required" query:"name,required"`
+	// 是否私密
+	IsPrivate bool `thrift:"is_private,4,required" form:"is_private,required" json:"is_private,required" query:"is_private,required"`
+	// 是否为默认的"稍后再看"
+	IsDefault bool `thrift:"is_default,5,required" form:"is_default,required" json:"is_default,required" query:"is_default,required"`
+	// 排序值，越小越靠前
+	SortOrder int32 `thrift:"sort_order,6,required" form:"sort_order,required" json:"sort_order,required" query:"sort_order,required"`
+	// 视频数量
+	VideoCount int64 `thrift:"video_count,7,required" form:"video_count,required" json:"video_count,required" query:"video_count,required"`
+	CreatedAt  int64 `thrift:"created_at,8,required" form:"created_at,required" json:"created_at,required" query:"created_at,required"`
+	UpdatedAt  int64 `thrift:"updated_at,9,required" form:"updated_at,required" json:"updated_at,required" query:"updated_at,required"`
+}
+
+func NewCollection() *Collection {
+	return &Collection{}
+}
+
+func (p *Collection) InitDefault() {
+}
+
+func (p *Collection) GetID() (v int64) {
+	return p.ID
+}
+
+func (p *Collection) GetUserID() (v int64) {
+	return p.UserID
+}
+
+func (p *Collection) GetName() (v string) {
+	return p.Name
+}
+
+func (p *Collection) GetIsPrivate() (v bool) {
+	return p.IsPrivate
+}
+
+func (p *Collection) GetIsDefault() (v bool) {
+	return p.IsDefault
+}
+
+func (p *Collection) GetSortOrder() (v int32) {
+	return p.SortOrder
+}
+
+func (p *Collection) GetVideoCount() (v int64) {
+	return p.VideoCount
+}
+
+func (p *Collection) GetCreatedAt() (v int64) {
+	return p.CreatedAt
+}
+
+func (p *Collection) GetUpdatedAt() (v int64) {
+	return p.UpdatedAt
+}
+
+var fieldIDToName_Collection = map[int16]string{
+	1: "id",
+	2: "user_id",
+	3: "name",
+	4: "is_private",
+	5: "is_default",
+	6: "sort_order",
+	7: "video_count",
+	8: "created_at",
+	9: "updated_at",
+}
+
+func (p *Collection) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetID bool = false
+	var issetUserID bool = false
+	var issetName bool = false
+	var issetIsPrivate bool = false
+	var issetIsDefault bool = false
+	var issetSortOrder bool = false
+	var issetVideoCount bool = false
+	var issetCreatedAt bool = false
+	var issetUpdatedAt bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetID = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetUserID = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetName = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.BOOL {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetIsPrivate = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 5:
+			if fieldTypeId == thrift.BOOL {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetIsDefault = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 6:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField6(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetSortOrder = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 7:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField7(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetVideoCount = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 8:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField8(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetCreatedAt = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 9:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField9(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetUpdatedAt = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	if !issetID {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetUserID {
+		fieldId = 2
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetName {
+		fieldId = 3
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetIsPrivate {
+		fieldId = 4
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetIsDefault {
+		fieldId = 5
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetSortOrder {
+		fieldId = 6
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetVideoCount {
+		fieldId = 7
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetCreatedAt {
+		fieldId = 8
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetUpdatedAt {
+		fieldId = 9
+		goto RequiredFieldNotSetError
+	}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_Collection[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_Collection[fieldId]))
+}
+
+func (p *Collection) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.ID = _field
+	return nil
+}
+func (p *Collection) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.UserID = _field
+	return nil
+}
+func (p *Collection) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Name = _field
+	return nil
+}
+func (p *Collection) ReadField4(iprot thrift.TProtocol) error {
+
+	var _field bool
+	if v, err := iprot.ReadBool(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.IsPrivate = _field
+	return nil
+}
+func (p *Collection) ReadField5(iprot thrift.TProtocol) error {
+
+	var _field bool
+	if v, err := iprot.ReadBool(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.IsDefault = _field
+	return nil
+}
+func (p *Collection) ReadField6(iprot thrift.TProtocol) error {
+
+	var _field int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.SortOrder = _field
+	return nil
+}
+func (p *Collection) ReadField7(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.VideoCount = _field
+	return nil
+}
+func (p *Collection) ReadField8(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.CreatedAt = _field
+	return nil
+}
+func (p *Collection) ReadField9(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.UpdatedAt = _field
+	return nil
+}
+
+func (p *Collection) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("Collection"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+		if err = p.writeField6(oprot); err != nil {
+			fieldId = 6
+			goto WriteFieldError
+		}
+		if err = p.writeField7(oprot); err != nil {
+			fieldId = 7
+			goto WriteFieldError
+		}
+		if err = p.writeField8(oprot); err != nil {
+			fieldId = 8
+			goto WriteFieldError
+		}
+		if err = p.writeField9(oprot); err != nil {
+			fieldId = 9
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *Collection) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("id", thrift.I64, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.ID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *Collection) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("user_id", thrift.I64, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.UserID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+func (p *Collection) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("name", thrift.STRING, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Name); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+func (p *Collection) writeField4(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("is_private", thrift.BOOL, 4); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteBool(p.IsPrivate); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+func (p *Collection) writeField5(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("is_default", thrift.BOOL, 5); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteBool(p.IsDefault); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+}
+func (p *Collection) writeField6(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("sort_order", thrift.I32, 6); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.SortOrder); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
+}
+func (p *Collection) writeField7(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("video_count", thrift.I64, 7); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.VideoCount); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 end error: ", p), err)
+}
+func (p *Collection) writeField8(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("created_at", thrift.I64, 8); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.CreatedAt); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 end error: ", p), err)
+}
+func (p *Collection) writeField9(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("updated_at", thrift.I64, 9); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.UpdatedAt); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 9 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 9 end error: ", p), err)
+}
+
+func (p *Collection) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("Collection(%+v)", *p)
+
+}
+
+// 评论编辑记录
+type CommentEdit struct {
+	ID        int64 `thrift:"id,1,required" form:"id,required" json:"id,required" query:"id,required"`
+	CommentID int64 `thrift:"comment_id,2,required" form:"comment_id,required" json:"comment_id,required" query:"comment_id,required"`
+	// 编辑前的内容
+	Content  string `thrift:"content,3,required" form:"content,required" json:"content,required" query:"content,required"`
+	EditedAt int64  `thrift:"edited_at,4,required" form:"edited_at,required" json:"edited_at,required" query:"edited_at,required"`
+}
+
+func NewCommentEdit() *CommentEdit {
+	return &CommentEdit{}
+}
+
+func (p *CommentEdit) InitDefault() {
+}
+
+func (p *CommentEdit) GetID() (v int64) {
+	return p.ID
+}
+
+func (p *CommentEdit) GetCommentID() (v int64) {
+	return p.CommentID
+}
+
+func (p *CommentEdit) GetContent() (v string) {
+	return p.Content
+}
+
+func (p *CommentEdit) GetEditedAt() (v int64) {
+	return p.EditedAt
+}
+
+var fieldIDToName_CommentEdit = map[int16]string{
+	1: "id",
+	2: "comment_id",
+	3: "content",
+	4: "edited_at",
+}
+
+func (p *CommentEdit) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetID bool = false
+	var issetCommentID bool = false
+	var issetContent bool = false
+	var issetEditedAt bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetID = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetCommentID = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetContent = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetEditedAt = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	if !issetID {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetCommentID {
+		fieldId = 2
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetContent {
+		fieldId = 3
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetEditedAt {
+		fieldId = 4
+		goto RequiredFieldNotSetError
+	}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_CommentEdit[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_CommentEdit[fieldId]))
+}
+
+func (p *CommentEdit) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.ID = _field
+	return nil
+}
+func (p *CommentEdit) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.CommentID = _field
+	return nil
+}
+func (p *CommentEdit) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Content = _field
+	return nil
+}
+func (p *CommentEdit) ReadField4(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.EditedAt = _field
+	return nil
+}
+
+func (p *CommentEdit) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("CommentEdit"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *CommentEdit) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("id", thrift.I64, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.ID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *CommentEdit) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("comment_id", thrift.I64, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.CommentID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+func (p *CommentEdit) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("content", thrift.STRING, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Content); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+func (p *CommentEdit) writeField4(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("edited_at", thrift.I64, 4); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.EditedAt); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+
+func (p *CommentEdit) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CommentEdit(%+v)", *p)
+
+}
+
+// 私信消息
+type PrivateMessage struct {
+	// 消息ID
+	ID int64 `thrift:"id,1,required" form:"id,required" json:"id,required" query:"id,required"`
+	// 发送者ID
+	SenderID int64 `thrift:"sender_id,2,required" form:"sender_id,required" json:"sender_id,required" query:"sender_id,required"`
+	// 接收者ID
+	ReceiverID int64 `thrift:"receiver_id,3,required" form:"receiver_id,required" json:"receiver_id,required" query:"receiver_id,required"`
+	// 消息内容
+	Content string `thrift:"content,4,required" form:"content,required" json:"content,required" query:"content,required"`
+	// 是否已读
+	IsRead bool `thrift:"is_read,5,required" form:"is_read,required" json:"is_read,required" query:"is_read,required"`
+	// 创建时间
+	CreatedAt int64 `thrift:"created_at,6,required" form:"created_at,required" json:"created_at,required" query:"created_at,required"`
+	// 更新时间
+	UpdatedAt int64 `thrift:"updated_at,7,required" form:"updated_at,required" json:"updated_at,required" query:"updated_at,required"`
+	// 删除时间
+	DeletedAt *int64 `thrift:"deleted_at,8,optional" form:"deleted_at" json:"deleted_at,omitempty" query:"deleted_at"`
+	// 会话内序号，单调递增
+	Seq int64 `thrift:"seq,9,required" form:"seq,required" json:"seq,required" query:"seq,required"`
+	// 回复的消息ID
+	ReplyToID *int64 `thrift:"reply_to_id,10,optional" form:"reply_to_id" json:"reply_to_id,omitempty" query:"reply_to_id"`
+	// 最后编辑时间
+	EditedAt *int64 `thrift:"edited_at,11,optional" form:"edited_at" json:"edited_at,omitempty" query:"edited_at"`
+	// 是否已撤回，撤回后内容为空
+	Recalled *bool `thrift:"recalled,12,optional" form:"recalled" json:"recalled,omitempty" query:"recalled"`
+}
+
+func NewPrivateMessage() *PrivateMessage {
+	return &PrivateMessage{}
+}
+
+func (p *PrivateMessage) InitDefault() {
+}
+
+func (p *PrivateMessage) GetID() (v int64) {
+	return p.ID
+}
+
+func (p *PrivateMessage) GetSenderID() (v int64) {
+	return p.SenderID
+}
+
+func (p *PrivateMessage) GetReceiverID() (v int64) {
+	return p.ReceiverID
+}
+
+func (p *PrivateMessage) GetContent() (v string) {
+	return p.Content
+}
+
+func (p *PrivateMessage) GetIsRead() (v bool) {
+	return p.IsRead
+}
+
+func (p *PrivateMessage) GetCreatedAt() (v int64) {
+	return p.CreatedAt
+}
+
+func (p *PrivateMessage) GetUpdatedAt() (v int64) {
+	return p.UpdatedAt
+}
+
+var PrivateMessage_DeletedAt_DEFAULT int64
+
+func (p *PrivateMessage) GetDeletedAt() (v int64) {
+	if !p.IsSetDeletedAt() {
+		return PrivateMessage_DeletedAt_DEFAULT
+	}
+	return *p.DeletedAt
+}
+
+func (p *PrivateMessage) GetSeq() (v int64) {
+	return p.Seq
+}
+
+var PrivateMessage_ReplyToID_DEFAULT int64
+
+func (p *PrivateMessage) GetReplyToID() (v int64) {
+	if !p.IsSetReplyToID() {
+		return PrivateMessage_ReplyToID_DEFAULT
+	}
+	return *p.ReplyToID
+}
+
+var PrivateMessage_EditedAt_DEFAULT int64
+
+func (p *PrivateMessage) GetEditedAt() (v int64) {
+	if !p.IsSetEditedAt() {
+		return PrivateMessage_EditedAt_DEFAULT
+	}
+	return *p.EditedAt
+}
+
+var PrivateMessage_Recalled_DEFAULT bool
+
+func (p *PrivateMessage) GetRecalled() (v bool) {
+	if !p.IsSetRecalled() {
+		return PrivateMessage_Recalled_DEFAULT
+	}
+	return *p.Recalled
+}
+
+var fieldIDToName_PrivateMessage = map[int16]string{
+	1:  "id",
+	2:  "sender_id",
+	3:  "receiver_id",
+	4:  "content",
+	5:  "is_read",
+	6:  "created_at",
+	7:  "updated_at",
+	8:  "deleted_at",
+	9:  "seq",
+	10: "reply_to_id",
+	11: "edited_at",
+	12: "recalled",
+}
+
+func (p *PrivateMessage) IsSetDeletedAt() bool {
+	return p.DeletedAt != nil
+}
+
+func (p *PrivateMessage) IsSetReplyToID() bool {
+	return p.ReplyToID != nil
+}
+
+func (p *PrivateMessage) IsSetEditedAt() bool {
+	return p.EditedAt != nil
+}
+
+func (p *PrivateMessage) IsSetRecalled() bool {
+	return p.Recalled != nil
+}
+
+func (p *PrivateMessage) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetID bool = false
+	var issetSenderID bool = false
+	var issetReceiverID bool = false
+	var issetContent bool = false
+	var issetIsRead bool = false
+	var issetCreatedAt bool = false
+	var issetUpdatedAt bool = false
+	var issetSeq bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetID = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetSenderID = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetReceiverID = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetContent = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 5:
+			if fieldTypeId == thrift.BOOL {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetIsRead = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 6:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField6(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetCreatedAt = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 7:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField7(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetUpdatedAt = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 8:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField8(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 9:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField9(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetSeq = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 10:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField10(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 11:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField11(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 12:
+			if fieldTypeId == thrift.BOOL {
+				if err = p.ReadField12(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	if !issetID {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetSenderID {
+		fieldId = 2
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetReceiverID {
+		fieldId = 3
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetContent {
+		fieldId = 4
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetIsRead {
+		fieldId = 5
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetCreatedAt {
+		fieldId = 6
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetUpdatedAt {
+		fieldId = 7
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetSeq {
+		fieldId = 9
+		goto RequiredFieldNotSetError
+	}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PrivateMessage[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_PrivateMessage[fieldId]))
+}
+
+func (p *PrivateMessage) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.ID = _field
+	return nil
+}
+func (p *PrivateMessage) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.SenderID = _field
+	return nil
+}
+func (p *PrivateMessage) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.ReceiverID = _field
+	return nil
+}
+func (p *PrivateMessage) ReadField4(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Content = _field
+	return nil
+}
+func (p *PrivateMessage) ReadField5(iprot thrift.TProtocol) error {
+
+	var _field bool
+	if v, err := iprot.ReadBool(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.IsRead = _field
+	return nil
+}
+func (p *PrivateMessage) ReadField6(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.CreatedAt = _field
+	return nil
+}
+func (p *PrivateMessage) ReadField7(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.UpdatedAt = _field
+	return nil
+}
+func (p *PrivateMessage) ReadField8(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.DeletedAt = _field
+	return nil
+}
+func (p *PrivateMessage) ReadField9(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Seq = _field
+	return nil
+}
+func (p *PrivateMessage) ReadField10(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.ReplyToID = _field
+	return nil
+}
+func (p *PrivateMessage) ReadField11(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.EditedAt = _field
+	return nil
+}
+func (p *PrivateMessage) ReadField12(iprot thrift.TProtocol) error {
+
+	var _field *bool
+	if v, err := iprot.ReadBool(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Recalled = _field
+	return nil
+}
+
+func (p *PrivateMessage) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("PrivateMessage"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+		if err = p.writeField6(oprot); err != nil {
+			fieldId = 6
+			goto WriteFieldError
+		}
+		if err = p.writeField7(oprot); err != nil {
+			fieldId = 7
+			goto WriteFieldError
+		}
+		if err = p.writeField8(oprot); err != nil {
+			fieldId = 8
+			goto WriteFieldError
+		}
+		if err = p.writeField9(oprot); err != nil {
+			fieldId = 9
+			goto WriteFieldError
+		}
+		if err = p.writeField10(oprot); err != nil {
+			fieldId = 10
+			goto WriteFieldError
+		}
+		if err = p.writeField11(oprot); err != nil {
+			fieldId = 11
+			goto WriteFieldError
+		}
+		if err = p.writeField12(oprot); err != nil {
+			fieldId = 12
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *PrivateMessage) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("id", thrift.I64, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.ID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *PrivateMessage) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("sender_id", thrift.I64, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.SenderID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+func (p *PrivateMessage) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("receiver_id", thrift.I64, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.ReceiverID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+func (p *PrivateMessage) writeField4(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("content", thrift.STRING, 4); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Content); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+func (p *PrivateMessage) writeField5(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("is_read", thrift.BOOL, 5); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteBool(p.IsRead); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+}
+func (p *PrivateMessage) writeField6(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("created_at", thrift.I64, 6); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.CreatedAt); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
+}
+func (p *PrivateMessage) writeField7(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("updated_at", thrift.I64, 7); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.UpdatedAt); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 end error: ", p), err)
+}
+func (p *PrivateMessage) writeField8(oprot thrift.TProtocol) (err error) {
+	if p.IsSetDeletedAt() {
+		if err = oprot.WriteFieldBegin("deleted_at", thrift.I64, 8); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.DeletedAt); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 end error: ", p), err)
+}
+func (p *PrivateMessage) writeField9(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("seq", thrift.I64, 9); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.Seq); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 9 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 9 end error: ", p), err)
+}
+func (p *PrivateMessage) writeField10(oprot thrift.TProtocol) (err error) {
+	if p.IsSetReplyToID() {
+		if err = oprot.WriteFieldBegin("reply_to_id", thrift.I64, 10); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.ReplyToID); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 10 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 10 end error: ", p), err)
+}
+func (p *PrivateMessage) writeField11(oprot thrift.TProtocol) (err error) {
+	if p.IsSetEditedAt() {
+		if err = oprot.WriteFieldBegin("edited_at", thrift.I64, 11); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.EditedAt); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 11 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 11 end error: ", p), err)
+}
+func (p *PrivateMessage) writeField12(oprot thrift.TProtocol) (err error) {
+	if p.IsSetRecalled() {
+		if err = oprot.WriteFieldBegin("recalled", thrift.BOOL, 12); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteBool(*p.Recalled); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 12 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 12 end error: ", p), err)
+}
+
+func (p *PrivateMessage) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("PrivateMessage(%+v)", *p)
+
+}
+
+// 聊天附件，图片、视频、文件消息的内容为其 JSON
+type ChatAttachment struct {
+	// 访问地址
+	URL string `thrift:"url,1,required" form:"url,required" json:"url,required" query:"url,required"`
+	// 缩略图地址，图片和视频才有
+	ThumbnailURL *string `thrift:"thumbnail_url,2,optional" form:"thumbnail_url" json:"thumbnail_url,omitempty" query:"thumbnail_url"`
+	// 原始文件名
+	Name string `thrift:"name,3,required" form:"name,required" json:"name,required" query:"name,required"`
+	// 文件类型
+	ContentType string `thrift:"content_type,4,required" form:"content_type,required" json:"content_type,required" query:"content_type,required"`
+	// 文件大小（字节）
+	Size int64 `thrift:"size,5,required" form:"size,required" json:"size,required" query:"size,required"`
+}
+
+func NewChatAttachment() *ChatAttachment {
+	return &ChatAttachment{}
+}
+
+func (p *ChatAttachment) InitDefault() {
+}
+
+func (p *ChatAttachment) GetURL() (v string) {
+	return p.URL
+}
+
+var ChatAttachment_ThumbnailURL_DEFAULT string
+
+func (p *ChatAttachment) GetThumbnailURL() (v string) {
+	if !p.IsSetThumbnailURL() {
+		return ChatAttachment_ThumbnailURL_DEFAULT
+	}
+	return *p.ThumbnailURL
+}
+
+func (p *ChatAttachment) GetName() (v string) {
+	return p.Name
+}
+
+func (p *ChatAttachment) GetContentType() (v string) {
+	return p.ContentType
+}
+
+func (p *ChatAttachment) GetSize() (v int64) {
+	return p.Size
+}
+
+var fieldIDToName_ChatAttachment = map[int16]string{
+	1: "url",
+	2: "thumbnail_url",
+	3: "name",
+	4: "content_type",
+	5: "size",
+}
+
+func (p *ChatAttachment) IsSetThumbnailURL() bool {
+	return p.ThumbnailURL != nil
+}
+
+func (p *ChatAttachment) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetURL bool = false
+	var issetName bool = false
+	var issetContentType bool = false
+	var issetSize bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetURL = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetName = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetContentType = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 5:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetSize = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	if !issetURL {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetName {
+		fieldId = 3
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetContentType {
+		fieldId = 4
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetSize {
+		fieldId = 5
+		goto RequiredFieldNotSetError
+	}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ChatAttachment[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_ChatAttachment[fieldId]))
+}
+
+func (p *ChatAttachment) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.URL = _field
+	return nil
+}
+func (p *ChatAttachment) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.ThumbnailURL = _field
+	return nil
+}
+func (p *ChatAttachment) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Name = _field
+	return nil
+}
+func (p *ChatAttachment) ReadField4(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.ContentType = _field
+	return nil
+}
+func (p *ChatAttachment) ReadField5(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Size = _field
+	return nil
+}
+
+func (p *ChatAttachment) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ChatAttachment"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ChatAttachment) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("url", thrift.STRING, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.URL); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *ChatAttachment) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetThumbnailURL() {
+		if err = oprot.WriteFieldBegin("thumbnail_url", thrift.STRING, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.ThumbnailURL); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+func (p *ChatAttachment) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("name", thrift.STRING, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Name); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+func (p *ChatAttachment) writeField4(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("content_type", thrift.STRING, 4); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.ContentType); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+func (p *ChatAttachment) writeField5(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("size", thrift.I64, 5); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.Size); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+}
+
+func (p *ChatAttachment) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ChatAttachment(%+v)", *p)
+
+}
+
+// 聊天室
+type ChatRoom struct {
+	// 聊天室ID
+	ID int64 `thrift:"id,1,required" form:"id,required" json:"id,required" query:"id,required"`
+	// 聊天室名称
+	Name string `thrift:"name,2,required" form:"name,required" json:"name,required" query:"name,required"`
+	// 创建者ID
+	CreatorID int64 `thrift:"creator_id,3,required" form:"creator_id,required" json:"creator_id,required" query:"creator_id,required"`
+	// 类型：1=私聊,2=群聊
+	Type int8 `thrift:"type,4,required" form:"type,required" json:"type,required" query:"type,required"`
+	// 成员列表
+	Members []*ChatRoomMember `thrift:"members,8,optional" form:"members" json:"members,omitempty" query:"members"`
+}
+
+func NewChatRoom() *ChatRoom {
+	return &ChatRoom{}
+}
+
+func (p *ChatRoom) InitDefault() {
+}
+
+func (p *ChatRoom) GetID() (v int64) {
+	return p.ID
+}
+
+func (p *ChatRoom) GetName() (v string) {
+	return p.Name
+}
+
+func (p *ChatRoom) GetCreatorID() (v int64) {
+	return p.CreatorID
+}
+
+func (p *ChatRoom) GetType() (v int8) {
+	return p.Type
+}
+
+var ChatRoom_Members_DEFAULT []*ChatRoomMember
+
+func (p *ChatRoom) GetMembers() (v []*ChatRoomMember) {
+	if !p.IsSetMembers() {
+		return ChatRoom_Members_DEFAULT
+	}
+	return p.Members
+}
+
+var fieldIDToName_ChatRoom = map[int16]string{
+	1: "id",
+	2: "name",
+	3: "creator_id",
+	4: "type",
+	8: "members",
+}
+
+func (p *ChatRoom) IsSetMembers() bool {
+	return p.Members != nil
+}
+
+func (p *ChatRoom) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetID bool = false
+	var issetName bool = false
+	var issetCreatorID bool = false
+	var issetType bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetID = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetName = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetCreatorID = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.BYTE {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetType = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 8:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField8(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	if !issetID {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetName {
+		fieldId = 2
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetCreatorID {
+		fieldId = 3
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetType {
+		fieldId = 4
+		goto RequiredFieldNotSetError
+	}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ChatRoom[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_ChatRoom[fieldId]))
+}
+
+func (p *ChatRoom) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.ID = _field
+	return nil
+}
+func (p *ChatRoom) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Name = _field
+	return nil
+}
+func (p *ChatRoom) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.CreatorID = _field
+	return nil
+}
+func (p *ChatRoom) ReadField4(iprot thrift.TProtocol) error {
+
+	var _field int8
+	if v, err := iprot.ReadByte(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Type = _field
+	return nil
+}
+func (p *ChatRoom) ReadField8(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*ChatRoomMember, 0, size)
+	values := make([]ChatRoomMember, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.Members = _field
+	return nil
+}
+
+func (p *ChatRoom) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ChatRoom"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField8(oprot); err != nil {
+			fieldId = 8
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ChatRoom) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("id", thrift.I64, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.ID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *ChatRoom) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("name", thrift.STRING, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Name); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+func (p *ChatRoom) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("creator_id", thrift.I64, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.CreatorID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+func (p *ChatRoom) writeField4(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("type", thrift.BYTE, 4); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteByte(p.Type); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+func (p *ChatRoom) writeField8(oprot thrift.TProtocol) (err error) {
+	if p.IsSetMembers() {
+		if err = oprot.WriteFieldBegin("members", thrift.LIST, 8); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Members)); err != nil {
+			return err
+		}
+		for _, v := range p.Members {
+			if err := v.Write(oprot); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 end error: ", p), err)
+}
+
+func (p *ChatRoom) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ChatRoom(%+v)", *p)
+
+}
+
+// 聊天室成员
+type ChatRoomMember struct {
+	// 成员ID
+	ID int64 `thrift:"id,1,required" form:"id,required" json:"id,required" query:"id,required"`
+	// 聊天室ID
+	RoomID int64 `thrift:"room_id,2,required" form:"room_id,required" json:"room_id,required" query:"room_id,required"`
+	// 用户ID
+	UserID int64 `thrift:"user_id,3,required" form:"user_id,required" json:"user_id,required" query:"user_id,required"`
+	// 在群里的昵称
+	Nickname *string `thrift:"nickname,4,optional" form:"nickname" json:"nickname,omitempty" query:"nickname"`
+	// 角色：0=普通成员,1=管理员,2=群主
+	Role int8 `thrift:"role,5,required" form:"role,required" json:"role,required" query:"role,required"`
+	// 创建时间
+	CreatedAt int64 `thrift:"created_at,6,required" form:"created_at,required" json:"created_at,required" query:"created_at,required"`
+	// 更新时间
+	UpdatedAt int64 `thrift:"updated_at,7,required" form:"updated_at,required" json:"updated_at,required" query:"updated_at,required"`
+	// 删除时间
+	DeletedAt *int64 `thrift:"deleted_at,8,optional" form:"deleted_at" json:"deleted_at,omitempty" query:"deleted_at"`
+	// 禁言截止时间，0 表示未禁言
+	MutedUntil *int64 `thrift:"muted_until,9,optional" form:"muted_until" json:"muted_until,omitempty" query:"muted_until"`
+}
+
+func NewChatRoomMember() *ChatRoomMember {
+	return &ChatRoomMember{}
+}
+
+func (p *ChatRoomMember) InitDefault() {
+}
+
+func (p *ChatRoomMember) GetID() (v int64) {
+	return p.ID
+}
+
+func (p *ChatRoomMember) GetRoomID() (v int64) {
+	return p.RoomID
+}
+
+func (p *ChatRoomMember) GetUserID() (v int64) {
+	return p.UserID
+}
+
+var ChatRoomMember_Nickname_DEFAULT string
+
+func (p *ChatRoomMember) GetNickname() (v string) {
+	if !p.IsSetNickname() {
+		return ChatRoomMember_Nickname_DEFAULT
+	}
+	return *p.Nickname
+}
+
+func (p *ChatRoomMember) GetRole() (v int8) {
+	return p.Role
+}
+
+func (p *ChatRoomMember) GetCreatedAt() (v int64) {
+	return p.CreatedAt
+}
+
+func (p *ChatRoomMember) GetUpdatedAt() (v int64) {
+	return p.UpdatedAt
+}
+
+var ChatRoomMember_DeletedAt_DEFAULT int64
+
+func (p *ChatRoomMember) GetDeletedAt() (v int64) {
+	if !p.IsSetDeletedAt() {
+		return ChatRoomMember_DeletedAt_DEFAULT
+	}
+	return *p.DeletedAt
+}
+
+var ChatRoomMember_MutedUntil_DEFAULT int64
+
+func (p *ChatRoomMember) GetMutedUntil() (v int64) {
+	if !p.IsSetMutedUntil() {
+		return ChatRoomMember_MutedUntil_DEFAULT
+	}
+	return *p.MutedUntil
+}
+
+var fieldIDToName_ChatRoomMember = map[int16]string{
+	1: "id",
+	2: "room_id",
+	3: "user_id",
+	4: "nickname",
+	5: "role",
+	6: "created_at",
+	7: "updated_at",
+	8: "deleted_at",
+	9: "muted_until",
+}
+
+func (p *ChatRoomMember) IsSetNickname() bool {
+	return p.Nickname != nil
+}
+
+func (p *ChatRoomMember) IsSetDeletedAt() bool {
+	return p.DeletedAt != nil
+}
+
+func (p *ChatRoomMember) IsSetMutedUntil() bool {
+	return p.MutedUntil != nil
+}
+
+func (p *ChatRoomMember) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetID bool = false
+	var issetRoomID bool = false
+	var issetUserID bool = false
+	var issetRole bool = false
+	var issetCreatedAt bool = false
+	var issetUpdatedAt bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetID = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetRoomID = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetUserID = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 5:
+			if fieldTypeId == thrift.BYTE {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetRole = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 6:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField6(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetCreatedAt = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 7:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField7(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetUpdatedAt = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 8:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField8(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 9:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField9(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	if !issetID {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetRoomID {
+		fieldId = 2
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetUserID {
+		fieldId = 3
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetRole {
+		fieldId = 5
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetCreatedAt {
+		fieldId = 6
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetUpdatedAt {
+		fieldId = 7
+		goto RequiredFieldNotSetError
+	}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ChatRoomMember[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_ChatRoomMember[fieldId]))
+}
+
+func (p *ChatRoomMember) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.ID = _field
+	return nil
+}
+func (p *ChatRoomMember) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.RoomID = _field
+	return nil
+}
+func (p *ChatRoomMember) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.UserID = _field
+	return nil
+}
+func (p *ChatRoomMember) ReadField4(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Nickname = _field
+	return nil
+}
+func (p *ChatRoomMember) ReadField5(iprot thrift.TProtocol) error {
+
+	var _field int8
+	if v, err := iprot.ReadByte(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Role = _field
+	return nil
+}
+func (p *ChatRoomMember) ReadField6(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.CreatedAt = _field
+	return nil
+}
+func (p *ChatRoomMember) ReadField7(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.UpdatedAt = _field
+	return nil
+}
+func (p *ChatRoomMember) ReadField8(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.DeletedAt = _field
+	return nil
+}
+func (p *ChatRoomMember) ReadField9(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.MutedUntil = _field
+	return nil
+}
+
+func (p *ChatRoomMember) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ChatRoomMember"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+		if err = p.writeField6(oprot); err != nil {
+			fieldId = 6
+			goto WriteFieldError
+		}
+		if err = p.writeField7(oprot); err != nil {
+			fieldId = 7
+			goto WriteFieldError
+		}
+		if err = p.writeField8(oprot); err != nil {
+			fieldId = 8
+			goto WriteFieldError
+		}
+		if err = p.writeField9(oprot); err != nil {
+			fieldId = 9
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ChatRoomMember) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("id", thrift.I64, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.ID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *ChatRoomMember) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("room_id", thrift.I64, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.RoomID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+func (p *ChatRoomMember) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("user_id", thrift.I64, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.UserID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+func (p *ChatRoomMember) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetNickname() {
+		if err = oprot.WriteFieldBegin("nickname", thrift.STRING, 4); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Nickname); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+func (p *ChatRoomMember) writeField5(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("role", thrift.BYTE, 5); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteByte(p.Role); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+}
+func (p *ChatRoomMember) writeField6(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("created_at", thrift.I64, 6); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.CreatedAt); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
+}
+func (p *ChatRoomMember) writeField7(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("updated_at", thrift.I64, 7); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.UpdatedAt); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 end error: ", p), err)
+}
+func (p *ChatRoomMember) writeField8(oprot thrift.TProtocol) (err error) {
+	if p.IsSetDeletedAt() {
+		if err = oprot.WriteFieldBegin("deleted_at", thrift.I64, 8); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.DeletedAt); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 end error: ", p), err)
+}
+func (p *ChatRoomMember) writeField9(oprot thrift.TProtocol) (err error) {
+	if p.IsSetMutedUntil() {
+		if err = oprot.WriteFieldBegin("muted_until", thrift.I64, 9); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.MutedUntil); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 9 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 9 end error: ", p), err)
+}
+
+func (p *ChatRoomMember) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ChatRoomMember(%+v)", *p)
+
+}
+
+// 聊天室邀请链接
+type ChatRoomInvite struct {
+	// 邀请码
+	Code string `thrift:"code,1,required" form:"code,required" json:"code,required" query:"code,required"`
+	// 聊天室ID
+	RoomID int64 `thrift:"room_id,2,required" form:"room_id,required" json:"room_id,required" query:"room_id,required"`
+	// 创建者ID
+	InviterID int64 `thrift:"inviter_id,3,required" form:"inviter_id,required" json:"inviter_id,required" query:"inviter_id,required"`
+	// 过期时间
+	ExpiresAt int64 `thrift:"expires_at,4,required" form:"expires_at,required" json:"expires_at,required" query:"expires_at,required"`
+}
+
+func NewChatRoomInvite() *ChatRoomInvite {
+	return &ChatRoomInvite{}
+}
+
+func (p *ChatRoomInvite) InitDefault() {
+}
+
+func (p *ChatRoomInvite) GetCode() (v string) {
+	return p.Code
+}
+
+func (p *ChatRoomInvite) GetRoomID() (v int64) {
+	return p.RoomID
+}
+
+func (p *ChatRoomInvite) GetInviterID() (v int64) {
+	return p.InviterID
+}
+
+func (p *ChatRoomInvite) GetExpiresAt() (v int64) {
+	return p.ExpiresAt
+}
+
+var fieldIDToName_ChatRoomInvite = map[int16]string{
+	1: "code",
+	2: "room_id",
+	3: "inviter_id",
+	4: "expires_at",
+}
+
+func (p *ChatRoomInvite) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetCode bool = false
+	var issetRoomID bool = false
+	var issetInviterID bool = false
+	var issetExpiresAt bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetCode = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetRoomID = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetInviterID = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetExpiresAt = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	if !issetCode {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetRoomID {
+		fieldId = 2
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetInviterID {
+		fieldId = 3
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetExpiresAt {
+		fieldId = 4
+		goto RequiredFieldNotSetError
+	}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ChatRoomInvite[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_ChatRoomInvite[fieldId]))
+}
+
+func (p *ChatRoomInvite) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Code = _field
+	return nil
+}
+func (p *ChatRoomInvite) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.RoomID = _field
+	return nil
+}
+func (p *ChatRoomInvite) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.InviterID = _field
+	return nil
+}
+func (p *ChatRoomInvite) ReadField4(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.ExpiresAt = _field
+	return nil
+}
+
+func (p *ChatRoomInvite) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ChatRoomInvite"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ChatRoomInvite) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("code", thrift.STRING, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Code); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *ChatRoomInvite) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("room_id", thrift.I64, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.RoomID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+func (p *ChatRoomInvite) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("inviter_id", thrift.I64, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.InviterID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+func (p *ChatRoomInvite) writeField4(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("expires_at", thrift.I64, 4); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.ExpiresAt); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+
+func (p *ChatRoomInvite) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ChatRoomInvite(%+v)", *p)
+
+}
+
+// 会话已读游标，peer_id 与 room_id 二选一
+type ReadCursor struct {
+	// 私信对方ID
+	PeerID *int64 `thrift:"peer_id,1,optional" form:"peer_id" json:"peer_id,omitempty" query:"peer_id"`
+	// 聊天室ID
+	RoomID *int64 `thrift:"room_id,2,optional" form:"room_id" json:"room_id,omitempty" query:"room_id"`
+	// 已读到的会话序号，标记已读时为 0 表示读到最新
+	ReadSeq int64 `thrift:"read_seq,3,required" form:"read_seq,required" json:"read_seq,required" query:"read_seq,required"`
+}
+
+func NewReadCursor() *ReadCursor {
+	return &ReadCursor{}
+}
+
+func (p *ReadCursor) InitDefault() {
+}
+
+var ReadCursor_PeerID_DEFAULT int64
+
+func (p *ReadCursor) GetPeerID() (v int64) {
+	if !p.IsSetPeerID() {
+		return ReadCursor_PeerID_DEFAULT
+	}
+	return *p.PeerID
+}
+
+var ReadCursor_RoomID_DEFAULT int64
+
+func (p *ReadCursor) GetRoomID() (v int64) {
+	if !p.IsSetRoomID() {
+		return ReadCursor_RoomID_DEFAULT
+	}
+	return *p.RoomID
+}
+
+func (p *ReadCursor) GetReadSeq() (v int64) {
+	return p.ReadSeq
+}
+
+var fieldIDToName_ReadCursor = map[int16]string{
+	1: "peer_id",
+	2: "room_id",
+	3: "read_seq",
+}
+
+func (p *ReadCursor) IsSetPeerID() bool {
+	return p.PeerID != nil
+}
+
+func (p *ReadCursor) IsSetRoomID() bool {
+	return p.RoomID != nil
+}
+
+func (p *ReadCursor) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetReadSeq bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetReadSeq = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	if !issetReadSeq {
+		fieldId = 3
+		goto RequiredFieldNotSetError
+	}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ReadCursor[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_ReadCursor[fieldId]))
+}
+
+func (p *ReadCursor) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.PeerID = _field
+	return nil
+}
+func (p *ReadCursor) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.RoomID = _field
+	return nil
+}
+func (p *ReadCursor) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.ReadSeq = _field
+	return nil
+}
+
+func (p *ReadCursor) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ReadCursor"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ReadCursor) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetPeerID() {
+		if err = oprot.WriteFieldBegin("peer_id", thrift.I64, 1); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.PeerID); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *ReadCursor) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetRoomID() {
+		if err = oprot.WriteFieldBegin("room_id", thrift.I64, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.RoomID); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+func (p *ReadCursor) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("read_seq", thrift.I64, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.ReadSeq); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *ReadCursor) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ReadCursor(%+v)", *p)
+
+}
+
+// 会话未读数，peer_id 与 room_id 二选一
+type UnreadCount struct {
+	// 私信对方ID
+	PeerID *int64 `thrift:"peer_id,1,optional" form:"peer_id" json:"peer_id,omitempty" query:"peer_id"`
+	// 聊天室ID
+	RoomID *int64 `thrift:"room_id,2,optional" form:"room_id" json:"room_id,omitempty" query:"room_id"`
+	// 未读消息数
+	Count int64 `thrift:"count,3,required" form:"count,required" json:"count,required" query:"count,required"`
+}
+
+func NewUnreadCount() *UnreadCount {
+	return &UnreadCount{}
+}
+
+func (p *UnreadCount) InitDefault() {
+}
+
+var UnreadCount_PeerID_DEFAULT int64
+
+func (p *UnreadCount) GetPeerID() (v int64) {
+	if !p.IsSetPeerID() {
+		return UnreadCount_PeerID_DEFAULT
+	}
+	return *p.PeerID
+}
+
+var UnreadCount_RoomID_DEFAULT int64
+
+func (p *UnreadCount) GetRoomID() (v int64) {
+	if !p.IsSetRoomID() {
+		return UnreadCount_RoomID_DEFAULT
+	}
+	return *p.RoomID
+}
+
+func (p *UnreadCount) GetCount() (v int64) {
+	return p.Count
+}
+
+var fieldIDToName_UnreadCount = map[int16]string{
+	1: "peer_id",
+	2: "room_id",
+	3: "count",
+}
+
+func (p *UnreadCount) IsSetPeerID() bool {
+	return p.PeerID != nil
+}
+
+func (p *UnreadCount) IsSetRoomID() bool {
+	return p.RoomID != nil
+}
+
+func (p *UnreadCount) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetCount bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetCount = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	if !issetCount {
+		fieldId = 3
+		goto RequiredFieldNotSetError
+	}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UnreadCount[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_UnreadCount[fieldId]))
+}
+
+func (p *UnreadCount) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.PeerID = _field
+	return nil
+}
+func (p *UnreadCount) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.RoomID = _field
+	return nil
+}
+func (p *UnreadCount) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Count = _field
+	return nil
+}
+
+func (p *UnreadCount) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("UnreadCount"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *UnreadCount) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetPeerID() {
+		if err = oprot.WriteFieldBegin("peer_id", thrift.I64, 1); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.PeerID); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *UnreadCount) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetRoomID() {
+		if err = oprot.WriteFieldBegin("room_id", thrift.I64, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.RoomID); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+func (p *UnreadCount) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("count", thrift.I64, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.Count); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *UnreadCount) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UnreadCount(%+v)", *p)
+
+}
+
+// 收件箱会话，peer_id 与 room_id 二选一
+type Conversation struct {
+	// 私信对方ID
+	PeerID *int64 `thrift:"peer_id,1,optional" form:"peer_id" json:"peer_id,omitempty" query:"peer_id"`
+	// 聊天室ID
+	RoomID *int64 `thrift:"room_id,2,optional" form:"room_id" json:"room_id,omitempty" query:"room_id"`
+	// 聊天室名称，私信为空
+	Name *string `thrift:"name,3,optional" form:"name" json:"name,omitempty" query:"name"`
+	// 最后一条消息ID
+	LastMessageID int64 `thrift:"last_message_id,4,required" form:"last_message_id,required" json:"last_message_id,required" query:"last_message_id,required"`
+	// 最后一条消息的发送者ID
+	LastSenderID int64 `thrift:"last_sender_id,5,required" form:"last_sender_id,required" json:"last_sender_id,required" query:"last_sender_id,required"`
+	// 最后一条消息的类型
+	LastMessageType int8 `thrift:"last_message_type,6,required" form:"last_message_type,required" json:"last_message_type,required" query:"last_message_type,required"`
+	// 最后一条消息的预览文本
+	LastPreview string `thrift:"last_preview,7,required" form:"last_preview,required" json:"last_preview,required" query:"last_preview,required"`
+	// 最后活跃时间，毫秒
+	LastActiveAt int64 `thrift:"last_active_at,8,required" form:"last_active_at,required" json:"last_active_at,required" query:"last_active_at,required"`
+	// 未读消息数
+	UnreadCount int64 `thrift:"unread_count,9,required" form:"unread_count,required" json:"unread_count,required" query:"unread_count,required"`
+	// 是否免打扰
+	Muted bool `thrift:"muted,10,required" form:"muted,required" json:"muted,required" query:"muted,required"`
+	// 是否置顶
+	Pinned bool `thrift:"pinned,11,required" form:"pinned,required" json:"pinned,required" query:"pinned,required"`
+	// 是否归档
+	Archived bool `thrift:"archived,12,required" form:"archived,required" json:"archived,required" query:"archived,required"`
+}
+
+func NewConversation() *Conversation {
+	return &Conversation{}
+}
+
+func (p *Conversation) InitDefault() {
+}
+
+var Conversation_PeerID_DEFAULT int64
+
+func (p *Conversation) GetPeerID() (v int64) {
+	if !p.IsSetPeerID() {
+		return Conversation_PeerID_DEFAULT
+	}
+	return *p.PeerID
+}
+
+var Conversation_RoomID_DEFAULT int64
+
+func (p *Conversation) GetRoomID() (v int64) {
+	if !p.IsSetRoomID() {
+		return Conversation_RoomID_DEFAULT
+	}
+	return *p.RoomID
+}
+
+var Conversation_Name_DEFAULT string
+
+func (p *Conversation) GetName() (v string) {
+	if !p.IsSetName() {
+		return Conversation_Name_DEFAULT
+	}
+	return *p.Name
+}
+
+func (p *Conversation) GetLastMessageID() (v int64) {
+	return p.LastMessageID
+}
+
+func (p *Conversation) GetLastSenderID() (v int64) {
+	return p.LastSenderID
+}
+
+func (p *Conversation) GetLastMessageType() (v int8) {
+	return p.LastMessageType
+}
+
+func (p *Conversation) GetLastPreview() (v string) {
+	return p.LastPreview
+}
+
+func (p *Conversation) GetLastActiveAt() (v int64) {
+	return p.LastActiveAt
+}
+
+func (p *Conversation) GetUnreadCount() (v int64) {
+	return p.UnreadCount
+}
+
+func (p *Conversation) GetMuted() (v bool) {
+	return p.Muted
+}
+
+func (p *Conversation) GetPinned() (v bool) {
+	return p.Pinned
+}
+
+func (p *Conversation) GetArchived() (v bool) {
+	return p.Archived
+}
+
+var fieldIDToName_Conversation = map[int16]string{
+	1:  "peer_id",
+	2:  "room_id",
+	3:  "name",
+	4:  "last_message_id",
+	5:  "last_sender_id",
+	6:  "last_message_type",
+	7:  "last_preview",
+	8:  "last_active_at",
+	9:  "unread_count",
+	10: "muted",
+	11: "pinned",
+	12: "archived",
+}
+
+func (p *Conversation) IsSetPeerID() bool {
+	return p.PeerID != nil
+}
+
+func (p *Conversation) IsSetRoomID() bool {
+	return p.RoomID != nil
+}
+
+func (p *Conversation) IsSetName() bool {
+	return p.Name != nil
+}
+
+func (p *Conversation) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetLastMessageID bool = false
+	var issetLastSenderID bool = false
+	var issetLastMessageType bool = false
+	var issetLastPreview bool = false
+	var issetLastActiveAt bool = false
+	var issetUnreadCount bool = false
+	var issetMuted bool = false
+	var issetPinned bool = false
+	var issetArchived bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetLastMessageID = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 5:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetLastSenderID = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 6:
+			if fieldTypeId == thrift.BYTE {
+				if err = p.ReadField6(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetLastMessageType = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 7:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField7(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetLastPreview = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 8:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField8(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetLastActiveAt = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 9:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField9(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetUnreadCount = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 10:
+			if fieldTypeId == thrift.BOOL {
+				if err = p.ReadField10(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetMuted = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 11:
+			if fieldTypeId == thrift.BOOL {
+				if err = p.ReadField11(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetPinned = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 12:
+			if fieldTypeId == thrift.BOOL {
+				if err = p.ReadField12(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetArchived = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	if !issetLastMessageID {
+		fieldId = 4
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetLastSenderID {
+		fieldId = 5
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetLastMessageType {
+		fieldId = 6
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetLastPreview {
+		fieldId = 7
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetLastActiveAt {
+		fieldId = 8
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetUnreadCount {
+		fieldId = 9
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetMuted {
+		fieldId = 10
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetPinned {
+		fieldId = 11
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetArchived {
+		fieldId = 12
+		goto RequiredFieldNotSetError
+	}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_Conversation[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_Conversation[fieldId]))
+}
+
+func (p *Conversation) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.PeerID = _field
+	return nil
+}
+func (p *Conversation) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.RoomID = _field
+	return nil
+}
+func (p *Conversation) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Name = _field
+	return nil
+}
+func (p *Conversation) ReadField4(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.LastMessageID = _field
+	return nil
+}
+func (p *Conversation) ReadField5(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.LastSenderID = _field
+	return nil
+}
+func (p *Conversation) ReadField6(iprot thrift.TProtocol) error {
+
+	var _field int8
+	if v, err := iprot.ReadByte(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.LastMessageType = _field
+	return nil
+}
+func (p *Conversation) ReadField7(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.LastPreview = _field
+	return nil
+}
+func (p *Conversation) ReadField8(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.LastActiveAt = _field
+	return nil
+}
+func (p *Conversation) ReadField9(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.UnreadCount = _field
+	return nil
+}
+func (p *Conversation) ReadField10(iprot thrift.TProtocol) error {
+
+	var _field bool
+	if v, err := iprot.ReadBool(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Muted = _field
+	return nil
+}
+func (p *Conversation) ReadField11(iprot thrift.TProtocol) error {
+
+	var _field bool
+	if v, err := iprot.ReadBool(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Pinned = _field
+	return nil
+}
+func (p *Conversation) ReadField12(iprot thrift.TProtocol) error {
+
+	var _field bool
+	if v, err := iprot.ReadBool(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Archived = _field
+	return nil
+}
+
+func (p *Conversation) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("Conversation"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+		if err = p.writeField6(oprot); err != nil {
+			fieldId = 6
+			goto WriteFieldError
+		}
+		if err = p.writeField7(oprot); err != nil {
+			fieldId = 7
+			goto WriteFieldError
+		}
+		if err = p.writeField8(oprot); err != nil {
+			fieldId = 8
+			goto WriteFieldError
+		}
+		if err = p.writeField9(oprot); err != nil {
+			fieldId = 9
+			goto WriteFieldError
+		}
+		if err = p.writeField10(oprot); err != nil {
+			fieldId = 10
+			goto WriteFieldError
+		}
+		if err = p.writeField11(oprot); err != nil {
+			fieldId = 11
+			goto WriteFieldError
+		}
+		if err = p.writeField12(oprot); err != nil {
+			fieldId = 12
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *Conversation) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetPeerID() {
+		if err = oprot.WriteFieldBegin("peer_id", thrift.I64, 1); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.PeerID); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *Conversation) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetRoomID() {
+		if err = oprot.WriteFieldBegin("room_id", thrift.I64, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.RoomID); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+func (p *Conversation) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetName() {
+		if err = oprot.WriteFieldBegin("name", thrift.STRING, 3); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Name); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+func (p *Conversation) writeField4(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("last_message_id", thrift.I64, 4); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.LastMessageID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+func (p *Conversation) writeField5(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("last_sender_id", thrift.I64, 5); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.LastSenderID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+}
+func (p *Conversation) writeField6(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("last_message_type", thrift.BYTE, 6); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteByte(p.LastMessageType); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
+}
+func (p *Conversation) writeField7(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("last_preview", thrift.STRING, 7); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.LastPreview); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 end error: ", p), err)
+}
+func (p *Conversation) writeField8(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("last_active_at", thrift.I64, 8); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.LastActiveAt); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 end error: ", p), err)
+}
+func (p *Conversation) writeField9(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("unread_count", thrift.I64, 9); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.UnreadCount); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 9 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 9 end error: ", p), err)
+}
+func (p *Conversation) writeField10(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("muted", thrift.BOOL, 10); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteBool(p.Muted); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 10 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 10 end error: ", p), err)
+}
+func (p *Conversation) writeField11(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("pinned", thrift.BOOL, 11); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteBool(p.Pinned); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 11 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 11 end error: ", p), err)
+}
+func (p *Conversation) writeField12(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("archived", thrift.BOOL, 12); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteBool(p.Archived); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 12 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 12 end error: ", p), err)
+}
+
+func (p *Conversation) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("Conversation(%+v)", *p)
+
+}
+
+// 聊天消息
+type ChatMessage struct {
+	// 消息ID
+	ID int64 `thrift:"id,1,required" form:"id,required" json:"id,required" query:"id,required"`
+	// 聊天室ID
+	RoomID int64 `thrift:"room_id,2,required" form:"room_id,required" json:"room_id,required" query:"room_id,required"`
+	// 发送者ID
+	SenderID int64 `thrift:"sender_id,3,required" form:"sender_id,required" json:"sender_id,required" query:"sender_id,required"`
+	// 消息内容
+	Content string `thrift:"content,4,required" form:"content,required" json:"content,required" query:"content,required"`
+	// 消息类型：0=文本,1=图片,2=视频,3=文件,4=系统,7=视频分享卡片
+	Type int8 `thrift:"type,5,required" form:"type,required" json:"type,required" query:"type,required"`
+	// 创建时间
+	CreatedAt int64 `thrift:"created_at,6,required" form:"created_at,required" json:"created_at,required" query:"created_at,required"`
+	// 更新时间
+	UpdatedAt int64 `thrift:"updated_at,7,required" form:"updated_at,required" json:"updated_at,required" query:"updated_at,required"`
+	// 删除时间
+	DeletedAt *int64 `thrift:"deleted_at,8,optional" form:"deleted_at" json:"deleted_at,omitempty" query:"deleted_at"`
+	// 会话内序号，单调递增
+	Seq int64 `thrift:"seq,9,required" form:"seq,required" json:"seq,required" query:"seq,required"`
+	// 回复的消息ID
+	ReplyToID *int64 `thrift:"reply_to_id,10,optional" form:"reply_to_id" json:"reply_to_id,omitempty" query:"reply_to_id"`
+	// 最后编辑时间
+	EditedAt *int64 `thrift:"edited_at,11,optional" form:"edited_at" json:"edited_at,omitempty" query:"edited_at"`
+	// 是否已撤回，撤回后内容为空
+	Recalled *bool `thrift:"recalled,12,optional" form:"recalled" json:"recalled,omitempty" query:"recalled"`
+}
+
+func NewChatMessage() *ChatMessage {
+	return &ChatMessage{}
+}
+
+func (p *ChatMessage) InitDefault() {
+}
+
+func (p *ChatMessage) GetID() (v int64) {
+	return p.ID
+}
+
+func (p *ChatMessage) GetRoomID() (v int64) {
+	return p.RoomID
+}
+
+func (p *ChatMessage) GetSenderID() (v int64) {
+	return p.SenderID
+}
+
+func (p *ChatMessage) GetContent() (v string) {
+	return p.Content
+}
+
+func (p *ChatMessage) GetType() (v int8) {
+	return p.Type
+}
+
+func (p *ChatMessage) GetCreatedAt() (v int64) {
+	return p.CreatedAt
+}
+
+func (p *ChatMessage) GetUpdatedAt() (v int64) {
+	return p.UpdatedAt
+}
+
+var ChatMessage_DeletedAt_DEFAULT int64
+
+func (p *ChatMessage) GetDeletedAt() (v int64) {
+	if !p.IsSetDeletedAt() {
+		return ChatMessage_DeletedAt_DEFAULT
+	}
+	return *p.DeletedAt
+}
+
+func (p *ChatMessage) GetSeq() (v int64) {
+	return p.Seq
+}
+
+var ChatMessage_ReplyToID_DEFAULT int64
+
+func (p *ChatMessage) GetReplyToID() (v int64) {
+	if !p.IsSetReplyToID() {
+		return ChatMessage_ReplyToID_DEFAULT
+	}
+	return *p.ReplyToID
+}
+
+var ChatMessage_EditedAt_DEFAULT int64
+
+func (p *ChatMessage) GetEditedAt() (v int64) {
+	if !p.IsSetEditedAt() {
+		return ChatMessage_EditedAt_DEFAULT
+	}
+	return *p.EditedAt
+}
+
+var ChatMessage_Recalled_DEFAULT bool
+
+func (p *ChatMessage) GetRecalled() (v bool) {
+	if !p.IsSetRecalled() {
+		return ChatMessage_Recalled_DEFAULT
+	}
+	return *p.Recalled
+}
+
+var fieldIDToName_ChatMessage = map[int16]string{
+	1:  "id",
+	2:  "room_id",
+	3:  "sender_id",
+	4:  "content",
+	5:  "type",
+	6:  "created_at",
+	7:  "updated_at",
+	8:  "deleted_at",
+	9:  "seq",
+	10: "reply_to_id",
+	11: "edited_at",
+	12: "recalled",
+}
+
+func (p *ChatMessage) IsSetDeletedAt() bool {
+	return p.DeletedAt != nil
+}
+
+func (p *ChatMessage) IsSetReplyToID() bool {
+	return p.ReplyToID != nil
+}
+
+func (p *ChatMessage) IsSetEditedAt() bool {
+	return p.EditedAt != nil
+}
+
+func (p *ChatMessage) IsSetRecalled() bool {
+	return p.Recalled != nil
+}
+
+func (p *ChatMessage) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetID bool = false
+	var issetRoomID bool = false
+	var issetSenderID bool = false
+	var issetContent bool = false
+	var issetType bool = false
+	var issetCreatedAt bool = false
+	var issetUpdatedAt bool = false
+	var issetSeq bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetID = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetRoomID = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetSenderID = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetContent = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 5:
+			if fieldTypeId == thrift.BYTE {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetType = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 6:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField6(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetCreatedAt = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 7:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField7(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetUpdatedAt = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 8:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField8(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 9:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField9(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetSeq = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 10:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField10(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 11:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField11(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 12:
+			if fieldTypeId == thrift.BOOL {
+				if err = p.ReadField12(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	if !issetID {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetRoomID {
+		fieldId = 2
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetSenderID {
+		fieldId = 3
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetContent {
+		fieldId = 4
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetType {
+		fieldId = 5
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetCreatedAt {
+		fieldId = 6
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetUpdatedAt {
+		fieldId = 7
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetSeq {
+		fieldId = 9
+		goto RequiredFieldNotSetError
+	}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ChatMessage[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_ChatMessage[fieldId]))
+}
+
+func (p *ChatMessage) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.ID = _field
+	return nil
+}
+func (p *ChatMessage) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.RoomID = _field
+	return nil
+}
+func (p *ChatMessage) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.SenderID = _field
+	return nil
+}
+func (p *ChatMessage) ReadField4(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Content = _field
+	return nil
+}
+func (p *ChatMessage) ReadField5(iprot thrift.TProtocol) error {
+
+	var _field int8
+	if v, err := iprot.ReadByte(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Type = _field
+	return nil
+}
+func (p *ChatMessage) ReadField6(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.CreatedAt = _field
+	return nil
+}
+func (p *ChatMessage) ReadField7(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.UpdatedAt = _field
+	return nil
+}
+func (p *ChatMessage) ReadField8(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.DeletedAt = _field
+	return nil
+}
+func (p *ChatMessage) ReadField9(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Seq = _field
+	return nil
+}
+func (p *ChatMessage) ReadField10(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.ReplyToID = _field
+	return nil
+}
+func (p *ChatMessage) ReadField11(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.EditedAt = _field
+	return nil
+}
+func (p *ChatMessage) ReadField12(iprot thrift.TProtocol) error {
+
+	var _field *bool
+	if v, err := iprot.ReadBool(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Recalled = _field
+	return nil
+}
+
+func (p *ChatMessage) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ChatMessage"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+		if err = p.writeField6(oprot); err != nil {
+			fieldId = 6
+			goto WriteFieldError
+		}
+		if err = p.writeField7(oprot); err != nil {
+			fieldId = 7
+			goto WriteFieldError
+		}
+		if err = p.writeField8(oprot); err != nil {
+			fieldId = 8
+			goto WriteFieldError
+		}
+		if err = p.writeField9(oprot); err != nil {
+			fieldId = 9
+			goto WriteFieldError
+		}
+		if err = p.writeField10(oprot); err != nil {
+			fieldId = 10
+			goto WriteFieldError
+		}
+		if err = p.writeField11(oprot); err != nil {
+			fieldId = 11
+			goto WriteFieldError
+		}
+		if err = p.writeField12(oprot); err != nil {
+			fieldId = 12
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ChatMessage) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("id", thrift.I64, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.ID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *ChatMessage) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("room_id", thrift.I64, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.RoomID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+func (p *ChatMessage) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("sender_id", thrift.I64, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.SenderID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+func (p *ChatMessage) writeField4(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("content", thrift.STRING, 4); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Content); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+func (p *ChatMessage) writeField5(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("type", thrift.BYTE, 5); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteByte(p.Type); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+}
+func (p *ChatMessage) writeField6(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("created_at", thrift.I64, 6); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.CreatedAt); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
+}
+func (p *ChatMessage) writeField7(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("updated_at", thrift.I64, 7); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.UpdatedAt); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 end error: ", p), err)
+}
+func (p *ChatMessage) writeField8(oprot thrift.TProtocol) (err error) {
+	if p.IsSetDeletedAt() {
+		if err = oprot.WriteFieldBegin("deleted_at", thrift.I64, 8); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.DeletedAt); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 end error: ", p), err)
+}
+func (p *ChatMessage) writeField9(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("seq", thrift.I64, 9); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.Seq); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 9 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 9 end error: ", p), err)
+}
+func (p *ChatMessage) writeField10(oprot thrift.TProtocol) (err error) {
+	if p.IsSetReplyToID() {
+		if err = oprot.WriteFieldBegin("reply_to_id", thrift.I64, 10); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.ReplyToID); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 10 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 10 end error: ", p), err)
+}
+func (p *ChatMessage) writeField11(oprot thrift.TProtocol) (err error) {
+	if p.IsSetEditedAt() {
+		if err = oprot.WriteFieldBegin("edited_at", thrift.I64, 11); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.EditedAt); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 11 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 11 end error: ", p), err)
+}
+func (p *ChatMessage) writeField12(oprot thrift.TProtocol) (err error) {
+	if p.IsSetRecalled() {
+		if err = oprot.WriteFieldBegin("recalled", thrift.BOOL, 12); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteBool(*p.Recalled); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 12 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 12 end error: ", p), err)
+}
+
+func (p *ChatMessage) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ChatMessage(%+v)", *p)
+
+}
+
+// 好友关系
+type Friendship struct {
+	// 关系ID
+	ID int64 `thrift:"id,1,required" form:"id,required" json:"id,required" query:"id,required"`
+	// 用户ID
+	UserID int64 `thrift:"user_id,2,required" form:"user_id,required" json:"user_id,required" query:"user_id,required"`
 	// 好友ID
 	FriendID int64 `thrift:"friend_id,3,required" form:"friend_id,required" json:"friend_id,required" query:"friend_id,required"`
 	// 状态：0=待确认,1=已接受,2=已拒绝,3=已拉黑
@@ -4883,90 +10651,2448 @@ type Friendship struct {
 	// 好友备注
 	Remark *string `thrift:"remark,5,optional" form:"remark" json:"remark,omitempty" query:"remark"`
 	// 创建时间
-	CreatedAt int64 `thrift:"created_at,6,required" form:"created_at,required" json:"created_at,required" query:"created_at,required"`
-	// 更新时间
-	UpdatedAt int64 `thrift:"updated_at,7,required" form:"updated_at,required" json:"updated_at,required" query:"updated_at,required"`
-	// 删除时间
-	DeletedAt *int64 `thrift:"deleted_at,8,optional" form:"deleted_at" json:"deleted_at,omitempty" query:"deleted_at"`
+	CreatedAt int64 `thrift:"created_at,6,required" form:"created_at,required" json:"created_at,required" query:"created_at,required"`
+	// 更新时间
+	UpdatedAt int64 `thrift:"updated_at,7,required" form:"updated_at,required" json:"updated_at,required" query:"updated_at,required"`
+	// 删除时间
+	DeletedAt *int64 `thrift:"deleted_at,8,optional" form:"deleted_at" json:"deleted_at,omitempty" query:"deleted_at"`
+}
+
+func NewFriendship() *Friendship {
+	return &Friendship{}
+}
+
+func (p *Friendship) InitDefault() {
+}
+
+func (p *Friendship) GetID() (v int64) {
+	return p.ID
+}
+
+func (p *Friendship) GetUserID() (v int64) {
+	return p.UserID
+}
+
+func (p *Friendship) GetFriendID() (v int64) {
+	return p.FriendID
+}
+
+func (p *Friendship) GetStatus() (v int8) {
+	return p.Status
+}
+
+var Friendship_Remark_DEFAULT string
+
+func (p *Friendship) GetRemark() (v string) {
+	if !p.IsSetRemark() {
+		return Friendship_Remark_DEFAULT
+	}
+	return *p.Remark
+}
+
+func (p *Friendship) GetCreatedAt() (v int64) {
+	return p.CreatedAt
+}
+
+func (p *Friendship) GetUpdatedAt() (v int64) {
+	return p.UpdatedAt
+}
+
+var Friendship_DeletedAt_DEFAULT int64
+
+func (p *Friendship) GetDeletedAt() (v int64) {
+	if !p.IsSetDeletedAt() {
+		return Friendship_DeletedAt_DEFAULT
+	}
+	return *p.DeletedAt
+}
+
+var fieldIDToName_Friendship = map[int16]string{
+	1: "id",
+	2: "user_id",
+	3: "friend_id",
+	4: "status",
+	5: "remark",
+	6: "created_at",
+	7: "updated_at",
+	8: "deleted_at",
+}
+
+func (p *Friendship) IsSetRemark() bool {
+	return p.Remark != nil
+}
+
+func (p *Friendship) IsSetDeletedAt() bool {
+	return p.DeletedAt != nil
+}
+
+func (p *Friendship) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetID bool = false
+	var issetUserID bool = false
+	var issetFriendID bool = false
+	var issetStatus bool = false
+	var issetCreatedAt bool = false
+	var issetUpdatedAt bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetID = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetUserID = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetFriendID = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.BYTE {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetStatus = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 5:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 6:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField6(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetCreatedAt = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 7:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField7(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetUpdatedAt = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 8:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField8(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	if !issetID {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetUserID {
+		fieldId = 2
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetFriendID {
+		fieldId = 3
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetStatus {
+		fieldId = 4
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetCreatedAt {
+		fieldId = 6
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetUpdatedAt {
+		fieldId = 7
+		goto RequiredFieldNotSetError
+	}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_Friendship[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_Friendship[fieldId]))
+}
+
+func (p *Friendship) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.ID = _field
+	return nil
+}
+func (p *Friendship) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.UserID = _field
+	return nil
+}
+func (p *Friendship) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.FriendID = _field
+	return nil
+}
+func (p *Friendship) ReadField4(iprot thrift.TProtocol) error {
+
+	var _field int8
+	if v, err := iprot.ReadByte(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Status = _field
+	return nil
+}
+func (p *Friendship) ReadField5(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Remark = _field
+	return nil
+}
+func (p *Friendship) ReadField6(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.CreatedAt = _field
+	return nil
+}
+func (p *Friendship) ReadField7(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.UpdatedAt = _field
+	return nil
+}
+func (p *Friendship) ReadField8(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.DeletedAt = _field
+	return nil
+}
+
+func (p *Friendship) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("Friendship"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+		if err = p.writeField6(oprot); err != nil {
+			fieldId = 6
+			goto WriteFieldError
+		}
+		if err = p.writeField7(oprot); err != nil {
+			fieldId = 7
+			goto WriteFieldError
+		}
+		if err = p.writeField8(oprot); err != nil {
+			fieldId = 8
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *Friendship) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("id", thrift.I64, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.ID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *Friendship) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("user_id", thrift.I64, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.UserID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+func (p *Friendship) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("friend_id", thrift.I64, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.FriendID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+func (p *Friendship) writeField4(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("status", thrift.BYTE, 4); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteByte(p.Status); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+func (p *Friendship) writeField5(oprot thrift.TProtocol) (err error) {
+	if p.IsSetRemark() {
+		if err = oprot.WriteFieldBegin("remark", thrift.STRING, 5); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Remark); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+}
+func (p *Friendship) writeField6(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("created_at", thrift.I64, 6); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.CreatedAt); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
+}
+func (p *Friendship) writeField7(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("updated_at", thrift.I64, 7); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.UpdatedAt); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 end error: ", p), err)
+}
+func (p *Friendship) writeField8(oprot thrift.TProtocol) (err error) {
+	if p.IsSetDeletedAt() {
+		if err = oprot.WriteFieldBegin("deleted_at", thrift.I64, 8); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.DeletedAt); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 end error: ", p), err)
+}
+
+func (p *Friendship) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("Friendship(%+v)", *p)
+
+}
+
+// 好友申请
+type FriendRequest struct {
+	// 申请ID
+	ID int64 `thrift:"id,1,required" form:"id,required" json:"id,required" query:"id,required"`
+	// 发送者ID
+	SenderID int64 `thrift:"sender_id,2,required" form:"sender_id,required" json:"sender_id,required" query:"sender_id,required"`
+	// 接收者ID
+	ReceiverID int64 `thrift:"receiver_id,3,required" form:"receiver_id,required" json:"receiver_id,required" query:"receiver_id,required"`
+	// 申请消息
+	Message *string `thrift:"message,4,optional" form:"message" json:"message,omitempty" query:"message"`
+	// 状态：0=待处理,1=已接受,2=已拒绝,3=已撤回,4=已过期
+	Status int8 `thrift:"status,5,required" form:"status,required" json:"status,required" query:"status,required"`
+	// 创建时间
+	CreatedAt int64 `thrift:"created_at,6,required" form:"created_at,required" json:"created_at,required" query:"created_at,required"`
+	// 更新时间
+	UpdatedAt int64 `thrift:"updated_at,7,required" form:"updated_at,required" json:"updated_at,required" query:"updated_at,required"`
+	// 删除时间
+	DeletedAt *int64 `thrift:"deleted_at,8,optional" form:"deleted_at" json:"deleted_at,omitempty" query:"deleted_at"`
+}
+
+func NewFriendRequest() *FriendRequest {
+	return &FriendRequest{}
+}
+
+func (p *FriendRequest) InitDefault() {
+}
+
+func (p *FriendRequest) GetID() (v int64) {
+	return p.ID
+}
+
+func (p *FriendRequest) GetSenderID() (v int64) {
+	return p.SenderID
+}
+
+func (p *FriendRequest) GetReceiverID() (v int64) {
+	return p.ReceiverID
+}
+
+var FriendRequest_Message_DEFAULT string
+
+func (p *FriendRequest) GetMessage() (v string) {
+	if !p.IsSetMessage() {
+		return FriendRequest_Message_DEFAULT
+	}
+	return *p.Message
+}
+
+func (p *FriendRequest) GetStatus() (v int8) {
+	return p.Status
+}
+
+func (p *FriendRequest) GetCreatedAt() (v int64) {
+	return p.CreatedAt
+}
+
+func (p *FriendRequest) GetUpdatedAt() (v int64) {
+	return p.UpdatedAt
+}
+
+var FriendRequest_DeletedAt_DEFAULT int64
+
+func (p *FriendRequest) GetDeletedAt() (v int64) {
+	if !p.IsSetDeletedAt() {
+		return FriendRequest_DeletedAt_DEFAULT
+	}
+	return *p.DeletedAt
+}
+
+var fieldIDToName_FriendRequest = map[int16]string{
+	1: "id",
+	2: "sender_id",
+	3: "receiver_id",
+	4: "message",
+	5: "status",
+	6: "created_at",
+	7: "updated_at",
+	8: "deleted_at",
+}
+
+func (p *FriendRequest) IsSetMessage() bool {
+	return p.Message != nil
+}
+
+func (p *FriendRequest) IsSetDeletedAt() bool {
+	return p.DeletedAt != nil
+}
+
+func (p *FriendRequest) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetID bool = false
+	var issetSenderID bool = false
+	var issetReceiverID bool = false
+	var issetStatus bool = false
+	var issetCreatedAt bool = false
+	var issetUpdatedAt bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetID = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetSenderID = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetReceiverID = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 5:
+			if fieldTypeId == thrift.BYTE {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetStatus = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 6:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField6(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetCreatedAt = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 7:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField7(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetUpdatedAt = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 8:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField8(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	if !issetID {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetSenderID {
+		fieldId = 2
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetReceiverID {
+		fieldId = 3
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetStatus {
+		fieldId = 5
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetCreatedAt {
+		fieldId = 6
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetUpdatedAt {
+		fieldId = 7
+		goto RequiredFieldNotSetError
+	}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_FriendRequest[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_FriendRequest[fieldId]))
+}
+
+func (p *FriendRequest) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.ID = _field
+	return nil
+}
+func (p *FriendRequest) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.SenderID = _field
+	return nil
+}
+func (p *FriendRequest) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.ReceiverID = _field
+	return nil
+}
+func (p *FriendRequest) ReadField4(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Message = _field
+	return nil
+}
+func (p *FriendRequest) ReadField5(iprot thrift.TProtocol) error {
+
+	var _field int8
+	if v, err := iprot.ReadByte(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Status = _field
+	return nil
+}
+func (p *FriendRequest) ReadField6(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.CreatedAt = _field
+	return nil
+}
+func (p *FriendRequest) ReadField7(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.UpdatedAt = _field
+	return nil
+}
+func (p *FriendRequest) ReadField8(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.DeletedAt = _field
+	return nil
+}
+
+func (p *FriendRequest) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("FriendRequest"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+		if err = p.writeField6(oprot); err != nil {
+			fieldId = 6
+			goto WriteFieldError
+		}
+		if err = p.writeField7(oprot); err != nil {
+			fieldId = 7
+			goto WriteFieldError
+		}
+		if err = p.writeField8(oprot); err != nil {
+			fieldId = 8
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *FriendRequest) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("id", thrift.I64, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.ID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *FriendRequest) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("sender_id", thrift.I64, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.SenderID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+func (p *FriendRequest) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("receiver_id", thrift.I64, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.ReceiverID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+func (p *FriendRequest) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetMessage() {
+		if err = oprot.WriteFieldBegin("message", thrift.STRING, 4); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Message); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+func (p *FriendRequest) writeField5(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("status", thrift.BYTE, 5); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteByte(p.Status); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+}
+func (p *FriendRequest) writeField6(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("created_at", thrift.I64, 6); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.CreatedAt); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
+}
+func (p *FriendRequest) writeField7(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("updated_at", thrift.I64, 7); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.UpdatedAt); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 end error: ", p), err)
+}
+func (p *FriendRequest) writeField8(oprot thrift.TProtocol) (err error) {
+	if p.IsSetDeletedAt() {
+		if err = oprot.WriteFieldBegin("deleted_at", thrift.I64, 8); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.DeletedAt); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 end error: ", p), err)
+}
+
+func (p *FriendRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("FriendRequest(%+v)", *p)
+
+}
+
+// 好友推荐
+type FriendRecommendation struct {
+	// 被推荐的用户ID
+	UserID int64 `thrift:"user_id,1,required" form:"user_id,required" json:"user_id,required" query:"user_id,required"`
+	// 推荐分数
+	Score float64 `thrift:"score,2,required" form:"score,required" json:"score,required" query:"score,required"`
+	// 共同好友数
+	MutualFriends int64 `thrift:"mutual_friends,3,required" form:"mutual_friends,required" json:"mutual_friends,required" query:"mutual_friends,required"`
+	// 我关注的人中关注了对方的人数
+	MutualFollows int64 `thrift:"mutual_follows,4,required" form:"mutual_follows,required" json:"mutual_follows,required" query:"mutual_follows,required"`
+	// 双方都点赞过的视频数
+	SharedLikes int64 `thrift:"shared_likes,5,required" form:"shared_likes,required" json:"shared_likes,required" query:"shared_likes,required"`
+	// 双方都评论过的视频数
+	CoComments int64 `thrift:"co_comments,6,required" form:"co_comments,required" json:"co_comments,required" query:"co_comments,required"`
+}
+
+func NewFriendRecommendation() *FriendRecommendation {
+	return &FriendRecommendation{}
+}
+
+func (p *FriendRecommendation) InitDefault() {
+}
+
+func (p *FriendRecommendation) GetUserID() (v int64) {
+	return p.UserID
+}
+
+func (p *FriendRecommendation) GetScore() (v float64) {
+	return p.Score
+}
+
+func (p *FriendRecommendation) GetMutualFriends() (v int64) {
+	return p.MutualFriends
+}
+
+func (p *FriendRecommendation) GetMutualFollows() (v int64) {
+	return p.MutualFollows
+}
+
+func (p *FriendRecommendation) GetSharedLikes() (v int64) {
+	return p.SharedLikes
+}
+
+func (p *FriendRecommendation) GetCoComments() (v int64) {
+	return p.CoComments
+}
+
+var fieldIDToName_FriendRecommendation = map[int16]string{
+	1: "user_id",
+	2: "score",
+	3: "mutual_friends",
+	4: "mutual_follows",
+	5: "shared_likes",
+	6: "co_comments",
+}
+
+func (p *FriendRecommendation) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetUserID bool = false
+	var issetScore bool = false
+	var issetMutualFriends bool = false
+	var issetMutualFollows bool = false
+	var issetSharedLikes bool = false
+	var issetCoComments bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetUserID = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.DOUBLE {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetScore = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetMutualFriends = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetMutualFollows = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 5:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetSharedLikes = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 6:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField6(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetCoComments = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	if !issetUserID {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetScore {
+		fieldId = 2
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetMutualFriends {
+		fieldId = 3
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetMutualFollows {
+		fieldId = 4
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetSharedLikes {
+		fieldId = 5
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetCoComments {
+		fieldId = 6
+		goto RequiredFieldNotSetError
+	}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_FriendRecommendation[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_FriendRecommendation[fieldId]))
+}
+
+func (p *FriendRecommendation) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.UserID = _field
+	return nil
+}
+func (p *FriendRecommendation) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field float64
+	if v, err := iprot.ReadDouble(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Score = _field
+	return nil
+}
+func (p *FriendRecommendation) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.MutualFriends = _field
+	return nil
+}
+func (p *FriendRecommendation) ReadField4(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.MutualFollows = _field
+	return nil
+}
+func (p *FriendRecommendation) ReadField5(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.SharedLikes = _field
+	return nil
+}
+func (p *FriendRecommendation) ReadField6(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.CoComments = _field
+	return nil
+}
+
+func (p *FriendRecommendation) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("FriendRecommendation"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+		if err = p.writeField6(oprot); err != nil {
+			fieldId = 6
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *FriendRecommendation) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("user_id", thrift.I64, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.UserID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *FriendRecommendation) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("score", thrift.DOUBLE, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteDouble(p.Score); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+func (p *FriendRecommendation) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("mutual_friends", thrift.I64, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.MutualFriends); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+func (p *FriendRecommendation) writeField4(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("mutual_follows", thrift.I64, 4); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.MutualFollows); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+func (p *FriendRecommendation) writeField5(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("shared_likes", thrift.I64, 5); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.SharedLikes); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+}
+func (p *FriendRecommendation) writeField6(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("co_comments", thrift.I64, 6); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.CoComments); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
+}
+
+func (p *FriendRecommendation) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("FriendRecommendation(%+v)", *p)
+
+}
+
+// 通知，同一目标上未读的同类通知合并为一条，如"A 等 13 人赞了你的视频"
+type Notification struct {
+	// 通知ID
+	ID int64 `thrift:"id,1,required" form:"id,required" json:"id,required" query:"id,required"`
+	// 类型：1=点赞视频,2=评论视频,3=回复评论,4=好友申请,5=新增关注,6=好友申请已通过
+	Type int8 `thrift:"type,2,required" form:"type,required" json:"type,required" query:"type,required"`
+	// 点赞和评论为视频ID，回复为被回复的评论ID，好友申请和关注为 0
+	TargetID int64 `thrift:"target_id,3,required" form:"target_id,required" json:"target_id,required" query:"target_id,required"`
+	// 最近的触发者，最新的在前
+	ActorIds []int64 `thrift:"actor_ids,4,required" form:"actor_ids,required" json:"actor_ids,required" query:"actor_ids,required"`
+	// 触发者总数
+	ActorCount int64 `thrift:"actor_count,5,required" form:"actor_count,required" json:"actor_count,required" query:"actor_count,required"`
+	// 是否已读
+	IsRead bool `thrift:"is_read,6,required" form:"is_read,required" json:"is_read,required" query:"is_read,required"`
+	// 创建时间
+	CreatedAt int64 `thrift:"created_at,7,required" form:"created_at,required" json:"created_at,required" query:"created_at,required"`
+	// 最后一次合并的时间
+	UpdatedAt int64 `thrift:"updated_at,8,required" form:"updated_at,required" json:"updated_at,required" query:"updated_at,required"`
+}
+
+func NewNotification() *Notification {
+	return &Notification{}
+}
+
+func (p *Notification) InitDefault() {
+}
+
+func (p *Notification) GetID() (v int64) {
+	return p.ID
+}
+
+func (p *Notification) GetType() (v int8) {
+	return p.Type
+}
+
+func (p *Notification) GetTargetID() (v int64) {
+	return p.TargetID
+}
+
+func (p *Notification) GetActorIds() (v []int64) {
+	return p.ActorIds
+}
+
+func (p *Notification) GetActorCount() (v int64) {
+	return p.ActorCount
+}
+
+func (p *Notification) GetIsRead() (v bool) {
+	return p.IsRead
+}
+
+func (p *Notification) GetCreatedAt() (v int64) {
+	return p.CreatedAt
+}
+
+func (p *Notification) GetUpdatedAt() (v int64) {
+	return p.UpdatedAt
+}
+
+var fieldIDToName_Notification = map[int16]string{
+	1: "id",
+	2: "type",
+	3: "target_id",
+	4: "actor_ids",
+	5: "actor_count",
+	6: "is_read",
+	7: "created_at",
+	8: "updated_at",
+}
+
+func (p *Notification) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetID bool = false
+	var issetType bool = false
+	var issetTargetID bool = false
+	var issetActorIds bool = false
+	var issetActorCount bool = false
+	var issetIsRead bool = false
+	var issetCreatedAt bool = false
+	var issetUpdatedAt bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetID = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.BYTE {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetType = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetTargetID = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetActorIds = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 5:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetActorCount = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 6:
+			if fieldTypeId == thrift.BOOL {
+				if err = p.ReadField6(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetIsRead = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 7:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField7(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetCreatedAt = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 8:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField8(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetUpdatedAt = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	if !issetID {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetType {
+		fieldId = 2
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetTargetID {
+		fieldId = 3
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetActorIds {
+		fieldId = 4
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetActorCount {
+		fieldId = 5
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetIsRead {
+		fieldId = 6
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetCreatedAt {
+		fieldId = 7
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetUpdatedAt {
+		fieldId = 8
+		goto RequiredFieldNotSetError
+	}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_Notification[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_Notification[fieldId]))
+}
+
+func (p *Notification) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.ID = _field
+	return nil
+}
+func (p *Notification) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field int8
+	if v, err := iprot.ReadByte(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Type = _field
+	return nil
+}
+func (p *Notification) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.TargetID = _field
+	return nil
+}
+func (p *Notification) ReadField4(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]int64, 0, size)
+	for i := 0; i < size; i++ {
+
+		var _elem int64
+		if v, err := iprot.ReadI64(); err != nil {
+			return err
+		} else {
+			_elem = v
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.ActorIds = _field
+	return nil
+}
+func (p *Notification) ReadField5(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.ActorCount = _field
+	return nil
+}
+func (p *Notification) ReadField6(iprot thrift.TProtocol) error {
+
+	var _field bool
+	if v, err := iprot.ReadBool(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.IsRead = _field
+	return nil
+}
+func (p *Notification) ReadField7(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.CreatedAt = _field
+	return nil
+}
+func (p *Notification) ReadField8(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.UpdatedAt = _field
+	return nil
+}
+
+func (p *Notification) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("Notification"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+		if err = p.writeField6(oprot); err != nil {
+			fieldId = 6
+			goto WriteFieldError
+		}
+		if err = p.writeField7(oprot); err != nil {
+			fieldId = 7
+			goto WriteFieldError
+		}
+		if err = p.writeField8(oprot); err != nil {
+			fieldId = 8
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *Notification) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("id", thrift.I64, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.ID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *Notification) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("type", thrift.BYTE, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteByte(p.Type); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+func (p *Notification) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("target_id", thrift.I64, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.TargetID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+func (p *Notification) writeField4(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("actor_ids", thrift.LIST, 4); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteListBegin(thrift.I64, len(p.ActorIds)); err != nil {
+		return err
+	}
+	for _, v := range p.ActorIds {
+		if err := oprot.WriteI64(v); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteListEnd(); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+func (p *Notification) writeField5(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("actor_count", thrift.I64, 5); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.ActorCount); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+}
+func (p *Notification) writeField6(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("is_read", thrift.BOOL, 6); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteBool(p.IsRead); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
+}
+func (p *Notification) writeField7(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("created_at", thrift.I64, 7); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.CreatedAt); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 end error: ", p), err)
+}
+func (p *Notification) writeField8(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("updated_at", thrift.I64, 8); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.UpdatedAt); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 end error: ", p), err)
+}
+
+func (p *Notification) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("Notification(%+v)", *p)
+
+}
+
+// 各类型的未读通知数
+type NotificationUnreadCount struct {
+	// 通知类型
+	Type int8 `thrift:"type,1,required" form:"type,required" json:"type,required" query:"type,required"`
+	// 未读数
+	Count int64 `thrift:"count,2,required" form:"count,required" json:"count,required" query:"count,required"`
+}
+
+func NewNotificationUnreadCount() *NotificationUnreadCount {
+	return &NotificationUnreadCount{}
+}
+
+func (p *NotificationUnreadCount) InitDefault() {
+}
+
+func (p *NotificationUnreadCount) GetType() (v int8) {
+	return p.Type
+}
+
+func (p *NotificationUnreadCount) GetCount() (v int64) {
+	return p.Count
+}
+
+var fieldIDToName_NotificationUnreadCount = map[int16]string{
+	1: "type",
+	2: "count",
+}
+
+func (p *NotificationUnreadCount) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetType bool = false
+	var issetCount bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.BYTE {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetType = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetCount = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	if !issetType {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetCount {
+		fieldId = 2
+		goto RequiredFieldNotSetError
+	}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_NotificationUnreadCount[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_NotificationUnreadCount[fieldId]))
+}
+
+func (p *NotificationUnreadCount) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field int8
+	if v, err := iprot.ReadByte(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Type = _field
+	return nil
+}
+func (p *NotificationUnreadCount) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Count = _field
+	return nil
 }
 
-func NewFriendship() *Friendship {
-	return &Friendship{}
+func (p *NotificationUnreadCount) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("NotificationUnreadCount"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *Friendship) InitDefault() {
+func (p *NotificationUnreadCount) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("type", thrift.BYTE, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteByte(p.Type); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *NotificationUnreadCount) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("count", thrift.I64, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.Count); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
 
-func (p *Friendship) GetID() (v int64) {
-	return p.ID
+func (p *NotificationUnreadCount) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("NotificationUnreadCount(%+v)", *p)
+
 }
 
-func (p *Friendship) GetUserID() (v int64) {
-	return p.UserID
+// 通知偏好，关闭的类型不再产生通知
+type NotificationPreference struct {
+	// 通知类型
+	Type int8 `thrift:"type,1,required" form:"type,required" json:"type,required" query:"type,required"`
+	// 是否接收
+	Enabled bool `thrift:"enabled,2,required" form:"enabled,required" json:"enabled,required" query:"enabled,required"`
 }
 
-func (p *Friendship) GetFriendID() (v int64) {
-	return p.FriendID
+func NewNotificationPreference() *NotificationPreference {
+	return &NotificationPreference{}
 }
 
-func (p *Friendship) GetStatus() (v int8) {
-	return p.Status
+func (p *NotificationPreference) InitDefault() {
 }
 
-var Friendship_Remark_DEFAULT string
+func (p *NotificationPreference) GetType() (v int8) {
+	return p.Type
+}
 
-func (p *Friendship) GetRemark() (v string) {
-	if !p.IsSetRemark() {
-		return Friendship_Remark_DEFAULT
+func (p *NotificationPreference) GetEnabled() (v bool) {
+	return p.Enabled
+}
+
+var fieldIDToName_NotificationPreference = map[int16]string{
+	1: "type",
+	2: "enabled",
+}
+
+func (p *NotificationPreference) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetType bool = false
+	var issetEnabled bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
 	}
-	return *p.Remark
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.BYTE {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetType = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.BOOL {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetEnabled = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	if !issetType {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetEnabled {
+		fieldId = 2
+		goto RequiredFieldNotSetError
+	}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_NotificationPreference[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_NotificationPreference[fieldId]))
 }
 
-func (p *Friendship) GetCreatedAt() (v int64) {
-	return p.CreatedAt
+func (p *NotificationPreference) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field int8
+	if v, err := iprot.ReadByte(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Type = _field
+	return nil
 }
+func (p *NotificationPreference) ReadField2(iprot thrift.TProtocol) error {
 
-func (p *Friendship) GetUpdatedAt() (v int64) {
-	return p.UpdatedAt
+	var _field bool
+	if v, err := iprot.ReadBool(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Enabled = _field
+	return nil
 }
 
-var Friendship_DeletedAt_DEFAULT int64
+func (p *NotificationPreference) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("NotificationPreference"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
 
-func (p *Friendship) GetDeletedAt() (v int64) {
-	if !p.IsSetDeletedAt() {
-		return Friendship_DeletedAt_DEFAULT
+func (p *NotificationPreference) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("type", thrift.BYTE, 1); err != nil {
+		goto WriteFieldBeginError
 	}
-	return *p.DeletedAt
+	if err := oprot.WriteByte(p.Type); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *NotificationPreference) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("enabled", thrift.BOOL, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteBool(p.Enabled); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
 
-var fieldIDToName_Friendship = map[int16]string{
-	1: "id",
-	2: "user_id",
-	3: "friend_id",
-	4: "status",
-	5: "remark",
-	6: "created_at",
-	7: "updated_at",
-	8: "deleted_at",
+func (p *NotificationPreference) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("NotificationPreference(%+v)", *p)
+
+}
+
+// 用户在线状态，对方隐藏在线状态或不是好友时 online 为 false，last_seen 为 0
+type UserPresence struct {
+	// 用户ID
+	UserID int64 `thrift:"user_id,1,required" form:"user_id,required" json:"user_id,required" query:"user_id,required"`
+	// 是否在线
+	Online bool `thrift:"online,2,required" form:"online,required" json:"online,required" query:"online,required"`
+	// 最后在线时间，从未上线时为 0
+	LastSeen int64 `thrift:"last_seen,3,required" form:"last_seen,required" json:"last_seen,required" query:"last_seen,required"`
+}
+
+func NewUserPresence() *UserPresence {
+	return &UserPresence{}
+}
+
+func (p *UserPresence) InitDefault() {
 }
 
-func (p *Friendship) IsSetRemark() bool {
-	return p.Remark != nil
+func (p *UserPresence) GetUserID() (v int64) {
+	return p.UserID
 }
 
-func (p *Friendship) IsSetDeletedAt() bool {
-	return p.DeletedAt != nil
+func (p *UserPresence) GetOnline() (v bool) {
+	return p.Online
 }
 
-func (p *Friendship) Read(iprot thrift.TProtocol) (err error) {
+func (p *UserPresence) GetLastSeen() (v int64) {
+	return p.LastSeen
+}
+
+var fieldIDToName_UserPresence = map[int16]string{
+	1: "user_id",
+	2: "online",
+	3: "last_seen",
+}
+
+func (p *UserPresence) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
-	var issetID bool = false
 	var issetUserID bool = false
-	var issetFriendID bool = false
-	var issetStatus bool = false
-	var issetCreatedAt bool = false
-	var issetUpdatedAt bool = false
+	var issetOnline bool = false
+	var issetLastSeen bool = false
 
 	if _, err = iprot.ReadStructBegin(); err != nil {
 		goto ReadStructBeginError
@@ -4987,16 +13113,16 @@ func (p *Friendship) Read(iprot thrift.TProtocol) (err error) {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
-				issetID = true
+				issetUserID = true
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
 		case 2:
-			if fieldTypeId == thrift.I64 {
+			if fieldTypeId == thrift.BOOL {
 				if err = p.ReadField2(iprot); err != nil {
 					goto ReadFieldError
 				}
-				issetUserID = true
+				issetOnline = true
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
@@ -5005,50 +13131,7 @@ func (p *Friendship) Read(iprot thrift.TProtocol) (err error) {
 				if err = p.ReadField3(iprot); err != nil {
 					goto ReadFieldError
 				}
-				issetFriendID = true
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 4:
-			if fieldTypeId == thrift.BYTE {
-				if err = p.ReadField4(iprot); err != nil {
-					goto ReadFieldError
-				}
-				issetStatus = true
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 5:
-			if fieldTypeId == thrift.STRING {
-				if err = p.ReadField5(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 6:
-			if fieldTypeId == thrift.I64 {
-				if err = p.ReadField6(iprot); err != nil {
-					goto ReadFieldError
-				}
-				issetCreatedAt = true
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 7:
-			if fieldTypeId == thrift.I64 {
-				if err = p.ReadField7(iprot); err != nil {
-					goto ReadFieldError
-				}
-				issetUpdatedAt = true
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 8:
-			if fieldTypeId == thrift.I64 {
-				if err = p.ReadField8(iprot); err != nil {
-					goto ReadFieldError
-				}
+				issetLastSeen = true
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
@@ -5065,42 +13148,27 @@ func (p *Friendship) Read(iprot thrift.TProtocol) (err error) {
 		goto ReadStructEndError
 	}
 
-	if !issetID {
+	if !issetUserID {
 		fieldId = 1
 		goto RequiredFieldNotSetError
 	}
 
-	if !issetUserID {
+	if !issetOnline {
 		fieldId = 2
 		goto RequiredFieldNotSetError
 	}
 
-	if !issetFriendID {
+	if !issetLastSeen {
 		fieldId = 3
 		goto RequiredFieldNotSetError
 	}
-
-	if !issetStatus {
-		fieldId = 4
-		goto RequiredFieldNotSetError
-	}
-
-	if !issetCreatedAt {
-		fieldId = 6
-		goto RequiredFieldNotSetError
-	}
-
-	if !issetUpdatedAt {
-		fieldId = 7
-		goto RequiredFieldNotSetError
-	}
 	return nil
 ReadStructBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_Friendship[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserPresence[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -5109,21 +13177,10 @@ ReadFieldEndError:
 ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 RequiredFieldNotSetError:
-	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_Friendship[fieldId]))
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_UserPresence[fieldId]))
 }
 
-func (p *Friendship) ReadField1(iprot thrift.TProtocol) error {
-
-	var _field int64
-	if v, err := iprot.ReadI64(); err != nil {
-		return err
-	} else {
-		_field = v
-	}
-	p.ID = _field
-	return nil
-}
-func (p *Friendship) ReadField2(iprot thrift.TProtocol) error {
+func (p *UserPresence) ReadField1(iprot thrift.TProtocol) error {
 
 	var _field int64
 	if v, err := iprot.ReadI64(); err != nil {
@@ -5134,51 +13191,18 @@ func (p *Friendship) ReadField2(iprot thrift.TProtocol) error {
 	p.UserID = _field
 	return nil
 }
-func (p *Friendship) ReadField3(iprot thrift.TProtocol) error {
-
-	var _field int64
-	if v, err := iprot.ReadI64(); err != nil {
-		return err
-	} else {
-		_field = v
-	}
-	p.FriendID = _field
-	return nil
-}
-func (p *Friendship) ReadField4(iprot thrift.TProtocol) error {
-
-	var _field int8
-	if v, err := iprot.ReadByte(); err != nil {
-		return err
-	} else {
-		_field = v
-	}
-	p.Status = _field
-	return nil
-}
-func (p *Friendship) ReadField5(iprot thrift.TProtocol) error {
-
-	var _field *string
-	if v, err := iprot.ReadString(); err != nil {
-		return err
-	} else {
-		_field = &v
-	}
-	p.Remark = _field
-	return nil
-}
-func (p *Friendship) ReadField6(iprot thrift.TProtocol) error {
+func (p *UserPresence) ReadField2(iprot thrift.TProtocol) error {
 
-	var _field int64
-	if v, err := iprot.ReadI64(); err != nil {
+	var _field bool
+	if v, err := iprot.ReadBool(); err != nil {
 		return err
 	} else {
 		_field = v
 	}
-	p.CreatedAt = _field
+	p.Online = _field
 	return nil
 }
-func (p *Friendship) ReadField7(iprot thrift.TProtocol) error {
+func (p *UserPresence) ReadField3(iprot thrift.TProtocol) error {
 
 	var _field int64
 	if v, err := iprot.ReadI64(); err != nil {
@@ -5186,24 +13210,13 @@ func (p *Friendship) ReadField7(iprot thrift.TProtocol) error {
 	} else {
 		_field = v
 	}
-	p.UpdatedAt = _field
-	return nil
-}
-func (p *Friendship) ReadField8(iprot thrift.TProtocol) error {
-
-	var _field *int64
-	if v, err := iprot.ReadI64(); err != nil {
-		return err
-	} else {
-		_field = &v
-	}
-	p.DeletedAt = _field
+	p.LastSeen = _field
 	return nil
 }
 
-func (p *Friendship) Write(oprot thrift.TProtocol) (err error) {
+func (p *UserPresence) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("Friendship"); err != nil {
+	if err = oprot.WriteStructBegin("UserPresence"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -5219,26 +13232,6 @@ func (p *Friendship) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 3
 			goto WriteFieldError
 		}
-		if err = p.writeField4(oprot); err != nil {
-			fieldId = 4
-			goto WriteFieldError
-		}
-		if err = p.writeField5(oprot); err != nil {
-			fieldId = 5
-			goto WriteFieldError
-		}
-		if err = p.writeField6(oprot); err != nil {
-			fieldId = 6
-			goto WriteFieldError
-		}
-		if err = p.writeField7(oprot); err != nil {
-			fieldId = 7
-			goto WriteFieldError
-		}
-		if err = p.writeField8(oprot); err != nil {
-			fieldId = 8
-			goto WriteFieldError
-		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -5253,97 +13246,15 @@ WriteFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
 WriteFieldStopError:
 	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
-WriteStructEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
-}
-
-func (p *Friendship) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("id", thrift.I64, 1); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteI64(p.ID); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
-}
-func (p *Friendship) writeField2(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("user_id", thrift.I64, 2); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteI64(p.UserID); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
-}
-func (p *Friendship) writeField3(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("friend_id", thrift.I64, 3); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteI64(p.FriendID); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
-}
-func (p *Friendship) writeField4(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("status", thrift.BYTE, 4); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteByte(p.Status); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
-}
-func (p *Friendship) writeField5(oprot thrift.TProtocol) (err error) {
-	if p.IsSetRemark() {
-		if err = oprot.WriteFieldBegin("remark", thrift.STRING, 5); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteString(*p.Remark); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
-func (p *Friendship) writeField6(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("created_at", thrift.I64, 6); err != nil {
+
+func (p *UserPresence) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("user_id", thrift.I64, 1); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteI64(p.CreatedAt); err != nil {
+	if err := oprot.WriteI64(p.UserID); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -5351,15 +13262,15 @@ func (p *Friendship) writeField6(oprot thrift.TProtocol) (err error) {
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
-func (p *Friendship) writeField7(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("updated_at", thrift.I64, 7); err != nil {
+func (p *UserPresence) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("online", thrift.BOOL, 2); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteI64(p.UpdatedAt); err != nil {
+	if err := oprot.WriteBool(p.Online); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -5367,134 +13278,116 @@ func (p *Friendship) writeField7(oprot thrift.TProtocol) (err error) {
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 7 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 7 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
-func (p *Friendship) writeField8(oprot thrift.TProtocol) (err error) {
-	if p.IsSetDeletedAt() {
-		if err = oprot.WriteFieldBegin("deleted_at", thrift.I64, 8); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteI64(*p.DeletedAt); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
+func (p *UserPresence) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("last_seen", thrift.I64, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.LastSeen); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 8 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 8 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
 
-func (p *Friendship) String() string {
+func (p *UserPresence) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("Friendship(%+v)", *p)
+	return fmt.Sprintf("UserPresence(%+v)", *p)
 
 }
 
-// 好友申请
-type FriendRequest struct {
-	// 申请ID
+// 聊天记录搜索结果，room_id 为 0 时是私信
+type SearchedMessage struct {
+	// 消息ID
 	ID int64 `thrift:"id,1,required" form:"id,required" json:"id,required" query:"id,required"`
+	// 聊天室ID
+	RoomID int64 `thrift:"room_id,2,required" form:"room_id,required" json:"room_id,required" query:"room_id,required"`
 	// 发送者ID
-	SenderID int64 `thrift:"sender_id,2,required" form:"sender_id,required" json:"sender_id,required" query:"sender_id,required"`
-	// 接收者ID
-	ReceiverID int64 `thrift:"receiver_id,3,required" form:"receiver_id,required" json:"receiver_id,required" query:"receiver_id,required"`
-	// 申请消息
-	Message *string `thrift:"message,4,optional" form:"message" json:"message,omitempty" query:"message"`
-	// 状态：0=待处理,1=已接受,2=已拒绝
-	Status int8 `thrift:"status,5,required" form:"status,required" json:"status,required" query:"status,required"`
+	SenderID int64 `thrift:"sender_id,3,required" form:"sender_id,required" json:"sender_id,required" query:"sender_id,required"`
+	// 私信接收者ID
+	ReceiverID int64 `thrift:"receiver_id,4,required" form:"receiver_id,required" json:"receiver_id,required" query:"receiver_id,required"`
+	// 消息内容
+	Content string `thrift:"content,5,required" form:"content,required" json:"content,required" query:"content,required"`
+	// 消息类型
+	Type int8 `thrift:"type,6,required" form:"type,required" json:"type,required" query:"type,required"`
+	// 会话内序号，用于定位上下文
+	Seq int64 `thrift:"seq,7,required" form:"seq,required" json:"seq,required" query:"seq,required"`
 	// 创建时间
-	CreatedAt int64 `thrift:"created_at,6,required" form:"created_at,required" json:"created_at,required" query:"created_at,required"`
-	// 更新时间
-	UpdatedAt int64 `thrift:"updated_at,7,required" form:"updated_at,required" json:"updated_at,required" query:"updated_at,required"`
-	// 删除时间
-	DeletedAt *int64 `thrift:"deleted_at,8,optional" form:"deleted_at" json:"deleted_at,omitempty" query:"deleted_at"`
+	CreatedAt int64 `thrift:"created_at,8,required" form:"created_at,required" json:"created_at,required" query:"created_at,required"`
 }
 
-func NewFriendRequest() *FriendRequest {
-	return &FriendRequest{}
+func NewSearchedMessage() *SearchedMessage {
+	return &SearchedMessage{}
 }
 
-func (p *FriendRequest) InitDefault() {
+func (p *SearchedMessage) InitDefault() {
 }
 
-func (p *FriendRequest) GetID() (v int64) {
+func (p *SearchedMessage) GetID() (v int64) {
 	return p.ID
 }
 
-func (p *FriendRequest) GetSenderID() (v int64) {
-	return p.SenderID
+func (p *SearchedMessage) GetRoomID() (v int64) {
+	return p.RoomID
 }
 
-func (p *FriendRequest) GetReceiverID() (v int64) {
-	return p.ReceiverID
+func (p *SearchedMessage) GetSenderID() (v int64) {
+	return p.SenderID
 }
 
-var FriendRequest_Message_DEFAULT string
-
-func (p *FriendRequest) GetMessage() (v string) {
-	if !p.IsSetMessage() {
-		return FriendRequest_Message_DEFAULT
-	}
-	return *p.Message
+func (p *SearchedMessage) GetReceiverID() (v int64) {
+	return p.ReceiverID
 }
 
-func (p *FriendRequest) GetStatus() (v int8) {
-	return p.Status
+func (p *SearchedMessage) GetContent() (v string) {
+	return p.Content
 }
 
-func (p *FriendRequest) GetCreatedAt() (v int64) {
-	return p.CreatedAt
+func (p *SearchedMessage) GetType() (v int8) {
+	return p.Type
 }
 
-func (p *FriendRequest) GetUpdatedAt() (v int64) {
-	return p.UpdatedAt
+func (p *SearchedMessage) GetSeq() (v int64) {
+	return p.Seq
 }
 
-var FriendRequest_DeletedAt_DEFAULT int64
-
-func (p *FriendRequest) GetDeletedAt() (v int64) {
-	if !p.IsSetDeletedAt() {
-		return FriendRequest_DeletedAt_DEFAULT
-	}
-	return *p.DeletedAt
+func (p *SearchedMessage) GetCreatedAt() (v int64) {
+	return p.CreatedAt
 }
 
-var fieldIDToName_FriendRequest = map[int16]string{
+var fieldIDToName_SearchedMessage = map[int16]string{
 	1: "id",
-	2: "sender_id",
-	3: "receiver_id",
-	4: "message",
-	5: "status",
-	6: "created_at",
-	7: "updated_at",
-	8: "deleted_at",
-}
-
-func (p *FriendRequest) IsSetMessage() bool {
-	return p.Message != nil
-}
-
-func (p *FriendRequest) IsSetDeletedAt() bool {
-	return p.DeletedAt != nil
+	2: "room_id",
+	3: "sender_id",
+	4: "receiver_id",
+	5: "content",
+	6: "type",
+	7: "seq",
+	8: "created_at",
 }
 
-func (p *FriendRequest) Read(iprot thrift.TProtocol) (err error) {
+func (p *SearchedMessage) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 	var issetID bool = false
+	var issetRoomID bool = false
 	var issetSenderID bool = false
 	var issetReceiverID bool = false
-	var issetStatus bool = false
+	var issetContent bool = false
+	var issetType bool = false
+	var issetSeq bool = false
 	var issetCreatedAt bool = false
-	var issetUpdatedAt bool = false
 
 	if _, err = iprot.ReadStructBegin(); err != nil {
 		goto ReadStructBeginError
@@ -5524,7 +13417,7 @@ func (p *FriendRequest) Read(iprot thrift.TProtocol) (err error) {
 				if err = p.ReadField2(iprot); err != nil {
 					goto ReadFieldError
 				}
-				issetSenderID = true
+				issetRoomID = true
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
@@ -5533,33 +13426,34 @@ func (p *FriendRequest) Read(iprot thrift.TProtocol) (err error) {
 				if err = p.ReadField3(iprot); err != nil {
 					goto ReadFieldError
 				}
-				issetReceiverID = true
+				issetSenderID = true
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
 		case 4:
-			if fieldTypeId == thrift.STRING {
+			if fieldTypeId == thrift.I64 {
 				if err = p.ReadField4(iprot); err != nil {
 					goto ReadFieldError
 				}
+				issetReceiverID = true
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
 		case 5:
-			if fieldTypeId == thrift.BYTE {
+			if fieldTypeId == thrift.STRING {
 				if err = p.ReadField5(iprot); err != nil {
 					goto ReadFieldError
 				}
-				issetStatus = true
+				issetContent = true
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
 		case 6:
-			if fieldTypeId == thrift.I64 {
+			if fieldTypeId == thrift.BYTE {
 				if err = p.ReadField6(iprot); err != nil {
 					goto ReadFieldError
 				}
-				issetCreatedAt = true
+				issetType = true
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
@@ -5568,7 +13462,7 @@ func (p *FriendRequest) Read(iprot thrift.TProtocol) (err error) {
 				if err = p.ReadField7(iprot); err != nil {
 					goto ReadFieldError
 				}
-				issetUpdatedAt = true
+				issetSeq = true
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
@@ -5577,6 +13471,7 @@ func (p *FriendRequest) Read(iprot thrift.TProtocol) (err error) {
 				if err = p.ReadField8(iprot); err != nil {
 					goto ReadFieldError
 				}
+				issetCreatedAt = true
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
@@ -5598,37 +13493,47 @@ func (p *FriendRequest) Read(iprot thrift.TProtocol) (err error) {
 		goto RequiredFieldNotSetError
 	}
 
-	if !issetSenderID {
+	if !issetRoomID {
 		fieldId = 2
 		goto RequiredFieldNotSetError
 	}
 
-	if !issetReceiverID {
+	if !issetSenderID {
 		fieldId = 3
 		goto RequiredFieldNotSetError
 	}
 
-	if !issetStatus {
+	if !issetReceiverID {
+		fieldId = 4
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetContent {
 		fieldId = 5
 		goto RequiredFieldNotSetError
 	}
 
-	if !issetCreatedAt {
+	if !issetType {
 		fieldId = 6
 		goto RequiredFieldNotSetError
 	}
 
-	if !issetUpdatedAt {
+	if !issetSeq {
 		fieldId = 7
 		goto RequiredFieldNotSetError
 	}
+
+	if !issetCreatedAt {
+		fieldId = 8
+		goto RequiredFieldNotSetError
+	}
 	return nil
 ReadStructBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_FriendRequest[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_SearchedMessage[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -5637,10 +13542,10 @@ ReadFieldEndError:
 ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 RequiredFieldNotSetError:
-	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_FriendRequest[fieldId]))
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_SearchedMessage[fieldId]))
 }
 
-func (p *FriendRequest) ReadField1(iprot thrift.TProtocol) error {
+func (p *SearchedMessage) ReadField1(iprot thrift.TProtocol) error {
 
 	var _field int64
 	if v, err := iprot.ReadI64(); err != nil {
@@ -5651,7 +13556,7 @@ func (p *FriendRequest) ReadField1(iprot thrift.TProtocol) error {
 	p.ID = _field
 	return nil
 }
-func (p *FriendRequest) ReadField2(iprot thrift.TProtocol) error {
+func (p *SearchedMessage) ReadField2(iprot thrift.TProtocol) error {
 
 	var _field int64
 	if v, err := iprot.ReadI64(); err != nil {
@@ -5659,10 +13564,10 @@ func (p *FriendRequest) ReadField2(iprot thrift.TProtocol) error {
 	} else {
 		_field = v
 	}
-	p.SenderID = _field
+	p.RoomID = _field
 	return nil
 }
-func (p *FriendRequest) ReadField3(iprot thrift.TProtocol) error {
+func (p *SearchedMessage) ReadField3(iprot thrift.TProtocol) error {
 
 	var _field int64
 	if v, err := iprot.ReadI64(); err != nil {
@@ -5670,43 +13575,43 @@ func (p *FriendRequest) ReadField3(iprot thrift.TProtocol) error {
 	} else {
 		_field = v
 	}
-	p.ReceiverID = _field
+	p.SenderID = _field
 	return nil
 }
-func (p *FriendRequest) ReadField4(iprot thrift.TProtocol) error {
+func (p *SearchedMessage) ReadField4(iprot thrift.TProtocol) error {
 
-	var _field *string
-	if v, err := iprot.ReadString(); err != nil {
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
 		return err
 	} else {
-		_field = &v
+		_field = v
 	}
-	p.Message = _field
+	p.ReceiverID = _field
 	return nil
 }
-func (p *FriendRequest) ReadField5(iprot thrift.TProtocol) error {
+func (p *SearchedMessage) ReadField5(iprot thrift.TProtocol) error {
 
-	var _field int8
-	if v, err := iprot.ReadByte(); err != nil {
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
 		return err
 	} else {
 		_field = v
 	}
-	p.Status = _field
+	p.Content = _field
 	return nil
 }
-func (p *FriendRequest) ReadField6(iprot thrift.TProtocol) error {
+func (p *SearchedMessage) ReadField6(iprot thrift.TProtocol) error {
 
-	var _field int64
-	if v, err := iprot.ReadI64(); err != nil {
+	var _field int8
+	if v, err := iprot.ReadByte(); err != nil {
 		return err
 	} else {
 		_field = v
 	}
-	p.CreatedAt = _field
+	p.Type = _field
 	return nil
 }
-func (p *FriendRequest) ReadField7(iprot thrift.TProtocol) error {
+func (p *SearchedMessage) ReadField7(iprot thrift.TProtocol) error {
 
 	var _field int64
 	if v, err := iprot.ReadI64(); err != nil {
@@ -5714,24 +13619,24 @@ func (p *FriendRequest) ReadField7(iprot thrift.TProtocol) error {
 	} else {
 		_field = v
 	}
-	p.UpdatedAt = _field
+	p.Seq = _field
 	return nil
 }
-func (p *FriendRequest) ReadField8(iprot thrift.TProtocol) error {
+func (p *SearchedMessage) ReadField8(iprot thrift.TProtocol) error {
 
-	var _field *int64
+	var _field int64
 	if v, err := iprot.ReadI64(); err != nil {
 		return err
 	} else {
-		_field = &v
+		_field = v
 	}
-	p.DeletedAt = _field
+	p.CreatedAt = _field
 	return nil
 }
 
-func (p *FriendRequest) Write(oprot thrift.TProtocol) (err error) {
+func (p *SearchedMessage) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("FriendRequest"); err != nil {
+	if err = oprot.WriteStructBegin("SearchedMessage"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -5785,7 +13690,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *FriendRequest) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *SearchedMessage) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("id", thrift.I64, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -5801,11 +13706,11 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
-func (p *FriendRequest) writeField2(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("sender_id", thrift.I64, 2); err != nil {
+func (p *SearchedMessage) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("room_id", thrift.I64, 2); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteI64(p.SenderID); err != nil {
+	if err := oprot.WriteI64(p.RoomID); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -5817,11 +13722,11 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
-func (p *FriendRequest) writeField3(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("receiver_id", thrift.I64, 3); err != nil {
+func (p *SearchedMessage) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("sender_id", thrift.I64, 3); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteI64(p.ReceiverID); err != nil {
+	if err := oprot.WriteI64(p.SenderID); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -5833,17 +13738,15 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
-func (p *FriendRequest) writeField4(oprot thrift.TProtocol) (err error) {
-	if p.IsSetMessage() {
-		if err = oprot.WriteFieldBegin("message", thrift.STRING, 4); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteString(*p.Message); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
+func (p *SearchedMessage) writeField4(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("receiver_id", thrift.I64, 4); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.ReceiverID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
 	}
 	return nil
 WriteFieldBeginError:
@@ -5851,11 +13754,11 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
 }
-func (p *FriendRequest) writeField5(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("status", thrift.BYTE, 5); err != nil {
+func (p *SearchedMessage) writeField5(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("content", thrift.STRING, 5); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteByte(p.Status); err != nil {
+	if err := oprot.WriteString(p.Content); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -5867,11 +13770,11 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
 }
-func (p *FriendRequest) writeField6(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("created_at", thrift.I64, 6); err != nil {
+func (p *SearchedMessage) writeField6(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("type", thrift.BYTE, 6); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteI64(p.CreatedAt); err != nil {
+	if err := oprot.WriteByte(p.Type); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -5883,11 +13786,11 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
 }
-func (p *FriendRequest) writeField7(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("updated_at", thrift.I64, 7); err != nil {
+func (p *SearchedMessage) writeField7(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("seq", thrift.I64, 7); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteI64(p.UpdatedAt); err != nil {
+	if err := oprot.WriteI64(p.Seq); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -5899,17 +13802,15 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 7 end error: ", p), err)
 }
-func (p *FriendRequest) writeField8(oprot thrift.TProtocol) (err error) {
-	if p.IsSetDeletedAt() {
-		if err = oprot.WriteFieldBegin("deleted_at", thrift.I64, 8); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteI64(*p.DeletedAt); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
+func (p *SearchedMessage) writeField8(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("created_at", thrift.I64, 8); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.CreatedAt); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
 	}
 	return nil
 WriteFieldBeginError:
@@ -5918,11 +13819,11 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 8 end error: ", p), err)
 }
 
-func (p *FriendRequest) String() string {
+func (p *SearchedMessage) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("FriendRequest(%+v)", *p)
+	return fmt.Sprintf("SearchedMessage(%+v)", *p)
 
 }
 
@@ -5,6 +5,7 @@ package video
 import (
 	"context"
 	"fmt"
+
 	"github.com/apache/thrift/lib/go/thrift"
 	"github.com/yxrxy/videoHub/app/gateway/model/model"
 )
@@ -4953,155 +4954,1028 @@ func (p *SemanticSearchResponse) String() string {
 
 }
 
-type VideoService interface {
-	Publish(ctx context.Context, req *PublishRequest) (r *PublishResponse, err error)
+// 关注流请求，按视频ID倒序
+type FollowingFeedRequest struct {
+	// 上一页返回的 next_cursor，首页不传
+	Cursor *int64 `thrift:"cursor,1,optional" form:"cursor" json:"cursor,omitempty" query:"cursor"`
+	// 每页数量，默认20
+	Limit *int32 `thrift:"limit,2,optional" form:"limit" json:"limit,omitempty" query:"limit"`
+}
 
-	List(ctx context.Context, req *VideoListRequest) (r *VideoListResponse, err error)
+func NewFollowingFeedRequest() *FollowingFeedRequest {
+	return &FollowingFeedRequest{}
+}
 
-	Detail(ctx context.Context, req *DetailRequest) (r *DetailResponse, err error)
+func (p *FollowingFeedRequest) InitDefault() {
+}
 
-	GetHotVideos(ctx context.Context, req *HotVideoRequest) (r *HotVideoResponse, err error)
+var FollowingFeedRequest_Cursor_DEFAULT int64
 
-	Delete(ctx context.Context, req *DeleteRequest) (r *DeleteResponse, err error)
+func (p *FollowingFeedRequest) GetCursor() (v int64) {
+	if !p.IsSetCursor() {
+		return FollowingFeedRequest_Cursor_DEFAULT
+	}
+	return *p.Cursor
+}
 
-	IncrementVisitCount(ctx context.Context, req *IncrementVisitCountRequest) (r *IncrementVisitCountResponse, err error)
+var FollowingFeedRequest_Limit_DEFAULT int32
 
-	IncrementLikeCount(ctx context.Context, req *IncrementLikeCountRequest) (r *IncrementLikeCountResponse, err error)
+func (p *FollowingFeedRequest) GetLimit() (v int32) {
+	if !p.IsSetLimit() {
+		return FollowingFeedRequest_Limit_DEFAULT
+	}
+	return *p.Limit
+}
 
-	Search(ctx context.Context, req *SearchRequest) (r *SearchResponse, err error)
+var fieldIDToName_FollowingFeedRequest = map[int16]string{
+	1: "cursor",
+	2: "limit",
+}
 
-	SemanticSearch(ctx context.Context, req *SemanticSearchRequest) (r *SemanticSearchResponse, err error)
+func (p *FollowingFeedRequest) IsSetCursor() bool {
+	return p.Cursor != nil
 }
 
-type VideoServiceClient struct {
-	c thrift.TClient
+func (p *FollowingFeedRequest) IsSetLimit() bool {
+	return p.Limit != nil
 }
 
-func NewVideoServiceClientFactory(t thrift.TTransport, f thrift.TProtocolFactory) *VideoServiceClient {
-	return &VideoServiceClient{
-		c: thrift.NewTStandardClient(f.GetProtocol(t), f.GetProtocol(t)),
+func (p *FollowingFeedRequest) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
 	}
-}
 
-func NewVideoServiceClientProtocol(t thrift.TTransport, iprot thrift.TProtocol, oprot thrift.TProtocol) *VideoServiceClient {
-	return &VideoServiceClient{
-		c: thrift.NewTStandardClient(iprot, oprot),
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
 	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_FollowingFeedRequest[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func NewVideoServiceClient(c thrift.TClient) *VideoServiceClient {
-	return &VideoServiceClient{
-		c: c,
+func (p *FollowingFeedRequest) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
 	}
+	p.Cursor = _field
+	return nil
 }
+func (p *FollowingFeedRequest) ReadField2(iprot thrift.TProtocol) error {
 
-func (p *VideoServiceClient) Client_() thrift.TClient {
-	return p.c
+	var _field *int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Limit = _field
+	return nil
 }
 
-func (p *VideoServiceClient) Publish(ctx context.Context, req *PublishRequest) (r *PublishResponse, err error) {
-	var _args VideoServicePublishArgs
-	_args.Req = req
-	var _result VideoServicePublishResult
-	if err = p.Client_().Call(ctx, "Publish", &_args, &_result); err != nil {
-		return
+func (p *FollowingFeedRequest) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("FollowingFeedRequest"); err != nil {
+		goto WriteStructBeginError
 	}
-	return _result.GetSuccess(), nil
-}
-func (p *VideoServiceClient) List(ctx context.Context, req *VideoListRequest) (r *VideoListResponse, err error) {
-	var _args VideoServiceListArgs
-	_args.Req = req
-	var _result VideoServiceListResult
-	if err = p.Client_().Call(ctx, "List", &_args, &_result); err != nil {
-		return
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
 	}
-	return _result.GetSuccess(), nil
-}
-func (p *VideoServiceClient) Detail(ctx context.Context, req *DetailRequest) (r *DetailResponse, err error) {
-	var _args VideoServiceDetailArgs
-	_args.Req = req
-	var _result VideoServiceDetailResult
-	if err = p.Client_().Call(ctx, "Detail", &_args, &_result); err != nil {
-		return
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
 	}
-	return _result.GetSuccess(), nil
-}
-func (p *VideoServiceClient) GetHotVideos(ctx context.Context, req *HotVideoRequest) (r *HotVideoResponse, err error) {
-	var _args VideoServiceGetHotVideosArgs
-	_args.Req = req
-	var _result VideoServiceGetHotVideosResult
-	if err = p.Client_().Call(ctx, "GetHotVideos", &_args, &_result); err != nil {
-		return
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
 	}
-	return _result.GetSuccess(), nil
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
-func (p *VideoServiceClient) Delete(ctx context.Context, req *DeleteRequest) (r *DeleteResponse, err error) {
-	var _args VideoServiceDeleteArgs
-	_args.Req = req
-	var _result VideoServiceDeleteResult
-	if err = p.Client_().Call(ctx, "Delete", &_args, &_result); err != nil {
-		return
+
+func (p *FollowingFeedRequest) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetCursor() {
+		if err = oprot.WriteFieldBegin("cursor", thrift.I64, 1); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.Cursor); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
 	}
-	return _result.GetSuccess(), nil
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
-func (p *VideoServiceClient) IncrementVisitCount(ctx context.Context, req *IncrementVisitCountRequest) (r *IncrementVisitCountResponse, err error) {
-	var _args VideoServiceIncrementVisitCountArgs
-	_args.Req = req
-	var _result VideoServiceIncrementVisitCountResult
-	if err = p.Client_().Call(ctx, "IncrementVisitCount", &_args, &_result); err != nil {
-		return
+func (p *FollowingFeedRequest) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetLimit() {
+		if err = oprot.WriteFieldBegin("limit", thrift.I32, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI32(*p.Limit); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
 	}
-	return _result.GetSuccess(), nil
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
-func (p *VideoServiceClient) IncrementLikeCount(ctx context.Context, req *IncrementLikeCountRequest) (r *IncrementLikeCountResponse, err error) {
-	var _args VideoServiceIncrementLikeCountArgs
-	_args.Req = req
-	var _result VideoServiceIncrementLikeCountResult
-	if err = p.Client_().Call(ctx, "IncrementLikeCount", &_args, &_result); err != nil {
-		return
+
+func (p *FollowingFeedRequest) String() string {
+	if p == nil {
+		return "<nil>"
 	}
-	return _result.GetSuccess(), nil
+	return fmt.Sprintf("FollowingFeedRequest(%+v)", *p)
+
 }
-func (p *VideoServiceClient) Search(ctx context.Context, req *SearchRequest) (r *SearchResponse, err error) {
-	var _args VideoServiceSearchArgs
-	_args.Req = req
-	var _result VideoServiceSearchResult
-	if err = p.Client_().Call(ctx, "Search", &_args, &_result); err != nil {
-		return
-	}
-	return _result.GetSuccess(), nil
+
+// 关注流响应
+type FollowingFeedResponse struct {
+	// 基本响应信息
+	Base *model.BaseResp `thrift:"Base,1,required" form:"Base,required" json:"Base,required" query:"Base,required"`
+	// 视频列表
+	Videos []*model.Video `thrift:"videos,2,required" form:"videos,required" json:"videos,required" query:"videos,required"`
+	// 下一页游标
+	NextCursor *int64 `thrift:"next_cursor,3,optional" form:"next_cursor" json:"next_cursor,omitempty" query:"next_cursor"`
+	// 是否还有更多
+	HasMore bool `thrift:"has_more,4,required" form:"has_more,required" json:"has_more,required" query:"has_more,required"`
 }
-func (p *VideoServiceClient) SemanticSearch(ctx context.Context, req *SemanticSearchRequest) (r *SemanticSearchResponse, err error) {
-	var _args VideoServiceSemanticSearchArgs
-	_args.Req = req
-	var _result VideoServiceSemanticSearchResult
-	if err = p.Client_().Call(ctx, "SemanticSearch", &_args, &_result); err != nil {
-		return
-	}
-	return _result.GetSuccess(), nil
+
+func NewFollowingFeedResponse() *FollowingFeedResponse {
+	return &FollowingFeedResponse{}
 }
 
-type VideoServiceProcessor struct {
-	processorMap map[string]thrift.TProcessorFunction
-	handler      VideoService
+func (p *FollowingFeedResponse) InitDefault() {
 }
 
-func (p *VideoServiceProcessor) AddToProcessorMap(key string, processor thrift.TProcessorFunction) {
-	p.processorMap[key] = processor
+var FollowingFeedResponse_Base_DEFAULT *model.BaseResp
+
+func (p *FollowingFeedResponse) GetBase() (v *model.BaseResp) {
+	if !p.IsSetBase() {
+		return FollowingFeedResponse_Base_DEFAULT
+	}
+	return p.Base
 }
 
-func (p *VideoServiceProcessor) GetProcessorFunction(key string) (processor thrift.TProcessorFunction, ok bool) {
-	processor, ok = p.processorMap[key]
-	return processor, ok
+func (p *FollowingFeedResponse) GetVideos() (v []*model.Video) {
+	return p.Videos
 }
 
-func (p *VideoServiceProcessor) ProcessorMap() map[string]thrift.TProcessorFunction {
-	return p.processorMap
+var FollowingFeedResponse_NextCursor_DEFAULT int64
+
+func (p *FollowingFeedResponse) GetNextCursor() (v int64) {
+	if !p.IsSetNextCursor() {
+		return FollowingFeedResponse_NextCursor_DEFAULT
+	}
+	return *p.NextCursor
 }
 
-func NewVideoServiceProcessor(handler VideoService) *VideoServiceProcessor {
-	self := &VideoServiceProcessor{handler: handler, processorMap: make(map[string]thrift.TProcessorFunction)}
-	self.AddToProcessorMap("Publish", &videoServiceProcessorPublish{handler: handler})
+func (p *FollowingFeedResponse) GetHasMore() (v bool) {
+	return p.HasMore
+}
+
+var fieldIDToName_FollowingFeedResponse = map[int16]string{
+	1: "Base",
+	2: "videos",
+	3: "next_cursor",
+	4: "has_more",
+}
+
+func (p *FollowingFeedResponse) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *FollowingFeedResponse) IsSetNextCursor() bool {
+	return p.NextCursor != nil
+}
+
+func (p *FollowingFeedResponse) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetBase bool = false
+	var issetVideos bool = false
+	var issetHasMore bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetBase = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetVideos = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.BOOL {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetHasMore = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	if !issetBase {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetVideos {
+		fieldId = 2
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetHasMore {
+		fieldId = 4
+		goto RequiredFieldNotSetError
+	}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_FollowingFeedResponse[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_FollowingFeedResponse[fieldId]))
+}
+
+func (p *FollowingFeedResponse) ReadField1(iprot thrift.TProtocol) error {
+	_field := model.NewBaseResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Base = _field
+	return nil
+}
+func (p *FollowingFeedResponse) ReadField2(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*model.Video, 0, size)
+	values := make([]model.Video, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.Videos = _field
+	return nil
+}
+func (p *FollowingFeedResponse) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.NextCursor = _field
+	return nil
+}
+func (p *FollowingFeedResponse) ReadField4(iprot thrift.TProtocol) error {
+
+	var _field bool
+	if v, err := iprot.ReadBool(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.HasMore = _field
+	return nil
+}
+
+func (p *FollowingFeedResponse) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("FollowingFeedResponse"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *FollowingFeedResponse) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("Base", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Base.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *FollowingFeedResponse) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("videos", thrift.LIST, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Videos)); err != nil {
+		return err
+	}
+	for _, v := range p.Videos {
+		if err := v.Write(oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteListEnd(); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+func (p *FollowingFeedResponse) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetNextCursor() {
+		if err = oprot.WriteFieldBegin("next_cursor", thrift.I64, 3); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.NextCursor); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+func (p *FollowingFeedResponse) writeField4(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("has_more", thrift.BOOL, 4); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteBool(p.HasMore); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+
+func (p *FollowingFeedResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("FollowingFeedResponse(%+v)", *p)
+
+}
+
+// 新关注时回填关注流请求
+type BackfillFeedRequest struct {
+	// 新关注的作者ID
+	CreatorID int64 `thrift:"creator_id,1,required" form:"creator_id,required" json:"creator_id,required" query:"creator_id,required"`
+}
+
+func NewBackfillFeedRequest() *BackfillFeedRequest {
+	return &BackfillFeedRequest{}
+}
+
+func (p *BackfillFeedRequest) InitDefault() {
+}
+
+func (p *BackfillFeedRequest) GetCreatorID() (v int64) {
+	return p.CreatorID
+}
+
+var fieldIDToName_BackfillFeedRequest = map[int16]string{
+	1: "creator_id",
+}
+
+func (p *BackfillFeedRequest) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetCreatorID bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetCreatorID = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	if !issetCreatorID {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_BackfillFeedRequest[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_BackfillFeedRequest[fieldId]))
+}
+
+func (p *BackfillFeedRequest) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.CreatorID = _field
+	return nil
+}
+
+func (p *BackfillFeedRequest) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("BackfillFeedRequest"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *BackfillFeedRequest) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("creator_id", thrift.I64, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.CreatorID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *BackfillFeedRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("BackfillFeedRequest(%+v)", *p)
+
+}
+
+// 新关注时回填关注流响应
+type BackfillFeedResponse struct {
+	// 基本响应信息
+	Base *model.BaseResp `thrift:"Base,1,required" form:"Base,required" json:"Base,required" query:"Base,required"`
+}
+
+func NewBackfillFeedResponse() *BackfillFeedResponse {
+	return &BackfillFeedResponse{}
+}
+
+func (p *BackfillFeedResponse) InitDefault() {
+}
+
+var BackfillFeedResponse_Base_DEFAULT *model.BaseResp
+
+func (p *BackfillFeedResponse) GetBase() (v *model.BaseResp) {
+	if !p.IsSetBase() {
+		return BackfillFeedResponse_Base_DEFAULT
+	}
+	return p.Base
+}
+
+var fieldIDToName_BackfillFeedResponse = map[int16]string{
+	1: "Base",
+}
+
+func (p *BackfillFeedResponse) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *BackfillFeedResponse) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetBase bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetBase = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	if !issetBase {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_BackfillFeedResponse[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_BackfillFeedResponse[fieldId]))
+}
+
+func (p *BackfillFeedResponse) ReadField1(iprot thrift.TProtocol) error {
+	_field := model.NewBaseResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Base = _field
+	return nil
+}
+
+func (p *BackfillFeedResponse) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("BackfillFeedResponse"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *BackfillFeedResponse) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("Base", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Base.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *BackfillFeedResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("BackfillFeedResponse(%+v)", *p)
+
+}
+
+type VideoService interface {
+	Publish(ctx context.Context, req *PublishRequest) (r *PublishResponse, err error)
+
+	List(ctx context.Context, req *VideoListRequest) (r *VideoListResponse, err error)
+
+	Detail(ctx context.Context, req *DetailRequest) (r *DetailResponse, err error)
+
+	GetHotVideos(ctx context.Context, req *HotVideoRequest) (r *HotVideoResponse, err error)
+
+	Delete(ctx context.Context, req *DeleteRequest) (r *DeleteResponse, err error)
+
+	IncrementVisitCount(ctx context.Context, req *IncrementVisitCountRequest) (r *IncrementVisitCountResponse, err error)
+
+	IncrementLikeCount(ctx context.Context, req *IncrementLikeCountRequest) (r *IncrementLikeCountResponse, err error)
+
+	Search(ctx context.Context, req *SearchRequest) (r *SearchResponse, err error)
+
+	SemanticSearch(ctx context.Context, req *SemanticSearchRequest) (r *SemanticSearchResponse, err error)
+
+	GetFollowingFeed(ctx context.Context, req *FollowingFeedRequest) (r *FollowingFeedResponse, err error)
+
+	BackfillFeed(ctx context.Context, req *BackfillFeedRequest) (r *BackfillFeedResponse, err error)
+}
+
+type VideoServiceClient struct {
+	c thrift.TClient
+}
+
+func NewVideoServiceClientFactory(t thrift.TTransport, f thrift.TProtocolFactory) *VideoServiceClient {
+	return &VideoServiceClient{
+		c: thrift.NewTStandardClient(f.GetProtocol(t), f.GetProtocol(t)),
+	}
+}
+
+func NewVideoServiceClientProtocol(t thrift.TTransport, iprot thrift.TProtocol, oprot thrift.TProtocol) *VideoServiceClient {
+	return &VideoServiceClient{
+		c: thrift.NewTStandardClient(iprot, oprot),
+	}
+}
+
+func NewVideoServiceClient(c thrift.TClient) *VideoServiceClient {
+	return &VideoServiceClient{
+		c: c,
+	}
+}
+
+func (p *VideoServiceClient) Client_() thrift.TClient {
+	return p.c
+}
+
+func (p *VideoServiceClient) Publish(ctx context.Context, req *PublishRequest) (r *PublishResponse, err error) {
+	var _args VideoServicePublishArgs
+	_args.Req = req
+	var _result VideoServicePublishResult
+	if err = p.Client_().Call(ctx, "Publish", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *VideoServiceClient) List(ctx context.Context, req *VideoListRequest) (r *VideoListResponse, err error) {
+	var _args VideoServiceListArgs
+	_args.Req = req
+	var _result VideoServiceListResult
+	if err = p.Client_().Call(ctx, "List", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *VideoServiceClient) Detail(ctx context.Context, req *DetailRequest) (r *DetailResponse, err error) {
+	var _args VideoServiceDetailArgs
+	_args.Req = req
+	var _result VideoServiceDetailResult
+	if err = p.Client_().Call(ctx, "Detail", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *VideoServiceClient) GetHotVideos(ctx context.Context, req *HotVideoRequest) (r *HotVideoResponse, err error) {
+	var _args VideoServiceGetHotVideosArgs
+	_args.Req = req
+	var _result VideoServiceGetHotVideosResult
+	if err = p.Client_().Call(ctx, "GetHotVideos", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *VideoServiceClient) Delete(ctx context.Context, req *DeleteRequest) (r *DeleteResponse, err error) {
+	var _args VideoServiceDeleteArgs
+	_args.Req = req
+	var _result VideoServiceDeleteResult
+	if err = p.Client_().Call(ctx, "Delete", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *VideoServiceClient) IncrementVisitCount(ctx context.Context, req *IncrementVisitCountRequest) (r *IncrementVisitCountResponse, err error) {
+	var _args VideoServiceIncrementVisitCountArgs
+	_args.Req = req
+	var _result VideoServiceIncrementVisitCountResult
+	if err = p.Client_().Call(ctx, "IncrementVisitCount", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *VideoServiceClient) IncrementLikeCount(ctx context.Context, req *IncrementLikeCountRequest) (r *IncrementLikeCountResponse, err error) {
+	var _args VideoServiceIncrementLikeCountArgs
+	_args.Req = req
+	var _result VideoServiceIncrementLikeCountResult
+	if err = p.Client_().Call(ctx, "IncrementLikeCount", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *VideoServiceClient) Search(ctx context.Context, req *SearchRequest) (r *SearchResponse, err error) {
+	var _args VideoServiceSearchArgs
+	_args.Req = req
+	var _result VideoServiceSearchResult
+	if err = p.Client_().Call(ctx, "Search", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *VideoServiceClient) SemanticSearch(ctx context.Context, req *SemanticSearchRequest) (r *SemanticSearchResponse, err error) {
+	var _args VideoServiceSemanticSearchArgs
+	_args.Req = req
+	var _result VideoServiceSemanticSearchResult
+	if err = p.Client_().Call(ctx, "SemanticSearch", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *VideoServiceClient) GetFollowingFeed(ctx context.Context, req *FollowingFeedRequest) (r *FollowingFeedResponse, err error) {
+	var _args VideoServiceGetFollowingFeedArgs
+	_args.Req = req
+	var _result VideoServiceGetFollowingFeedResult
+	if err = p.Client_().Call(ctx, "GetFollowingFeed", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *VideoServiceClient) BackfillFeed(ctx context.Context, req *BackfillFeedRequest) (r *BackfillFeedResponse, err error) {
+	var _args VideoServiceBackfillFeedArgs
+	_args.Req = req
+	var _result VideoServiceBackfillFeedResult
+	if err = p.Client_().Call(ctx, "BackfillFeed", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+type VideoServiceProcessor struct {
+	processorMap map[string]thrift.TProcessorFunction
+	handler      VideoService
+}
+
+func (p *VideoServiceProcessor) AddToProcessorMap(key string, processor thrift.TProcessorFunction) {
+	p.processorMap[key] = processor
+}
+
+func (p *VideoServiceProcessor) GetProcessorFunction(key string) (processor thrift.TProcessorFunction, ok bool) {
+	processor, ok = p.processorMap[key]
+	return processor, ok
+}
+
+func (p *VideoServiceProcessor) ProcessorMap() map[string]thrift.TProcessorFunction {
+	return p.processorMap
+}
+
+func NewVideoServiceProcessor(handler VideoService) *VideoServiceProcessor {
+	self := &VideoServiceProcessor{handler: handler, processorMap: make(map[string]thrift.TProcessorFunction)}
+	self.AddToProcessorMap("Publish", &videoServiceProcessorPublish{handler: handler})
 	self.AddToProcessorMap("List", &videoServiceProcessorList{handler: handler})
 	self.AddToProcessorMap("Detail", &videoServiceProcessorDetail{handler: handler})
 	self.AddToProcessorMap("GetHotVideos", &videoServiceProcessorGetHotVideos{handler: handler})
@@ -5110,487 +5984,1169 @@ func NewVideoServiceProcessor(handler VideoService) *VideoServiceProcessor {
 	self.AddToProcessorMap("IncrementLikeCount", &videoServiceProcessorIncrementLikeCount{handler: handler})
 	self.AddToProcessorMap("Search", &videoServiceProcessorSearch{handler: handler})
 	self.AddToProcessorMap("SemanticSearch", &videoServiceProcessorSemanticSearch{handler: handler})
+	self.AddToProcessorMap("GetFollowingFeed", &videoServiceProcessorGetFollowingFeed{handler: handler})
+	self.AddToProcessorMap("BackfillFeed", &videoServiceProcessorBackfillFeed{handler: handler})
 	return self
 }
-func (p *VideoServiceProcessor) Process(ctx context.Context, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	name, _, seqId, err := iprot.ReadMessageBegin()
+func (p *VideoServiceProcessor) Process(ctx context.Context, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	name, _, seqId, err := iprot.ReadMessageBegin()
+	if err != nil {
+		return false, err
+	}
+	if processor, ok := p.GetProcessorFunction(name); ok {
+		return processor.Process(ctx, seqId, iprot, oprot)
+	}
+	iprot.Skip(thrift.STRUCT)
+	iprot.ReadMessageEnd()
+	x := thrift.NewTApplicationException(thrift.UNKNOWN_METHOD, "Unknown function "+name)
+	oprot.WriteMessageBegin(name, thrift.EXCEPTION, seqId)
+	x.Write(oprot)
+	oprot.WriteMessageEnd()
+	oprot.Flush(ctx)
+	return false, x
+}
+
+type videoServiceProcessorPublish struct {
+	handler VideoService
+}
+
+func (p *videoServiceProcessorPublish) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := VideoServicePublishArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("Publish", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := VideoServicePublishResult{}
+	var retval *PublishResponse
+	if retval, err2 = p.handler.Publish(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing Publish: "+err2.Error())
+		oprot.WriteMessageBegin("Publish", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("Publish", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type videoServiceProcessorList struct {
+	handler VideoService
+}
+
+func (p *videoServiceProcessorList) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := VideoServiceListArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("List", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := VideoServiceListResult{}
+	var retval *VideoListResponse
+	if retval, err2 = p.handler.List(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing List: "+err2.Error())
+		oprot.WriteMessageBegin("List", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("List", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type videoServiceProcessorDetail struct {
+	handler VideoService
+}
+
+func (p *videoServiceProcessorDetail) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := VideoServiceDetailArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("Detail", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := VideoServiceDetailResult{}
+	var retval *DetailResponse
+	if retval, err2 = p.handler.Detail(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing Detail: "+err2.Error())
+		oprot.WriteMessageBegin("Detail", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("Detail", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type videoServiceProcessorGetHotVideos struct {
+	handler VideoService
+}
+
+func (p *videoServiceProcessorGetHotVideos) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := VideoServiceGetHotVideosArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("GetHotVideos", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := VideoServiceGetHotVideosResult{}
+	var retval *HotVideoResponse
+	if retval, err2 = p.handler.GetHotVideos(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetHotVideos: "+err2.Error())
+		oprot.WriteMessageBegin("GetHotVideos", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("GetHotVideos", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type videoServiceProcessorDelete struct {
+	handler VideoService
+}
+
+func (p *videoServiceProcessorDelete) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := VideoServiceDeleteArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("Delete", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := VideoServiceDeleteResult{}
+	var retval *DeleteResponse
+	if retval, err2 = p.handler.Delete(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing Delete: "+err2.Error())
+		oprot.WriteMessageBegin("Delete", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("Delete", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type videoServiceProcessorIncrementVisitCount struct {
+	handler VideoService
+}
+
+func (p *videoServiceProcessorIncrementVisitCount) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := VideoServiceIncrementVisitCountArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("IncrementVisitCount", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := VideoServiceIncrementVisitCountResult{}
+	var retval *IncrementVisitCountResponse
+	if retval, err2 = p.handler.IncrementVisitCount(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing IncrementVisitCount: "+err2.Error())
+		oprot.WriteMessageBegin("IncrementVisitCount", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("IncrementVisitCount", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type videoServiceProcessorIncrementLikeCount struct {
+	handler VideoService
+}
+
+func (p *videoServiceProcessorIncrementLikeCount) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := VideoServiceIncrementLikeCountArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("IncrementLikeCount", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := VideoServiceIncrementLikeCountResult{}
+	var retval *IncrementLikeCountResponse
+	if retval, err2 = p.handler.IncrementLikeCount(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing IncrementLikeCount: "+err2.Error())
+		oprot.WriteMessageBegin("IncrementLikeCount", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("IncrementLikeCount", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type videoServiceProcessorSearch struct {
+	handler VideoService
+}
+
+func (p *videoServiceProcessorSearch) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := VideoServiceSearchArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("Search", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := VideoServiceSearchResult{}
+	var retval *SearchResponse
+	if retval, err2 = p.handler.Search(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing Search: "+err2.Error())
+		oprot.WriteMessageBegin("Search", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("Search", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type videoServiceProcessorSemanticSearch struct {
+	handler VideoService
+}
+
+func (p *videoServiceProcessorSemanticSearch) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := VideoServiceSemanticSearchArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("SemanticSearch", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := VideoServiceSemanticSearchResult{}
+	var retval *SemanticSearchResponse
+	if retval, err2 = p.handler.SemanticSearch(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing SemanticSearch: "+err2.Error())
+		oprot.WriteMessageBegin("SemanticSearch", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("SemanticSearch", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type videoServiceProcessorGetFollowingFeed struct {
+	handler VideoService
+}
+
+func (p *videoServiceProcessorGetFollowingFeed) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := VideoServiceGetFollowingFeedArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("GetFollowingFeed", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := VideoServiceGetFollowingFeedResult{}
+	var retval *FollowingFeedResponse
+	if retval, err2 = p.handler.GetFollowingFeed(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetFollowingFeed: "+err2.Error())
+		oprot.WriteMessageBegin("GetFollowingFeed", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("GetFollowingFeed", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
 	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type videoServiceProcessorBackfillFeed struct {
+	handler VideoService
+}
+
+func (p *videoServiceProcessorBackfillFeed) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := VideoServiceBackfillFeedArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("BackfillFeed", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
 		return false, err
 	}
-	if processor, ok := p.GetProcessorFunction(name); ok {
-		return processor.Process(ctx, seqId, iprot, oprot)
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := VideoServiceBackfillFeedResult{}
+	var retval *BackfillFeedResponse
+	if retval, err2 = p.handler.BackfillFeed(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing BackfillFeed: "+err2.Error())
+		oprot.WriteMessageBegin("BackfillFeed", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("BackfillFeed", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type VideoServicePublishArgs struct {
+	Req *PublishRequest `thrift:"req,1"`
+}
+
+func NewVideoServicePublishArgs() *VideoServicePublishArgs {
+	return &VideoServicePublishArgs{}
+}
+
+func (p *VideoServicePublishArgs) InitDefault() {
+}
+
+var VideoServicePublishArgs_Req_DEFAULT *PublishRequest
+
+func (p *VideoServicePublishArgs) GetReq() (v *PublishRequest) {
+	if !p.IsSetReq() {
+		return VideoServicePublishArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+var fieldIDToName_VideoServicePublishArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *VideoServicePublishArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *VideoServicePublishArgs) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VideoServicePublishArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *VideoServicePublishArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewPublishRequest()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Req = _field
+	return nil
+}
+
+func (p *VideoServicePublishArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("Publish_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *VideoServicePublishArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *VideoServicePublishArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("VideoServicePublishArgs(%+v)", *p)
+
+}
+
+type VideoServicePublishResult struct {
+	Success *PublishResponse `thrift:"success,0,optional"`
+}
+
+func NewVideoServicePublishResult() *VideoServicePublishResult {
+	return &VideoServicePublishResult{}
+}
+
+func (p *VideoServicePublishResult) InitDefault() {
+}
+
+var VideoServicePublishResult_Success_DEFAULT *PublishResponse
+
+func (p *VideoServicePublishResult) GetSuccess() (v *PublishResponse) {
+	if !p.IsSetSuccess() {
+		return VideoServicePublishResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var fieldIDToName_VideoServicePublishResult = map[int16]string{
+	0: "success",
+}
+
+func (p *VideoServicePublishResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *VideoServicePublishResult) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
 	}
-	iprot.Skip(thrift.STRUCT)
-	iprot.ReadMessageEnd()
-	x := thrift.NewTApplicationException(thrift.UNKNOWN_METHOD, "Unknown function "+name)
-	oprot.WriteMessageBegin(name, thrift.EXCEPTION, seqId)
-	x.Write(oprot)
-	oprot.WriteMessageEnd()
-	oprot.Flush(ctx)
-	return false, x
-}
 
-type videoServiceProcessorPublish struct {
-	handler VideoService
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VideoServicePublishResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *videoServiceProcessorPublish) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := VideoServicePublishArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("Publish", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
+func (p *VideoServicePublishResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewPublishResponse()
+	if err := _field.Read(iprot); err != nil {
+		return err
 	}
+	p.Success = _field
+	return nil
+}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := VideoServicePublishResult{}
-	var retval *PublishResponse
-	if retval, err2 = p.handler.Publish(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing Publish: "+err2.Error())
-		oprot.WriteMessageBegin("Publish", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
+func (p *VideoServicePublishResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("Publish_result"); err != nil {
+		goto WriteStructBeginError
 	}
-	if err2 = oprot.WriteMessageBegin("Publish", thrift.REPLY, seqId); err2 != nil {
-		err = err2
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
 	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
 	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *VideoServicePublishResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
 	}
-	if err != nil {
-		return
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *VideoServicePublishResult) String() string {
+	if p == nil {
+		return "<nil>"
 	}
-	return true, err
+	return fmt.Sprintf("VideoServicePublishResult(%+v)", *p)
+
 }
 
-type videoServiceProcessorList struct {
-	handler VideoService
+type VideoServiceListArgs struct {
+	Req *VideoListRequest `thrift:"req,1"`
 }
 
-func (p *videoServiceProcessorList) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := VideoServiceListArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("List", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
-	}
+func NewVideoServiceListArgs() *VideoServiceListArgs {
+	return &VideoServiceListArgs{}
+}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := VideoServiceListResult{}
-	var retval *VideoListResponse
-	if retval, err2 = p.handler.List(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing List: "+err2.Error())
-		oprot.WriteMessageBegin("List", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
-	}
-	if err2 = oprot.WriteMessageBegin("List", thrift.REPLY, seqId); err2 != nil {
-		err = err2
-	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
-	}
-	if err != nil {
-		return
+func (p *VideoServiceListArgs) InitDefault() {
+}
+
+var VideoServiceListArgs_Req_DEFAULT *VideoListRequest
+
+func (p *VideoServiceListArgs) GetReq() (v *VideoListRequest) {
+	if !p.IsSetReq() {
+		return VideoServiceListArgs_Req_DEFAULT
 	}
-	return true, err
+	return p.Req
 }
 
-type videoServiceProcessorDetail struct {
-	handler VideoService
+var fieldIDToName_VideoServiceListArgs = map[int16]string{
+	1: "req",
 }
 
-func (p *videoServiceProcessorDetail) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := VideoServiceDetailArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("Detail", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
-	}
+func (p *VideoServiceListArgs) IsSetReq() bool {
+	return p.Req != nil
+}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := VideoServiceDetailResult{}
-	var retval *DetailResponse
-	if retval, err2 = p.handler.Detail(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing Detail: "+err2.Error())
-		oprot.WriteMessageBegin("Detail", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
-	}
-	if err2 = oprot.WriteMessageBegin("Detail", thrift.REPLY, seqId); err2 != nil {
-		err = err2
-	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+func (p *VideoServiceListArgs) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
 	}
-	if err != nil {
-		return
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
 	}
-	return true, err
-}
 
-type videoServiceProcessorGetHotVideos struct {
-	handler VideoService
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VideoServiceListArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *videoServiceProcessorGetHotVideos) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := VideoServiceGetHotVideosArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("GetHotVideos", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
+func (p *VideoServiceListArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewVideoListRequest()
+	if err := _field.Read(iprot); err != nil {
+		return err
 	}
+	p.Req = _field
+	return nil
+}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := VideoServiceGetHotVideosResult{}
-	var retval *HotVideoResponse
-	if retval, err2 = p.handler.GetHotVideos(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetHotVideos: "+err2.Error())
-		oprot.WriteMessageBegin("GetHotVideos", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
+func (p *VideoServiceListArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("List_args"); err != nil {
+		goto WriteStructBeginError
 	}
-	if err2 = oprot.WriteMessageBegin("GetHotVideos", thrift.REPLY, seqId); err2 != nil {
-		err = err2
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
 	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
 	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *VideoServiceListArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
 	}
-	if err != nil {
-		return
+	if err := p.Req.Write(oprot); err != nil {
+		return err
 	}
-	return true, err
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-type videoServiceProcessorDelete struct {
-	handler VideoService
+func (p *VideoServiceListArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("VideoServiceListArgs(%+v)", *p)
+
 }
 
-func (p *videoServiceProcessorDelete) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := VideoServiceDeleteArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("Delete", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
-	}
+type VideoServiceListResult struct {
+	Success *VideoListResponse `thrift:"success,0,optional"`
+}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := VideoServiceDeleteResult{}
-	var retval *DeleteResponse
-	if retval, err2 = p.handler.Delete(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing Delete: "+err2.Error())
-		oprot.WriteMessageBegin("Delete", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
-	}
-	if err2 = oprot.WriteMessageBegin("Delete", thrift.REPLY, seqId); err2 != nil {
-		err = err2
-	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
-	}
-	if err != nil {
-		return
-	}
-	return true, err
+func NewVideoServiceListResult() *VideoServiceListResult {
+	return &VideoServiceListResult{}
 }
 
-type videoServiceProcessorIncrementVisitCount struct {
-	handler VideoService
+func (p *VideoServiceListResult) InitDefault() {
 }
 
-func (p *videoServiceProcessorIncrementVisitCount) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := VideoServiceIncrementVisitCountArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("IncrementVisitCount", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
-	}
+var VideoServiceListResult_Success_DEFAULT *VideoListResponse
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := VideoServiceIncrementVisitCountResult{}
-	var retval *IncrementVisitCountResponse
-	if retval, err2 = p.handler.IncrementVisitCount(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing IncrementVisitCount: "+err2.Error())
-		oprot.WriteMessageBegin("IncrementVisitCount", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
-	}
-	if err2 = oprot.WriteMessageBegin("IncrementVisitCount", thrift.REPLY, seqId); err2 != nil {
-		err = err2
-	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
-	}
-	if err != nil {
-		return
+func (p *VideoServiceListResult) GetSuccess() (v *VideoListResponse) {
+	if !p.IsSetSuccess() {
+		return VideoServiceListResult_Success_DEFAULT
 	}
-	return true, err
+	return p.Success
 }
 
-type videoServiceProcessorIncrementLikeCount struct {
-	handler VideoService
+var fieldIDToName_VideoServiceListResult = map[int16]string{
+	0: "success",
 }
 
-func (p *videoServiceProcessorIncrementLikeCount) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := VideoServiceIncrementLikeCountArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("IncrementLikeCount", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
-	}
+func (p *VideoServiceListResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := VideoServiceIncrementLikeCountResult{}
-	var retval *IncrementLikeCountResponse
-	if retval, err2 = p.handler.IncrementLikeCount(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing IncrementLikeCount: "+err2.Error())
-		oprot.WriteMessageBegin("IncrementLikeCount", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
-	}
-	if err2 = oprot.WriteMessageBegin("IncrementLikeCount", thrift.REPLY, seqId); err2 != nil {
-		err = err2
-	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+func (p *VideoServiceListResult) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
 	}
-	if err != nil {
-		return
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
 	}
-	return true, err
-}
 
-type videoServiceProcessorSearch struct {
-	handler VideoService
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VideoServiceListResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *videoServiceProcessorSearch) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := VideoServiceSearchArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("Search", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
+func (p *VideoServiceListResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewVideoListResponse()
+	if err := _field.Read(iprot); err != nil {
+		return err
 	}
+	p.Success = _field
+	return nil
+}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := VideoServiceSearchResult{}
-	var retval *SearchResponse
-	if retval, err2 = p.handler.Search(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing Search: "+err2.Error())
-		oprot.WriteMessageBegin("Search", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
-	}
-	if err2 = oprot.WriteMessageBegin("Search", thrift.REPLY, seqId); err2 != nil {
-		err = err2
-	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
+func (p *VideoServiceListResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("List_result"); err != nil {
+		goto WriteStructBeginError
 	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
 	}
-	if err != nil {
-		return
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
 	}
-	return true, err
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-type videoServiceProcessorSemanticSearch struct {
-	handler VideoService
+func (p *VideoServiceListResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *videoServiceProcessorSemanticSearch) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := VideoServiceSemanticSearchArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("SemanticSearch", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
+func (p *VideoServiceListResult) String() string {
+	if p == nil {
+		return "<nil>"
 	}
+	return fmt.Sprintf("VideoServiceListResult(%+v)", *p)
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := VideoServiceSemanticSearchResult{}
-	var retval *SemanticSearchResponse
-	if retval, err2 = p.handler.SemanticSearch(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing SemanticSearch: "+err2.Error())
-		oprot.WriteMessageBegin("SemanticSearch", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
-	}
-	if err2 = oprot.WriteMessageBegin("SemanticSearch", thrift.REPLY, seqId); err2 != nil {
-		err = err2
-	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
-	}
-	if err != nil {
-		return
-	}
-	return true, err
 }
 
-type VideoServicePublishArgs struct {
-	Req *PublishRequest `thrift:"req,1"`
+type VideoServiceDetailArgs struct {
+	Req *DetailRequest `thrift:"req,1"`
 }
 
-func NewVideoServicePublishArgs() *VideoServicePublishArgs {
-	return &VideoServicePublishArgs{}
+func NewVideoServiceDetailArgs() *VideoServiceDetailArgs {
+	return &VideoServiceDetailArgs{}
 }
 
-func (p *VideoServicePublishArgs) InitDefault() {
+func (p *VideoServiceDetailArgs) InitDefault() {
 }
 
-var VideoServicePublishArgs_Req_DEFAULT *PublishRequest
+var VideoServiceDetailArgs_Req_DEFAULT *DetailRequest
 
-func (p *VideoServicePublishArgs) GetReq() (v *PublishRequest) {
+func (p *VideoServiceDetailArgs) GetReq() (v *DetailRequest) {
 	if !p.IsSetReq() {
-		return VideoServicePublishArgs_Req_DEFAULT
+		return VideoServiceDetailArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-var fieldIDToName_VideoServicePublishArgs = map[int16]string{
+var fieldIDToName_VideoServiceDetailArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *VideoServicePublishArgs) IsSetReq() bool {
+func (p *VideoServiceDetailArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *VideoServicePublishArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *VideoServiceDetailArgs) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -5635,7 +7191,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VideoServicePublishArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VideoServiceDetailArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -5645,8 +7201,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *VideoServicePublishArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewPublishRequest()
+func (p *VideoServiceDetailArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewDetailRequest()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -5654,9 +7210,9 @@ func (p *VideoServicePublishArgs) ReadField1(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *VideoServicePublishArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *VideoServiceDetailArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("Publish_args"); err != nil {
+	if err = oprot.WriteStructBegin("Detail_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -5682,7 +7238,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *VideoServicePublishArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *VideoServiceDetailArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -5699,43 +7255,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *VideoServicePublishArgs) String() string {
+func (p *VideoServiceDetailArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("VideoServicePublishArgs(%+v)", *p)
+	return fmt.Sprintf("VideoServiceDetailArgs(%+v)", *p)
 
 }
 
-type VideoServicePublishResult struct {
-	Success *PublishResponse `thrift:"success,0,optional"`
+type VideoServiceDetailResult struct {
+	Success *DetailResponse `thrift:"success,0,optional"`
 }
 
-func NewVideoServicePublishResult() *VideoServicePublishResult {
-	return &VideoServicePublishResult{}
+func NewVideoServiceDetailResult() *VideoServiceDetailResult {
+	return &VideoServiceDetailResult{}
 }
 
-func (p *VideoServicePublishResult) InitDefault() {
+func (p *VideoServiceDetailResult) InitDefault() {
 }
 
-var VideoServicePublishResult_Success_DEFAULT *PublishResponse
+var VideoServiceDetailResult_Success_DEFAULT *DetailResponse
 
-func (p *VideoServicePublishResult) GetSuccess() (v *PublishResponse) {
+func (p *VideoServiceDetailResult) GetSuccess() (v *DetailResponse) {
 	if !p.IsSetSuccess() {
-		return VideoServicePublishResult_Success_DEFAULT
+		return VideoServiceDetailResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_VideoServicePublishResult = map[int16]string{
+var fieldIDToName_VideoServiceDetailResult = map[int16]string{
 	0: "success",
 }
 
-func (p *VideoServicePublishResult) IsSetSuccess() bool {
+func (p *VideoServiceDetailResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *VideoServicePublishResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *VideoServiceDetailResult) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -5780,7 +7336,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VideoServicePublishResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VideoServiceDetailResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -5790,8 +7346,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *VideoServicePublishResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewPublishResponse()
+func (p *VideoServiceDetailResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewDetailResponse()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -5799,9 +7355,9 @@ func (p *VideoServicePublishResult) ReadField0(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *VideoServicePublishResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *VideoServiceDetailResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("Publish_result"); err != nil {
+	if err = oprot.WriteStructBegin("Detail_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -5827,7 +7383,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *VideoServicePublishResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *VideoServiceDetailResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -5846,43 +7402,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *VideoServicePublishResult) String() string {
+func (p *VideoServiceDetailResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("VideoServicePublishResult(%+v)", *p)
+	return fmt.Sprintf("VideoServiceDetailResult(%+v)", *p)
 
 }
 
-type VideoServiceListArgs struct {
-	Req *VideoListRequest `thrift:"req,1"`
+type VideoServiceGetHotVideosArgs struct {
+	Req *HotVideoRequest `thrift:"req,1"`
 }
 
-func NewVideoServiceListArgs() *VideoServiceListArgs {
-	return &VideoServiceListArgs{}
+func NewVideoServiceGetHotVideosArgs() *VideoServiceGetHotVideosArgs {
+	return &VideoServiceGetHotVideosArgs{}
 }
 
-func (p *VideoServiceListArgs) InitDefault() {
+func (p *VideoServiceGetHotVideosArgs) InitDefault() {
 }
 
-var VideoServiceListArgs_Req_DEFAULT *VideoListRequest
+var VideoServiceGetHotVideosArgs_Req_DEFAULT *HotVideoRequest
 
-func (p *VideoServiceListArgs) GetReq() (v *VideoListRequest) {
+func (p *VideoServiceGetHotVideosArgs) GetReq() (v *HotVideoRequest) {
 	if !p.IsSetReq() {
-		return VideoServiceListArgs_Req_DEFAULT
+		return VideoServiceGetHotVideosArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-var fieldIDToName_VideoServiceListArgs = map[int16]string{
+var fieldIDToName_VideoServiceGetHotVideosArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *VideoServiceListArgs) IsSetReq() bool {
+func (p *VideoServiceGetHotVideosArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *VideoServiceListArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *VideoServiceGetHotVideosArgs) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -5927,7 +7483,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VideoServiceListArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VideoServiceGetHotVideosArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -5937,8 +7493,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *VideoServiceListArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewVideoListRequest()
+func (p *VideoServiceGetHotVideosArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewHotVideoRequest()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -5946,9 +7502,9 @@ func (p *VideoServiceListArgs) ReadField1(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *VideoServiceListArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *VideoServiceGetHotVideosArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("List_args"); err != nil {
+	if err = oprot.WriteStructBegin("GetHotVideos_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -5974,7 +7530,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *VideoServiceListArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *VideoServiceGetHotVideosArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -5991,43 +7547,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *VideoServiceListArgs) String() string {
+func (p *VideoServiceGetHotVideosArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("VideoServiceListArgs(%+v)", *p)
+	return fmt.Sprintf("VideoServiceGetHotVideosArgs(%+v)", *p)
 
 }
 
-type VideoServiceListResult struct {
-	Success *VideoListResponse `thrift:"success,0,optional"`
+type VideoServiceGetHotVideosResult struct {
+	Success *HotVideoResponse `thrift:"success,0,optional"`
 }
 
-func NewVideoServiceListResult() *VideoServiceListResult {
-	return &VideoServiceListResult{}
+func NewVideoServiceGetHotVideosResult() *VideoServiceGetHotVideosResult {
+	return &VideoServiceGetHotVideosResult{}
 }
 
-func (p *VideoServiceListResult) InitDefault() {
+func (p *VideoServiceGetHotVideosResult) InitDefault() {
 }
 
-var VideoServiceListResult_Success_DEFAULT *VideoListResponse
+var VideoServiceGetHotVideosResult_Success_DEFAULT *HotVideoResponse
 
-func (p *VideoServiceListResult) GetSuccess() (v *VideoListResponse) {
+func (p *VideoServiceGetHotVideosResult) GetSuccess() (v *HotVideoResponse) {
 	if !p.IsSetSuccess() {
-		return VideoServiceListResult_Success_DEFAULT
+		return VideoServiceGetHotVideosResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_VideoServiceListResult = map[int16]string{
+var fieldIDToName_VideoServiceGetHotVideosResult = map[int16]string{
 	0: "success",
 }
 
-func (p *VideoServiceListResult) IsSetSuccess() bool {
+func (p *VideoServiceGetHotVideosResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *VideoServiceListResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *VideoServiceGetHotVideosResult) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -6072,7 +7628,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VideoServiceListResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VideoServiceGetHotVideosResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -6082,8 +7638,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *VideoServiceListResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewVideoListResponse()
+func (p *VideoServiceGetHotVideosResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewHotVideoResponse()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -6091,9 +7647,9 @@ func (p *VideoServiceListResult) ReadField0(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *VideoServiceListResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *VideoServiceGetHotVideosResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("List_result"); err != nil {
+	if err = oprot.WriteStructBegin("GetHotVideos_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -6119,7 +7675,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *VideoServiceListResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *VideoServiceGetHotVideosResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -6138,43 +7694,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *VideoServiceListResult) String() string {
+func (p *VideoServiceGetHotVideosResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("VideoServiceListResult(%+v)", *p)
+	return fmt.Sprintf("VideoServiceGetHotVideosResult(%+v)", *p)
 
 }
 
-type VideoServiceDetailArgs struct {
-	Req *DetailRequest `thrift:"req,1"`
+type VideoServiceDeleteArgs struct {
+	Req *DeleteRequest `thrift:"req,1"`
 }
 
-func NewVideoServiceDetailArgs() *VideoServiceDetailArgs {
-	return &VideoServiceDetailArgs{}
+func NewVideoServiceDeleteArgs() *VideoServiceDeleteArgs {
+	return &VideoServiceDeleteArgs{}
 }
 
-func (p *VideoServiceDetailArgs) InitDefault() {
+func (p *VideoServiceDeleteArgs) InitDefault() {
 }
 
-var VideoServiceDetailArgs_Req_DEFAULT *DetailRequest
+var VideoServiceDeleteArgs_Req_DEFAULT *DeleteRequest
 
-func (p *VideoServiceDetailArgs) GetReq() (v *DetailRequest) {
+func (p *VideoServiceDeleteArgs) GetReq() (v *DeleteRequest) {
 	if !p.IsSetReq() {
-		return VideoServiceDetailArgs_Req_DEFAULT
+		return VideoServiceDeleteArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-var fieldIDToName_VideoServiceDetailArgs = map[int16]string{
+var fieldIDToName_VideoServiceDeleteArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *VideoServiceDetailArgs) IsSetReq() bool {
+func (p *VideoServiceDeleteArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *VideoServiceDetailArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *VideoServiceDeleteArgs) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -6219,7 +7775,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VideoServiceDetailArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VideoServiceDeleteArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -6229,8 +7785,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *VideoServiceDetailArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewDetailRequest()
+func (p *VideoServiceDeleteArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewDeleteRequest()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -6238,9 +7794,9 @@ func (p *VideoServiceDetailArgs) ReadField1(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *VideoServiceDetailArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *VideoServiceDeleteArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("Detail_args"); err != nil {
+	if err = oprot.WriteStructBegin("Delete_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -6266,7 +7822,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *VideoServiceDetailArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *VideoServiceDeleteArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -6283,43 +7839,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *VideoServiceDetailArgs) String() string {
+func (p *VideoServiceDeleteArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("VideoServiceDetailArgs(%+v)", *p)
+	return fmt.Sprintf("VideoServiceDeleteArgs(%+v)", *p)
 
 }
 
-type VideoServiceDetailResult struct {
-	Success *DetailResponse `thrift:"success,0,optional"`
+type VideoServiceDeleteResult struct {
+	Success *DeleteResponse `thrift:"success,0,optional"`
 }
 
-func NewVideoServiceDetailResult() *VideoServiceDetailResult {
-	return &VideoServiceDetailResult{}
+func NewVideoServiceDeleteResult() *VideoServiceDeleteResult {
+	return &VideoServiceDeleteResult{}
 }
 
-func (p *VideoServiceDetailResult) InitDefault() {
+func (p *VideoServiceDeleteResult) InitDefault() {
 }
 
-var VideoServiceDetailResult_Success_DEFAULT *DetailResponse
+var VideoServiceDeleteResult_Success_DEFAULT *DeleteResponse
 
-func (p *VideoServiceDetailResult) GetSuccess() (v *DetailResponse) {
+func (p *VideoServiceDeleteResult) GetSuccess() (v *DeleteResponse) {
 	if !p.IsSetSuccess() {
-		return VideoServiceDetailResult_Success_DEFAULT
+		return VideoServiceDeleteResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_VideoServiceDetailResult = map[int16]string{
+var fieldIDToName_VideoServiceDeleteResult = map[int16]string{
 	0: "success",
 }
 
-func (p *VideoServiceDetailResult) IsSetSuccess() bool {
+func (p *VideoServiceDeleteResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *VideoServiceDetailResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *VideoServiceDeleteResult) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -6364,7 +7920,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VideoServiceDetailResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VideoServiceDeleteResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -6374,8 +7930,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *VideoServiceDetailResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewDetailResponse()
+func (p *VideoServiceDeleteResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewDeleteResponse()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -6383,9 +7939,9 @@ func (p *VideoServiceDetailResult) ReadField0(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *VideoServiceDetailResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *VideoServiceDeleteResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("Detail_result"); err != nil {
+	if err = oprot.WriteStructBegin("Delete_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -6411,7 +7967,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *VideoServiceDetailResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *VideoServiceDeleteResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -6430,43 +7986,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *VideoServiceDetailResult) String() string {
+func (p *VideoServiceDeleteResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("VideoServiceDetailResult(%+v)", *p)
+	return fmt.Sprintf("VideoServiceDeleteResult(%+v)", *p)
 
 }
 
-type VideoServiceGetHotVideosArgs struct {
-	Req *HotVideoRequest `thrift:"req,1"`
+type VideoServiceIncrementVisitCountArgs struct {
+	Req *IncrementVisitCountRequest `thrift:"req,1"`
 }
 
-func NewVideoServiceGetHotVideosArgs() *VideoServiceGetHotVideosArgs {
-	return &VideoServiceGetHotVideosArgs{}
+func NewVideoServiceIncrementVisitCountArgs() *VideoServiceIncrementVisitCountArgs {
+	return &VideoServiceIncrementVisitCountArgs{}
 }
 
-func (p *VideoServiceGetHotVideosArgs) InitDefault() {
+func (p *VideoServiceIncrementVisitCountArgs) InitDefault() {
 }
 
-var VideoServiceGetHotVideosArgs_Req_DEFAULT *HotVideoRequest
+var VideoServiceIncrementVisitCountArgs_Req_DEFAULT *IncrementVisitCountRequest
 
-func (p *VideoServiceGetHotVideosArgs) GetReq() (v *HotVideoRequest) {
+func (p *VideoServiceIncrementVisitCountArgs) GetReq() (v *IncrementVisitCountRequest) {
 	if !p.IsSetReq() {
-		return VideoServiceGetHotVideosArgs_Req_DEFAULT
+		return VideoServiceIncrementVisitCountArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-var fieldIDToName_VideoServiceGetHotVideosArgs = map[int16]string{
+var fieldIDToName_VideoServiceIncrementVisitCountArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *VideoServiceGetHotVideosArgs) IsSetReq() bool {
+func (p *VideoServiceIncrementVisitCountArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *VideoServiceGetHotVideosArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *VideoServiceIncrementVisitCountArgs) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -6511,7 +8067,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VideoServiceGetHotVideosArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VideoServiceIncrementVisitCountArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -6521,8 +8077,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *VideoServiceGetHotVideosArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewHotVideoRequest()
+func (p *VideoServiceIncrementVisitCountArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewIncrementVisitCountRequest()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -6530,9 +8086,9 @@ func (p *VideoServiceGetHotVideosArgs) ReadField1(iprot thrift.TProtocol) error
 	return nil
 }
 
-func (p *VideoServiceGetHotVideosArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *VideoServiceIncrementVisitCountArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("GetHotVideos_args"); err != nil {
+	if err = oprot.WriteStructBegin("IncrementVisitCount_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -6558,7 +8114,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *VideoServiceGetHotVideosArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *VideoServiceIncrementVisitCountArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -6575,43 +8131,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *VideoServiceGetHotVideosArgs) String() string {
+func (p *VideoServiceIncrementVisitCountArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("VideoServiceGetHotVideosArgs(%+v)", *p)
+	return fmt.Sprintf("VideoServiceIncrementVisitCountArgs(%+v)", *p)
 
 }
 
-type VideoServiceGetHotVideosResult struct {
-	Success *HotVideoResponse `thrift:"success,0,optional"`
+type VideoServiceIncrementVisitCountResult struct {
+	Success *IncrementVisitCountResponse `thrift:"success,0,optional"`
 }
 
-func NewVideoServiceGetHotVideosResult() *VideoServiceGetHotVideosResult {
-	return &VideoServiceGetHotVideosResult{}
+func NewVideoServiceIncrementVisitCountResult() *VideoServiceIncrementVisitCountResult {
+	return &VideoServiceIncrementVisitCountResult{}
 }
 
-func (p *VideoServiceGetHotVideosResult) InitDefault() {
+func (p *VideoServiceIncrementVisitCountResult) InitDefault() {
 }
 
-var VideoServiceGetHotVideosResult_Success_DEFAULT *HotVideoResponse
+var VideoServiceIncrementVisitCountResult_Success_DEFAULT *IncrementVisitCountResponse
 
-func (p *VideoServiceGetHotVideosResult) GetSuccess() (v *HotVideoResponse) {
+func (p *VideoServiceIncrementVisitCountResult) GetSuccess() (v *IncrementVisitCountResponse) {
 	if !p.IsSetSuccess() {
-		return VideoServiceGetHotVideosResult_Success_DEFAULT
+		return VideoServiceIncrementVisitCountResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_VideoServiceGetHotVideosResult = map[int16]string{
+var fieldIDToName_VideoServiceIncrementVisitCountResult = map[int16]string{
 	0: "success",
 }
 
-func (p *VideoServiceGetHotVideosResult) IsSetSuccess() bool {
+func (p *VideoServiceIncrementVisitCountResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *VideoServiceGetHotVideosResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *VideoServiceIncrementVisitCountResult) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -6656,7 +8212,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VideoServiceGetHotVideosResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VideoServiceIncrementVisitCountResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -6666,8 +8222,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *VideoServiceGetHotVideosResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewHotVideoResponse()
+func (p *VideoServiceIncrementVisitCountResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewIncrementVisitCountResponse()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -6675,9 +8231,9 @@ func (p *VideoServiceGetHotVideosResult) ReadField0(iprot thrift.TProtocol) erro
 	return nil
 }
 
-func (p *VideoServiceGetHotVideosResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *VideoServiceIncrementVisitCountResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("GetHotVideos_result"); err != nil {
+	if err = oprot.WriteStructBegin("IncrementVisitCount_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -6703,7 +8259,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *VideoServiceGetHotVideosResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *VideoServiceIncrementVisitCountResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -6722,43 +8278,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *VideoServiceGetHotVideosResult) String() string {
+func (p *VideoServiceIncrementVisitCountResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("VideoServiceGetHotVideosResult(%+v)", *p)
+	return fmt.Sprintf("VideoServiceIncrementVisitCountResult(%+v)", *p)
 
 }
 
-type VideoServiceDeleteArgs struct {
-	Req *DeleteRequest `thrift:"req,1"`
+type VideoServiceIncrementLikeCountArgs struct {
+	Req *IncrementLikeCountRequest `thrift:"req,1"`
 }
 
-func NewVideoServiceDeleteArgs() *VideoServiceDeleteArgs {
-	return &VideoServiceDeleteArgs{}
+func NewVideoServiceIncrementLikeCountArgs() *VideoServiceIncrementLikeCountArgs {
+	return &VideoServiceIncrementLikeCountArgs{}
 }
 
-func (p *VideoServiceDeleteArgs) InitDefault() {
+func (p *VideoServiceIncrementLikeCountArgs) InitDefault() {
 }
 
-var VideoServiceDeleteArgs_Req_DEFAULT *DeleteRequest
+var VideoServiceIncrementLikeCountArgs_Req_DEFAULT *IncrementLikeCountRequest
 
-func (p *VideoServiceDeleteArgs) GetReq() (v *DeleteRequest) {
+func (p *VideoServiceIncrementLikeCountArgs) GetReq() (v *IncrementLikeCountRequest) {
 	if !p.IsSetReq() {
-		return VideoServiceDeleteArgs_Req_DEFAULT
+		return VideoServiceIncrementLikeCountArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-var fieldIDToName_VideoServiceDeleteArgs = map[int16]string{
+var fieldIDToName_VideoServiceIncrementLikeCountArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *VideoServiceDeleteArgs) IsSetReq() bool {
+func (p *VideoServiceIncrementLikeCountArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *VideoServiceDeleteArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *VideoServiceIncrementLikeCountArgs) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -6803,7 +8359,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VideoServiceDeleteArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VideoServiceIncrementLikeCountArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -6813,8 +8369,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *VideoServiceDeleteArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewDeleteRequest()
+func (p *VideoServiceIncrementLikeCountArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewIncrementLikeCountRequest()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -6822,9 +8378,9 @@ func (p *VideoServiceDeleteArgs) ReadField1(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *VideoServiceDeleteArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *VideoServiceIncrementLikeCountArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("Delete_args"); err != nil {
+	if err = oprot.WriteStructBegin("IncrementLikeCount_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -6850,7 +8406,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *VideoServiceDeleteArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *VideoServiceIncrementLikeCountArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -6867,43 +8423,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *VideoServiceDeleteArgs) String() string {
+func (p *VideoServiceIncrementLikeCountArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("VideoServiceDeleteArgs(%+v)", *p)
+	return fmt.Sprintf("VideoServiceIncrementLikeCountArgs(%+v)", *p)
 
 }
 
-type VideoServiceDeleteResult struct {
-	Success *DeleteResponse `thrift:"success,0,optional"`
+type VideoServiceIncrementLikeCountResult struct {
+	Success *IncrementLikeCountResponse `thrift:"success,0,optional"`
 }
 
-func NewVideoServiceDeleteResult() *VideoServiceDeleteResult {
-	return &VideoServiceDeleteResult{}
+func NewVideoServiceIncrementLikeCountResult() *VideoServiceIncrementLikeCountResult {
+	return &VideoServiceIncrementLikeCountResult{}
 }
 
-func (p *VideoServiceDeleteResult) InitDefault() {
+func (p *VideoServiceIncrementLikeCountResult) InitDefault() {
 }
 
-var VideoServiceDeleteResult_Success_DEFAULT *DeleteResponse
+var VideoServiceIncrementLikeCountResult_Success_DEFAULT *IncrementLikeCountResponse
 
-func (p *VideoServiceDeleteResult) GetSuccess() (v *DeleteResponse) {
+func (p *VideoServiceIncrementLikeCountResult) GetSuccess() (v *IncrementLikeCountResponse) {
 	if !p.IsSetSuccess() {
-		return VideoServiceDeleteResult_Success_DEFAULT
+		return VideoServiceIncrementLikeCountResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_VideoServiceDeleteResult = map[int16]string{
+var fieldIDToName_VideoServiceIncrementLikeCountResult = map[int16]string{
 	0: "success",
 }
 
-func (p *VideoServiceDeleteResult) IsSetSuccess() bool {
+func (p *VideoServiceIncrementLikeCountResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *VideoServiceDeleteResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *VideoServiceIncrementLikeCountResult) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -6948,7 +8504,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VideoServiceDeleteResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VideoServiceIncrementLikeCountResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -6958,8 +8514,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *VideoServiceDeleteResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewDeleteResponse()
+func (p *VideoServiceIncrementLikeCountResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewIncrementLikeCountResponse()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -6967,9 +8523,9 @@ func (p *VideoServiceDeleteResult) ReadField0(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *VideoServiceDeleteResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *VideoServiceIncrementLikeCountResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("Delete_result"); err != nil {
+	if err = oprot.WriteStructBegin("IncrementLikeCount_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -6995,7 +8551,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *VideoServiceDeleteResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *VideoServiceIncrementLikeCountResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -7014,43 +8570,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *VideoServiceDeleteResult) String() string {
+func (p *VideoServiceIncrementLikeCountResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("VideoServiceDeleteResult(%+v)", *p)
+	return fmt.Sprintf("VideoServiceIncrementLikeCountResult(%+v)", *p)
 
 }
 
-type VideoServiceIncrementVisitCountArgs struct {
-	Req *IncrementVisitCountRequest `thrift:"req,1"`
+type VideoServiceSearchArgs struct {
+	Req *SearchRequest `thrift:"req,1"`
 }
 
-func NewVideoServiceIncrementVisitCountArgs() *VideoServiceIncrementVisitCountArgs {
-	return &VideoServiceIncrementVisitCountArgs{}
+func NewVideoServiceSearchArgs() *VideoServiceSearchArgs {
+	return &VideoServiceSearchArgs{}
 }
 
-func (p *VideoServiceIncrementVisitCountArgs) InitDefault() {
+func (p *VideoServiceSearchArgs) InitDefault() {
 }
 
-var VideoServiceIncrementVisitCountArgs_Req_DEFAULT *IncrementVisitCountRequest
+var VideoServiceSearchArgs_Req_DEFAULT *SearchRequest
 
-func (p *VideoServiceIncrementVisitCountArgs) GetReq() (v *IncrementVisitCountRequest) {
+func (p *VideoServiceSearchArgs) GetReq() (v *SearchRequest) {
 	if !p.IsSetReq() {
-		return VideoServiceIncrementVisitCountArgs_Req_DEFAULT
+		return VideoServiceSearchArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-var fieldIDToName_VideoServiceIncrementVisitCountArgs = map[int16]string{
+var fieldIDToName_VideoServiceSearchArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *VideoServiceIncrementVisitCountArgs) IsSetReq() bool {
+func (p *VideoServiceSearchArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *VideoServiceIncrementVisitCountArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *VideoServiceSearchArgs) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -7095,7 +8651,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VideoServiceIncrementVisitCountArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VideoServiceSearchArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -7105,8 +8661,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *VideoServiceIncrementVisitCountArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewIncrementVisitCountRequest()
+func (p *VideoServiceSearchArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewSearchRequest()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -7114,9 +8670,9 @@ func (p *VideoServiceIncrementVisitCountArgs) ReadField1(iprot thrift.TProtocol)
 	return nil
 }
 
-func (p *VideoServiceIncrementVisitCountArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *VideoServiceSearchArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("IncrementVisitCount_args"); err != nil {
+	if err = oprot.WriteStructBegin("Search_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -7142,7 +8698,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *VideoServiceIncrementVisitCountArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *VideoServiceSearchArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -7159,43 +8715,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *VideoServiceIncrementVisitCountArgs) String() string {
+func (p *VideoServiceSearchArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("VideoServiceIncrementVisitCountArgs(%+v)", *p)
+	return fmt.Sprintf("VideoServiceSearchArgs(%+v)", *p)
 
 }
 
-type VideoServiceIncrementVisitCountResult struct {
-	Success *IncrementVisitCountResponse `thrift:"success,0,optional"`
+type VideoServiceSearchResult struct {
+	Success *SearchResponse `thrift:"success,0,optional"`
 }
 
-func NewVideoServiceIncrementVisitCountResult() *VideoServiceIncrementVisitCountResult {
-	return &VideoServiceIncrementVisitCountResult{}
+func NewVideoServiceSearchResult() *VideoServiceSearchResult {
+	return &VideoServiceSearchResult{}
 }
 
-func (p *VideoServiceIncrementVisitCountResult) InitDefault() {
+func (p *VideoServiceSearchResult) InitDefault() {
 }
 
-var VideoServiceIncrementVisitCountResult_Success_DEFAULT *IncrementVisitCountResponse
+var VideoServiceSearchResult_Success_DEFAULT *SearchResponse
 
-func (p *VideoServiceIncrementVisitCountResult) GetSuccess() (v *IncrementVisitCountResponse) {
+func (p *VideoServiceSearchResult) GetSuccess() (v *SearchResponse) {
 	if !p.IsSetSuccess() {
-		return VideoServiceIncrementVisitCountResult_Success_DEFAULT
+		return VideoServiceSearchResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_VideoServiceIncrementVisitCountResult = map[int16]string{
+var fieldIDToName_VideoServiceSearchResult = map[int16]string{
 	0: "success",
 }
 
-func (p *VideoServiceIncrementVisitCountResult) IsSetSuccess() bool {
+func (p *VideoServiceSearchResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *VideoServiceIncrementVisitCountResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *VideoServiceSearchResult) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -7240,7 +8796,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VideoServiceIncrementVisitCountResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VideoServiceSearchResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -7250,8 +8806,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *VideoServiceIncrementVisitCountResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewIncrementVisitCountResponse()
+func (p *VideoServiceSearchResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewSearchResponse()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -7259,9 +8815,9 @@ func (p *VideoServiceIncrementVisitCountResult) ReadField0(iprot thrift.TProtoco
 	return nil
 }
 
-func (p *VideoServiceIncrementVisitCountResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *VideoServiceSearchResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("IncrementVisitCount_result"); err != nil {
+	if err = oprot.WriteStructBegin("Search_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -7287,7 +8843,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *VideoServiceIncrementVisitCountResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *VideoServiceSearchResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -7306,43 +8862,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *VideoServiceIncrementVisitCountResult) String() string {
+func (p *VideoServiceSearchResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("VideoServiceIncrementVisitCountResult(%+v)", *p)
+	return fmt.Sprintf("VideoServiceSearchResult(%+v)", *p)
 
 }
 
-type VideoServiceIncrementLikeCountArgs struct {
-	Req *IncrementLikeCountRequest `thrift:"req,1"`
+type VideoServiceSemanticSearchArgs struct {
+	Req *SemanticSearchRequest `thrift:"req,1"`
 }
 
-func NewVideoServiceIncrementLikeCountArgs() *VideoServiceIncrementLikeCountArgs {
-	return &VideoServiceIncrementLikeCountArgs{}
+func NewVideoServiceSemanticSearchArgs() *VideoServiceSemanticSearchArgs {
+	return &VideoServiceSemanticSearchArgs{}
 }
 
-func (p *VideoServiceIncrementLikeCountArgs) InitDefault() {
+func (p *VideoServiceSemanticSearchArgs) InitDefault() {
 }
 
-var VideoServiceIncrementLikeCountArgs_Req_DEFAULT *IncrementLikeCountRequest
+var VideoServiceSemanticSearchArgs_Req_DEFAULT *SemanticSearchRequest
 
-func (p *VideoServiceIncrementLikeCountArgs) GetReq() (v *IncrementLikeCountRequest) {
+func (p *VideoServiceSemanticSearchArgs) GetReq() (v *SemanticSearchRequest) {
 	if !p.IsSetReq() {
-		return VideoServiceIncrementLikeCountArgs_Req_DEFAULT
+		return VideoServiceSemanticSearchArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-var fieldIDToName_VideoServiceIncrementLikeCountArgs = map[int16]string{
+var fieldIDToName_VideoServiceSemanticSearchArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *VideoServiceIncrementLikeCountArgs) IsSetReq() bool {
+func (p *VideoServiceSemanticSearchArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *VideoServiceIncrementLikeCountArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *VideoServiceSemanticSearchArgs) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -7387,7 +8943,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VideoServiceIncrementLikeCountArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VideoServiceSemanticSearchArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -7397,8 +8953,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *VideoServiceIncrementLikeCountArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewIncrementLikeCountRequest()
+func (p *VideoServiceSemanticSearchArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewSemanticSearchRequest()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -7406,9 +8962,9 @@ func (p *VideoServiceIncrementLikeCountArgs) ReadField1(iprot thrift.TProtocol)
 	return nil
 }
 
-func (p *VideoServiceIncrementLikeCountArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *VideoServiceSemanticSearchArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("IncrementLikeCount_args"); err != nil {
+	if err = oprot.WriteStructBegin("SemanticSearch_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -7434,7 +8990,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *VideoServiceIncrementLikeCountArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *VideoServiceSemanticSearchArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -7451,43 +9007,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *VideoServiceIncrementLikeCountArgs) String() string {
+func (p *VideoServiceSemanticSearchArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("VideoServiceIncrementLikeCountArgs(%+v)", *p)
+	return fmt.Sprintf("VideoServiceSemanticSearchArgs(%+v)", *p)
 
 }
 
-type VideoServiceIncrementLikeCountResult struct {
-	Success *IncrementLikeCountResponse `thrift:"success,0,optional"`
+type VideoServiceSemanticSearchResult struct {
+	Success *SemanticSearchResponse `thrift:"success,0,optional"`
 }
 
-func NewVideoServiceIncrementLikeCountResult() *VideoServiceIncrementLikeCountResult {
-	return &VideoServiceIncrementLikeCountResult{}
+func NewVideoServiceSemanticSearchResult() *VideoServiceSemanticSearchResult {
+	return &VideoServiceSemanticSearchResult{}
 }
 
-func (p *VideoServiceIncrementLikeCountResult) InitDefault() {
+func (p *VideoServiceSemanticSearchResult) InitDefault() {
 }
 
-var VideoServiceIncrementLikeCountResult_Success_DEFAULT *IncrementLikeCountResponse
+var VideoServiceSemanticSearchResult_Success_DEFAULT *SemanticSearchResponse
 
-func (p *VideoServiceIncrementLikeCountResult) GetSuccess() (v *IncrementLikeCountResponse) {
+func (p *VideoServiceSemanticSearchResult) GetSuccess() (v *SemanticSearchResponse) {
 	if !p.IsSetSuccess() {
-		return VideoServiceIncrementLikeCountResult_Success_DEFAULT
+		return VideoServiceSemanticSearchResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_VideoServiceIncrementLikeCountResult = map[int16]string{
+var fieldIDToName_VideoServiceSemanticSearchResult = map[int16]string{
 	0: "success",
 }
 
-func (p *VideoServiceIncrementLikeCountResult) IsSetSuccess() bool {
+func (p *VideoServiceSemanticSearchResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *VideoServiceIncrementLikeCountResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *VideoServiceSemanticSearchResult) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -7532,7 +9088,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VideoServiceIncrementLikeCountResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VideoServiceSemanticSearchResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -7542,8 +9098,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *VideoServiceIncrementLikeCountResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewIncrementLikeCountResponse()
+func (p *VideoServiceSemanticSearchResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewSemanticSearchResponse()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -7551,9 +9107,9 @@ func (p *VideoServiceIncrementLikeCountResult) ReadField0(iprot thrift.TProtocol
 	return nil
 }
 
-func (p *VideoServiceIncrementLikeCountResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *VideoServiceSemanticSearchResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("IncrementLikeCount_result"); err != nil {
+	if err = oprot.WriteStructBegin("SemanticSearch_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -7579,7 +9135,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *VideoServiceIncrementLikeCountResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *VideoServiceSemanticSearchResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -7598,43 +9154,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *VideoServiceIncrementLikeCountResult) String() string {
+func (p *VideoServiceSemanticSearchResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("VideoServiceIncrementLikeCountResult(%+v)", *p)
+	return fmt.Sprintf("VideoServiceSemanticSearchResult(%+v)", *p)
 
 }
 
-type VideoServiceSearchArgs struct {
-	Req *SearchRequest `thrift:"req,1"`
+type VideoServiceGetFollowingFeedArgs struct {
+	Req *FollowingFeedRequest `thrift:"req,1"`
 }
 
-func NewVideoServiceSearchArgs() *VideoServiceSearchArgs {
-	return &VideoServiceSearchArgs{}
+func NewVideoServiceGetFollowingFeedArgs() *VideoServiceGetFollowingFeedArgs {
+	return &VideoServiceGetFollowingFeedArgs{}
 }
 
-func (p *VideoServiceSearchArgs) InitDefault() {
+func (p *VideoServiceGetFollowingFeedArgs) InitDefault() {
 }
 
-var VideoServiceSearchArgs_Req_DEFAULT *SearchRequest
+var VideoServiceGetFollowingFeedArgs_Req_DEFAULT *FollowingFeedRequest
 
-func (p *VideoServiceSearchArgs) GetReq() (v *SearchRequest) {
+func (p *VideoServiceGetFollowingFeedArgs) GetReq() (v *FollowingFeedRequest) {
 	if !p.IsSetReq() {
-		return VideoServiceSearchArgs_Req_DEFAULT
+		return VideoServiceGetFollowingFeedArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-var fieldIDToName_VideoServiceSearchArgs = map[int16]string{
+var fieldIDToName_VideoServiceGetFollowingFeedArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *VideoServiceSearchArgs) IsSetReq() bool {
+func (p *VideoServiceGetFollowingFeedArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *VideoServiceSearchArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *VideoServiceGetFollowingFeedArgs) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -7679,7 +9235,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VideoServiceSearchArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VideoServiceGetFollowingFeedArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -7689,8 +9245,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *VideoServiceSearchArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewSearchRequest()
+func (p *VideoServiceGetFollowingFeedArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewFollowingFeedRequest()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -7698,9 +9254,9 @@ func (p *VideoServiceSearchArgs) ReadField1(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *VideoServiceSearchArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *VideoServiceGetFollowingFeedArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("Search_args"); err != nil {
+	if err = oprot.WriteStructBegin("GetFollowingFeed_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -7726,7 +9282,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *VideoServiceSearchArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *VideoServiceGetFollowingFeedArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -7743,43 +9299,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *VideoServiceSearchArgs) String() string {
+func (p *VideoServiceGetFollowingFeedArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("VideoServiceSearchArgs(%+v)", *p)
+	return fmt.Sprintf("VideoServiceGetFollowingFeedArgs(%+v)", *p)
 
 }
 
-type VideoServiceSearchResult struct {
-	Success *SearchResponse `thrift:"success,0,optional"`
+type VideoServiceGetFollowingFeedResult struct {
+	Success *FollowingFeedResponse `thrift:"success,0,optional"`
 }
 
-func NewVideoServiceSearchResult() *VideoServiceSearchResult {
-	return &VideoServiceSearchResult{}
+func NewVideoServiceGetFollowingFeedResult() *VideoServiceGetFollowingFeedResult {
+	return &VideoServiceGetFollowingFeedResult{}
 }
 
-func (p *VideoServiceSearchResult) InitDefault() {
+func (p *VideoServiceGetFollowingFeedResult) InitDefault() {
 }
 
-var VideoServiceSearchResult_Success_DEFAULT *SearchResponse
+var VideoServiceGetFollowingFeedResult_Success_DEFAULT *FollowingFeedResponse
 
-func (p *VideoServiceSearchResult) GetSuccess() (v *SearchResponse) {
+func (p *VideoServiceGetFollowingFeedResult) GetSuccess() (v *FollowingFeedResponse) {
 	if !p.IsSetSuccess() {
-		return VideoServiceSearchResult_Success_DEFAULT
+		return VideoServiceGetFollowingFeedResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_VideoServiceSearchResult = map[int16]string{
+var fieldIDToName_VideoServiceGetFollowingFeedResult = map[int16]string{
 	0: "success",
 }
 
-func (p *VideoServiceSearchResult) IsSetSuccess() bool {
+func (p *VideoServiceGetFollowingFeedResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *VideoServiceSearchResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *VideoServiceGetFollowingFeedResult) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -7824,7 +9380,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VideoServiceSearchResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VideoServiceGetFollowingFeedResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -7834,8 +9390,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *VideoServiceSearchResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewSearchResponse()
+func (p *VideoServiceGetFollowingFeedResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewFollowingFeedResponse()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -7843,9 +9399,9 @@ func (p *VideoServiceSearchResult) ReadField0(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *VideoServiceSearchResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *VideoServiceGetFollowingFeedResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("Search_result"); err != nil {
+	if err = oprot.WriteStructBegin("GetFollowingFeed_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -7871,7 +9427,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *VideoServiceSearchResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *VideoServiceGetFollowingFeedResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -7890,43 +9446,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *VideoServiceSearchResult) String() string {
+func (p *VideoServiceGetFollowingFeedResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("VideoServiceSearchResult(%+v)", *p)
+	return fmt.Sprintf("VideoServiceGetFollowingFeedResult(%+v)", *p)
 
 }
 
-type VideoServiceSemanticSearchArgs struct {
-	Req *SemanticSearchRequest `thrift:"req,1"`
+type VideoServiceBackfillFeedArgs struct {
+	Req *BackfillFeedRequest `thrift:"req,1"`
 }
 
-func NewVideoServiceSemanticSearchArgs() *VideoServiceSemanticSearchArgs {
-	return &VideoServiceSemanticSearchArgs{}
+func NewVideoServiceBackfillFeedArgs() *VideoServiceBackfillFeedArgs {
+	return &VideoServiceBackfillFeedArgs{}
 }
 
-func (p *VideoServiceSemanticSearchArgs) InitDefault() {
+func (p *VideoServiceBackfillFeedArgs) InitDefault() {
 }
 
-var VideoServiceSemanticSearchArgs_Req_DEFAULT *SemanticSearchRequest
+var VideoServiceBackfillFeedArgs_Req_DEFAULT *BackfillFeedRequest
 
-func (p *VideoServiceSemanticSearchArgs) GetReq() (v *SemanticSearchRequest) {
+func (p *VideoServiceBackfillFeedArgs) GetReq() (v *BackfillFeedRequest) {
 	if !p.IsSetReq() {
-		return VideoServiceSemanticSearchArgs_Req_DEFAULT
+		return VideoServiceBackfillFeedArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-var fieldIDToName_VideoServiceSemanticSearchArgs = map[int16]string{
+var fieldIDToName_VideoServiceBackfillFeedArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *VideoServiceSemanticSearchArgs) IsSetReq() bool {
+func (p *VideoServiceBackfillFeedArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *VideoServiceSemanticSearchArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *VideoServiceBackfillFeedArgs) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -7971,7 +9527,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VideoServiceSemanticSearchArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VideoServiceBackfillFeedArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -7981,8 +9537,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *VideoServiceSemanticSearchArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewSemanticSearchRequest()
+func (p *VideoServiceBackfillFeedArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewBackfillFeedRequest()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -7990,9 +9546,9 @@ func (p *VideoServiceSemanticSearchArgs) ReadField1(iprot thrift.TProtocol) erro
 	return nil
 }
 
-func (p *VideoServiceSemanticSearchArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *VideoServiceBackfillFeedArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("SemanticSearch_args"); err != nil {
+	if err = oprot.WriteStructBegin("BackfillFeed_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -8018,7 +9574,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *VideoServiceSemanticSearchArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *VideoServiceBackfillFeedArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -8035,43 +9591,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *VideoServiceSemanticSearchArgs) String() string {
+func (p *VideoServiceBackfillFeedArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("VideoServiceSemanticSearchArgs(%+v)", *p)
+	return fmt.Sprintf("VideoServiceBackfillFeedArgs(%+v)", *p)
 
 }
 
-type VideoServiceSemanticSearchResult struct {
-	Success *SemanticSearchResponse `thrift:"success,0,optional"`
+type VideoServiceBackfillFeedResult struct {
+	Success *BackfillFeedResponse `thrift:"success,0,optional"`
 }
 
-func NewVideoServiceSemanticSearchResult() *VideoServiceSemanticSearchResult {
-	return &VideoServiceSemanticSearchResult{}
+func NewVideoServiceBackfillFeedResult() *VideoServiceBackfillFeedResult {
+	return &VideoServiceBackfillFeedResult{}
 }
 
-func (p *VideoServiceSemanticSearchResult) InitDefault() {
+func (p *VideoServiceBackfillFeedResult) InitDefault() {
 }
 
-var VideoServiceSemanticSearchResult_Success_DEFAULT *SemanticSearchResponse
+var VideoServiceBackfillFeedResult_Success_DEFAULT *BackfillFeedResponse
 
-func (p *VideoServiceSemanticSearchResult) GetSuccess() (v *SemanticSearchResponse) {
+func (p *VideoServiceBackfillFeedResult) GetSuccess() (v *BackfillFeedResponse) {
 	if !p.IsSetSuccess() {
-		return VideoServiceSemanticSearchResult_Success_DEFAULT
+		return VideoServiceBackfillFeedResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_VideoServiceSemanticSearchResult = map[int16]string{
+var fieldIDToName_VideoServiceBackfillFeedResult = map[int16]string{
 	0: "success",
 }
 
-func (p *VideoServiceSemanticSearchResult) IsSetSuccess() bool {
+func (p *VideoServiceBackfillFeedResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *VideoServiceSemanticSearchResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *VideoServiceBackfillFeedResult) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -8116,7 +9672,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VideoServiceSemanticSearchResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VideoServiceBackfillFeedResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -8126,8 +9682,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *VideoServiceSemanticSearchResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewSemanticSearchResponse()
+func (p *VideoServiceBackfillFeedResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewBackfillFeedResponse()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -8135,9 +9691,9 @@ func (p *VideoServiceSemanticSearchResult) ReadField0(iprot thrift.TProtocol) er
 	return nil
 }
 
-func (p *VideoServiceSemanticSearchResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *VideoServiceBackfillFeedResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("SemanticSearch_result"); err != nil {
+	if err = oprot.WriteStructBegin("BackfillFeed_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -8163,7 +9719,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *VideoServiceSemanticSearchResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *VideoServiceBackfillFeedResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -8182,10 +9738,10 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *VideoServiceSemanticSearchResult) String() string {
+func (p *VideoServiceBackfillFeedResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("VideoServiceSemanticSearchResult(%+v)", *p)
+	return fmt.Sprintf("VideoServiceBackfillFeedResult(%+v)", *p)
 
 }
@@ -40,6 +40,10 @@ func BuildCommentInfo(c *model.CommentInfo) *rpcmodel.CommentInfo {
 		DeletedAt: c.DeletedAt,
 		LikeCount: c.LikeCount,
 		IsLiked:   c.IsLiked,
+		IsEdited:  c.IsEdited,
+		EditedAt:  c.EditedAt,
+		IsPinned:  c.IsPinned,
+		IsHearted: c.IsHearted,
 	}
 }
 
@@ -20,6 +20,7 @@ func BuildVideo(v *model.Video) *rpcmodel.Video {
 		Description:   v.Description,
 		FavoriteCount: v.FavoriteCount,
 		CommentCount:  v.CommentCount,
+		IsFavorite:    v.IsFavorite,
 		CreatedAt:     v.CreatedAt,
 		UpdatedAt:     v.UpdatedAt,
 	}
@@ -29,3 +30,22 @@ func BuildVideo(v *model.Video) *rpcmodel.Video {
 func BuildVideoList(vs []*model.Video) []*rpcmodel.Video {
 	return base.BuildTypeList(vs, BuildVideo)
 }
+
+// AttachInteractionState 将互动状态填充到视频的点赞数、评论数、收藏数以及当前用户的点赞和收藏状态
+func AttachInteractionState(vs []*rpcmodel.Video, states []*rpcmodel.VideoInteractionState) {
+	stateMap := make(map[int64]*rpcmodel.VideoInteractionState, len(states))
+	for _, state := range states {
+		stateMap[state.VideoId] = state
+	}
+	for _, v := range vs {
+		state, ok := stateMap[v.Id]
+		if !ok {
+			continue
+		}
+		v.FavoriteCount = &state.LikeCount
+		v.CommentCount = &state.CommentCount
+		v.IsFavorite = &state.IsLiked
+		v.CollectCount = state.CollectCount
+		v.IsCollected = state.IsCollected
+	}
+}
@@ -21,16 +21,49 @@ func Register(r *server.Hertz) {
 		_api := root.Group("/api", _apiMw()...)
 		{
 			_v1 := _api.Group("/v1", _v1Mw()...)
+			_v1.POST("/collection", append(_createcollectionMw(), interaction.CreateCollection)...)
+			_collection := _v1.Group("/collection", _collectionMw()...)
+			_collection.DELETE("/:collection_id", append(_deletecollectionMw(), interaction.DeleteCollection)...)
+			_collection_id := _collection.Group("/:collection_id", _collection_idMw()...)
+			_collection_id.GET("/videos", append(_getcollectionvideosMw(), interaction.GetCollectionVideos)...)
+			_collection.PUT("/:collection_id", append(_updatecollectionMw(), interaction.UpdateCollection)...)
+			_collection.DELETE("/video", append(_removefromcollectionMw(), interaction.RemoveFromCollection)...)
+			_collection.POST("/video", append(_addtocollectionMw(), interaction.AddToCollection)...)
+			_v1.GET("/collections", append(_getcollectionsMw(), interaction.GetCollections)...)
+			_v1.POST("/reaction", append(_reactMw(), interaction.React)...)
+			_v1.GET("/reactions", append(_getreactionsMw(), interaction.GetReactions)...)
 			{
-				_comment := _v1.Group("/comment", _commentMw()...)
-				_comment.DELETE("/:comment_id", append(_deletecommentMw(), interaction.DeleteComment)...)
-				_comment_id := _comment.Group("/:comment_id", _comment_idMw()...)
-				_comment_id.POST("/like", append(_likecommentMw(), interaction.LikeComment)...)
-				_comment.POST("/like", append(_likeMw(), interaction.Like)...)
+				_admin := _v1.Group("/admin", _adminMw()...)
+				{
+					_comment := _admin.Group("/comment", _commentMw()...)
+					{
+						_comment_id := _comment.Group("/:comment_id", _comment_idMw()...)
+						_comment_id.POST("/review", append(_reviewcommentMw(), interaction.ReviewComment)...)
+					}
+				}
+				{
+					_comments := _admin.Group("/comments", _commentsMw()...)
+					_comments.GET("/flagged", append(_getflaggedcommentsMw(), interaction.GetFlaggedComments)...)
+				}
+			}
+			{
+				_collections := _v1.Group("/collections", _collectionsMw()...)
+				_collections.PUT("/order", append(_reordercollectionsMw(), interaction.ReorderCollections)...)
+			}
+			{
+				_comment0 := _v1.Group("/comment", _comment0Mw()...)
+				_comment0.DELETE("/:comment_id", append(_deletecommentMw(), interaction.DeleteComment)...)
+				_comment_id0 := _comment0.Group("/:comment_id", _comment_id0Mw()...)
+				_comment_id0.POST("/heart", append(_heartcommentMw(), interaction.HeartComment)...)
+				_comment_id0.GET("/history", append(_getcommentedithistoryMw(), interaction.GetCommentEditHistory)...)
+				_comment_id0.POST("/like", append(_likecommentMw(), interaction.LikeComment)...)
+				_comment_id0.POST("/pin", append(_pincommentMw(), interaction.PinComment)...)
+				_comment0.PUT("/:comment_id", append(_editcommentMw(), interaction.EditComment)...)
+				_comment0.POST("/like", append(_likeMw(), interaction.Like)...)
 			}
 			{
 				_video := _v1.Group("/video", _videoMw()...)
-				_video.POST("/comment", append(_comment0Mw(), interaction.Comment)...)
+				_video.POST("/comment", append(_comment1Mw(), interaction.Comment)...)
 				_video.GET("/comments", append(_getcommentsMw(), interaction.GetComments)...)
 				_video.GET("/likelist", append(_getlikesMw(), interaction.GetLikes)...)
 			}
@@ -83,3 +83,118 @@ func _likesMw() []app.HandlerFunc {
 	// your code...
 	return nil
 }
+
+func _adminMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _reviewcommentMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _commentsMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _getflaggedcommentsMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _comment_id0Mw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _comment1Mw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _heartcommentMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _getcommentedithistoryMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _pincommentMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _editcommentMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _reactMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _getreactionsMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _collectionMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _createcollectionMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _collection_idMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _deletecollectionMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _getcollectionvideosMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _updatecollectionMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _removefromcollectionMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _addtocollectionMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _getcollectionsMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _collectionsMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _reordercollectionsMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
@@ -163,3 +163,233 @@ func _connectwebsocketMw() []app.HandlerFunc {
 	// your code...
 	return nil
 }
+
+func _syncmessagesMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _leavechatroomMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _membersMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _addchatroommembersMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _removechatroommemberMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _disbandchatroomMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _createchatroominviteMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _room_id0Mw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _user_idMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _mutechatroommemberMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _transferchatroomMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _adminsMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _setchatroomadminMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _renamechatroomMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _inviteMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _codeMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _joinchatroombyinviteMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _editchatmessageMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _recallchatmessageMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _shareMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _sharevideoMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _chatMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _uploadchatattachmentMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _message0Mw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _message_id0Mw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _conversationsMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _markconversationsreadMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _getunreadcountsMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _getinboxMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _updateconversationMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _conversations0Mw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _getfriendrecommendationsMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _notificationsMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _getnotificationsMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _getnotificationpreferencesMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _updatenotificationpreferencesMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _marknotificationsreadMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _getnotificationunreadcountMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _unread0Mw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _getpresenceMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _searchmessagesMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _exportchathistoryMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _message1Mw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _message_id1Mw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _editprivatemessageMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _recallprivatemessageMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
@@ -26,38 +26,98 @@ func Register(r *server.Hertz) {
 				_social.POST("/chatroom", append(_createchatroomMw(), social.CreateChatRoom)...)
 				_chatroom := _social.Group("/chatroom", _chatroomMw()...)
 				_chatroom.POST("/message", append(_sendchatmessageMw(), social.SendChatMessage)...)
-				_chatroom.GET("/:room_id", append(_getchatroomMw(), social.GetChatRoom)...)
+				_message := _chatroom.Group("/message", _messageMw()...)
+				_message.PUT("/:message_id", append(_editchatmessageMw(), social.EditChatMessage)...)
+				_message_id := _message.Group("/:message_id", _message_idMw()...)
+				_message_id.POST("/recall", append(_recallchatmessageMw(), social.RecallChatMessage)...)
+				_chatroom.DELETE("/:room_id", append(_disbandchatroomMw(), social.DisbandChatRoom)...)
 				_room_id := _chatroom.Group("/:room_id", _room_idMw()...)
-				_room_id.GET("/messages", append(_getchatmessagesMw(), social.GetChatMessages)...)
+				_room_id.POST("/invites", append(_createchatroominviteMw(), social.CreateChatRoomInvite)...)
+				_chatroom.GET("/:room_id", append(_getchatroomMw(), social.GetChatRoom)...)
+				_room_id0 := _chatroom.Group("/:room_id", _room_id0Mw()...)
+				_room_id0.POST("/leave", append(_leavechatroomMw(), social.LeaveChatRoom)...)
+				_room_id0.POST("/members", append(_addchatroommembersMw(), social.AddChatRoomMembers)...)
+				_members := _room_id0.Group("/members", _membersMw()...)
+				_members.DELETE("/:user_id", append(_removechatroommemberMw(), social.RemoveChatRoomMember)...)
+				_user_id := _members.Group("/:user_id", _user_idMw()...)
+				_user_id.PUT("/mute", append(_mutechatroommemberMw(), social.MuteChatRoomMember)...)
+				_room_id0.GET("/messages", append(_getchatmessagesMw(), social.GetChatMessages)...)
+				_room_id0.PUT("/owner", append(_transferchatroomMw(), social.TransferChatRoom)...)
+				{
+					_admins := _room_id0.Group("/admins", _adminsMw()...)
+					_admins.PUT("/:user_id", append(_setchatroomadminMw(), social.SetChatRoomAdmin)...)
+				}
+				{
+					_share := _room_id0.Group("/share", _shareMw()...)
+					_share.POST("/video", append(_sharevideoMw(), social.ShareVideo)...)
+				}
+				_chatroom.PUT("/:room_id", append(_renamechatroomMw(), social.RenameChatRoom)...)
+				{
+					_invite := _chatroom.Group("/invite", _inviteMw()...)
+					{
+						_code := _invite.Group("/:code", _codeMw()...)
+						_code.POST("/join", append(_joinchatroombyinviteMw(), social.JoinChatRoomByInvite)...)
+					}
+				}
 				_social.GET("/chatrooms", append(_getuserchatroomsMw(), social.GetUserChatRooms)...)
+				_social.GET("/conversations", append(_getinboxMw(), social.GetInbox)...)
+				_conversations := _social.Group("/conversations", _conversationsMw()...)
+				_conversations.PUT("/settings", append(_updateconversationMw(), social.UpdateConversation)...)
 				_social.POST("/friend", append(_addfriendMw(), social.AddFriend)...)
 				_friend := _social.Group("/friend", _friendMw()...)
+				_friend.GET("/recommendations", append(_getfriendrecommendationsMw(), social.GetFriendRecommendations)...)
 				_friend.POST("/request", append(_createfriendrequestMw(), social.CreateFriendRequest)...)
 				_request := _friend.Group("/request", _requestMw()...)
 				_request.PUT("/:request_id", append(_handlefriendrequestMw(), social.HandleFriendRequest)...)
 				_friend.GET("/requests", append(_getfriendrequestsMw(), social.GetFriendRequests)...)
 				_social.GET("/friends", append(_getuserfriendsMw(), social.GetUserFriends)...)
+				_social.GET("/notifications", append(_getnotificationsMw(), social.GetNotifications)...)
+				_notifications := _social.Group("/notifications", _notificationsMw()...)
+				_notifications.GET("/preferences", append(_getnotificationpreferencesMw(), social.GetNotificationPreferences)...)
+				_notifications.PUT("/preferences", append(_updatenotificationpreferencesMw(), social.UpdateNotificationPreferences)...)
+				_notifications.POST("/read", append(_marknotificationsreadMw(), social.MarkNotificationsRead)...)
+				{
+					_unread := _notifications.Group("/unread", _unreadMw()...)
+					_unread.GET("/count", append(_getnotificationunreadcountMw(), social.GetNotificationUnreadCount)...)
+				}
+				_social.GET("/presence", append(_getpresenceMw(), social.GetPresence)...)
+				{
+					_chat := _social.Group("/chat", _chatMw()...)
+					_chat.POST("/attachment", append(_uploadchatattachmentMw(), social.UploadChatAttachment)...)
+				}
+				{
+					_conversations0 := _social.Group("/conversations", _conversations0Mw()...)
+					_conversations0.POST("/read", append(_markconversationsreadMw(), social.MarkConversationsRead)...)
+					_conversations0.GET("/unread", append(_getunreadcountsMw(), social.GetUnreadCounts)...)
+				}
 				{
 					_friendship := _social.Group("/friendship", _friendshipMw()...)
 					_friendship.GET("/:friend_id", append(_getfriendshipMw(), social.GetFriendship)...)
 				}
 				{
-					_message := _social.Group("/message", _messageMw()...)
+					_message0 := _social.Group("/message", _message0Mw()...)
 					{
-						_message_id := _message.Group("/:message_id", _message_idMw()...)
-						_message_id.PUT("/read", append(_markmessagereadMw(), social.MarkMessageRead)...)
+						_message_id0 := _message0.Group("/:message_id", _message_id0Mw()...)
+						_message_id0.PUT("/read", append(_markmessagereadMw(), social.MarkMessageRead)...)
 					}
 				}
 				{
 					_messages := _social.Group("/messages", _messagesMw()...)
+					_messages.POST("/export", append(_exportchathistoryMw(), social.ExportChatHistory)...)
+					_messages.GET("/search", append(_searchmessagesMw(), social.SearchMessages)...)
+					_messages.GET("/sync", append(_syncmessagesMw(), social.SyncMessages)...)
 					{
-						_unread := _messages.Group("/unread", _unreadMw()...)
-						_unread.GET("/count", append(_getunreadmessagecountMw(), social.GetUnreadMessageCount)...)
+						_unread0 := _messages.Group("/unread", _unread0Mw()...)
+						_unread0.GET("/count", append(_getunreadmessagecountMw(), social.GetUnreadMessageCount)...)
 					}
 				}
 				{
 					_private := _social.Group("/private", _privateMw()...)
 					_private.POST("/message", append(_sendprivatemessageMw(), social.SendPrivateMessage)...)
+					_message1 := _private.Group("/message", _message1Mw()...)
+					_message1.PUT("/:message_id", append(_editprivatemessageMw(), social.EditPrivateMessage)...)
+					_message_id1 := _message1.Group("/:message_id", _message_id1Mw()...)
+					_message_id1.POST("/recall", append(_recallprivatemessageMw(), social.RecallPrivateMessage)...)
 					_private.GET("/messages", append(_getprivatemessagesMw(), social.GetPrivateMessages)...)
 				}
 			}
@@ -63,3 +63,67 @@ func _refreshtokenMw() []app.HandlerFunc {
 	// your code...
 	return nil
 }
+
+func _followMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _follow0Mw() []app.HandlerFunc {
+	return []app.HandlerFunc{
+		mw.Auth(),
+	}
+}
+
+func _unfollowMw() []app.HandlerFunc {
+	return []app.HandlerFunc{
+		mw.Auth(),
+	}
+}
+
+func _getfollowersMw() []app.HandlerFunc {
+	return []app.HandlerFunc{
+		mw.Auth(),
+	}
+}
+
+func _getfollowingMw() []app.HandlerFunc {
+	return []app.HandlerFunc{
+		mw.Auth(),
+	}
+}
+
+func _blockMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _block0Mw() []app.HandlerFunc {
+	return []app.HandlerFunc{
+		mw.Auth(),
+	}
+}
+
+func _unblockMw() []app.HandlerFunc {
+	return []app.HandlerFunc{
+		mw.Auth(),
+	}
+}
+
+func _getblocklistMw() []app.HandlerFunc {
+	return []app.HandlerFunc{
+		mw.Auth(),
+	}
+}
+
+func _getprivacysettingsMw() []app.HandlerFunc {
+	return []app.HandlerFunc{
+		mw.Auth(),
+	}
+}
+
+func _updateprivacysettingsMw() []app.HandlerFunc {
+	return []app.HandlerFunc{
+		mw.Auth(),
+	}
+}
@@ -24,8 +24,19 @@ func Register(r *server.Hertz) {
 			{
 				_user := _v1.Group("/user", _userMw()...)
 				_user.POST("/avatar", append(_uploadavatarMw(), user.UploadAvatar)...)
+				_user.POST("/block", append(_block0Mw(), user.Block)...)
+				_block := _user.Group("/block", _blockMw()...)
+				_block.DELETE("/:user_id", append(_unblockMw(), user.Unblock)...)
+				_user.GET("/blocks", append(_getblocklistMw(), user.GetBlockList)...)
+				_user.POST("/follow", append(_follow0Mw(), user.Follow)...)
+				_follow := _user.Group("/follow", _followMw()...)
+				_follow.DELETE("/:followee_id", append(_unfollowMw(), user.Unfollow)...)
+				_user.GET("/followers", append(_getfollowersMw(), user.GetFollowers)...)
+				_user.GET("/following", append(_getfollowingMw(), user.GetFollowing)...)
 				_user.GET("/info", append(_getuserinfoMw(), user.GetUserInfo)...)
 				_user.POST("/login", append(_loginMw(), user.Login)...)
+				_user.GET("/privacy", append(_getprivacysettingsMw(), user.GetPrivacySettings)...)
+				_user.PUT("/privacy", append(_updateprivacysettingsMw(), user.UpdatePrivacySettings)...)
 				_user.POST("/register", append(_registerMw(), user.Register)...)
 				{
 					_token := _user.Group("/token", _tokenMw()...)
@@ -83,3 +83,13 @@ func _semanticsearchMw() []app.HandlerFunc {
 	// your code...
 	return nil
 }
+
+func _feedMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _getfollowingfeedMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
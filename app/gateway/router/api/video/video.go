@@ -33,6 +33,10 @@ func Register(r *server.Hertz) {
 				_video_id.POST("/like", append(_incrementlikecountMw(), video.IncrementLikeCount)...)
 				_video_id.POST("/visit", append(_incrementvisitcountMw(), video.IncrementVisitCount)...)
 				_video.GET("/:video_id", append(_getvideodetailMw(), video.GetVideoDetail)...)
+				{
+					_feed := _video.Group("/feed", _feedMw()...)
+					_feed.GET("/following", append(_getfollowingfeedMw(), video.GetFollowingFeed)...)
+				}
 			}
 		}
 	}
@@ -46,20 +46,20 @@ func GetLikesRPC(ctx context.Context, req *interaction.GetLikesRequest) ([]*mode
 }
 
 // AddCommentRPC 添加评论
-func CommentVideoRPC(ctx context.Context, req *interaction.CommentRequest) error {
+func CommentVideoRPC(ctx context.Context, req *interaction.CommentRequest) (int64, error) {
 	success, err := interactionClient.Comment(ctx, req)
 	if err != nil {
 		log.Printf("发表评论RPC调用失败: %v", err)
-		return errno.InternalServiceError.WithError(err)
+		return 0, errno.InternalServiceError.WithError(err)
 	}
 	if success.Base.Code != errno.SuccessCode {
-		return errno.InternalServiceError.WithMessage(success.Base.Msg)
+		return 0, errno.InternalServiceError.WithMessage(success.Base.Msg)
 	}
-	return nil
+	return success.CommentId, nil
 }
 
 // GetCommentsRPC 获取评论列表
-func GetCommentsRPC(ctx context.Context, req *interaction.GetCommentsRequest) ([]*model.Comment, int64, error) {
+func GetCommentsRPC(ctx context.Context, req *interaction.GetCommentsRequest) ([]*model.CommentInfo, int64, error) {
 	resp, err := interactionClient.GetComments(ctx, req)
 	if err != nil {
 		log.Printf("获取评论列表RPC调用失败: %v", err)
@@ -94,3 +94,224 @@ func LikeCommentRPC(ctx context.Context, req *interaction.LikeCommentRequest) er
 	}
 	return nil
 }
+
+// EditCommentRPC 编辑评论
+func EditCommentRPC(ctx context.Context, req *interaction.EditCommentRequest) error {
+	resp, err := interactionClient.EditComment(ctx, req)
+	if err != nil {
+		log.Printf("编辑评论RPC调用失败: %v", err)
+		return errno.InternalServiceError.WithError(err)
+	}
+	if resp.Base.Code != errno.SuccessCode {
+		return errno.InternalServiceError.WithMessage(resp.Base.Msg)
+	}
+	return nil
+}
+
+// GetCommentEditHistoryRPC 获取评论编辑历史
+func GetCommentEditHistoryRPC(ctx context.Context, req *interaction.GetCommentEditHistoryRequest) ([]*model.CommentEdit, error) {
+	resp, err := interactionClient.GetCommentEditHistory(ctx, req)
+	if err != nil {
+		log.Printf("获取评论编辑历史RPC调用失败: %v", err)
+		return nil, errno.InternalServiceError.WithError(err)
+	}
+	if resp.Base.Code != errno.SuccessCode {
+		return nil, errno.InternalServiceError.WithMessage(resp.Base.Msg)
+	}
+	return resp.EditList, nil
+}
+
+// PinCommentRPC 置顶评论
+func PinCommentRPC(ctx context.Context, req *interaction.PinCommentRequest) error {
+	resp, err := interactionClient.PinComment(ctx, req)
+	if err != nil {
+		log.Printf("置顶评论RPC调用失败: %v", err)
+		return errno.InternalServiceError.WithError(err)
+	}
+	if resp.Base.Code != errno.SuccessCode {
+		return errno.InternalServiceError.WithMessage(resp.Base.Msg)
+	}
+	return nil
+}
+
+// HeartCommentRPC 作者点亮评论
+func HeartCommentRPC(ctx context.Context, req *interaction.HeartCommentRequest) error {
+	resp, err := interactionClient.HeartComment(ctx, req)
+	if err != nil {
+		log.Printf("点亮评论RPC调用失败: %v", err)
+		return errno.InternalServiceError.WithError(err)
+	}
+	if resp.Base.Code != errno.SuccessCode {
+		return errno.InternalServiceError.WithMessage(resp.Base.Msg)
+	}
+	return nil
+}
+
+// ReactRPC 表情回应
+func ReactRPC(ctx context.Context, req *interaction.ReactRequest) (*interaction.ReactResponse, error) {
+	resp, err := interactionClient.React(ctx, req)
+	if err != nil {
+		log.Printf("表情回应RPC调用失败: %v", err)
+		return nil, errno.InternalServiceError.WithError(err)
+	}
+	if resp.Base.Code != errno.SuccessCode {
+		return nil, errno.InternalServiceError.WithMessage(resp.Base.Msg)
+	}
+	return resp, nil
+}
+
+// GetReactionsRPC 获取表情回应列表
+func GetReactionsRPC(ctx context.Context, req *interaction.GetReactionsRequest) (*interaction.GetReactionsResponse, error) {
+	resp, err := interactionClient.GetReactions(ctx, req)
+	if err != nil {
+		log.Printf("获取表情回应列表RPC调用失败: %v", err)
+		return nil, errno.InternalServiceError.WithError(err)
+	}
+	if resp.Base.Code != errno.SuccessCode {
+		return nil, errno.InternalServiceError.WithMessage(resp.Base.Msg)
+	}
+	return resp, nil
+}
+
+// GetInteractionStateRPC 批量获取视频互动状态
+func GetInteractionStateRPC(ctx context.Context, req *interaction.GetInteractionStateRequest) ([]*model.VideoInteractionState, error) {
+	resp, err := interactionClient.GetInteractionState(ctx, req)
+	if err != nil {
+		log.Printf("获取视频互动状态RPC调用失败: %v", err)
+		return nil, errno.InternalServiceError.WithError(err)
+	}
+	if resp.Base.Code != errno.SuccessCode {
+		return nil, errno.InternalServiceError.WithMessage(resp.Base.Msg)
+	}
+	return resp.States, nil
+}
+
+// GetFlaggedCommentsRPC 获取待审核评论
+func GetFlaggedCommentsRPC(ctx context.Context, req *interaction.GetFlaggedCommentsRequest) ([]*model.Comment, int64, error) {
+	resp, err := interactionClient.GetFlaggedComments(ctx, req)
+	if err != nil {
+		log.Printf("获取待审核评论RPC调用失败: %v", err)
+		return nil, 0, errno.InternalServiceError.WithError(err)
+	}
+	if resp.Base.Code != errno.SuccessCode {
+		return nil, 0, errno.InternalServiceError.WithMessage(resp.Base.Msg)
+	}
+	return resp.CommentList, resp.Total, nil
+}
+
+// ReviewCommentRPC 审核评论
+func ReviewCommentRPC(ctx context.Context, req *interaction.ReviewCommentRequest) error {
+	resp, err := interactionClient.ReviewComment(ctx, req)
+	if err != nil {
+		log.Printf("审核评论RPC调用失败: %v", err)
+		return errno.InternalServiceError.WithError(err)
+	}
+	if resp.Base.Code != errno.SuccessCode {
+		return errno.InternalServiceError.WithMessage(resp.Base.Msg)
+	}
+	return nil
+}
+
+// CreateCollectionRPC 创建收藏夹
+func CreateCollectionRPC(ctx context.Context, req *interaction.CreateCollectionRequest) (*model.Collection, error) {
+	resp, err := interactionClient.CreateCollection(ctx, req)
+	if err != nil {
+		log.Printf("创建收藏夹RPC调用失败: %v", err)
+		return nil, errno.InternalServiceError.WithError(err)
+	}
+	if resp.Base.Code != errno.SuccessCode {
+		return nil, errno.InternalServiceError.WithMessage(resp.Base.Msg)
+	}
+	return resp.Collection, nil
+}
+
+// UpdateCollectionRPC 修改收藏夹
+func UpdateCollectionRPC(ctx context.Context, req *interaction.UpdateCollectionRequest) error {
+	resp, err := interactionClient.UpdateCollection(ctx, req)
+	if err != nil {
+		log.Printf("修改收藏夹RPC调用失败: %v", err)
+		return errno.InternalServiceError.WithError(err)
+	}
+	if resp.Base.Code != errno.SuccessCode {
+		return errno.InternalServiceError.WithMessage(resp.Base.Msg)
+	}
+	return nil
+}
+
+// DeleteCollectionRPC 删除收藏夹
+func DeleteCollectionRPC(ctx context.Context, req *interaction.DeleteCollectionRequest) error {
+	resp, err := interactionClient.DeleteCollection(ctx, req)
+	if err != nil {
+		log.Printf("删除收藏夹RPC调用失败: %v", err)
+		return errno.InternalServiceError.WithError(err)
+	}
+	if resp.Base.Code != errno.SuccessCode {
+		return errno.InternalServiceError.WithMessage(resp.Base.Msg)
+	}
+	return nil
+}
+
+// ReorderCollectionsRPC 调整收藏夹顺序
+func ReorderCollectionsRPC(ctx context.Context, req *interaction.ReorderCollectionsRequest) error {
+	resp, err := interactionClient.ReorderCollections(ctx, req)
+	if err != nil {
+		log.Printf("调整收藏夹顺序RPC调用失败: %v", err)
+		return errno.InternalServiceError.WithError(err)
+	}
+	if resp.Base.Code != errno.SuccessCode {
+		return errno.InternalServiceError.WithMessage(resp.Base.Msg)
+	}
+	return nil
+}
+
+// GetCollectionsRPC 获取收藏夹列表
+func GetCollectionsRPC(ctx context.Context, req *interaction.GetCollectionsRequest) ([]*model.Collection, error) {
+	resp, err := interactionClient.GetCollections(ctx, req)
+	if err != nil {
+		log.Printf("获取收藏夹列表RPC调用失败: %v", err)
+		return nil, errno.InternalServiceError.WithError(err)
+	}
+	if resp.Base.Code != errno.SuccessCode {
+		return nil, errno.InternalServiceError.WithMessage(resp.Base.Msg)
+	}
+	return resp.CollectionList, nil
+}
+
+// AddToCollectionRPC 收藏视频
+func AddToCollectionRPC(ctx context.Context, req *interaction.AddToCollectionRequest) (int64, error) {
+	resp, err := interactionClient.AddToCollection(ctx, req)
+	if err != nil {
+		log.Printf("收藏视频RPC调用失败: %v", err)
+		return 0, errno.InternalServiceError.WithError(err)
+	}
+	if resp.Base.Code != errno.SuccessCode {
+		return 0, errno.InternalServiceError.WithMessage(resp.Base.Msg)
+	}
+	return resp.CollectionId, nil
+}
+
+// RemoveFromCollectionRPC 取消收藏视频
+func RemoveFromCollectionRPC(ctx context.Context, req *interaction.RemoveFromCollectionRequest) error {
+	resp, err := interactionClient.RemoveFromCollection(ctx, req)
+	if err != nil {
+		log.Printf("取消收藏视频RPC调用失败: %v", err)
+		return errno.InternalServiceError.WithError(err)
+	}
+	if resp.Base.Code != errno.SuccessCode {
+		return errno.InternalServiceError.WithMessage(resp.Base.Msg)
+	}
+	return nil
+}
+
+// GetCollectionVideosRPC 获取收藏夹中的视频
+func GetCollectionVideosRPC(ctx context.Context, req *interaction.GetCollectionVideosRequest) ([]int64, int64, error) {
+	resp, err := interactionClient.GetCollectionVideos(ctx, req)
+	if err != nil {
+		log.Printf("获取收藏夹视频RPC调用失败: %v", err)
+		return nil, 0, errno.InternalServiceError.WithError(err)
+	}
+	if resp.Base.Code != errno.SuccessCode {
+		return nil, 0, errno.InternalServiceError.WithMessage(resp.Base.Msg)
+	}
+	return resp.VideoIDs, resp.Total, nil
+}
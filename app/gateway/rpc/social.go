@@ -110,17 +110,238 @@ func GetChatMessagesRPC(ctx context.Context, req *social.GetChatMessagesRequest)
 	return resp.MessageList, resp.Total, nil
 }
 
-// AddFriendRPC 添加好友
-func AddFriendRPC(ctx context.Context, req *social.AddFriendRequest) error {
+// AddChatRoomMembersRPC 添加聊天室成员，响应包含新加入的用户ID和系统消息
+func AddChatRoomMembersRPC(ctx context.Context, req *social.AddChatRoomMembersRequest) (*social.AddChatRoomMembersResponse, error) {
+	resp, err := socialClient.AddChatRoomMembers(ctx, req)
+	if err != nil {
+		log.Printf("添加聊天室成员RPC调用失败: %v", err)
+		return nil, errno.InternalServiceError.WithError(err)
+	}
+	if resp.Base.Code != errno.SuccessCode {
+		return nil, errno.InternalServiceError.WithMessage(resp.Base.Msg)
+	}
+	return resp, nil
+}
+
+// RemoveChatRoomMemberRPC 移除聊天室成员，响应包含系统消息
+func RemoveChatRoomMemberRPC(ctx context.Context, req *social.RemoveChatRoomMemberRequest) (*social.RemoveChatRoomMemberResponse, error) {
+	resp, err := socialClient.RemoveChatRoomMember(ctx, req)
+	if err != nil {
+		log.Printf("移除聊天室成员RPC调用失败: %v", err)
+		return nil, errno.InternalServiceError.WithError(err)
+	}
+	if resp.Base.Code != errno.SuccessCode {
+		return nil, errno.InternalServiceError.WithMessage(resp.Base.Msg)
+	}
+	return resp, nil
+}
+
+// LeaveChatRoomRPC 退出聊天室，响应包含系统消息
+func LeaveChatRoomRPC(ctx context.Context, req *social.LeaveChatRoomRequest) (*social.LeaveChatRoomResponse, error) {
+	resp, err := socialClient.LeaveChatRoom(ctx, req)
+	if err != nil {
+		log.Printf("退出聊天室RPC调用失败: %v", err)
+		return nil, errno.InternalServiceError.WithError(err)
+	}
+	if resp.Base.Code != errno.SuccessCode {
+		return nil, errno.InternalServiceError.WithMessage(resp.Base.Msg)
+	}
+	return resp, nil
+}
+
+// CheckChatRoomMemberRPC 检查用户是否是聊天室成员
+func CheckChatRoomMemberRPC(ctx context.Context, req *social.CheckChatRoomMemberRequest) (bool, error) {
+	resp, err := socialClient.CheckChatRoomMember(ctx, req)
+	if err != nil {
+		log.Printf("检查聊天室成员RPC调用失败: %v", err)
+		return false, errno.InternalServiceError.WithError(err)
+	}
+	if resp.Base.Code != errno.SuccessCode {
+		return false, errno.InternalServiceError.WithMessage(resp.Base.Msg)
+	}
+	return resp.IsMember, nil
+}
+
+// CheckPrivateChatRPC 检查用户能否私信对方
+func CheckPrivateChatRPC(ctx context.Context, req *social.CheckPrivateChatRequest) (bool, error) {
+	resp, err := socialClient.CheckPrivateChat(ctx, req)
+	if err != nil {
+		log.Printf("检查私信权限RPC调用失败: %v", err)
+		return false, errno.InternalServiceError.WithError(err)
+	}
+	if resp.Base.Code != errno.SuccessCode {
+		return false, errno.InternalServiceError.WithMessage(resp.Base.Msg)
+	}
+	return resp.Allowed, nil
+}
+
+// SetChatRoomAdminRPC 设置或取消聊天室管理员
+func SetChatRoomAdminRPC(ctx context.Context, req *social.SetChatRoomAdminRequest) (*social.SetChatRoomAdminResponse, error) {
+	resp, err := socialClient.SetChatRoomAdmin(ctx, req)
+	if err != nil {
+		log.Printf("设置聊天室管理员RPC调用失败: %v", err)
+		return nil, errno.InternalServiceError.WithError(err)
+	}
+	if resp.Base.Code != errno.SuccessCode {
+		return nil, errno.InternalServiceError.WithMessage(resp.Base.Msg)
+	}
+	return resp, nil
+}
+
+// TransferChatRoomRPC 转让群主
+func TransferChatRoomRPC(ctx context.Context, req *social.TransferChatRoomRequest) (*social.TransferChatRoomResponse, error) {
+	resp, err := socialClient.TransferChatRoom(ctx, req)
+	if err != nil {
+		log.Printf("转让群主RPC调用失败: %v", err)
+		return nil, errno.InternalServiceError.WithError(err)
+	}
+	if resp.Base.Code != errno.SuccessCode {
+		return nil, errno.InternalServiceError.WithMessage(resp.Base.Msg)
+	}
+	return resp, nil
+}
+
+// MuteChatRoomMemberRPC 禁言或解除禁言聊天室成员
+func MuteChatRoomMemberRPC(ctx context.Context, req *social.MuteChatRoomMemberRequest) (*social.MuteChatRoomMemberResponse, error) {
+	resp, err := socialClient.MuteChatRoomMember(ctx, req)
+	if err != nil {
+		log.Printf("禁言聊天室成员RPC调用失败: %v", err)
+		return nil, errno.InternalServiceError.WithError(err)
+	}
+	if resp.Base.Code != errno.SuccessCode {
+		return nil, errno.InternalServiceError.WithMessage(resp.Base.Msg)
+	}
+	return resp, nil
+}
+
+// RenameChatRoomRPC 修改聊天室名称
+func RenameChatRoomRPC(ctx context.Context, req *social.RenameChatRoomRequest) (*social.RenameChatRoomResponse, error) {
+	resp, err := socialClient.RenameChatRoom(ctx, req)
+	if err != nil {
+		log.Printf("修改聊天室名称RPC调用失败: %v", err)
+		return nil, errno.InternalServiceError.WithError(err)
+	}
+	if resp.Base.Code != errno.SuccessCode {
+		return nil, errno.InternalServiceError.WithMessage(resp.Base.Msg)
+	}
+	return resp, nil
+}
+
+// DisbandChatRoomRPC 解散聊天室，响应包含解散前的成员ID
+func DisbandChatRoomRPC(ctx context.Context, req *social.DisbandChatRoomRequest) (*social.DisbandChatRoomResponse, error) {
+	resp, err := socialClient.DisbandChatRoom(ctx, req)
+	if err != nil {
+		log.Printf("解散聊天室RPC调用失败: %v", err)
+		return nil, errno.InternalServiceError.WithError(err)
+	}
+	if resp.Base.Code != errno.SuccessCode {
+		return nil, errno.InternalServiceError.WithMessage(resp.Base.Msg)
+	}
+	return resp, nil
+}
+
+// CreateChatRoomInviteRPC 创建聊天室邀请链接
+func CreateChatRoomInviteRPC(ctx context.Context, req *social.CreateChatRoomInviteRequest) (*social.CreateChatRoomInviteResponse, error) {
+	resp, err := socialClient.CreateChatRoomInvite(ctx, req)
+	if err != nil {
+		log.Printf("创建聊天室邀请链接RPC调用失败: %v", err)
+		return nil, errno.InternalServiceError.WithError(err)
+	}
+	if resp.Base.Code != errno.SuccessCode {
+		return nil, errno.InternalServiceError.WithMessage(resp.Base.Msg)
+	}
+	return resp, nil
+}
+
+// JoinChatRoomByInviteRPC 通过邀请链接加入聊天室
+func JoinChatRoomByInviteRPC(ctx context.Context, req *social.JoinChatRoomByInviteRequest) (*social.JoinChatRoomByInviteResponse, error) {
+	resp, err := socialClient.JoinChatRoomByInvite(ctx, req)
+	if err != nil {
+		log.Printf("通过邀请链接加入聊天室RPC调用失败: %v", err)
+		return nil, errno.InternalServiceError.WithError(err)
+	}
+	if resp.Base.Code != errno.SuccessCode {
+		return nil, errno.InternalServiceError.WithMessage(resp.Base.Msg)
+	}
+	return resp, nil
+}
+
+// UploadChatAttachmentRPC 上传聊天附件
+func UploadChatAttachmentRPC(ctx context.Context, req *social.UploadChatAttachmentRequest) (*social.UploadChatAttachmentResponse, error) {
+	resp, err := socialClient.UploadChatAttachment(ctx, req)
+	if err != nil {
+		log.Printf("上传聊天附件RPC调用失败: %v", err)
+		return nil, errno.InternalServiceError.WithError(err)
+	}
+	if resp.Base.Code != errno.SuccessCode {
+		return nil, errno.InternalServiceError.WithMessage(resp.Base.Msg)
+	}
+	return resp, nil
+}
+
+// EditChatMessageRPC 编辑聊天消息
+func EditChatMessageRPC(ctx context.Context, req *social.EditChatMessageRequest) (*social.EditChatMessageResponse, error) {
+	resp, err := socialClient.EditChatMessage(ctx, req)
+	if err != nil {
+		log.Printf("编辑聊天消息RPC调用失败: %v", err)
+		return nil, errno.InternalServiceError.WithError(err)
+	}
+	if resp.Base.Code != errno.SuccessCode {
+		return nil, errno.InternalServiceError.WithMessage(resp.Base.Msg)
+	}
+	return resp, nil
+}
+
+// RecallChatMessageRPC 撤回聊天消息
+func RecallChatMessageRPC(ctx context.Context, req *social.RecallChatMessageRequest) (*social.RecallChatMessageResponse, error) {
+	resp, err := socialClient.RecallChatMessage(ctx, req)
+	if err != nil {
+		log.Printf("撤回聊天消息RPC调用失败: %v", err)
+		return nil, errno.InternalServiceError.WithError(err)
+	}
+	if resp.Base.Code != errno.SuccessCode {
+		return nil, errno.InternalServiceError.WithMessage(resp.Base.Msg)
+	}
+	return resp, nil
+}
+
+// EditPrivateMessageRPC 编辑私信
+func EditPrivateMessageRPC(ctx context.Context, req *social.EditPrivateMessageRequest) (*social.EditPrivateMessageResponse, error) {
+	resp, err := socialClient.EditPrivateMessage(ctx, req)
+	if err != nil {
+		log.Printf("编辑私信RPC调用失败: %v", err)
+		return nil, errno.InternalServiceError.WithError(err)
+	}
+	if resp.Base.Code != errno.SuccessCode {
+		return nil, errno.InternalServiceError.WithMessage(resp.Base.Msg)
+	}
+	return resp, nil
+}
+
+// RecallPrivateMessageRPC 撤回私信
+func RecallPrivateMessageRPC(ctx context.Context, req *social.RecallPrivateMessageRequest) (*social.RecallPrivateMessageResponse, error) {
+	resp, err := socialClient.RecallPrivateMessage(ctx, req)
+	if err != nil {
+		log.Printf("撤回私信RPC调用失败: %v", err)
+		return nil, errno.InternalServiceError.WithError(err)
+	}
+	if resp.Base.Code != errno.SuccessCode {
+		return nil, errno.InternalServiceError.WithMessage(resp.Base.Msg)
+	}
+	return resp, nil
+}
+
+// AddFriendRPC 添加好友，返回发出的好友申请
+func AddFriendRPC(ctx context.Context, req *social.AddFriendRequest) (*model.FriendRequest, error) {
 	resp, err := socialClient.AddFriend(ctx, req)
 	if err != nil {
 		log.Printf("添加好友RPC调用失败: %v", err)
-		return errno.InternalServiceError.WithError(err)
+		return nil, errno.InternalServiceError.WithError(err)
 	}
 	if resp.Base.Code != errno.SuccessCode {
-		return errno.InternalServiceError.WithMessage(resp.Base.Msg)
+		return nil, errno.InternalServiceError.WithMessage(resp.Base.Msg)
 	}
-	return nil
+	return resp.Request, nil
 }
 
 // GetFriendshipRPC 获取好友关系
@@ -175,30 +396,43 @@ func GetFriendRequestsRPC(ctx context.Context, req *social.GetFriendRequestsRequ
 	return resp.RequestList, resp.Total, nil
 }
 
-// HandleFriendRequestRPC 处理好友请求
-func HandleFriendRequestRPC(ctx context.Context, req *social.HandleFriendRequestRequest) error {
+// GetFriendRecommendationsRPC 获取好友推荐
+func GetFriendRecommendationsRPC(ctx context.Context, req *social.GetFriendRecommendationsRequest) ([]*model.FriendRecommendation, error) {
+	resp, err := socialClient.GetFriendRecommendations(ctx, req)
+	if err != nil {
+		log.Printf("获取好友推荐RPC调用失败: %v", err)
+		return nil, errno.InternalServiceError.WithError(err)
+	}
+	if resp.Base.Code != errno.SuccessCode {
+		return nil, errno.InternalServiceError.WithMessage(resp.Base.Msg)
+	}
+	return resp.Recommendations, nil
+}
+
+// HandleFriendRequestRPC 处理好友请求，返回处理后的申请
+func HandleFriendRequestRPC(ctx context.Context, req *social.HandleFriendRequestRequest) (*model.FriendRequest, error) {
 	resp, err := socialClient.HandleFriendRequest(ctx, req)
 	if err != nil {
 		log.Printf("处理好友请求RPC调用失败: %v", err)
-		return errno.InternalServiceError.WithError(err)
+		return nil, errno.InternalServiceError.WithError(err)
 	}
 	if resp.Base.Code != errno.SuccessCode {
-		return errno.InternalServiceError.WithMessage(resp.Base.Msg)
+		return nil, errno.InternalServiceError.WithMessage(resp.Base.Msg)
 	}
-	return nil
+	return resp.Request, nil
 }
 
-// MarkMessageReadRPC 标记消息已读
-func MarkMessageReadRPC(ctx context.Context, req *social.MarkMessageReadRequest) error {
+// MarkMessageReadRPC 标记消息已读，返回推进后的已读游标
+func MarkMessageReadRPC(ctx context.Context, req *social.MarkMessageReadRequest) (*model.ReadCursor, error) {
 	resp, err := socialClient.MarkMessageRead(ctx, req)
 	if err != nil {
 		log.Printf("标记消息已读RPC调用失败: %v", err)
-		return errno.InternalServiceError.WithError(err)
+		return nil, errno.InternalServiceError.WithError(err)
 	}
 	if resp.Base.Code != errno.SuccessCode {
-		return errno.InternalServiceError.WithMessage(resp.Base.Msg)
+		return nil, errno.InternalServiceError.WithMessage(resp.Base.Msg)
 	}
-	return nil
+	return resp.Cursor, nil
 }
 
 // GetUnreadMessageCountRPC 获取未读消息数量
@@ -213,3 +447,191 @@ func GetUnreadMessageCountRPC(ctx context.Context, req *social.GetUnreadMessageC
 	}
 	return resp.Count, nil
 }
+
+// MarkConversationsReadRPC 批量标记会话已读
+func MarkConversationsReadRPC(ctx context.Context, req *social.MarkConversationsReadRequest) (*social.MarkConversationsReadResponse, error) {
+	resp, err := socialClient.MarkConversationsRead(ctx, req)
+	if err != nil {
+		log.Printf("批量标记会话已读RPC调用失败: %v", err)
+		return nil, errno.InternalServiceError.WithError(err)
+	}
+	if resp.Base.Code != errno.SuccessCode {
+		return nil, errno.InternalServiceError.WithMessage(resp.Base.Msg)
+	}
+	return resp, nil
+}
+
+// GetUnreadCountsRPC 获取各会话未读数
+func GetUnreadCountsRPC(ctx context.Context, req *social.GetUnreadCountsRequest) (*social.GetUnreadCountsResponse, error) {
+	resp, err := socialClient.GetUnreadCounts(ctx, req)
+	if err != nil {
+		log.Printf("获取各会话未读数RPC调用失败: %v", err)
+		return nil, errno.InternalServiceError.WithError(err)
+	}
+	if resp.Base.Code != errno.SuccessCode {
+		return nil, errno.InternalServiceError.WithMessage(resp.Base.Msg)
+	}
+	return resp, nil
+}
+
+// GetInboxRPC 获取收件箱
+func GetInboxRPC(ctx context.Context, req *social.GetInboxRequest) (*social.GetInboxResponse, error) {
+	resp, err := socialClient.GetInbox(ctx, req)
+	if err != nil {
+		log.Printf("获取收件箱RPC调用失败: %v", err)
+		return nil, errno.InternalServiceError.WithError(err)
+	}
+	if resp.Base.Code != errno.SuccessCode {
+		return nil, errno.InternalServiceError.WithMessage(resp.Base.Msg)
+	}
+	return resp, nil
+}
+
+// UpdateConversationRPC 修改会话设置
+func UpdateConversationRPC(ctx context.Context, req *social.UpdateConversationRequest) (*model.Conversation, error) {
+	resp, err := socialClient.UpdateConversation(ctx, req)
+	if err != nil {
+		log.Printf("修改会话设置RPC调用失败: %v", err)
+		return nil, errno.InternalServiceError.WithError(err)
+	}
+	if resp.Base.Code != errno.SuccessCode {
+		return nil, errno.InternalServiceError.WithMessage(resp.Base.Msg)
+	}
+	return resp.Conversation, nil
+}
+
+// SyncMessagesRPC 同步会话消息
+func SyncMessagesRPC(ctx context.Context, req *social.SyncMessagesRequest) (*social.SyncMessagesResponse, error) {
+	resp, err := socialClient.SyncMessages(ctx, req)
+	if err != nil {
+		log.Printf("同步消息RPC调用失败: %v", err)
+		return nil, errno.InternalServiceError.WithError(err)
+	}
+	if resp.Base.Code != errno.SuccessCode {
+		return nil, errno.InternalServiceError.WithMessage(resp.Base.Msg)
+	}
+	return resp, nil
+}
+
+// GetNotificationsRPC 分页获取通知
+func GetNotificationsRPC(ctx context.Context, req *social.GetNotificationsRequest) (*social.GetNotificationsResponse, error) {
+	resp, err := socialClient.GetNotifications(ctx, req)
+	if err != nil {
+		log.Printf("获取通知列表RPC调用失败: %v", err)
+		return nil, errno.InternalServiceError.WithError(err)
+	}
+	if resp.Base.Code != errno.SuccessCode {
+		return nil, errno.InternalServiceError.WithMessage(resp.Base.Msg)
+	}
+	return resp, nil
+}
+
+// GetNotificationUnreadCountRPC 获取各类型的未读通知数
+func GetNotificationUnreadCountRPC(
+	ctx context.Context, req *social.GetNotificationUnreadCountRequest,
+) (*social.GetNotificationUnreadCountResponse, error) {
+	resp, err := socialClient.GetNotificationUnreadCount(ctx, req)
+	if err != nil {
+		log.Printf("获取未读通知数RPC调用失败: %v", err)
+		return nil, errno.InternalServiceError.WithError(err)
+	}
+	if resp.Base.Code != errno.SuccessCode {
+		return nil, errno.InternalServiceError.WithMessage(resp.Base.Msg)
+	}
+	return resp, nil
+}
+
+// MarkNotificationsReadRPC 标记通知已读，返回本次标记的条数
+func MarkNotificationsReadRPC(ctx context.Context, req *social.MarkNotificationsReadRequest) (int64, error) {
+	resp, err := socialClient.MarkNotificationsRead(ctx, req)
+	if err != nil {
+		log.Printf("标记通知已读RPC调用失败: %v", err)
+		return 0, errno.InternalServiceError.WithError(err)
+	}
+	if resp.Base.Code != errno.SuccessCode {
+		return 0, errno.InternalServiceError.WithMessage(resp.Base.Msg)
+	}
+	return resp.Count, nil
+}
+
+// GetNotificationPreferencesRPC 获取通知偏好
+func GetNotificationPreferencesRPC(
+	ctx context.Context, req *social.GetNotificationPreferencesRequest,
+) ([]*model.NotificationPreference, error) {
+	resp, err := socialClient.GetNotificationPreferences(ctx, req)
+	if err != nil {
+		log.Printf("获取通知偏好RPC调用失败: %v", err)
+		return nil, errno.InternalServiceError.WithError(err)
+	}
+	if resp.Base.Code != errno.SuccessCode {
+		return nil, errno.InternalServiceError.WithMessage(resp.Base.Msg)
+	}
+	return resp.Preferences, nil
+}
+
+// UpdateNotificationPreferencesRPC 修改通知偏好，返回修改后的全部偏好
+func UpdateNotificationPreferencesRPC(
+	ctx context.Context, req *social.UpdateNotificationPreferencesRequest,
+) ([]*model.NotificationPreference, error) {
+	resp, err := socialClient.UpdateNotificationPreferences(ctx, req)
+	if err != nil {
+		log.Printf("修改通知偏好RPC调用失败: %v", err)
+		return nil, errno.InternalServiceError.WithError(err)
+	}
+	if resp.Base.Code != errno.SuccessCode {
+		return nil, errno.InternalServiceError.WithMessage(resp.Base.Msg)
+	}
+	return resp.Preferences, nil
+}
+
+// UpdatePresenceRPC 上报用户在线状态
+func UpdatePresenceRPC(ctx context.Context, req *social.UpdatePresenceRequest) error {
+	resp, err := socialClient.UpdatePresence(ctx, req)
+	if err != nil {
+		log.Printf("上报在线状态RPC调用失败: %v", err)
+		return errno.InternalServiceError.WithError(err)
+	}
+	if resp.Base.Code != errno.SuccessCode {
+		return errno.InternalServiceError.WithMessage(resp.Base.Msg)
+	}
+	return nil
+}
+
+// GetPresenceRPC 批量获取在线状态
+func GetPresenceRPC(ctx context.Context, req *social.GetPresenceRequest) ([]*model.UserPresence, error) {
+	resp, err := socialClient.GetPresence(ctx, req)
+	if err != nil {
+		log.Printf("获取在线状态RPC调用失败: %v", err)
+		return nil, errno.InternalServiceError.WithError(err)
+	}
+	if resp.Base.Code != errno.SuccessCode {
+		return nil, errno.InternalServiceError.WithMessage(resp.Base.Msg)
+	}
+	return resp.Presences, nil
+}
+
+// SearchMessagesRPC 搜索聊天记录
+func SearchMessagesRPC(ctx context.Context, req *social.SearchMessagesRequest) (*social.SearchMessagesResponse, error) {
+	resp, err := socialClient.SearchMessages(ctx, req)
+	if err != nil {
+		log.Printf("搜索聊天记录RPC调用失败: %v", err)
+		return nil, errno.InternalServiceError.WithError(err)
+	}
+	if resp.Base.Code != errno.SuccessCode {
+		return nil, errno.InternalServiceError.WithMessage(resp.Base.Msg)
+	}
+	return resp, nil
+}
+
+// ExportChatHistoryRPC 导出已归档的聊天记录
+func ExportChatHistoryRPC(ctx context.Context, req *social.ExportChatHistoryRequest) (*social.ExportChatHistoryResponse, error) {
+	resp, err := socialClient.ExportChatHistory(ctx, req)
+	if err != nil {
+		log.Printf("导出聊天记录RPC调用失败: %v", err)
+		return nil, errno.InternalServiceError.WithError(err)
+	}
+	if resp.Base.Code != errno.SuccessCode {
+		return nil, errno.InternalServiceError.WithMessage(resp.Base.Msg)
+	}
+	return resp, nil
+}
@@ -83,3 +83,120 @@ func RefreshTokenRPC(ctx context.Context, req *user.RefreshTokenRequest) (string
 	}
 	return resp.Token, nil
 }
+
+// FollowRPC 关注用户，返回关注后是否互相关注
+func FollowRPC(ctx context.Context, req *user.FollowRequest) (bool, error) {
+	resp, err := userClient.Follow(ctx, req)
+	if err != nil {
+		log.Printf("关注用户RPC调用失败: %v", err)
+		return false, errno.InternalServiceError.WithError(err)
+	}
+	if resp.Base.Code != errno.SuccessCode {
+		return false, errno.InternalServiceError.WithMessage(resp.Base.Msg)
+	}
+	return resp.IsMutual, nil
+}
+
+// UnfollowRPC 取消关注
+func UnfollowRPC(ctx context.Context, req *user.UnfollowRequest) error {
+	resp, err := userClient.Unfollow(ctx, req)
+	if err != nil {
+		log.Printf("取消关注RPC调用失败: %v", err)
+		return errno.InternalServiceError.WithError(err)
+	}
+	if resp.Base.Code != errno.SuccessCode {
+		return errno.InternalServiceError.WithMessage(resp.Base.Msg)
+	}
+	return nil
+}
+
+// GetFollowingRPC 获取关注列表
+func GetFollowingRPC(ctx context.Context, req *user.FollowListRequest) (*user.FollowListResponse, error) {
+	resp, err := userClient.GetFollowing(ctx, req)
+	if err != nil {
+		log.Printf("获取关注列表RPC调用失败: %v", err)
+		return nil, errno.InternalServiceError.WithError(err)
+	}
+	if resp.Base.Code != errno.SuccessCode {
+		return nil, errno.InternalServiceError.WithMessage(resp.Base.Msg)
+	}
+	return resp, nil
+}
+
+// GetFollowersRPC 获取粉丝列表
+func GetFollowersRPC(ctx context.Context, req *user.FollowListRequest) (*user.FollowListResponse, error) {
+	resp, err := userClient.GetFollowers(ctx, req)
+	if err != nil {
+		log.Printf("获取粉丝列表RPC调用失败: %v", err)
+		return nil, errno.InternalServiceError.WithError(err)
+	}
+	if resp.Base.Code != errno.SuccessCode {
+		return nil, errno.InternalServiceError.WithMessage(resp.Base.Msg)
+	}
+	return resp, nil
+}
+
+// BlockRPC 拉黑用户
+func BlockRPC(ctx context.Context, req *user.BlockRequest) error {
+	resp, err := userClient.Block(ctx, req)
+	if err != nil {
+		log.Printf("拉黑用户RPC调用失败: %v", err)
+		return errno.InternalServiceError.WithError(err)
+	}
+	if resp.Base.Code != errno.SuccessCode {
+		return errno.InternalServiceError.WithMessage(resp.Base.Msg)
+	}
+	return nil
+}
+
+// UnblockRPC 取消拉黑
+func UnblockRPC(ctx context.Context, req *user.UnblockRequest) error {
+	resp, err := userClient.Unblock(ctx, req)
+	if err != nil {
+		log.Printf("取消拉黑RPC调用失败: %v", err)
+		return errno.InternalServiceError.WithError(err)
+	}
+	if resp.Base.Code != errno.SuccessCode {
+		return errno.InternalServiceError.WithMessage(resp.Base.Msg)
+	}
+	return nil
+}
+
+// GetBlockListRPC 获取黑名单
+func GetBlockListRPC(ctx context.Context, req *user.BlockListRequest) (*user.BlockListResponse, error) {
+	resp, err := userClient.GetBlockList(ctx, req)
+	if err != nil {
+		log.Printf("获取黑名单RPC调用失败: %v", err)
+		return nil, errno.InternalServiceError.WithError(err)
+	}
+	if resp.Base.Code != errno.SuccessCode {
+		return nil, errno.InternalServiceError.WithMessage(resp.Base.Msg)
+	}
+	return resp, nil
+}
+
+// GetPrivacySettingsRPC 获取隐私设置
+func GetPrivacySettingsRPC(ctx context.Context, req *user.GetPrivacySettingsRequest) (*model.PrivacySettings, error) {
+	resp, err := userClient.GetPrivacySettings(ctx, req)
+	if err != nil {
+		log.Printf("获取隐私设置RPC调用失败: %v", err)
+		return nil, errno.InternalServiceError.WithError(err)
+	}
+	if resp.Base.Code != errno.SuccessCode {
+		return nil, errno.InternalServiceError.WithMessage(resp.Base.Msg)
+	}
+	return resp.Settings, nil
+}
+
+// UpdatePrivacySettingsRPC 修改隐私设置
+func UpdatePrivacySettingsRPC(ctx context.Context, req *user.UpdatePrivacySettingsRequest) (*model.PrivacySettings, error) {
+	resp, err := userClient.UpdatePrivacySettings(ctx, req)
+	if err != nil {
+		log.Printf("修改隐私设置RPC调用失败: %v", err)
+		return nil, errno.InternalServiceError.WithError(err)
+	}
+	if resp.Base.Code != errno.SuccessCode {
+		return nil, errno.InternalServiceError.WithMessage(resp.Base.Msg)
+	}
+	return resp.Settings, nil
+}
@@ -133,3 +133,29 @@ func SemanticSearchRPC(ctx context.Context, req *video.SemanticSearchRequest) ([
 	}
 	return resp.Results, nil
 }
+
+// GetFollowingFeedRPC 获取关注流
+func GetFollowingFeedRPC(ctx context.Context, req *video.FollowingFeedRequest) (*video.FollowingFeedResponse, error) {
+	resp, err := videoClient.GetFollowingFeed(ctx, req)
+	if err != nil {
+		log.Printf("获取关注流RPC调用失败: %v", err)
+		return nil, errno.InternalServiceError.WithError(err)
+	}
+	if resp.Base.Code != errno.SuccessCode {
+		return nil, errno.InternalServiceError.WithMessage(resp.Base.Msg)
+	}
+	return resp, nil
+}
+
+// BackfillFeedRPC 新关注后回填关注流
+func BackfillFeedRPC(ctx context.Context, req *video.BackfillFeedRequest) error {
+	resp, err := videoClient.BackfillFeed(ctx, req)
+	if err != nil {
+		log.Printf("回填关注流RPC调用失败: %v", err)
+		return errno.InternalServiceError.WithError(err)
+	}
+	if resp.Base.Code != errno.SuccessCode {
+		return errno.InternalServiceError.WithMessage(resp.Base.Msg)
+	}
+	return nil
+}
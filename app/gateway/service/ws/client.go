@@ -1,11 +1,14 @@
 package ws
 
 import (
+	"context"
+	"encoding/json"
 	"log"
 	"time"
 
 	"github.com/hertz-contrib/websocket"
 	"github.com/yxrxy/videoHub/pkg/constants"
+	"github.com/yxrxy/videoHub/pkg/errno"
 )
 
 const (
@@ -19,42 +22,191 @@ const (
 	pingPeriod = time.Duration(float64(pongWait) * constants.WebSocketPingRatio)
 )
 
-// ReadPump 处理WebSocket读取
-func (c *Client) ReadPump(manager *Manager) {
+// Conn 客户端使用的WebSocket连接，*websocket.Conn 实现了该接口
+type Conn interface {
+	ReadMessage() (messageType int, p []byte, err error)
+	WriteMessage(messageType int, data []byte) error
+	SetReadLimit(limit int64)
+	SetReadDeadline(t time.Time) error
+	SetWriteDeadline(t time.Time) error
+	SetPongHandler(h func(appData string) error)
+	Close() error
+}
+
+// ReadPump 持续读取客户端帧并交给 service 处理，连接断开或读取超时后注销客户端
+func (c *Client) ReadPump(ctx context.Context, service *WsService) {
 	defer func() {
-		manager.unregister <- c
-		c.Conn.Close()
+		service.StopSignals(c)
+		service.manager.unregister <- c
+		c.Close()
 	}()
+
+	c.Conn.SetReadLimit(constants.WebSocketMaxFrameSize)
+	if err := c.Conn.SetReadDeadline(time.Now().Add(pongWait)); err != nil {
+		log.Printf("error setting read deadline: %v", err)
+		return
+	}
+	c.Conn.SetPongHandler(func(string) error {
+		return c.Conn.SetReadDeadline(time.Now().Add(pongWait))
+	})
+
+	for {
+		messageType, data, err := c.Conn.ReadMessage()
+		if err != nil {
+			if websocket.IsUnexpectedCloseError(err, websocket.CloseGoingAway, websocket.CloseNormalClosure) {
+				log.Printf("error reading message: %v", err)
+			}
+			return
+		}
+		// 收到任何帧都说明连接存活
+		if err := c.Conn.SetReadDeadline(time.Now().Add(pongWait)); err != nil {
+			log.Printf("error setting read deadline: %v", err)
+			return
+		}
+		if messageType != websocket.TextMessage {
+			c.SendReply(errorReply("", errno.ParamVerifyError.WithMessage("only text frames are supported")))
+			continue
+		}
+		service.HandleFrame(ctx, c, data)
+	}
+}
+
+// outbound 待写入连接的帧，closeAfter 为真时写入后关闭连接
+type outbound struct {
+	data       []byte
+	closeAfter bool
+}
+
+// newClient 创建客户端，发送队列由 WritePump 独占消费
+func newClient(userID int64, deviceID string, conn Conn, queue sendQueueConfig, metrics *sendMetrics) *Client {
+	return &Client{
+		UserID:   userID,
+		DeviceID: deviceID,
+		Conn:     conn,
+		Rooms:    make(map[int64]bool),
+		send:     make(chan outbound, queue.size),
+		done:     make(chan struct{}),
+		policy:   queue.policy,
+		metrics:  metrics,
+	}
 }
 
+// WritePump 唯一写连接的协程，依次写出发送队列中的帧并定时发送 ping
 func (c *Client) WritePump() {
 	ticker := time.NewTicker(pingPeriod)
 	defer func() {
 		ticker.Stop()
-		c.Conn.Close()
+		c.Close()
 	}()
 
-	for range ticker.C {
-		if err := c.Conn.SetWriteDeadline(time.Now().Add(writeWait)); err != nil {
-			log.Printf("error setting write deadline: %v", err)
-			return
-		}
-		if err := c.Conn.WriteMessage(websocket.PingMessage, nil); err != nil {
-			log.Printf("error writing ping message: %v", err)
+	for {
+		select {
+		case msg := <-c.send:
+			if err := c.write(websocket.TextMessage, msg.data); err != nil {
+				log.Printf("error writing message to device %s: %v", c.DeviceID, err)
+				return
+			}
+			if msg.closeAfter {
+				return
+			}
+		case <-ticker.C:
+			if err := c.write(websocket.PingMessage, nil); err != nil {
+				log.Printf("error writing ping message: %v", err)
+				return
+			}
+		case <-c.done:
 			return
 		}
 	}
 }
 
-// SendMessage 发送消息给客户端
-func (c *Client) SendMessage(messageType int, data []byte) error {
+func (c *Client) write(messageType int, data []byte) error {
 	if err := c.Conn.SetWriteDeadline(time.Now().Add(writeWait)); err != nil {
-		log.Printf("error setting write deadline: %v", err)
 		return err
 	}
 	return c.Conn.WriteMessage(messageType, data)
 }
 
+// SendMessage 将文本消息放入发送队列，不会阻塞调用方。
+// 队列已满时按慢消费者策略丢弃最早的消息或断开连接
+func (c *Client) SendMessage(data []byte) error {
+	return c.enqueue(outbound{data: data})
+}
+
+func (c *Client) enqueue(msg outbound) error {
+	for {
+		select {
+		case <-c.done:
+			return errClientClosed
+		default:
+		}
+
+		select {
+		case c.send <- msg:
+			return nil
+		default:
+		}
+
+		c.metrics.dropped.Add(1)
+		if c.policy == SlowConsumerDisconnect {
+			c.metrics.disconnected.Add(1)
+			log.Printf("send queue of user %d device %s is full, disconnecting", c.UserID, c.DeviceID)
+			c.Close()
+			return errSendQueueFull
+		}
+		// 丢弃最早的一条后重试，期间 WritePump 可能已经取走消息。
+		// 被丢弃的是踢下线通知时直接关闭连接
+		select {
+		case old := <-c.send:
+			if old.closeAfter {
+				c.Close()
+				return errClientClosed
+			}
+		default:
+		}
+	}
+}
+
+// Close 关闭连接并停止 WritePump，可重复调用，ReadPump 随后注销客户端
+func (c *Client) Close() {
+	c.closeOnce.Do(func() {
+		close(c.done)
+		c.Conn.Close()
+	})
+}
+
+// QueueDepth 发送队列中待写出的消息数
+func (c *Client) QueueDepth() int {
+	return len(c.send)
+}
+
+// SendReply 发送回复帧
+func (c *Client) SendReply(reply *ReplyFrame) {
+	reply.Timestamp = time.Now().Unix()
+	data, err := json.Marshal(reply)
+	if err != nil {
+		log.Printf("error marshaling reply: %v", err)
+		return
+	}
+	if err := c.SendMessage(data); err != nil {
+		log.Printf("error sending reply: %v", err)
+	}
+}
+
+// Kick 通知客户端被踢下线，通知写出后关闭连接，ReadPump 随后注销客户端
+func (c *Client) Kick(reason string) {
+	data, _ := json.Marshal(&Message{
+		Type:      MessageTypeKicked,
+		Content:   reason,
+		Extra:     map[string]any{"device_id": c.DeviceID},
+		Timestamp: time.Now().Unix(),
+	})
+	if err := c.enqueue(outbound{data: data, closeAfter: true}); err != nil {
+		log.Printf("error sending kick message: %v", err)
+		c.Close()
+	}
+}
+
 // JoinRoom 加入聊天室
 func (c *Client) JoinRoom(roomID int64) {
 	c.mu.Lock()
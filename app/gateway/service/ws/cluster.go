@@ -0,0 +1,343 @@
+package ws
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// 跨节点分发：每个节点订阅自己的节点频道以及公共的聊天室、广播频道。
+// 私信按在线表路由到持有连接的节点；聊天室成员只保存在各节点内存中，
+// 因此聊天室消息发往所有节点，由各节点投递给本地成员。
+const (
+	clusterNodeChannelPrefix = "ws:node:"
+	clusterRoomChannel       = "ws:room"
+	clusterBroadcastChannel  = "ws:broadcast"
+	presenceKeyPrefix        = "ws:presence:"
+
+	// 在线表过期时间，节点每个心跳周期续期，节点宕机后记录自动过期
+	presenceTTL = 2 * pongWait
+	// 访问 Broker 和在线表的超时时间
+	clusterTimeout = 3 * time.Second
+	// 订阅通道缓冲大小
+	clusterBufferSize = 256
+)
+
+// 节点间信封类型
+const (
+	envelopeUser      = "user"
+	envelopeRoom      = "room"
+	envelopeBroadcast = "broadcast"
+	envelopeKick      = "kick"
+	envelopeJoin      = "join"
+	envelopeLeave     = "leave"
+)
+
+// envelope 节点间转发的消息
+type envelope struct {
+	Kind    string `json:"kind"`
+	Origin  string `json:"origin"`            // 发出消息的节点
+	Target  int64  `json:"target,omitempty"`  // 用户ID或聊天室ID
+	Exclude int64  `json:"exclude,omitempty"` // 聊天室消息不投递给该用户（发送者）
+	Device  string `json:"device,omitempty"`  // 踢下线时保留的设备
+	Room    int64  `json:"room,omitempty"`    // 加入或离开的聊天室
+	Data    []byte `json:"data"`
+}
+
+// Broker 节点间的发布订阅通道
+type Broker interface {
+	Publish(ctx context.Context, channel string, payload []byte) error
+	// Subscribe 订阅频道，ctx 结束后返回的通道关闭
+	Subscribe(ctx context.Context, channels ...string) (<-chan []byte, error)
+}
+
+// Presence 在线表，记录用户连接所在的节点
+type Presence interface {
+	Register(ctx context.Context, userID int64, nodeID string) error
+	Unregister(ctx context.Context, userID int64, nodeID string) error
+	Nodes(ctx context.Context, userID int64) ([]string, error)
+}
+
+func nodeChannel(nodeID string) string {
+	return clusterNodeChannelPrefix + nodeID
+}
+
+// redisBroker 基于 Redis pub/sub 的 Broker
+type redisBroker struct {
+	client *redis.Client
+}
+
+// NewRedisBroker 创建基于 Redis pub/sub 的 Broker
+func NewRedisBroker(client *redis.Client) Broker {
+	return &redisBroker{client: client}
+}
+
+func (b *redisBroker) Publish(ctx context.Context, channel string, payload []byte) error {
+	return b.client.Publish(ctx, channel, payload).Err()
+}
+
+func (b *redisBroker) Subscribe(ctx context.Context, channels ...string) (<-chan []byte, error) {
+	ps := b.client.Subscribe(ctx, channels...)
+	// 等待订阅确认，保证返回后不会丢失发布的消息
+	if _, err := ps.Receive(ctx); err != nil {
+		ps.Close()
+		return nil, err
+	}
+
+	out := make(chan []byte, clusterBufferSize)
+	go func() {
+		defer close(out)
+		defer ps.Close()
+		ch := ps.Channel()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case msg, ok := <-ch:
+				if !ok {
+					return
+				}
+				out <- []byte(msg.Payload)
+			}
+		}
+	}()
+	return out, nil
+}
+
+// redisPresence 基于 Redis 集合的在线表
+type redisPresence struct {
+	client *redis.Client
+}
+
+// NewRedisPresence 创建基于 Redis 的在线表
+func NewRedisPresence(client *redis.Client) Presence {
+	return &redisPresence{client: client}
+}
+
+func presenceKey(userID int64) string {
+	return presenceKeyPrefix + strconv.FormatInt(userID, 10)
+}
+
+func (p *redisPresence) Register(ctx context.Context, userID int64, nodeID string) error {
+	key := presenceKey(userID)
+	pipe := p.client.TxPipeline()
+	pipe.SAdd(ctx, key, nodeID)
+	pipe.Expire(ctx, key, presenceTTL)
+	_, err := pipe.Exec(ctx)
+	return err
+}
+
+func (p *redisPresence) Unregister(ctx context.Context, userID int64, nodeID string) error {
+	return p.client.SRem(ctx, presenceKey(userID), nodeID).Err()
+}
+
+func (p *redisPresence) Nodes(ctx context.Context, userID int64) ([]string, error) {
+	return p.client.SMembers(ctx, presenceKey(userID)).Result()
+}
+
+// MemoryBroker 进程内的 Broker，用于单机部署和测试
+type MemoryBroker struct {
+	mu   sync.RWMutex
+	subs map[string]map[chan []byte]struct{}
+}
+
+// NewMemoryBroker 创建进程内的 Broker
+func NewMemoryBroker() *MemoryBroker {
+	return &MemoryBroker{subs: make(map[string]map[chan []byte]struct{})}
+}
+
+// Publish 发布消息，订阅者缓冲区已满时丢弃，与 Redis pub/sub 一样最多投递一次
+func (b *MemoryBroker) Publish(_ context.Context, channel string, payload []byte) error {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	for ch := range b.subs[channel] {
+		select {
+		case ch <- payload:
+		default:
+			log.Printf("memory broker: subscriber of %s is full, message dropped", channel)
+		}
+	}
+	return nil
+}
+
+// Subscribe 订阅频道
+func (b *MemoryBroker) Subscribe(ctx context.Context, channels ...string) (<-chan []byte, error) {
+	ch := make(chan []byte, clusterBufferSize)
+	b.mu.Lock()
+	for _, channel := range channels {
+		if b.subs[channel] == nil {
+			b.subs[channel] = make(map[chan []byte]struct{})
+		}
+		b.subs[channel][ch] = struct{}{}
+	}
+	b.mu.Unlock()
+
+	go func() {
+		<-ctx.Done()
+		b.mu.Lock()
+		for _, channel := range channels {
+			delete(b.subs[channel], ch)
+		}
+		b.mu.Unlock()
+		close(ch)
+	}()
+	return ch, nil
+}
+
+// MemoryPresence 进程内的在线表，用于单机部署和测试
+type MemoryPresence struct {
+	mu    sync.RWMutex
+	nodes map[int64]map[string]struct{}
+}
+
+// NewMemoryPresence 创建进程内的在线表
+func NewMemoryPresence() *MemoryPresence {
+	return &MemoryPresence{nodes: make(map[int64]map[string]struct{})}
+}
+
+// Register 记录用户连接所在的节点
+func (p *MemoryPresence) Register(_ context.Context, userID int64, nodeID string) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.nodes[userID] == nil {
+		p.nodes[userID] = make(map[string]struct{})
+	}
+	p.nodes[userID][nodeID] = struct{}{}
+	return nil
+}
+
+// Unregister 删除用户在节点上的记录
+func (p *MemoryPresence) Unregister(_ context.Context, userID int64, nodeID string) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	delete(p.nodes[userID], nodeID)
+	if len(p.nodes[userID]) == 0 {
+		delete(p.nodes, userID)
+	}
+	return nil
+}
+
+// Nodes 获取用户连接所在的节点
+func (p *MemoryPresence) Nodes(_ context.Context, userID int64) ([]string, error) {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	nodes := make([]string, 0, len(p.nodes[userID]))
+	for node := range p.nodes[userID] {
+		nodes = append(nodes, node)
+	}
+	return nodes, nil
+}
+
+// Subscribe 订阅节点间消息，集群模式下需在 Start 之前调用
+func (m *Manager) Subscribe(ctx context.Context) error {
+	if m.broker == nil {
+		return nil
+	}
+	ch, err := m.broker.Subscribe(ctx, nodeChannel(m.nodeID), clusterRoomChannel, clusterBroadcastChannel)
+	if err != nil {
+		return fmt.Errorf("订阅节点频道失败: %w", err)
+	}
+	go func() {
+		for payload := range ch {
+			m.handleEnvelope(payload)
+		}
+	}()
+	return nil
+}
+
+// handleEnvelope 投递其他节点转发来的消息
+func (m *Manager) handleEnvelope(payload []byte) {
+	var env envelope
+	if err := json.Unmarshal(payload, &env); err != nil {
+		log.Printf("error decoding cluster envelope: %v", err)
+		return
+	}
+	// 聊天室和广播频道会收到本节点自己发布的消息，本地已经投递过
+	if env.Origin == m.nodeID {
+		return
+	}
+	switch env.Kind {
+	case envelopeUser:
+		if err := m.deliverToUser(env.Target, env.Data); err != nil {
+			log.Printf("error delivering cluster message to user %d: %v", env.Target, err)
+		}
+	case envelopeRoom:
+		m.deliverToRoom(env.Exclude, env.Target, env.Data)
+	case envelopeBroadcast:
+		m.broadcast <- env.Data
+	case envelopeKick:
+		m.kickLocal(env.Target, env.Device)
+	case envelopeJoin:
+		m.joinUserLocal(env.Target, env.Room)
+	case envelopeLeave:
+		m.leaveUserLocal(env.Target, env.Room)
+	}
+}
+
+// publish 向其他节点发布消息
+func (m *Manager) publish(channel string, env *envelope) error {
+	env.Origin = m.nodeID
+	data, err := json.Marshal(env)
+	if err != nil {
+		return err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), clusterTimeout)
+	defer cancel()
+	return m.broker.Publish(ctx, channel, data)
+}
+
+// forwardToUser 将私信转发到用户连接所在的其他节点
+func (m *Manager) forwardToUser(userID int64, data []byte) error {
+	return m.forward(userID, &envelope{Kind: envelopeUser, Target: userID, Data: data})
+}
+
+// forward 将信封发往用户连接所在的其他节点
+func (m *Manager) forward(userID int64, env *envelope) error {
+	ctx, cancel := context.WithTimeout(context.Background(), clusterTimeout)
+	defer cancel()
+	nodes, err := m.presence.Nodes(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("查询在线表失败: %w", err)
+	}
+	for _, node := range nodes {
+		if node == m.nodeID {
+			continue
+		}
+		if err := m.publish(nodeChannel(node), env); err != nil {
+			return fmt.Errorf("转发消息到节点 %s 失败: %w", node, err)
+		}
+	}
+	return nil
+}
+
+// registerPresence 在在线表中登记本节点持有用户连接
+func (m *Manager) registerPresence(userIDs ...int64) {
+	if m.presence == nil {
+		return
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), clusterTimeout)
+	defer cancel()
+	for _, userID := range userIDs {
+		if err := m.presence.Register(ctx, userID, m.nodeID); err != nil {
+			log.Printf("error registering presence of user %d: %v", userID, err)
+		}
+	}
+}
+
+// unregisterPresence 从在线表中删除本节点的用户连接
+func (m *Manager) unregisterPresence(userID int64) {
+	if m.presence == nil {
+		return
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), clusterTimeout)
+	defer cancel()
+	if err := m.presence.Unregister(ctx, userID, m.nodeID); err != nil {
+		log.Printf("error unregistering presence of user %d: %v", userID, err)
+	}
+}
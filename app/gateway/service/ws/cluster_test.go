@@ -0,0 +1,153 @@
+package ws
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hertz-contrib/websocket"
+	"github.com/smartystreets/goconvey/convey"
+)
+
+// fakeConn 记录写入的文本消息
+type fakeConn struct {
+	messages  chan []byte
+	closed    chan struct{}
+	closeOnce sync.Once
+}
+
+func newFakeConn() *fakeConn {
+	return &fakeConn{
+		messages: make(chan []byte, 16),
+		closed:   make(chan struct{}),
+	}
+}
+
+func (c *fakeConn) ReadMessage() (int, []byte, error) {
+	<-c.closed
+	return 0, nil, errors.New("closed")
+}
+
+func (c *fakeConn) WriteMessage(messageType int, data []byte) error {
+	if messageType == websocket.TextMessage {
+		c.messages <- data
+	}
+	return nil
+}
+
+func (c *fakeConn) SetReadLimit(int64)                {}
+func (c *fakeConn) SetReadDeadline(time.Time) error   { return nil }
+func (c *fakeConn) SetWriteDeadline(time.Time) error  { return nil }
+func (c *fakeConn) SetPongHandler(func(string) error) {}
+func (c *fakeConn) Close() error {
+	c.closeOnce.Do(func() { close(c.closed) })
+	return nil
+}
+
+func (c *fakeConn) isClosed() bool {
+	select {
+	case <-c.closed:
+		return true
+	default:
+		return false
+	}
+}
+
+func (c *fakeConn) next(timeout time.Duration) *Message {
+	select {
+	case data := <-c.messages:
+		var msg Message
+		if err := json.Unmarshal(data, &msg); err != nil {
+			return nil
+		}
+		return &msg
+	case <-time.After(timeout):
+		return nil
+	}
+}
+
+// register 注册客户端并丢弃欢迎消息
+func register(m *Manager, userID int64, deviceID string) (*Client, *fakeConn) {
+	conn := newFakeConn()
+	client := m.RegisterClient(userID, deviceID, conn)
+	conn.next(time.Second)
+	return client, conn
+}
+
+func newTestCluster(ctx context.Context) (*Manager, *Manager, *MemoryPresence) {
+	broker := NewMemoryBroker()
+	presence := NewMemoryPresence()
+	nodeA := NewClusterManager("a", broker, presence)
+	nodeB := NewClusterManager("b", broker, presence)
+	for _, m := range []*Manager{nodeA, nodeB} {
+		if err := m.Subscribe(ctx); err != nil {
+			panic(err)
+		}
+		go m.Start(ctx)
+	}
+	return nodeA, nodeB, presence
+}
+
+func TestManager_Cluster(t *testing.T) {
+	convey.Convey("跨节点投递", t, func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		nodeA, nodeB, presence := newTestCluster(ctx)
+
+		convey.Convey("私信路由到持有连接的节点", func() {
+			_, conn := register(nodeB, 2, "web")
+			convey.So(nodeA.IsUserOnline(2), convey.ShouldBeTrue)
+
+			data, _ := json.Marshal(&Message{Type: MessageTypePrivate, From: 1, To: 2, Content: "hi"})
+			convey.So(nodeA.SendToUser(2, data), convey.ShouldBeNil)
+
+			msg := conn.next(time.Second)
+			convey.So(msg, convey.ShouldNotBeNil)
+			convey.So(msg.Content, convey.ShouldEqual, "hi")
+			// 只投递一次
+			convey.So(conn.next(100*time.Millisecond), convey.ShouldBeNil)
+		})
+
+		convey.Convey("聊天室消息投递到所有节点的成员，发送者除外", func() {
+			sender, senderConn := register(nodeA, 1, "web")
+			memberA, connA := register(nodeA, 3, "web")
+			memberB, connB := register(nodeB, 2, "web")
+			nodeA.JoinRoom(sender, 10)
+			nodeA.JoinRoom(memberA, 10)
+			nodeB.JoinRoom(memberB, 10)
+
+			nodeA.SendToRoom(1, 10, &Message{Type: MessageTypeGroup, From: 1, RoomID: 10, Content: "hello"})
+
+			for _, conn := range []*fakeConn{connA, connB} {
+				msg := conn.next(time.Second)
+				convey.So(msg, convey.ShouldNotBeNil)
+				convey.So(msg.Content, convey.ShouldEqual, "hello")
+				convey.So(conn.next(100*time.Millisecond), convey.ShouldBeNil)
+			}
+			convey.So(senderConn.next(100*time.Millisecond), convey.ShouldBeNil)
+		})
+
+		convey.Convey("断开连接后从在线表删除", func() {
+			client, _ := register(nodeB, 2, "web")
+			nodes, _ := presence.Nodes(ctx, 2)
+			convey.So(nodes, convey.ShouldResemble, []string{"b"})
+
+			nodeB.unregister <- client
+			convey.So(waitFor(func() bool { return !nodeA.IsUserOnline(2) }), convey.ShouldBeTrue)
+		})
+	})
+}
+
+func waitFor(cond func() bool) bool {
+	deadline := time.Now().Add(time.Second)
+	for time.Now().Before(deadline) {
+		if cond() {
+			return true
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	return false
+}
@@ -0,0 +1,127 @@
+package ws
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/redis/go-redis/v9"
+)
+
+const cursorKeyPrefix = "ws:cursor:"
+
+// CursorStore 每个设备在每个会话中的已读游标，游标为已确认的最大会话序号
+type CursorStore interface {
+	// SetCursor 推进游标，seq 不大于当前游标时忽略
+	SetCursor(ctx context.Context, userID int64, deviceID, conversation string, seq int64) error
+	// GetCursors 获取设备在所有会话中的游标
+	GetCursors(ctx context.Context, userID int64, deviceID string) (map[string]int64, error)
+}
+
+// conversationKey 会话标识，私信为 private:<对方ID>，群聊为 group:<聊天室ID>
+func conversationKey(peerID, roomID int64) string {
+	if roomID != 0 {
+		return "group:" + strconv.FormatInt(roomID, 10)
+	}
+	return "private:" + strconv.FormatInt(peerID, 10)
+}
+
+// parseConversationKey 解析会话标识，返回私信对方ID或聊天室ID
+func parseConversationKey(key string) (peerID, roomID int64, ok bool) {
+	kind, id, found := strings.Cut(key, ":")
+	if !found {
+		return 0, 0, false
+	}
+	n, err := strconv.ParseInt(id, 10, 64)
+	if err != nil || n <= 0 {
+		return 0, 0, false
+	}
+	switch kind {
+	case "private":
+		return n, 0, true
+	case "group":
+		return 0, n, true
+	}
+	return 0, 0, false
+}
+
+func cursorKey(userID int64, deviceID string) string {
+	return fmt.Sprintf("%s%d:%s", cursorKeyPrefix, userID, deviceID)
+}
+
+// advanceCursorScript 仅在新游标更大时写入
+var advanceCursorScript = redis.NewScript(`
+local current = tonumber(redis.call('HGET', KEYS[1], ARGV[1]) or '0')
+if tonumber(ARGV[2]) > current then
+	redis.call('HSET', KEYS[1], ARGV[1], ARGV[2])
+end
+return 0
+`)
+
+// redisCursorStore 基于 Redis 哈希的游标存储，每个设备一个哈希
+type redisCursorStore struct {
+	client *redis.Client
+}
+
+// NewRedisCursorStore 创建基于 Redis 的游标存储
+func NewRedisCursorStore(client *redis.Client) CursorStore {
+	return &redisCursorStore{client: client}
+}
+
+func (s *redisCursorStore) SetCursor(ctx context.Context, userID int64, deviceID, conversation string, seq int64) error {
+	return advanceCursorScript.Run(ctx, s.client, []string{cursorKey(userID, deviceID)}, conversation, seq).Err()
+}
+
+func (s *redisCursorStore) GetCursors(ctx context.Context, userID int64, deviceID string) (map[string]int64, error) {
+	values, err := s.client.HGetAll(ctx, cursorKey(userID, deviceID)).Result()
+	if err != nil {
+		return nil, err
+	}
+	cursors := make(map[string]int64, len(values))
+	for conversation, value := range values {
+		id, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			continue
+		}
+		cursors[conversation] = id
+	}
+	return cursors, nil
+}
+
+// MemoryCursorStore 进程内的游标存储，用于单机部署和测试
+type MemoryCursorStore struct {
+	mu      sync.RWMutex
+	cursors map[string]map[string]int64
+}
+
+// NewMemoryCursorStore 创建进程内的游标存储
+func NewMemoryCursorStore() *MemoryCursorStore {
+	return &MemoryCursorStore{cursors: make(map[string]map[string]int64)}
+}
+
+// SetCursor 推进游标
+func (s *MemoryCursorStore) SetCursor(_ context.Context, userID int64, deviceID, conversation string, seq int64) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	key := cursorKey(userID, deviceID)
+	if s.cursors[key] == nil {
+		s.cursors[key] = make(map[string]int64)
+	}
+	if seq > s.cursors[key][conversation] {
+		s.cursors[key][conversation] = seq
+	}
+	return nil
+}
+
+// GetCursors 获取设备在所有会话中的游标
+func (s *MemoryCursorStore) GetCursors(_ context.Context, userID int64, deviceID string) (map[string]int64, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	cursors := make(map[string]int64, len(s.cursors[cursorKey(userID, deviceID)]))
+	for conversation, id := range s.cursors[cursorKey(userID, deviceID)] {
+		cursors[conversation] = id
+	}
+	return cursors, nil
+}
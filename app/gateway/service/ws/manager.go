@@ -3,11 +3,13 @@ package ws
 import (
 	"context"
 	"encoding/json"
+	"errors"
+	"fmt"
 	"log"
 	"sync"
 	"time"
 
-	"github.com/hertz-contrib/websocket"
+	"github.com/yxrxy/videoHub/pkg/constants"
 )
 
 // 消息类型
@@ -20,10 +22,39 @@ const (
 	MessageTypeGroup = "group"
 	// 好友请求消息类型
 	MessageTypeFriendRequest = "friend_request"
+	// 正在输入提示类型，由 typing 帧触发，Extra 与 signal 消息相同
+	MessageTypeTyping = "typing"
+	// 临时信号，Extra["kind"] 为信号种类，Extra["active"] 为是否进行中，
+	// Extra["ttl"] 为未续期时的过期秒数，Extra["expired"] 表示因超时自动停止
+	MessageTypeSignal = "signal"
+	// 连接被其他会话踢下线
+	MessageTypeKicked = "kicked"
+	// 会话有未补发完的消息，客户端需通过 sync 拉取
+	MessageTypeSyncRequired = "sync_required"
+	// 被移出聊天室或聊天室已解散，原因见 Extra["reason"]
+	MessageTypeRoomRemoved = "room_removed"
+	// 消息被编辑，ID 和 Seq 指向原消息，群聊推送给聊天室成员，私信推送给双方
+	MessageTypeMessageEdited = "message_edited"
+	// 消息被撤回，ID 和 Seq 指向原消息，内容为空，推送范围同 message_edited
+	MessageTypeMessageRecalled = "message_recalled"
+	// 已读回执，Extra["read_seq"] 为读者推进后的已读序号
+	MessageTypeReadReceipt = "read_receipt"
+	// 新通知或已有通知合并了新的触发者，Extra["notification"] 为合并后的通知，Extra["unread"] 为未读通知总数
+	MessageTypeNotification = "notification"
+	// 好友上线或下线，From 为该好友，Extra["online"] 为是否在线，Extra["last_seen"] 为最后在线时间
+	MessageTypePresence = "presence"
+)
+
+// room_removed 消息的原因
+const (
+	roomRemovedByAdmin   = "removed"
+	roomRemovedDisbanded = "disbanded"
 )
 
 // Message WebSocket消息结构
 type Message struct {
+	ID        int64          `json:"id,omitempty"`      // 消息ID（已持久化的消息）
+	Seq       int64          `json:"seq,omitempty"`     // 会话内序号（已持久化的消息）
 	Type      string         `json:"type"`              // 消息类型
 	From      int64          `json:"from"`              // 发送者ID
 	To        int64          `json:"to,omitempty"`      // 接收者ID（私信时使用）
@@ -35,60 +66,102 @@ type Message struct {
 
 // Client 表示一个WebSocket客户端连接
 type Client struct {
-	UserID int64           // 用户ID
-	Conn   *websocket.Conn // WebSocket连接
-	Rooms  map[int64]bool  // 加入的聊天室
-	mu     sync.Mutex      // 保护 Rooms 的互斥锁
+	UserID   int64          // 用户ID
+	DeviceID string         // 设备ID，同一用户可以有多个设备同时在线
+	Conn     Conn           // WebSocket连接
+	Rooms    map[int64]bool // 加入的聊天室
+	mu       sync.Mutex     // 保护 Rooms 的互斥锁
+
+	send      chan outbound      // 发送队列，只由 WritePump 写入连接
+	done      chan struct{}      // 连接关闭后关闭
+	closeOnce sync.Once          // 保证连接只关闭一次
+	policy    SlowConsumerPolicy // 发送队列已满时的处理策略
+	metrics   *sendMetrics       // 发送队列计数
+	signals   signalState        // 进行中的临时信号
 }
 
 // Manager 管理WebSocket连接
 type Manager struct {
-	clients    map[int64]*Client          // 用户ID -> 客户端连接
-	roomMap    map[int64]map[*Client]bool // 聊天室ID -> 客户端集合
-	broadcast  chan []byte                // 广播消息通道
-	register   chan *Client               // 注册客户端通道
-	unregister chan *Client               // 注销客户端通道
-	mutex      sync.RWMutex               // 保护 clients 和 roomMap 的互斥锁
+	clients    map[int64]map[string]*Client // 用户ID -> 设备ID -> 客户端连接
+	roomMap    map[int64]map[*Client]bool   // 聊天室ID -> 客户端集合
+	broadcast  chan []byte                  // 广播消息通道
+	register   chan *Client                 // 注册客户端通道
+	unregister chan *Client                 // 注销客户端通道
+	mutex      sync.RWMutex                 // 保护 clients 和 roomMap 的互斥锁
+	queue      sendQueueConfig              // 客户端发送队列配置
+	metrics    *sendMetrics                 // 发送队列计数
+	onOffline  func(userID int64)           // 用户在本节点的最后一个连接断开后调用
+
+	// 集群模式，单机模式下均为空
+	nodeID   string   // 本节点ID
+	broker   Broker   // 节点间消息通道
+	presence Presence // 在线表
 }
 
 // NewManager 创建一个新的WebSocket管理器
 func NewManager() *Manager {
 	return &Manager{
-		clients:    make(map[int64]*Client),
+		clients:    make(map[int64]map[string]*Client),
 		roomMap:    make(map[int64]map[*Client]bool),
 		broadcast:  make(chan []byte),
 		register:   make(chan *Client),
 		unregister: make(chan *Client),
+		queue: sendQueueConfig{
+			size:   constants.WebSocketSendQueueSize,
+			policy: SlowConsumerDropOldest,
+		},
+		metrics: &sendMetrics{},
 	}
 }
 
-// RegisterClient 注册新的WebSocket客户端
-func (m *Manager) RegisterClient(userID int64, conn *websocket.Conn) {
-	client := &Client{
-		UserID: userID,
-		Conn:   conn,
-		Rooms:  make(map[int64]bool),
+// SetSendQueue 设置客户端发送队列的容量和慢消费者策略，需在注册客户端之前调用
+func (m *Manager) SetSendQueue(size int, policy SlowConsumerPolicy) {
+	if size > 0 {
+		m.queue.size = size
 	}
+	m.queue.policy = policy
+}
 
-	// 启动读写泵
-	go client.ReadPump(m)
+// SetOfflineHandler 设置用户在本节点的最后一个连接断开后的回调，需在 Start 之前调用
+func (m *Manager) SetOfflineHandler(h func(userID int64)) {
+	m.onOffline = h
+}
+
+// NewClusterManager 创建集群模式的WebSocket管理器，消息通过 broker 在节点间转发
+func NewClusterManager(nodeID string, broker Broker, presence Presence) *Manager {
+	m := NewManager()
+	m.nodeID = nodeID
+	m.broker = broker
+	m.presence = presence
+	return m
+}
+
+// RegisterClient 注册新的WebSocket客户端，读循环由调用方通过 ReadPump 驱动。
+// 同一设备重复连接时旧连接会被关闭，其他设备的连接不受影响
+func (m *Manager) RegisterClient(userID int64, deviceID string, conn Conn) *Client {
+	client := newClient(userID, deviceID, conn, m.queue, m.metrics)
+
+	// 启动写泵
 	go client.WritePump()
 
 	// 注册到管理器
-	m.mutex.Lock()
-	m.clients[userID] = client
-	m.mutex.Unlock()
+	if old := m.addClient(client); old != nil {
+		old.Kick("该设备已在其他位置重新连接")
+	}
+	m.registerPresence(userID)
 
-	// 发送欢迎消息
+	// 发送欢迎消息，带上设备ID便于客户端重连时复用
 	welcomeMsg := &Message{
 		Type:      MessageTypeSystem,
 		Content:   "欢迎加入聊天系统",
+		Extra:     map[string]any{"device_id": deviceID},
 		Timestamp: time.Now().Unix(),
 	}
 	data, _ := json.Marshal(welcomeMsg)
-	if err := conn.WriteMessage(websocket.TextMessage, data); err != nil {
+	if err := client.SendMessage(data); err != nil {
 		log.Printf("error sending welcome message: %v", err)
 	}
+	return client
 }
 
 // Start 启动WebSocket管理器
@@ -96,60 +169,133 @@ func (m *Manager) Start(ctx context.Context) {
 	for {
 		select {
 		case client := <-m.register:
-			m.mutex.Lock()
-			m.clients[client.UserID] = client
-			m.mutex.Unlock()
+			if old := m.addClient(client); old != nil {
+				old.Kick("该设备已在其他位置重新连接")
+			}
 
 		case client := <-m.unregister:
-			m.mutex.Lock()
-			if _, ok := m.clients[client.UserID]; ok {
-				// 从所有房间中移除客户端
-				for roomID := range client.Rooms {
-					if room, exists := m.roomMap[roomID]; exists {
-						delete(room, client)
-						if len(room) == 0 {
-							delete(m.roomMap, roomID)
-						}
-					}
+			// 用户在本节点的最后一个设备断开后才从在线表删除
+			if m.removeClient(client) {
+				m.unregisterPresence(client.UserID)
+				if m.onOffline != nil {
+					go m.onOffline(client.UserID)
 				}
-				delete(m.clients, client.UserID)
 			}
-			m.mutex.Unlock()
 
 		case message := <-m.broadcast:
-			m.mutex.RLock()
-			for _, client := range m.clients {
-				// 直接发送消息
-				if err := client.Conn.WriteMessage(websocket.TextMessage, message); err != nil {
-					m.unregister <- client
+			// 放入各客户端的发送队列，不会被慢客户端阻塞
+			for _, client := range m.allClients() {
+				if err := client.SendMessage(message); err != nil {
 					log.Printf("error broadcasting message: %v", err)
 				}
 			}
-			m.mutex.RUnlock()
 
 		case <-ctx.Done():
 			// 关闭所有连接
-			m.mutex.Lock()
-			for _, client := range m.clients {
-				client.Conn.Close()
+			for _, client := range m.allClients() {
+				client.Close()
 			}
-			m.mutex.Unlock()
 			return
 		}
 	}
 }
 
-// SendToUser 发送消息给指定用户
-func (m *Manager) SendToUser(userID int64, message []byte) error {
-	m.mutex.RLock()
-	client, exists := m.clients[userID]
-	m.mutex.RUnlock()
+// addClient 登记客户端，返回被替换的同设备旧连接
+func (m *Manager) addClient(client *Client) *Client {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
 
+	devices, exists := m.clients[client.UserID]
 	if !exists {
-		return nil // 用户不在线，忽略消息
+		devices = make(map[string]*Client)
+		m.clients[client.UserID] = devices
+	}
+	old := devices[client.DeviceID]
+	devices[client.DeviceID] = client
+	if old != nil {
+		m.leaveAllRoomsLocked(old)
 	}
+	return old
+}
+
+// removeClient 注销客户端，只有登记的仍是该连接时才删除，
+// 避免旧连接断开时误删同设备的新连接。返回用户在本节点是否已没有连接
+func (m *Manager) removeClient(client *Client) bool {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
 
-	return client.Conn.WriteMessage(websocket.TextMessage, message)
+	devices := m.clients[client.UserID]
+	if devices[client.DeviceID] != client {
+		return false
+	}
+	m.leaveAllRoomsLocked(client)
+	delete(devices, client.DeviceID)
+	if len(devices) > 0 {
+		return false
+	}
+	delete(m.clients, client.UserID)
+	return true
+}
+
+// leaveAllRoomsLocked 从所有房间中移除客户端，调用方需持有 m.mutex
+func (m *Manager) leaveAllRoomsLocked(client *Client) {
+	client.mu.Lock()
+	defer client.mu.Unlock()
+	for roomID := range client.Rooms {
+		if room, exists := m.roomMap[roomID]; exists {
+			delete(room, client)
+			if len(room) == 0 {
+				delete(m.roomMap, roomID)
+			}
+		}
+	}
+}
+
+// allClients 获取本节点的所有连接
+func (m *Manager) allClients() []*Client {
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+	var clients []*Client
+	for _, devices := range m.clients {
+		for _, client := range devices {
+			clients = append(clients, client)
+		}
+	}
+	return clients
+}
+
+// GetClients 获取用户在本节点的所有设备连接
+func (m *Manager) GetClients(userID int64) []*Client {
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+	clients := make([]*Client, 0, len(m.clients[userID]))
+	for _, client := range m.clients[userID] {
+		clients = append(clients, client)
+	}
+	return clients
+}
+
+// SendToUser 发送消息给指定用户的所有设备，集群模式下同时转发到用户连接所在的其他节点
+func (m *Manager) SendToUser(userID int64, message []byte) error {
+	if err := m.deliverToUser(userID, message); err != nil {
+		return err
+	}
+	if m.presence == nil {
+		return nil
+	}
+	return m.forwardToUser(userID, message)
+}
+
+// deliverToUser 投递消息给本节点上的用户连接
+func (m *Manager) deliverToUser(userID int64, message []byte) error {
+	// 用户不在线时没有连接，忽略消息
+	var errs []error
+	for _, client := range m.GetClients(userID) {
+		if err := client.SendMessage(message); err != nil {
+			errs = append(errs, fmt.Errorf("设备 %s: %w", client.DeviceID, err))
+		}
+	}
+	return errors.Join(errs...)
 }
 
 // BroadcastMessage 广播消息给所有连接的客户端
@@ -169,15 +315,26 @@ func (m *Manager) GetOnlineUsers() []int64 {
 	return users
 }
 
-// IsUserOnline 检查用户是否在线
+// IsUserOnline 检查用户是否在线，集群模式下查询在线表
 func (m *Manager) IsUserOnline(userID int64) bool {
 	m.mutex.RLock()
-	defer m.mutex.RUnlock()
-	_, exists := m.clients[userID]
-	return exists
+	exists := len(m.clients[userID]) > 0
+	m.mutex.RUnlock()
+	if exists || m.presence == nil {
+		return exists
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), clusterTimeout)
+	defer cancel()
+	nodes, err := m.presence.Nodes(ctx, userID)
+	if err != nil {
+		log.Printf("error querying presence of user %d: %v", userID, err)
+		return false
+	}
+	return len(nodes) > 0
 }
 
-// StartHeartbeat 启动心跳检测
+// StartHeartbeat 定期续期在线表，ping 由各客户端的 WritePump 发送
 func (m *Manager) StartHeartbeat(ctx context.Context, interval time.Duration) {
 	ticker := time.NewTicker(interval)
 	defer ticker.Stop()
@@ -186,14 +343,12 @@ func (m *Manager) StartHeartbeat(ctx context.Context, interval time.Duration) {
 		select {
 		case <-ticker.C:
 			m.mutex.RLock()
-			for _, client := range m.clients {
-				go func(client *Client) {
-					if err := client.Conn.WriteMessage(websocket.PingMessage, nil); err != nil {
-						m.unregister <- client
-					}
-				}(client)
+			userIDs := make([]int64, 0, len(m.clients))
+			for userID := range m.clients {
+				userIDs = append(userIDs, userID)
 			}
 			m.mutex.RUnlock()
+			m.registerPresence(userIDs...)
 		case <-ctx.Done():
 			return
 		}
@@ -236,16 +391,8 @@ func (m *Manager) LeaveRoom(client *Client, roomID int64) {
 	}
 }
 
-// SendToRoom 发送消息给聊天室
+// SendToRoom 发送消息给聊天室，集群模式下同时发往其他节点
 func (m *Manager) SendToRoom(selfUserID int64, roomID int64, message *Message) {
-	m.mutex.RLock()
-	room, exists := m.roomMap[roomID]
-	m.mutex.RUnlock()
-
-	if !exists {
-		return
-	}
-
 	message.Timestamp = time.Now().Unix()
 	data, err := json.Marshal(message)
 	if err != nil {
@@ -253,11 +400,38 @@ func (m *Manager) SendToRoom(selfUserID int64, roomID int64, message *Message) {
 		return
 	}
 
+	m.deliverToRoom(selfUserID, roomID, data)
+	if m.broker == nil {
+		return
+	}
+	if err := m.publish(clusterRoomChannel, &envelope{
+		Kind:    envelopeRoom,
+		Target:  roomID,
+		Exclude: selfUserID,
+		Data:    data,
+	}); err != nil {
+		log.Printf("error publishing room message: %v", err)
+	}
+}
+
+// deliverToRoom 投递消息给本节点上的聊天室成员
+func (m *Manager) deliverToRoom(selfUserID int64, roomID int64, data []byte) {
+	m.mutex.RLock()
+	room, exists := m.roomMap[roomID]
+	clients := make([]*Client, 0, len(room))
 	for client := range room {
+		clients = append(clients, client)
+	}
+	m.mutex.RUnlock()
+
+	if !exists {
+		return
+	}
+
+	for _, client := range clients {
 		if client.UserID != selfUserID {
-			if err := client.Conn.WriteMessage(websocket.TextMessage, data); err != nil {
+			if err := client.SendMessage(data); err != nil {
 				log.Printf("error sending message to room member: %v", err)
-				continue
 			}
 		}
 	}
@@ -272,12 +446,58 @@ func (m *Manager) Broadcast(message *Message) {
 	}
 
 	m.broadcast <- data
+	if m.broker == nil {
+		return
+	}
+	if err := m.publish(clusterBroadcastChannel, &envelope{Kind: envelopeBroadcast, Data: data}); err != nil {
+		log.Printf("error publishing broadcast message: %v", err)
+	}
 }
 
-// GetClient 根据用户ID获取客户端
-func (m *Manager) GetClient(userID int64) (*Client, bool) {
-	m.mutex.RLock()
-	defer m.mutex.RUnlock()
-	client, exists := m.clients[userID]
-	return client, exists
+// KickOthers 关闭用户除 keepDeviceID 之外的所有设备连接，集群模式下同时通知其他节点
+func (m *Manager) KickOthers(userID int64, keepDeviceID string) error {
+	m.kickLocal(userID, keepDeviceID)
+	if m.presence == nil {
+		return nil
+	}
+	return m.forward(userID, &envelope{Kind: envelopeKick, Target: userID, Device: keepDeviceID})
+}
+
+// kickLocal 关闭用户在本节点上除 keepDeviceID 之外的设备连接
+func (m *Manager) kickLocal(userID int64, keepDeviceID string) {
+	for _, client := range m.GetClients(userID) {
+		if client.DeviceID != keepDeviceID {
+			client.Kick("已在其他设备上被强制下线")
+		}
+	}
+}
+
+// JoinUserRoom 用户的所有设备加入聊天室，集群模式下同时通知其他节点，用于成员变更后同步在线连接
+func (m *Manager) JoinUserRoom(userID, roomID int64) error {
+	m.joinUserLocal(userID, roomID)
+	if m.presence == nil {
+		return nil
+	}
+	return m.forward(userID, &envelope{Kind: envelopeJoin, Target: userID, Room: roomID})
+}
+
+// LeaveUserRoom 用户的所有设备离开聊天室，集群模式下同时通知其他节点
+func (m *Manager) LeaveUserRoom(userID, roomID int64) error {
+	m.leaveUserLocal(userID, roomID)
+	if m.presence == nil {
+		return nil
+	}
+	return m.forward(userID, &envelope{Kind: envelopeLeave, Target: userID, Room: roomID})
+}
+
+func (m *Manager) joinUserLocal(userID, roomID int64) {
+	for _, client := range m.GetClients(userID) {
+		m.JoinRoom(client, roomID)
+	}
+}
+
+func (m *Manager) leaveUserLocal(userID, roomID int64) {
+	for _, client := range m.GetClients(userID) {
+		m.LeaveRoom(client, roomID)
+	}
 }
@@ -0,0 +1,158 @@
+package ws
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/smartystreets/goconvey/convey"
+)
+
+func TestManager_MultiDevice(t *testing.T) {
+	convey.Convey("多设备连接", t, func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		nodeA, nodeB, _ := newTestCluster(ctx)
+
+		convey.Convey("私信投递到所有设备", func() {
+			_, phone := register(nodeA, 2, "phone")
+			_, web := register(nodeA, 2, "web")
+			_, remote := register(nodeB, 2, "pad")
+
+			data, _ := json.Marshal(&Message{Type: MessageTypePrivate, From: 1, To: 2, Content: "hi"})
+			convey.So(nodeA.SendToUser(2, data), convey.ShouldBeNil)
+
+			for _, conn := range []*fakeConn{phone, web, remote} {
+				msg := conn.next(time.Second)
+				convey.So(msg, convey.ShouldNotBeNil)
+				convey.So(msg.Content, convey.ShouldEqual, "hi")
+			}
+		})
+
+		convey.Convey("同一设备重连时关闭旧连接，旧连接注销不影响新连接", func() {
+			oldClient, oldConn := register(nodeA, 2, "phone")
+			_, newConn := register(nodeA, 2, "phone")
+
+			kicked := oldConn.next(time.Second)
+			convey.So(kicked, convey.ShouldNotBeNil)
+			convey.So(kicked.Type, convey.ShouldEqual, MessageTypeKicked)
+			convey.So(oldConn.isClosed(), convey.ShouldBeTrue)
+
+			nodeA.unregister <- oldClient
+			convey.So(waitFor(func() bool { return len(nodeA.GetClients(2)) == 1 }), convey.ShouldBeTrue)
+			convey.So(nodeB.IsUserOnline(2), convey.ShouldBeTrue)
+
+			data, _ := json.Marshal(&Message{Type: MessageTypePrivate, From: 1, To: 2, Content: "still here"})
+			convey.So(nodeB.SendToUser(2, data), convey.ShouldBeNil)
+			msg := newConn.next(time.Second)
+			convey.So(msg, convey.ShouldNotBeNil)
+			convey.So(msg.Content, convey.ShouldEqual, "still here")
+		})
+
+		convey.Convey("踢下线其他设备", func() {
+			_, keep := register(nodeA, 2, "phone")
+			_, local := register(nodeA, 2, "web")
+			_, remote := register(nodeB, 2, "pad")
+
+			convey.So(nodeA.KickOthers(2, "phone"), convey.ShouldBeNil)
+
+			convey.So(waitFor(local.isClosed), convey.ShouldBeTrue)
+			convey.So(waitFor(remote.isClosed), convey.ShouldBeTrue)
+			convey.So(keep.isClosed(), convey.ShouldBeFalse)
+		})
+	})
+}
+
+func TestMemoryCursorStore(t *testing.T) {
+	convey.Convey("设备游标只前进且互不影响", t, func() {
+		ctx := context.Background()
+		store := NewMemoryCursorStore()
+		conversation := conversationKey(0, 10)
+
+		convey.So(store.SetCursor(ctx, 1, "phone", conversation, 5), convey.ShouldBeNil)
+		convey.So(store.SetCursor(ctx, 1, "phone", conversation, 3), convey.ShouldBeNil)
+		convey.So(store.SetCursor(ctx, 1, "web", conversation, 1), convey.ShouldBeNil)
+
+		phone, _ := store.GetCursors(ctx, 1, "phone")
+		web, _ := store.GetCursors(ctx, 1, "web")
+		convey.So(phone, convey.ShouldResemble, map[string]int64{"group:10": 5})
+		convey.So(web, convey.ShouldResemble, map[string]int64{"group:10": 1})
+	})
+}
+
+// blockingConn 在 release 关闭前阻塞写入，模拟慢客户端
+type blockingConn struct {
+	*fakeConn
+	writing chan struct{}
+	release chan struct{}
+}
+
+func newBlockingConn() *blockingConn {
+	return &blockingConn{
+		fakeConn: newFakeConn(),
+		writing:  make(chan struct{}, 1),
+		release:  make(chan struct{}),
+	}
+}
+
+func (c *blockingConn) WriteMessage(messageType int, data []byte) error {
+	select {
+	case c.writing <- struct{}{}:
+	default:
+	}
+	<-c.release
+	return c.fakeConn.WriteMessage(messageType, data)
+}
+
+func TestManager_SlowConsumer(t *testing.T) {
+	convey.Convey("发送队列已满", t, func() {
+		send := func(m *Manager, content string) error {
+			data, _ := json.Marshal(&Message{Type: MessageTypePrivate, From: 1, To: 2, Content: content})
+			return m.SendToUser(2, data)
+		}
+
+		convey.Convey("丢弃最早的消息", func() {
+			m := NewManager()
+			m.SetSendQueue(2, SlowConsumerDropOldest)
+			conn := newBlockingConn()
+			m.RegisterClient(2, "phone", conn)
+			// 欢迎消息正在写出，队列为空
+			<-conn.writing
+
+			for _, content := range []string{"1", "2", "3"} {
+				convey.So(send(m, content), convey.ShouldBeNil)
+			}
+			stats := m.Stats()
+			convey.So(stats.QueuedMessages, convey.ShouldEqual, 2)
+			convey.So(stats.DroppedMessages, convey.ShouldEqual, 1)
+
+			close(conn.release)
+			convey.So(conn.next(time.Second).Type, convey.ShouldEqual, MessageTypeSystem)
+			convey.So(conn.next(time.Second).Content, convey.ShouldEqual, "2")
+			convey.So(conn.next(time.Second).Content, convey.ShouldEqual, "3")
+		})
+
+		convey.Convey("断开慢客户端", func() {
+			m := NewManager()
+			m.SetSendQueue(1, SlowConsumerDisconnect)
+			conn := newBlockingConn()
+			m.RegisterClient(2, "phone", conn)
+			<-conn.writing
+
+			convey.So(send(m, "1"), convey.ShouldBeNil)
+			convey.So(send(m, "2"), convey.ShouldNotBeNil)
+			convey.So(conn.isClosed(), convey.ShouldBeTrue)
+			convey.So(m.Stats().SlowDisconnects, convey.ShouldEqual, 1)
+			close(conn.release)
+		})
+	})
+
+	convey.Convey("解析慢消费者策略", t, func() {
+		policy, err := ParseSlowConsumerPolicy("")
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(policy, convey.ShouldEqual, SlowConsumerDropOldest)
+		_, err = ParseSlowConsumerPolicy("block")
+		convey.So(err, convey.ShouldNotBeNil)
+	})
+}
@@ -0,0 +1,115 @@
+package ws
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/metric"
+)
+
+// SlowConsumerPolicy 发送队列已满时的处理策略
+type SlowConsumerPolicy string
+
+const (
+	// SlowConsumerDropOldest 丢弃队列中最早的消息
+	SlowConsumerDropOldest SlowConsumerPolicy = "drop_oldest"
+	// SlowConsumerDisconnect 断开连接，客户端重连后通过游标补发
+	SlowConsumerDisconnect SlowConsumerPolicy = "disconnect"
+)
+
+var (
+	errClientClosed  = errors.New("websocket client closed")
+	errSendQueueFull = errors.New("websocket send queue is full")
+)
+
+// ParseSlowConsumerPolicy 解析配置中的慢消费者策略，为空时丢弃最早的消息
+func ParseSlowConsumerPolicy(s string) (SlowConsumerPolicy, error) {
+	switch policy := SlowConsumerPolicy(s); policy {
+	case "":
+		return SlowConsumerDropOldest, nil
+	case SlowConsumerDropOldest, SlowConsumerDisconnect:
+		return policy, nil
+	default:
+		return "", errors.New("unknown slow consumer policy: " + s)
+	}
+}
+
+// sendQueueConfig 客户端发送队列配置
+type sendQueueConfig struct {
+	size   int
+	policy SlowConsumerPolicy
+}
+
+// sendMetrics 发送队列计数，由同一个 Manager 的所有客户端共享
+type sendMetrics struct {
+	dropped      atomic.Int64 // 因队列已满丢弃的消息数
+	disconnected atomic.Int64 // 因队列已满断开的连接数
+}
+
+// SendQueueStats 发送队列统计
+type SendQueueStats struct {
+	Clients         int   `json:"clients"`          // 本节点连接数
+	QueuedMessages  int64 `json:"queued_messages"`  // 所有队列中待写出的消息数
+	MaxQueueDepth   int   `json:"max_queue_depth"`  // 单个队列的最大深度
+	DroppedMessages int64 `json:"dropped_messages"` // 累计丢弃的消息数
+	SlowDisconnects int64 `json:"slow_disconnects"` // 累计因慢消费断开的连接数
+}
+
+// Stats 获取本节点发送队列统计
+func (m *Manager) Stats() SendQueueStats {
+	clients := m.allClients()
+	stats := SendQueueStats{
+		Clients:         len(clients),
+		DroppedMessages: m.metrics.dropped.Load(),
+		SlowDisconnects: m.metrics.disconnected.Load(),
+	}
+	for _, client := range clients {
+		depth := client.QueueDepth()
+		stats.QueuedMessages += int64(depth)
+		stats.MaxQueueDepth = max(stats.MaxQueueDepth, depth)
+	}
+	return stats
+}
+
+// RegisterMetrics 将发送队列统计注册到全局 OpenTelemetry MeterProvider
+func (m *Manager) RegisterMetrics() error {
+	meter := otel.Meter("github.com/yxrxy/videoHub/app/gateway/service/ws")
+	connections, err := meter.Int64ObservableGauge("ws.connections",
+		metric.WithDescription("本节点的WebSocket连接数"))
+	if err != nil {
+		return err
+	}
+	queued, err := meter.Int64ObservableGauge("ws.send_queue.depth",
+		metric.WithDescription("所有发送队列中待写出的消息数"))
+	if err != nil {
+		return err
+	}
+	maxDepth, err := meter.Int64ObservableGauge("ws.send_queue.max_depth",
+		metric.WithDescription("单个发送队列的最大深度"))
+	if err != nil {
+		return err
+	}
+	dropped, err := meter.Int64ObservableCounter("ws.send_queue.dropped",
+		metric.WithDescription("因发送队列已满丢弃的消息数"))
+	if err != nil {
+		return err
+	}
+	disconnected, err := meter.Int64ObservableCounter("ws.send_queue.slow_disconnects",
+		metric.WithDescription("因发送队列已满断开的连接数"))
+	if err != nil {
+		return err
+	}
+
+	_, err = meter.RegisterCallback(func(_ context.Context, o metric.Observer) error {
+		stats := m.Stats()
+		o.ObserveInt64(connections, int64(stats.Clients))
+		o.ObserveInt64(queued, stats.QueuedMessages)
+		o.ObserveInt64(maxDepth, int64(stats.MaxQueueDepth))
+		o.ObserveInt64(dropped, stats.DroppedMessages)
+		o.ObserveInt64(disconnected, stats.SlowDisconnects)
+		return nil
+	}, connections, queued, maxDepth, dropped, disconnected)
+	return err
+}
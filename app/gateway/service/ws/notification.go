@@ -0,0 +1,53 @@
+package ws
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+
+	"github.com/yxrxy/videoHub/pkg/kafka"
+)
+
+// NotificationPush 社交服务合并通知后投递的推送，字段与社交服务的通知推送一致
+type NotificationPush struct {
+	UserID       int64           `json:"user_id"`
+	Notification json.RawMessage `json:"notification"`
+	Unread       int64           `json:"unread"` // 推送时的未读通知总数
+}
+
+// ConsumeNotifications 消费通知推送并转发给在线用户，集群模式下由用户所在的网关实例下发
+func (s *WsService) ConsumeNotifications(ctx context.Context, msgCh <-chan *kafka.Message) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case msg, ok := <-msgCh:
+			if !ok {
+				return
+			}
+			push := new(NotificationPush)
+			if err := json.Unmarshal(msg.V, push); err != nil {
+				log.Printf("error decoding notification push: %v", err)
+				continue
+			}
+			s.PublishNotification(push)
+		}
+	}
+}
+
+// PublishNotification 推送通知给接收者，用户不在线时忽略
+func (s *WsService) PublishNotification(push *NotificationPush) {
+	if push.UserID == 0 {
+		return
+	}
+	if err := s.pushToUser(push.UserID, &Message{
+		Type: MessageTypeNotification,
+		To:   push.UserID,
+		Extra: map[string]any{
+			"notification": push.Notification,
+			"unread":       push.Unread,
+		},
+	}); err != nil {
+		log.Printf("error pushing notification to user %d: %v", push.UserID, err)
+	}
+}
@@ -0,0 +1,93 @@
+package ws
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"time"
+
+	"github.com/yxrxy/videoHub/pkg/constants"
+	"github.com/yxrxy/videoHub/pkg/kafka"
+)
+
+// PresencePush 社交服务投递的在线状态变化，字段与社交服务的在线状态推送一致
+type PresencePush struct {
+	UserID     int64   `json:"user_id"`
+	Online     bool    `json:"online"`
+	LastSeen   int64   `json:"last_seen"`
+	Recipients []int64 `json:"recipients"` // 可以看到该用户在线状态的好友
+}
+
+// reportPresence 分批上报在线状态，失败只记录日志，未续期的在线状态会自动过期
+func (s *WsService) reportPresence(ctx context.Context, userIDs []int64, online bool) {
+	for start := 0; start < len(userIDs); start += constants.PresenceMaxBatch {
+		batch := userIDs[start:min(start+constants.PresenceMaxBatch, len(userIDs))]
+		if err := s.store.UpdatePresence(ctx, batch, online); err != nil {
+			log.Printf("error reporting presence of %d users: %v", len(batch), err)
+		}
+	}
+}
+
+// StartPresenceHeartbeat 定期为本节点的在线用户续期在线状态
+func (s *WsService) StartPresenceHeartbeat(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			if userIDs := s.manager.GetOnlineUsers(); len(userIDs) > 0 {
+				s.reportPresence(ctx, userIDs, true)
+			}
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// userOffline 用户在本节点的最后一个连接断开，其他节点或本节点上已没有连接时上报下线
+func (s *WsService) userOffline(userID int64) {
+	if s.manager.IsUserOnline(userID) {
+		return
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), clusterTimeout)
+	defer cancel()
+	s.reportPresence(ctx, []int64{userID}, false)
+}
+
+// ConsumePresence 消费在线状态变化并推送给在线的好友
+func (s *WsService) ConsumePresence(ctx context.Context, msgCh <-chan *kafka.Message) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case msg, ok := <-msgCh:
+			if !ok {
+				return
+			}
+			push := new(PresencePush)
+			if err := json.Unmarshal(msg.V, push); err != nil {
+				log.Printf("error decoding presence push: %v", err)
+				continue
+			}
+			s.PublishPresence(push)
+		}
+	}
+}
+
+// PublishPresence 推送在线状态变化给接收者，接收者不在线时忽略
+func (s *WsService) PublishPresence(push *PresencePush) {
+	for _, recipient := range push.Recipients {
+		if err := s.pushToUser(recipient, &Message{
+			Type: MessageTypePresence,
+			From: push.UserID,
+			To:   recipient,
+			Extra: map[string]any{
+				"online":    push.Online,
+				"last_seen": push.LastSeen,
+			},
+		}); err != nil {
+			log.Printf("error pushing presence to user %d: %v", recipient, err)
+		}
+	}
+}
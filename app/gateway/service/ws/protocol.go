@@ -0,0 +1,255 @@
+package ws
+
+import (
+	"encoding/json"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/yxrxy/videoHub/pkg/constants"
+	"github.com/yxrxy/videoHub/pkg/errno"
+)
+
+// 客户端帧类型
+const (
+	FrameSendPrivate = "send_private" // 发送私信
+	FrameSendGroup   = "send_group"   // 发送群聊消息
+	FrameJoinRoom    = "join"         // 当前连接订阅聊天室消息，需是聊天室成员
+	FrameLeaveRoom   = "leave"        // 当前连接取消订阅聊天室消息，不影响成员身份
+	FrameTyping      = "typing"       // 正在输入，等同于 kind 为 typing 的开始信号，推送类型为 typing
+	FrameSignal      = "signal"       // 临时信号，如正在输入、正在录音、正在查看会话
+	FrameAck         = "ack"          // 消息确认
+	FrameRead        = "read"         // 标记会话已读
+	FramePing        = "ping"         // 应用层心跳
+	FrameCursors     = "cursors"      // 查询本设备的已读游标
+	FrameSync        = "sync"         // 拉取会话中指定序号之后的消息
+	FrameKickOthers  = "kick_others"  // 踢下线其他设备
+)
+
+// 服务端回复帧类型
+const (
+	FrameResult = "result" // 请求处理成功
+	FrameError  = "error"  // 请求处理失败
+	FramePong   = "pong"   // 心跳回复
+)
+
+// Frame 客户端发送的帧，Data 的结构由 Type 决定
+type Frame struct {
+	Type      string          `json:"type"`
+	RequestID string          `json:"request_id,omitempty"` // 客户端生成，原样带回回复帧
+	Data      json.RawMessage `json:"data,omitempty"`
+}
+
+// ReplyFrame 服务端对客户端帧的回复
+type ReplyFrame struct {
+	Type      string `json:"type"`
+	RequestID string `json:"request_id,omitempty"`
+	Code      int64  `json:"code,omitempty"`    // 错误码，仅错误帧
+	Message   string `json:"message,omitempty"` // 错误信息，仅错误帧
+	Data      any    `json:"data,omitempty"`
+	Timestamp int64  `json:"timestamp"`
+}
+
+// SendPrivatePayload 发送私信
+type SendPrivatePayload struct {
+	To      int64  `json:"to"`
+	Content string `json:"content"`
+	ReplyTo int64  `json:"reply_to,omitempty"` // 回复的消息ID
+}
+
+// SendGroupPayload 发送群聊消息
+type SendGroupPayload struct {
+	RoomID  int64  `json:"room_id"`
+	Content string `json:"content"`
+	MsgType int8   `json:"msg_type"`           // 0=文本,1=图片,2=视频,3=文件
+	ReplyTo int64  `json:"reply_to,omitempty"` // 回复的消息ID
+}
+
+// RoomPayload 加入或离开聊天室
+type RoomPayload struct {
+	RoomID int64 `json:"room_id"`
+}
+
+// TypingPayload 正在输入，To 与 RoomID 二选一
+type TypingPayload struct {
+	To     int64 `json:"to,omitempty"`
+	RoomID int64 `json:"room_id,omitempty"`
+}
+
+// 临时信号的种类
+const (
+	SignalTyping    = "typing"    // 正在输入
+	SignalRecording = "recording" // 正在录制语音
+	SignalViewing   = "viewing"   // 正在查看会话
+)
+
+// SignalPayload 临时信号，To 与 RoomID 二选一，Active 为 false 表示停止
+type SignalPayload struct {
+	Kind   string `json:"kind"`
+	Active bool   `json:"active"`
+	To     int64  `json:"to,omitempty"`      // 私信对方
+	RoomID int64  `json:"room_id,omitempty"` // 聊天室ID
+}
+
+// AckPayload 确认已收到会话中 Seq 及之前的消息，From 与 RoomID 二选一，用于推进本设备在该会话的游标
+type AckPayload struct {
+	Seq    int64 `json:"seq"`
+	From   int64 `json:"from,omitempty"`    // 私信对方
+	RoomID int64 `json:"room_id,omitempty"` // 聊天室ID
+}
+
+// ReadPayload 标记会话已读到 Seq，To 与 RoomID 二选一，Seq 为 0 表示读到最新
+type ReadPayload struct {
+	To     int64 `json:"to,omitempty"`      // 私信对方
+	RoomID int64 `json:"room_id,omitempty"` // 聊天室ID
+	Seq    int64 `json:"seq,omitempty"`
+}
+
+// SyncPayload 拉取会话中 AfterSeq 之后的消息，To 与 RoomID 二选一
+type SyncPayload struct {
+	To       int64 `json:"to,omitempty"`      // 私信对方
+	RoomID   int64 `json:"room_id,omitempty"` // 聊天室ID
+	AfterSeq int64 `json:"after_seq"`
+	Limit    int32 `json:"limit,omitempty"`
+}
+
+// Validate 校验私信帧
+func (p *SendPrivatePayload) Validate(userID int64) error {
+	if p.To <= 0 || p.To == userID {
+		return errno.ParamVerifyError.WithMessage("invalid receiver")
+	}
+	if p.ReplyTo < 0 {
+		return errno.ParamVerifyError.WithMessage("invalid reply_to")
+	}
+	return validateContent(p.Content)
+}
+
+// Validate 校验群聊帧
+func (p *SendGroupPayload) Validate(int64) error {
+	if p.RoomID <= 0 {
+		return errno.ParamVerifyError.WithMessage("invalid room_id")
+	}
+	if p.MsgType < 0 || p.MsgType > 3 {
+		return errno.ParamVerifyError.WithMessage("invalid msg_type")
+	}
+	if p.ReplyTo < 0 {
+		return errno.ParamVerifyError.WithMessage("invalid reply_to")
+	}
+	return validateContent(p.Content)
+}
+
+// Validate 校验聊天室帧
+func (p *RoomPayload) Validate(int64) error {
+	if p.RoomID <= 0 {
+		return errno.ParamVerifyError.WithMessage("invalid room_id")
+	}
+	return nil
+}
+
+// Validate 校验正在输入帧
+func (p *TypingPayload) Validate(userID int64) error {
+	if (p.To == 0) == (p.RoomID == 0) {
+		return errno.ParamVerifyError.WithMessage("exactly one of to and room_id is required")
+	}
+	if p.To < 0 || p.RoomID < 0 || p.To == userID {
+		return errno.ParamVerifyError.WithMessage("invalid typing target")
+	}
+	return nil
+}
+
+// Validate 校验临时信号帧
+func (p *SignalPayload) Validate(userID int64) error {
+	switch p.Kind {
+	case SignalTyping, SignalRecording, SignalViewing:
+	default:
+		return errno.ParamVerifyError.WithMessage("invalid signal kind")
+	}
+	if (p.To == 0) == (p.RoomID == 0) || p.To < 0 || p.RoomID < 0 || p.To == userID {
+		return errno.ParamVerifyError.WithMessage("exactly one of to and room_id is required")
+	}
+	return nil
+}
+
+// Validate 校验确认帧
+func (p *AckPayload) Validate(int64) error {
+	if p.Seq <= 0 {
+		return errno.ParamVerifyError.WithMessage("invalid seq")
+	}
+	if (p.From == 0) == (p.RoomID == 0) || p.From < 0 || p.RoomID < 0 {
+		return errno.ParamVerifyError.WithMessage("exactly one of from and room_id is required")
+	}
+	return nil
+}
+
+// Validate 校验已读帧
+func (p *ReadPayload) Validate(userID int64) error {
+	if (p.To == 0) == (p.RoomID == 0) || p.To < 0 || p.RoomID < 0 || p.To == userID {
+		return errno.ParamVerifyError.WithMessage("exactly one of to and room_id is required")
+	}
+	if p.Seq < 0 {
+		return errno.ParamVerifyError.WithMessage("invalid seq")
+	}
+	return nil
+}
+
+// Validate 校验同步帧
+func (p *SyncPayload) Validate(userID int64) error {
+	if (p.To == 0) == (p.RoomID == 0) || p.To < 0 || p.RoomID < 0 || p.To == userID {
+		return errno.ParamVerifyError.WithMessage("exactly one of to and room_id is required")
+	}
+	if p.AfterSeq < 0 || p.Limit < 0 || p.Limit > constants.SyncMessagesMaxLimit {
+		return errno.ParamVerifyError.WithMessage("invalid after_seq or limit")
+	}
+	return nil
+}
+
+type payload interface {
+	Validate(userID int64) error
+}
+
+// decodePayload 解析并校验帧数据
+func decodePayload(frame *Frame, userID int64, p payload) error {
+	if len(frame.Data) == 0 {
+		return errno.ParamVerifyError.WithMessage("missing data")
+	}
+	if err := json.Unmarshal(frame.Data, p); err != nil {
+		return errno.ParamVerifyError.WithMessage("invalid data: " + err.Error())
+	}
+	return p.Validate(userID)
+}
+
+// parseFrame 解析客户端帧
+func parseFrame(raw []byte) (*Frame, error) {
+	var frame Frame
+	if err := json.Unmarshal(raw, &frame); err != nil {
+		return nil, errno.ParamVerifyError.WithMessage("invalid frame")
+	}
+	if frame.Type == "" {
+		return &frame, errno.ParamVerifyError.WithMessage("missing frame type")
+	}
+	if len(frame.RequestID) > constants.WebSocketMaxRequestIDLen {
+		frame.RequestID = ""
+		return &frame, errno.ParamVerifyError.WithMessage("request_id too long")
+	}
+	return &frame, nil
+}
+
+func validateContent(content string) error {
+	if strings.TrimSpace(content) == "" {
+		return errno.ParamVerifyError.WithMessage("empty content")
+	}
+	if utf8.RuneCountInString(content) > constants.MaxChatMessageLength {
+		return errno.ParamVerifyError.WithMessage("content too long")
+	}
+	return nil
+}
+
+// errorReply 将错误转换为错误帧
+func errorReply(requestID string, err error) *ReplyFrame {
+	e := errno.ConvertErr(err)
+	return &ReplyFrame{
+		Type:      FrameError,
+		RequestID: requestID,
+		Code:      e.ErrorCode,
+		Message:   e.ErrorMsg,
+	}
+}
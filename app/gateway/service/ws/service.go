@@ -4,133 +4,409 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"log"
 	"sync"
 	"time"
 
-	"github.com/hertz-contrib/websocket"
+	"github.com/yxrxy/videoHub/kitex_gen/model"
+	"github.com/yxrxy/videoHub/pkg/constants"
+	"github.com/yxrxy/videoHub/pkg/errno"
 )
 
 // WsService WebSocket服务
 type WsService struct {
 	manager *Manager
+	store   MessageStore
+	cursors CursorStore
 	mu      sync.RWMutex
 }
 
 // NewWsService 创建新的WebSocket服务
-func NewWsService(manager *Manager) *WsService {
-	return &WsService{
+func NewWsService(manager *Manager, store MessageStore, cursors CursorStore) *WsService {
+	s := &WsService{
 		manager: manager,
+		store:   store,
+		cursors: cursors,
 		mu:      sync.RWMutex{},
 	}
+	manager.SetOfflineHandler(s.userOffline)
+	return s
 }
 
 // Start 启动WebSocket服务
 func (s *WsService) Start(ctx context.Context) {
+	// 集群模式下先订阅节点间消息，订阅失败时仍可服务本节点的连接
+	if err := s.manager.Subscribe(ctx); err != nil {
+		log.Printf("error subscribing cluster channels: %v", err)
+	}
 	// 启动WebSocket管理器
 	go s.manager.Start(ctx)
 	// 启动心跳检测
 	go s.manager.StartHeartbeat(ctx, pingPeriod)
+	// 按心跳周期续期社交服务中的在线状态
+	go s.StartPresenceHeartbeat(ctx, pingPeriod)
 }
 
-// RegisterClient 注册WebSocket客户端
-func (s *WsService) RegisterClient(userID int64, conn *websocket.Conn) error {
+// RegisterClient 注册WebSocket客户端并补发该设备未确认的消息，调用方随后需要执行 client.ReadPump
+func (s *WsService) RegisterClient(ctx context.Context, userID int64, deviceID string, conn Conn) (*Client, error) {
 	s.mu.Lock()
-	defer s.mu.Unlock()
+	client := s.manager.RegisterClient(userID, deviceID, conn)
+	s.mu.Unlock()
+
+	s.reportPresence(ctx, []int64{userID}, true)
+	s.joinMemberRooms(ctx, client)
+	s.redeliver(ctx, client)
+	return client, nil
+}
 
-	// 创建新的客户端
-	client := &Client{
-		UserID: userID,
-		Rooms:  make(map[int64]bool),
-		mu:     sync.Mutex{},
-		Conn:   conn,
+// joinMemberRooms 连接订阅用户已加入的所有聊天室
+func (s *WsService) joinMemberRooms(ctx context.Context, client *Client) {
+	roomIDs, err := s.store.UserRooms(ctx, client.UserID)
+	if err != nil {
+		log.Printf("error loading rooms of user %d: %v", client.UserID, err)
+		return
+	}
+	for _, roomID := range roomIDs {
+		s.manager.JoinRoom(client, roomID)
 	}
-	s.manager.RegisterClient(userID, client.Conn)
+}
 
-	// 同时发送到注册通道，以便其他功能使用
-	go func() {
-		s.manager.register <- client
-	}()
-	return nil
+// redeliver 按设备游标补发各会话中未确认的消息，每个会话最多补发
+// constants.RedeliverMessagesLimit 条，超出时通知客户端通过 sync 帧继续拉取
+func (s *WsService) redeliver(ctx context.Context, client *Client) {
+	cursors, err := s.cursors.GetCursors(ctx, client.UserID, client.DeviceID)
+	if err != nil {
+		log.Printf("error loading cursors of user %d device %s: %v", client.UserID, client.DeviceID, err)
+		return
+	}
+	for conversation, seq := range cursors {
+		peerID, roomID, ok := parseConversationKey(conversation)
+		if !ok {
+			continue
+		}
+		result, err := s.store.Sync(ctx, client.UserID, peerID, roomID, seq, constants.RedeliverMessagesLimit)
+		if err != nil {
+			log.Printf("error syncing %s for user %d: %v", conversation, client.UserID, err)
+			continue
+		}
+		for _, msg := range result.Messages {
+			msg.Extra = withExtra(msg.Extra, "redelivered", true)
+			s.sendToClient(client, msg)
+		}
+		if result.HasMore {
+			s.sendToClient(client, &Message{
+				Type:      MessageTypeSyncRequired,
+				To:        peerID,
+				RoomID:    roomID,
+				Extra:     map[string]any{"latest_seq": result.LatestSeq},
+				Timestamp: time.Now().Unix(),
+			})
+		}
+	}
 }
 
-// JoinChatRoom 加入聊天室
-func (s *WsService) JoinChatRoom(userID, roomID int64) error {
-	client, exists := s.manager.GetClient(userID)
-	if !exists {
-		return fmt.Errorf("用户 %d 不在线", userID)
+// sendToClient 发送消息给单个设备连接
+func (s *WsService) sendToClient(client *Client, msg *Message) {
+	data, err := json.Marshal(msg)
+	if err != nil {
+		log.Printf("error marshaling message: %v", err)
+		return
+	}
+	if err := client.SendMessage(data); err != nil {
+		log.Printf("error sending message to device %s: %v", client.DeviceID, err)
 	}
+}
 
-	s.manager.JoinRoom(client, roomID)
+func withExtra(extra map[string]any, key string, value any) map[string]any {
+	if extra == nil {
+		extra = make(map[string]any, 1)
+	}
+	extra[key] = value
+	return extra
+}
 
-	// 发送系统消息通知聊天室
-	joinMsg := &Message{
-		Type:      MessageTypeSystem,
-		Content:   fmt.Sprintf("用户 %d 加入了聊天室", userID),
-		RoomID:    roomID,
-		Timestamp: time.Now().Unix(),
+// HandleFrame 处理一个客户端帧，并回复结果帧或错误帧
+func (s *WsService) HandleFrame(ctx context.Context, c *Client, raw []byte) {
+	frame, err := parseFrame(raw)
+	if err != nil {
+		var requestID string
+		if frame != nil {
+			requestID = frame.RequestID
+		}
+		c.SendReply(errorReply(requestID, err))
+		return
 	}
-	s.manager.SendToRoom(userID, roomID, joinMsg)
 
-	return nil
+	data, err := s.dispatch(ctx, c, frame)
+	if err != nil {
+		c.SendReply(errorReply(frame.RequestID, err))
+		return
+	}
+	replyType := FrameResult
+	if frame.Type == FramePing {
+		replyType = FramePong
+	}
+	c.SendReply(&ReplyFrame{Type: replyType, RequestID: frame.RequestID, Data: data})
 }
 
-// LeaveChatRoom 离开聊天室
-func (s *WsService) LeaveChatRoom(userID, roomID int64) error {
-	client, exists := s.manager.GetClient(userID)
-	if !exists {
-		return fmt.Errorf("用户 %d 不在线", userID)
-	}
+func (s *WsService) dispatch(ctx context.Context, c *Client, frame *Frame) (any, error) {
+	switch frame.Type {
+	case FrameSendPrivate:
+		var p SendPrivatePayload
+		if err := decodePayload(frame, c.UserID, &p); err != nil {
+			return nil, err
+		}
+		return s.SendPrivateMessage(ctx, c.UserID, p.To, p.Content, p.ReplyTo)
 
-	s.manager.LeaveRoom(client, roomID)
+	case FrameSendGroup:
+		var p SendGroupPayload
+		if err := decodePayload(frame, c.UserID, &p); err != nil {
+			return nil, err
+		}
+		return s.SendChatMessage(ctx, c.UserID, p.RoomID, p.Content, p.MsgType, p.ReplyTo)
 
-	// 发送系统消息通知聊天室
-	leaveMsg := &Message{
-		Type:      MessageTypeSystem,
-		Content:   fmt.Sprintf("用户 %d 离开了聊天室", userID),
-		RoomID:    roomID,
-		Timestamp: time.Now().Unix(),
+	case FrameJoinRoom:
+		var p RoomPayload
+		if err := decodePayload(frame, c.UserID, &p); err != nil {
+			return nil, err
+		}
+		return nil, s.JoinRoom(ctx, c, p.RoomID)
+
+	case FrameLeaveRoom:
+		var p RoomPayload
+		if err := decodePayload(frame, c.UserID, &p); err != nil {
+			return nil, err
+		}
+		s.manager.LeaveRoom(c, p.RoomID)
+		return nil, nil
+
+	case FrameTyping:
+		var p TypingPayload
+		if err := decodePayload(frame, c.UserID, &p); err != nil {
+			return nil, err
+		}
+		return nil, s.SendSignal(ctx, c, MessageTypeTyping, &SignalPayload{Kind: SignalTyping, Active: true, To: p.To, RoomID: p.RoomID})
+
+	case FrameSignal:
+		var p SignalPayload
+		if err := decodePayload(frame, c.UserID, &p); err != nil {
+			return nil, err
+		}
+		return nil, s.SendSignal(ctx, c, MessageTypeSignal, &p)
+
+	case FrameAck:
+		var p AckPayload
+		if err := decodePayload(frame, c.UserID, &p); err != nil {
+			return nil, err
+		}
+		return nil, s.cursors.SetCursor(ctx, c.UserID, c.DeviceID, conversationKey(p.From, p.RoomID), p.Seq)
+
+	case FrameRead:
+		var p ReadPayload
+		if err := decodePayload(frame, c.UserID, &p); err != nil {
+			return nil, err
+		}
+		readSeq, err := s.store.MarkRead(ctx, c.UserID, p.To, p.RoomID, p.Seq)
+		if err != nil {
+			return nil, err
+		}
+		s.PublishReadReceipt(c.UserID, p.To, p.RoomID, readSeq)
+		return map[string]int64{"read_seq": readSeq}, nil
+
+	case FrameSync:
+		var p SyncPayload
+		if err := decodePayload(frame, c.UserID, &p); err != nil {
+			return nil, err
+		}
+		return s.store.Sync(ctx, c.UserID, p.To, p.RoomID, p.AfterSeq, p.Limit)
+
+	case FrameCursors:
+		return s.cursors.GetCursors(ctx, c.UserID, c.DeviceID)
+
+	case FrameKickOthers:
+		return nil, s.manager.KickOthers(c.UserID, c.DeviceID)
+
+	case FramePing:
+		return nil, nil
+
+	default:
+		return nil, errno.ParamVerifyError.WithMessage("unknown frame type: " + frame.Type)
 	}
-	s.manager.SendToRoom(userID, roomID, leaveMsg)
+}
 
+// JoinRoom 校验成员身份后当前连接订阅聊天室消息
+func (s *WsService) JoinRoom(ctx context.Context, client *Client, roomID int64) error {
+	if err := s.store.CheckRoom(ctx, roomID, client.UserID); err != nil {
+		return err
+	}
+	s.manager.JoinRoom(client, roomID)
 	return nil
 }
 
-// SendChatMessage 发送聊天消息
-func (s *WsService) SendChatMessage(userID, roomID int64, content string, msgType int8) error {
+// PublishRoomEvent 推送群管理操作产生的系统消息给聊天室所有成员，event 为空时忽略
+func (s *WsService) PublishRoomEvent(event *model.ChatMessage) {
+	if event == nil {
+		return
+	}
+	s.manager.SendToRoom(0, event.GetRoomId(), fromChatMessage(event))
+}
+
+// PublishMessageUpdate 推送消息编辑或撤回给聊天室所有成员，kind 为 MessageTypeMessageEdited 或 MessageTypeMessageRecalled
+func (s *WsService) PublishMessageUpdate(kind string, msg *model.ChatMessage) {
+	if msg == nil {
+		return
+	}
+	update := fromChatMessage(msg)
+	update.Type = kind
+	s.manager.SendToRoom(0, msg.GetRoomId(), update)
+}
+
+// PublishPrivateMessageUpdate 推送私信编辑或撤回给双方的所有设备，kind 为 MessageTypeMessageEdited 或 MessageTypeMessageRecalled
+func (s *WsService) PublishPrivateMessageUpdate(kind string, msg *model.PrivateMessage) {
+	if msg == nil {
+		return
+	}
+	update := fromPrivateMessage(msg)
+	update.Type = kind
+	for _, id := range []int64{msg.GetReceiverId(), msg.GetSenderId()} {
+		if err := s.pushToUser(id, update); err != nil {
+			log.Printf("error pushing message update to user %d: %v", id, err)
+		}
+	}
+}
+
+// PublishReadReceipt 推送已读回执，私信推送给对方和读者的所有设备，群聊推送给聊天室所有成员
+func (s *WsService) PublishReadReceipt(userID, peerID, roomID, readSeq int64) {
+	if readSeq <= 0 {
+		return
+	}
 	msg := &Message{
-		Type:      MessageTypeGroup,
-		From:      userID,
-		RoomID:    roomID,
-		Content:   content,
-		Timestamp: time.Now().Unix(),
+		Type:   MessageTypeReadReceipt,
+		From:   userID,
+		To:     peerID,
+		RoomID: roomID,
+		Extra:  map[string]any{"read_seq": readSeq},
+	}
+	if roomID != 0 {
+		s.manager.SendToRoom(0, roomID, msg)
+		return
+	}
+	for _, id := range []int64{peerID, userID} {
+		if err := s.pushToUser(id, msg); err != nil {
+			log.Printf("error pushing read receipt to user %d: %v", id, err)
+		}
+	}
+}
+
+// AddRoomMembers 成员加入聊天室后，其在线连接订阅聊天室，再推送系统消息
+func (s *WsService) AddRoomMembers(roomID int64, userIDs []int64, event *model.ChatMessage) {
+	for _, userID := range userIDs {
+		if err := s.manager.JoinUserRoom(userID, roomID); err != nil {
+			log.Printf("error joining user %d to room %d: %v", userID, roomID, err)
+		}
+	}
+	s.PublishRoomEvent(event)
+}
+
+// RemoveRoomMember 成员被移除或退出后，其在线连接取消订阅并推送系统消息，被移除时同时通知该用户
+func (s *WsService) RemoveRoomMember(operatorID, roomID, userID int64, event *model.ChatMessage) {
+	if err := s.manager.LeaveUserRoom(userID, roomID); err != nil {
+		log.Printf("error removing user %d from room %d: %v", userID, roomID, err)
+	}
+	if operatorID != userID {
+		s.notifyRoomRemoved(operatorID, roomID, userID, roomRemovedByAdmin)
+	}
+	s.PublishRoomEvent(event)
+}
+
+// DisbandRoom 聊天室解散后推送系统消息，所有成员的在线连接取消订阅并收到通知
+func (s *WsService) DisbandRoom(operatorID, roomID int64, memberIDs []int64, event *model.ChatMessage) {
+	s.PublishRoomEvent(event)
+	for _, userID := range memberIDs {
+		if err := s.manager.LeaveUserRoom(userID, roomID); err != nil {
+			log.Printf("error removing user %d from room %d: %v", userID, roomID, err)
+		}
+		s.notifyRoomRemoved(operatorID, roomID, userID, roomRemovedDisbanded)
+	}
+}
+
+// notifyRoomRemoved 通知用户已不在聊天室中
+func (s *WsService) notifyRoomRemoved(operatorID, roomID, userID int64, reason string) {
+	if err := s.pushToUser(userID, &Message{
+		Type:   MessageTypeRoomRemoved,
+		From:   operatorID,
+		RoomID: roomID,
+		Extra:  map[string]any{"reason": reason},
+	}); err != nil {
+		log.Printf("error notifying removed user %d: %v", userID, err)
+	}
+}
+
+// PublishFriendRequest 好友申请发出或状态变化后通知另一方，actorID 为本次操作者
+func (s *WsService) PublishFriendRequest(actorID int64, request *model.FriendRequest) {
+	if request == nil {
+		return
+	}
+	target := request.ReceiverId
+	if actorID == request.ReceiverId {
+		target = request.SenderId
+	}
+	if err := s.pushToUser(target, &Message{
+		ID:      request.Id,
+		Type:    MessageTypeFriendRequest,
+		From:    actorID,
+		To:      target,
+		Content: request.GetMessage(),
 		Extra: map[string]any{
-			"type": msgType,
+			"sender_id":   request.SenderId,
+			"receiver_id": request.ReceiverId,
+			"status":      request.Status,
 		},
+	}); err != nil {
+		log.Printf("error notifying friend request %d to user %d: %v", request.Id, target, err)
 	}
+}
 
+// KickOtherSessions 踢下线用户除 keepDeviceID 之外的所有设备
+func (s *WsService) KickOtherSessions(userID int64, keepDeviceID string) error {
+	return s.manager.KickOthers(userID, keepDeviceID)
+}
+
+// SendChatMessage 持久化群聊消息后推送给聊天室内的其他成员，replyToID 为 0 表示不是回复
+func (s *WsService) SendChatMessage(ctx context.Context, userID, roomID int64, content string, msgType int8, replyToID int64) (*Message, error) {
+	msg, err := s.store.SaveGroupMessage(ctx, roomID, userID, content, msgType, replyToID)
+	if err != nil {
+		return nil, err
+	}
 	s.manager.SendToRoom(userID, roomID, msg)
-	return nil
+	return msg, nil
 }
 
-// SendPrivateMessage 发送私信
-func (s *WsService) SendPrivateMessage(fromID, toID int64, content string) error {
-	msg := &Message{
-		Type:      MessageTypePrivate,
-		From:      fromID,
-		To:        toID,
-		Content:   content,
-		Timestamp: time.Now().Unix(),
+// SendPrivateMessage 持久化私信后推送给接收者，replyToID 为 0 表示不是回复。
+// 消息已落库后推送失败只记录日志，接收者上线后通过补发或 sync 获取
+func (s *WsService) SendPrivateMessage(ctx context.Context, fromID, toID int64, content string, replyToID int64) (*Message, error) {
+	msg, err := s.store.SavePrivateMessage(ctx, fromID, toID, content, replyToID)
+	if err != nil {
+		return nil, err
 	}
+	if err := s.pushToUser(toID, msg); err != nil {
+		log.Printf("error pushing private message %d to user %d: %v", msg.ID, toID, err)
+	}
+	return msg, nil
+}
 
+// pushToUser 推送消息给在线用户，用户不在线时忽略
+func (s *WsService) pushToUser(userID int64, msg *Message) error {
+	if msg.Timestamp == 0 {
+		msg.Timestamp = time.Now().Unix()
+	}
 	data, err := json.Marshal(msg)
 	if err != nil {
 		return fmt.Errorf("消息序列化失败: %w", err)
 	}
-
-	if err := s.manager.SendToUser(toID, data); err != nil {
+	if err := s.manager.SendToUser(userID, data); err != nil {
 		return fmt.Errorf("发送消息失败: %w", err)
 	}
-
 	return nil
 }
 
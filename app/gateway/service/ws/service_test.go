@@ -0,0 +1,443 @@
+package ws
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/smartystreets/goconvey/convey"
+	"github.com/yxrxy/videoHub/kitex_gen/model"
+	"github.com/yxrxy/videoHub/kitex_gen/social"
+	"github.com/yxrxy/videoHub/pkg/errno"
+	"github.com/yxrxy/videoHub/pkg/kafka"
+)
+
+// fakeStore 按会话保存已持久化的消息
+type fakeStore struct {
+	MessageStore
+	messages map[string][]*Message
+	rooms    map[int64][]int64 // 用户ID -> 加入的聊天室
+	denied   map[int64]bool    // 不能私信的用户
+
+	mu       sync.Mutex
+	presence map[int64]bool // 用户ID -> 最近一次上报的在线状态
+}
+
+func (s *fakeStore) UpdatePresence(_ context.Context, userIDs []int64, online bool) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.presence == nil {
+		s.presence = make(map[int64]bool)
+	}
+	for _, id := range userIDs {
+		s.presence[id] = online
+	}
+	return nil
+}
+
+// reported 获取用户最近一次上报的在线状态，第二个返回值表示是否上报过
+func (s *fakeStore) reported(userID int64) (bool, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	online, ok := s.presence[userID]
+	return online, ok
+}
+
+func (s *fakeStore) CheckPeer(_ context.Context, _, peerID int64) error {
+	if s.denied[peerID] {
+		return errno.AuthNoOperatePermission
+	}
+	return nil
+}
+
+func (s *fakeStore) UserRooms(_ context.Context, userID int64) ([]int64, error) {
+	return s.rooms[userID], nil
+}
+
+func (s *fakeStore) Sync(_ context.Context, _ int64, peerID, roomID, afterSeq int64, limit int32) (*SyncResult, error) {
+	result := &SyncResult{}
+	for _, msg := range s.messages[conversationKey(peerID, roomID)] {
+		result.LatestSeq = msg.Seq
+		if msg.Seq <= afterSeq {
+			continue
+		}
+		if len(result.Messages) == int(limit) {
+			result.HasMore = true
+			continue
+		}
+		result.Messages = append(result.Messages, msg)
+	}
+	return result, nil
+}
+
+func (s *fakeStore) MarkRead(_ context.Context, _ int64, peerID, roomID, seq int64) (int64, error) {
+	if msgs := s.messages[conversationKey(peerID, roomID)]; seq == 0 && len(msgs) > 0 {
+		seq = msgs[len(msgs)-1].Seq
+	}
+	return seq, nil
+}
+
+func TestWsService_Redeliver(t *testing.T) {
+	convey.Convey("重连时按设备游标补发消息", t, func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		manager := NewManager()
+		go manager.Start(ctx)
+
+		store := &fakeStore{messages: map[string][]*Message{
+			conversationKey(1, 0): {
+				{ID: 11, Seq: 1, Type: MessageTypePrivate, From: 1, To: 2, Content: "a"},
+				{ID: 12, Seq: 2, Type: MessageTypePrivate, From: 1, To: 2, Content: "b"},
+				{ID: 13, Seq: 3, Type: MessageTypePrivate, From: 2, To: 1, Content: "c"},
+			},
+		}}
+		cursors := NewMemoryCursorStore()
+		convey.So(cursors.SetCursor(ctx, 2, "phone", conversationKey(1, 0), 1), convey.ShouldBeNil)
+		s := NewWsService(manager, store, cursors)
+
+		conn := newFakeConn()
+		_, err := s.RegisterClient(ctx, 2, "phone", conn)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(conn.next(time.Second).Type, convey.ShouldEqual, MessageTypeSystem)
+
+		for _, seq := range []int64{2, 3} {
+			msg := conn.next(time.Second)
+			convey.So(msg, convey.ShouldNotBeNil)
+			convey.So(msg.Seq, convey.ShouldEqual, seq)
+			convey.So(msg.Extra["redelivered"], convey.ShouldEqual, true)
+		}
+		convey.So(conn.next(100*time.Millisecond), convey.ShouldBeNil)
+	})
+
+	convey.Convey("解析会话标识", t, func() {
+		peerID, roomID, ok := parseConversationKey(conversationKey(0, 10))
+		convey.So(ok, convey.ShouldBeTrue)
+		convey.So(peerID, convey.ShouldEqual, 0)
+		convey.So(roomID, convey.ShouldEqual, 10)
+
+		_, _, ok = parseConversationKey("private:abc")
+		convey.So(ok, convey.ShouldBeFalse)
+	})
+}
+
+func TestWsService_RoomMembership(t *testing.T) {
+	convey.Convey("聊天室成员变更同步到在线连接", t, func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		nodeA, nodeB, _ := newTestCluster(ctx)
+		store := &fakeStore{rooms: map[int64][]int64{1: {10}}}
+		s := NewWsService(nodeA, store, NewMemoryCursorStore())
+
+		// 注册时自动订阅已加入的聊天室
+		conn := newFakeConn()
+		owner, err := s.RegisterClient(ctx, 1, "web", conn)
+		convey.So(err, convey.ShouldBeNil)
+		conn.next(time.Second)
+		convey.So(owner.IsInRoom(10), convey.ShouldBeTrue)
+
+		// 新成员先订阅聊天室，再与已有成员一起收到持久化的系统消息
+		member, memberConn := register(nodeB, 2, "web")
+		systemType := int8(social.MessageType_SYSTEM)
+		s.AddRoomMembers(10, []int64{2}, &model.ChatMessage{
+			Id: 100, RoomId: 10, SenderId: 1, Seq: 7, Type: systemType,
+			Content: `{"event":"member_added","operator_id":1,"user_ids":[2]}`,
+		})
+		convey.So(waitFor(func() bool { return member.IsInRoom(10) }), convey.ShouldBeTrue)
+		msg := memberConn.next(time.Second)
+		convey.So(msg, convey.ShouldNotBeNil)
+		convey.So(msg.Type, convey.ShouldEqual, MessageTypeSystem)
+		convey.So(msg.Seq, convey.ShouldEqual, 7)
+		convey.So(conn.next(time.Second).Seq, convey.ShouldEqual, 7)
+
+		// 编辑和撤回推送给包括操作者在内的所有成员，ID 指向原消息
+		edited := int64(1700000000)
+		s.PublishMessageUpdate(MessageTypeMessageEdited, &model.ChatMessage{Id: 50, RoomId: 10, SenderId: 2, Seq: 3, Content: "fixed", EditedAt: &edited})
+		msg = memberConn.next(time.Second)
+		convey.So(msg.Type, convey.ShouldEqual, MessageTypeMessageEdited)
+		convey.So(msg.ID, convey.ShouldEqual, 50)
+		convey.So(msg.Extra["edited_at"], convey.ShouldEqual, edited)
+		convey.So(conn.next(time.Second).Content, convey.ShouldEqual, "fixed")
+		recalled := true
+		s.PublishMessageUpdate(MessageTypeMessageRecalled, &model.ChatMessage{Id: 50, RoomId: 10, SenderId: 2, Seq: 3, Recalled: &recalled})
+		msg = memberConn.next(time.Second)
+		convey.So(msg.Type, convey.ShouldEqual, MessageTypeMessageRecalled)
+		convey.So(msg.Content, convey.ShouldBeEmpty)
+		convey.So(conn.next(time.Second).Extra["recalled"], convey.ShouldEqual, true)
+
+		s.RemoveRoomMember(1, 10, 2, &model.ChatMessage{Id: 101, RoomId: 10, SenderId: 1, Seq: 8, Type: systemType})
+		convey.So(waitFor(func() bool { return !member.IsInRoom(10) }), convey.ShouldBeTrue)
+		msg = memberConn.next(time.Second)
+		convey.So(msg, convey.ShouldNotBeNil)
+		convey.So(msg.Type, convey.ShouldEqual, MessageTypeRoomRemoved)
+		convey.So(msg.Extra["reason"], convey.ShouldEqual, roomRemovedByAdmin)
+		convey.So(conn.next(time.Second).Seq, convey.ShouldEqual, 8)
+
+		// 被移除后不再收到聊天室消息
+		nodeA.SendToRoom(1, 10, &Message{Type: MessageTypeGroup, From: 1, RoomID: 10, Content: "bye"})
+		convey.So(memberConn.next(100*time.Millisecond), convey.ShouldBeNil)
+
+		// 解散后所有成员取消订阅并收到通知
+		s.DisbandRoom(1, 10, []int64{1}, &model.ChatMessage{Id: 102, RoomId: 10, SenderId: 1, Seq: 9, Type: systemType})
+		convey.So(conn.next(time.Second).Seq, convey.ShouldEqual, 9)
+		msg = conn.next(time.Second)
+		convey.So(msg, convey.ShouldNotBeNil)
+		convey.So(msg.Type, convey.ShouldEqual, MessageTypeRoomRemoved)
+		convey.So(msg.Extra["reason"], convey.ShouldEqual, roomRemovedDisbanded)
+		convey.So(owner.IsInRoom(10), convey.ShouldBeFalse)
+	})
+}
+
+func TestWsService_ReadReceipt(t *testing.T) {
+	convey.Convey("标记已读后推送已读回执", t, func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		manager := NewManager()
+		go manager.Start(ctx)
+		store := &fakeStore{messages: map[string][]*Message{
+			conversationKey(1, 0): {{ID: 11, Seq: 1}, {ID: 12, Seq: 2}},
+		}}
+		s := NewWsService(manager, store, NewMemoryCursorStore())
+
+		reader, _ := register(manager, 2, "phone")
+		_, tabletConn := register(manager, 2, "tablet")
+		_, senderConn := register(manager, 1, "web")
+
+		data, err := s.dispatch(ctx, reader, &Frame{Type: FrameRead, Data: []byte(`{"to":1}`)})
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(data, convey.ShouldResemble, map[string]int64{"read_seq": 2})
+
+		// 对方和读者的其他设备都收到回执
+		for _, conn := range []*fakeConn{senderConn, tabletConn} {
+			msg := conn.next(time.Second)
+			convey.So(msg, convey.ShouldNotBeNil)
+			convey.So(msg.Type, convey.ShouldEqual, MessageTypeReadReceipt)
+			convey.So(msg.From, convey.ShouldEqual, 2)
+			convey.So(msg.Extra["read_seq"], convey.ShouldEqual, 2)
+		}
+
+		_, err = s.dispatch(ctx, reader, &Frame{Type: FrameRead, Data: []byte(`{"to":2}`)})
+		convey.So(err, convey.ShouldNotBeNil)
+	})
+}
+
+func TestWsService_PublishFriendRequest(t *testing.T) {
+	convey.Convey("好友申请发出和处理后通知另一方", t, func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		manager := NewManager()
+		go manager.Start(ctx)
+		s := NewWsService(manager, &fakeStore{}, NewMemoryCursorStore())
+
+		_, senderConn := register(manager, 1, "web")
+		_, receiverConn := register(manager, 2, "phone")
+		message := "你好"
+		request := &model.FriendRequest{Id: 7, SenderId: 1, ReceiverId: 2, Message: &message}
+
+		s.PublishFriendRequest(1, request)
+		msg := receiverConn.next(time.Second)
+		convey.So(msg, convey.ShouldNotBeNil)
+		convey.So(msg.Type, convey.ShouldEqual, MessageTypeFriendRequest)
+		convey.So(msg.ID, convey.ShouldEqual, 7)
+		convey.So(msg.Content, convey.ShouldEqual, message)
+
+		request.Status = 1
+		s.PublishFriendRequest(2, request)
+		msg = senderConn.next(time.Second)
+		convey.So(msg, convey.ShouldNotBeNil)
+		convey.So(msg.From, convey.ShouldEqual, 2)
+		convey.So(msg.Extra["status"], convey.ShouldEqual, 1)
+	})
+}
+
+func TestWsService_PublishPrivateMessageUpdate(t *testing.T) {
+	convey.Convey("私信撤回后推送给双方的所有设备", t, func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		manager := NewManager()
+		go manager.Start(ctx)
+		s := NewWsService(manager, &fakeStore{}, NewMemoryCursorStore())
+
+		_, senderWeb := register(manager, 1, "web")
+		_, senderPhone := register(manager, 1, "phone")
+		_, receiverConn := register(manager, 2, "phone")
+		recalled := true
+		s.PublishPrivateMessageUpdate(MessageTypeMessageRecalled, &model.PrivateMessage{
+			Id: 7, SenderId: 1, ReceiverId: 2, Seq: 3, Recalled: &recalled,
+		})
+
+		for _, conn := range []*fakeConn{receiverConn, senderWeb, senderPhone} {
+			msg := conn.next(time.Second)
+			convey.So(msg, convey.ShouldNotBeNil)
+			convey.So(msg.Type, convey.ShouldEqual, MessageTypeMessageRecalled)
+			convey.So(msg.ID, convey.ShouldEqual, 7)
+			convey.So(msg.Seq, convey.ShouldEqual, 3)
+			convey.So(msg.Content, convey.ShouldBeEmpty)
+			convey.So(msg.Extra["recalled"], convey.ShouldEqual, true)
+		}
+	})
+}
+
+func TestWsService_ConsumeNotifications(t *testing.T) {
+	convey.Convey("通知推送转发给在线的接收者", t, func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		manager := NewManager()
+		go manager.Start(ctx)
+		s := NewWsService(manager, &fakeStore{}, NewMemoryCursorStore())
+
+		_, conn := register(manager, 2, "phone")
+		msgCh := make(chan *kafka.Message, 1)
+		go s.ConsumeNotifications(ctx, msgCh)
+		msgCh <- &kafka.Message{V: []byte(`{"user_id":2,"notification":{"id":7,"type":1,"actor_count":13},"unread":4}`)}
+
+		msg := conn.next(time.Second)
+		convey.So(msg, convey.ShouldNotBeNil)
+		convey.So(msg.Type, convey.ShouldEqual, MessageTypeNotification)
+		convey.So(msg.Extra["unread"], convey.ShouldEqual, 4)
+		notification, ok := msg.Extra["notification"].(map[string]any)
+		convey.So(ok, convey.ShouldBeTrue)
+		convey.So(notification["actor_count"], convey.ShouldEqual, 13)
+	})
+}
+
+func TestWsService_Presence(t *testing.T) {
+	convey.Convey("所有节点上的连接都断开后才上报下线", t, func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		nodeA, nodeB, _ := newTestCluster(ctx)
+		store := &fakeStore{}
+		sA := NewWsService(nodeA, store, NewMemoryCursorStore())
+		sB := NewWsService(nodeB, store, NewMemoryCursorStore())
+
+		clientA, err := sA.RegisterClient(ctx, 1, "web", newFakeConn())
+		convey.So(err, convey.ShouldBeNil)
+		clientB, err := sB.RegisterClient(ctx, 1, "phone", newFakeConn())
+		convey.So(err, convey.ShouldBeNil)
+		online, ok := store.reported(1)
+		convey.So(ok && online, convey.ShouldBeTrue)
+
+		nodeA.unregister <- clientA
+		time.Sleep(100 * time.Millisecond)
+		online, _ = store.reported(1)
+		convey.So(online, convey.ShouldBeTrue)
+
+		nodeB.unregister <- clientB
+		deadline := time.Now().Add(time.Second)
+		for online && time.Now().Before(deadline) {
+			time.Sleep(10 * time.Millisecond)
+			online, _ = store.reported(1)
+		}
+		convey.So(online, convey.ShouldBeFalse)
+	})
+
+	convey.Convey("在线状态变化推送给在线的好友", t, func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		manager := NewManager()
+		go manager.Start(ctx)
+		s := NewWsService(manager, &fakeStore{}, NewMemoryCursorStore())
+
+		_, conn := register(manager, 2, "phone")
+		msgCh := make(chan *kafka.Message, 1)
+		go s.ConsumePresence(ctx, msgCh)
+		msgCh <- &kafka.Message{V: []byte(`{"user_id":1,"online":false,"last_seen":1700000000,"recipients":[2,3]}`)}
+
+		msg := conn.next(time.Second)
+		convey.So(msg, convey.ShouldNotBeNil)
+		convey.So(msg.Type, convey.ShouldEqual, MessageTypePresence)
+		convey.So(msg.From, convey.ShouldEqual, 1)
+		convey.So(msg.Extra["online"], convey.ShouldEqual, false)
+		convey.So(msg.Extra["last_seen"], convey.ShouldEqual, 1700000000)
+	})
+}
+
+func TestWsService_Signal(t *testing.T) {
+	convey.Convey("临时信号转发给私信对方并限流", t, func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		manager := NewManager()
+		go manager.Start(ctx)
+		s := NewWsService(manager, &fakeStore{denied: map[int64]bool{3: true}}, NewMemoryCursorStore())
+
+		sender, _ := register(manager, 1, "web")
+		_, conn := register(manager, 2, "phone")
+
+		signal := func(kind string, active bool, to int64) error {
+			data, _ := json.Marshal(&SignalPayload{Kind: kind, Active: active, To: to})
+			_, err := s.dispatch(ctx, sender, &Frame{Type: FrameSignal, Data: data})
+			return err
+		}
+
+		convey.So(signal(SignalRecording, true, 2), convey.ShouldBeNil)
+		msg := conn.next(time.Second)
+		convey.So(msg, convey.ShouldNotBeNil)
+		convey.So(msg.Type, convey.ShouldEqual, MessageTypeSignal)
+		convey.So(msg.From, convey.ShouldEqual, 1)
+		convey.So(msg.Extra["kind"], convey.ShouldEqual, SignalRecording)
+		convey.So(msg.Extra["active"], convey.ShouldEqual, true)
+
+		// 限流窗口内重复的开始信号不转发
+		convey.So(signal(SignalRecording, true, 2), convey.ShouldBeNil)
+		convey.So(conn.next(100*time.Millisecond), convey.ShouldBeNil)
+
+		convey.So(signal(SignalRecording, false, 2), convey.ShouldBeNil)
+		msg = conn.next(time.Second)
+		convey.So(msg, convey.ShouldNotBeNil)
+		convey.So(msg.Extra["active"], convey.ShouldEqual, false)
+
+		// 未在进行中的信号停止时不转发
+		convey.So(signal(SignalRecording, false, 2), convey.ShouldBeNil)
+		convey.So(conn.next(100*time.Millisecond), convey.ShouldBeNil)
+
+		convey.So(signal(SignalTyping, true, 3), convey.ShouldNotBeNil)
+		convey.So(signal("dancing", true, 2), convey.ShouldNotBeNil)
+	})
+
+	convey.Convey("未续期的信号自动停止，连接断开时停止所有信号", t, func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		ttl := signalTTL
+		signalTTL = 50 * time.Millisecond
+		defer func() { signalTTL = ttl }()
+		manager := NewManager()
+		go manager.Start(ctx)
+		s := NewWsService(manager, &fakeStore{}, NewMemoryCursorStore())
+
+		sender, _ := register(manager, 1, "web")
+		member, memberConn := register(manager, 2, "phone")
+		_, peerConn := register(manager, 3, "phone")
+		manager.JoinRoom(member, 10)
+
+		// 旧版 typing 帧仍推送 typing 消息
+		_, err := s.dispatch(ctx, sender, &Frame{Type: FrameTyping, Data: []byte(`{"to":3}`)})
+		convey.So(err, convey.ShouldBeNil)
+		msg := peerConn.next(time.Second)
+		convey.So(msg, convey.ShouldNotBeNil)
+		convey.So(msg.Type, convey.ShouldEqual, MessageTypeTyping)
+		msg = peerConn.next(time.Second)
+		convey.So(msg, convey.ShouldNotBeNil)
+		convey.So(msg.Type, convey.ShouldEqual, MessageTypeTyping)
+		convey.So(msg.Extra["active"], convey.ShouldEqual, false)
+		convey.So(msg.Extra["expired"], convey.ShouldEqual, true)
+
+		// 未订阅聊天室时不能发送信号
+		_, err = s.dispatch(ctx, sender, &Frame{Type: FrameSignal, Data: []byte(`{"kind":"viewing","active":true,"room_id":10}`)})
+		convey.So(err, convey.ShouldNotBeNil)
+
+		signalTTL = time.Minute
+		manager.JoinRoom(sender, 10)
+		_, err = s.dispatch(ctx, sender, &Frame{Type: FrameSignal, Data: []byte(`{"kind":"viewing","active":true,"room_id":10}`)})
+		convey.So(err, convey.ShouldBeNil)
+		msg = memberConn.next(time.Second)
+		convey.So(msg, convey.ShouldNotBeNil)
+		convey.So(msg.RoomID, convey.ShouldEqual, 10)
+		convey.So(msg.Extra["kind"], convey.ShouldEqual, SignalViewing)
+
+		s.StopSignals(sender)
+		msg = memberConn.next(time.Second)
+		convey.So(msg, convey.ShouldNotBeNil)
+		convey.So(msg.Extra["active"], convey.ShouldEqual, false)
+	})
+}
@@ -0,0 +1,207 @@
+package ws
+
+import (
+	"context"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/yxrxy/videoHub/pkg/constants"
+	"github.com/yxrxy/videoHub/pkg/errno"
+)
+
+// signalTTL 信号未续期时的过期时间，测试中可以缩短
+var signalTTL = constants.SignalTTL
+
+// signalKey 标识连接在某个会话中的一类信号
+type signalKey struct {
+	kind   string
+	to     int64
+	roomID int64
+}
+
+// activeSignal 进行中的信号，deadline 前未续期时由 timer 自动停止
+type activeSignal struct {
+	msgType  string
+	deadline time.Time
+	timer    *time.Timer
+}
+
+// signalState 连接的临时信号状态
+type signalState struct {
+	mu     sync.Mutex
+	active map[signalKey]*activeSignal
+	sent   map[signalKey]time.Time // 最近一次转发开始信号的时间，用于限流
+	peers  map[int64]time.Time     // 已校验可以私信的对方 -> 校验结果过期时间
+}
+
+// start 开始或续期信号，返回是否需要转发。同类开始信号在 constants.SignalMinInterval 内只转发一次
+func (st *signalState) start(key signalKey, msgType string, expire func(*activeSignal)) (bool, error) {
+	st.mu.Lock()
+	defer st.mu.Unlock()
+
+	now := time.Now()
+	if last, ok := st.sent[key]; ok && now.Sub(last) < constants.SignalMinInterval {
+		return false, nil
+	}
+	sig := st.active[key]
+	if sig == nil {
+		if len(st.active) >= constants.SignalMaxActive {
+			return false, errno.ParamVerifyError.WithMessage("too many active signals")
+		}
+		if st.active == nil {
+			st.active = make(map[signalKey]*activeSignal)
+			st.sent = make(map[signalKey]time.Time)
+		}
+		sig = &activeSignal{msgType: msgType}
+		sig.timer = time.AfterFunc(signalTTL, func() { expire(sig) })
+		st.active[key] = sig
+	} else {
+		sig.timer.Reset(signalTTL)
+	}
+	sig.deadline = now.Add(signalTTL)
+
+	// 清理已过限流窗口的记录
+	for k, t := range st.sent {
+		if now.Sub(t) >= constants.SignalMinInterval {
+			delete(st.sent, k)
+		}
+	}
+	st.sent[key] = now
+	return true, nil
+}
+
+// stop 停止信号，信号未在进行中时返回 nil
+func (st *signalState) stop(key signalKey) *activeSignal {
+	st.mu.Lock()
+	defer st.mu.Unlock()
+
+	sig := st.active[key]
+	if sig == nil {
+		return nil
+	}
+	sig.timer.Stop()
+	delete(st.active, key)
+	return sig
+}
+
+// expire 信号超时，期间被续期或已停止时返回 false
+func (st *signalState) expire(key signalKey, sig *activeSignal) bool {
+	st.mu.Lock()
+	defer st.mu.Unlock()
+
+	if st.active[key] != sig || time.Now().Before(sig.deadline) {
+		return false
+	}
+	delete(st.active, key)
+	return true
+}
+
+// stopAll 停止所有进行中的信号
+func (st *signalState) stopAll() map[signalKey]*activeSignal {
+	st.mu.Lock()
+	defer st.mu.Unlock()
+
+	active := st.active
+	for _, sig := range active {
+		sig.timer.Stop()
+	}
+	st.active = nil
+	st.sent = nil
+	return active
+}
+
+// peerAllowed 对方是否已在缓存有效期内校验过可以私信
+func (st *signalState) peerAllowed(peerID int64) bool {
+	st.mu.Lock()
+	defer st.mu.Unlock()
+	return time.Now().Before(st.peers[peerID])
+}
+
+// allowPeer 缓存可以私信对方的校验结果，并清理已过期的结果
+func (st *signalState) allowPeer(peerID int64) {
+	st.mu.Lock()
+	defer st.mu.Unlock()
+
+	now := time.Now()
+	if st.peers == nil {
+		st.peers = make(map[int64]time.Time)
+	}
+	for id, expireAt := range st.peers {
+		if !now.Before(expireAt) {
+			delete(st.peers, id)
+		}
+	}
+	st.peers[peerID] = now.Add(constants.SignalPeerCacheTTL)
+}
+
+// SendSignal 转发临时信号给会话的其他参与者，信号不落库。聊天室信号要求当前连接已订阅该聊天室，
+// 私信信号要求可以私信对方；开始信号过于频繁时忽略，超过 signalTTL 未续期时自动转发停止信号
+func (s *WsService) SendSignal(ctx context.Context, c *Client, msgType string, p *SignalPayload) error {
+	key := signalKey{kind: p.Kind, to: p.To, roomID: p.RoomID}
+	if !p.Active {
+		if sig := c.signals.stop(key); sig != nil {
+			s.deliverSignal(c, sig.msgType, key, false, false)
+		}
+		return nil
+	}
+
+	if err := s.checkSignalTarget(ctx, c, key); err != nil {
+		return err
+	}
+	forward, err := c.signals.start(key, msgType, func(sig *activeSignal) {
+		if c.signals.expire(key, sig) {
+			s.deliverSignal(c, sig.msgType, key, false, true)
+		}
+	})
+	if err != nil || !forward {
+		return err
+	}
+	s.deliverSignal(c, msgType, key, true, false)
+	return nil
+}
+
+// StopSignals 连接断开时停止其所有进行中的信号
+func (s *WsService) StopSignals(c *Client) {
+	for key, sig := range c.signals.stopAll() {
+		s.deliverSignal(c, sig.msgType, key, false, false)
+	}
+}
+
+// checkSignalTarget 校验信号的接收方是会话参与者
+func (s *WsService) checkSignalTarget(ctx context.Context, c *Client, key signalKey) error {
+	if key.roomID != 0 {
+		if !c.IsInRoom(key.roomID) {
+			return errno.AuthNoOperatePermission.WithMessage("not in room")
+		}
+		return nil
+	}
+	if c.signals.peerAllowed(key.to) {
+		return nil
+	}
+	if err := s.store.CheckPeer(ctx, c.UserID, key.to); err != nil {
+		return err
+	}
+	c.signals.allowPeer(key.to)
+	return nil
+}
+
+// deliverSignal 推送信号给聊天室的其他成员或私信对方
+func (s *WsService) deliverSignal(c *Client, msgType string, key signalKey, active, expired bool) {
+	extra := map[string]any{
+		"kind":   key.kind,
+		"active": active,
+		"ttl":    int64(signalTTL / time.Second),
+	}
+	if expired {
+		extra["expired"] = true
+	}
+	msg := &Message{Type: msgType, From: c.UserID, To: key.to, RoomID: key.roomID, Extra: extra}
+	if key.roomID != 0 {
+		s.manager.SendToRoom(c.UserID, key.roomID, msg)
+		return
+	}
+	if err := s.pushToUser(key.to, msg); err != nil {
+		log.Printf("error pushing signal to user %d: %v", key.to, err)
+	}
+}
@@ -0,0 +1,221 @@
+package ws
+
+import (
+	"context"
+
+	"github.com/yxrxy/videoHub/app/gateway/rpc"
+	"github.com/yxrxy/videoHub/kitex_gen/model"
+	"github.com/yxrxy/videoHub/kitex_gen/social"
+	metainfoContext "github.com/yxrxy/videoHub/pkg/base/context"
+	"github.com/yxrxy/videoHub/pkg/errno"
+)
+
+// MessageStore 消息持久化，客户端发送的消息先落库再推送
+type MessageStore interface {
+	SavePrivateMessage(ctx context.Context, senderID, receiverID int64, content string, replyToID int64) (*Message, error)
+	SaveGroupMessage(ctx context.Context, roomID, senderID int64, content string, msgType int8, replyToID int64) (*Message, error)
+	// CheckRoom 校验用户是聊天室成员
+	CheckRoom(ctx context.Context, roomID, userID int64) error
+	// CheckPeer 校验用户可以私信对方
+	CheckPeer(ctx context.Context, userID, peerID int64) error
+	// UserRooms 获取用户加入的聊天室ID
+	UserRooms(ctx context.Context, userID int64) ([]int64, error)
+	// MarkRead 推进用户在会话中的已读游标，peerID 与 roomID 二选一，返回推进后的已读序号
+	MarkRead(ctx context.Context, userID, peerID, roomID, seq int64) (int64, error)
+	// Sync 获取会话中序号大于 afterSeq 的消息，peerID 与 roomID 二选一
+	Sync(ctx context.Context, userID, peerID, roomID, afterSeq int64, limit int32) (*SyncResult, error)
+	// UpdatePresence 上报用户在线状态
+	UpdatePresence(ctx context.Context, userIDs []int64, online bool) error
+}
+
+// SyncResult 会话增量同步结果，消息按序号升序
+type SyncResult struct {
+	Messages  []*Message `json:"messages"`
+	LatestSeq int64      `json:"latest_seq"`
+	HasMore   bool       `json:"has_more"`
+}
+
+// rpcStore 通过 social 服务持久化消息
+type rpcStore struct{}
+
+// NewRPCStore 创建基于 social RPC 的消息存储
+func NewRPCStore() MessageStore {
+	return rpcStore{}
+}
+
+func (rpcStore) SavePrivateMessage(ctx context.Context, senderID, receiverID int64, content string, replyToID int64) (*Message, error) {
+	req := &social.SendPrivateMessageRequest{
+		ReceiverId: receiverID,
+		Content:    content,
+	}
+	if replyToID != 0 {
+		req.ReplyToId = &replyToID
+	}
+	// social 服务从 context 中取发送者
+	msg, err := rpc.SendPrivateMessageRPC(metainfoContext.WithUserID(ctx, senderID), req)
+	if err != nil {
+		return nil, err
+	}
+	return fromPrivateMessage(msg), nil
+}
+
+// fromPrivateMessage 转换私信，回复、编辑和撤回状态放在 Extra 中
+func fromPrivateMessage(msg *model.PrivateMessage) *Message {
+	var extra map[string]any
+	if msg.IsSetReplyToId() {
+		extra = withExtra(extra, "reply_to_id", msg.GetReplyToId())
+	}
+	if msg.IsSetEditedAt() {
+		extra = withExtra(extra, "edited_at", msg.GetEditedAt())
+	}
+	if msg.GetRecalled() {
+		extra = withExtra(extra, "recalled", true)
+	}
+	return &Message{
+		ID:        msg.GetId(),
+		Seq:       msg.GetSeq(),
+		Type:      MessageTypePrivate,
+		From:      msg.GetSenderId(),
+		To:        msg.GetReceiverId(),
+		Content:   msg.GetContent(),
+		Extra:     extra,
+		Timestamp: msg.GetCreatedAt(),
+	}
+}
+
+func (rpcStore) SaveGroupMessage(ctx context.Context, roomID, senderID int64, content string, msgType int8, replyToID int64) (*Message, error) {
+	req := &social.SendChatMessageRequest{
+		RoomId:  roomID,
+		Content: content,
+		Type:    &msgType,
+	}
+	if replyToID != 0 {
+		req.ReplyToId = &replyToID
+	}
+	// social 服务从 context 中取发送者
+	msg, err := rpc.SendChatMessageRPC(metainfoContext.WithUserID(ctx, senderID), req)
+	if err != nil {
+		return nil, err
+	}
+	return fromChatMessage(msg), nil
+}
+
+// fromChatMessage 转换群聊消息，群管理产生的系统消息使用 MessageTypeSystem
+func fromChatMessage(msg *model.ChatMessage) *Message {
+	msgType := MessageTypeGroup
+	if msg.GetType() == int8(social.MessageType_SYSTEM) {
+		msgType = MessageTypeSystem
+	}
+	extra := map[string]any{"type": msg.GetType()}
+	if msg.IsSetReplyToId() {
+		extra["reply_to_id"] = msg.GetReplyToId()
+	}
+	if msg.IsSetEditedAt() {
+		extra["edited_at"] = msg.GetEditedAt()
+	}
+	if msg.GetRecalled() {
+		extra["recalled"] = true
+	}
+	return &Message{
+		ID:        msg.GetId(),
+		Seq:       msg.GetSeq(),
+		Type:      msgType,
+		From:      msg.GetSenderId(),
+		RoomID:    msg.GetRoomId(),
+		Content:   msg.GetContent(),
+		Extra:     extra,
+		Timestamp: msg.GetCreatedAt(),
+	}
+}
+
+func (rpcStore) CheckRoom(ctx context.Context, roomID, userID int64) error {
+	isMember, err := rpc.CheckChatRoomMemberRPC(ctx, &social.CheckChatRoomMemberRequest{
+		RoomId: roomID,
+		UserId: userID,
+	})
+	if err != nil {
+		return err
+	}
+	if !isMember {
+		return errno.AuthNoOperatePermission.WithMessage("not a member of the room")
+	}
+	return nil
+}
+
+func (rpcStore) CheckPeer(ctx context.Context, userID, peerID int64) error {
+	allowed, err := rpc.CheckPrivateChatRPC(ctx, &social.CheckPrivateChatRequest{
+		UserId: userID,
+		PeerId: peerID,
+	})
+	if err != nil {
+		return err
+	}
+	if !allowed {
+		return errno.AuthNoOperatePermission.WithMessage("cannot message the user")
+	}
+	return nil
+}
+
+func (rpcStore) UserRooms(ctx context.Context, userID int64) ([]int64, error) {
+	rooms, _, err := rpc.GetUserChatRoomsRPC(ctx, &social.GetUserChatRoomsRequest{UserId: userID})
+	if err != nil {
+		return nil, err
+	}
+	roomIDs := make([]int64, 0, len(rooms))
+	for _, room := range rooms {
+		roomIDs = append(roomIDs, room.GetId())
+	}
+	return roomIDs, nil
+}
+
+func (rpcStore) MarkRead(ctx context.Context, userID, peerID, roomID, seq int64) (int64, error) {
+	cursor := &model.ReadCursor{ReadSeq: seq}
+	if roomID != 0 {
+		cursor.RoomId = &roomID
+	} else {
+		cursor.PeerId = &peerID
+	}
+	resp, err := rpc.MarkConversationsReadRPC(metainfoContext.WithUserID(ctx, userID), &social.MarkConversationsReadRequest{
+		Conversations: []*model.ReadCursor{cursor},
+	})
+	if err != nil {
+		return 0, err
+	}
+	if len(resp.Cursors) == 0 {
+		return 0, errno.InternalServiceError.WithMessage("missing read cursor")
+	}
+	return resp.Cursors[0].ReadSeq, nil
+}
+
+func (rpcStore) Sync(ctx context.Context, userID, peerID, roomID, afterSeq int64, limit int32) (*SyncResult, error) {
+	req := &social.SyncMessagesRequest{
+		AfterSeq: afterSeq,
+		Limit:    &limit,
+	}
+	if roomID != 0 {
+		req.RoomId = &roomID
+	} else {
+		req.PeerId = &peerID
+	}
+	resp, err := rpc.SyncMessagesRPC(metainfoContext.WithUserID(ctx, userID), req)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &SyncResult{
+		Messages:  make([]*Message, 0, len(resp.PrivateMessages)+len(resp.ChatMessages)),
+		LatestSeq: resp.LatestSeq,
+		HasMore:   resp.HasMore,
+	}
+	for _, msg := range resp.PrivateMessages {
+		result.Messages = append(result.Messages, fromPrivateMessage(msg))
+	}
+	for _, msg := range resp.ChatMessages {
+		result.Messages = append(result.Messages, fromChatMessage(msg))
+	}
+	return result, nil
+}
+
+func (rpcStore) UpdatePresence(ctx context.Context, userIDs []int64, online bool) error {
+	return rpc.UpdatePresenceRPC(ctx, &social.UpdatePresenceRequest{UserIds: userIDs, Online: online})
+}
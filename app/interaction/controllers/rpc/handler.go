@@ -3,6 +3,8 @@ package rpc
 import (
 	"context"
 
+	"github.com/yxrxy/videoHub/app/interaction/controllers/rpc/pack"
+	"github.com/yxrxy/videoHub/app/interaction/domain/model"
 	"github.com/yxrxy/videoHub/app/interaction/usecase"
 	"github.com/yxrxy/videoHub/kitex_gen/interaction"
 	rpcmodel "github.com/yxrxy/videoHub/kitex_gen/model"
@@ -33,8 +35,9 @@ func (h *InteractionHandler) Like(ctx context.Context, req *interaction.LikeRequ
 
 func (h *InteractionHandler) GetLikes(ctx context.Context, req *interaction.GetLikesRequest) (r *interaction.GetLikesResponse, err error) {
 	r = new(interaction.GetLikesResponse)
+	viewerID, _ := pkgContext.GetUserID(ctx)
 
-	likes, err := h.useCase.GetLikes(ctx, req.VideoId, req.Page, req.Size)
+	likes, err := h.useCase.GetLikes(ctx, viewerID, req.VideoId, req.Page, req.Size)
 	if err != nil {
 		return
 	}
@@ -45,6 +48,7 @@ func (h *InteractionHandler) GetLikes(ctx context.Context, req *interaction.GetL
 			VideoId: like.VideoID,
 		}
 	}
+	r.Base = base.BuildBaseResp(err)
 	return
 }
 
@@ -54,10 +58,11 @@ func (h *InteractionHandler) Comment(ctx context.Context, req *interaction.Comme
 	if err != nil {
 		return
 	}
-	if req.ParentId == nil {
-		*req.ParentId = -1
+	parentID := int64(-1)
+	if req.ParentId != nil {
+		parentID = *req.ParentId
 	}
-	if _, err = h.useCase.Comment(ctx, userID, req.VideoId, req.Content, *req.ParentId); err != nil {
+	if r.CommentId, err = h.useCase.Comment(ctx, userID, req.VideoId, req.Content, parentID); err != nil {
 		return
 	}
 	r.Base = base.BuildBaseResp(err)
@@ -66,20 +71,15 @@ func (h *InteractionHandler) Comment(ctx context.Context, req *interaction.Comme
 
 func (h *InteractionHandler) GetComments(ctx context.Context, req *interaction.GetCommentsRequest) (r *interaction.GetCommentsResponse, err error) {
 	r = new(interaction.GetCommentsResponse)
+	// 未登录时没有作者视角，只返回已通过审核的评论
+	viewerID, _ := pkgContext.GetUserID(ctx)
 
-	comments, err := h.useCase.GetComments(ctx, req.VideoId, req.Page, req.Size)
+	comments, err := h.useCase.GetComments(ctx, req.VideoId, viewerID, req.Page, req.Size)
 	if err != nil {
 		return
 	}
-	r.CommentList = make([]*rpcmodel.Comment, len(comments))
-	for i, comment := range comments {
-		r.CommentList[i] = &rpcmodel.Comment{
-			Id:      comment.ID,
-			UserId:  comment.UserID,
-			VideoId: comment.VideoID,
-			Content: comment.Content,
-		}
-	}
+	r.CommentList = pack.CommentInfos(comments)
+	r.Base = base.BuildBaseResp(err)
 	return
 }
 
@@ -108,3 +108,261 @@ func (h *InteractionHandler) LikeComment(ctx context.Context, req *interaction.L
 	r.Base = base.BuildBaseResp(err)
 	return
 }
+
+func (h *InteractionHandler) EditComment(ctx context.Context, req *interaction.EditCommentRequest) (r *interaction.EditCommentResponse, err error) {
+	r = new(interaction.EditCommentResponse)
+	userID, err := pkgContext.GetUserID(ctx)
+	if err != nil {
+		return
+	}
+	if err = h.useCase.EditComment(ctx, userID, req.CommentId, req.Content); err != nil {
+		return
+	}
+	r.Base = base.BuildBaseResp(err)
+	return
+}
+
+func (h *InteractionHandler) GetCommentEditHistory(ctx context.Context, req *interaction.GetCommentEditHistoryRequest) (r *interaction.GetCommentEditHistoryResponse, err error) {
+	r = new(interaction.GetCommentEditHistoryResponse)
+	viewerID, _ := pkgContext.GetUserID(ctx)
+
+	edits, err := h.useCase.GetCommentEditHistory(ctx, viewerID, req.CommentId)
+	if err != nil {
+		return
+	}
+	r.EditList = pack.CommentEdits(edits)
+	r.Base = base.BuildBaseResp(err)
+	return
+}
+
+func (h *InteractionHandler) PinComment(ctx context.Context, req *interaction.PinCommentRequest) (r *interaction.PinCommentResponse, err error) {
+	r = new(interaction.PinCommentResponse)
+	userID, err := pkgContext.GetUserID(ctx)
+	if err != nil {
+		return
+	}
+	if err = h.useCase.PinComment(ctx, userID, req.CommentId, req.Pin); err != nil {
+		return
+	}
+	r.Base = base.BuildBaseResp(err)
+	return
+}
+
+func (h *InteractionHandler) HeartComment(ctx context.Context, req *interaction.HeartCommentRequest) (r *interaction.HeartCommentResponse, err error) {
+	r = new(interaction.HeartCommentResponse)
+	userID, err := pkgContext.GetUserID(ctx)
+	if err != nil {
+		return
+	}
+	if err = h.useCase.HeartComment(ctx, userID, req.CommentId, req.Heart); err != nil {
+		return
+	}
+	r.Base = base.BuildBaseResp(err)
+	return
+}
+
+func (h *InteractionHandler) React(ctx context.Context, req *interaction.ReactRequest) (r *interaction.ReactResponse, err error) {
+	r = new(interaction.ReactResponse)
+	userID, err := pkgContext.GetUserID(ctx)
+	if err != nil {
+		return
+	}
+	summary, err := h.useCase.React(ctx, userID, req.TargetType, req.TargetId, req.Reaction)
+	if err != nil {
+		return
+	}
+	r.Counts = pack.ReactionCounts(summary.Counts, h.useCase.ReactionTypes())
+	if summary.MyReaction != "" {
+		r.MyReaction = &summary.MyReaction
+	}
+	r.Base = base.BuildBaseResp(err)
+	return
+}
+
+func (h *InteractionHandler) GetReactions(ctx context.Context, req *interaction.GetReactionsRequest) (r *interaction.GetReactionsResponse, err error) {
+	r = new(interaction.GetReactionsResponse)
+	viewerID, _ := pkgContext.GetUserID(ctx)
+
+	reactions, total, summary, err := h.useCase.GetReactions(ctx, viewerID, req.TargetType, req.TargetId, req.GetReaction(), req.Page, req.Size)
+	if err != nil {
+		return
+	}
+	r.ReactionList = pack.ReactionInfos(reactions)
+	r.Counts = pack.ReactionCounts(summary.Counts, h.useCase.ReactionTypes())
+	if summary.MyReaction != "" {
+		r.MyReaction = &summary.MyReaction
+	}
+	r.Total = total
+	r.Base = base.BuildBaseResp(err)
+	return
+}
+
+func (h *InteractionHandler) GetInteractionState(ctx context.Context, req *interaction.GetInteractionStateRequest) (r *interaction.GetInteractionStateResponse, err error) {
+	r = new(interaction.GetInteractionStateResponse)
+	// 未登录时按 0 查询，不返回任何用户的点赞和收藏状态
+	var viewerID int64
+	if id, err := pkgContext.GetUserID(ctx); err == nil {
+		viewerID = id
+	}
+
+	states, err := h.useCase.GetInteractionState(ctx, viewerID, req.VideoIds)
+	if err != nil {
+		return
+	}
+	r.States = pack.VideoInteractionStates(states, h.useCase.ReactionTypes())
+	r.Base = base.BuildBaseResp(err)
+	return
+}
+
+func (h *InteractionHandler) GetFlaggedComments(ctx context.Context, req *interaction.GetFlaggedCommentsRequest) (r *interaction.GetFlaggedCommentsResponse, err error) {
+	r = new(interaction.GetFlaggedCommentsResponse)
+	userID, err := pkgContext.GetUserID(ctx)
+	if err != nil {
+		return
+	}
+	status := model.CommentStatusPending
+	if req.Status != nil {
+		status = *req.Status
+	}
+	comments, total, err := h.useCase.GetFlaggedComments(ctx, userID, status, req.Page, req.Size)
+	if err != nil {
+		return
+	}
+	r.CommentList = make([]*rpcmodel.Comment, len(comments))
+	for i, comment := range comments {
+		r.CommentList[i] = &rpcmodel.Comment{
+			Id:               comment.ID,
+			UserId:           comment.UserID,
+			VideoId:          comment.VideoID,
+			Content:          comment.Content,
+			Status:           &comment.Status,
+			ModerationReason: &comment.ModerationReason,
+		}
+	}
+	r.Total = total
+	r.Base = base.BuildBaseResp(err)
+	return
+}
+
+func (h *InteractionHandler) ReviewComment(ctx context.Context, req *interaction.ReviewCommentRequest) (r *interaction.ReviewCommentResponse, err error) {
+	r = new(interaction.ReviewCommentResponse)
+	userID, err := pkgContext.GetUserID(ctx)
+	if err != nil {
+		return
+	}
+	if err = h.useCase.ReviewComment(ctx, userID, req.CommentId, req.Action, req.GetReason()); err != nil {
+		return
+	}
+	r.Base = base.BuildBaseResp(err)
+	return
+}
+
+func (h *InteractionHandler) CreateCollection(ctx context.Context, req *interaction.CreateCollectionRequest) (r *interaction.CreateCollectionResponse, err error) {
+	r = new(interaction.CreateCollectionResponse)
+	userID, err := pkgContext.GetUserID(ctx)
+	if err != nil {
+		return
+	}
+	collection, err := h.useCase.CreateCollection(ctx, userID, req.Name, req.GetIsPrivate())
+	if err != nil {
+		return
+	}
+	r.Collection = pack.Collection(collection)
+	r.Base = base.BuildBaseResp(err)
+	return
+}
+
+func (h *InteractionHandler) UpdateCollection(ctx context.Context, req *interaction.UpdateCollectionRequest) (r *interaction.UpdateCollectionResponse, err error) {
+	r = new(interaction.UpdateCollectionResponse)
+	userID, err := pkgContext.GetUserID(ctx)
+	if err != nil {
+		return
+	}
+	update := &model.CollectionUpdate{Name: req.Name, IsPrivate: req.IsPrivate}
+	if err = h.useCase.UpdateCollection(ctx, userID, req.CollectionId, update); err != nil {
+		return
+	}
+	r.Base = base.BuildBaseResp(err)
+	return
+}
+
+func (h *InteractionHandler) DeleteCollection(ctx context.Context, req *interaction.DeleteCollectionRequest) (r *interaction.DeleteCollectionResponse, err error) {
+	r = new(interaction.DeleteCollectionResponse)
+	userID, err := pkgContext.GetUserID(ctx)
+	if err != nil {
+		return
+	}
+	if err = h.useCase.DeleteCollection(ctx, userID, req.CollectionId); err != nil {
+		return
+	}
+	r.Base = base.BuildBaseResp(err)
+	return
+}
+
+func (h *InteractionHandler) ReorderCollections(ctx context.Context, req *interaction.ReorderCollectionsRequest) (r *interaction.ReorderCollectionsResponse, err error) {
+	r = new(interaction.ReorderCollectionsResponse)
+	userID, err := pkgContext.GetUserID(ctx)
+	if err != nil {
+		return
+	}
+	if err = h.useCase.ReorderCollections(ctx, userID, req.CollectionIds); err != nil {
+		return
+	}
+	r.Base = base.BuildBaseResp(err)
+	return
+}
+
+func (h *InteractionHandler) GetCollections(ctx context.Context, req *interaction.GetCollectionsRequest) (r *interaction.GetCollectionsResponse, err error) {
+	r = new(interaction.GetCollectionsResponse)
+	viewerID, _ := pkgContext.GetUserID(ctx)
+
+	collections, err := h.useCase.GetCollections(ctx, viewerID, req.UserId)
+	if err != nil {
+		return
+	}
+	r.CollectionList = pack.Collections(collections)
+	r.Base = base.BuildBaseResp(err)
+	return
+}
+
+func (h *InteractionHandler) AddToCollection(ctx context.Context, req *interaction.AddToCollectionRequest) (r *interaction.AddToCollectionResponse, err error) {
+	r = new(interaction.AddToCollectionResponse)
+	userID, err := pkgContext.GetUserID(ctx)
+	if err != nil {
+		return
+	}
+	collectionID, err := h.useCase.AddToCollection(ctx, userID, req.VideoId, req.GetCollectionId())
+	if err != nil {
+		return
+	}
+	r.CollectionId = collectionID
+	r.Base = base.BuildBaseResp(err)
+	return
+}
+
+func (h *InteractionHandler) RemoveFromCollection(ctx context.Context, req *interaction.RemoveFromCollectionRequest) (r *interaction.RemoveFromCollectionResponse, err error) {
+	r = new(interaction.RemoveFromCollectionResponse)
+	userID, err := pkgContext.GetUserID(ctx)
+	if err != nil {
+		return
+	}
+	if err = h.useCase.RemoveFromCollection(ctx, userID, req.VideoId, req.CollectionId); err != nil {
+		return
+	}
+	r.Base = base.BuildBaseResp(err)
+	return
+}
+
+func (h *InteractionHandler) GetCollectionVideos(ctx context.Context, req *interaction.GetCollectionVideosRequest) (r *interaction.GetCollectionVideosResponse, err error) {
+	r = new(interaction.GetCollectionVideosResponse)
+	viewerID, _ := pkgContext.GetUserID(ctx)
+
+	videoIDs, total, err := h.useCase.GetCollectionVideos(ctx, viewerID, req.CollectionId, req.Page, req.Size)
+	if err != nil {
+		return
+	}
+	r.VideoIDs = videoIDs
+	r.Total = total
+	r.Base = base.BuildBaseResp(err)
+	return
+}
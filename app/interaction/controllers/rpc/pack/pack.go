@@ -1,3 +1,119 @@
 package pack
 
-// 无
+import (
+	"github.com/yxrxy/videoHub/app/interaction/domain/model"
+	rpcmodel "github.com/yxrxy/videoHub/kitex_gen/model"
+)
+
+func CommentInfos(c []*model.Comment) []*rpcmodel.CommentInfo {
+	rpcComments := make([]*rpcmodel.CommentInfo, 0, len(c))
+	for _, comment := range c {
+		rpcComments = append(rpcComments, CommentInfo(comment))
+	}
+	return rpcComments
+}
+
+func CommentInfo(c *model.Comment) *rpcmodel.CommentInfo {
+	isEdited := c.EditedAt != 0
+	info := &rpcmodel.CommentInfo{
+		Id:        c.ID,
+		UserId:    c.UserID,
+		VideoId:   c.VideoID,
+		Content:   c.Content,
+		ParentId:  c.ParentID,
+		CreatedAt: c.CreatedAt,
+		LikeCount: &c.LikeCount,
+		IsEdited:  &isEdited,
+		IsPinned:  &c.IsPinned,
+		IsHearted: &c.IsHearted,
+	}
+	if isEdited {
+		info.EditedAt = &c.EditedAt
+	}
+	return info
+}
+
+func CommentEdits(e []*model.CommentEdit) []*rpcmodel.CommentEdit {
+	rpcEdits := make([]*rpcmodel.CommentEdit, 0, len(e))
+	for _, edit := range e {
+		rpcEdits = append(rpcEdits, &rpcmodel.CommentEdit{
+			Id:        edit.ID,
+			CommentId: edit.CommentID,
+			Content:   edit.Content,
+			EditedAt:  edit.EditedAt,
+		})
+	}
+	return rpcEdits
+}
+
+// ReactionCounts 按表情集合的顺序输出计数，不在集合中的表情排在最后
+func ReactionCounts(counts map[string]int64, order []string) []*rpcmodel.ReactionCount {
+	rpcCounts := make([]*rpcmodel.ReactionCount, 0, len(counts))
+	seen := make(map[string]bool, len(order))
+	for _, reaction := range order {
+		seen[reaction] = true
+		if n := counts[reaction]; n > 0 {
+			rpcCounts = append(rpcCounts, &rpcmodel.ReactionCount{Reaction: reaction, Count: n})
+		}
+	}
+	for reaction, n := range counts {
+		if !seen[reaction] && n > 0 {
+			rpcCounts = append(rpcCounts, &rpcmodel.ReactionCount{Reaction: reaction, Count: n})
+		}
+	}
+	return rpcCounts
+}
+
+func ReactionInfos(r []*model.Reaction) []*rpcmodel.ReactionInfo {
+	rpcReactions := make([]*rpcmodel.ReactionInfo, 0, len(r))
+	for _, reaction := range r {
+		rpcReactions = append(rpcReactions, &rpcmodel.ReactionInfo{
+			UserId:    reaction.UserID,
+			Reaction:  reaction.Reaction,
+			CreatedAt: reaction.CreatedAt,
+		})
+	}
+	return rpcReactions
+}
+
+func VideoInteractionStates(states []*model.VideoInteractionState, reactionOrder []string) []*rpcmodel.VideoInteractionState {
+	rpcStates := make([]*rpcmodel.VideoInteractionState, 0, len(states))
+	for _, state := range states {
+		rpcState := &rpcmodel.VideoInteractionState{
+			VideoId:      state.VideoID,
+			IsLiked:      state.IsLiked,
+			LikeCount:    state.LikeCount,
+			CommentCount: state.CommentCount,
+			Reactions:    ReactionCounts(state.Reactions, reactionOrder),
+		}
+		if state.MyReaction != "" {
+			rpcState.MyReaction = &state.MyReaction
+		}
+		rpcState.CollectCount = &state.CollectCount
+		rpcState.IsCollected = &state.IsCollected
+		rpcStates = append(rpcStates, rpcState)
+	}
+	return rpcStates
+}
+
+func Collection(c *model.Collection) *rpcmodel.Collection {
+	return &rpcmodel.Collection{
+		Id:         c.ID,
+		UserId:     c.UserID,
+		Name:       c.Name,
+		IsPrivate:  c.IsPrivate,
+		IsDefault:  c.IsDefault,
+		SortOrder:  c.SortOrder,
+		VideoCount: c.VideoCount,
+		CreatedAt:  c.CreatedAt,
+		UpdatedAt:  c.UpdatedAt,
+	}
+}
+
+func Collections(c []*model.Collection) []*rpcmodel.Collection {
+	rpcCollections := make([]*rpcmodel.Collection, 0, len(c))
+	for _, collection := range c {
+		rpcCollections = append(rpcCollections, Collection(collection))
+	}
+	return rpcCollections
+}
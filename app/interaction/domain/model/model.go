@@ -8,12 +8,26 @@ type Like struct {
 
 // Comment 评论模型
 type Comment struct {
+	ID               int64
+	UserID           int64
+	VideoID          int64
+	Content          string
+	ParentID         *int64
+	LikeCount        int32
+	Status           int8
+	ModerationReason string
+	CreatedAt        int64
+	EditedAt         int64 // 0 表示未编辑
+	IsPinned         bool
+	IsHearted        bool
+}
+
+// CommentEdit 评论编辑记录，保存编辑前的内容
+type CommentEdit struct {
 	ID        int64
-	UserID    int64
-	VideoID   int64
+	CommentID int64
 	Content   string
-	ParentID  *int64
-	LikeCount int32
+	EditedAt  int64
 }
 
 // CommentLike 评论点赞模型
@@ -21,3 +35,101 @@ type CommentLike struct {
 	UserID    int64
 	CommentID int64
 }
+
+// 评论审核状态
+const (
+	CommentStatusVisible  int8 = 0 // 正常可见
+	CommentStatusPending  int8 = 1 // 待人工审核，仅作者可见
+	CommentStatusRejected int8 = 2 // 审核拒绝，仅作者可见
+)
+
+// 审核结论，数值越大越严格
+const (
+	ModerationPass   int8 = 0 // 通过
+	ModerationReview int8 = 1 // 需要人工审核
+	ModerationReject int8 = 2 // 直接拒绝
+)
+
+// 人工审核操作
+const (
+	ReviewActionApprove int8 = 1 // 审核通过
+	ReviewActionReject  int8 = 2 // 审核拒绝
+)
+
+// ModerationResult 单个过滤器的审核结果
+type ModerationResult struct {
+	Verdict int8
+	Filter  string
+	Reason  string
+}
+
+// CommentStatus 将审核结论转换为评论状态
+func (r *ModerationResult) CommentStatus() int8 {
+	switch r.Verdict {
+	case ModerationReject:
+		return CommentStatusRejected
+	case ModerationReview:
+		return CommentStatusPending
+	default:
+		return CommentStatusVisible
+	}
+}
+
+// 表情回应目标类型
+const (
+	ReactionTargetVideo   int8 = 1
+	ReactionTargetComment int8 = 2
+)
+
+// Reaction 表情回应，每个用户对同一目标只保留一个表情
+type Reaction struct {
+	ID         int64
+	UserID     int64
+	TargetType int8
+	TargetID   int64
+	Reaction   string
+	CreatedAt  int64
+}
+
+// ReactionTarget 表情回应目标
+type ReactionTarget struct {
+	Type int8
+	ID   int64
+}
+
+// ReactionSummary 目标的表情统计以及当前用户的表情
+type ReactionSummary struct {
+	Counts     map[string]int64
+	MyReaction string
+}
+
+// VideoInteractionState 视频对当前用户的互动状态
+type VideoInteractionState struct {
+	VideoID      int64
+	IsLiked      bool
+	LikeCount    int64
+	CommentCount int64
+	Reactions    map[string]int64
+	MyReaction   string
+	CollectCount int64
+	IsCollected  bool
+}
+
+// Collection 收藏夹，每个用户有一个不可删除的默认收藏夹"稍后再看"
+type Collection struct {
+	ID         int64
+	UserID     int64
+	Name       string
+	IsPrivate  bool
+	IsDefault  bool
+	SortOrder  int32
+	VideoCount int64
+	CreatedAt  int64
+	UpdatedAt  int64
+}
+
+// CollectionUpdate 收藏夹修改项，nil 表示不修改
+type CollectionUpdate struct {
+	Name      *string
+	IsPrivate *bool
+}
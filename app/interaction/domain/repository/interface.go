@@ -4,20 +4,96 @@ import (
 	"context"
 
 	"github.com/yxrxy/videoHub/app/interaction/domain/model"
+	socialmodel "github.com/yxrxy/videoHub/app/social/domain/model"
 )
 
 type InteractionRepository interface {
+	CollectionDB
 	GetLikeList(ctx context.Context, videoID int64, offset, limit int) ([]*model.Like, error)
 	CreateLike(ctx context.Context, userID, videoID int64) error
 	GetLike(ctx context.Context, userID, videoID int64) (*model.Like, error)
 	DeleteLike(ctx context.Context, like *model.Like) error
 	IsVideoExist(ctx context.Context, videoID int64) (bool, error)
-	CreateComment(ctx context.Context, userID, videoID int64, content string, parentID *int64) error
+	CreateComment(ctx context.Context, comment *model.Comment) (int64, error)
 	GetComment(ctx context.Context, commentID int64) (*model.Comment, error)
-	GetCommentList(ctx context.Context, videoID int64, offset, limit int) ([]*model.Comment, int64, error)
+	GetCommentList(ctx context.Context, videoID, viewerID int64, offset, limit int) ([]*model.Comment, int64, error)
+	GetCommentsByStatus(ctx context.Context, status int8, offset, limit int) ([]*model.Comment, int64, error)
+	UpdateCommentStatus(ctx context.Context, commentID int64, status int8, reason string, reviewerID int64) error
+	EditComment(ctx context.Context, comment *model.Comment, content string, status int8, reason string) error
+	GetCommentEdits(ctx context.Context, commentID int64) ([]*model.CommentEdit, error)
+	SetCommentPinned(ctx context.Context, videoID, commentID int64, pinned bool) error
+	SetCommentHearted(ctx context.Context, commentID int64, hearted bool) error
+	GetVideoAuthorID(ctx context.Context, videoID int64) (int64, error)
 	DeleteComment(ctx context.Context, comment *model.Comment) error
 	LikeComment(ctx context.Context, userID, commentID int64) error
 	UnlikeComment(ctx context.Context, userID, commentID int64) error
 	IsCommentLiked(ctx context.Context, userID, commentID int64) (bool, error)
 	GetCommentLikeCount(ctx context.Context, commentID int64) (int64, error)
+	GetReaction(ctx context.Context, userID int64, targetType int8, targetID int64) (*model.Reaction, error)
+	UpsertReaction(ctx context.Context, reaction *model.Reaction) error
+	DeleteReaction(ctx context.Context, userID int64, targetType int8, targetID int64) error
+	GetReactionCounts(ctx context.Context, targetType int8, targetID int64) (map[string]int64, error)
+	GetReactionList(ctx context.Context, targetType int8, targetID int64, reaction string, offset, limit int) ([]*model.Reaction, int64, error)
+	GetUserReactions(ctx context.Context, userID int64, targetType int8, targetIDs []int64) (map[int64]string, error)
+	GetUserLikedVideos(ctx context.Context, userID int64, videoIDs []int64) (map[int64]bool, error)
+	GetUserLikedVideoIDs(ctx context.Context, userID int64) ([]int64, error)
+	CountVideoLikes(ctx context.Context, videoIDs []int64) (map[int64]int64, error)
+	CountVisibleComments(ctx context.Context, videoIDs []int64) (map[int64]int64, error)
+}
+
+// CollectionDB 收藏夹存储，视频服务删除视频时也通过它清理收藏记录
+type CollectionDB interface {
+	CreateCollection(ctx context.Context, collection *model.Collection) (int64, error)
+	GetCollection(ctx context.Context, collectionID int64) (*model.Collection, error)
+	GetDefaultCollection(ctx context.Context, userID int64) (*model.Collection, error)
+	GetUserCollections(ctx context.Context, userID int64, includePrivate bool) ([]*model.Collection, error)
+	CountUserCollections(ctx context.Context, userID int64) (int64, error)
+	UpdateCollection(ctx context.Context, collectionID int64, update *model.CollectionUpdate) error
+	DeleteCollection(ctx context.Context, collectionID int64) error
+	ReorderCollections(ctx context.Context, userID int64, collectionIDs []int64) error
+	AddCollectionVideo(ctx context.Context, collectionID, videoID int64) error
+	RemoveCollectionVideo(ctx context.Context, collectionID, videoID int64) error
+	GetCollectionVideos(ctx context.Context, collectionID int64, offset, limit int) ([]int64, int64, error)
+	RemoveVideoFromCollections(ctx context.Context, videoID int64) error
+	GetVideoCollectCounts(ctx context.Context, videoIDs []int64) (map[int64]int64, error)
+	GetUserCollectedVideos(ctx context.Context, userID int64, videoIDs []int64) (map[int64]bool, error)
+}
+
+type InteractionCache interface {
+	GetReactionCounts(ctx context.Context, targetType int8, targetID int64) (map[string]int64, bool, error)
+	SetReactionCounts(ctx context.Context, targetType int8, targetID int64, counts map[string]int64) error
+	IncrReactionCount(ctx context.Context, targetType int8, targetID int64, reaction string, delta int64) error
+	MarkReactionDirty(ctx context.Context, targetType int8, targetID int64) error
+	PopDirtyReactions(ctx context.Context, count int64) ([]*model.ReactionTarget, error)
+	BatchGetReactionCounts(ctx context.Context, targetType int8, targetIDs []int64) (map[int64]map[string]int64, error)
+	GetLikeCounts(ctx context.Context, videoIDs []int64) (map[int64]int64, error)
+	SetLikeCount(ctx context.Context, videoID int64, count int64) error
+	IncrLikeCount(ctx context.Context, videoID int64, delta int64) error
+	GetLikedVideos(ctx context.Context, userID int64, videoIDs []int64) (liked map[int64]bool, cached bool, err error)
+	SetLikedVideos(ctx context.Context, userID int64, videoIDs []int64) error
+	UpdateLikedVideo(ctx context.Context, userID, videoID int64, liked bool) error
+	GetCommentCounts(ctx context.Context, videoIDs []int64) (map[int64]int64, error)
+	SetCommentCount(ctx context.Context, videoID int64, count int64) error
+	DeleteCommentCount(ctx context.Context, videoID int64) error
+}
+
+// TextFilter 评论审核过滤器，多个过滤器按顺序组成审核链
+type TextFilter interface {
+	Name() string
+	Check(ctx context.Context, content string) (*model.ModerationResult, error)
+}
+
+// PrivacyChecker 拉黑与隐私设置校验，由用户服务的 PrivacyGuard 实现
+type PrivacyChecker interface {
+	// CheckInteract 双方存在拉黑关系时返回错误
+	CheckInteract(ctx context.Context, actorID, targetID int64) error
+	// CanViewCollections 判断 viewerID 能否看到 ownerID 的收藏
+	CanViewCollections(ctx context.Context, viewerID, ownerID int64) (bool, error)
+	// FilterLikesVisible 返回 userIDs 中点赞对 viewerID 可见的用户
+	FilterLikesVisible(ctx context.Context, viewerID int64, userIDs []int64) (map[int64]bool, error)
+}
+
+// NotificationPublisher 通知事件投递，由社交服务的通知消息队列实现
+type NotificationPublisher interface {
+	SendNotificationEvent(ctx context.Context, event *socialmodel.NotificationEvent) error
 }
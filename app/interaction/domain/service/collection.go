@@ -0,0 +1,226 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/yxrxy/videoHub/app/interaction/domain/model"
+	"github.com/yxrxy/videoHub/pkg/constants"
+	"github.com/yxrxy/videoHub/pkg/errno"
+	"gorm.io/gorm"
+)
+
+// CreateCollection 创建收藏夹，新收藏夹排在末尾
+func (s *InteractionService) CreateCollection(ctx context.Context, userID int64, name string, isPrivate bool) (*model.Collection, error) {
+	name, err := checkCollectionName(name)
+	if err != nil {
+		return nil, err
+	}
+	count, err := s.db.CountUserCollections(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if count >= constants.MaxCollectionsPerUser {
+		return nil, errno.ParamVerifyError.WithMessage("too many collections")
+	}
+	id, err := s.db.CreateCollection(ctx, &model.Collection{
+		UserID:    userID,
+		Name:      name,
+		IsPrivate: isPrivate,
+		SortOrder: int32(count),
+	})
+	if err != nil {
+		return nil, collectionNameError(err)
+	}
+	return s.db.GetCollection(ctx, id)
+}
+
+// UpdateCollection 修改收藏夹名称或可见性，默认收藏夹不能改名
+func (s *InteractionService) UpdateCollection(ctx context.Context, userID int64, collectionID int64, update *model.CollectionUpdate) error {
+	collection, err := s.getOwnCollection(ctx, userID, collectionID)
+	if err != nil {
+		return err
+	}
+	if update.Name != nil {
+		if collection.IsDefault {
+			return errno.ParamVerifyError.WithMessage("default collection cannot be renamed")
+		}
+		name, err := checkCollectionName(*update.Name)
+		if err != nil {
+			return err
+		}
+		update.Name = &name
+	}
+	return collectionNameError(s.db.UpdateCollection(ctx, collectionID, update))
+}
+
+// DeleteCollection 删除收藏夹，默认收藏夹不能删除
+func (s *InteractionService) DeleteCollection(ctx context.Context, userID int64, collectionID int64) error {
+	collection, err := s.getOwnCollection(ctx, userID, collectionID)
+	if err != nil {
+		return err
+	}
+	if collection.IsDefault {
+		return errno.ParamVerifyError.WithMessage("default collection cannot be deleted")
+	}
+	return s.db.DeleteCollection(ctx, collectionID)
+}
+
+// ReorderCollections 调整收藏夹顺序，collectionIDs 必须恰好包含用户的全部收藏夹
+func (s *InteractionService) ReorderCollections(ctx context.Context, userID int64, collectionIDs []int64) error {
+	collections, err := s.db.GetUserCollections(ctx, userID, true)
+	if err != nil {
+		return err
+	}
+	if len(collectionIDs) != len(collections) {
+		return errno.ParamVerifyError.WithMessage("collection ids do not match")
+	}
+	owned := make(map[int64]bool, len(collections))
+	for _, c := range collections {
+		owned[c.ID] = true
+	}
+	for _, id := range collectionIDs {
+		if !owned[id] {
+			return errno.ParamVerifyError.WithMessage("collection ids do not match")
+		}
+		// 防止重复ID
+		delete(owned, id)
+	}
+	return s.db.ReorderCollections(ctx, userID, collectionIDs)
+}
+
+// GetCollections 获取用户的收藏夹列表，非本人查看时不返回私密收藏夹
+func (s *InteractionService) GetCollections(ctx context.Context, viewerID int64, ownerID int64) ([]*model.Collection, error) {
+	isOwner := viewerID != 0 && viewerID == ownerID
+	if isOwner {
+		if _, err := s.ensureDefaultCollection(ctx, ownerID); err != nil {
+			return nil, err
+		}
+	} else if err := s.checkCollectionsVisible(ctx, viewerID, ownerID); err != nil {
+		return nil, err
+	}
+	return s.db.GetUserCollections(ctx, ownerID, isOwner)
+}
+
+// AddToCollection 收藏视频，collectionID 为 0 时加入"稍后再看"，返回实际使用的收藏夹ID
+func (s *InteractionService) AddToCollection(ctx context.Context, userID int64, videoID int64, collectionID int64) (int64, error) {
+	exist, err := s.db.IsVideoExist(ctx, videoID)
+	if err != nil {
+		return 0, err
+	}
+	if !exist {
+		return 0, errors.New("video not found")
+	}
+
+	var collection *model.Collection
+	if collectionID == 0 {
+		collection, err = s.ensureDefaultCollection(ctx, userID)
+	} else {
+		collection, err = s.getOwnCollection(ctx, userID, collectionID)
+	}
+	if err != nil {
+		return 0, err
+	}
+	if err := s.db.AddCollectionVideo(ctx, collection.ID, videoID); err != nil {
+		return 0, err
+	}
+	return collection.ID, nil
+}
+
+// RemoveFromCollection 从收藏夹移除视频
+func (s *InteractionService) RemoveFromCollection(ctx context.Context, userID int64, videoID int64, collectionID int64) error {
+	if _, err := s.getOwnCollection(ctx, userID, collectionID); err != nil {
+		return err
+	}
+	return s.db.RemoveCollectionVideo(ctx, collectionID, videoID)
+}
+
+// GetCollectionVideos 获取收藏夹中的视频ID，私密收藏夹仅本人可见
+func (s *InteractionService) GetCollectionVideos(
+	ctx context.Context, viewerID int64, collectionID int64, page, size int32,
+) ([]int64, int64, error) {
+	collection, err := s.db.GetCollection(ctx, collectionID)
+	if err != nil {
+		return nil, 0, err
+	}
+	if collection.UserID != viewerID {
+		if collection.IsPrivate {
+			return nil, 0, errno.AuthNoOperatePermission
+		}
+		if err := s.checkCollectionsVisible(ctx, viewerID, collection.UserID); err != nil {
+			return nil, 0, err
+		}
+	}
+	offset := (page - 1) * size
+	return s.db.GetCollectionVideos(ctx, collectionID, int(offset), int(size))
+}
+
+// checkCollectionsVisible 按收藏者的隐私设置校验 viewerID 能否查看其收藏
+func (s *InteractionService) checkCollectionsVisible(ctx context.Context, viewerID, ownerID int64) error {
+	visible, err := s.privacy.CanViewCollections(ctx, viewerID, ownerID)
+	if err != nil {
+		return err
+	}
+	if !visible {
+		return errno.AuthNoOperatePermission.WithMessage("collections are not visible")
+	}
+	return nil
+}
+
+func (s *InteractionService) getOwnCollection(ctx context.Context, userID int64, collectionID int64) (*model.Collection, error) {
+	collection, err := s.db.GetCollection(ctx, collectionID)
+	if err != nil {
+		return nil, err
+	}
+	if collection.UserID != userID {
+		return nil, errno.AuthNoOperatePermission
+	}
+	return collection, nil
+}
+
+// ensureDefaultCollection 获取用户的"稍后再看"，不存在时创建
+func (s *InteractionService) ensureDefaultCollection(ctx context.Context, userID int64) (*model.Collection, error) {
+	collection, err := s.db.GetDefaultCollection(ctx, userID)
+	if err == nil {
+		return collection, nil
+	}
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, err
+	}
+	count, err := s.db.CountUserCollections(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	_, err = s.db.CreateCollection(ctx, &model.Collection{
+		UserID:    userID,
+		Name:      constants.DefaultCollectionName,
+		IsDefault: true,
+		SortOrder: int32(count),
+	})
+	// 并发创建时唯一索引冲突，重新读取即可
+	if err != nil && !errors.Is(err, gorm.ErrDuplicatedKey) {
+		return nil, err
+	}
+	return s.db.GetDefaultCollection(ctx, userID)
+}
+
+// checkCollectionName 校验收藏夹名称，默认收藏夹的名称保留
+func checkCollectionName(name string) (string, error) {
+	name = strings.TrimSpace(name)
+	if name == "" || utf8.RuneCountInString(name) > constants.MaxCollectionNameLength {
+		return "", errno.ParamVerifyError.WithMessage("invalid collection name")
+	}
+	if name == constants.DefaultCollectionName {
+		return "", errno.ParamVerifyError.WithMessage("collection name is reserved")
+	}
+	return name, nil
+}
+
+func collectionNameError(err error) error {
+	if errors.Is(err, gorm.ErrDuplicatedKey) {
+		return errno.ParamVerifyError.WithMessage("collection name already exists")
+	}
+	return err
+}
@@ -0,0 +1,139 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/smartystreets/goconvey/convey"
+	"github.com/stretchr/testify/mock"
+	"github.com/yxrxy/videoHub/app/interaction/domain/model"
+	"github.com/yxrxy/videoHub/pkg/constants"
+	"gorm.io/gorm"
+)
+
+func TestInteractionService_AddToCollection(t *testing.T) {
+	type TestCase struct {
+		Name         string
+		CollectionID int64
+		// Mock 数据库相关
+		MockDefault    *model.Collection
+		MockCollection *model.Collection
+		// 预期结果
+		ExpectedError      bool
+		ExpectedCollection int64
+		ExpectedCreate     bool
+	}
+
+	const userID, videoID = int64(1), int64(100)
+	testCases := []TestCase{
+		{
+			Name:               "默认收藏夹已存在",
+			MockDefault:        &model.Collection{ID: 5, UserID: userID, IsDefault: true},
+			ExpectedCollection: 5,
+		},
+		{
+			Name:               "首次收藏时创建稍后再看",
+			ExpectedCollection: 6,
+			ExpectedCreate:     true,
+		},
+		{
+			Name:               "加入指定收藏夹",
+			CollectionID:       7,
+			MockCollection:     &model.Collection{ID: 7, UserID: userID},
+			ExpectedCollection: 7,
+		},
+		{
+			Name:           "不能加入他人的收藏夹",
+			CollectionID:   8,
+			MockCollection: &model.Collection{ID: 8, UserID: 2},
+			ExpectedError:  true,
+		},
+	}
+
+	for _, tc := range testCases {
+		convey.Convey(tc.Name, t, func() {
+			ctx := context.Background()
+			db := new(MockRepository)
+			cache := new(MockCache)
+			db.On("IsVideoExist", ctx, videoID).Return(true, nil)
+			db.On("GetCollection", ctx, tc.CollectionID).Return(tc.MockCollection, nil)
+			if tc.MockDefault != nil {
+				db.On("GetDefaultCollection", ctx, userID).Return(tc.MockDefault, nil)
+			} else {
+				db.On("GetDefaultCollection", ctx, userID).Return(nil, gorm.ErrRecordNotFound).Once()
+				db.On("GetDefaultCollection", ctx, userID).Return(&model.Collection{ID: 6, UserID: userID, IsDefault: true}, nil)
+			}
+			db.On("CountUserCollections", ctx, userID).Return(int64(2), nil)
+			db.On("CreateCollection", ctx, mock.Anything).Return(int64(6), nil)
+			db.On("AddCollectionVideo", ctx, mock.Anything, videoID).Return(nil)
+
+			svc := NewInteractionService(db, cache, new(MockPrivacy), new(MockNotifier))
+			collectionID, err := svc.AddToCollection(ctx, userID, videoID, tc.CollectionID)
+
+			if tc.ExpectedError {
+				convey.So(err, convey.ShouldNotBeNil)
+				db.AssertNotCalled(t, "AddCollectionVideo", ctx, mock.Anything, videoID)
+				return
+			}
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(collectionID, convey.ShouldEqual, tc.ExpectedCollection)
+			db.AssertCalled(t, "AddCollectionVideo", ctx, tc.ExpectedCollection, videoID)
+			if tc.ExpectedCreate {
+				db.AssertCalled(t, "CreateCollection", ctx, &model.Collection{
+					UserID:    userID,
+					Name:      constants.DefaultCollectionName,
+					IsDefault: true,
+					SortOrder: 2,
+				})
+			} else {
+				db.AssertNotCalled(t, "CreateCollection", ctx, mock.Anything)
+			}
+		})
+	}
+}
+
+func TestInteractionService_DeleteCollection(t *testing.T) {
+	type TestCase struct {
+		Name           string
+		MockCollection *model.Collection
+		ExpectedError  bool
+	}
+
+	const userID, collectionID = int64(1), int64(10)
+	testCases := []TestCase{
+		{
+			Name:           "删除自己的收藏夹",
+			MockCollection: &model.Collection{ID: collectionID, UserID: userID},
+		},
+		{
+			Name:           "默认收藏夹不能删除",
+			MockCollection: &model.Collection{ID: collectionID, UserID: userID, IsDefault: true},
+			ExpectedError:  true,
+		},
+		{
+			Name:           "不能删除他人的收藏夹",
+			MockCollection: &model.Collection{ID: collectionID, UserID: 2},
+			ExpectedError:  true,
+		},
+	}
+
+	for _, tc := range testCases {
+		convey.Convey(tc.Name, t, func() {
+			ctx := context.Background()
+			db := new(MockRepository)
+			db.On("GetCollection", ctx, collectionID).Return(tc.MockCollection, nil)
+			db.On("DeleteCollection", ctx, collectionID).Return(nil)
+
+			svc := NewInteractionService(db, new(MockCache), new(MockPrivacy), new(MockNotifier))
+			err := svc.DeleteCollection(ctx, userID, collectionID)
+
+			if tc.ExpectedError {
+				convey.So(err, convey.ShouldNotBeNil)
+				db.AssertNotCalled(t, "DeleteCollection", ctx, collectionID)
+			} else {
+				convey.So(err, convey.ShouldBeNil)
+				db.AssertCalled(t, "DeleteCollection", ctx, collectionID)
+			}
+		})
+	}
+}
@@ -3,9 +3,22 @@ package service
 import "github.com/yxrxy/videoHub/app/interaction/domain/repository"
 
 type InteractionService struct {
-	db repository.InteractionRepository
+	db       repository.InteractionRepository
+	cache    repository.InteractionCache
+	privacy  repository.PrivacyChecker        // 拉黑与隐私设置校验
+	notifier repository.NotificationPublisher // 点赞、评论和回复的通知事件投递
+	filters  []repository.TextFilter          // 评论审核过滤链，按顺序执行
 }
 
-func NewInteractionService(db repository.InteractionRepository) *InteractionService {
-	return &InteractionService{db: db}
+func NewInteractionService(
+	db repository.InteractionRepository,
+	cache repository.InteractionCache,
+	privacy repository.PrivacyChecker,
+	notifier repository.NotificationPublisher,
+	filters ...repository.TextFilter,
+) *InteractionService {
+	if db == nil || cache == nil || privacy == nil || notifier == nil {
+		panic("interactionService`s db or cache or privacy or notifier should not be nil")
+	}
+	return &InteractionService{db: db, cache: cache, privacy: privacy, notifier: notifier, filters: filters}
 }
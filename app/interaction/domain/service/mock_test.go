@@ -0,0 +1,259 @@
+package service
+
+import (
+	"context"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/yxrxy/videoHub/app/interaction/domain/model"
+	"github.com/yxrxy/videoHub/app/interaction/domain/repository"
+	socialmodel "github.com/yxrxy/videoHub/app/social/domain/model"
+)
+
+// MockRepository 只实现测试用到的方法，其余方法调用时会 panic
+type MockRepository struct {
+	repository.InteractionRepository
+	mock.Mock
+}
+
+func (m *MockRepository) IsVideoExist(ctx context.Context, videoID int64) (bool, error) {
+	args := m.Called(ctx, videoID)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockRepository) GetReaction(ctx context.Context, userID int64, targetType int8, targetID int64) (*model.Reaction, error) {
+	args := m.Called(ctx, userID, targetType, targetID)
+	result, _ := args.Get(0).(*model.Reaction)
+	return result, args.Error(1)
+}
+
+func (m *MockRepository) UpsertReaction(ctx context.Context, reaction *model.Reaction) error {
+	args := m.Called(ctx, reaction)
+	return args.Error(0)
+}
+
+func (m *MockRepository) DeleteReaction(ctx context.Context, userID int64, targetType int8, targetID int64) error {
+	args := m.Called(ctx, userID, targetType, targetID)
+	return args.Error(0)
+}
+
+type MockCache struct {
+	repository.InteractionCache
+	mock.Mock
+}
+
+func (m *MockCache) GetReactionCounts(ctx context.Context, targetType int8, targetID int64) (map[string]int64, bool, error) {
+	args := m.Called(ctx, targetType, targetID)
+	result, _ := args.Get(0).(map[string]int64)
+	return result, args.Bool(1), args.Error(2)
+}
+
+func (m *MockCache) IncrReactionCount(ctx context.Context, targetType int8, targetID int64, reaction string, delta int64) error {
+	args := m.Called(ctx, targetType, targetID, reaction, delta)
+	return args.Error(0)
+}
+
+func (m *MockCache) MarkReactionDirty(ctx context.Context, targetType int8, targetID int64) error {
+	args := m.Called(ctx, targetType, targetID)
+	return args.Error(0)
+}
+
+func (m *MockRepository) GetUserReactions(ctx context.Context, userID int64, targetType int8, targetIDs []int64) (map[int64]string, error) {
+	args := m.Called(ctx, userID, targetType, targetIDs)
+	result, _ := args.Get(0).(map[int64]string)
+	return result, args.Error(1)
+}
+
+func (m *MockRepository) GetUserLikedVideos(ctx context.Context, userID int64, videoIDs []int64) (map[int64]bool, error) {
+	args := m.Called(ctx, userID, videoIDs)
+	result, _ := args.Get(0).(map[int64]bool)
+	return result, args.Error(1)
+}
+
+func (m *MockRepository) GetUserLikedVideoIDs(ctx context.Context, userID int64) ([]int64, error) {
+	args := m.Called(ctx, userID)
+	result, _ := args.Get(0).([]int64)
+	return result, args.Error(1)
+}
+
+func (m *MockRepository) CountVideoLikes(ctx context.Context, videoIDs []int64) (map[int64]int64, error) {
+	args := m.Called(ctx, videoIDs)
+	result, _ := args.Get(0).(map[int64]int64)
+	return result, args.Error(1)
+}
+
+func (m *MockRepository) CountVisibleComments(ctx context.Context, videoIDs []int64) (map[int64]int64, error) {
+	args := m.Called(ctx, videoIDs)
+	result, _ := args.Get(0).(map[int64]int64)
+	return result, args.Error(1)
+}
+
+func (m *MockCache) BatchGetReactionCounts(ctx context.Context, targetType int8, targetIDs []int64) (map[int64]map[string]int64, error) {
+	args := m.Called(ctx, targetType, targetIDs)
+	result, _ := args.Get(0).(map[int64]map[string]int64)
+	return result, args.Error(1)
+}
+
+func (m *MockCache) GetLikeCounts(ctx context.Context, videoIDs []int64) (map[int64]int64, error) {
+	args := m.Called(ctx, videoIDs)
+	result, _ := args.Get(0).(map[int64]int64)
+	return result, args.Error(1)
+}
+
+func (m *MockCache) GetLikedVideos(ctx context.Context, userID int64, videoIDs []int64) (map[int64]bool, bool, error) {
+	args := m.Called(ctx, userID, videoIDs)
+	result, _ := args.Get(0).(map[int64]bool)
+	return result, args.Bool(1), args.Error(2)
+}
+
+func (m *MockCache) SetLikedVideos(ctx context.Context, userID int64, videoIDs []int64) error {
+	args := m.Called(ctx, userID, videoIDs)
+	return args.Error(0)
+}
+
+func (m *MockCache) UpdateLikedVideo(ctx context.Context, userID, videoID int64, liked bool) error {
+	args := m.Called(ctx, userID, videoID, liked)
+	return args.Error(0)
+}
+
+func (m *MockCache) SetLikeCount(ctx context.Context, videoID int64, count int64) error {
+	args := m.Called(ctx, videoID, count)
+	return args.Error(0)
+}
+
+func (m *MockCache) GetCommentCounts(ctx context.Context, videoIDs []int64) (map[int64]int64, error) {
+	args := m.Called(ctx, videoIDs)
+	result, _ := args.Get(0).(map[int64]int64)
+	return result, args.Error(1)
+}
+
+func (m *MockCache) SetCommentCount(ctx context.Context, videoID int64, count int64) error {
+	args := m.Called(ctx, videoID, count)
+	return args.Error(0)
+}
+
+func (m *MockRepository) GetVideoCollectCounts(ctx context.Context, videoIDs []int64) (map[int64]int64, error) {
+	args := m.Called(ctx, videoIDs)
+	result, _ := args.Get(0).(map[int64]int64)
+	return result, args.Error(1)
+}
+
+func (m *MockRepository) GetUserCollectedVideos(ctx context.Context, userID int64, videoIDs []int64) (map[int64]bool, error) {
+	args := m.Called(ctx, userID, videoIDs)
+	result, _ := args.Get(0).(map[int64]bool)
+	return result, args.Error(1)
+}
+
+func (m *MockRepository) CreateCollection(ctx context.Context, collection *model.Collection) (int64, error) {
+	args := m.Called(ctx, collection)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockRepository) GetCollection(ctx context.Context, collectionID int64) (*model.Collection, error) {
+	args := m.Called(ctx, collectionID)
+	result, _ := args.Get(0).(*model.Collection)
+	return result, args.Error(1)
+}
+
+func (m *MockRepository) GetDefaultCollection(ctx context.Context, userID int64) (*model.Collection, error) {
+	args := m.Called(ctx, userID)
+	result, _ := args.Get(0).(*model.Collection)
+	return result, args.Error(1)
+}
+
+func (m *MockRepository) CountUserCollections(ctx context.Context, userID int64) (int64, error) {
+	args := m.Called(ctx, userID)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockRepository) DeleteCollection(ctx context.Context, collectionID int64) error {
+	args := m.Called(ctx, collectionID)
+	return args.Error(0)
+}
+
+func (m *MockRepository) AddCollectionVideo(ctx context.Context, collectionID, videoID int64) error {
+	args := m.Called(ctx, collectionID, videoID)
+	return args.Error(0)
+}
+
+func (m *MockRepository) GetVideoAuthorID(ctx context.Context, videoID int64) (int64, error) {
+	args := m.Called(ctx, videoID)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockRepository) CreateComment(ctx context.Context, comment *model.Comment) (int64, error) {
+	args := m.Called(ctx, comment)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockRepository) GetLikeList(ctx context.Context, videoID int64, offset, limit int) ([]*model.Like, error) {
+	args := m.Called(ctx, videoID, offset, limit)
+	result, _ := args.Get(0).([]*model.Like)
+	return result, args.Error(1)
+}
+
+func (m *MockRepository) GetUserCollections(ctx context.Context, userID int64, includePrivate bool) ([]*model.Collection, error) {
+	args := m.Called(ctx, userID, includePrivate)
+	result, _ := args.Get(0).([]*model.Collection)
+	return result, args.Error(1)
+}
+
+func (m *MockCache) DeleteCommentCount(ctx context.Context, videoID int64) error {
+	args := m.Called(ctx, videoID)
+	return args.Error(0)
+}
+
+type MockPrivacy struct {
+	mock.Mock
+}
+
+func (m *MockPrivacy) CheckInteract(ctx context.Context, actorID, targetID int64) error {
+	args := m.Called(ctx, actorID, targetID)
+	return args.Error(0)
+}
+
+func (m *MockPrivacy) CanViewCollections(ctx context.Context, viewerID, ownerID int64) (bool, error) {
+	args := m.Called(ctx, viewerID, ownerID)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockPrivacy) FilterLikesVisible(ctx context.Context, viewerID int64, userIDs []int64) (map[int64]bool, error) {
+	args := m.Called(ctx, viewerID, userIDs)
+	result, _ := args.Get(0).(map[int64]bool)
+	return result, args.Error(1)
+}
+
+type MockNotifier struct {
+	mock.Mock
+}
+
+func (m *MockNotifier) SendNotificationEvent(ctx context.Context, event *socialmodel.NotificationEvent) error {
+	args := m.Called(ctx, event)
+	return args.Error(0)
+}
+
+func (m *MockRepository) GetLike(ctx context.Context, userID, videoID int64) (*model.Like, error) {
+	args := m.Called(ctx, userID, videoID)
+	result, _ := args.Get(0).(*model.Like)
+	return result, args.Error(1)
+}
+
+func (m *MockRepository) CreateLike(ctx context.Context, userID, videoID int64) error {
+	args := m.Called(ctx, userID, videoID)
+	return args.Error(0)
+}
+
+func (m *MockRepository) GetComment(ctx context.Context, commentID int64) (*model.Comment, error) {
+	args := m.Called(ctx, commentID)
+	result, _ := args.Get(0).(*model.Comment)
+	return result, args.Error(1)
+}
+
+func (m *MockRepository) UpdateCommentStatus(ctx context.Context, commentID int64, status int8, reason string, reviewerID int64) error {
+	args := m.Called(ctx, commentID, status, reason, reviewerID)
+	return args.Error(0)
+}
+
+func (m *MockCache) IncrLikeCount(ctx context.Context, videoID int64, delta int64) error {
+	args := m.Called(ctx, videoID, delta)
+	return args.Error(0)
+}
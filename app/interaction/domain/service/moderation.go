@@ -0,0 +1,50 @@
+package service
+
+import (
+	"context"
+	"log"
+	"strings"
+
+	"github.com/yxrxy/videoHub/app/interaction/domain/model"
+	"github.com/yxrxy/videoHub/pkg/constants"
+)
+
+// ModerateComment 依次执行审核过滤链
+// 任一过滤器拒绝则立即返回；需要人工审核的结论会累积原因后继续检查
+func (s *InteractionService) ModerateComment(ctx context.Context, content string) *model.ModerationResult {
+	result := &model.ModerationResult{Verdict: model.ModerationPass}
+	var filters, reasons []string
+	for _, f := range s.filters {
+		r, err := f.Check(ctx, content)
+		if err != nil {
+			// 过滤器异常时放行，避免外部依赖故障导致无法评论
+			log.Printf("评论审核过滤器 %s 执行失败: %v", f.Name(), err)
+			continue
+		}
+		if r == nil || r.Verdict == model.ModerationPass {
+			continue
+		}
+		if r.Verdict == model.ModerationReject {
+			return &model.ModerationResult{
+				Verdict: model.ModerationReject,
+				Filter:  f.Name(),
+				Reason:  truncateReason(f.Name() + ": " + r.Reason),
+			}
+		}
+		result.Verdict = model.ModerationReview
+		filters = append(filters, f.Name())
+		reasons = append(reasons, f.Name()+": "+r.Reason)
+	}
+	result.Filter = strings.Join(filters, ",")
+	result.Reason = truncateReason(strings.Join(reasons, "; "))
+	return result
+}
+
+// truncateReason 截断审核原因，大模型返回的原因和多个过滤器拼接的原因可能超出列宽
+func truncateReason(reason string) string {
+	runes := []rune(reason)
+	if len(runes) > constants.ModerationReasonMaxLen {
+		return string(runes[:constants.ModerationReasonMaxLen])
+	}
+	return reason
+}
@@ -0,0 +1,67 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	"github.com/smartystreets/goconvey/convey"
+	"github.com/yxrxy/videoHub/app/interaction/domain/model"
+	"github.com/yxrxy/videoHub/app/interaction/domain/repository"
+	"github.com/yxrxy/videoHub/pkg/constants"
+)
+
+// reasonFilter 返回指定结论和原因的过滤器
+type reasonFilter struct {
+	verdict int8
+	reason  string
+}
+
+func (reasonFilter) Name() string { return "llm" }
+
+func (f reasonFilter) Check(context.Context, string) (*model.ModerationResult, error) {
+	return &model.ModerationResult{Verdict: f.verdict, Reason: f.reason}, nil
+}
+
+func TestInteractionService_ModerateComment(t *testing.T) {
+	type TestCase struct {
+		Name            string
+		Filters         []repository.TextFilter
+		ExpectedVerdict int8
+		ExpectedReason  string
+	}
+
+	long := strings.Repeat("违规", constants.ModerationReasonMaxLen)
+	testCases := []TestCase{
+		{
+			Name:            "原因未超长时原样保留",
+			Filters:         []repository.TextFilter{reviewFilter{}, reasonFilter{verdict: model.ModerationReview, reason: "广告"}},
+			ExpectedVerdict: model.ModerationReview,
+			ExpectedReason:  "review: review; llm: 广告",
+		},
+		{
+			Name:            "拼接后的原因按列宽截断",
+			Filters:         []repository.TextFilter{reviewFilter{}, reasonFilter{verdict: model.ModerationReview, reason: long}},
+			ExpectedVerdict: model.ModerationReview,
+			ExpectedReason:  string([]rune("review: review; llm: " + long)[:constants.ModerationReasonMaxLen]),
+		},
+		{
+			Name:            "拒绝原因按列宽截断",
+			Filters:         []repository.TextFilter{reasonFilter{verdict: model.ModerationReject, reason: long}},
+			ExpectedVerdict: model.ModerationReject,
+			ExpectedReason:  string([]rune("llm: " + long)[:constants.ModerationReasonMaxLen]),
+		},
+	}
+
+	for _, tc := range testCases {
+		convey.Convey(tc.Name, t, func() {
+			svc := NewInteractionService(new(MockRepository), new(MockCache), new(MockPrivacy), new(MockNotifier), tc.Filters...)
+			result := svc.ModerateComment(context.Background(), "content")
+
+			convey.So(result.Verdict, convey.ShouldEqual, tc.ExpectedVerdict)
+			convey.So(result.Reason, convey.ShouldEqual, tc.ExpectedReason)
+			convey.So(utf8.RuneCountInString(result.Reason), convey.ShouldBeLessThanOrEqualTo, constants.ModerationReasonMaxLen)
+		})
+	}
+}
@@ -0,0 +1,52 @@
+package service
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/yxrxy/videoHub/app/interaction/domain/model"
+	socialmodel "github.com/yxrxy/videoHub/app/social/domain/model"
+)
+
+// publishNotification 投递通知事件，失败只记录日志，不影响点赞和评论
+func (s *InteractionService) publishNotification(ctx context.Context, notificationType int8, userID, actorID, targetID int64) {
+	if err := s.notifier.SendNotificationEvent(ctx, &socialmodel.NotificationEvent{
+		Type:      notificationType,
+		UserID:    userID,
+		ActorID:   actorID,
+		TargetID:  targetID,
+		CreatedAt: time.Now().Unix(),
+	}); err != nil {
+		log.Printf("投递通知事件失败: %v", err)
+	}
+}
+
+// notifyLike 通知视频作者有新的点赞
+func (s *InteractionService) notifyLike(ctx context.Context, userID, videoID int64) {
+	authorID, err := s.db.GetVideoAuthorID(ctx, videoID)
+	if err != nil {
+		log.Printf("获取视频作者失败: %v", err)
+		return
+	}
+	s.publishNotification(ctx, socialmodel.NotificationTypeLike, authorID, userID, videoID)
+}
+
+// notifyComment 评论可见后通知，回复通知被回复的评论作者，否则通知视频作者
+func (s *InteractionService) notifyComment(ctx context.Context, comment *model.Comment) {
+	if comment.ParentID != nil && *comment.ParentID > 0 {
+		parent, err := s.db.GetComment(ctx, *comment.ParentID)
+		if err != nil {
+			log.Printf("获取被回复的评论失败: %v", err)
+			return
+		}
+		s.publishNotification(ctx, socialmodel.NotificationTypeReply, parent.UserID, comment.UserID, parent.ID)
+		return
+	}
+	authorID, err := s.db.GetVideoAuthorID(ctx, comment.VideoID)
+	if err != nil {
+		log.Printf("获取视频作者失败: %v", err)
+		return
+	}
+	s.publishNotification(ctx, socialmodel.NotificationTypeComment, authorID, comment.UserID, comment.VideoID)
+}
@@ -0,0 +1,132 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/smartystreets/goconvey/convey"
+	"github.com/stretchr/testify/mock"
+	"github.com/yxrxy/videoHub/app/interaction/domain/model"
+	"github.com/yxrxy/videoHub/app/interaction/domain/repository"
+	socialmodel "github.com/yxrxy/videoHub/app/social/domain/model"
+	"gorm.io/gorm"
+)
+
+// reviewFilter 把所有评论标记为待人工审核
+type reviewFilter struct{}
+
+func (reviewFilter) Name() string { return "review" }
+
+func (reviewFilter) Check(context.Context, string) (*model.ModerationResult, error) {
+	return &model.ModerationResult{Verdict: model.ModerationReview, Reason: "review"}, nil
+}
+
+// notificationEvent 匹配指定类型、接收者、触发者和目标的通知事件
+func notificationEvent(notificationType int8, userID, actorID, targetID int64) any {
+	return mock.MatchedBy(func(e *socialmodel.NotificationEvent) bool {
+		return e.Type == notificationType && e.UserID == userID && e.ActorID == actorID && e.TargetID == targetID
+	})
+}
+
+func TestInteractionService_LikeNotification(t *testing.T) {
+	convey.Convey("点赞后通知视频作者", t, func() {
+		ctx := context.Background()
+		const userID, videoID, authorID = int64(1), int64(10), int64(2)
+		db := new(MockRepository)
+		db.On("GetLike", ctx, userID, videoID).Return(nil, gorm.ErrRecordNotFound)
+		db.On("CreateLike", ctx, userID, videoID).Return(nil)
+		db.On("GetVideoAuthorID", ctx, videoID).Return(authorID, nil)
+		cache := new(MockCache)
+		cache.On("IncrLikeCount", ctx, videoID, int64(1)).Return(nil)
+		cache.On("UpdateLikedVideo", ctx, userID, videoID, true).Return(nil)
+		notifier := new(MockNotifier)
+		notifier.On("SendNotificationEvent", ctx, mock.Anything).Return(nil)
+
+		svc := NewInteractionService(db, cache, new(MockPrivacy), notifier)
+		_, err := svc.Like(ctx, userID, videoID)
+
+		convey.So(err, convey.ShouldBeNil)
+		notifier.AssertCalled(t, "SendNotificationEvent", ctx, notificationEvent(socialmodel.NotificationTypeLike, authorID, userID, videoID))
+	})
+}
+
+func TestInteractionService_CommentNotification(t *testing.T) {
+	type TestCase struct {
+		Name          string
+		ParentID      int64
+		Filters       []repository.TextFilter
+		ExpectedEvent any
+	}
+
+	const userID, videoID, authorID = int64(1), int64(10), int64(2)
+	const parentID, parentAuthorID = int64(50), int64(3)
+	testCases := []TestCase{
+		{
+			Name:          "评论通知视频作者",
+			ParentID:      -1,
+			ExpectedEvent: notificationEvent(socialmodel.NotificationTypeComment, authorID, userID, videoID),
+		},
+		{
+			Name:          "回复通知被回复的评论作者",
+			ParentID:      parentID,
+			ExpectedEvent: notificationEvent(socialmodel.NotificationTypeReply, parentAuthorID, userID, parentID),
+		},
+		{
+			Name:     "待审核的评论不通知",
+			ParentID: -1,
+			Filters:  []repository.TextFilter{reviewFilter{}},
+		},
+	}
+
+	for _, tc := range testCases {
+		convey.Convey(tc.Name, t, func() {
+			ctx := context.Background()
+			db := new(MockRepository)
+			db.On("IsVideoExist", ctx, videoID).Return(true, nil)
+			db.On("GetVideoAuthorID", ctx, videoID).Return(authorID, nil)
+			db.On("GetComment", ctx, parentID).Return(&model.Comment{ID: parentID, UserID: parentAuthorID, VideoID: videoID}, nil)
+			db.On("CreateComment", ctx, mock.Anything).Return(int64(100), nil)
+			cache := new(MockCache)
+			cache.On("DeleteCommentCount", ctx, videoID).Return(nil)
+			privacy := new(MockPrivacy)
+			privacy.On("CheckInteract", ctx, userID, authorID).Return(nil)
+			notifier := new(MockNotifier)
+			notifier.On("SendNotificationEvent", ctx, mock.Anything).Return(nil)
+
+			svc := NewInteractionService(db, cache, privacy, notifier, tc.Filters...)
+			_, err := svc.Comment(ctx, userID, videoID, "hello", tc.ParentID)
+
+			convey.So(err, convey.ShouldBeNil)
+			if tc.ExpectedEvent == nil {
+				notifier.AssertNotCalled(t, "SendNotificationEvent", ctx, mock.Anything)
+				return
+			}
+			notifier.AssertNumberOfCalls(t, "SendNotificationEvent", 1)
+			notifier.AssertCalled(t, "SendNotificationEvent", ctx, tc.ExpectedEvent)
+		})
+	}
+}
+
+func TestInteractionService_ReviewCommentNotification(t *testing.T) {
+	convey.Convey("人工审核通过后通知视频作者", t, func() {
+		ctx := context.Background()
+		const reviewerID, commentID, userID, videoID, authorID = int64(9), int64(100), int64(1), int64(10), int64(2)
+		parent := int64(-1)
+		db := new(MockRepository)
+		db.On("GetComment", ctx, commentID).Return(&model.Comment{
+			ID: commentID, UserID: userID, VideoID: videoID, ParentID: &parent, Status: model.CommentStatusPending,
+		}, nil)
+		db.On("UpdateCommentStatus", ctx, commentID, model.CommentStatusVisible, "", reviewerID).Return(nil)
+		db.On("GetVideoAuthorID", ctx, videoID).Return(authorID, nil)
+		cache := new(MockCache)
+		cache.On("DeleteCommentCount", ctx, videoID).Return(nil)
+		notifier := new(MockNotifier)
+		notifier.On("SendNotificationEvent", ctx, mock.Anything).Return(nil)
+
+		svc := NewInteractionService(db, cache, new(MockPrivacy), notifier)
+		err := svc.ReviewComment(ctx, reviewerID, commentID, model.ReviewActionApprove, "")
+
+		convey.So(err, convey.ShouldBeNil)
+		notifier.AssertCalled(t, "SendNotificationEvent", ctx, notificationEvent(socialmodel.NotificationTypeComment, authorID, userID, videoID))
+	})
+}
@@ -0,0 +1,126 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/smartystreets/goconvey/convey"
+	"github.com/stretchr/testify/mock"
+	"github.com/yxrxy/videoHub/app/interaction/domain/model"
+	"github.com/yxrxy/videoHub/pkg/errno"
+)
+
+func TestInteractionService_CommentBlocked(t *testing.T) {
+	type TestCase struct {
+		Name          string
+		Blocked       bool
+		ExpectedError bool
+	}
+
+	const userID, videoID, authorID = int64(1), int64(10), int64(2)
+	testCases := []TestCase{
+		{
+			Name: "正常评论",
+		},
+		{
+			Name:          "与视频作者存在拉黑关系时不能评论",
+			Blocked:       true,
+			ExpectedError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		convey.Convey(tc.Name, t, func() {
+			ctx := context.Background()
+			db := new(MockRepository)
+			db.On("IsVideoExist", ctx, videoID).Return(true, nil)
+			db.On("GetVideoAuthorID", ctx, videoID).Return(authorID, nil)
+			db.On("CreateComment", ctx, mock.Anything).Return(int64(100), nil)
+			cache := new(MockCache)
+			cache.On("DeleteCommentCount", ctx, videoID).Return(nil)
+			privacy := new(MockPrivacy)
+			var blockErr error
+			if tc.Blocked {
+				blockErr = errno.AuthNoOperatePermission.WithMessage("user is blocked")
+			}
+			privacy.On("CheckInteract", ctx, userID, authorID).Return(blockErr)
+			notifier := new(MockNotifier)
+			notifier.On("SendNotificationEvent", ctx, mock.Anything).Return(nil)
+
+			svc := NewInteractionService(db, cache, privacy, notifier)
+			commentID, err := svc.Comment(ctx, userID, videoID, "hello", -1)
+
+			if tc.ExpectedError {
+				convey.So(err, convey.ShouldNotBeNil)
+				db.AssertNotCalled(t, "CreateComment", ctx, mock.Anything)
+				return
+			}
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(commentID, convey.ShouldEqual, 100)
+		})
+	}
+}
+
+func TestInteractionService_GetLikes(t *testing.T) {
+	convey.Convey("隐藏点赞不可见的用户", t, func() {
+		ctx := context.Background()
+		const viewerID, videoID = int64(1), int64(10)
+		db := new(MockRepository)
+		db.On("GetLikeList", ctx, videoID, 0, 10).Return([]*model.Like{
+			{UserID: 2, VideoID: videoID},
+			{UserID: 3, VideoID: videoID},
+			{UserID: 4, VideoID: videoID},
+		}, nil)
+		privacy := new(MockPrivacy)
+		privacy.On("FilterLikesVisible", ctx, viewerID, []int64{2, 3, 4}).Return(map[int64]bool{2: true, 4: true}, nil)
+
+		svc := NewInteractionService(db, new(MockCache), privacy, new(MockNotifier))
+		likes, err := svc.GetLikes(ctx, viewerID, videoID, 1, 10)
+
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(likes, convey.ShouldHaveLength, 2)
+		convey.So(likes[0].UserID, convey.ShouldEqual, 2)
+		convey.So(likes[1].UserID, convey.ShouldEqual, 4)
+	})
+}
+
+func TestInteractionService_GetCollectionsVisibility(t *testing.T) {
+	type TestCase struct {
+		Name          string
+		Visible       bool
+		ExpectedError bool
+	}
+
+	const viewerID, ownerID = int64(1), int64(2)
+	testCases := []TestCase{
+		{
+			Name:    "收藏对访问者可见",
+			Visible: true,
+		},
+		{
+			Name:          "收藏对访问者不可见",
+			ExpectedError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		convey.Convey(tc.Name, t, func() {
+			ctx := context.Background()
+			db := new(MockRepository)
+			db.On("GetUserCollections", ctx, ownerID, false).Return([]*model.Collection{{ID: 1, UserID: ownerID}}, nil)
+			privacy := new(MockPrivacy)
+			privacy.On("CanViewCollections", ctx, viewerID, ownerID).Return(tc.Visible, nil)
+
+			svc := NewInteractionService(db, new(MockCache), privacy, new(MockNotifier))
+			collections, err := svc.GetCollections(ctx, viewerID, ownerID)
+
+			if tc.ExpectedError {
+				convey.So(err, convey.ShouldNotBeNil)
+				db.AssertNotCalled(t, "GetUserCollections", ctx, ownerID, false)
+				return
+			}
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(collections, convey.ShouldHaveLength, 1)
+		})
+	}
+}
@@ -0,0 +1,199 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"log"
+	"slices"
+	"time"
+
+	"github.com/yxrxy/videoHub/app/interaction/domain/model"
+	"github.com/yxrxy/videoHub/config"
+	"github.com/yxrxy/videoHub/pkg/constants"
+	"github.com/yxrxy/videoHub/pkg/errno"
+	"gorm.io/gorm"
+)
+
+// ReactionTypes 返回可用的表情集合，顺序即展示顺序
+func (s *InteractionService) ReactionTypes() []string {
+	if config.VideoInteractions != nil && len(config.VideoInteractions.Reactions) > 0 {
+		return config.VideoInteractions.Reactions
+	}
+	return constants.DefaultReactions
+}
+
+// React 对视频或评论进行表情回应
+// 未回应时新增；表情不同时替换；与当前表情相同时取消
+func (s *InteractionService) React(ctx context.Context, userID int64, targetType int8, targetID int64, reaction string) (*model.ReactionSummary, error) {
+	if !slices.Contains(s.ReactionTypes(), reaction) {
+		return nil, errno.ParamVerifyError.WithMessage("unsupported reaction")
+	}
+	if err := s.checkReactionTarget(ctx, targetType, targetID); err != nil {
+		return nil, err
+	}
+
+	current, err := s.db.GetReaction(ctx, userID, targetType, targetID)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, err
+	}
+
+	myReaction := reaction
+	if current != nil && current.Reaction == reaction {
+		if err := s.db.DeleteReaction(ctx, userID, targetType, targetID); err != nil {
+			return nil, err
+		}
+		myReaction = ""
+	} else {
+		err := s.db.UpsertReaction(ctx, &model.Reaction{
+			UserID:     userID,
+			TargetType: targetType,
+			TargetID:   targetID,
+			Reaction:   reaction,
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	s.updateReactionCache(ctx, targetType, targetID, current, myReaction)
+
+	counts, err := s.GetReactionCounts(ctx, targetType, targetID)
+	if err != nil {
+		return nil, err
+	}
+	return &model.ReactionSummary{Counts: counts, MyReaction: myReaction}, nil
+}
+
+// updateReactionCache 增量更新缓存计数，缓存失败只记录日志，由对账任务修正
+func (s *InteractionService) updateReactionCache(ctx context.Context, targetType int8, targetID int64, old *model.Reaction, newReaction string) {
+	if old != nil {
+		if err := s.cache.IncrReactionCount(ctx, targetType, targetID, old.Reaction, -1); err != nil {
+			log.Printf("更新表情计数缓存失败: %v", err)
+		}
+	}
+	if newReaction != "" {
+		if err := s.cache.IncrReactionCount(ctx, targetType, targetID, newReaction, 1); err != nil {
+			log.Printf("更新表情计数缓存失败: %v", err)
+		}
+	}
+	if err := s.cache.MarkReactionDirty(ctx, targetType, targetID); err != nil {
+		log.Printf("标记表情对账目标失败: %v", err)
+	}
+}
+
+// GetReactionCounts 获取表情计数，优先读取缓存，未命中时从数据库加载
+func (s *InteractionService) GetReactionCounts(ctx context.Context, targetType int8, targetID int64) (map[string]int64, error) {
+	counts, ok, err := s.cache.GetReactionCounts(ctx, targetType, targetID)
+	if err == nil && ok {
+		return counts, nil
+	}
+	if err != nil {
+		log.Printf("读取表情计数缓存失败: %v", err)
+	}
+
+	counts, err = s.db.GetReactionCounts(ctx, targetType, targetID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.cache.SetReactionCounts(ctx, targetType, targetID, counts); err != nil {
+		log.Printf("写入表情计数缓存失败: %v", err)
+	}
+	return counts, nil
+}
+
+// GetReactions 获取表情回应列表及统计
+func (s *InteractionService) GetReactions(
+	ctx context.Context, viewerID int64, targetType int8, targetID int64, reaction string, page, size int32,
+) ([]*model.Reaction, int64, *model.ReactionSummary, error) {
+	if err := s.checkReactionTarget(ctx, targetType, targetID); err != nil {
+		return nil, 0, nil, err
+	}
+	offset := (page - 1) * size
+	reactions, total, err := s.db.GetReactionList(ctx, targetType, targetID, reaction, int(offset), int(size))
+	if err != nil {
+		return nil, 0, nil, err
+	}
+	counts, err := s.GetReactionCounts(ctx, targetType, targetID)
+	if err != nil {
+		return nil, 0, nil, err
+	}
+
+	summary := &model.ReactionSummary{Counts: counts}
+	mine, err := s.db.GetReaction(ctx, viewerID, targetType, targetID)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, 0, nil, err
+	}
+	if mine != nil {
+		summary.MyReaction = mine.Reaction
+	}
+	return reactions, total, summary, nil
+}
+
+// checkReactionTarget 检查回应目标是否存在
+func (s *InteractionService) checkReactionTarget(ctx context.Context, targetType int8, targetID int64) error {
+	switch targetType {
+	case model.ReactionTargetVideo:
+		exist, err := s.db.IsVideoExist(ctx, targetID)
+		if err != nil {
+			return err
+		}
+		if !exist {
+			return errors.New("video not found")
+		}
+		return nil
+	case model.ReactionTargetComment:
+		comment, err := s.db.GetComment(ctx, targetID)
+		if err != nil {
+			return err
+		}
+		if comment.Status != model.CommentStatusVisible {
+			return errno.ParamVerifyError.WithMessage("comment is not visible")
+		}
+		return nil
+	default:
+		return errno.ParamVerifyError.WithMessage("invalid reaction target type")
+	}
+}
+
+// ReconcileReactions 用数据库计数修正最近变更过的目标缓存
+func (s *InteractionService) ReconcileReactions(ctx context.Context) error {
+	for {
+		targets, err := s.cache.PopDirtyReactions(ctx, constants.ReactionReconcileBatch)
+		if err != nil {
+			return err
+		}
+		for _, t := range targets {
+			counts, err := s.db.GetReactionCounts(ctx, t.Type, t.ID)
+			if err != nil {
+				// 放回待对账集合，下个周期重试
+				_ = s.cache.MarkReactionDirty(ctx, t.Type, t.ID)
+				return err
+			}
+			if err := s.cache.SetReactionCounts(ctx, t.Type, t.ID, counts); err != nil {
+				_ = s.cache.MarkReactionDirty(ctx, t.Type, t.ID)
+				return err
+			}
+		}
+		if len(targets) < constants.ReactionReconcileBatch {
+			return nil
+		}
+	}
+}
+
+// StartReactionReconciler 定期执行表情计数对账
+func (s *InteractionService) StartReactionReconciler(ctx context.Context) {
+	ticker := time.NewTicker(constants.ReactionReconcilePeriod)
+	go func() {
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				if err := s.ReconcileReactions(ctx); err != nil {
+					log.Printf("表情计数对账失败: %v", err)
+				}
+			}
+		}
+	}()
+}
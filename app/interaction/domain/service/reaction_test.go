@@ -0,0 +1,117 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/smartystreets/goconvey/convey"
+	"github.com/stretchr/testify/mock"
+	"github.com/yxrxy/videoHub/app/interaction/domain/model"
+	"gorm.io/gorm"
+)
+
+func TestInteractionService_React(t *testing.T) {
+	type TestCase struct {
+		Name       string
+		TargetType int8
+		Reaction   string
+		// Mock 数据库相关
+		MockCurrent *model.Reaction
+		// Mock 缓存相关
+		MockCacheCounts map[string]int64
+		// 预期结果
+		ExpectedError  bool
+		ExpectedMine   string
+		ExpectedUpsert bool
+		ExpectedDelete bool
+		ExpectedIncr   map[string]int64
+	}
+
+	testCases := []TestCase{
+		{
+			Name:            "首次回应",
+			TargetType:      model.ReactionTargetVideo,
+			Reaction:        "👍",
+			ExpectedMine:    "👍",
+			ExpectedUpsert:  true,
+			ExpectedIncr:    map[string]int64{"👍": 1},
+			MockCacheCounts: map[string]int64{"👍": 1},
+		},
+		{
+			Name:            "重复回应取消",
+			TargetType:      model.ReactionTargetVideo,
+			Reaction:        "👍",
+			MockCurrent:     &model.Reaction{Reaction: "👍"},
+			ExpectedMine:    "",
+			ExpectedDelete:  true,
+			ExpectedIncr:    map[string]int64{"👍": -1},
+			MockCacheCounts: map[string]int64{},
+		},
+		{
+			Name:            "切换表情",
+			TargetType:      model.ReactionTargetVideo,
+			Reaction:        "😂",
+			MockCurrent:     &model.Reaction{Reaction: "👍"},
+			ExpectedMine:    "😂",
+			ExpectedUpsert:  true,
+			ExpectedIncr:    map[string]int64{"👍": -1, "😂": 1},
+			MockCacheCounts: map[string]int64{"😂": 1},
+		},
+		{
+			Name:          "不支持的表情",
+			TargetType:    model.ReactionTargetVideo,
+			Reaction:      "🦄",
+			ExpectedError: true,
+		},
+		{
+			Name:          "非法目标类型",
+			TargetType:    9,
+			Reaction:      "👍",
+			ExpectedError: true,
+		},
+	}
+
+	const userID, targetID = int64(1), int64(100)
+	for _, tc := range testCases {
+		convey.Convey(tc.Name, t, func() {
+			ctx := context.Background()
+			db := new(MockRepository)
+			cache := new(MockCache)
+			db.On("IsVideoExist", ctx, targetID).Return(true, nil)
+			if tc.MockCurrent != nil {
+				db.On("GetReaction", ctx, userID, tc.TargetType, targetID).Return(tc.MockCurrent, nil)
+			} else {
+				db.On("GetReaction", ctx, userID, tc.TargetType, targetID).Return(nil, gorm.ErrRecordNotFound)
+			}
+			db.On("UpsertReaction", ctx, mock.Anything).Return(nil)
+			db.On("DeleteReaction", ctx, userID, tc.TargetType, targetID).Return(nil)
+			for reaction, delta := range tc.ExpectedIncr {
+				cache.On("IncrReactionCount", ctx, tc.TargetType, targetID, reaction, delta).Return(nil)
+			}
+			cache.On("MarkReactionDirty", ctx, tc.TargetType, targetID).Return(nil)
+			cache.On("GetReactionCounts", ctx, tc.TargetType, targetID).Return(tc.MockCacheCounts, true, nil)
+
+			svc := NewInteractionService(db, cache, new(MockPrivacy), new(MockNotifier))
+			summary, err := svc.React(ctx, userID, tc.TargetType, targetID, tc.Reaction)
+
+			if tc.ExpectedError {
+				convey.So(err, convey.ShouldNotBeNil)
+				return
+			}
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(summary.MyReaction, convey.ShouldEqual, tc.ExpectedMine)
+			convey.So(summary.Counts, convey.ShouldResemble, tc.MockCacheCounts)
+			if tc.ExpectedUpsert {
+				db.AssertCalled(t, "UpsertReaction", ctx, mock.Anything)
+			} else {
+				db.AssertNotCalled(t, "UpsertReaction", ctx, mock.Anything)
+			}
+			if tc.ExpectedDelete {
+				db.AssertCalled(t, "DeleteReaction", ctx, userID, tc.TargetType, targetID)
+			} else {
+				db.AssertNotCalled(t, "DeleteReaction", ctx, userID, tc.TargetType, targetID)
+			}
+			cache.AssertNumberOfCalls(t, "IncrReactionCount", len(tc.ExpectedIncr))
+		})
+	}
+}
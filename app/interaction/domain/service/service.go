@@ -3,8 +3,11 @@ package service
 import (
 	"context"
 	"errors"
+	"log"
 
 	"github.com/yxrxy/videoHub/app/interaction/domain/model"
+	"github.com/yxrxy/videoHub/config"
+	"github.com/yxrxy/videoHub/pkg/errno"
 	"gorm.io/gorm"
 )
 
@@ -15,6 +18,7 @@ func (s *InteractionService) Like(ctx context.Context, userID int64, videoID int
 		if err := s.db.DeleteLike(ctx, like); err != nil {
 			return false, err
 		}
+		s.updateLikeCache(ctx, userID, videoID, false)
 		return true, nil
 	} else if !errors.Is(err, gorm.ErrRecordNotFound) {
 		return false, err
@@ -24,27 +28,54 @@ func (s *InteractionService) Like(ctx context.Context, userID int64, videoID int
 	if err := s.db.CreateLike(ctx, userID, videoID); err != nil {
 		return false, err
 	}
+	s.updateLikeCache(ctx, userID, videoID, true)
+	s.notifyLike(ctx, userID, videoID)
 	return true, nil
 }
 
-func (s *InteractionService) GetLikes(ctx context.Context, videoID int64, page int32, size int32) ([]*model.Like, error) {
+func (s *InteractionService) updateLikeCache(ctx context.Context, userID, videoID int64, liked bool) {
+	delta := int64(-1)
+	if liked {
+		delta = 1
+	}
+	if err := s.cache.IncrLikeCount(ctx, videoID, delta); err != nil {
+		log.Printf("更新点赞数缓存失败: %v", err)
+	}
+	if err := s.cache.UpdateLikedVideo(ctx, userID, videoID, liked); err != nil {
+		log.Printf("更新用户点赞缓存失败: %v", err)
+	}
+}
+
+// GetLikes 获取视频的点赞列表，隐藏点赞对 viewerID 不可见的用户
+func (s *InteractionService) GetLikes(ctx context.Context, viewerID, videoID int64, page int32, size int32) ([]*model.Like, error) {
 	offset := (page - 1) * size
 	likes, err := s.db.GetLikeList(ctx, videoID, int(offset), int(size))
 	if err != nil {
 		return nil, err
 	}
 
-	result := make([]*model.Like, len(likes))
+	userIDs := make([]int64, len(likes))
 	for i, like := range likes {
-		result[i] = &model.Like{
+		userIDs[i] = like.UserID
+	}
+	visible, err := s.privacy.FilterLikesVisible(ctx, viewerID, userIDs)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]*model.Like, 0, len(likes))
+	for _, like := range likes {
+		if !visible[like.UserID] {
+			continue
+		}
+		result = append(result, &model.Like{
 			UserID:  like.UserID,
 			VideoID: like.VideoID,
-		}
+		})
 	}
 	return result, nil
 }
 
-func (s *InteractionService) Comment(ctx context.Context, userID int64, videoID int64, content string, parentID int64) (bool, error) {
+func (s *InteractionService) Comment(ctx context.Context, userID int64, videoID int64, content string, parentID int64) (int64, error) {
 	pa := int64(-1)
 	pID := &pa
 	if parentID != -1 {
@@ -52,47 +83,60 @@ func (s *InteractionService) Comment(ctx context.Context, userID int64, videoID
 		_, err := s.db.GetComment(ctx, parentID)
 		if err != nil {
 			if errors.Is(err, gorm.ErrRecordNotFound) {
-				return false, err
+				return 0, err
 			}
-			return false, err
+			return 0, err
 		}
 		pID = &parentID
 	}
 	// 检查视频是否存在
 	exist, err := s.db.IsVideoExist(ctx, videoID)
 	if err != nil {
-		return false, err
+		return 0, err
 	}
 	if !exist {
-		return false, errors.New("video not found")
+		return 0, errors.New("video not found")
+	}
+	// 视频作者拉黑了评论者或被评论者拉黑时不能评论
+	authorID, err := s.db.GetVideoAuthorID(ctx, videoID)
+	if err != nil {
+		return 0, err
+	}
+	if err := s.privacy.CheckInteract(ctx, userID, authorID); err != nil {
+		return 0, err
 	}
+	// 审核评论内容，未通过的评论仍会保存，但仅作者本人可见
+	result := s.ModerateComment(ctx, content)
 	// 创建评论
-	if err := s.db.CreateComment(ctx, userID, videoID, content, pID); err != nil {
-		return false, err
+	comment := &model.Comment{
+		UserID:           userID,
+		VideoID:          videoID,
+		Content:          content,
+		ParentID:         pID,
+		Status:           result.CommentStatus(),
+		ModerationReason: result.Reason,
 	}
-	return true, nil
+	commentID, err := s.db.CreateComment(ctx, comment)
+	if err != nil {
+		return 0, err
+	}
+	s.invalidateCommentCount(ctx, videoID)
+	// 未通过审核的评论在人工审核通过后再通知
+	if comment.Status == model.CommentStatusVisible {
+		s.notifyComment(ctx, comment)
+	}
+	return commentID, nil
 }
 
-func (s *InteractionService) GetComments(ctx context.Context, videoID int64, page int32, size int32) ([]*model.Comment, error) {
+// GetComments 获取评论列表，viewerID 对应用户可以看到自己未通过审核的评论
+func (s *InteractionService) GetComments(ctx context.Context, videoID int64, viewerID int64, page int32, size int32) ([]*model.Comment, error) {
 	offset := (page - 1) * size
-	comments, _, err := s.db.GetCommentList(ctx, videoID, int(offset), int(size))
+	comments, _, err := s.db.GetCommentList(ctx, videoID, viewerID, int(offset), int(size))
 	if err != nil {
 		return nil, err
 	}
 
-	result := make([]*model.Comment, len(comments))
-	for i, comment := range comments {
-		result[i] = &model.Comment{
-			ID:        comment.ID,
-			UserID:    comment.UserID,
-			VideoID:   comment.VideoID,
-			Content:   comment.Content,
-			ParentID:  comment.ParentID,
-			LikeCount: comment.LikeCount,
-		}
-	}
-
-	return result, nil
+	return comments, nil
 }
 
 func (s *InteractionService) LikeComment(ctx context.Context, userID int64, commentID int64) (bool, error) {
@@ -132,3 +176,141 @@ func (s *InteractionService) LikeComment(ctx context.Context, userID int64, comm
 
 	return true, nil
 }
+
+// IsModerator 判断用户是否为评论审核管理员
+func (s *InteractionService) IsModerator(userID int64) bool {
+	if config.VideoInteractions == nil {
+		return false
+	}
+	for _, id := range config.VideoInteractions.Moderation.Admins {
+		if id == userID {
+			return true
+		}
+	}
+	return false
+}
+
+// GetFlaggedComments 获取指定审核状态的评论
+func (s *InteractionService) GetFlaggedComments(ctx context.Context, status int8, page int32, size int32) ([]*model.Comment, int64, error) {
+	if status != model.CommentStatusPending && status != model.CommentStatusRejected {
+		return nil, 0, errno.ParamVerifyError.WithMessage("invalid comment status")
+	}
+	offset := (page - 1) * size
+	return s.db.GetCommentsByStatus(ctx, status, int(offset), int(size))
+}
+
+// ReviewComment 人工审核评论
+func (s *InteractionService) ReviewComment(ctx context.Context, reviewerID int64, commentID int64, action int8, reason string) error {
+	var status int8
+	switch action {
+	case model.ReviewActionApprove:
+		status = model.CommentStatusVisible
+	case model.ReviewActionReject:
+		status = model.CommentStatusRejected
+	default:
+		return errno.ParamVerifyError.WithMessage("invalid review action")
+	}
+	comment, err := s.db.GetComment(ctx, commentID)
+	if err != nil {
+		return err
+	}
+	if err := s.db.UpdateCommentStatus(ctx, commentID, status, truncateReason(reason), reviewerID); err != nil {
+		return err
+	}
+	s.invalidateCommentCount(ctx, comment.VideoID)
+	if status == model.CommentStatusVisible && comment.Status != model.CommentStatusVisible {
+		s.notifyComment(ctx, comment)
+	}
+	return nil
+}
+
+// DeleteComment 删除评论，仅作者本人可删除
+func (s *InteractionService) DeleteComment(ctx context.Context, userID int64, commentID int64) error {
+	comment, err := s.db.GetComment(ctx, commentID)
+	if err != nil {
+		return err
+	}
+	if comment.UserID != userID {
+		return errno.AuthNoOperatePermission
+	}
+	if err := s.db.DeleteComment(ctx, comment); err != nil {
+		return err
+	}
+	s.invalidateCommentCount(ctx, comment.VideoID)
+	return nil
+}
+
+// EditComment 编辑评论，编辑后的内容重新审核
+func (s *InteractionService) EditComment(ctx context.Context, userID int64, commentID int64, content string) error {
+	comment, err := s.db.GetComment(ctx, commentID)
+	if err != nil {
+		return err
+	}
+	if comment.UserID != userID {
+		return errno.AuthNoOperatePermission
+	}
+	if comment.Content == content {
+		return nil
+	}
+	result := s.ModerateComment(ctx, content)
+	if err := s.db.EditComment(ctx, comment, content, result.CommentStatus(), result.Reason); err != nil {
+		return err
+	}
+	if result.CommentStatus() != comment.Status {
+		s.invalidateCommentCount(ctx, comment.VideoID)
+	}
+	return nil
+}
+
+// GetCommentEditHistory 获取评论编辑记录，未通过审核的评论仅作者可查看
+func (s *InteractionService) GetCommentEditHistory(ctx context.Context, viewerID int64, commentID int64) ([]*model.CommentEdit, error) {
+	comment, err := s.db.GetComment(ctx, commentID)
+	if err != nil {
+		return nil, err
+	}
+	if comment.Status != model.CommentStatusVisible && comment.UserID != viewerID {
+		return nil, errno.AuthNoOperatePermission
+	}
+	return s.db.GetCommentEdits(ctx, commentID)
+}
+
+// PinComment 视频作者置顶评论，只能置顶已通过审核的一级评论
+func (s *InteractionService) PinComment(ctx context.Context, userID int64, commentID int64, pin bool) error {
+	comment, err := s.checkVideoAuthor(ctx, userID, commentID)
+	if err != nil {
+		return err
+	}
+	if pin {
+		if comment.ParentID != nil && *comment.ParentID != -1 {
+			return errno.ParamVerifyError.WithMessage("only top-level comments can be pinned")
+		}
+		if comment.Status != model.CommentStatusVisible {
+			return errno.ParamVerifyError.WithMessage("comment is not visible")
+		}
+	}
+	return s.db.SetCommentPinned(ctx, comment.VideoID, commentID, pin)
+}
+
+// HeartComment 视频作者点亮评论
+func (s *InteractionService) HeartComment(ctx context.Context, userID int64, commentID int64, heart bool) error {
+	if _, err := s.checkVideoAuthor(ctx, userID, commentID); err != nil {
+		return err
+	}
+	return s.db.SetCommentHearted(ctx, commentID, heart)
+}
+
+// checkVideoAuthor 检查用户是否为评论所属视频的作者
+func (s *InteractionService) checkVideoAuthor(ctx context.Context, userID int64, commentID int64) (*model.Comment, error) {
+	comment, err := s.db.GetComment(ctx, commentID)
+	if err != nil {
+		return nil, err
+	}
+	authorID, err := s.db.GetVideoAuthorID(ctx, comment.VideoID)
+	if err != nil {
+		return nil, err
+	}
+	if authorID != userID {
+		return nil, errno.AuthNoOperatePermission
+	}
+	return comment, nil
+}
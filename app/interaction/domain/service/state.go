@@ -0,0 +1,183 @@
+package service
+
+import (
+	"context"
+	"log"
+
+	"github.com/yxrxy/videoHub/app/interaction/domain/model"
+	"github.com/yxrxy/videoHub/pkg/constants"
+	"github.com/yxrxy/videoHub/pkg/errno"
+)
+
+// GetInteractionState 批量获取视频的互动状态，结果顺序与 videoIDs 一致
+// 优先读取缓存，未命中的视频从数据库批量加载后回填
+func (s *InteractionService) GetInteractionState(ctx context.Context, userID int64, videoIDs []int64) ([]*model.VideoInteractionState, error) {
+	if len(videoIDs) == 0 {
+		return []*model.VideoInteractionState{}, nil
+	}
+	if len(videoIDs) > constants.MaxStateBatchSize {
+		return nil, errno.ParamVerifyError.WithMessage("too many video ids")
+	}
+
+	liked, likeCounts, err := s.getLikeStates(ctx, userID, videoIDs)
+	if err != nil {
+		return nil, err
+	}
+	commentCounts, err := s.getCommentCounts(ctx, videoIDs)
+	if err != nil {
+		return nil, err
+	}
+	reactions, err := s.getReactionCountsBatch(ctx, videoIDs)
+	if err != nil {
+		return nil, err
+	}
+	myReactions, err := s.db.GetUserReactions(ctx, userID, model.ReactionTargetVideo, videoIDs)
+	if err != nil {
+		return nil, err
+	}
+	collectCounts, err := s.db.GetVideoCollectCounts(ctx, videoIDs)
+	if err != nil {
+		return nil, err
+	}
+	collected, err := s.db.GetUserCollectedVideos(ctx, userID, videoIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	states := make([]*model.VideoInteractionState, len(videoIDs))
+	for i, id := range videoIDs {
+		states[i] = &model.VideoInteractionState{
+			VideoID:      id,
+			IsLiked:      liked[id],
+			LikeCount:    likeCounts[id],
+			CommentCount: commentCounts[id],
+			Reactions:    reactions[id],
+			MyReaction:   myReactions[id],
+			CollectCount: collectCounts[id],
+			IsCollected:  collected[id],
+		}
+	}
+	return states, nil
+}
+
+// getLikeStates 用户是否点赞读取用户的点赞集合缓存，点赞数按视频单独缓存
+func (s *InteractionService) getLikeStates(ctx context.Context, userID int64, videoIDs []int64) (map[int64]bool, map[int64]int64, error) {
+	liked, err := s.getLikedVideos(ctx, userID, videoIDs)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	counts, err := s.cache.GetLikeCounts(ctx, videoIDs)
+	if err != nil {
+		log.Printf("读取点赞数缓存失败: %v", err)
+		counts = map[int64]int64{}
+	}
+	missing := missingIDs(videoIDs, counts)
+	if len(missing) == 0 {
+		return liked, counts, nil
+	}
+
+	dbCounts, err := s.db.CountVideoLikes(ctx, missing)
+	if err != nil {
+		return nil, nil, err
+	}
+	for _, id := range missing {
+		counts[id] = dbCounts[id]
+		if err := s.cache.SetLikeCount(ctx, id, dbCounts[id]); err != nil {
+			log.Printf("写入点赞数缓存失败: %v", err)
+		}
+	}
+	return liked, counts, nil
+}
+
+// getLikedVideos 批量判断用户是否点赞了视频，未登录时均为未点赞。
+// 点赞集合未缓存时从数据库加载用户的全部点赞并回填，缓存不可用时直接按视频查询数据库
+func (s *InteractionService) getLikedVideos(ctx context.Context, userID int64, videoIDs []int64) (map[int64]bool, error) {
+	if userID <= 0 {
+		return map[int64]bool{}, nil
+	}
+	liked, cached, err := s.cache.GetLikedVideos(ctx, userID, videoIDs)
+	if err != nil {
+		log.Printf("读取用户点赞缓存失败: %v", err)
+		return s.db.GetUserLikedVideos(ctx, userID, videoIDs)
+	}
+	if cached {
+		return liked, nil
+	}
+
+	likedIDs, err := s.db.GetUserLikedVideoIDs(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.cache.SetLikedVideos(ctx, userID, likedIDs); err != nil {
+		log.Printf("写入用户点赞缓存失败: %v", err)
+	}
+	all := make(map[int64]bool, len(likedIDs))
+	for _, id := range likedIDs {
+		all[id] = true
+	}
+	liked = make(map[int64]bool, len(videoIDs))
+	for _, id := range videoIDs {
+		if all[id] {
+			liked[id] = true
+		}
+	}
+	return liked, nil
+}
+
+func (s *InteractionService) getCommentCounts(ctx context.Context, videoIDs []int64) (map[int64]int64, error) {
+	counts, err := s.cache.GetCommentCounts(ctx, videoIDs)
+	if err != nil {
+		log.Printf("读取评论数缓存失败: %v", err)
+		counts = map[int64]int64{}
+	}
+	missing := missingIDs(videoIDs, counts)
+	if len(missing) == 0 {
+		return counts, nil
+	}
+
+	dbCounts, err := s.db.CountVisibleComments(ctx, missing)
+	if err != nil {
+		return nil, err
+	}
+	for _, id := range missing {
+		counts[id] = dbCounts[id]
+		if err := s.cache.SetCommentCount(ctx, id, dbCounts[id]); err != nil {
+			log.Printf("写入评论数缓存失败: %v", err)
+		}
+	}
+	return counts, nil
+}
+
+func (s *InteractionService) getReactionCountsBatch(ctx context.Context, videoIDs []int64) (map[int64]map[string]int64, error) {
+	result, err := s.cache.BatchGetReactionCounts(ctx, model.ReactionTargetVideo, videoIDs)
+	if err != nil {
+		log.Printf("读取表情计数缓存失败: %v", err)
+		result = map[int64]map[string]int64{}
+	}
+	for _, id := range missingIDs(videoIDs, result) {
+		counts, err := s.GetReactionCounts(ctx, model.ReactionTargetVideo, id)
+		if err != nil {
+			return nil, err
+		}
+		result[id] = counts
+	}
+	return result, nil
+}
+
+// invalidateCommentCount 评论可见性变化后删除缓存的评论数
+func (s *InteractionService) invalidateCommentCount(ctx context.Context, videoID int64) {
+	if err := s.cache.DeleteCommentCount(ctx, videoID); err != nil {
+		log.Printf("删除评论数缓存失败: %v", err)
+	}
+}
+
+func missingIDs[V any](ids []int64, found map[int64]V) []int64 {
+	var missing []int64
+	for _, id := range ids {
+		if _, ok := found[id]; !ok {
+			missing = append(missing, id)
+		}
+	}
+	return missing
+}
@@ -0,0 +1,110 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/smartystreets/goconvey/convey"
+	"github.com/stretchr/testify/mock"
+	"github.com/yxrxy/videoHub/app/interaction/domain/model"
+)
+
+func TestInteractionService_GetInteractionState(t *testing.T) {
+	type TestCase struct {
+		Name     string
+		UserID   int64
+		VideoIDs []int64
+		// Mock 缓存相关
+		MockCachedLikes     map[int64]int64
+		MockCachedComments  map[int64]int64
+		MockCachedReactions map[int64]map[string]int64
+		MockLiked           map[int64]bool
+		MockLikedCached     bool
+		// Mock 数据库相关
+		MockLikedIDs      []int64
+		MockDBLikes       map[int64]int64
+		MockDBComments    map[int64]int64
+		MockUserReactions map[int64]string
+		MockCollectCounts map[int64]int64
+		MockCollected     map[int64]bool
+		// 预期结果
+		ExpectedStates []*model.VideoInteractionState
+	}
+
+	testCases := []TestCase{
+		{
+			Name:                "全部命中缓存",
+			UserID:              1,
+			VideoIDs:            []int64{10, 11},
+			MockCachedLikes:     map[int64]int64{10: 3, 11: 0},
+			MockCachedComments:  map[int64]int64{10: 2, 11: 5},
+			MockCachedReactions: map[int64]map[string]int64{10: {"👍": 1}, 11: {}},
+			MockLiked:           map[int64]bool{10: true},
+			MockLikedCached:     true,
+			MockUserReactions:   map[int64]string{10: "👍"},
+			MockCollectCounts:   map[int64]int64{11: 2},
+			MockCollected:       map[int64]bool{11: true},
+			ExpectedStates: []*model.VideoInteractionState{
+				{VideoID: 10, IsLiked: true, LikeCount: 3, CommentCount: 2, Reactions: map[string]int64{"👍": 1}, MyReaction: "👍"},
+				{VideoID: 11, IsLiked: false, LikeCount: 0, CommentCount: 5, Reactions: map[string]int64{}, CollectCount: 2, IsCollected: true},
+			},
+		},
+		{
+			Name:                "部分未命中从数据库加载并回填点赞集合",
+			UserID:              1,
+			VideoIDs:            []int64{10, 11},
+			MockCachedLikes:     map[int64]int64{10: 4},
+			MockCachedComments:  map[int64]int64{10: 1},
+			MockCachedReactions: map[int64]map[string]int64{10: {}, 11: {}},
+			MockLikedIDs:        []int64{11, 30},
+			MockDBLikes:         map[int64]int64{11: 2},
+			MockDBComments:      map[int64]int64{11: 7},
+			MockUserReactions:   map[int64]string{},
+			ExpectedStates: []*model.VideoInteractionState{
+				{VideoID: 10, IsLiked: false, LikeCount: 4, CommentCount: 1, Reactions: map[string]int64{}},
+				{VideoID: 11, IsLiked: true, LikeCount: 2, CommentCount: 7, Reactions: map[string]int64{}},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		convey.Convey(tc.Name, t, func() {
+			ctx := context.Background()
+			db := new(MockRepository)
+			cache := new(MockCache)
+			cache.On("GetLikeCounts", ctx, tc.VideoIDs).Return(tc.MockCachedLikes, nil)
+			cache.On("GetCommentCounts", ctx, tc.VideoIDs).Return(tc.MockCachedComments, nil)
+			cache.On("BatchGetReactionCounts", ctx, model.ReactionTargetVideo, tc.VideoIDs).Return(tc.MockCachedReactions, nil)
+			cache.On("SetLikeCount", ctx, mock.Anything, mock.Anything).Return(nil)
+			cache.On("SetCommentCount", ctx, mock.Anything, mock.Anything).Return(nil)
+			cache.On("GetLikedVideos", ctx, tc.UserID, tc.VideoIDs).Return(tc.MockLiked, tc.MockLikedCached, nil)
+			cache.On("SetLikedVideos", ctx, tc.UserID, mock.Anything).Return(nil)
+			db.On("GetUserLikedVideoIDs", ctx, tc.UserID).Return(tc.MockLikedIDs, nil)
+			db.On("CountVideoLikes", ctx, mock.Anything).Return(tc.MockDBLikes, nil)
+			db.On("CountVisibleComments", ctx, mock.Anything).Return(tc.MockDBComments, nil)
+			db.On("GetUserReactions", ctx, tc.UserID, model.ReactionTargetVideo, tc.VideoIDs).Return(tc.MockUserReactions, nil)
+			db.On("GetVideoCollectCounts", ctx, tc.VideoIDs).Return(tc.MockCollectCounts, nil)
+			db.On("GetUserCollectedVideos", ctx, tc.UserID, tc.VideoIDs).Return(tc.MockCollected, nil)
+
+			svc := NewInteractionService(db, cache, new(MockPrivacy), new(MockNotifier))
+			states, err := svc.GetInteractionState(ctx, tc.UserID, tc.VideoIDs)
+
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(states, convey.ShouldResemble, tc.ExpectedStates)
+			db.AssertNotCalled(t, "GetUserLikedVideos", ctx, mock.Anything, mock.Anything)
+			if tc.MockLikedCached {
+				db.AssertNotCalled(t, "GetUserLikedVideoIDs", ctx, tc.UserID)
+			} else {
+				cache.AssertCalled(t, "SetLikedVideos", ctx, tc.UserID, tc.MockLikedIDs)
+			}
+			if tc.MockDBLikes == nil {
+				db.AssertNotCalled(t, "CountVideoLikes", ctx, mock.Anything)
+				db.AssertNotCalled(t, "CountVisibleComments", ctx, mock.Anything)
+			} else {
+				db.AssertCalled(t, "CountVideoLikes", ctx, []int64{11})
+				db.AssertCalled(t, "CountVisibleComments", ctx, []int64{11})
+				cache.AssertCalled(t, "SetLikeCount", ctx, int64(11), int64(2))
+			}
+		})
+	}
+}
@@ -0,0 +1,264 @@
+package cache
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/redis/go-redis/v9"
+	"github.com/yxrxy/videoHub/app/interaction/domain/model"
+	"github.com/yxrxy/videoHub/app/interaction/domain/repository"
+	"github.com/yxrxy/videoHub/pkg/constants"
+)
+
+type InteractionCache struct {
+	client *redis.Client
+}
+
+func NewInteractionCache(client *redis.Client) repository.InteractionCache {
+	return &InteractionCache{client: client}
+}
+
+const (
+	ReactionCountKey = "reaction:count:%d:%d" // 表情计数 hash，field 为表情
+	ReactionDirtyKey = "reaction:dirty"       // 待对账的目标 set，member 为 "类型:ID"
+)
+
+// incrIfExists 仅在计数 hash 已缓存时累加，未缓存时等待下次读取从数据库加载
+var incrIfExists = redis.NewScript(`
+if redis.call("EXISTS", KEYS[1]) == 1 then
+	return redis.call("HINCRBY", KEYS[1], ARGV[1], ARGV[2])
+end
+return 0
+`)
+
+// GetReactionCounts 获取表情计数，第二个返回值表示缓存是否存在
+func (c *InteractionCache) GetReactionCounts(ctx context.Context, targetType int8, targetID int64) (map[string]int64, bool, error) {
+	values, err := c.client.HGetAll(ctx, fmt.Sprintf(ReactionCountKey, targetType, targetID)).Result()
+	if err != nil {
+		return nil, false, err
+	}
+	if len(values) == 0 {
+		return nil, false, nil
+	}
+	counts := make(map[string]int64, len(values))
+	for reaction, v := range values {
+		n, err := strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			return nil, false, err
+		}
+		if n > 0 {
+			counts[reaction] = n
+		}
+	}
+	return counts, true, nil
+}
+
+// SetReactionCounts 用数据库中的计数覆盖缓存
+func (c *InteractionCache) SetReactionCounts(ctx context.Context, targetType int8, targetID int64, counts map[string]int64) error {
+	key := fmt.Sprintf(ReactionCountKey, targetType, targetID)
+	// 空 hash 无法区分未缓存，用占位字段标记“没有任何回应”
+	values := map[string]interface{}{"": 0}
+	for reaction, n := range counts {
+		values[reaction] = n
+	}
+	pipe := c.client.TxPipeline()
+	pipe.Del(ctx, key)
+	pipe.HSet(ctx, key, values)
+	pipe.Expire(ctx, key, constants.ReactionCountExpire)
+	_, err := pipe.Exec(ctx)
+	return err
+}
+
+// IncrReactionCount 累加表情计数
+func (c *InteractionCache) IncrReactionCount(ctx context.Context, targetType int8, targetID int64, reaction string, delta int64) error {
+	key := fmt.Sprintf(ReactionCountKey, targetType, targetID)
+	return incrIfExists.Run(ctx, c.client, []string{key}, reaction, delta).Err()
+}
+
+// MarkReactionDirty 标记目标需要与数据库对账
+func (c *InteractionCache) MarkReactionDirty(ctx context.Context, targetType int8, targetID int64) error {
+	return c.client.SAdd(ctx, ReactionDirtyKey, fmt.Sprintf("%d:%d", targetType, targetID)).Err()
+}
+
+// PopDirtyReactions 取出一批待对账的目标
+func (c *InteractionCache) PopDirtyReactions(ctx context.Context, count int64) ([]*model.ReactionTarget, error) {
+	members, err := c.client.SPopN(ctx, ReactionDirtyKey, count).Result()
+	if err != nil {
+		return nil, err
+	}
+	targets := make([]*model.ReactionTarget, 0, len(members))
+	for _, m := range members {
+		parts := strings.SplitN(m, ":", 2)
+		if len(parts) != 2 {
+			continue
+		}
+		targetType, err := strconv.ParseInt(parts[0], 10, 8)
+		if err != nil {
+			continue
+		}
+		targetID, err := strconv.ParseInt(parts[1], 10, 64)
+		if err != nil {
+			continue
+		}
+		targets = append(targets, &model.ReactionTarget{Type: int8(targetType), ID: targetID})
+	}
+	return targets, nil
+}
+
+const (
+	VideoLikeCountKey  = "video:like_count:%d"    // 视频点赞数
+	CommentCountKey    = "video:comment_count:%d" // 视频可见评论数
+	UserLikedVideosKey = "user:liked_videos:%d"   // 用户点赞的视频 set
+	likedPlaceholder   = "0"                      // 占位成员，区分“未缓存”和“没有点赞”
+)
+
+// updateMemberIfExists 仅在集合已缓存时更新成员
+var updateMemberIfExists = redis.NewScript(`
+if redis.call("EXISTS", KEYS[1]) == 1 then
+	if ARGV[2] == "1" then
+		return redis.call("SADD", KEYS[1], ARGV[1])
+	end
+	return redis.call("SREM", KEYS[1], ARGV[1])
+end
+return 0
+`)
+
+// incrCountIfExists 仅在计数已缓存时增减，避免未缓存时从 0 开始计数
+var incrCountIfExists = redis.NewScript(`
+if redis.call("EXISTS", KEYS[1]) == 1 then
+	return redis.call("INCRBY", KEYS[1], ARGV[1])
+end
+return 0
+`)
+
+// BatchGetReactionCounts 批量获取表情计数，未缓存的目标不出现在结果中
+func (c *InteractionCache) BatchGetReactionCounts(ctx context.Context, targetType int8, targetIDs []int64) (map[int64]map[string]int64, error) {
+	pipe := c.client.Pipeline()
+	cmds := make([]*redis.MapStringStringCmd, len(targetIDs))
+	for i, id := range targetIDs {
+		cmds[i] = pipe.HGetAll(ctx, fmt.Sprintf(ReactionCountKey, targetType, id))
+	}
+	if _, err := pipe.Exec(ctx); err != nil {
+		return nil, err
+	}
+
+	result := make(map[int64]map[string]int64, len(targetIDs))
+	for i, cmd := range cmds {
+		values := cmd.Val()
+		if len(values) == 0 {
+			continue
+		}
+		counts := make(map[string]int64, len(values))
+		for reaction, v := range values {
+			if n, err := strconv.ParseInt(v, 10, 64); err == nil && n > 0 {
+				counts[reaction] = n
+			}
+		}
+		result[targetIDs[i]] = counts
+	}
+	return result, nil
+}
+
+// GetLikeCounts 批量获取点赞数，未缓存的视频不出现在结果中
+func (c *InteractionCache) GetLikeCounts(ctx context.Context, videoIDs []int64) (map[int64]int64, error) {
+	return c.getCounts(ctx, VideoLikeCountKey, videoIDs)
+}
+
+// SetLikeCount 缓存视频点赞数
+func (c *InteractionCache) SetLikeCount(ctx context.Context, videoID int64, count int64) error {
+	return c.client.Set(ctx, fmt.Sprintf(VideoLikeCountKey, videoID), count, constants.LikeCountExpire).Err()
+}
+
+// IncrLikeCount 点赞或取消点赞后更新已缓存的点赞数
+func (c *InteractionCache) IncrLikeCount(ctx context.Context, videoID int64, delta int64) error {
+	return incrCountIfExists.Run(ctx, c.client, []string{fmt.Sprintf(VideoLikeCountKey, videoID)}, delta).Err()
+}
+
+// GetLikedVideos 批量判断用户是否点赞了视频，第二个返回值表示用户的点赞集合是否已缓存
+func (c *InteractionCache) GetLikedVideos(ctx context.Context, userID int64, videoIDs []int64) (map[int64]bool, bool, error) {
+	members := make([]interface{}, 0, len(videoIDs)+1)
+	members = append(members, likedPlaceholder)
+	for _, id := range videoIDs {
+		members = append(members, strconv.FormatInt(id, 10))
+	}
+	flags, err := c.client.SMIsMember(ctx, fmt.Sprintf(UserLikedVideosKey, userID), members...).Result()
+	if err != nil {
+		return nil, false, err
+	}
+	if !flags[0] {
+		return nil, false, nil
+	}
+	liked := make(map[int64]bool, len(videoIDs))
+	for i, id := range videoIDs {
+		if flags[i+1] {
+			liked[id] = true
+		}
+	}
+	return liked, true, nil
+}
+
+// SetLikedVideos 用数据库中用户点赞的视频覆盖缓存
+func (c *InteractionCache) SetLikedVideos(ctx context.Context, userID int64, videoIDs []int64) error {
+	key := fmt.Sprintf(UserLikedVideosKey, userID)
+	members := make([]interface{}, 0, len(videoIDs)+1)
+	members = append(members, likedPlaceholder)
+	for _, id := range videoIDs {
+		members = append(members, strconv.FormatInt(id, 10))
+	}
+	pipe := c.client.TxPipeline()
+	pipe.Del(ctx, key)
+	pipe.SAdd(ctx, key, members...)
+	pipe.Expire(ctx, key, constants.UserLikedVideosExpire)
+	_, err := pipe.Exec(ctx)
+	return err
+}
+
+// UpdateLikedVideo 点赞或取消点赞后更新已缓存的用户点赞集合
+func (c *InteractionCache) UpdateLikedVideo(ctx context.Context, userID, videoID int64, liked bool) error {
+	flag := "0"
+	if liked {
+		flag = "1"
+	}
+	key := fmt.Sprintf(UserLikedVideosKey, userID)
+	return updateMemberIfExists.Run(ctx, c.client, []string{key}, strconv.FormatInt(videoID, 10), flag).Err()
+}
+
+// GetCommentCounts 批量获取评论数，未缓存的视频不出现在结果中
+func (c *InteractionCache) GetCommentCounts(ctx context.Context, videoIDs []int64) (map[int64]int64, error) {
+	return c.getCounts(ctx, CommentCountKey, videoIDs)
+}
+
+// getCounts 按 keyFormat 批量读取视频计数，未缓存的视频不出现在结果中
+func (c *InteractionCache) getCounts(ctx context.Context, keyFormat string, videoIDs []int64) (map[int64]int64, error) {
+	keys := make([]string, len(videoIDs))
+	for i, id := range videoIDs {
+		keys[i] = fmt.Sprintf(keyFormat, id)
+	}
+	values, err := c.client.MGet(ctx, keys...).Result()
+	if err != nil {
+		return nil, err
+	}
+	result := make(map[int64]int64, len(videoIDs))
+	for i, v := range values {
+		s, ok := v.(string)
+		if !ok {
+			continue
+		}
+		if n, err := strconv.ParseInt(s, 10, 64); err == nil {
+			result[videoIDs[i]] = n
+		}
+	}
+	return result, nil
+}
+
+// SetCommentCount 缓存视频评论数
+func (c *InteractionCache) SetCommentCount(ctx context.Context, videoID int64, count int64) error {
+	return c.client.Set(ctx, fmt.Sprintf(CommentCountKey, videoID), count, constants.CommentCountExpire).Err()
+}
+
+// DeleteCommentCount 评论变化后删除缓存的评论数
+func (c *InteractionCache) DeleteCommentCount(ctx context.Context, videoID int64) error {
+	return c.client.Del(ctx, fmt.Sprintf(CommentCountKey, videoID)).Err()
+}
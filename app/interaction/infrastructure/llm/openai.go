@@ -0,0 +1,103 @@
+package llm
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/sashabaranov/go-openai"
+	"github.com/yxrxy/videoHub/app/interaction/domain/model"
+	"github.com/yxrxy/videoHub/app/interaction/domain/repository"
+	"github.com/yxrxy/videoHub/pkg/constants"
+)
+
+type OpenAIModerator struct {
+	client *openai.Client
+	model  string
+}
+
+func NewOpenAIModerator(apiKey string, baseURL string, proxyURL string) repository.TextFilter {
+	config := openai.DefaultConfig(apiKey)
+
+	// 设置基础 URL（如果在配置中指定）
+	if baseURL != "" {
+		config.BaseURL = baseURL
+	}
+
+	// 设置代理（如果在配置中指定）
+	if proxyURL != "" {
+		proxyUrl, err := url.Parse(proxyURL)
+		if err == nil {
+			httpClient := &http.Client{
+				Transport: &http.Transport{
+					Proxy: http.ProxyURL(proxyUrl),
+				},
+			}
+			config.HTTPClient = httpClient
+		}
+	}
+
+	client := openai.NewClientWithConfig(config)
+	return &OpenAIModerator{
+		client: client,
+		model:  openai.GPT4oMini,
+	}
+}
+
+func (o *OpenAIModerator) Name() string {
+	return "llm"
+}
+
+func (o *OpenAIModerator) Check(ctx context.Context, content string) (*model.ModerationResult, error) {
+	systemPrompt := "你是一个视频平台的评论审核员。判断用户评论是否包含辱骂、色情、暴力、违法或广告内容。" +
+		"第一行只回答 PASS、REVIEW 或 REJECT 之一，第二行给出简短理由。"
+	userPrompt := fmt.Sprintf("评论内容：%s", content)
+
+	messages := []openai.ChatCompletionMessage{
+		{
+			Role:    openai.ChatMessageRoleSystem,
+			Content: systemPrompt,
+		},
+		{
+			Role:    openai.ChatMessageRoleUser,
+			Content: userPrompt,
+		},
+	}
+
+	req := openai.ChatCompletionRequest{
+		Model:     o.model,
+		Messages:  messages,
+		MaxTokens: constants.DefaultMaxTokens,
+	}
+
+	resp, err := o.client.CreateChatCompletion(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(resp.Choices) == 0 {
+		return nil, fmt.Errorf("评论审核失败：没有返回结果")
+	}
+
+	// 解析结果
+	lines := strings.SplitN(strings.TrimSpace(resp.Choices[0].Message.Content), "\n", 2)
+	result := &model.ModerationResult{Filter: o.Name()}
+	if len(lines) > 1 {
+		result.Reason = strings.TrimSpace(lines[1])
+	}
+	switch strings.ToUpper(strings.TrimSpace(lines[0])) {
+	case "PASS":
+		result.Verdict = model.ModerationPass
+	case "REJECT":
+		result.Verdict = model.ModerationReject
+	case "REVIEW":
+		result.Verdict = model.ModerationReview
+	default:
+		// 无法识别的回答交由人工审核
+		result.Verdict = model.ModerationReview
+		result.Reason = "无法识别的审核结果"
+	}
+	return result, nil
+}
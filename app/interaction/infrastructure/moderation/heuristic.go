@@ -0,0 +1,80 @@
+package moderation
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"unicode/utf8"
+
+	"github.com/yxrxy/videoHub/app/interaction/domain/model"
+	"github.com/yxrxy/videoHub/app/interaction/domain/repository"
+)
+
+const (
+	// 连续重复字符达到该长度视为刷屏
+	maxRepeatRun = 10
+	// 内容达到该长度后才检查字符多样性
+	minDiversityLength = 20
+	// 不同字符占比低于该值视为刷屏
+	minDiversityRatio = 0.2
+)
+
+var linkPattern = regexp.MustCompile(`(?i)(https?://|www\.)\S+`)
+
+// HeuristicFilter 基于长度、链接数量和重复内容的启发式过滤器
+type HeuristicFilter struct {
+	maxLength int
+	maxLinks  int
+}
+
+// NewHeuristicFilter 创建启发式过滤器，maxLength/maxLinks 小于等于 0 时不做对应检查
+func NewHeuristicFilter(maxLength, maxLinks int) repository.TextFilter {
+	return &HeuristicFilter{maxLength: maxLength, maxLinks: maxLinks}
+}
+
+func (f *HeuristicFilter) Name() string {
+	return "heuristic"
+}
+
+func (f *HeuristicFilter) Check(ctx context.Context, content string) (*model.ModerationResult, error) {
+	length := utf8.RuneCountInString(content)
+	if f.maxLength > 0 && length > f.maxLength {
+		return f.result(model.ModerationReject, fmt.Sprintf("内容长度 %d 超过限制 %d", length, f.maxLength)), nil
+	}
+
+	if links := len(linkPattern.FindAllStringIndex(content, -1)); f.maxLinks > 0 && links > f.maxLinks {
+		return f.result(model.ModerationReview, fmt.Sprintf("包含 %d 个链接", links)), nil
+	}
+
+	if isSpam(content, length) {
+		return f.result(model.ModerationReview, "疑似刷屏"), nil
+	}
+
+	return f.result(model.ModerationPass, ""), nil
+}
+
+func (f *HeuristicFilter) result(verdict int8, reason string) *model.ModerationResult {
+	return &model.ModerationResult{Verdict: verdict, Filter: f.Name(), Reason: reason}
+}
+
+// isSpam 检查连续重复字符和字符多样性
+func isSpam(content string, length int) bool {
+	var prev rune
+	run := 0
+	distinct := make(map[rune]struct{})
+	for _, r := range content {
+		if r == prev {
+			run++
+		} else {
+			prev, run = r, 1
+		}
+		if run >= maxRepeatRun {
+			return true
+		}
+		distinct[r] = struct{}{}
+	}
+	if length < minDiversityLength {
+		return false
+	}
+	return float64(len(distinct))/float64(length) < minDiversityRatio
+}
@@ -0,0 +1,119 @@
+package moderation
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode"
+
+	"github.com/yxrxy/videoHub/app/interaction/domain/model"
+	"github.com/yxrxy/videoHub/app/interaction/domain/repository"
+)
+
+// trieNode DFA 状态节点
+type trieNode struct {
+	children map[rune]*trieNode
+	verdict  int8 // 非零表示该节点为某个词的结尾
+	word     string
+}
+
+// KeywordFilter 基于 DFA 的敏感词过滤器，同时支持正则匹配
+type KeywordFilter struct {
+	root     *trieNode
+	patterns []*regexp.Regexp
+}
+
+// NewKeywordFilter 创建敏感词过滤器
+// blockWords 命中即拒绝，reviewWords 命中后进入人工审核，patterns 为命中即拒绝的正则
+func NewKeywordFilter(blockWords, reviewWords, patterns []string) (repository.TextFilter, error) {
+	f := &KeywordFilter{root: newTrieNode()}
+	for _, w := range reviewWords {
+		f.addWord(w, model.ModerationReview)
+	}
+	for _, w := range blockWords {
+		f.addWord(w, model.ModerationReject)
+	}
+	for _, p := range patterns {
+		re, err := regexp.Compile(p)
+		if err != nil {
+			return nil, fmt.Errorf("编译审核正则 %q 失败: %w", p, err)
+		}
+		f.patterns = append(f.patterns, re)
+	}
+	return f, nil
+}
+
+func newTrieNode() *trieNode {
+	return &trieNode{children: make(map[rune]*trieNode)}
+}
+
+func (f *KeywordFilter) addWord(word string, verdict int8) {
+	runes := normalize(word)
+	if len(runes) == 0 {
+		return
+	}
+	node := f.root
+	for _, r := range runes {
+		next, ok := node.children[r]
+		if !ok {
+			next = newTrieNode()
+			node.children[r] = next
+		}
+		node = next
+	}
+	// 同一个词同时出现在两个词表时取更严格的结论
+	if verdict > node.verdict {
+		node.verdict = verdict
+		node.word = word
+	}
+}
+
+func (f *KeywordFilter) Name() string {
+	return "keyword"
+}
+
+func (f *KeywordFilter) Check(ctx context.Context, content string) (*model.ModerationResult, error) {
+	for _, re := range f.patterns {
+		if re.MatchString(content) {
+			return &model.ModerationResult{
+				Verdict: model.ModerationReject,
+				Filter:  f.Name(),
+				Reason:  fmt.Sprintf("匹配规则 %s", re.String()),
+			}, nil
+		}
+	}
+
+	result := &model.ModerationResult{Verdict: model.ModerationPass, Filter: f.Name()}
+	text := normalize(content)
+	for i := range text {
+		node := f.root
+		for j := i; j < len(text); j++ {
+			next, ok := node.children[text[j]]
+			if !ok {
+				break
+			}
+			node = next
+			if node.verdict > result.Verdict {
+				result.Verdict = node.verdict
+				result.Reason = fmt.Sprintf("命中敏感词 %s", node.word)
+				if result.Verdict == model.ModerationReject {
+					return result, nil
+				}
+			}
+		}
+	}
+	return result, nil
+}
+
+// normalize 统一大小写并去除空白和标点，避免通过插入分隔符绕过过滤
+func normalize(s string) []rune {
+	runes := make([]rune, 0, len(s))
+	for _, r := range strings.ToLower(s) {
+		if unicode.IsSpace(r) || unicode.IsPunct(r) || unicode.IsSymbol(r) {
+			continue
+		}
+		runes = append(runes, r)
+	}
+	return runes
+}
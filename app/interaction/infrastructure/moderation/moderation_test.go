@@ -0,0 +1,113 @@
+package moderation
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/smartystreets/goconvey/convey"
+	"github.com/yxrxy/videoHub/app/interaction/domain/model"
+)
+
+func TestKeywordFilter_Check(t *testing.T) {
+	type TestCase struct {
+		Name            string
+		Content         string
+		ExpectedVerdict int8
+	}
+
+	testCases := []TestCase{
+		{
+			Name:            "正常评论",
+			Content:         "这个视频拍得真好",
+			ExpectedVerdict: model.ModerationPass,
+		},
+		{
+			Name:            "命中拒绝词",
+			Content:         "你就是个傻瓜",
+			ExpectedVerdict: model.ModerationReject,
+		},
+		{
+			Name:            "插入分隔符绕过",
+			Content:         "你就是个 傻-瓜",
+			ExpectedVerdict: model.ModerationReject,
+		},
+		{
+			Name:            "大小写不敏感",
+			Content:         "加我 VX 领福利",
+			ExpectedVerdict: model.ModerationReview,
+		},
+		{
+			Name:            "拒绝词优先于审核词",
+			Content:         "vx 傻瓜",
+			ExpectedVerdict: model.ModerationReject,
+		},
+		{
+			Name:            "命中正则",
+			Content:         "联系电话 13800138000",
+			ExpectedVerdict: model.ModerationReject,
+		},
+	}
+
+	f, err := NewKeywordFilter([]string{"傻瓜"}, []string{"vx", "领福利"}, []string{`1[3-9]\d{9}`})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, tc := range testCases {
+		convey.Convey(tc.Name, t, func() {
+			result, err := f.Check(context.Background(), tc.Content)
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(result.Verdict, convey.ShouldEqual, tc.ExpectedVerdict)
+		})
+	}
+
+	convey.Convey("非法正则", t, func() {
+		_, err := NewKeywordFilter(nil, nil, []string{"("})
+		convey.So(err, convey.ShouldNotBeNil)
+	})
+}
+
+func TestHeuristicFilter_Check(t *testing.T) {
+	type TestCase struct {
+		Name            string
+		Content         string
+		ExpectedVerdict int8
+	}
+
+	testCases := []TestCase{
+		{
+			Name:            "正常评论",
+			Content:         "学到了，感谢分享 https://example.com",
+			ExpectedVerdict: model.ModerationPass,
+		},
+		{
+			Name:            "超过长度限制",
+			Content:         strings.Repeat("好看", 30),
+			ExpectedVerdict: model.ModerationReject,
+		},
+		{
+			Name:            "链接过多",
+			Content:         "http://a.com http://b.com www.c.com",
+			ExpectedVerdict: model.ModerationReview,
+		},
+		{
+			Name:            "连续重复字符",
+			Content:         "哈哈哈哈哈哈哈哈哈哈",
+			ExpectedVerdict: model.ModerationReview,
+		},
+		{
+			Name:            "字符多样性过低",
+			Content:         strings.Repeat("顶一下", 10),
+			ExpectedVerdict: model.ModerationReview,
+		},
+	}
+
+	f := NewHeuristicFilter(50, 2)
+	for _, tc := range testCases {
+		convey.Convey(tc.Name, t, func() {
+			result, err := f.Check(context.Background(), tc.Content)
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(result.Verdict, convey.ShouldEqual, tc.ExpectedVerdict)
+		})
+	}
+}
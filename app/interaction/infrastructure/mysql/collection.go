@@ -0,0 +1,237 @@
+package mysql
+
+import (
+	"context"
+
+	"github.com/yxrxy/videoHub/app/interaction/domain/model"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// CreateCollection 创建收藏夹
+func (i *Interaction) CreateCollection(ctx context.Context, collection *model.Collection) (int64, error) {
+	c := &Collection{
+		UserID:    collection.UserID,
+		Name:      collection.Name,
+		IsPrivate: collection.IsPrivate,
+		IsDefault: collection.IsDefault,
+		SortOrder: collection.SortOrder,
+	}
+	if err := i.db.WithContext(ctx).Create(c).Error; err != nil {
+		return 0, err
+	}
+	return c.ID, nil
+}
+
+// GetCollection 获取收藏夹
+func (i *Interaction) GetCollection(ctx context.Context, collectionID int64) (*model.Collection, error) {
+	var collection Collection
+	err := i.db.WithContext(ctx).
+		Where("id = ?", collectionID).
+		First(&collection).Error
+	if err != nil {
+		return nil, err
+	}
+	return toCollectionModel(&collection), nil
+}
+
+// GetDefaultCollection 获取用户的默认收藏夹
+func (i *Interaction) GetDefaultCollection(ctx context.Context, userID int64) (*model.Collection, error) {
+	var collection Collection
+	err := i.db.WithContext(ctx).
+		Where("user_id = ? AND is_default = ?", userID, true).
+		First(&collection).Error
+	if err != nil {
+		return nil, err
+	}
+	return toCollectionModel(&collection), nil
+}
+
+// GetUserCollections 获取用户的收藏夹列表，按 sort_order 排序
+func (i *Interaction) GetUserCollections(ctx context.Context, userID int64, includePrivate bool) ([]*model.Collection, error) {
+	var collections []*Collection
+	query := i.db.WithContext(ctx).Where("user_id = ?", userID)
+	if !includePrivate {
+		query = query.Where("is_private = ?", false)
+	}
+	if err := query.Order("sort_order ASC, id ASC").Find(&collections).Error; err != nil {
+		return nil, err
+	}
+	result := make([]*model.Collection, 0, len(collections))
+	for _, c := range collections {
+		result = append(result, toCollectionModel(c))
+	}
+	return result, nil
+}
+
+// CountUserCollections 统计用户的收藏夹数量
+func (i *Interaction) CountUserCollections(ctx context.Context, userID int64) (int64, error) {
+	var count int64
+	err := i.db.WithContext(ctx).Model(&Collection{}).
+		Where("user_id = ?", userID).
+		Count(&count).Error
+	return count, err
+}
+
+// UpdateCollection 修改收藏夹名称或可见性
+func (i *Interaction) UpdateCollection(ctx context.Context, collectionID int64, update *model.CollectionUpdate) error {
+	updates := make(map[string]any, 2)
+	if update.Name != nil {
+		updates["name"] = *update.Name
+	}
+	if update.IsPrivate != nil {
+		updates["is_private"] = *update.IsPrivate
+	}
+	if len(updates) == 0 {
+		return nil
+	}
+	return i.db.WithContext(ctx).Model(&Collection{}).
+		Where("id = ?", collectionID).
+		Updates(updates).Error
+}
+
+// DeleteCollection 删除收藏夹及其中的收藏记录
+func (i *Interaction) DeleteCollection(ctx context.Context, collectionID int64) error {
+	return i.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("collection_id = ?", collectionID).Delete(&CollectionVideo{}).Error; err != nil {
+			return err
+		}
+		return tx.Where("id = ?", collectionID).Delete(&Collection{}).Error
+	})
+}
+
+// ReorderCollections 按 collectionIDs 的顺序重写用户收藏夹的 sort_order
+func (i *Interaction) ReorderCollections(ctx context.Context, userID int64, collectionIDs []int64) error {
+	return i.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		for order, id := range collectionIDs {
+			if err := tx.Model(&Collection{}).
+				Where("id = ? AND user_id = ?", id, userID).
+				Update("sort_order", order).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// AddCollectionVideo 向收藏夹添加视频，重复添加不报错
+func (i *Interaction) AddCollectionVideo(ctx context.Context, collectionID, videoID int64) error {
+	return i.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&CollectionVideo{
+			CollectionID: collectionID,
+			VideoID:      videoID,
+		})
+		if result.Error != nil || result.RowsAffected == 0 {
+			return result.Error
+		}
+		return tx.Model(&Collection{}).
+			Where("id = ?", collectionID).
+			Update("video_count", gorm.Expr("video_count + 1")).Error
+	})
+}
+
+// RemoveCollectionVideo 从收藏夹移除视频
+func (i *Interaction) RemoveCollectionVideo(ctx context.Context, collectionID, videoID int64) error {
+	return i.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Where("collection_id = ? AND video_id = ?", collectionID, videoID).Delete(&CollectionVideo{})
+		if result.Error != nil || result.RowsAffected == 0 {
+			return result.Error
+		}
+		return tx.Model(&Collection{}).
+			Where("id = ? AND video_count > 0", collectionID).
+			Update("video_count", gorm.Expr("video_count - 1")).Error
+	})
+}
+
+// GetCollectionVideos 获取收藏夹中的视频ID，按收藏时间倒序
+func (i *Interaction) GetCollectionVideos(ctx context.Context, collectionID int64, offset, limit int) ([]int64, int64, error) {
+	var total int64
+	query := i.db.WithContext(ctx).Model(&CollectionVideo{}).Where("collection_id = ?", collectionID)
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+	var videoIDs []int64
+	err := query.Order("created_at DESC, id DESC").
+		Offset(offset).
+		Limit(limit).
+		Pluck("video_id", &videoIDs).Error
+	if err != nil {
+		return nil, 0, err
+	}
+	return videoIDs, total, nil
+}
+
+// RemoveVideoFromCollections 视频删除后将其从所有收藏夹中移除
+func (i *Interaction) RemoveVideoFromCollections(ctx context.Context, videoID int64) error {
+	return i.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var collectionIDs []int64
+		if err := tx.Model(&CollectionVideo{}).
+			Where("video_id = ?", videoID).
+			Pluck("collection_id", &collectionIDs).Error; err != nil {
+			return err
+		}
+		if len(collectionIDs) == 0 {
+			return nil
+		}
+		if err := tx.Where("video_id = ?", videoID).Delete(&CollectionVideo{}).Error; err != nil {
+			return err
+		}
+		return tx.Model(&Collection{}).
+			Where("id IN ? AND video_count > 0", collectionIDs).
+			Update("video_count", gorm.Expr("video_count - 1")).Error
+	})
+}
+
+// GetVideoCollectCounts 批量统计收藏了视频的用户数
+func (i *Interaction) GetVideoCollectCounts(ctx context.Context, videoIDs []int64) (map[int64]int64, error) {
+	var rows []struct {
+		VideoID int64
+		Count   int64
+	}
+	err := i.db.WithContext(ctx).Table("collection_videos AS cv").
+		Select("cv.video_id, COUNT(DISTINCT c.user_id) AS count").
+		Joins("JOIN collections AS c ON c.id = cv.collection_id").
+		Where("cv.video_id IN ?", videoIDs).
+		Group("cv.video_id").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+	result := make(map[int64]int64, len(rows))
+	for _, row := range rows {
+		result[row.VideoID] = row.Count
+	}
+	return result, nil
+}
+
+// GetUserCollectedVideos 批量判断用户是否收藏了视频
+func (i *Interaction) GetUserCollectedVideos(ctx context.Context, userID int64, videoIDs []int64) (map[int64]bool, error) {
+	var collected []int64
+	err := i.db.WithContext(ctx).Table("collection_videos AS cv").
+		Distinct("cv.video_id").
+		Joins("JOIN collections AS c ON c.id = cv.collection_id").
+		Where("c.user_id = ? AND cv.video_id IN ?", userID, videoIDs).
+		Pluck("cv.video_id", &collected).Error
+	if err != nil {
+		return nil, err
+	}
+	result := make(map[int64]bool, len(collected))
+	for _, id := range collected {
+		result[id] = true
+	}
+	return result, nil
+}
+
+func toCollectionModel(c *Collection) *model.Collection {
+	return &model.Collection{
+		ID:         c.ID,
+		UserID:     c.UserID,
+		Name:       c.Name,
+		IsPrivate:  c.IsPrivate,
+		IsDefault:  c.IsDefault,
+		SortOrder:  c.SortOrder,
+		VideoCount: c.VideoCount,
+		CreatedAt:  c.CreatedAt,
+		UpdatedAt:  c.UpdatedAt,
+	}
+}
@@ -2,10 +2,12 @@ package mysql
 
 import (
 	"context"
+	"time"
 
 	"github.com/yxrxy/videoHub/app/interaction/domain/model"
-	model2 "github.com/yxrxy/videoHub/app/video/domain/model"
+	videomysql "github.com/yxrxy/videoHub/app/video/infrastructure/mysql"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type Interaction struct {
@@ -46,7 +48,7 @@ func (i *Interaction) CreateLike(ctx context.Context, userID, videoID int64) err
 func (i *Interaction) GetLike(ctx context.Context, userID, videoID int64) (*model.Like, error) {
 	var like Like
 	err := i.db.WithContext(ctx).
-		Where("video_id = ? AND deleted_at IS NULL", videoID).
+		Where("user_id = ? AND video_id = ? AND deleted_at IS NULL", userID, videoID).
 		First(&like).Error
 	if err != nil {
 		return nil, err
@@ -59,20 +61,25 @@ func (i *Interaction) GetLike(ctx context.Context, userID, videoID int64) (*mode
 
 // DeleteLike 删除点赞
 func (i *Interaction) DeleteLike(ctx context.Context, like *model.Like) error {
-	return i.db.WithContext(ctx).Delete(&Like{
-		UserID:  like.UserID,
-		VideoID: like.VideoID,
-	}).Error
+	return i.db.WithContext(ctx).
+		Where("user_id = ? AND video_id = ?", like.UserID, like.VideoID).
+		Delete(&Like{}).Error
 }
 
 // CreateComment 创建评论
-func (i *Interaction) CreateComment(ctx context.Context, userID, videoID int64, content string, parentID *int64) error {
-	return i.db.WithContext(ctx).Create(&Comment{
-		UserID:   userID,
-		VideoID:  videoID,
-		Content:  content,
-		ParentID: parentID,
-	}).Error
+func (i *Interaction) CreateComment(ctx context.Context, comment *model.Comment) (int64, error) {
+	c := &Comment{
+		UserID:           comment.UserID,
+		VideoID:          comment.VideoID,
+		Content:          comment.Content,
+		ParentID:         comment.ParentID,
+		Status:           comment.Status,
+		ModerationReason: comment.ModerationReason,
+	}
+	if err := i.db.WithContext(ctx).Create(c).Error; err != nil {
+		return 0, err
+	}
+	return c.ID, nil
 }
 
 // GetComment 获取评论
@@ -84,19 +91,13 @@ func (i *Interaction) GetComment(ctx context.Context, commentID int64) (*model.C
 	if err != nil {
 		return nil, err
 	}
-	return &model.Comment{
-		ID:       comment.ID,
-		UserID:   comment.UserID,
-		VideoID:  comment.VideoID,
-		Content:  comment.Content,
-		ParentID: comment.ParentID,
-	}, nil
+	return toCommentModel(&comment), nil
 }
 
 // IsVideoExist 检查视频是否存在
 func (i *Interaction) IsVideoExist(ctx context.Context, videoID int64) (bool, error) {
 	var count int64
-	err := i.db.WithContext(ctx).Model(&model2.Video{}).
+	err := i.db.WithContext(ctx).Model(&videomysql.Video{}).
 		Where("id = ?", videoID).
 		Count(&count).Error
 	if err != nil {
@@ -105,14 +106,15 @@ func (i *Interaction) IsVideoExist(ctx context.Context, videoID int64) (bool, er
 	return count > 0, nil
 }
 
-// GetCommentList 获取评论列表
-func (i *Interaction) GetCommentList(ctx context.Context, videoID int64, offset, limit int) ([]*model.Comment, int64, error) {
+// GetCommentList 获取评论列表，未通过审核的评论只对作者本人（viewerID）可见
+func (i *Interaction) GetCommentList(ctx context.Context, videoID, viewerID int64, offset, limit int) ([]*model.Comment, int64, error) {
 	var comments []*Comment
 	var total int64
 
 	// 获取总数
 	err := i.db.WithContext(ctx).Model(&Comment{}).
 		Where("video_id = ? AND deleted_at IS NULL", videoID).
+		Where("status = ? OR user_id = ?", model.CommentStatusVisible, viewerID).
 		Count(&total).Error
 	if err != nil {
 		return nil, 0, err
@@ -121,6 +123,8 @@ func (i *Interaction) GetCommentList(ctx context.Context, videoID int64, offset,
 	// 获取评论列表
 	err = i.db.WithContext(ctx).
 		Where("video_id = ? AND deleted_at IS NULL", videoID).
+		Where("status = ? OR user_id = ?", model.CommentStatusVisible, viewerID).
+		Order("is_pinned DESC, id ASC").
 		Offset(offset).
 		Limit(limit).
 		Find(&comments).Error
@@ -129,18 +133,51 @@ func (i *Interaction) GetCommentList(ctx context.Context, videoID int64, offset,
 	}
 	var result []*model.Comment
 	for _, comment := range comments {
-		result = append(result, &model.Comment{
-			ID:        comment.ID,
-			UserID:    comment.UserID,
-			VideoID:   comment.VideoID,
-			Content:   comment.Content,
-			ParentID:  comment.ParentID,
-			LikeCount: comment.LikeCount,
-		})
+		result = append(result, toCommentModel(comment))
+	}
+	return result, total, nil
+}
+
+// GetCommentsByStatus 按审核状态获取评论
+func (i *Interaction) GetCommentsByStatus(ctx context.Context, status int8, offset, limit int) ([]*model.Comment, int64, error) {
+	var comments []*Comment
+	var total int64
+
+	err := i.db.WithContext(ctx).Model(&Comment{}).
+		Where("status = ? AND deleted_at IS NULL", status).
+		Count(&total).Error
+	if err != nil {
+		return nil, 0, err
+	}
+
+	err = i.db.WithContext(ctx).
+		Where("status = ? AND deleted_at IS NULL", status).
+		Order("id ASC").
+		Offset(offset).
+		Limit(limit).
+		Find(&comments).Error
+	if err != nil {
+		return nil, 0, err
+	}
+	result := make([]*model.Comment, 0, len(comments))
+	for _, comment := range comments {
+		result = append(result, toCommentModel(comment))
 	}
 	return result, total, nil
 }
 
+// UpdateCommentStatus 更新评论审核状态
+func (i *Interaction) UpdateCommentStatus(ctx context.Context, commentID int64, status int8, reason string, reviewerID int64) error {
+	return i.db.WithContext(ctx).Model(&Comment{}).
+		Where("id = ? AND deleted_at IS NULL", commentID).
+		Updates(map[string]interface{}{
+			"status":            status,
+			"moderation_reason": reason,
+			"reviewed_by":       reviewerID,
+			"reviewed_at":       time.Now().Unix(),
+		}).Error
+}
+
 // DeleteComment 删除评论
 func (i *Interaction) DeleteComment(ctx context.Context, comment *model.Comment) error {
 	return i.db.WithContext(ctx).Delete(&Comment{
@@ -181,3 +218,279 @@ func (i *Interaction) GetCommentLikeCount(ctx context.Context, commentID int64)
 		Count(&count).Error
 	return count, err
 }
+
+// EditComment 编辑评论，同时保存编辑前的内容
+func (i *Interaction) EditComment(ctx context.Context, comment *model.Comment, content string, status int8, reason string) error {
+	now := time.Now().Unix()
+	return i.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(&CommentEdit{
+			CommentID: comment.ID,
+			Content:   comment.Content,
+			EditedAt:  now,
+		}).Error; err != nil {
+			return err
+		}
+		return tx.Model(&Comment{}).
+			Where("id = ? AND deleted_at IS NULL", comment.ID).
+			Updates(map[string]interface{}{
+				"content":           content,
+				"status":            status,
+				"moderation_reason": reason,
+				"edited_at":         now,
+			}).Error
+	})
+}
+
+// GetCommentEdits 获取评论编辑记录
+func (i *Interaction) GetCommentEdits(ctx context.Context, commentID int64) ([]*model.CommentEdit, error) {
+	var edits []*CommentEdit
+	err := i.db.WithContext(ctx).
+		Where("comment_id = ?", commentID).
+		Order("id DESC").
+		Find(&edits).Error
+	if err != nil {
+		return nil, err
+	}
+	result := make([]*model.CommentEdit, 0, len(edits))
+	for _, edit := range edits {
+		result = append(result, &model.CommentEdit{
+			ID:        edit.ID,
+			CommentID: edit.CommentID,
+			Content:   edit.Content,
+			EditedAt:  edit.EditedAt,
+		})
+	}
+	return result, nil
+}
+
+// SetCommentPinned 置顶或取消置顶评论，每个视频最多一条置顶评论
+func (i *Interaction) SetCommentPinned(ctx context.Context, videoID, commentID int64, pinned bool) error {
+	return i.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if pinned {
+			if err := tx.Model(&Comment{}).
+				Where("video_id = ? AND is_pinned = ?", videoID, true).
+				Update("is_pinned", false).Error; err != nil {
+				return err
+			}
+		}
+		return tx.Model(&Comment{}).
+			Where("id = ?", commentID).
+			Update("is_pinned", pinned).Error
+	})
+}
+
+// SetCommentHearted 作者点亮或取消点亮评论
+func (i *Interaction) SetCommentHearted(ctx context.Context, commentID int64, hearted bool) error {
+	return i.db.WithContext(ctx).Model(&Comment{}).
+		Where("id = ?", commentID).
+		Update("is_hearted", hearted).Error
+}
+
+// GetVideoAuthorID 获取视频作者ID
+func (i *Interaction) GetVideoAuthorID(ctx context.Context, videoID int64) (int64, error) {
+	var video videomysql.Video
+	err := i.db.WithContext(ctx).
+		Select("user_id").
+		Where("id = ?", videoID).
+		First(&video).Error
+	if err != nil {
+		return 0, err
+	}
+	return video.UserID, nil
+}
+
+// GetReaction 获取用户对目标的表情回应
+func (i *Interaction) GetReaction(ctx context.Context, userID int64, targetType int8, targetID int64) (*model.Reaction, error) {
+	var reaction Reaction
+	err := i.db.WithContext(ctx).
+		Where("user_id = ? AND target_type = ? AND target_id = ?", userID, targetType, targetID).
+		First(&reaction).Error
+	if err != nil {
+		return nil, err
+	}
+	return toReactionModel(&reaction), nil
+}
+
+// UpsertReaction 创建或替换用户的表情回应
+func (i *Interaction) UpsertReaction(ctx context.Context, reaction *model.Reaction) error {
+	return i.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "user_id"}, {Name: "target_type"}, {Name: "target_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"reaction", "created_at"}),
+	}).Create(&Reaction{
+		UserID:     reaction.UserID,
+		TargetType: reaction.TargetType,
+		TargetID:   reaction.TargetID,
+		Reaction:   reaction.Reaction,
+	}).Error
+}
+
+// DeleteReaction 删除用户的表情回应
+func (i *Interaction) DeleteReaction(ctx context.Context, userID int64, targetType int8, targetID int64) error {
+	return i.db.WithContext(ctx).
+		Where("user_id = ? AND target_type = ? AND target_id = ?", userID, targetType, targetID).
+		Delete(&Reaction{}).Error
+}
+
+// GetReactionCounts 按表情统计目标的回应数量
+func (i *Interaction) GetReactionCounts(ctx context.Context, targetType int8, targetID int64) (map[string]int64, error) {
+	var rows []struct {
+		Reaction string
+		Count    int64
+	}
+	err := i.db.WithContext(ctx).Model(&Reaction{}).
+		Select("reaction, COUNT(*) AS count").
+		Where("target_type = ? AND target_id = ?", targetType, targetID).
+		Group("reaction").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+	counts := make(map[string]int64, len(rows))
+	for _, row := range rows {
+		counts[row.Reaction] = row.Count
+	}
+	return counts, nil
+}
+
+// GetReactionList 获取目标的表情回应列表，reaction 为空时不筛选
+func (i *Interaction) GetReactionList(
+	ctx context.Context, targetType int8, targetID int64, reaction string, offset, limit int,
+) ([]*model.Reaction, int64, error) {
+	var reactions []*Reaction
+	var total int64
+
+	query := i.db.WithContext(ctx).Model(&Reaction{}).
+		Where("target_type = ? AND target_id = ?", targetType, targetID)
+	if reaction != "" {
+		query = query.Where("reaction = ?", reaction)
+	}
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+	err := query.Order("created_at DESC, id DESC").
+		Offset(offset).
+		Limit(limit).
+		Find(&reactions).Error
+	if err != nil {
+		return nil, 0, err
+	}
+	result := make([]*model.Reaction, 0, len(reactions))
+	for _, r := range reactions {
+		result = append(result, toReactionModel(r))
+	}
+	return result, total, nil
+}
+
+// GetUserReactions 批量获取用户对多个目标的表情
+func (i *Interaction) GetUserReactions(ctx context.Context, userID int64, targetType int8, targetIDs []int64) (map[int64]string, error) {
+	var reactions []*Reaction
+	err := i.db.WithContext(ctx).
+		Where("user_id = ? AND target_type = ? AND target_id IN ?", userID, targetType, targetIDs).
+		Find(&reactions).Error
+	if err != nil {
+		return nil, err
+	}
+	result := make(map[int64]string, len(reactions))
+	for _, r := range reactions {
+		result[r.TargetID] = r.Reaction
+	}
+	return result, nil
+}
+
+// GetUserLikedVideos 批量判断用户是否点赞了视频
+func (i *Interaction) GetUserLikedVideos(ctx context.Context, userID int64, videoIDs []int64) (map[int64]bool, error) {
+	var liked []int64
+	err := i.db.WithContext(ctx).Model(&Like{}).
+		Where("user_id = ? AND video_id IN ? AND deleted_at IS NULL", userID, videoIDs).
+		Pluck("video_id", &liked).Error
+	if err != nil {
+		return nil, err
+	}
+	result := make(map[int64]bool, len(liked))
+	for _, id := range liked {
+		result[id] = true
+	}
+	return result, nil
+}
+
+// GetUserLikedVideoIDs 获取用户点赞的全部视频ID
+func (i *Interaction) GetUserLikedVideoIDs(ctx context.Context, userID int64) ([]int64, error) {
+	var videoIDs []int64
+	err := i.db.WithContext(ctx).Model(&Like{}).
+		Where("user_id = ? AND deleted_at IS NULL", userID).
+		Pluck("video_id", &videoIDs).Error
+	return videoIDs, err
+}
+
+// CountVideoLikes 批量统计视频的点赞数
+func (i *Interaction) CountVideoLikes(ctx context.Context, videoIDs []int64) (map[int64]int64, error) {
+	var rows []struct {
+		VideoID int64
+		Count   int64
+	}
+	err := i.db.WithContext(ctx).Model(&Like{}).
+		Select("video_id, COUNT(*) AS count").
+		Where("video_id IN ? AND deleted_at IS NULL", videoIDs).
+		Group("video_id").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+	result := make(map[int64]int64, len(rows))
+	for _, row := range rows {
+		result[row.VideoID] = row.Count
+	}
+	return result, nil
+}
+
+// CountVisibleComments 批量统计视频的可见评论数
+func (i *Interaction) CountVisibleComments(ctx context.Context, videoIDs []int64) (map[int64]int64, error) {
+	var rows []struct {
+		VideoID int64
+		Count   int64
+	}
+	err := i.db.WithContext(ctx).Model(&Comment{}).
+		Select("video_id, COUNT(*) AS count").
+		Where("video_id IN ? AND status = ? AND deleted_at IS NULL", videoIDs, model.CommentStatusVisible).
+		Group("video_id").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+	result := make(map[int64]int64, len(rows))
+	for _, row := range rows {
+		result[row.VideoID] = row.Count
+	}
+	return result, nil
+}
+
+func toReactionModel(r *Reaction) *model.Reaction {
+	return &model.Reaction{
+		ID:         r.ID,
+		UserID:     r.UserID,
+		TargetType: r.TargetType,
+		TargetID:   r.TargetID,
+		Reaction:   r.Reaction,
+		CreatedAt:  r.CreatedAt,
+	}
+}
+
+func toCommentModel(comment *Comment) *model.Comment {
+	c := &model.Comment{
+		ID:               comment.ID,
+		UserID:           comment.UserID,
+		VideoID:          comment.VideoID,
+		Content:          comment.Content,
+		ParentID:         comment.ParentID,
+		LikeCount:        comment.LikeCount,
+		Status:           comment.Status,
+		ModerationReason: comment.ModerationReason,
+		CreatedAt:        comment.CreatedAt,
+		IsPinned:         comment.IsPinned,
+		IsHearted:        comment.IsHearted,
+	}
+	if comment.EditedAt != nil {
+		c.EditedAt = *comment.EditedAt
+	}
+	return c
+}
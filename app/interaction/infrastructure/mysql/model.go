@@ -4,7 +4,7 @@ package mysql
 type Like struct {
 	ID        int64  `gorm:"primarykey;column:id;comment:点赞ID"`
 	UserID    int64  `gorm:"index:idx_user_video;not null;column:user_id;comment:用户ID"`
-	VideoID   int64  `gorm:"index:idx_user_video;not null;column:video_id;comment:视频ID"`
+	VideoID   int64  `gorm:"index:idx_user_video;index:idx_video;not null;column:video_id;comment:视频ID"`
 	DeletedAt *int64 `gorm:"column:deleted_at;comment:删除时间"`
 }
 
@@ -15,13 +15,21 @@ func (Like) TableName() string {
 
 // Comment 评论模型
 type Comment struct {
-	ID        int64  `gorm:"primarykey;column:id;comment:评论ID"`
-	UserID    int64  `gorm:"index:idx_video;not null;column:user_id;comment:用户ID"`
-	VideoID   int64  `gorm:"index:idx_video;not null;column:video_id;comment:视频ID"`
-	Content   string `gorm:"type:text;not null;column:content;comment:评论内容"`
-	ParentID  *int64 `gorm:"column:parent_id;comment:父评论ID"`
-	LikeCount int32  `gorm:"default:0;column:like_count;comment:点赞数"`
-	DeletedAt *int64 `gorm:"column:deleted_at;comment:删除时间"`
+	ID               int64  `gorm:"primarykey;column:id;comment:评论ID"`
+	UserID           int64  `gorm:"index:idx_video;not null;column:user_id;comment:用户ID"`
+	VideoID          int64  `gorm:"index:idx_video;not null;column:video_id;comment:视频ID"`
+	Content          string `gorm:"type:text;not null;column:content;comment:评论内容"`
+	ParentID         *int64 `gorm:"column:parent_id;comment:父评论ID"`
+	LikeCount        int32  `gorm:"default:0;column:like_count;comment:点赞数"`
+	Status           int8   `gorm:"index:idx_status;default:0;column:status;comment:审核状态"`
+	ModerationReason string `gorm:"type:varchar(255);column:moderation_reason;comment:审核原因"`
+	ReviewedBy       *int64 `gorm:"column:reviewed_by;comment:审核人ID"`
+	ReviewedAt       *int64 `gorm:"column:reviewed_at;comment:审核时间"`
+	IsPinned         bool   `gorm:"default:false;column:is_pinned;comment:是否置顶"`
+	IsHearted        bool   `gorm:"default:false;column:is_hearted;comment:是否被作者点亮"`
+	CreatedAt        int64  `gorm:"autoCreateTime;column:created_at;comment:创建时间"`
+	EditedAt         *int64 `gorm:"column:edited_at;comment:最后编辑时间"`
+	DeletedAt        *int64 `gorm:"column:deleted_at;comment:删除时间"`
 }
 
 // TableName 指定表名
@@ -29,6 +37,19 @@ func (Comment) TableName() string {
 	return "comments"
 }
 
+// CommentEdit 评论编辑记录模型
+type CommentEdit struct {
+	ID        int64  `gorm:"primarykey;column:id;comment:记录ID"`
+	CommentID int64  `gorm:"index:idx_comment;not null;column:comment_id;comment:评论ID"`
+	Content   string `gorm:"type:text;not null;column:content;comment:编辑前内容"`
+	EditedAt  int64  `gorm:"not null;column:edited_at;comment:编辑时间"`
+}
+
+// TableName 指定表名
+func (CommentEdit) TableName() string {
+	return "comment_edits"
+}
+
 // CommentLike 评论点赞模型
 type CommentLike struct {
 	UserID    int64 `gorm:"index:idx_user_comment;not null;comment:用户ID"`
@@ -39,3 +60,49 @@ type CommentLike struct {
 func (CommentLike) TableName() string {
 	return "comment_likes"
 }
+
+// Reaction 表情回应模型
+type Reaction struct {
+	ID         int64  `gorm:"primarykey;column:id;comment:回应ID"`
+	UserID     int64  `gorm:"uniqueIndex:uk_user_target;not null;column:user_id;comment:用户ID"`
+	TargetType int8   `gorm:"uniqueIndex:uk_user_target;index:idx_target;not null;column:target_type;comment:目标类型"`
+	TargetID   int64  `gorm:"uniqueIndex:uk_user_target;index:idx_target;not null;column:target_id;comment:目标ID"`
+	Reaction   string `gorm:"type:varchar(32);not null;column:reaction;comment:表情"`
+	CreatedAt  int64  `gorm:"autoCreateTime;column:created_at;comment:创建时间"`
+}
+
+// TableName 指定表名
+func (Reaction) TableName() string {
+	return "reactions"
+}
+
+// Collection 收藏夹模型
+type Collection struct {
+	ID         int64  `gorm:"primarykey;column:id;comment:收藏夹ID"`
+	UserID     int64  `gorm:"uniqueIndex:uk_user_name;not null;column:user_id;comment:用户ID"`
+	Name       string `gorm:"uniqueIndex:uk_user_name;type:varchar(64);not null;column:name;comment:收藏夹名称"`
+	IsPrivate  bool   `gorm:"default:false;column:is_private;comment:是否私密"`
+	IsDefault  bool   `gorm:"default:false;column:is_default;comment:是否为默认收藏夹"`
+	SortOrder  int32  `gorm:"default:0;column:sort_order;comment:排序值"`
+	VideoCount int64  `gorm:"default:0;column:video_count;comment:视频数量"`
+	CreatedAt  int64  `gorm:"autoCreateTime;column:created_at;comment:创建时间"`
+	UpdatedAt  int64  `gorm:"autoUpdateTime;column:updated_at;comment:更新时间"`
+}
+
+// TableName 指定表名
+func (Collection) TableName() string {
+	return "collections"
+}
+
+// CollectionVideo 收藏夹中的视频
+type CollectionVideo struct {
+	ID           int64 `gorm:"primarykey;column:id;comment:记录ID"`
+	CollectionID int64 `gorm:"uniqueIndex:uk_collection_video;not null;column:collection_id;comment:收藏夹ID"`
+	VideoID      int64 `gorm:"uniqueIndex:uk_collection_video;index:idx_video;not null;column:video_id;comment:视频ID"`
+	CreatedAt    int64 `gorm:"autoCreateTime;column:created_at;comment:收藏时间"`
+}
+
+// TableName 指定表名
+func (CollectionVideo) TableName() string {
+	return "collection_videos"
+}
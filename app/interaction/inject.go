@@ -1,12 +1,23 @@
 package interaction
 
 import (
+	"context"
+
 	"github.com/yxrxy/videoHub/app/interaction/controllers/rpc"
+	"github.com/yxrxy/videoHub/app/interaction/domain/repository"
 	"github.com/yxrxy/videoHub/app/interaction/domain/service"
+	"github.com/yxrxy/videoHub/app/interaction/infrastructure/cache"
+	"github.com/yxrxy/videoHub/app/interaction/infrastructure/llm"
+	"github.com/yxrxy/videoHub/app/interaction/infrastructure/moderation"
 	"github.com/yxrxy/videoHub/app/interaction/infrastructure/mysql"
 	"github.com/yxrxy/videoHub/app/interaction/usecase"
+	socialmq "github.com/yxrxy/videoHub/app/social/infrastructure/mq"
+	userservice "github.com/yxrxy/videoHub/app/user/domain/service"
+	usermysql "github.com/yxrxy/videoHub/app/user/infrastructure/mysql"
+	"github.com/yxrxy/videoHub/config"
 	"github.com/yxrxy/videoHub/kitex_gen/interaction"
 	"github.com/yxrxy/videoHub/pkg/base/client"
+	"github.com/yxrxy/videoHub/pkg/kafka"
 )
 
 func InjectInteractionHandler() interaction.InteractionService {
@@ -15,9 +26,39 @@ func InjectInteractionHandler() interaction.InteractionService {
 		panic(err)
 	}
 
+	re, err := client.NewRedisClient(config.Redis.DB.Interaction)
+	if err != nil {
+		panic(err)
+	}
+
 	db := mysql.NewInteraction(gormDB)
-	svc := service.NewInteractionService(db)
+	redisCache := cache.NewInteractionCache(re)
+	privacy := userservice.NewPrivacyGuard(usermysql.NewUserDB(gormDB))
+	notifier := socialmq.NewNotificationMQ(kafka.NewKafkaInstance())
+	svc := service.NewInteractionService(db, redisCache, privacy, notifier, newCommentFilters()...)
+	svc.StartReactionReconciler(context.Background())
 	uc := usecase.NewInteractionCase(db, svc)
 
 	return rpc.NewInteractionHandler(uc)
 }
+
+// newCommentFilters 根据配置构建评论审核过滤链
+func newCommentFilters() []repository.TextFilter {
+	cfg := config.VideoInteractions.Moderation
+	if !cfg.Enabled {
+		return nil
+	}
+
+	keyword, err := moderation.NewKeywordFilter(cfg.BlockWords, cfg.ReviewWords, cfg.BlockPatterns)
+	if err != nil {
+		panic(err)
+	}
+	filters := []repository.TextFilter{
+		keyword,
+		moderation.NewHeuristicFilter(cfg.MaxLength, cfg.MaxLinks),
+	}
+	if cfg.EnableLLM {
+		filters = append(filters, llm.NewOpenAIModerator(config.ApiKey.Key, config.ApiKey.BaseURL, config.ApiKey.Proxy))
+	}
+	return filters
+}
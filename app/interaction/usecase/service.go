@@ -2,10 +2,9 @@ package usecase
 
 import (
 	"context"
-	"errors"
 
 	"github.com/yxrxy/videoHub/app/interaction/domain/model"
-	"gorm.io/gorm"
+	"github.com/yxrxy/videoHub/pkg/errno"
 )
 
 // Like 点赞操作
@@ -14,38 +13,23 @@ func (s *useCase) Like(ctx context.Context, userID int64, videoID int64) (bool,
 }
 
 // GetLikes 获取点赞列表
-func (s *useCase) GetLikes(ctx context.Context, videoID int64, page int32, size int32) ([]*model.Like, error) {
-	return s.svc.GetLikes(ctx, videoID, page, size)
+func (s *useCase) GetLikes(ctx context.Context, viewerID, videoID int64, page int32, size int32) ([]*model.Like, error) {
+	return s.svc.GetLikes(ctx, viewerID, videoID, page, size)
 }
 
 // Comment 发表评论
-func (s *useCase) Comment(ctx context.Context, userID int64, videoID int64, content string, parentID int64) (bool, error) {
+func (s *useCase) Comment(ctx context.Context, userID int64, videoID int64, content string, parentID int64) (int64, error) {
 	return s.svc.Comment(ctx, userID, videoID, content, parentID)
 }
 
 // GetComments 获取评论列表
-func (s *useCase) GetComments(ctx context.Context, videoID int64, page int32, size int32) ([]*model.Comment, error) {
-	return s.svc.GetComments(ctx, videoID, page, size)
+func (s *useCase) GetComments(ctx context.Context, videoID int64, viewerID int64, page int32, size int32) ([]*model.Comment, error) {
+	return s.svc.GetComments(ctx, videoID, viewerID, page, size)
 }
 
 // DeleteComment 删除评论
 func (s *useCase) DeleteComment(ctx context.Context, userID int64, commentID int64) (bool, error) {
-	// 获取评论
-	comment, err := s.db.GetComment(ctx, commentID)
-	if err != nil {
-		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return false, err
-		}
-		return false, err
-	}
-
-	// 检查是否是评论作者
-	if comment.UserID != userID {
-		return false, err
-	}
-
-	// 删除评论
-	if err := s.db.DeleteComment(ctx, comment); err != nil {
+	if err := s.svc.DeleteComment(ctx, userID, commentID); err != nil {
 		return false, err
 	}
 	return true, nil
@@ -55,3 +39,101 @@ func (s *useCase) DeleteComment(ctx context.Context, userID int64, commentID int
 func (s *useCase) LikeComment(ctx context.Context, userID int64, commentID int64) (bool, error) {
 	return s.svc.LikeComment(ctx, userID, commentID)
 }
+
+// EditComment 编辑评论
+func (s *useCase) EditComment(ctx context.Context, userID int64, commentID int64, content string) error {
+	return s.svc.EditComment(ctx, userID, commentID, content)
+}
+
+// GetCommentEditHistory 获取评论编辑历史
+func (s *useCase) GetCommentEditHistory(ctx context.Context, viewerID int64, commentID int64) ([]*model.CommentEdit, error) {
+	return s.svc.GetCommentEditHistory(ctx, viewerID, commentID)
+}
+
+// PinComment 置顶评论
+func (s *useCase) PinComment(ctx context.Context, userID int64, commentID int64, pin bool) error {
+	return s.svc.PinComment(ctx, userID, commentID, pin)
+}
+
+// HeartComment 作者点亮评论
+func (s *useCase) HeartComment(ctx context.Context, userID int64, commentID int64, heart bool) error {
+	return s.svc.HeartComment(ctx, userID, commentID, heart)
+}
+
+// ReactionTypes 可用的表情集合
+func (s *useCase) ReactionTypes() []string {
+	return s.svc.ReactionTypes()
+}
+
+// React 表情回应
+func (s *useCase) React(ctx context.Context, userID int64, targetType int8, targetID int64, reaction string) (*model.ReactionSummary, error) {
+	return s.svc.React(ctx, userID, targetType, targetID, reaction)
+}
+
+// GetReactions 获取表情回应列表
+func (s *useCase) GetReactions(
+	ctx context.Context, viewerID int64, targetType int8, targetID int64, reaction string, page int32, size int32,
+) ([]*model.Reaction, int64, *model.ReactionSummary, error) {
+	return s.svc.GetReactions(ctx, viewerID, targetType, targetID, reaction, page, size)
+}
+
+// GetInteractionState 批量获取视频互动状态
+func (s *useCase) GetInteractionState(ctx context.Context, userID int64, videoIDs []int64) ([]*model.VideoInteractionState, error) {
+	return s.svc.GetInteractionState(ctx, userID, videoIDs)
+}
+
+// GetFlaggedComments 获取待审核或已拒绝的评论
+func (s *useCase) GetFlaggedComments(ctx context.Context, userID int64, status int8, page int32, size int32) ([]*model.Comment, int64, error) {
+	if !s.svc.IsModerator(userID) {
+		return nil, 0, errno.AuthNoOperatePermission
+	}
+	return s.svc.GetFlaggedComments(ctx, status, page, size)
+}
+
+// ReviewComment 人工审核评论
+func (s *useCase) ReviewComment(ctx context.Context, userID int64, commentID int64, action int8, reason string) error {
+	if !s.svc.IsModerator(userID) {
+		return errno.AuthNoOperatePermission
+	}
+	return s.svc.ReviewComment(ctx, userID, commentID, action, reason)
+}
+
+// CreateCollection 创建收藏夹
+func (s *useCase) CreateCollection(ctx context.Context, userID int64, name string, isPrivate bool) (*model.Collection, error) {
+	return s.svc.CreateCollection(ctx, userID, name, isPrivate)
+}
+
+// UpdateCollection 修改收藏夹
+func (s *useCase) UpdateCollection(ctx context.Context, userID int64, collectionID int64, update *model.CollectionUpdate) error {
+	return s.svc.UpdateCollection(ctx, userID, collectionID, update)
+}
+
+// DeleteCollection 删除收藏夹
+func (s *useCase) DeleteCollection(ctx context.Context, userID int64, collectionID int64) error {
+	return s.svc.DeleteCollection(ctx, userID, collectionID)
+}
+
+// ReorderCollections 调整收藏夹顺序
+func (s *useCase) ReorderCollections(ctx context.Context, userID int64, collectionIDs []int64) error {
+	return s.svc.ReorderCollections(ctx, userID, collectionIDs)
+}
+
+// GetCollections 获取用户收藏夹列表
+func (s *useCase) GetCollections(ctx context.Context, viewerID int64, ownerID int64) ([]*model.Collection, error) {
+	return s.svc.GetCollections(ctx, viewerID, ownerID)
+}
+
+// AddToCollection 收藏视频
+func (s *useCase) AddToCollection(ctx context.Context, userID int64, videoID int64, collectionID int64) (int64, error) {
+	return s.svc.AddToCollection(ctx, userID, videoID, collectionID)
+}
+
+// RemoveFromCollection 取消收藏视频
+func (s *useCase) RemoveFromCollection(ctx context.Context, userID int64, videoID int64, collectionID int64) error {
+	return s.svc.RemoveFromCollection(ctx, userID, videoID, collectionID)
+}
+
+// GetCollectionVideos 获取收藏夹中的视频
+func (s *useCase) GetCollectionVideos(ctx context.Context, viewerID int64, collectionID int64, page int32, size int32) ([]int64, int64, error) {
+	return s.svc.GetCollectionVideos(ctx, viewerID, collectionID, page, size)
+}
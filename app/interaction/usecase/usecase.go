@@ -10,11 +10,29 @@ import (
 
 type InteractionUseCase interface {
 	Like(ctx context.Context, userID int64, videoID int64) (bool, error)
-	GetLikes(ctx context.Context, videoID int64, page int32, size int32) ([]*model.Like, error)
-	Comment(ctx context.Context, userID int64, videoID int64, content string, parentID int64) (bool, error)
-	GetComments(ctx context.Context, videoID int64, page int32, size int32) ([]*model.Comment, error)
+	GetLikes(ctx context.Context, viewerID, videoID int64, page int32, size int32) ([]*model.Like, error)
+	Comment(ctx context.Context, userID int64, videoID int64, content string, parentID int64) (int64, error)
+	GetComments(ctx context.Context, videoID int64, viewerID int64, page int32, size int32) ([]*model.Comment, error)
 	DeleteComment(ctx context.Context, userID int64, commentID int64) (bool, error)
 	LikeComment(ctx context.Context, userID int64, commentID int64) (bool, error)
+	EditComment(ctx context.Context, userID int64, commentID int64, content string) error
+	GetCommentEditHistory(ctx context.Context, viewerID int64, commentID int64) ([]*model.CommentEdit, error)
+	PinComment(ctx context.Context, userID int64, commentID int64, pin bool) error
+	HeartComment(ctx context.Context, userID int64, commentID int64, heart bool) error
+	ReactionTypes() []string
+	React(ctx context.Context, userID int64, targetType int8, targetID int64, reaction string) (*model.ReactionSummary, error)
+	GetReactions(ctx context.Context, viewerID int64, targetType int8, targetID int64, reaction string, page int32, size int32) ([]*model.Reaction, int64, *model.ReactionSummary, error)
+	GetInteractionState(ctx context.Context, userID int64, videoIDs []int64) ([]*model.VideoInteractionState, error)
+	GetFlaggedComments(ctx context.Context, userID int64, status int8, page int32, size int32) ([]*model.Comment, int64, error)
+	ReviewComment(ctx context.Context, userID int64, commentID int64, action int8, reason string) error
+	CreateCollection(ctx context.Context, userID int64, name string, isPrivate bool) (*model.Collection, error)
+	UpdateCollection(ctx context.Context, userID int64, collectionID int64, update *model.CollectionUpdate) error
+	DeleteCollection(ctx context.Context, userID int64, collectionID int64) error
+	ReorderCollections(ctx context.Context, userID int64, collectionIDs []int64) error
+	GetCollections(ctx context.Context, viewerID int64, ownerID int64) ([]*model.Collection, error)
+	AddToCollection(ctx context.Context, userID int64, videoID int64, collectionID int64) (int64, error)
+	RemoveFromCollection(ctx context.Context, userID int64, videoID int64, collectionID int64) error
+	GetCollectionVideos(ctx context.Context, viewerID int64, collectionID int64, page int32, size int32) ([]int64, int64, error)
 }
 
 type useCase struct {
@@ -7,6 +7,7 @@ import (
 	"github.com/yxrxy/videoHub/app/social/usecase"
 	"github.com/yxrxy/videoHub/kitex_gen/social"
 	"github.com/yxrxy/videoHub/pkg/base"
+	pkgcontext "github.com/yxrxy/videoHub/pkg/base/context"
 )
 
 type SocialHandler struct {
@@ -20,10 +21,15 @@ func NewSocialHandler(useCase usecase.SocialUseCase) *SocialHandler {
 // 发送私信
 func (h *SocialHandler) SendPrivateMessage(ctx context.Context, req *social.SendPrivateMessageRequest) (r *social.SendPrivateMessageResponse, err error) {
 	r = new(social.SendPrivateMessageResponse)
-
-	if err = h.useCase.SendPrivateMessage(ctx, req.SenderId, req.ReceiverId, req.Content); err != nil {
+	userID, err := pkgcontext.GetUserID(ctx)
+	if err != nil {
+		return
+	}
+	msg, err := h.useCase.SendPrivateMessage(ctx, userID, req.ReceiverId, req.Content, req.GetReplyToId())
+	if err != nil {
 		return
 	}
+	r.Message = pack.PackPrivateMessage(msg)
 	r.Base = base.BuildBaseResp(err)
 	return
 }
@@ -41,11 +47,46 @@ func (h *SocialHandler) GetPrivateMessages(ctx context.Context, req *social.GetP
 	return
 }
 
+// EditPrivateMessage 编辑私信
+func (h *SocialHandler) EditPrivateMessage(ctx context.Context, req *social.EditPrivateMessageRequest) (r *social.EditPrivateMessageResponse, err error) {
+	r = new(social.EditPrivateMessageResponse)
+	userID, err := pkgcontext.GetUserID(ctx)
+	if err != nil {
+		return
+	}
+	msg, err := h.useCase.EditPrivateMessage(ctx, userID, req.MessageId, req.Content)
+	if err != nil {
+		return
+	}
+	r.Message = pack.PackPrivateMessage(msg)
+	r.Base = base.BuildBaseResp(err)
+	return
+}
+
+// RecallPrivateMessage 撤回私信
+func (h *SocialHandler) RecallPrivateMessage(ctx context.Context, req *social.RecallPrivateMessageRequest) (r *social.RecallPrivateMessageResponse, err error) {
+	r = new(social.RecallPrivateMessageResponse)
+	userID, err := pkgcontext.GetUserID(ctx)
+	if err != nil {
+		return
+	}
+	msg, err := h.useCase.RecallPrivateMessage(ctx, userID, req.MessageId)
+	if err != nil {
+		return
+	}
+	r.Message = pack.PackPrivateMessage(msg)
+	r.Base = base.BuildBaseResp(err)
+	return
+}
+
 // 创建聊天室
 func (h *SocialHandler) CreateChatRoom(ctx context.Context, req *social.CreateChatRoomRequest) (r *social.CreateChatRoomResponse, err error) {
 	r = new(social.CreateChatRoomResponse)
-
-	room, err := h.useCase.CreateChatRoom(ctx, req.Name, req.CreatorId, req.Type, req.MemberIds)
+	userID, err := pkgcontext.GetUserID(ctx)
+	if err != nil {
+		return
+	}
+	room, err := h.useCase.CreateChatRoom(ctx, req.Name, userID, req.Type, req.MemberIds)
 	if err != nil {
 		return
 	}
@@ -57,8 +98,11 @@ func (h *SocialHandler) CreateChatRoom(ctx context.Context, req *social.CreateCh
 // 获取聊天室信息
 func (h *SocialHandler) GetChatRoom(ctx context.Context, req *social.GetChatRoomRequest) (r *social.GetChatRoomResponse, err error) {
 	r = new(social.GetChatRoomResponse)
-
-	room, err := h.useCase.GetChatRoom(ctx, req.RoomId)
+	userID, err := pkgcontext.GetUserID(ctx)
+	if err != nil {
+		return
+	}
+	room, err := h.useCase.GetChatRoom(ctx, req.RoomId, userID)
 	if err != nil {
 		return
 	}
@@ -83,13 +127,19 @@ func (h *SocialHandler) GetUserChatRooms(ctx context.Context, req *social.GetUse
 // SendChatMessage 发送聊天消息
 func (h *SocialHandler) SendChatMessage(ctx context.Context, req *social.SendChatMessageRequest) (r *social.SendChatMessageResponse, err error) {
 	r = new(social.SendChatMessageResponse)
+	userID, err := pkgcontext.GetUserID(ctx)
+	if err != nil {
+		return
+	}
 	if req.Type == nil {
 		req.Type = new(int8)
 		*req.Type = 0
 	}
-	if err = h.useCase.SendChatMessage(ctx, req.RoomId, req.SenderId, req.Content, *req.Type); err != nil {
+	msg, err := h.useCase.SendChatMessage(ctx, req.RoomId, userID, req.Content, *req.Type, req.GetReplyToId())
+	if err != nil {
 		return
 	}
+	r.Message = pack.PackChatMessage(msg)
 	r.Base = base.BuildBaseResp(err)
 	return
 }
@@ -97,8 +147,11 @@ func (h *SocialHandler) SendChatMessage(ctx context.Context, req *social.SendCha
 // 获取聊天消息列表
 func (h *SocialHandler) GetChatMessages(ctx context.Context, req *social.GetChatMessagesRequest) (r *social.GetChatMessagesResponse, err error) {
 	r = new(social.GetChatMessagesResponse)
-
-	messages, err := h.useCase.GetChatMessages(ctx, req.RoomId, int32(*req.Page), *req.Size)
+	userID, err := pkgcontext.GetUserID(ctx)
+	if err != nil {
+		return
+	}
+	messages, err := h.useCase.GetChatMessages(ctx, req.RoomId, userID, int32(*req.Page), *req.Size)
 	if err != nil {
 		return
 	}
@@ -107,13 +160,257 @@ func (h *SocialHandler) GetChatMessages(ctx context.Context, req *social.GetChat
 	return
 }
 
+// UploadChatAttachment 上传聊天附件
+func (h *SocialHandler) UploadChatAttachment(ctx context.Context, req *social.UploadChatAttachmentRequest) (r *social.UploadChatAttachmentResponse, err error) {
+	r = new(social.UploadChatAttachmentResponse)
+	userID, err := pkgcontext.GetUserID(ctx)
+	if err != nil {
+		return
+	}
+	attachment, err := h.useCase.UploadChatAttachment(ctx, userID, req.FileName, req.ContentType, req.Data)
+	if err != nil {
+		return
+	}
+	r.Attachment = pack.PackChatAttachment(attachment)
+	r.Base = base.BuildBaseResp(err)
+	return
+}
+
+// EditChatMessage 编辑聊天消息
+func (h *SocialHandler) EditChatMessage(ctx context.Context, req *social.EditChatMessageRequest) (r *social.EditChatMessageResponse, err error) {
+	r = new(social.EditChatMessageResponse)
+	userID, err := pkgcontext.GetUserID(ctx)
+	if err != nil {
+		return
+	}
+	msg, err := h.useCase.EditChatMessage(ctx, userID, req.MessageId, req.Content)
+	if err != nil {
+		return
+	}
+	r.Message = pack.PackChatMessage(msg)
+	r.Base = base.BuildBaseResp(err)
+	return
+}
+
+// RecallChatMessage 撤回聊天消息
+func (h *SocialHandler) RecallChatMessage(ctx context.Context, req *social.RecallChatMessageRequest) (r *social.RecallChatMessageResponse, err error) {
+	r = new(social.RecallChatMessageResponse)
+	userID, err := pkgcontext.GetUserID(ctx)
+	if err != nil {
+		return
+	}
+	msg, err := h.useCase.RecallChatMessage(ctx, userID, req.MessageId)
+	if err != nil {
+		return
+	}
+	r.Message = pack.PackChatMessage(msg)
+	r.Base = base.BuildBaseResp(err)
+	return
+}
+
+// 添加聊天室成员
+func (h *SocialHandler) AddChatRoomMembers(ctx context.Context, req *social.AddChatRoomMembersRequest) (r *social.AddChatRoomMembersResponse, err error) {
+	r = new(social.AddChatRoomMembersResponse)
+	userID, err := pkgcontext.GetUserID(ctx)
+	if err != nil {
+		return
+	}
+	added, msg, err := h.useCase.AddChatRoomMembers(ctx, userID, req.RoomId, req.MemberIds)
+	if err != nil {
+		return
+	}
+	r.AddedIds = added
+	r.SystemMessage = pack.PackSystemMessage(msg)
+	r.Base = base.BuildBaseResp(err)
+	return
+}
+
+// 移除聊天室成员
+func (h *SocialHandler) RemoveChatRoomMember(ctx context.Context, req *social.RemoveChatRoomMemberRequest) (r *social.RemoveChatRoomMemberResponse, err error) {
+	r = new(social.RemoveChatRoomMemberResponse)
+	userID, err := pkgcontext.GetUserID(ctx)
+	if err != nil {
+		return
+	}
+	msg, err := h.useCase.RemoveChatRoomMember(ctx, userID, req.RoomId, req.UserId)
+	if err != nil {
+		return
+	}
+	r.SystemMessage = pack.PackSystemMessage(msg)
+	r.Base = base.BuildBaseResp(err)
+	return
+}
+
+// 退出聊天室
+func (h *SocialHandler) LeaveChatRoom(ctx context.Context, req *social.LeaveChatRoomRequest) (r *social.LeaveChatRoomResponse, err error) {
+	r = new(social.LeaveChatRoomResponse)
+	userID, err := pkgcontext.GetUserID(ctx)
+	if err != nil {
+		return
+	}
+	msg, err := h.useCase.LeaveChatRoom(ctx, userID, req.RoomId)
+	if err != nil {
+		return
+	}
+	r.SystemMessage = pack.PackSystemMessage(msg)
+	r.Base = base.BuildBaseResp(err)
+	return
+}
+
+// 检查聊天室成员
+func (h *SocialHandler) CheckChatRoomMember(ctx context.Context, req *social.CheckChatRoomMemberRequest) (r *social.CheckChatRoomMemberResponse, err error) {
+	r = new(social.CheckChatRoomMemberResponse)
+
+	isMember, err := h.useCase.CheckChatRoomMember(ctx, req.RoomId, req.UserId)
+	if err != nil {
+		return
+	}
+	r.IsMember = isMember
+	r.Base = base.BuildBaseResp(err)
+	return
+}
+
+// 检查能否私信
+func (h *SocialHandler) CheckPrivateChat(ctx context.Context, req *social.CheckPrivateChatRequest) (r *social.CheckPrivateChatResponse, err error) {
+	r = new(social.CheckPrivateChatResponse)
+
+	allowed, err := h.useCase.CheckPrivateChat(ctx, req.UserId, req.PeerId)
+	if err != nil {
+		return
+	}
+	r.Allowed = allowed
+	r.Base = base.BuildBaseResp(err)
+	return
+}
+
+// 设置或取消管理员
+func (h *SocialHandler) SetChatRoomAdmin(ctx context.Context, req *social.SetChatRoomAdminRequest) (r *social.SetChatRoomAdminResponse, err error) {
+	r = new(social.SetChatRoomAdminResponse)
+	userID, err := pkgcontext.GetUserID(ctx)
+	if err != nil {
+		return
+	}
+	msg, err := h.useCase.SetChatRoomAdmin(ctx, userID, req.RoomId, req.UserId, req.IsAdmin)
+	if err != nil {
+		return
+	}
+	r.SystemMessage = pack.PackSystemMessage(msg)
+	r.Base = base.BuildBaseResp(err)
+	return
+}
+
+// 转让群主
+func (h *SocialHandler) TransferChatRoom(ctx context.Context, req *social.TransferChatRoomRequest) (r *social.TransferChatRoomResponse, err error) {
+	r = new(social.TransferChatRoomResponse)
+	userID, err := pkgcontext.GetUserID(ctx)
+	if err != nil {
+		return
+	}
+	msg, err := h.useCase.TransferChatRoom(ctx, userID, req.RoomId, req.NewOwnerId_)
+	if err != nil {
+		return
+	}
+	r.SystemMessage = pack.PackSystemMessage(msg)
+	r.Base = base.BuildBaseResp(err)
+	return
+}
+
+// 禁言聊天室成员
+func (h *SocialHandler) MuteChatRoomMember(ctx context.Context, req *social.MuteChatRoomMemberRequest) (r *social.MuteChatRoomMemberResponse, err error) {
+	r = new(social.MuteChatRoomMemberResponse)
+	userID, err := pkgcontext.GetUserID(ctx)
+	if err != nil {
+		return
+	}
+	mutedUntil, msg, err := h.useCase.MuteChatRoomMember(ctx, userID, req.RoomId, req.UserId, req.Duration)
+	if err != nil {
+		return
+	}
+	r.MutedUntil = mutedUntil
+	r.SystemMessage = pack.PackSystemMessage(msg)
+	r.Base = base.BuildBaseResp(err)
+	return
+}
+
+// 修改聊天室名称
+func (h *SocialHandler) RenameChatRoom(ctx context.Context, req *social.RenameChatRoomRequest) (r *social.RenameChatRoomResponse, err error) {
+	r = new(social.RenameChatRoomResponse)
+	userID, err := pkgcontext.GetUserID(ctx)
+	if err != nil {
+		return
+	}
+	msg, err := h.useCase.RenameChatRoom(ctx, userID, req.RoomId, req.Name)
+	if err != nil {
+		return
+	}
+	r.SystemMessage = pack.PackSystemMessage(msg)
+	r.Base = base.BuildBaseResp(err)
+	return
+}
+
+// 解散聊天室
+func (h *SocialHandler) DisbandChatRoom(ctx context.Context, req *social.DisbandChatRoomRequest) (r *social.DisbandChatRoomResponse, err error) {
+	r = new(social.DisbandChatRoomResponse)
+	userID, err := pkgcontext.GetUserID(ctx)
+	if err != nil {
+		return
+	}
+	memberIDs, msg, err := h.useCase.DisbandChatRoom(ctx, userID, req.RoomId)
+	if err != nil {
+		return
+	}
+	r.MemberIds = memberIDs
+	r.SystemMessage = pack.PackSystemMessage(msg)
+	r.Base = base.BuildBaseResp(err)
+	return
+}
+
+// 创建聊天室邀请链接
+func (h *SocialHandler) CreateChatRoomInvite(ctx context.Context, req *social.CreateChatRoomInviteRequest) (r *social.CreateChatRoomInviteResponse, err error) {
+	r = new(social.CreateChatRoomInviteResponse)
+	userID, err := pkgcontext.GetUserID(ctx)
+	if err != nil {
+		return
+	}
+	invite, err := h.useCase.CreateChatRoomInvite(ctx, userID, req.RoomId, req.GetTtl())
+	if err != nil {
+		return
+	}
+	r.Invite = pack.PackChatRoomInvite(invite)
+	r.Base = base.BuildBaseResp(err)
+	return
+}
+
+// 通过邀请链接加入聊天室
+func (h *SocialHandler) JoinChatRoomByInvite(ctx context.Context, req *social.JoinChatRoomByInviteRequest) (r *social.JoinChatRoomByInviteResponse, err error) {
+	r = new(social.JoinChatRoomByInviteResponse)
+	userID, err := pkgcontext.GetUserID(ctx)
+	if err != nil {
+		return
+	}
+	roomID, msg, err := h.useCase.JoinChatRoomByInvite(ctx, userID, req.Code)
+	if err != nil {
+		return
+	}
+	r.RoomId = roomID
+	r.SystemMessage = pack.PackSystemMessage(msg)
+	r.Base = base.BuildBaseResp(err)
+	return
+}
+
 // 添加好友
 func (h *SocialHandler) AddFriend(ctx context.Context, req *social.AddFriendRequest) (r *social.AddFriendResponse, err error) {
 	r = new(social.AddFriendResponse)
-
-	if err = h.useCase.AddFriend(ctx, req.UserId, req.FriendId); err != nil {
+	userID, err := pkgcontext.GetUserID(ctx)
+	if err != nil {
 		return
 	}
+	request, err := h.useCase.AddFriend(ctx, userID, req.FriendId)
+	if err != nil {
+		return
+	}
+	r.Friend = pack.PackRequestedFriendship(request, userID)
+	r.Request = pack.PackFriendRequest(request)
 	r.Base = base.BuildBaseResp(err)
 	return
 }
@@ -134,8 +431,11 @@ func (h *SocialHandler) GetUserFriends(ctx context.Context, req *social.GetUserF
 // 获取好友申请列表
 func (h *SocialHandler) GetFriendRequests(ctx context.Context, req *social.GetFriendRequestsRequest) (r *social.GetFriendRequestsResponse, err error) {
 	r = new(social.GetFriendRequestsResponse)
-
-	requests, err := h.useCase.GetFriendRequests(ctx, req.UserId, *req.Type)
+	userID, err := pkgcontext.GetUserID(ctx)
+	if err != nil {
+		return
+	}
+	requests, err := h.useCase.GetFriendRequests(ctx, userID, req.GetType() == 1)
 	if err != nil {
 		return
 	}
@@ -144,13 +444,35 @@ func (h *SocialHandler) GetFriendRequests(ctx context.Context, req *social.GetFr
 	return
 }
 
+// GetFriendRecommendations 获取好友推荐
+func (h *SocialHandler) GetFriendRecommendations(ctx context.Context, req *social.GetFriendRecommendationsRequest) (r *social.GetFriendRecommendationsResponse, err error) {
+	r = new(social.GetFriendRecommendationsResponse)
+	userID, err := pkgcontext.GetUserID(ctx)
+	if err != nil {
+		return
+	}
+	recommendations, err := h.useCase.GetFriendRecommendations(ctx, userID, req.GetLimit())
+	if err != nil {
+		return
+	}
+	r.Recommendations = pack.PackRecommendationList(recommendations)
+	r.Base = base.BuildBaseResp(err)
+	return
+}
+
 // 处理好友申请
 func (h *SocialHandler) HandleFriendRequest(ctx context.Context, req *social.HandleFriendRequestRequest) (r *social.HandleFriendRequestResponse, err error) {
 	r = new(social.HandleFriendRequestResponse)
-
-	if err = h.useCase.HandleFriendRequest(ctx, req.RequestId, req.Action); err != nil {
+	userID, err := pkgcontext.GetUserID(ctx)
+	if err != nil {
+		return
+	}
+	request, err := h.useCase.HandleFriendRequest(ctx, req.RequestId, userID, req.Action)
+	if err != nil {
 		return
 	}
+	r.Request = pack.PackFriendRequest(request)
+	r.Friend = pack.PackAcceptedFriendship(request, userID)
 	r.Base = base.BuildBaseResp(err)
 	return
 }
@@ -161,8 +483,11 @@ func (h *SocialHandler) GetUnreadMessageCount(
 	req *social.GetUnreadMessageCountRequest,
 ) (r *social.GetUnreadMessageCountResponse, err error) {
 	r = new(social.GetUnreadMessageCountResponse)
-
-	count, err := h.useCase.GetUnreadMessageCount(ctx, req.UserId)
+	userID, err := pkgcontext.GetUserID(ctx)
+	if err != nil {
+		return
+	}
+	count, err := h.useCase.GetUnreadMessageCount(ctx, userID)
 	if err != nil {
 		return
 	}
@@ -174,10 +499,84 @@ func (h *SocialHandler) GetUnreadMessageCount(
 // 设置消息已读
 func (h *SocialHandler) MarkMessageRead(ctx context.Context, req *social.MarkMessageReadRequest) (r *social.MarkMessageReadResponse, err error) {
 	r = new(social.MarkMessageReadResponse)
+	userID, err := pkgcontext.GetUserID(ctx)
+	if err != nil {
+		return
+	}
+	cursor, err := h.useCase.MarkMessageRead(ctx, req.MessageId, userID)
+	if err != nil {
+		return
+	}
+	r.Cursor = pack.PackReadCursor(cursor)
+	r.Base = base.BuildBaseResp(err)
+	return
+}
+
+// MarkConversationsRead 批量标记会话已读
+func (h *SocialHandler) MarkConversationsRead(ctx context.Context, req *social.MarkConversationsReadRequest) (r *social.MarkConversationsReadResponse, err error) {
+	r = new(social.MarkConversationsReadResponse)
+	userID, err := pkgcontext.GetUserID(ctx)
+	if err != nil {
+		return
+	}
+	cursors, err := h.useCase.MarkConversationsRead(ctx, userID, pack.UnpackReadCursorList(req.Conversations))
+	if err != nil {
+		return
+	}
+	r.Cursors = pack.PackReadCursorList(cursors)
+	r.Base = base.BuildBaseResp(err)
+	return
+}
+
+// GetUnreadCounts 获取各会话未读数
+func (h *SocialHandler) GetUnreadCounts(ctx context.Context, req *social.GetUnreadCountsRequest) (r *social.GetUnreadCountsResponse, err error) {
+	r = new(social.GetUnreadCountsResponse)
+	userID, err := pkgcontext.GetUserID(ctx)
+	if err != nil {
+		return
+	}
+	counts, total, err := h.useCase.GetUnreadCounts(ctx, userID)
+	if err != nil {
+		return
+	}
+	r.Counts = pack.PackUnreadCountList(counts)
+	r.Total = total
+	r.Base = base.BuildBaseResp(err)
+	return
+}
+
+// GetInbox 分页获取收件箱
+func (h *SocialHandler) GetInbox(ctx context.Context, req *social.GetInboxRequest) (r *social.GetInboxResponse, err error) {
+	r = new(social.GetInboxResponse)
+	userID, err := pkgcontext.GetUserID(ctx)
+	if err != nil {
+		return
+	}
+	page, err := h.useCase.GetInbox(ctx, userID, req.GetCursor(), req.GetLimit(), req.GetArchived())
+	if err != nil {
+		return
+	}
+	r.Conversations = pack.PackConversationList(page.Conversations)
+	if page.NextCursor != "" {
+		r.NextCursor = &page.NextCursor
+	}
+	r.HasMore = page.HasMore
+	r.Base = base.BuildBaseResp(err)
+	return
+}
 
-	if err = h.useCase.MarkMessageRead(ctx, req.MessageId, req.UserId); err != nil {
+// UpdateConversation 修改会话的免打扰、置顶和归档设置
+func (h *SocialHandler) UpdateConversation(ctx context.Context, req *social.UpdateConversationRequest) (r *social.UpdateConversationResponse, err error) {
+	r = new(social.UpdateConversationResponse)
+	userID, err := pkgcontext.GetUserID(ctx)
+	if err != nil {
+		return
+	}
+	conversation, err := h.useCase.UpdateConversation(ctx, userID, req.GetPeerId(), req.GetRoomId(), pack.UnpackConversationSettings(req.Muted, req.Pinned, req.Archived))
+	if err != nil {
 		return
 	}
+	r.Conversation = pack.PackConversation(conversation)
 	r.Base = base.BuildBaseResp(err)
 	return
 }
@@ -185,15 +584,20 @@ func (h *SocialHandler) MarkMessageRead(ctx context.Context, req *social.MarkMes
 // 创建好友申请
 func (h *SocialHandler) CreateFriendRequest(ctx context.Context, req *social.CreateFriendRequestRequest) (r *social.CreateFriendRequestResponse, err error) {
 	r = new(social.CreateFriendRequestResponse)
-
+	userID, err := pkgcontext.GetUserID(ctx)
+	if err != nil {
+		return
+	}
 	message := ""
 	if req.Message != nil {
 		message = *req.Message
 	}
 
-	if err = h.useCase.CreateFriendRequest(ctx, req.SenderId, req.ReceiverId, message); err != nil {
+	request, err := h.useCase.CreateFriendRequest(ctx, userID, req.ReceiverId, message)
+	if err != nil {
 		return
 	}
+	r.Request = pack.PackFriendRequest(request)
 	r.Base = base.BuildBaseResp(err)
 	return
 }
@@ -201,8 +605,11 @@ func (h *SocialHandler) CreateFriendRequest(ctx context.Context, req *social.Cre
 // 获取好友申请
 func (h *SocialHandler) GetFriendRequest(ctx context.Context, req *social.GetFriendRequestsRequest) (r *social.GetFriendRequestsResponse, err error) {
 	r = new(social.GetFriendRequestsResponse)
-
-	requests, err := h.useCase.GetFriendRequests(ctx, req.UserId, *req.Type)
+	userID, err := pkgcontext.GetUserID(ctx)
+	if err != nil {
+		return
+	}
+	requests, err := h.useCase.GetFriendRequests(ctx, userID, req.GetType() == 1)
 	if err != nil {
 		return
 	}
@@ -222,3 +629,171 @@ func (h *SocialHandler) GetFriendship(ctx context.Context, req *social.GetFriend
 	r.Base = base.BuildBaseResp(err)
 	return
 }
+
+// 同步会话消息
+func (h *SocialHandler) SyncMessages(ctx context.Context, req *social.SyncMessagesRequest) (r *social.SyncMessagesResponse, err error) {
+	r = new(social.SyncMessagesResponse)
+	userID, err := pkgcontext.GetUserID(ctx)
+	if err != nil {
+		return
+	}
+	result, err := h.useCase.SyncMessages(ctx, userID, req.GetPeerId(), req.GetRoomId(), req.AfterSeq, req.GetLimit())
+	if err != nil {
+		return
+	}
+	r.PrivateMessages = pack.PackPrivateMessageList(result.PrivateMessages)
+	r.ChatMessages = pack.PackChatMessageList(result.ChatMessages)
+	r.LatestSeq = result.LatestSeq
+	r.HasMore = result.HasMore
+	r.Base = base.BuildBaseResp(err)
+	return
+}
+
+// GetNotifications 分页获取通知
+func (h *SocialHandler) GetNotifications(ctx context.Context, req *social.GetNotificationsRequest) (r *social.GetNotificationsResponse, err error) {
+	r = new(social.GetNotificationsResponse)
+	userID, err := pkgcontext.GetUserID(ctx)
+	if err != nil {
+		return
+	}
+	notifications, total, err := h.useCase.GetNotifications(ctx, userID, req.GetType(), req.GetPage(), req.GetSize())
+	if err != nil {
+		return
+	}
+	r.Notifications = pack.PackNotificationList(notifications)
+	r.Total = total
+	r.Base = base.BuildBaseResp(err)
+	return
+}
+
+// GetNotificationUnreadCount 获取各类型的未读通知数
+func (h *SocialHandler) GetNotificationUnreadCount(
+	ctx context.Context, req *social.GetNotificationUnreadCountRequest,
+) (r *social.GetNotificationUnreadCountResponse, err error) {
+	r = new(social.GetNotificationUnreadCountResponse)
+	userID, err := pkgcontext.GetUserID(ctx)
+	if err != nil {
+		return
+	}
+	counts, total, err := h.useCase.GetNotificationUnreadCount(ctx, userID)
+	if err != nil {
+		return
+	}
+	r.Counts = pack.PackNotificationUnreadCounts(counts)
+	r.Total = total
+	r.Base = base.BuildBaseResp(err)
+	return
+}
+
+// MarkNotificationsRead 标记通知已读
+func (h *SocialHandler) MarkNotificationsRead(ctx context.Context, req *social.MarkNotificationsReadRequest) (r *social.MarkNotificationsReadResponse, err error) {
+	r = new(social.MarkNotificationsReadResponse)
+	userID, err := pkgcontext.GetUserID(ctx)
+	if err != nil {
+		return
+	}
+	count, err := h.useCase.MarkNotificationsRead(ctx, userID, req.NotificationIds, req.GetType())
+	if err != nil {
+		return
+	}
+	r.Count = count
+	r.Base = base.BuildBaseResp(err)
+	return
+}
+
+// GetNotificationPreferences 获取通知偏好
+func (h *SocialHandler) GetNotificationPreferences(
+	ctx context.Context, req *social.GetNotificationPreferencesRequest,
+) (r *social.GetNotificationPreferencesResponse, err error) {
+	r = new(social.GetNotificationPreferencesResponse)
+	userID, err := pkgcontext.GetUserID(ctx)
+	if err != nil {
+		return
+	}
+	preferences, err := h.useCase.GetNotificationPreferences(ctx, userID)
+	if err != nil {
+		return
+	}
+	r.Preferences = pack.PackNotificationPreferences(preferences)
+	r.Base = base.BuildBaseResp(err)
+	return
+}
+
+// UpdateNotificationPreferences 修改通知偏好
+func (h *SocialHandler) UpdateNotificationPreferences(
+	ctx context.Context, req *social.UpdateNotificationPreferencesRequest,
+) (r *social.UpdateNotificationPreferencesResponse, err error) {
+	r = new(social.UpdateNotificationPreferencesResponse)
+	userID, err := pkgcontext.GetUserID(ctx)
+	if err != nil {
+		return
+	}
+	preferences, err := h.useCase.UpdateNotificationPreferences(ctx, userID, pack.UnpackNotificationPreferences(req.Preferences))
+	if err != nil {
+		return
+	}
+	r.Preferences = pack.PackNotificationPreferences(preferences)
+	r.Base = base.BuildBaseResp(err)
+	return
+}
+
+// UpdatePresence 上报在线状态
+func (h *SocialHandler) UpdatePresence(ctx context.Context, req *social.UpdatePresenceRequest) (r *social.UpdatePresenceResponse, err error) {
+	r = new(social.UpdatePresenceResponse)
+
+	if err = h.useCase.UpdatePresence(ctx, req.UserIds, req.Online); err != nil {
+		return
+	}
+	r.Base = base.BuildBaseResp(err)
+	return
+}
+
+// GetPresence 批量获取在线状态
+func (h *SocialHandler) GetPresence(ctx context.Context, req *social.GetPresenceRequest) (r *social.GetPresenceResponse, err error) {
+	r = new(social.GetPresenceResponse)
+	userID, err := pkgcontext.GetUserID(ctx)
+	if err != nil {
+		return
+	}
+	presences, err := h.useCase.GetPresence(ctx, userID, req.UserIds)
+	if err != nil {
+		return
+	}
+	r.Presences = pack.PackPresences(presences)
+	r.Base = base.BuildBaseResp(err)
+	return
+}
+
+// SearchMessages 搜索聊天记录
+func (h *SocialHandler) SearchMessages(ctx context.Context, req *social.SearchMessagesRequest) (r *social.SearchMessagesResponse, err error) {
+	r = new(social.SearchMessagesResponse)
+	userID, err := pkgcontext.GetUserID(ctx)
+	if err != nil {
+		return
+	}
+	messages, total, err := h.useCase.SearchMessages(ctx, pack.UnpackMessageSearchQuery(userID, req))
+	if err != nil {
+		return
+	}
+	r.Messages = pack.PackSearchedMessages(messages)
+	r.Total = total
+	r.Base = base.BuildBaseResp(err)
+	return
+}
+
+// ExportChatHistory 导出已归档的聊天记录
+func (h *SocialHandler) ExportChatHistory(ctx context.Context, req *social.ExportChatHistoryRequest) (r *social.ExportChatHistoryResponse, err error) {
+	r = new(social.ExportChatHistoryResponse)
+	userID, err := pkgcontext.GetUserID(ctx)
+	if err != nil {
+		return
+	}
+	export, err := h.useCase.ExportChatHistory(ctx, userID, req.GetPeerId(), req.GetRoomId())
+	if err != nil {
+		return
+	}
+	r.Url = export.URL
+	r.MessageCount = export.MessageCount
+	r.Base = base.BuildBaseResp(err)
+	return
+}
@@ -3,16 +3,31 @@ package pack
 import (
 	"github.com/yxrxy/videoHub/app/social/domain/model"
 	rpcmodel "github.com/yxrxy/videoHub/kitex_gen/model"
+	"github.com/yxrxy/videoHub/kitex_gen/social"
 )
 
 func PackPrivateMessage(msg *model.PrivateMessage) *rpcmodel.PrivateMessage {
-	return &rpcmodel.PrivateMessage{
+	m := &rpcmodel.PrivateMessage{
 		Id:         msg.ID,
 		SenderId:   msg.SenderID,
 		ReceiverId: msg.ReceiverID,
 		Content:    msg.Content,
 		IsRead:     msg.IsRead,
+		Seq:        msg.Seq,
+		CreatedAt:  msg.CreatedAt,
+		UpdatedAt:  msg.CreatedAt,
 	}
+	if msg.ReplyToID != 0 {
+		m.ReplyToId = &msg.ReplyToID
+	}
+	if msg.EditedAt != 0 {
+		m.EditedAt = &msg.EditedAt
+		m.UpdatedAt = msg.EditedAt
+	}
+	if msg.Recalled {
+		m.Recalled = &msg.Recalled
+	}
+	return m
 }
 
 func PackPrivateMessageList(msgs []*model.PrivateMessage) []*rpcmodel.PrivateMessage {
@@ -41,11 +56,56 @@ func PackChatRoomList(rooms []*model.ChatRoom) []*rpcmodel.ChatRoom {
 }
 
 func PackChatMessage(msg *model.ChatMessage) *rpcmodel.ChatMessage {
-	return &rpcmodel.ChatMessage{
-		Id:       msg.ID,
-		RoomId:   msg.RoomID,
-		SenderId: msg.SenderID,
-		Content:  msg.Content,
+	m := &rpcmodel.ChatMessage{
+		Id:        msg.ID,
+		RoomId:    msg.RoomID,
+		SenderId:  msg.SenderID,
+		Content:   msg.Content,
+		Type:      msg.Type,
+		Seq:       msg.Seq,
+		CreatedAt: msg.CreatedAt,
+		UpdatedAt: msg.CreatedAt,
+	}
+	if msg.ReplyToID != 0 {
+		m.ReplyToId = &msg.ReplyToID
+	}
+	if msg.EditedAt != 0 {
+		m.EditedAt = &msg.EditedAt
+		m.UpdatedAt = msg.EditedAt
+	}
+	if msg.Recalled {
+		m.Recalled = &msg.Recalled
+	}
+	return m
+}
+
+func PackChatAttachment(attachment *model.ChatAttachment) *rpcmodel.ChatAttachment {
+	a := &rpcmodel.ChatAttachment{
+		Url:         attachment.URL,
+		Name:        attachment.Name,
+		ContentType: attachment.ContentType,
+		Size:        attachment.Size,
+	}
+	if attachment.ThumbnailURL != "" {
+		a.ThumbnailUrl = &attachment.ThumbnailURL
+	}
+	return a
+}
+
+// PackSystemMessage 群管理操作产生的系统消息，未产生时为 nil
+func PackSystemMessage(msg *model.ChatMessage) *rpcmodel.ChatMessage {
+	if msg == nil {
+		return nil
+	}
+	return PackChatMessage(msg)
+}
+
+func PackChatRoomInvite(invite *model.ChatRoomInvite) *rpcmodel.ChatRoomInvite {
+	return &rpcmodel.ChatRoomInvite{
+		Code:      invite.Code,
+		RoomId:    invite.RoomID,
+		InviterId: invite.InviterID,
+		ExpiresAt: invite.ExpiresAt,
 	}
 }
 
@@ -81,7 +141,36 @@ func PackFriendRequest(request *model.FriendRequest) *rpcmodel.FriendRequest {
 		ReceiverId: request.ReceiverID,
 		Message:    &request.Message,
 		Status:     request.Status,
+		CreatedAt:  request.CreatedAt,
+		UpdatedAt:  request.UpdatedAt,
+	}
+}
+
+// PackRequestedFriendship 以 userID 的视角打包好友申请对应的好友关系，申请同意前为待确认
+func PackRequestedFriendship(request *model.FriendRequest, userID int64) *rpcmodel.Friendship {
+	friendID := request.ReceiverID
+	if friendID == userID {
+		friendID = request.SenderID
 	}
+	status := int8(model.FriendStatusPending)
+	if request.Status == model.FriendRequestStatusAccepted {
+		status = model.FriendStatusAccepted
+	}
+	return &rpcmodel.Friendship{
+		UserId:    userID,
+		FriendId:  friendID,
+		Status:    status,
+		CreatedAt: request.CreatedAt,
+		UpdatedAt: request.UpdatedAt,
+	}
+}
+
+// PackAcceptedFriendship 申请已同意时打包建立的好友关系，否则返回 nil
+func PackAcceptedFriendship(request *model.FriendRequest, userID int64) *rpcmodel.Friendship {
+	if request.Status != model.FriendRequestStatusAccepted {
+		return nil
+	}
+	return PackRequestedFriendship(request, userID)
 }
 
 func PackFriendRequestList(requests []*model.FriendRequest) []*rpcmodel.FriendRequest {
@@ -92,6 +181,21 @@ func PackFriendRequestList(requests []*model.FriendRequest) []*rpcmodel.FriendRe
 	return requestList
 }
 
+func PackRecommendationList(recommendations []*model.Recommendation) []*rpcmodel.FriendRecommendation {
+	list := make([]*rpcmodel.FriendRecommendation, 0, len(recommendations))
+	for _, r := range recommendations {
+		list = append(list, &rpcmodel.FriendRecommendation{
+			UserId:        r.UserID,
+			Score:         r.Score,
+			MutualFriends: r.MutualFriends,
+			MutualFollows: r.MutualFollows,
+			SharedLikes:   r.SharedLikes,
+			CoComments:    r.CoComments,
+		})
+	}
+	return list
+}
+
 func PackFriendship(friendship *model.Friendship) *rpcmodel.Friendship {
 	return &rpcmodel.Friendship{
 		Id:       friendship.ID,
@@ -108,3 +212,175 @@ func PackFriendshipList(friendships []*model.Friendship) []*rpcmodel.Friendship
 	}
 	return friendshipList
 }
+
+func PackReadCursor(cursor *model.ReadCursor) *rpcmodel.ReadCursor {
+	c := &rpcmodel.ReadCursor{ReadSeq: cursor.ReadSeq}
+	if cursor.PeerID != 0 {
+		c.PeerId = &cursor.PeerID
+	}
+	if cursor.RoomID != 0 {
+		c.RoomId = &cursor.RoomID
+	}
+	return c
+}
+
+func PackReadCursorList(cursors []*model.ReadCursor) []*rpcmodel.ReadCursor {
+	list := make([]*rpcmodel.ReadCursor, 0, len(cursors))
+	for _, cursor := range cursors {
+		list = append(list, PackReadCursor(cursor))
+	}
+	return list
+}
+
+// UnpackReadCursorList 转换请求中的会话列表，空元素忽略
+func UnpackReadCursorList(cursors []*rpcmodel.ReadCursor) []model.ReadCursor {
+	list := make([]model.ReadCursor, 0, len(cursors))
+	for _, c := range cursors {
+		if c == nil {
+			continue
+		}
+		list = append(list, model.ReadCursor{PeerID: c.GetPeerId(), RoomID: c.GetRoomId(), ReadSeq: c.ReadSeq})
+	}
+	return list
+}
+
+func PackUnreadCountList(counts []*model.UnreadCount) []*rpcmodel.UnreadCount {
+	list := make([]*rpcmodel.UnreadCount, 0, len(counts))
+	for _, count := range counts {
+		c := &rpcmodel.UnreadCount{Count: count.Count}
+		if count.PeerID != 0 {
+			c.PeerId = &count.PeerID
+		}
+		if count.RoomID != 0 {
+			c.RoomId = &count.RoomID
+		}
+		list = append(list, c)
+	}
+	return list
+}
+
+func PackConversation(conversation *model.Conversation) *rpcmodel.Conversation {
+	c := &rpcmodel.Conversation{
+		LastMessageId:   conversation.LastMessageID,
+		LastSenderId:    conversation.LastSenderID,
+		LastMessageType: conversation.LastMessageType,
+		LastPreview:     conversation.LastPreview,
+		LastActiveAt:    conversation.LastActiveAt,
+		UnreadCount:     conversation.UnreadCount,
+		Muted:           conversation.Muted,
+		Pinned:          conversation.Pinned,
+		Archived:        conversation.Archived,
+	}
+	if conversation.PeerID != 0 {
+		c.PeerId = &conversation.PeerID
+	}
+	if conversation.RoomID != 0 {
+		c.RoomId = &conversation.RoomID
+		c.Name = &conversation.Name
+	}
+	return c
+}
+
+func PackConversationList(conversations []*model.Conversation) []*rpcmodel.Conversation {
+	list := make([]*rpcmodel.Conversation, 0, len(conversations))
+	for _, conversation := range conversations {
+		list = append(list, PackConversation(conversation))
+	}
+	return list
+}
+
+// UnpackConversationSettings 转换请求中的会话设置，未设置的字段保持为 nil
+func UnpackConversationSettings(muted, pinned, archived *bool) *model.ConversationSettings {
+	return &model.ConversationSettings{Muted: muted, Pinned: pinned, Archived: archived}
+}
+
+func PackNotification(notification *model.Notification) *rpcmodel.Notification {
+	actorIDs := notification.ActorIDs
+	if actorIDs == nil {
+		actorIDs = []int64{}
+	}
+	return &rpcmodel.Notification{
+		Id:         notification.ID,
+		Type:       notification.Type,
+		TargetId:   notification.TargetID,
+		ActorIds:   actorIDs,
+		ActorCount: notification.ActorCount,
+		IsRead:     notification.IsRead,
+		CreatedAt:  notification.CreatedAt,
+		UpdatedAt:  notification.UpdatedAt,
+	}
+}
+
+func PackNotificationList(notifications []model.Notification) []*rpcmodel.Notification {
+	list := make([]*rpcmodel.Notification, 0, len(notifications))
+	for i := range notifications {
+		list = append(list, PackNotification(&notifications[i]))
+	}
+	return list
+}
+
+// PackNotificationUnreadCounts 按通知类型的顺序返回，只包含有未读通知的类型
+func PackNotificationUnreadCounts(counts map[int8]int64) []*rpcmodel.NotificationUnreadCount {
+	list := make([]*rpcmodel.NotificationUnreadCount, 0, len(counts))
+	for _, t := range model.NotificationTypes {
+		if count := counts[t]; count > 0 {
+			list = append(list, &rpcmodel.NotificationUnreadCount{Type: t, Count: count})
+		}
+	}
+	return list
+}
+
+func PackNotificationPreferences(preferences []model.NotificationPreference) []*rpcmodel.NotificationPreference {
+	list := make([]*rpcmodel.NotificationPreference, 0, len(preferences))
+	for _, p := range preferences {
+		list = append(list, &rpcmodel.NotificationPreference{Type: p.Type, Enabled: p.Enabled})
+	}
+	return list
+}
+
+func UnpackNotificationPreferences(preferences []*rpcmodel.NotificationPreference) []model.NotificationPreference {
+	list := make([]model.NotificationPreference, 0, len(preferences))
+	for _, p := range preferences {
+		list = append(list, model.NotificationPreference{Type: p.Type, Enabled: p.Enabled})
+	}
+	return list
+}
+
+func PackPresences(presences []*model.Presence) []*rpcmodel.UserPresence {
+	list := make([]*rpcmodel.UserPresence, 0, len(presences))
+	for _, p := range presences {
+		list = append(list, &rpcmodel.UserPresence{UserId: p.UserID, Online: p.Online, LastSeen: p.LastSeen})
+	}
+	return list
+}
+
+func PackSearchedMessages(docs []*model.MessageDocument) []*rpcmodel.SearchedMessage {
+	list := make([]*rpcmodel.SearchedMessage, 0, len(docs))
+	for _, d := range docs {
+		list = append(list, &rpcmodel.SearchedMessage{
+			Id:         d.ID,
+			RoomId:     d.RoomID,
+			SenderId:   d.SenderID,
+			ReceiverId: d.ReceiverID,
+			Content:    d.Content,
+			Type:       d.Type,
+			Seq:        d.Seq,
+			CreatedAt:  d.CreatedAt,
+		})
+	}
+	return list
+}
+
+func UnpackMessageSearchQuery(userID int64, req *social.SearchMessagesRequest) *model.MessageSearchQuery {
+	return &model.MessageSearchQuery{
+		UserID:   userID,
+		Keyword:  req.GetKeyword(),
+		SenderID: req.GetSenderId(),
+		PeerID:   req.GetPeerId(),
+		RoomID:   req.GetRoomId(),
+		FromDate: req.GetFromDate(),
+		ToDate:   req.GetToDate(),
+		Page:     int(req.GetPage()),
+		Size:     int(req.GetSize()),
+	}
+}
@@ -1,5 +1,11 @@
 package model
 
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
 // MessageType 消息类型
 type MessageType int8
 
@@ -14,10 +20,12 @@ const (
 	ChatRoomRoleCreator = 2 // 群主
 
 	// 消息类型
-	MessageTypeText  = 0 // 文本
-	MessageTypeImage = 1 // 图片
-	MessageTypeVideo = 2 // 视频
-	MessageTypeFile  = 3 // 文件
+	MessageTypeText      = 0 // 文本
+	MessageTypeImage     = 1 // 图片
+	MessageTypeVideo     = 2 // 视频
+	MessageTypeFile      = 3 // 文件
+	MessageTypeSystem    = 4 // 系统消息，内容为 RoomEvent 的 JSON
+	MessageTypeVideoCard = 7 // 视频分享卡片，内容为 VideoCard 的 JSON
 
 	// 好友状态
 	FriendStatusPending  = 0 // 待确认
@@ -25,10 +33,17 @@ const (
 	FriendStatusRejected = 2 // 已拒绝
 	FriendStatusBlocked  = 3 // 已拉黑
 
-	// 好友申请状态
-	FriendRequestStatusPending  = 0 // 待处理
-	FriendRequestStatusAccepted = 1 // 已接受
-	FriendRequestStatusRejected = 2 // 已拒绝
+	// 好友申请状态，只能从待处理流转到其余终态
+	FriendRequestStatusPending   = 0 // 待处理
+	FriendRequestStatusAccepted  = 1 // 已接受
+	FriendRequestStatusRejected  = 2 // 已拒绝
+	FriendRequestStatusCancelled = 3 // 发送者已撤回
+	FriendRequestStatusExpired   = 4 // 超时未处理
+
+	// 好友申请处理操作
+	FriendRequestActionAccept = 1 // 接收者同意
+	FriendRequestActionReject = 2 // 接收者拒绝
+	FriendRequestActionCancel = 3 // 发送者撤回
 )
 
 // PrivateMessage 私信模型
@@ -38,6 +53,11 @@ type PrivateMessage struct {
 	ReceiverID int64  // 接收者ID
 	Content    string // 消息内容
 	IsRead     bool   // 是否已读
+	Seq        int64  // 会话内序号
+	ReplyToID  int64  // 回复的消息ID
+	EditedAt   int64  // 最后编辑时间，0 表示未编辑
+	Recalled   bool   // 是否已撤回，撤回后内容为空
+	CreatedAt  int64  // 创建时间
 }
 
 // ChatRoom 聊天室模型
@@ -51,20 +71,101 @@ type ChatRoom struct {
 
 // ChatRoomMember 聊天室成员模型
 type ChatRoomMember struct {
-	ID       int64  // 主键ID
-	RoomID   int64  // 聊天室ID
-	UserID   int64  // 用户ID
-	Nickname string // 在群里的昵称
-	Role     int8   // 角色：0=普通成员,1=管理员,2=群主
+	ID         int64  // 主键ID
+	RoomID     int64  // 聊天室ID
+	UserID     int64  // 用户ID
+	Nickname   string // 在群里的昵称
+	Role       int8   // 角色：0=普通成员,1=管理员,2=群主
+	MutedUntil int64  // 禁言截止时间，0 表示未禁言
+}
+
+// IsMuted 成员在 now 时刻是否处于禁言中
+func (m *ChatRoomMember) IsMuted(now int64) bool {
+	return m.MutedUntil > now
+}
+
+// ChatRoomInvite 聊天室邀请链接
+type ChatRoomInvite struct {
+	Code      string // 邀请码
+	RoomID    int64  // 聊天室ID
+	InviterID int64  // 创建者ID
+	ExpiresAt int64  // 过期时间
+}
+
+// 群管理事件，记录在系统消息中
+const (
+	RoomEventMemberAdded   = "member_added"
+	RoomEventMemberRemoved = "member_removed"
+	RoomEventMemberLeft    = "member_left"
+	RoomEventMemberJoined  = "member_joined" // 通过邀请链接加入
+	RoomEventAdminSet      = "admin_set"
+	RoomEventAdminUnset    = "admin_unset"
+	RoomEventOwnerChanged  = "owner_changed"
+	RoomEventMemberMuted   = "member_muted"
+	RoomEventMemberUnmuted = "member_unmuted"
+	RoomEventRenamed       = "renamed"
+	RoomEventDisbanded     = "disbanded"
+)
+
+// RoomEvent 群管理系统消息内容
+type RoomEvent struct {
+	Event      string  `json:"event"`
+	OperatorID int64   `json:"operator_id"`
+	UserIDs    []int64 `json:"user_ids,omitempty"`
+	Name       string  `json:"name,omitempty"`
+	MutedUntil int64   `json:"muted_until,omitempty"`
 }
 
 // ChatMessage 聊天消息模型
 type ChatMessage struct {
-	ID       int64  // 主键ID
-	RoomID   int64  // 聊天室ID
-	SenderID int64  // 发送者ID
-	Content  string // 消息内容
-	Type     int8   // 消息类型：0=文本,1=图片,2=视频,3=文件
+	ID        int64  // 主键ID
+	RoomID    int64  // 聊天室ID
+	SenderID  int64  // 发送者ID
+	Content   string // 消息内容
+	Type      int8   // 消息类型：0=文本,1=图片,2=视频,3=文件,4=系统,7=视频分享卡片
+	Seq       int64  // 会话内序号
+	ReplyToID int64  // 回复的消息ID
+	EditedAt  int64  // 最后编辑时间，0 表示未编辑
+	Recalled  bool   // 是否已撤回，撤回后内容为空
+	CreatedAt int64  // 创建时间
+}
+
+// ChatAttachment 聊天附件，图片、视频、文件消息的内容为其 JSON
+type ChatAttachment struct {
+	URL          string `json:"url"`
+	ThumbnailURL string `json:"thumbnail_url,omitempty"`
+	Name         string `json:"name"`
+	ContentType  string `json:"content_type"`
+	Size         int64  `json:"size"`
+}
+
+// VideoCard 视频分享卡片
+type VideoCard struct {
+	VideoID  int64  `json:"video_id"`
+	Title    string `json:"title"`
+	CoverURL string `json:"cover_url"`
+	AuthorID int64  `json:"author_id"`
+}
+
+// SyncResult 会话增量同步结果，消息按序号升序
+type SyncResult struct {
+	PrivateMessages []*PrivateMessage // 私信会话的消息
+	ChatMessages    []*ChatMessage    // 群聊会话的消息
+	LatestSeq       int64             // 会话当前最大序号
+	HasMore         bool              // 是否还有更多消息
+}
+
+// PrivateConversationKey 私信会话标识，与双方顺序无关
+func PrivateConversationKey(userID, peerID int64) string {
+	if userID > peerID {
+		userID, peerID = peerID, userID
+	}
+	return fmt.Sprintf("private:%d:%d", userID, peerID)
+}
+
+// GroupConversationKey 群聊会话标识
+func GroupConversationKey(roomID int64) string {
+	return fmt.Sprintf("group:%d", roomID)
 }
 
 // Friendship 好友关系模型
@@ -82,13 +183,318 @@ type FriendRequest struct {
 	SenderID   int64  // 发送者ID
 	ReceiverID int64  // 接收者ID
 	Message    string // 申请消息
-	Status     int8   // 状态：0=待处理,1=已接受,2=已拒绝
+	Status     int8   // 状态：0=待处理,1=已接受,2=已拒绝,3=已撤回,4=已过期
+	CreatedAt  int64  // 创建时间
+	UpdatedAt  int64  // 更新时间
+}
+
+// ReadCursor 用户在会话中的已读游标，PeerID 与 RoomID 二选一
+type ReadCursor struct {
+	UserID  int64 // 用户ID
+	PeerID  int64 // 私信对方ID
+	RoomID  int64 // 聊天室ID
+	ReadSeq int64 // 已读到的会话序号
+}
+
+// ConversationKey 游标所属会话的标识
+func (c *ReadCursor) ConversationKey() string {
+	if c.RoomID != 0 {
+		return GroupConversationKey(c.RoomID)
+	}
+	return PrivateConversationKey(c.UserID, c.PeerID)
+}
+
+// UnreadCount 会话未读数，PeerID 与 RoomID 二选一
+type UnreadCount struct {
+	PeerID int64 // 私信对方ID
+	RoomID int64 // 聊天室ID
+	Count  int64 // 未读消息数
+}
+
+// Conversation 用户收件箱中的会话，PeerID 与 RoomID 二选一
+type Conversation struct {
+	UserID          int64  // 用户ID
+	PeerID          int64  // 私信对方ID
+	RoomID          int64  // 聊天室ID
+	Name            string // 聊天室名称，私信为空
+	LastMessageID   int64  // 最后一条消息ID
+	LastSenderID    int64  // 最后一条消息的发送者ID
+	LastMessageType int8   // 最后一条消息的类型
+	LastPreview     string // 最后一条消息的预览文本
+	LastActiveAt    int64  // 最后活跃时间（毫秒）
+	UnreadCount     int64  // 未读消息数
+	Muted           bool   // 是否免打扰
+	Pinned          bool   // 是否置顶
+	Archived        bool   // 是否归档
+}
+
+// ConversationKey 会话标识
+func (c *Conversation) ConversationKey() string {
+	if c.RoomID != 0 {
+		return GroupConversationKey(c.RoomID)
+	}
+	return PrivateConversationKey(c.UserID, c.PeerID)
+}
+
+// InboxPage 收件箱分页结果
+type InboxPage struct {
+	Conversations []*Conversation // 会话列表
+	NextCursor    string          // 下一页游标，没有更多时为空
+	HasMore       bool            // 是否还有更多会话
+}
+
+// ConversationSettings 会话设置，nil 表示不修改
+type ConversationSettings struct {
+	Muted    *bool // 是否免打扰
+	Pinned   *bool // 是否置顶
+	Archived *bool // 是否归档
+}
+
+// InboxCursor 收件箱分页游标，指向上一页最后一个会话的排序位置
+type InboxCursor struct {
+	Pinned          bool   // 是否置顶
+	LastActiveAt    int64  // 最后活跃时间（毫秒）
+	ConversationKey string // 会话标识
 }
 
-// MessageReadStatus 消息已读状态模型
-type MessageReadStatus struct {
-	ID        int64 // 主键ID
-	MessageID int64 // 消息ID
-	UserID    int64 // 用户ID
-	IsRead    bool  // 是否已读
+// Recommendation 好友推荐，Score 由各项推荐信号加权得到
+type Recommendation struct {
+	UserID        int64   `json:"user_id"`        // 被推荐的用户ID
+	Score         float64 `json:"score"`          // 推荐分数
+	MutualFriends int64   `json:"mutual_friends"` // 共同好友数
+	MutualFollows int64   `json:"mutual_follows"` // 我关注的人中关注了对方的人数
+	SharedLikes   int64   `json:"shared_likes"`   // 双方都点赞过的视频数
+	CoComments    int64   `json:"co_comments"`    // 双方都评论过的视频数
+}
+
+// 通知类型
+const (
+	NotificationTypeLike           int8 = 1 // 点赞视频
+	NotificationTypeComment        int8 = 2 // 评论视频
+	NotificationTypeReply          int8 = 3 // 回复评论
+	NotificationTypeFriendRequest  int8 = 4 // 好友申请
+	NotificationTypeFollow         int8 = 5 // 新增关注
+	NotificationTypeFriendAccepted int8 = 6 // 好友申请已通过
+)
+
+// NotificationTypes 全部通知类型，偏好设置按此顺序返回
+var NotificationTypes = []int8{
+	NotificationTypeLike,
+	NotificationTypeComment,
+	NotificationTypeReply,
+	NotificationTypeFriendRequest,
+	NotificationTypeFollow,
+	NotificationTypeFriendAccepted,
+}
+
+// ValidNotificationType 判断通知类型是否有效
+func ValidNotificationType(t int8) bool {
+	return t >= NotificationTypeLike && t <= NotificationTypeFriendAccepted
+}
+
+// NotificationEvent 产生通知的领域事件，经 Kafka 投递给社交服务
+type NotificationEvent struct {
+	Type      int8  `json:"type"`       // 通知类型
+	UserID    int64 `json:"user_id"`    // 接收通知的用户
+	ActorID   int64 `json:"actor_id"`   // 触发通知的用户
+	TargetID  int64 `json:"target_id"`  // 点赞和评论为视频ID，回复为被回复的评论ID，好友申请、好友通过和关注为 0
+	CreatedAt int64 `json:"created_at"` // 事件发生时间
+}
+
+// Notification 聚合后的通知，同一目标上未读的同类通知合并为一条
+type Notification struct {
+	ID         int64   `json:"id"`
+	UserID     int64   `json:"user_id"`
+	Type       int8    `json:"type"`
+	TargetID   int64   `json:"target_id"`
+	ActorIDs   []int64 `json:"actor_ids"`   // 最近的触发者，最新的在前
+	ActorCount int64   `json:"actor_count"` // 触发者总数，同一用户重复触发只计一次
+	IsRead     bool    `json:"is_read"`
+	CreatedAt  int64   `json:"created_at"`
+	UpdatedAt  int64   `json:"updated_at"` // 最后一次合并的时间
+}
+
+// NotificationPush 通知推送，经 Kafka 投递给网关后推送给在线用户
+type NotificationPush struct {
+	UserID       int64         `json:"user_id"`
+	Notification *Notification `json:"notification"`
+	Unread       int64         `json:"unread"` // 推送时的未读通知总数
+}
+
+// NotificationPreference 某类通知的偏好，未设置过时默认接收
+type NotificationPreference struct {
+	Type    int8
+	Enabled bool
+}
+
+// Presence 用户在线状态
+type Presence struct {
+	UserID   int64
+	Online   bool
+	LastSeen int64 // 最后在线时间，从未上线时为 0
+}
+
+// PresencePush 在线状态变化推送，经 Kafka 投递给网关后推送给在线的好友
+type PresencePush struct {
+	UserID     int64   `json:"user_id"`
+	Online     bool    `json:"online"`
+	LastSeen   int64   `json:"last_seen"`
+	Recipients []int64 `json:"recipients"` // 可以看到该用户在线状态的好友
+}
+
+// MessageDocument 聊天记录搜索索引中的消息，RoomID 为 0 时是私信。
+// 消息创建、编辑和撤回后经 Kafka 投递给社交服务，按最新状态覆盖写入索引
+type MessageDocument struct {
+	ID         int64  `json:"id"`
+	RoomID     int64  `json:"room_id"`
+	SenderID   int64  `json:"sender_id"`
+	ReceiverID int64  `json:"receiver_id"` // 私信接收者，群聊为 0
+	Content    string `json:"content"`
+	SearchText string `json:"search_text"` // 参与全文检索的文本
+	Type       int8   `json:"type"`
+	Seq        int64  `json:"seq"`
+	Recalled   bool   `json:"recalled"`
+	CreatedAt  int64  `json:"created_at"`
+}
+
+// DocID 索引文档ID，私信与群聊消息的ID相互独立
+func (d *MessageDocument) DocID() string {
+	if d.RoomID == 0 {
+		return fmt.Sprintf("private:%d", d.ID)
+	}
+	return fmt.Sprintf("group:%d", d.ID)
+}
+
+// PrivateMessageDocument 私信的索引文档
+func PrivateMessageDocument(msg *PrivateMessage) *MessageDocument {
+	searchText := msg.Content
+	if msg.Recalled {
+		searchText = ""
+	}
+	return &MessageDocument{
+		ID:         msg.ID,
+		SenderID:   msg.SenderID,
+		ReceiverID: msg.ReceiverID,
+		Content:    msg.Content,
+		SearchText: searchText,
+		Type:       MessageTypeText,
+		Seq:        msg.Seq,
+		Recalled:   msg.Recalled,
+		CreatedAt:  msg.CreatedAt,
+	}
+}
+
+// ChatMessageDocument 聊天消息的索引文档，附件按文件名、视频分享卡片按标题检索
+func ChatMessageDocument(msg *ChatMessage) *MessageDocument {
+	searchText := msg.Content
+	switch msg.Type {
+	case MessageTypeImage, MessageTypeVideo, MessageTypeFile:
+		var attachment ChatAttachment
+		_ = json.Unmarshal([]byte(msg.Content), &attachment)
+		searchText = attachment.Name
+	case MessageTypeVideoCard:
+		var card VideoCard
+		_ = json.Unmarshal([]byte(msg.Content), &card)
+		searchText = card.Title
+	}
+	if msg.Recalled {
+		searchText = ""
+	}
+	return &MessageDocument{
+		ID:         msg.ID,
+		RoomID:     msg.RoomID,
+		SenderID:   msg.SenderID,
+		Content:    msg.Content,
+		SearchText: searchText,
+		Type:       msg.Type,
+		Seq:        msg.Seq,
+		Recalled:   msg.Recalled,
+		CreatedAt:  msg.CreatedAt,
+	}
+}
+
+// MessageSearchQuery 聊天记录搜索条件，PeerID 与 RoomID 都为 0 时搜索全部私信和 RoomIDs 中的聊天室
+type MessageSearchQuery struct {
+	UserID   int64
+	Keyword  string
+	SenderID int64   // 发送者，0 表示不限
+	PeerID   int64   // 只搜索与该用户的私信
+	RoomID   int64   // 只搜索该聊天室
+	RoomIDs  []int64 // 用户所在的聊天室，由服务按成员关系填充
+	FromDate int64   // 起始时间（秒），0 表示不限
+	ToDate   int64   // 截止时间（秒），0 表示不限
+	Page     int
+	Size     int
+}
+
+// ChatArchive 归档文件，保存会话中一段序号连续的消息
+type ChatArchive struct {
+	ID              int64
+	ConversationKey string
+	FromSeq         int64
+	ToSeq           int64
+	MessageCount    int64
+	FilePath        string // 归档文件在对象存储中的路径
+	CreatedAt       int64
+}
+
+// ArchivedMessage 归档文件和导出文件中的消息，每行一条 JSON，RoomID 为 0 时是私信
+type ArchivedMessage struct {
+	ID         int64  `json:"id"`
+	RoomID     int64  `json:"room_id,omitempty"`
+	SenderID   int64  `json:"sender_id"`
+	ReceiverID int64  `json:"receiver_id,omitempty"`
+	Content    string `json:"content"`
+	Type       int8   `json:"type"`
+	Seq        int64  `json:"seq"`
+	ReplyToID  int64  `json:"reply_to_id,omitempty"`
+	EditedAt   int64  `json:"edited_at,omitempty"`
+	Recalled   bool   `json:"recalled,omitempty"`
+	CreatedAt  int64  `json:"created_at"`
+}
+
+// ConversationKey 消息所属会话的标识
+func (m *ArchivedMessage) ConversationKey() string {
+	if m.RoomID != 0 {
+		return GroupConversationKey(m.RoomID)
+	}
+	return PrivateConversationKey(m.SenderID, m.ReceiverID)
+}
+
+// ChatExport 聊天记录导出结果，没有归档记录时 URL 为空
+type ChatExport struct {
+	URL          string
+	MessageCount int64
+}
+
+// MessagePreviewRecalled 消息撤回后的预览文本
+const MessagePreviewRecalled = "[消息已撤回]"
+
+// messagePreviewMaxLen 文本消息预览的最大字符数
+const messagePreviewMaxLen = 64
+
+// MessagePreview 收件箱中展示的消息预览，文本消息截断，其他类型使用占位文本
+func MessagePreview(msgType int8, content string) string {
+	switch msgType {
+	case MessageTypeImage:
+		return "[图片]"
+	case MessageTypeVideo:
+		return "[视频]"
+	case MessageTypeFile:
+		return "[文件]"
+	case MessageTypeSystem:
+		return "[系统消息]"
+	case MessageTypeVideoCard:
+		var card VideoCard
+		if err := json.Unmarshal([]byte(content), &card); err == nil && card.Title != "" {
+			content = "[视频分享] " + card.Title
+		} else {
+			return "[视频分享]"
+		}
+	}
+	runes := []rune(strings.Join(strings.Fields(content), " "))
+	if len(runes) > messagePreviewMaxLen {
+		return string(runes[:messagePreviewMaxLen]) + "…"
+	}
+	return string(runes)
 }
@@ -2,42 +2,185 @@ package repository
 
 import (
 	"context"
+	"time"
 
 	"github.com/yxrxy/videoHub/app/social/domain/model"
+	"github.com/yxrxy/videoHub/pkg/kafka"
 )
 
 type SocialDB interface {
 	// 私信相关
 	SendPrivateMessage(ctx context.Context, msg *model.PrivateMessage) error
 	GetPrivateMessages(ctx context.Context, senderID, receiverID int64, page, size int) ([]model.PrivateMessage, int64, error)
+	SyncPrivateMessages(ctx context.Context, userID, peerID, afterSeq int64, limit int) ([]model.PrivateMessage, error)
+	EditPrivateMessage(ctx context.Context, messageID int64, content string, editedAt int64) error
+	RecallPrivateMessage(ctx context.Context, messageID int64) error
 
 	// 聊天室相关
 	CreateChatRoom(ctx context.Context, room *model.ChatRoom) (int64, error)
 	GetChatRoom(ctx context.Context, roomID int64) (*model.ChatRoom, error)
 	GetUserChatRooms(ctx context.Context, userID int64) ([]model.ChatRoom, error)
+	IsChatRoomMember(ctx context.Context, roomID, userID int64) (bool, error)
+	GetChatRoomMember(ctx context.Context, roomID, userID int64) (*model.ChatRoomMember, error)
+	GetChatRoomMemberIDs(ctx context.Context, roomID int64, userIDs []int64) ([]int64, error)
+	AddChatRoomMembers(ctx context.Context, members []model.ChatRoomMember) error
+	RemoveChatRoomMember(ctx context.Context, roomID, userID int64) error
+	CountChatRoomMembers(ctx context.Context, roomID int64) (int64, error)
+	CountUserGroupRooms(ctx context.Context, userIDs []int64) (map[int64]int64, error)
+
+	// 群管理相关
+	UpdateChatRoomMemberRole(ctx context.Context, roomID, userID int64, role int8) error
+	TransferChatRoomOwner(ctx context.Context, roomID, fromUserID, toUserID int64) error
+	MuteChatRoomMember(ctx context.Context, roomID, userID, mutedUntil int64) error
+	UpdateChatRoomName(ctx context.Context, roomID int64, name string) error
+	DeleteChatRoom(ctx context.Context, roomID int64) error
+	CreateChatRoomInvite(ctx context.Context, invite *model.ChatRoomInvite) error
+	GetChatRoomInvite(ctx context.Context, code string) (*model.ChatRoomInvite, error)
 
 	// 聊天消息相关
 	SendChatMessage(ctx context.Context, msg *model.ChatMessage) error
 	GetChatMessages(ctx context.Context, roomID int64, page, size int) ([]model.ChatMessage, int64, error)
+	SyncChatMessages(ctx context.Context, roomID, afterSeq int64, limit int) ([]model.ChatMessage, error)
+	GetChatMessage(ctx context.Context, messageID int64) (*model.ChatMessage, error)
+	EditChatMessage(ctx context.Context, messageID int64, content string, editedAt int64) error
+	RecallChatMessage(ctx context.Context, messageID int64) error
+
+	// 会话序号相关
+	GetConversationSeq(ctx context.Context, conversationKey string) (int64, error)
 
-	// 好友关系相关
-	AddFriend(ctx context.Context, friendship *model.Friendship) error
+	// 好友关系相关，好友关系只通过同意好友申请建立，双方各一行
 	GetFriendship(ctx context.Context, userID, friendID int64) (*model.Friendship, error)
 	GetUserFriends(ctx context.Context, userID int64) ([]model.Friendship, error)
+	AreFriends(ctx context.Context, userID, friendID int64) (bool, error)
+	// CountFriends 统计用户的好友数，键为用户ID
+	CountFriends(ctx context.Context, userIDs []int64) (map[int64]int64, error)
 
 	// 好友申请相关
 	CreateFriendRequest(ctx context.Context, request *model.FriendRequest) error
-	GetFriendRequests(ctx context.Context, userID int64, status int8) ([]model.FriendRequest, error)
-	UpdateFriendRequest(ctx context.Context, requestID int64, status int8) error
+	// GetFriendRequest 获取好友申请，不存在时返回 nil
+	GetFriendRequest(ctx context.Context, requestID int64) (*model.FriendRequest, error)
+	// GetPendingFriendRequest 获取 senderID 发给 receiverID 的待处理申请，不存在时返回 nil
+	GetPendingFriendRequest(ctx context.Context, senderID, receiverID int64) (*model.FriendRequest, error)
+	// GetFriendRequests 按创建时间倒序获取收到的或发出的好友申请
+	GetFriendRequests(ctx context.Context, userID int64, sent bool) ([]model.FriendRequest, error)
+	// UpdateFriendRequestStatus 仅当申请仍为 from 状态时改为 to，返回是否更新成功
+	UpdateFriendRequestStatus(ctx context.Context, requestID int64, from, to int8) (bool, error)
+	// AcceptFriendRequest 在同一事务中同意待处理的申请并建立双向好友关系，申请已不是待处理时返回 false
+	AcceptFriendRequest(ctx context.Context, requestID int64) (bool, error)
 
 	// 消息已读状态相关
-	MarkMessageRead(ctx context.Context, messageID, userID int64) error
-	GetUnreadMessageCount(ctx context.Context, userID int64) (int64, error)
+	GetPrivateMessage(ctx context.Context, messageID int64) (*model.PrivateMessage, error)
+	// MarkConversationRead 推进已读游标，游标只进不退，返回推进后的已读序号
+	MarkConversationRead(ctx context.Context, cursor *model.ReadCursor) (int64, error)
+	// GetPrivateUnreadCounts 各私信会话的未读数，键为对方ID
+	GetPrivateUnreadCounts(ctx context.Context, userID int64) (map[int64]int64, error)
+	// GetGroupUnreadCounts 用户所在各聊天室的未读数，键为聊天室ID，不含自己发送和已撤回的消息
+	GetGroupUnreadCounts(ctx context.Context, userID int64) (map[int64]int64, error)
+
+	// 收件箱相关，会话记录随消息收发和成员变更增量维护
+	// GetInbox 按置顶、最后活跃时间倒序获取收件箱，after 为空时从头开始
+	GetInbox(ctx context.Context, userID int64, archived bool, after *model.InboxCursor, limit int) ([]model.Conversation, error)
+	// GetConversation 获取收件箱中的会话，不存在时返回 nil
+	GetConversation(ctx context.Context, userID int64, conversationKey string) (*model.Conversation, error)
+	UpdateConversationSettings(ctx context.Context, userID int64, conversationKey string, settings *model.ConversationSettings) error
+
+	// 好友推荐相关，各推荐信号返回候选人及其计数，按计数倒序最多 limit 个，不含 userID 本人
+	// GetMutualFriendCandidates 好友的好友，计数为共同好友数
+	GetMutualFriendCandidates(ctx context.Context, userID int64, limit int) (map[int64]int64, error)
+	// GetMutualFollowCandidates 关注的人所关注的人，计数为其中关注了候选人的人数
+	GetMutualFollowCandidates(ctx context.Context, userID int64, limit int) (map[int64]int64, error)
+	// GetSharedLikeCandidates 点赞过相同视频的用户，计数为共同点赞的视频数
+	GetSharedLikeCandidates(ctx context.Context, userID int64, limit int) (map[int64]int64, error)
+	// GetCoCommentCandidates 评论过相同视频的用户，计数为共同评论的视频数
+	GetCoCommentCandidates(ctx context.Context, userID int64, limit int) (map[int64]int64, error)
+	// GetConnectedUsers 返回 userIDs 中已是 userID 好友或已被 userID 关注的用户
+	GetConnectedUsers(ctx context.Context, userID int64, userIDs []int64) (map[int64]bool, error)
+
+	// 通知相关，返回的通知 ActorIDs 只含最近几个触发者
+	// AddNotificationActor 把事件合并进同一目标上未读的同类通知，没有时新建；
+	// 触发者已在该通知中时不做修改并返回 false
+	AddNotificationActor(ctx context.Context, event *model.NotificationEvent) (*model.Notification, bool, error)
+	// GetNotifications 按最后合并时间倒序分页获取通知，notificationType 为 0 时不限类型
+	GetNotifications(ctx context.Context, userID int64, notificationType int8, page, size int) ([]model.Notification, int64, error)
+	// GetNotificationUnreadCounts 各类型的未读通知数，键为通知类型
+	GetNotificationUnreadCounts(ctx context.Context, userID int64) (map[int8]int64, error)
+	// MarkNotificationsRead 标记通知已读，ids 为空时标记全部未读通知，notificationType 为 0 时不限类型，返回标记的条数
+	MarkNotificationsRead(ctx context.Context, userID int64, ids []int64, notificationType int8) (int64, error)
+	// GetNotificationPreferences 获取通知偏好，键为通知类型，未设置过的类型不在结果中
+	GetNotificationPreferences(ctx context.Context, userID int64) (map[int8]bool, error)
+	UpdateNotificationPreferences(ctx context.Context, userID int64, preferences map[int8]bool) error
+
+	// 聊天记录归档相关，过期消息按ID升序返回，最多 limit 条
+	// GetExpiredPrivateMessages 获取创建时间早于 before（秒）的私信
+	GetExpiredPrivateMessages(ctx context.Context, before int64, limit int) ([]model.ArchivedMessage, error)
+	// GetExpiredChatMessages 获取创建时间早于 before（秒）的聊天消息
+	GetExpiredChatMessages(ctx context.Context, before int64, limit int) ([]model.ArchivedMessage, error)
+	// ArchiveMessages 在同一事务中记录归档文件并删除已归档的私信和聊天消息
+	ArchiveMessages(ctx context.Context, archives []model.ChatArchive, privateIDs, chatIDs []int64) error
+	// GetChatArchives 按序号升序获取会话的归档文件
+	GetChatArchives(ctx context.Context, conversationKey string) ([]model.ChatArchive, error)
 }
 
 type SocialCache interface {
-	// 在线状态相关
-	SetUserOnline(ctx context.Context, userID int64) error
-	SetUserOffline(ctx context.Context, userID int64) error
+	// 在线状态相关，在线标记在 constants.PresenceTTL 内未续期时自动过期
+	// SetUserOnline 标记在线或续期并记录最后在线时间，返回用户是否由离线变为在线
+	SetUserOnline(ctx context.Context, userID, now int64) (bool, error)
+	// SetUserOffline 清除在线标记并记录最后在线时间，返回用户之前是否在线
+	SetUserOffline(ctx context.Context, userID, now int64) (bool, error)
 	IsUserOnline(ctx context.Context, userID int64) (bool, error)
+	// GetPresences 批量获取在线状态和最后在线时间，键为用户ID
+	GetPresences(ctx context.Context, userIDs []int64) (map[int64]*model.Presence, error)
+
+	// 好友推荐相关
+	// GetRecommendations 获取缓存的推荐列表，第二个返回值表示缓存是否存在
+	GetRecommendations(ctx context.Context, userID int64) ([]*model.Recommendation, bool, error)
+	SetRecommendations(ctx context.Context, userID int64, recommendations []*model.Recommendation) error
+	// TouchRecommendUser 记录用户最近一次访问推荐的时间，定时任务只刷新活跃用户
+	TouchRecommendUser(ctx context.Context, userID int64) error
+	// GetRecommendUsers 分页获取最近访问过推荐的用户
+	GetRecommendUsers(ctx context.Context, offset, count int64) ([]int64, error)
+	// RemoveRecommendUsersBefore 清理访问时间早于 before（秒）的用户
+	RemoveRecommendUsersBefore(ctx context.Context, before int64) error
+
+	// 聊天记录归档任务锁，多个实例中同一时间只有一个执行归档，token 标识持有者，只有持有者能释放
+	AcquireChatRetentionLock(ctx context.Context, token string, ttl time.Duration) (bool, error)
+	ReleaseChatRetentionLock(ctx context.Context, token string) error
+}
+
+// PrivacyChecker 拉黑与隐私设置校验，由用户服务的 PrivacyGuard 实现
+type PrivacyChecker interface {
+	// CheckInteract 双方存在拉黑关系时返回错误
+	CheckInteract(ctx context.Context, actorID, targetID int64) error
+	// CheckDirectMessage 校验拉黑关系和接收方的私信权限
+	CheckDirectMessage(ctx context.Context, senderID, receiverID int64) error
+	// FilterBlocked 返回 userIDs 中与 userID 存在拉黑关系的用户
+	FilterBlocked(ctx context.Context, userID int64, userIDs []int64) (map[int64]bool, error)
+	// FilterPresenceVisible 返回 userIDs 中在线状态对 viewerID 可见的用户
+	FilterPresenceVisible(ctx context.Context, viewerID int64, userIDs []int64) (map[int64]bool, error)
+}
+
+// NotificationMQ 通知事件、通知推送、在线状态推送和消息索引事件的消息队列
+type NotificationMQ interface {
+	// SendNotificationEvent 投递产生通知的领域事件
+	SendNotificationEvent(ctx context.Context, event *model.NotificationEvent) error
+	ConsumeNotificationEvents(ctx context.Context) <-chan *kafka.Message
+	// SendNotificationPush 投递通知推送，由网关推送给在线用户
+	SendNotificationPush(ctx context.Context, push *model.NotificationPush) error
+	// SendPresencePush 投递在线状态变化，由网关推送给在线的好友
+	SendPresencePush(ctx context.Context, push *model.PresencePush) error
+	// SendMessageIndexEvent 投递消息创建、编辑或撤回后的最新状态，由社交服务写入搜索索引
+	SendMessageIndexEvent(ctx context.Context, doc *model.MessageDocument) error
+	ConsumeMessageIndexEvents(ctx context.Context) <-chan *kafka.Message
+}
+
+// MessageElastic 聊天记录搜索索引
+type MessageElastic interface {
+	IsExist(ctx context.Context, indexName string) bool
+	CreateIndex(ctx context.Context, indexName string) error
+	// IndexMessage 按文档ID覆盖写入消息的最新状态
+	IndexMessage(ctx context.Context, indexName string, doc *model.MessageDocument) error
+	// SearchMessages 按创建时间倒序搜索未撤回的消息，返回当前页和命中总数
+	SearchMessages(ctx context.Context, indexName string, query *model.MessageSearchQuery) ([]*model.MessageDocument, int64, error)
+	// DeleteMessagesBefore 删除创建时间早于 before（秒）的消息，已归档的消息不再可搜索
+	DeleteMessagesBefore(ctx context.Context, indexName string, before int64) error
 }
@@ -0,0 +1,231 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/yxrxy/videoHub/app/social/domain/model"
+	"github.com/yxrxy/videoHub/pkg/constants"
+	"github.com/yxrxy/videoHub/pkg/errno"
+)
+
+// requireGroupRole 校验聊天室为群聊且操作者角色不低于 role
+func (s *SocialService) requireGroupRole(ctx context.Context, roomID, operatorID int64, role int8) (*model.ChatRoomMember, error) {
+	operator, err := s.RequireChatRoomMember(ctx, roomID, operatorID)
+	if err != nil {
+		return nil, err
+	}
+	if operator.Role < role {
+		return nil, errno.AuthNoOperatePermission.WithMessage("insufficient role for this operation")
+	}
+	room, err := s.db.GetChatRoom(ctx, roomID)
+	if err != nil {
+		return nil, err
+	}
+	if room.Type != model.ChatRoomTypeGroup {
+		return nil, errno.ParamVerifyError.WithMessage("only group rooms can be managed")
+	}
+	return operator, nil
+}
+
+// requireTarget 获取被操作的成员，不能是操作者本人
+func (s *SocialService) requireTarget(ctx context.Context, roomID, operatorID, userID int64) (*model.ChatRoomMember, error) {
+	if operatorID == userID {
+		return nil, errno.ParamVerifyError.WithMessage("cannot operate on yourself")
+	}
+	target, err := s.db.GetChatRoomMember(ctx, roomID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if target == nil {
+		return nil, errno.ParamVerifyError.WithMessage("user is not a member of the room")
+	}
+	return target, nil
+}
+
+// SetChatRoomAdmin 群主设置或取消管理员，角色未变化时不产生系统消息
+func (s *SocialService) SetChatRoomAdmin(ctx context.Context, operatorID, roomID, userID int64, isAdmin bool) (*model.ChatMessage, error) {
+	if _, err := s.requireGroupRole(ctx, roomID, operatorID, model.ChatRoomRoleCreator); err != nil {
+		return nil, err
+	}
+	target, err := s.requireTarget(ctx, roomID, operatorID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	role, event := int8(model.ChatRoomRoleMember), model.RoomEventAdminUnset
+	if isAdmin {
+		role, event = model.ChatRoomRoleAdmin, model.RoomEventAdminSet
+	}
+	if target.Role == role {
+		return nil, nil
+	}
+	if err := s.db.UpdateChatRoomMemberRole(ctx, roomID, userID, role); err != nil {
+		return nil, err
+	}
+	return s.emitRoomEvent(ctx, roomID, &model.RoomEvent{
+		Event:      event,
+		OperatorID: operatorID,
+		UserIDs:    []int64{userID},
+	}), nil
+}
+
+// TransferChatRoom 群主转让给其他成员，原群主降为管理员
+func (s *SocialService) TransferChatRoom(ctx context.Context, operatorID, roomID, newOwnerID int64) (*model.ChatMessage, error) {
+	if _, err := s.requireGroupRole(ctx, roomID, operatorID, model.ChatRoomRoleCreator); err != nil {
+		return nil, err
+	}
+	if _, err := s.requireTarget(ctx, roomID, operatorID, newOwnerID); err != nil {
+		return nil, err
+	}
+	if err := s.db.TransferChatRoomOwner(ctx, roomID, operatorID, newOwnerID); err != nil {
+		return nil, err
+	}
+	return s.emitRoomEvent(ctx, roomID, &model.RoomEvent{
+		Event:      model.RoomEventOwnerChanged,
+		OperatorID: operatorID,
+		UserIDs:    []int64{newOwnerID},
+	}), nil
+}
+
+// MuteChatRoomMember 禁言成员 duration 秒，duration 为 0 时解除禁言，返回禁言截止时间
+func (s *SocialService) MuteChatRoomMember(ctx context.Context, operatorID, roomID, userID, duration int64) (int64, *model.ChatMessage, error) {
+	if duration < 0 || duration > constants.ChatRoomMaxMuteSeconds {
+		return 0, nil, errno.ParamVerifyError.WithMessage("invalid mute duration")
+	}
+	operator, err := s.requireGroupRole(ctx, roomID, operatorID, model.ChatRoomRoleAdmin)
+	if err != nil {
+		return 0, nil, err
+	}
+	target, err := s.requireTarget(ctx, roomID, operatorID, userID)
+	if err != nil {
+		return 0, nil, err
+	}
+	if operator.Role <= target.Role {
+		return 0, nil, errno.AuthNoOperatePermission.WithMessage("cannot mute a member with the same or higher role")
+	}
+
+	var mutedUntil int64
+	event := model.RoomEventMemberUnmuted
+	if duration > 0 {
+		mutedUntil = time.Now().Unix() + duration
+		event = model.RoomEventMemberMuted
+	}
+	if err := s.db.MuteChatRoomMember(ctx, roomID, userID, mutedUntil); err != nil {
+		return 0, nil, err
+	}
+	msg := s.emitRoomEvent(ctx, roomID, &model.RoomEvent{
+		Event:      event,
+		OperatorID: operatorID,
+		UserIDs:    []int64{userID},
+		MutedUntil: mutedUntil,
+	})
+	return mutedUntil, msg, nil
+}
+
+// RenameChatRoom 管理员或群主修改聊天室名称
+func (s *SocialService) RenameChatRoom(ctx context.Context, operatorID, roomID int64, name string) (*model.ChatMessage, error) {
+	name = strings.TrimSpace(name)
+	if name == "" || utf8.RuneCountInString(name) > constants.MaxChatRoomNameLength {
+		return nil, errno.ParamVerifyError.WithMessage("invalid room name")
+	}
+	if _, err := s.requireGroupRole(ctx, roomID, operatorID, model.ChatRoomRoleAdmin); err != nil {
+		return nil, err
+	}
+	if err := s.db.UpdateChatRoomName(ctx, roomID, name); err != nil {
+		return nil, err
+	}
+	return s.emitRoomEvent(ctx, roomID, &model.RoomEvent{
+		Event:      model.RoomEventRenamed,
+		OperatorID: operatorID,
+		Name:       name,
+	}), nil
+}
+
+// DisbandChatRoom 群主解散聊天室，返回解散前的成员ID，系统消息在删除前写入
+func (s *SocialService) DisbandChatRoom(ctx context.Context, operatorID, roomID int64) ([]int64, *model.ChatMessage, error) {
+	if _, err := s.requireGroupRole(ctx, roomID, operatorID, model.ChatRoomRoleCreator); err != nil {
+		return nil, nil, err
+	}
+	memberIDs, err := s.db.GetChatRoomMemberIDs(ctx, roomID, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	msg := s.emitRoomEvent(ctx, roomID, &model.RoomEvent{
+		Event:      model.RoomEventDisbanded,
+		OperatorID: operatorID,
+	})
+	if err := s.db.DeleteChatRoom(ctx, roomID); err != nil {
+		return nil, nil, err
+	}
+	return memberIDs, msg, nil
+}
+
+// CreateChatRoomInvite 管理员或群主创建邀请链接，ttl 为 0 时使用默认有效期
+func (s *SocialService) CreateChatRoomInvite(ctx context.Context, operatorID, roomID, ttl int64) (*model.ChatRoomInvite, error) {
+	if ttl == 0 {
+		ttl = constants.ChatRoomInviteTTL
+	}
+	if ttl < 0 || ttl > constants.ChatRoomInviteMaxTTL {
+		return nil, errno.ParamVerifyError.WithMessage("invalid invite ttl")
+	}
+	if _, err := s.requireGroupRole(ctx, roomID, operatorID, model.ChatRoomRoleAdmin); err != nil {
+		return nil, err
+	}
+
+	buf := make([]byte, constants.ChatRoomInviteCodeBytes)
+	if _, err := rand.Read(buf); err != nil {
+		return nil, errno.InternalServiceError.WithError(err)
+	}
+	invite := &model.ChatRoomInvite{
+		Code:      hex.EncodeToString(buf),
+		RoomID:    roomID,
+		InviterID: operatorID,
+		ExpiresAt: time.Now().Unix() + ttl,
+	}
+	if err := s.db.CreateChatRoomInvite(ctx, invite); err != nil {
+		return nil, err
+	}
+	return invite, nil
+}
+
+// JoinChatRoomByInvite 通过邀请链接加入聊天室，已是成员时直接返回聊天室ID
+func (s *SocialService) JoinChatRoomByInvite(ctx context.Context, userID int64, code string) (int64, *model.ChatMessage, error) {
+	invite, err := s.db.GetChatRoomInvite(ctx, code)
+	if err != nil {
+		return 0, nil, err
+	}
+	if invite.ExpiresAt <= time.Now().Unix() {
+		return 0, nil, errno.ParamVerifyError.WithMessage("invite link has expired")
+	}
+	roomID := invite.RoomID
+	member, err := s.db.GetChatRoomMember(ctx, roomID, userID)
+	if err != nil {
+		return 0, nil, err
+	}
+	if member != nil {
+		return roomID, nil, nil
+	}
+
+	current, err := s.db.CountChatRoomMembers(ctx, roomID)
+	if err != nil {
+		return 0, nil, err
+	}
+	if err := s.checkGroupQuota(ctx, current, []int64{userID}); err != nil {
+		return 0, nil, err
+	}
+	if err := s.db.AddChatRoomMembers(ctx, []model.ChatRoomMember{
+		{RoomID: roomID, UserID: userID, Role: model.ChatRoomRoleMember},
+	}); err != nil {
+		return 0, nil, err
+	}
+	return roomID, s.emitRoomEvent(ctx, roomID, &model.RoomEvent{
+		Event:      model.RoomEventMemberJoined,
+		OperatorID: userID,
+		UserIDs:    []int64{userID},
+	}), nil
+}
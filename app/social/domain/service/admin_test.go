@@ -0,0 +1,224 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/smartystreets/goconvey/convey"
+	"github.com/stretchr/testify/mock"
+	"github.com/yxrxy/videoHub/app/social/domain/model"
+	"github.com/yxrxy/videoHub/pkg/constants"
+)
+
+func TestSocialService_SetChatRoomAdmin(t *testing.T) {
+	type TestCase struct {
+		Name          string
+		OperatorRole  int8
+		TargetRole    int8
+		IsAdmin       bool
+		ExpectedError bool
+		ExpectedEvent string
+	}
+
+	const roomID, operatorID, userID = int64(10), int64(1), int64(2)
+	testCases := []TestCase{
+		{
+			Name:          "群主设置管理员",
+			OperatorRole:  model.ChatRoomRoleCreator,
+			TargetRole:    model.ChatRoomRoleMember,
+			IsAdmin:       true,
+			ExpectedEvent: model.RoomEventAdminSet,
+		},
+		{
+			Name:          "群主取消管理员",
+			OperatorRole:  model.ChatRoomRoleCreator,
+			TargetRole:    model.ChatRoomRoleAdmin,
+			ExpectedEvent: model.RoomEventAdminUnset,
+		},
+		{
+			Name:         "角色未变化时不产生系统消息",
+			OperatorRole: model.ChatRoomRoleCreator,
+			TargetRole:   model.ChatRoomRoleAdmin,
+			IsAdmin:      true,
+		},
+		{
+			Name:          "管理员不能设置管理员",
+			OperatorRole:  model.ChatRoomRoleAdmin,
+			TargetRole:    model.ChatRoomRoleMember,
+			IsAdmin:       true,
+			ExpectedError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		convey.Convey(tc.Name, t, func() {
+			ctx := context.Background()
+			db := new(MockDB)
+			db.On("GetChatRoomMember", ctx, roomID, operatorID).Return(member(roomID, operatorID, tc.OperatorRole), nil)
+			db.On("GetChatRoomMember", ctx, roomID, userID).Return(member(roomID, userID, tc.TargetRole), nil)
+			db.On("GetChatRoom", ctx, roomID).Return(&model.ChatRoom{ID: roomID, Type: model.ChatRoomTypeGroup}, nil)
+			db.On("UpdateChatRoomMemberRole", ctx, roomID, userID, mock.Anything).Return(nil)
+			db.On("SendChatMessage", ctx, mock.Anything).Return(nil)
+
+			svc := NewSocialService(db, new(MockCache), new(MockPrivacy), new(MockNotificationMQ), new(MockElastic))
+			msg, err := svc.SetChatRoomAdmin(ctx, operatorID, roomID, userID, tc.IsAdmin)
+
+			if tc.ExpectedError {
+				convey.So(err, convey.ShouldNotBeNil)
+				db.AssertNotCalled(t, "UpdateChatRoomMemberRole", ctx, roomID, userID, mock.Anything)
+				return
+			}
+			convey.So(err, convey.ShouldBeNil)
+			if tc.ExpectedEvent == "" {
+				convey.So(msg, convey.ShouldBeNil)
+				db.AssertNotCalled(t, "UpdateChatRoomMemberRole", ctx, roomID, userID, mock.Anything)
+				return
+			}
+			convey.So(msg.Content, convey.ShouldContainSubstring, tc.ExpectedEvent)
+		})
+	}
+}
+
+func TestSocialService_MuteChatRoomMember(t *testing.T) {
+	type TestCase struct {
+		Name          string
+		OperatorRole  int8
+		TargetRole    int8
+		Duration      int64
+		ExpectedError bool
+	}
+
+	const roomID, operatorID, userID = int64(10), int64(1), int64(2)
+	testCases := []TestCase{
+		{
+			Name:         "管理员禁言普通成员",
+			OperatorRole: model.ChatRoomRoleAdmin,
+			TargetRole:   model.ChatRoomRoleMember,
+			Duration:     600,
+		},
+		{
+			Name:         "解除禁言",
+			OperatorRole: model.ChatRoomRoleAdmin,
+			TargetRole:   model.ChatRoomRoleMember,
+		},
+		{
+			Name:          "管理员不能禁言管理员",
+			OperatorRole:  model.ChatRoomRoleAdmin,
+			TargetRole:    model.ChatRoomRoleAdmin,
+			Duration:      600,
+			ExpectedError: true,
+		},
+		{
+			Name:          "禁言时长超过上限",
+			OperatorRole:  model.ChatRoomRoleCreator,
+			TargetRole:    model.ChatRoomRoleMember,
+			Duration:      constants.ChatRoomMaxMuteSeconds + 1,
+			ExpectedError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		convey.Convey(tc.Name, t, func() {
+			ctx := context.Background()
+			db := new(MockDB)
+			db.On("GetChatRoomMember", ctx, roomID, operatorID).Return(member(roomID, operatorID, tc.OperatorRole), nil)
+			db.On("GetChatRoomMember", ctx, roomID, userID).Return(member(roomID, userID, tc.TargetRole), nil)
+			db.On("GetChatRoom", ctx, roomID).Return(&model.ChatRoom{ID: roomID, Type: model.ChatRoomTypeGroup}, nil)
+			db.On("MuteChatRoomMember", ctx, roomID, userID, mock.Anything).Return(nil)
+			db.On("SendChatMessage", ctx, mock.Anything).Return(nil)
+
+			svc := NewSocialService(db, new(MockCache), new(MockPrivacy), new(MockNotificationMQ), new(MockElastic))
+			mutedUntil, msg, err := svc.MuteChatRoomMember(ctx, operatorID, roomID, userID, tc.Duration)
+
+			if tc.ExpectedError {
+				convey.So(err, convey.ShouldNotBeNil)
+				db.AssertNotCalled(t, "MuteChatRoomMember", ctx, roomID, userID, mock.Anything)
+				return
+			}
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(msg, convey.ShouldNotBeNil)
+			if tc.Duration == 0 {
+				convey.So(mutedUntil, convey.ShouldEqual, 0)
+			} else {
+				convey.So(mutedUntil, convey.ShouldBeGreaterThan, time.Now().Unix())
+			}
+			db.AssertCalled(t, "MuteChatRoomMember", ctx, roomID, userID, mutedUntil)
+		})
+	}
+}
+
+func TestSocialService_JoinChatRoomByInvite(t *testing.T) {
+	type TestCase struct {
+		Name          string
+		ExpiresIn     int64
+		Member        *model.ChatRoomMember
+		Current       int64
+		ExpectedError bool
+		ExpectedJoin  bool
+	}
+
+	const roomID, userID, code = int64(10), int64(2), "abcd"
+	testCases := []TestCase{
+		{
+			Name:         "通过有效邀请加入",
+			ExpiresIn:    3600,
+			Current:      3,
+			ExpectedJoin: true,
+		},
+		{
+			Name:      "已是成员时直接返回",
+			ExpiresIn: 3600,
+			Member:    member(roomID, userID, model.ChatRoomRoleMember),
+		},
+		{
+			Name:          "邀请已过期",
+			ExpiresIn:     -1,
+			ExpectedError: true,
+		},
+		{
+			Name:          "聊天室已满",
+			ExpiresIn:     3600,
+			Current:       constants.DefaultMaxGroupMembers,
+			ExpectedError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		convey.Convey(tc.Name, t, func() {
+			ctx := context.Background()
+			db := new(MockDB)
+			db.On("GetChatRoomInvite", ctx, code).Return(&model.ChatRoomInvite{
+				Code:      code,
+				RoomID:    roomID,
+				InviterID: 1,
+				ExpiresAt: time.Now().Unix() + tc.ExpiresIn,
+			}, nil)
+			db.On("GetChatRoomMember", ctx, roomID, userID).Return(tc.Member, nil)
+			db.On("CountChatRoomMembers", ctx, roomID).Return(tc.Current, nil)
+			db.On("CountUserGroupRooms", ctx, []int64{userID}).Return(map[int64]int64{userID: 1}, nil)
+			db.On("AddChatRoomMembers", ctx, mock.Anything).Return(nil)
+			db.On("SendChatMessage", ctx, mock.Anything).Return(nil)
+
+			svc := NewSocialService(db, new(MockCache), new(MockPrivacy), new(MockNotificationMQ), new(MockElastic))
+			joined, msg, err := svc.JoinChatRoomByInvite(ctx, userID, code)
+
+			if tc.ExpectedError {
+				convey.So(err, convey.ShouldNotBeNil)
+				db.AssertNotCalled(t, "AddChatRoomMembers", ctx, mock.Anything)
+				return
+			}
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(joined, convey.ShouldEqual, roomID)
+			if tc.ExpectedJoin {
+				convey.So(msg.Content, convey.ShouldContainSubstring, model.RoomEventMemberJoined)
+				db.AssertCalled(t, "AddChatRoomMembers", ctx, []model.ChatRoomMember{
+					{RoomID: roomID, UserID: userID, Role: model.ChatRoomRoleMember},
+				})
+			} else {
+				convey.So(msg, convey.ShouldBeNil)
+				db.AssertNotCalled(t, "AddChatRoomMembers", ctx, mock.Anything)
+			}
+		})
+	}
+}
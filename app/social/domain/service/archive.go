@@ -0,0 +1,280 @@
+package service
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/yxrxy/videoHub/app/social/domain/model"
+	"github.com/yxrxy/videoHub/config"
+	"github.com/yxrxy/videoHub/pkg/constants"
+	"github.com/yxrxy/videoHub/pkg/errno"
+	"github.com/yxrxy/videoHub/pkg/storage"
+	"github.com/yxrxy/videoHub/pkg/upyun"
+)
+
+// chatHistoryDays 聊天记录在消息表中保留的天数，未配置时不归档
+func chatHistoryDays() int {
+	if config.Social != nil {
+		return config.Social.Chat.HistoryDays
+	}
+	return 0
+}
+
+// chatArchiveDir 归档文件在对象存储中的目录，未配置时使用默认值
+func chatArchiveDir() string {
+	if config.Social != nil && config.Social.Chat.ArchiveDir != "" {
+		return config.Social.Chat.ArchiveDir
+	}
+	return constants.DefaultChatArchiveDir
+}
+
+// chatArchiveURL 归档文件在又拍云中的地址。消息删除后只剩归档文件，必须放在所有实例都能读到的共享存储中
+func chatArchiveURL(filePath string) (string, error) {
+	if config.Upyun == nil || config.Upyun.UssDomain == "" {
+		return "", errno.InternalServiceError.WithMessage("chat archive storage is not configured")
+	}
+	return strings.TrimSuffix(config.Upyun.UssDomain, "/") + "/" + filePath, nil
+}
+
+// StartChatRetention 定期归档超过保留天数的聊天记录，未配置保留天数时不启动
+func (s *SocialService) StartChatRetention(ctx context.Context) {
+	days := chatHistoryDays()
+	if days <= 0 {
+		return
+	}
+	ticker := time.NewTicker(constants.ChatRetentionInterval)
+	go func() {
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				before := time.Now().AddDate(0, 0, -days).Unix()
+				if _, err := s.ArchiveExpiredMessages(ctx, before); err != nil {
+					log.Printf("归档聊天记录失败: %v", err)
+				}
+			}
+		}
+	}()
+}
+
+// ArchiveExpiredMessages 把创建时间早于 before（秒）的私信和聊天消息按会话写入对象存储中的压缩归档文件，
+// 再分批从消息表删除并清理搜索索引。多个实例中同一时间只有一个执行，返回归档的消息数
+func (s *SocialService) ArchiveExpiredMessages(ctx context.Context, before int64) (int64, error) {
+	// 锁的值是本次执行的随机标识，执行超过锁的有效期时不会释放其他实例重新加的锁
+	random := make([]byte, constants.ChatRetentionLockBytes)
+	if _, err := rand.Read(random); err != nil {
+		return 0, errno.InternalServiceError.WithError(err)
+	}
+	token := hex.EncodeToString(random)
+	locked, err := s.cache.AcquireChatRetentionLock(ctx, token, constants.ChatRetentionLockTTL)
+	if err != nil || !locked {
+		return 0, err
+	}
+	defer func() {
+		if err := s.cache.ReleaseChatRetentionLock(ctx, token); err != nil {
+			log.Printf("释放归档任务锁失败: %v", err)
+		}
+	}()
+
+	privateCount, err := s.archiveBatches(ctx, before, s.db.GetExpiredPrivateMessages, func(archives []model.ChatArchive, ids []int64) error {
+		return s.db.ArchiveMessages(ctx, archives, ids, nil)
+	})
+	if err != nil {
+		return privateCount, err
+	}
+	chatCount, err := s.archiveBatches(ctx, before, s.db.GetExpiredChatMessages, func(archives []model.ChatArchive, ids []int64) error {
+		return s.db.ArchiveMessages(ctx, archives, nil, ids)
+	})
+	total := privateCount + chatCount
+	if err != nil {
+		return total, err
+	}
+
+	if total > 0 {
+		if err := s.es.DeleteMessagesBefore(ctx, constants.MessageIndexName, before); err != nil {
+			log.Printf("清理已归档消息的搜索索引失败: %v", err)
+		}
+	}
+	return total, nil
+}
+
+// archiveBatches 分批读取过期消息，上传归档文件后在同一事务中记录归档并删除消息
+func (s *SocialService) archiveBatches(
+	ctx context.Context,
+	before int64,
+	fetch func(ctx context.Context, before int64, limit int) ([]model.ArchivedMessage, error),
+	commit func(archives []model.ChatArchive, ids []int64) error,
+) (int64, error) {
+	var total int64
+	for {
+		messages, err := fetch(ctx, before, constants.ChatArchiveBatchSize)
+		if err != nil || len(messages) == 0 {
+			return total, err
+		}
+		archives, err := writeArchives(messages)
+		if err != nil {
+			return total, err
+		}
+		ids := make([]int64, len(messages))
+		for i, msg := range messages {
+			ids[i] = msg.ID
+		}
+		if err := commit(archives, ids); err != nil {
+			deleteArchives(archives)
+			return total, err
+		}
+		total += int64(len(messages))
+		if len(messages) < constants.ChatArchiveBatchSize {
+			return total, nil
+		}
+	}
+}
+
+// writeArchives 按会话把一批消息上传为各自的归档文件，文件内按序号升序每行一条消息
+func writeArchives(messages []model.ArchivedMessage) ([]model.ChatArchive, error) {
+	groups := make(map[string][]model.ArchivedMessage)
+	var keys []string
+	for _, msg := range messages {
+		key := msg.ConversationKey()
+		if _, ok := groups[key]; !ok {
+			keys = append(keys, key)
+		}
+		groups[key] = append(groups[key], msg)
+	}
+
+	archives := make([]model.ChatArchive, 0, len(keys))
+	for _, key := range keys {
+		group := groups[key]
+		var buf bytes.Buffer
+		gz := gzip.NewWriter(&buf)
+		enc := json.NewEncoder(gz)
+		for i := range group {
+			if err := enc.Encode(&group[i]); err != nil {
+				return nil, errno.InternalServiceError.WithError(err)
+			}
+		}
+		if err := gz.Close(); err != nil {
+			return nil, errno.InternalServiceError.WithError(err)
+		}
+
+		archive := model.ChatArchive{
+			ConversationKey: key,
+			FromSeq:         group[0].Seq,
+			ToSeq:           group[len(group)-1].Seq,
+			MessageCount:    int64(len(group)),
+		}
+		// 会话标识中的冒号替换掉，作为会话的归档子目录；记录完整路径，修改归档目录后旧归档仍可读取
+		archive.FilePath = path.Join(chatArchiveDir(), strings.ReplaceAll(key, ":", "_"),
+			fmt.Sprintf("%d-%d.jsonl.gz", archive.FromSeq, archive.ToSeq))
+		url, err := chatArchiveURL(archive.FilePath)
+		if err == nil {
+			err = upyun.UploadYun(buf.Bytes(), url)
+		}
+		if err != nil {
+			deleteArchives(archives)
+			return nil, err
+		}
+		archives = append(archives, archive)
+	}
+	return archives, nil
+}
+
+// deleteArchives 消息未能删除时清理已上传的归档文件，失败只记录日志
+func deleteArchives(archives []model.ChatArchive) {
+	for _, archive := range archives {
+		url, err := chatArchiveURL(archive.FilePath)
+		if err == nil {
+			err = upyun.DeleteYun(url)
+		}
+		if err != nil {
+			log.Printf("清理归档文件 %s 失败: %v", archive.FilePath, err)
+		}
+	}
+}
+
+// ExportChatHistory 把会话已归档的聊天记录合并为一个压缩文件，返回下载地址和消息数。
+// peerID 与 roomID 二选一，聊天室仅当前成员可导出，没有归档记录时地址为空
+func (s *SocialService) ExportChatHistory(ctx context.Context, userID, peerID, roomID int64) (*model.ChatExport, error) {
+	var key string
+	switch {
+	case roomID != 0 && peerID == 0:
+		if _, err := s.RequireChatRoomMember(ctx, roomID, userID); err != nil {
+			return nil, err
+		}
+		key = model.GroupConversationKey(roomID)
+	case peerID != 0 && roomID == 0 && peerID != userID:
+		key = model.PrivateConversationKey(userID, peerID)
+	default:
+		return nil, errno.ParamVerifyError.WithMessage("exactly one of peer_id and room_id is required")
+	}
+
+	archives, err := s.db.GetChatArchives(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	export := &model.ChatExport{}
+	if len(archives) == 0 {
+		return export, nil
+	}
+
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	for _, archive := range archives {
+		if err := copyArchive(gz, archive.FilePath); err != nil {
+			return nil, err
+		}
+		export.MessageCount += archive.MessageCount
+	}
+	if err := gz.Close(); err != nil {
+		return nil, errno.InternalServiceError.WithError(err)
+	}
+
+	// 导出文件与聊天附件放在同一目录，文件名带随机串防止被猜到
+	cfg := chatFileConfig()
+	if err := os.MkdirAll(cfg.UploadDir, constants.DirPermission); err != nil {
+		return nil, errno.InternalServiceError.WithError(err)
+	}
+	random := make([]byte, constants.ChatRoomInviteCodeBytes)
+	if _, err := rand.Read(random); err != nil {
+		return nil, errno.InternalServiceError.WithError(err)
+	}
+	name := fmt.Sprintf("export_%d_%s.jsonl.gz", userID, hex.EncodeToString(random))
+	if export.URL, err = storage.NewLocalStorage(cfg.UploadDir, cfg.BaseURL).Save(buf.Bytes(), name); err != nil {
+		return nil, err
+	}
+	return export, nil
+}
+
+// copyArchive 从对象存储读取归档文件，解压后写入 w
+func copyArchive(w io.Writer, filePath string) error {
+	url, err := chatArchiveURL(filePath)
+	if err != nil {
+		return err
+	}
+	data, err := upyun.DownloadYun(url)
+	if err != nil {
+		return err
+	}
+	r, err := gzip.NewReader(bytes.NewReader(data))
+	if err != nil {
+		return errno.InternalServiceError.WithError(err)
+	}
+	defer r.Close()
+	if _, err := io.Copy(w, r); err != nil {
+		return errno.InternalServiceError.WithError(err)
+	}
+	return nil
+}
@@ -0,0 +1,230 @@
+package service
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/smartystreets/goconvey/convey"
+	"github.com/stretchr/testify/mock"
+	"github.com/yxrxy/videoHub/app/social/domain/model"
+	"github.com/yxrxy/videoHub/config"
+	"github.com/yxrxy/videoHub/pkg/constants"
+)
+
+// useChatStorage 把附件目录指向临时目录，又拍云指向内存中的文件服务，返回按路径保存的归档文件
+func useChatStorage(t *testing.T) (objects map[string][]byte, uploadDir string) {
+	objects = make(map[string][]byte)
+	var mu sync.Mutex
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		switch r.Method {
+		case http.MethodPut:
+			data, _ := io.ReadAll(r.Body)
+			objects[r.URL.Path] = data
+		case http.MethodGet:
+			data, ok := objects[r.URL.Path]
+			if !ok {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			_, _ = w.Write(data)
+		case http.MethodDelete:
+			delete(objects, r.URL.Path)
+		}
+	}))
+
+	originSocial, originUpyun := config.Social, config.Upyun
+	cfg := &config.SocialConfig{}
+	cfg.File.UploadDir = t.TempDir()
+	config.Social = cfg
+	config.Upyun = &config.UpyunConfig{UssDomain: server.URL + "/bucket"}
+	convey.Reset(func() {
+		server.Close()
+		config.Social, config.Upyun = originSocial, originUpyun
+	})
+	return objects, cfg.File.UploadDir
+}
+
+// readArchiveFile 解压并逐行解析本地的导出文件
+func readArchiveFile(path string) []model.ArchivedMessage {
+	data, err := os.ReadFile(path)
+	convey.So(err, convey.ShouldBeNil)
+	return readArchive(data)
+}
+
+// readArchive 解压并逐行解析归档或导出文件的内容
+func readArchive(data []byte) []model.ArchivedMessage {
+	r, err := gzip.NewReader(bytes.NewReader(data))
+	convey.So(err, convey.ShouldBeNil)
+
+	var messages []model.ArchivedMessage
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		var msg model.ArchivedMessage
+		convey.So(json.Unmarshal(scanner.Bytes(), &msg), convey.ShouldBeNil)
+		messages = append(messages, msg)
+	}
+	return messages
+}
+
+func TestSocialService_ArchiveExpiredMessages(t *testing.T) {
+	const before = int64(1000)
+
+	convey.Convey("按会话写入归档文件后删除消息并清理索引", t, func() {
+		objects, uploadDir := useChatStorage(t)
+		ctx := context.Background()
+		var token string
+		cache := new(MockCache)
+		cache.On("AcquireChatRetentionLock", ctx, mock.AnythingOfType("string"), constants.ChatRetentionLockTTL).
+			Run(func(args mock.Arguments) { token = args.String(1) }).Return(true, nil)
+		cache.On("ReleaseChatRetentionLock", ctx, mock.AnythingOfType("string")).Return(nil)
+		db := new(MockDB)
+		db.On("GetExpiredPrivateMessages", ctx, before, constants.ChatArchiveBatchSize).Return([]model.ArchivedMessage{
+			{ID: 1, SenderID: 2, ReceiverID: 1, Content: "hi", Seq: 1, CreatedAt: 100},
+		}, nil)
+		db.On("GetExpiredChatMessages", ctx, before, constants.ChatArchiveBatchSize).Return([]model.ArchivedMessage{
+			{ID: 7, RoomID: 10, SenderID: 1, Content: "a", Seq: 3, CreatedAt: 100},
+			{ID: 8, RoomID: 11, SenderID: 2, Content: "b", Seq: 1, CreatedAt: 200},
+			{ID: 9, RoomID: 10, SenderID: 2, Recalled: true, Seq: 4, CreatedAt: 300},
+		}, nil)
+		var chatArchives []model.ChatArchive
+		db.On("ArchiveMessages", ctx, mock.Anything, []int64{1}, []int64(nil)).Return(nil)
+		db.On("ArchiveMessages", ctx, mock.Anything, []int64(nil), []int64{7, 8, 9}).
+			Run(func(args mock.Arguments) { chatArchives = args.Get(1).([]model.ChatArchive) }).Return(nil)
+		es := new(MockElastic)
+		es.On("DeleteMessagesBefore", ctx, constants.MessageIndexName, before).Return(nil)
+
+		svc := NewSocialService(db, cache, new(MockPrivacy), new(MockNotificationMQ), es)
+		total, err := svc.ArchiveExpiredMessages(ctx, before)
+
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(total, convey.ShouldEqual, 4)
+		es.AssertCalled(t, "DeleteMessagesBefore", ctx, constants.MessageIndexName, before)
+		convey.So(token, convey.ShouldNotBeEmpty)
+		cache.AssertCalled(t, "ReleaseChatRetentionLock", ctx, token)
+		convey.So(chatArchives, convey.ShouldHaveLength, 2)
+		convey.So(chatArchives[0].ConversationKey, convey.ShouldEqual, "group:10")
+		convey.So(chatArchives[0].FromSeq, convey.ShouldEqual, 3)
+		convey.So(chatArchives[0].ToSeq, convey.ShouldEqual, 4)
+		convey.So(chatArchives[0].MessageCount, convey.ShouldEqual, 2)
+		convey.So(chatArchives[0].FilePath, convey.ShouldEqual, constants.DefaultChatArchiveDir+"/group_10/3-4.jsonl.gz")
+		convey.So(objects, convey.ShouldHaveLength, 3)
+		archived := readArchive(objects["/bucket/"+chatArchives[0].FilePath])
+		convey.So(archived, convey.ShouldHaveLength, 2)
+		convey.So(archived[0].Content, convey.ShouldEqual, "a")
+		convey.So(archived[1].Recalled, convey.ShouldBeTrue)
+
+		convey.Convey("成员导出聊天室的归档记录", func() {
+			db.On("GetChatRoomMember", ctx, int64(10), int64(1)).Return(member(10, 1, model.ChatRoomRoleMember), nil)
+			db.On("GetChatArchives", ctx, "group:10").Return(chatArchives[:1], nil)
+
+			export, err := svc.ExportChatHistory(ctx, 1, 0, 10)
+
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(export.MessageCount, convey.ShouldEqual, 2)
+			convey.So(export.URL, convey.ShouldStartWith, constants.DefaultChatFileBaseURL+"/export_1_")
+			exported := readArchiveFile(filepath.Join(uploadDir, strings.TrimPrefix(export.URL, constants.DefaultChatFileBaseURL+"/")))
+			convey.So(exported, convey.ShouldResemble, archived)
+		})
+	})
+
+	convey.Convey("消息删除失败时清理已上传的归档文件", t, func() {
+		objects, _ := useChatStorage(t)
+		ctx := context.Background()
+		cache := new(MockCache)
+		cache.On("AcquireChatRetentionLock", ctx, mock.AnythingOfType("string"), constants.ChatRetentionLockTTL).Return(true, nil)
+		cache.On("ReleaseChatRetentionLock", ctx, mock.AnythingOfType("string")).Return(nil)
+		db := new(MockDB)
+		db.On("GetExpiredPrivateMessages", ctx, before, constants.ChatArchiveBatchSize).Return([]model.ArchivedMessage{
+			{ID: 1, SenderID: 2, ReceiverID: 1, Content: "hi", Seq: 1, CreatedAt: 100},
+		}, nil)
+		db.On("ArchiveMessages", ctx, mock.Anything, []int64{1}, []int64(nil)).Return(errors.New("db error"))
+
+		svc := NewSocialService(db, cache, new(MockPrivacy), new(MockNotificationMQ), new(MockElastic))
+		_, err := svc.ArchiveExpiredMessages(ctx, before)
+
+		convey.So(err, convey.ShouldNotBeNil)
+		convey.So(objects, convey.ShouldBeEmpty)
+		db.AssertNotCalled(t, "GetExpiredChatMessages", ctx, before, constants.ChatArchiveBatchSize)
+	})
+
+	convey.Convey("其他实例正在归档时跳过", t, func() {
+		ctx := context.Background()
+		cache := new(MockCache)
+		cache.On("AcquireChatRetentionLock", ctx, mock.AnythingOfType("string"), constants.ChatRetentionLockTTL).Return(false, nil)
+		db := new(MockDB)
+
+		svc := NewSocialService(db, cache, new(MockPrivacy), new(MockNotificationMQ), new(MockElastic))
+		total, err := svc.ArchiveExpiredMessages(ctx, before)
+
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(total, convey.ShouldEqual, 0)
+		db.AssertNotCalled(t, "GetExpiredPrivateMessages", ctx, before, constants.ChatArchiveBatchSize)
+	})
+}
+
+func TestSocialService_ExportChatHistory(t *testing.T) {
+	type TestCase struct {
+		Name          string
+		PeerID        int64
+		RoomID        int64
+		ExpectedError bool
+	}
+
+	testCases := []TestCase{
+		{
+			Name:   "没有归档记录时返回空地址",
+			PeerID: 2,
+		},
+		{
+			Name:          "私信对象与聊天室不能同时指定",
+			PeerID:        2,
+			RoomID:        10,
+			ExpectedError: true,
+		},
+		{
+			Name:          "不能导出与自己的私信",
+			PeerID:        1,
+			ExpectedError: true,
+		},
+		{
+			Name:          "非成员不能导出聊天室",
+			RoomID:        10,
+			ExpectedError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		convey.Convey(tc.Name, t, func() {
+			ctx := context.Background()
+			db := new(MockDB)
+			db.On("GetChatRoomMember", ctx, int64(10), int64(1)).Return(nil, nil)
+			db.On("GetChatArchives", ctx, mock.Anything).Return([]model.ChatArchive{}, nil)
+
+			svc := NewSocialService(db, new(MockCache), new(MockPrivacy), new(MockNotificationMQ), new(MockElastic))
+			export, err := svc.ExportChatHistory(ctx, 1, tc.PeerID, tc.RoomID)
+
+			if tc.ExpectedError {
+				convey.So(err, convey.ShouldNotBeNil)
+				db.AssertNotCalled(t, "GetChatArchives", ctx, mock.Anything)
+				return
+			}
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(export.URL, convey.ShouldBeEmpty)
+			db.AssertCalled(t, "GetChatArchives", ctx, "private:1:2")
+		})
+	}
+}
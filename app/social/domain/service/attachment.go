@@ -0,0 +1,190 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"image"
+	_ "image/gif" // 注册 GIF 解码器
+	"image/jpeg"
+	_ "image/png" // 注册 PNG 解码器
+	"log"
+	"net/http"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"slices"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/yxrxy/videoHub/app/social/domain/model"
+	"github.com/yxrxy/videoHub/config"
+	"github.com/yxrxy/videoHub/pkg/constants"
+	"github.com/yxrxy/videoHub/pkg/errno"
+	"github.com/yxrxy/videoHub/pkg/storage"
+)
+
+// fileConfig 聊天附件配置
+type fileConfig struct {
+	MaxSize      int
+	AllowedTypes []string
+	UploadDir    string
+	BaseURL      string
+}
+
+// chatFileConfig 读取聊天附件配置，未配置的项使用默认值
+func chatFileConfig() fileConfig {
+	cfg := fileConfig{
+		MaxSize:      constants.DefaultChatFileMaxSize,
+		AllowedTypes: constants.DefaultChatFileTypes,
+		UploadDir:    constants.DefaultChatFileUploadDir,
+		BaseURL:      constants.DefaultChatFileBaseURL,
+	}
+	if config.Social == nil {
+		return cfg
+	}
+	file := config.Social.File
+	if file.MaxSize > 0 {
+		cfg.MaxSize = file.MaxSize
+	}
+	if file.UploadDir != "" {
+		cfg.UploadDir = file.UploadDir
+	}
+	if file.BaseURL != "" {
+		cfg.BaseURL = strings.TrimSuffix(file.BaseURL, "/")
+	}
+	if len(file.AllowedTypes) > 0 {
+		cfg.AllowedTypes = file.AllowedTypes
+	}
+	return cfg
+}
+
+// attachmentExt 根据文件类型确定扩展名，未知类型沿用原始文件名的扩展名
+func attachmentExt(contentType, fileName string) string {
+	switch contentType {
+	case "image/jpeg":
+		return ".jpg"
+	case "image/png":
+		return ".png"
+	case "image/gif":
+		return ".gif"
+	case "video/mp4":
+		return ".mp4"
+	case "application/pdf":
+		return ".pdf"
+	case "application/msword":
+		return ".doc"
+	}
+	ext := strings.ToLower(filepath.Ext(fileName))
+	if len(ext) > 8 || strings.ContainsAny(ext, `/\`) {
+		return ""
+	}
+	return ext
+}
+
+// SaveChatAttachment 校验并保存聊天附件，图片和视频生成缩略图，缩略图失败不影响上传
+func (s *SocialService) SaveChatAttachment(_ context.Context, uploaderID int64, fileName, contentType string, data []byte) (*model.ChatAttachment, error) {
+	cfg := chatFileConfig()
+	fileName = filepath.Base(strings.TrimSpace(fileName))
+	if fileName == "." || fileName == "/" || utf8.RuneCountInString(fileName) > constants.ChatAttachmentMaxNameLen {
+		return nil, errno.ParamVerifyError.WithMessage("invalid file name")
+	}
+	if len(data) == 0 || len(data) > cfg.MaxSize {
+		return nil, errno.ParamVerifyError.WithMessage(fmt.Sprintf("file size must be between 1 and %d bytes", cfg.MaxSize))
+	}
+	if !slices.Contains(cfg.AllowedTypes, contentType) {
+		return nil, errno.ParamVerifyError.WithMessage("file type is not allowed")
+	}
+	// 图片和视频按实际内容校验，防止伪造类型
+	isImage, isVideo := strings.HasPrefix(contentType, "image/"), strings.HasPrefix(contentType, "video/")
+	if (isImage || isVideo) && http.DetectContentType(data) != contentType {
+		return nil, errno.ParamVerifyError.WithMessage("file content does not match its type")
+	}
+	if err := os.MkdirAll(cfg.UploadDir, constants.DirPermission); err != nil {
+		return nil, errno.InternalServiceError.WithError(err)
+	}
+
+	buf := make([]byte, constants.ChatRoomInviteCodeBytes)
+	if _, err := rand.Read(buf); err != nil {
+		return nil, errno.InternalServiceError.WithError(err)
+	}
+	base := fmt.Sprintf("%d_%s", uploaderID, hex.EncodeToString(buf))
+	store := storage.NewLocalStorage(cfg.UploadDir, cfg.BaseURL)
+	url, err := store.Save(data, base+attachmentExt(contentType, fileName))
+	if err != nil {
+		return nil, err
+	}
+
+	attachment := &model.ChatAttachment{
+		URL:         url,
+		Name:        fileName,
+		ContentType: contentType,
+		Size:        int64(len(data)),
+	}
+	thumbName := base + "_thumb.jpg"
+	switch {
+	case isImage:
+		thumb, err := imageThumbnail(data)
+		if err == nil {
+			attachment.ThumbnailURL, err = store.Save(thumb, thumbName)
+		}
+		if err != nil {
+			log.Printf("生成图片缩略图失败: %v", err)
+		}
+	case isVideo:
+		videoPath := filepath.Join(cfg.UploadDir, base+attachmentExt(contentType, fileName))
+		if err := videoThumbnail(videoPath, filepath.Join(cfg.UploadDir, thumbName)); err != nil {
+			log.Printf("生成视频缩略图失败: %v", err)
+		} else {
+			attachment.ThumbnailURL = fmt.Sprintf("%s/%s", cfg.BaseURL, thumbName)
+		}
+	}
+	return attachment, nil
+}
+
+// imageThumbnail 按最长边等比缩小图片并编码为 JPEG，小图保持原尺寸
+func imageThumbnail(data []byte) ([]byte, error) {
+	src, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	bounds := src.Bounds()
+	w, h := bounds.Dx(), bounds.Dy()
+	if w == 0 || h == 0 {
+		return nil, fmt.Errorf("empty image")
+	}
+	scale := min(1, float64(constants.ChatThumbnailMaxDimension)/float64(max(w, h)))
+	tw, th := max(1, int(float64(w)*scale)), max(1, int(float64(h)*scale))
+
+	// 最近邻采样，缩略图对画质要求不高
+	dst := image.NewRGBA(image.Rect(0, 0, tw, th))
+	for y := range th {
+		sy := bounds.Min.Y + y*h/th
+		for x := range tw {
+			dst.Set(x, y, src.At(bounds.Min.X+x*w/tw, sy))
+		}
+	}
+	var out bytes.Buffer
+	if err := jpeg.Encode(&out, dst, nil); err != nil {
+		return nil, err
+	}
+	return out.Bytes(), nil
+}
+
+// videoThumbnail 截取视频第 1 秒的画面作为缩略图
+func videoThumbnail(videoPath, thumbPath string) error {
+	scale := fmt.Sprintf("scale='min(%d,iw)':-2", constants.ChatThumbnailMaxDimension)
+	cmd := exec.Command("ffmpeg", "-y",
+		"-i", videoPath,
+		"-ss", "00:00:01",
+		"-vframes", "1",
+		"-vf", scale,
+		thumbPath,
+	)
+	if output, err := cmd.CombinedOutput(); err != nil {
+		return fmt.Errorf("ffmpeg 输出：%s，错误：%w", string(output), err)
+	}
+	return nil
+}
@@ -0,0 +1,200 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+	"unicode/utf8"
+
+	"github.com/yxrxy/videoHub/app/social/domain/model"
+	"github.com/yxrxy/videoHub/config"
+	"github.com/yxrxy/videoHub/pkg/constants"
+	"github.com/yxrxy/videoHub/pkg/errno"
+)
+
+// maxFriendsPerUser 每个用户的好友数上限，未配置时使用默认值
+func maxFriendsPerUser() int64 {
+	if config.Social != nil && config.Social.Chat.MaxFriendsPerUser > 0 {
+		return int64(config.Social.Chat.MaxFriendsPerUser)
+	}
+	return constants.DefaultMaxFriendsPerUser
+}
+
+// friendRequestExpired 判断待处理的申请是否已超过有效期
+func friendRequestExpired(request *model.FriendRequest) bool {
+	return request.Status == model.FriendRequestStatusPending &&
+		time.Now().Unix()-request.CreatedAt > constants.FriendRequestTTL
+}
+
+// expireFriendRequest 将超时的待处理申请标记为过期
+func (s *SocialService) expireFriendRequest(ctx context.Context, request *model.FriendRequest) error {
+	if _, err := s.db.UpdateFriendRequestStatus(ctx, request.ID,
+		model.FriendRequestStatusPending, model.FriendRequestStatusExpired); err != nil {
+		return err
+	}
+	request.Status = model.FriendRequestStatusExpired
+	return nil
+}
+
+// checkFriendQuota 校验用户的好友数未达上限
+func (s *SocialService) checkFriendQuota(ctx context.Context, userIDs ...int64) error {
+	counts, err := s.db.CountFriends(ctx, userIDs)
+	if err != nil {
+		return err
+	}
+	for _, id := range userIDs {
+		if counts[id] >= maxFriendsPerUser() {
+			return errno.ParamVerifyError.WithMessage(fmt.Sprintf("user %d cannot have more than %d friends", id, maxFriendsPerUser()))
+		}
+	}
+	return nil
+}
+
+// SendFriendRequest 发送好友申请，对方已发来待处理的申请时直接同意该申请
+func (s *SocialService) SendFriendRequest(ctx context.Context, senderID, receiverID int64, message string) (*model.FriendRequest, error) {
+	if senderID == receiverID {
+		return nil, errno.ParamVerifyError.WithMessage("cannot send friend request to yourself")
+	}
+	if utf8.RuneCountInString(message) > constants.MaxFriendRequestMessageLength {
+		return nil, errno.ParamVerifyError.WithMessage(fmt.Sprintf("message cannot exceed %d characters", constants.MaxFriendRequestMessageLength))
+	}
+	if err := s.privacy.CheckInteract(ctx, senderID, receiverID); err != nil {
+		return nil, err
+	}
+	friends, err := s.db.AreFriends(ctx, senderID, receiverID)
+	if err != nil {
+		return nil, err
+	}
+	if friends {
+		return nil, errno.ParamVerifyError.WithMessage("already friends")
+	}
+
+	pending, err := s.db.GetPendingFriendRequest(ctx, senderID, receiverID)
+	if err != nil {
+		return nil, err
+	}
+	if pending != nil {
+		if !friendRequestExpired(pending) {
+			return nil, errno.ParamVerifyError.WithMessage("friend request already sent")
+		}
+		if err := s.expireFriendRequest(ctx, pending); err != nil {
+			return nil, err
+		}
+	}
+
+	reverse, err := s.db.GetPendingFriendRequest(ctx, receiverID, senderID)
+	if err != nil {
+		return nil, err
+	}
+	if reverse != nil {
+		if !friendRequestExpired(reverse) {
+			return s.acceptFriendRequest(ctx, reverse)
+		}
+		if err := s.expireFriendRequest(ctx, reverse); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := s.checkFriendQuota(ctx, senderID); err != nil {
+		return nil, err
+	}
+	request := &model.FriendRequest{
+		SenderID:   senderID,
+		ReceiverID: receiverID,
+		Message:    message,
+		Status:     model.FriendRequestStatusPending,
+	}
+	if err := s.db.CreateFriendRequest(ctx, request); err != nil {
+		return nil, err
+	}
+	s.publishNotification(ctx, model.NotificationTypeFriendRequest, receiverID, senderID, 0)
+	return request, nil
+}
+
+// HandleFriendRequest 接收者同意或拒绝、发送者撤回待处理的好友申请，返回处理后的申请
+func (s *SocialService) HandleFriendRequest(ctx context.Context, requestID, userID int64, action int8) (*model.FriendRequest, error) {
+	request, err := s.db.GetFriendRequest(ctx, requestID)
+	if err != nil {
+		return nil, err
+	}
+	if request == nil {
+		return nil, errno.ParamVerifyError.WithMessage("friend request not found")
+	}
+
+	switch action {
+	case model.FriendRequestActionAccept, model.FriendRequestActionReject:
+		if request.ReceiverID != userID {
+			return nil, errno.AuthNoOperatePermission.WithMessage("only the receiver can handle the friend request")
+		}
+	case model.FriendRequestActionCancel:
+		if request.SenderID != userID {
+			return nil, errno.AuthNoOperatePermission.WithMessage("only the sender can cancel the friend request")
+		}
+	default:
+		return nil, errno.ParamVerifyError.WithMessage("invalid action")
+	}
+
+	if request.Status != model.FriendRequestStatusPending {
+		return nil, errno.ParamVerifyError.WithMessage("friend request already handled")
+	}
+	if friendRequestExpired(request) {
+		if err := s.expireFriendRequest(ctx, request); err != nil {
+			return nil, err
+		}
+		return nil, errno.ParamVerifyError.WithMessage("friend request has expired")
+	}
+
+	if action == model.FriendRequestActionAccept {
+		return s.acceptFriendRequest(ctx, request)
+	}
+	status := int8(model.FriendRequestStatusRejected)
+	if action == model.FriendRequestActionCancel {
+		status = model.FriendRequestStatusCancelled
+	}
+	updated, err := s.db.UpdateFriendRequestStatus(ctx, request.ID, model.FriendRequestStatusPending, status)
+	if err != nil {
+		return nil, err
+	}
+	if !updated {
+		return nil, errno.ParamVerifyError.WithMessage("friend request already handled")
+	}
+	request.Status = status
+	return request, nil
+}
+
+// acceptFriendRequest 校验拉黑关系和双方好友数后同意申请，并建立双向好友关系。
+// 接收者手动同意和发送申请时自动同意对方的申请都经过这里，同意后通知申请的发送者
+func (s *SocialService) acceptFriendRequest(ctx context.Context, request *model.FriendRequest) (*model.FriendRequest, error) {
+	if err := s.privacy.CheckInteract(ctx, request.ReceiverID, request.SenderID); err != nil {
+		return nil, err
+	}
+	if err := s.checkFriendQuota(ctx, request.SenderID, request.ReceiverID); err != nil {
+		return nil, err
+	}
+	accepted, err := s.db.AcceptFriendRequest(ctx, request.ID)
+	if err != nil {
+		return nil, err
+	}
+	if !accepted {
+		return nil, errno.ParamVerifyError.WithMessage("friend request already handled")
+	}
+	request.Status = model.FriendRequestStatusAccepted
+	s.publishNotification(ctx, model.NotificationTypeFriendAccepted, request.SenderID, request.ReceiverID, 0)
+	return request, nil
+}
+
+// GetFriendRequests 获取收到的或发出的好友申请，超时未处理的申请显示为已过期
+func (s *SocialService) GetFriendRequests(ctx context.Context, userID int64, sent bool) ([]*model.FriendRequest, error) {
+	requests, err := s.db.GetFriendRequests(ctx, userID, sent)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]*model.FriendRequest, len(requests))
+	for i := range requests {
+		if friendRequestExpired(&requests[i]) {
+			requests[i].Status = model.FriendRequestStatusExpired
+		}
+		result[i] = &requests[i]
+	}
+	return result, nil
+}
@@ -0,0 +1,245 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/smartystreets/goconvey/convey"
+	"github.com/stretchr/testify/mock"
+	"github.com/yxrxy/videoHub/app/social/domain/model"
+	"github.com/yxrxy/videoHub/pkg/constants"
+)
+
+func TestSocialService_SendFriendRequest(t *testing.T) {
+	type TestCase struct {
+		Name           string
+		ReceiverID     int64
+		AreFriends     bool
+		Pending        *model.FriendRequest // 已发出的待处理申请
+		Reverse        *model.FriendRequest // 对方发来的待处理申请
+		Friends        map[int64]int64
+		Blocked        bool
+		ExpectedError  bool
+		ExpectedStatus int8
+		ExpectCreate   bool
+	}
+
+	const senderID = int64(1)
+	now := time.Now().Unix()
+	expiredAt := now - constants.FriendRequestTTL - 1
+	testCases := []TestCase{
+		{
+			Name:         "发送好友申请",
+			ReceiverID:   2,
+			ExpectCreate: true,
+		},
+		{
+			Name:          "不能向自己发送申请",
+			ReceiverID:    senderID,
+			ExpectedError: true,
+		},
+		{
+			Name:          "已是好友",
+			ReceiverID:    2,
+			AreFriends:    true,
+			ExpectedError: true,
+		},
+		{
+			Name:          "存在拉黑关系时不能发送",
+			ReceiverID:    2,
+			Blocked:       true,
+			ExpectedError: true,
+		},
+		{
+			Name:          "已有待处理的申请时不能重复发送",
+			ReceiverID:    2,
+			Pending:       &model.FriendRequest{ID: 5, SenderID: senderID, ReceiverID: 2, CreatedAt: now},
+			ExpectedError: true,
+		},
+		{
+			Name:         "之前的申请已过期时可以重新发送",
+			ReceiverID:   2,
+			Pending:      &model.FriendRequest{ID: 5, SenderID: senderID, ReceiverID: 2, CreatedAt: expiredAt},
+			ExpectCreate: true,
+		},
+		{
+			Name:           "对方已发来申请时直接同意",
+			ReceiverID:     2,
+			Reverse:        &model.FriendRequest{ID: 6, SenderID: 2, ReceiverID: senderID, CreatedAt: now},
+			ExpectedStatus: model.FriendRequestStatusAccepted,
+		},
+		{
+			Name:          "好友数已达上限",
+			ReceiverID:    2,
+			Friends:       map[int64]int64{senderID: constants.DefaultMaxFriendsPerUser},
+			ExpectedError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		convey.Convey(tc.Name, t, func() {
+			ctx := context.Background()
+			db := new(MockDB)
+			db.On("AreFriends", ctx, senderID, tc.ReceiverID).Return(tc.AreFriends, nil)
+			db.On("GetPendingFriendRequest", ctx, senderID, tc.ReceiverID).Return(tc.Pending, nil)
+			db.On("GetPendingFriendRequest", ctx, tc.ReceiverID, senderID).Return(tc.Reverse, nil)
+			db.On("UpdateFriendRequestStatus", ctx, int64(5), int8(model.FriendRequestStatusPending), int8(model.FriendRequestStatusExpired)).Return(true, nil)
+			db.On("CountFriends", ctx, mock.Anything).Return(tc.Friends, nil)
+			db.On("AcceptFriendRequest", ctx, int64(6)).Return(true, nil)
+			db.On("CreateFriendRequest", ctx, mock.Anything).Return(nil)
+			privacy := new(MockPrivacy)
+			privacy.On("CheckInteract", ctx, mock.Anything, mock.Anything).Return(blockedError(tc.Blocked))
+			notifier := new(MockNotificationMQ)
+			notifier.On("SendNotificationEvent", ctx, mock.Anything).Return(nil)
+
+			svc := NewSocialService(db, new(MockCache), privacy, notifier, new(MockElastic))
+			request, err := svc.SendFriendRequest(ctx, senderID, tc.ReceiverID, "hi")
+
+			if tc.ExpectedError {
+				convey.So(err, convey.ShouldNotBeNil)
+				db.AssertNotCalled(t, "CreateFriendRequest", ctx, mock.Anything)
+				return
+			}
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(request.Status, convey.ShouldEqual, tc.ExpectedStatus)
+			if tc.ExpectCreate {
+				db.AssertCalled(t, "CreateFriendRequest", ctx, mock.Anything)
+				notifier.AssertCalled(t, "SendNotificationEvent", ctx, mock.MatchedBy(func(e *model.NotificationEvent) bool {
+					return e.Type == model.NotificationTypeFriendRequest && e.UserID == tc.ReceiverID && e.ActorID == senderID
+				}))
+			} else {
+				db.AssertNotCalled(t, "CreateFriendRequest", ctx, mock.Anything)
+				// 自动同意对方的申请时与手动同意一样通知对方
+				notifier.AssertCalled(t, "SendNotificationEvent", ctx, mock.MatchedBy(func(e *model.NotificationEvent) bool {
+					return e.Type == model.NotificationTypeFriendAccepted && e.UserID == tc.ReceiverID && e.ActorID == senderID
+				}))
+				notifier.AssertNotCalled(t, "SendNotificationEvent", ctx, mock.MatchedBy(func(e *model.NotificationEvent) bool {
+					return e.Type == model.NotificationTypeFriendRequest
+				}))
+			}
+		})
+	}
+}
+
+func TestSocialService_HandleFriendRequest(t *testing.T) {
+	type TestCase struct {
+		Name           string
+		UserID         int64
+		Action         int8
+		Request        *model.FriendRequest
+		Blocked        bool
+		ExpectedError  bool
+		ExpectedStatus int8
+	}
+
+	const requestID, senderID, receiverID = int64(9), int64(1), int64(2)
+	now := time.Now().Unix()
+	pending := func() *model.FriendRequest {
+		return &model.FriendRequest{ID: requestID, SenderID: senderID, ReceiverID: receiverID, CreatedAt: now}
+	}
+	testCases := []TestCase{
+		{
+			Name:           "接收者同意",
+			UserID:         receiverID,
+			Action:         model.FriendRequestActionAccept,
+			Request:        pending(),
+			ExpectedStatus: model.FriendRequestStatusAccepted,
+		},
+		{
+			Name:           "接收者拒绝",
+			UserID:         receiverID,
+			Action:         model.FriendRequestActionReject,
+			Request:        pending(),
+			ExpectedStatus: model.FriendRequestStatusRejected,
+		},
+		{
+			Name:           "发送者撤回",
+			UserID:         senderID,
+			Action:         model.FriendRequestActionCancel,
+			Request:        pending(),
+			ExpectedStatus: model.FriendRequestStatusCancelled,
+		},
+		{
+			Name:          "发送者不能同意自己的申请",
+			UserID:        senderID,
+			Action:        model.FriendRequestActionAccept,
+			Request:       pending(),
+			ExpectedError: true,
+		},
+		{
+			Name:          "接收者不能撤回",
+			UserID:        receiverID,
+			Action:        model.FriendRequestActionCancel,
+			Request:       pending(),
+			ExpectedError: true,
+		},
+		{
+			Name:   "已处理的申请不能再次处理",
+			UserID: receiverID,
+			Action: model.FriendRequestActionAccept,
+			Request: &model.FriendRequest{
+				ID: requestID, SenderID: senderID, ReceiverID: receiverID,
+				Status: model.FriendRequestStatusRejected, CreatedAt: now,
+			},
+			ExpectedError: true,
+		},
+		{
+			Name:   "过期的申请不能同意",
+			UserID: receiverID,
+			Action: model.FriendRequestActionAccept,
+			Request: &model.FriendRequest{
+				ID: requestID, SenderID: senderID, ReceiverID: receiverID,
+				CreatedAt: now - constants.FriendRequestTTL - 1,
+			},
+			ExpectedError: true,
+		},
+		{
+			Name:          "拉黑后不能同意",
+			UserID:        receiverID,
+			Action:        model.FriendRequestActionAccept,
+			Request:       pending(),
+			Blocked:       true,
+			ExpectedError: true,
+		},
+		{
+			Name:          "申请不存在",
+			UserID:        receiverID,
+			Action:        model.FriendRequestActionAccept,
+			ExpectedError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		convey.Convey(tc.Name, t, func() {
+			ctx := context.Background()
+			db := new(MockDB)
+			db.On("GetFriendRequest", ctx, requestID).Return(tc.Request, nil)
+			db.On("CountFriends", ctx, []int64{senderID, receiverID}).Return(map[int64]int64{}, nil)
+			db.On("AcceptFriendRequest", ctx, requestID).Return(true, nil)
+			db.On("UpdateFriendRequestStatus", ctx, requestID, int8(model.FriendRequestStatusPending), mock.Anything).Return(true, nil)
+			privacy := new(MockPrivacy)
+			privacy.On("CheckInteract", ctx, receiverID, senderID).Return(blockedError(tc.Blocked))
+			notifier := new(MockNotificationMQ)
+			notifier.On("SendNotificationEvent", ctx, mock.Anything).Return(nil)
+
+			svc := NewSocialService(db, new(MockCache), privacy, notifier, new(MockElastic))
+			request, err := svc.HandleFriendRequest(ctx, requestID, tc.UserID, tc.Action)
+
+			if tc.ExpectedError {
+				convey.So(err, convey.ShouldNotBeNil)
+				db.AssertNotCalled(t, "AcceptFriendRequest", ctx, requestID)
+				return
+			}
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(request.Status, convey.ShouldEqual, tc.ExpectedStatus)
+			if tc.ExpectedStatus == model.FriendRequestStatusAccepted {
+				notifier.AssertCalled(t, "SendNotificationEvent", ctx, mock.MatchedBy(func(e *model.NotificationEvent) bool {
+					return e.Type == model.NotificationTypeFriendAccepted && e.UserID == senderID && e.ActorID == receiverID
+				}))
+			} else {
+				notifier.AssertNotCalled(t, "SendNotificationEvent", ctx, mock.Anything)
+			}
+		})
+	}
+}
@@ -0,0 +1,107 @@
+package service
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/yxrxy/videoHub/app/social/domain/model"
+	"github.com/yxrxy/videoHub/pkg/constants"
+	"github.com/yxrxy/videoHub/pkg/errno"
+)
+
+// encodeInboxCursor 将会话的排序位置编码为不透明的分页游标
+func encodeInboxCursor(c *model.Conversation) string {
+	pinned := 0
+	if c.Pinned {
+		pinned = 1
+	}
+	raw := fmt.Sprintf("%d|%d|%s", pinned, c.LastActiveAt, c.ConversationKey())
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+// decodeInboxCursor 解析分页游标，游标为空时返回 nil
+func decodeInboxCursor(cursor string) (*model.InboxCursor, error) {
+	if cursor == "" {
+		return nil, nil
+	}
+	invalid := errno.ParamVerifyError.WithMessage("invalid cursor")
+	raw, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, invalid
+	}
+	parts := strings.SplitN(string(raw), "|", 3)
+	if len(parts) != 3 || (parts[0] != "0" && parts[0] != "1") || parts[2] == "" {
+		return nil, invalid
+	}
+	activeAt, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil {
+		return nil, invalid
+	}
+	return &model.InboxCursor{Pinned: parts[0] == "1", LastActiveAt: activeAt, ConversationKey: parts[2]}, nil
+}
+
+// GetInbox 分页获取收件箱，置顶会话在前，其余按最后活跃时间倒序
+func (s *SocialService) GetInbox(ctx context.Context, userID int64, cursor string, limit int, archived bool) (*model.InboxPage, error) {
+	after, err := decodeInboxCursor(cursor)
+	if err != nil {
+		return nil, err
+	}
+	if limit <= 0 {
+		limit = constants.InboxDefaultLimit
+	}
+	limit = min(limit, constants.InboxMaxLimit)
+
+	// 多取一条用于判断是否还有更多会话
+	conversations, err := s.db.GetInbox(ctx, userID, archived, after, limit+1)
+	if err != nil {
+		return nil, err
+	}
+	page := &model.InboxPage{HasMore: len(conversations) > limit}
+	conversations = conversations[:min(len(conversations), limit)]
+	for i := range conversations {
+		page.Conversations = append(page.Conversations, &conversations[i])
+	}
+	if page.HasMore {
+		page.NextCursor = encodeInboxCursor(page.Conversations[len(page.Conversations)-1])
+	}
+	return page, nil
+}
+
+// UpdateConversation 修改会话的免打扰、置顶和归档设置，只能修改自己收件箱中的会话
+func (s *SocialService) UpdateConversation(ctx context.Context, userID, peerID, roomID int64, settings *model.ConversationSettings) (*model.Conversation, error) {
+	if (peerID == 0) == (roomID == 0) {
+		return nil, errno.ParamVerifyError.WithMessage("exactly one of peer_id and room_id is required")
+	}
+	if settings.Muted == nil && settings.Pinned == nil && settings.Archived == nil {
+		return nil, errno.ParamVerifyError.WithMessage("no settings to update")
+	}
+
+	key := model.GroupConversationKey(roomID)
+	if roomID == 0 {
+		key = model.PrivateConversationKey(userID, peerID)
+	}
+	conversation, err := s.db.GetConversation(ctx, userID, key)
+	if err != nil {
+		return nil, err
+	}
+	if conversation == nil {
+		return nil, errno.ParamVerifyError.WithMessage("conversation not found")
+	}
+	if err := s.db.UpdateConversationSettings(ctx, userID, key, settings); err != nil {
+		return nil, err
+	}
+
+	if settings.Muted != nil {
+		conversation.Muted = *settings.Muted
+	}
+	if settings.Pinned != nil {
+		conversation.Pinned = *settings.Pinned
+	}
+	if settings.Archived != nil {
+		conversation.Archived = *settings.Archived
+	}
+	return conversation, nil
+}
@@ -0,0 +1,167 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/smartystreets/goconvey/convey"
+	"github.com/stretchr/testify/mock"
+	"github.com/yxrxy/videoHub/app/social/domain/model"
+)
+
+func TestSocialService_GetInbox(t *testing.T) {
+	type TestCase struct {
+		Name          string
+		Cursor        string
+		Limit         int
+		Rows          int
+		ExpectedAfter *model.InboxCursor
+		ExpectedLimit int
+		ExpectedCount int
+		ExpectedMore  bool
+		ExpectedError bool
+	}
+
+	const userID = int64(1)
+	after := &model.Conversation{UserID: userID, RoomID: 10, Pinned: true, LastActiveAt: 1700000000000}
+	testCases := []TestCase{
+		{
+			Name:          "默认每页条数",
+			Rows:          3,
+			ExpectedLimit: 21,
+			ExpectedCount: 3,
+		},
+		{
+			Name:          "多取一条判断是否还有更多",
+			Limit:         2,
+			Rows:          3,
+			ExpectedLimit: 3,
+			ExpectedCount: 2,
+			ExpectedMore:  true,
+		},
+		{
+			Name:          "每页条数不超过上限",
+			Limit:         1000,
+			ExpectedLimit: 101,
+		},
+		{
+			Name:          "按游标继续分页",
+			Cursor:        encodeInboxCursor(after),
+			Limit:         2,
+			ExpectedAfter: &model.InboxCursor{Pinned: true, LastActiveAt: 1700000000000, ConversationKey: "group:10"},
+			ExpectedLimit: 3,
+		},
+		{
+			Name:          "非法游标",
+			Cursor:        "not-a-cursor",
+			ExpectedError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		convey.Convey(tc.Name, t, func() {
+			ctx := context.Background()
+			rows := make([]model.Conversation, tc.Rows)
+			for i := range rows {
+				rows[i] = model.Conversation{UserID: userID, PeerID: int64(i + 2), LastActiveAt: int64(100 - i)}
+			}
+			db := new(MockDB)
+			db.On("GetInbox", ctx, userID, false, tc.ExpectedAfter, tc.ExpectedLimit).Return(rows, nil)
+
+			svc := NewSocialService(db, new(MockCache), new(MockPrivacy), new(MockNotificationMQ), new(MockElastic))
+			page, err := svc.GetInbox(ctx, userID, tc.Cursor, tc.Limit, false)
+
+			if tc.ExpectedError {
+				convey.So(err, convey.ShouldNotBeNil)
+				db.AssertNotCalled(t, "GetInbox", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+				return
+			}
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(page.Conversations, convey.ShouldHaveLength, tc.ExpectedCount)
+			convey.So(page.HasMore, convey.ShouldEqual, tc.ExpectedMore)
+			if !tc.ExpectedMore {
+				convey.So(page.NextCursor, convey.ShouldBeEmpty)
+				return
+			}
+			// 下一页游标指向本页最后一个会话
+			next, err := decodeInboxCursor(page.NextCursor)
+			convey.So(err, convey.ShouldBeNil)
+			last := page.Conversations[len(page.Conversations)-1]
+			convey.So(next.ConversationKey, convey.ShouldEqual, last.ConversationKey())
+			convey.So(next.LastActiveAt, convey.ShouldEqual, last.LastActiveAt)
+		})
+	}
+}
+
+func TestSocialService_UpdateConversation(t *testing.T) {
+	type TestCase struct {
+		Name          string
+		PeerID        int64
+		RoomID        int64
+		Settings      *model.ConversationSettings
+		Existing      *model.Conversation
+		ExpectedError bool
+		ExpectedKey   string
+	}
+
+	const userID = int64(1)
+	yes := true
+	testCases := []TestCase{
+		{
+			Name:        "置顶私信会话",
+			PeerID:      2,
+			Settings:    &model.ConversationSettings{Pinned: &yes},
+			Existing:    &model.Conversation{UserID: userID, PeerID: 2},
+			ExpectedKey: "private:1:2",
+		},
+		{
+			Name:        "归档并免打扰群聊",
+			RoomID:      10,
+			Settings:    &model.ConversationSettings{Muted: &yes, Archived: &yes},
+			Existing:    &model.Conversation{UserID: userID, RoomID: 10},
+			ExpectedKey: "group:10",
+		},
+		{
+			Name:          "会话不在收件箱中",
+			RoomID:        10,
+			Settings:      &model.ConversationSettings{Pinned: &yes},
+			ExpectedError: true,
+		},
+		{
+			Name:          "没有要修改的设置",
+			PeerID:        2,
+			Settings:      &model.ConversationSettings{},
+			ExpectedError: true,
+		},
+		{
+			Name:          "私信与群聊同时指定",
+			PeerID:        2,
+			RoomID:        10,
+			Settings:      &model.ConversationSettings{Pinned: &yes},
+			ExpectedError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		convey.Convey(tc.Name, t, func() {
+			ctx := context.Background()
+			db := new(MockDB)
+			db.On("GetConversation", ctx, userID, mock.Anything).Return(tc.Existing, nil)
+			db.On("UpdateConversationSettings", ctx, userID, mock.Anything, tc.Settings).Return(nil)
+
+			svc := NewSocialService(db, new(MockCache), new(MockPrivacy), new(MockNotificationMQ), new(MockElastic))
+			conversation, err := svc.UpdateConversation(ctx, userID, tc.PeerID, tc.RoomID, tc.Settings)
+
+			if tc.ExpectedError {
+				convey.So(err, convey.ShouldNotBeNil)
+				db.AssertNotCalled(t, "UpdateConversationSettings", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+				return
+			}
+			convey.So(err, convey.ShouldBeNil)
+			db.AssertCalled(t, "UpdateConversationSettings", ctx, userID, tc.ExpectedKey, tc.Settings)
+			convey.So(conversation.Muted, convey.ShouldEqual, tc.Settings.Muted != nil)
+			convey.So(conversation.Pinned, convey.ShouldEqual, tc.Settings.Pinned != nil)
+			convey.So(conversation.Archived, convey.ShouldEqual, tc.Settings.Archived != nil)
+		})
+	}
+}
@@ -5,17 +5,29 @@ import (
 )
 
 type SocialService struct {
-	db    repository.SocialDB
-	cache repository.SocialCache
+	db      repository.SocialDB
+	cache   repository.SocialCache
+	privacy repository.PrivacyChecker // 拉黑与隐私设置校验
+	mq      repository.NotificationMQ // 通知事件与推送
+	es      repository.MessageElastic // 聊天记录搜索索引
 }
 
-func NewSocialService(db repository.SocialDB, cache repository.SocialCache) *SocialService {
-	if db == nil || cache == nil {
-		panic("socialService`s db or cache should not be nil")
+func NewSocialService(
+	db repository.SocialDB,
+	cache repository.SocialCache,
+	privacy repository.PrivacyChecker,
+	mq repository.NotificationMQ,
+	es repository.MessageElastic,
+) *SocialService {
+	if db == nil || cache == nil || privacy == nil || mq == nil || es == nil {
+		panic("socialService`s db or cache or privacy or mq or es should not be nil")
 	}
 	svc := &SocialService{
-		db:    db,
-		cache: cache,
+		db:      db,
+		cache:   cache,
+		privacy: privacy,
+		mq:      mq,
+		es:      es,
 	}
 	return svc
 }
@@ -0,0 +1,234 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/yxrxy/videoHub/app/social/domain/model"
+	"github.com/yxrxy/videoHub/config"
+	"github.com/yxrxy/videoHub/pkg/constants"
+	"github.com/yxrxy/videoHub/pkg/errno"
+)
+
+// maxMessageLength 文本消息最大字符数，未配置时使用默认值
+func maxMessageLength() int {
+	if config.Social != nil && config.Social.Chat.MaxMessageLength > 0 {
+		return config.Social.Chat.MaxMessageLength
+	}
+	return constants.MaxChatMessageLength
+}
+
+func validateText(content string) error {
+	if strings.TrimSpace(content) == "" {
+		return errno.ParamVerifyError.WithMessage("empty content")
+	}
+	if utf8.RuneCountInString(content) > maxMessageLength() {
+		return errno.ParamVerifyError.WithMessage("content too long")
+	}
+	return nil
+}
+
+// validateAttachment 附件消息的内容需是本服务上传的附件，且文件类型与消息类型一致
+func validateAttachment(content string, msgType int8) error {
+	var attachment model.ChatAttachment
+	if err := json.Unmarshal([]byte(content), &attachment); err != nil {
+		return errno.ParamVerifyError.WithMessage("attachment content must be json")
+	}
+	if !strings.HasPrefix(attachment.URL, chatFileConfig().BaseURL+"/") {
+		return errno.ParamVerifyError.WithMessage("attachment must be uploaded first")
+	}
+	switch msgType {
+	case model.MessageTypeImage:
+		if !strings.HasPrefix(attachment.ContentType, "image/") {
+			return errno.ParamVerifyError.WithMessage("attachment is not an image")
+		}
+	case model.MessageTypeVideo:
+		if !strings.HasPrefix(attachment.ContentType, "video/") {
+			return errno.ParamVerifyError.WithMessage("attachment is not a video")
+		}
+	}
+	return nil
+}
+
+// ValidateChatMessage 按消息类型校验内容，回复的消息需在同一聊天室且未撤回
+func (s *SocialService) ValidateChatMessage(ctx context.Context, roomID int64, msgType int8, content string, replyToID int64) error {
+	var err error
+	switch msgType {
+	case model.MessageTypeText:
+		err = validateText(content)
+	case model.MessageTypeImage, model.MessageTypeVideo, model.MessageTypeFile:
+		err = validateAttachment(content, msgType)
+	case model.MessageTypeVideoCard:
+		var card model.VideoCard
+		if json.Unmarshal([]byte(content), &card) != nil || card.VideoID <= 0 {
+			err = errno.ParamVerifyError.WithMessage("invalid video card")
+		}
+	case model.MessageTypeSystem:
+		err = errno.ParamVerifyError.WithMessage("system messages cannot be sent by users")
+	default:
+		err = errno.ParamVerifyError.WithMessage("invalid message type")
+	}
+	if err != nil || replyToID == 0 {
+		return err
+	}
+
+	if replyToID < 0 {
+		return errno.ParamVerifyError.WithMessage("invalid reply_to_id")
+	}
+	target, err := s.db.GetChatMessage(ctx, replyToID)
+	if err != nil {
+		return err
+	}
+	if target.RoomID != roomID || target.Recalled {
+		return errno.ParamVerifyError.WithMessage("cannot reply to this message")
+	}
+	return nil
+}
+
+// EditChatMessage 发送者在编辑时限内修改文本消息
+func (s *SocialService) EditChatMessage(ctx context.Context, operatorID, messageID int64, content string) (*model.ChatMessage, error) {
+	if err := validateText(content); err != nil {
+		return nil, err
+	}
+	msg, err := s.db.GetChatMessage(ctx, messageID)
+	if err != nil {
+		return nil, err
+	}
+	if msg.SenderID != operatorID {
+		return nil, errno.AuthNoOperatePermission.WithMessage("only the sender can edit the message")
+	}
+	if msg.Type != model.MessageTypeText || msg.Recalled {
+		return nil, errno.ParamVerifyError.WithMessage("only text messages can be edited")
+	}
+	now := time.Now().Unix()
+	if now-msg.CreatedAt > constants.ChatMessageEditWindow {
+		return nil, errno.ParamVerifyError.WithMessage("edit window has passed")
+	}
+	if _, err := s.RequireChatRoomMember(ctx, msg.RoomID, operatorID); err != nil {
+		return nil, err
+	}
+
+	if err := s.db.EditChatMessage(ctx, messageID, content, now); err != nil {
+		return nil, err
+	}
+	msg.Content = content
+	msg.EditedAt = now
+	s.IndexChatMessage(ctx, msg)
+	return msg, nil
+}
+
+// RecallChatMessage 撤回消息，发送者需在撤回时限内，管理员可随时撤回角色更低成员的消息，返回撤回后的占位
+func (s *SocialService) RecallChatMessage(ctx context.Context, operatorID, messageID int64) (*model.ChatMessage, error) {
+	msg, err := s.db.GetChatMessage(ctx, messageID)
+	if err != nil {
+		return nil, err
+	}
+	if msg.Type == model.MessageTypeSystem {
+		return nil, errno.ParamVerifyError.WithMessage("system messages cannot be recalled")
+	}
+	operator, err := s.RequireChatRoomMember(ctx, msg.RoomID, operatorID)
+	if err != nil {
+		return nil, err
+	}
+	if msg.Recalled {
+		return msg, nil
+	}
+
+	if msg.SenderID != operatorID || time.Now().Unix()-msg.CreatedAt > constants.ChatMessageRecallWindow {
+		// 发送者已退出聊天室时按普通成员处理
+		var senderRole int8 = model.ChatRoomRoleMember
+		sender, err := s.db.GetChatRoomMember(ctx, msg.RoomID, msg.SenderID)
+		if err != nil {
+			return nil, err
+		}
+		if sender != nil {
+			senderRole = sender.Role
+		}
+		if operator.Role < model.ChatRoomRoleAdmin || operator.Role <= senderRole {
+			return nil, errno.AuthNoOperatePermission.WithMessage("recall window has passed")
+		}
+	}
+
+	if err := s.db.RecallChatMessage(ctx, messageID); err != nil {
+		return nil, err
+	}
+	msg.Content = ""
+	msg.Recalled = true
+	s.IndexChatMessage(ctx, msg)
+	return msg, nil
+}
+
+// validatePrivateReply 回复的私信需在同一会话且未撤回
+func (s *SocialService) validatePrivateReply(ctx context.Context, senderID, receiverID, replyToID int64) error {
+	if replyToID == 0 {
+		return nil
+	}
+	if replyToID < 0 {
+		return errno.ParamVerifyError.WithMessage("invalid reply_to_id")
+	}
+	target, err := s.db.GetPrivateMessage(ctx, replyToID)
+	if err != nil {
+		return err
+	}
+	if model.PrivateConversationKey(target.SenderID, target.ReceiverID) != model.PrivateConversationKey(senderID, receiverID) || target.Recalled {
+		return errno.ParamVerifyError.WithMessage("cannot reply to this message")
+	}
+	return nil
+}
+
+// EditPrivateMessage 发送者在编辑时限内修改私信
+func (s *SocialService) EditPrivateMessage(ctx context.Context, operatorID, messageID int64, content string) (*model.PrivateMessage, error) {
+	if err := validateText(content); err != nil {
+		return nil, err
+	}
+	msg, err := s.db.GetPrivateMessage(ctx, messageID)
+	if err != nil {
+		return nil, err
+	}
+	if msg.SenderID != operatorID {
+		return nil, errno.AuthNoOperatePermission.WithMessage("only the sender can edit the message")
+	}
+	if msg.Recalled {
+		return nil, errno.ParamVerifyError.WithMessage("recalled messages cannot be edited")
+	}
+	now := time.Now().Unix()
+	if now-msg.CreatedAt > constants.ChatMessageEditWindow {
+		return nil, errno.ParamVerifyError.WithMessage("edit window has passed")
+	}
+
+	if err := s.db.EditPrivateMessage(ctx, messageID, content, now); err != nil {
+		return nil, err
+	}
+	msg.Content = content
+	msg.EditedAt = now
+	s.publishMessageIndex(ctx, model.PrivateMessageDocument(msg))
+	return msg, nil
+}
+
+// RecallPrivateMessage 发送者在撤回时限内撤回私信，返回撤回后的占位
+func (s *SocialService) RecallPrivateMessage(ctx context.Context, operatorID, messageID int64) (*model.PrivateMessage, error) {
+	msg, err := s.db.GetPrivateMessage(ctx, messageID)
+	if err != nil {
+		return nil, err
+	}
+	if msg.SenderID != operatorID {
+		return nil, errno.AuthNoOperatePermission.WithMessage("only the sender can recall the message")
+	}
+	if msg.Recalled {
+		return msg, nil
+	}
+	if time.Now().Unix()-msg.CreatedAt > constants.ChatMessageRecallWindow {
+		return nil, errno.AuthNoOperatePermission.WithMessage("recall window has passed")
+	}
+
+	if err := s.db.RecallPrivateMessage(ctx, messageID); err != nil {
+		return nil, err
+	}
+	msg.Content = ""
+	msg.Recalled = true
+	s.publishMessageIndex(ctx, model.PrivateMessageDocument(msg))
+	return msg, nil
+}
@@ -0,0 +1,480 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/smartystreets/goconvey/convey"
+	"github.com/stretchr/testify/mock"
+	"github.com/yxrxy/videoHub/app/social/domain/model"
+	"github.com/yxrxy/videoHub/pkg/constants"
+	"github.com/yxrxy/videoHub/pkg/errno"
+)
+
+func TestSocialService_ValidateChatMessage(t *testing.T) {
+	type TestCase struct {
+		Name          string
+		MsgType       int8
+		Content       string
+		ReplyToID     int64
+		Target        *model.ChatMessage
+		ExpectedError bool
+	}
+
+	const roomID = int64(10)
+	testCases := []TestCase{
+		{
+			Name:    "文本消息",
+			MsgType: model.MessageTypeText,
+			Content: "hello",
+		},
+		{
+			Name:          "空文本",
+			MsgType:       model.MessageTypeText,
+			Content:       "  ",
+			ExpectedError: true,
+		},
+		{
+			Name:    "已上传的图片",
+			MsgType: model.MessageTypeImage,
+			Content: `{"url":"/chat/1_ab.png","name":"a.png","content_type":"image/png","size":10}`,
+		},
+		{
+			Name:          "未上传的图片",
+			MsgType:       model.MessageTypeImage,
+			Content:       `{"url":"http://evil.com/a.png","content_type":"image/png"}`,
+			ExpectedError: true,
+		},
+		{
+			Name:          "附件类型与消息类型不一致",
+			MsgType:       model.MessageTypeVideo,
+			Content:       `{"url":"/chat/1_ab.png","content_type":"image/png"}`,
+			ExpectedError: true,
+		},
+		{
+			Name:          "用户不能发送系统消息",
+			MsgType:       model.MessageTypeSystem,
+			Content:       "{}",
+			ExpectedError: true,
+		},
+		{
+			Name:      "回复同一聊天室的消息",
+			MsgType:   model.MessageTypeText,
+			Content:   "reply",
+			ReplyToID: 5,
+			Target:    &model.ChatMessage{ID: 5, RoomID: roomID},
+		},
+		{
+			Name:          "回复其他聊天室的消息",
+			MsgType:       model.MessageTypeText,
+			Content:       "reply",
+			ReplyToID:     5,
+			Target:        &model.ChatMessage{ID: 5, RoomID: roomID + 1},
+			ExpectedError: true,
+		},
+		{
+			Name:          "回复已撤回的消息",
+			MsgType:       model.MessageTypeText,
+			Content:       "reply",
+			ReplyToID:     5,
+			Target:        &model.ChatMessage{ID: 5, RoomID: roomID, Recalled: true},
+			ExpectedError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		convey.Convey(tc.Name, t, func() {
+			ctx := context.Background()
+			db := new(MockDB)
+			db.On("GetChatMessage", ctx, tc.ReplyToID).Return(tc.Target, nil)
+
+			svc := NewSocialService(db, new(MockCache), new(MockPrivacy), new(MockNotificationMQ), new(MockElastic))
+			err := svc.ValidateChatMessage(ctx, roomID, tc.MsgType, tc.Content, tc.ReplyToID)
+
+			if tc.ExpectedError {
+				convey.So(err, convey.ShouldNotBeNil)
+			} else {
+				convey.So(err, convey.ShouldBeNil)
+			}
+		})
+	}
+}
+
+func TestSocialService_EditChatMessage(t *testing.T) {
+	type TestCase struct {
+		Name          string
+		OperatorID    int64
+		Message       *model.ChatMessage
+		ExpectedError bool
+	}
+
+	const roomID, senderID, messageID = int64(10), int64(1), int64(5)
+	now := time.Now().Unix()
+	testCases := []TestCase{
+		{
+			Name:       "发送者在时限内编辑",
+			OperatorID: senderID,
+			Message:    &model.ChatMessage{ID: messageID, RoomID: roomID, SenderID: senderID, CreatedAt: now - 60},
+		},
+		{
+			Name:          "超过编辑时限",
+			OperatorID:    senderID,
+			Message:       &model.ChatMessage{ID: messageID, RoomID: roomID, SenderID: senderID, CreatedAt: now - constants.ChatMessageEditWindow - 1},
+			ExpectedError: true,
+		},
+		{
+			Name:          "非发送者不能编辑",
+			OperatorID:    senderID + 1,
+			Message:       &model.ChatMessage{ID: messageID, RoomID: roomID, SenderID: senderID, CreatedAt: now},
+			ExpectedError: true,
+		},
+		{
+			Name:          "非文本消息不能编辑",
+			OperatorID:    senderID,
+			Message:       &model.ChatMessage{ID: messageID, RoomID: roomID, SenderID: senderID, Type: model.MessageTypeImage, CreatedAt: now},
+			ExpectedError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		convey.Convey(tc.Name, t, func() {
+			ctx := context.Background()
+			db := new(MockDB)
+			db.On("GetChatMessage", ctx, messageID).Return(tc.Message, nil)
+			db.On("GetChatRoomMember", ctx, roomID, tc.OperatorID).Return(member(roomID, tc.OperatorID, model.ChatRoomRoleMember), nil)
+			db.On("EditChatMessage", ctx, messageID, "edited", mock.Anything).Return(nil)
+			notifier := new(MockNotificationMQ)
+			notifier.On("SendMessageIndexEvent", ctx, mock.Anything).Return(nil)
+
+			svc := NewSocialService(db, new(MockCache), new(MockPrivacy), notifier, new(MockElastic))
+			msg, err := svc.EditChatMessage(ctx, tc.OperatorID, messageID, "edited")
+
+			if tc.ExpectedError {
+				convey.So(err, convey.ShouldNotBeNil)
+				db.AssertNotCalled(t, "EditChatMessage", ctx, messageID, "edited", mock.Anything)
+				return
+			}
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(msg.Content, convey.ShouldEqual, "edited")
+			convey.So(msg.EditedAt, convey.ShouldBeGreaterThanOrEqualTo, now)
+			// 编辑后的内容重新写入搜索索引
+			notifier.AssertCalled(t, "SendMessageIndexEvent", ctx, mock.MatchedBy(func(doc *model.MessageDocument) bool {
+				return doc.ID == messageID && doc.RoomID == roomID && doc.SearchText == "edited"
+			}))
+		})
+	}
+}
+
+func TestSocialService_RecallChatMessage(t *testing.T) {
+	type TestCase struct {
+		Name          string
+		OperatorID    int64
+		OperatorRole  int8
+		SenderRole    int8
+		SentAgo       int64
+		ExpectedError bool
+	}
+
+	const roomID, senderID, otherID, messageID = int64(10), int64(1), int64(2), int64(5)
+	testCases := []TestCase{
+		{
+			Name:         "发送者在时限内撤回",
+			OperatorID:   senderID,
+			OperatorRole: model.ChatRoomRoleMember,
+			SenderRole:   model.ChatRoomRoleMember,
+			SentAgo:      30,
+		},
+		{
+			Name:          "发送者超过撤回时限",
+			OperatorID:    senderID,
+			OperatorRole:  model.ChatRoomRoleMember,
+			SenderRole:    model.ChatRoomRoleMember,
+			SentAgo:       constants.ChatMessageRecallWindow + 1,
+			ExpectedError: true,
+		},
+		{
+			Name:         "管理员撤回普通成员的消息",
+			OperatorID:   otherID,
+			OperatorRole: model.ChatRoomRoleAdmin,
+			SenderRole:   model.ChatRoomRoleMember,
+			SentAgo:      3600,
+		},
+		{
+			Name:          "管理员不能撤回群主的消息",
+			OperatorID:    otherID,
+			OperatorRole:  model.ChatRoomRoleAdmin,
+			SenderRole:    model.ChatRoomRoleCreator,
+			SentAgo:       30,
+			ExpectedError: true,
+		},
+		{
+			Name:          "普通成员不能撤回他人消息",
+			OperatorID:    otherID,
+			OperatorRole:  model.ChatRoomRoleMember,
+			SenderRole:    model.ChatRoomRoleMember,
+			SentAgo:       30,
+			ExpectedError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		convey.Convey(tc.Name, t, func() {
+			ctx := context.Background()
+			db := new(MockDB)
+			db.On("GetChatMessage", ctx, messageID).Return(&model.ChatMessage{
+				ID:        messageID,
+				RoomID:    roomID,
+				SenderID:  senderID,
+				Content:   "hello",
+				CreatedAt: time.Now().Unix() - tc.SentAgo,
+			}, nil)
+			if tc.OperatorID != senderID {
+				db.On("GetChatRoomMember", ctx, roomID, tc.OperatorID).Return(member(roomID, tc.OperatorID, tc.OperatorRole), nil)
+			}
+			db.On("GetChatRoomMember", ctx, roomID, senderID).Return(member(roomID, senderID, tc.SenderRole), nil)
+			db.On("RecallChatMessage", ctx, messageID).Return(nil)
+			notifier := new(MockNotificationMQ)
+			notifier.On("SendMessageIndexEvent", ctx, mock.Anything).Return(nil)
+
+			svc := NewSocialService(db, new(MockCache), new(MockPrivacy), notifier, new(MockElastic))
+			msg, err := svc.RecallChatMessage(ctx, tc.OperatorID, messageID)
+
+			if tc.ExpectedError {
+				convey.So(err, convey.ShouldNotBeNil)
+				db.AssertNotCalled(t, "RecallChatMessage", ctx, messageID)
+				return
+			}
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(msg.Recalled, convey.ShouldBeTrue)
+			convey.So(msg.Content, convey.ShouldBeEmpty)
+			// 撤回后索引中的消息不再可搜索
+			notifier.AssertCalled(t, "SendMessageIndexEvent", ctx, mock.MatchedBy(func(doc *model.MessageDocument) bool {
+				return doc.ID == messageID && doc.Recalled && doc.SearchText == ""
+			}))
+		})
+	}
+}
+
+func TestSocialService_SavePrivateMessageReply(t *testing.T) {
+	type TestCase struct {
+		Name          string
+		Target        *model.PrivateMessage
+		ExpectedError bool
+	}
+
+	const senderID, receiverID, replyToID = int64(1), int64(2), int64(5)
+	testCases := []TestCase{
+		{
+			Name:   "回复对方发来的消息",
+			Target: &model.PrivateMessage{ID: replyToID, SenderID: receiverID, ReceiverID: senderID},
+		},
+		{
+			Name:   "回复自己发出的消息",
+			Target: &model.PrivateMessage{ID: replyToID, SenderID: senderID, ReceiverID: receiverID},
+		},
+		{
+			Name:          "不能回复其他会话的消息",
+			Target:        &model.PrivateMessage{ID: replyToID, SenderID: receiverID, ReceiverID: 3},
+			ExpectedError: true,
+		},
+		{
+			Name:          "不能回复已撤回的消息",
+			Target:        &model.PrivateMessage{ID: replyToID, SenderID: receiverID, ReceiverID: senderID, Recalled: true},
+			ExpectedError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		convey.Convey(tc.Name, t, func() {
+			ctx := context.Background()
+			db := new(MockDB)
+			db.On("GetPrivateMessage", ctx, replyToID).Return(tc.Target, nil)
+			db.On("SendPrivateMessage", ctx, mock.Anything).Return(nil)
+			privacy := new(MockPrivacy)
+			privacy.On("CheckDirectMessage", ctx, senderID, receiverID).Return(nil)
+			notifier := new(MockNotificationMQ)
+			notifier.On("SendMessageIndexEvent", ctx, mock.Anything).Return(nil)
+
+			svc := NewSocialService(db, new(MockCache), privacy, notifier, new(MockElastic))
+			msg, err := svc.SavePrivateMessage(ctx, senderID, receiverID, "hello", replyToID)
+
+			if tc.ExpectedError {
+				convey.So(err, convey.ShouldNotBeNil)
+				db.AssertNotCalled(t, "SendPrivateMessage", ctx, mock.Anything)
+				return
+			}
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(msg.ReplyToID, convey.ShouldEqual, replyToID)
+		})
+	}
+}
+
+func TestSocialService_EditPrivateMessage(t *testing.T) {
+	type TestCase struct {
+		Name          string
+		OperatorID    int64
+		Message       *model.PrivateMessage
+		ExpectedError bool
+	}
+
+	const senderID, receiverID, messageID = int64(1), int64(2), int64(5)
+	now := time.Now().Unix()
+	testCases := []TestCase{
+		{
+			Name:       "发送者在时限内编辑",
+			OperatorID: senderID,
+			Message:    &model.PrivateMessage{ID: messageID, SenderID: senderID, ReceiverID: receiverID, CreatedAt: now - 60},
+		},
+		{
+			Name:          "超过编辑时限",
+			OperatorID:    senderID,
+			Message:       &model.PrivateMessage{ID: messageID, SenderID: senderID, ReceiverID: receiverID, CreatedAt: now - constants.ChatMessageEditWindow - 1},
+			ExpectedError: true,
+		},
+		{
+			Name:          "接收者不能编辑",
+			OperatorID:    receiverID,
+			Message:       &model.PrivateMessage{ID: messageID, SenderID: senderID, ReceiverID: receiverID, CreatedAt: now},
+			ExpectedError: true,
+		},
+		{
+			Name:          "已撤回的消息不能编辑",
+			OperatorID:    senderID,
+			Message:       &model.PrivateMessage{ID: messageID, SenderID: senderID, ReceiverID: receiverID, Recalled: true, CreatedAt: now},
+			ExpectedError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		convey.Convey(tc.Name, t, func() {
+			ctx := context.Background()
+			db := new(MockDB)
+			db.On("GetPrivateMessage", ctx, messageID).Return(tc.Message, nil)
+			db.On("EditPrivateMessage", ctx, messageID, "edited", mock.Anything).Return(nil)
+			notifier := new(MockNotificationMQ)
+			notifier.On("SendMessageIndexEvent", ctx, mock.Anything).Return(nil)
+
+			svc := NewSocialService(db, new(MockCache), new(MockPrivacy), notifier, new(MockElastic))
+			msg, err := svc.EditPrivateMessage(ctx, tc.OperatorID, messageID, "edited")
+
+			if tc.ExpectedError {
+				convey.So(err, convey.ShouldNotBeNil)
+				db.AssertNotCalled(t, "EditPrivateMessage", ctx, messageID, "edited", mock.Anything)
+				return
+			}
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(msg.Content, convey.ShouldEqual, "edited")
+			convey.So(msg.EditedAt, convey.ShouldBeGreaterThanOrEqualTo, now)
+			notifier.AssertCalled(t, "SendMessageIndexEvent", ctx, mock.MatchedBy(func(doc *model.MessageDocument) bool {
+				return doc.ID == messageID && doc.RoomID == 0 && doc.SearchText == "edited"
+			}))
+		})
+	}
+}
+
+func TestSocialService_RecallPrivateMessage(t *testing.T) {
+	type TestCase struct {
+		Name          string
+		OperatorID    int64
+		SentAgo       int64
+		ExpectedError bool
+	}
+
+	const senderID, receiverID, messageID = int64(1), int64(2), int64(5)
+	testCases := []TestCase{
+		{
+			Name:       "发送者在时限内撤回",
+			OperatorID: senderID,
+			SentAgo:    30,
+		},
+		{
+			Name:          "发送者超过撤回时限",
+			OperatorID:    senderID,
+			SentAgo:       constants.ChatMessageRecallWindow + 1,
+			ExpectedError: true,
+		},
+		{
+			Name:          "接收者不能撤回",
+			OperatorID:    receiverID,
+			SentAgo:       30,
+			ExpectedError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		convey.Convey(tc.Name, t, func() {
+			ctx := context.Background()
+			db := new(MockDB)
+			db.On("GetPrivateMessage", ctx, messageID).Return(&model.PrivateMessage{
+				ID:         messageID,
+				SenderID:   senderID,
+				ReceiverID: receiverID,
+				Content:    "hello",
+				CreatedAt:  time.Now().Unix() - tc.SentAgo,
+			}, nil)
+			db.On("RecallPrivateMessage", ctx, messageID).Return(nil)
+			notifier := new(MockNotificationMQ)
+			notifier.On("SendMessageIndexEvent", ctx, mock.Anything).Return(nil)
+
+			svc := NewSocialService(db, new(MockCache), new(MockPrivacy), notifier, new(MockElastic))
+			msg, err := svc.RecallPrivateMessage(ctx, tc.OperatorID, messageID)
+
+			if tc.ExpectedError {
+				convey.So(err, convey.ShouldNotBeNil)
+				db.AssertNotCalled(t, "RecallPrivateMessage", ctx, messageID)
+				return
+			}
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(msg.Recalled, convey.ShouldBeTrue)
+			convey.So(msg.Content, convey.ShouldBeEmpty)
+			// 撤回后索引中的私信不再可搜索
+			notifier.AssertCalled(t, "SendMessageIndexEvent", ctx, mock.MatchedBy(func(doc *model.MessageDocument) bool {
+				return doc.ID == messageID && doc.Recalled && doc.SearchText == ""
+			}))
+		})
+	}
+}
+
+func TestSocialService_CanDirectMessage(t *testing.T) {
+	type TestCase struct {
+		Name          string
+		CheckErr      error
+		Expected      bool
+		ExpectedError bool
+	}
+
+	testCases := []TestCase{
+		{
+			Name:     "可以私信",
+			Expected: true,
+		},
+		{
+			Name:     "被拉黑或对方不接收私信",
+			CheckErr: blockedError(true),
+		},
+		{
+			Name:          "校验失败",
+			CheckErr:      errno.InternalServiceError,
+			ExpectedError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		convey.Convey(tc.Name, t, func() {
+			ctx := context.Background()
+			privacy := new(MockPrivacy)
+			privacy.On("CheckDirectMessage", ctx, int64(1), int64(2)).Return(tc.CheckErr)
+
+			svc := NewSocialService(new(MockDB), new(MockCache), privacy, new(MockNotificationMQ), new(MockElastic))
+			allowed, err := svc.CanDirectMessage(ctx, 1, 2)
+
+			if tc.ExpectedError {
+				convey.So(err, convey.ShouldNotBeNil)
+				return
+			}
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(allowed, convey.ShouldEqual, tc.Expected)
+		})
+	}
+}
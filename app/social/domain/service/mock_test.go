@@ -0,0 +1,430 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/yxrxy/videoHub/app/social/domain/model"
+	"github.com/yxrxy/videoHub/app/social/domain/repository"
+	"github.com/yxrxy/videoHub/pkg/errno"
+	"github.com/yxrxy/videoHub/pkg/kafka"
+)
+
+// MockDB 只实现测试用到的方法，其余方法调用时会 panic
+type MockDB struct {
+	repository.SocialDB
+	mock.Mock
+}
+
+func (m *MockDB) GetChatRoom(ctx context.Context, roomID int64) (*model.ChatRoom, error) {
+	args := m.Called(ctx, roomID)
+	result, _ := args.Get(0).(*model.ChatRoom)
+	return result, args.Error(1)
+}
+
+func (m *MockDB) GetChatRoomMember(ctx context.Context, roomID, userID int64) (*model.ChatRoomMember, error) {
+	args := m.Called(ctx, roomID, userID)
+	result, _ := args.Get(0).(*model.ChatRoomMember)
+	return result, args.Error(1)
+}
+
+func (m *MockDB) GetUserChatRooms(ctx context.Context, userID int64) ([]model.ChatRoom, error) {
+	args := m.Called(ctx, userID)
+	result, _ := args.Get(0).([]model.ChatRoom)
+	return result, args.Error(1)
+}
+
+func (m *MockDB) GetChatRoomMemberIDs(ctx context.Context, roomID int64, userIDs []int64) ([]int64, error) {
+	args := m.Called(ctx, roomID, userIDs)
+	result, _ := args.Get(0).([]int64)
+	return result, args.Error(1)
+}
+
+func (m *MockDB) AddChatRoomMembers(ctx context.Context, members []model.ChatRoomMember) error {
+	args := m.Called(ctx, members)
+	return args.Error(0)
+}
+
+func (m *MockDB) RemoveChatRoomMember(ctx context.Context, roomID, userID int64) error {
+	args := m.Called(ctx, roomID, userID)
+	return args.Error(0)
+}
+
+func (m *MockDB) CountChatRoomMembers(ctx context.Context, roomID int64) (int64, error) {
+	args := m.Called(ctx, roomID)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockDB) CountUserGroupRooms(ctx context.Context, userIDs []int64) (map[int64]int64, error) {
+	args := m.Called(ctx, userIDs)
+	result, _ := args.Get(0).(map[int64]int64)
+	return result, args.Error(1)
+}
+
+func (m *MockDB) UpdateChatRoomMemberRole(ctx context.Context, roomID, userID int64, role int8) error {
+	args := m.Called(ctx, roomID, userID, role)
+	return args.Error(0)
+}
+
+func (m *MockDB) MuteChatRoomMember(ctx context.Context, roomID, userID, mutedUntil int64) error {
+	args := m.Called(ctx, roomID, userID, mutedUntil)
+	return args.Error(0)
+}
+
+func (m *MockDB) GetChatRoomInvite(ctx context.Context, code string) (*model.ChatRoomInvite, error) {
+	args := m.Called(ctx, code)
+	result, _ := args.Get(0).(*model.ChatRoomInvite)
+	return result, args.Error(1)
+}
+
+func (m *MockDB) GetChatMessage(ctx context.Context, id int64) (*model.ChatMessage, error) {
+	args := m.Called(ctx, id)
+	result, _ := args.Get(0).(*model.ChatMessage)
+	return result, args.Error(1)
+}
+
+func (m *MockDB) EditChatMessage(ctx context.Context, id int64, content string, editedAt int64) error {
+	args := m.Called(ctx, id, content, editedAt)
+	return args.Error(0)
+}
+
+func (m *MockDB) RecallChatMessage(ctx context.Context, id int64) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+func (m *MockDB) GetConversationSeq(ctx context.Context, conversationKey string) (int64, error) {
+	args := m.Called(ctx, conversationKey)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockDB) GetPrivateMessage(ctx context.Context, messageID int64) (*model.PrivateMessage, error) {
+	args := m.Called(ctx, messageID)
+	result, _ := args.Get(0).(*model.PrivateMessage)
+	return result, args.Error(1)
+}
+
+func (m *MockDB) SendPrivateMessage(ctx context.Context, msg *model.PrivateMessage) error {
+	args := m.Called(ctx, msg)
+	return args.Error(0)
+}
+
+func (m *MockDB) EditPrivateMessage(ctx context.Context, id int64, content string, editedAt int64) error {
+	args := m.Called(ctx, id, content, editedAt)
+	return args.Error(0)
+}
+
+func (m *MockDB) RecallPrivateMessage(ctx context.Context, id int64) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+func (m *MockDB) MarkConversationRead(ctx context.Context, cursor *model.ReadCursor) (int64, error) {
+	args := m.Called(ctx, cursor)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockDB) GetPrivateUnreadCounts(ctx context.Context, userID int64) (map[int64]int64, error) {
+	args := m.Called(ctx, userID)
+	result, _ := args.Get(0).(map[int64]int64)
+	return result, args.Error(1)
+}
+
+func (m *MockDB) GetGroupUnreadCounts(ctx context.Context, userID int64) (map[int64]int64, error) {
+	args := m.Called(ctx, userID)
+	result, _ := args.Get(0).(map[int64]int64)
+	return result, args.Error(1)
+}
+
+func (m *MockDB) SendChatMessage(ctx context.Context, msg *model.ChatMessage) error {
+	args := m.Called(ctx, msg)
+	return args.Error(0)
+}
+
+func (m *MockDB) GetInbox(ctx context.Context, userID int64, archived bool, after *model.InboxCursor, limit int) ([]model.Conversation, error) {
+	args := m.Called(ctx, userID, archived, after, limit)
+	result, _ := args.Get(0).([]model.Conversation)
+	return result, args.Error(1)
+}
+
+func (m *MockDB) GetConversation(ctx context.Context, userID int64, conversationKey string) (*model.Conversation, error) {
+	args := m.Called(ctx, userID, conversationKey)
+	result, _ := args.Get(0).(*model.Conversation)
+	return result, args.Error(1)
+}
+
+func (m *MockDB) UpdateConversationSettings(ctx context.Context, userID int64, conversationKey string, settings *model.ConversationSettings) error {
+	args := m.Called(ctx, userID, conversationKey, settings)
+	return args.Error(0)
+}
+
+type MockCache struct {
+	repository.SocialCache
+	mock.Mock
+}
+
+func (m *MockCache) AcquireChatRetentionLock(ctx context.Context, token string, ttl time.Duration) (bool, error) {
+	args := m.Called(ctx, token, ttl)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockCache) ReleaseChatRetentionLock(ctx context.Context, token string) error {
+	args := m.Called(ctx, token)
+	return args.Error(0)
+}
+
+type MockPrivacy struct {
+	mock.Mock
+}
+
+func (m *MockPrivacy) CheckInteract(ctx context.Context, actorID, targetID int64) error {
+	args := m.Called(ctx, actorID, targetID)
+	return args.Error(0)
+}
+
+func (m *MockPrivacy) CheckDirectMessage(ctx context.Context, senderID, receiverID int64) error {
+	args := m.Called(ctx, senderID, receiverID)
+	return args.Error(0)
+}
+
+func (m *MockPrivacy) FilterBlocked(ctx context.Context, userID int64, userIDs []int64) (map[int64]bool, error) {
+	args := m.Called(ctx, userID, userIDs)
+	result, _ := args.Get(0).(map[int64]bool)
+	return result, args.Error(1)
+}
+
+func (m *MockPrivacy) FilterPresenceVisible(ctx context.Context, viewerID int64, userIDs []int64) (map[int64]bool, error) {
+	args := m.Called(ctx, viewerID, userIDs)
+	result, _ := args.Get(0).(map[int64]bool)
+	return result, args.Error(1)
+}
+
+// blockedError 模拟拉黑校验结果
+func blockedError(blocked bool) error {
+	if blocked {
+		return errno.AuthNoOperatePermission.WithMessage("user is blocked")
+	}
+	return nil
+}
+
+func (m *MockDB) AreFriends(ctx context.Context, userID, friendID int64) (bool, error) {
+	args := m.Called(ctx, userID, friendID)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockDB) GetUserFriends(ctx context.Context, userID int64) ([]model.Friendship, error) {
+	args := m.Called(ctx, userID)
+	result, _ := args.Get(0).([]model.Friendship)
+	return result, args.Error(1)
+}
+
+func (m *MockDB) CountFriends(ctx context.Context, userIDs []int64) (map[int64]int64, error) {
+	args := m.Called(ctx, userIDs)
+	result, _ := args.Get(0).(map[int64]int64)
+	return result, args.Error(1)
+}
+
+func (m *MockDB) CreateFriendRequest(ctx context.Context, request *model.FriendRequest) error {
+	args := m.Called(ctx, request)
+	request.ID = 100
+	return args.Error(0)
+}
+
+func (m *MockDB) GetFriendRequest(ctx context.Context, requestID int64) (*model.FriendRequest, error) {
+	args := m.Called(ctx, requestID)
+	result, _ := args.Get(0).(*model.FriendRequest)
+	return result, args.Error(1)
+}
+
+func (m *MockDB) GetPendingFriendRequest(ctx context.Context, senderID, receiverID int64) (*model.FriendRequest, error) {
+	args := m.Called(ctx, senderID, receiverID)
+	result, _ := args.Get(0).(*model.FriendRequest)
+	return result, args.Error(1)
+}
+
+func (m *MockDB) UpdateFriendRequestStatus(ctx context.Context, requestID int64, from, to int8) (bool, error) {
+	args := m.Called(ctx, requestID, from, to)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockDB) AcceptFriendRequest(ctx context.Context, requestID int64) (bool, error) {
+	args := m.Called(ctx, requestID)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockDB) GetMutualFriendCandidates(ctx context.Context, userID int64, limit int) (map[int64]int64, error) {
+	args := m.Called(ctx, userID, limit)
+	result, _ := args.Get(0).(map[int64]int64)
+	return result, args.Error(1)
+}
+
+func (m *MockDB) GetMutualFollowCandidates(ctx context.Context, userID int64, limit int) (map[int64]int64, error) {
+	args := m.Called(ctx, userID, limit)
+	result, _ := args.Get(0).(map[int64]int64)
+	return result, args.Error(1)
+}
+
+func (m *MockDB) GetSharedLikeCandidates(ctx context.Context, userID int64, limit int) (map[int64]int64, error) {
+	args := m.Called(ctx, userID, limit)
+	result, _ := args.Get(0).(map[int64]int64)
+	return result, args.Error(1)
+}
+
+func (m *MockDB) GetCoCommentCandidates(ctx context.Context, userID int64, limit int) (map[int64]int64, error) {
+	args := m.Called(ctx, userID, limit)
+	result, _ := args.Get(0).(map[int64]int64)
+	return result, args.Error(1)
+}
+
+func (m *MockDB) GetConnectedUsers(ctx context.Context, userID int64, userIDs []int64) (map[int64]bool, error) {
+	args := m.Called(ctx, userID, userIDs)
+	result, _ := args.Get(0).(map[int64]bool)
+	return result, args.Error(1)
+}
+
+func (m *MockCache) GetRecommendations(ctx context.Context, userID int64) ([]*model.Recommendation, bool, error) {
+	args := m.Called(ctx, userID)
+	result, _ := args.Get(0).([]*model.Recommendation)
+	return result, args.Bool(1), args.Error(2)
+}
+
+func (m *MockCache) SetRecommendations(ctx context.Context, userID int64, recommendations []*model.Recommendation) error {
+	args := m.Called(ctx, userID, recommendations)
+	return args.Error(0)
+}
+
+func (m *MockCache) TouchRecommendUser(ctx context.Context, userID int64) error {
+	args := m.Called(ctx, userID)
+	return args.Error(0)
+}
+
+func (m *MockCache) SetUserOnline(ctx context.Context, userID, now int64) (bool, error) {
+	args := m.Called(ctx, userID, now)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockCache) SetUserOffline(ctx context.Context, userID, now int64) (bool, error) {
+	args := m.Called(ctx, userID, now)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockCache) GetPresences(ctx context.Context, userIDs []int64) (map[int64]*model.Presence, error) {
+	args := m.Called(ctx, userIDs)
+	result, _ := args.Get(0).(map[int64]*model.Presence)
+	return result, args.Error(1)
+}
+
+type MockNotificationMQ struct {
+	mock.Mock
+}
+
+func (m *MockNotificationMQ) SendNotificationEvent(ctx context.Context, event *model.NotificationEvent) error {
+	args := m.Called(ctx, event)
+	return args.Error(0)
+}
+
+func (m *MockNotificationMQ) ConsumeNotificationEvents(ctx context.Context) <-chan *kafka.Message {
+	args := m.Called(ctx)
+	result, _ := args.Get(0).(<-chan *kafka.Message)
+	return result
+}
+
+func (m *MockNotificationMQ) SendNotificationPush(ctx context.Context, push *model.NotificationPush) error {
+	args := m.Called(ctx, push)
+	return args.Error(0)
+}
+
+func (m *MockNotificationMQ) SendPresencePush(ctx context.Context, push *model.PresencePush) error {
+	args := m.Called(ctx, push)
+	return args.Error(0)
+}
+
+func (m *MockNotificationMQ) SendMessageIndexEvent(ctx context.Context, doc *model.MessageDocument) error {
+	args := m.Called(ctx, doc)
+	return args.Error(0)
+}
+
+func (m *MockNotificationMQ) ConsumeMessageIndexEvents(ctx context.Context) <-chan *kafka.Message {
+	args := m.Called(ctx)
+	result, _ := args.Get(0).(<-chan *kafka.Message)
+	return result
+}
+
+func (m *MockDB) GetExpiredPrivateMessages(ctx context.Context, before int64, limit int) ([]model.ArchivedMessage, error) {
+	args := m.Called(ctx, before, limit)
+	result, _ := args.Get(0).([]model.ArchivedMessage)
+	return result, args.Error(1)
+}
+
+func (m *MockDB) GetExpiredChatMessages(ctx context.Context, before int64, limit int) ([]model.ArchivedMessage, error) {
+	args := m.Called(ctx, before, limit)
+	result, _ := args.Get(0).([]model.ArchivedMessage)
+	return result, args.Error(1)
+}
+
+func (m *MockDB) ArchiveMessages(ctx context.Context, archives []model.ChatArchive, privateIDs, chatIDs []int64) error {
+	args := m.Called(ctx, archives, privateIDs, chatIDs)
+	return args.Error(0)
+}
+
+func (m *MockDB) GetChatArchives(ctx context.Context, conversationKey string) ([]model.ChatArchive, error) {
+	args := m.Called(ctx, conversationKey)
+	result, _ := args.Get(0).([]model.ChatArchive)
+	return result, args.Error(1)
+}
+
+type MockElastic struct {
+	mock.Mock
+}
+
+func (m *MockElastic) IsExist(ctx context.Context, indexName string) bool {
+	args := m.Called(ctx, indexName)
+	return args.Bool(0)
+}
+
+func (m *MockElastic) CreateIndex(ctx context.Context, indexName string) error {
+	args := m.Called(ctx, indexName)
+	return args.Error(0)
+}
+
+func (m *MockElastic) IndexMessage(ctx context.Context, indexName string, doc *model.MessageDocument) error {
+	args := m.Called(ctx, indexName, doc)
+	return args.Error(0)
+}
+
+func (m *MockElastic) DeleteMessagesBefore(ctx context.Context, indexName string, before int64) error {
+	args := m.Called(ctx, indexName, before)
+	return args.Error(0)
+}
+
+func (m *MockElastic) SearchMessages(
+	ctx context.Context, indexName string, query *model.MessageSearchQuery,
+) ([]*model.MessageDocument, int64, error) {
+	args := m.Called(ctx, indexName, query)
+	result, _ := args.Get(0).([]*model.MessageDocument)
+	return result, args.Get(1).(int64), args.Error(2)
+}
+
+func (m *MockDB) AddNotificationActor(ctx context.Context, event *model.NotificationEvent) (*model.Notification, bool, error) {
+	args := m.Called(ctx, event)
+	result, _ := args.Get(0).(*model.Notification)
+	return result, args.Bool(1), args.Error(2)
+}
+
+func (m *MockDB) GetNotificationUnreadCounts(ctx context.Context, userID int64) (map[int8]int64, error) {
+	args := m.Called(ctx, userID)
+	result, _ := args.Get(0).(map[int8]int64)
+	return result, args.Error(1)
+}
+
+func (m *MockDB) GetNotificationPreferences(ctx context.Context, userID int64) (map[int8]bool, error) {
+	args := m.Called(ctx, userID)
+	result, _ := args.Get(0).(map[int8]bool)
+	return result, args.Error(1)
+}
+
+func (m *MockDB) UpdateNotificationPreferences(ctx context.Context, userID int64, preferences map[int8]bool) error {
+	args := m.Called(ctx, userID, preferences)
+	return args.Error(0)
+}
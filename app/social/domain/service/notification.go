@@ -0,0 +1,151 @@
+package service
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/bytedance/sonic"
+	"github.com/yxrxy/videoHub/app/social/domain/model"
+	"github.com/yxrxy/videoHub/pkg/constants"
+	"github.com/yxrxy/videoHub/pkg/errno"
+)
+
+// publishNotification 投递通知事件，失败只记录日志，不影响触发通知的操作
+func (s *SocialService) publishNotification(ctx context.Context, notificationType int8, userID, actorID, targetID int64) {
+	if err := s.mq.SendNotificationEvent(ctx, &model.NotificationEvent{
+		Type:      notificationType,
+		UserID:    userID,
+		ActorID:   actorID,
+		TargetID:  targetID,
+		CreatedAt: time.Now().Unix(),
+	}); err != nil {
+		log.Printf("投递通知事件失败: %v", err)
+	}
+}
+
+// ConsumeNotificationEvents 消费互动、用户和社交服务产生的通知事件
+func (s *SocialService) ConsumeNotificationEvents(ctx context.Context) {
+	msgCh := s.mq.ConsumeNotificationEvents(ctx)
+	go func() {
+		for msg := range msgCh {
+			event := new(model.NotificationEvent)
+			if err := sonic.Unmarshal(msg.V, event); err != nil {
+				log.Printf("解析通知事件失败: %v", err)
+				continue
+			}
+			if err := s.HandleNotificationEvent(ctx, event); err != nil {
+				log.Printf("处理通知事件失败: %v", err)
+			}
+		}
+	}()
+}
+
+// HandleNotificationEvent 把事件合并进接收者的通知，自己触发、接收者关闭了该类通知
+// 或双方存在拉黑关系时忽略，产生新的触发者时推送给接收者
+func (s *SocialService) HandleNotificationEvent(ctx context.Context, event *model.NotificationEvent) error {
+	if !model.ValidNotificationType(event.Type) || event.UserID == 0 || event.ActorID == 0 || event.UserID == event.ActorID {
+		return nil
+	}
+	preferences, err := s.db.GetNotificationPreferences(ctx, event.UserID)
+	if err != nil {
+		return err
+	}
+	if enabled, ok := preferences[event.Type]; ok && !enabled {
+		return nil
+	}
+	blocked, err := s.privacy.FilterBlocked(ctx, event.UserID, []int64{event.ActorID})
+	if err != nil {
+		return err
+	}
+	if blocked[event.ActorID] {
+		return nil
+	}
+
+	notification, added, err := s.db.AddNotificationActor(ctx, event)
+	if err != nil || !added {
+		return err
+	}
+	_, unread, err := s.GetNotificationUnreadCount(ctx, event.UserID)
+	if err != nil {
+		return err
+	}
+	if err := s.mq.SendNotificationPush(ctx, &model.NotificationPush{
+		UserID:       event.UserID,
+		Notification: notification,
+		Unread:       unread,
+	}); err != nil {
+		log.Printf("投递通知推送失败: %v", err)
+	}
+	return nil
+}
+
+// GetNotifications 分页获取通知，notificationType 为 0 时返回全部类型
+func (s *SocialService) GetNotifications(ctx context.Context, userID int64, notificationType int8, page, size int) ([]model.Notification, int64, error) {
+	if notificationType != 0 && !model.ValidNotificationType(notificationType) {
+		return nil, 0, errno.ParamVerifyError.WithMessage("invalid notification type")
+	}
+	if page <= 0 {
+		page = constants.DefaultPage
+	}
+	if size <= 0 {
+		size = constants.DefaultPageSize
+	}
+	size = min(size, constants.MaxPageSize)
+	return s.db.GetNotifications(ctx, userID, notificationType, page, size)
+}
+
+// GetNotificationUnreadCount 获取各类型的未读通知数和未读总数
+func (s *SocialService) GetNotificationUnreadCount(ctx context.Context, userID int64) (map[int8]int64, int64, error) {
+	counts, err := s.db.GetNotificationUnreadCounts(ctx, userID)
+	if err != nil {
+		return nil, 0, err
+	}
+	var total int64
+	for _, count := range counts {
+		total += count
+	}
+	return counts, total, nil
+}
+
+// MarkNotificationsRead 标记通知已读，ids 为空时标记全部（或 notificationType 类型的全部）未读通知
+func (s *SocialService) MarkNotificationsRead(ctx context.Context, userID int64, ids []int64, notificationType int8) (int64, error) {
+	if notificationType != 0 && !model.ValidNotificationType(notificationType) {
+		return 0, errno.ParamVerifyError.WithMessage("invalid notification type")
+	}
+	if len(ids) > constants.MaxPageSize {
+		return 0, errno.ParamVerifyError.WithMessage("too many notifications")
+	}
+	return s.db.MarkNotificationsRead(ctx, userID, ids, notificationType)
+}
+
+// GetNotificationPreferences 获取全部类型的通知偏好，未设置过的类型默认接收
+func (s *SocialService) GetNotificationPreferences(ctx context.Context, userID int64) ([]model.NotificationPreference, error) {
+	settings, err := s.db.GetNotificationPreferences(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	preferences := make([]model.NotificationPreference, len(model.NotificationTypes))
+	for i, t := range model.NotificationTypes {
+		enabled, ok := settings[t]
+		preferences[i] = model.NotificationPreference{Type: t, Enabled: !ok || enabled}
+	}
+	return preferences, nil
+}
+
+// UpdateNotificationPreferences 修改通知偏好，返回修改后的全部偏好
+func (s *SocialService) UpdateNotificationPreferences(
+	ctx context.Context, userID int64, preferences []model.NotificationPreference,
+) ([]model.NotificationPreference, error) {
+	updates := make(map[int8]bool, len(preferences))
+	for _, p := range preferences {
+		if !model.ValidNotificationType(p.Type) {
+			return nil, errno.ParamVerifyError.WithMessage("invalid notification type")
+		}
+		updates[p.Type] = p.Enabled
+	}
+	if err := s.db.UpdateNotificationPreferences(ctx, userID, updates); err != nil {
+		return nil, err
+	}
+	return s.GetNotificationPreferences(ctx, userID)
+}
@@ -0,0 +1,112 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/smartystreets/goconvey/convey"
+	"github.com/stretchr/testify/mock"
+	"github.com/yxrxy/videoHub/app/social/domain/model"
+)
+
+func TestSocialService_HandleNotificationEvent(t *testing.T) {
+	type TestCase struct {
+		Name        string
+		ActorID     int64
+		Preferences map[int8]bool
+		Blocked     bool
+		Added       bool
+		ExpectStore bool
+		ExpectPush  bool
+	}
+
+	const userID, videoID = int64(1), int64(10)
+	testCases := []TestCase{
+		{
+			Name:        "合并通知并推送",
+			ActorID:     2,
+			Added:       true,
+			ExpectStore: true,
+			ExpectPush:  true,
+		},
+		{
+			Name:        "同一用户重复触发不推送",
+			ActorID:     2,
+			ExpectStore: true,
+		},
+		{
+			Name:    "自己触发的事件忽略",
+			ActorID: userID,
+		},
+		{
+			Name:        "关闭了该类通知时忽略",
+			ActorID:     2,
+			Preferences: map[int8]bool{model.NotificationTypeLike: false},
+		},
+		{
+			Name:    "存在拉黑关系时忽略",
+			ActorID: 2,
+			Blocked: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		convey.Convey(tc.Name, t, func() {
+			ctx := context.Background()
+			event := &model.NotificationEvent{Type: model.NotificationTypeLike, UserID: userID, ActorID: tc.ActorID, TargetID: videoID}
+			notification := &model.Notification{ID: 7, UserID: userID, Type: event.Type, TargetID: videoID, ActorIDs: []int64{tc.ActorID}, ActorCount: 13}
+			db := new(MockDB)
+			db.On("GetNotificationPreferences", ctx, userID).Return(tc.Preferences, nil)
+			db.On("AddNotificationActor", ctx, event).Return(notification, tc.Added, nil)
+			db.On("GetNotificationUnreadCounts", ctx, userID).Return(map[int8]int64{model.NotificationTypeLike: 1, model.NotificationTypeFollow: 2}, nil)
+			privacy := new(MockPrivacy)
+			privacy.On("FilterBlocked", ctx, userID, []int64{tc.ActorID}).Return(map[int64]bool{tc.ActorID: tc.Blocked}, nil)
+			notifier := new(MockNotificationMQ)
+			notifier.On("SendNotificationPush", ctx, mock.Anything).Return(nil)
+
+			svc := NewSocialService(db, new(MockCache), privacy, notifier, new(MockElastic))
+			err := svc.HandleNotificationEvent(ctx, event)
+
+			convey.So(err, convey.ShouldBeNil)
+			if tc.ExpectStore {
+				db.AssertCalled(t, "AddNotificationActor", ctx, event)
+			} else {
+				db.AssertNotCalled(t, "AddNotificationActor", ctx, event)
+			}
+			if tc.ExpectPush {
+				notifier.AssertCalled(t, "SendNotificationPush", ctx, &model.NotificationPush{UserID: userID, Notification: notification, Unread: 3})
+			} else {
+				notifier.AssertNotCalled(t, "SendNotificationPush", ctx, mock.Anything)
+			}
+		})
+	}
+}
+
+func TestSocialService_NotificationPreferences(t *testing.T) {
+	convey.Convey("未设置过的类型默认接收", t, func() {
+		ctx := context.Background()
+		const userID = int64(1)
+		db := new(MockDB)
+		db.On("GetNotificationPreferences", ctx, userID).Return(map[int8]bool{model.NotificationTypeFollow: false}, nil)
+
+		svc := NewSocialService(db, new(MockCache), new(MockPrivacy), new(MockNotificationMQ), new(MockElastic))
+		preferences, err := svc.GetNotificationPreferences(ctx, userID)
+
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(preferences, convey.ShouldHaveLength, len(model.NotificationTypes))
+		for _, p := range preferences {
+			convey.So(p.Enabled, convey.ShouldEqual, p.Type != model.NotificationTypeFollow)
+		}
+	})
+
+	convey.Convey("修改偏好时校验通知类型", t, func() {
+		ctx := context.Background()
+		db := new(MockDB)
+
+		svc := NewSocialService(db, new(MockCache), new(MockPrivacy), new(MockNotificationMQ), new(MockElastic))
+		_, err := svc.UpdateNotificationPreferences(ctx, 1, []model.NotificationPreference{{Type: 99, Enabled: false}})
+
+		convey.So(err, convey.ShouldNotBeNil)
+		db.AssertNotCalled(t, "UpdateNotificationPreferences", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
@@ -0,0 +1,135 @@
+package service
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/yxrxy/videoHub/app/social/domain/model"
+	"github.com/yxrxy/videoHub/pkg/constants"
+	"github.com/yxrxy/videoHub/pkg/errno"
+)
+
+// UpdatePresence 网关在连接建立、心跳和用户最后一个连接断开时上报在线状态，
+// 用户上线或下线时通知可以看到其在线状态的好友
+func (s *SocialService) UpdatePresence(ctx context.Context, userIDs []int64, online bool) error {
+	if len(userIDs) > constants.PresenceMaxBatch {
+		return errno.ParamVerifyError.WithMessage("too many users")
+	}
+	now := time.Now().Unix()
+	for _, userID := range userIDs {
+		if userID <= 0 {
+			continue
+		}
+		var changed bool
+		var err error
+		if online {
+			changed, err = s.cache.SetUserOnline(ctx, userID, now)
+		} else {
+			changed, err = s.cache.SetUserOffline(ctx, userID, now)
+		}
+		if err != nil {
+			return err
+		}
+		if changed {
+			s.publishPresence(ctx, &model.Presence{UserID: userID, Online: online, LastSeen: now})
+		}
+	}
+	return nil
+}
+
+// publishPresence 把在线状态变化推送给好友，隐藏了在线状态时不推送，
+// 存在拉黑关系的好友不推送，失败只记录日志
+func (s *SocialService) publishPresence(ctx context.Context, presence *model.Presence) {
+	recipients, err := s.presenceRecipients(ctx, presence.UserID)
+	if err != nil {
+		log.Printf("获取用户 %d 的在线状态接收者失败: %v", presence.UserID, err)
+		return
+	}
+	if len(recipients) == 0 {
+		return
+	}
+	if err := s.mq.SendPresencePush(ctx, &model.PresencePush{
+		UserID:     presence.UserID,
+		Online:     presence.Online,
+		LastSeen:   presence.LastSeen,
+		Recipients: recipients,
+	}); err != nil {
+		log.Printf("投递在线状态推送失败: %v", err)
+	}
+}
+
+func (s *SocialService) presenceRecipients(ctx context.Context, userID int64) ([]int64, error) {
+	// viewerID 为 0 时只按用户自己的隐私设置判断
+	visible, err := s.privacy.FilterPresenceVisible(ctx, 0, []int64{userID})
+	if err != nil {
+		return nil, err
+	}
+	if !visible[userID] {
+		return nil, nil
+	}
+	friendIDs, err := s.friendIDs(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	blocked, err := s.privacy.FilterBlocked(ctx, userID, friendIDs)
+	if err != nil {
+		return nil, err
+	}
+	recipients := make([]int64, 0, len(friendIDs))
+	for _, id := range friendIDs {
+		if !blocked[id] {
+			recipients = append(recipients, id)
+		}
+	}
+	return recipients, nil
+}
+
+func (s *SocialService) friendIDs(ctx context.Context, userID int64) ([]int64, error) {
+	friendships, err := s.db.GetUserFriends(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	ids := make([]int64, len(friendships))
+	for i, f := range friendships {
+		ids[i] = f.FriendID
+	}
+	return ids, nil
+}
+
+// GetPresence 批量获取在线状态，userIDs 为空时返回全部好友。只有本人和好友的在线状态可见，
+// 对方隐藏了在线状态或存在拉黑关系时按从未上线返回
+func (s *SocialService) GetPresence(ctx context.Context, viewerID int64, userIDs []int64) ([]*model.Presence, error) {
+	if len(userIDs) > constants.PresenceMaxBatch {
+		return nil, errno.ParamVerifyError.WithMessage("too many users")
+	}
+	friendIDs, err := s.friendIDs(ctx, viewerID)
+	if err != nil {
+		return nil, err
+	}
+	if len(userIDs) == 0 {
+		userIDs = friendIDs
+	}
+	friends := make(map[int64]bool, len(friendIDs))
+	for _, id := range friendIDs {
+		friends[id] = true
+	}
+
+	visible, err := s.privacy.FilterPresenceVisible(ctx, viewerID, userIDs)
+	if err != nil {
+		return nil, err
+	}
+	presences, err := s.cache.GetPresences(ctx, userIDs)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]*model.Presence, 0, len(userIDs))
+	for _, id := range userIDs {
+		if (id == viewerID || friends[id]) && visible[id] && presences[id] != nil {
+			result = append(result, presences[id])
+			continue
+		}
+		result = append(result, &model.Presence{UserID: id})
+	}
+	return result, nil
+}
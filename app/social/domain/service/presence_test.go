@@ -0,0 +1,121 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/smartystreets/goconvey/convey"
+	"github.com/stretchr/testify/mock"
+	"github.com/yxrxy/videoHub/app/social/domain/model"
+)
+
+func TestSocialService_UpdatePresence(t *testing.T) {
+	type TestCase struct {
+		Name       string
+		Online     bool
+		Changed    bool
+		Hidden     bool
+		ExpectPush bool
+	}
+
+	const userID = int64(1)
+	testCases := []TestCase{
+		{
+			Name:       "上线时推送给好友",
+			Online:     true,
+			Changed:    true,
+			ExpectPush: true,
+		},
+		{
+			Name:   "心跳续期不推送",
+			Online: true,
+		},
+		{
+			Name:       "下线时推送给好友",
+			Changed:    true,
+			ExpectPush: true,
+		},
+		{
+			Name:    "隐藏了在线状态时不推送",
+			Online:  true,
+			Changed: true,
+			Hidden:  true,
+		},
+	}
+
+	for _, tc := range testCases {
+		convey.Convey(tc.Name, t, func() {
+			ctx := context.Background()
+			db := new(MockDB)
+			db.On("GetUserFriends", ctx, userID).Return([]model.Friendship{{UserID: userID, FriendID: 2}, {UserID: userID, FriendID: 3}}, nil)
+			cache := new(MockCache)
+			cache.On("SetUserOnline", ctx, userID, mock.Anything).Return(tc.Changed, nil)
+			cache.On("SetUserOffline", ctx, userID, mock.Anything).Return(tc.Changed, nil)
+			privacy := new(MockPrivacy)
+			privacy.On("FilterPresenceVisible", ctx, int64(0), []int64{userID}).Return(map[int64]bool{userID: !tc.Hidden}, nil)
+			privacy.On("FilterBlocked", ctx, userID, []int64{2, 3}).Return(map[int64]bool{3: true}, nil)
+			notifier := new(MockNotificationMQ)
+			notifier.On("SendPresencePush", ctx, mock.Anything).Return(nil)
+
+			svc := NewSocialService(db, cache, privacy, notifier, new(MockElastic))
+			err := svc.UpdatePresence(ctx, []int64{userID}, tc.Online)
+
+			convey.So(err, convey.ShouldBeNil)
+			if !tc.ExpectPush {
+				notifier.AssertNotCalled(t, "SendPresencePush", ctx, mock.Anything)
+				return
+			}
+			// 存在拉黑关系的好友不推送
+			notifier.AssertCalled(t, "SendPresencePush", ctx, mock.MatchedBy(func(push *model.PresencePush) bool {
+				return push.UserID == userID && push.Online == tc.Online && push.LastSeen > 0 &&
+					len(push.Recipients) == 1 && push.Recipients[0] == 2
+			}))
+		})
+	}
+}
+
+func TestSocialService_GetPresence(t *testing.T) {
+	convey.Convey("只返回好友中可见的在线状态", t, func() {
+		ctx := context.Background()
+		const viewerID = int64(1)
+		// 2 为好友，3 为好友但隐藏了在线状态，4 不是好友
+		ids := []int64{2, 3, 4}
+		db := new(MockDB)
+		db.On("GetUserFriends", ctx, viewerID).Return([]model.Friendship{{UserID: viewerID, FriendID: 2}, {UserID: viewerID, FriendID: 3}}, nil)
+		privacy := new(MockPrivacy)
+		privacy.On("FilterPresenceVisible", ctx, viewerID, ids).Return(map[int64]bool{2: true, 4: true}, nil)
+		cache := new(MockCache)
+		cache.On("GetPresences", ctx, ids).Return(map[int64]*model.Presence{
+			2: {UserID: 2, Online: true, LastSeen: 100},
+			3: {UserID: 3, Online: true, LastSeen: 200},
+			4: {UserID: 4, Online: true, LastSeen: 300},
+		}, nil)
+
+		svc := NewSocialService(db, cache, privacy, new(MockNotificationMQ), new(MockElastic))
+		presences, err := svc.GetPresence(ctx, viewerID, ids)
+
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(presences, convey.ShouldResemble, []*model.Presence{
+			{UserID: 2, Online: true, LastSeen: 100},
+			{UserID: 3},
+			{UserID: 4},
+		})
+	})
+
+	convey.Convey("不传用户ID时返回全部好友", t, func() {
+		ctx := context.Background()
+		const viewerID = int64(1)
+		db := new(MockDB)
+		db.On("GetUserFriends", ctx, viewerID).Return([]model.Friendship{{UserID: viewerID, FriendID: 2}}, nil)
+		privacy := new(MockPrivacy)
+		privacy.On("FilterPresenceVisible", ctx, viewerID, []int64{2}).Return(map[int64]bool{2: true}, nil)
+		cache := new(MockCache)
+		cache.On("GetPresences", ctx, []int64{2}).Return(map[int64]*model.Presence{2: {UserID: 2, LastSeen: 100}}, nil)
+
+		svc := NewSocialService(db, cache, privacy, new(MockNotificationMQ), new(MockElastic))
+		presences, err := svc.GetPresence(ctx, viewerID, nil)
+
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(presences, convey.ShouldResemble, []*model.Presence{{UserID: 2, LastSeen: 100}})
+	})
+}
@@ -0,0 +1,97 @@
+package service
+
+import (
+	"context"
+	"sort"
+
+	"github.com/yxrxy/videoHub/app/social/domain/model"
+	"github.com/yxrxy/videoHub/pkg/constants"
+	"github.com/yxrxy/videoHub/pkg/errno"
+)
+
+// MarkConversationRead 推进用户在会话中的已读游标，seq 为 0 或超过会话最大序号时读到最新
+func (s *SocialService) MarkConversationRead(ctx context.Context, userID, peerID, roomID, seq int64) (*model.ReadCursor, error) {
+	if (peerID == 0) == (roomID == 0) {
+		return nil, errno.ParamVerifyError.WithMessage("exactly one of peer_id and room_id is required")
+	}
+	if peerID < 0 || roomID < 0 || seq < 0 || peerID == userID {
+		return nil, errno.ParamVerifyError.WithMessage("invalid read cursor")
+	}
+	if roomID != 0 {
+		if _, err := s.RequireChatRoomMember(ctx, roomID, userID); err != nil {
+			return nil, err
+		}
+	}
+
+	cursor := &model.ReadCursor{UserID: userID, PeerID: peerID, RoomID: roomID}
+	latest, err := s.db.GetConversationSeq(ctx, cursor.ConversationKey())
+	if err != nil {
+		return nil, err
+	}
+	if seq == 0 || seq > latest {
+		seq = latest
+	}
+	cursor.ReadSeq = seq
+	if cursor.ReadSeq, err = s.db.MarkConversationRead(ctx, cursor); err != nil {
+		return nil, err
+	}
+	return cursor, nil
+}
+
+// MarkConversationsRead 批量推进已读游标，任一会话失败时返回错误，已推进的游标不回退
+func (s *SocialService) MarkConversationsRead(ctx context.Context, userID int64, conversations []model.ReadCursor) ([]*model.ReadCursor, error) {
+	if len(conversations) == 0 || len(conversations) > constants.MarkReadMaxBatch {
+		return nil, errno.ParamVerifyError.WithMessage("invalid number of conversations")
+	}
+	cursors := make([]*model.ReadCursor, 0, len(conversations))
+	for _, c := range conversations {
+		cursor, err := s.MarkConversationRead(ctx, userID, c.PeerID, c.RoomID, c.ReadSeq)
+		if err != nil {
+			return nil, err
+		}
+		cursors = append(cursors, cursor)
+	}
+	return cursors, nil
+}
+
+// MarkMessageRead 将私信会话的已读游标推进到该消息，仅接收者可标记
+func (s *SocialService) MarkMessageRead(ctx context.Context, messageID, userID int64) (*model.ReadCursor, error) {
+	msg, err := s.db.GetPrivateMessage(ctx, messageID)
+	if err != nil {
+		return nil, err
+	}
+	if msg.ReceiverID != userID {
+		return nil, errno.AuthNoOperatePermission.WithMessage("only the receiver can mark the message read")
+	}
+	return s.MarkConversationRead(ctx, userID, msg.SenderID, 0, msg.Seq)
+}
+
+// GetUnreadCounts 获取各会话的未读数和总数，私信在前，同类按ID升序
+func (s *SocialService) GetUnreadCounts(ctx context.Context, userID int64) ([]*model.UnreadCount, int64, error) {
+	private, err := s.db.GetPrivateUnreadCounts(ctx, userID)
+	if err != nil {
+		return nil, 0, err
+	}
+	group, err := s.db.GetGroupUnreadCounts(ctx, userID)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	var total int64
+	counts := make([]*model.UnreadCount, 0, len(private)+len(group))
+	for peerID, n := range private {
+		counts = append(counts, &model.UnreadCount{PeerID: peerID, Count: n})
+		total += n
+	}
+	for roomID, n := range group {
+		counts = append(counts, &model.UnreadCount{RoomID: roomID, Count: n})
+		total += n
+	}
+	sort.Slice(counts, func(i, j int) bool {
+		if (counts[i].RoomID == 0) != (counts[j].RoomID == 0) {
+			return counts[i].RoomID == 0
+		}
+		return counts[i].PeerID+counts[i].RoomID < counts[j].PeerID+counts[j].RoomID
+	})
+	return counts, total, nil
+}
@@ -0,0 +1,131 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/smartystreets/goconvey/convey"
+	"github.com/stretchr/testify/mock"
+	"github.com/yxrxy/videoHub/app/social/domain/model"
+)
+
+func TestSocialService_MarkConversationRead(t *testing.T) {
+	type TestCase struct {
+		Name            string
+		PeerID          int64
+		RoomID          int64
+		Seq             int64
+		Member          *model.ChatRoomMember
+		ExpectedError   bool
+		ExpectedReadSeq int64
+	}
+
+	const userID, latest = int64(1), int64(20)
+	testCases := []TestCase{
+		{
+			Name:            "私信读到指定序号",
+			PeerID:          2,
+			Seq:             5,
+			ExpectedReadSeq: 5,
+		},
+		{
+			Name:            "序号为0时读到最新",
+			PeerID:          2,
+			ExpectedReadSeq: latest,
+		},
+		{
+			Name:            "序号超过会话最大序号时读到最新",
+			PeerID:          2,
+			Seq:             latest + 10,
+			ExpectedReadSeq: latest,
+		},
+		{
+			Name:            "群聊成员标记已读",
+			RoomID:          10,
+			Seq:             3,
+			Member:          member(10, userID, model.ChatRoomRoleMember),
+			ExpectedReadSeq: 3,
+		},
+		{
+			Name:          "非成员不能标记群聊已读",
+			RoomID:        10,
+			ExpectedError: true,
+		},
+		{
+			Name:          "私信与群聊同时指定",
+			PeerID:        2,
+			RoomID:        10,
+			ExpectedError: true,
+		},
+		{
+			Name:          "不能标记与自己的会话",
+			PeerID:        userID,
+			ExpectedError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		convey.Convey(tc.Name, t, func() {
+			ctx := context.Background()
+			db := new(MockDB)
+			db.On("GetChatRoomMember", ctx, tc.RoomID, userID).Return(tc.Member, nil)
+			db.On("GetConversationSeq", ctx, mock.Anything).Return(latest, nil)
+			db.On("MarkConversationRead", ctx, mock.Anything).Return(tc.ExpectedReadSeq, nil)
+
+			svc := NewSocialService(db, new(MockCache), new(MockPrivacy), new(MockNotificationMQ), new(MockElastic))
+			cursor, err := svc.MarkConversationRead(ctx, userID, tc.PeerID, tc.RoomID, tc.Seq)
+
+			if tc.ExpectedError {
+				convey.So(err, convey.ShouldNotBeNil)
+				db.AssertNotCalled(t, "MarkConversationRead", ctx, mock.Anything)
+				return
+			}
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(cursor.ReadSeq, convey.ShouldEqual, tc.ExpectedReadSeq)
+			db.AssertCalled(t, "MarkConversationRead", ctx, &model.ReadCursor{
+				UserID:  userID,
+				PeerID:  tc.PeerID,
+				RoomID:  tc.RoomID,
+				ReadSeq: tc.ExpectedReadSeq,
+			})
+		})
+	}
+}
+
+func TestSocialService_MarkMessageRead(t *testing.T) {
+	convey.Convey("只有接收者可以标记私信已读", t, func() {
+		ctx := context.Background()
+		db := new(MockDB)
+		db.On("GetPrivateMessage", ctx, int64(7)).Return(&model.PrivateMessage{ID: 7, SenderID: 2, ReceiverID: 1, Seq: 4}, nil)
+		db.On("GetConversationSeq", ctx, model.PrivateConversationKey(1, 2)).Return(int64(9), nil)
+		db.On("MarkConversationRead", ctx, mock.Anything).Return(int64(4), nil)
+
+		svc := NewSocialService(db, new(MockCache), new(MockPrivacy), new(MockNotificationMQ), new(MockElastic))
+		cursor, err := svc.MarkMessageRead(ctx, 7, 1)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(cursor.PeerID, convey.ShouldEqual, 2)
+		convey.So(cursor.ReadSeq, convey.ShouldEqual, 4)
+
+		_, err = svc.MarkMessageRead(ctx, 7, 2)
+		convey.So(err, convey.ShouldNotBeNil)
+	})
+}
+
+func TestSocialService_GetUnreadCounts(t *testing.T) {
+	convey.Convey("合并私信与群聊未读数", t, func() {
+		ctx := context.Background()
+		db := new(MockDB)
+		db.On("GetPrivateUnreadCounts", ctx, int64(1)).Return(map[int64]int64{3: 2, 2: 1}, nil)
+		db.On("GetGroupUnreadCounts", ctx, int64(1)).Return(map[int64]int64{10: 5}, nil)
+
+		svc := NewSocialService(db, new(MockCache), new(MockPrivacy), new(MockNotificationMQ), new(MockElastic))
+		counts, total, err := svc.GetUnreadCounts(ctx, 1)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(total, convey.ShouldEqual, 8)
+		convey.So(counts, convey.ShouldResemble, []*model.UnreadCount{
+			{PeerID: 2, Count: 1},
+			{PeerID: 3, Count: 2},
+			{RoomID: 10, Count: 5},
+		})
+	})
+}
@@ -0,0 +1,182 @@
+package service
+
+import (
+	"context"
+	"log"
+	"sort"
+	"time"
+
+	"github.com/yxrxy/videoHub/app/social/domain/model"
+	"github.com/yxrxy/videoHub/config"
+	"github.com/yxrxy/videoHub/pkg/constants"
+)
+
+func recommendRefreshPeriod() time.Duration {
+	if config.Social != nil && config.Social.Recommend.RefreshInterval > 0 {
+		return time.Duration(config.Social.Recommend.RefreshInterval) * time.Second
+	}
+	return constants.DefaultRecommendRefreshPeriod
+}
+
+func recommendSize() int {
+	if config.Social != nil && config.Social.Recommend.Size > 0 {
+		return config.Social.Recommend.Size
+	}
+	return constants.DefaultRecommendSize
+}
+
+// GetRecommendations 获取好友推荐，优先读取定时任务算好的缓存，未命中时现算并回填
+func (s *SocialService) GetRecommendations(ctx context.Context, userID int64, limit int) ([]*model.Recommendation, error) {
+	if limit <= 0 {
+		limit = constants.RecommendDefaultLimit
+	}
+	limit = min(limit, constants.RecommendMaxLimit)
+	if err := s.cache.TouchRecommendUser(ctx, userID); err != nil {
+		log.Printf("记录推荐活跃用户失败: %v", err)
+	}
+
+	recommendations, ok, err := s.cache.GetRecommendations(ctx, userID)
+	if err != nil {
+		log.Printf("读取推荐缓存失败: %v", err)
+	}
+	if !ok {
+		if recommendations, err = s.RefreshRecommendations(ctx, userID); err != nil {
+			return nil, err
+		}
+	} else if recommendations, err = s.excludeRecommendations(ctx, userID, recommendations); err != nil {
+		// 缓存生成后可能已加好友、关注或拉黑，返回前重新过滤
+		return nil, err
+	}
+	return recommendations[:min(len(recommendations), limit)], nil
+}
+
+// RefreshRecommendations 重新计算用户的推荐列表并写入缓存，写缓存失败只记录日志
+func (s *SocialService) RefreshRecommendations(ctx context.Context, userID int64) ([]*model.Recommendation, error) {
+	recommendations, err := s.ComputeRecommendations(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.cache.SetRecommendations(ctx, userID, recommendations); err != nil {
+		log.Printf("写入推荐缓存失败: %v", err)
+	}
+	return recommendations, nil
+}
+
+// ComputeRecommendations 按共同好友、共同关注、共同评论和共同点赞加权打分，
+// 排除本人、已是好友或已关注的用户以及存在拉黑关系的用户
+func (s *SocialService) ComputeRecommendations(ctx context.Context, userID int64) ([]*model.Recommendation, error) {
+	signals := []struct {
+		fetch  func(ctx context.Context, userID int64, limit int) (map[int64]int64, error)
+		weight float64
+		field  func(r *model.Recommendation) *int64
+	}{
+		{s.db.GetMutualFriendCandidates, constants.RecommendWeightMutualFriend, func(r *model.Recommendation) *int64 { return &r.MutualFriends }},
+		{s.db.GetMutualFollowCandidates, constants.RecommendWeightMutualFollow, func(r *model.Recommendation) *int64 { return &r.MutualFollows }},
+		{s.db.GetCoCommentCandidates, constants.RecommendWeightCoComment, func(r *model.Recommendation) *int64 { return &r.CoComments }},
+		{s.db.GetSharedLikeCandidates, constants.RecommendWeightSharedLike, func(r *model.Recommendation) *int64 { return &r.SharedLikes }},
+	}
+
+	candidates := make(map[int64]*model.Recommendation)
+	for _, signal := range signals {
+		counts, err := signal.fetch(ctx, userID, constants.RecommendSignalLimit)
+		if err != nil {
+			return nil, err
+		}
+		for id, count := range counts {
+			if id == userID {
+				continue
+			}
+			r, ok := candidates[id]
+			if !ok {
+				r = &model.Recommendation{UserID: id}
+				candidates[id] = r
+			}
+			*signal.field(r) = count
+			r.Score += signal.weight * float64(count)
+		}
+	}
+
+	recommendations := make([]*model.Recommendation, 0, len(candidates))
+	for _, r := range candidates {
+		recommendations = append(recommendations, r)
+	}
+	recommendations, err := s.excludeRecommendations(ctx, userID, recommendations)
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(recommendations, func(i, j int) bool {
+		if recommendations[i].Score != recommendations[j].Score {
+			return recommendations[i].Score > recommendations[j].Score
+		}
+		return recommendations[i].UserID < recommendations[j].UserID
+	})
+	return recommendations[:min(len(recommendations), recommendSize())], nil
+}
+
+// excludeRecommendations 去掉已是好友、已关注或存在拉黑关系的用户，保持原有顺序
+func (s *SocialService) excludeRecommendations(
+	ctx context.Context, userID int64, recommendations []*model.Recommendation,
+) ([]*model.Recommendation, error) {
+	if len(recommendations) == 0 {
+		return recommendations, nil
+	}
+	ids := make([]int64, len(recommendations))
+	for i, r := range recommendations {
+		ids[i] = r.UserID
+	}
+	connected, err := s.db.GetConnectedUsers(ctx, userID, ids)
+	if err != nil {
+		return nil, err
+	}
+	blocked, err := s.privacy.FilterBlocked(ctx, userID, ids)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]*model.Recommendation, 0, len(recommendations))
+	for _, r := range recommendations {
+		if !connected[r.UserID] && !blocked[r.UserID] {
+			result = append(result, r)
+		}
+	}
+	return result, nil
+}
+
+// RefreshActiveRecommendations 为最近访问过推荐的用户刷新缓存，并清理不再活跃的用户
+func (s *SocialService) RefreshActiveRecommendations(ctx context.Context) error {
+	before := time.Now().Add(-constants.RecommendActiveWindow).Unix()
+	if err := s.cache.RemoveRecommendUsersBefore(ctx, before); err != nil {
+		return err
+	}
+	for offset := int64(0); ; offset += constants.RecommendRefreshBatch {
+		userIDs, err := s.cache.GetRecommendUsers(ctx, offset, constants.RecommendRefreshBatch)
+		if err != nil {
+			return err
+		}
+		for _, userID := range userIDs {
+			if _, err := s.RefreshRecommendations(ctx, userID); err != nil {
+				log.Printf("刷新用户 %d 的好友推荐失败: %v", userID, err)
+			}
+		}
+		if len(userIDs) < constants.RecommendRefreshBatch {
+			return nil
+		}
+	}
+}
+
+// StartRecommendationRefresher 定期刷新活跃用户的好友推荐
+func (s *SocialService) StartRecommendationRefresher(ctx context.Context) {
+	ticker := time.NewTicker(recommendRefreshPeriod())
+	go func() {
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				if err := s.RefreshActiveRecommendations(ctx); err != nil {
+					log.Printf("刷新好友推荐失败: %v", err)
+				}
+			}
+		}
+	}()
+}
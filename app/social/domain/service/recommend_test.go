@@ -0,0 +1,85 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/smartystreets/goconvey/convey"
+	"github.com/stretchr/testify/mock"
+	"github.com/yxrxy/videoHub/app/social/domain/model"
+	"github.com/yxrxy/videoHub/pkg/constants"
+)
+
+func TestSocialService_ComputeRecommendations(t *testing.T) {
+	convey.Convey("按加权分数排序并排除已建立关系和拉黑的用户", t, func() {
+		ctx := context.Background()
+		const userID = int64(1)
+		limit := constants.RecommendSignalLimit
+		db := new(MockDB)
+		db.On("GetMutualFriendCandidates", ctx, userID, limit).Return(map[int64]int64{2: 1, 3: 2}, nil)
+		db.On("GetMutualFollowCandidates", ctx, userID, limit).Return(map[int64]int64{2: 1, 4: 5}, nil)
+		db.On("GetCoCommentCandidates", ctx, userID, limit).Return(map[int64]int64{5: 1}, nil)
+		db.On("GetSharedLikeCandidates", ctx, userID, limit).Return(map[int64]int64{5: 3, 6: 10, userID: 1}, nil)
+		db.On("GetConnectedUsers", ctx, userID, mock.Anything).Return(map[int64]bool{4: true}, nil)
+		privacy := new(MockPrivacy)
+		privacy.On("FilterBlocked", ctx, userID, mock.Anything).Return(map[int64]bool{6: true}, nil)
+
+		svc := NewSocialService(db, new(MockCache), privacy, new(MockNotificationMQ), new(MockElastic))
+		recommendations, err := svc.ComputeRecommendations(ctx, userID)
+
+		convey.So(err, convey.ShouldBeNil)
+		// 2: 4+3=7，3: 8，5: 2+3=5，4 已关注，6 已拉黑，本人不推荐
+		convey.So(recommendations, convey.ShouldResemble, []*model.Recommendation{
+			{UserID: 3, Score: 8, MutualFriends: 2},
+			{UserID: 2, Score: 7, MutualFriends: 1, MutualFollows: 1},
+			{UserID: 5, Score: 5, CoComments: 1, SharedLikes: 3},
+		})
+	})
+}
+
+func TestSocialService_GetRecommendations(t *testing.T) {
+	const userID = int64(1)
+
+	convey.Convey("命中缓存时重新过滤后返回", t, func() {
+		ctx := context.Background()
+		cached := []*model.Recommendation{{UserID: 2, Score: 8}, {UserID: 3, Score: 7}, {UserID: 4, Score: 6}}
+		cache := new(MockCache)
+		cache.On("TouchRecommendUser", ctx, userID).Return(nil)
+		cache.On("GetRecommendations", ctx, userID).Return(cached, true, nil)
+		db := new(MockDB)
+		db.On("GetConnectedUsers", ctx, userID, []int64{2, 3, 4}).Return(map[int64]bool{2: true}, nil)
+		privacy := new(MockPrivacy)
+		privacy.On("FilterBlocked", ctx, userID, []int64{2, 3, 4}).Return(map[int64]bool{}, nil)
+
+		svc := NewSocialService(db, cache, privacy, new(MockNotificationMQ), new(MockElastic))
+		recommendations, err := svc.GetRecommendations(ctx, userID, 1)
+
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(recommendations, convey.ShouldHaveLength, 1)
+		convey.So(recommendations[0].UserID, convey.ShouldEqual, 3)
+		db.AssertNotCalled(t, "GetMutualFriendCandidates", ctx, userID, mock.Anything)
+	})
+
+	convey.Convey("未命中缓存时现算并回填", t, func() {
+		ctx := context.Background()
+		cache := new(MockCache)
+		cache.On("TouchRecommendUser", ctx, userID).Return(nil)
+		cache.On("GetRecommendations", ctx, userID).Return(nil, false, nil)
+		cache.On("SetRecommendations", ctx, userID, mock.Anything).Return(nil)
+		db := new(MockDB)
+		db.On("GetMutualFriendCandidates", ctx, userID, mock.Anything).Return(map[int64]int64{2: 1}, nil)
+		db.On("GetMutualFollowCandidates", ctx, userID, mock.Anything).Return(map[int64]int64{}, nil)
+		db.On("GetCoCommentCandidates", ctx, userID, mock.Anything).Return(map[int64]int64{}, nil)
+		db.On("GetSharedLikeCandidates", ctx, userID, mock.Anything).Return(map[int64]int64{}, nil)
+		db.On("GetConnectedUsers", ctx, userID, []int64{2}).Return(map[int64]bool{}, nil)
+		privacy := new(MockPrivacy)
+		privacy.On("FilterBlocked", ctx, userID, []int64{2}).Return(map[int64]bool{}, nil)
+
+		svc := NewSocialService(db, cache, privacy, new(MockNotificationMQ), new(MockElastic))
+		recommendations, err := svc.GetRecommendations(ctx, userID, 0)
+
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(recommendations, convey.ShouldHaveLength, 1)
+		cache.AssertCalled(t, "SetRecommendations", ctx, userID, mock.Anything)
+	})
+}
@@ -0,0 +1,198 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+
+	"github.com/yxrxy/videoHub/app/social/domain/model"
+	"github.com/yxrxy/videoHub/config"
+	"github.com/yxrxy/videoHub/pkg/constants"
+	"github.com/yxrxy/videoHub/pkg/errno"
+)
+
+// ErrNotChatRoomMember 当前登录用户不是聊天室成员
+var ErrNotChatRoomMember = errno.AuthNoOperatePermission.WithMessage("not a member of the room")
+
+// RequireChatRoomMember 校验用户是聊天室成员，发送、读取聊天室消息前调用
+func (s *SocialService) RequireChatRoomMember(ctx context.Context, roomID, userID int64) (*model.ChatRoomMember, error) {
+	member, err := s.db.GetChatRoomMember(ctx, roomID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if member == nil {
+		return nil, ErrNotChatRoomMember
+	}
+	return member, nil
+}
+
+// maxGroupMembers 群聊成员上限，未配置时使用默认值
+func maxGroupMembers() int64 {
+	if config.Social != nil && config.Social.Chat.MaxGroupMembers > 0 {
+		return int64(config.Social.Chat.MaxGroupMembers)
+	}
+	return constants.DefaultMaxGroupMembers
+}
+
+// maxGroupsPerUser 每个用户最多加入的群聊数，未配置时使用默认值
+func maxGroupsPerUser() int64 {
+	if config.Social != nil && config.Social.Chat.MaxGroupsPerUser > 0 {
+		return int64(config.Social.Chat.MaxGroupsPerUser)
+	}
+	return constants.DefaultMaxGroupsPerUser
+}
+
+// checkGroupQuota 校验群聊新增 userIDs 后不超过成员上限，且这些用户加入的群聊数未达上限
+func (s *SocialService) checkGroupQuota(ctx context.Context, current int64, userIDs []int64) error {
+	if current+int64(len(userIDs)) > maxGroupMembers() {
+		return errno.ParamVerifyError.WithMessage(fmt.Sprintf("room members cannot exceed %d", maxGroupMembers()))
+	}
+	counts, err := s.db.CountUserGroupRooms(ctx, userIDs)
+	if err != nil {
+		return err
+	}
+	for _, id := range userIDs {
+		if counts[id] >= maxGroupsPerUser() {
+			return errno.ParamVerifyError.WithMessage(fmt.Sprintf("user %d cannot join more than %d groups", id, maxGroupsPerUser()))
+		}
+	}
+	return nil
+}
+
+// emitRoomEvent 写入群管理系统消息，变更已生效，写入失败只记录日志
+func (s *SocialService) emitRoomEvent(ctx context.Context, roomID int64, event *model.RoomEvent) *model.ChatMessage {
+	content, err := json.Marshal(event)
+	if err != nil {
+		log.Printf("系统消息序列化失败: %v", err)
+		return nil
+	}
+	msg := &model.ChatMessage{
+		RoomID:   roomID,
+		SenderID: event.OperatorID,
+		Content:  string(content),
+		Type:     model.MessageTypeSystem,
+	}
+	if err := s.db.SendChatMessage(ctx, msg); err != nil {
+		log.Printf("写入系统消息失败: %v", err)
+		return nil
+	}
+	return msg
+}
+
+// AddChatRoomMembers 管理员或群主添加成员，返回新加入的用户ID和系统消息
+func (s *SocialService) AddChatRoomMembers(ctx context.Context, operatorID, roomID int64, userIDs []int64) ([]int64, *model.ChatMessage, error) {
+	operator, err := s.RequireChatRoomMember(ctx, roomID, operatorID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if operator.Role < model.ChatRoomRoleAdmin {
+		return nil, nil, errno.AuthNoOperatePermission.WithMessage("only admins can add members")
+	}
+	room, err := s.db.GetChatRoom(ctx, roomID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if room.Type != model.ChatRoomTypeGroup {
+		return nil, nil, errno.ParamVerifyError.WithMessage("members can only be added to group rooms")
+	}
+
+	candidates := make([]int64, 0, len(userIDs))
+	seen := make(map[int64]bool, len(userIDs))
+	for _, id := range userIDs {
+		if id <= 0 {
+			return nil, nil, errno.ParamVerifyError.WithMessage("invalid member id")
+		}
+		if !seen[id] {
+			seen[id] = true
+			candidates = append(candidates, id)
+		}
+	}
+	if len(candidates) == 0 {
+		return nil, nil, errno.ParamVerifyError.WithMessage("member_ids is empty")
+	}
+
+	existing, err := s.db.GetChatRoomMemberIDs(ctx, roomID, candidates)
+	if err != nil {
+		return nil, nil, err
+	}
+	for _, id := range existing {
+		seen[id] = false
+	}
+	added := make([]int64, 0, len(candidates))
+	members := make([]model.ChatRoomMember, 0, len(candidates))
+	for _, id := range candidates {
+		if seen[id] {
+			added = append(added, id)
+			members = append(members, model.ChatRoomMember{RoomID: roomID, UserID: id, Role: model.ChatRoomRoleMember})
+		}
+	}
+	if len(added) == 0 {
+		return added, nil, nil
+	}
+
+	current, err := s.db.CountChatRoomMembers(ctx, roomID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := s.checkGroupQuota(ctx, current, added); err != nil {
+		return nil, nil, err
+	}
+	if err := s.db.AddChatRoomMembers(ctx, members); err != nil {
+		return nil, nil, err
+	}
+	msg := s.emitRoomEvent(ctx, roomID, &model.RoomEvent{
+		Event:      model.RoomEventMemberAdded,
+		OperatorID: operatorID,
+		UserIDs:    added,
+	})
+	return added, msg, nil
+}
+
+// RemoveChatRoomMember 移除成员，操作者角色需高于被移除的成员
+func (s *SocialService) RemoveChatRoomMember(ctx context.Context, operatorID, roomID, userID int64) (*model.ChatMessage, error) {
+	if operatorID == userID {
+		return nil, errno.ParamVerifyError.WithMessage("use leave to remove yourself")
+	}
+	operator, err := s.RequireChatRoomMember(ctx, roomID, operatorID)
+	if err != nil {
+		return nil, err
+	}
+	target, err := s.db.GetChatRoomMember(ctx, roomID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if target == nil {
+		return nil, errno.ParamVerifyError.WithMessage("user is not a member of the room")
+	}
+	if operator.Role <= target.Role {
+		return nil, errno.AuthNoOperatePermission.WithMessage("cannot remove a member with the same or higher role")
+	}
+	if err := s.db.RemoveChatRoomMember(ctx, roomID, userID); err != nil {
+		return nil, err
+	}
+	return s.emitRoomEvent(ctx, roomID, &model.RoomEvent{
+		Event:      model.RoomEventMemberRemoved,
+		OperatorID: operatorID,
+		UserIDs:    []int64{userID},
+	}), nil
+}
+
+// LeaveChatRoom 成员退出聊天室，群主需先转让群主或解散聊天室
+func (s *SocialService) LeaveChatRoom(ctx context.Context, userID, roomID int64) (*model.ChatMessage, error) {
+	member, err := s.RequireChatRoomMember(ctx, roomID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if member.Role == model.ChatRoomRoleCreator {
+		return nil, errno.AuthNoOperatePermission.WithMessage("the owner must transfer or disband the room before leaving")
+	}
+	if err := s.db.RemoveChatRoomMember(ctx, roomID, userID); err != nil {
+		return nil, err
+	}
+	return s.emitRoomEvent(ctx, roomID, &model.RoomEvent{
+		Event:      model.RoomEventMemberLeft,
+		OperatorID: userID,
+		UserIDs:    []int64{userID},
+	}), nil
+}
@@ -0,0 +1,188 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/smartystreets/goconvey/convey"
+	"github.com/stretchr/testify/mock"
+	"github.com/yxrxy/videoHub/app/social/domain/model"
+	"github.com/yxrxy/videoHub/pkg/constants"
+)
+
+func member(roomID, userID int64, role int8) *model.ChatRoomMember {
+	return &model.ChatRoomMember{RoomID: roomID, UserID: userID, Role: role}
+}
+
+func TestSocialService_RequireChatRoomMember(t *testing.T) {
+	convey.Convey("成员通过校验", t, func() {
+		ctx := context.Background()
+		db := new(MockDB)
+		db.On("GetChatRoomMember", ctx, int64(10), int64(1)).Return(member(10, 1, model.ChatRoomRoleMember), nil)
+
+		svc := NewSocialService(db, new(MockCache), new(MockPrivacy), new(MockNotificationMQ), new(MockElastic))
+		m, err := svc.RequireChatRoomMember(ctx, 10, 1)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(m.UserID, convey.ShouldEqual, 1)
+	})
+
+	convey.Convey("非成员不能查看聊天室和读取消息", t, func() {
+		ctx := context.Background()
+		db := new(MockDB)
+		db.On("GetChatRoomMember", ctx, int64(10), int64(2)).Return(nil, nil)
+
+		svc := NewSocialService(db, new(MockCache), new(MockPrivacy), new(MockNotificationMQ), new(MockElastic))
+		m, err := svc.RequireChatRoomMember(ctx, 10, 2)
+		convey.So(err, convey.ShouldResemble, ErrNotChatRoomMember)
+		convey.So(m, convey.ShouldBeNil)
+	})
+}
+
+func TestSocialService_AddChatRoomMembers(t *testing.T) {
+	type TestCase struct {
+		Name          string
+		OperatorRole  int8
+		MemberIDs     []int64
+		Existing      []int64
+		Current       int64           // 聊天室当前成员数
+		Joined        map[int64]int64 // 用户已加入的群聊数
+		ExpectedError bool
+		ExpectedAdded []int64
+	}
+
+	const roomID, operatorID = int64(10), int64(1)
+	testCases := []TestCase{
+		{
+			Name:          "管理员添加成员，已是成员的用户和重复ID忽略",
+			OperatorRole:  model.ChatRoomRoleAdmin,
+			MemberIDs:     []int64{2, 3, 3, 4},
+			Existing:      []int64{3},
+			ExpectedAdded: []int64{2, 4},
+		},
+		{
+			Name:          "普通成员不能添加成员",
+			OperatorRole:  model.ChatRoomRoleMember,
+			MemberIDs:     []int64{2},
+			ExpectedError: true,
+		},
+		{
+			Name:          "超过群成员上限",
+			OperatorRole:  model.ChatRoomRoleAdmin,
+			MemberIDs:     []int64{2, 4},
+			Current:       constants.DefaultMaxGroupMembers - 1,
+			ExpectedError: true,
+		},
+		{
+			Name:          "用户加入的群聊数已达上限",
+			OperatorRole:  model.ChatRoomRoleAdmin,
+			MemberIDs:     []int64{2, 4},
+			Joined:        map[int64]int64{4: constants.DefaultMaxGroupsPerUser},
+			ExpectedError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		convey.Convey(tc.Name, t, func() {
+			ctx := context.Background()
+			db := new(MockDB)
+			db.On("GetChatRoomMember", ctx, roomID, operatorID).Return(member(roomID, operatorID, tc.OperatorRole), nil)
+			db.On("GetChatRoom", ctx, roomID).Return(&model.ChatRoom{ID: roomID, Type: model.ChatRoomTypeGroup}, nil)
+			db.On("GetChatRoomMemberIDs", ctx, roomID, mock.Anything).Return(tc.Existing, nil)
+			db.On("AddChatRoomMembers", ctx, mock.Anything).Return(nil)
+			db.On("CountChatRoomMembers", ctx, roomID).Return(tc.Current, nil)
+			db.On("CountUserGroupRooms", ctx, mock.Anything).Return(tc.Joined, nil)
+			db.On("SendChatMessage", ctx, mock.Anything).Return(nil)
+
+			svc := NewSocialService(db, new(MockCache), new(MockPrivacy), new(MockNotificationMQ), new(MockElastic))
+			added, msg, err := svc.AddChatRoomMembers(ctx, operatorID, roomID, tc.MemberIDs)
+
+			if tc.ExpectedError {
+				convey.So(err, convey.ShouldNotBeNil)
+				db.AssertNotCalled(t, "AddChatRoomMembers", ctx, mock.Anything)
+				return
+			}
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(added, convey.ShouldResemble, tc.ExpectedAdded)
+			convey.So(msg.Type, convey.ShouldEqual, model.MessageTypeSystem)
+			convey.So(msg.Content, convey.ShouldContainSubstring, model.RoomEventMemberAdded)
+			db.AssertCalled(t, "AddChatRoomMembers", ctx, []model.ChatRoomMember{
+				{RoomID: roomID, UserID: 2, Role: model.ChatRoomRoleMember},
+				{RoomID: roomID, UserID: 4, Role: model.ChatRoomRoleMember},
+			})
+		})
+	}
+}
+
+func TestSocialService_RemoveChatRoomMember(t *testing.T) {
+	type TestCase struct {
+		Name          string
+		OperatorRole  int8
+		Target        *model.ChatRoomMember
+		ExpectedError bool
+	}
+
+	const roomID, operatorID, userID = int64(10), int64(1), int64(2)
+	testCases := []TestCase{
+		{
+			Name:         "群主移除管理员",
+			OperatorRole: model.ChatRoomRoleCreator,
+			Target:       member(roomID, userID, model.ChatRoomRoleAdmin),
+		},
+		{
+			Name:          "管理员不能移除管理员",
+			OperatorRole:  model.ChatRoomRoleAdmin,
+			Target:        member(roomID, userID, model.ChatRoomRoleAdmin),
+			ExpectedError: true,
+		},
+		{
+			Name:          "被移除的用户不是成员",
+			OperatorRole:  model.ChatRoomRoleCreator,
+			ExpectedError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		convey.Convey(tc.Name, t, func() {
+			ctx := context.Background()
+			db := new(MockDB)
+			db.On("GetChatRoomMember", ctx, roomID, operatorID).Return(member(roomID, operatorID, tc.OperatorRole), nil)
+			db.On("GetChatRoomMember", ctx, roomID, userID).Return(tc.Target, nil)
+			db.On("RemoveChatRoomMember", ctx, roomID, userID).Return(nil)
+			db.On("SendChatMessage", ctx, mock.Anything).Return(nil)
+
+			svc := NewSocialService(db, new(MockCache), new(MockPrivacy), new(MockNotificationMQ), new(MockElastic))
+			_, err := svc.RemoveChatRoomMember(ctx, operatorID, roomID, userID)
+
+			if tc.ExpectedError {
+				convey.So(err, convey.ShouldNotBeNil)
+				db.AssertNotCalled(t, "RemoveChatRoomMember", ctx, roomID, userID)
+			} else {
+				convey.So(err, convey.ShouldBeNil)
+				db.AssertCalled(t, "RemoveChatRoomMember", ctx, roomID, userID)
+			}
+		})
+	}
+}
+
+func TestSocialService_LeaveChatRoom(t *testing.T) {
+	convey.Convey("群主不能直接退出聊天室", t, func() {
+		ctx := context.Background()
+		db := new(MockDB)
+		db.On("GetChatRoomMember", ctx, int64(10), int64(1)).Return(member(10, 1, model.ChatRoomRoleCreator), nil)
+
+		svc := NewSocialService(db, new(MockCache), new(MockPrivacy), new(MockNotificationMQ), new(MockElastic))
+		_, err := svc.LeaveChatRoom(ctx, 1, 10)
+		convey.So(err, convey.ShouldNotBeNil)
+		db.AssertNotCalled(t, "RemoveChatRoomMember", ctx, int64(10), int64(1))
+	})
+
+	convey.Convey("非成员不能退出聊天室", t, func() {
+		ctx := context.Background()
+		db := new(MockDB)
+		db.On("GetChatRoomMember", ctx, int64(10), int64(1)).Return(nil, nil)
+
+		svc := NewSocialService(db, new(MockCache), new(MockPrivacy), new(MockNotificationMQ), new(MockElastic))
+		_, err := svc.LeaveChatRoom(ctx, 1, 10)
+		convey.So(err, convey.ShouldNotBeNil)
+	})
+}
@@ -0,0 +1,85 @@
+package service
+
+import (
+	"context"
+	"log"
+
+	"github.com/bytedance/sonic"
+	"github.com/yxrxy/videoHub/app/social/domain/model"
+	"github.com/yxrxy/videoHub/pkg/constants"
+	"github.com/yxrxy/videoHub/pkg/errno"
+)
+
+// IndexChatMessage 投递聊天消息的最新状态以更新搜索索引，系统消息不索引，失败只记录日志
+func (s *SocialService) IndexChatMessage(ctx context.Context, msg *model.ChatMessage) {
+	if msg.Type == model.MessageTypeSystem {
+		return
+	}
+	s.publishMessageIndex(ctx, model.ChatMessageDocument(msg))
+}
+
+func (s *SocialService) publishMessageIndex(ctx context.Context, doc *model.MessageDocument) {
+	if err := s.mq.SendMessageIndexEvent(ctx, doc); err != nil {
+		log.Printf("投递消息索引事件失败: %v", err)
+	}
+}
+
+// ConsumeMessageIndexEvents 消费消息索引事件并写入搜索索引，索引不存在时先创建
+func (s *SocialService) ConsumeMessageIndexEvents(ctx context.Context) {
+	if !s.es.IsExist(ctx, constants.MessageIndexName) {
+		if err := s.es.CreateIndex(ctx, constants.MessageIndexName); err != nil {
+			log.Printf("创建聊天记录索引失败: %v", err)
+		}
+	}
+	msgCh := s.mq.ConsumeMessageIndexEvents(ctx)
+	go func() {
+		for msg := range msgCh {
+			doc := new(model.MessageDocument)
+			if err := sonic.Unmarshal(msg.V, doc); err != nil {
+				log.Printf("解析消息索引事件失败: %v", err)
+				continue
+			}
+			if err := s.es.IndexMessage(ctx, constants.MessageIndexName, doc); err != nil {
+				log.Printf("写入聊天记录索引失败: %v", err)
+			}
+		}
+	}()
+}
+
+// SearchMessages 搜索本人的私信和当前所在聊天室的聊天记录，已撤回的消息不返回
+func (s *SocialService) SearchMessages(ctx context.Context, query *model.MessageSearchQuery) ([]*model.MessageDocument, int64, error) {
+	if query.PeerID != 0 && query.RoomID != 0 {
+		return nil, 0, errno.ParamVerifyError.WithMessage("peer_id and room_id cannot both be set")
+	}
+	if len([]rune(query.Keyword)) > constants.MessageSearchMaxKeywordLen {
+		return nil, 0, errno.ParamVerifyError.WithMessage("keyword too long")
+	}
+	if query.FromDate < 0 || query.ToDate < 0 || (query.ToDate != 0 && query.FromDate > query.ToDate) {
+		return nil, 0, errno.ParamVerifyError.WithMessage("invalid date range")
+	}
+	if query.Page <= 0 {
+		query.Page = constants.DefaultPage
+	}
+	if query.Size <= 0 {
+		query.Size = constants.DefaultPageSize
+	}
+	query.Size = min(query.Size, constants.MaxPageSize)
+
+	switch {
+	case query.RoomID != 0:
+		if _, err := s.RequireChatRoomMember(ctx, query.RoomID, query.UserID); err != nil {
+			return nil, 0, err
+		}
+		query.RoomIDs = []int64{query.RoomID}
+	case query.PeerID == 0:
+		rooms, err := s.db.GetUserChatRooms(ctx, query.UserID)
+		if err != nil {
+			return nil, 0, err
+		}
+		query.RoomIDs = make([]int64, len(rooms))
+		for i, room := range rooms {
+			query.RoomIDs[i] = room.ID
+		}
+	}
+	return s.es.SearchMessages(ctx, constants.MessageIndexName, query)
+}
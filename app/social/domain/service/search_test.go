@@ -0,0 +1,103 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/smartystreets/goconvey/convey"
+	"github.com/stretchr/testify/mock"
+	"github.com/yxrxy/videoHub/app/social/domain/model"
+	"github.com/yxrxy/videoHub/pkg/constants"
+)
+
+func TestSocialService_SearchMessages(t *testing.T) {
+	type TestCase struct {
+		Name            string
+		Query           *model.MessageSearchQuery
+		Member          bool
+		ExpectedRoomIDs []int64
+		ExpectedError   bool
+	}
+
+	const userID, roomID = int64(1), int64(10)
+	testCases := []TestCase{
+		{
+			Name:            "默认搜索私信和所在的全部聊天室",
+			Query:           &model.MessageSearchQuery{UserID: userID, Keyword: "hello"},
+			ExpectedRoomIDs: []int64{10, 11},
+		},
+		{
+			Name:            "成员搜索指定聊天室",
+			Query:           &model.MessageSearchQuery{UserID: userID, RoomID: roomID},
+			Member:          true,
+			ExpectedRoomIDs: []int64{roomID},
+		},
+		{
+			Name:          "非成员不能搜索聊天室",
+			Query:         &model.MessageSearchQuery{UserID: userID, RoomID: roomID},
+			ExpectedError: true,
+		},
+		{
+			Name:  "搜索与某人的私信不限定聊天室",
+			Query: &model.MessageSearchQuery{UserID: userID, PeerID: 2},
+		},
+		{
+			Name:          "私信对象与聊天室不能同时指定",
+			Query:         &model.MessageSearchQuery{UserID: userID, PeerID: 2, RoomID: roomID},
+			ExpectedError: true,
+		},
+		{
+			Name:          "起始时间晚于截止时间",
+			Query:         &model.MessageSearchQuery{UserID: userID, FromDate: 200, ToDate: 100},
+			ExpectedError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		convey.Convey(tc.Name, t, func() {
+			ctx := context.Background()
+			db := new(MockDB)
+			db.On("GetUserChatRooms", ctx, userID).Return([]model.ChatRoom{{ID: 10}, {ID: 11}}, nil)
+			var roomMember *model.ChatRoomMember
+			if tc.Member {
+				roomMember = member(roomID, userID, model.ChatRoomRoleMember)
+			}
+			db.On("GetChatRoomMember", ctx, roomID, userID).Return(roomMember, nil)
+			es := new(MockElastic)
+			es.On("SearchMessages", ctx, constants.MessageIndexName, mock.Anything).
+				Return([]*model.MessageDocument{{ID: 5, RoomID: roomID, SenderID: 3}}, int64(1), nil)
+
+			svc := NewSocialService(db, new(MockCache), new(MockPrivacy), new(MockNotificationMQ), es)
+			docs, total, err := svc.SearchMessages(ctx, tc.Query)
+
+			if tc.ExpectedError {
+				convey.So(err, convey.ShouldNotBeNil)
+				es.AssertNotCalled(t, "SearchMessages", ctx, constants.MessageIndexName, mock.Anything)
+				return
+			}
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(docs, convey.ShouldHaveLength, 1)
+			convey.So(total, convey.ShouldEqual, 1)
+			convey.So(tc.Query.RoomIDs, convey.ShouldResemble, tc.ExpectedRoomIDs)
+			convey.So(tc.Query.Page, convey.ShouldEqual, constants.DefaultPage)
+			convey.So(tc.Query.Size, convey.ShouldEqual, constants.DefaultPageSize)
+		})
+	}
+}
+
+func TestChatMessageDocument(t *testing.T) {
+	convey.Convey("附件按文件名检索，撤回后不可检索", t, func() {
+		doc := model.ChatMessageDocument(&model.ChatMessage{
+			ID:      5,
+			RoomID:  10,
+			Type:    model.MessageTypeFile,
+			Content: `{"url":"/chat/a.pdf","name":"report.pdf"}`,
+		})
+		convey.So(doc.SearchText, convey.ShouldEqual, "report.pdf")
+		convey.So(doc.DocID(), convey.ShouldEqual, "group:5")
+
+		doc = model.ChatMessageDocument(&model.ChatMessage{ID: 5, RoomID: 10, Recalled: true})
+		convey.So(doc.Recalled, convey.ShouldBeTrue)
+		convey.So(doc.SearchText, convey.ShouldBeEmpty)
+	})
+}
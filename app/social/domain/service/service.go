@@ -4,23 +4,46 @@ import (
 	"context"
 
 	"github.com/yxrxy/videoHub/app/social/domain/model"
+	"github.com/yxrxy/videoHub/pkg/constants"
+	"github.com/yxrxy/videoHub/pkg/errno"
 )
 
-func (s *SocialService) SavePrivateMessage(ctx context.Context, senderID, receiverID int64, content string) error {
+// SavePrivateMessage 保存私信，发送前校验拉黑关系和接收方的私信权限，replyToID 为 0 表示不是回复
+func (s *SocialService) SavePrivateMessage(ctx context.Context, senderID, receiverID int64, content string, replyToID int64) (*model.PrivateMessage, error) {
+	if err := s.privacy.CheckDirectMessage(ctx, senderID, receiverID); err != nil {
+		return nil, err
+	}
+	if err := s.validatePrivateReply(ctx, senderID, receiverID, replyToID); err != nil {
+		return nil, err
+	}
 	msg := &model.PrivateMessage{
 		SenderID:   senderID,
 		ReceiverID: receiverID,
 		Content:    content,
 		IsRead:     false,
+		ReplyToID:  replyToID,
 	}
 	if err := s.db.SendPrivateMessage(ctx, msg); err != nil {
-		return err
+		return nil, err
+	}
+	s.publishMessageIndex(ctx, model.PrivateMessageDocument(msg))
+	return msg, nil
+}
+
+// CanDirectMessage 判断 senderID 能否私信 receiverID，存在拉黑关系或对方不接收私信时返回 false
+func (s *SocialService) CanDirectMessage(ctx context.Context, senderID, receiverID int64) (bool, error) {
+	err := s.privacy.CheckDirectMessage(ctx, senderID, receiverID)
+	if err == nil {
+		return true, nil
+	}
+	if errno.ConvertErr(err).ErrorCode == errno.AuthNoOperatePermissionCode {
+		return false, nil
 	}
-	return nil
+	return false, err
 }
 
-// CreateChatRoom 创建聊天室成员
-func (s *SocialService) CreateChatRoom(ctx context.Context, name string, creatorID int64, memberIDs []int64) ([]model.ChatRoomMember, error) {
+// CreateChatRoom 创建聊天室成员，群聊受成员上限和用户入群上限约束
+func (s *SocialService) CreateChatRoom(ctx context.Context, name string, creatorID int64, roomType int8, memberIDs []int64) ([]model.ChatRoomMember, error) {
 	members := make([]model.ChatRoomMember, 0, len(memberIDs)+1)
 	// 添加创建者
 	members = append(members, model.ChatRoomMember{
@@ -29,9 +52,11 @@ func (s *SocialService) CreateChatRoom(ctx context.Context, name string, creator
 		Nickname: name, // 可以从用户服务获取昵称
 	})
 
-	// 添加其他成员
+	// 添加其他成员，重复ID忽略
+	seen := map[int64]bool{creatorID: true}
 	for _, memberID := range memberIDs {
-		if memberID != creatorID {
+		if !seen[memberID] {
+			seen[memberID] = true
 			members = append(members, model.ChatRoomMember{
 				UserID:   memberID,
 				Role:     model.ChatRoomRoleMember,
@@ -40,5 +65,64 @@ func (s *SocialService) CreateChatRoom(ctx context.Context, name string, creator
 		}
 	}
 
+	if roomType == model.ChatRoomTypeGroup {
+		userIDs := make([]int64, 0, len(members))
+		for _, member := range members {
+			userIDs = append(userIDs, member.UserID)
+		}
+		if err := s.checkGroupQuota(ctx, 0, userIDs); err != nil {
+			return nil, err
+		}
+	}
 	return members, nil
 }
+
+// SyncMessages 获取会话中序号大于 afterSeq 的消息，peerID 与 roomID 二选一，群聊仅成员可同步
+func (s *SocialService) SyncMessages(ctx context.Context, userID, peerID, roomID, afterSeq int64, limit int) (*model.SyncResult, error) {
+	if (peerID == 0) == (roomID == 0) {
+		return nil, errno.ParamVerifyError.WithMessage("exactly one of peer_id and room_id is required")
+	}
+	if afterSeq < 0 || peerID == userID {
+		return nil, errno.ParamVerifyError
+	}
+	if limit <= 0 {
+		limit = constants.SyncMessagesDefaultLimit
+	}
+	limit = min(limit, constants.SyncMessagesMaxLimit)
+
+	result := &model.SyncResult{}
+	var err error
+	// 多取一条用于判断是否还有更多消息
+	if peerID != 0 {
+		var messages []model.PrivateMessage
+		if messages, err = s.db.SyncPrivateMessages(ctx, userID, peerID, afterSeq, limit+1); err != nil {
+			return nil, err
+		}
+		result.HasMore = len(messages) > limit
+		messages = messages[:min(len(messages), limit)]
+		for i := range messages {
+			result.PrivateMessages = append(result.PrivateMessages, &messages[i])
+		}
+		result.LatestSeq, err = s.db.GetConversationSeq(ctx, model.PrivateConversationKey(userID, peerID))
+		return result, err
+	}
+
+	isMember, err := s.db.IsChatRoomMember(ctx, roomID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if !isMember {
+		return nil, errno.AuthNoOperatePermission
+	}
+	messages, err := s.db.SyncChatMessages(ctx, roomID, afterSeq, limit+1)
+	if err != nil {
+		return nil, err
+	}
+	result.HasMore = len(messages) > limit
+	messages = messages[:min(len(messages), limit)]
+	for i := range messages {
+		result.ChatMessages = append(result.ChatMessages, &messages[i])
+	}
+	result.LatestSeq, err = s.db.GetConversationSeq(ctx, model.GroupConversationKey(roomID))
+	return result, err
+}
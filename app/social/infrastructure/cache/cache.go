@@ -2,9 +2,16 @@ package cache
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
 
 	"github.com/redis/go-redis/v9"
+	"github.com/yxrxy/videoHub/app/social/domain/model"
 	"github.com/yxrxy/videoHub/app/social/domain/repository"
+	"github.com/yxrxy/videoHub/pkg/constants"
 )
 
 type socialCache struct {
@@ -15,14 +22,130 @@ func NewSocialCache(client *redis.Client) repository.SocialCache {
 	return &socialCache{client: client}
 }
 
+func onlineKey(userID int64) string {
+	return fmt.Sprintf("presence:online:%d", userID)
+}
+
+func lastSeenKey(userID int64) string {
+	return fmt.Sprintf("presence:last_seen:%d", userID)
+}
+
 func (c *socialCache) IsUserOnline(ctx context.Context, userID int64) (bool, error) {
-	return c.client.SIsMember(ctx, "online_users", userID).Result()
+	n, err := c.client.Exists(ctx, onlineKey(userID)).Result()
+	return n > 0, err
+}
+
+func (c *socialCache) SetUserOnline(ctx context.Context, userID, now int64) (bool, error) {
+	pipe := c.client.TxPipeline()
+	added := pipe.SetNX(ctx, onlineKey(userID), now, constants.PresenceTTL)
+	pipe.Expire(ctx, onlineKey(userID), constants.PresenceTTL)
+	pipe.Set(ctx, lastSeenKey(userID), now, 0)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return false, err
+	}
+	return added.Val(), nil
+}
+
+func (c *socialCache) SetUserOffline(ctx context.Context, userID, now int64) (bool, error) {
+	pipe := c.client.TxPipeline()
+	removed := pipe.Del(ctx, onlineKey(userID))
+	pipe.Set(ctx, lastSeenKey(userID), now, 0)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return false, err
+	}
+	return removed.Val() > 0, nil
+}
+
+func (c *socialCache) GetPresences(ctx context.Context, userIDs []int64) (map[int64]*model.Presence, error) {
+	result := make(map[int64]*model.Presence, len(userIDs))
+	if len(userIDs) == 0 {
+		return result, nil
+	}
+	keys := make([]string, 0, 2*len(userIDs))
+	for _, id := range userIDs {
+		keys = append(keys, onlineKey(id), lastSeenKey(id))
+	}
+	values, err := c.client.MGet(ctx, keys...).Result()
+	if err != nil {
+		return nil, err
+	}
+	for i, id := range userIDs {
+		p := &model.Presence{UserID: id, Online: values[2*i] != nil}
+		if v, ok := values[2*i+1].(string); ok {
+			p.LastSeen, _ = strconv.ParseInt(v, 10, 64)
+		}
+		result[id] = p
+	}
+	return result, nil
+}
+
+const recommendUsersKey = "recommend:users"
+
+func recommendKey(userID int64) string {
+	return fmt.Sprintf("recommend:%d", userID)
 }
 
-func (c *socialCache) SetUserOnline(ctx context.Context, userID int64) error {
-	return c.client.SAdd(ctx, "online_users", userID).Err()
+func (c *socialCache) GetRecommendations(ctx context.Context, userID int64) ([]*model.Recommendation, bool, error) {
+	data, err := c.client.Get(ctx, recommendKey(userID)).Bytes()
+	if errors.Is(err, redis.Nil) {
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, err
+	}
+	var recommendations []*model.Recommendation
+	if err := json.Unmarshal(data, &recommendations); err != nil {
+		return nil, false, err
+	}
+	return recommendations, true, nil
+}
+
+func (c *socialCache) SetRecommendations(ctx context.Context, userID int64, recommendations []*model.Recommendation) error {
+	data, err := json.Marshal(recommendations)
+	if err != nil {
+		return err
+	}
+	return c.client.Set(ctx, recommendKey(userID), data, constants.RecommendExpire).Err()
+}
+
+func (c *socialCache) TouchRecommendUser(ctx context.Context, userID int64) error {
+	return c.client.ZAdd(ctx, recommendUsersKey, redis.Z{Score: float64(time.Now().Unix()), Member: userID}).Err()
+}
+
+func (c *socialCache) GetRecommendUsers(ctx context.Context, offset, count int64) ([]int64, error) {
+	members, err := c.client.ZRange(ctx, recommendUsersKey, offset, offset+count-1).Result()
+	if err != nil {
+		return nil, err
+	}
+	userIDs := make([]int64, 0, len(members))
+	for _, member := range members {
+		id, err := strconv.ParseInt(member, 10, 64)
+		if err != nil {
+			continue
+		}
+		userIDs = append(userIDs, id)
+	}
+	return userIDs, nil
+}
+
+func (c *socialCache) RemoveRecommendUsersBefore(ctx context.Context, before int64) error {
+	return c.client.ZRemRangeByScore(ctx, recommendUsersKey, "-inf", "("+strconv.FormatInt(before, 10)).Err()
+}
+
+const chatRetentionLockKey = "chat:retention:lock"
+
+// releaseLockIfHeld 仅在锁仍由 token 持有时删除，避免锁过期后删掉其他实例新加的锁
+var releaseLockIfHeld = redis.NewScript(`
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+	return redis.call("DEL", KEYS[1])
+end
+return 0
+`)
+
+func (c *socialCache) AcquireChatRetentionLock(ctx context.Context, token string, ttl time.Duration) (bool, error) {
+	return c.client.SetNX(ctx, chatRetentionLockKey, token, ttl).Result()
 }
 
-func (c *socialCache) SetUserOffline(ctx context.Context, userID int64) error {
-	return c.client.SRem(ctx, "online_users", userID).Err()
+func (c *socialCache) ReleaseChatRetentionLock(ctx context.Context, token string) error {
+	return releaseLockIfHeld.Run(ctx, c.client, []string{chatRetentionLockKey}, token).Err()
 }
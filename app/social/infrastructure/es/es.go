@@ -0,0 +1,119 @@
+package es
+
+import (
+	"context"
+
+	"github.com/bytedance/gopkg/util/logger"
+	"github.com/bytedance/sonic"
+	"github.com/olivere/elastic/v7"
+	"github.com/yxrxy/videoHub/app/social/domain/model"
+	"github.com/yxrxy/videoHub/pkg/errno"
+)
+
+func (es *MessageElastic) IsExist(ctx context.Context, indexName string) bool {
+	res, err := es.client.IndexExists(indexName).Do(ctx)
+	if err != nil {
+		logger.Errorf("MessageElastic.IsExist Error checking if index exists: %v", err)
+		return false
+	}
+	return res
+}
+
+func (es *MessageElastic) CreateIndex(ctx context.Context, indexName string) error {
+	_, err := es.client.CreateIndex(indexName).BodyString(mapping).Do(ctx)
+	if err != nil {
+		return errno.Errorf(errno.InternalESErrorCode, "MessageElastic.CreateIndex Error creating index: %v", err)
+	}
+	return nil
+}
+
+func (es *MessageElastic) IndexMessage(ctx context.Context, indexName string, doc *model.MessageDocument) error {
+	_, err := es.client.Index().Index(indexName).
+		Id(doc.DocID()).
+		BodyJson(doc).Do(ctx)
+	if err != nil {
+		return errno.Errorf(errno.InternalESErrorCode, "MessageElastic.IndexMessage failed: %v", err)
+	}
+	return nil
+}
+
+func (es *MessageElastic) SearchMessages(
+	ctx context.Context, indexName string, query *model.MessageSearchQuery,
+) ([]*model.MessageDocument, int64, error) {
+	result, err := es.client.Search().Index(indexName).
+		Query(BuildQuery(query)).
+		Sort("created_at", false).
+		Sort("id", false).
+		From((query.Page - 1) * query.Size).
+		Size(query.Size).
+		Do(ctx)
+	if err != nil {
+		return nil, 0, errno.Errorf(errno.InternalESErrorCode, "MessageElastic.SearchMessages failed: %v", err)
+	}
+
+	docs := make([]*model.MessageDocument, 0, len(result.Hits.Hits))
+	for _, hit := range result.Hits.Hits {
+		doc := new(model.MessageDocument)
+		if err := sonic.Unmarshal(hit.Source, doc); err != nil {
+			return nil, 0, errno.Errorf(errno.InternalServiceErrorCode, "MessageElastic.SearchMessages failed: %v", err)
+		}
+		docs = append(docs, doc)
+	}
+	return docs, result.TotalHits(), nil
+}
+
+func (es *MessageElastic) DeleteMessagesBefore(ctx context.Context, indexName string, before int64) error {
+	_, err := es.client.DeleteByQuery(indexName).
+		Query(elastic.NewRangeQuery("created_at").Lt(before)).
+		Do(ctx)
+	if err != nil {
+		return errno.Errorf(errno.InternalESErrorCode, "MessageElastic.DeleteMessagesBefore failed: %v", err)
+	}
+	return nil
+}
+
+// BuildQuery 按会话范围、发送者、时间和关键词构造查询，只返回未撤回的消息。
+// 私信要求用户是发送者或接收者，群聊限定在 RoomIDs 中
+func BuildQuery(req *model.MessageSearchQuery) *elastic.BoolQuery {
+	query := elastic.NewBoolQuery().
+		MustNot(elastic.NewTermQuery("recalled", true))
+
+	scopes := make([]elastic.Query, 0, 2)
+	if req.RoomID == 0 {
+		private := elastic.NewBoolQuery().Filter(elastic.NewTermQuery("room_id", 0))
+		if req.PeerID != 0 {
+			private = private.Filter(elastic.NewTermsQuery("sender_id", req.UserID, req.PeerID),
+				elastic.NewTermsQuery("receiver_id", req.UserID, req.PeerID))
+		} else {
+			private = private.Should(elastic.NewTermQuery("sender_id", req.UserID),
+				elastic.NewTermQuery("receiver_id", req.UserID)).MinimumNumberShouldMatch(1)
+		}
+		scopes = append(scopes, private)
+	}
+	if req.PeerID == 0 && len(req.RoomIDs) > 0 {
+		roomIDs := make([]interface{}, len(req.RoomIDs))
+		for i, id := range req.RoomIDs {
+			roomIDs[i] = id
+		}
+		scopes = append(scopes, elastic.NewTermsQuery("room_id", roomIDs...))
+	}
+	query = query.Filter(elastic.NewBoolQuery().Should(scopes...).MinimumNumberShouldMatch(1))
+
+	if req.SenderID != 0 {
+		query = query.Filter(elastic.NewTermQuery("sender_id", req.SenderID))
+	}
+	if req.FromDate != 0 || req.ToDate != 0 {
+		dateRange := elastic.NewRangeQuery("created_at")
+		if req.FromDate != 0 {
+			dateRange.Gte(req.FromDate)
+		}
+		if req.ToDate != 0 {
+			dateRange.Lte(req.ToDate)
+		}
+		query = query.Filter(dateRange)
+	}
+	if req.Keyword != "" {
+		query = query.Must(elastic.NewMatchQuery("search_text", req.Keyword))
+	}
+	return query
+}
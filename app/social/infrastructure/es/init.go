@@ -0,0 +1,14 @@
+package es
+
+import (
+	"github.com/olivere/elastic/v7"
+	"github.com/yxrxy/videoHub/app/social/domain/repository"
+)
+
+type MessageElastic struct {
+	client *elastic.Client
+}
+
+func NewMessageElastic(client *elastic.Client) repository.MessageElastic {
+	return &MessageElastic{client: client}
+}
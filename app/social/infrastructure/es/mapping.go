@@ -0,0 +1,33 @@
+package es
+
+var mapping = `{
+  "settings": {
+    "number_of_shards": 3,
+    "number_of_replicas": 1,
+    "analysis": {
+      "analyzer": {
+        "text_analyzer": {
+          "tokenizer": "ik_max_word",
+          "filter": ["lowercase", "asciifolding", "trim"]
+        }
+      }
+    }
+  },
+  "mappings": {
+    "properties": {
+      "id": { "type": "long" },
+      "room_id": { "type": "long" },
+      "sender_id": { "type": "long" },
+      "receiver_id": { "type": "long" },
+      "content": { "type": "text", "index": false },
+      "search_text": {
+        "type": "text",
+        "analyzer": "text_analyzer"
+      },
+      "type": { "type": "byte" },
+      "seq": { "type": "long" },
+      "recalled": { "type": "boolean" },
+      "created_at": { "type": "date", "format": "epoch_second" }
+    }
+  }
+}`
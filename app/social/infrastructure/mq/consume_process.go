@@ -0,0 +1,24 @@
+package mq
+
+import (
+	"context"
+
+	"github.com/yxrxy/videoHub/pkg/constants"
+	"github.com/yxrxy/videoHub/pkg/kafka"
+)
+
+func (c *NotificationMQ) ConsumeNotificationEvents(ctx context.Context) <-chan *kafka.Message {
+	return c.client.Consume(ctx,
+		constants.NotificationTopic,
+		constants.NotificationConsumerNum,
+		constants.NotificationGroupID,
+		constants.NotificationConsumerChanCap)
+}
+
+func (c *NotificationMQ) ConsumeMessageIndexEvents(ctx context.Context) <-chan *kafka.Message {
+	return c.client.Consume(ctx,
+		constants.MessageIndexTopic,
+		constants.MessageIndexConsumerNum,
+		constants.MessageIndexGroupID,
+		constants.MessageIndexConsumerChanCap)
+}
@@ -0,0 +1,22 @@
+package mq
+
+import (
+	"sync/atomic"
+
+	"github.com/yxrxy/videoHub/app/social/domain/repository"
+	"github.com/yxrxy/videoHub/pkg/kafka"
+)
+
+// NotificationMQ 通知事件由互动、用户和社交服务投递，社交服务消费后合并存储，
+// 推送消息和在线状态变化由网关消费，消息索引事件由社交服务消费后写入搜索索引
+type NotificationMQ struct {
+	client       *kafka.Kafka
+	eventDone    atomic.Bool
+	pushDone     atomic.Bool
+	presenceDone atomic.Bool
+	indexDone    atomic.Bool
+}
+
+func NewNotificationMQ(client *kafka.Kafka) repository.NotificationMQ {
+	return &NotificationMQ{client: client}
+}
@@ -0,0 +1,30 @@
+package mq
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync/atomic"
+
+	"github.com/yxrxy/videoHub/pkg/kafka"
+)
+
+func (c *NotificationMQ) send(ctx context.Context, topic string, done *atomic.Bool, msg []*kafka.Message) (err error) {
+	if !done.Load() {
+		err = c.client.SetWriter(topic, true)
+		if err != nil {
+			return err
+		}
+		done.Swap(true)
+	}
+	errs := c.client.Send(ctx, topic, msg)
+	if len(errs) != 0 {
+		var errMsg string
+		for _, e := range errs {
+			errMsg = strings.Join([]string{errMsg, e.Error(), ";"}, "")
+		}
+		err = fmt.Errorf("mq.Send: send msg failed, errs: %v", errMsg)
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,83 @@
+package mq
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/bytedance/sonic"
+	"github.com/yxrxy/videoHub/app/social/domain/model"
+	"github.com/yxrxy/videoHub/pkg/constants"
+	"github.com/yxrxy/videoHub/pkg/kafka"
+)
+
+// SendNotificationEvent 按接收者分区，同一用户的事件由同一个消费者按顺序合并
+func (c *NotificationMQ) SendNotificationEvent(ctx context.Context, event *model.NotificationEvent) error {
+	v, err := sonic.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("sonic.Marshal: %w", err)
+	}
+	msg := []*kafka.Message{
+		{
+			K: []byte(strconv.FormatInt(event.UserID%constants.NotificationConsumerNum, 10)),
+			V: v,
+		},
+	}
+	if err = c.send(ctx, constants.NotificationTopic, &c.eventDone, msg); err != nil {
+		return fmt.Errorf("mq.SendNotificationEvent: send msg failed, err: %w", err)
+	}
+	return nil
+}
+
+func (c *NotificationMQ) SendNotificationPush(ctx context.Context, push *model.NotificationPush) error {
+	v, err := sonic.Marshal(push)
+	if err != nil {
+		return fmt.Errorf("sonic.Marshal: %w", err)
+	}
+	msg := []*kafka.Message{
+		{
+			K: []byte(strconv.FormatInt(push.UserID, 10)),
+			V: v,
+		},
+	}
+	if err = c.send(ctx, constants.NotificationPushTopic, &c.pushDone, msg); err != nil {
+		return fmt.Errorf("mq.SendNotificationPush: send msg failed, err: %w", err)
+	}
+	return nil
+}
+
+// SendPresencePush 按状态变化的用户分区，同一用户的上下线按顺序推送
+func (c *NotificationMQ) SendPresencePush(ctx context.Context, push *model.PresencePush) error {
+	v, err := sonic.Marshal(push)
+	if err != nil {
+		return fmt.Errorf("sonic.Marshal: %w", err)
+	}
+	msg := []*kafka.Message{
+		{
+			K: []byte(strconv.FormatInt(push.UserID, 10)),
+			V: v,
+		},
+	}
+	if err = c.send(ctx, constants.PresencePushTopic, &c.presenceDone, msg); err != nil {
+		return fmt.Errorf("mq.SendPresencePush: send msg failed, err: %w", err)
+	}
+	return nil
+}
+
+// SendMessageIndexEvent 按文档分区，同一消息的创建、编辑和撤回按顺序写入索引
+func (c *NotificationMQ) SendMessageIndexEvent(ctx context.Context, doc *model.MessageDocument) error {
+	v, err := sonic.Marshal(doc)
+	if err != nil {
+		return fmt.Errorf("sonic.Marshal: %w", err)
+	}
+	msg := []*kafka.Message{
+		{
+			K: []byte(doc.DocID()),
+			V: v,
+		},
+	}
+	if err = c.send(ctx, constants.MessageIndexTopic, &c.indexDone, msg); err != nil {
+		return fmt.Errorf("mq.SendMessageIndexEvent: send msg failed, err: %w", err)
+	}
+	return nil
+}
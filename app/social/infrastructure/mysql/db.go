@@ -2,10 +2,14 @@ package mysql
 
 import (
 	"context"
+	"time"
 
+	interactionmodel "github.com/yxrxy/videoHub/app/interaction/domain/model"
 	"github.com/yxrxy/videoHub/app/social/domain/model"
 	"github.com/yxrxy/videoHub/app/social/domain/repository"
+	"github.com/yxrxy/videoHub/pkg/constants"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type SocialDB struct {
@@ -18,13 +22,84 @@ func NewSocialDB(db *gorm.DB) repository.SocialDB {
 
 // 私信相关
 func (s *SocialDB) SendPrivateMessage(ctx context.Context, msg *model.PrivateMessage) error {
-	dbMsg := &PrivateMessage{
-		SenderID:   msg.SenderID,
-		ReceiverID: msg.ReceiverID,
-		Content:    msg.Content,
-		IsRead:     msg.IsRead,
+	key := model.PrivateConversationKey(msg.SenderID, msg.ReceiverID)
+	return s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		seq, err := nextSeq(tx, key)
+		if err != nil {
+			return err
+		}
+		dbMsg := &PrivateMessage{
+			SenderID:        msg.SenderID,
+			ReceiverID:      msg.ReceiverID,
+			ConversationKey: key,
+			Seq:             seq,
+			Content:         msg.Content,
+			IsRead:          msg.IsRead,
+			ReplyToID:       msg.ReplyToID,
+		}
+		if err := tx.Create(dbMsg).Error; err != nil {
+			return err
+		}
+		// 发送者视为已读到自己发送的消息
+		if err := advanceReadCursor(tx, msg.SenderID, key, 0, seq); err != nil {
+			return err
+		}
+		if err := upsertPrivateConversation(tx, msg.SenderID, msg.ReceiverID, dbMsg); err != nil {
+			return err
+		}
+		if err := upsertPrivateConversation(tx, msg.ReceiverID, msg.SenderID, dbMsg); err != nil {
+			return err
+		}
+		msg.ID = dbMsg.ID
+		msg.Seq = seq
+		msg.CreatedAt = dbMsg.CreatedAt.Unix()
+		return nil
+	})
+}
+
+// nextSeq 在事务中为会话分配下一个序号，会话行被锁定直到事务结束，保证序号连续且不重复
+func nextSeq(tx *gorm.DB, conversationKey string) (int64, error) {
+	err := tx.Clauses(clause.OnConflict{
+		DoUpdates: clause.Assignments(map[string]any{"seq": gorm.Expr("seq + 1")}),
+	}).Create(&ConversationSeq{ConversationKey: conversationKey, Seq: 1}).Error
+	if err != nil {
+		return 0, err
+	}
+	var row ConversationSeq
+	if err := tx.Where("conversation_key = ?", conversationKey).First(&row).Error; err != nil {
+		return 0, err
+	}
+	return row.Seq, nil
+}
+
+// GetConversationSeq 获取会话当前最大序号，会话不存在时返回0
+func (s *SocialDB) GetConversationSeq(ctx context.Context, conversationKey string) (int64, error) {
+	var seqs []int64
+	err := s.db.WithContext(ctx).Model(&ConversationSeq{}).
+		Where("conversation_key = ?", conversationKey).
+		Pluck("seq", &seqs).Error
+	if err != nil || len(seqs) == 0 {
+		return 0, err
+	}
+	return seqs[0], nil
+}
+
+// SyncPrivateMessages 获取私信会话中序号大于 afterSeq 的消息，按序号升序
+func (s *SocialDB) SyncPrivateMessages(ctx context.Context, userID, peerID, afterSeq int64, limit int) ([]model.PrivateMessage, error) {
+	var dbMessages []PrivateMessage
+	if err := s.db.WithContext(ctx).
+		Where("conversation_key = ? AND seq > ?", model.PrivateConversationKey(userID, peerID), afterSeq).
+		Order("seq ASC").
+		Limit(limit).
+		Find(&dbMessages).Error; err != nil {
+		return nil, err
+	}
+
+	messages := make([]model.PrivateMessage, len(dbMessages))
+	for i, dbMsg := range dbMessages {
+		messages[i] = toPrivateMessage(&dbMsg)
 	}
-	return s.db.WithContext(ctx).Create(dbMsg).Error
+	return messages, nil
 }
 
 func (s *SocialDB) GetPrivateMessages(ctx context.Context, senderID, receiverID int64, page, size int) ([]model.PrivateMessage, int64, error) {
@@ -48,32 +123,109 @@ func (s *SocialDB) GetPrivateMessages(ctx context.Context, senderID, receiverID
 
 	messages := make([]model.PrivateMessage, len(dbMessages))
 	for i, dbMsg := range dbMessages {
-		messages[i] = model.PrivateMessage{
-			ID:         dbMsg.ID,
-			SenderID:   dbMsg.SenderID,
-			ReceiverID: dbMsg.ReceiverID,
-			Content:    dbMsg.Content,
-			IsRead:     dbMsg.IsRead,
-		}
+		messages[i] = toPrivateMessage(&dbMsg)
 	}
 
 	return messages, total, nil
 }
 
-// 聊天室相关
+func toPrivateMessage(dbMsg *PrivateMessage) model.PrivateMessage {
+	msg := model.PrivateMessage{
+		ID:         dbMsg.ID,
+		SenderID:   dbMsg.SenderID,
+		ReceiverID: dbMsg.ReceiverID,
+		Content:    dbMsg.Content,
+		IsRead:     dbMsg.IsRead,
+		Seq:        dbMsg.Seq,
+		ReplyToID:  dbMsg.ReplyToID,
+		Recalled:   dbMsg.RecalledAt != nil,
+		CreatedAt:  dbMsg.CreatedAt.Unix(),
+	}
+	if dbMsg.EditedAt != nil {
+		msg.EditedAt = dbMsg.EditedAt.Unix()
+	}
+	return msg
+}
+
+// EditPrivateMessage 修改私信内容并记录编辑时间，该消息是会话最后一条消息时同步更新双方的收件箱预览
+func (s *SocialDB) EditPrivateMessage(ctx context.Context, messageID int64, content string, editedAt int64) error {
+	return s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var dbMsg PrivateMessage
+		if err := tx.First(&dbMsg, messageID).Error; err != nil {
+			return err
+		}
+		if err := tx.Model(&dbMsg).
+			Updates(map[string]any{"content": content, "edited_at": time.Unix(editedAt, 0)}).Error; err != nil {
+			return err
+		}
+		return tx.Model(&Conversation{}).
+			Where("conversation_key = ? AND last_message_id = ?", dbMsg.ConversationKey, messageID).
+			Update("last_preview", model.MessagePreview(model.MessageTypeText, content)).Error
+	})
+}
+
+// RecallPrivateMessage 撤回私信，清空内容只保留占位
+// 同步更新双方的收件箱预览，接收者尚未读到该消息时未读数减一
+func (s *SocialDB) RecallPrivateMessage(ctx context.Context, messageID int64) error {
+	return s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var dbMsg PrivateMessage
+		if err := tx.First(&dbMsg, messageID).Error; err != nil {
+			return err
+		}
+		if err := tx.Model(&dbMsg).
+			Updates(map[string]any{"content": "", "recalled_at": time.Now()}).Error; err != nil {
+			return err
+		}
+		if err := tx.Model(&Conversation{}).
+			Where("conversation_key = ? AND last_message_id = ?", dbMsg.ConversationKey, messageID).
+			Update("last_preview", model.MessagePreviewRecalled).Error; err != nil {
+			return err
+		}
+		return tx.Exec(`UPDATE conversations AS c
+			LEFT JOIN conversation_read_cursors AS r ON r.user_id = c.user_id AND r.conversation_key = c.conversation_key
+			SET c.unread_count = c.unread_count - 1
+			WHERE c.conversation_key = ? AND c.user_id = ? AND c.unread_count > 0 AND COALESCE(r.read_seq, 0) < ?`,
+			dbMsg.ConversationKey, dbMsg.ReceiverID, dbMsg.Seq).Error
+	})
+}
+
+// 聊天室相关，成员、成员的收件箱记录与聊天室在同一事务中创建
 func (s *SocialDB) CreateChatRoom(ctx context.Context, room *model.ChatRoom) (int64, error) {
 	dbRoom := &ChatRoom{
 		Name:      room.Name,
 		CreatorID: room.CreatorID,
 		Type:      room.Type,
+		Members:   toDBChatRoomMembers(room.Members),
 	}
-	err := s.db.WithContext(ctx).Create(dbRoom).Error
+	err := s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(dbRoom).Error; err != nil {
+			return err
+		}
+		userIDs := make([]int64, len(room.Members))
+		for i, member := range room.Members {
+			userIDs[i] = member.UserID
+		}
+		return createGroupConversations(tx, dbRoom.ID, userIDs)
+	})
 	if err != nil {
 		return 0, err
 	}
 	return dbRoom.ID, nil
 }
 
+func toDBChatRoomMembers(members []model.ChatRoomMember) []ChatRoomMember {
+	dbMembers := make([]ChatRoomMember, len(members))
+	for i, member := range members {
+		dbMembers[i] = ChatRoomMember{
+			RoomID:   member.RoomID,
+			UserID:   member.UserID,
+			Nickname: member.Nickname,
+			Role:     member.Role,
+		}
+	}
+	return dbMembers
+}
+
 func (s *SocialDB) GetChatRoom(ctx context.Context, roomID int64) (*model.ChatRoom, error) {
 	var dbRoom ChatRoom
 	if err := s.db.WithContext(ctx).
@@ -83,14 +235,8 @@ func (s *SocialDB) GetChatRoom(ctx context.Context, roomID int64) (*model.ChatRo
 	}
 
 	members := make([]model.ChatRoomMember, len(dbRoom.Members))
-	for i, dbMember := range dbRoom.Members {
-		members[i] = model.ChatRoomMember{
-			ID:       dbMember.ID,
-			RoomID:   dbMember.RoomID,
-			UserID:   dbMember.UserID,
-			Nickname: dbMember.Nickname,
-			Role:     dbMember.Role,
-		}
+	for i := range dbRoom.Members {
+		members[i] = toChatRoomMember(&dbRoom.Members[i])
 	}
 
 	return &model.ChatRoom{
@@ -102,49 +248,307 @@ func (s *SocialDB) GetChatRoom(ctx context.Context, roomID int64) (*model.ChatRo
 	}, nil
 }
 
+// GetUserChatRooms 获取用户加入的聊天室，不加载成员列表
 func (s *SocialDB) GetUserChatRooms(ctx context.Context, userID int64) ([]model.ChatRoom, error) {
 	var dbRooms []ChatRoom
 	if err := s.db.WithContext(ctx).
-		Where("NOT (chat_rooms.id = -1 AND chat_rooms.creator_id != ?)", userID).
-		Preload("Members").
+		Joins("JOIN chat_room_members ON chat_room_members.room_id = chat_rooms.id").
+		Where("chat_room_members.user_id = ?", userID).
+		Order("chat_rooms.id").
 		Find(&dbRooms).Error; err != nil {
 		return nil, err
 	}
 
 	rooms := make([]model.ChatRoom, len(dbRooms))
 	for i, dbRoom := range dbRooms {
-		members := make([]model.ChatRoomMember, len(dbRoom.Members))
-		for j, dbMember := range dbRoom.Members {
-			members[j] = model.ChatRoomMember{
-				ID:       dbMember.ID,
-				RoomID:   dbMember.RoomID,
-				UserID:   dbMember.UserID,
-				Nickname: dbMember.Nickname,
-				Role:     dbMember.Role,
-			}
-		}
-
 		rooms[i] = model.ChatRoom{
 			ID:        dbRoom.ID,
 			Name:      dbRoom.Name,
 			CreatorID: dbRoom.CreatorID,
 			Type:      dbRoom.Type,
-			Members:   members,
 		}
 	}
 
 	return rooms, nil
 }
 
+// GetChatRoomMember 获取聊天室成员，用户不是成员时返回 nil
+func (s *SocialDB) GetChatRoomMember(ctx context.Context, roomID, userID int64) (*model.ChatRoomMember, error) {
+	var dbMembers []ChatRoomMember
+	if err := s.db.WithContext(ctx).
+		Where("room_id = ? AND user_id = ?", roomID, userID).
+		Limit(1).
+		Find(&dbMembers).Error; err != nil {
+		return nil, err
+	}
+	if len(dbMembers) == 0 {
+		return nil, nil
+	}
+	member := toChatRoomMember(&dbMembers[0])
+	return &member, nil
+}
+
+func toChatRoomMember(dbMember *ChatRoomMember) model.ChatRoomMember {
+	member := model.ChatRoomMember{
+		ID:       dbMember.ID,
+		RoomID:   dbMember.RoomID,
+		UserID:   dbMember.UserID,
+		Nickname: dbMember.Nickname,
+		Role:     dbMember.Role,
+	}
+	if dbMember.MutedUntil != nil {
+		member.MutedUntil = dbMember.MutedUntil.Unix()
+	}
+	return member
+}
+
+// GetChatRoomMemberIDs 获取聊天室中已存在的成员ID，userIDs 为空时返回全部成员
+func (s *SocialDB) GetChatRoomMemberIDs(ctx context.Context, roomID int64, userIDs []int64) ([]int64, error) {
+	query := s.db.WithContext(ctx).Model(&ChatRoomMember{}).Where("room_id = ?", roomID)
+	if len(userIDs) > 0 {
+		query = query.Where("user_id IN ?", userIDs)
+	}
+	var ids []int64
+	err := query.Pluck("user_id", &ids).Error
+	return ids, err
+}
+
+// AddChatRoomMembers 添加聊天室成员并创建收件箱记录，已是成员的用户忽略
+func (s *SocialDB) AddChatRoomMembers(ctx context.Context, members []model.ChatRoomMember) error {
+	if len(members) == 0 {
+		return nil
+	}
+	return s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Clauses(clause.OnConflict{DoNothing: true}).
+			Create(toDBChatRoomMembers(members)).Error; err != nil {
+			return err
+		}
+		roomUsers := make(map[int64][]int64)
+		for _, m := range members {
+			roomUsers[m.RoomID] = append(roomUsers[m.RoomID], m.UserID)
+		}
+		for roomID, userIDs := range roomUsers {
+			if err := createGroupConversations(tx, roomID, userIDs); err != nil {
+				return err
+			}
+		}
+		// 新成员的已读游标从当前序号开始，入群前的历史消息不计入未读
+		roomSeqs := make(map[int64]int64)
+		for _, m := range members {
+			key := model.GroupConversationKey(m.RoomID)
+			seq, ok := roomSeqs[m.RoomID]
+			if !ok {
+				var seqs []int64
+				if err := tx.Model(&ConversationSeq{}).Where("conversation_key = ?", key).Pluck("seq", &seqs).Error; err != nil {
+					return err
+				}
+				if len(seqs) > 0 {
+					seq = seqs[0]
+				}
+				roomSeqs[m.RoomID] = seq
+			}
+			if seq == 0 {
+				continue
+			}
+			if err := advanceReadCursor(tx, m.UserID, key, m.RoomID, seq); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// RemoveChatRoomMember 移除聊天室成员，同时从其收件箱中移除该聊天室
+func (s *SocialDB) RemoveChatRoomMember(ctx context.Context, roomID, userID int64) error {
+	return s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("room_id = ? AND user_id = ?", roomID, userID).
+			Delete(&ChatRoomMember{}).Error; err != nil {
+			return err
+		}
+		return tx.Where("user_id = ? AND conversation_key = ?", userID, model.GroupConversationKey(roomID)).
+			Delete(&Conversation{}).Error
+	})
+}
+
+// CountChatRoomMembers 统计聊天室成员数
+func (s *SocialDB) CountChatRoomMembers(ctx context.Context, roomID int64) (int64, error) {
+	var count int64
+	err := s.db.WithContext(ctx).Model(&ChatRoomMember{}).
+		Where("room_id = ?", roomID).
+		Count(&count).Error
+	return count, err
+}
+
+// CountUserGroupRooms 统计每个用户加入的群聊数，未加入任何群聊的用户不在结果中
+func (s *SocialDB) CountUserGroupRooms(ctx context.Context, userIDs []int64) (map[int64]int64, error) {
+	counts := make(map[int64]int64, len(userIDs))
+	if len(userIDs) == 0 {
+		return counts, nil
+	}
+	var rows []struct {
+		UserID int64
+		Count  int64
+	}
+	if err := s.db.WithContext(ctx).Model(&ChatRoomMember{}).
+		Select("chat_room_members.user_id, COUNT(*) AS count").
+		Joins("JOIN chat_rooms ON chat_rooms.id = chat_room_members.room_id").
+		Where("chat_room_members.user_id IN ? AND chat_rooms.type = ?", userIDs, model.ChatRoomTypeGroup).
+		Group("chat_room_members.user_id").
+		Scan(&rows).Error; err != nil {
+		return nil, err
+	}
+	for _, row := range rows {
+		counts[row.UserID] = row.Count
+	}
+	return counts, nil
+}
+
+// UpdateChatRoomMemberRole 修改成员角色
+func (s *SocialDB) UpdateChatRoomMemberRole(ctx context.Context, roomID, userID int64, role int8) error {
+	return s.db.WithContext(ctx).Model(&ChatRoomMember{}).
+		Where("room_id = ? AND user_id = ?", roomID, userID).
+		Update("role", role).Error
+}
+
+// TransferChatRoomOwner 转让群主，原群主降为管理员，在同一事务中完成
+func (s *SocialDB) TransferChatRoomOwner(ctx context.Context, roomID, fromUserID, toUserID int64) error {
+	return s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&ChatRoomMember{}).
+			Where("room_id = ? AND user_id = ?", roomID, fromUserID).
+			Update("role", model.ChatRoomRoleAdmin).Error; err != nil {
+			return err
+		}
+		if err := tx.Model(&ChatRoomMember{}).
+			Where("room_id = ? AND user_id = ?", roomID, toUserID).
+			Update("role", model.ChatRoomRoleCreator).Error; err != nil {
+			return err
+		}
+		return tx.Model(&ChatRoom{}).Where("id = ?", roomID).Update("creator_id", toUserID).Error
+	})
+}
+
+// MuteChatRoomMember 设置成员禁言截止时间，mutedUntil 为 0 时解除禁言
+func (s *SocialDB) MuteChatRoomMember(ctx context.Context, roomID, userID, mutedUntil int64) error {
+	var until *time.Time
+	if mutedUntil > 0 {
+		t := time.Unix(mutedUntil, 0)
+		until = &t
+	}
+	return s.db.WithContext(ctx).Model(&ChatRoomMember{}).
+		Where("room_id = ? AND user_id = ?", roomID, userID).
+		Update("muted_until", until).Error
+}
+
+// UpdateChatRoomName 修改聊天室名称
+func (s *SocialDB) UpdateChatRoomName(ctx context.Context, roomID int64, name string) error {
+	return s.db.WithContext(ctx).Model(&ChatRoom{}).
+		Where("id = ?", roomID).
+		Update("name", name).Error
+}
+
+// DeleteChatRoom 解散聊天室，删除聊天室、成员、邀请链接和收件箱记录，历史消息保留
+func (s *SocialDB) DeleteChatRoom(ctx context.Context, roomID int64) error {
+	return s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("room_id = ?", roomID).Delete(&ChatRoomMember{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("room_id = ?", roomID).Delete(&ChatRoomInvite{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("room_id = ?", roomID).Delete(&ConversationReadCursor{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("room_id = ?", roomID).Delete(&Conversation{}).Error; err != nil {
+			return err
+		}
+		return tx.Delete(&ChatRoom{}, roomID).Error
+	})
+}
+
+// CreateChatRoomInvite 创建邀请链接
+func (s *SocialDB) CreateChatRoomInvite(ctx context.Context, invite *model.ChatRoomInvite) error {
+	return s.db.WithContext(ctx).Create(&ChatRoomInvite{
+		Code:      invite.Code,
+		RoomID:    invite.RoomID,
+		InviterID: invite.InviterID,
+		ExpiresAt: time.Unix(invite.ExpiresAt, 0),
+	}).Error
+}
+
+// GetChatRoomInvite 根据邀请码获取邀请链接
+func (s *SocialDB) GetChatRoomInvite(ctx context.Context, code string) (*model.ChatRoomInvite, error) {
+	var dbInvite ChatRoomInvite
+	if err := s.db.WithContext(ctx).Where("code = ?", code).First(&dbInvite).Error; err != nil {
+		return nil, err
+	}
+	return &model.ChatRoomInvite{
+		Code:      dbInvite.Code,
+		RoomID:    dbInvite.RoomID,
+		InviterID: dbInvite.InviterID,
+		ExpiresAt: dbInvite.ExpiresAt.Unix(),
+	}, nil
+}
+
+// IsChatRoomMember 判断用户是否是聊天室成员
+func (s *SocialDB) IsChatRoomMember(ctx context.Context, roomID, userID int64) (bool, error) {
+	var count int64
+	err := s.db.WithContext(ctx).Model(&ChatRoomMember{}).
+		Where("room_id = ? AND user_id = ?", roomID, userID).
+		Count(&count).Error
+	return count > 0, err
+}
+
 // 聊天消息相关
 func (s *SocialDB) SendChatMessage(ctx context.Context, msg *model.ChatMessage) error {
-	dbMsg := &ChatMessage{
-		RoomID:   msg.RoomID,
-		SenderID: msg.SenderID,
-		Content:  msg.Content,
-		Type:     msg.Type,
+	return s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		seq, err := nextSeq(tx, model.GroupConversationKey(msg.RoomID))
+		if err != nil {
+			return err
+		}
+		dbMsg := &ChatMessage{
+			RoomID:    msg.RoomID,
+			Seq:       seq,
+			SenderID:  msg.SenderID,
+			Content:   msg.Content,
+			Type:      msg.Type,
+			ReplyToID: msg.ReplyToID,
+		}
+		if err := tx.Create(dbMsg).Error; err != nil {
+			return err
+		}
+		// 发送者视为已读到自己发送的消息，系统消息不影响操作者的游标
+		if msg.Type != model.MessageTypeSystem {
+			key := model.GroupConversationKey(msg.RoomID)
+			if err := advanceReadCursor(tx, msg.SenderID, key, msg.RoomID, seq); err != nil {
+				return err
+			}
+		}
+		if err := upsertGroupConversations(tx, dbMsg); err != nil {
+			return err
+		}
+		msg.ID = dbMsg.ID
+		msg.Seq = seq
+		msg.CreatedAt = dbMsg.CreatedAt.Unix()
+		return nil
+	})
+}
+
+// SyncChatMessages 获取聊天室中序号大于 afterSeq 的消息，按序号升序
+func (s *SocialDB) SyncChatMessages(ctx context.Context, roomID, afterSeq int64, limit int) ([]model.ChatMessage, error) {
+	var dbMessages []ChatMessage
+	if err := s.db.WithContext(ctx).
+		Where("room_id = ? AND seq > ?", roomID, afterSeq).
+		Order("seq ASC").
+		Limit(limit).
+		Find(&dbMessages).Error; err != nil {
+		return nil, err
+	}
+
+	messages := make([]model.ChatMessage, len(dbMessages))
+	for i, dbMsg := range dbMessages {
+		messages[i] = toChatMessage(&dbMsg)
 	}
-	return s.db.WithContext(ctx).Create(dbMsg).Error
+	return messages, nil
 }
 
 func (s *SocialDB) GetChatMessages(ctx context.Context, roomID int64, page, size int) ([]model.ChatMessage, int64, error) {
@@ -167,29 +571,84 @@ func (s *SocialDB) GetChatMessages(ctx context.Context, roomID int64, page, size
 
 	messages := make([]model.ChatMessage, len(dbMessages))
 	for i, dbMsg := range dbMessages {
-		messages[i] = model.ChatMessage{
-			ID:       dbMsg.ID,
-			RoomID:   dbMsg.RoomID,
-			SenderID: dbMsg.SenderID,
-			Content:  dbMsg.Content,
-			Type:     dbMsg.Type,
-		}
+		messages[i] = toChatMessage(&dbMsg)
 	}
 
 	return messages, total, nil
 }
 
-// 好友关系相关
-func (s *SocialDB) AddFriend(ctx context.Context, friendship *model.Friendship) error {
-	dbFriendship := &Friendship{
-		UserID:   friendship.UserID,
-		FriendID: friendship.FriendID,
-		Status:   friendship.Status,
-		Remark:   friendship.Remark,
+func toChatMessage(dbMsg *ChatMessage) model.ChatMessage {
+	msg := model.ChatMessage{
+		ID:        dbMsg.ID,
+		RoomID:    dbMsg.RoomID,
+		SenderID:  dbMsg.SenderID,
+		Content:   dbMsg.Content,
+		Type:      dbMsg.Type,
+		Seq:       dbMsg.Seq,
+		ReplyToID: dbMsg.ReplyToID,
+		Recalled:  dbMsg.RecalledAt != nil,
+		CreatedAt: dbMsg.CreatedAt.Unix(),
+	}
+	if dbMsg.EditedAt != nil {
+		msg.EditedAt = dbMsg.EditedAt.Unix()
+	}
+	return msg
+}
+
+// GetChatMessage 根据ID获取聊天消息
+func (s *SocialDB) GetChatMessage(ctx context.Context, messageID int64) (*model.ChatMessage, error) {
+	var dbMsg ChatMessage
+	if err := s.db.WithContext(ctx).First(&dbMsg, messageID).Error; err != nil {
+		return nil, err
 	}
-	return s.db.WithContext(ctx).Create(dbFriendship).Error
+	msg := toChatMessage(&dbMsg)
+	return &msg, nil
+}
+
+// EditChatMessage 修改消息内容并记录编辑时间，该消息是会话最后一条消息时同步更新收件箱预览
+func (s *SocialDB) EditChatMessage(ctx context.Context, messageID int64, content string, editedAt int64) error {
+	return s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var dbMsg ChatMessage
+		if err := tx.First(&dbMsg, messageID).Error; err != nil {
+			return err
+		}
+		if err := tx.Model(&dbMsg).
+			Updates(map[string]any{"content": content, "edited_at": time.Unix(editedAt, 0)}).Error; err != nil {
+			return err
+		}
+		return tx.Model(&Conversation{}).
+			Where("conversation_key = ? AND last_message_id = ?", model.GroupConversationKey(dbMsg.RoomID), messageID).
+			Update("last_preview", model.MessagePreview(dbMsg.Type, content)).Error
+	})
+}
+
+// RecallChatMessage 撤回消息，清空内容只保留占位
+// 同步更新收件箱预览，尚未读到该消息的成员未读数减一
+func (s *SocialDB) RecallChatMessage(ctx context.Context, messageID int64) error {
+	return s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var dbMsg ChatMessage
+		if err := tx.First(&dbMsg, messageID).Error; err != nil {
+			return err
+		}
+		if err := tx.Model(&dbMsg).
+			Updates(map[string]any{"content": "", "recalled_at": time.Now()}).Error; err != nil {
+			return err
+		}
+		key := model.GroupConversationKey(dbMsg.RoomID)
+		if err := tx.Model(&Conversation{}).
+			Where("conversation_key = ? AND last_message_id = ?", key, messageID).
+			Update("last_preview", model.MessagePreviewRecalled).Error; err != nil {
+			return err
+		}
+		return tx.Exec(`UPDATE conversations AS c
+			LEFT JOIN conversation_read_cursors AS r ON r.user_id = c.user_id AND r.conversation_key = c.conversation_key
+			SET c.unread_count = c.unread_count - 1
+			WHERE c.conversation_key = ? AND c.user_id <> ? AND c.unread_count > 0 AND COALESCE(r.read_seq, 0) < ?`,
+			key, dbMsg.SenderID, dbMsg.Seq).Error
+	})
 }
 
+// 好友关系相关
 func (s *SocialDB) GetFriendship(ctx context.Context, userID, friendID int64) (*model.Friendship, error) {
 	var dbFriendship Friendship
 	if err := s.db.WithContext(ctx).
@@ -211,8 +670,7 @@ func (s *SocialDB) GetFriendship(ctx context.Context, userID, friendID int64) (*
 func (s *SocialDB) GetUserFriends(ctx context.Context, userID int64) ([]model.Friendship, error) {
 	var dbFriendships []Friendship
 	if err := s.db.WithContext(ctx).
-		Where("(user_id = ? OR friend_id = ?)",
-			userID, userID).
+		Where("user_id = ? AND status = ?", userID, model.FriendStatusAccepted).
 		Find(&dbFriendships).Error; err != nil {
 		return nil, err
 	}
@@ -231,7 +689,49 @@ func (s *SocialDB) GetUserFriends(ctx context.Context, userID int64) ([]model.Fr
 	return friendships, nil
 }
 
+func (s *SocialDB) AreFriends(ctx context.Context, userID, friendID int64) (bool, error) {
+	var count int64
+	err := s.db.WithContext(ctx).Model(&Friendship{}).
+		Where("user_id = ? AND friend_id = ? AND status = ?", userID, friendID, model.FriendStatusAccepted).
+		Count(&count).Error
+	return count > 0, err
+}
+
+func (s *SocialDB) CountFriends(ctx context.Context, userIDs []int64) (map[int64]int64, error) {
+	counts := make(map[int64]int64, len(userIDs))
+	if len(userIDs) == 0 {
+		return counts, nil
+	}
+	var rows []struct {
+		UserID int64
+		Count  int64
+	}
+	if err := s.db.WithContext(ctx).Model(&Friendship{}).
+		Select("user_id, COUNT(*) AS count").
+		Where("user_id IN ? AND status = ?", userIDs, model.FriendStatusAccepted).
+		Group("user_id").
+		Scan(&rows).Error; err != nil {
+		return nil, err
+	}
+	for _, row := range rows {
+		counts[row.UserID] = row.Count
+	}
+	return counts, nil
+}
+
 // 好友申请相关
+func toFriendRequest(dbRequest *FriendRequest) *model.FriendRequest {
+	return &model.FriendRequest{
+		ID:         dbRequest.ID,
+		SenderID:   dbRequest.SenderID,
+		ReceiverID: dbRequest.ReceiverID,
+		Message:    dbRequest.Message,
+		Status:     dbRequest.Status,
+		CreatedAt:  dbRequest.CreatedAt.Unix(),
+		UpdatedAt:  dbRequest.UpdatedAt.Unix(),
+	}
+}
+
 func (s *SocialDB) CreateFriendRequest(ctx context.Context, request *model.FriendRequest) error {
 	dbRequest := &FriendRequest{
 		SenderID:   request.SenderID,
@@ -239,55 +739,726 @@ func (s *SocialDB) CreateFriendRequest(ctx context.Context, request *model.Frien
 		Message:    request.Message,
 		Status:     request.Status,
 	}
-	return s.db.WithContext(ctx).Create(dbRequest).Error
+	if err := s.db.WithContext(ctx).Create(dbRequest).Error; err != nil {
+		return err
+	}
+	*request = *toFriendRequest(dbRequest)
+	return nil
 }
 
-func (s *SocialDB) GetFriendRequests(ctx context.Context, userID int64, status int8) ([]model.FriendRequest, error) {
+func (s *SocialDB) GetFriendRequest(ctx context.Context, requestID int64) (*model.FriendRequest, error) {
 	var dbRequests []FriendRequest
-	query := s.db.WithContext(ctx).Where("receiver_id = ?", userID)
-	if status >= 0 {
-		query = query.Where("status = ?", status)
+	if err := s.db.WithContext(ctx).Where("id = ?", requestID).Limit(1).Find(&dbRequests).Error; err != nil {
+		return nil, err
+	}
+	if len(dbRequests) == 0 {
+		return nil, nil
 	}
-	if err := query.Order("created_at DESC").Find(&dbRequests).Error; err != nil {
+	return toFriendRequest(&dbRequests[0]), nil
+}
+
+func (s *SocialDB) GetPendingFriendRequest(ctx context.Context, senderID, receiverID int64) (*model.FriendRequest, error) {
+	var dbRequests []FriendRequest
+	if err := s.db.WithContext(ctx).
+		Where("sender_id = ? AND receiver_id = ? AND status = ?", senderID, receiverID, model.FriendRequestStatusPending).
+		Order("id DESC").Limit(1).Find(&dbRequests).Error; err != nil {
 		return nil, err
 	}
+	if len(dbRequests) == 0 {
+		return nil, nil
+	}
+	return toFriendRequest(&dbRequests[0]), nil
+}
 
-	requests := make([]model.FriendRequest, len(dbRequests))
-	for i, dbRequest := range dbRequests {
-		requests[i] = model.FriendRequest{
-			ID:         dbRequest.ID,
-			SenderID:   dbRequest.SenderID,
-			ReceiverID: dbRequest.ReceiverID,
-			Message:    dbRequest.Message,
-			Status:     dbRequest.Status,
-		}
+func (s *SocialDB) GetFriendRequests(ctx context.Context, userID int64, sent bool) ([]model.FriendRequest, error) {
+	column := "receiver_id"
+	if sent {
+		column = "sender_id"
+	}
+	var dbRequests []FriendRequest
+	if err := s.db.WithContext(ctx).Where(column+" = ?", userID).
+		Order("id DESC").Find(&dbRequests).Error; err != nil {
+		return nil, err
 	}
 
+	requests := make([]model.FriendRequest, len(dbRequests))
+	for i := range dbRequests {
+		requests[i] = *toFriendRequest(&dbRequests[i])
+	}
 	return requests, nil
 }
 
-func (s *SocialDB) UpdateFriendRequest(ctx context.Context, requestID int64, status int8) error {
-	return s.db.WithContext(ctx).Model(&FriendRequest{}).
-		Where("id = ?", requestID).
-		Update("status", status).Error
+func (s *SocialDB) UpdateFriendRequestStatus(ctx context.Context, requestID int64, from, to int8) (bool, error) {
+	result := s.db.WithContext(ctx).Model(&FriendRequest{}).
+		Where("id = ? AND status = ?", requestID, from).
+		Update("status", to)
+	return result.RowsAffected > 0, result.Error
+}
+
+func (s *SocialDB) AcceptFriendRequest(ctx context.Context, requestID int64) (bool, error) {
+	accepted := false
+	err := s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var dbRequest FriendRequest
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("id = ? AND status = ?", requestID, model.FriendRequestStatusPending).
+			Limit(1).Find(&dbRequest).Error; err != nil {
+			return err
+		}
+		if dbRequest.ID == 0 {
+			return nil
+		}
+		if err := tx.Model(&FriendRequest{}).Where("id = ?", requestID).
+			Update("status", model.FriendRequestStatusAccepted).Error; err != nil {
+			return err
+		}
+		friendships := []Friendship{
+			{UserID: dbRequest.SenderID, FriendID: dbRequest.ReceiverID, Status: model.FriendStatusAccepted},
+			{UserID: dbRequest.ReceiverID, FriendID: dbRequest.SenderID, Status: model.FriendStatusAccepted},
+		}
+		if err := tx.Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "user_id"}, {Name: "friend_id"}},
+			DoUpdates: clause.AssignmentColumns([]string{"status", "updated_at"}),
+		}).Create(&friendships).Error; err != nil {
+			return err
+		}
+		accepted = true
+		return nil
+	})
+	return accepted, err
 }
 
 // 消息已读状态相关
-func (s *SocialDB) MarkMessageRead(ctx context.Context, messageID, userID int64) error {
-	dbStatus := &MessageReadStatus{
-		MessageID: messageID,
-		UserID:    userID,
-		IsRead:    true,
+func (s *SocialDB) GetPrivateMessage(ctx context.Context, messageID int64) (*model.PrivateMessage, error) {
+	var dbMsg PrivateMessage
+	if err := s.db.WithContext(ctx).First(&dbMsg, messageID).Error; err != nil {
+		return nil, err
+	}
+	msg := toPrivateMessage(&dbMsg)
+	return &msg, nil
+}
+
+// advanceReadCursor 在事务中推进已读游标，已有游标更大时保持不变
+func advanceReadCursor(tx *gorm.DB, userID int64, conversationKey string, roomID, seq int64) error {
+	return tx.Clauses(clause.OnConflict{
+		DoUpdates: clause.Assignments(map[string]any{
+			"read_seq":   gorm.Expr("GREATEST(read_seq, VALUES(read_seq))"),
+			"updated_at": gorm.Expr("VALUES(updated_at)"),
+		}),
+	}).Create(&ConversationReadCursor{
+		UserID:          userID,
+		ConversationKey: conversationKey,
+		RoomID:          roomID,
+		ReadSeq:         seq,
+	}).Error
+}
+
+// MarkConversationRead 推进已读游标并重新统计收件箱未读数，私信会话同时将已读范围内收到的消息标记为已读
+func (s *SocialDB) MarkConversationRead(ctx context.Context, cursor *model.ReadCursor) (int64, error) {
+	key := cursor.ConversationKey()
+	var readSeq int64
+	err := s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := advanceReadCursor(tx, cursor.UserID, key, cursor.RoomID, cursor.ReadSeq); err != nil {
+			return err
+		}
+		var row ConversationReadCursor
+		if err := tx.Where("user_id = ? AND conversation_key = ?", cursor.UserID, key).First(&row).Error; err != nil {
+			return err
+		}
+		readSeq = row.ReadSeq
+		if err := refreshUnreadCount(tx, cursor.UserID, key, cursor.RoomID, readSeq); err != nil {
+			return err
+		}
+		if cursor.RoomID != 0 {
+			return nil
+		}
+		return tx.Model(&PrivateMessage{}).
+			Where("conversation_key = ? AND receiver_id = ? AND seq <= ? AND is_read = ?", key, cursor.UserID, readSeq, false).
+			Update("is_read", true).Error
+	})
+	return readSeq, err
+}
+
+// unreadRow 会话未读数
+type unreadRow struct {
+	ID    int64
+	Count int64
+}
+
+// getUnreadCounts 读取收件箱中维护的未读数，column 为私信对方或聊天室ID列
+func (s *SocialDB) getUnreadCounts(ctx context.Context, userID int64, column string) (map[int64]int64, error) {
+	var rows []unreadRow
+	err := s.db.WithContext(ctx).Model(&Conversation{}).
+		Select(column+" AS id, unread_count AS count").
+		Where("user_id = ? AND "+column+" <> 0 AND unread_count > 0", userID).
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+	counts := make(map[int64]int64, len(rows))
+	for _, row := range rows {
+		counts[row.ID] = row.Count
 	}
-	return s.db.WithContext(ctx).Create(dbStatus).Error
+	return counts, nil
+}
+
+// GetPrivateUnreadCounts 各私信会话的未读数，按对方分组
+func (s *SocialDB) GetPrivateUnreadCounts(ctx context.Context, userID int64) (map[int64]int64, error) {
+	return s.getUnreadCounts(ctx, userID, "peer_id")
+}
+
+// GetGroupUnreadCounts 用户所在各聊天室的未读数，按聊天室分组
+func (s *SocialDB) GetGroupUnreadCounts(ctx context.Context, userID int64) (map[int64]int64, error) {
+	return s.getUnreadCounts(ctx, userID, "room_id")
 }
 
-func (s *SocialDB) GetUnreadMessageCount(ctx context.Context, userID int64) (int64, error) {
+// 收件箱相关
+
+// upsertPrivateConversation 在事务中用新私信更新一方的收件箱记录，记录不存在时创建
+// 发送者的未读数清零，接收者的未读数加一，新消息会取消归档
+func upsertPrivateConversation(tx *gorm.DB, userID, peerID int64, msg *PrivateMessage) error {
+	var unread int64
+	unreadExpr := gorm.Expr("0")
+	if msg.ReceiverID == userID {
+		unread = 1
+		unreadExpr = gorm.Expr("unread_count + 1")
+	}
+	preview := model.MessagePreview(model.MessageTypeText, msg.Content)
+	return tx.Clauses(clause.OnConflict{
+		DoUpdates: clause.Assignments(map[string]any{
+			"last_message_id":   msg.ID,
+			"last_sender_id":    msg.SenderID,
+			"last_message_type": model.MessageTypeText,
+			"last_preview":      preview,
+			"last_active_at":    msg.CreatedAt.UnixMilli(),
+			"unread_count":      unreadExpr,
+			"archived":          false,
+			"updated_at":        gorm.Expr("VALUES(updated_at)"),
+		}),
+	}).Create(&Conversation{
+		UserID:          userID,
+		ConversationKey: msg.ConversationKey,
+		PeerID:          peerID,
+		LastMessageID:   msg.ID,
+		LastSenderID:    msg.SenderID,
+		LastMessageType: model.MessageTypeText,
+		LastPreview:     preview,
+		LastActiveAt:    msg.CreatedAt.UnixMilli(),
+		UnreadCount:     unread,
+	}).Error
+}
+
+// upsertGroupConversations 在事务中用新消息更新聊天室全部成员的收件箱记录，缺失的记录一并创建
+// 他人的未读数加一；发送者的未读数清零，系统消息不改变操作者的未读数
+func upsertGroupConversations(tx *gorm.DB, msg *ChatMessage) error {
+	senderUnread := "0"
+	if msg.Type == model.MessageTypeSystem {
+		senderUnread = "unread_count"
+	}
+	activeAt := msg.CreatedAt.UnixMilli()
+	preview := model.MessagePreview(msg.Type, msg.Content)
+	return tx.Exec(`INSERT INTO conversations
+		(user_id, conversation_key, peer_id, room_id, last_message_id, last_sender_id, last_message_type,
+		 last_preview, last_active_at, unread_count, muted, pinned, archived, updated_at)
+		SELECT user_id, ?, 0, room_id, ?, ?, ?, ?, ?, IF(user_id = ?, 0, 1), FALSE, FALSE, FALSE, NOW()
+		FROM chat_room_members WHERE room_id = ?
+		ON DUPLICATE KEY UPDATE
+			last_message_id = VALUES(last_message_id),
+			last_sender_id = VALUES(last_sender_id),
+			last_message_type = VALUES(last_message_type),
+			last_preview = VALUES(last_preview),
+			last_active_at = VALUES(last_active_at),
+			unread_count = IF(user_id = ?, `+senderUnread+`, unread_count + 1),
+			archived = FALSE,
+			updated_at = VALUES(updated_at)`,
+		model.GroupConversationKey(msg.RoomID), msg.ID, msg.SenderID, msg.Type, preview, activeAt,
+		msg.SenderID, msg.RoomID, msg.SenderID).Error
+}
+
+// createGroupConversations 在事务中为新成员创建聊天室的收件箱记录，已有记录保持不变
+func createGroupConversations(tx *gorm.DB, roomID int64, userIDs []int64) error {
+	if len(userIDs) == 0 {
+		return nil
+	}
+	now := time.Now().UnixMilli()
+	rows := make([]Conversation, len(userIDs))
+	for i, userID := range userIDs {
+		rows[i] = Conversation{
+			UserID:          userID,
+			ConversationKey: model.GroupConversationKey(roomID),
+			RoomID:          roomID,
+			LastActiveAt:    now,
+		}
+	}
+	return tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&rows).Error
+}
+
+// refreshUnreadCount 在事务中按已读游标重新统计用户在会话中的未读数
+func refreshUnreadCount(tx *gorm.DB, userID int64, key string, roomID, readSeq int64) error {
 	var count int64
-	if err := s.db.WithContext(ctx).Model(&PrivateMessage{}).
-		Where("receiver_id = ? AND is_read = ?", userID, false).
-		Count(&count).Error; err != nil {
-		return 0, err
+	var err error
+	if roomID != 0 {
+		err = tx.Model(&ChatMessage{}).
+			Where("room_id = ? AND seq > ? AND sender_id <> ? AND recalled_at IS NULL", roomID, readSeq, userID).
+			Count(&count).Error
+	} else {
+		err = tx.Model(&PrivateMessage{}).
+			Where("conversation_key = ? AND seq > ? AND receiver_id = ? AND recalled_at IS NULL", key, readSeq, userID).
+			Count(&count).Error
+	}
+	if err != nil {
+		return err
+	}
+	return tx.Model(&Conversation{}).
+		Where("user_id = ? AND conversation_key = ?", userID, key).
+		Update("unread_count", count).Error
+}
+
+// inboxRow 收件箱查询结果，附带聊天室名称
+type inboxRow struct {
+	Conversation
+	Name string
+}
+
+func toConversation(row *inboxRow) model.Conversation {
+	return model.Conversation{
+		UserID:          row.UserID,
+		PeerID:          row.PeerID,
+		RoomID:          row.RoomID,
+		Name:            row.Name,
+		LastMessageID:   row.LastMessageID,
+		LastSenderID:    row.LastSenderID,
+		LastMessageType: row.LastMessageType,
+		LastPreview:     row.LastPreview,
+		LastActiveAt:    row.LastActiveAt,
+		UnreadCount:     row.UnreadCount,
+		Muted:           row.Muted,
+		Pinned:          row.Pinned,
+		Archived:        row.Archived,
+	}
+}
+
+func (s *SocialDB) inboxQuery(ctx context.Context) *gorm.DB {
+	return s.db.WithContext(ctx).Table("conversations AS c").
+		Select("c.*, COALESCE(r.name, '') AS name").
+		Joins("LEFT JOIN chat_rooms AS r ON r.id = c.room_id")
+}
+
+// GetInbox 按置顶、最后活跃时间、会话标识倒序获取收件箱，after 为空时从头开始
+func (s *SocialDB) GetInbox(ctx context.Context, userID int64, archived bool, after *model.InboxCursor, limit int) ([]model.Conversation, error) {
+	query := s.inboxQuery(ctx).Where("c.user_id = ? AND c.archived = ?", userID, archived)
+	if after != nil {
+		query = query.Where(
+			"c.pinned < ? OR (c.pinned = ? AND (c.last_active_at < ? OR (c.last_active_at = ? AND c.conversation_key < ?)))",
+			after.Pinned, after.Pinned, after.LastActiveAt, after.LastActiveAt, after.ConversationKey)
+	}
+	var rows []inboxRow
+	if err := query.
+		Order("c.pinned DESC, c.last_active_at DESC, c.conversation_key DESC").
+		Limit(limit).
+		Scan(&rows).Error; err != nil {
+		return nil, err
+	}
+
+	conversations := make([]model.Conversation, len(rows))
+	for i := range rows {
+		conversations[i] = toConversation(&rows[i])
+	}
+	return conversations, nil
+}
+
+// GetConversation 获取用户收件箱中的会话，不存在时返回 nil
+func (s *SocialDB) GetConversation(ctx context.Context, userID int64, conversationKey string) (*model.Conversation, error) {
+	var rows []inboxRow
+	if err := s.inboxQuery(ctx).
+		Where("c.user_id = ? AND c.conversation_key = ?", userID, conversationKey).
+		Limit(1).
+		Scan(&rows).Error; err != nil {
+		return nil, err
+	}
+	if len(rows) == 0 {
+		return nil, nil
+	}
+	conversation := toConversation(&rows[0])
+	return &conversation, nil
+}
+
+// UpdateConversationSettings 修改会话的免打扰、置顶和归档设置，nil 字段保持不变
+func (s *SocialDB) UpdateConversationSettings(ctx context.Context, userID int64, conversationKey string, settings *model.ConversationSettings) error {
+	updates := make(map[string]any, 3)
+	if settings.Muted != nil {
+		updates["muted"] = *settings.Muted
+	}
+	if settings.Pinned != nil {
+		updates["pinned"] = *settings.Pinned
+	}
+	if settings.Archived != nil {
+		updates["archived"] = *settings.Archived
+	}
+	if len(updates) == 0 {
+		return nil
+	}
+	return s.db.WithContext(ctx).Model(&Conversation{}).
+		Where("user_id = ? AND conversation_key = ?", userID, conversationKey).
+		Updates(updates).Error
+}
+
+// 好友推荐相关，推荐信号来自好友、关注和互动数据
+
+// candidateRow 推荐候选人及其计数
+type candidateRow struct {
+	UserID int64
+	Count  int64
+}
+
+// scanCandidates 按候选人分组计数，取计数最高的 limit 个
+func scanCandidates(query *gorm.DB, limit int) (map[int64]int64, error) {
+	var rows []candidateRow
+	if err := query.Group("user_id").Order("count DESC").Limit(limit).Scan(&rows).Error; err != nil {
+		return nil, err
+	}
+	candidates := make(map[int64]int64, len(rows))
+	for _, row := range rows {
+		candidates[row.UserID] = row.Count
+	}
+	return candidates, nil
+}
+
+func (s *SocialDB) GetMutualFriendCandidates(ctx context.Context, userID int64, limit int) (map[int64]int64, error) {
+	query := s.db.WithContext(ctx).Table("friendships AS a").
+		Select("b.friend_id AS user_id, COUNT(DISTINCT a.friend_id) AS count").
+		Joins("JOIN friendships AS b ON b.user_id = a.friend_id AND b.status = ?", model.FriendStatusAccepted).
+		Where("a.user_id = ? AND a.status = ? AND b.friend_id <> ?", userID, model.FriendStatusAccepted, userID)
+	return scanCandidates(query, limit)
+}
+
+func (s *SocialDB) GetMutualFollowCandidates(ctx context.Context, userID int64, limit int) (map[int64]int64, error) {
+	query := s.db.WithContext(ctx).Table("follows AS a").
+		Select("b.followee_id AS user_id, COUNT(*) AS count").
+		Joins("JOIN follows AS b ON b.follower_id = a.followee_id").
+		Where("a.follower_id = ? AND b.followee_id <> ?", userID, userID)
+	return scanCandidates(query, limit)
+}
+
+func (s *SocialDB) GetSharedLikeCandidates(ctx context.Context, userID int64, limit int) (map[int64]int64, error) {
+	query := s.db.WithContext(ctx).Table("likes AS a").
+		Select("b.user_id AS user_id, COUNT(DISTINCT a.video_id) AS count").
+		Joins("JOIN likes AS b ON b.video_id = a.video_id AND b.deleted_at IS NULL").
+		Where("a.user_id = ? AND a.deleted_at IS NULL AND b.user_id <> ?", userID, userID)
+	return scanCandidates(query, limit)
+}
+
+func (s *SocialDB) GetCoCommentCandidates(ctx context.Context, userID int64, limit int) (map[int64]int64, error) {
+	visible := interactionmodel.CommentStatusVisible
+	query := s.db.WithContext(ctx).Table("comments AS a").
+		Select("b.user_id AS user_id, COUNT(DISTINCT a.video_id) AS count").
+		Joins("JOIN comments AS b ON b.video_id = a.video_id AND b.status = ? AND b.deleted_at IS NULL", visible).
+		Where("a.user_id = ? AND a.status = ? AND a.deleted_at IS NULL AND b.user_id <> ?", userID, visible, userID)
+	return scanCandidates(query, limit)
+}
+
+func (s *SocialDB) GetConnectedUsers(ctx context.Context, userID int64, userIDs []int64) (map[int64]bool, error) {
+	connected := make(map[int64]bool, len(userIDs))
+	if len(userIDs) == 0 {
+		return connected, nil
+	}
+	var friendIDs, followeeIDs []int64
+	if err := s.db.WithContext(ctx).Model(&Friendship{}).
+		Where("user_id = ? AND friend_id IN ? AND status = ?", userID, userIDs, model.FriendStatusAccepted).
+		Pluck("friend_id", &friendIDs).Error; err != nil {
+		return nil, err
+	}
+	if err := s.db.WithContext(ctx).Table("follows").
+		Where("follower_id = ? AND followee_id IN ?", userID, userIDs).
+		Pluck("followee_id", &followeeIDs).Error; err != nil {
+		return nil, err
+	}
+	for _, id := range append(friendIDs, followeeIDs...) {
+		connected[id] = true
+	}
+	return connected, nil
+}
+
+// 通知相关，通知按接收者分区消费，同一用户的事件串行合并
+
+func (s *SocialDB) AddNotificationActor(ctx context.Context, event *model.NotificationEvent) (*model.Notification, bool, error) {
+	var dbNotification Notification
+	added := false
+	err := s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("user_id = ? AND type = ? AND target_id = ? AND is_read = ?", event.UserID, event.Type, event.TargetID, false).
+			Order("id DESC").Limit(1).Find(&dbNotification).Error; err != nil {
+			return err
+		}
+		if dbNotification.ID == 0 {
+			dbNotification = Notification{
+				UserID:      event.UserID,
+				Type:        event.Type,
+				TargetID:    event.TargetID,
+				LastActorAt: event.CreatedAt,
+			}
+			if err := tx.Create(&dbNotification).Error; err != nil {
+				return err
+			}
+		}
+		result := tx.Clauses(clause.OnConflict{DoNothing: true}).
+			Create(&NotificationActor{NotificationID: dbNotification.ID, ActorID: event.ActorID})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return nil
+		}
+		added = true
+		dbNotification.ActorCount++
+		dbNotification.LastActorAt = max(dbNotification.LastActorAt, event.CreatedAt)
+		return tx.Model(&Notification{}).Where("id = ?", dbNotification.ID).Updates(map[string]any{
+			"actor_count":   gorm.Expr("actor_count + 1"),
+			"last_actor_at": dbNotification.LastActorAt,
+		}).Error
+	})
+	if err != nil || !added {
+		return nil, false, err
+	}
+	actors, err := s.getRecentNotificationActors(ctx, []int64{dbNotification.ID})
+	if err != nil {
+		return nil, false, err
+	}
+	notification := toNotification(&dbNotification, actors[dbNotification.ID])
+	return &notification, true, nil
+}
+
+func (s *SocialDB) GetNotifications(ctx context.Context, userID int64, notificationType int8, page, size int) ([]model.Notification, int64, error) {
+	var dbNotifications []Notification
+	var total int64
+
+	query := s.db.WithContext(ctx).Model(&Notification{}).Where("user_id = ?", userID)
+	if notificationType != 0 {
+		query = query.Where("type = ?", notificationType)
+	}
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	offset := (page - 1) * size
+	if err := query.Order("last_actor_at DESC, id DESC").
+		Offset(offset).Limit(size).
+		Find(&dbNotifications).Error; err != nil {
+		return nil, 0, err
+	}
+
+	ids := make([]int64, len(dbNotifications))
+	for i, n := range dbNotifications {
+		ids[i] = n.ID
+	}
+	actors, err := s.getRecentNotificationActors(ctx, ids)
+	if err != nil {
+		return nil, 0, err
+	}
+	notifications := make([]model.Notification, len(dbNotifications))
+	for i, n := range dbNotifications {
+		notifications[i] = toNotification(&n, actors[n.ID])
+	}
+	return notifications, total, nil
+}
+
+// getRecentNotificationActors 获取每条通知最近的几个触发者，最新的在前
+func (s *SocialDB) getRecentNotificationActors(ctx context.Context, ids []int64) (map[int64][]int64, error) {
+	actors := make(map[int64][]int64, len(ids))
+	if len(ids) == 0 {
+		return actors, nil
+	}
+	ranked := s.db.Model(&NotificationActor{}).
+		Select("id, notification_id, actor_id, ROW_NUMBER() OVER (PARTITION BY notification_id ORDER BY id DESC) AS rn").
+		Where("notification_id IN ?", ids)
+	var rows []NotificationActor
+	if err := s.db.WithContext(ctx).Table("(?) AS ranked", ranked).
+		Select("id, notification_id, actor_id").
+		Where("rn <= ?", constants.NotificationRecentActors).
+		Order("notification_id, id DESC").
+		Scan(&rows).Error; err != nil {
+		return nil, err
+	}
+	for _, row := range rows {
+		actors[row.NotificationID] = append(actors[row.NotificationID], row.ActorID)
+	}
+	return actors, nil
+}
+
+func toNotification(dbNotification *Notification, actorIDs []int64) model.Notification {
+	return model.Notification{
+		ID:         dbNotification.ID,
+		UserID:     dbNotification.UserID,
+		Type:       dbNotification.Type,
+		TargetID:   dbNotification.TargetID,
+		ActorIDs:   actorIDs,
+		ActorCount: dbNotification.ActorCount,
+		IsRead:     dbNotification.IsRead,
+		CreatedAt:  dbNotification.CreatedAt.Unix(),
+		UpdatedAt:  dbNotification.LastActorAt,
+	}
+}
+
+func (s *SocialDB) GetNotificationUnreadCounts(ctx context.Context, userID int64) (map[int8]int64, error) {
+	var rows []struct {
+		Type  int8
+		Count int64
+	}
+	if err := s.db.WithContext(ctx).Model(&Notification{}).
+		Select("type, COUNT(*) AS count").
+		Where("user_id = ? AND is_read = ?", userID, false).
+		Group("type").
+		Scan(&rows).Error; err != nil {
+		return nil, err
+	}
+	counts := make(map[int8]int64, len(rows))
+	for _, row := range rows {
+		counts[row.Type] = row.Count
+	}
+	return counts, nil
+}
+
+func (s *SocialDB) MarkNotificationsRead(ctx context.Context, userID int64, ids []int64, notificationType int8) (int64, error) {
+	query := s.db.WithContext(ctx).Model(&Notification{}).Where("user_id = ? AND is_read = ?", userID, false)
+	if len(ids) != 0 {
+		query = query.Where("id IN ?", ids)
+	}
+	if notificationType != 0 {
+		query = query.Where("type = ?", notificationType)
+	}
+	result := query.Update("is_read", true)
+	return result.RowsAffected, result.Error
+}
+
+func (s *SocialDB) GetNotificationPreferences(ctx context.Context, userID int64) (map[int8]bool, error) {
+	var dbPreferences []NotificationPreference
+	if err := s.db.WithContext(ctx).Where("user_id = ?", userID).Find(&dbPreferences).Error; err != nil {
+		return nil, err
+	}
+	preferences := make(map[int8]bool, len(dbPreferences))
+	for _, p := range dbPreferences {
+		preferences[p.Type] = p.Enabled
+	}
+	return preferences, nil
+}
+
+func (s *SocialDB) UpdateNotificationPreferences(ctx context.Context, userID int64, preferences map[int8]bool) error {
+	if len(preferences) == 0 {
+		return nil
+	}
+	dbPreferences := make([]NotificationPreference, 0, len(preferences))
+	for t, enabled := range preferences {
+		dbPreferences = append(dbPreferences, NotificationPreference{UserID: userID, Type: t, Enabled: enabled})
+	}
+	return s.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "user_id"}, {Name: "type"}},
+		DoUpdates: clause.AssignmentColumns([]string{"enabled", "updated_at"}),
+	}).Create(&dbPreferences).Error
+}
+
+// 聊天记录归档相关，ID 随创建时间递增，按主键顺序扫描即可从最早的消息开始
+func (s *SocialDB) GetExpiredPrivateMessages(ctx context.Context, before int64, limit int) ([]model.ArchivedMessage, error) {
+	var dbMessages []PrivateMessage
+	if err := s.db.WithContext(ctx).
+		Where("created_at < ?", time.Unix(before, 0)).
+		Order("id").Limit(limit).
+		Find(&dbMessages).Error; err != nil {
+		return nil, err
+	}
+
+	messages := make([]model.ArchivedMessage, len(dbMessages))
+	for i, dbMsg := range dbMessages {
+		messages[i] = model.ArchivedMessage{
+			ID:         dbMsg.ID,
+			SenderID:   dbMsg.SenderID,
+			ReceiverID: dbMsg.ReceiverID,
+			Content:    dbMsg.Content,
+			Type:       model.MessageTypeText,
+			Seq:        dbMsg.Seq,
+			CreatedAt:  dbMsg.CreatedAt.Unix(),
+		}
+	}
+	return messages, nil
+}
+
+func (s *SocialDB) GetExpiredChatMessages(ctx context.Context, before int64, limit int) ([]model.ArchivedMessage, error) {
+	var dbMessages []ChatMessage
+	if err := s.db.WithContext(ctx).
+		Where("created_at < ?", time.Unix(before, 0)).
+		Order("id").Limit(limit).
+		Find(&dbMessages).Error; err != nil {
+		return nil, err
+	}
+
+	messages := make([]model.ArchivedMessage, len(dbMessages))
+	for i, dbMsg := range dbMessages {
+		msg := toChatMessage(&dbMsg)
+		messages[i] = model.ArchivedMessage{
+			ID:        msg.ID,
+			RoomID:    msg.RoomID,
+			SenderID:  msg.SenderID,
+			Content:   msg.Content,
+			Type:      msg.Type,
+			Seq:       msg.Seq,
+			ReplyToID: msg.ReplyToID,
+			EditedAt:  msg.EditedAt,
+			Recalled:  msg.Recalled,
+			CreatedAt: msg.CreatedAt,
+		}
+	}
+	return messages, nil
+}
+
+func (s *SocialDB) ArchiveMessages(ctx context.Context, archives []model.ChatArchive, privateIDs, chatIDs []int64) error {
+	dbArchives := make([]ChatArchive, len(archives))
+	for i, a := range archives {
+		dbArchives[i] = ChatArchive{
+			ConversationKey: a.ConversationKey,
+			FromSeq:         a.FromSeq,
+			ToSeq:           a.ToSeq,
+			MessageCount:    a.MessageCount,
+			FilePath:        a.FilePath,
+		}
+	}
+	return s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if len(dbArchives) > 0 {
+			if err := tx.Create(&dbArchives).Error; err != nil {
+				return err
+			}
+		}
+		if len(privateIDs) > 0 {
+			if err := tx.Where("id IN ?", privateIDs).Delete(&PrivateMessage{}).Error; err != nil {
+				return err
+			}
+		}
+		if len(chatIDs) > 0 {
+			if err := tx.Where("id IN ?", chatIDs).Delete(&ChatMessage{}).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func (s *SocialDB) GetChatArchives(ctx context.Context, conversationKey string) ([]model.ChatArchive, error) {
+	var dbArchives []ChatArchive
+	if err := s.db.WithContext(ctx).
+		Where("conversation_key = ?", conversationKey).
+		Order("from_seq").
+		Find(&dbArchives).Error; err != nil {
+		return nil, err
+	}
+
+	archives := make([]model.ChatArchive, len(dbArchives))
+	for i, a := range dbArchives {
+		archives[i] = model.ChatArchive{
+			ID:              a.ID,
+			ConversationKey: a.ConversationKey,
+			FromSeq:         a.FromSeq,
+			ToSeq:           a.ToSeq,
+			MessageCount:    a.MessageCount,
+			FilePath:        a.FilePath,
+			CreatedAt:       a.CreatedAt.Unix(),
+		}
 	}
-	return count, nil
+	return archives, nil
 }
@@ -4,14 +4,19 @@ import "time"
 
 // PrivateMessage 私信模型
 type PrivateMessage struct {
-	ID         int64      `json:"id"                   gorm:"primarykey"`
-	SenderID   int64      `json:"sender_id"            gorm:"index"`         // 发送者ID
-	ReceiverID int64      `json:"receiver_id"          gorm:"index"`         // 接收者ID
-	Content    string     `json:"content"              gorm:"type:text"`     // 消息内容
-	IsRead     bool       `json:"is_read"              gorm:"default:false"` // 是否已读
-	CreatedAt  time.Time  `json:"created_at"`                                // 创建时间
-	UpdatedAt  time.Time  `json:"updated_at"`                                // 更新时间
-	DeletedAt  *time.Time `json:"deleted_at,omitempty" gorm:"index"`         // 删除时间
+	ID              int64      `json:"id"                   gorm:"primarykey"`
+	SenderID        int64      `json:"sender_id"            gorm:"index"`                                                        // 发送者ID
+	ReceiverID      int64      `json:"receiver_id"          gorm:"index"`                                                        // 接收者ID
+	ConversationKey string     `json:"conversation_key"     gorm:"type:varchar(64);uniqueIndex:idx_conversation_seq,priority:1"` // 会话标识
+	Seq             int64      `json:"seq"                  gorm:"uniqueIndex:idx_conversation_seq,priority:2"`                  // 会话内序号
+	Content         string     `json:"content"              gorm:"type:text"`                                                    // 消息内容
+	IsRead          bool       `json:"is_read"              gorm:"default:false"`                                                // 是否已读
+	ReplyToID       int64      `json:"reply_to_id"          gorm:"index"`                                                        // 回复的消息ID
+	EditedAt        *time.Time `json:"edited_at,omitempty"`                                                                      // 最后编辑时间
+	RecalledAt      *time.Time `json:"recalled_at,omitempty"`                                                                    // 撤回时间
+	CreatedAt       time.Time  `json:"created_at"`                                                                               // 创建时间
+	UpdatedAt       time.Time  `json:"updated_at"`                                                                               // 更新时间
+	DeletedAt       *time.Time `json:"deleted_at,omitempty" gorm:"index"`                                                        // 删除时间
 }
 
 // ChatRoom 聊天室模型
@@ -28,26 +33,52 @@ type ChatRoom struct {
 
 // ChatRoomMember 聊天室成员模型
 type ChatRoomMember struct {
-	ID        int64      `json:"id"                   gorm:"primarykey"`
-	RoomID    int64      `json:"room_id"              gorm:"uniqueIndex:idx_room_user"` // 聊天室ID
-	UserID    int64      `json:"user_id"              gorm:"uniqueIndex:idx_room_user"` // 用户ID
-	Nickname  string     `json:"nickname"             gorm:"type:varchar(32)"`          // 在群里的昵称
-	Role      int8       `json:"role"                 gorm:"type:tinyint;default:0"`    // 角色：0=普通成员,1=管理员,2=群主
-	CreatedAt time.Time  `json:"created_at"`                                            // 创建时间
-	UpdatedAt time.Time  `json:"updated_at"`                                            // 更新时间
-	DeletedAt *time.Time `json:"deleted_at,omitempty" gorm:"index"`                     // 删除时间
+	ID         int64      `json:"id"                   gorm:"primarykey"`
+	RoomID     int64      `json:"room_id"              gorm:"uniqueIndex:idx_room_user"` // 聊天室ID
+	UserID     int64      `json:"user_id"              gorm:"uniqueIndex:idx_room_user"` // 用户ID
+	Nickname   string     `json:"nickname"             gorm:"type:varchar(32)"`          // 在群里的昵称
+	Role       int8       `json:"role"                 gorm:"type:tinyint;default:0"`    // 角色：0=普通成员,1=管理员,2=群主
+	MutedUntil *time.Time `json:"muted_until,omitempty"`                                 // 禁言截止时间
+	CreatedAt  time.Time  `json:"created_at"`                                            // 创建时间
+	UpdatedAt  time.Time  `json:"updated_at"`                                            // 更新时间
+	DeletedAt  *time.Time `json:"deleted_at,omitempty" gorm:"index"`                     // 删除时间
 }
 
 // ChatMessage 聊天消息模型
 type ChatMessage struct {
-	ID        int64      `json:"id"                   gorm:"primarykey"`
-	RoomID    int64      `json:"room_id"              gorm:"index:idx_room_created"` // 聊天室ID
-	SenderID  int64      `json:"sender_id"            gorm:"index"`                  // 发送者ID
-	Content   string     `json:"content"              gorm:"type:text"`              // 消息内容
-	Type      int8       `json:"type"                 gorm:"type:tinyint;default:0"` // 消息类型：0=文本,1=图片,2=视频,3=文件
-	CreatedAt time.Time  `json:"created_at"           gorm:"index:idx_room_created"` // 创建时间
-	UpdatedAt time.Time  `json:"updated_at"`                                         // 更新时间
-	DeletedAt *time.Time `json:"deleted_at,omitempty" gorm:"index"`                  // 删除时间
+	ID         int64      `json:"id"                   gorm:"primarykey"`
+	RoomID     int64      `json:"room_id"              gorm:"index:idx_room_created;uniqueIndex:idx_room_seq,priority:1"` // 聊天室ID
+	Seq        int64      `json:"seq"                  gorm:"uniqueIndex:idx_room_seq,priority:2"`                        // 会话内序号
+	SenderID   int64      `json:"sender_id"            gorm:"index"`                                                      // 发送者ID
+	Content    string     `json:"content"              gorm:"type:text"`                                                  // 消息内容
+	Type       int8       `json:"type"                 gorm:"type:tinyint;default:0"`                                     // 消息类型：0=文本,1=图片,2=视频,3=文件,4=系统,7=视频分享卡片
+	ReplyToID  int64      `json:"reply_to_id"          gorm:"index"`                                                      // 回复的消息ID
+	EditedAt   *time.Time `json:"edited_at,omitempty"`                                                                    // 最后编辑时间
+	RecalledAt *time.Time `json:"recalled_at,omitempty"`                                                                  // 撤回时间
+	CreatedAt  time.Time  `json:"created_at"           gorm:"index:idx_room_created"`                                     // 创建时间
+	UpdatedAt  time.Time  `json:"updated_at"`                                                                             // 更新时间
+	DeletedAt  *time.Time `json:"deleted_at,omitempty" gorm:"index"`                                                      // 删除时间
+}
+
+// ChatArchive 聊天记录归档文件模型
+type ChatArchive struct {
+	ID              int64     `json:"id"               gorm:"primarykey"`
+	ConversationKey string    `json:"conversation_key" gorm:"type:varchar(64);index:idx_conversation_seq,priority:1"` // 会话标识
+	FromSeq         int64     `json:"from_seq"         gorm:"index:idx_conversation_seq,priority:2"`                  // 起始序号
+	ToSeq           int64     `json:"to_seq"`                                                                         // 截止序号
+	MessageCount    int64     `json:"message_count"`                                                                  // 消息数
+	FilePath        string    `json:"file_path"        gorm:"type:varchar(255)"`                                      // 归档文件在对象存储中的路径
+	CreatedAt       time.Time `json:"created_at"`                                                                     // 创建时间
+}
+
+// ChatRoomInvite 聊天室邀请链接模型
+type ChatRoomInvite struct {
+	ID        int64     `json:"id"         gorm:"primarykey"`
+	Code      string    `json:"code"       gorm:"type:varchar(32);uniqueIndex"` // 邀请码
+	RoomID    int64     `json:"room_id"    gorm:"index"`                        // 聊天室ID
+	InviterID int64     `json:"inviter_id"`                                     // 创建者ID
+	ExpiresAt time.Time `json:"expires_at"`                                     // 过期时间
+	CreatedAt time.Time `json:"created_at"`                                     // 创建时间
 }
 
 // Friendship 好友关系模型
@@ -65,23 +96,76 @@ type Friendship struct {
 // FriendRequest 好友申请模型
 type FriendRequest struct {
 	ID         int64      `json:"id"                   gorm:"primarykey"`
-	SenderID   int64      `json:"sender_id"            gorm:"index"`                        // 发送者ID
-	ReceiverID int64      `json:"receiver_id"          gorm:"index"`                        // 接收者ID
-	Message    string     `json:"message"              gorm:"type:varchar(255)"`            // 申请消息
-	Status     int8       `json:"status"               gorm:"type:tinyint;default:0;index"` // 状态：0=待处理,1=已接受,2=已拒绝
-	CreatedAt  time.Time  `json:"created_at"`                                               // 创建时间
-	UpdatedAt  time.Time  `json:"updated_at"`                                               // 更新时间
-	DeletedAt  *time.Time `json:"deleted_at,omitempty" gorm:"index"`                        // 删除时间
+	SenderID   int64      `json:"sender_id"            gorm:"index;index:idx_sender_receiver"` // 发送者ID
+	ReceiverID int64      `json:"receiver_id"          gorm:"index;index:idx_sender_receiver"` // 接收者ID
+	Message    string     `json:"message"              gorm:"type:varchar(255)"`               // 申请消息
+	Status     int8       `json:"status"               gorm:"type:tinyint;default:0;index"`    // 状态：0=待处理,1=已接受,2=已拒绝,3=已撤回,4=已过期
+	CreatedAt  time.Time  `json:"created_at"`                                                  // 创建时间
+	UpdatedAt  time.Time  `json:"updated_at"`                                                  // 更新时间
+	DeletedAt  *time.Time `json:"deleted_at,omitempty" gorm:"index"`                           // 删除时间
+}
+
+// ConversationReadCursor 用户在会话中的已读游标，每个用户每个会话一行
+type ConversationReadCursor struct {
+	UserID          int64     `json:"user_id"          gorm:"primarykey;autoIncrement:false"` // 用户ID
+	ConversationKey string    `json:"conversation_key" gorm:"type:varchar(64);primarykey"`    // 会话标识
+	RoomID          int64     `json:"room_id"          gorm:"index"`                          // 聊天室ID，私信为0
+	ReadSeq         int64     `json:"read_seq"`                                               // 已读到的会话序号
+	UpdatedAt       time.Time `json:"updated_at"`                                             // 更新时间
+}
+
+// Conversation 用户收件箱中的会话，每个用户每个会话一行，随消息收发增量维护
+type Conversation struct {
+	UserID          int64     `json:"user_id"           gorm:"primarykey;autoIncrement:false"` // 用户ID
+	ConversationKey string    `json:"conversation_key"  gorm:"type:varchar(64);primarykey"`    // 会话标识
+	PeerID          int64     `json:"peer_id"`                                                 // 私信对方ID，群聊为0
+	RoomID          int64     `json:"room_id"           gorm:"index"`                          // 聊天室ID，私信为0
+	LastMessageID   int64     `json:"last_message_id"`                                         // 最后一条消息ID
+	LastSenderID    int64     `json:"last_sender_id"`                                          // 最后一条消息的发送者ID
+	LastMessageType int8      `json:"last_message_type" gorm:"type:tinyint"`                   // 最后一条消息的类型
+	LastPreview     string    `json:"last_preview"      gorm:"type:varchar(128)"`              // 最后一条消息的预览文本
+	LastActiveAt    int64     `json:"last_active_at"`                                          // 最后活跃时间（毫秒）
+	UnreadCount     int64     `json:"unread_count"`                                            // 未读消息数
+	Muted           bool      `json:"muted"`                                                   // 是否免打扰
+	Pinned          bool      `json:"pinned"`                                                  // 是否置顶
+	Archived        bool      `json:"archived"`                                                // 是否归档
+	UpdatedAt       time.Time `json:"updated_at"`                                              // 更新时间
+}
+
+// ConversationSeq 会话序号分配表，每个会话一行
+type ConversationSeq struct {
+	ConversationKey string    `json:"conversation_key" gorm:"type:varchar(64);primarykey"` // 会话标识
+	Seq             int64     `json:"seq"`                                                 // 已分配的最大序号
+	UpdatedAt       time.Time `json:"updated_at"`                                          // 更新时间
+}
+
+// Notification 聚合后的通知，同一目标上未读的同类通知合并为一行
+type Notification struct {
+	ID          int64     `json:"id"            gorm:"primarykey"`
+	UserID      int64     `json:"user_id"       gorm:"index:idx_user_latest;index:idx_aggregate"` // 接收通知的用户
+	Type        int8      `json:"type"          gorm:"type:tinyint;index:idx_aggregate"`          // 通知类型
+	TargetID    int64     `json:"target_id"     gorm:"index:idx_aggregate"`                       // 通知目标
+	ActorCount  int64     `json:"actor_count"`                                                    // 触发者总数
+	IsRead      bool      `json:"is_read"       gorm:"index:idx_aggregate"`                       // 是否已读
+	LastActorAt int64     `json:"last_actor_at" gorm:"index:idx_user_latest"`                     // 最后一次合并的时间（秒）
+	CreatedAt   time.Time `json:"created_at"`                                                     // 创建时间
+	UpdatedAt   time.Time `json:"updated_at"`                                                     // 更新时间
 }
 
-// MessageReadStatus 消息已读状态模型
-type MessageReadStatus struct {
-	ID        int64      `json:"id"         gorm:"primarykey"`
-	MessageID int64      `json:"message_id" gorm:"uniqueIndex:idx_message_user"` // 消息ID
-	UserID    int64      `json:"user_id"    gorm:"uniqueIndex:idx_message_user"` // 用户ID
-	IsRead    bool       `json:"is_read"    gorm:"default:false"`                // 是否已读
-	ReadAt    *time.Time `json:"read_at"`                                        // 读取时间
-	CreatedAt time.Time  `json:"created_at"`                                     // 创建时间
+// NotificationActor 通知的触发者，每条通知每个用户一行
+type NotificationActor struct {
+	ID             int64     `json:"id"              gorm:"primarykey"`
+	NotificationID int64     `json:"notification_id" gorm:"uniqueIndex:idx_notification_actor"` // 通知ID
+	ActorID        int64     `json:"actor_id"        gorm:"uniqueIndex:idx_notification_actor"` // 触发者ID
+	CreatedAt      time.Time `json:"created_at"`                                                // 创建时间
+}
+
+// NotificationPreference 用户的通知偏好，没有记录的类型默认接收
+type NotificationPreference struct {
+	UserID    int64     `json:"user_id"    gorm:"primarykey;autoIncrement:false"` // 用户ID
+	Type      int8      `json:"type"       gorm:"type:tinyint;primarykey"`        // 通知类型
+	Enabled   bool      `json:"enabled"`                                          // 是否接收
+	UpdatedAt time.Time `json:"updated_at"`                                       // 更新时间
 }
 
 // TableName 指定表名
@@ -97,6 +181,10 @@ func (ChatRoomMember) TableName() string {
 	return "chat_room_members"
 }
 
+func (ChatRoomInvite) TableName() string {
+	return "chat_room_invites"
+}
+
 func (ChatMessage) TableName() string {
 	return "chat_messages"
 }
@@ -109,6 +197,30 @@ func (FriendRequest) TableName() string {
 	return "friend_requests"
 }
 
-func (MessageReadStatus) TableName() string {
-	return "message_read_status"
+func (ConversationReadCursor) TableName() string {
+	return "conversation_read_cursors"
+}
+
+func (Conversation) TableName() string {
+	return "conversations"
+}
+
+func (ChatArchive) TableName() string {
+	return "chat_archives"
+}
+
+func (ConversationSeq) TableName() string {
+	return "conversation_seqs"
+}
+
+func (Notification) TableName() string {
+	return "notifications"
+}
+
+func (NotificationActor) TableName() string {
+	return "notification_actors"
+}
+
+func (NotificationPreference) TableName() string {
+	return "notification_preferences"
 }
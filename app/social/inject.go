@@ -1,14 +1,21 @@
 package social
 
 import (
+	"context"
+
 	"github.com/yxrxy/videoHub/app/social/controllers/rpc"
 	"github.com/yxrxy/videoHub/app/social/domain/service"
 	"github.com/yxrxy/videoHub/app/social/infrastructure/cache"
+	"github.com/yxrxy/videoHub/app/social/infrastructure/es"
+	"github.com/yxrxy/videoHub/app/social/infrastructure/mq"
 	"github.com/yxrxy/videoHub/app/social/infrastructure/mysql"
 	"github.com/yxrxy/videoHub/app/social/usecase"
+	userservice "github.com/yxrxy/videoHub/app/user/domain/service"
+	usermysql "github.com/yxrxy/videoHub/app/user/infrastructure/mysql"
 	"github.com/yxrxy/videoHub/config"
 	"github.com/yxrxy/videoHub/kitex_gen/social"
 	"github.com/yxrxy/videoHub/pkg/base/client"
+	"github.com/yxrxy/videoHub/pkg/kafka"
 )
 
 func InjectSocialHandler() social.SocialService {
@@ -21,10 +28,22 @@ func InjectSocialHandler() social.SocialService {
 	if err != nil {
 		panic(err)
 	}
+	elastic, err := client.NewEsVideoClient()
+	if err != nil {
+		panic(err)
+	}
 	db := mysql.NewSocialDB(gormDB)
 	cache0 := cache.NewSocialCache(re)
 
-	svc := service.NewSocialService(db, cache0)
+	privacy := userservice.NewPrivacyGuard(usermysql.NewUserDB(gormDB))
+	notificationMQ := mq.NewNotificationMQ(kafka.NewKafkaInstance())
+	messageES := es.NewMessageElastic(elastic)
+
+	svc := service.NewSocialService(db, cache0, privacy, notificationMQ, messageES)
+	svc.StartRecommendationRefresher(context.Background())
+	svc.ConsumeNotificationEvents(context.Background())
+	svc.ConsumeMessageIndexEvents(context.Background())
+	svc.StartChatRetention(context.Background())
 	uc := usecase.NewSocialCase(db, cache0, svc)
 
 	return rpc.NewSocialHandler(uc)
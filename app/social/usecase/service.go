@@ -2,17 +2,15 @@ package usecase
 
 import (
 	"context"
-	"errors"
+	"time"
 
 	"github.com/yxrxy/videoHub/app/social/domain/model"
+	"github.com/yxrxy/videoHub/pkg/errno"
 )
 
 // SendPrivateMessage 私信相关
-func (s *useCase) SendPrivateMessage(ctx context.Context, senderID, receiverID int64, content string) error {
-	if err := s.svc.SavePrivateMessage(ctx, senderID, receiverID, content); err != nil {
-		return err
-	}
-	return nil
+func (s *useCase) SendPrivateMessage(ctx context.Context, senderID, receiverID int64, content string, replyToID int64) (*model.PrivateMessage, error) {
+	return s.svc.SavePrivateMessage(ctx, senderID, receiverID, content, replyToID)
 }
 
 // GetPrivateMessages 获取私信列表
@@ -24,20 +22,23 @@ func (s *useCase) GetPrivateMessages(ctx context.Context, senderID, receiverID i
 
 	// 转换为domain类型
 	domainMessages := make([]*model.PrivateMessage, 0, len(messages))
-	for _, msg := range messages {
-		domainMsg := &model.PrivateMessage{
-			ID:         msg.ID,
-			SenderID:   msg.SenderID,
-			ReceiverID: msg.ReceiverID,
-			Content:    msg.Content,
-			IsRead:     msg.IsRead,
-		}
-		domainMessages = append(domainMessages, domainMsg)
+	for i := range messages {
+		domainMessages = append(domainMessages, &messages[i])
 	}
 
 	return domainMessages, nil
 }
 
+// EditPrivateMessage 编辑私信
+func (s *useCase) EditPrivateMessage(ctx context.Context, operatorID, messageID int64, content string) (*model.PrivateMessage, error) {
+	return s.svc.EditPrivateMessage(ctx, operatorID, messageID, content)
+}
+
+// RecallPrivateMessage 撤回私信
+func (s *useCase) RecallPrivateMessage(ctx context.Context, operatorID, messageID int64) (*model.PrivateMessage, error) {
+	return s.svc.RecallPrivateMessage(ctx, operatorID, messageID)
+}
+
 // CreateChatRoom 聊天室相关
 func (s *useCase) CreateChatRoom(ctx context.Context, name string, creatorID int64, roomType int8, memberIDs []int64) (*model.ChatRoom, error) {
 	room := &model.ChatRoom{
@@ -46,7 +47,7 @@ func (s *useCase) CreateChatRoom(ctx context.Context, name string, creatorID int
 		Type:      roomType,
 	}
 
-	members, err := s.svc.CreateChatRoom(ctx, name, creatorID, memberIDs)
+	members, err := s.svc.CreateChatRoom(ctx, name, creatorID, roomType, memberIDs)
 	if err != nil {
 		return nil, err
 	}
@@ -69,7 +70,11 @@ func (s *useCase) CreateChatRoom(ctx context.Context, name string, creatorID int
 	return domainRoom, nil
 }
 
-func (s *useCase) GetChatRoom(ctx context.Context, roomID int64) (*model.ChatRoom, error) {
+// GetChatRoom 获取聊天室信息，仅成员可查看
+func (s *useCase) GetChatRoom(ctx context.Context, roomID, userID int64) (*model.ChatRoom, error) {
+	if _, err := s.svc.RequireChatRoomMember(ctx, roomID, userID); err != nil {
+		return nil, err
+	}
 	room, err := s.db.GetChatRoom(ctx, roomID)
 	if err != nil {
 		return nil, err
@@ -107,22 +112,113 @@ func (s *useCase) GetUserChatRooms(ctx context.Context, userID int64) ([]*model.
 	return domainRooms, nil
 }
 
-// 聊天消息相关
-func (s *useCase) SendChatMessage(ctx context.Context, roomID, senderID int64, content string, msgType int8) error {
+// AddChatRoomMembers 添加聊天室成员
+func (s *useCase) AddChatRoomMembers(ctx context.Context, operatorID, roomID int64, memberIDs []int64) ([]int64, *model.ChatMessage, error) {
+	return s.svc.AddChatRoomMembers(ctx, operatorID, roomID, memberIDs)
+}
+
+// RemoveChatRoomMember 移除聊天室成员
+func (s *useCase) RemoveChatRoomMember(ctx context.Context, operatorID, roomID, userID int64) (*model.ChatMessage, error) {
+	return s.svc.RemoveChatRoomMember(ctx, operatorID, roomID, userID)
+}
+
+// LeaveChatRoom 退出聊天室
+func (s *useCase) LeaveChatRoom(ctx context.Context, userID, roomID int64) (*model.ChatMessage, error) {
+	return s.svc.LeaveChatRoom(ctx, userID, roomID)
+}
+
+// CheckChatRoomMember 检查用户是否是聊天室成员
+func (s *useCase) CheckChatRoomMember(ctx context.Context, roomID, userID int64) (bool, error) {
+	return s.db.IsChatRoomMember(ctx, roomID, userID)
+}
+
+// CheckPrivateChat 检查用户能否私信对方
+func (s *useCase) CheckPrivateChat(ctx context.Context, userID, peerID int64) (bool, error) {
+	return s.svc.CanDirectMessage(ctx, userID, peerID)
+}
+
+// SetChatRoomAdmin 设置或取消管理员
+func (s *useCase) SetChatRoomAdmin(ctx context.Context, operatorID, roomID, userID int64, isAdmin bool) (*model.ChatMessage, error) {
+	return s.svc.SetChatRoomAdmin(ctx, operatorID, roomID, userID, isAdmin)
+}
+
+// TransferChatRoom 转让群主
+func (s *useCase) TransferChatRoom(ctx context.Context, operatorID, roomID, newOwnerID int64) (*model.ChatMessage, error) {
+	return s.svc.TransferChatRoom(ctx, operatorID, roomID, newOwnerID)
+}
+
+// MuteChatRoomMember 禁言或解除禁言成员
+func (s *useCase) MuteChatRoomMember(ctx context.Context, operatorID, roomID, userID, duration int64) (int64, *model.ChatMessage, error) {
+	return s.svc.MuteChatRoomMember(ctx, operatorID, roomID, userID, duration)
+}
+
+// RenameChatRoom 修改聊天室名称
+func (s *useCase) RenameChatRoom(ctx context.Context, operatorID, roomID int64, name string) (*model.ChatMessage, error) {
+	return s.svc.RenameChatRoom(ctx, operatorID, roomID, name)
+}
+
+// DisbandChatRoom 解散聊天室
+func (s *useCase) DisbandChatRoom(ctx context.Context, operatorID, roomID int64) ([]int64, *model.ChatMessage, error) {
+	return s.svc.DisbandChatRoom(ctx, operatorID, roomID)
+}
+
+// CreateChatRoomInvite 创建邀请链接
+func (s *useCase) CreateChatRoomInvite(ctx context.Context, operatorID, roomID, ttl int64) (*model.ChatRoomInvite, error) {
+	return s.svc.CreateChatRoomInvite(ctx, operatorID, roomID, ttl)
+}
+
+// JoinChatRoomByInvite 通过邀请链接加入聊天室
+func (s *useCase) JoinChatRoomByInvite(ctx context.Context, userID int64, code string) (int64, *model.ChatMessage, error) {
+	return s.svc.JoinChatRoomByInvite(ctx, userID, code)
+}
+
+// SendChatMessage 聊天消息相关，仅未被禁言的成员可发送，内容按消息类型校验
+func (s *useCase) SendChatMessage(ctx context.Context, roomID, senderID int64, content string, msgType int8, replyToID int64) (*model.ChatMessage, error) {
+	if err := s.svc.ValidateChatMessage(ctx, roomID, msgType, content, replyToID); err != nil {
+		return nil, err
+	}
+	member, err := s.svc.RequireChatRoomMember(ctx, roomID, senderID)
+	if err != nil {
+		return nil, err
+	}
+	if member.IsMuted(time.Now().Unix()) {
+		return nil, errno.AuthNoOperatePermission.WithMessage("you are muted in this room")
+	}
 	msg := &model.ChatMessage{
-		RoomID:   roomID,
-		SenderID: senderID,
-		Content:  content,
-		Type:     msgType,
+		RoomID:    roomID,
+		SenderID:  senderID,
+		Content:   content,
+		Type:      msgType,
+		ReplyToID: replyToID,
 	}
 	if err := s.db.SendChatMessage(ctx, msg); err != nil {
-		return err
+		return nil, err
 	}
+	s.svc.IndexChatMessage(ctx, msg)
 
-	return nil
+	return msg, nil
 }
 
-func (s *useCase) GetChatMessages(ctx context.Context, roomID int64, page, size int32) ([]*model.ChatMessage, error) {
+// UploadChatAttachment 上传聊天附件
+func (s *useCase) UploadChatAttachment(ctx context.Context, uploaderID int64, fileName, contentType string, data []byte) (*model.ChatAttachment, error) {
+	return s.svc.SaveChatAttachment(ctx, uploaderID, fileName, contentType, data)
+}
+
+// EditChatMessage 编辑聊天消息
+func (s *useCase) EditChatMessage(ctx context.Context, operatorID, messageID int64, content string) (*model.ChatMessage, error) {
+	return s.svc.EditChatMessage(ctx, operatorID, messageID, content)
+}
+
+// RecallChatMessage 撤回聊天消息
+func (s *useCase) RecallChatMessage(ctx context.Context, operatorID, messageID int64) (*model.ChatMessage, error) {
+	return s.svc.RecallChatMessage(ctx, operatorID, messageID)
+}
+
+// GetChatMessages 获取聊天消息列表，仅成员可读取
+func (s *useCase) GetChatMessages(ctx context.Context, roomID, userID int64, page, size int32) ([]*model.ChatMessage, error) {
+	if _, err := s.svc.RequireChatRoomMember(ctx, roomID, userID); err != nil {
+		return nil, err
+	}
 	messages, _, err := s.db.GetChatMessages(ctx, roomID, int(page), int(size))
 	if err != nil {
 		return nil, err
@@ -132,11 +228,16 @@ func (s *useCase) GetChatMessages(ctx context.Context, roomID int64, page, size
 	domainMessages := make([]*model.ChatMessage, 0, len(messages))
 	for _, msg := range messages {
 		domainMsg := &model.ChatMessage{
-			ID:       msg.ID,
-			RoomID:   msg.RoomID,
-			SenderID: msg.SenderID,
-			Content:  msg.Content,
-			Type:     msg.Type,
+			ID:        msg.ID,
+			RoomID:    msg.RoomID,
+			SenderID:  msg.SenderID,
+			Content:   msg.Content,
+			Type:      msg.Type,
+			ReplyToID: msg.ReplyToID,
+			EditedAt:  msg.EditedAt,
+			Recalled:  msg.Recalled,
+			Seq:       msg.Seq,
+			CreatedAt: msg.CreatedAt,
 		}
 		domainMessages = append(domainMessages, domainMsg)
 	}
@@ -145,18 +246,8 @@ func (s *useCase) GetChatMessages(ctx context.Context, roomID int64, page, size
 }
 
 // 好友关系相关
-func (s *useCase) AddFriend(ctx context.Context, userID, friendID int64) error {
-	// 检查是否已经是好友
-	if friendship, err := s.db.GetFriendship(ctx, userID, friendID); err == nil && friendship != nil {
-		return errors.New("already friends")
-	}
-
-	friendship := &model.Friendship{
-		UserID:   userID,
-		FriendID: friendID,
-		Status:   model.FriendStatusPending,
-	}
-	return s.db.AddFriend(ctx, friendship)
+func (s *useCase) AddFriend(ctx context.Context, userID, friendID int64) (*model.FriendRequest, error) {
+	return s.svc.SendFriendRequest(ctx, userID, friendID, "")
 }
 
 func (s *useCase) GetFriendship(ctx context.Context, userID, friendID int64) (*model.Friendship, error) {
@@ -198,51 +289,101 @@ func (s *useCase) GetUserFriends(ctx context.Context, userID int64) ([]*model.Fr
 }
 
 // 好友申请相关 gateway
-func (s *useCase) CreateFriendRequest(ctx context.Context, senderID, receiverID int64, message string) error {
-	request := &model.FriendRequest{
-		SenderID:   senderID,
-		ReceiverID: receiverID,
-		Message:    message,
-		Status:     model.FriendRequestStatusPending,
-	}
-	if err := s.db.CreateFriendRequest(ctx, request); err != nil {
-		return err
-	}
-
-	return nil
+func (s *useCase) CreateFriendRequest(ctx context.Context, senderID, receiverID int64, message string) (*model.FriendRequest, error) {
+	return s.svc.SendFriendRequest(ctx, senderID, receiverID, message)
 }
 
-func (s *useCase) GetFriendRequests(ctx context.Context, userID int64, status int8) ([]*model.FriendRequest, error) {
-	requests, err := s.db.GetFriendRequests(ctx, userID, status)
-	if err != nil {
-		return nil, err
-	}
-
-	// 转换为domain类型
-	domainRequests := make([]*model.FriendRequest, 0, len(requests))
-	for _, request := range requests {
-		domainRequest := &model.FriendRequest{
-			ID:         request.ID,
-			SenderID:   request.SenderID,
-			ReceiverID: request.ReceiverID,
-			Message:    request.Message,
-			Status:     request.Status,
-		}
-		domainRequests = append(domainRequests, domainRequest)
-	}
+func (s *useCase) GetFriendRequests(ctx context.Context, userID int64, sent bool) ([]*model.FriendRequest, error) {
+	return s.svc.GetFriendRequests(ctx, userID, sent)
+}
 
-	return domainRequests, nil
+func (s *useCase) HandleFriendRequest(ctx context.Context, requestID, userID int64, action int8) (*model.FriendRequest, error) {
+	return s.svc.HandleFriendRequest(ctx, requestID, userID, action)
 }
 
-func (s *useCase) HandleFriendRequest(ctx context.Context, requestID int64, status int8) error {
-	return s.db.UpdateFriendRequest(ctx, requestID, status)
+// GetFriendRecommendations 获取好友推荐
+func (s *useCase) GetFriendRecommendations(ctx context.Context, userID int64, limit int32) ([]*model.Recommendation, error) {
+	return s.svc.GetRecommendations(ctx, userID, int(limit))
 }
 
 // MarkMessageRead 消息已读状态相关
-func (s *useCase) MarkMessageRead(ctx context.Context, messageID, userID int64) error {
-	return s.db.MarkMessageRead(ctx, messageID, userID)
+func (s *useCase) MarkMessageRead(ctx context.Context, messageID, userID int64) (*model.ReadCursor, error) {
+	return s.svc.MarkMessageRead(ctx, messageID, userID)
 }
 
 func (s *useCase) GetUnreadMessageCount(ctx context.Context, userID int64) (int64, error) {
-	return s.db.GetUnreadMessageCount(ctx, userID)
+	_, total, err := s.svc.GetUnreadCounts(ctx, userID)
+	return total, err
+}
+
+// MarkConversationsRead 批量标记会话已读
+func (s *useCase) MarkConversationsRead(ctx context.Context, userID int64, conversations []model.ReadCursor) ([]*model.ReadCursor, error) {
+	return s.svc.MarkConversationsRead(ctx, userID, conversations)
+}
+
+// GetUnreadCounts 获取各会话未读数
+func (s *useCase) GetUnreadCounts(ctx context.Context, userID int64) ([]*model.UnreadCount, int64, error) {
+	return s.svc.GetUnreadCounts(ctx, userID)
+}
+
+// GetInbox 分页获取收件箱
+func (s *useCase) GetInbox(ctx context.Context, userID int64, cursor string, limit int32, archived bool) (*model.InboxPage, error) {
+	return s.svc.GetInbox(ctx, userID, cursor, int(limit), archived)
+}
+
+// UpdateConversation 修改会话设置
+func (s *useCase) UpdateConversation(ctx context.Context, userID, peerID, roomID int64, settings *model.ConversationSettings) (*model.Conversation, error) {
+	return s.svc.UpdateConversation(ctx, userID, peerID, roomID, settings)
+}
+
+// SyncMessages 同步会话中序号大于 afterSeq 的消息
+func (s *useCase) SyncMessages(ctx context.Context, userID, peerID, roomID, afterSeq int64, limit int32) (*model.SyncResult, error) {
+	return s.svc.SyncMessages(ctx, userID, peerID, roomID, afterSeq, int(limit))
+}
+
+// GetNotifications 分页获取通知
+func (s *useCase) GetNotifications(ctx context.Context, userID int64, notificationType int8, page, size int32) ([]model.Notification, int64, error) {
+	return s.svc.GetNotifications(ctx, userID, notificationType, int(page), int(size))
+}
+
+// GetNotificationUnreadCount 获取各类型的未读通知数
+func (s *useCase) GetNotificationUnreadCount(ctx context.Context, userID int64) (map[int8]int64, int64, error) {
+	return s.svc.GetNotificationUnreadCount(ctx, userID)
+}
+
+// MarkNotificationsRead 标记通知已读
+func (s *useCase) MarkNotificationsRead(ctx context.Context, userID int64, ids []int64, notificationType int8) (int64, error) {
+	return s.svc.MarkNotificationsRead(ctx, userID, ids, notificationType)
+}
+
+// GetNotificationPreferences 获取通知偏好
+func (s *useCase) GetNotificationPreferences(ctx context.Context, userID int64) ([]model.NotificationPreference, error) {
+	return s.svc.GetNotificationPreferences(ctx, userID)
+}
+
+// UpdateNotificationPreferences 修改通知偏好
+func (s *useCase) UpdateNotificationPreferences(
+	ctx context.Context, userID int64, preferences []model.NotificationPreference,
+) ([]model.NotificationPreference, error) {
+	return s.svc.UpdateNotificationPreferences(ctx, userID, preferences)
+}
+
+// UpdatePresence 上报在线状态
+func (s *useCase) UpdatePresence(ctx context.Context, userIDs []int64, online bool) error {
+	return s.svc.UpdatePresence(ctx, userIDs, online)
+}
+
+// GetPresence 批量获取在线状态
+func (s *useCase) GetPresence(ctx context.Context, userID int64, userIDs []int64) ([]*model.Presence, error) {
+	return s.svc.GetPresence(ctx, userID, userIDs)
+}
+
+// SearchMessages 搜索聊天记录
+func (s *useCase) SearchMessages(ctx context.Context, query *model.MessageSearchQuery) ([]*model.MessageDocument, int64, error) {
+	return s.svc.SearchMessages(ctx, query)
+}
+
+// ExportChatHistory 导出已归档的聊天记录
+func (s *useCase) ExportChatHistory(ctx context.Context, userID, peerID, roomID int64) (*model.ChatExport, error) {
+	return s.svc.ExportChatHistory(ctx, userID, peerID, roomID)
 }
@@ -10,31 +10,79 @@ import (
 
 type SocialUseCase interface {
 	// 私信相关
-	SendPrivateMessage(ctx context.Context, senderID, receiverID int64, content string) error
+	SendPrivateMessage(ctx context.Context, senderID, receiverID int64, content string, replyToID int64) (*model.PrivateMessage, error)
 	GetPrivateMessages(ctx context.Context, senderID, receiverID int64, page, size int32) ([]*model.PrivateMessage, error)
+	EditPrivateMessage(ctx context.Context, operatorID, messageID int64, content string) (*model.PrivateMessage, error)
+	RecallPrivateMessage(ctx context.Context, operatorID, messageID int64) (*model.PrivateMessage, error)
 
 	// 聊天室相关
 	CreateChatRoom(ctx context.Context, name string, creatorID int64, roomType int8, memberIDs []int64) (*model.ChatRoom, error)
-	GetChatRoom(ctx context.Context, roomID int64) (*model.ChatRoom, error)
+	GetChatRoom(ctx context.Context, roomID, userID int64) (*model.ChatRoom, error)
 	GetUserChatRooms(ctx context.Context, userID int64) ([]*model.ChatRoom, error)
 
+	// 聊天室成员相关
+	AddChatRoomMembers(ctx context.Context, operatorID, roomID int64, memberIDs []int64) ([]int64, *model.ChatMessage, error)
+	RemoveChatRoomMember(ctx context.Context, operatorID, roomID, userID int64) (*model.ChatMessage, error)
+	LeaveChatRoom(ctx context.Context, userID, roomID int64) (*model.ChatMessage, error)
+	CheckChatRoomMember(ctx context.Context, roomID, userID int64) (bool, error)
+	CheckPrivateChat(ctx context.Context, userID, peerID int64) (bool, error)
+
+	// 群管理相关，返回的系统消息由网关推送给聊天室
+	SetChatRoomAdmin(ctx context.Context, operatorID, roomID, userID int64, isAdmin bool) (*model.ChatMessage, error)
+	TransferChatRoom(ctx context.Context, operatorID, roomID, newOwnerID int64) (*model.ChatMessage, error)
+	MuteChatRoomMember(ctx context.Context, operatorID, roomID, userID, duration int64) (int64, *model.ChatMessage, error)
+	RenameChatRoom(ctx context.Context, operatorID, roomID int64, name string) (*model.ChatMessage, error)
+	DisbandChatRoom(ctx context.Context, operatorID, roomID int64) ([]int64, *model.ChatMessage, error)
+	CreateChatRoomInvite(ctx context.Context, operatorID, roomID, ttl int64) (*model.ChatRoomInvite, error)
+	JoinChatRoomByInvite(ctx context.Context, userID int64, code string) (int64, *model.ChatMessage, error)
+
 	// 聊天消息相关
-	SendChatMessage(ctx context.Context, roomID, senderID int64, content string, msgType int8) error
-	GetChatMessages(ctx context.Context, roomID int64, page, size int32) ([]*model.ChatMessage, error)
+	SendChatMessage(ctx context.Context, roomID, senderID int64, content string, msgType int8, replyToID int64) (*model.ChatMessage, error)
+	GetChatMessages(ctx context.Context, roomID, userID int64, page, size int32) ([]*model.ChatMessage, error)
+	UploadChatAttachment(ctx context.Context, uploaderID int64, fileName, contentType string, data []byte) (*model.ChatAttachment, error)
+	EditChatMessage(ctx context.Context, operatorID, messageID int64, content string) (*model.ChatMessage, error)
+	RecallChatMessage(ctx context.Context, operatorID, messageID int64) (*model.ChatMessage, error)
 
-	// 好友关系相关
-	AddFriend(ctx context.Context, userID, friendID int64) error
+	// 好友关系相关，添加好友即发送好友申请
+	AddFriend(ctx context.Context, userID, friendID int64) (*model.FriendRequest, error)
 	GetFriendship(ctx context.Context, userID, friendID int64) (*model.Friendship, error)
 	GetUserFriends(ctx context.Context, userID int64) ([]*model.Friendship, error)
 
 	// 好友申请相关
-	CreateFriendRequest(ctx context.Context, senderID, receiverID int64, message string) error
-	GetFriendRequests(ctx context.Context, userID int64, status int8) ([]*model.FriendRequest, error)
-	HandleFriendRequest(ctx context.Context, requestID int64, status int8) error
+	CreateFriendRequest(ctx context.Context, senderID, receiverID int64, message string) (*model.FriendRequest, error)
+	GetFriendRequests(ctx context.Context, userID int64, sent bool) ([]*model.FriendRequest, error)
+	HandleFriendRequest(ctx context.Context, requestID, userID int64, action int8) (*model.FriendRequest, error)
+	GetFriendRecommendations(ctx context.Context, userID int64, limit int32) ([]*model.Recommendation, error)
 
 	// 消息已读状态相关
-	MarkMessageRead(ctx context.Context, messageID, userID int64) error
+	MarkMessageRead(ctx context.Context, messageID, userID int64) (*model.ReadCursor, error)
 	GetUnreadMessageCount(ctx context.Context, userID int64) (int64, error)
+	MarkConversationsRead(ctx context.Context, userID int64, conversations []model.ReadCursor) ([]*model.ReadCursor, error)
+	GetUnreadCounts(ctx context.Context, userID int64) ([]*model.UnreadCount, int64, error)
+
+	// 收件箱相关
+	GetInbox(ctx context.Context, userID int64, cursor string, limit int32, archived bool) (*model.InboxPage, error)
+	UpdateConversation(ctx context.Context, userID, peerID, roomID int64, settings *model.ConversationSettings) (*model.Conversation, error)
+
+	// 消息同步
+	SyncMessages(ctx context.Context, userID, peerID, roomID, afterSeq int64, limit int32) (*model.SyncResult, error)
+
+	// 通知相关
+	GetNotifications(ctx context.Context, userID int64, notificationType int8, page, size int32) ([]model.Notification, int64, error)
+	GetNotificationUnreadCount(ctx context.Context, userID int64) (map[int8]int64, int64, error)
+	MarkNotificationsRead(ctx context.Context, userID int64, ids []int64, notificationType int8) (int64, error)
+	GetNotificationPreferences(ctx context.Context, userID int64) ([]model.NotificationPreference, error)
+	UpdateNotificationPreferences(ctx context.Context, userID int64, preferences []model.NotificationPreference) ([]model.NotificationPreference, error)
+
+	// 在线状态相关
+	UpdatePresence(ctx context.Context, userIDs []int64, online bool) error
+	GetPresence(ctx context.Context, userID int64, userIDs []int64) ([]*model.Presence, error)
+
+	// 聊天记录搜索相关
+	SearchMessages(ctx context.Context, query *model.MessageSearchQuery) ([]*model.MessageDocument, int64, error)
+
+	// 聊天记录归档相关
+	ExportChatHistory(ctx context.Context, userID, peerID, roomID int64) (*model.ChatExport, error)
 }
 
 type useCase struct {
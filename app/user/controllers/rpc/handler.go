@@ -56,8 +56,10 @@ func (h *UserHandler) Login(ctx context.Context, req *user.LoginRequest) (r *use
 func (h *UserHandler) GetUserInfo(ctx context.Context, req *user.UserInfoRequest) (r *user.UserInfoResponse, err error) {
 	r = new(user.UserInfoResponse)
 
+	// 未登录时不判断是否已关注
+	viewerID, _ := uc.GetUserID(ctx)
 	var user *model.User
-	if user, err = h.useCase.GetUserInfo(ctx, req.UserId); err != nil {
+	if user, err = h.useCase.GetUserInfo(ctx, req.UserId, viewerID); err != nil {
 		return
 	}
 	r.User = pack.User(user)
@@ -92,3 +94,138 @@ func (h *UserHandler) RefreshToken(ctx context.Context, req *user.RefreshTokenRe
 	r.Base = base.BuildBaseResp(err)
 	return
 }
+
+func (h *UserHandler) Follow(ctx context.Context, req *user.FollowRequest) (r *user.FollowResponse, err error) {
+	r = new(user.FollowResponse)
+	uid, err := uc.GetUserID(ctx)
+	if err != nil {
+		return
+	}
+
+	var mutual bool
+	if mutual, err = h.useCase.Follow(ctx, uid, req.FolloweeId); err != nil {
+		return
+	}
+	r.IsMutual = mutual
+	r.Base = base.BuildBaseResp(err)
+	return
+}
+
+func (h *UserHandler) Unfollow(ctx context.Context, req *user.UnfollowRequest) (r *user.UnfollowResponse, err error) {
+	r = new(user.UnfollowResponse)
+	uid, err := uc.GetUserID(ctx)
+	if err != nil {
+		return
+	}
+
+	if err = h.useCase.Unfollow(ctx, uid, req.FolloweeId); err != nil {
+		return
+	}
+	r.Base = base.BuildBaseResp(err)
+	return
+}
+
+func (h *UserHandler) GetFollowing(ctx context.Context, req *user.FollowListRequest) (r *user.FollowListResponse, err error) {
+	r = new(user.FollowListResponse)
+
+	var page *model.FollowPage
+	if page, err = h.useCase.GetFollowing(ctx, req.UserId, req.GetCursor(), req.GetLimit()); err != nil {
+		return
+	}
+	pack.FollowPage(r, page)
+	r.Base = base.BuildBaseResp(err)
+	return
+}
+
+func (h *UserHandler) GetFollowers(ctx context.Context, req *user.FollowListRequest) (r *user.FollowListResponse, err error) {
+	r = new(user.FollowListResponse)
+
+	var page *model.FollowPage
+	if page, err = h.useCase.GetFollowers(ctx, req.UserId, req.GetCursor(), req.GetLimit()); err != nil {
+		return
+	}
+	pack.FollowPage(r, page)
+	r.Base = base.BuildBaseResp(err)
+	return
+}
+
+func (h *UserHandler) Block(ctx context.Context, req *user.BlockRequest) (r *user.BlockResponse, err error) {
+	r = new(user.BlockResponse)
+	uid, err := uc.GetUserID(ctx)
+	if err != nil {
+		return
+	}
+
+	if err = h.useCase.Block(ctx, uid, req.UserId); err != nil {
+		return
+	}
+	r.Base = base.BuildBaseResp(err)
+	return
+}
+
+func (h *UserHandler) Unblock(ctx context.Context, req *user.UnblockRequest) (r *user.UnblockResponse, err error) {
+	r = new(user.UnblockResponse)
+	uid, err := uc.GetUserID(ctx)
+	if err != nil {
+		return
+	}
+
+	if err = h.useCase.Unblock(ctx, uid, req.UserId); err != nil {
+		return
+	}
+	r.Base = base.BuildBaseResp(err)
+	return
+}
+
+func (h *UserHandler) GetBlockList(ctx context.Context, req *user.BlockListRequest) (r *user.BlockListResponse, err error) {
+	r = new(user.BlockListResponse)
+	uid, err := uc.GetUserID(ctx)
+	if err != nil {
+		return
+	}
+
+	var page *model.BlockPage
+	if page, err = h.useCase.GetBlockList(ctx, uid, req.GetCursor(), req.GetLimit()); err != nil {
+		return
+	}
+	pack.BlockPage(r, page)
+	r.Base = base.BuildBaseResp(err)
+	return
+}
+
+func (h *UserHandler) GetPrivacySettings(ctx context.Context, req *user.GetPrivacySettingsRequest) (r *user.GetPrivacySettingsResponse, err error) {
+	r = new(user.GetPrivacySettingsResponse)
+	uid, err := uc.GetUserID(ctx)
+	if err != nil {
+		return
+	}
+
+	var settings *model.PrivacySettings
+	if settings, err = h.useCase.GetPrivacySettings(ctx, uid); err != nil {
+		return
+	}
+	r.Settings = pack.PrivacySettings(settings)
+	r.Base = base.BuildBaseResp(err)
+	return
+}
+
+func (h *UserHandler) UpdatePrivacySettings(ctx context.Context, req *user.UpdatePrivacySettingsRequest) (r *user.UpdatePrivacySettingsResponse, err error) {
+	r = new(user.UpdatePrivacySettingsResponse)
+	uid, err := uc.GetUserID(ctx)
+	if err != nil {
+		return
+	}
+
+	var settings *model.PrivacySettings
+	if settings, err = h.useCase.UpdatePrivacySettings(ctx, uid, &model.PrivacySettingsUpdate{
+		DMPolicy:              req.DmPolicy,
+		LikesVisibility:       req.LikesVisibility,
+		CollectionsVisibility: req.CollectionsVisibility,
+		HideOnline:            req.HideOnline,
+	}); err != nil {
+		return
+	}
+	r.Settings = pack.PrivacySettings(settings)
+	r.Base = base.BuildBaseResp(err)
+	return
+}
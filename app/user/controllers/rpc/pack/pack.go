@@ -3,6 +3,7 @@ package pack
 import (
 	"github.com/yxrxy/videoHub/app/user/domain/model"
 	rpcmodel "github.com/yxrxy/videoHub/kitex_gen/model"
+	"github.com/yxrxy/videoHub/kitex_gen/user"
 )
 
 // User 将数据库实体转换为RPC响应实体
@@ -11,8 +12,66 @@ func User(u *model.User) *rpcmodel.User {
 		return nil
 	}
 	return &rpcmodel.User{
-		Id:       u.ID,
-		Username: u.Username,
-		Avatar:   &u.AvatarURL,
+		Id:            u.ID,
+		Username:      u.Username,
+		Avatar:        &u.AvatarURL,
+		FollowCount:   &u.FollowCount,
+		FollowerCount: &u.FollowerCount,
+		IsFollow:      &u.IsFollow,
+	}
+}
+
+// FollowUser 将关注列表中的用户转换为RPC响应实体
+func FollowUser(u *model.FollowUser) *rpcmodel.FollowUser {
+	return &rpcmodel.FollowUser{
+		Id:         u.ID,
+		Username:   u.Username,
+		Avatar:     &u.AvatarURL,
+		FollowedAt: u.FollowedAt,
+		IsMutual:   u.IsMutual,
+	}
+}
+
+// FollowPage 将关注或粉丝列表的分页结果写入响应
+func FollowPage(r *user.FollowListResponse, page *model.FollowPage) {
+	r.Users = make([]*rpcmodel.FollowUser, 0, len(page.Users))
+	for _, u := range page.Users {
+		r.Users = append(r.Users, FollowUser(u))
+	}
+	if page.HasMore {
+		r.NextCursor = &page.NextCursor
+	}
+	r.HasMore = page.HasMore
+}
+
+// BlockedUser 将黑名单中的用户转换为RPC响应实体
+func BlockedUser(u *model.BlockedUser) *rpcmodel.BlockedUser {
+	return &rpcmodel.BlockedUser{
+		Id:        u.ID,
+		Username:  u.Username,
+		Avatar:    &u.AvatarURL,
+		BlockedAt: u.BlockedAt,
+	}
+}
+
+// BlockPage 将黑名单的分页结果写入响应
+func BlockPage(r *user.BlockListResponse, page *model.BlockPage) {
+	r.Users = make([]*rpcmodel.BlockedUser, 0, len(page.Users))
+	for _, u := range page.Users {
+		r.Users = append(r.Users, BlockedUser(u))
+	}
+	if page.HasMore {
+		r.NextCursor = &page.NextCursor
+	}
+	r.HasMore = page.HasMore
+}
+
+// PrivacySettings 将隐私设置转换为RPC响应实体
+func PrivacySettings(s *model.PrivacySettings) *rpcmodel.PrivacySettings {
+	return &rpcmodel.PrivacySettings{
+		DmPolicy:              s.DMPolicy,
+		LikesVisibility:       s.LikesVisibility,
+		CollectionsVisibility: s.CollectionsVisibility,
+		HideOnline:            s.HideOnline,
 	}
 }
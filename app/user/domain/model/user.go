@@ -1,10 +1,83 @@
 package model
 
 type User struct {
-	ID           int64
-	Username     string
-	Password     string
-	AvatarURL    string
-	Token        string
-	RefreshToken string
+	ID            int64
+	Username      string
+	Password      string
+	AvatarURL     string
+	Token         string
+	RefreshToken  string
+	FollowCount   int64 // 关注数
+	FollowerCount int64 // 粉丝数
+	IsFollow      bool  // 当前登录用户是否已关注
+}
+
+// FollowStats 用户的关注数和粉丝数
+type FollowStats struct {
+	FollowCount   int64 // 关注数
+	FollowerCount int64 // 粉丝数
+}
+
+// FollowUser 关注或粉丝列表中的用户
+type FollowUser struct {
+	ID         int64  // 用户ID
+	Username   string // 用户名
+	AvatarURL  string // 头像URL
+	FollowID   int64  // 关注关系ID，用作分页游标
+	FollowedAt int64  // 关注时间
+	IsMutual   bool   // 是否互相关注
+}
+
+// FollowPage 关注或粉丝列表分页结果
+type FollowPage struct {
+	Users      []*FollowUser // 用户列表
+	NextCursor int64         // 下一页游标，没有更多时为 0
+	HasMore    bool          // 是否还有更多
+}
+
+// 私信权限
+const (
+	DMPolicyEveryone  int8 = 0 // 所有人
+	DMPolicyFollowing int8 = 1 // 仅我关注的人
+	DMPolicyNobody    int8 = 2 // 任何人都不可以
+)
+
+// 点赞、收藏的可见范围
+const (
+	VisibilityPublic    int8 = 0 // 所有人
+	VisibilityFollowers int8 = 1 // 仅我的粉丝
+	VisibilityPrivate   int8 = 2 // 仅自己
+)
+
+// PrivacySettings 用户隐私设置，未设置时均为最宽松的默认值
+type PrivacySettings struct {
+	UserID                int64 // 用户ID
+	DMPolicy              int8  // 谁可以私信我
+	LikesVisibility       int8  // 谁可以看我的点赞
+	CollectionsVisibility int8  // 谁可以看我的收藏
+	HideOnline            bool  // 是否对他人隐藏在线状态
+}
+
+// PrivacySettingsUpdate 修改隐私设置，nil 字段保持不变
+type PrivacySettingsUpdate struct {
+	DMPolicy              *int8
+	LikesVisibility       *int8
+	CollectionsVisibility *int8
+	HideOnline            *bool
+}
+
+// BlockedUser 黑名单中的用户
+type BlockedUser struct {
+	ID        int64  // 用户ID
+	Username  string // 用户名
+	AvatarURL string // 头像URL
+	BlockID   int64  // 拉黑记录ID，用作分页游标
+	BlockedAt int64  // 拉黑时间
+}
+
+// BlockPage 黑名单分页结果
+type BlockPage struct {
+	Users      []*BlockedUser // 用户列表
+	NextCursor int64          // 下一页游标，没有更多时为 0
+	HasMore    bool           // 是否还有更多
 }
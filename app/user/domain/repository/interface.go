@@ -3,6 +3,7 @@ package repository
 import (
 	"context"
 
+	socialmodel "github.com/yxrxy/videoHub/app/social/domain/model"
 	"github.com/yxrxy/videoHub/app/user/domain/model"
 )
 
@@ -14,6 +15,37 @@ type UserDB interface {
 	GetUserByID(ctx context.Context, id int64) (*model.User, error)
 	UpdateUser(ctx context.Context, user *model.User) error
 	UpdateAvatar(ctx context.Context, user *model.User) error
+
+	// 关注相关，关注关系与计数在同一事务中维护
+	// Follow 建立关注关系，已关注时返回 false
+	Follow(ctx context.Context, followerID, followeeID int64) (bool, error)
+	// Unfollow 取消关注，未关注时返回 false
+	Unfollow(ctx context.Context, followerID, followeeID int64) (bool, error)
+	IsFollowing(ctx context.Context, followerID, followeeID int64) (bool, error)
+	GetFollowStats(ctx context.Context, userID int64) (*model.FollowStats, error)
+	// GetFollowing 获取用户关注的人，按关注关系ID倒序，cursor 为 0 时从头开始
+	GetFollowing(ctx context.Context, userID, cursor int64, limit int) ([]model.FollowUser, error)
+	// GetFollowers 获取用户的粉丝，按关注关系ID倒序，cursor 为 0 时从头开始
+	GetFollowers(ctx context.Context, userID, cursor int64, limit int) ([]model.FollowUser, error)
+	// FilterFollowing 返回 userIDs 中被 followerID 关注的用户
+	FilterFollowing(ctx context.Context, followerID int64, userIDs []int64) (map[int64]bool, error)
+	// FilterFollowers 返回 userIDs 中关注了 followeeID 的用户
+	FilterFollowers(ctx context.Context, followeeID int64, userIDs []int64) (map[int64]bool, error)
+	// GetFollowedCreators 获取 followerID 关注的、粉丝数不少于 minFollowers 的用户
+	GetFollowedCreators(ctx context.Context, followerID, minFollowers int64) ([]int64, error)
+
+	// 黑名单与隐私设置相关
+	// Block 拉黑用户，已拉黑时返回 false
+	Block(ctx context.Context, blockerID, blockedID int64) (bool, error)
+	// Unblock 取消拉黑，未拉黑时返回 false
+	Unblock(ctx context.Context, blockerID, blockedID int64) (bool, error)
+	// GetBlockedUsers 获取用户的黑名单，按拉黑记录ID倒序，cursor 为 0 时从头开始
+	GetBlockedUsers(ctx context.Context, blockerID, cursor int64, limit int) ([]model.BlockedUser, error)
+	// FilterBlocked 返回 userIDs 中与 userID 存在拉黑关系的用户，任意一方拉黑对方都算
+	FilterBlocked(ctx context.Context, userID int64, userIDs []int64) (map[int64]bool, error)
+	// GetPrivacySettings 批量获取隐私设置，没有设置过的用户返回默认值
+	GetPrivacySettings(ctx context.Context, userIDs []int64) (map[int64]*model.PrivacySettings, error)
+	UpdatePrivacySettings(ctx context.Context, settings *model.PrivacySettings) error
 }
 
 type UserCache interface {
@@ -22,4 +54,16 @@ type UserCache interface {
 	SetUserRefreshToken(ctx context.Context, key string, token string) error
 	DeleteUserToken(ctx context.Context, key string) error
 	GetToken(ctx context.Context, key string) (string, error)
+
+	// 关注计数相关
+	// GetFollowStats 获取缓存的关注计数，第二个返回值表示缓存是否存在
+	GetFollowStats(ctx context.Context, userID int64) (*model.FollowStats, bool, error)
+	SetFollowStats(ctx context.Context, userID int64, stats *model.FollowStats) error
+	// IncrFollowStats 累加已缓存的关注计数，未缓存时忽略
+	IncrFollowStats(ctx context.Context, userID, followDelta, followerDelta int64) error
+}
+
+// NotificationPublisher 通知事件投递，由社交服务的通知消息队列实现
+type NotificationPublisher interface {
+	SendNotificationEvent(ctx context.Context, event *socialmodel.NotificationEvent) error
 }
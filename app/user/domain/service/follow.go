@@ -0,0 +1,154 @@
+package service
+
+import (
+	"context"
+	"log"
+	"time"
+
+	socialmodel "github.com/yxrxy/videoHub/app/social/domain/model"
+	"github.com/yxrxy/videoHub/app/user/domain/model"
+	"github.com/yxrxy/videoHub/pkg/constants"
+	"github.com/yxrxy/videoHub/pkg/errno"
+)
+
+// Follow 关注用户，重复关注不改变计数，返回关注后是否互相关注
+func (s *UserService) Follow(ctx context.Context, followerID, followeeID int64) (bool, error) {
+	if followeeID <= 0 || followerID == followeeID {
+		return false, errno.ParamVerifyError.WithMessage("invalid followee")
+	}
+	exist, err := s.db.IsUserExistByID(ctx, followeeID)
+	if err != nil {
+		return false, err
+	}
+	if !exist {
+		return false, errno.NewErrNo(errno.ServiceUserNotExist, "user not exist")
+	}
+
+	created, err := s.db.Follow(ctx, followerID, followeeID)
+	if err != nil {
+		return false, err
+	}
+	if created {
+		s.updateFollowStatsCache(ctx, followerID, followeeID, 1)
+		s.notifyFollow(ctx, followerID, followeeID)
+	}
+	return s.db.IsFollowing(ctx, followeeID, followerID)
+}
+
+// Unfollow 取消关注，未关注时直接返回
+func (s *UserService) Unfollow(ctx context.Context, followerID, followeeID int64) error {
+	if followeeID <= 0 || followerID == followeeID {
+		return errno.ParamVerifyError.WithMessage("invalid followee")
+	}
+	deleted, err := s.db.Unfollow(ctx, followerID, followeeID)
+	if err != nil {
+		return err
+	}
+	if deleted {
+		s.updateFollowStatsCache(ctx, followerID, followeeID, -1)
+	}
+	return nil
+}
+
+// notifyFollow 通知被关注者有新的粉丝，失败只记录日志
+func (s *UserService) notifyFollow(ctx context.Context, followerID, followeeID int64) {
+	if err := s.notifier.SendNotificationEvent(ctx, &socialmodel.NotificationEvent{
+		Type:      socialmodel.NotificationTypeFollow,
+		UserID:    followeeID,
+		ActorID:   followerID,
+		CreatedAt: time.Now().Unix(),
+	}); err != nil {
+		log.Printf("投递关注通知事件失败: %v", err)
+	}
+}
+
+// updateFollowStatsCache 增量更新双方的缓存计数，失败只记录日志，缓存过期后从数据库重新加载
+func (s *UserService) updateFollowStatsCache(ctx context.Context, followerID, followeeID, delta int64) {
+	if err := s.cache.IncrFollowStats(ctx, followerID, delta, 0); err != nil {
+		log.Printf("更新关注计数缓存失败: %v", err)
+	}
+	if err := s.cache.IncrFollowStats(ctx, followeeID, 0, delta); err != nil {
+		log.Printf("更新粉丝计数缓存失败: %v", err)
+	}
+}
+
+// GetFollowStats 获取关注计数，优先读缓存，未命中时从数据库加载并回填
+func (s *UserService) GetFollowStats(ctx context.Context, userID int64) (*model.FollowStats, error) {
+	stats, ok, err := s.cache.GetFollowStats(ctx, userID)
+	if err != nil {
+		log.Printf("读取关注计数缓存失败: %v", err)
+	} else if ok {
+		return stats, nil
+	}
+
+	if stats, err = s.db.GetFollowStats(ctx, userID); err != nil {
+		return nil, err
+	}
+	if err := s.cache.SetFollowStats(ctx, userID, stats); err != nil {
+		log.Printf("回填关注计数缓存失败: %v", err)
+	}
+	return stats, nil
+}
+
+// FillFollowInfo 填充用户的关注数、粉丝数以及 viewerID 是否已关注，viewerID 为 0 表示未登录
+func (s *UserService) FillFollowInfo(ctx context.Context, user *model.User, viewerID int64) error {
+	stats, err := s.GetFollowStats(ctx, user.ID)
+	if err != nil {
+		return err
+	}
+	user.FollowCount = stats.FollowCount
+	user.FollowerCount = stats.FollowerCount
+	if viewerID == 0 || viewerID == user.ID {
+		return nil
+	}
+	user.IsFollow, err = s.db.IsFollowing(ctx, viewerID, user.ID)
+	return err
+}
+
+// GetFollowing 分页获取用户关注的人，互相关注表示对方也关注了该用户
+func (s *UserService) GetFollowing(ctx context.Context, userID, cursor int64, limit int) (*model.FollowPage, error) {
+	return s.listFollows(ctx, userID, cursor, limit, s.db.GetFollowing, s.db.FilterFollowers)
+}
+
+// GetFollowers 分页获取用户的粉丝，互相关注表示该用户也关注了对方
+func (s *UserService) GetFollowers(ctx context.Context, userID, cursor int64, limit int) (*model.FollowPage, error) {
+	return s.listFollows(ctx, userID, cursor, limit, s.db.GetFollowers, s.db.FilterFollowing)
+}
+
+func (s *UserService) listFollows(
+	ctx context.Context, userID, cursor int64, limit int,
+	list func(ctx context.Context, userID, cursor int64, limit int) ([]model.FollowUser, error),
+	reverse func(ctx context.Context, userID int64, userIDs []int64) (map[int64]bool, error),
+) (*model.FollowPage, error) {
+	if userID <= 0 || cursor < 0 {
+		return nil, errno.ParamVerifyError
+	}
+	if limit <= 0 {
+		limit = constants.FollowListDefaultLimit
+	}
+	limit = min(limit, constants.FollowListMaxLimit)
+
+	// 多取一条用于判断是否还有更多
+	users, err := list(ctx, userID, cursor, limit+1)
+	if err != nil {
+		return nil, err
+	}
+	page := &model.FollowPage{HasMore: len(users) > limit}
+	users = users[:min(len(users), limit)]
+	ids := make([]int64, len(users))
+	for i := range users {
+		ids[i] = users[i].ID
+	}
+	mutual, err := reverse(ctx, userID, ids)
+	if err != nil {
+		return nil, err
+	}
+	for i := range users {
+		users[i].IsMutual = mutual[users[i].ID]
+		page.Users = append(page.Users, &users[i])
+	}
+	if page.HasMore {
+		page.NextCursor = users[len(users)-1].FollowID
+	}
+	return page, nil
+}
@@ -0,0 +1,178 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/smartystreets/goconvey/convey"
+	"github.com/stretchr/testify/mock"
+	socialmodel "github.com/yxrxy/videoHub/app/social/domain/model"
+	"github.com/yxrxy/videoHub/app/user/domain/model"
+)
+
+func TestUserService_Follow(t *testing.T) {
+	type TestCase struct {
+		Name           string
+		FolloweeID     int64
+		FolloweeExist  bool
+		Created        bool
+		FollowedBack   bool
+		ExpectedError  bool
+		ExpectedMutual bool
+	}
+
+	const followerID = int64(1)
+	testCases := []TestCase{
+		{
+			Name:          "关注用户并更新计数缓存",
+			FolloweeID:    2,
+			FolloweeExist: true,
+			Created:       true,
+		},
+		{
+			Name:           "对方已关注时为互相关注",
+			FolloweeID:     2,
+			FolloweeExist:  true,
+			Created:        true,
+			FollowedBack:   true,
+			ExpectedMutual: true,
+		},
+		{
+			Name:          "重复关注不改变计数",
+			FolloweeID:    2,
+			FolloweeExist: true,
+		},
+		{
+			Name:          "不能关注自己",
+			FolloweeID:    followerID,
+			ExpectedError: true,
+		},
+		{
+			Name:          "被关注的用户不存在",
+			FolloweeID:    3,
+			ExpectedError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		convey.Convey(tc.Name, t, func() {
+			ctx := context.Background()
+			db := new(MockDB)
+			cache := new(MockCache)
+			db.On("IsUserExistByID", ctx, tc.FolloweeID).Return(tc.FolloweeExist, nil)
+			db.On("Follow", ctx, followerID, tc.FolloweeID).Return(tc.Created, nil)
+			db.On("IsFollowing", ctx, tc.FolloweeID, followerID).Return(tc.FollowedBack, nil)
+			cache.On("IncrFollowStats", ctx, mock.Anything, mock.Anything, mock.Anything).Return(nil)
+			notifier := new(MockNotifier)
+			notifier.On("SendNotificationEvent", ctx, mock.Anything).Return(nil)
+
+			svc := NewUserService(db, cache, notifier)
+			mutual, err := svc.Follow(ctx, followerID, tc.FolloweeID)
+
+			if tc.ExpectedError {
+				convey.So(err, convey.ShouldNotBeNil)
+				db.AssertNotCalled(t, "Follow", mock.Anything, mock.Anything, mock.Anything)
+				return
+			}
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(mutual, convey.ShouldEqual, tc.ExpectedMutual)
+			if tc.Created {
+				cache.AssertCalled(t, "IncrFollowStats", ctx, followerID, int64(1), int64(0))
+				cache.AssertCalled(t, "IncrFollowStats", ctx, tc.FolloweeID, int64(0), int64(1))
+				notifier.AssertCalled(t, "SendNotificationEvent", ctx, mock.MatchedBy(func(e *socialmodel.NotificationEvent) bool {
+					return e.Type == socialmodel.NotificationTypeFollow && e.UserID == tc.FolloweeID && e.ActorID == followerID
+				}))
+			} else {
+				cache.AssertNotCalled(t, "IncrFollowStats", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+				notifier.AssertNotCalled(t, "SendNotificationEvent", mock.Anything, mock.Anything)
+			}
+		})
+	}
+}
+
+func TestUserService_FillFollowInfo(t *testing.T) {
+	type TestCase struct {
+		Name             string
+		ViewerID         int64
+		Cached           *model.FollowStats
+		Stored           *model.FollowStats
+		Following        bool
+		ExpectedStats    model.FollowStats
+		ExpectedIsFollow bool
+	}
+
+	const userID = int64(2)
+	testCases := []TestCase{
+		{
+			Name:             "命中缓存",
+			ViewerID:         1,
+			Cached:           &model.FollowStats{FollowCount: 3, FollowerCount: 5},
+			Following:        true,
+			ExpectedStats:    model.FollowStats{FollowCount: 3, FollowerCount: 5},
+			ExpectedIsFollow: true,
+		},
+		{
+			Name:          "未命中缓存时从数据库加载并回填",
+			ViewerID:      1,
+			Stored:        &model.FollowStats{FollowCount: 1, FollowerCount: 2},
+			ExpectedStats: model.FollowStats{FollowCount: 1, FollowerCount: 2},
+		},
+		{
+			Name:          "查看自己时不判断是否已关注",
+			ViewerID:      userID,
+			Cached:        &model.FollowStats{FollowerCount: 7},
+			ExpectedStats: model.FollowStats{FollowerCount: 7},
+		},
+	}
+
+	for _, tc := range testCases {
+		convey.Convey(tc.Name, t, func() {
+			ctx := context.Background()
+			db := new(MockDB)
+			cache := new(MockCache)
+			cache.On("GetFollowStats", ctx, userID).Return(tc.Cached, tc.Cached != nil, nil)
+			cache.On("SetFollowStats", ctx, userID, tc.Stored).Return(nil)
+			db.On("GetFollowStats", ctx, userID).Return(tc.Stored, nil)
+			db.On("IsFollowing", ctx, tc.ViewerID, userID).Return(tc.Following, nil)
+
+			svc := NewUserService(db, cache, new(MockNotifier))
+			user := &model.User{ID: userID}
+			err := svc.FillFollowInfo(ctx, user, tc.ViewerID)
+
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(user.FollowCount, convey.ShouldEqual, tc.ExpectedStats.FollowCount)
+			convey.So(user.FollowerCount, convey.ShouldEqual, tc.ExpectedStats.FollowerCount)
+			convey.So(user.IsFollow, convey.ShouldEqual, tc.ExpectedIsFollow)
+			if tc.Cached == nil {
+				cache.AssertCalled(t, "SetFollowStats", ctx, userID, tc.Stored)
+			}
+			if tc.ViewerID == userID {
+				db.AssertNotCalled(t, "IsFollowing", mock.Anything, mock.Anything, mock.Anything)
+			}
+		})
+	}
+}
+
+func TestUserService_GetFollowing(t *testing.T) {
+	convey.Convey("关注列表按游标分页并标记互相关注", t, func() {
+		ctx := context.Background()
+		const userID = int64(1)
+		db := new(MockDB)
+		db.On("GetFollowing", ctx, userID, int64(0), 3).Return([]model.FollowUser{
+			{ID: 4, FollowID: 30},
+			{ID: 3, FollowID: 20},
+			{ID: 2, FollowID: 10},
+		}, nil)
+		db.On("FilterFollowers", ctx, userID, []int64{4, 3}).Return(map[int64]bool{3: true}, nil)
+
+		svc := NewUserService(db, new(MockCache), new(MockNotifier))
+		page, err := svc.GetFollowing(ctx, userID, 0, 2)
+
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(page.Users, convey.ShouldHaveLength, 2)
+		convey.So(page.HasMore, convey.ShouldBeTrue)
+		convey.So(page.NextCursor, convey.ShouldEqual, 20)
+		convey.So(page.Users[0].IsMutual, convey.ShouldBeFalse)
+		convey.So(page.Users[1].IsMutual, convey.ShouldBeTrue)
+	})
+}
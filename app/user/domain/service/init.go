@@ -5,17 +5,19 @@ import (
 )
 
 type UserService struct {
-	db    repository.UserDB
-	cache repository.UserCache
+	db       repository.UserDB
+	cache    repository.UserCache
+	notifier repository.NotificationPublisher // 新增关注的通知事件投递
 }
 
-func NewUserService(db repository.UserDB, cache repository.UserCache) *UserService {
-	if db == nil || cache == nil {
-		panic("userService`s db or cache should not be nil")
+func NewUserService(db repository.UserDB, cache repository.UserCache, notifier repository.NotificationPublisher) *UserService {
+	if db == nil || cache == nil || notifier == nil {
+		panic("userService`s db or cache or notifier should not be nil")
 	}
 	svc := &UserService{
-		db:    db,
-		cache: cache,
+		db:       db,
+		cache:    cache,
+		notifier: notifier,
 	}
 	return svc
 }
@@ -0,0 +1,112 @@
+package service
+
+import (
+	"context"
+
+	"github.com/stretchr/testify/mock"
+	socialmodel "github.com/yxrxy/videoHub/app/social/domain/model"
+	"github.com/yxrxy/videoHub/app/user/domain/model"
+	"github.com/yxrxy/videoHub/app/user/domain/repository"
+)
+
+// MockDB 只实现测试用到的方法，其余方法调用时会 panic
+type MockDB struct {
+	repository.UserDB
+	mock.Mock
+}
+
+func (m *MockDB) IsUserExistByID(ctx context.Context, id int64) (bool, error) {
+	args := m.Called(ctx, id)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockDB) Follow(ctx context.Context, followerID, followeeID int64) (bool, error) {
+	args := m.Called(ctx, followerID, followeeID)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockDB) Unfollow(ctx context.Context, followerID, followeeID int64) (bool, error) {
+	args := m.Called(ctx, followerID, followeeID)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockDB) IsFollowing(ctx context.Context, followerID, followeeID int64) (bool, error) {
+	args := m.Called(ctx, followerID, followeeID)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockDB) GetFollowStats(ctx context.Context, userID int64) (*model.FollowStats, error) {
+	args := m.Called(ctx, userID)
+	result, _ := args.Get(0).(*model.FollowStats)
+	return result, args.Error(1)
+}
+
+func (m *MockDB) GetFollowing(ctx context.Context, userID, cursor int64, limit int) ([]model.FollowUser, error) {
+	args := m.Called(ctx, userID, cursor, limit)
+	result, _ := args.Get(0).([]model.FollowUser)
+	return result, args.Error(1)
+}
+
+func (m *MockDB) FilterFollowers(ctx context.Context, followeeID int64, userIDs []int64) (map[int64]bool, error) {
+	args := m.Called(ctx, followeeID, userIDs)
+	result, _ := args.Get(0).(map[int64]bool)
+	return result, args.Error(1)
+}
+
+func (m *MockDB) FilterFollowing(ctx context.Context, followerID int64, userIDs []int64) (map[int64]bool, error) {
+	args := m.Called(ctx, followerID, userIDs)
+	result, _ := args.Get(0).(map[int64]bool)
+	return result, args.Error(1)
+}
+
+func (m *MockDB) Block(ctx context.Context, blockerID, blockedID int64) (bool, error) {
+	args := m.Called(ctx, blockerID, blockedID)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockDB) FilterBlocked(ctx context.Context, userID int64, userIDs []int64) (map[int64]bool, error) {
+	args := m.Called(ctx, userID, userIDs)
+	result, _ := args.Get(0).(map[int64]bool)
+	return result, args.Error(1)
+}
+
+func (m *MockDB) GetPrivacySettings(ctx context.Context, userIDs []int64) (map[int64]*model.PrivacySettings, error) {
+	args := m.Called(ctx, userIDs)
+	result, _ := args.Get(0).(map[int64]*model.PrivacySettings)
+	return result, args.Error(1)
+}
+
+func (m *MockDB) UpdatePrivacySettings(ctx context.Context, settings *model.PrivacySettings) error {
+	args := m.Called(ctx, settings)
+	return args.Error(0)
+}
+
+type MockCache struct {
+	repository.UserCache
+	mock.Mock
+}
+
+func (m *MockCache) GetFollowStats(ctx context.Context, userID int64) (*model.FollowStats, bool, error) {
+	args := m.Called(ctx, userID)
+	result, _ := args.Get(0).(*model.FollowStats)
+	return result, args.Bool(1), args.Error(2)
+}
+
+func (m *MockCache) SetFollowStats(ctx context.Context, userID int64, stats *model.FollowStats) error {
+	args := m.Called(ctx, userID, stats)
+	return args.Error(0)
+}
+
+func (m *MockCache) IncrFollowStats(ctx context.Context, userID, followDelta, followerDelta int64) error {
+	args := m.Called(ctx, userID, followDelta, followerDelta)
+	return args.Error(0)
+}
+
+type MockNotifier struct {
+	mock.Mock
+}
+
+func (m *MockNotifier) SendNotificationEvent(ctx context.Context, event *socialmodel.NotificationEvent) error {
+	args := m.Called(ctx, event)
+	return args.Error(0)
+}
@@ -0,0 +1,267 @@
+package service
+
+import (
+	"context"
+
+	"github.com/yxrxy/videoHub/app/user/domain/model"
+	"github.com/yxrxy/videoHub/app/user/domain/repository"
+	"github.com/yxrxy/videoHub/pkg/constants"
+	"github.com/yxrxy/videoHub/pkg/errno"
+)
+
+// Block 拉黑用户，同时解除双方的关注关系
+func (s *UserService) Block(ctx context.Context, blockerID, blockedID int64) error {
+	if blockedID <= 0 || blockerID == blockedID {
+		return errno.ParamVerifyError.WithMessage("invalid user")
+	}
+	exist, err := s.db.IsUserExistByID(ctx, blockedID)
+	if err != nil {
+		return err
+	}
+	if !exist {
+		return errno.NewErrNo(errno.ServiceUserNotExist, "user not exist")
+	}
+
+	if _, err := s.db.Block(ctx, blockerID, blockedID); err != nil {
+		return err
+	}
+	if err := s.Unfollow(ctx, blockerID, blockedID); err != nil {
+		return err
+	}
+	return s.Unfollow(ctx, blockedID, blockerID)
+}
+
+// Unblock 取消拉黑，未拉黑时直接返回，已解除的关注关系不会恢复
+func (s *UserService) Unblock(ctx context.Context, blockerID, blockedID int64) error {
+	if blockedID <= 0 || blockerID == blockedID {
+		return errno.ParamVerifyError.WithMessage("invalid user")
+	}
+	_, err := s.db.Unblock(ctx, blockerID, blockedID)
+	return err
+}
+
+// GetBlockedUsers 分页获取黑名单
+func (s *UserService) GetBlockedUsers(ctx context.Context, userID, cursor int64, limit int) (*model.BlockPage, error) {
+	if cursor < 0 {
+		return nil, errno.ParamVerifyError
+	}
+	if limit <= 0 {
+		limit = constants.BlockListDefaultLimit
+	}
+	limit = min(limit, constants.BlockListMaxLimit)
+
+	// 多取一条用于判断是否还有更多
+	users, err := s.db.GetBlockedUsers(ctx, userID, cursor, limit+1)
+	if err != nil {
+		return nil, err
+	}
+	page := &model.BlockPage{HasMore: len(users) > limit}
+	users = users[:min(len(users), limit)]
+	for i := range users {
+		page.Users = append(page.Users, &users[i])
+	}
+	if page.HasMore {
+		page.NextCursor = users[len(users)-1].BlockID
+	}
+	return page, nil
+}
+
+// GetPrivacySettings 获取用户的隐私设置
+func (s *UserService) GetPrivacySettings(ctx context.Context, userID int64) (*model.PrivacySettings, error) {
+	settings, err := s.db.GetPrivacySettings(ctx, []int64{userID})
+	if err != nil {
+		return nil, err
+	}
+	return settings[userID], nil
+}
+
+// UpdatePrivacySettings 修改隐私设置，返回修改后的完整设置
+func (s *UserService) UpdatePrivacySettings(ctx context.Context, userID int64, update *model.PrivacySettingsUpdate) (*model.PrivacySettings, error) {
+	settings, err := s.GetPrivacySettings(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if update.DMPolicy != nil {
+		if *update.DMPolicy < model.DMPolicyEveryone || *update.DMPolicy > model.DMPolicyNobody {
+			return nil, errno.ParamVerifyError.WithMessage("invalid dm policy")
+		}
+		settings.DMPolicy = *update.DMPolicy
+	}
+	if update.LikesVisibility != nil {
+		if !validVisibility(*update.LikesVisibility) {
+			return nil, errno.ParamVerifyError.WithMessage("invalid likes visibility")
+		}
+		settings.LikesVisibility = *update.LikesVisibility
+	}
+	if update.CollectionsVisibility != nil {
+		if !validVisibility(*update.CollectionsVisibility) {
+			return nil, errno.ParamVerifyError.WithMessage("invalid collections visibility")
+		}
+		settings.CollectionsVisibility = *update.CollectionsVisibility
+	}
+	if update.HideOnline != nil {
+		settings.HideOnline = *update.HideOnline
+	}
+	if err := s.db.UpdatePrivacySettings(ctx, settings); err != nil {
+		return nil, err
+	}
+	return settings, nil
+}
+
+func validVisibility(v int8) bool {
+	return v >= model.VisibilityPublic && v <= model.VisibilityPrivate
+}
+
+// PrivacyGuard 拉黑与隐私设置的统一校验，社交、互动和视频服务共用
+type PrivacyGuard struct {
+	db repository.UserDB
+}
+
+func NewPrivacyGuard(db repository.UserDB) *PrivacyGuard {
+	if db == nil {
+		panic("privacyGuard`s db should not be nil")
+	}
+	return &PrivacyGuard{db: db}
+}
+
+// IsBlocked 判断两个用户之间是否存在拉黑关系，任意一方拉黑对方都算
+func (g *PrivacyGuard) IsBlocked(ctx context.Context, userID, otherID int64) (bool, error) {
+	if userID == 0 || otherID == 0 || userID == otherID {
+		return false, nil
+	}
+	blocked, err := g.db.FilterBlocked(ctx, userID, []int64{otherID})
+	if err != nil {
+		return false, err
+	}
+	return blocked[otherID], nil
+}
+
+// FilterBlocked 返回 userIDs 中与 userID 存在拉黑关系的用户
+func (g *PrivacyGuard) FilterBlocked(ctx context.Context, userID int64, userIDs []int64) (map[int64]bool, error) {
+	if userID == 0 {
+		return map[int64]bool{}, nil
+	}
+	return g.db.FilterBlocked(ctx, userID, userIDs)
+}
+
+// CheckInteract 校验 actorID 能否与 targetID 互动，如发送好友申请、评论对方的视频
+func (g *PrivacyGuard) CheckInteract(ctx context.Context, actorID, targetID int64) error {
+	blocked, err := g.IsBlocked(ctx, actorID, targetID)
+	if err != nil {
+		return err
+	}
+	if blocked {
+		return errno.AuthNoOperatePermission.WithMessage("user is blocked")
+	}
+	return nil
+}
+
+// CheckDirectMessage 校验 senderID 能否私信 receiverID，同时检查拉黑关系和接收方的私信权限
+func (g *PrivacyGuard) CheckDirectMessage(ctx context.Context, senderID, receiverID int64) error {
+	if err := g.CheckInteract(ctx, senderID, receiverID); err != nil {
+		return err
+	}
+	settings, err := g.db.GetPrivacySettings(ctx, []int64{receiverID})
+	if err != nil {
+		return err
+	}
+	switch settings[receiverID].DMPolicy {
+	case model.DMPolicyNobody:
+		return errno.AuthNoOperatePermission.WithMessage("user does not accept messages")
+	case model.DMPolicyFollowing:
+		following, err := g.db.IsFollowing(ctx, receiverID, senderID)
+		if err != nil {
+			return err
+		}
+		if !following {
+			return errno.AuthNoOperatePermission.WithMessage("user only accepts messages from people they follow")
+		}
+	}
+	return nil
+}
+
+// CanViewLikes 判断 viewerID 能否看到 ownerID 的点赞，viewerID 为 0 表示未登录
+func (g *PrivacyGuard) CanViewLikes(ctx context.Context, viewerID, ownerID int64) (bool, error) {
+	visible, err := g.filterVisible(ctx, viewerID, []int64{ownerID}, likesVisibility)
+	return visible[ownerID], err
+}
+
+// CanViewCollections 判断 viewerID 能否看到 ownerID 的收藏，viewerID 为 0 表示未登录
+func (g *PrivacyGuard) CanViewCollections(ctx context.Context, viewerID, ownerID int64) (bool, error) {
+	visible, err := g.filterVisible(ctx, viewerID, []int64{ownerID}, collectionsVisibility)
+	return visible[ownerID], err
+}
+
+// FilterLikesVisible 返回 userIDs 中点赞对 viewerID 可见的用户，用于过滤点赞列表
+func (g *PrivacyGuard) FilterLikesVisible(ctx context.Context, viewerID int64, userIDs []int64) (map[int64]bool, error) {
+	return g.filterVisible(ctx, viewerID, userIDs, likesVisibility)
+}
+
+// FilterPresenceVisible 返回 userIDs 中在线状态对 viewerID 可见的用户，
+// 本人始终可见，隐藏了在线状态或存在拉黑关系时不可见
+func (g *PrivacyGuard) FilterPresenceVisible(ctx context.Context, viewerID int64, userIDs []int64) (map[int64]bool, error) {
+	result := make(map[int64]bool, len(userIDs))
+	if len(userIDs) == 0 {
+		return result, nil
+	}
+	settings, err := g.db.GetPrivacySettings(ctx, userIDs)
+	if err != nil {
+		return nil, err
+	}
+	blocked, err := g.FilterBlocked(ctx, viewerID, userIDs)
+	if err != nil {
+		return nil, err
+	}
+	for _, id := range userIDs {
+		result[id] = id == viewerID || (!blocked[id] && !settings[id].HideOnline)
+	}
+	return result, nil
+}
+
+func likesVisibility(s *model.PrivacySettings) int8 { return s.LikesVisibility }
+
+func collectionsVisibility(s *model.PrivacySettings) int8 { return s.CollectionsVisibility }
+
+// filterVisible 按 visibility 取出的可见范围批量判断 ownerIDs 的内容是否对 viewerID 可见，
+// 本人始终可见，存在拉黑关系时始终不可见
+func (g *PrivacyGuard) filterVisible(
+	ctx context.Context, viewerID int64, ownerIDs []int64,
+	visibility func(*model.PrivacySettings) int8,
+) (map[int64]bool, error) {
+	result := make(map[int64]bool, len(ownerIDs))
+	if len(ownerIDs) == 0 {
+		return result, nil
+	}
+	settings, err := g.db.GetPrivacySettings(ctx, ownerIDs)
+	if err != nil {
+		return nil, err
+	}
+	blocked, err := g.FilterBlocked(ctx, viewerID, ownerIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	var followersOnly []int64
+	for _, id := range ownerIDs {
+		switch {
+		case id == viewerID:
+			result[id] = true
+		case blocked[id]:
+		case visibility(settings[id]) == model.VisibilityPublic:
+			result[id] = true
+		case visibility(settings[id]) == model.VisibilityFollowers && viewerID != 0:
+			followersOnly = append(followersOnly, id)
+		}
+	}
+	if len(followersOnly) == 0 {
+		return result, nil
+	}
+	following, err := g.db.FilterFollowing(ctx, viewerID, followersOnly)
+	if err != nil {
+		return nil, err
+	}
+	for _, id := range followersOnly {
+		result[id] = following[id]
+	}
+	return result, nil
+}
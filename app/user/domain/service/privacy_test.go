@@ -0,0 +1,203 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/smartystreets/goconvey/convey"
+	"github.com/stretchr/testify/mock"
+	"github.com/yxrxy/videoHub/app/user/domain/model"
+)
+
+func TestUserService_Block(t *testing.T) {
+	convey.Convey("拉黑时解除双方的关注关系", t, func() {
+		ctx := context.Background()
+		const blockerID, blockedID = int64(1), int64(2)
+		db := new(MockDB)
+		db.On("IsUserExistByID", ctx, blockedID).Return(true, nil)
+		db.On("Block", ctx, blockerID, blockedID).Return(true, nil)
+		db.On("Unfollow", ctx, blockerID, blockedID).Return(true, nil)
+		db.On("Unfollow", ctx, blockedID, blockerID).Return(false, nil)
+		cache := new(MockCache)
+		cache.On("IncrFollowStats", ctx, mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+		svc := NewUserService(db, cache, new(MockNotifier))
+		err := svc.Block(ctx, blockerID, blockedID)
+
+		convey.So(err, convey.ShouldBeNil)
+		db.AssertCalled(t, "Unfollow", ctx, blockerID, blockedID)
+		db.AssertCalled(t, "Unfollow", ctx, blockedID, blockerID)
+		cache.AssertCalled(t, "IncrFollowStats", ctx, blockerID, int64(-1), int64(0))
+		cache.AssertCalled(t, "IncrFollowStats", ctx, blockedID, int64(0), int64(-1))
+	})
+
+	convey.Convey("不能拉黑自己", t, func() {
+		svc := NewUserService(new(MockDB), new(MockCache), new(MockNotifier))
+		convey.So(svc.Block(context.Background(), 1, 1), convey.ShouldNotBeNil)
+	})
+}
+
+func TestUserService_UpdatePrivacySettings(t *testing.T) {
+	type TestCase struct {
+		Name          string
+		Update        *model.PrivacySettingsUpdate
+		ExpectedError bool
+		Expected      *model.PrivacySettings
+	}
+
+	const userID = int64(1)
+	nobody, private, invalid := model.DMPolicyNobody, model.VisibilityPrivate, int8(9)
+	testCases := []TestCase{
+		{
+			Name:     "只修改传入的字段",
+			Update:   &model.PrivacySettingsUpdate{DMPolicy: &nobody, CollectionsVisibility: &private},
+			Expected: &model.PrivacySettings{UserID: userID, DMPolicy: nobody, LikesVisibility: model.VisibilityFollowers, CollectionsVisibility: private},
+		},
+		{
+			Name:          "非法的可见范围",
+			Update:        &model.PrivacySettingsUpdate{LikesVisibility: &invalid},
+			ExpectedError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		convey.Convey(tc.Name, t, func() {
+			ctx := context.Background()
+			db := new(MockDB)
+			db.On("GetPrivacySettings", ctx, []int64{userID}).Return(map[int64]*model.PrivacySettings{
+				userID: {UserID: userID, LikesVisibility: model.VisibilityFollowers},
+			}, nil)
+			db.On("UpdatePrivacySettings", ctx, mock.Anything).Return(nil)
+
+			svc := NewUserService(db, new(MockCache), new(MockNotifier))
+			settings, err := svc.UpdatePrivacySettings(ctx, userID, tc.Update)
+
+			if tc.ExpectedError {
+				convey.So(err, convey.ShouldNotBeNil)
+				db.AssertNotCalled(t, "UpdatePrivacySettings", ctx, mock.Anything)
+				return
+			}
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(settings, convey.ShouldResemble, tc.Expected)
+		})
+	}
+}
+
+func TestPrivacyGuard_CheckDirectMessage(t *testing.T) {
+	type TestCase struct {
+		Name          string
+		Blocked       bool
+		DMPolicy      int8
+		Following     bool
+		ExpectedError bool
+	}
+
+	const senderID, receiverID = int64(1), int64(2)
+	testCases := []TestCase{
+		{
+			Name: "所有人都可以私信",
+		},
+		{
+			Name:          "存在拉黑关系时不能私信",
+			Blocked:       true,
+			ExpectedError: true,
+		},
+		{
+			Name:          "对方不接收私信",
+			DMPolicy:      model.DMPolicyNobody,
+			ExpectedError: true,
+		},
+		{
+			Name:      "对方只接收关注的人的私信且已关注发送者",
+			DMPolicy:  model.DMPolicyFollowing,
+			Following: true,
+		},
+		{
+			Name:          "对方只接收关注的人的私信但未关注发送者",
+			DMPolicy:      model.DMPolicyFollowing,
+			ExpectedError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		convey.Convey(tc.Name, t, func() {
+			ctx := context.Background()
+			db := new(MockDB)
+			db.On("FilterBlocked", ctx, senderID, []int64{receiverID}).Return(map[int64]bool{receiverID: tc.Blocked}, nil)
+			db.On("GetPrivacySettings", ctx, []int64{receiverID}).Return(map[int64]*model.PrivacySettings{
+				receiverID: {UserID: receiverID, DMPolicy: tc.DMPolicy},
+			}, nil)
+			db.On("IsFollowing", ctx, receiverID, senderID).Return(tc.Following, nil)
+
+			err := NewPrivacyGuard(db).CheckDirectMessage(ctx, senderID, receiverID)
+
+			if tc.ExpectedError {
+				convey.So(err, convey.ShouldNotBeNil)
+			} else {
+				convey.So(err, convey.ShouldBeNil)
+			}
+		})
+	}
+}
+
+func TestPrivacyGuard_FilterLikesVisible(t *testing.T) {
+	convey.Convey("按点赞可见范围和拉黑关系过滤", t, func() {
+		ctx := context.Background()
+		const viewerID = int64(1)
+		// 2 公开，3 仅粉丝且已关注，4 仅粉丝未关注，5 仅自己，6 公开但已拉黑，1 为本人
+		ids := []int64{1, 2, 3, 4, 5, 6}
+		db := new(MockDB)
+		db.On("GetPrivacySettings", ctx, ids).Return(map[int64]*model.PrivacySettings{
+			1: {UserID: 1, LikesVisibility: model.VisibilityPrivate},
+			2: {UserID: 2},
+			3: {UserID: 3, LikesVisibility: model.VisibilityFollowers},
+			4: {UserID: 4, LikesVisibility: model.VisibilityFollowers},
+			5: {UserID: 5, LikesVisibility: model.VisibilityPrivate},
+			6: {UserID: 6},
+		}, nil)
+		db.On("FilterBlocked", ctx, viewerID, ids).Return(map[int64]bool{6: true}, nil)
+		db.On("FilterFollowing", ctx, viewerID, []int64{3, 4}).Return(map[int64]bool{3: true}, nil)
+
+		visible, err := NewPrivacyGuard(db).FilterLikesVisible(ctx, viewerID, ids)
+
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(visible, convey.ShouldResemble, map[int64]bool{1: true, 2: true, 3: true, 4: false})
+	})
+
+	convey.Convey("未登录只能看到公开的点赞", t, func() {
+		ctx := context.Background()
+		ids := []int64{2, 3}
+		db := new(MockDB)
+		db.On("GetPrivacySettings", ctx, ids).Return(map[int64]*model.PrivacySettings{
+			2: {UserID: 2},
+			3: {UserID: 3, LikesVisibility: model.VisibilityFollowers},
+		}, nil)
+
+		visible, err := NewPrivacyGuard(db).FilterLikesVisible(ctx, 0, ids)
+
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(visible, convey.ShouldResemble, map[int64]bool{2: true})
+	})
+}
+
+func TestPrivacyGuard_FilterPresenceVisible(t *testing.T) {
+	convey.Convey("隐藏在线状态或存在拉黑关系时不可见", t, func() {
+		ctx := context.Background()
+		const viewerID = int64(1)
+		// 1 为本人且隐藏了在线状态，2 公开，3 隐藏了在线状态，4 已拉黑
+		ids := []int64{1, 2, 3, 4}
+		db := new(MockDB)
+		db.On("GetPrivacySettings", ctx, ids).Return(map[int64]*model.PrivacySettings{
+			1: {UserID: 1, HideOnline: true},
+			2: {UserID: 2},
+			3: {UserID: 3, HideOnline: true},
+			4: {UserID: 4},
+		}, nil)
+		db.On("FilterBlocked", ctx, viewerID, ids).Return(map[int64]bool{4: true}, nil)
+
+		visible, err := NewPrivacyGuard(db).FilterPresenceVisible(ctx, viewerID, ids)
+
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(visible, convey.ShouldResemble, map[int64]bool{1: true, 2: true, 3: false, 4: false})
+	})
+}
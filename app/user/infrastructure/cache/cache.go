@@ -2,8 +2,11 @@ package cache
 
 import (
 	"context"
+	"fmt"
+	"strconv"
 
 	"github.com/redis/go-redis/v9"
+	"github.com/yxrxy/videoHub/app/user/domain/model"
 	"github.com/yxrxy/videoHub/app/user/domain/repository"
 	"github.com/yxrxy/videoHub/pkg/constants"
 )
@@ -35,3 +38,49 @@ func (c *userCache) DeleteUserToken(ctx context.Context, key string) error {
 func (c *userCache) GetToken(ctx context.Context, key string) (string, error) {
 	return c.client.Get(ctx, key).Result()
 }
+
+const FollowStatsKey = "user:follow_stats:%d" // 关注计数 hash，field 为 follow 和 follower
+
+// incrFollowStatsIfExists 仅在计数已缓存时累加，未缓存时等待下次读取从数据库加载
+var incrFollowStatsIfExists = redis.NewScript(`
+if redis.call("EXISTS", KEYS[1]) == 1 then
+	redis.call("HINCRBY", KEYS[1], "follow", ARGV[1])
+	redis.call("HINCRBY", KEYS[1], "follower", ARGV[2])
+end
+return 0
+`)
+
+// GetFollowStats 获取缓存的关注计数，第二个返回值表示缓存是否存在
+func (c *userCache) GetFollowStats(ctx context.Context, userID int64) (*model.FollowStats, bool, error) {
+	values, err := c.client.HMGet(ctx, fmt.Sprintf(FollowStatsKey, userID), "follow", "follower").Result()
+	if err != nil {
+		return nil, false, err
+	}
+	counts := make([]int64, len(values))
+	for i, v := range values {
+		s, ok := v.(string)
+		if !ok {
+			return nil, false, nil
+		}
+		if counts[i], err = strconv.ParseInt(s, 10, 64); err != nil {
+			return nil, false, err
+		}
+	}
+	return &model.FollowStats{FollowCount: max(counts[0], 0), FollowerCount: max(counts[1], 0)}, true, nil
+}
+
+// SetFollowStats 用数据库中的计数覆盖缓存
+func (c *userCache) SetFollowStats(ctx context.Context, userID int64, stats *model.FollowStats) error {
+	key := fmt.Sprintf(FollowStatsKey, userID)
+	pipe := c.client.TxPipeline()
+	pipe.HSet(ctx, key, "follow", stats.FollowCount, "follower", stats.FollowerCount)
+	pipe.Expire(ctx, key, constants.FollowStatsExpire)
+	_, err := pipe.Exec(ctx)
+	return err
+}
+
+// IncrFollowStats 累加已缓存的关注计数
+func (c *userCache) IncrFollowStats(ctx context.Context, userID, followDelta, followerDelta int64) error {
+	key := fmt.Sprintf(FollowStatsKey, userID)
+	return incrFollowStatsIfExists.Run(ctx, c.client, []string{key}, followDelta, followerDelta).Err()
+}
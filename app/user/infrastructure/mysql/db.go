@@ -3,11 +3,13 @@ package mysql
 import (
 	"context"
 	"errors"
+	"time"
 
 	"github.com/yxrxy/videoHub/app/user/domain/model"
 	"github.com/yxrxy/videoHub/app/user/domain/repository"
 	"github.com/yxrxy/videoHub/pkg/errno"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type userDB struct {
@@ -112,3 +114,268 @@ func (u *userDB) ExistByUsername(ctx context.Context, username string) (bool, er
 	}
 	return count > 0, nil
 }
+
+// incrFollowStat 在事务中累加用户的关注计数，计数行不存在时创建，计数不会小于0
+func incrFollowStat(tx *gorm.DB, userID int64, column string, delta int64) error {
+	stat := &FollowStat{UserID: userID}
+	if delta > 0 {
+		if column == "follow_count" {
+			stat.FollowCount = delta
+		} else {
+			stat.FollowerCount = delta
+		}
+	}
+	return tx.Clauses(clause.OnConflict{
+		DoUpdates: clause.Assignments(map[string]any{
+			column:       gorm.Expr("GREATEST("+column+" + ?, 0)", delta),
+			"updated_at": gorm.Expr("VALUES(updated_at)"),
+		}),
+	}).Create(stat).Error
+}
+
+// Follow 建立关注关系并更新双方计数，已关注时不做修改
+func (u *userDB) Follow(ctx context.Context, followerID, followeeID int64) (bool, error) {
+	created := false
+	err := u.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Clauses(clause.OnConflict{DoNothing: true}).
+			Create(&Follow{FollowerID: followerID, FolloweeID: followeeID})
+		if result.Error != nil || result.RowsAffected == 0 {
+			return result.Error
+		}
+		created = true
+		if err := incrFollowStat(tx, followerID, "follow_count", 1); err != nil {
+			return err
+		}
+		return incrFollowStat(tx, followeeID, "follower_count", 1)
+	})
+	return created, err
+}
+
+// Unfollow 删除关注关系并更新双方计数，未关注时不做修改
+func (u *userDB) Unfollow(ctx context.Context, followerID, followeeID int64) (bool, error) {
+	deleted := false
+	err := u.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Where("follower_id = ? AND followee_id = ?", followerID, followeeID).Delete(&Follow{})
+		if result.Error != nil || result.RowsAffected == 0 {
+			return result.Error
+		}
+		deleted = true
+		if err := incrFollowStat(tx, followerID, "follow_count", -1); err != nil {
+			return err
+		}
+		return incrFollowStat(tx, followeeID, "follower_count", -1)
+	})
+	return deleted, err
+}
+
+// IsFollowing 判断 followerID 是否关注了 followeeID
+func (u *userDB) IsFollowing(ctx context.Context, followerID, followeeID int64) (bool, error) {
+	var count int64
+	err := u.db.WithContext(ctx).Model(&Follow{}).
+		Where("follower_id = ? AND followee_id = ?", followerID, followeeID).
+		Count(&count).Error
+	return count > 0, err
+}
+
+// GetFollowStats 获取用户的关注数和粉丝数，没有计数行时均为0
+func (u *userDB) GetFollowStats(ctx context.Context, userID int64) (*model.FollowStats, error) {
+	var stats []FollowStat
+	if err := u.db.WithContext(ctx).Where("user_id = ?", userID).Limit(1).Find(&stats).Error; err != nil {
+		return nil, err
+	}
+	if len(stats) == 0 {
+		return &model.FollowStats{}, nil
+	}
+	return &model.FollowStats{FollowCount: stats[0].FollowCount, FollowerCount: stats[0].FollowerCount}, nil
+}
+
+// followRow 关注列表查询结果
+type followRow struct {
+	FollowID   int64
+	UserID     int64
+	Username   string
+	AvatarURL  string
+	FollowedAt time.Time
+}
+
+// listFollows 按关注关系ID倒序分页，column 为过滤列，userColumn 为列表中用户所在的列
+func (u *userDB) listFollows(ctx context.Context, column, userColumn string, userID, cursor int64, limit int) ([]model.FollowUser, error) {
+	query := u.db.WithContext(ctx).Table("follows AS f").
+		Select("f.id AS follow_id, u.id AS user_id, u.username, u.avatar_url, f.created_at AS followed_at").
+		Joins("JOIN users AS u ON u.id = f."+userColumn).
+		Where("f."+column+" = ?", userID)
+	if cursor > 0 {
+		query = query.Where("f.id < ?", cursor)
+	}
+	var rows []followRow
+	if err := query.Order("f.id DESC").Limit(limit).Scan(&rows).Error; err != nil {
+		return nil, err
+	}
+
+	users := make([]model.FollowUser, len(rows))
+	for i, row := range rows {
+		users[i] = model.FollowUser{
+			ID:         row.UserID,
+			Username:   row.Username,
+			AvatarURL:  row.AvatarURL,
+			FollowID:   row.FollowID,
+			FollowedAt: row.FollowedAt.Unix(),
+		}
+	}
+	return users, nil
+}
+
+func (u *userDB) GetFollowing(ctx context.Context, userID, cursor int64, limit int) ([]model.FollowUser, error) {
+	return u.listFollows(ctx, "follower_id", "followee_id", userID, cursor, limit)
+}
+
+func (u *userDB) GetFollowers(ctx context.Context, userID, cursor int64, limit int) ([]model.FollowUser, error) {
+	return u.listFollows(ctx, "followee_id", "follower_id", userID, cursor, limit)
+}
+
+// filterFollows 返回 userIDs 中与 userID 在 column 上存在关注关系的用户
+func (u *userDB) filterFollows(ctx context.Context, column, userColumn string, userID int64, userIDs []int64) (map[int64]bool, error) {
+	result := make(map[int64]bool, len(userIDs))
+	if len(userIDs) == 0 {
+		return result, nil
+	}
+	var ids []int64
+	if err := u.db.WithContext(ctx).Model(&Follow{}).
+		Where(column+" = ? AND "+userColumn+" IN ?", userID, userIDs).
+		Pluck(userColumn, &ids).Error; err != nil {
+		return nil, err
+	}
+	for _, id := range ids {
+		result[id] = true
+	}
+	return result, nil
+}
+
+func (u *userDB) FilterFollowing(ctx context.Context, followerID int64, userIDs []int64) (map[int64]bool, error) {
+	return u.filterFollows(ctx, "follower_id", "followee_id", followerID, userIDs)
+}
+
+func (u *userDB) FilterFollowers(ctx context.Context, followeeID int64, userIDs []int64) (map[int64]bool, error) {
+	return u.filterFollows(ctx, "followee_id", "follower_id", followeeID, userIDs)
+}
+
+// GetFollowedCreators 获取关注的人中粉丝数达到 minFollowers 的用户
+func (u *userDB) GetFollowedCreators(ctx context.Context, followerID, minFollowers int64) ([]int64, error) {
+	var ids []int64
+	err := u.db.WithContext(ctx).Table("follows AS f").
+		Joins("JOIN user_follow_stats AS s ON s.user_id = f.followee_id").
+		Where("f.follower_id = ? AND s.follower_count >= ?", followerID, minFollowers).
+		Pluck("f.followee_id", &ids).Error
+	return ids, err
+}
+
+// Block 拉黑用户，已拉黑时不做修改
+func (u *userDB) Block(ctx context.Context, blockerID, blockedID int64) (bool, error) {
+	result := u.db.WithContext(ctx).Clauses(clause.OnConflict{DoNothing: true}).
+		Create(&Block{BlockerID: blockerID, BlockedID: blockedID})
+	return result.RowsAffected > 0, result.Error
+}
+
+// Unblock 取消拉黑，未拉黑时不做修改
+func (u *userDB) Unblock(ctx context.Context, blockerID, blockedID int64) (bool, error) {
+	result := u.db.WithContext(ctx).
+		Where("blocker_id = ? AND blocked_id = ?", blockerID, blockedID).
+		Delete(&Block{})
+	return result.RowsAffected > 0, result.Error
+}
+
+// blockRow 黑名单查询结果
+type blockRow struct {
+	BlockID   int64
+	UserID    int64
+	Username  string
+	AvatarURL string
+	BlockedAt time.Time
+}
+
+// GetBlockedUsers 按拉黑记录ID倒序分页获取黑名单
+func (u *userDB) GetBlockedUsers(ctx context.Context, blockerID, cursor int64, limit int) ([]model.BlockedUser, error) {
+	query := u.db.WithContext(ctx).Table("user_blocks AS b").
+		Select("b.id AS block_id, u.id AS user_id, u.username, u.avatar_url, b.created_at AS blocked_at").
+		Joins("JOIN users AS u ON u.id = b.blocked_id").
+		Where("b.blocker_id = ?", blockerID)
+	if cursor > 0 {
+		query = query.Where("b.id < ?", cursor)
+	}
+	var rows []blockRow
+	if err := query.Order("b.id DESC").Limit(limit).Scan(&rows).Error; err != nil {
+		return nil, err
+	}
+
+	users := make([]model.BlockedUser, len(rows))
+	for i, row := range rows {
+		users[i] = model.BlockedUser{
+			ID:        row.UserID,
+			Username:  row.Username,
+			AvatarURL: row.AvatarURL,
+			BlockID:   row.BlockID,
+			BlockedAt: row.BlockedAt.Unix(),
+		}
+	}
+	return users, nil
+}
+
+// FilterBlocked 返回 userIDs 中拉黑了 userID 或被 userID 拉黑的用户
+func (u *userDB) FilterBlocked(ctx context.Context, userID int64, userIDs []int64) (map[int64]bool, error) {
+	result := make(map[int64]bool, len(userIDs))
+	if len(userIDs) == 0 {
+		return result, nil
+	}
+	var blocks []Block
+	if err := u.db.WithContext(ctx).
+		Where("(blocker_id = ? AND blocked_id IN ?) OR (blocked_id = ? AND blocker_id IN ?)", userID, userIDs, userID, userIDs).
+		Find(&blocks).Error; err != nil {
+		return nil, err
+	}
+	for _, b := range blocks {
+		if b.BlockerID == userID {
+			result[b.BlockedID] = true
+		} else {
+			result[b.BlockerID] = true
+		}
+	}
+	return result, nil
+}
+
+// GetPrivacySettings 批量获取隐私设置，没有记录的用户使用默认值
+func (u *userDB) GetPrivacySettings(ctx context.Context, userIDs []int64) (map[int64]*model.PrivacySettings, error) {
+	result := make(map[int64]*model.PrivacySettings, len(userIDs))
+	if len(userIDs) == 0 {
+		return result, nil
+	}
+	var rows []PrivacySetting
+	if err := u.db.WithContext(ctx).Where("user_id IN ?", userIDs).Find(&rows).Error; err != nil {
+		return nil, err
+	}
+	for _, id := range userIDs {
+		result[id] = &model.PrivacySettings{UserID: id}
+	}
+	for _, row := range rows {
+		result[row.UserID] = &model.PrivacySettings{
+			UserID:                row.UserID,
+			DMPolicy:              row.DMPolicy,
+			LikesVisibility:       row.LikesVisibility,
+			CollectionsVisibility: row.CollectionsVisibility,
+			HideOnline:            row.HideOnline,
+		}
+	}
+	return result, nil
+}
+
+// UpdatePrivacySettings 保存隐私设置，记录不存在时创建
+func (u *userDB) UpdatePrivacySettings(ctx context.Context, settings *model.PrivacySettings) error {
+	return u.db.WithContext(ctx).Clauses(clause.OnConflict{
+		DoUpdates: clause.AssignmentColumns([]string{"dm_policy", "likes_visibility", "collections_visibility", "hide_online", "updated_at"}),
+	}).Create(&PrivacySetting{
+		UserID:                settings.UserID,
+		DMPolicy:              settings.DMPolicy,
+		LikesVisibility:       settings.LikesVisibility,
+		CollectionsVisibility: settings.CollectionsVisibility,
+		HideOnline:            settings.HideOnline,
+	}).Error
+}
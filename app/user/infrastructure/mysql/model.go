@@ -17,3 +17,53 @@ type User struct {
 func (User) TableName() string {
 	return "users"
 }
+
+// Follow 关注关系，follower 关注 followee
+type Follow struct {
+	ID         int64     `json:"id"          gorm:"primarykey"`
+	FollowerID int64     `json:"follower_id" gorm:"uniqueIndex:idx_follower_followee;not null"`
+	FolloweeID int64     `json:"followee_id" gorm:"uniqueIndex:idx_follower_followee;index;not null"`
+	CreatedAt  time.Time `json:"created_at"  gorm:"autoCreateTime"`
+}
+
+func (Follow) TableName() string {
+	return "follows"
+}
+
+// FollowStat 用户的关注数和粉丝数，随关注关系在同一事务中更新
+type FollowStat struct {
+	UserID        int64     `json:"user_id"        gorm:"primarykey;autoIncrement:false"`
+	FollowCount   int64     `json:"follow_count"   gorm:"not null;default:0"`
+	FollowerCount int64     `json:"follower_count" gorm:"not null;default:0"`
+	UpdatedAt     time.Time `json:"updated_at"     gorm:"autoUpdateTime"`
+}
+
+func (FollowStat) TableName() string {
+	return "user_follow_stats"
+}
+
+// Block 拉黑关系，blocker 拉黑 blocked
+type Block struct {
+	ID        int64     `json:"id"         gorm:"primarykey"`
+	BlockerID int64     `json:"blocker_id" gorm:"uniqueIndex:idx_blocker_blocked;not null"`
+	BlockedID int64     `json:"blocked_id" gorm:"uniqueIndex:idx_blocker_blocked;index;not null"`
+	CreatedAt time.Time `json:"created_at" gorm:"autoCreateTime"`
+}
+
+func (Block) TableName() string {
+	return "user_blocks"
+}
+
+// PrivacySetting 用户隐私设置，没有记录时使用默认值
+type PrivacySetting struct {
+	UserID                int64     `json:"user_id"                gorm:"primarykey;autoIncrement:false"`
+	DMPolicy              int8      `json:"dm_policy"              gorm:"not null;default:0"`
+	LikesVisibility       int8      `json:"likes_visibility"       gorm:"not null;default:0"`
+	CollectionsVisibility int8      `json:"collections_visibility" gorm:"not null;default:0"`
+	HideOnline            bool      `json:"hide_online"            gorm:"not null;default:false"`
+	UpdatedAt             time.Time `json:"updated_at"             gorm:"autoUpdateTime"`
+}
+
+func (PrivacySetting) TableName() string {
+	return "user_privacy_settings"
+}
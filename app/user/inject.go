@@ -1,6 +1,7 @@
 package user
 
 import (
+	socialmq "github.com/yxrxy/videoHub/app/social/infrastructure/mq"
 	"github.com/yxrxy/videoHub/app/user/controllers/rpc"
 	"github.com/yxrxy/videoHub/app/user/domain/service"
 	"github.com/yxrxy/videoHub/app/user/infrastructure/cache"
@@ -9,6 +10,7 @@ import (
 	"github.com/yxrxy/videoHub/config"
 	"github.com/yxrxy/videoHub/kitex_gen/user"
 	"github.com/yxrxy/videoHub/pkg/base/client"
+	"github.com/yxrxy/videoHub/pkg/kafka"
 )
 
 func InjectUserHandler() user.UserService {
@@ -24,7 +26,8 @@ func InjectUserHandler() user.UserService {
 
 	db := mysql.NewUserDB(gormDB)
 	redisCache := cache.NewUserCache(re)
-	svc := service.NewUserService(db, redisCache)
+	notifier := socialmq.NewNotificationMQ(kafka.NewKafkaInstance())
+	svc := service.NewUserService(db, redisCache, notifier)
 	uc := usecase.NewUserCase(db, svc)
 
 	return rpc.NewUserHandler(uc)
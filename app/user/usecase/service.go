@@ -31,8 +31,16 @@ func (s *useCase) Login(ctx context.Context, req *model.User) (*model.User, erro
 	return s.svc.Login(ctx, req)
 }
 
-func (s *useCase) GetUserInfo(ctx context.Context, uid int64) (*model.User, error) {
-	return s.db.GetUserByID(ctx, uid)
+// GetUserInfo 获取用户信息及关注计数，viewerID 为 0 时不判断是否已关注
+func (s *useCase) GetUserInfo(ctx context.Context, uid, viewerID int64) (*model.User, error) {
+	user, err := s.db.GetUserByID(ctx, uid)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.svc.FillFollowInfo(ctx, user, viewerID); err != nil {
+		return nil, err
+	}
+	return user, nil
 }
 
 func (s *useCase) UploadAvatar(ctx context.Context, uid int64, avatarData []byte, contentType string) (string, error) {
@@ -50,3 +58,48 @@ func (s *useCase) UploadAvatar(ctx context.Context, uid int64, avatarData []byte
 func (s *useCase) RefreshToken(ctx context.Context, uid int64) (string, error) {
 	return s.svc.RefreshToken(ctx, uid)
 }
+
+// Follow 关注用户
+func (s *useCase) Follow(ctx context.Context, followerID, followeeID int64) (bool, error) {
+	return s.svc.Follow(ctx, followerID, followeeID)
+}
+
+// Unfollow 取消关注
+func (s *useCase) Unfollow(ctx context.Context, followerID, followeeID int64) error {
+	return s.svc.Unfollow(ctx, followerID, followeeID)
+}
+
+// GetFollowing 获取关注列表
+func (s *useCase) GetFollowing(ctx context.Context, userID, cursor int64, limit int32) (*model.FollowPage, error) {
+	return s.svc.GetFollowing(ctx, userID, cursor, int(limit))
+}
+
+// GetFollowers 获取粉丝列表
+func (s *useCase) GetFollowers(ctx context.Context, userID, cursor int64, limit int32) (*model.FollowPage, error) {
+	return s.svc.GetFollowers(ctx, userID, cursor, int(limit))
+}
+
+// Block 拉黑用户
+func (s *useCase) Block(ctx context.Context, blockerID, blockedID int64) error {
+	return s.svc.Block(ctx, blockerID, blockedID)
+}
+
+// Unblock 取消拉黑
+func (s *useCase) Unblock(ctx context.Context, blockerID, blockedID int64) error {
+	return s.svc.Unblock(ctx, blockerID, blockedID)
+}
+
+// GetBlockList 获取黑名单
+func (s *useCase) GetBlockList(ctx context.Context, userID, cursor int64, limit int32) (*model.BlockPage, error) {
+	return s.svc.GetBlockedUsers(ctx, userID, cursor, int(limit))
+}
+
+// GetPrivacySettings 获取隐私设置
+func (s *useCase) GetPrivacySettings(ctx context.Context, userID int64) (*model.PrivacySettings, error) {
+	return s.svc.GetPrivacySettings(ctx, userID)
+}
+
+// UpdatePrivacySettings 修改隐私设置
+func (s *useCase) UpdatePrivacySettings(ctx context.Context, userID int64, update *model.PrivacySettingsUpdate) (*model.PrivacySettings, error) {
+	return s.svc.UpdatePrivacySettings(ctx, userID, update)
+}
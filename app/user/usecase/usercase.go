@@ -12,9 +12,22 @@ import (
 type UserUseCase interface {
 	Register(ctx context.Context, user *model.User) (uid int64, err error)
 	Login(ctx context.Context, user *model.User) (*model.User, error)
-	GetUserInfo(ctx context.Context, uid int64) (*model.User, error)
+	GetUserInfo(ctx context.Context, uid, viewerID int64) (*model.User, error)
 	UploadAvatar(ctx context.Context, uid int64, avatarData []byte, contentType string) (string, error)
 	RefreshToken(ctx context.Context, uid int64) (string, error)
+
+	// 关注相关
+	Follow(ctx context.Context, followerID, followeeID int64) (bool, error)
+	Unfollow(ctx context.Context, followerID, followeeID int64) error
+	GetFollowing(ctx context.Context, userID, cursor int64, limit int32) (*model.FollowPage, error)
+	GetFollowers(ctx context.Context, userID, cursor int64, limit int32) (*model.FollowPage, error)
+
+	// 黑名单与隐私设置相关
+	Block(ctx context.Context, blockerID, blockedID int64) error
+	Unblock(ctx context.Context, blockerID, blockedID int64) error
+	GetBlockList(ctx context.Context, userID, cursor int64, limit int32) (*model.BlockPage, error)
+	GetPrivacySettings(ctx context.Context, userID int64) (*model.PrivacySettings, error)
+	UpdatePrivacySettings(ctx context.Context, userID int64, update *model.PrivacySettingsUpdate) (*model.PrivacySettings, error)
 }
 
 type useCase struct {
@@ -169,10 +169,11 @@ func (h *VideoHandler) IncrementLikeCount(ctx context.Context, req *video.Increm
 
 func (h *VideoHandler) Search(ctx context.Context, req *video.SearchRequest) (r *video.SearchResponse, err error) {
 	r = new(video.SearchResponse)
+	viewerID, _ := pkgcontext.GetUserID(ctx)
 
 	var videoList []*model.Video
 	var total int64
-	if videoList, total, err = h.useCase.SearchVideo(ctx, req.Keywords, req.PageSize, req.PageNum, req.FromDate, req.ToDate, req.Username); err != nil {
+	if videoList, total, err = h.useCase.SearchVideo(ctx, viewerID, req.Keywords, req.PageSize, req.PageNum, req.FromDate, req.ToDate, req.Username); err != nil {
 		return
 	}
 	r.Videos = pack.Videos(videoList)
@@ -183,12 +184,45 @@ func (h *VideoHandler) Search(ctx context.Context, req *video.SearchRequest) (r
 
 func (h *VideoHandler) SemanticSearch(ctx context.Context, req *video.SemanticSearchRequest) (r *video.SemanticSearchResponse, err error) {
 	r = new(video.SemanticSearchResponse)
+	viewerID, _ := pkgcontext.GetUserID(ctx)
 
 	var result []*model.SemanticSearchResultItem
-	if result, err = h.useCase.SemanticSearch(ctx, req.Query, req.PageSize, req.PageNum, *req.Threshold); err != nil {
+	if result, err = h.useCase.SemanticSearch(ctx, viewerID, req.Query, req.PageSize, req.PageNum, *req.Threshold); err != nil {
 		return
 	}
 	r.Results = pack.SemanticSearchResultItems(result)
 	r.Base = base.BuildBaseResp(err)
 	return
 }
+
+func (h *VideoHandler) GetFollowingFeed(ctx context.Context, req *video.FollowingFeedRequest) (r *video.FollowingFeedResponse, err error) {
+	r = new(video.FollowingFeedResponse)
+	userID, err := pkgcontext.GetUserID(ctx)
+	if err != nil {
+		return
+	}
+	var page *model.FeedPage
+	if page, err = h.useCase.GetFollowingFeed(ctx, userID, req.GetCursor(), req.GetLimit()); err != nil {
+		return
+	}
+	r.Videos = pack.Videos(page.Videos)
+	if page.HasMore {
+		r.NextCursor = &page.NextCursor
+	}
+	r.HasMore = page.HasMore
+	r.Base = base.BuildBaseResp(err)
+	return
+}
+
+func (h *VideoHandler) BackfillFeed(ctx context.Context, req *video.BackfillFeedRequest) (r *video.BackfillFeedResponse, err error) {
+	r = new(video.BackfillFeedResponse)
+	userID, err := pkgcontext.GetUserID(ctx)
+	if err != nil {
+		return
+	}
+	if err = h.useCase.BackfillFeed(ctx, userID, req.CreatorId); err != nil {
+		return
+	}
+	r.Base = base.BuildBaseResp(err)
+	return
+}
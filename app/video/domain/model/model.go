@@ -46,3 +46,10 @@ type VideoES struct {
 	ToDate   *int64  `json:"to_date,omitempty"`
 	Username *string `json:"username,omitempty"`
 }
+
+// FeedPage 关注流分页结果
+type FeedPage struct {
+	Videos     []*Video `json:"videos"`
+	NextCursor int64    `json:"next_cursor"`
+	HasMore    bool     `json:"has_more"`
+}
@@ -22,6 +22,10 @@ type VideoDB interface {
 	IncrementLikeCount(ctx context.Context, videoID int64) error
 	GetVideoByID(ctx context.Context, videoID int64) (*model.Video, error)
 	DeleteVideo(ctx context.Context, videoID int64) error
+	// GetVideosByIDs 批量获取视频，不存在的视频不返回
+	GetVideosByIDs(ctx context.Context, videoIDs []int64) ([]*model.Video, error)
+	// GetPublicVideosByAuthors 按ID倒序获取多个作者ID小于 cursor 的公开视频
+	GetPublicVideosByAuthors(ctx context.Context, authorIDs []int64, cursor int64, limit int) ([]*model.Video, error)
 }
 
 type VideoCache interface {
@@ -31,6 +35,11 @@ type VideoCache interface {
 	Delete(key string) error
 	Range(f func(key, value interface{}) bool)
 	Store(key string, value interface{}) error
+
+	// 关注流收件箱，按视频ID倒序
+	PushFeed(ctx context.Context, userIDs []int64, videoID int64, size int) error
+	AddToFeed(ctx context.Context, userID int64, videoIDs []int64, size int) error
+	GetFeed(ctx context.Context, userID, cursor int64, limit int) ([]int64, error)
 }
 
 type VideoMQ interface {
@@ -62,3 +71,9 @@ type LLMService interface {
 	GenerateResponse(ctx context.Context, query string, documents []string) (string, error)
 	GenerateRelatedQueries(ctx context.Context, query string) ([]string, error)
 }
+
+// PrivacyChecker 拉黑关系校验，由用户服务的 PrivacyGuard 实现
+type PrivacyChecker interface {
+	// FilterBlocked 返回 userIDs 中与 userID 存在拉黑关系的用户
+	FilterBlocked(ctx context.Context, userID int64, userIDs []int64) (map[int64]bool, error)
+}
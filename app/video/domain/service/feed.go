@@ -0,0 +1,189 @@
+package service
+
+import (
+	"context"
+	"log"
+	"sort"
+
+	"github.com/yxrxy/videoHub/app/video/domain/model"
+	"github.com/yxrxy/videoHub/config"
+	"github.com/yxrxy/videoHub/pkg/constants"
+)
+
+// 关注流采用推拉结合：粉丝数未达阈值的作者发布时写入粉丝收件箱，
+// 大作者的视频在读取时按关注关系实时拉取，两路结果按视频ID合并
+
+// feedPushThreshold 粉丝数达到该值的作者不再写扩散
+func feedPushThreshold() int64 {
+	if config.Video != nil && config.Video.Feed.PushThreshold > 0 {
+		return config.Video.Feed.PushThreshold
+	}
+	return constants.FeedPushThreshold
+}
+
+// feedInboxSize 每个收件箱保留的视频数
+func feedInboxSize() int {
+	if config.Video != nil && config.Video.Feed.InboxSize > 0 {
+		return config.Video.Feed.InboxSize
+	}
+	return constants.FeedInboxSize
+}
+
+// isBigCreator 判断作者是否走读时拉取
+func (s *VideoService) isBigCreator(ctx context.Context, userID int64) (bool, error) {
+	stats, err := s.userDB.GetFollowStats(ctx, userID)
+	if err != nil {
+		return false, err
+	}
+	return stats.FollowerCount >= feedPushThreshold(), nil
+}
+
+// FanOutVideo 将新发布的公开视频写入作者粉丝的收件箱，大作者跳过
+func (s *VideoService) FanOutVideo(ctx context.Context, video *model.Video) error {
+	if video.IsPrivate {
+		return nil
+	}
+	big, err := s.isBigCreator(ctx, video.UserID)
+	if err != nil || big {
+		return err
+	}
+
+	size := feedInboxSize()
+	var cursor int64
+	for {
+		followers, err := s.userDB.GetFollowers(ctx, video.UserID, cursor, constants.FeedFanOutBatch)
+		if err != nil {
+			return err
+		}
+		if len(followers) == 0 {
+			return nil
+		}
+		ids := make([]int64, len(followers))
+		for i, f := range followers {
+			ids[i] = f.ID
+		}
+		if err := s.cache.PushFeed(ctx, ids, video.ID, size); err != nil {
+			return err
+		}
+		if len(followers) < constants.FeedFanOutBatch {
+			return nil
+		}
+		cursor = followers[len(followers)-1].FollowID
+	}
+}
+
+// BackfillFeed 新关注作者后将其最近的公开视频补入收件箱，大作者读时拉取无需回填
+func (s *VideoService) BackfillFeed(ctx context.Context, userID, creatorID int64) error {
+	if userID == creatorID {
+		return nil
+	}
+	big, err := s.isBigCreator(ctx, creatorID)
+	if err != nil || big {
+		return err
+	}
+	videos, err := s.db.GetPublicVideosByAuthors(ctx, []int64{creatorID}, 0, constants.FeedBackfillSize)
+	if err != nil {
+		return err
+	}
+	ids := make([]int64, len(videos))
+	for i, v := range videos {
+		ids[i] = v.ID
+	}
+	return s.cache.AddToFeed(ctx, userID, ids, feedInboxSize())
+}
+
+// GetFollowingFeed 获取关注流，cursor 为上一页最后处理到的视频ID
+func (s *VideoService) GetFollowingFeed(ctx context.Context, userID, cursor int64, limit int) (*model.FeedPage, error) {
+	if limit <= 0 {
+		limit = constants.FeedDefaultLimit
+	}
+	if limit > constants.FeedMaxLimit {
+		limit = constants.FeedMaxLimit
+	}
+
+	// 1. 推：收件箱中的视频
+	inboxIDs, err := s.cache.GetFeed(ctx, userID, cursor, limit+1)
+	if err != nil {
+		return nil, err
+	}
+
+	// 2. 拉：关注的大作者的视频
+	creators, err := s.userDB.GetFollowedCreators(ctx, userID, feedPushThreshold())
+	if err != nil {
+		return nil, err
+	}
+	pulled, err := s.db.GetPublicVideosByAuthors(ctx, creators, cursor, limit+1)
+	if err != nil {
+		return nil, err
+	}
+
+	// 3. 按视频ID倒序合并去重
+	videos := make(map[int64]*model.Video, len(pulled))
+	for _, v := range pulled {
+		videos[v.ID] = v
+	}
+	ids := make([]int64, 0, len(inboxIDs)+len(pulled))
+	for id := range videos {
+		ids = append(ids, id)
+	}
+	for _, id := range inboxIDs {
+		if _, ok := videos[id]; !ok {
+			videos[id] = nil
+			ids = append(ids, id)
+		}
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] > ids[j] })
+
+	page := &model.FeedPage{Videos: make([]*model.Video, 0, limit)}
+	if len(ids) > limit {
+		ids = ids[:limit]
+		page.HasMore = true
+		page.NextCursor = ids[limit-1]
+	}
+
+	// 4. 补全收件箱中的视频详情
+	missing := make([]int64, 0, len(ids))
+	for _, id := range ids {
+		if videos[id] == nil {
+			missing = append(missing, id)
+		}
+	}
+	loaded, err := s.db.GetVideosByIDs(ctx, missing)
+	if err != nil {
+		return nil, err
+	}
+	for _, v := range loaded {
+		videos[v.ID] = v
+	}
+
+	// 5. 过滤已删除、转为私有或已取关作者的视频
+	authorIDs := make([]int64, 0, len(ids))
+	seen := make(map[int64]bool, len(ids))
+	for _, id := range ids {
+		if v := videos[id]; v != nil && !seen[v.UserID] {
+			seen[v.UserID] = true
+			authorIDs = append(authorIDs, v.UserID)
+		}
+	}
+	following, err := s.userDB.FilterFollowing(ctx, userID, authorIDs)
+	if err != nil {
+		return nil, err
+	}
+	for _, id := range ids {
+		v := videos[id]
+		if v == nil || v.IsPrivate || !following[v.UserID] {
+			continue
+		}
+		page.Videos = append(page.Videos, v)
+	}
+	return page, nil
+}
+
+// fanOutAsync 异步写扩散，失败只记录日志
+func (s *VideoService) fanOutAsync(video *model.Video) {
+	go func() {
+		if err := s.FanOutVideo(context.Background(), video); err != nil {
+			log.Printf("failed to fan out video %d to followers: %v", video.ID, err)
+		}
+	}()
+}
@@ -0,0 +1,154 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/smartystreets/goconvey/convey"
+	"github.com/stretchr/testify/mock"
+	usermodel "github.com/yxrxy/videoHub/app/user/domain/model"
+	"github.com/yxrxy/videoHub/app/video/domain/model"
+	"github.com/yxrxy/videoHub/pkg/constants"
+)
+
+func TestVideoService_FanOutVideo(t *testing.T) {
+	type TestCase struct {
+		Name          string
+		Video         *model.Video
+		FollowerCount int64
+		Followers     []usermodel.FollowUser
+		ExpectedPush  []int64
+	}
+
+	testCases := []TestCase{
+		{
+			Name:          "普通作者写入粉丝收件箱",
+			Video:         &model.Video{ID: 100, UserID: 1},
+			FollowerCount: 2,
+			Followers:     []usermodel.FollowUser{{ID: 2, FollowID: 9}, {ID: 3, FollowID: 8}},
+			ExpectedPush:  []int64{2, 3},
+		},
+		{
+			Name:          "大作者读时拉取不写扩散",
+			Video:         &model.Video{ID: 100, UserID: 1},
+			FollowerCount: constants.FeedPushThreshold,
+		},
+		{
+			Name:  "私有视频不进入关注流",
+			Video: &model.Video{ID: 100, UserID: 1, IsPrivate: true},
+		},
+	}
+
+	for _, tc := range testCases {
+		convey.Convey(tc.Name, t, func() {
+			ctx := context.Background()
+			cache := new(MockCache)
+			userDB := new(MockUserDB)
+			if !tc.Video.IsPrivate {
+				userDB.On("GetFollowStats", ctx, tc.Video.UserID).
+					Return(&usermodel.FollowStats{FollowerCount: tc.FollowerCount}, nil)
+			}
+			if tc.ExpectedPush != nil {
+				userDB.On("GetFollowers", ctx, tc.Video.UserID, int64(0), constants.FeedFanOutBatch).
+					Return(tc.Followers, nil)
+				cache.On("PushFeed", ctx, tc.ExpectedPush, tc.Video.ID, constants.FeedInboxSize).Return(nil)
+			}
+
+			svc := &VideoService{cache: cache, userDB: userDB}
+			err := svc.FanOutVideo(ctx, tc.Video)
+
+			convey.So(err, convey.ShouldBeNil)
+			cache.AssertExpectations(t)
+			userDB.AssertExpectations(t)
+			if tc.ExpectedPush == nil {
+				cache.AssertNotCalled(t, "PushFeed", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+			}
+		})
+	}
+}
+
+func TestVideoService_BackfillFeed(t *testing.T) {
+	convey.Convey("新关注普通作者时回填最近视频", t, func() {
+		ctx := context.Background()
+		db := new(MockDB)
+		cache := new(MockCache)
+		userDB := new(MockUserDB)
+		userDB.On("GetFollowStats", ctx, int64(2)).Return(&usermodel.FollowStats{FollowerCount: 1}, nil)
+		db.On("GetPublicVideosByAuthors", ctx, []int64{2}, int64(0), constants.FeedBackfillSize).
+			Return([]*model.Video{{ID: 12, UserID: 2}, {ID: 10, UserID: 2}}, nil)
+		cache.On("AddToFeed", ctx, int64(1), []int64{12, 10}, constants.FeedInboxSize).Return(nil)
+
+		svc := &VideoService{db: db, cache: cache, userDB: userDB}
+		convey.So(svc.BackfillFeed(ctx, 1, 2), convey.ShouldBeNil)
+		db.AssertExpectations(t)
+		cache.AssertExpectations(t)
+	})
+
+	convey.Convey("新关注大作者时无需回填", t, func() {
+		ctx := context.Background()
+		cache := new(MockCache)
+		userDB := new(MockUserDB)
+		userDB.On("GetFollowStats", ctx, int64(2)).
+			Return(&usermodel.FollowStats{FollowerCount: constants.FeedPushThreshold}, nil)
+
+		svc := &VideoService{db: new(MockDB), cache: cache, userDB: userDB}
+		convey.So(svc.BackfillFeed(ctx, 1, 2), convey.ShouldBeNil)
+		cache.AssertNotCalled(t, "AddToFeed", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+func TestVideoService_GetFollowingFeed(t *testing.T) {
+	const userID = int64(1)
+
+	convey.Convey("合并收件箱与大作者视频并分页", t, func() {
+		ctx := context.Background()
+		db := new(MockDB)
+		cache := new(MockCache)
+		userDB := new(MockUserDB)
+
+		// 收件箱：普通作者2的视频；大作者9的视频实时拉取
+		cache.On("GetFeed", ctx, userID, int64(0), 4).Return([]int64{15, 12, 8}, nil)
+		userDB.On("GetFollowedCreators", ctx, userID, int64(constants.FeedPushThreshold)).Return([]int64{9}, nil)
+		db.On("GetPublicVideosByAuthors", ctx, []int64{9}, int64(0), 4).
+			Return([]*model.Video{{ID: 14, UserID: 9}, {ID: 11, UserID: 9}}, nil)
+		db.On("GetVideosByIDs", ctx, []int64{15, 12}).Return([]*model.Video{{ID: 15, UserID: 2}, {ID: 12, UserID: 2}}, nil)
+		userDB.On("FilterFollowing", ctx, userID, []int64{2, 9}).Return(map[int64]bool{2: true, 9: true}, nil)
+
+		svc := &VideoService{db: db, cache: cache, userDB: userDB}
+		page, err := svc.GetFollowingFeed(ctx, userID, 0, 3)
+
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(page.HasMore, convey.ShouldBeTrue)
+		convey.So(page.NextCursor, convey.ShouldEqual, 12)
+		convey.So(len(page.Videos), convey.ShouldEqual, 3)
+		convey.So(page.Videos[0].ID, convey.ShouldEqual, 15)
+		convey.So(page.Videos[1].ID, convey.ShouldEqual, 14)
+		convey.So(page.Videos[2].ID, convey.ShouldEqual, 12)
+	})
+
+	convey.Convey("过滤已删除、私有和已取关作者的视频", t, func() {
+		ctx := context.Background()
+		db := new(MockDB)
+		cache := new(MockCache)
+		userDB := new(MockUserDB)
+
+		cache.On("GetFeed", ctx, userID, int64(20), constants.FeedDefaultLimit+1).Return([]int64{19, 18, 17, 16}, nil)
+		userDB.On("GetFollowedCreators", ctx, userID, int64(constants.FeedPushThreshold)).Return([]int64(nil), nil)
+		db.On("GetPublicVideosByAuthors", ctx, []int64(nil), int64(20), constants.FeedDefaultLimit+1).
+			Return([]*model.Video(nil), nil)
+		db.On("GetVideosByIDs", ctx, []int64{19, 18, 17, 16}).Return([]*model.Video{
+			{ID: 19, UserID: 2},
+			{ID: 18, UserID: 2, IsPrivate: true},
+			{ID: 16, UserID: 3},
+		}, nil)
+		userDB.On("FilterFollowing", ctx, userID, []int64{2, 3}).Return(map[int64]bool{2: true}, nil)
+
+		svc := &VideoService{db: db, cache: cache, userDB: userDB}
+		page, err := svc.GetFollowingFeed(ctx, userID, 20, 0)
+
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(page.HasMore, convey.ShouldBeFalse)
+		convey.So(len(page.Videos), convey.ShouldEqual, 1)
+		convey.So(page.Videos[0].ID, convey.ShouldEqual, 19)
+	})
+}
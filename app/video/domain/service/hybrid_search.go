@@ -210,6 +210,14 @@ func (s *VideoService) DeleteVideoEmbedding(ctx context.Context, videoID int64)
 	return nil
 }
 
+// RemoveVideoFromCollections 删除视频前将其从所有用户的收藏夹中移除
+func (s *VideoService) RemoveVideoFromCollections(ctx context.Context, videoID int64) error {
+	if err := s.collectionDB.RemoveVideoFromCollections(ctx, videoID); err != nil {
+		return fmt.Errorf("清理收藏记录失败: %w", err)
+	}
+	return nil
+}
+
 func (s *VideoService) ClearRelatedCache(category string) {
 	// 遍历并清除相关缓存
 	s.cache.Range(func(key, value interface{}) bool {
@@ -5,6 +5,8 @@ import (
 	"fmt"
 
 	"github.com/stretchr/testify/mock"
+	usermodel "github.com/yxrxy/videoHub/app/user/domain/model"
+	"github.com/yxrxy/videoHub/app/user/domain/repository"
 	"github.com/yxrxy/videoHub/app/video/domain/model"
 )
 
@@ -42,6 +44,22 @@ func (m *MockCache) UpdateVideoScore(ctx context.Context, videoID int64, visitDe
 	return args.Error(0)
 }
 
+func (m *MockCache) PushFeed(ctx context.Context, userIDs []int64, videoID int64, size int) error {
+	args := m.Called(ctx, userIDs, videoID, size)
+	return args.Error(0)
+}
+
+func (m *MockCache) AddToFeed(ctx context.Context, userID int64, videoIDs []int64, size int) error {
+	args := m.Called(ctx, userID, videoIDs, size)
+	return args.Error(0)
+}
+
+func (m *MockCache) GetFeed(ctx context.Context, userID, cursor int64, limit int) ([]int64, error) {
+	args := m.Called(ctx, userID, cursor, limit)
+	ids, _ := args.Get(0).([]int64)
+	return ids, args.Error(1)
+}
+
 type MockVectorDB struct {
 	mock.Mock
 }
@@ -136,6 +154,48 @@ func (m *MockDB) DeleteVideo(ctx context.Context, videoID int64) error {
 	return args.Error(0)
 }
 
+func (m *MockDB) GetVideosByIDs(ctx context.Context, videoIDs []int64) ([]*model.Video, error) {
+	args := m.Called(ctx, videoIDs)
+	videos, _ := args.Get(0).([]*model.Video)
+	return videos, args.Error(1)
+}
+
+func (m *MockDB) GetPublicVideosByAuthors(ctx context.Context, authorIDs []int64, cursor int64, limit int) ([]*model.Video, error) {
+	args := m.Called(ctx, authorIDs, cursor, limit)
+	videos, _ := args.Get(0).([]*model.Video)
+	return videos, args.Error(1)
+}
+
+// MockUserDB 只实现关注流用到的方法
+type MockUserDB struct {
+	repository.UserDB
+	mock.Mock
+}
+
+func (m *MockUserDB) GetFollowStats(ctx context.Context, userID int64) (*usermodel.FollowStats, error) {
+	args := m.Called(ctx, userID)
+	stats, _ := args.Get(0).(*usermodel.FollowStats)
+	return stats, args.Error(1)
+}
+
+func (m *MockUserDB) GetFollowers(ctx context.Context, userID, cursor int64, limit int) ([]usermodel.FollowUser, error) {
+	args := m.Called(ctx, userID, cursor, limit)
+	users, _ := args.Get(0).([]usermodel.FollowUser)
+	return users, args.Error(1)
+}
+
+func (m *MockUserDB) GetFollowedCreators(ctx context.Context, followerID, minFollowers int64) ([]int64, error) {
+	args := m.Called(ctx, followerID, minFollowers)
+	ids, _ := args.Get(0).([]int64)
+	return ids, args.Error(1)
+}
+
+func (m *MockUserDB) FilterFollowing(ctx context.Context, followerID int64, userIDs []int64) (map[int64]bool, error) {
+	args := m.Called(ctx, followerID, userIDs)
+	result, _ := args.Get(0).(map[int64]bool)
+	return result, args.Error(1)
+}
+
 type MockLLM struct {
 	mock.Mock
 }
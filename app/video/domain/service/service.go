@@ -82,6 +82,7 @@ func (s *VideoService) SaveVideo(ctx context.Context, userID int64, videoData []
 			log.Printf("failed to send video processing message: %v", err)
 		}
 	}()
+	s.fanOutAsync(video)
 	user, err := s.userDB.GetUserByID(ctx, userID)
 	if err != nil {
 		return "", err
@@ -254,6 +255,28 @@ func (s *VideoService) GetVideoDetail(ctx context.Context, videoID, userID int64
 	return result, nil
 }
 
+// FilterBlockedVideos 过滤作者与 viewerID 存在拉黑关系的视频，viewerID 为 0 表示未登录，不做过滤
+func (s *VideoService) FilterBlockedVideos(ctx context.Context, viewerID int64, videos []*model.Video) ([]*model.Video, error) {
+	if viewerID == 0 || len(videos) == 0 {
+		return videos, nil
+	}
+	authorIDs := make([]int64, 0, len(videos))
+	for _, v := range videos {
+		authorIDs = append(authorIDs, v.UserID)
+	}
+	blocked, err := s.privacy.FilterBlocked(ctx, viewerID, authorIDs)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]*model.Video, 0, len(videos))
+	for _, v := range videos {
+		if !blocked[v.UserID] {
+			result = append(result, v)
+		}
+	}
+	return result, nil
+}
+
 func (s *VideoService) GetHotVideos(
 	ctx context.Context,
 	limit int32,
@@ -25,6 +25,7 @@ const (
 	VideoHotKey    = "video:hot"      // 视频热度 zset
 	VideoHotExpire = 30 * time.Minute // 热度数据过期时间
 	CategoryHotKey = "video:hot:%s"   // 分类下的热门视频
+	FeedInboxKey   = "feed:inbox:%d"  // 用户关注流收件箱 zset，成员和分数均为视频ID
 )
 
 // UpdateVideoScore 更新视频分数（同步更新总榜和分类榜）
@@ -95,6 +96,65 @@ func (v *VideoCache) GetHotVideos(ctx context.Context, category string, limit in
 	return videoIDs, nil
 }
 
+// PushFeed 将视频写入多个用户的关注流收件箱，并裁剪到 size 条
+func (v *VideoCache) PushFeed(ctx context.Context, userIDs []int64, videoID int64, size int) error {
+	if len(userIDs) == 0 {
+		return nil
+	}
+	pipe := v.client.Pipeline()
+	for _, userID := range userIDs {
+		key := fmt.Sprintf(FeedInboxKey, userID)
+		pipe.ZAdd(ctx, key, redis.Z{Score: float64(videoID), Member: videoID})
+		pipe.ZRemRangeByRank(ctx, key, 0, int64(-size-1))
+		pipe.Expire(ctx, key, constants.FeedInboxExpire)
+	}
+	_, err := pipe.Exec(ctx)
+	return err
+}
+
+// AddToFeed 将多个视频写入用户的关注流收件箱，并裁剪到 size 条
+func (v *VideoCache) AddToFeed(ctx context.Context, userID int64, videoIDs []int64, size int) error {
+	if len(videoIDs) == 0 {
+		return nil
+	}
+	key := fmt.Sprintf(FeedInboxKey, userID)
+	members := make([]redis.Z, len(videoIDs))
+	for i, id := range videoIDs {
+		members[i] = redis.Z{Score: float64(id), Member: id}
+	}
+	pipe := v.client.Pipeline()
+	pipe.ZAdd(ctx, key, members...)
+	pipe.ZRemRangeByRank(ctx, key, 0, int64(-size-1))
+	pipe.Expire(ctx, key, constants.FeedInboxExpire)
+	_, err := pipe.Exec(ctx)
+	return err
+}
+
+// GetFeed 按视频ID倒序获取收件箱中小于 cursor 的视频ID，cursor 为 0 时从最新开始
+func (v *VideoCache) GetFeed(ctx context.Context, userID, cursor int64, limit int) ([]int64, error) {
+	_max := "+inf"
+	if cursor > 0 {
+		_max = "(" + strconv.FormatInt(cursor, 10)
+	}
+	members, err := v.client.ZRevRangeByScore(ctx, fmt.Sprintf(FeedInboxKey, userID), &redis.ZRangeBy{
+		Min:   "-inf",
+		Max:   _max,
+		Count: int64(limit),
+	}).Result()
+	if err != nil {
+		return nil, err
+	}
+	ids := make([]int64, 0, len(members))
+	for _, member := range members {
+		id, err := strconv.ParseInt(member, 10, 64)
+		if err != nil {
+			continue
+		}
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
+
 func (v *VideoCache) Load(key string) (interface{}, bool) {
 	value, err := v.client.Get(context.Background(), key).Result()
 	if err != nil {
@@ -151,6 +151,34 @@ func (v *VideoDB) fetchVideosFromDB(
 	return result, int64(len(result)), nextVisitCount, nextLikeCount, nextID, nil
 }
 
+// GetVideosByIDs 批量获取视频，不存在的视频不返回，顺序不保证
+func (v *VideoDB) GetVideosByIDs(ctx context.Context, videoIDs []int64) ([]*model.Video, error) {
+	if len(videoIDs) == 0 {
+		return nil, nil
+	}
+	var videos []Video
+	if err := v.db.WithContext(ctx).Where("id IN ?", videoIDs).Find(&videos).Error; err != nil {
+		return nil, err
+	}
+	return v.convertFormat(videos), nil
+}
+
+// GetPublicVideosByAuthors 按ID倒序获取多个作者ID小于 cursor 的公开视频，cursor 为 0 时从最新开始
+func (v *VideoDB) GetPublicVideosByAuthors(ctx context.Context, authorIDs []int64, cursor int64, limit int) ([]*model.Video, error) {
+	if len(authorIDs) == 0 {
+		return nil, nil
+	}
+	query := v.db.WithContext(ctx).Where("user_id IN ? AND is_private = ?", authorIDs, false)
+	if cursor > 0 {
+		query = query.Where("id < ?", cursor)
+	}
+	var videos []Video
+	if err := query.Order("id DESC").Limit(limit).Find(&videos).Error; err != nil {
+		return nil, err
+	}
+	return v.convertFormat(videos), nil
+}
+
 // getVideosByIDs 根据视频 ID 获取视频详细信息
 func (v *VideoDB) getVideosByIDs(ctx context.Context, videoIDs []string) ([]*model.Video, int64, int64, int64, int64, error) {
 	var videos []Video
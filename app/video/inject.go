@@ -1,6 +1,8 @@
 package video
 
 import (
+	interactionmysql "github.com/yxrxy/videoHub/app/interaction/infrastructure/mysql"
+	userservice "github.com/yxrxy/videoHub/app/user/domain/service"
 	usermysql "github.com/yxrxy/videoHub/app/user/infrastructure/mysql"
 	"github.com/yxrxy/videoHub/app/video/controllers/rpc"
 	"github.com/yxrxy/videoHub/app/video/domain/service"
@@ -38,10 +40,12 @@ func InjectVideoHandler() video.VideoService {
 	db := videomysql.NewVideoDB(gormDB, redisCache)
 	esClient := es.NewVideoElastic(elastic)
 	userDB := usermysql.NewUserDB(gormDB)
+	collectionDB := interactionmysql.NewInteraction(gormDB)
+	privacy := userservice.NewPrivacyGuard(userDB)
 	emb := embedding.NewOpenAIEmbedding(config.ApiKey.Key, config.ApiKey.BaseURL, config.ApiKey.Proxy)
 	vec, _ := vector.NewChromemDB("videos")
 	llm0 := llm.NewOpenAILLM(config.ApiKey.Key, config.ApiKey.BaseURL, config.ApiKey.Proxy)
-	svc := service.NewVideoService(db, redisCache, kaf, esClient, userDB, emb, vec, llm0)
+	svc := service.NewVideoService(db, redisCache, kaf, esClient, userDB, collectionDB, privacy, emb, vec, llm0)
 	uc := usecase.NewVideoCase(db, redisCache, esClient, svc)
 	return rpc.NewVideoHandler(uc)
 }
@@ -25,8 +25,10 @@ func (s *useCase) GetVideoList(ctx context.Context, userID, page int64, size int
 	return s.svc.GetVideoList(ctx, userID, page, size, category)
 }
 
+// SearchVideo 搜索视频，不返回与 viewerID 存在拉黑关系的作者的视频
 func (s *useCase) SearchVideo(
 	ctx context.Context,
+	viewerID int64,
 	keywords string,
 	pageSize, pageNum int32,
 	fromDate, toDate *int64,
@@ -51,6 +53,9 @@ func (s *useCase) SearchVideo(
 		}
 		res = append(res, detail)
 	}
+	if res, err = s.svc.FilterBlockedVideos(ctx, viewerID, res); err != nil {
+		return nil, 0, err
+	}
 	return res, total, nil
 }
 
@@ -83,6 +88,10 @@ func (s *useCase) DeleteVideo(ctx context.Context, videoID, userID int64) error
 	if err != nil {
 		return err
 	}
+	err = s.svc.RemoveVideoFromCollections(ctx, videoID)
+	if err != nil {
+		return err
+	}
 	s.svc.ClearRelatedCache(video.Category)
 	return s.db.DeleteVideo(ctx, videoID)
 }
@@ -95,8 +104,10 @@ func (s *useCase) IncrementLikeCount(ctx context.Context, videoID int64) error {
 	return s.db.IncrementLikeCount(ctx, videoID)
 }
 
+// SemanticSearch 语义搜索视频，不返回与 viewerID 存在拉黑关系的作者的视频
 func (s *useCase) SemanticSearch(
 	ctx context.Context,
+	viewerID int64,
 	query string,
 	pageSize, pageNum int32,
 	threshold float64,
@@ -106,12 +117,20 @@ func (s *useCase) SemanticSearch(
 		return nil, err
 	}
 
-	var res []*model.SemanticSearchResultItem
+	videos := make([]*model.Video, 0, len(result.Videos))
 	for _, video := range result.Videos {
 		video, err := s.GetVideoDetail(ctx, video.ID, 0)
 		if err != nil {
 			return nil, err
 		}
+		videos = append(videos, video)
+	}
+	if videos, err = s.svc.FilterBlockedVideos(ctx, viewerID, videos); err != nil {
+		return nil, err
+	}
+
+	var res []*model.SemanticSearchResultItem
+	for _, video := range videos {
 		// 转换 Video 到 SemanticSearchResultItem
 		item := &model.SemanticSearchResultItem{
 			Videos:         []*model.Video{video},
@@ -123,3 +142,11 @@ func (s *useCase) SemanticSearch(
 	}
 	return res, nil
 }
+
+func (s *useCase) GetFollowingFeed(ctx context.Context, userID, cursor int64, limit int32) (*model.FeedPage, error) {
+	return s.svc.GetFollowingFeed(ctx, userID, cursor, int(limit))
+}
+
+func (s *useCase) BackfillFeed(ctx context.Context, userID, creatorID int64) error {
+	return s.svc.BackfillFeed(ctx, userID, creatorID)
+}
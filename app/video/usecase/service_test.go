@@ -206,7 +206,7 @@ func TestSemanticSearch(t *testing.T) {
 			}
 
 			// 执行测试
-			result, err := uc.SemanticSearch(ctx, tc.Query, tc.Limit, tc.UserID, tc.Score)
+			result, err := uc.SemanticSearch(ctx, 0, tc.Query, tc.Limit, tc.UserID, tc.Score)
 
 			// 验证结果
 			if tc.ExpectedError != nil {
@@ -31,6 +31,7 @@ type VideoUseCase interface {
 	IncrementLikeCount(ctx context.Context, videoID int64) error
 	SearchVideo(
 		ctx context.Context,
+		viewerID int64,
 		keywords string,
 		pageSize, pageNum int32,
 		fromDate, toDate *int64,
@@ -38,10 +39,13 @@ type VideoUseCase interface {
 	) ([]*model.Video, int64, error)
 	SemanticSearch(
 		ctx context.Context,
+		viewerID int64,
 		query string,
 		pageSize, pageNum int32,
 		threshold float64,
 	) ([]*model.SemanticSearchResultItem, error)
+	GetFollowingFeed(ctx context.Context, userID, cursor int64, limit int32) (*model.FeedPage, error)
+	BackfillFeed(ctx context.Context, userID, creatorID int64) error
 }
 
 type useCase struct {
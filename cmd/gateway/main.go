@@ -1,11 +1,15 @@
 package main
 
 import (
+	"context"
+	"log"
+
 	"github.com/cloudwego/hertz/pkg/app/server"
 	"github.com/yxrxy/videoHub/app/gateway/mw"
 	"github.com/yxrxy/videoHub/app/gateway/router"
 	"github.com/yxrxy/videoHub/app/gateway/rpc"
 	"github.com/yxrxy/videoHub/config"
+	"github.com/yxrxy/videoHub/pkg/base"
 )
 
 func init() {
@@ -15,6 +19,13 @@ func init() {
 
 func main() {
 	listenAddr := config.Gateway.Addr
+	// 导出 WebSocket 发送队列等指标
+	p := base.TelemetryProvider(config.Server.Name, config.Otel.CollectorAddr)
+	defer func() {
+		if err := p.Shutdown(context.Background()); err != nil {
+			log.Fatalf("Gateway: shutdown telemetry provider failed, err: %v", err)
+		}
+	}()
 
 	h := server.New(
 		server.WithHostPorts(listenAddr),
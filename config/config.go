@@ -24,9 +24,10 @@ type RedisConfig struct {
 	Port     int
 	Password string
 	DB       struct {
-		User   int `mapstructure:"user"`
-		Video  int `mapstructure:"video"`
-		Social int `mapstructure:"social"`
+		User        int `mapstructure:"user"`
+		Video       int `mapstructure:"video"`
+		Social      int `mapstructure:"social"`
+		Interaction int `mapstructure:"interaction"`
 	} `mapstructure:"db"`
 }
 
@@ -43,7 +44,10 @@ type JWTConfig struct {
 }
 
 type GatewayConfig struct {
-	Addr string `mapstructure:"addr"`
+	Addr               string `mapstructure:"addr"`
+	NodeID             string `mapstructure:"node_id"`              // 多实例部署时的节点ID，为空时使用 主机名-进程号
+	SendQueueSize      int    `mapstructure:"send_queue_size"`      // 每个WebSocket连接的发送队列容量，为 0 时使用默认值
+	SlowConsumerPolicy string `mapstructure:"slow_consumer_policy"` // 发送队列已满时的策略：drop_oldest 或 disconnect
 }
 
 type ServerConfig struct {
@@ -82,6 +86,10 @@ type UploadConfig struct {
 type VideoConfig struct {
 	Name    string
 	RPCAddr string `mapstructure:"rpc_addr"`
+	Feed    struct {
+		PushThreshold int64 `mapstructure:"push_threshold"`
+		InboxSize     int   `mapstructure:"inbox_size"`
+	} `mapstructure:"feed"`
 }
 
 type ElasticsearchConfig struct {
@@ -93,13 +101,18 @@ type SocialConfig struct {
 	RPCAddr  string `mapstructure:"rpc_addr"`
 	HTTPAddr string `mapstructure:"http_addr"`
 	Chat     struct {
-		MaxGroupMembers   int `mapstructure:"max_group_members"`
-		MaxMessageLength  int `mapstructure:"max_message_length"`
-		MaxGroupsPerUser  int `mapstructure:"max_groups_per_user"`
-		MaxFriendsPerUser int `mapstructure:"max_friends_per_user"`
-		MessagePageSize   int `mapstructure:"message_page_size"`
-		HistoryDays       int `mapstructure:"history_days"`
+		MaxGroupMembers   int    `mapstructure:"max_group_members"`
+		MaxMessageLength  int    `mapstructure:"max_message_length"`
+		MaxGroupsPerUser  int    `mapstructure:"max_groups_per_user"`
+		MaxFriendsPerUser int    `mapstructure:"max_friends_per_user"`
+		MessagePageSize   int    `mapstructure:"message_page_size"`
+		HistoryDays       int    `mapstructure:"history_days"` // 聊天记录在热表中保留的天数，0 表示不归档
+		ArchiveDir        string `mapstructure:"archive_dir"`  // 归档文件在对象存储中的目录
 	} `mapstructure:"chat"`
+	Recommend struct {
+		RefreshInterval int `mapstructure:"refresh_interval"` // 推荐列表定时刷新周期（秒）
+		Size            int `mapstructure:"size"`             // 每个用户缓存的推荐人数
+	} `mapstructure:"recommend"`
 	File struct {
 		MaxSize      int      `mapstructure:"max_size"`
 		AllowedTypes []string `mapstructure:"allowed_types"`
@@ -113,8 +126,19 @@ type EtcdConfig struct {
 }
 
 type VideoInteractionsConfig struct {
-	Name    string
-	RPCAddr string `mapstructure:"rpc_addr"`
+	Name       string
+	RPCAddr    string   `mapstructure:"rpc_addr"`
+	Reactions  []string `mapstructure:"reactions"`
+	Moderation struct {
+		Enabled       bool     `mapstructure:"enabled"`
+		BlockWords    []string `mapstructure:"block_words"`
+		ReviewWords   []string `mapstructure:"review_words"`
+		BlockPatterns []string `mapstructure:"block_patterns"`
+		MaxLength     int      `mapstructure:"max_length"`
+		MaxLinks      int      `mapstructure:"max_links"`
+		EnableLLM     bool     `mapstructure:"enable_llm"`
+		Admins        []int64  `mapstructure:"admins"`
+	} `mapstructure:"moderation"`
 }
 
 type UpyunConfig struct {
@@ -170,7 +194,7 @@ type Config struct {
 	Social            SocialConfig
 	Gateway           GatewayConfig
 	Etcd              EtcdConfig
-	VideoInteractions VideoInteractionsConfig
+	VideoInteractions VideoInteractionsConfig `mapstructure:"interactions"`
 	Otel              OtelConfig
 	Elasticsearch     ElasticsearchConfig
 	Upyun             UpyunConfig
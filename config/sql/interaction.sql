@@ -4,7 +4,8 @@ CREATE TABLE IF NOT EXISTS likes (
     user_id BIGINT NOT NULL COMMENT '用户ID',
     video_id BIGINT NOT NULL COMMENT '视频ID',
     deleted_at BIGINT NULL COMMENT '删除时间',
-    UNIQUE KEY `idx_user_video` (`user_id`, `video_id`)
+    UNIQUE KEY `idx_user_video` (`user_id`, `video_id`),
+    KEY `idx_video` (`video_id`)
 ) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='点赞表';
 
 -- 评论表
@@ -15,13 +16,67 @@ CREATE TABLE IF NOT EXISTS comments (
     content TEXT NOT NULL COMMENT '评论内容',
     parent_id BIGINT NULL COMMENT '父评论ID',
     like_count INT NOT NULL DEFAULT 0 COMMENT '点赞数',
+    status TINYINT NOT NULL DEFAULT 0 COMMENT '审核状态：0=可见,1=待审核,2=已拒绝',
+    moderation_reason VARCHAR(255) NOT NULL DEFAULT '' COMMENT '审核原因',
+    reviewed_by BIGINT NULL COMMENT '审核人ID',
+    reviewed_at BIGINT NULL COMMENT '审核时间',
+    is_pinned TINYINT(1) NOT NULL DEFAULT 0 COMMENT '是否置顶',
+    is_hearted TINYINT(1) NOT NULL DEFAULT 0 COMMENT '是否被作者点亮',
+    created_at BIGINT NOT NULL DEFAULT 0 COMMENT '创建时间',
+    edited_at BIGINT NULL COMMENT '最后编辑时间',
     deleted_at BIGINT NULL COMMENT '删除时间',
-    KEY `idx_video` (`video_id`, `user_id`)
+    KEY `idx_video` (`video_id`, `user_id`),
+    KEY `idx_status` (`status`)
 ) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='评论表';
 
+-- 评论编辑记录表
+CREATE TABLE IF NOT EXISTS comment_edits (
+    id BIGINT PRIMARY KEY AUTO_INCREMENT COMMENT '记录ID',
+    comment_id BIGINT NOT NULL COMMENT '评论ID',
+    content TEXT NOT NULL COMMENT '编辑前内容',
+    edited_at BIGINT NOT NULL COMMENT '编辑时间',
+    KEY `idx_comment` (`comment_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='评论编辑记录表';
+
+-- 表情回应表
+CREATE TABLE IF NOT EXISTS reactions (
+    id BIGINT PRIMARY KEY AUTO_INCREMENT COMMENT '回应ID',
+    user_id BIGINT NOT NULL COMMENT '用户ID',
+    target_type TINYINT NOT NULL COMMENT '目标类型：1=视频,2=评论',
+    target_id BIGINT NOT NULL COMMENT '目标ID',
+    reaction VARCHAR(32) NOT NULL COMMENT '表情',
+    created_at BIGINT NOT NULL DEFAULT 0 COMMENT '创建时间',
+    UNIQUE KEY `uk_user_target` (`user_id`, `target_type`, `target_id`),
+    KEY `idx_target` (`target_type`, `target_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='表情回应表';
+
 -- 评论点赞表
 CREATE TABLE IF NOT EXISTS comment_likes (
     user_id BIGINT NOT NULL COMMENT '用户ID',
     comment_id BIGINT NOT NULL COMMENT '评论ID',
     UNIQUE KEY `idx_user_comment` (`user_id`, `comment_id`)
-) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='评论点赞表';
\ No newline at end of file
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='评论点赞表';
+
+-- 收藏夹表
+CREATE TABLE IF NOT EXISTS collections (
+    id BIGINT PRIMARY KEY AUTO_INCREMENT COMMENT '收藏夹ID',
+    user_id BIGINT NOT NULL COMMENT '用户ID',
+    name VARCHAR(64) NOT NULL COMMENT '收藏夹名称',
+    is_private TINYINT(1) NOT NULL DEFAULT 0 COMMENT '是否私密',
+    is_default TINYINT(1) NOT NULL DEFAULT 0 COMMENT '是否为默认收藏夹',
+    sort_order INT NOT NULL DEFAULT 0 COMMENT '排序值',
+    video_count BIGINT NOT NULL DEFAULT 0 COMMENT '视频数量',
+    created_at BIGINT NOT NULL DEFAULT 0 COMMENT '创建时间',
+    updated_at BIGINT NOT NULL DEFAULT 0 COMMENT '更新时间',
+    UNIQUE KEY `uk_user_name` (`user_id`, `name`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='收藏夹表';
+
+-- 收藏夹视频表
+CREATE TABLE IF NOT EXISTS collection_videos (
+    id BIGINT PRIMARY KEY AUTO_INCREMENT COMMENT '记录ID',
+    collection_id BIGINT NOT NULL COMMENT '收藏夹ID',
+    video_id BIGINT NOT NULL COMMENT '视频ID',
+    created_at BIGINT NOT NULL DEFAULT 0 COMMENT '收藏时间',
+    UNIQUE KEY `uk_collection_video` (`collection_id`, `video_id`),
+    KEY `idx_video` (`video_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='收藏夹视频表';
\ No newline at end of file
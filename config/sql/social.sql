@@ -4,13 +4,20 @@ CREATE TABLE IF NOT EXISTS private_messages (
     id BIGINT PRIMARY KEY AUTO_INCREMENT COMMENT '私信ID',
     sender_id BIGINT NOT NULL COMMENT '发送者ID',
     receiver_id BIGINT NOT NULL COMMENT '接收者ID',
+    conversation_key VARCHAR(64) NOT NULL COMMENT '会话标识',
+    seq BIGINT NOT NULL COMMENT '会话内序号',
     content TEXT NOT NULL COMMENT '消息内容',
     is_read BOOLEAN NOT NULL DEFAULT FALSE COMMENT '是否已读',
+    reply_to_id BIGINT NOT NULL DEFAULT 0 COMMENT '回复的消息ID',
+    edited_at TIMESTAMP NULL COMMENT '最后编辑时间',
+    recalled_at TIMESTAMP NULL COMMENT '撤回时间',
     created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
     updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '更新时间',
     deleted_at TIMESTAMP NULL COMMENT '删除时间',
     KEY `idx_sender` (`sender_id`),
     KEY `idx_receiver` (`receiver_id`),
+    UNIQUE KEY `idx_conversation_seq` (`conversation_key`, `seq`),
+    KEY `idx_reply_to` (`reply_to_id`),
     KEY `idx_deleted_at` (`deleted_at`)
 ) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='私信表';
 
@@ -35,6 +42,7 @@ CREATE TABLE IF NOT EXISTS chat_room_members (
     user_id BIGINT NOT NULL COMMENT '用户ID',
     nickname VARCHAR(32) COMMENT '在群里的昵称',
     role TINYINT NOT NULL DEFAULT 0 COMMENT '角色：0=普通成员,1=管理员,2=群主',
+    muted_until TIMESTAMP NULL COMMENT '禁言截止时间',
     created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
     updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '更新时间',
     deleted_at TIMESTAMP NULL COMMENT '删除时间',
@@ -42,21 +50,58 @@ CREATE TABLE IF NOT EXISTS chat_room_members (
     KEY `idx_deleted_at` (`deleted_at`)
 ) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='聊天室成员表';
 
+-- 聊天室邀请链接表
+CREATE TABLE IF NOT EXISTS chat_room_invites (
+    id BIGINT PRIMARY KEY AUTO_INCREMENT COMMENT '邀请ID',
+    code VARCHAR(32) NOT NULL COMMENT '邀请码',
+    room_id BIGINT NOT NULL COMMENT '聊天室ID',
+    inviter_id BIGINT NOT NULL COMMENT '创建者ID',
+    expires_at TIMESTAMP NOT NULL COMMENT '过期时间',
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+    UNIQUE KEY `idx_code` (`code`),
+    KEY `idx_room` (`room_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='聊天室邀请链接表';
+
 -- 聊天消息表
 CREATE TABLE IF NOT EXISTS chat_messages (
     id BIGINT PRIMARY KEY AUTO_INCREMENT COMMENT '消息ID',
     room_id BIGINT NOT NULL COMMENT '聊天室ID',
+    seq BIGINT NOT NULL COMMENT '会话内序号',
     sender_id BIGINT NOT NULL COMMENT '发送者ID',
     content TEXT NOT NULL COMMENT '消息内容',
-    type TINYINT NOT NULL DEFAULT 0 COMMENT '消息类型：0=文本,1=图片,2=视频,3=文件',
+    type TINYINT NOT NULL DEFAULT 0 COMMENT '消息类型：0=文本,1=图片,2=视频,3=文件,4=系统,7=视频分享卡片',
+    reply_to_id BIGINT NOT NULL DEFAULT 0 COMMENT '回复的消息ID',
+    edited_at TIMESTAMP NULL COMMENT '最后编辑时间',
+    recalled_at TIMESTAMP NULL COMMENT '撤回时间',
     created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
     updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '更新时间',
     deleted_at TIMESTAMP NULL COMMENT '删除时间',
     KEY `idx_room_created` (`room_id`, `created_at`),
+    UNIQUE KEY `idx_room_seq` (`room_id`, `seq`),
     KEY `idx_sender` (`sender_id`),
+    KEY `idx_reply_to` (`reply_to_id`),
     KEY `idx_deleted_at` (`deleted_at`)
 ) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='聊天消息表';
 
+-- 聊天记录归档表，每行对应一个压缩归档文件
+CREATE TABLE IF NOT EXISTS chat_archives (
+    id BIGINT PRIMARY KEY AUTO_INCREMENT COMMENT '归档ID',
+    conversation_key VARCHAR(64) NOT NULL COMMENT '会话标识',
+    from_seq BIGINT NOT NULL COMMENT '起始序号',
+    to_seq BIGINT NOT NULL COMMENT '截止序号',
+    message_count BIGINT NOT NULL DEFAULT 0 COMMENT '消息数',
+    file_path VARCHAR(255) NOT NULL COMMENT '归档文件在对象存储中的路径',
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+    KEY `idx_conversation_seq` (`conversation_key`, `from_seq`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='聊天记录归档表';
+
+-- 会话序号表
+CREATE TABLE IF NOT EXISTS conversation_seqs (
+    conversation_key VARCHAR(64) PRIMARY KEY COMMENT '会话标识：private:<小ID>:<大ID> 或 group:<聊天室ID>',
+    seq BIGINT NOT NULL DEFAULT 0 COMMENT '已分配的最大序号',
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '更新时间'
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='会话序号表';
+
 -- 好友关系表
 CREATE TABLE IF NOT EXISTS friendships (
     id BIGINT PRIMARY KEY AUTO_INCREMENT COMMENT '关系ID',
@@ -78,23 +123,77 @@ CREATE TABLE IF NOT EXISTS friend_requests (
     sender_id BIGINT NOT NULL COMMENT '发送者ID',
     receiver_id BIGINT NOT NULL COMMENT '接收者ID',
     message VARCHAR(255) COMMENT '申请消息',
-    status TINYINT NOT NULL DEFAULT 0 COMMENT '状态：0=待处理,1=已接受,2=已拒绝',
+    status TINYINT NOT NULL DEFAULT 0 COMMENT '状态：0=待处理,1=已接受,2=已拒绝,3=已撤回,4=已过期',
     created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
     updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '更新时间',
     deleted_at TIMESTAMP NULL COMMENT '删除时间',
     KEY `idx_sender` (`sender_id`),
     KEY `idx_receiver` (`receiver_id`),
+    KEY `idx_sender_receiver` (`sender_id`, `receiver_id`, `status`),
     KEY `idx_status` (`status`),
     KEY `idx_deleted_at` (`deleted_at`)
 ) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='好友申请表';
 
--- 消息已读状态表
-CREATE TABLE IF NOT EXISTS message_read_status (
-    id BIGINT PRIMARY KEY AUTO_INCREMENT COMMENT '状态ID',
-    message_id BIGINT NOT NULL COMMENT '消息ID',
+-- 会话已读游标表
+CREATE TABLE IF NOT EXISTS conversation_read_cursors (
     user_id BIGINT NOT NULL COMMENT '用户ID',
-    is_read BOOLEAN NOT NULL DEFAULT FALSE COMMENT '是否已读',
-    read_at TIMESTAMP NULL COMMENT '读取时间',
+    conversation_key VARCHAR(64) NOT NULL COMMENT '会话标识',
+    room_id BIGINT NOT NULL DEFAULT 0 COMMENT '聊天室ID，私信为0',
+    read_seq BIGINT NOT NULL DEFAULT 0 COMMENT '已读到的会话序号',
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '更新时间',
+    PRIMARY KEY (`user_id`, `conversation_key`),
+    KEY `idx_room` (`room_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='会话已读游标表';
+-- 收件箱会话表
+CREATE TABLE IF NOT EXISTS conversations (
+    user_id BIGINT NOT NULL COMMENT '用户ID',
+    conversation_key VARCHAR(64) NOT NULL COMMENT '会话标识',
+    peer_id BIGINT NOT NULL DEFAULT 0 COMMENT '私信对方ID，群聊为0',
+    room_id BIGINT NOT NULL DEFAULT 0 COMMENT '聊天室ID，私信为0',
+    last_message_id BIGINT NOT NULL DEFAULT 0 COMMENT '最后一条消息ID',
+    last_sender_id BIGINT NOT NULL DEFAULT 0 COMMENT '最后一条消息的发送者ID',
+    last_message_type TINYINT NOT NULL DEFAULT 0 COMMENT '最后一条消息的类型',
+    last_preview VARCHAR(128) NOT NULL DEFAULT '' COMMENT '最后一条消息的预览文本',
+    last_active_at BIGINT NOT NULL DEFAULT 0 COMMENT '最后活跃时间（毫秒）',
+    unread_count BIGINT NOT NULL DEFAULT 0 COMMENT '未读消息数',
+    muted TINYINT(1) NOT NULL DEFAULT 0 COMMENT '是否免打扰',
+    pinned TINYINT(1) NOT NULL DEFAULT 0 COMMENT '是否置顶',
+    archived TINYINT(1) NOT NULL DEFAULT 0 COMMENT '是否归档',
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '更新时间',
+    PRIMARY KEY (`user_id`, `conversation_key`),
+    KEY `idx_room` (`room_id`),
+    KEY `idx_inbox` (`user_id`, `archived`, `pinned`, `last_active_at`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='收件箱会话表';
+
+-- 通知表，同一目标上未读的同类通知合并为一行
+CREATE TABLE IF NOT EXISTS notifications (
+    id BIGINT PRIMARY KEY AUTO_INCREMENT COMMENT '通知ID',
+    user_id BIGINT NOT NULL COMMENT '接收通知的用户ID',
+    type TINYINT NOT NULL COMMENT '类型：1=点赞视频,2=评论视频,3=回复评论,4=好友申请,5=新增关注,6=好友申请已通过',
+    target_id BIGINT NOT NULL DEFAULT 0 COMMENT '点赞和评论为视频ID，回复为评论ID，好友申请和关注为0',
+    actor_count BIGINT NOT NULL DEFAULT 0 COMMENT '触发者总数',
+    is_read TINYINT(1) NOT NULL DEFAULT 0 COMMENT '是否已读',
+    last_actor_at BIGINT NOT NULL DEFAULT 0 COMMENT '最后一次合并的时间（秒）',
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '更新时间',
+    KEY `idx_user_latest` (`user_id`, `last_actor_at`),
+    KEY `idx_aggregate` (`user_id`, `type`, `target_id`, `is_read`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='通知表';
+
+-- 通知触发者表
+CREATE TABLE IF NOT EXISTS notification_actors (
+    id BIGINT PRIMARY KEY AUTO_INCREMENT COMMENT 'ID',
+    notification_id BIGINT NOT NULL COMMENT '通知ID',
+    actor_id BIGINT NOT NULL COMMENT '触发者ID',
     created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
-    UNIQUE KEY `idx_message_user` (`message_id`, `user_id`)
-) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='消息已读状态表';
\ No newline at end of file
+    UNIQUE KEY `idx_notification_actor` (`notification_id`, `actor_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='通知触发者表';
+
+-- 通知偏好表，没有记录的类型默认接收
+CREATE TABLE IF NOT EXISTS notification_preferences (
+    user_id BIGINT NOT NULL COMMENT '用户ID',
+    type TINYINT NOT NULL COMMENT '通知类型',
+    enabled TINYINT(1) NOT NULL DEFAULT 1 COMMENT '是否接收',
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '更新时间',
+    PRIMARY KEY (`user_id`, `type`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='通知偏好表';
@@ -21,4 +21,45 @@ CREATE TABLE IF NOT EXISTS `users` (
     PRIMARY KEY (`id`),
     UNIQUE KEY `idx_username` (`username`),
     KEY `idx_deleted_at` (`deleted_at`)
-) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='用户表';
\ No newline at end of file
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='用户表';
+-- 创建关注关系表
+CREATE TABLE IF NOT EXISTS `follows` (
+    `id` bigint(20) NOT NULL AUTO_INCREMENT COMMENT '关注关系ID',
+    `follower_id` bigint(20) NOT NULL COMMENT '关注者ID',
+    `followee_id` bigint(20) NOT NULL COMMENT '被关注者ID',
+    `created_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '关注时间',
+    PRIMARY KEY (`id`),
+    UNIQUE KEY `idx_follower_followee` (`follower_id`, `followee_id`),
+    KEY `idx_followee` (`followee_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='关注关系表';
+
+-- 创建关注计数表
+CREATE TABLE IF NOT EXISTS `user_follow_stats` (
+    `user_id` bigint(20) NOT NULL COMMENT '用户ID',
+    `follow_count` bigint(20) NOT NULL DEFAULT 0 COMMENT '关注数',
+    `follower_count` bigint(20) NOT NULL DEFAULT 0 COMMENT '粉丝数',
+    `updated_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '更新时间',
+    PRIMARY KEY (`user_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='关注计数表';
+
+-- 创建黑名单表
+CREATE TABLE IF NOT EXISTS `user_blocks` (
+    `id` bigint(20) NOT NULL AUTO_INCREMENT COMMENT '拉黑记录ID',
+    `blocker_id` bigint(20) NOT NULL COMMENT '拉黑者ID',
+    `blocked_id` bigint(20) NOT NULL COMMENT '被拉黑者ID',
+    `created_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '拉黑时间',
+    PRIMARY KEY (`id`),
+    UNIQUE KEY `idx_blocker_blocked` (`blocker_id`, `blocked_id`),
+    KEY `idx_blocked` (`blocked_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='黑名单表';
+
+-- 创建隐私设置表
+CREATE TABLE IF NOT EXISTS `user_privacy_settings` (
+    `user_id` bigint(20) NOT NULL COMMENT '用户ID',
+    `dm_policy` tinyint(4) NOT NULL DEFAULT 0 COMMENT '谁可以私信我：0=所有人 1=我关注的人 2=任何人都不可以',
+    `likes_visibility` tinyint(4) NOT NULL DEFAULT 0 COMMENT '谁可以看我的点赞：0=所有人 1=我的粉丝 2=仅自己',
+    `collections_visibility` tinyint(4) NOT NULL DEFAULT 0 COMMENT '谁可以看我的收藏：0=所有人 1=我的粉丝 2=仅自己',
+    `hide_online` tinyint(1) NOT NULL DEFAULT 0 COMMENT '是否对他人隐藏在线状态',
+    `updated_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '更新时间',
+    PRIMARY KEY (`user_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='隐私设置表';
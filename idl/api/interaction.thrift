@@ -13,4 +13,26 @@ service InteractionAPI {
     interaction.CommentListResponse GetComments(1: interaction.GetCommentsRequest req) (api.get="/api/v1/video/comments")
     interaction.DeleteCommentResponse DeleteComment(1: interaction.DeleteCommentRequest req) (api.delete="/api/v1/comment/:comment_id")
     interaction.LikeCommentResponse LikeComment(1: interaction.LikeCommentRequest req) (api.post="/api/v1/comment/:comment_id/like")
+    interaction.EditCommentResponse EditComment(1: interaction.EditCommentRequest req) (api.put="/api/v1/comment/:comment_id")
+    interaction.GetCommentEditHistoryResponse GetCommentEditHistory(1: interaction.GetCommentEditHistoryRequest req) (api.get="/api/v1/comment/:comment_id/history")
+    interaction.PinCommentResponse PinComment(1: interaction.PinCommentRequest req) (api.post="/api/v1/comment/:comment_id/pin")
+    interaction.HeartCommentResponse HeartComment(1: interaction.HeartCommentRequest req) (api.post="/api/v1/comment/:comment_id/heart")
+
+    // 表情回应接口
+    interaction.ReactResponse React(1: interaction.ReactRequest req) (api.post="/api/v1/reaction")
+    interaction.GetReactionsResponse GetReactions(1: interaction.GetReactionsRequest req) (api.get="/api/v1/reactions")
+
+    // 评论审核接口（管理员）
+    interaction.GetFlaggedCommentsResponse GetFlaggedComments(1: interaction.GetFlaggedCommentsRequest req) (api.get="/api/v1/admin/comments/flagged")
+    interaction.ReviewCommentResponse ReviewComment(1: interaction.ReviewCommentRequest req) (api.post="/api/v1/admin/comment/:comment_id/review")
+
+    // 收藏夹接口
+    interaction.CreateCollectionResponse CreateCollection(1: interaction.CreateCollectionRequest req) (api.post="/api/v1/collection")
+    interaction.UpdateCollectionResponse UpdateCollection(1: interaction.UpdateCollectionRequest req) (api.put="/api/v1/collection/:collection_id")
+    interaction.DeleteCollectionResponse DeleteCollection(1: interaction.DeleteCollectionRequest req) (api.delete="/api/v1/collection/:collection_id")
+    interaction.ReorderCollectionsResponse ReorderCollections(1: interaction.ReorderCollectionsRequest req) (api.put="/api/v1/collections/order")
+    interaction.GetCollectionsResponse GetCollections(1: interaction.GetCollectionsRequest req) (api.get="/api/v1/collections")
+    interaction.AddToCollectionResponse AddToCollection(1: interaction.AddToCollectionRequest req) (api.post="/api/v1/collection/video")
+    interaction.RemoveFromCollectionResponse RemoveFromCollection(1: interaction.RemoveFromCollectionRequest req) (api.delete="/api/v1/collection/video")
+    interaction.GetCollectionVideosResponse GetCollectionVideos(1: interaction.GetCollectionVideosRequest req) (api.get="/api/v1/collection/:collection_id/videos")
 } 
\ No newline at end of file
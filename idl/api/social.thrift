@@ -7,6 +7,8 @@ service SocialAPI {
     // 私信相关接口
     social.SendPrivateMessageResponse SendPrivateMessage(1: social.SendPrivateMessageRequest request) (api.post="/api/v1/social/private/message")
     social.GetPrivateMessagesResponse GetPrivateMessages(1: social.GetPrivateMessagesRequest request) (api.get="/api/v1/social/private/messages")
+    social.EditPrivateMessageResponse EditPrivateMessage(1: social.EditPrivateMessageRequest request) (api.put="/api/v1/social/private/message/:message_id")
+    social.RecallPrivateMessageResponse RecallPrivateMessage(1: social.RecallPrivateMessageRequest request) (api.post="/api/v1/social/private/message/:message_id/recall")
     
     // 聊天室相关接口
     social.CreateChatRoomResponse CreateChatRoom(1: social.CreateChatRoomRequest request) (api.post="/api/v1/social/chatroom")
@@ -14,6 +16,24 @@ service SocialAPI {
     social.GetUserChatRoomsResponse GetUserChatRooms(1: social.GetUserChatRoomsRequest request) (api.get="/api/v1/social/chatrooms")
     social.SendChatMessageResponse SendChatMessage(1: social.SendChatMessageRequest request) (api.post="/api/v1/social/chatroom/message")
     social.GetChatMessagesResponse GetChatMessages(1: social.GetChatMessagesRequest request) (api.get="/api/v1/social/chatroom/:room_id/messages")
+    social.UploadChatAttachmentResponse UploadChatAttachment(1: social.UploadChatAttachmentRequest request) (api.post="/api/v1/social/chat/attachment")
+    social.EditChatMessageResponse EditChatMessage(1: social.EditChatMessageRequest request) (api.put="/api/v1/social/chatroom/message/:message_id")
+    social.RecallChatMessageResponse RecallChatMessage(1: social.RecallChatMessageRequest request) (api.post="/api/v1/social/chatroom/message/:message_id/recall")
+    social.SendChatMessageResponse ShareVideo(1: social.ShareVideoRequest request) (api.post="/api/v1/social/chatroom/:room_id/share/video")
+
+    // 聊天室成员相关接口
+    social.AddChatRoomMembersResponse AddChatRoomMembers(1: social.AddChatRoomMembersRequest request) (api.post="/api/v1/social/chatroom/:room_id/members")
+    social.RemoveChatRoomMemberResponse RemoveChatRoomMember(1: social.RemoveChatRoomMemberRequest request) (api.delete="/api/v1/social/chatroom/:room_id/members/:user_id")
+    social.LeaveChatRoomResponse LeaveChatRoom(1: social.LeaveChatRoomRequest request) (api.post="/api/v1/social/chatroom/:room_id/leave")
+
+    // 群管理相关接口
+    social.SetChatRoomAdminResponse SetChatRoomAdmin(1: social.SetChatRoomAdminRequest request) (api.put="/api/v1/social/chatroom/:room_id/admins/:user_id")
+    social.TransferChatRoomResponse TransferChatRoom(1: social.TransferChatRoomRequest request) (api.put="/api/v1/social/chatroom/:room_id/owner")
+    social.MuteChatRoomMemberResponse MuteChatRoomMember(1: social.MuteChatRoomMemberRequest request) (api.put="/api/v1/social/chatroom/:room_id/members/:user_id/mute")
+    social.RenameChatRoomResponse RenameChatRoom(1: social.RenameChatRoomRequest request) (api.put="/api/v1/social/chatroom/:room_id")
+    social.DisbandChatRoomResponse DisbandChatRoom(1: social.DisbandChatRoomRequest request) (api.delete="/api/v1/social/chatroom/:room_id")
+    social.CreateChatRoomInviteResponse CreateChatRoomInvite(1: social.CreateChatRoomInviteRequest request) (api.post="/api/v1/social/chatroom/:room_id/invites")
+    social.JoinChatRoomByInviteResponse JoinChatRoomByInvite(1: social.JoinChatRoomByInviteRequest request) (api.post="/api/v1/social/chatroom/invite/:code/join")
     
     // 好友相关接口
     social.AddFriendResponse AddFriend(1: social.AddFriendRequest request) (api.post="/api/v1/social/friend")
@@ -24,8 +44,30 @@ service SocialAPI {
     social.CreateFriendRequestResponse CreateFriendRequest(1: social.CreateFriendRequestRequest request) (api.post="/api/v1/social/friend/request")
     social.GetFriendRequestsResponse GetFriendRequests(1: social.GetFriendRequestsRequest request) (api.get="/api/v1/social/friend/requests")
     social.HandleFriendRequestResponse HandleFriendRequest(1: social.HandleFriendRequestRequest request) (api.put="/api/v1/social/friend/request/:request_id")
+    social.GetFriendRecommendationsResponse GetFriendRecommendations(1: social.GetFriendRecommendationsRequest request) (api.get="/api/v1/social/friend/recommendations")
     
     // 消息状态相关接口
     social.MarkMessageReadResponse MarkMessageRead(1: social.MarkMessageReadRequest request) (api.put="/api/v1/social/message/:message_id/read")
     social.GetUnreadMessageCountResponse GetUnreadMessageCount(1: social.GetUnreadMessageCountRequest request) (api.get="/api/v1/social/messages/unread/count")
+    social.MarkConversationsReadResponse MarkConversationsRead(1: social.MarkConversationsReadRequest request) (api.post="/api/v1/social/conversations/read")
+    social.GetUnreadCountsResponse GetUnreadCounts(1: social.GetUnreadCountsRequest request) (api.get="/api/v1/social/conversations/unread")
+
+    // 收件箱相关接口
+    social.GetInboxResponse GetInbox(1: social.GetInboxRequest request) (api.get="/api/v1/social/conversations")
+    social.UpdateConversationResponse UpdateConversation(1: social.UpdateConversationRequest request) (api.put="/api/v1/social/conversations/settings")
+
+    // 消息同步接口
+    social.SyncMessagesResponse SyncMessages(1: social.SyncMessagesRequest request) (api.get="/api/v1/social/messages/sync")
+
+    // 通知相关接口
+    social.GetNotificationsResponse GetNotifications(1: social.GetNotificationsRequest request) (api.get="/api/v1/social/notifications")
+    social.GetNotificationUnreadCountResponse GetNotificationUnreadCount(1: social.GetNotificationUnreadCountRequest request) (api.get="/api/v1/social/notifications/unread/count")
+    social.MarkNotificationsReadResponse MarkNotificationsRead(1: social.MarkNotificationsReadRequest request) (api.post="/api/v1/social/notifications/read")
+    social.GetNotificationPreferencesResponse GetNotificationPreferences(1: social.GetNotificationPreferencesRequest request) (api.get="/api/v1/social/notifications/preferences")
+    social.UpdateNotificationPreferencesResponse UpdateNotificationPreferences(1: social.UpdateNotificationPreferencesRequest request) (api.put="/api/v1/social/notifications/preferences")
+    social.GetPresenceResponse GetPresence(1: social.GetPresenceRequest request) (api.get="/api/v1/social/presence")
+
+    // 聊天记录搜索
+    social.SearchMessagesResponse SearchMessages(1: social.SearchMessagesRequest request) (api.get="/api/v1/social/messages/search")
+    social.ExportChatHistoryResponse ExportChatHistory(1: social.ExportChatHistoryRequest request) (api.post="/api/v1/social/messages/export")
 }
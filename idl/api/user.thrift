@@ -12,4 +12,15 @@ service UserAPI {
     // 用户信息相关接口
     user.UserInfoResponse GetUserInfo(1: user.UserInfoRequest request) (api.get="/api/v1/user/info")
     user.UploadAvatarResponse UploadAvatar(1: user.UploadAvatarRequest request) (api.post="/api/v1/user/avatar")
+
+    // 关注相关接口
+    user.FollowResponse Follow(1: user.FollowRequest request) (api.post="/api/v1/user/follow")
+    user.UnfollowResponse Unfollow(1: user.UnfollowRequest request) (api.delete="/api/v1/user/follow/:followee_id")
+    user.FollowListResponse GetFollowing(1: user.FollowListRequest request) (api.get="/api/v1/user/following")
+    user.FollowListResponse GetFollowers(1: user.FollowListRequest request) (api.get="/api/v1/user/followers")
+    user.BlockResponse Block(1: user.BlockRequest request) (api.post="/api/v1/user/block")
+    user.UnblockResponse Unblock(1: user.UnblockRequest request) (api.delete="/api/v1/user/block/:user_id")
+    user.BlockListResponse GetBlockList(1: user.BlockListRequest request) (api.get="/api/v1/user/blocks")
+    user.GetPrivacySettingsResponse GetPrivacySettings(1: user.GetPrivacySettingsRequest request) (api.get="/api/v1/user/privacy")
+    user.UpdatePrivacySettingsResponse UpdatePrivacySettings(1: user.UpdatePrivacySettingsRequest request) (api.put="/api/v1/user/privacy")
 } 
\ No newline at end of file
@@ -9,6 +9,7 @@ service VideoAPI {
     video.VideoListResponse GetVideoList(1: video.VideoListRequest request) (api.get="/api/v1/video/list")
     video.DetailResponse GetVideoDetail(1: video.DetailRequest request) (api.get="/api/v1/video/:video_id")
     video.HotVideoResponse GetHotVideos(1: video.HotVideoRequest request) (api.get="/api/v1/video/hot")
+    video.FollowingFeedResponse GetFollowingFeed(1: video.FollowingFeedRequest request) (api.get="/api/v1/video/feed/following")
     video.DeleteResponse DeleteVideo(1: video.DeleteRequest request) (api.delete="/api/v1/video/:video_id")
     video.SearchResponse SearchVideo(1: video.SearchRequest request) (api.post="/api/v1/video/search")
     video.SemanticSearchResponse SemanticSearch(1: video.SemanticSearchRequest request) (api.post="/api/v1/video/semantic")
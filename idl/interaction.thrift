@@ -4,9 +4,9 @@ include "model.thrift"
 
 // 评论列表响应
 struct CommentListResponse {
-    1: required model.BaseResp Base           // 基本响应信息
-    2: required list<model.Comment> comments  // 评论列表
-    3: required i64 total                     // 总数
+    1: required model.BaseResp Base               // 基本响应信息
+    2: required list<model.CommentInfo> comments  // 评论列表，置顶评论在前
+    3: required i64 total                         // 总数
 }
 
 // 点赞请求
@@ -56,8 +56,8 @@ struct GetCommentsRequest {
 // 获取评论列表响应
 struct GetCommentsResponse {
     1: required model.BaseResp Base              // 基本响应信息
-    2: required list<model.Comment> CommentList  // 评论列表
-    3: required i64 Total                        // 总数
+    2: required list<model.CommentInfo> CommentList  // 评论列表，置顶评论在前
+    3: required i64 Total                            // 总数
 }
 
 // 删除评论请求
@@ -80,6 +80,211 @@ struct LikeCommentResponse {
     1: required model.BaseResp Base  // 基本响应信息
 }
 
+// 表情回应请求，重复提交相同表情视为取消
+struct ReactRequest {
+    1: required i8 target_type       // 目标类型：1=视频,2=评论
+    2: required i64 target_id        // 目标ID
+    3: required string reaction      // 表情
+}
+
+// 表情回应响应
+struct ReactResponse {
+    1: required model.BaseResp Base                // 基本响应信息
+    2: required list<model.ReactionCount> Counts   // 各表情数量
+    3: optional string MyReaction                  // 当前用户的表情，取消后为空
+}
+
+// 获取表情回应列表请求
+struct GetReactionsRequest {
+    1: required i8 target_type       // 目标类型：1=视频,2=评论
+    2: required i64 target_id        // 目标ID
+    3: optional string reaction      // 按表情筛选
+    4: required i32 page             // 页码
+    5: required i32 size             // 每页大小
+}
+
+// 获取表情回应列表响应
+struct GetReactionsResponse {
+    1: required model.BaseResp Base                  // 基本响应信息
+    2: required list<model.ReactionInfo> ReactionList // 回应列表
+    3: required list<model.ReactionCount> Counts     // 各表情数量
+    4: optional string MyReaction                    // 当前用户的表情
+    5: required i64 Total                            // 总数
+}
+
+// 批量获取视频互动状态请求，点赞和收藏状态按当前登录用户返回，未登录时均为 false
+struct GetInteractionStateRequest {
+    2: required list<i64> video_ids  // 视频ID列表
+}
+
+// 批量获取视频互动状态响应
+struct GetInteractionStateResponse {
+    1: required model.BaseResp Base                       // 基本响应信息
+    2: required list<model.VideoInteractionState> States  // 与 video_ids 顺序一致
+}
+
+// 获取待审核评论请求
+struct GetFlaggedCommentsRequest {
+    1: optional i8 status            // 审核状态：1=待审核,2=已拒绝，默认待审核
+    2: required i32 page             // 页码
+    3: required i32 size             // 每页大小
+}
+
+// 获取待审核评论响应
+struct GetFlaggedCommentsResponse {
+    1: required model.BaseResp Base              // 基本响应信息
+    2: required list<model.Comment> CommentList  // 评论列表
+    3: required i64 Total                        // 总数
+}
+
+// 审核评论请求
+struct ReviewCommentRequest {
+    1: required i64 comment_id (api.path="comment_id") // 评论ID
+    2: required i8 action            // 操作：1=通过,2=拒绝
+    3: optional string reason        // 审核备注
+}
+
+// 审核评论响应
+struct ReviewCommentResponse {
+    1: required model.BaseResp Base  // 基本响应信息
+}
+
+// 编辑评论请求
+struct EditCommentRequest {
+    1: required i64 comment_id (api.path="comment_id") // 评论ID
+    2: required string content       // 新的评论内容
+}
+
+// 编辑评论响应
+struct EditCommentResponse {
+    1: required model.BaseResp Base  // 基本响应信息
+}
+
+// 获取评论编辑历史请求
+struct GetCommentEditHistoryRequest {
+    1: required i64 comment_id (api.path="comment_id") // 评论ID
+}
+
+// 获取评论编辑历史响应
+struct GetCommentEditHistoryResponse {
+    1: required model.BaseResp Base              // 基本响应信息
+    2: required list<model.CommentEdit> EditList // 编辑记录，按时间倒序
+}
+
+// 置顶评论请求
+struct PinCommentRequest {
+    1: required i64 comment_id (api.path="comment_id") // 评论ID
+    2: required bool pin             // true=置顶,false=取消置顶
+}
+
+// 置顶评论响应
+struct PinCommentResponse {
+    1: required model.BaseResp Base  // 基本响应信息
+}
+
+// 作者点亮评论请求
+struct HeartCommentRequest {
+    1: required i64 comment_id (api.path="comment_id") // 评论ID
+    2: required bool heart           // true=点亮,false=取消点亮
+}
+
+// 作者点亮评论响应
+struct HeartCommentResponse {
+    1: required model.BaseResp Base  // 基本响应信息
+}
+
+// 创建收藏夹请求
+struct CreateCollectionRequest {
+    1: required string name          // 收藏夹名称
+    2: optional bool is_private      // 是否私密
+}
+
+// 创建收藏夹响应
+struct CreateCollectionResponse {
+    1: required model.BaseResp Base              // 基本响应信息
+    2: required model.Collection Collection      // 新建的收藏夹
+}
+
+// 修改收藏夹请求，未传的字段保持不变
+struct UpdateCollectionRequest {
+    1: required i64 collection_id (api.path="collection_id") // 收藏夹ID
+    2: optional string name          // 新名称
+    3: optional bool is_private      // 是否私密
+}
+
+// 修改收藏夹响应
+struct UpdateCollectionResponse {
+    1: required model.BaseResp Base  // 基本响应信息
+}
+
+// 删除收藏夹请求
+struct DeleteCollectionRequest {
+    1: required i64 collection_id (api.path="collection_id") // 收藏夹ID
+}
+
+// 删除收藏夹响应
+struct DeleteCollectionResponse {
+    1: required model.BaseResp Base  // 基本响应信息
+}
+
+// 调整收藏夹顺序请求
+struct ReorderCollectionsRequest {
+    1: required list<i64> collection_ids // 按新顺序排列的收藏夹ID
+}
+
+// 调整收藏夹顺序响应
+struct ReorderCollectionsResponse {
+    1: required model.BaseResp Base  // 基本响应信息
+}
+
+// 获取收藏夹列表请求
+struct GetCollectionsRequest {
+    1: required i64 user_id          // 收藏夹所属用户，非本人时不返回私密收藏夹
+}
+
+// 获取收藏夹列表响应
+struct GetCollectionsResponse {
+    1: required model.BaseResp Base                   // 基本响应信息
+    2: required list<model.Collection> CollectionList // 收藏夹列表
+}
+
+// 收藏视频请求
+struct AddToCollectionRequest {
+    1: required i64 video_id         // 视频ID
+    2: optional i64 collection_id    // 收藏夹ID，不传则加入"稍后再看"
+}
+
+// 收藏视频响应
+struct AddToCollectionResponse {
+    1: required model.BaseResp Base  // 基本响应信息
+    2: required i64 collection_id    // 实际加入的收藏夹ID
+}
+
+// 取消收藏请求
+struct RemoveFromCollectionRequest {
+    1: required i64 video_id         // 视频ID
+    2: required i64 collection_id    // 收藏夹ID
+}
+
+// 取消收藏响应
+struct RemoveFromCollectionResponse {
+    1: required model.BaseResp Base  // 基本响应信息
+}
+
+// 获取收藏夹视频请求
+struct GetCollectionVideosRequest {
+    1: required i64 collection_id (api.path="collection_id") // 收藏夹ID
+    2: required i32 page             // 页码
+    3: required i32 size             // 每页大小
+}
+
+// 获取收藏夹视频响应
+struct GetCollectionVideosResponse {
+    1: required model.BaseResp Base          // 基本响应信息
+    2: required list<i64> VideoIDs           // 视频ID列表，按收藏时间倒序
+    3: required i64 Total                    // 总数
+}
+
 // 互动服务
 service InteractionService {
     // 点赞操作
@@ -99,4 +304,55 @@ service InteractionService {
 
     // 点赞评论
     LikeCommentResponse LikeComment(1: LikeCommentRequest req)
+
+    // 编辑评论
+    EditCommentResponse EditComment(1: EditCommentRequest req)
+
+    // 获取评论编辑历史
+    GetCommentEditHistoryResponse GetCommentEditHistory(1: GetCommentEditHistoryRequest req)
+
+    // 置顶评论（视频作者）
+    PinCommentResponse PinComment(1: PinCommentRequest req)
+
+    // 点亮评论（视频作者）
+    HeartCommentResponse HeartComment(1: HeartCommentRequest req)
+
+    // 表情回应（视频/评论）
+    ReactResponse React(1: ReactRequest req)
+
+    // 获取表情回应列表
+    GetReactionsResponse GetReactions(1: GetReactionsRequest req)
+
+    // 批量获取视频互动状态
+    GetInteractionStateResponse GetInteractionState(1: GetInteractionStateRequest req)
+
+    // 获取待审核评论（管理员）
+    GetFlaggedCommentsResponse GetFlaggedComments(1: GetFlaggedCommentsRequest req)
+
+    // 审核评论（管理员）
+    ReviewCommentResponse ReviewComment(1: ReviewCommentRequest req)
+
+    // 创建收藏夹
+    CreateCollectionResponse CreateCollection(1: CreateCollectionRequest req)
+
+    // 修改收藏夹名称或可见性
+    UpdateCollectionResponse UpdateCollection(1: UpdateCollectionRequest req)
+
+    // 删除收藏夹
+    DeleteCollectionResponse DeleteCollection(1: DeleteCollectionRequest req)
+
+    // 调整收藏夹顺序
+    ReorderCollectionsResponse ReorderCollections(1: ReorderCollectionsRequest req)
+
+    // 获取用户收藏夹列表
+    GetCollectionsResponse GetCollections(1: GetCollectionsRequest req)
+
+    // 收藏视频
+    AddToCollectionResponse AddToCollection(1: AddToCollectionRequest req)
+
+    // 取消收藏视频
+    RemoveFromCollectionResponse RemoveFromCollection(1: RemoveFromCollectionRequest req)
+
+    // 获取收藏夹中的视频
+    GetCollectionVideosResponse GetCollectionVideos(1: GetCollectionVideosRequest req)
 }
\ No newline at end of file
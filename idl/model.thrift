@@ -18,6 +18,31 @@ struct User {
     10: optional i64 videoCount,     // 视频数量
 }
 
+// 关注列表中的用户
+struct FollowUser {
+    1: required i64 id,              // 用户ID
+    2: required string username,     // 用户名
+    3: optional string avatar,       // 头像URL
+    4: required i64 followed_at,     // 关注时间
+    5: required bool is_mutual,      // 是否互相关注
+}
+
+// 黑名单中的用户
+struct BlockedUser {
+    1: required i64 id,              // 用户ID
+    2: required string username,     // 用户名
+    3: optional string avatar,       // 头像URL
+    4: required i64 blocked_at,      // 拉黑时间
+}
+
+// 隐私设置
+struct PrivacySettings {
+    1: required i8 dm_policy,              // 谁可以私信我：0=所有人 1=我关注的人 2=任何人都不可以
+    2: required i8 likes_visibility,       // 谁可以看我的点赞：0=所有人 1=我的粉丝 2=仅自己
+    3: required i8 collections_visibility, // 谁可以看我的收藏：0=所有人 1=我的粉丝 2=仅自己
+    4: required bool hide_online,          // 是否对他人隐藏在线状态和最后在线时间
+}
+
 // 视频模型
 struct Video {
     1: required i64 id,              // 视频ID
@@ -32,6 +57,8 @@ struct Video {
     10: optional string description, // 视频描述
     11: optional i64 createdAt,      // 创建时间
     12: optional i64 updatedAt,      // 更新时间
+    13: optional i64 collectCount,   // 收藏数
+    14: optional bool isCollected,   // 是否已收藏
 }
 
 // 评论模型
@@ -43,6 +70,8 @@ struct Comment {
     5: optional User user,           // 用户信息
     6: optional i64 createdAt,       // 创建时间
     7: optional i64 updatedAt,       // 更新时间
+    8: optional i8 status,           // 审核状态：0=可见,1=待审核,2=已拒绝
+    9: optional string moderationReason, // 审核原因
 }
 
 struct LikeInfo {
@@ -63,6 +92,56 @@ struct CommentInfo {
     7: optional i64 deleted_at
     8: optional i32 like_count
     9: optional bool is_liked
+    10: optional bool is_edited      // 是否编辑过
+    11: optional i64 edited_at       // 最后编辑时间
+    12: optional bool is_pinned      // 是否被视频作者置顶
+    13: optional bool is_hearted     // 是否被视频作者点亮
+}
+
+// 表情回应统计
+struct ReactionCount {
+    1: required string reaction      // 表情
+    2: required i64 count            // 数量
+}
+
+// 表情回应记录
+struct ReactionInfo {
+    1: required i64 user_id
+    2: required string reaction
+    3: required i64 created_at
+}
+
+// 视频互动状态
+struct VideoInteractionState {
+    1: required i64 video_id
+    2: required bool is_liked                 // 当前用户是否点赞
+    3: required i64 like_count                // 点赞数
+    4: required i64 comment_count             // 可见评论数
+    5: required list<ReactionCount> reactions // 各表情数量
+    6: optional string my_reaction            // 当前用户的表情
+    7: optional i64 collect_count             // 被收藏次数
+    8: optional bool is_collected             // 当前用户是否已收藏
+}
+
+// 收藏夹
+struct Collection {
+    1: required i64 id
+    2: required i64 user_id
+    3: required string name
+    4: required bool is_private               // 是否私密
+    5: required bool is_default               // 是否为默认的"稍后再看"
+    6: required i32 sort_order                // 排序值，越小越靠前
+    7: required i64 video_count               // 视频数量
+    8: required i64 created_at
+    9: required i64 updated_at
+}
+
+// 评论编辑记录
+struct CommentEdit {
+    1: required i64 id
+    2: required i64 comment_id
+    3: required string content       // 编辑前的内容
+    4: required i64 edited_at
 }
 
 // 私信消息
@@ -75,6 +154,19 @@ struct PrivateMessage {
     6: required i64 created_at           // 创建时间
     7: required i64 updated_at           // 更新时间
     8: optional i64 deleted_at           // 删除时间
+    9: required i64 seq                  // 会话内序号，单调递增
+    10: optional i64 reply_to_id         // 回复的消息ID
+    11: optional i64 edited_at           // 最后编辑时间
+    12: optional bool recalled           // 是否已撤回，撤回后内容为空
+}
+
+// 聊天附件，图片、视频、文件消息的内容为其 JSON
+struct ChatAttachment {
+    1: required string url               // 访问地址
+    2: optional string thumbnail_url     // 缩略图地址，图片和视频才有
+    3: required string name              // 原始文件名
+    4: required string content_type      // 文件类型
+    5: required i64 size                 // 文件大小（字节）
 }
 
 // 聊天室
@@ -96,6 +188,45 @@ struct ChatRoomMember {
     6: required i64 created_at           // 创建时间
     7: required i64 updated_at           // 更新时间
     8: optional i64 deleted_at           // 删除时间
+    9: optional i64 muted_until          // 禁言截止时间，0 表示未禁言
+}
+
+// 聊天室邀请链接
+struct ChatRoomInvite {
+    1: required string code              // 邀请码
+    2: required i64 room_id              // 聊天室ID
+    3: required i64 inviter_id           // 创建者ID
+    4: required i64 expires_at           // 过期时间
+}
+
+// 会话已读游标，peer_id 与 room_id 二选一
+struct ReadCursor {
+    1: optional i64 peer_id              // 私信对方ID
+    2: optional i64 room_id              // 聊天室ID
+    3: required i64 read_seq             // 已读到的会话序号，标记已读时为 0 表示读到最新
+}
+
+// 会话未读数，peer_id 与 room_id 二选一
+struct UnreadCount {
+    1: optional i64 peer_id              // 私信对方ID
+    2: optional i64 room_id              // 聊天室ID
+    3: required i64 count                // 未读消息数
+}
+
+// 收件箱会话，peer_id 与 room_id 二选一
+struct Conversation {
+    1: optional i64 peer_id              // 私信对方ID
+    2: optional i64 room_id              // 聊天室ID
+    3: optional string name              // 聊天室名称，私信为空
+    4: required i64 last_message_id      // 最后一条消息ID
+    5: required i64 last_sender_id       // 最后一条消息的发送者ID
+    6: required i8 last_message_type     // 最后一条消息的类型
+    7: required string last_preview      // 最后一条消息的预览文本
+    8: required i64 last_active_at       // 最后活跃时间，毫秒
+    9: required i64 unread_count         // 未读消息数
+    10: required bool muted              // 是否免打扰
+    11: required bool pinned             // 是否置顶
+    12: required bool archived           // 是否归档
 }
 
 // 聊天消息
@@ -104,10 +235,14 @@ struct ChatMessage {
     2: required i64 room_id              // 聊天室ID
     3: required i64 sender_id            // 发送者ID
     4: required string content           // 消息内容
-    5: required i8 type                  // 消息类型：0=文本,1=图片,2=视频,3=文件
+    5: required i8 type                  // 消息类型：0=文本,1=图片,2=视频,3=文件,4=系统,7=视频分享卡片
     6: required i64 created_at           // 创建时间
     7: required i64 updated_at           // 更新时间
     8: optional i64 deleted_at           // 删除时间
+    9: required i64 seq                  // 会话内序号，单调递增
+    10: optional i64 reply_to_id         // 回复的消息ID
+    11: optional i64 edited_at           // 最后编辑时间
+    12: optional bool recalled           // 是否已撤回，撤回后内容为空
 }
 
 // 好友关系
@@ -128,12 +263,65 @@ struct FriendRequest {
     2: required i64 sender_id            // 发送者ID
     3: required i64 receiver_id          // 接收者ID
     4: optional string message           // 申请消息
-    5: required i8 status                // 状态：0=待处理,1=已接受,2=已拒绝
+    5: required i8 status                // 状态：0=待处理,1=已接受,2=已拒绝,3=已撤回,4=已过期
     6: required i64 created_at           // 创建时间
     7: required i64 updated_at           // 更新时间
     8: optional i64 deleted_at           // 删除时间
 } 
 
+// 好友推荐
+struct FriendRecommendation {
+    1: required i64 user_id              // 被推荐的用户ID
+    2: required double score             // 推荐分数
+    3: required i64 mutual_friends       // 共同好友数
+    4: required i64 mutual_follows       // 我关注的人中关注了对方的人数
+    5: required i64 shared_likes         // 双方都点赞过的视频数
+    6: required i64 co_comments          // 双方都评论过的视频数
+}
+
+// 通知，同一目标上未读的同类通知合并为一条，如"A 等 13 人赞了你的视频"
+struct Notification {
+    1: required i64 id                   // 通知ID
+    2: required i8 type                  // 类型：1=点赞视频,2=评论视频,3=回复评论,4=好友申请,5=新增关注,6=好友申请已通过
+    3: required i64 target_id            // 点赞和评论为视频ID，回复为被回复的评论ID，好友申请和关注为 0
+    4: required list<i64> actor_ids      // 最近的触发者，最新的在前
+    5: required i64 actor_count          // 触发者总数
+    6: required bool is_read             // 是否已读
+    7: required i64 created_at           // 创建时间
+    8: required i64 updated_at           // 最后一次合并的时间
+}
+
+// 各类型的未读通知数
+struct NotificationUnreadCount {
+    1: required i8 type                  // 通知类型
+    2: required i64 count                // 未读数
+}
+
+// 通知偏好，关闭的类型不再产生通知
+struct NotificationPreference {
+    1: required i8 type                  // 通知类型
+    2: required bool enabled             // 是否接收
+}
+
+// 用户在线状态，对方隐藏在线状态或不是好友时 online 为 false，last_seen 为 0
+struct UserPresence {
+    1: required i64 user_id              // 用户ID
+    2: required bool online              // 是否在线
+    3: required i64 last_seen            // 最后在线时间，从未上线时为 0
+}
+
+// 聊天记录搜索结果，room_id 为 0 时是私信
+struct SearchedMessage {
+    1: required i64 id                   // 消息ID
+    2: required i64 room_id              // 聊天室ID
+    3: required i64 sender_id            // 发送者ID
+    4: required i64 receiver_id          // 私信接收者ID
+    5: required string content           // 消息内容
+    6: required i8 type                  // 消息类型
+    7: required i64 seq                  // 会话内序号，用于定位上下文
+    8: required i64 created_at           // 创建时间
+}
+
 // 语义搜索结果项
 struct SemanticSearchResultItem {
     1: required list<Video> videos            // 视频列表
@@ -10,7 +10,8 @@ enum MessageType {
     FILE = 3,      // 文件消息
     SYSTEM = 4,    // 系统消息
     PRIVATE = 5,   // 私信
-    GROUP = 6      // 群聊消息
+    GROUP = 6,     // 群聊消息
+    VIDEO_CARD = 7 // 视频分享卡片
 }
 
 // 聊天室类型
@@ -29,9 +30,9 @@ enum FriendshipStatus {
 
 // 发送私信请求
 struct SendPrivateMessageRequest {
-    1: required i64 sender_id            // 发送者ID
-    2: required i64 receiver_id          // 接收者ID
+    2: required i64 receiver_id          // 接收者ID，发送者为当前登录用户
     3: required string content           // 消息内容
+    4: optional i64 reply_to_id          // 回复的消息ID，需在同一私信会话
 }
 
 // 发送私信响应
@@ -57,8 +58,7 @@ struct GetPrivateMessagesResponse {
 
 // 创建聊天室请求
 struct CreateChatRoomRequest {
-    1: required i64 creator_id           // 创建者ID
-    2: required string name              // 聊天室名称
+    2: required string name              // 聊天室名称，创建者为当前登录用户
     3: required i8 type                  // 类型：1=私聊,2=群聊
     4: optional list<i64> member_ids     // 初始成员ID列表
 }
@@ -71,8 +71,7 @@ struct CreateChatRoomResponse {
 
 // 获取聊天室请求
 struct GetChatRoomRequest {
-    1: required i64 room_id              // 聊天室ID
-    2: required i64 user_id              // 请求用户ID
+    1: required i64 room_id              // 聊天室ID，请求用户为当前登录用户
 }
 
 // 获取聊天室响应
@@ -95,12 +94,12 @@ struct GetUserChatRoomsResponse {
     3: required i64 Total                // 总数
 }
 
-// 发送聊天消息请求
+// 发送聊天消息请求，发送者为当前登录用户
 struct SendChatMessageRequest {
     1: required i64 room_id              // 聊天室ID
-    2: required i64 sender_id            // 发送者ID
     3: required string content           // 消息内容
-    4: optional i8 type                  // 消息类型：0=文本,1=图片,2=视频,3=文件
+    4: optional i8 type                  // 消息类型：0=文本,1=图片,2=视频,3=文件,7=视频分享卡片
+    5: optional i64 reply_to_id          // 回复的消息ID，需在同一聊天室
 }
 
 // 发送聊天消息响应
@@ -111,8 +110,7 @@ struct SendChatMessageResponse {
 
 // 获取聊天消息列表请求
 struct GetChatMessagesRequest {
-    1: required i64 room_id              // 聊天室ID
-    2: required i64 user_id              // 用户ID
+    1: required i64 room_id              // 聊天室ID，请求用户为当前登录用户
     3: optional i64 page           
     4: optional i32 size              
 }
@@ -124,17 +122,17 @@ struct GetChatMessagesResponse {
     3: required i64 Total                        // 总数
 }
 
-// 添加好友请求
+// 添加好友请求，申请者为当前登录用户
 struct AddFriendRequest {
-    1: required i64 user_id              // 用户ID
     2: required i64 friend_id            // 好友ID
     3: optional string remark            // 好友备注
 }
 
-// 添加好友响应
+// 添加好友响应，添加好友即发送好友申请
 struct AddFriendResponse {
     1: required model.BaseResp Base      // 基本响应信息
-    2: required model.Friendship Friend        // 创建的好友关系
+    2: required model.Friendship Friend        // 创建的好友关系，对方同意前为待确认
+    3: optional model.FriendRequest Request    // 发出的好友申请，对方已发来申请时为同意后的该申请
 }
 
 // 获取用户好友列表请求
@@ -163,9 +161,8 @@ struct GetFriendshipResponse {
     2: required model.Friendship Friend        // 好友关系
 }
 
-// 创建好友申请请求
+// 创建好友申请请求，发送者为当前登录用户
 struct CreateFriendRequestRequest {
-    1: required i64 sender_id            // 发送者ID
     2: required i64 receiver_id          // 接收者ID
     3: optional string message           // 申请消息
 }
@@ -178,8 +175,7 @@ struct CreateFriendRequestResponse {
 
 // 获取好友申请列表请求
 struct GetFriendRequestsRequest {
-    1: required i64 user_id              // 用户ID
-    2: optional i8 type                  // 类型：0=收到的,1=发出的
+    2: optional i8 type                  // 类型：0=收到的,1=发出的，用户为当前登录用户
     3: optional i32 page                 // 页码
     4: optional i32 size                 // 每页数量
 }
@@ -191,11 +187,21 @@ struct GetFriendRequestsResponse {
     3: required i64 Total                        // 总数
 }
 
-// 处理好友申请请求
+// 为当前登录用户获取好友推荐请求，排除已是好友、已关注和存在拉黑关系的用户
+struct GetFriendRecommendationsRequest {
+    2: optional i32 limit                // 返回条数
+}
+
+// 获取好友推荐响应，按推荐分数倒序
+struct GetFriendRecommendationsResponse {
+    1: required model.BaseResp Base                           // 基本响应信息
+    2: required list<model.FriendRecommendation> Recommendations // 推荐列表
+}
+
+// 处理好友申请请求，处理者为当前登录用户
 struct HandleFriendRequestRequest {
     1: required i64 request_id           // 申请ID
-    2: required i64 user_id              // 处理用户ID
-    3: required i8 action                // 操作：1=接受,2=拒绝
+    3: required i8 action                // 操作：1=接受,2=拒绝,3=撤回（发送者）
 }
 
 // 处理好友申请响应
@@ -205,33 +211,428 @@ struct HandleFriendRequestResponse {
     3: optional model.Friendship Friend        // 如果接受，创建的好友关系
 }
 
-// 标记消息已读请求
+// 标记消息已读请求，读者为当前登录用户
 struct MarkMessageReadRequest {
     1: required i64 message_id           // 消息ID
-    2: required i64 user_id              // 用户ID
 }
 
-// 标记消息已读响应
+// 标记消息已读响应，已读游标推进到该私信的序号
 struct MarkMessageReadResponse {
     1: required model.BaseResp Base      // 基本响应信息
+    2: optional model.ReadCursor Cursor  // 推进后的已读游标
 }
 
-// 获取未读消息数请求
+// 获取当前登录用户的未读消息数请求
 struct GetUnreadMessageCountRequest {
-    1: required i64 user_id              // 用户ID
 }
 
 // 获取未读消息数响应
 struct GetUnreadMessageCountResponse {
     1: required model.BaseResp Base      // 基本响应信息
-    2: required i64 Count                // 未读消息数
+    2: required i64 Count                // 所有会话的未读消息总数
+}
+
+// 批量标记会话已读请求，读者为当前登录用户
+struct MarkConversationsReadRequest {
+    2: required list<model.ReadCursor> conversations // 要标记的会话及已读序号
+}
+
+// 批量标记会话已读响应
+struct MarkConversationsReadResponse {
+    1: required model.BaseResp Base                  // 基本响应信息
+    2: required list<model.ReadCursor> Cursors       // 推进后的已读游标，游标只进不退
+}
+
+// 获取当前登录用户各会话未读数请求
+struct GetUnreadCountsRequest {
+}
+
+// 获取各会话未读数响应，只包含有未读消息的会话
+struct GetUnreadCountsResponse {
+    1: required model.BaseResp Base          // 基本响应信息
+    2: required list<model.UnreadCount> Counts // 各会话未读数
+    3: required i64 Total                    // 未读消息总数
+}
+
+// 获取当前登录用户的收件箱请求，按置顶、最后活跃时间倒序分页
+struct GetInboxRequest {
+    2: optional string cursor            // 上一页返回的游标，为空时从头开始
+    3: optional i32 limit                // 每页条数
+    4: optional bool archived            // 为 true 时只返回已归档的会话，否则只返回未归档的会话
+}
+
+// 获取收件箱响应
+struct GetInboxResponse {
+    1: required model.BaseResp Base                    // 基本响应信息
+    2: required list<model.Conversation> Conversations // 会话列表
+    3: optional string NextCursor                      // 下一页游标
+    4: required bool HasMore                           // 是否还有更多会话
+}
+
+// 修改当前登录用户的会话设置请求，peer_id 与 room_id 二选一，未设置的字段保持不变
+struct UpdateConversationRequest {
+    2: optional i64 peer_id              // 私信对方ID
+    3: optional i64 room_id              // 聊天室ID
+    4: optional bool muted               // 是否免打扰
+    5: optional bool pinned              // 是否置顶
+    6: optional bool archived            // 是否归档
+}
+
+// 修改会话设置响应
+struct UpdateConversationResponse {
+    1: required model.BaseResp Base          // 基本响应信息
+    2: required model.Conversation Conversation // 修改后的会话
+}
+
+// 同步会话消息请求，同步当前登录用户的会话，peer_id 与 room_id 二选一
+struct SyncMessagesRequest {
+    2: optional i64 peer_id              // 私信对方ID
+    3: optional i64 room_id              // 聊天室ID
+    4: required i64 after_seq            // 返回序号大于该值的消息
+    5: optional i32 limit                // 最多返回条数
+}
+
+// 同步会话消息响应，消息按序号升序
+struct SyncMessagesResponse {
+    1: required model.BaseResp Base                      // 基本响应信息
+    2: optional list<model.PrivateMessage> PrivateMessages // 私信消息
+    3: optional list<model.ChatMessage> ChatMessages       // 群聊消息
+    4: required i64 LatestSeq                            // 会话当前最大序号
+    5: required bool HasMore                             // 是否还有更多消息
+}
+
+// 添加聊天室成员请求，操作者为当前登录用户，仅管理员和群主可操作，受群成员上限和用户入群上限约束
+struct AddChatRoomMembersRequest {
+    2: required i64 room_id (api.path="room_id")       // 聊天室ID
+    3: required list<i64> member_ids                   // 待添加的用户ID
+}
+
+// 添加聊天室成员响应
+struct AddChatRoomMembersResponse {
+    1: required model.BaseResp Base      // 基本响应信息
+    2: required list<i64> AddedIds       // 新加入的用户ID，已是成员的用户不包含在内
+    3: optional model.ChatMessage SystemMessage // 本次变更产生的系统消息
+}
+
+// 移除聊天室成员请求，操作者为当前登录用户，角色需高于被移除的成员
+struct RemoveChatRoomMemberRequest {
+    2: required i64 room_id (api.path="room_id")       // 聊天室ID
+    3: required i64 user_id (api.path="user_id")       // 被移除的用户ID
+}
+
+// 移除聊天室成员响应
+struct RemoveChatRoomMemberResponse {
+    1: required model.BaseResp Base      // 基本响应信息
+    2: optional model.ChatMessage SystemMessage // 本次变更产生的系统消息
+}
+
+// 退出聊天室请求，退出者为当前登录用户
+struct LeaveChatRoomRequest {
+    2: required i64 room_id (api.path="room_id")       // 聊天室ID
+}
+
+// 退出聊天室响应
+struct LeaveChatRoomResponse {
+    1: required model.BaseResp Base      // 基本响应信息
+    2: optional model.ChatMessage SystemMessage // 本次变更产生的系统消息
+}
+
+// 检查聊天室成员请求
+struct CheckChatRoomMemberRequest {
+    1: required i64 room_id              // 聊天室ID
+    2: required i64 user_id              // 用户ID
+}
+
+// 检查聊天室成员响应
+struct CheckChatRoomMemberResponse {
+    1: required model.BaseResp Base      // 基本响应信息
+    2: required bool IsMember            // 是否是成员
+}
+
+// 检查能否私信请求，用于网关转发正在输入等临时信号
+struct CheckPrivateChatRequest {
+    1: required i64 user_id              // 用户ID
+    2: required i64 peer_id              // 对方用户ID
+}
+
+// 检查能否私信响应
+struct CheckPrivateChatResponse {
+    1: required model.BaseResp Base      // 基本响应信息
+    2: required bool Allowed             // 是否可以私信对方
+}
+
+// 设置管理员请求，操作者为当前登录用户，仅群主可操作
+struct SetChatRoomAdminRequest {
+    2: required i64 room_id (api.path="room_id")       // 聊天室ID
+    3: required i64 user_id (api.path="user_id")       // 目标用户ID
+    4: required bool is_admin                          // true=设为管理员,false=取消管理员
+}
+
+// 设置管理员响应
+struct SetChatRoomAdminResponse {
+    1: required model.BaseResp Base      // 基本响应信息
+    2: optional model.ChatMessage SystemMessage // 本次变更产生的系统消息
+}
+
+// 转让群主请求，操作者为当前登录用户，原群主转为管理员
+struct TransferChatRoomRequest {
+    2: required i64 room_id (api.path="room_id")       // 聊天室ID
+    3: required i64 new_owner_id                       // 新群主ID，需已是成员
+}
+
+// 转让群主响应
+struct TransferChatRoomResponse {
+    1: required model.BaseResp Base      // 基本响应信息
+    2: optional model.ChatMessage SystemMessage // 本次变更产生的系统消息
+}
+
+// 禁言成员请求，操作者为当前登录用户，角色需高于被禁言的成员
+struct MuteChatRoomMemberRequest {
+    2: required i64 room_id (api.path="room_id")       // 聊天室ID
+    3: required i64 user_id (api.path="user_id")       // 被禁言的用户ID
+    4: required i64 duration                           // 禁言时长（秒），0 表示解除禁言
+}
+
+// 禁言成员响应
+struct MuteChatRoomMemberResponse {
+    1: required model.BaseResp Base      // 基本响应信息
+    2: required i64 MutedUntil           // 禁言截止时间，0 表示未禁言
+    3: optional model.ChatMessage SystemMessage // 本次变更产生的系统消息
+}
+
+// 修改聊天室名称请求，操作者为当前登录用户，仅管理员和群主可操作
+struct RenameChatRoomRequest {
+    2: required i64 room_id (api.path="room_id")       // 聊天室ID
+    3: required string name                            // 新名称
+}
+
+// 修改聊天室名称响应
+struct RenameChatRoomResponse {
+    1: required model.BaseResp Base      // 基本响应信息
+    2: optional model.ChatMessage SystemMessage // 本次变更产生的系统消息
+}
+
+// 解散聊天室请求，操作者为当前登录用户，仅群主可操作
+struct DisbandChatRoomRequest {
+    2: required i64 room_id (api.path="room_id")       // 聊天室ID
+}
+
+// 解散聊天室响应
+struct DisbandChatRoomResponse {
+    1: required model.BaseResp Base      // 基本响应信息
+    2: required list<i64> MemberIds      // 解散前的成员ID，用于通知在线连接
+    3: optional model.ChatMessage SystemMessage // 解散前写入的系统消息
+}
+
+// 创建邀请链接请求，操作者为当前登录用户，仅管理员和群主可操作
+struct CreateChatRoomInviteRequest {
+    2: required i64 room_id (api.path="room_id")       // 聊天室ID
+    3: optional i64 ttl                                // 有效期（秒），不传使用默认值
+}
+
+// 创建邀请链接响应
+struct CreateChatRoomInviteResponse {
+    1: required model.BaseResp Base      // 基本响应信息
+    2: required model.ChatRoomInvite Invite // 邀请链接
+}
+
+// 通过邀请链接加入聊天室请求，加入者为当前登录用户
+struct JoinChatRoomByInviteRequest {
+    2: required string code (api.path="code")          // 邀请码
+}
+
+// 通过邀请链接加入聊天室响应
+struct JoinChatRoomByInviteResponse {
+    1: required model.BaseResp Base      // 基本响应信息
+    2: required i64 RoomId               // 加入的聊天室ID
+    3: optional model.ChatMessage SystemMessage // 本次变更产生的系统消息，已是成员时为空
+}
+
+// 上传聊天附件请求，上传者为当前登录用户
+struct UploadChatAttachmentRequest {
+    2: required string file_name         // 原始文件名
+    3: required string content_type      // 文件类型
+    4: required binary data              // 文件内容
+}
+
+// 上传聊天附件响应
+struct UploadChatAttachmentResponse {
+    1: required model.BaseResp Base      // 基本响应信息
+    2: required model.ChatAttachment Attachment // 附件信息，作为消息内容发送
+}
+
+// 编辑聊天消息请求，操作者为当前登录用户，仅发送者可在时限内编辑文本消息
+struct EditChatMessageRequest {
+    2: required i64 message_id (api.path="message_id") // 消息ID
+    3: required string content                         // 新内容
+}
+
+// 编辑聊天消息响应
+struct EditChatMessageResponse {
+    1: required model.BaseResp Base      // 基本响应信息
+    2: required model.ChatMessage Message // 编辑后的消息
+}
+
+// 撤回聊天消息请求，操作者为当前登录用户，发送者可在时限内撤回，管理员可撤回角色更低成员的消息
+struct RecallChatMessageRequest {
+    2: required i64 message_id (api.path="message_id") // 消息ID
+}
+
+// 撤回聊天消息响应
+struct RecallChatMessageResponse {
+    1: required model.BaseResp Base      // 基本响应信息
+    2: required model.ChatMessage Message // 撤回后的消息占位
+}
+
+// 编辑私信请求，操作者为当前登录用户，仅发送者可在时限内编辑
+struct EditPrivateMessageRequest {
+    1: required i64 message_id (api.path="message_id") // 消息ID
+    2: required string content                         // 新内容
+}
+
+// 编辑私信响应
+struct EditPrivateMessageResponse {
+    1: required model.BaseResp Base         // 基本响应信息
+    2: required model.PrivateMessage Message // 编辑后的消息
+}
+
+// 撤回私信请求，操作者为当前登录用户，仅发送者可在时限内撤回
+struct RecallPrivateMessageRequest {
+    1: required i64 message_id (api.path="message_id") // 消息ID
+}
+
+// 撤回私信响应
+struct RecallPrivateMessageResponse {
+    1: required model.BaseResp Base         // 基本响应信息
+    2: required model.PrivateMessage Message // 撤回后的消息占位
+}
+
+// 分享视频到聊天室请求，由网关查询视频信息生成卡片消息，发送者为当前登录用户
+struct ShareVideoRequest {
+    1: required i64 room_id (api.path="room_id") // 聊天室ID
+    2: required i64 video_id             // 视频ID
+    3: optional i64 reply_to_id          // 回复的消息ID
 }
 
 // 社交服务
+// 分页获取当前登录用户的通知请求，按最后合并时间倒序
+struct GetNotificationsRequest {
+    2: optional i8 type                  // 通知类型，不传时返回全部类型
+    3: optional i32 page                 // 页码
+    4: optional i32 size                 // 每页条数
+}
+
+// 分页获取通知响应
+struct GetNotificationsResponse {
+    1: required model.BaseResp Base                      // 基本响应信息
+    2: required list<model.Notification> Notifications   // 通知列表
+    3: required i64 Total                                // 通知总数
+}
+
+// 获取当前登录用户的未读通知数请求
+struct GetNotificationUnreadCountRequest {
+}
+
+// 获取未读通知数响应，只包含有未读通知的类型
+struct GetNotificationUnreadCountResponse {
+    1: required model.BaseResp Base                      // 基本响应信息
+    2: required list<model.NotificationUnreadCount> Counts // 各类型未读数
+    3: required i64 Total                                // 未读通知总数
+}
+
+// 标记当前登录用户的通知已读请求，不传通知ID时标记全部（或指定类型的全部）未读通知
+struct MarkNotificationsReadRequest {
+    2: optional list<i64> notification_ids   // 通知ID列表
+    3: optional i8 type                      // 通知类型
+}
+
+// 标记通知已读响应
+struct MarkNotificationsReadResponse {
+    1: required model.BaseResp Base      // 基本响应信息
+    2: required i64 Count                // 本次标记已读的通知数
+}
+
+// 获取当前登录用户的通知偏好请求
+struct GetNotificationPreferencesRequest {
+}
+
+// 获取通知偏好响应，包含全部通知类型
+struct GetNotificationPreferencesResponse {
+    1: required model.BaseResp Base                          // 基本响应信息
+    2: required list<model.NotificationPreference> Preferences // 各类型偏好
+}
+
+// 修改当前登录用户的通知偏好请求，未传的类型保持不变
+struct UpdateNotificationPreferencesRequest {
+    2: required list<model.NotificationPreference> preferences // 要修改的偏好
+}
+
+// 修改通知偏好响应，返回修改后的全部偏好
+struct UpdateNotificationPreferencesResponse {
+    1: required model.BaseResp Base                          // 基本响应信息
+    2: required list<model.NotificationPreference> Preferences // 各类型偏好
+}
+
+// 上报在线状态请求，由网关在连接建立、心跳和最后一个连接断开时调用
+struct UpdatePresenceRequest {
+    1: required list<i64> user_ids       // 用户ID列表
+    2: required bool online              // 是否在线
+}
+
+// 上报在线状态响应
+struct UpdatePresenceResponse {
+    1: required model.BaseResp Base      // 基本响应信息
+}
+
+// 批量获取在线状态请求，查看者为当前登录用户，不传用户ID时返回全部好友的在线状态
+struct GetPresenceRequest {
+    2: optional list<i64> user_ids       // 要查询的用户ID列表
+}
+
+// 批量获取在线状态响应
+struct GetPresenceResponse {
+    1: required model.BaseResp Base              // 基本响应信息
+    2: required list<model.UserPresence> Presences // 在线状态列表
+}
+
+// 搜索当前登录用户的聊天记录请求，peer_id 与 room_id 都不传时搜索全部私信和所在聊天室
+struct SearchMessagesRequest {
+    2: optional string keyword           // 关键词
+    3: optional i64 sender_id            // 发送者ID
+    4: optional i64 peer_id              // 只搜索与该用户的私信
+    5: optional i64 room_id              // 只搜索该聊天室
+    6: optional i64 from_date            // 起始时间（秒）
+    7: optional i64 to_date              // 截止时间（秒）
+    8: optional i32 page                 // 页码
+    9: optional i32 size                 // 每页数量
+}
+
+// 搜索聊天记录响应
+struct SearchMessagesResponse {
+    1: required model.BaseResp Base              // 基本响应信息
+    2: required list<model.SearchedMessage> Messages // 按时间倒序的消息
+    3: required i64 Total                        // 命中总数
+}
+
+// 导出当前登录用户的聊天记录请求，peer_id 与 room_id 二选一，只导出已归档的聊天记录
+struct ExportChatHistoryRequest {
+    2: optional i64 peer_id              // 私信对方ID
+    3: optional i64 room_id              // 聊天室ID
+}
+
+// 导出聊天记录响应
+struct ExportChatHistoryResponse {
+    1: required model.BaseResp Base      // 基本响应信息
+    2: required string url               // 导出文件的下载地址，每行一条 JSON 消息，没有归档记录时为空
+    3: required i64 message_count        // 导出的消息数
+}
+
 service SocialService {
     // 私信相关
     SendPrivateMessageResponse SendPrivateMessage(1: SendPrivateMessageRequest req)
     GetPrivateMessagesResponse GetPrivateMessages(1: GetPrivateMessagesRequest req)
+    EditPrivateMessageResponse EditPrivateMessage(1: EditPrivateMessageRequest req)
+    RecallPrivateMessageResponse RecallPrivateMessage(1: RecallPrivateMessageRequest req)
     
     // 聊天室相关
     CreateChatRoomResponse CreateChatRoom(1: CreateChatRoomRequest req)
@@ -239,6 +640,25 @@ service SocialService {
     GetUserChatRoomsResponse GetUserChatRooms(1: GetUserChatRoomsRequest req)
     SendChatMessageResponse SendChatMessage(1: SendChatMessageRequest req)
     GetChatMessagesResponse GetChatMessages(1: GetChatMessagesRequest req)
+    UploadChatAttachmentResponse UploadChatAttachment(1: UploadChatAttachmentRequest req)
+    EditChatMessageResponse EditChatMessage(1: EditChatMessageRequest req)
+    RecallChatMessageResponse RecallChatMessage(1: RecallChatMessageRequest req)
+
+    // 聊天室成员相关
+    AddChatRoomMembersResponse AddChatRoomMembers(1: AddChatRoomMembersRequest req)
+    RemoveChatRoomMemberResponse RemoveChatRoomMember(1: RemoveChatRoomMemberRequest req)
+    LeaveChatRoomResponse LeaveChatRoom(1: LeaveChatRoomRequest req)
+    CheckChatRoomMemberResponse CheckChatRoomMember(1: CheckChatRoomMemberRequest req)
+    CheckPrivateChatResponse CheckPrivateChat(1: CheckPrivateChatRequest req)
+
+    // 群管理相关
+    SetChatRoomAdminResponse SetChatRoomAdmin(1: SetChatRoomAdminRequest req)
+    TransferChatRoomResponse TransferChatRoom(1: TransferChatRoomRequest req)
+    MuteChatRoomMemberResponse MuteChatRoomMember(1: MuteChatRoomMemberRequest req)
+    RenameChatRoomResponse RenameChatRoom(1: RenameChatRoomRequest req)
+    DisbandChatRoomResponse DisbandChatRoom(1: DisbandChatRoomRequest req)
+    CreateChatRoomInviteResponse CreateChatRoomInvite(1: CreateChatRoomInviteRequest req)
+    JoinChatRoomByInviteResponse JoinChatRoomByInvite(1: JoinChatRoomByInviteRequest req)
     
     // 好友相关
     AddFriendResponse AddFriend(1: AddFriendRequest req)
@@ -249,8 +669,33 @@ service SocialService {
     CreateFriendRequestResponse CreateFriendRequest(1: CreateFriendRequestRequest req)
     GetFriendRequestsResponse GetFriendRequests(1: GetFriendRequestsRequest req)
     HandleFriendRequestResponse HandleFriendRequest(1: HandleFriendRequestRequest req)
+    GetFriendRecommendationsResponse GetFriendRecommendations(1: GetFriendRecommendationsRequest req)
     
     // 消息状态相关
     MarkMessageReadResponse MarkMessageRead(1: MarkMessageReadRequest req)
     GetUnreadMessageCountResponse GetUnreadMessageCount(1: GetUnreadMessageCountRequest req)
+    MarkConversationsReadResponse MarkConversationsRead(1: MarkConversationsReadRequest req)
+    GetUnreadCountsResponse GetUnreadCounts(1: GetUnreadCountsRequest req)
+
+    // 收件箱相关
+    GetInboxResponse GetInbox(1: GetInboxRequest req)
+    UpdateConversationResponse UpdateConversation(1: UpdateConversationRequest req)
+
+    // 消息同步
+    SyncMessagesResponse SyncMessages(1: SyncMessagesRequest req)
+
+    // 通知相关
+    GetNotificationsResponse GetNotifications(1: GetNotificationsRequest req)
+    GetNotificationUnreadCountResponse GetNotificationUnreadCount(1: GetNotificationUnreadCountRequest req)
+    MarkNotificationsReadResponse MarkNotificationsRead(1: MarkNotificationsReadRequest req)
+    GetNotificationPreferencesResponse GetNotificationPreferences(1: GetNotificationPreferencesRequest req)
+    UpdateNotificationPreferencesResponse UpdateNotificationPreferences(1: UpdateNotificationPreferencesRequest req)
+
+    // 在线状态相关
+    UpdatePresenceResponse UpdatePresence(1: UpdatePresenceRequest req)
+    GetPresenceResponse GetPresence(1: GetPresenceRequest req)
+
+    // 聊天记录搜索
+    SearchMessagesResponse SearchMessages(1: SearchMessagesRequest req)
+    ExportChatHistoryResponse ExportChatHistory(1: ExportChatHistoryRequest req)
 }
\ No newline at end of file
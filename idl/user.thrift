@@ -62,6 +62,100 @@ struct RefreshTokenResponse {
     2: required string token         // 新的访问令牌
 }
 
+// 关注请求，关注者为当前登录用户
+struct FollowRequest {
+    1: required i64 followee_id         // 被关注的用户ID
+}
+
+// 关注响应
+struct FollowResponse {
+    1: required model.BaseResp Base // 基本响应信息
+    2: required bool is_mutual      // 关注后是否互相关注
+}
+
+// 取消关注请求，关注者为当前登录用户
+struct UnfollowRequest {
+    1: required i64 followee_id (api.path="followee_id") // 取消关注的用户ID
+}
+
+// 取消关注响应
+struct UnfollowResponse {
+    1: required model.BaseResp Base // 基本响应信息
+}
+
+// 获取关注或粉丝列表请求，按关注时间倒序
+struct FollowListRequest {
+    1: required i64 user_id             // 用户ID
+    2: optional i64 cursor              // 上一页返回的游标，为空时从头开始
+    3: optional i32 limit               // 每页条数
+}
+
+// 获取关注或粉丝列表响应
+struct FollowListResponse {
+    1: required model.BaseResp Base          // 基本响应信息
+    2: required list<model.FollowUser> users // 用户列表
+    3: optional i64 next_cursor              // 下一页游标
+    4: required bool has_more                // 是否还有更多
+}
+
+// 拉黑请求，拉黑者为当前登录用户
+struct BlockRequest {
+    1: required i64 user_id             // 被拉黑的用户ID
+}
+
+// 拉黑响应
+struct BlockResponse {
+    1: required model.BaseResp Base // 基本响应信息
+}
+
+// 取消拉黑请求，拉黑者为当前登录用户
+struct UnblockRequest {
+    1: required i64 user_id (api.path="user_id") // 取消拉黑的用户ID
+}
+
+// 取消拉黑响应
+struct UnblockResponse {
+    1: required model.BaseResp Base // 基本响应信息
+}
+
+// 获取黑名单请求，按拉黑时间倒序
+struct BlockListRequest {
+    1: optional i64 cursor              // 上一页返回的游标，为空时从头开始
+    2: optional i32 limit               // 每页条数
+}
+
+// 获取黑名单响应
+struct BlockListResponse {
+    1: required model.BaseResp Base           // 基本响应信息
+    2: required list<model.BlockedUser> users // 用户列表
+    3: optional i64 next_cursor               // 下一页游标
+    4: required bool has_more                 // 是否还有更多
+}
+
+// 获取隐私设置请求
+struct GetPrivacySettingsRequest {
+}
+
+// 获取隐私设置响应
+struct GetPrivacySettingsResponse {
+    1: required model.BaseResp Base           // 基本响应信息
+    2: required model.PrivacySettings settings // 隐私设置
+}
+
+// 修改隐私设置请求，未传的字段保持不变
+struct UpdatePrivacySettingsRequest {
+    1: optional i8 dm_policy              // 谁可以私信我
+    2: optional i8 likes_visibility       // 谁可以看我的点赞
+    3: optional i8 collections_visibility // 谁可以看我的收藏
+    4: optional bool hide_online          // 是否隐藏在线状态
+}
+
+// 修改隐私设置响应
+struct UpdatePrivacySettingsResponse {
+    1: required model.BaseResp Base           // 基本响应信息
+    2: required model.PrivacySettings settings // 修改后的隐私设置
+}
+
 // 用户服务
 service UserService {
     // 用户注册
@@ -74,4 +168,22 @@ service UserService {
     UploadAvatarResponse UploadAvatar(1: UploadAvatarRequest req)
     // 刷新令牌
     RefreshTokenResponse RefreshToken(1: RefreshTokenRequest req)
+    // 关注用户
+    FollowResponse Follow(1: FollowRequest req)
+    // 取消关注
+    UnfollowResponse Unfollow(1: UnfollowRequest req)
+    // 获取关注列表
+    FollowListResponse GetFollowing(1: FollowListRequest req)
+    // 获取粉丝列表
+    FollowListResponse GetFollowers(1: FollowListRequest req)
+    // 拉黑用户
+    BlockResponse Block(1: BlockRequest req)
+    // 取消拉黑
+    UnblockResponse Unblock(1: UnblockRequest req)
+    // 获取黑名单
+    BlockListResponse GetBlockList(1: BlockListRequest req)
+    // 获取隐私设置
+    GetPrivacySettingsResponse GetPrivacySettings(1: GetPrivacySettingsRequest req)
+    // 修改隐私设置
+    UpdatePrivacySettingsResponse UpdatePrivacySettings(1: UpdatePrivacySettingsRequest req)
 }
\ No newline at end of file
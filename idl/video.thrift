@@ -129,6 +129,30 @@ struct SemanticSearchResponse {
     5: optional string summary          // 搜索结果摘要
 }
 
+// 关注流请求，按视频ID倒序
+struct FollowingFeedRequest {
+    1: optional i64 cursor               // 上一页返回的 next_cursor，首页不传
+    2: optional i32 limit                // 每页数量，默认20
+}
+
+// 关注流响应
+struct FollowingFeedResponse {
+    1: required model.BaseResp Base      // 基本响应信息
+    2: required list<model.Video> videos // 视频列表
+    3: optional i64 next_cursor          // 下一页游标
+    4: required bool has_more            // 是否还有更多
+}
+
+// 新关注时回填关注流请求
+struct BackfillFeedRequest {
+    1: required i64 creator_id           // 新关注的作者ID
+}
+
+// 新关注时回填关注流响应
+struct BackfillFeedResponse {
+    1: required model.BaseResp Base      // 基本响应信息
+}
+
 service VideoService {
     PublishResponse Publish(1: PublishRequest req)
     VideoListResponse List(1: VideoListRequest req)
@@ -139,4 +163,6 @@ service VideoService {
     IncrementLikeCountResponse IncrementLikeCount(1: IncrementLikeCountRequest req)
     SearchResponse Search(1: SearchRequest req)
     SemanticSearchResponse SemanticSearch(1: SemanticSearchRequest req)
+    FollowingFeedResponse GetFollowingFeed(1: FollowingFeedRequest req)
+    BackfillFeedResponse BackfillFeed(1: BackfillFeedRequest req)
 }
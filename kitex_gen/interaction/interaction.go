@@ -9,9 +9,9 @@ import (
 )
 
 type CommentListResponse struct {
-	Base     *model.BaseResp  `thrift:"Base,1,required" frugal:"1,required,model.BaseResp" json:"Base"`
-	Comments []*model.Comment `thrift:"comments,2,required" frugal:"2,required,list<model.Comment>" json:"comments"`
-	Total    int64            `thrift:"total,3,required" frugal:"3,required,i64" json:"total"`
+	Base     *model.BaseResp      `thrift:"Base,1,required" frugal:"1,required,model.BaseResp" json:"Base"`
+	Comments []*model.CommentInfo `thrift:"comments,2,required" frugal:"2,required,list<model.CommentInfo>" json:"comments"`
+	Total    int64                `thrift:"total,3,required" frugal:"3,required,i64" json:"total"`
 }
 
 func NewCommentListResponse() *CommentListResponse {
@@ -30,7 +30,7 @@ func (p *CommentListResponse) GetBase() (v *model.BaseResp) {
 	return p.Base
 }
 
-func (p *CommentListResponse) GetComments() (v []*model.Comment) {
+func (p *CommentListResponse) GetComments() (v []*model.CommentInfo) {
 	return p.Comments
 }
 
@@ -40,7 +40,7 @@ func (p *CommentListResponse) GetTotal() (v int64) {
 func (p *CommentListResponse) SetBase(val *model.BaseResp) {
 	p.Base = val
 }
-func (p *CommentListResponse) SetComments(val []*model.Comment) {
+func (p *CommentListResponse) SetComments(val []*model.CommentInfo) {
 	p.Comments = val
 }
 func (p *CommentListResponse) SetTotal(val int64) {
@@ -385,9 +385,9 @@ var fieldIDToName_GetCommentsRequest = map[int16]string{
 }
 
 type GetCommentsResponse struct {
-	Base        *model.BaseResp  `thrift:"Base,1,required" frugal:"1,required,model.BaseResp" json:"Base"`
-	CommentList []*model.Comment `thrift:"CommentList,2,required" frugal:"2,required,list<model.Comment>" json:"CommentList"`
-	Total       int64            `thrift:"Total,3,required" frugal:"3,required,i64" json:"Total"`
+	Base        *model.BaseResp      `thrift:"Base,1,required" frugal:"1,required,model.BaseResp" json:"Base"`
+	CommentList []*model.CommentInfo `thrift:"CommentList,2,required" frugal:"2,required,list<model.CommentInfo>" json:"CommentList"`
+	Total       int64                `thrift:"Total,3,required" frugal:"3,required,i64" json:"Total"`
 }
 
 func NewGetCommentsResponse() *GetCommentsResponse {
@@ -406,7 +406,7 @@ func (p *GetCommentsResponse) GetBase() (v *model.BaseResp) {
 	return p.Base
 }
 
-func (p *GetCommentsResponse) GetCommentList() (v []*model.Comment) {
+func (p *GetCommentsResponse) GetCommentList() (v []*model.CommentInfo) {
 	return p.CommentList
 }
 
@@ -416,7 +416,7 @@ func (p *GetCommentsResponse) GetTotal() (v int64) {
 func (p *GetCommentsResponse) SetBase(val *model.BaseResp) {
 	p.Base = val
 }
-func (p *GetCommentsResponse) SetCommentList(val []*model.Comment) {
+func (p *GetCommentsResponse) SetCommentList(val []*model.CommentInfo) {
 	p.CommentList = val
 }
 func (p *GetCommentsResponse) SetTotal(val int64) {
@@ -447,599 +447,3469 @@ type DeleteCommentRequest struct {
 func NewDeleteCommentRequest() *DeleteCommentRequest {
 	return &DeleteCommentRequest{}
 }
-
-func (p *DeleteCommentRequest) InitDefault() {
+
+func (p *DeleteCommentRequest) InitDefault() {
+}
+
+func (p *DeleteCommentRequest) GetCommentId() (v int64) {
+	return p.CommentId
+}
+func (p *DeleteCommentRequest) SetCommentId(val int64) {
+	p.CommentId = val
+}
+
+func (p *DeleteCommentRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("DeleteCommentRequest(%+v)", *p)
+}
+
+var fieldIDToName_DeleteCommentRequest = map[int16]string{
+	1: "comment_id",
+}
+
+type DeleteCommentResponse struct {
+	Base *model.BaseResp `thrift:"Base,1,required" frugal:"1,required,model.BaseResp" json:"Base"`
+}
+
+func NewDeleteCommentResponse() *DeleteCommentResponse {
+	return &DeleteCommentResponse{}
+}
+
+func (p *DeleteCommentResponse) InitDefault() {
+}
+
+var DeleteCommentResponse_Base_DEFAULT *model.BaseResp
+
+func (p *DeleteCommentResponse) GetBase() (v *model.BaseResp) {
+	if !p.IsSetBase() {
+		return DeleteCommentResponse_Base_DEFAULT
+	}
+	return p.Base
+}
+func (p *DeleteCommentResponse) SetBase(val *model.BaseResp) {
+	p.Base = val
+}
+
+func (p *DeleteCommentResponse) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *DeleteCommentResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("DeleteCommentResponse(%+v)", *p)
+}
+
+var fieldIDToName_DeleteCommentResponse = map[int16]string{
+	1: "Base",
+}
+
+type LikeCommentRequest struct {
+	CommentId int64 `thrift:"comment_id,1,required" frugal:"1,required,i64" json:"comment_id"`
+}
+
+func NewLikeCommentRequest() *LikeCommentRequest {
+	return &LikeCommentRequest{}
+}
+
+func (p *LikeCommentRequest) InitDefault() {
+}
+
+func (p *LikeCommentRequest) GetCommentId() (v int64) {
+	return p.CommentId
+}
+func (p *LikeCommentRequest) SetCommentId(val int64) {
+	p.CommentId = val
+}
+
+func (p *LikeCommentRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("LikeCommentRequest(%+v)", *p)
+}
+
+var fieldIDToName_LikeCommentRequest = map[int16]string{
+	1: "comment_id",
+}
+
+type LikeCommentResponse struct {
+	Base *model.BaseResp `thrift:"Base,1,required" frugal:"1,required,model.BaseResp" json:"Base"`
+}
+
+func NewLikeCommentResponse() *LikeCommentResponse {
+	return &LikeCommentResponse{}
+}
+
+func (p *LikeCommentResponse) InitDefault() {
+}
+
+var LikeCommentResponse_Base_DEFAULT *model.BaseResp
+
+func (p *LikeCommentResponse) GetBase() (v *model.BaseResp) {
+	if !p.IsSetBase() {
+		return LikeCommentResponse_Base_DEFAULT
+	}
+	return p.Base
+}
+func (p *LikeCommentResponse) SetBase(val *model.BaseResp) {
+	p.Base = val
+}
+
+func (p *LikeCommentResponse) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *LikeCommentResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("LikeCommentResponse(%+v)", *p)
+}
+
+var fieldIDToName_LikeCommentResponse = map[int16]string{
+	1: "Base",
+}
+
+type ReactRequest struct {
+	TargetType int8   `thrift:"target_type,1,required" frugal:"1,required,i8" json:"target_type"`
+	TargetId   int64  `thrift:"target_id,2,required" frugal:"2,required,i64" json:"target_id"`
+	Reaction   string `thrift:"reaction,3,required" frugal:"3,required,string" json:"reaction"`
+}
+
+func NewReactRequest() *ReactRequest {
+	return &ReactRequest{}
+}
+
+func (p *ReactRequest) InitDefault() {
+}
+
+func (p *ReactRequest) GetTargetType() (v int8) {
+	return p.TargetType
+}
+
+func (p *ReactRequest) GetTargetId() (v int64) {
+	return p.TargetId
+}
+
+func (p *ReactRequest) GetReaction() (v string) {
+	return p.Reaction
+}
+func (p *ReactRequest) SetTargetType(val int8) {
+	p.TargetType = val
+}
+func (p *ReactRequest) SetTargetId(val int64) {
+	p.TargetId = val
+}
+func (p *ReactRequest) SetReaction(val string) {
+	p.Reaction = val
+}
+
+func (p *ReactRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ReactRequest(%+v)", *p)
+}
+
+var fieldIDToName_ReactRequest = map[int16]string{
+	1: "target_type",
+	2: "target_id",
+	3: "reaction",
+}
+
+type ReactResponse struct {
+	Base       *model.BaseResp        `thrift:"Base,1,required" frugal:"1,required,model.BaseResp" json:"Base"`
+	Counts     []*model.ReactionCount `thrift:"Counts,2,required" frugal:"2,required,list<model.ReactionCount>" json:"Counts"`
+	MyReaction *string                `thrift:"MyReaction,3,optional" frugal:"3,optional,string" json:"MyReaction,omitempty"`
+}
+
+func NewReactResponse() *ReactResponse {
+	return &ReactResponse{}
+}
+
+func (p *ReactResponse) InitDefault() {
+}
+
+var ReactResponse_Base_DEFAULT *model.BaseResp
+
+func (p *ReactResponse) GetBase() (v *model.BaseResp) {
+	if !p.IsSetBase() {
+		return ReactResponse_Base_DEFAULT
+	}
+	return p.Base
+}
+
+func (p *ReactResponse) GetCounts() (v []*model.ReactionCount) {
+	return p.Counts
+}
+
+var ReactResponse_MyReaction_DEFAULT string
+
+func (p *ReactResponse) GetMyReaction() (v string) {
+	if !p.IsSetMyReaction() {
+		return ReactResponse_MyReaction_DEFAULT
+	}
+	return *p.MyReaction
+}
+func (p *ReactResponse) SetBase(val *model.BaseResp) {
+	p.Base = val
+}
+func (p *ReactResponse) SetCounts(val []*model.ReactionCount) {
+	p.Counts = val
+}
+func (p *ReactResponse) SetMyReaction(val *string) {
+	p.MyReaction = val
+}
+
+func (p *ReactResponse) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *ReactResponse) IsSetMyReaction() bool {
+	return p.MyReaction != nil
+}
+
+func (p *ReactResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ReactResponse(%+v)", *p)
+}
+
+var fieldIDToName_ReactResponse = map[int16]string{
+	1: "Base",
+	2: "Counts",
+	3: "MyReaction",
+}
+
+type GetReactionsRequest struct {
+	TargetType int8    `thrift:"target_type,1,required" frugal:"1,required,i8" json:"target_type"`
+	TargetId   int64   `thrift:"target_id,2,required" frugal:"2,required,i64" json:"target_id"`
+	Reaction   *string `thrift:"reaction,3,optional" frugal:"3,optional,string" json:"reaction,omitempty"`
+	Page       int32   `thrift:"page,4,required" frugal:"4,required,i32" json:"page"`
+	Size       int32   `thrift:"size,5,required" frugal:"5,required,i32" json:"size"`
+}
+
+func NewGetReactionsRequest() *GetReactionsRequest {
+	return &GetReactionsRequest{}
+}
+
+func (p *GetReactionsRequest) InitDefault() {
+}
+
+func (p *GetReactionsRequest) GetTargetType() (v int8) {
+	return p.TargetType
+}
+
+func (p *GetReactionsRequest) GetTargetId() (v int64) {
+	return p.TargetId
+}
+
+var GetReactionsRequest_Reaction_DEFAULT string
+
+func (p *GetReactionsRequest) GetReaction() (v string) {
+	if !p.IsSetReaction() {
+		return GetReactionsRequest_Reaction_DEFAULT
+	}
+	return *p.Reaction
+}
+
+func (p *GetReactionsRequest) GetPage() (v int32) {
+	return p.Page
+}
+
+func (p *GetReactionsRequest) GetSize() (v int32) {
+	return p.Size
+}
+func (p *GetReactionsRequest) SetTargetType(val int8) {
+	p.TargetType = val
+}
+func (p *GetReactionsRequest) SetTargetId(val int64) {
+	p.TargetId = val
+}
+func (p *GetReactionsRequest) SetReaction(val *string) {
+	p.Reaction = val
+}
+func (p *GetReactionsRequest) SetPage(val int32) {
+	p.Page = val
+}
+func (p *GetReactionsRequest) SetSize(val int32) {
+	p.Size = val
+}
+
+func (p *GetReactionsRequest) IsSetReaction() bool {
+	return p.Reaction != nil
+}
+
+func (p *GetReactionsRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GetReactionsRequest(%+v)", *p)
+}
+
+var fieldIDToName_GetReactionsRequest = map[int16]string{
+	1: "target_type",
+	2: "target_id",
+	3: "reaction",
+	4: "page",
+	5: "size",
+}
+
+type GetReactionsResponse struct {
+	Base         *model.BaseResp        `thrift:"Base,1,required" frugal:"1,required,model.BaseResp" json:"Base"`
+	ReactionList []*model.ReactionInfo  `thrift:"ReactionList,2,required" frugal:"2,required,list<model.ReactionInfo>" json:"ReactionList"`
+	Counts       []*model.ReactionCount `thrift:"Counts,3,required" frugal:"3,required,list<model.ReactionCount>" json:"Counts"`
+	MyReaction   *string                `thrift:"MyReaction,4,optional" frugal:"4,optional,string" json:"MyReaction,omitempty"`
+	Total        int64                  `thrift:"Total,5,required" frugal:"5,required,i64" json:"Total"`
+}
+
+func NewGetReactionsResponse() *GetReactionsResponse {
+	return &GetReactionsResponse{}
+}
+
+func (p *GetReactionsResponse) InitDefault() {
+}
+
+var GetReactionsResponse_Base_DEFAULT *model.BaseResp
+
+func (p *GetReactionsResponse) GetBase() (v *model.BaseResp) {
+	if !p.IsSetBase() {
+		return GetReactionsResponse_Base_DEFAULT
+	}
+	return p.Base
+}
+
+func (p *GetReactionsResponse) GetReactionList() (v []*model.ReactionInfo) {
+	return p.ReactionList
+}
+
+func (p *GetReactionsResponse) GetCounts() (v []*model.ReactionCount) {
+	return p.Counts
+}
+
+var GetReactionsResponse_MyReaction_DEFAULT string
+
+func (p *GetReactionsResponse) GetMyReaction() (v string) {
+	if !p.IsSetMyReaction() {
+		return GetReactionsResponse_MyReaction_DEFAULT
+	}
+	return *p.MyReaction
+}
+
+func (p *GetReactionsResponse) GetTotal() (v int64) {
+	return p.Total
+}
+func (p *GetReactionsResponse) SetBase(val *model.BaseResp) {
+	p.Base = val
+}
+func (p *GetReactionsResponse) SetReactionList(val []*model.ReactionInfo) {
+	p.ReactionList = val
+}
+func (p *GetReactionsResponse) SetCounts(val []*model.ReactionCount) {
+	p.Counts = val
+}
+func (p *GetReactionsResponse) SetMyReaction(val *string) {
+	p.MyReaction = val
+}
+func (p *GetReactionsResponse) SetTotal(val int64) {
+	p.Total = val
+}
+
+func (p *GetReactionsResponse) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *GetReactionsResponse) IsSetMyReaction() bool {
+	return p.MyReaction != nil
+}
+
+func (p *GetReactionsResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GetReactionsResponse(%+v)", *p)
+}
+
+var fieldIDToName_GetReactionsResponse = map[int16]string{
+	1: "Base",
+	2: "ReactionList",
+	3: "Counts",
+	4: "MyReaction",
+	5: "Total",
+}
+
+type GetInteractionStateRequest struct {
+	VideoIds []int64 `thrift:"video_ids,2,required" frugal:"2,required,list<i64>" json:"video_ids"`
+}
+
+func NewGetInteractionStateRequest() *GetInteractionStateRequest {
+	return &GetInteractionStateRequest{}
+}
+
+func (p *GetInteractionStateRequest) InitDefault() {
+}
+
+func (p *GetInteractionStateRequest) GetVideoIds() (v []int64) {
+	return p.VideoIds
+}
+func (p *GetInteractionStateRequest) SetVideoIds(val []int64) {
+	p.VideoIds = val
+}
+
+func (p *GetInteractionStateRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GetInteractionStateRequest(%+v)", *p)
+}
+
+var fieldIDToName_GetInteractionStateRequest = map[int16]string{
+	2: "video_ids",
+}
+
+type GetInteractionStateResponse struct {
+	Base   *model.BaseResp                `thrift:"Base,1,required" frugal:"1,required,model.BaseResp" json:"Base"`
+	States []*model.VideoInteractionState `thrift:"States,2,required" frugal:"2,required,list<model.VideoInteractionState>" json:"States"`
+}
+
+func NewGetInteractionStateResponse() *GetInteractionStateResponse {
+	return &GetInteractionStateResponse{}
+}
+
+func (p *GetInteractionStateResponse) InitDefault() {
+}
+
+var GetInteractionStateResponse_Base_DEFAULT *model.BaseResp
+
+func (p *GetInteractionStateResponse) GetBase() (v *model.BaseResp) {
+	if !p.IsSetBase() {
+		return GetInteractionStateResponse_Base_DEFAULT
+	}
+	return p.Base
+}
+
+func (p *GetInteractionStateResponse) GetStates() (v []*model.VideoInteractionState) {
+	return p.States
+}
+func (p *GetInteractionStateResponse) SetBase(val *model.BaseResp) {
+	p.Base = val
+}
+func (p *GetInteractionStateResponse) SetStates(val []*model.VideoInteractionState) {
+	p.States = val
+}
+
+func (p *GetInteractionStateResponse) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *GetInteractionStateResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GetInteractionStateResponse(%+v)", *p)
+}
+
+var fieldIDToName_GetInteractionStateResponse = map[int16]string{
+	1: "Base",
+	2: "States",
+}
+
+type GetFlaggedCommentsRequest struct {
+	Status *int8 `thrift:"status,1,optional" frugal:"1,optional,i8" json:"status,omitempty"`
+	Page   int32 `thrift:"page,2,required" frugal:"2,required,i32" json:"page"`
+	Size   int32 `thrift:"size,3,required" frugal:"3,required,i32" json:"size"`
+}
+
+func NewGetFlaggedCommentsRequest() *GetFlaggedCommentsRequest {
+	return &GetFlaggedCommentsRequest{}
+}
+
+func (p *GetFlaggedCommentsRequest) InitDefault() {
+}
+
+var GetFlaggedCommentsRequest_Status_DEFAULT int8
+
+func (p *GetFlaggedCommentsRequest) GetStatus() (v int8) {
+	if !p.IsSetStatus() {
+		return GetFlaggedCommentsRequest_Status_DEFAULT
+	}
+	return *p.Status
+}
+
+func (p *GetFlaggedCommentsRequest) GetPage() (v int32) {
+	return p.Page
+}
+
+func (p *GetFlaggedCommentsRequest) GetSize() (v int32) {
+	return p.Size
+}
+func (p *GetFlaggedCommentsRequest) SetStatus(val *int8) {
+	p.Status = val
+}
+func (p *GetFlaggedCommentsRequest) SetPage(val int32) {
+	p.Page = val
+}
+func (p *GetFlaggedCommentsRequest) SetSize(val int32) {
+	p.Size = val
+}
+
+func (p *GetFlaggedCommentsRequest) IsSetStatus() bool {
+	return p.Status != nil
+}
+
+func (p *GetFlaggedCommentsRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GetFlaggedCommentsRequest(%+v)", *p)
+}
+
+var fieldIDToName_GetFlaggedCommentsRequest = map[int16]string{
+	1: "status",
+	2: "page",
+	3: "size",
+}
+
+type GetFlaggedCommentsResponse struct {
+	Base        *model.BaseResp  `thrift:"Base,1,required" frugal:"1,required,model.BaseResp" json:"Base"`
+	CommentList []*model.Comment `thrift:"CommentList,2,required" frugal:"2,required,list<model.Comment>" json:"CommentList"`
+	Total       int64            `thrift:"Total,3,required" frugal:"3,required,i64" json:"Total"`
+}
+
+func NewGetFlaggedCommentsResponse() *GetFlaggedCommentsResponse {
+	return &GetFlaggedCommentsResponse{}
+}
+
+func (p *GetFlaggedCommentsResponse) InitDefault() {
+}
+
+var GetFlaggedCommentsResponse_Base_DEFAULT *model.BaseResp
+
+func (p *GetFlaggedCommentsResponse) GetBase() (v *model.BaseResp) {
+	if !p.IsSetBase() {
+		return GetFlaggedCommentsResponse_Base_DEFAULT
+	}
+	return p.Base
+}
+
+func (p *GetFlaggedCommentsResponse) GetCommentList() (v []*model.Comment) {
+	return p.CommentList
+}
+
+func (p *GetFlaggedCommentsResponse) GetTotal() (v int64) {
+	return p.Total
+}
+func (p *GetFlaggedCommentsResponse) SetBase(val *model.BaseResp) {
+	p.Base = val
+}
+func (p *GetFlaggedCommentsResponse) SetCommentList(val []*model.Comment) {
+	p.CommentList = val
+}
+func (p *GetFlaggedCommentsResponse) SetTotal(val int64) {
+	p.Total = val
+}
+
+func (p *GetFlaggedCommentsResponse) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *GetFlaggedCommentsResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GetFlaggedCommentsResponse(%+v)", *p)
+}
+
+var fieldIDToName_GetFlaggedCommentsResponse = map[int16]string{
+	1: "Base",
+	2: "CommentList",
+	3: "Total",
+}
+
+type ReviewCommentRequest struct {
+	CommentId int64   `thrift:"comment_id,1,required" frugal:"1,required,i64" json:"comment_id"`
+	Action    int8    `thrift:"action,2,required" frugal:"2,required,i8" json:"action"`
+	Reason    *string `thrift:"reason,3,optional" frugal:"3,optional,string" json:"reason,omitempty"`
+}
+
+func NewReviewCommentRequest() *ReviewCommentRequest {
+	return &ReviewCommentRequest{}
+}
+
+func (p *ReviewCommentRequest) InitDefault() {
+}
+
+func (p *ReviewCommentRequest) GetCommentId() (v int64) {
+	return p.CommentId
+}
+
+func (p *ReviewCommentRequest) GetAction() (v int8) {
+	return p.Action
+}
+
+var ReviewCommentRequest_Reason_DEFAULT string
+
+func (p *ReviewCommentRequest) GetReason() (v string) {
+	if !p.IsSetReason() {
+		return ReviewCommentRequest_Reason_DEFAULT
+	}
+	return *p.Reason
+}
+func (p *ReviewCommentRequest) SetCommentId(val int64) {
+	p.CommentId = val
+}
+func (p *ReviewCommentRequest) SetAction(val int8) {
+	p.Action = val
+}
+func (p *ReviewCommentRequest) SetReason(val *string) {
+	p.Reason = val
+}
+
+func (p *ReviewCommentRequest) IsSetReason() bool {
+	return p.Reason != nil
+}
+
+func (p *ReviewCommentRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ReviewCommentRequest(%+v)", *p)
+}
+
+var fieldIDToName_ReviewCommentRequest = map[int16]string{
+	1: "comment_id",
+	2: "action",
+	3: "reason",
+}
+
+type ReviewCommentResponse struct {
+	Base *model.BaseResp `thrift:"Base,1,required" frugal:"1,required,model.BaseResp" json:"Base"`
+}
+
+func NewReviewCommentResponse() *ReviewCommentResponse {
+	return &ReviewCommentResponse{}
+}
+
+func (p *ReviewCommentResponse) InitDefault() {
+}
+
+var ReviewCommentResponse_Base_DEFAULT *model.BaseResp
+
+func (p *ReviewCommentResponse) GetBase() (v *model.BaseResp) {
+	if !p.IsSetBase() {
+		return ReviewCommentResponse_Base_DEFAULT
+	}
+	return p.Base
+}
+func (p *ReviewCommentResponse) SetBase(val *model.BaseResp) {
+	p.Base = val
+}
+
+func (p *ReviewCommentResponse) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *ReviewCommentResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ReviewCommentResponse(%+v)", *p)
+}
+
+var fieldIDToName_ReviewCommentResponse = map[int16]string{
+	1: "Base",
+}
+
+type EditCommentRequest struct {
+	CommentId int64  `thrift:"comment_id,1,required" frugal:"1,required,i64" json:"comment_id"`
+	Content   string `thrift:"content,2,required" frugal:"2,required,string" json:"content"`
+}
+
+func NewEditCommentRequest() *EditCommentRequest {
+	return &EditCommentRequest{}
+}
+
+func (p *EditCommentRequest) InitDefault() {
+}
+
+func (p *EditCommentRequest) GetCommentId() (v int64) {
+	return p.CommentId
+}
+
+func (p *EditCommentRequest) GetContent() (v string) {
+	return p.Content
+}
+func (p *EditCommentRequest) SetCommentId(val int64) {
+	p.CommentId = val
+}
+func (p *EditCommentRequest) SetContent(val string) {
+	p.Content = val
+}
+
+func (p *EditCommentRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("EditCommentRequest(%+v)", *p)
+}
+
+var fieldIDToName_EditCommentRequest = map[int16]string{
+	1: "comment_id",
+	2: "content",
+}
+
+type EditCommentResponse struct {
+	Base *model.BaseResp `thrift:"Base,1,required" frugal:"1,required,model.BaseResp" json:"Base"`
+}
+
+func NewEditCommentResponse() *EditCommentResponse {
+	return &EditCommentResponse{}
+}
+
+func (p *EditCommentResponse) InitDefault() {
+}
+
+var EditCommentResponse_Base_DEFAULT *model.BaseResp
+
+func (p *EditCommentResponse) GetBase() (v *model.BaseResp) {
+	if !p.IsSetBase() {
+		return EditCommentResponse_Base_DEFAULT
+	}
+	return p.Base
+}
+func (p *EditCommentResponse) SetBase(val *model.BaseResp) {
+	p.Base = val
+}
+
+func (p *EditCommentResponse) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *EditCommentResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("EditCommentResponse(%+v)", *p)
+}
+
+var fieldIDToName_EditCommentResponse = map[int16]string{
+	1: "Base",
+}
+
+type GetCommentEditHistoryRequest struct {
+	CommentId int64 `thrift:"comment_id,1,required" frugal:"1,required,i64" json:"comment_id"`
+}
+
+func NewGetCommentEditHistoryRequest() *GetCommentEditHistoryRequest {
+	return &GetCommentEditHistoryRequest{}
+}
+
+func (p *GetCommentEditHistoryRequest) InitDefault() {
+}
+
+func (p *GetCommentEditHistoryRequest) GetCommentId() (v int64) {
+	return p.CommentId
+}
+func (p *GetCommentEditHistoryRequest) SetCommentId(val int64) {
+	p.CommentId = val
+}
+
+func (p *GetCommentEditHistoryRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GetCommentEditHistoryRequest(%+v)", *p)
+}
+
+var fieldIDToName_GetCommentEditHistoryRequest = map[int16]string{
+	1: "comment_id",
+}
+
+type GetCommentEditHistoryResponse struct {
+	Base     *model.BaseResp      `thrift:"Base,1,required" frugal:"1,required,model.BaseResp" json:"Base"`
+	EditList []*model.CommentEdit `thrift:"EditList,2,required" frugal:"2,required,list<model.CommentEdit>" json:"EditList"`
+}
+
+func NewGetCommentEditHistoryResponse() *GetCommentEditHistoryResponse {
+	return &GetCommentEditHistoryResponse{}
+}
+
+func (p *GetCommentEditHistoryResponse) InitDefault() {
+}
+
+var GetCommentEditHistoryResponse_Base_DEFAULT *model.BaseResp
+
+func (p *GetCommentEditHistoryResponse) GetBase() (v *model.BaseResp) {
+	if !p.IsSetBase() {
+		return GetCommentEditHistoryResponse_Base_DEFAULT
+	}
+	return p.Base
+}
+
+func (p *GetCommentEditHistoryResponse) GetEditList() (v []*model.CommentEdit) {
+	return p.EditList
+}
+func (p *GetCommentEditHistoryResponse) SetBase(val *model.BaseResp) {
+	p.Base = val
+}
+func (p *GetCommentEditHistoryResponse) SetEditList(val []*model.CommentEdit) {
+	p.EditList = val
+}
+
+func (p *GetCommentEditHistoryResponse) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *GetCommentEditHistoryResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GetCommentEditHistoryResponse(%+v)", *p)
+}
+
+var fieldIDToName_GetCommentEditHistoryResponse = map[int16]string{
+	1: "Base",
+	2: "EditList",
+}
+
+type PinCommentRequest struct {
+	CommentId int64 `thrift:"comment_id,1,required" frugal:"1,required,i64" json:"comment_id"`
+	Pin       bool  `thrift:"pin,2,required" frugal:"2,required,bool" json:"pin"`
+}
+
+func NewPinCommentRequest() *PinCommentRequest {
+	return &PinCommentRequest{}
+}
+
+func (p *PinCommentRequest) InitDefault() {
+}
+
+func (p *PinCommentRequest) GetCommentId() (v int64) {
+	return p.CommentId
+}
+
+func (p *PinCommentRequest) GetPin() (v bool) {
+	return p.Pin
+}
+func (p *PinCommentRequest) SetCommentId(val int64) {
+	p.CommentId = val
+}
+func (p *PinCommentRequest) SetPin(val bool) {
+	p.Pin = val
+}
+
+func (p *PinCommentRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("PinCommentRequest(%+v)", *p)
+}
+
+var fieldIDToName_PinCommentRequest = map[int16]string{
+	1: "comment_id",
+	2: "pin",
+}
+
+type PinCommentResponse struct {
+	Base *model.BaseResp `thrift:"Base,1,required" frugal:"1,required,model.BaseResp" json:"Base"`
+}
+
+func NewPinCommentResponse() *PinCommentResponse {
+	return &PinCommentResponse{}
+}
+
+func (p *PinCommentResponse) InitDefault() {
+}
+
+var PinCommentResponse_Base_DEFAULT *model.BaseResp
+
+func (p *PinCommentResponse) GetBase() (v *model.BaseResp) {
+	if !p.IsSetBase() {
+		return PinCommentResponse_Base_DEFAULT
+	}
+	return p.Base
+}
+func (p *PinCommentResponse) SetBase(val *model.BaseResp) {
+	p.Base = val
+}
+
+func (p *PinCommentResponse) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *PinCommentResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("PinCommentResponse(%+v)", *p)
+}
+
+var fieldIDToName_PinCommentResponse = map[int16]string{
+	1: "Base",
+}
+
+type HeartCommentRequest struct {
+	CommentId int64 `thrift:"comment_id,1,required" frugal:"1,required,i64" json:"comment_id"`
+	Heart     bool  `thrift:"heart,2,required" frugal:"2,required,bool" json:"heart"`
+}
+
+func NewHeartCommentRequest() *HeartCommentRequest {
+	return &HeartCommentRequest{}
+}
+
+func (p *HeartCommentRequest) InitDefault() {
+}
+
+func (p *HeartCommentRequest) GetCommentId() (v int64) {
+	return p.CommentId
+}
+
+func (p *HeartCommentRequest) GetHeart() (v bool) {
+	return p.Heart
+}
+func (p *HeartCommentRequest) SetCommentId(val int64) {
+	p.CommentId = val
+}
+func (p *HeartCommentRequest) SetHeart(val bool) {
+	p.Heart = val
+}
+
+func (p *HeartCommentRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("HeartCommentRequest(%+v)", *p)
+}
+
+var fieldIDToName_HeartCommentRequest = map[int16]string{
+	1: "comment_id",
+	2: "heart",
+}
+
+type HeartCommentResponse struct {
+	Base *model.BaseResp `thrift:"Base,1,required" frugal:"1,required,model.BaseResp" json:"Base"`
+}
+
+func NewHeartCommentResponse() *HeartCommentResponse {
+	return &HeartCommentResponse{}
+}
+
+func (p *HeartCommentResponse) InitDefault() {
+}
+
+var HeartCommentResponse_Base_DEFAULT *model.BaseResp
+
+func (p *HeartCommentResponse) GetBase() (v *model.BaseResp) {
+	if !p.IsSetBase() {
+		return HeartCommentResponse_Base_DEFAULT
+	}
+	return p.Base
+}
+func (p *HeartCommentResponse) SetBase(val *model.BaseResp) {
+	p.Base = val
+}
+
+func (p *HeartCommentResponse) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *HeartCommentResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("HeartCommentResponse(%+v)", *p)
+}
+
+var fieldIDToName_HeartCommentResponse = map[int16]string{
+	1: "Base",
+}
+
+type CreateCollectionRequest struct {
+	Name      string `thrift:"name,1,required" frugal:"1,required,string" json:"name"`
+	IsPrivate *bool  `thrift:"is_private,2,optional" frugal:"2,optional,bool" json:"is_private,omitempty"`
+}
+
+func NewCreateCollectionRequest() *CreateCollectionRequest {
+	return &CreateCollectionRequest{}
+}
+
+func (p *CreateCollectionRequest) InitDefault() {
+}
+
+func (p *CreateCollectionRequest) GetName() (v string) {
+	return p.Name
+}
+
+var CreateCollectionRequest_IsPrivate_DEFAULT bool
+
+func (p *CreateCollectionRequest) GetIsPrivate() (v bool) {
+	if !p.IsSetIsPrivate() {
+		return CreateCollectionRequest_IsPrivate_DEFAULT
+	}
+	return *p.IsPrivate
+}
+func (p *CreateCollectionRequest) SetName(val string) {
+	p.Name = val
+}
+func (p *CreateCollectionRequest) SetIsPrivate(val *bool) {
+	p.IsPrivate = val
+}
+
+func (p *CreateCollectionRequest) IsSetIsPrivate() bool {
+	return p.IsPrivate != nil
+}
+
+func (p *CreateCollectionRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CreateCollectionRequest(%+v)", *p)
+}
+
+var fieldIDToName_CreateCollectionRequest = map[int16]string{
+	1: "name",
+	2: "is_private",
+}
+
+type CreateCollectionResponse struct {
+	Base       *model.BaseResp   `thrift:"Base,1,required" frugal:"1,required,model.BaseResp" json:"Base"`
+	Collection *model.Collection `thrift:"Collection,2,required" frugal:"2,required,model.Collection" json:"Collection"`
+}
+
+func NewCreateCollectionResponse() *CreateCollectionResponse {
+	return &CreateCollectionResponse{}
+}
+
+func (p *CreateCollectionResponse) InitDefault() {
+}
+
+var CreateCollectionResponse_Base_DEFAULT *model.BaseResp
+
+func (p *CreateCollectionResponse) GetBase() (v *model.BaseResp) {
+	if !p.IsSetBase() {
+		return CreateCollectionResponse_Base_DEFAULT
+	}
+	return p.Base
+}
+
+var CreateCollectionResponse_Collection_DEFAULT *model.Collection
+
+func (p *CreateCollectionResponse) GetCollection() (v *model.Collection) {
+	if !p.IsSetCollection() {
+		return CreateCollectionResponse_Collection_DEFAULT
+	}
+	return p.Collection
+}
+func (p *CreateCollectionResponse) SetBase(val *model.BaseResp) {
+	p.Base = val
+}
+func (p *CreateCollectionResponse) SetCollection(val *model.Collection) {
+	p.Collection = val
+}
+
+func (p *CreateCollectionResponse) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *CreateCollectionResponse) IsSetCollection() bool {
+	return p.Collection != nil
+}
+
+func (p *CreateCollectionResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CreateCollectionResponse(%+v)", *p)
+}
+
+var fieldIDToName_CreateCollectionResponse = map[int16]string{
+	1: "Base",
+	2: "Collection",
+}
+
+type UpdateCollectionRequest struct {
+	CollectionId int64   `thrift:"collection_id,1,required" frugal:"1,required,i64" json:"collection_id"`
+	Name         *string `thrift:"name,2,optional" frugal:"2,optional,string" json:"name,omitempty"`
+	IsPrivate    *bool   `thrift:"is_private,3,optional" frugal:"3,optional,bool" json:"is_private,omitempty"`
+}
+
+func NewUpdateCollectionRequest() *UpdateCollectionRequest {
+	return &UpdateCollectionRequest{}
+}
+
+func (p *UpdateCollectionRequest) InitDefault() {
+}
+
+func (p *UpdateCollectionRequest) GetCollectionId() (v int64) {
+	return p.CollectionId
+}
+
+var UpdateCollectionRequest_Name_DEFAULT string
+
+func (p *UpdateCollectionRequest) GetName() (v string) {
+	if !p.IsSetName() {
+		return UpdateCollectionRequest_Name_DEFAULT
+	}
+	return *p.Name
+}
+
+var UpdateCollectionRequest_IsPrivate_DEFAULT bool
+
+func (p *UpdateCollectionRequest) GetIsPrivate() (v bool) {
+	if !p.IsSetIsPrivate() {
+		return UpdateCollectionRequest_IsPrivate_DEFAULT
+	}
+	return *p.IsPrivate
+}
+func (p *UpdateCollectionRequest) SetCollectionId(val int64) {
+	p.CollectionId = val
+}
+func (p *UpdateCollectionRequest) SetName(val *string) {
+	p.Name = val
+}
+func (p *UpdateCollectionRequest) SetIsPrivate(val *bool) {
+	p.IsPrivate = val
+}
+
+func (p *UpdateCollectionRequest) IsSetName() bool {
+	return p.Name != nil
+}
+
+func (p *UpdateCollectionRequest) IsSetIsPrivate() bool {
+	return p.IsPrivate != nil
+}
+
+func (p *UpdateCollectionRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UpdateCollectionRequest(%+v)", *p)
+}
+
+var fieldIDToName_UpdateCollectionRequest = map[int16]string{
+	1: "collection_id",
+	2: "name",
+	3: "is_private",
+}
+
+type UpdateCollectionResponse struct {
+	Base *model.BaseResp `thrift:"Base,1,required" frugal:"1,required,model.BaseResp" json:"Base"`
+}
+
+func NewUpdateCollectionResponse() *UpdateCollectionResponse {
+	return &UpdateCollectionResponse{}
+}
+
+func (p *UpdateCollectionResponse) InitDefault() {
+}
+
+var UpdateCollectionResponse_Base_DEFAULT *model.BaseResp
+
+func (p *UpdateCollectionResponse) GetBase() (v *model.BaseResp) {
+	if !p.IsSetBase() {
+		return UpdateCollectionResponse_Base_DEFAULT
+	}
+	return p.Base
+}
+func (p *UpdateCollectionResponse) SetBase(val *model.BaseResp) {
+	p.Base = val
+}
+
+func (p *UpdateCollectionResponse) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *UpdateCollectionResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UpdateCollectionResponse(%+v)", *p)
+}
+
+var fieldIDToName_UpdateCollectionResponse = map[int16]string{
+	1: "Base",
+}
+
+type DeleteCollectionRequest struct {
+	CollectionId int64 `thrift:"collection_id,1,required" frugal:"1,required,i64" json:"collection_id"`
+}
+
+func NewDeleteCollectionRequest() *DeleteCollectionRequest {
+	return &DeleteCollectionRequest{}
+}
+
+func (p *DeleteCollectionRequest) InitDefault() {
+}
+
+func (p *DeleteCollectionRequest) GetCollectionId() (v int64) {
+	return p.CollectionId
+}
+func (p *DeleteCollectionRequest) SetCollectionId(val int64) {
+	p.CollectionId = val
+}
+
+func (p *DeleteCollectionRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("DeleteCollectionRequest(%+v)", *p)
+}
+
+var fieldIDToName_DeleteCollectionRequest = map[int16]string{
+	1: "collection_id",
+}
+
+type DeleteCollectionResponse struct {
+	Base *model.BaseResp `thrift:"Base,1,required" frugal:"1,required,model.BaseResp" json:"Base"`
+}
+
+func NewDeleteCollectionResponse() *DeleteCollectionResponse {
+	return &DeleteCollectionResponse{}
+}
+
+func (p *DeleteCollectionResponse) InitDefault() {
+}
+
+var DeleteCollectionResponse_Base_DEFAULT *model.BaseResp
+
+func (p *DeleteCollectionResponse) GetBase() (v *model.BaseResp) {
+	if !p.IsSetBase() {
+		return DeleteCollectionResponse_Base_DEFAULT
+	}
+	return p.Base
+}
+func (p *DeleteCollectionResponse) SetBase(val *model.BaseResp) {
+	p.Base = val
+}
+
+func (p *DeleteCollectionResponse) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *DeleteCollectionResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("DeleteCollectionResponse(%+v)", *p)
+}
+
+var fieldIDToName_DeleteCollectionResponse = map[int16]string{
+	1: "Base",
+}
+
+type ReorderCollectionsRequest struct {
+	CollectionIds []int64 `thrift:"collection_ids,1,required" frugal:"1,required,list<i64>" json:"collection_ids"`
+}
+
+func NewReorderCollectionsRequest() *ReorderCollectionsRequest {
+	return &ReorderCollectionsRequest{}
+}
+
+func (p *ReorderCollectionsRequest) InitDefault() {
+}
+
+func (p *ReorderCollectionsRequest) GetCollectionIds() (v []int64) {
+	return p.CollectionIds
+}
+func (p *ReorderCollectionsRequest) SetCollectionIds(val []int64) {
+	p.CollectionIds = val
+}
+
+func (p *ReorderCollectionsRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ReorderCollectionsRequest(%+v)", *p)
+}
+
+var fieldIDToName_ReorderCollectionsRequest = map[int16]string{
+	1: "collection_ids",
+}
+
+type ReorderCollectionsResponse struct {
+	Base *model.BaseResp `thrift:"Base,1,required" frugal:"1,required,model.BaseResp" json:"Base"`
+}
+
+func NewReorderCollectionsResponse() *ReorderCollectionsResponse {
+	return &ReorderCollectionsResponse{}
+}
+
+func (p *ReorderCollectionsResponse) InitDefault() {
+}
+
+var ReorderCollectionsResponse_Base_DEFAULT *model.BaseResp
+
+func (p *ReorderCollectionsResponse) GetBase() (v *model.BaseResp) {
+	if !p.IsSetBase() {
+		return ReorderCollectionsResponse_Base_DEFAULT
+	}
+	return p.Base
+}
+func (p *ReorderCollectionsResponse) SetBase(val *model.BaseResp) {
+	p.Base = val
+}
+
+func (p *ReorderCollectionsResponse) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *ReorderCollectionsResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ReorderCollectionsResponse(%+v)", *p)
+}
+
+var fieldIDToName_ReorderCollectionsResponse = map[int16]string{
+	1: "Base",
+}
+
+type GetCollectionsRequest struct {
+	UserId int64 `thrift:"user_id,1,required" frugal:"1,required,i64" json:"user_id"`
+}
+
+func NewGetCollectionsRequest() *GetCollectionsRequest {
+	return &GetCollectionsRequest{}
+}
+
+func (p *GetCollectionsRequest) InitDefault() {
+}
+
+func (p *GetCollectionsRequest) GetUserId() (v int64) {
+	return p.UserId
+}
+func (p *GetCollectionsRequest) SetUserId(val int64) {
+	p.UserId = val
+}
+
+func (p *GetCollectionsRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GetCollectionsRequest(%+v)", *p)
+}
+
+var fieldIDToName_GetCollectionsRequest = map[int16]string{
+	1: "user_id",
+}
+
+type GetCollectionsResponse struct {
+	Base           *model.BaseResp     `thrift:"Base,1,required" frugal:"1,required,model.BaseResp" json:"Base"`
+	CollectionList []*model.Collection `thrift:"CollectionList,2,required" frugal:"2,required,list<model.Collection>" json:"CollectionList"`
+}
+
+func NewGetCollectionsResponse() *GetCollectionsResponse {
+	return &GetCollectionsResponse{}
+}
+
+func (p *GetCollectionsResponse) InitDefault() {
+}
+
+var GetCollectionsResponse_Base_DEFAULT *model.BaseResp
+
+func (p *GetCollectionsResponse) GetBase() (v *model.BaseResp) {
+	if !p.IsSetBase() {
+		return GetCollectionsResponse_Base_DEFAULT
+	}
+	return p.Base
+}
+
+func (p *GetCollectionsResponse) GetCollectionList() (v []*model.Collection) {
+	return p.CollectionList
+}
+func (p *GetCollectionsResponse) SetBase(val *model.BaseResp) {
+	p.Base = val
+}
+func (p *GetCollectionsResponse) SetCollectionList(val []*model.Collection) {
+	p.CollectionList = val
+}
+
+func (p *GetCollectionsResponse) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *GetCollectionsResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GetCollectionsResponse(%+v)", *p)
+}
+
+var fieldIDToName_GetCollectionsResponse = map[int16]string{
+	1: "Base",
+	2: "CollectionList",
+}
+
+type AddToCollectionRequest struct {
+	VideoId      int64  `thrift:"video_id,1,required" frugal:"1,required,i64" json:"video_id"`
+	CollectionId *int64 `thrift:"collection_id,2,optional" frugal:"2,optional,i64" json:"collection_id,omitempty"`
+}
+
+func NewAddToCollectionRequest() *AddToCollectionRequest {
+	return &AddToCollectionRequest{}
+}
+
+func (p *AddToCollectionRequest) InitDefault() {
+}
+
+func (p *AddToCollectionRequest) GetVideoId() (v int64) {
+	return p.VideoId
+}
+
+var AddToCollectionRequest_CollectionId_DEFAULT int64
+
+func (p *AddToCollectionRequest) GetCollectionId() (v int64) {
+	if !p.IsSetCollectionId() {
+		return AddToCollectionRequest_CollectionId_DEFAULT
+	}
+	return *p.CollectionId
+}
+func (p *AddToCollectionRequest) SetVideoId(val int64) {
+	p.VideoId = val
+}
+func (p *AddToCollectionRequest) SetCollectionId(val *int64) {
+	p.CollectionId = val
+}
+
+func (p *AddToCollectionRequest) IsSetCollectionId() bool {
+	return p.CollectionId != nil
+}
+
+func (p *AddToCollectionRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("AddToCollectionRequest(%+v)", *p)
+}
+
+var fieldIDToName_AddToCollectionRequest = map[int16]string{
+	1: "video_id",
+	2: "collection_id",
+}
+
+type AddToCollectionResponse struct {
+	Base         *model.BaseResp `thrift:"Base,1,required" frugal:"1,required,model.BaseResp" json:"Base"`
+	CollectionId int64           `thrift:"collection_id,2,required" frugal:"2,required,i64" json:"collection_id"`
+}
+
+func NewAddToCollectionResponse() *AddToCollectionResponse {
+	return &AddToCollectionResponse{}
+}
+
+func (p *AddToCollectionResponse) InitDefault() {
+}
+
+var AddToCollectionResponse_Base_DEFAULT *model.BaseResp
+
+func (p *AddToCollectionResponse) GetBase() (v *model.BaseResp) {
+	if !p.IsSetBase() {
+		return AddToCollectionResponse_Base_DEFAULT
+	}
+	return p.Base
+}
+
+func (p *AddToCollectionResponse) GetCollectionId() (v int64) {
+	return p.CollectionId
+}
+func (p *AddToCollectionResponse) SetBase(val *model.BaseResp) {
+	p.Base = val
+}
+func (p *AddToCollectionResponse) SetCollectionId(val int64) {
+	p.CollectionId = val
+}
+
+func (p *AddToCollectionResponse) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *AddToCollectionResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("AddToCollectionResponse(%+v)", *p)
+}
+
+var fieldIDToName_AddToCollectionResponse = map[int16]string{
+	1: "Base",
+	2: "collection_id",
+}
+
+type RemoveFromCollectionRequest struct {
+	VideoId      int64 `thrift:"video_id,1,required" frugal:"1,required,i64" json:"video_id"`
+	CollectionId int64 `thrift:"collection_id,2,required" frugal:"2,required,i64" json:"collection_id"`
+}
+
+func NewRemoveFromCollectionRequest() *RemoveFromCollectionRequest {
+	return &RemoveFromCollectionRequest{}
+}
+
+func (p *RemoveFromCollectionRequest) InitDefault() {
+}
+
+func (p *RemoveFromCollectionRequest) GetVideoId() (v int64) {
+	return p.VideoId
+}
+
+func (p *RemoveFromCollectionRequest) GetCollectionId() (v int64) {
+	return p.CollectionId
+}
+func (p *RemoveFromCollectionRequest) SetVideoId(val int64) {
+	p.VideoId = val
+}
+func (p *RemoveFromCollectionRequest) SetCollectionId(val int64) {
+	p.CollectionId = val
+}
+
+func (p *RemoveFromCollectionRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("RemoveFromCollectionRequest(%+v)", *p)
+}
+
+var fieldIDToName_RemoveFromCollectionRequest = map[int16]string{
+	1: "video_id",
+	2: "collection_id",
+}
+
+type RemoveFromCollectionResponse struct {
+	Base *model.BaseResp `thrift:"Base,1,required" frugal:"1,required,model.BaseResp" json:"Base"`
+}
+
+func NewRemoveFromCollectionResponse() *RemoveFromCollectionResponse {
+	return &RemoveFromCollectionResponse{}
+}
+
+func (p *RemoveFromCollectionResponse) InitDefault() {
+}
+
+var RemoveFromCollectionResponse_Base_DEFAULT *model.BaseResp
+
+func (p *RemoveFromCollectionResponse) GetBase() (v *model.BaseResp) {
+	if !p.IsSetBase() {
+		return RemoveFromCollectionResponse_Base_DEFAULT
+	}
+	return p.Base
+}
+func (p *RemoveFromCollectionResponse) SetBase(val *model.BaseResp) {
+	p.Base = val
+}
+
+func (p *RemoveFromCollectionResponse) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *RemoveFromCollectionResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("RemoveFromCollectionResponse(%+v)", *p)
+}
+
+var fieldIDToName_RemoveFromCollectionResponse = map[int16]string{
+	1: "Base",
+}
+
+type GetCollectionVideosRequest struct {
+	CollectionId int64 `thrift:"collection_id,1,required" frugal:"1,required,i64" json:"collection_id"`
+	Page         int32 `thrift:"page,2,required" frugal:"2,required,i32" json:"page"`
+	Size         int32 `thrift:"size,3,required" frugal:"3,required,i32" json:"size"`
+}
+
+func NewGetCollectionVideosRequest() *GetCollectionVideosRequest {
+	return &GetCollectionVideosRequest{}
+}
+
+func (p *GetCollectionVideosRequest) InitDefault() {
+}
+
+func (p *GetCollectionVideosRequest) GetCollectionId() (v int64) {
+	return p.CollectionId
+}
+
+func (p *GetCollectionVideosRequest) GetPage() (v int32) {
+	return p.Page
+}
+
+func (p *GetCollectionVideosRequest) GetSize() (v int32) {
+	return p.Size
+}
+func (p *GetCollectionVideosRequest) SetCollectionId(val int64) {
+	p.CollectionId = val
+}
+func (p *GetCollectionVideosRequest) SetPage(val int32) {
+	p.Page = val
+}
+func (p *GetCollectionVideosRequest) SetSize(val int32) {
+	p.Size = val
+}
+
+func (p *GetCollectionVideosRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GetCollectionVideosRequest(%+v)", *p)
+}
+
+var fieldIDToName_GetCollectionVideosRequest = map[int16]string{
+	1: "collection_id",
+	2: "page",
+	3: "size",
+}
+
+type GetCollectionVideosResponse struct {
+	Base     *model.BaseResp `thrift:"Base,1,required" frugal:"1,required,model.BaseResp" json:"Base"`
+	VideoIDs []int64         `thrift:"VideoIDs,2,required" frugal:"2,required,list<i64>" json:"VideoIDs"`
+	Total    int64           `thrift:"Total,3,required" frugal:"3,required,i64" json:"Total"`
+}
+
+func NewGetCollectionVideosResponse() *GetCollectionVideosResponse {
+	return &GetCollectionVideosResponse{}
+}
+
+func (p *GetCollectionVideosResponse) InitDefault() {
+}
+
+var GetCollectionVideosResponse_Base_DEFAULT *model.BaseResp
+
+func (p *GetCollectionVideosResponse) GetBase() (v *model.BaseResp) {
+	if !p.IsSetBase() {
+		return GetCollectionVideosResponse_Base_DEFAULT
+	}
+	return p.Base
+}
+
+func (p *GetCollectionVideosResponse) GetVideoIDs() (v []int64) {
+	return p.VideoIDs
+}
+
+func (p *GetCollectionVideosResponse) GetTotal() (v int64) {
+	return p.Total
+}
+func (p *GetCollectionVideosResponse) SetBase(val *model.BaseResp) {
+	p.Base = val
+}
+func (p *GetCollectionVideosResponse) SetVideoIDs(val []int64) {
+	p.VideoIDs = val
+}
+func (p *GetCollectionVideosResponse) SetTotal(val int64) {
+	p.Total = val
+}
+
+func (p *GetCollectionVideosResponse) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *GetCollectionVideosResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GetCollectionVideosResponse(%+v)", *p)
+}
+
+var fieldIDToName_GetCollectionVideosResponse = map[int16]string{
+	1: "Base",
+	2: "VideoIDs",
+	3: "Total",
+}
+
+type InteractionService interface {
+	Like(ctx context.Context, req *LikeRequest) (r *LikeResponse, err error)
+
+	GetLikes(ctx context.Context, req *GetLikesRequest) (r *GetLikesResponse, err error)
+
+	Comment(ctx context.Context, req *CommentRequest) (r *CommentResponse, err error)
+
+	GetComments(ctx context.Context, req *GetCommentsRequest) (r *GetCommentsResponse, err error)
+
+	DeleteComment(ctx context.Context, req *DeleteCommentRequest) (r *DeleteCommentResponse, err error)
+
+	LikeComment(ctx context.Context, req *LikeCommentRequest) (r *LikeCommentResponse, err error)
+
+	EditComment(ctx context.Context, req *EditCommentRequest) (r *EditCommentResponse, err error)
+
+	GetCommentEditHistory(ctx context.Context, req *GetCommentEditHistoryRequest) (r *GetCommentEditHistoryResponse, err error)
+
+	PinComment(ctx context.Context, req *PinCommentRequest) (r *PinCommentResponse, err error)
+
+	HeartComment(ctx context.Context, req *HeartCommentRequest) (r *HeartCommentResponse, err error)
+
+	React(ctx context.Context, req *ReactRequest) (r *ReactResponse, err error)
+
+	GetReactions(ctx context.Context, req *GetReactionsRequest) (r *GetReactionsResponse, err error)
+
+	GetInteractionState(ctx context.Context, req *GetInteractionStateRequest) (r *GetInteractionStateResponse, err error)
+
+	GetFlaggedComments(ctx context.Context, req *GetFlaggedCommentsRequest) (r *GetFlaggedCommentsResponse, err error)
+
+	ReviewComment(ctx context.Context, req *ReviewCommentRequest) (r *ReviewCommentResponse, err error)
+
+	CreateCollection(ctx context.Context, req *CreateCollectionRequest) (r *CreateCollectionResponse, err error)
+
+	UpdateCollection(ctx context.Context, req *UpdateCollectionRequest) (r *UpdateCollectionResponse, err error)
+
+	DeleteCollection(ctx context.Context, req *DeleteCollectionRequest) (r *DeleteCollectionResponse, err error)
+
+	ReorderCollections(ctx context.Context, req *ReorderCollectionsRequest) (r *ReorderCollectionsResponse, err error)
+
+	GetCollections(ctx context.Context, req *GetCollectionsRequest) (r *GetCollectionsResponse, err error)
+
+	AddToCollection(ctx context.Context, req *AddToCollectionRequest) (r *AddToCollectionResponse, err error)
+
+	RemoveFromCollection(ctx context.Context, req *RemoveFromCollectionRequest) (r *RemoveFromCollectionResponse, err error)
+
+	GetCollectionVideos(ctx context.Context, req *GetCollectionVideosRequest) (r *GetCollectionVideosResponse, err error)
+}
+
+type InteractionServiceLikeArgs struct {
+	Req *LikeRequest `thrift:"req,1" frugal:"1,default,LikeRequest" json:"req"`
+}
+
+func NewInteractionServiceLikeArgs() *InteractionServiceLikeArgs {
+	return &InteractionServiceLikeArgs{}
+}
+
+func (p *InteractionServiceLikeArgs) InitDefault() {
+}
+
+var InteractionServiceLikeArgs_Req_DEFAULT *LikeRequest
+
+func (p *InteractionServiceLikeArgs) GetReq() (v *LikeRequest) {
+	if !p.IsSetReq() {
+		return InteractionServiceLikeArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *InteractionServiceLikeArgs) SetReq(val *LikeRequest) {
+	p.Req = val
+}
+
+func (p *InteractionServiceLikeArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *InteractionServiceLikeArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("InteractionServiceLikeArgs(%+v)", *p)
+}
+
+var fieldIDToName_InteractionServiceLikeArgs = map[int16]string{
+	1: "req",
+}
+
+type InteractionServiceLikeResult struct {
+	Success *LikeResponse `thrift:"success,0,optional" frugal:"0,optional,LikeResponse" json:"success,omitempty"`
+}
+
+func NewInteractionServiceLikeResult() *InteractionServiceLikeResult {
+	return &InteractionServiceLikeResult{}
+}
+
+func (p *InteractionServiceLikeResult) InitDefault() {
+}
+
+var InteractionServiceLikeResult_Success_DEFAULT *LikeResponse
+
+func (p *InteractionServiceLikeResult) GetSuccess() (v *LikeResponse) {
+	if !p.IsSetSuccess() {
+		return InteractionServiceLikeResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *InteractionServiceLikeResult) SetSuccess(x interface{}) {
+	p.Success = x.(*LikeResponse)
+}
+
+func (p *InteractionServiceLikeResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *InteractionServiceLikeResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("InteractionServiceLikeResult(%+v)", *p)
+}
+
+var fieldIDToName_InteractionServiceLikeResult = map[int16]string{
+	0: "success",
+}
+
+type InteractionServiceGetLikesArgs struct {
+	Req *GetLikesRequest `thrift:"req,1" frugal:"1,default,GetLikesRequest" json:"req"`
+}
+
+func NewInteractionServiceGetLikesArgs() *InteractionServiceGetLikesArgs {
+	return &InteractionServiceGetLikesArgs{}
+}
+
+func (p *InteractionServiceGetLikesArgs) InitDefault() {
+}
+
+var InteractionServiceGetLikesArgs_Req_DEFAULT *GetLikesRequest
+
+func (p *InteractionServiceGetLikesArgs) GetReq() (v *GetLikesRequest) {
+	if !p.IsSetReq() {
+		return InteractionServiceGetLikesArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *InteractionServiceGetLikesArgs) SetReq(val *GetLikesRequest) {
+	p.Req = val
+}
+
+func (p *InteractionServiceGetLikesArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *InteractionServiceGetLikesArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("InteractionServiceGetLikesArgs(%+v)", *p)
+}
+
+var fieldIDToName_InteractionServiceGetLikesArgs = map[int16]string{
+	1: "req",
+}
+
+type InteractionServiceGetLikesResult struct {
+	Success *GetLikesResponse `thrift:"success,0,optional" frugal:"0,optional,GetLikesResponse" json:"success,omitempty"`
+}
+
+func NewInteractionServiceGetLikesResult() *InteractionServiceGetLikesResult {
+	return &InteractionServiceGetLikesResult{}
+}
+
+func (p *InteractionServiceGetLikesResult) InitDefault() {
+}
+
+var InteractionServiceGetLikesResult_Success_DEFAULT *GetLikesResponse
+
+func (p *InteractionServiceGetLikesResult) GetSuccess() (v *GetLikesResponse) {
+	if !p.IsSetSuccess() {
+		return InteractionServiceGetLikesResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *InteractionServiceGetLikesResult) SetSuccess(x interface{}) {
+	p.Success = x.(*GetLikesResponse)
+}
+
+func (p *InteractionServiceGetLikesResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *InteractionServiceGetLikesResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("InteractionServiceGetLikesResult(%+v)", *p)
+}
+
+var fieldIDToName_InteractionServiceGetLikesResult = map[int16]string{
+	0: "success",
+}
+
+type InteractionServiceCommentArgs struct {
+	Req *CommentRequest `thrift:"req,1" frugal:"1,default,CommentRequest" json:"req"`
+}
+
+func NewInteractionServiceCommentArgs() *InteractionServiceCommentArgs {
+	return &InteractionServiceCommentArgs{}
+}
+
+func (p *InteractionServiceCommentArgs) InitDefault() {
+}
+
+var InteractionServiceCommentArgs_Req_DEFAULT *CommentRequest
+
+func (p *InteractionServiceCommentArgs) GetReq() (v *CommentRequest) {
+	if !p.IsSetReq() {
+		return InteractionServiceCommentArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *InteractionServiceCommentArgs) SetReq(val *CommentRequest) {
+	p.Req = val
+}
+
+func (p *InteractionServiceCommentArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *InteractionServiceCommentArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("InteractionServiceCommentArgs(%+v)", *p)
+}
+
+var fieldIDToName_InteractionServiceCommentArgs = map[int16]string{
+	1: "req",
+}
+
+type InteractionServiceCommentResult struct {
+	Success *CommentResponse `thrift:"success,0,optional" frugal:"0,optional,CommentResponse" json:"success,omitempty"`
+}
+
+func NewInteractionServiceCommentResult() *InteractionServiceCommentResult {
+	return &InteractionServiceCommentResult{}
+}
+
+func (p *InteractionServiceCommentResult) InitDefault() {
+}
+
+var InteractionServiceCommentResult_Success_DEFAULT *CommentResponse
+
+func (p *InteractionServiceCommentResult) GetSuccess() (v *CommentResponse) {
+	if !p.IsSetSuccess() {
+		return InteractionServiceCommentResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *InteractionServiceCommentResult) SetSuccess(x interface{}) {
+	p.Success = x.(*CommentResponse)
+}
+
+func (p *InteractionServiceCommentResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *InteractionServiceCommentResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("InteractionServiceCommentResult(%+v)", *p)
+}
+
+var fieldIDToName_InteractionServiceCommentResult = map[int16]string{
+	0: "success",
+}
+
+type InteractionServiceGetCommentsArgs struct {
+	Req *GetCommentsRequest `thrift:"req,1" frugal:"1,default,GetCommentsRequest" json:"req"`
+}
+
+func NewInteractionServiceGetCommentsArgs() *InteractionServiceGetCommentsArgs {
+	return &InteractionServiceGetCommentsArgs{}
+}
+
+func (p *InteractionServiceGetCommentsArgs) InitDefault() {
+}
+
+var InteractionServiceGetCommentsArgs_Req_DEFAULT *GetCommentsRequest
+
+func (p *InteractionServiceGetCommentsArgs) GetReq() (v *GetCommentsRequest) {
+	if !p.IsSetReq() {
+		return InteractionServiceGetCommentsArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *InteractionServiceGetCommentsArgs) SetReq(val *GetCommentsRequest) {
+	p.Req = val
+}
+
+func (p *InteractionServiceGetCommentsArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *InteractionServiceGetCommentsArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("InteractionServiceGetCommentsArgs(%+v)", *p)
+}
+
+var fieldIDToName_InteractionServiceGetCommentsArgs = map[int16]string{
+	1: "req",
+}
+
+type InteractionServiceGetCommentsResult struct {
+	Success *GetCommentsResponse `thrift:"success,0,optional" frugal:"0,optional,GetCommentsResponse" json:"success,omitempty"`
+}
+
+func NewInteractionServiceGetCommentsResult() *InteractionServiceGetCommentsResult {
+	return &InteractionServiceGetCommentsResult{}
+}
+
+func (p *InteractionServiceGetCommentsResult) InitDefault() {
+}
+
+var InteractionServiceGetCommentsResult_Success_DEFAULT *GetCommentsResponse
+
+func (p *InteractionServiceGetCommentsResult) GetSuccess() (v *GetCommentsResponse) {
+	if !p.IsSetSuccess() {
+		return InteractionServiceGetCommentsResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *InteractionServiceGetCommentsResult) SetSuccess(x interface{}) {
+	p.Success = x.(*GetCommentsResponse)
+}
+
+func (p *InteractionServiceGetCommentsResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *InteractionServiceGetCommentsResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("InteractionServiceGetCommentsResult(%+v)", *p)
+}
+
+var fieldIDToName_InteractionServiceGetCommentsResult = map[int16]string{
+	0: "success",
+}
+
+type InteractionServiceDeleteCommentArgs struct {
+	Req *DeleteCommentRequest `thrift:"req,1" frugal:"1,default,DeleteCommentRequest" json:"req"`
+}
+
+func NewInteractionServiceDeleteCommentArgs() *InteractionServiceDeleteCommentArgs {
+	return &InteractionServiceDeleteCommentArgs{}
+}
+
+func (p *InteractionServiceDeleteCommentArgs) InitDefault() {
+}
+
+var InteractionServiceDeleteCommentArgs_Req_DEFAULT *DeleteCommentRequest
+
+func (p *InteractionServiceDeleteCommentArgs) GetReq() (v *DeleteCommentRequest) {
+	if !p.IsSetReq() {
+		return InteractionServiceDeleteCommentArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *InteractionServiceDeleteCommentArgs) SetReq(val *DeleteCommentRequest) {
+	p.Req = val
+}
+
+func (p *InteractionServiceDeleteCommentArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *InteractionServiceDeleteCommentArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("InteractionServiceDeleteCommentArgs(%+v)", *p)
+}
+
+var fieldIDToName_InteractionServiceDeleteCommentArgs = map[int16]string{
+	1: "req",
+}
+
+type InteractionServiceDeleteCommentResult struct {
+	Success *DeleteCommentResponse `thrift:"success,0,optional" frugal:"0,optional,DeleteCommentResponse" json:"success,omitempty"`
+}
+
+func NewInteractionServiceDeleteCommentResult() *InteractionServiceDeleteCommentResult {
+	return &InteractionServiceDeleteCommentResult{}
+}
+
+func (p *InteractionServiceDeleteCommentResult) InitDefault() {
+}
+
+var InteractionServiceDeleteCommentResult_Success_DEFAULT *DeleteCommentResponse
+
+func (p *InteractionServiceDeleteCommentResult) GetSuccess() (v *DeleteCommentResponse) {
+	if !p.IsSetSuccess() {
+		return InteractionServiceDeleteCommentResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *InteractionServiceDeleteCommentResult) SetSuccess(x interface{}) {
+	p.Success = x.(*DeleteCommentResponse)
+}
+
+func (p *InteractionServiceDeleteCommentResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *InteractionServiceDeleteCommentResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("InteractionServiceDeleteCommentResult(%+v)", *p)
+}
+
+var fieldIDToName_InteractionServiceDeleteCommentResult = map[int16]string{
+	0: "success",
+}
+
+type InteractionServiceLikeCommentArgs struct {
+	Req *LikeCommentRequest `thrift:"req,1" frugal:"1,default,LikeCommentRequest" json:"req"`
+}
+
+func NewInteractionServiceLikeCommentArgs() *InteractionServiceLikeCommentArgs {
+	return &InteractionServiceLikeCommentArgs{}
+}
+
+func (p *InteractionServiceLikeCommentArgs) InitDefault() {
+}
+
+var InteractionServiceLikeCommentArgs_Req_DEFAULT *LikeCommentRequest
+
+func (p *InteractionServiceLikeCommentArgs) GetReq() (v *LikeCommentRequest) {
+	if !p.IsSetReq() {
+		return InteractionServiceLikeCommentArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *InteractionServiceLikeCommentArgs) SetReq(val *LikeCommentRequest) {
+	p.Req = val
+}
+
+func (p *InteractionServiceLikeCommentArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *InteractionServiceLikeCommentArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("InteractionServiceLikeCommentArgs(%+v)", *p)
+}
+
+var fieldIDToName_InteractionServiceLikeCommentArgs = map[int16]string{
+	1: "req",
+}
+
+type InteractionServiceLikeCommentResult struct {
+	Success *LikeCommentResponse `thrift:"success,0,optional" frugal:"0,optional,LikeCommentResponse" json:"success,omitempty"`
+}
+
+func NewInteractionServiceLikeCommentResult() *InteractionServiceLikeCommentResult {
+	return &InteractionServiceLikeCommentResult{}
+}
+
+func (p *InteractionServiceLikeCommentResult) InitDefault() {
+}
+
+var InteractionServiceLikeCommentResult_Success_DEFAULT *LikeCommentResponse
+
+func (p *InteractionServiceLikeCommentResult) GetSuccess() (v *LikeCommentResponse) {
+	if !p.IsSetSuccess() {
+		return InteractionServiceLikeCommentResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *InteractionServiceLikeCommentResult) SetSuccess(x interface{}) {
+	p.Success = x.(*LikeCommentResponse)
+}
+
+func (p *InteractionServiceLikeCommentResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *InteractionServiceLikeCommentResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("InteractionServiceLikeCommentResult(%+v)", *p)
+}
+
+var fieldIDToName_InteractionServiceLikeCommentResult = map[int16]string{
+	0: "success",
+}
+
+type InteractionServiceEditCommentArgs struct {
+	Req *EditCommentRequest `thrift:"req,1" frugal:"1,default,EditCommentRequest" json:"req"`
+}
+
+func NewInteractionServiceEditCommentArgs() *InteractionServiceEditCommentArgs {
+	return &InteractionServiceEditCommentArgs{}
+}
+
+func (p *InteractionServiceEditCommentArgs) InitDefault() {
+}
+
+var InteractionServiceEditCommentArgs_Req_DEFAULT *EditCommentRequest
+
+func (p *InteractionServiceEditCommentArgs) GetReq() (v *EditCommentRequest) {
+	if !p.IsSetReq() {
+		return InteractionServiceEditCommentArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *InteractionServiceEditCommentArgs) SetReq(val *EditCommentRequest) {
+	p.Req = val
+}
+
+func (p *InteractionServiceEditCommentArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *InteractionServiceEditCommentArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("InteractionServiceEditCommentArgs(%+v)", *p)
+}
+
+var fieldIDToName_InteractionServiceEditCommentArgs = map[int16]string{
+	1: "req",
+}
+
+type InteractionServiceEditCommentResult struct {
+	Success *EditCommentResponse `thrift:"success,0,optional" frugal:"0,optional,EditCommentResponse" json:"success,omitempty"`
+}
+
+func NewInteractionServiceEditCommentResult() *InteractionServiceEditCommentResult {
+	return &InteractionServiceEditCommentResult{}
+}
+
+func (p *InteractionServiceEditCommentResult) InitDefault() {
+}
+
+var InteractionServiceEditCommentResult_Success_DEFAULT *EditCommentResponse
+
+func (p *InteractionServiceEditCommentResult) GetSuccess() (v *EditCommentResponse) {
+	if !p.IsSetSuccess() {
+		return InteractionServiceEditCommentResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *InteractionServiceEditCommentResult) SetSuccess(x interface{}) {
+	p.Success = x.(*EditCommentResponse)
+}
+
+func (p *InteractionServiceEditCommentResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *InteractionServiceEditCommentResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("InteractionServiceEditCommentResult(%+v)", *p)
+}
+
+var fieldIDToName_InteractionServiceEditCommentResult = map[int16]string{
+	0: "success",
+}
+
+type InteractionServiceGetCommentEditHistoryArgs struct {
+	Req *GetCommentEditHistoryRequest `thrift:"req,1" frugal:"1,default,GetCommentEditHistoryRequest" json:"req"`
+}
+
+func NewInteractionServiceGetCommentEditHistoryArgs() *InteractionServiceGetCommentEditHistoryArgs {
+	return &InteractionServiceGetCommentEditHistoryArgs{}
+}
+
+func (p *InteractionServiceGetCommentEditHistoryArgs) InitDefault() {
+}
+
+var InteractionServiceGetCommentEditHistoryArgs_Req_DEFAULT *GetCommentEditHistoryRequest
+
+func (p *InteractionServiceGetCommentEditHistoryArgs) GetReq() (v *GetCommentEditHistoryRequest) {
+	if !p.IsSetReq() {
+		return InteractionServiceGetCommentEditHistoryArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *InteractionServiceGetCommentEditHistoryArgs) SetReq(val *GetCommentEditHistoryRequest) {
+	p.Req = val
+}
+
+func (p *InteractionServiceGetCommentEditHistoryArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *InteractionServiceGetCommentEditHistoryArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("InteractionServiceGetCommentEditHistoryArgs(%+v)", *p)
+}
+
+var fieldIDToName_InteractionServiceGetCommentEditHistoryArgs = map[int16]string{
+	1: "req",
+}
+
+type InteractionServiceGetCommentEditHistoryResult struct {
+	Success *GetCommentEditHistoryResponse `thrift:"success,0,optional" frugal:"0,optional,GetCommentEditHistoryResponse" json:"success,omitempty"`
+}
+
+func NewInteractionServiceGetCommentEditHistoryResult() *InteractionServiceGetCommentEditHistoryResult {
+	return &InteractionServiceGetCommentEditHistoryResult{}
+}
+
+func (p *InteractionServiceGetCommentEditHistoryResult) InitDefault() {
+}
+
+var InteractionServiceGetCommentEditHistoryResult_Success_DEFAULT *GetCommentEditHistoryResponse
+
+func (p *InteractionServiceGetCommentEditHistoryResult) GetSuccess() (v *GetCommentEditHistoryResponse) {
+	if !p.IsSetSuccess() {
+		return InteractionServiceGetCommentEditHistoryResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *InteractionServiceGetCommentEditHistoryResult) SetSuccess(x interface{}) {
+	p.Success = x.(*GetCommentEditHistoryResponse)
+}
+
+func (p *InteractionServiceGetCommentEditHistoryResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *InteractionServiceGetCommentEditHistoryResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("InteractionServiceGetCommentEditHistoryResult(%+v)", *p)
+}
+
+var fieldIDToName_InteractionServiceGetCommentEditHistoryResult = map[int16]string{
+	0: "success",
+}
+
+type InteractionServicePinCommentArgs struct {
+	Req *PinCommentRequest `thrift:"req,1" frugal:"1,default,PinCommentRequest" json:"req"`
+}
+
+func NewInteractionServicePinCommentArgs() *InteractionServicePinCommentArgs {
+	return &InteractionServicePinCommentArgs{}
+}
+
+func (p *InteractionServicePinCommentArgs) InitDefault() {
+}
+
+var InteractionServicePinCommentArgs_Req_DEFAULT *PinCommentRequest
+
+func (p *InteractionServicePinCommentArgs) GetReq() (v *PinCommentRequest) {
+	if !p.IsSetReq() {
+		return InteractionServicePinCommentArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *InteractionServicePinCommentArgs) SetReq(val *PinCommentRequest) {
+	p.Req = val
+}
+
+func (p *InteractionServicePinCommentArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *InteractionServicePinCommentArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("InteractionServicePinCommentArgs(%+v)", *p)
+}
+
+var fieldIDToName_InteractionServicePinCommentArgs = map[int16]string{
+	1: "req",
+}
+
+type InteractionServicePinCommentResult struct {
+	Success *PinCommentResponse `thrift:"success,0,optional" frugal:"0,optional,PinCommentResponse" json:"success,omitempty"`
+}
+
+func NewInteractionServicePinCommentResult() *InteractionServicePinCommentResult {
+	return &InteractionServicePinCommentResult{}
+}
+
+func (p *InteractionServicePinCommentResult) InitDefault() {
+}
+
+var InteractionServicePinCommentResult_Success_DEFAULT *PinCommentResponse
+
+func (p *InteractionServicePinCommentResult) GetSuccess() (v *PinCommentResponse) {
+	if !p.IsSetSuccess() {
+		return InteractionServicePinCommentResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *InteractionServicePinCommentResult) SetSuccess(x interface{}) {
+	p.Success = x.(*PinCommentResponse)
+}
+
+func (p *InteractionServicePinCommentResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *InteractionServicePinCommentResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("InteractionServicePinCommentResult(%+v)", *p)
+}
+
+var fieldIDToName_InteractionServicePinCommentResult = map[int16]string{
+	0: "success",
+}
+
+type InteractionServiceHeartCommentArgs struct {
+	Req *HeartCommentRequest `thrift:"req,1" frugal:"1,default,HeartCommentRequest" json:"req"`
+}
+
+func NewInteractionServiceHeartCommentArgs() *InteractionServiceHeartCommentArgs {
+	return &InteractionServiceHeartCommentArgs{}
+}
+
+func (p *InteractionServiceHeartCommentArgs) InitDefault() {
+}
+
+var InteractionServiceHeartCommentArgs_Req_DEFAULT *HeartCommentRequest
+
+func (p *InteractionServiceHeartCommentArgs) GetReq() (v *HeartCommentRequest) {
+	if !p.IsSetReq() {
+		return InteractionServiceHeartCommentArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *InteractionServiceHeartCommentArgs) SetReq(val *HeartCommentRequest) {
+	p.Req = val
+}
+
+func (p *InteractionServiceHeartCommentArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *InteractionServiceHeartCommentArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("InteractionServiceHeartCommentArgs(%+v)", *p)
+}
+
+var fieldIDToName_InteractionServiceHeartCommentArgs = map[int16]string{
+	1: "req",
+}
+
+type InteractionServiceHeartCommentResult struct {
+	Success *HeartCommentResponse `thrift:"success,0,optional" frugal:"0,optional,HeartCommentResponse" json:"success,omitempty"`
+}
+
+func NewInteractionServiceHeartCommentResult() *InteractionServiceHeartCommentResult {
+	return &InteractionServiceHeartCommentResult{}
+}
+
+func (p *InteractionServiceHeartCommentResult) InitDefault() {
+}
+
+var InteractionServiceHeartCommentResult_Success_DEFAULT *HeartCommentResponse
+
+func (p *InteractionServiceHeartCommentResult) GetSuccess() (v *HeartCommentResponse) {
+	if !p.IsSetSuccess() {
+		return InteractionServiceHeartCommentResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *InteractionServiceHeartCommentResult) SetSuccess(x interface{}) {
+	p.Success = x.(*HeartCommentResponse)
+}
+
+func (p *InteractionServiceHeartCommentResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *InteractionServiceHeartCommentResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("InteractionServiceHeartCommentResult(%+v)", *p)
+}
+
+var fieldIDToName_InteractionServiceHeartCommentResult = map[int16]string{
+	0: "success",
+}
+
+type InteractionServiceReactArgs struct {
+	Req *ReactRequest `thrift:"req,1" frugal:"1,default,ReactRequest" json:"req"`
+}
+
+func NewInteractionServiceReactArgs() *InteractionServiceReactArgs {
+	return &InteractionServiceReactArgs{}
+}
+
+func (p *InteractionServiceReactArgs) InitDefault() {
+}
+
+var InteractionServiceReactArgs_Req_DEFAULT *ReactRequest
+
+func (p *InteractionServiceReactArgs) GetReq() (v *ReactRequest) {
+	if !p.IsSetReq() {
+		return InteractionServiceReactArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *InteractionServiceReactArgs) SetReq(val *ReactRequest) {
+	p.Req = val
+}
+
+func (p *InteractionServiceReactArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *InteractionServiceReactArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("InteractionServiceReactArgs(%+v)", *p)
+}
+
+var fieldIDToName_InteractionServiceReactArgs = map[int16]string{
+	1: "req",
+}
+
+type InteractionServiceReactResult struct {
+	Success *ReactResponse `thrift:"success,0,optional" frugal:"0,optional,ReactResponse" json:"success,omitempty"`
+}
+
+func NewInteractionServiceReactResult() *InteractionServiceReactResult {
+	return &InteractionServiceReactResult{}
+}
+
+func (p *InteractionServiceReactResult) InitDefault() {
+}
+
+var InteractionServiceReactResult_Success_DEFAULT *ReactResponse
+
+func (p *InteractionServiceReactResult) GetSuccess() (v *ReactResponse) {
+	if !p.IsSetSuccess() {
+		return InteractionServiceReactResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *InteractionServiceReactResult) SetSuccess(x interface{}) {
+	p.Success = x.(*ReactResponse)
+}
+
+func (p *InteractionServiceReactResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *InteractionServiceReactResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("InteractionServiceReactResult(%+v)", *p)
+}
+
+var fieldIDToName_InteractionServiceReactResult = map[int16]string{
+	0: "success",
+}
+
+type InteractionServiceGetReactionsArgs struct {
+	Req *GetReactionsRequest `thrift:"req,1" frugal:"1,default,GetReactionsRequest" json:"req"`
+}
+
+func NewInteractionServiceGetReactionsArgs() *InteractionServiceGetReactionsArgs {
+	return &InteractionServiceGetReactionsArgs{}
+}
+
+func (p *InteractionServiceGetReactionsArgs) InitDefault() {
+}
+
+var InteractionServiceGetReactionsArgs_Req_DEFAULT *GetReactionsRequest
+
+func (p *InteractionServiceGetReactionsArgs) GetReq() (v *GetReactionsRequest) {
+	if !p.IsSetReq() {
+		return InteractionServiceGetReactionsArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *InteractionServiceGetReactionsArgs) SetReq(val *GetReactionsRequest) {
+	p.Req = val
+}
+
+func (p *InteractionServiceGetReactionsArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *InteractionServiceGetReactionsArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("InteractionServiceGetReactionsArgs(%+v)", *p)
+}
+
+var fieldIDToName_InteractionServiceGetReactionsArgs = map[int16]string{
+	1: "req",
+}
+
+type InteractionServiceGetReactionsResult struct {
+	Success *GetReactionsResponse `thrift:"success,0,optional" frugal:"0,optional,GetReactionsResponse" json:"success,omitempty"`
+}
+
+func NewInteractionServiceGetReactionsResult() *InteractionServiceGetReactionsResult {
+	return &InteractionServiceGetReactionsResult{}
+}
+
+func (p *InteractionServiceGetReactionsResult) InitDefault() {
+}
+
+var InteractionServiceGetReactionsResult_Success_DEFAULT *GetReactionsResponse
+
+func (p *InteractionServiceGetReactionsResult) GetSuccess() (v *GetReactionsResponse) {
+	if !p.IsSetSuccess() {
+		return InteractionServiceGetReactionsResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *InteractionServiceGetReactionsResult) SetSuccess(x interface{}) {
+	p.Success = x.(*GetReactionsResponse)
+}
+
+func (p *InteractionServiceGetReactionsResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *InteractionServiceGetReactionsResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("InteractionServiceGetReactionsResult(%+v)", *p)
+}
+
+var fieldIDToName_InteractionServiceGetReactionsResult = map[int16]string{
+	0: "success",
+}
+
+type InteractionServiceGetInteractionStateArgs struct {
+	Req *GetInteractionStateRequest `thrift:"req,1" frugal:"1,default,GetInteractionStateRequest" json:"req"`
+}
+
+func NewInteractionServiceGetInteractionStateArgs() *InteractionServiceGetInteractionStateArgs {
+	return &InteractionServiceGetInteractionStateArgs{}
+}
+
+func (p *InteractionServiceGetInteractionStateArgs) InitDefault() {
+}
+
+var InteractionServiceGetInteractionStateArgs_Req_DEFAULT *GetInteractionStateRequest
+
+func (p *InteractionServiceGetInteractionStateArgs) GetReq() (v *GetInteractionStateRequest) {
+	if !p.IsSetReq() {
+		return InteractionServiceGetInteractionStateArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *InteractionServiceGetInteractionStateArgs) SetReq(val *GetInteractionStateRequest) {
+	p.Req = val
+}
+
+func (p *InteractionServiceGetInteractionStateArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *InteractionServiceGetInteractionStateArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("InteractionServiceGetInteractionStateArgs(%+v)", *p)
+}
+
+var fieldIDToName_InteractionServiceGetInteractionStateArgs = map[int16]string{
+	1: "req",
+}
+
+type InteractionServiceGetInteractionStateResult struct {
+	Success *GetInteractionStateResponse `thrift:"success,0,optional" frugal:"0,optional,GetInteractionStateResponse" json:"success,omitempty"`
+}
+
+func NewInteractionServiceGetInteractionStateResult() *InteractionServiceGetInteractionStateResult {
+	return &InteractionServiceGetInteractionStateResult{}
+}
+
+func (p *InteractionServiceGetInteractionStateResult) InitDefault() {
+}
+
+var InteractionServiceGetInteractionStateResult_Success_DEFAULT *GetInteractionStateResponse
+
+func (p *InteractionServiceGetInteractionStateResult) GetSuccess() (v *GetInteractionStateResponse) {
+	if !p.IsSetSuccess() {
+		return InteractionServiceGetInteractionStateResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *InteractionServiceGetInteractionStateResult) SetSuccess(x interface{}) {
+	p.Success = x.(*GetInteractionStateResponse)
+}
+
+func (p *InteractionServiceGetInteractionStateResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *InteractionServiceGetInteractionStateResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("InteractionServiceGetInteractionStateResult(%+v)", *p)
+}
+
+var fieldIDToName_InteractionServiceGetInteractionStateResult = map[int16]string{
+	0: "success",
+}
+
+type InteractionServiceGetFlaggedCommentsArgs struct {
+	Req *GetFlaggedCommentsRequest `thrift:"req,1" frugal:"1,default,GetFlaggedCommentsRequest" json:"req"`
+}
+
+func NewInteractionServiceGetFlaggedCommentsArgs() *InteractionServiceGetFlaggedCommentsArgs {
+	return &InteractionServiceGetFlaggedCommentsArgs{}
+}
+
+func (p *InteractionServiceGetFlaggedCommentsArgs) InitDefault() {
+}
+
+var InteractionServiceGetFlaggedCommentsArgs_Req_DEFAULT *GetFlaggedCommentsRequest
+
+func (p *InteractionServiceGetFlaggedCommentsArgs) GetReq() (v *GetFlaggedCommentsRequest) {
+	if !p.IsSetReq() {
+		return InteractionServiceGetFlaggedCommentsArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *InteractionServiceGetFlaggedCommentsArgs) SetReq(val *GetFlaggedCommentsRequest) {
+	p.Req = val
+}
+
+func (p *InteractionServiceGetFlaggedCommentsArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *InteractionServiceGetFlaggedCommentsArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("InteractionServiceGetFlaggedCommentsArgs(%+v)", *p)
+}
+
+var fieldIDToName_InteractionServiceGetFlaggedCommentsArgs = map[int16]string{
+	1: "req",
+}
+
+type InteractionServiceGetFlaggedCommentsResult struct {
+	Success *GetFlaggedCommentsResponse `thrift:"success,0,optional" frugal:"0,optional,GetFlaggedCommentsResponse" json:"success,omitempty"`
+}
+
+func NewInteractionServiceGetFlaggedCommentsResult() *InteractionServiceGetFlaggedCommentsResult {
+	return &InteractionServiceGetFlaggedCommentsResult{}
+}
+
+func (p *InteractionServiceGetFlaggedCommentsResult) InitDefault() {
+}
+
+var InteractionServiceGetFlaggedCommentsResult_Success_DEFAULT *GetFlaggedCommentsResponse
+
+func (p *InteractionServiceGetFlaggedCommentsResult) GetSuccess() (v *GetFlaggedCommentsResponse) {
+	if !p.IsSetSuccess() {
+		return InteractionServiceGetFlaggedCommentsResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *InteractionServiceGetFlaggedCommentsResult) SetSuccess(x interface{}) {
+	p.Success = x.(*GetFlaggedCommentsResponse)
+}
+
+func (p *InteractionServiceGetFlaggedCommentsResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *InteractionServiceGetFlaggedCommentsResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("InteractionServiceGetFlaggedCommentsResult(%+v)", *p)
+}
+
+var fieldIDToName_InteractionServiceGetFlaggedCommentsResult = map[int16]string{
+	0: "success",
+}
+
+type InteractionServiceReviewCommentArgs struct {
+	Req *ReviewCommentRequest `thrift:"req,1" frugal:"1,default,ReviewCommentRequest" json:"req"`
+}
+
+func NewInteractionServiceReviewCommentArgs() *InteractionServiceReviewCommentArgs {
+	return &InteractionServiceReviewCommentArgs{}
+}
+
+func (p *InteractionServiceReviewCommentArgs) InitDefault() {
+}
+
+var InteractionServiceReviewCommentArgs_Req_DEFAULT *ReviewCommentRequest
+
+func (p *InteractionServiceReviewCommentArgs) GetReq() (v *ReviewCommentRequest) {
+	if !p.IsSetReq() {
+		return InteractionServiceReviewCommentArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *InteractionServiceReviewCommentArgs) SetReq(val *ReviewCommentRequest) {
+	p.Req = val
+}
+
+func (p *InteractionServiceReviewCommentArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *InteractionServiceReviewCommentArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("InteractionServiceReviewCommentArgs(%+v)", *p)
+}
+
+var fieldIDToName_InteractionServiceReviewCommentArgs = map[int16]string{
+	1: "req",
+}
+
+type InteractionServiceReviewCommentResult struct {
+	Success *ReviewCommentResponse `thrift:"success,0,optional" frugal:"0,optional,ReviewCommentResponse" json:"success,omitempty"`
+}
+
+func NewInteractionServiceReviewCommentResult() *InteractionServiceReviewCommentResult {
+	return &InteractionServiceReviewCommentResult{}
+}
+
+func (p *InteractionServiceReviewCommentResult) InitDefault() {
+}
+
+var InteractionServiceReviewCommentResult_Success_DEFAULT *ReviewCommentResponse
+
+func (p *InteractionServiceReviewCommentResult) GetSuccess() (v *ReviewCommentResponse) {
+	if !p.IsSetSuccess() {
+		return InteractionServiceReviewCommentResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *InteractionServiceReviewCommentResult) SetSuccess(x interface{}) {
+	p.Success = x.(*ReviewCommentResponse)
+}
+
+func (p *InteractionServiceReviewCommentResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *InteractionServiceReviewCommentResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("InteractionServiceReviewCommentResult(%+v)", *p)
+}
+
+var fieldIDToName_InteractionServiceReviewCommentResult = map[int16]string{
+	0: "success",
+}
+
+type InteractionServiceCreateCollectionArgs struct {
+	Req *CreateCollectionRequest `thrift:"req,1" frugal:"1,default,CreateCollectionRequest" json:"req"`
+}
+
+func NewInteractionServiceCreateCollectionArgs() *InteractionServiceCreateCollectionArgs {
+	return &InteractionServiceCreateCollectionArgs{}
+}
+
+func (p *InteractionServiceCreateCollectionArgs) InitDefault() {
+}
+
+var InteractionServiceCreateCollectionArgs_Req_DEFAULT *CreateCollectionRequest
+
+func (p *InteractionServiceCreateCollectionArgs) GetReq() (v *CreateCollectionRequest) {
+	if !p.IsSetReq() {
+		return InteractionServiceCreateCollectionArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *InteractionServiceCreateCollectionArgs) SetReq(val *CreateCollectionRequest) {
+	p.Req = val
 }
 
-func (p *DeleteCommentRequest) GetCommentId() (v int64) {
-	return p.CommentId
-}
-func (p *DeleteCommentRequest) SetCommentId(val int64) {
-	p.CommentId = val
+func (p *InteractionServiceCreateCollectionArgs) IsSetReq() bool {
+	return p.Req != nil
 }
 
-func (p *DeleteCommentRequest) String() string {
+func (p *InteractionServiceCreateCollectionArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("DeleteCommentRequest(%+v)", *p)
+	return fmt.Sprintf("InteractionServiceCreateCollectionArgs(%+v)", *p)
 }
 
-var fieldIDToName_DeleteCommentRequest = map[int16]string{
-	1: "comment_id",
+var fieldIDToName_InteractionServiceCreateCollectionArgs = map[int16]string{
+	1: "req",
 }
 
-type DeleteCommentResponse struct {
-	Base *model.BaseResp `thrift:"Base,1,required" frugal:"1,required,model.BaseResp" json:"Base"`
+type InteractionServiceCreateCollectionResult struct {
+	Success *CreateCollectionResponse `thrift:"success,0,optional" frugal:"0,optional,CreateCollectionResponse" json:"success,omitempty"`
 }
 
-func NewDeleteCommentResponse() *DeleteCommentResponse {
-	return &DeleteCommentResponse{}
+func NewInteractionServiceCreateCollectionResult() *InteractionServiceCreateCollectionResult {
+	return &InteractionServiceCreateCollectionResult{}
 }
 
-func (p *DeleteCommentResponse) InitDefault() {
+func (p *InteractionServiceCreateCollectionResult) InitDefault() {
 }
 
-var DeleteCommentResponse_Base_DEFAULT *model.BaseResp
+var InteractionServiceCreateCollectionResult_Success_DEFAULT *CreateCollectionResponse
 
-func (p *DeleteCommentResponse) GetBase() (v *model.BaseResp) {
-	if !p.IsSetBase() {
-		return DeleteCommentResponse_Base_DEFAULT
+func (p *InteractionServiceCreateCollectionResult) GetSuccess() (v *CreateCollectionResponse) {
+	if !p.IsSetSuccess() {
+		return InteractionServiceCreateCollectionResult_Success_DEFAULT
 	}
-	return p.Base
+	return p.Success
 }
-func (p *DeleteCommentResponse) SetBase(val *model.BaseResp) {
-	p.Base = val
+func (p *InteractionServiceCreateCollectionResult) SetSuccess(x interface{}) {
+	p.Success = x.(*CreateCollectionResponse)
 }
 
-func (p *DeleteCommentResponse) IsSetBase() bool {
-	return p.Base != nil
+func (p *InteractionServiceCreateCollectionResult) IsSetSuccess() bool {
+	return p.Success != nil
 }
 
-func (p *DeleteCommentResponse) String() string {
+func (p *InteractionServiceCreateCollectionResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("DeleteCommentResponse(%+v)", *p)
+	return fmt.Sprintf("InteractionServiceCreateCollectionResult(%+v)", *p)
 }
 
-var fieldIDToName_DeleteCommentResponse = map[int16]string{
-	1: "Base",
+var fieldIDToName_InteractionServiceCreateCollectionResult = map[int16]string{
+	0: "success",
 }
 
-type LikeCommentRequest struct {
-	CommentId int64 `thrift:"comment_id,1,required" frugal:"1,required,i64" json:"comment_id"`
+type InteractionServiceUpdateCollectionArgs struct {
+	Req *UpdateCollectionRequest `thrift:"req,1" frugal:"1,default,UpdateCollectionRequest" json:"req"`
 }
 
-func NewLikeCommentRequest() *LikeCommentRequest {
-	return &LikeCommentRequest{}
+func NewInteractionServiceUpdateCollectionArgs() *InteractionServiceUpdateCollectionArgs {
+	return &InteractionServiceUpdateCollectionArgs{}
 }
 
-func (p *LikeCommentRequest) InitDefault() {
+func (p *InteractionServiceUpdateCollectionArgs) InitDefault() {
 }
 
-func (p *LikeCommentRequest) GetCommentId() (v int64) {
-	return p.CommentId
+var InteractionServiceUpdateCollectionArgs_Req_DEFAULT *UpdateCollectionRequest
+
+func (p *InteractionServiceUpdateCollectionArgs) GetReq() (v *UpdateCollectionRequest) {
+	if !p.IsSetReq() {
+		return InteractionServiceUpdateCollectionArgs_Req_DEFAULT
+	}
+	return p.Req
 }
-func (p *LikeCommentRequest) SetCommentId(val int64) {
-	p.CommentId = val
+func (p *InteractionServiceUpdateCollectionArgs) SetReq(val *UpdateCollectionRequest) {
+	p.Req = val
 }
 
-func (p *LikeCommentRequest) String() string {
+func (p *InteractionServiceUpdateCollectionArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *InteractionServiceUpdateCollectionArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("LikeCommentRequest(%+v)", *p)
+	return fmt.Sprintf("InteractionServiceUpdateCollectionArgs(%+v)", *p)
 }
 
-var fieldIDToName_LikeCommentRequest = map[int16]string{
-	1: "comment_id",
+var fieldIDToName_InteractionServiceUpdateCollectionArgs = map[int16]string{
+	1: "req",
 }
 
-type LikeCommentResponse struct {
-	Base *model.BaseResp `thrift:"Base,1,required" frugal:"1,required,model.BaseResp" json:"Base"`
+type InteractionServiceUpdateCollectionResult struct {
+	Success *UpdateCollectionResponse `thrift:"success,0,optional" frugal:"0,optional,UpdateCollectionResponse" json:"success,omitempty"`
 }
 
-func NewLikeCommentResponse() *LikeCommentResponse {
-	return &LikeCommentResponse{}
+func NewInteractionServiceUpdateCollectionResult() *InteractionServiceUpdateCollectionResult {
+	return &InteractionServiceUpdateCollectionResult{}
 }
 
-func (p *LikeCommentResponse) InitDefault() {
+func (p *InteractionServiceUpdateCollectionResult) InitDefault() {
 }
 
-var LikeCommentResponse_Base_DEFAULT *model.BaseResp
+var InteractionServiceUpdateCollectionResult_Success_DEFAULT *UpdateCollectionResponse
 
-func (p *LikeCommentResponse) GetBase() (v *model.BaseResp) {
-	if !p.IsSetBase() {
-		return LikeCommentResponse_Base_DEFAULT
+func (p *InteractionServiceUpdateCollectionResult) GetSuccess() (v *UpdateCollectionResponse) {
+	if !p.IsSetSuccess() {
+		return InteractionServiceUpdateCollectionResult_Success_DEFAULT
 	}
-	return p.Base
+	return p.Success
 }
-func (p *LikeCommentResponse) SetBase(val *model.BaseResp) {
-	p.Base = val
+func (p *InteractionServiceUpdateCollectionResult) SetSuccess(x interface{}) {
+	p.Success = x.(*UpdateCollectionResponse)
 }
 
-func (p *LikeCommentResponse) IsSetBase() bool {
-	return p.Base != nil
+func (p *InteractionServiceUpdateCollectionResult) IsSetSuccess() bool {
+	return p.Success != nil
 }
 
-func (p *LikeCommentResponse) String() string {
+func (p *InteractionServiceUpdateCollectionResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("LikeCommentResponse(%+v)", *p)
-}
-
-var fieldIDToName_LikeCommentResponse = map[int16]string{
-	1: "Base",
+	return fmt.Sprintf("InteractionServiceUpdateCollectionResult(%+v)", *p)
 }
 
-type InteractionService interface {
-	Like(ctx context.Context, req *LikeRequest) (r *LikeResponse, err error)
-
-	GetLikes(ctx context.Context, req *GetLikesRequest) (r *GetLikesResponse, err error)
-
-	Comment(ctx context.Context, req *CommentRequest) (r *CommentResponse, err error)
-
-	GetComments(ctx context.Context, req *GetCommentsRequest) (r *GetCommentsResponse, err error)
-
-	DeleteComment(ctx context.Context, req *DeleteCommentRequest) (r *DeleteCommentResponse, err error)
-
-	LikeComment(ctx context.Context, req *LikeCommentRequest) (r *LikeCommentResponse, err error)
+var fieldIDToName_InteractionServiceUpdateCollectionResult = map[int16]string{
+	0: "success",
 }
 
-type InteractionServiceLikeArgs struct {
-	Req *LikeRequest `thrift:"req,1" frugal:"1,default,LikeRequest" json:"req"`
+type InteractionServiceDeleteCollectionArgs struct {
+	Req *DeleteCollectionRequest `thrift:"req,1" frugal:"1,default,DeleteCollectionRequest" json:"req"`
 }
 
-func NewInteractionServiceLikeArgs() *InteractionServiceLikeArgs {
-	return &InteractionServiceLikeArgs{}
+func NewInteractionServiceDeleteCollectionArgs() *InteractionServiceDeleteCollectionArgs {
+	return &InteractionServiceDeleteCollectionArgs{}
 }
 
-func (p *InteractionServiceLikeArgs) InitDefault() {
+func (p *InteractionServiceDeleteCollectionArgs) InitDefault() {
 }
 
-var InteractionServiceLikeArgs_Req_DEFAULT *LikeRequest
+var InteractionServiceDeleteCollectionArgs_Req_DEFAULT *DeleteCollectionRequest
 
-func (p *InteractionServiceLikeArgs) GetReq() (v *LikeRequest) {
+func (p *InteractionServiceDeleteCollectionArgs) GetReq() (v *DeleteCollectionRequest) {
 	if !p.IsSetReq() {
-		return InteractionServiceLikeArgs_Req_DEFAULT
+		return InteractionServiceDeleteCollectionArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *InteractionServiceLikeArgs) SetReq(val *LikeRequest) {
+func (p *InteractionServiceDeleteCollectionArgs) SetReq(val *DeleteCollectionRequest) {
 	p.Req = val
 }
 
-func (p *InteractionServiceLikeArgs) IsSetReq() bool {
+func (p *InteractionServiceDeleteCollectionArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *InteractionServiceLikeArgs) String() string {
+func (p *InteractionServiceDeleteCollectionArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("InteractionServiceLikeArgs(%+v)", *p)
+	return fmt.Sprintf("InteractionServiceDeleteCollectionArgs(%+v)", *p)
 }
 
-var fieldIDToName_InteractionServiceLikeArgs = map[int16]string{
+var fieldIDToName_InteractionServiceDeleteCollectionArgs = map[int16]string{
 	1: "req",
 }
 
-type InteractionServiceLikeResult struct {
-	Success *LikeResponse `thrift:"success,0,optional" frugal:"0,optional,LikeResponse" json:"success,omitempty"`
+type InteractionServiceDeleteCollectionResult struct {
+	Success *DeleteCollectionResponse `thrift:"success,0,optional" frugal:"0,optional,DeleteCollectionResponse" json:"success,omitempty"`
 }
 
-func NewInteractionServiceLikeResult() *InteractionServiceLikeResult {
-	return &InteractionServiceLikeResult{}
+func NewInteractionServiceDeleteCollectionResult() *InteractionServiceDeleteCollectionResult {
+	return &InteractionServiceDeleteCollectionResult{}
 }
 
-func (p *InteractionServiceLikeResult) InitDefault() {
+func (p *InteractionServiceDeleteCollectionResult) InitDefault() {
 }
 
-var InteractionServiceLikeResult_Success_DEFAULT *LikeResponse
+var InteractionServiceDeleteCollectionResult_Success_DEFAULT *DeleteCollectionResponse
 
-func (p *InteractionServiceLikeResult) GetSuccess() (v *LikeResponse) {
+func (p *InteractionServiceDeleteCollectionResult) GetSuccess() (v *DeleteCollectionResponse) {
 	if !p.IsSetSuccess() {
-		return InteractionServiceLikeResult_Success_DEFAULT
+		return InteractionServiceDeleteCollectionResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *InteractionServiceLikeResult) SetSuccess(x interface{}) {
-	p.Success = x.(*LikeResponse)
+func (p *InteractionServiceDeleteCollectionResult) SetSuccess(x interface{}) {
+	p.Success = x.(*DeleteCollectionResponse)
 }
 
-func (p *InteractionServiceLikeResult) IsSetSuccess() bool {
+func (p *InteractionServiceDeleteCollectionResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *InteractionServiceLikeResult) String() string {
+func (p *InteractionServiceDeleteCollectionResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("InteractionServiceLikeResult(%+v)", *p)
+	return fmt.Sprintf("InteractionServiceDeleteCollectionResult(%+v)", *p)
 }
 
-var fieldIDToName_InteractionServiceLikeResult = map[int16]string{
+var fieldIDToName_InteractionServiceDeleteCollectionResult = map[int16]string{
 	0: "success",
 }
 
-type InteractionServiceGetLikesArgs struct {
-	Req *GetLikesRequest `thrift:"req,1" frugal:"1,default,GetLikesRequest" json:"req"`
+type InteractionServiceReorderCollectionsArgs struct {
+	Req *ReorderCollectionsRequest `thrift:"req,1" frugal:"1,default,ReorderCollectionsRequest" json:"req"`
 }
 
-func NewInteractionServiceGetLikesArgs() *InteractionServiceGetLikesArgs {
-	return &InteractionServiceGetLikesArgs{}
+func NewInteractionServiceReorderCollectionsArgs() *InteractionServiceReorderCollectionsArgs {
+	return &InteractionServiceReorderCollectionsArgs{}
 }
 
-func (p *InteractionServiceGetLikesArgs) InitDefault() {
+func (p *InteractionServiceReorderCollectionsArgs) InitDefault() {
 }
 
-var InteractionServiceGetLikesArgs_Req_DEFAULT *GetLikesRequest
+var InteractionServiceReorderCollectionsArgs_Req_DEFAULT *ReorderCollectionsRequest
 
-func (p *InteractionServiceGetLikesArgs) GetReq() (v *GetLikesRequest) {
+func (p *InteractionServiceReorderCollectionsArgs) GetReq() (v *ReorderCollectionsRequest) {
 	if !p.IsSetReq() {
-		return InteractionServiceGetLikesArgs_Req_DEFAULT
+		return InteractionServiceReorderCollectionsArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *InteractionServiceGetLikesArgs) SetReq(val *GetLikesRequest) {
+func (p *InteractionServiceReorderCollectionsArgs) SetReq(val *ReorderCollectionsRequest) {
 	p.Req = val
 }
 
-func (p *InteractionServiceGetLikesArgs) IsSetReq() bool {
+func (p *InteractionServiceReorderCollectionsArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *InteractionServiceGetLikesArgs) String() string {
+func (p *InteractionServiceReorderCollectionsArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("InteractionServiceGetLikesArgs(%+v)", *p)
+	return fmt.Sprintf("InteractionServiceReorderCollectionsArgs(%+v)", *p)
 }
 
-var fieldIDToName_InteractionServiceGetLikesArgs = map[int16]string{
+var fieldIDToName_InteractionServiceReorderCollectionsArgs = map[int16]string{
 	1: "req",
 }
 
-type InteractionServiceGetLikesResult struct {
-	Success *GetLikesResponse `thrift:"success,0,optional" frugal:"0,optional,GetLikesResponse" json:"success,omitempty"`
+type InteractionServiceReorderCollectionsResult struct {
+	Success *ReorderCollectionsResponse `thrift:"success,0,optional" frugal:"0,optional,ReorderCollectionsResponse" json:"success,omitempty"`
 }
 
-func NewInteractionServiceGetLikesResult() *InteractionServiceGetLikesResult {
-	return &InteractionServiceGetLikesResult{}
+func NewInteractionServiceReorderCollectionsResult() *InteractionServiceReorderCollectionsResult {
+	return &InteractionServiceReorderCollectionsResult{}
 }
 
-func (p *InteractionServiceGetLikesResult) InitDefault() {
+func (p *InteractionServiceReorderCollectionsResult) InitDefault() {
 }
 
-var InteractionServiceGetLikesResult_Success_DEFAULT *GetLikesResponse
+var InteractionServiceReorderCollectionsResult_Success_DEFAULT *ReorderCollectionsResponse
 
-func (p *InteractionServiceGetLikesResult) GetSuccess() (v *GetLikesResponse) {
+func (p *InteractionServiceReorderCollectionsResult) GetSuccess() (v *ReorderCollectionsResponse) {
 	if !p.IsSetSuccess() {
-		return InteractionServiceGetLikesResult_Success_DEFAULT
+		return InteractionServiceReorderCollectionsResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *InteractionServiceGetLikesResult) SetSuccess(x interface{}) {
-	p.Success = x.(*GetLikesResponse)
+func (p *InteractionServiceReorderCollectionsResult) SetSuccess(x interface{}) {
+	p.Success = x.(*ReorderCollectionsResponse)
 }
 
-func (p *InteractionServiceGetLikesResult) IsSetSuccess() bool {
+func (p *InteractionServiceReorderCollectionsResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *InteractionServiceGetLikesResult) String() string {
+func (p *InteractionServiceReorderCollectionsResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("InteractionServiceGetLikesResult(%+v)", *p)
+	return fmt.Sprintf("InteractionServiceReorderCollectionsResult(%+v)", *p)
 }
 
-var fieldIDToName_InteractionServiceGetLikesResult = map[int16]string{
+var fieldIDToName_InteractionServiceReorderCollectionsResult = map[int16]string{
 	0: "success",
 }
 
-type InteractionServiceCommentArgs struct {
-	Req *CommentRequest `thrift:"req,1" frugal:"1,default,CommentRequest" json:"req"`
+type InteractionServiceGetCollectionsArgs struct {
+	Req *GetCollectionsRequest `thrift:"req,1" frugal:"1,default,GetCollectionsRequest" json:"req"`
 }
 
-func NewInteractionServiceCommentArgs() *InteractionServiceCommentArgs {
-	return &InteractionServiceCommentArgs{}
+func NewInteractionServiceGetCollectionsArgs() *InteractionServiceGetCollectionsArgs {
+	return &InteractionServiceGetCollectionsArgs{}
 }
 
-func (p *InteractionServiceCommentArgs) InitDefault() {
+func (p *InteractionServiceGetCollectionsArgs) InitDefault() {
 }
 
-var InteractionServiceCommentArgs_Req_DEFAULT *CommentRequest
+var InteractionServiceGetCollectionsArgs_Req_DEFAULT *GetCollectionsRequest
 
-func (p *InteractionServiceCommentArgs) GetReq() (v *CommentRequest) {
+func (p *InteractionServiceGetCollectionsArgs) GetReq() (v *GetCollectionsRequest) {
 	if !p.IsSetReq() {
-		return InteractionServiceCommentArgs_Req_DEFAULT
+		return InteractionServiceGetCollectionsArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *InteractionServiceCommentArgs) SetReq(val *CommentRequest) {
+func (p *InteractionServiceGetCollectionsArgs) SetReq(val *GetCollectionsRequest) {
 	p.Req = val
 }
 
-func (p *InteractionServiceCommentArgs) IsSetReq() bool {
+func (p *InteractionServiceGetCollectionsArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *InteractionServiceCommentArgs) String() string {
+func (p *InteractionServiceGetCollectionsArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("InteractionServiceCommentArgs(%+v)", *p)
+	return fmt.Sprintf("InteractionServiceGetCollectionsArgs(%+v)", *p)
 }
 
-var fieldIDToName_InteractionServiceCommentArgs = map[int16]string{
+var fieldIDToName_InteractionServiceGetCollectionsArgs = map[int16]string{
 	1: "req",
 }
 
-type InteractionServiceCommentResult struct {
-	Success *CommentResponse `thrift:"success,0,optional" frugal:"0,optional,CommentResponse" json:"success,omitempty"`
+type InteractionServiceGetCollectionsResult struct {
+	Success *GetCollectionsResponse `thrift:"success,0,optional" frugal:"0,optional,GetCollectionsResponse" json:"success,omitempty"`
 }
 
-func NewInteractionServiceCommentResult() *InteractionServiceCommentResult {
-	return &InteractionServiceCommentResult{}
+func NewInteractionServiceGetCollectionsResult() *InteractionServiceGetCollectionsResult {
+	return &InteractionServiceGetCollectionsResult{}
 }
 
-func (p *InteractionServiceCommentResult) InitDefault() {
+func (p *InteractionServiceGetCollectionsResult) InitDefault() {
 }
 
-var InteractionServiceCommentResult_Success_DEFAULT *CommentResponse
+var InteractionServiceGetCollectionsResult_Success_DEFAULT *GetCollectionsResponse
 
-func (p *InteractionServiceCommentResult) GetSuccess() (v *CommentResponse) {
+func (p *InteractionServiceGetCollectionsResult) GetSuccess() (v *GetCollectionsResponse) {
 	if !p.IsSetSuccess() {
-		return InteractionServiceCommentResult_Success_DEFAULT
+		return InteractionServiceGetCollectionsResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *InteractionServiceCommentResult) SetSuccess(x interface{}) {
-	p.Success = x.(*CommentResponse)
+func (p *InteractionServiceGetCollectionsResult) SetSuccess(x interface{}) {
+	p.Success = x.(*GetCollectionsResponse)
 }
 
-func (p *InteractionServiceCommentResult) IsSetSuccess() bool {
+func (p *InteractionServiceGetCollectionsResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *InteractionServiceCommentResult) String() string {
+func (p *InteractionServiceGetCollectionsResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("InteractionServiceCommentResult(%+v)", *p)
+	return fmt.Sprintf("InteractionServiceGetCollectionsResult(%+v)", *p)
 }
 
-var fieldIDToName_InteractionServiceCommentResult = map[int16]string{
+var fieldIDToName_InteractionServiceGetCollectionsResult = map[int16]string{
 	0: "success",
 }
 
-type InteractionServiceGetCommentsArgs struct {
-	Req *GetCommentsRequest `thrift:"req,1" frugal:"1,default,GetCommentsRequest" json:"req"`
+type InteractionServiceAddToCollectionArgs struct {
+	Req *AddToCollectionRequest `thrift:"req,1" frugal:"1,default,AddToCollectionRequest" json:"req"`
 }
 
-func NewInteractionServiceGetCommentsArgs() *InteractionServiceGetCommentsArgs {
-	return &InteractionServiceGetCommentsArgs{}
+func NewInteractionServiceAddToCollectionArgs() *InteractionServiceAddToCollectionArgs {
+	return &InteractionServiceAddToCollectionArgs{}
 }
 
-func (p *InteractionServiceGetCommentsArgs) InitDefault() {
+func (p *InteractionServiceAddToCollectionArgs) InitDefault() {
 }
 
-var InteractionServiceGetCommentsArgs_Req_DEFAULT *GetCommentsRequest
+var InteractionServiceAddToCollectionArgs_Req_DEFAULT *AddToCollectionRequest
 
-func (p *InteractionServiceGetCommentsArgs) GetReq() (v *GetCommentsRequest) {
+func (p *InteractionServiceAddToCollectionArgs) GetReq() (v *AddToCollectionRequest) {
 	if !p.IsSetReq() {
-		return InteractionServiceGetCommentsArgs_Req_DEFAULT
+		return InteractionServiceAddToCollectionArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *InteractionServiceGetCommentsArgs) SetReq(val *GetCommentsRequest) {
+func (p *InteractionServiceAddToCollectionArgs) SetReq(val *AddToCollectionRequest) {
 	p.Req = val
 }
 
-func (p *InteractionServiceGetCommentsArgs) IsSetReq() bool {
+func (p *InteractionServiceAddToCollectionArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *InteractionServiceGetCommentsArgs) String() string {
+func (p *InteractionServiceAddToCollectionArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("InteractionServiceGetCommentsArgs(%+v)", *p)
+	return fmt.Sprintf("InteractionServiceAddToCollectionArgs(%+v)", *p)
 }
 
-var fieldIDToName_InteractionServiceGetCommentsArgs = map[int16]string{
+var fieldIDToName_InteractionServiceAddToCollectionArgs = map[int16]string{
 	1: "req",
 }
 
-type InteractionServiceGetCommentsResult struct {
-	Success *GetCommentsResponse `thrift:"success,0,optional" frugal:"0,optional,GetCommentsResponse" json:"success,omitempty"`
+type InteractionServiceAddToCollectionResult struct {
+	Success *AddToCollectionResponse `thrift:"success,0,optional" frugal:"0,optional,AddToCollectionResponse" json:"success,omitempty"`
 }
 
-func NewInteractionServiceGetCommentsResult() *InteractionServiceGetCommentsResult {
-	return &InteractionServiceGetCommentsResult{}
+func NewInteractionServiceAddToCollectionResult() *InteractionServiceAddToCollectionResult {
+	return &InteractionServiceAddToCollectionResult{}
 }
 
-func (p *InteractionServiceGetCommentsResult) InitDefault() {
+func (p *InteractionServiceAddToCollectionResult) InitDefault() {
 }
 
-var InteractionServiceGetCommentsResult_Success_DEFAULT *GetCommentsResponse
+var InteractionServiceAddToCollectionResult_Success_DEFAULT *AddToCollectionResponse
 
-func (p *InteractionServiceGetCommentsResult) GetSuccess() (v *GetCommentsResponse) {
+func (p *InteractionServiceAddToCollectionResult) GetSuccess() (v *AddToCollectionResponse) {
 	if !p.IsSetSuccess() {
-		return InteractionServiceGetCommentsResult_Success_DEFAULT
+		return InteractionServiceAddToCollectionResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *InteractionServiceGetCommentsResult) SetSuccess(x interface{}) {
-	p.Success = x.(*GetCommentsResponse)
+func (p *InteractionServiceAddToCollectionResult) SetSuccess(x interface{}) {
+	p.Success = x.(*AddToCollectionResponse)
 }
 
-func (p *InteractionServiceGetCommentsResult) IsSetSuccess() bool {
+func (p *InteractionServiceAddToCollectionResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *InteractionServiceGetCommentsResult) String() string {
+func (p *InteractionServiceAddToCollectionResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("InteractionServiceGetCommentsResult(%+v)", *p)
+	return fmt.Sprintf("InteractionServiceAddToCollectionResult(%+v)", *p)
 }
 
-var fieldIDToName_InteractionServiceGetCommentsResult = map[int16]string{
+var fieldIDToName_InteractionServiceAddToCollectionResult = map[int16]string{
 	0: "success",
 }
 
-type InteractionServiceDeleteCommentArgs struct {
-	Req *DeleteCommentRequest `thrift:"req,1" frugal:"1,default,DeleteCommentRequest" json:"req"`
+type InteractionServiceRemoveFromCollectionArgs struct {
+	Req *RemoveFromCollectionRequest `thrift:"req,1" frugal:"1,default,RemoveFromCollectionRequest" json:"req"`
 }
 
-func NewInteractionServiceDeleteCommentArgs() *InteractionServiceDeleteCommentArgs {
-	return &InteractionServiceDeleteCommentArgs{}
+func NewInteractionServiceRemoveFromCollectionArgs() *InteractionServiceRemoveFromCollectionArgs {
+	return &InteractionServiceRemoveFromCollectionArgs{}
 }
 
-func (p *InteractionServiceDeleteCommentArgs) InitDefault() {
+func (p *InteractionServiceRemoveFromCollectionArgs) InitDefault() {
 }
 
-var InteractionServiceDeleteCommentArgs_Req_DEFAULT *DeleteCommentRequest
+var InteractionServiceRemoveFromCollectionArgs_Req_DEFAULT *RemoveFromCollectionRequest
 
-func (p *InteractionServiceDeleteCommentArgs) GetReq() (v *DeleteCommentRequest) {
+func (p *InteractionServiceRemoveFromCollectionArgs) GetReq() (v *RemoveFromCollectionRequest) {
 	if !p.IsSetReq() {
-		return InteractionServiceDeleteCommentArgs_Req_DEFAULT
+		return InteractionServiceRemoveFromCollectionArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *InteractionServiceDeleteCommentArgs) SetReq(val *DeleteCommentRequest) {
+func (p *InteractionServiceRemoveFromCollectionArgs) SetReq(val *RemoveFromCollectionRequest) {
 	p.Req = val
 }
 
-func (p *InteractionServiceDeleteCommentArgs) IsSetReq() bool {
+func (p *InteractionServiceRemoveFromCollectionArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *InteractionServiceDeleteCommentArgs) String() string {
+func (p *InteractionServiceRemoveFromCollectionArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("InteractionServiceDeleteCommentArgs(%+v)", *p)
+	return fmt.Sprintf("InteractionServiceRemoveFromCollectionArgs(%+v)", *p)
 }
 
-var fieldIDToName_InteractionServiceDeleteCommentArgs = map[int16]string{
+var fieldIDToName_InteractionServiceRemoveFromCollectionArgs = map[int16]string{
 	1: "req",
 }
 
-type InteractionServiceDeleteCommentResult struct {
-	Success *DeleteCommentResponse `thrift:"success,0,optional" frugal:"0,optional,DeleteCommentResponse" json:"success,omitempty"`
+type InteractionServiceRemoveFromCollectionResult struct {
+	Success *RemoveFromCollectionResponse `thrift:"success,0,optional" frugal:"0,optional,RemoveFromCollectionResponse" json:"success,omitempty"`
 }
 
-func NewInteractionServiceDeleteCommentResult() *InteractionServiceDeleteCommentResult {
-	return &InteractionServiceDeleteCommentResult{}
+func NewInteractionServiceRemoveFromCollectionResult() *InteractionServiceRemoveFromCollectionResult {
+	return &InteractionServiceRemoveFromCollectionResult{}
 }
 
-func (p *InteractionServiceDeleteCommentResult) InitDefault() {
+func (p *InteractionServiceRemoveFromCollectionResult) InitDefault() {
 }
 
-var InteractionServiceDeleteCommentResult_Success_DEFAULT *DeleteCommentResponse
+var InteractionServiceRemoveFromCollectionResult_Success_DEFAULT *RemoveFromCollectionResponse
 
-func (p *InteractionServiceDeleteCommentResult) GetSuccess() (v *DeleteCommentResponse) {
+func (p *InteractionServiceRemoveFromCollectionResult) GetSuccess() (v *RemoveFromCollectionResponse) {
 	if !p.IsSetSuccess() {
-		return InteractionServiceDeleteCommentResult_Success_DEFAULT
+		return InteractionServiceRemoveFromCollectionResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *InteractionServiceDeleteCommentResult) SetSuccess(x interface{}) {
-	p.Success = x.(*DeleteCommentResponse)
+func (p *InteractionServiceRemoveFromCollectionResult) SetSuccess(x interface{}) {
+	p.Success = x.(*RemoveFromCollectionResponse)
 }
 
-func (p *InteractionServiceDeleteCommentResult) IsSetSuccess() bool {
+func (p *InteractionServiceRemoveFromCollectionResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *InteractionServiceDeleteCommentResult) String() string {
+func (p *InteractionServiceRemoveFromCollectionResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("InteractionServiceDeleteCommentResult(%+v)", *p)
+	return fmt.Sprintf("InteractionServiceRemoveFromCollectionResult(%+v)", *p)
 }
 
-var fieldIDToName_InteractionServiceDeleteCommentResult = map[int16]string{
+var fieldIDToName_InteractionServiceRemoveFromCollectionResult = map[int16]string{
 	0: "success",
 }
 
-type InteractionServiceLikeCommentArgs struct {
-	Req *LikeCommentRequest `thrift:"req,1" frugal:"1,default,LikeCommentRequest" json:"req"`
+type InteractionServiceGetCollectionVideosArgs struct {
+	Req *GetCollectionVideosRequest `thrift:"req,1" frugal:"1,default,GetCollectionVideosRequest" json:"req"`
 }
 
-func NewInteractionServiceLikeCommentArgs() *InteractionServiceLikeCommentArgs {
-	return &InteractionServiceLikeCommentArgs{}
+func NewInteractionServiceGetCollectionVideosArgs() *InteractionServiceGetCollectionVideosArgs {
+	return &InteractionServiceGetCollectionVideosArgs{}
 }
 
-func (p *InteractionServiceLikeCommentArgs) InitDefault() {
+func (p *InteractionServiceGetCollectionVideosArgs) InitDefault() {
 }
 
-var InteractionServiceLikeCommentArgs_Req_DEFAULT *LikeCommentRequest
+var InteractionServiceGetCollectionVideosArgs_Req_DEFAULT *GetCollectionVideosRequest
 
-func (p *InteractionServiceLikeCommentArgs) GetReq() (v *LikeCommentRequest) {
+func (p *InteractionServiceGetCollectionVideosArgs) GetReq() (v *GetCollectionVideosRequest) {
 	if !p.IsSetReq() {
-		return InteractionServiceLikeCommentArgs_Req_DEFAULT
+		return InteractionServiceGetCollectionVideosArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *InteractionServiceLikeCommentArgs) SetReq(val *LikeCommentRequest) {
+func (p *InteractionServiceGetCollectionVideosArgs) SetReq(val *GetCollectionVideosRequest) {
 	p.Req = val
 }
 
-func (p *InteractionServiceLikeCommentArgs) IsSetReq() bool {
+func (p *InteractionServiceGetCollectionVideosArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *InteractionServiceLikeCommentArgs) String() string {
+func (p *InteractionServiceGetCollectionVideosArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("InteractionServiceLikeCommentArgs(%+v)", *p)
+	return fmt.Sprintf("InteractionServiceGetCollectionVideosArgs(%+v)", *p)
 }
 
-var fieldIDToName_InteractionServiceLikeCommentArgs = map[int16]string{
+var fieldIDToName_InteractionServiceGetCollectionVideosArgs = map[int16]string{
 	1: "req",
 }
 
-type InteractionServiceLikeCommentResult struct {
-	Success *LikeCommentResponse `thrift:"success,0,optional" frugal:"0,optional,LikeCommentResponse" json:"success,omitempty"`
+type InteractionServiceGetCollectionVideosResult struct {
+	Success *GetCollectionVideosResponse `thrift:"success,0,optional" frugal:"0,optional,GetCollectionVideosResponse" json:"success,omitempty"`
 }
 
-func NewInteractionServiceLikeCommentResult() *InteractionServiceLikeCommentResult {
-	return &InteractionServiceLikeCommentResult{}
+func NewInteractionServiceGetCollectionVideosResult() *InteractionServiceGetCollectionVideosResult {
+	return &InteractionServiceGetCollectionVideosResult{}
 }
 
-func (p *InteractionServiceLikeCommentResult) InitDefault() {
+func (p *InteractionServiceGetCollectionVideosResult) InitDefault() {
 }
 
-var InteractionServiceLikeCommentResult_Success_DEFAULT *LikeCommentResponse
+var InteractionServiceGetCollectionVideosResult_Success_DEFAULT *GetCollectionVideosResponse
 
-func (p *InteractionServiceLikeCommentResult) GetSuccess() (v *LikeCommentResponse) {
+func (p *InteractionServiceGetCollectionVideosResult) GetSuccess() (v *GetCollectionVideosResponse) {
 	if !p.IsSetSuccess() {
-		return InteractionServiceLikeCommentResult_Success_DEFAULT
+		return InteractionServiceGetCollectionVideosResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *InteractionServiceLikeCommentResult) SetSuccess(x interface{}) {
-	p.Success = x.(*LikeCommentResponse)
+func (p *InteractionServiceGetCollectionVideosResult) SetSuccess(x interface{}) {
+	p.Success = x.(*GetCollectionVideosResponse)
 }
 
-func (p *InteractionServiceLikeCommentResult) IsSetSuccess() bool {
+func (p *InteractionServiceGetCollectionVideosResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *InteractionServiceLikeCommentResult) String() string {
+func (p *InteractionServiceGetCollectionVideosResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("InteractionServiceLikeCommentResult(%+v)", *p)
+	return fmt.Sprintf("InteractionServiceGetCollectionVideosResult(%+v)", *p)
 }
 
-var fieldIDToName_InteractionServiceLikeCommentResult = map[int16]string{
+var fieldIDToName_InteractionServiceGetCollectionVideosResult = map[int16]string{
 	0: "success",
 }
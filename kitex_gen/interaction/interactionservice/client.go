@@ -17,6 +17,23 @@ type Client interface {
 	GetComments(ctx context.Context, req *interaction.GetCommentsRequest, callOptions ...callopt.Option) (r *interaction.GetCommentsResponse, err error)
 	DeleteComment(ctx context.Context, req *interaction.DeleteCommentRequest, callOptions ...callopt.Option) (r *interaction.DeleteCommentResponse, err error)
 	LikeComment(ctx context.Context, req *interaction.LikeCommentRequest, callOptions ...callopt.Option) (r *interaction.LikeCommentResponse, err error)
+	EditComment(ctx context.Context, req *interaction.EditCommentRequest, callOptions ...callopt.Option) (r *interaction.EditCommentResponse, err error)
+	GetCommentEditHistory(ctx context.Context, req *interaction.GetCommentEditHistoryRequest, callOptions ...callopt.Option) (r *interaction.GetCommentEditHistoryResponse, err error)
+	PinComment(ctx context.Context, req *interaction.PinCommentRequest, callOptions ...callopt.Option) (r *interaction.PinCommentResponse, err error)
+	HeartComment(ctx context.Context, req *interaction.HeartCommentRequest, callOptions ...callopt.Option) (r *interaction.HeartCommentResponse, err error)
+	React(ctx context.Context, req *interaction.ReactRequest, callOptions ...callopt.Option) (r *interaction.ReactResponse, err error)
+	GetReactions(ctx context.Context, req *interaction.GetReactionsRequest, callOptions ...callopt.Option) (r *interaction.GetReactionsResponse, err error)
+	GetInteractionState(ctx context.Context, req *interaction.GetInteractionStateRequest, callOptions ...callopt.Option) (r *interaction.GetInteractionStateResponse, err error)
+	GetFlaggedComments(ctx context.Context, req *interaction.GetFlaggedCommentsRequest, callOptions ...callopt.Option) (r *interaction.GetFlaggedCommentsResponse, err error)
+	ReviewComment(ctx context.Context, req *interaction.ReviewCommentRequest, callOptions ...callopt.Option) (r *interaction.ReviewCommentResponse, err error)
+	CreateCollection(ctx context.Context, req *interaction.CreateCollectionRequest, callOptions ...callopt.Option) (r *interaction.CreateCollectionResponse, err error)
+	UpdateCollection(ctx context.Context, req *interaction.UpdateCollectionRequest, callOptions ...callopt.Option) (r *interaction.UpdateCollectionResponse, err error)
+	DeleteCollection(ctx context.Context, req *interaction.DeleteCollectionRequest, callOptions ...callopt.Option) (r *interaction.DeleteCollectionResponse, err error)
+	ReorderCollections(ctx context.Context, req *interaction.ReorderCollectionsRequest, callOptions ...callopt.Option) (r *interaction.ReorderCollectionsResponse, err error)
+	GetCollections(ctx context.Context, req *interaction.GetCollectionsRequest, callOptions ...callopt.Option) (r *interaction.GetCollectionsResponse, err error)
+	AddToCollection(ctx context.Context, req *interaction.AddToCollectionRequest, callOptions ...callopt.Option) (r *interaction.AddToCollectionResponse, err error)
+	RemoveFromCollection(ctx context.Context, req *interaction.RemoveFromCollectionRequest, callOptions ...callopt.Option) (r *interaction.RemoveFromCollectionResponse, err error)
+	GetCollectionVideos(ctx context.Context, req *interaction.GetCollectionVideosRequest, callOptions ...callopt.Option) (r *interaction.GetCollectionVideosResponse, err error)
 }
 
 // NewClient creates a client for the service defined in IDL.
@@ -77,3 +94,88 @@ func (p *kInteractionServiceClient) LikeComment(ctx context.Context, req *intera
 	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
 	return p.kClient.LikeComment(ctx, req)
 }
+
+func (p *kInteractionServiceClient) EditComment(ctx context.Context, req *interaction.EditCommentRequest, callOptions ...callopt.Option) (r *interaction.EditCommentResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.EditComment(ctx, req)
+}
+
+func (p *kInteractionServiceClient) GetCommentEditHistory(ctx context.Context, req *interaction.GetCommentEditHistoryRequest, callOptions ...callopt.Option) (r *interaction.GetCommentEditHistoryResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.GetCommentEditHistory(ctx, req)
+}
+
+func (p *kInteractionServiceClient) PinComment(ctx context.Context, req *interaction.PinCommentRequest, callOptions ...callopt.Option) (r *interaction.PinCommentResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.PinComment(ctx, req)
+}
+
+func (p *kInteractionServiceClient) HeartComment(ctx context.Context, req *interaction.HeartCommentRequest, callOptions ...callopt.Option) (r *interaction.HeartCommentResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.HeartComment(ctx, req)
+}
+
+func (p *kInteractionServiceClient) React(ctx context.Context, req *interaction.ReactRequest, callOptions ...callopt.Option) (r *interaction.ReactResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.React(ctx, req)
+}
+
+func (p *kInteractionServiceClient) GetReactions(ctx context.Context, req *interaction.GetReactionsRequest, callOptions ...callopt.Option) (r *interaction.GetReactionsResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.GetReactions(ctx, req)
+}
+
+func (p *kInteractionServiceClient) GetInteractionState(ctx context.Context, req *interaction.GetInteractionStateRequest, callOptions ...callopt.Option) (r *interaction.GetInteractionStateResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.GetInteractionState(ctx, req)
+}
+
+func (p *kInteractionServiceClient) GetFlaggedComments(ctx context.Context, req *interaction.GetFlaggedCommentsRequest, callOptions ...callopt.Option) (r *interaction.GetFlaggedCommentsResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.GetFlaggedComments(ctx, req)
+}
+
+func (p *kInteractionServiceClient) ReviewComment(ctx context.Context, req *interaction.ReviewCommentRequest, callOptions ...callopt.Option) (r *interaction.ReviewCommentResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ReviewComment(ctx, req)
+}
+
+func (p *kInteractionServiceClient) CreateCollection(ctx context.Context, req *interaction.CreateCollectionRequest, callOptions ...callopt.Option) (r *interaction.CreateCollectionResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.CreateCollection(ctx, req)
+}
+
+func (p *kInteractionServiceClient) UpdateCollection(ctx context.Context, req *interaction.UpdateCollectionRequest, callOptions ...callopt.Option) (r *interaction.UpdateCollectionResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.UpdateCollection(ctx, req)
+}
+
+func (p *kInteractionServiceClient) DeleteCollection(ctx context.Context, req *interaction.DeleteCollectionRequest, callOptions ...callopt.Option) (r *interaction.DeleteCollectionResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.DeleteCollection(ctx, req)
+}
+
+func (p *kInteractionServiceClient) ReorderCollections(ctx context.Context, req *interaction.ReorderCollectionsRequest, callOptions ...callopt.Option) (r *interaction.ReorderCollectionsResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ReorderCollections(ctx, req)
+}
+
+func (p *kInteractionServiceClient) GetCollections(ctx context.Context, req *interaction.GetCollectionsRequest, callOptions ...callopt.Option) (r *interaction.GetCollectionsResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.GetCollections(ctx, req)
+}
+
+func (p *kInteractionServiceClient) AddToCollection(ctx context.Context, req *interaction.AddToCollectionRequest, callOptions ...callopt.Option) (r *interaction.AddToCollectionResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.AddToCollection(ctx, req)
+}
+
+func (p *kInteractionServiceClient) RemoveFromCollection(ctx context.Context, req *interaction.RemoveFromCollectionRequest, callOptions ...callopt.Option) (r *interaction.RemoveFromCollectionResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.RemoveFromCollection(ctx, req)
+}
+
+func (p *kInteractionServiceClient) GetCollectionVideos(ctx context.Context, req *interaction.GetCollectionVideosRequest, callOptions ...callopt.Option) (r *interaction.GetCollectionVideosResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.GetCollectionVideos(ctx, req)
+}
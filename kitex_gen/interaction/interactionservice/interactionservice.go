@@ -55,6 +55,125 @@ var serviceMethods = map[string]kitex.MethodInfo{
 		false,
 		kitex.WithStreamingMode(kitex.StreamingNone),
 	),
+	"EditComment": kitex.NewMethodInfo(
+		editCommentHandler,
+		newInteractionServiceEditCommentArgs,
+		newInteractionServiceEditCommentResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"GetCommentEditHistory": kitex.NewMethodInfo(
+		getCommentEditHistoryHandler,
+		newInteractionServiceGetCommentEditHistoryArgs,
+		newInteractionServiceGetCommentEditHistoryResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"PinComment": kitex.NewMethodInfo(
+		pinCommentHandler,
+		newInteractionServicePinCommentArgs,
+		newInteractionServicePinCommentResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"HeartComment": kitex.NewMethodInfo(
+		heartCommentHandler,
+		newInteractionServiceHeartCommentArgs,
+		newInteractionServiceHeartCommentResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"React": kitex.NewMethodInfo(
+		reactHandler,
+		newInteractionServiceReactArgs,
+		newInteractionServiceReactResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"GetReactions": kitex.NewMethodInfo(
+		getReactionsHandler,
+		newInteractionServiceGetReactionsArgs,
+		newInteractionServiceGetReactionsResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"GetInteractionState": kitex.NewMethodInfo(
+		getInteractionStateHandler,
+		newInteractionServiceGetInteractionStateArgs,
+		newInteractionServiceGetInteractionStateResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"GetFlaggedComments": kitex.NewMethodInfo(
+		getFlaggedCommentsHandler,
+		newInteractionServiceGetFlaggedCommentsArgs,
+		newInteractionServiceGetFlaggedCommentsResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"ReviewComment": kitex.NewMethodInfo(
+		reviewCommentHandler,
+		newInteractionServiceReviewCommentArgs,
+		newInteractionServiceReviewCommentResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"CreateCollection": kitex.NewMethodInfo(
+		createCollectionHandler,
+		newInteractionServiceCreateCollectionArgs,
+		newInteractionServiceCreateCollectionResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"UpdateCollection": kitex.NewMethodInfo(
+		updateCollectionHandler,
+		newInteractionServiceUpdateCollectionArgs,
+		newInteractionServiceUpdateCollectionResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"DeleteCollection": kitex.NewMethodInfo(
+		deleteCollectionHandler,
+		newInteractionServiceDeleteCollectionArgs,
+		newInteractionServiceDeleteCollectionResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"ReorderCollections": kitex.NewMethodInfo(
+		reorderCollectionsHandler,
+		newInteractionServiceReorderCollectionsArgs,
+		newInteractionServiceReorderCollectionsResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"GetCollections": kitex.NewMethodInfo(
+		getCollectionsHandler,
+		newInteractionServiceGetCollectionsArgs,
+		newInteractionServiceGetCollectionsResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"AddToCollection": kitex.NewMethodInfo(
+		addToCollectionHandler,
+		newInteractionServiceAddToCollectionArgs,
+		newInteractionServiceAddToCollectionResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"RemoveFromCollection": kitex.NewMethodInfo(
+		removeFromCollectionHandler,
+		newInteractionServiceRemoveFromCollectionArgs,
+		newInteractionServiceRemoveFromCollectionResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"GetCollectionVideos": kitex.NewMethodInfo(
+		getCollectionVideosHandler,
+		newInteractionServiceGetCollectionVideosArgs,
+		newInteractionServiceGetCollectionVideosResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
 }
 
 var (
@@ -229,6 +348,312 @@ func newInteractionServiceLikeCommentResult() interface{} {
 	return interaction.NewInteractionServiceLikeCommentResult()
 }
 
+func editCommentHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*interaction.InteractionServiceEditCommentArgs)
+	realResult := result.(*interaction.InteractionServiceEditCommentResult)
+	success, err := handler.(interaction.InteractionService).EditComment(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newInteractionServiceEditCommentArgs() interface{} {
+	return interaction.NewInteractionServiceEditCommentArgs()
+}
+
+func newInteractionServiceEditCommentResult() interface{} {
+	return interaction.NewInteractionServiceEditCommentResult()
+}
+
+func getCommentEditHistoryHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*interaction.InteractionServiceGetCommentEditHistoryArgs)
+	realResult := result.(*interaction.InteractionServiceGetCommentEditHistoryResult)
+	success, err := handler.(interaction.InteractionService).GetCommentEditHistory(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newInteractionServiceGetCommentEditHistoryArgs() interface{} {
+	return interaction.NewInteractionServiceGetCommentEditHistoryArgs()
+}
+
+func newInteractionServiceGetCommentEditHistoryResult() interface{} {
+	return interaction.NewInteractionServiceGetCommentEditHistoryResult()
+}
+
+func pinCommentHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*interaction.InteractionServicePinCommentArgs)
+	realResult := result.(*interaction.InteractionServicePinCommentResult)
+	success, err := handler.(interaction.InteractionService).PinComment(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newInteractionServicePinCommentArgs() interface{} {
+	return interaction.NewInteractionServicePinCommentArgs()
+}
+
+func newInteractionServicePinCommentResult() interface{} {
+	return interaction.NewInteractionServicePinCommentResult()
+}
+
+func heartCommentHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*interaction.InteractionServiceHeartCommentArgs)
+	realResult := result.(*interaction.InteractionServiceHeartCommentResult)
+	success, err := handler.(interaction.InteractionService).HeartComment(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newInteractionServiceHeartCommentArgs() interface{} {
+	return interaction.NewInteractionServiceHeartCommentArgs()
+}
+
+func newInteractionServiceHeartCommentResult() interface{} {
+	return interaction.NewInteractionServiceHeartCommentResult()
+}
+
+func reactHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*interaction.InteractionServiceReactArgs)
+	realResult := result.(*interaction.InteractionServiceReactResult)
+	success, err := handler.(interaction.InteractionService).React(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newInteractionServiceReactArgs() interface{} {
+	return interaction.NewInteractionServiceReactArgs()
+}
+
+func newInteractionServiceReactResult() interface{} {
+	return interaction.NewInteractionServiceReactResult()
+}
+
+func getReactionsHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*interaction.InteractionServiceGetReactionsArgs)
+	realResult := result.(*interaction.InteractionServiceGetReactionsResult)
+	success, err := handler.(interaction.InteractionService).GetReactions(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newInteractionServiceGetReactionsArgs() interface{} {
+	return interaction.NewInteractionServiceGetReactionsArgs()
+}
+
+func newInteractionServiceGetReactionsResult() interface{} {
+	return interaction.NewInteractionServiceGetReactionsResult()
+}
+
+func getInteractionStateHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*interaction.InteractionServiceGetInteractionStateArgs)
+	realResult := result.(*interaction.InteractionServiceGetInteractionStateResult)
+	success, err := handler.(interaction.InteractionService).GetInteractionState(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newInteractionServiceGetInteractionStateArgs() interface{} {
+	return interaction.NewInteractionServiceGetInteractionStateArgs()
+}
+
+func newInteractionServiceGetInteractionStateResult() interface{} {
+	return interaction.NewInteractionServiceGetInteractionStateResult()
+}
+
+func getFlaggedCommentsHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*interaction.InteractionServiceGetFlaggedCommentsArgs)
+	realResult := result.(*interaction.InteractionServiceGetFlaggedCommentsResult)
+	success, err := handler.(interaction.InteractionService).GetFlaggedComments(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newInteractionServiceGetFlaggedCommentsArgs() interface{} {
+	return interaction.NewInteractionServiceGetFlaggedCommentsArgs()
+}
+
+func newInteractionServiceGetFlaggedCommentsResult() interface{} {
+	return interaction.NewInteractionServiceGetFlaggedCommentsResult()
+}
+
+func reviewCommentHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*interaction.InteractionServiceReviewCommentArgs)
+	realResult := result.(*interaction.InteractionServiceReviewCommentResult)
+	success, err := handler.(interaction.InteractionService).ReviewComment(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newInteractionServiceReviewCommentArgs() interface{} {
+	return interaction.NewInteractionServiceReviewCommentArgs()
+}
+
+func newInteractionServiceReviewCommentResult() interface{} {
+	return interaction.NewInteractionServiceReviewCommentResult()
+}
+
+func createCollectionHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*interaction.InteractionServiceCreateCollectionArgs)
+	realResult := result.(*interaction.InteractionServiceCreateCollectionResult)
+	success, err := handler.(interaction.InteractionService).CreateCollection(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newInteractionServiceCreateCollectionArgs() interface{} {
+	return interaction.NewInteractionServiceCreateCollectionArgs()
+}
+
+func newInteractionServiceCreateCollectionResult() interface{} {
+	return interaction.NewInteractionServiceCreateCollectionResult()
+}
+
+func updateCollectionHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*interaction.InteractionServiceUpdateCollectionArgs)
+	realResult := result.(*interaction.InteractionServiceUpdateCollectionResult)
+	success, err := handler.(interaction.InteractionService).UpdateCollection(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newInteractionServiceUpdateCollectionArgs() interface{} {
+	return interaction.NewInteractionServiceUpdateCollectionArgs()
+}
+
+func newInteractionServiceUpdateCollectionResult() interface{} {
+	return interaction.NewInteractionServiceUpdateCollectionResult()
+}
+
+func deleteCollectionHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*interaction.InteractionServiceDeleteCollectionArgs)
+	realResult := result.(*interaction.InteractionServiceDeleteCollectionResult)
+	success, err := handler.(interaction.InteractionService).DeleteCollection(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newInteractionServiceDeleteCollectionArgs() interface{} {
+	return interaction.NewInteractionServiceDeleteCollectionArgs()
+}
+
+func newInteractionServiceDeleteCollectionResult() interface{} {
+	return interaction.NewInteractionServiceDeleteCollectionResult()
+}
+
+func reorderCollectionsHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*interaction.InteractionServiceReorderCollectionsArgs)
+	realResult := result.(*interaction.InteractionServiceReorderCollectionsResult)
+	success, err := handler.(interaction.InteractionService).ReorderCollections(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newInteractionServiceReorderCollectionsArgs() interface{} {
+	return interaction.NewInteractionServiceReorderCollectionsArgs()
+}
+
+func newInteractionServiceReorderCollectionsResult() interface{} {
+	return interaction.NewInteractionServiceReorderCollectionsResult()
+}
+
+func getCollectionsHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*interaction.InteractionServiceGetCollectionsArgs)
+	realResult := result.(*interaction.InteractionServiceGetCollectionsResult)
+	success, err := handler.(interaction.InteractionService).GetCollections(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newInteractionServiceGetCollectionsArgs() interface{} {
+	return interaction.NewInteractionServiceGetCollectionsArgs()
+}
+
+func newInteractionServiceGetCollectionsResult() interface{} {
+	return interaction.NewInteractionServiceGetCollectionsResult()
+}
+
+func addToCollectionHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*interaction.InteractionServiceAddToCollectionArgs)
+	realResult := result.(*interaction.InteractionServiceAddToCollectionResult)
+	success, err := handler.(interaction.InteractionService).AddToCollection(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newInteractionServiceAddToCollectionArgs() interface{} {
+	return interaction.NewInteractionServiceAddToCollectionArgs()
+}
+
+func newInteractionServiceAddToCollectionResult() interface{} {
+	return interaction.NewInteractionServiceAddToCollectionResult()
+}
+
+func removeFromCollectionHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*interaction.InteractionServiceRemoveFromCollectionArgs)
+	realResult := result.(*interaction.InteractionServiceRemoveFromCollectionResult)
+	success, err := handler.(interaction.InteractionService).RemoveFromCollection(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newInteractionServiceRemoveFromCollectionArgs() interface{} {
+	return interaction.NewInteractionServiceRemoveFromCollectionArgs()
+}
+
+func newInteractionServiceRemoveFromCollectionResult() interface{} {
+	return interaction.NewInteractionServiceRemoveFromCollectionResult()
+}
+
+func getCollectionVideosHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*interaction.InteractionServiceGetCollectionVideosArgs)
+	realResult := result.(*interaction.InteractionServiceGetCollectionVideosResult)
+	success, err := handler.(interaction.InteractionService).GetCollectionVideos(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newInteractionServiceGetCollectionVideosArgs() interface{} {
+	return interaction.NewInteractionServiceGetCollectionVideosArgs()
+}
+
+func newInteractionServiceGetCollectionVideosResult() interface{} {
+	return interaction.NewInteractionServiceGetCollectionVideosResult()
+}
+
 type kClient struct {
 	c client.Client
 }
@@ -298,3 +723,173 @@ func (p *kClient) LikeComment(ctx context.Context, req *interaction.LikeCommentR
 	}
 	return _result.GetSuccess(), nil
 }
+
+func (p *kClient) EditComment(ctx context.Context, req *interaction.EditCommentRequest) (r *interaction.EditCommentResponse, err error) {
+	var _args interaction.InteractionServiceEditCommentArgs
+	_args.Req = req
+	var _result interaction.InteractionServiceEditCommentResult
+	if err = p.c.Call(ctx, "EditComment", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) GetCommentEditHistory(ctx context.Context, req *interaction.GetCommentEditHistoryRequest) (r *interaction.GetCommentEditHistoryResponse, err error) {
+	var _args interaction.InteractionServiceGetCommentEditHistoryArgs
+	_args.Req = req
+	var _result interaction.InteractionServiceGetCommentEditHistoryResult
+	if err = p.c.Call(ctx, "GetCommentEditHistory", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) PinComment(ctx context.Context, req *interaction.PinCommentRequest) (r *interaction.PinCommentResponse, err error) {
+	var _args interaction.InteractionServicePinCommentArgs
+	_args.Req = req
+	var _result interaction.InteractionServicePinCommentResult
+	if err = p.c.Call(ctx, "PinComment", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) HeartComment(ctx context.Context, req *interaction.HeartCommentRequest) (r *interaction.HeartCommentResponse, err error) {
+	var _args interaction.InteractionServiceHeartCommentArgs
+	_args.Req = req
+	var _result interaction.InteractionServiceHeartCommentResult
+	if err = p.c.Call(ctx, "HeartComment", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) React(ctx context.Context, req *interaction.ReactRequest) (r *interaction.ReactResponse, err error) {
+	var _args interaction.InteractionServiceReactArgs
+	_args.Req = req
+	var _result interaction.InteractionServiceReactResult
+	if err = p.c.Call(ctx, "React", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) GetReactions(ctx context.Context, req *interaction.GetReactionsRequest) (r *interaction.GetReactionsResponse, err error) {
+	var _args interaction.InteractionServiceGetReactionsArgs
+	_args.Req = req
+	var _result interaction.InteractionServiceGetReactionsResult
+	if err = p.c.Call(ctx, "GetReactions", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) GetInteractionState(ctx context.Context, req *interaction.GetInteractionStateRequest) (r *interaction.GetInteractionStateResponse, err error) {
+	var _args interaction.InteractionServiceGetInteractionStateArgs
+	_args.Req = req
+	var _result interaction.InteractionServiceGetInteractionStateResult
+	if err = p.c.Call(ctx, "GetInteractionState", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) GetFlaggedComments(ctx context.Context, req *interaction.GetFlaggedCommentsRequest) (r *interaction.GetFlaggedCommentsResponse, err error) {
+	var _args interaction.InteractionServiceGetFlaggedCommentsArgs
+	_args.Req = req
+	var _result interaction.InteractionServiceGetFlaggedCommentsResult
+	if err = p.c.Call(ctx, "GetFlaggedComments", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) ReviewComment(ctx context.Context, req *interaction.ReviewCommentRequest) (r *interaction.ReviewCommentResponse, err error) {
+	var _args interaction.InteractionServiceReviewCommentArgs
+	_args.Req = req
+	var _result interaction.InteractionServiceReviewCommentResult
+	if err = p.c.Call(ctx, "ReviewComment", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) CreateCollection(ctx context.Context, req *interaction.CreateCollectionRequest) (r *interaction.CreateCollectionResponse, err error) {
+	var _args interaction.InteractionServiceCreateCollectionArgs
+	_args.Req = req
+	var _result interaction.InteractionServiceCreateCollectionResult
+	if err = p.c.Call(ctx, "CreateCollection", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) UpdateCollection(ctx context.Context, req *interaction.UpdateCollectionRequest) (r *interaction.UpdateCollectionResponse, err error) {
+	var _args interaction.InteractionServiceUpdateCollectionArgs
+	_args.Req = req
+	var _result interaction.InteractionServiceUpdateCollectionResult
+	if err = p.c.Call(ctx, "UpdateCollection", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) DeleteCollection(ctx context.Context, req *interaction.DeleteCollectionRequest) (r *interaction.DeleteCollectionResponse, err error) {
+	var _args interaction.InteractionServiceDeleteCollectionArgs
+	_args.Req = req
+	var _result interaction.InteractionServiceDeleteCollectionResult
+	if err = p.c.Call(ctx, "DeleteCollection", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) ReorderCollections(ctx context.Context, req *interaction.ReorderCollectionsRequest) (r *interaction.ReorderCollectionsResponse, err error) {
+	var _args interaction.InteractionServiceReorderCollectionsArgs
+	_args.Req = req
+	var _result interaction.InteractionServiceReorderCollectionsResult
+	if err = p.c.Call(ctx, "ReorderCollections", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) GetCollections(ctx context.Context, req *interaction.GetCollectionsRequest) (r *interaction.GetCollectionsResponse, err error) {
+	var _args interaction.InteractionServiceGetCollectionsArgs
+	_args.Req = req
+	var _result interaction.InteractionServiceGetCollectionsResult
+	if err = p.c.Call(ctx, "GetCollections", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) AddToCollection(ctx context.Context, req *interaction.AddToCollectionRequest) (r *interaction.AddToCollectionResponse, err error) {
+	var _args interaction.InteractionServiceAddToCollectionArgs
+	_args.Req = req
+	var _result interaction.InteractionServiceAddToCollectionResult
+	if err = p.c.Call(ctx, "AddToCollection", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) RemoveFromCollection(ctx context.Context, req *interaction.RemoveFromCollectionRequest) (r *interaction.RemoveFromCollectionResponse, err error) {
+	var _args interaction.InteractionServiceRemoveFromCollectionArgs
+	_args.Req = req
+	var _result interaction.InteractionServiceRemoveFromCollectionResult
+	if err = p.c.Call(ctx, "RemoveFromCollection", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) GetCollectionVideos(ctx context.Context, req *interaction.GetCollectionVideosRequest) (r *interaction.GetCollectionVideosResponse, err error) {
+	var _args interaction.InteractionServiceGetCollectionVideosArgs
+	_args.Req = req
+	var _result interaction.InteractionServiceGetCollectionVideosResult
+	if err = p.c.Call(ctx, "GetCollectionVideos", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
@@ -190,6 +190,201 @@ var fieldIDToName_User = map[int16]string{
 	10: "videoCount",
 }
 
+type FollowUser struct {
+	Id         int64   `thrift:"id,1,required" frugal:"1,required,i64" json:"id"`
+	Username   string  `thrift:"username,2,required" frugal:"2,required,string" json:"username"`
+	Avatar     *string `thrift:"avatar,3,optional" frugal:"3,optional,string" json:"avatar,omitempty"`
+	FollowedAt int64   `thrift:"followed_at,4,required" frugal:"4,required,i64" json:"followed_at"`
+	IsMutual   bool    `thrift:"is_mutual,5,required" frugal:"5,required,bool" json:"is_mutual"`
+}
+
+func NewFollowUser() *FollowUser {
+	return &FollowUser{}
+}
+
+func (p *FollowUser) InitDefault() {
+}
+
+func (p *FollowUser) GetId() (v int64) {
+	return p.Id
+}
+
+func (p *FollowUser) GetUsername() (v string) {
+	return p.Username
+}
+
+var FollowUser_Avatar_DEFAULT string
+
+func (p *FollowUser) GetAvatar() (v string) {
+	if !p.IsSetAvatar() {
+		return FollowUser_Avatar_DEFAULT
+	}
+	return *p.Avatar
+}
+
+func (p *FollowUser) GetFollowedAt() (v int64) {
+	return p.FollowedAt
+}
+
+func (p *FollowUser) GetIsMutual() (v bool) {
+	return p.IsMutual
+}
+func (p *FollowUser) SetId(val int64) {
+	p.Id = val
+}
+func (p *FollowUser) SetUsername(val string) {
+	p.Username = val
+}
+func (p *FollowUser) SetAvatar(val *string) {
+	p.Avatar = val
+}
+func (p *FollowUser) SetFollowedAt(val int64) {
+	p.FollowedAt = val
+}
+func (p *FollowUser) SetIsMutual(val bool) {
+	p.IsMutual = val
+}
+
+func (p *FollowUser) IsSetAvatar() bool {
+	return p.Avatar != nil
+}
+
+func (p *FollowUser) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("FollowUser(%+v)", *p)
+}
+
+var fieldIDToName_FollowUser = map[int16]string{
+	1: "id",
+	2: "username",
+	3: "avatar",
+	4: "followed_at",
+	5: "is_mutual",
+}
+
+type BlockedUser struct {
+	Id        int64   `thrift:"id,1,required" frugal:"1,required,i64" json:"id"`
+	Username  string  `thrift:"username,2,required" frugal:"2,required,string" json:"username"`
+	Avatar    *string `thrift:"avatar,3,optional" frugal:"3,optional,string" json:"avatar,omitempty"`
+	BlockedAt int64   `thrift:"blocked_at,4,required" frugal:"4,required,i64" json:"blocked_at"`
+}
+
+func NewBlockedUser() *BlockedUser {
+	return &BlockedUser{}
+}
+
+func (p *BlockedUser) InitDefault() {
+}
+
+func (p *BlockedUser) GetId() (v int64) {
+	return p.Id
+}
+
+func (p *BlockedUser) GetUsername() (v string) {
+	return p.Username
+}
+
+var BlockedUser_Avatar_DEFAULT string
+
+func (p *BlockedUser) GetAvatar() (v string) {
+	if !p.IsSetAvatar() {
+		return BlockedUser_Avatar_DEFAULT
+	}
+	return *p.Avatar
+}
+
+func (p *BlockedUser) GetBlockedAt() (v int64) {
+	return p.BlockedAt
+}
+func (p *BlockedUser) SetId(val int64) {
+	p.Id = val
+}
+func (p *BlockedUser) SetUsername(val string) {
+	p.Username = val
+}
+func (p *BlockedUser) SetAvatar(val *string) {
+	p.Avatar = val
+}
+func (p *BlockedUser) SetBlockedAt(val int64) {
+	p.BlockedAt = val
+}
+
+func (p *BlockedUser) IsSetAvatar() bool {
+	return p.Avatar != nil
+}
+
+func (p *BlockedUser) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("BlockedUser(%+v)", *p)
+}
+
+var fieldIDToName_BlockedUser = map[int16]string{
+	1: "id",
+	2: "username",
+	3: "avatar",
+	4: "blocked_at",
+}
+
+type PrivacySettings struct {
+	DmPolicy              int8 `thrift:"dm_policy,1,required" frugal:"1,required,i8" json:"dm_policy"`
+	LikesVisibility       int8 `thrift:"likes_visibility,2,required" frugal:"2,required,i8" json:"likes_visibility"`
+	CollectionsVisibility int8 `thrift:"collections_visibility,3,required" frugal:"3,required,i8" json:"collections_visibility"`
+	HideOnline            bool `thrift:"hide_online,4,required" frugal:"4,required,bool" json:"hide_online"`
+}
+
+func NewPrivacySettings() *PrivacySettings {
+	return &PrivacySettings{}
+}
+
+func (p *PrivacySettings) InitDefault() {
+}
+
+func (p *PrivacySettings) GetDmPolicy() (v int8) {
+	return p.DmPolicy
+}
+
+func (p *PrivacySettings) GetLikesVisibility() (v int8) {
+	return p.LikesVisibility
+}
+
+func (p *PrivacySettings) GetCollectionsVisibility() (v int8) {
+	return p.CollectionsVisibility
+}
+
+func (p *PrivacySettings) GetHideOnline() (v bool) {
+	return p.HideOnline
+}
+func (p *PrivacySettings) SetDmPolicy(val int8) {
+	p.DmPolicy = val
+}
+func (p *PrivacySettings) SetLikesVisibility(val int8) {
+	p.LikesVisibility = val
+}
+func (p *PrivacySettings) SetCollectionsVisibility(val int8) {
+	p.CollectionsVisibility = val
+}
+func (p *PrivacySettings) SetHideOnline(val bool) {
+	p.HideOnline = val
+}
+
+func (p *PrivacySettings) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("PrivacySettings(%+v)", *p)
+}
+
+var fieldIDToName_PrivacySettings = map[int16]string{
+	1: "dm_policy",
+	2: "likes_visibility",
+	3: "collections_visibility",
+	4: "hide_online",
+}
+
 type Video struct {
 	Id            int64   `thrift:"id,1,required" frugal:"1,required,i64" json:"id"`
 	AuthorId      int64   `thrift:"authorId,2,required" frugal:"2,required,i64" json:"authorId"`
@@ -203,6 +398,8 @@ type Video struct {
 	Description   *string `thrift:"description,10,optional" frugal:"10,optional,string" json:"description,omitempty"`
 	CreatedAt     *int64  `thrift:"createdAt,11,optional" frugal:"11,optional,i64" json:"createdAt,omitempty"`
 	UpdatedAt     *int64  `thrift:"updatedAt,12,optional" frugal:"12,optional,i64" json:"updatedAt,omitempty"`
+	CollectCount  *int64  `thrift:"collectCount,13,optional" frugal:"13,optional,i64" json:"collectCount,omitempty"`
+	IsCollected   *bool   `thrift:"isCollected,14,optional" frugal:"14,optional,bool" json:"isCollected,omitempty"`
 }
 
 func NewVideo() *Video {
@@ -294,6 +491,24 @@ func (p *Video) GetUpdatedAt() (v int64) {
 	}
 	return *p.UpdatedAt
 }
+
+var Video_CollectCount_DEFAULT int64
+
+func (p *Video) GetCollectCount() (v int64) {
+	if !p.IsSetCollectCount() {
+		return Video_CollectCount_DEFAULT
+	}
+	return *p.CollectCount
+}
+
+var Video_IsCollected_DEFAULT bool
+
+func (p *Video) GetIsCollected() (v bool) {
+	if !p.IsSetIsCollected() {
+		return Video_IsCollected_DEFAULT
+	}
+	return *p.IsCollected
+}
 func (p *Video) SetId(val int64) {
 	p.Id = val
 }
@@ -330,6 +545,12 @@ func (p *Video) SetCreatedAt(val *int64) {
 func (p *Video) SetUpdatedAt(val *int64) {
 	p.UpdatedAt = val
 }
+func (p *Video) SetCollectCount(val *int64) {
+	p.CollectCount = val
+}
+func (p *Video) SetIsCollected(val *bool) {
+	p.IsCollected = val
+}
 
 func (p *Video) IsSetFavoriteCount() bool {
 	return p.FavoriteCount != nil
@@ -359,6 +580,14 @@ func (p *Video) IsSetUpdatedAt() bool {
 	return p.UpdatedAt != nil
 }
 
+func (p *Video) IsSetCollectCount() bool {
+	return p.CollectCount != nil
+}
+
+func (p *Video) IsSetIsCollected() bool {
+	return p.IsCollected != nil
+}
+
 func (p *Video) String() string {
 	if p == nil {
 		return "<nil>"
@@ -379,16 +608,20 @@ var fieldIDToName_Video = map[int16]string{
 	10: "description",
 	11: "createdAt",
 	12: "updatedAt",
+	13: "collectCount",
+	14: "isCollected",
 }
 
 type Comment struct {
-	Id        int64  `thrift:"id,1,required" frugal:"1,required,i64" json:"id"`
-	UserId    int64  `thrift:"userId,2,required" frugal:"2,required,i64" json:"userId"`
-	VideoId   int64  `thrift:"videoId,3,required" frugal:"3,required,i64" json:"videoId"`
-	Content   string `thrift:"content,4,required" frugal:"4,required,string" json:"content"`
-	User      *User  `thrift:"user,5,optional" frugal:"5,optional,User" json:"user,omitempty"`
-	CreatedAt *int64 `thrift:"createdAt,6,optional" frugal:"6,optional,i64" json:"createdAt,omitempty"`
-	UpdatedAt *int64 `thrift:"updatedAt,7,optional" frugal:"7,optional,i64" json:"updatedAt,omitempty"`
+	Id               int64   `thrift:"id,1,required" frugal:"1,required,i64" json:"id"`
+	UserId           int64   `thrift:"userId,2,required" frugal:"2,required,i64" json:"userId"`
+	VideoId          int64   `thrift:"videoId,3,required" frugal:"3,required,i64" json:"videoId"`
+	Content          string  `thrift:"content,4,required" frugal:"4,required,string" json:"content"`
+	User             *User   `thrift:"user,5,optional" frugal:"5,optional,User" json:"user,omitempty"`
+	CreatedAt        *int64  `thrift:"createdAt,6,optional" frugal:"6,optional,i64" json:"createdAt,omitempty"`
+	UpdatedAt        *int64  `thrift:"updatedAt,7,optional" frugal:"7,optional,i64" json:"updatedAt,omitempty"`
+	Status           *int8   `thrift:"status,8,optional" frugal:"8,optional,i8" json:"status,omitempty"`
+	ModerationReason *string `thrift:"moderationReason,9,optional" frugal:"9,optional,string" json:"moderationReason,omitempty"`
 }
 
 func NewComment() *Comment {
@@ -440,6 +673,24 @@ func (p *Comment) GetUpdatedAt() (v int64) {
 	}
 	return *p.UpdatedAt
 }
+
+var Comment_Status_DEFAULT int8
+
+func (p *Comment) GetStatus() (v int8) {
+	if !p.IsSetStatus() {
+		return Comment_Status_DEFAULT
+	}
+	return *p.Status
+}
+
+var Comment_ModerationReason_DEFAULT string
+
+func (p *Comment) GetModerationReason() (v string) {
+	if !p.IsSetModerationReason() {
+		return Comment_ModerationReason_DEFAULT
+	}
+	return *p.ModerationReason
+}
 func (p *Comment) SetId(val int64) {
 	p.Id = val
 }
@@ -461,6 +712,12 @@ func (p *Comment) SetCreatedAt(val *int64) {
 func (p *Comment) SetUpdatedAt(val *int64) {
 	p.UpdatedAt = val
 }
+func (p *Comment) SetStatus(val *int8) {
+	p.Status = val
+}
+func (p *Comment) SetModerationReason(val *string) {
+	p.ModerationReason = val
+}
 
 func (p *Comment) IsSetUser() bool {
 	return p.User != nil
@@ -474,6 +731,14 @@ func (p *Comment) IsSetUpdatedAt() bool {
 	return p.UpdatedAt != nil
 }
 
+func (p *Comment) IsSetStatus() bool {
+	return p.Status != nil
+}
+
+func (p *Comment) IsSetModerationReason() bool {
+	return p.ModerationReason != nil
+}
+
 func (p *Comment) String() string {
 	if p == nil {
 		return "<nil>"
@@ -489,6 +754,8 @@ var fieldIDToName_Comment = map[int16]string{
 	5: "user",
 	6: "createdAt",
 	7: "updatedAt",
+	8: "status",
+	9: "moderationReason",
 }
 
 type LikeInfo struct {
@@ -575,6 +842,10 @@ type CommentInfo struct {
 	DeletedAt *int64 `thrift:"deleted_at,7,optional" frugal:"7,optional,i64" json:"deleted_at,omitempty"`
 	LikeCount *int32 `thrift:"like_count,8,optional" frugal:"8,optional,i32" json:"like_count,omitempty"`
 	IsLiked   *bool  `thrift:"is_liked,9,optional" frugal:"9,optional,bool" json:"is_liked,omitempty"`
+	IsEdited  *bool  `thrift:"is_edited,10,optional" frugal:"10,optional,bool" json:"is_edited,omitempty"`
+	EditedAt  *int64 `thrift:"edited_at,11,optional" frugal:"11,optional,i64" json:"edited_at,omitempty"`
+	IsPinned  *bool  `thrift:"is_pinned,12,optional" frugal:"12,optional,bool" json:"is_pinned,omitempty"`
+	IsHearted *bool  `thrift:"is_hearted,13,optional" frugal:"13,optional,bool" json:"is_hearted,omitempty"`
 }
 
 func NewCommentInfo() *CommentInfo {
@@ -639,6 +910,42 @@ func (p *CommentInfo) GetIsLiked() (v bool) {
 	}
 	return *p.IsLiked
 }
+
+var CommentInfo_IsEdited_DEFAULT bool
+
+func (p *CommentInfo) GetIsEdited() (v bool) {
+	if !p.IsSetIsEdited() {
+		return CommentInfo_IsEdited_DEFAULT
+	}
+	return *p.IsEdited
+}
+
+var CommentInfo_EditedAt_DEFAULT int64
+
+func (p *CommentInfo) GetEditedAt() (v int64) {
+	if !p.IsSetEditedAt() {
+		return CommentInfo_EditedAt_DEFAULT
+	}
+	return *p.EditedAt
+}
+
+var CommentInfo_IsPinned_DEFAULT bool
+
+func (p *CommentInfo) GetIsPinned() (v bool) {
+	if !p.IsSetIsPinned() {
+		return CommentInfo_IsPinned_DEFAULT
+	}
+	return *p.IsPinned
+}
+
+var CommentInfo_IsHearted_DEFAULT bool
+
+func (p *CommentInfo) GetIsHearted() (v bool) {
+	if !p.IsSetIsHearted() {
+		return CommentInfo_IsHearted_DEFAULT
+	}
+	return *p.IsHearted
+}
 func (p *CommentInfo) SetId(val int64) {
 	p.Id = val
 }
@@ -666,6 +973,18 @@ func (p *CommentInfo) SetLikeCount(val *int32) {
 func (p *CommentInfo) SetIsLiked(val *bool) {
 	p.IsLiked = val
 }
+func (p *CommentInfo) SetIsEdited(val *bool) {
+	p.IsEdited = val
+}
+func (p *CommentInfo) SetEditedAt(val *int64) {
+	p.EditedAt = val
+}
+func (p *CommentInfo) SetIsPinned(val *bool) {
+	p.IsPinned = val
+}
+func (p *CommentInfo) SetIsHearted(val *bool) {
+	p.IsHearted = val
+}
 
 func (p *CommentInfo) IsSetParentId() bool {
 	return p.ParentId != nil
@@ -683,6 +1002,22 @@ func (p *CommentInfo) IsSetIsLiked() bool {
 	return p.IsLiked != nil
 }
 
+func (p *CommentInfo) IsSetIsEdited() bool {
+	return p.IsEdited != nil
+}
+
+func (p *CommentInfo) IsSetEditedAt() bool {
+	return p.EditedAt != nil
+}
+
+func (p *CommentInfo) IsSetIsPinned() bool {
+	return p.IsPinned != nil
+}
+
+func (p *CommentInfo) IsSetIsHearted() bool {
+	return p.IsHearted != nil
+}
+
 func (p *CommentInfo) String() string {
 	if p == nil {
 		return "<nil>"
@@ -691,134 +1026,636 @@ func (p *CommentInfo) String() string {
 }
 
 var fieldIDToName_CommentInfo = map[int16]string{
-	1: "id",
-	2: "user_id",
-	3: "video_id",
-	4: "content",
-	5: "parent_id",
-	6: "created_at",
-	7: "deleted_at",
-	8: "like_count",
-	9: "is_liked",
+	1:  "id",
+	2:  "user_id",
+	3:  "video_id",
+	4:  "content",
+	5:  "parent_id",
+	6:  "created_at",
+	7:  "deleted_at",
+	8:  "like_count",
+	9:  "is_liked",
+	10: "is_edited",
+	11: "edited_at",
+	12: "is_pinned",
+	13: "is_hearted",
 }
 
-type PrivateMessage struct {
-	Id         int64  `thrift:"id,1,required" frugal:"1,required,i64" json:"id"`
-	SenderId   int64  `thrift:"sender_id,2,required" frugal:"2,required,i64" json:"sender_id"`
-	ReceiverId int64  `thrift:"receiver_id,3,required" frugal:"3,required,i64" json:"receiver_id"`
-	Content    string `thrift:"content,4,required" frugal:"4,required,string" json:"content"`
-	IsRead     bool   `thrift:"is_read,5,required" frugal:"5,required,bool" json:"is_read"`
-	CreatedAt  int64  `thrift:"created_at,6,required" frugal:"6,required,i64" json:"created_at"`
-	UpdatedAt  int64  `thrift:"updated_at,7,required" frugal:"7,required,i64" json:"updated_at"`
-	DeletedAt  *int64 `thrift:"deleted_at,8,optional" frugal:"8,optional,i64" json:"deleted_at,omitempty"`
+type ReactionCount struct {
+	Reaction string `thrift:"reaction,1,required" frugal:"1,required,string" json:"reaction"`
+	Count    int64  `thrift:"count,2,required" frugal:"2,required,i64" json:"count"`
 }
 
-func NewPrivateMessage() *PrivateMessage {
-	return &PrivateMessage{}
+func NewReactionCount() *ReactionCount {
+	return &ReactionCount{}
 }
 
-func (p *PrivateMessage) InitDefault() {
+func (p *ReactionCount) InitDefault() {
 }
 
-func (p *PrivateMessage) GetId() (v int64) {
-	return p.Id
+func (p *ReactionCount) GetReaction() (v string) {
+	return p.Reaction
 }
 
-func (p *PrivateMessage) GetSenderId() (v int64) {
-	return p.SenderId
+func (p *ReactionCount) GetCount() (v int64) {
+	return p.Count
 }
-
-func (p *PrivateMessage) GetReceiverId() (v int64) {
-	return p.ReceiverId
+func (p *ReactionCount) SetReaction(val string) {
+	p.Reaction = val
 }
-
-func (p *PrivateMessage) GetContent() (v string) {
-	return p.Content
+func (p *ReactionCount) SetCount(val int64) {
+	p.Count = val
 }
 
-func (p *PrivateMessage) GetIsRead() (v bool) {
-	return p.IsRead
+func (p *ReactionCount) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ReactionCount(%+v)", *p)
 }
 
-func (p *PrivateMessage) GetCreatedAt() (v int64) {
-	return p.CreatedAt
+var fieldIDToName_ReactionCount = map[int16]string{
+	1: "reaction",
+	2: "count",
 }
 
-func (p *PrivateMessage) GetUpdatedAt() (v int64) {
-	return p.UpdatedAt
+type ReactionInfo struct {
+	UserId    int64  `thrift:"user_id,1,required" frugal:"1,required,i64" json:"user_id"`
+	Reaction  string `thrift:"reaction,2,required" frugal:"2,required,string" json:"reaction"`
+	CreatedAt int64  `thrift:"created_at,3,required" frugal:"3,required,i64" json:"created_at"`
 }
 
-var PrivateMessage_DeletedAt_DEFAULT int64
+func NewReactionInfo() *ReactionInfo {
+	return &ReactionInfo{}
+}
 
-func (p *PrivateMessage) GetDeletedAt() (v int64) {
-	if !p.IsSetDeletedAt() {
-		return PrivateMessage_DeletedAt_DEFAULT
-	}
-	return *p.DeletedAt
+func (p *ReactionInfo) InitDefault() {
 }
-func (p *PrivateMessage) SetId(val int64) {
-	p.Id = val
+
+func (p *ReactionInfo) GetUserId() (v int64) {
+	return p.UserId
 }
-func (p *PrivateMessage) SetSenderId(val int64) {
-	p.SenderId = val
+
+func (p *ReactionInfo) GetReaction() (v string) {
+	return p.Reaction
 }
-func (p *PrivateMessage) SetReceiverId(val int64) {
-	p.ReceiverId = val
+
+func (p *ReactionInfo) GetCreatedAt() (v int64) {
+	return p.CreatedAt
 }
-func (p *PrivateMessage) SetContent(val string) {
-	p.Content = val
+func (p *ReactionInfo) SetUserId(val int64) {
+	p.UserId = val
 }
-func (p *PrivateMessage) SetIsRead(val bool) {
-	p.IsRead = val
+func (p *ReactionInfo) SetReaction(val string) {
+	p.Reaction = val
 }
-func (p *PrivateMessage) SetCreatedAt(val int64) {
+func (p *ReactionInfo) SetCreatedAt(val int64) {
 	p.CreatedAt = val
 }
-func (p *PrivateMessage) SetUpdatedAt(val int64) {
-	p.UpdatedAt = val
-}
-func (p *PrivateMessage) SetDeletedAt(val *int64) {
-	p.DeletedAt = val
-}
 
-func (p *PrivateMessage) IsSetDeletedAt() bool {
-	return p.DeletedAt != nil
-}
-
-func (p *PrivateMessage) String() string {
+func (p *ReactionInfo) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("PrivateMessage(%+v)", *p)
+	return fmt.Sprintf("ReactionInfo(%+v)", *p)
 }
 
-var fieldIDToName_PrivateMessage = map[int16]string{
-	1: "id",
-	2: "sender_id",
-	3: "receiver_id",
-	4: "content",
-	5: "is_read",
-	6: "created_at",
-	7: "updated_at",
-	8: "deleted_at",
+var fieldIDToName_ReactionInfo = map[int16]string{
+	1: "user_id",
+	2: "reaction",
+	3: "created_at",
 }
 
-type ChatRoom struct {
-	Id        int64             `thrift:"id,1,required" frugal:"1,required,i64" json:"id"`
-	Name      string            `thrift:"name,2,required" frugal:"2,required,string" json:"name"`
-	CreatorId int64             `thrift:"creator_id,3,required" frugal:"3,required,i64" json:"creator_id"`
-	Type      int8              `thrift:"type,4,required" frugal:"4,required,i8" json:"type"`
-	Members   []*ChatRoomMember `thrift:"members,8,optional" frugal:"8,optional,list<ChatRoomMember>" json:"members,omitempty"`
+type VideoInteractionState struct {
+	VideoId      int64            `thrift:"video_id,1,required" frugal:"1,required,i64" json:"video_id"`
+	IsLiked      bool             `thrift:"is_liked,2,required" frugal:"2,required,bool" json:"is_liked"`
+	LikeCount    int64            `thrift:"like_count,3,required" frugal:"3,required,i64" json:"like_count"`
+	CommentCount int64            `thrift:"comment_count,4,required" frugal:"4,required,i64" json:"comment_count"`
+	Reactions    []*ReactionCount `thrift:"reactions,5,required" frugal:"5,required,list<ReactionCount>" json:"reactions"`
+	MyReaction   *string          `thrift:"my_reaction,6,optional" frugal:"6,optional,string" json:"my_reaction,omitempty"`
+	CollectCount *int64           `thrift:"collect_count,7,optional" frugal:"7,optional,i64" json:"collect_count,omitempty"`
+	IsCollected  *bool            `thrift:"is_collected,8,optional" frugal:"8,optional,bool" json:"is_collected,omitempty"`
 }
 
-func NewChatRoom() *ChatRoom {
-	return &ChatRoom{}
+func NewVideoInteractionState() *VideoInteractionState {
+	return &VideoInteractionState{}
 }
 
-func (p *ChatRoom) InitDefault() {
+func (p *VideoInteractionState) InitDefault() {
 }
 
-func (p *ChatRoom) GetId() (v int64) {
+func (p *VideoInteractionState) GetVideoId() (v int64) {
+	return p.VideoId
+}
+
+func (p *VideoInteractionState) GetIsLiked() (v bool) {
+	return p.IsLiked
+}
+
+func (p *VideoInteractionState) GetLikeCount() (v int64) {
+	return p.LikeCount
+}
+
+func (p *VideoInteractionState) GetCommentCount() (v int64) {
+	return p.CommentCount
+}
+
+func (p *VideoInteractionState) GetReactions() (v []*ReactionCount) {
+	return p.Reactions
+}
+
+var VideoInteractionState_MyReaction_DEFAULT string
+
+func (p *VideoInteractionState) GetMyReaction() (v string) {
+	if !p.IsSetMyReaction() {
+		return VideoInteractionState_MyReaction_DEFAULT
+	}
+	return *p.MyReaction
+}
+
+var VideoInteractionState_CollectCount_DEFAULT int64
+
+func (p *VideoInteractionState) GetCollectCount() (v int64) {
+	if !p.IsSetCollectCount() {
+		return VideoInteractionState_CollectCount_DEFAULT
+	}
+	return *p.CollectCount
+}
+
+var VideoInteractionState_IsCollected_DEFAULT bool
+
+func (p *VideoInteractionState) GetIsCollected() (v bool) {
+	if !p.IsSetIsCollected() {
+		return VideoInteractionState_IsCollected_DEFAULT
+	}
+	return *p.IsCollected
+}
+func (p *VideoInteractionState) SetVideoId(val int64) {
+	p.VideoId = val
+}
+func (p *VideoInteractionState) SetIsLiked(val bool) {
+	p.IsLiked = val
+}
+func (p *VideoInteractionState) SetLikeCount(val int64) {
+	p.LikeCount = val
+}
+func (p *VideoInteractionState) SetCommentCount(val int64) {
+	p.CommentCount = val
+}
+func (p *VideoInteractionState) SetReactions(val []*ReactionCount) {
+	p.Reactions = val
+}
+func (p *VideoInteractionState) SetMyReaction(val *string) {
+	p.MyReaction = val
+}
+func (p *VideoInteractionState) SetCollectCount(val *int64) {
+	p.CollectCount = val
+}
+func (p *VideoInteractionState) SetIsCollected(val *bool) {
+	p.IsCollected = val
+}
+
+func (p *VideoInteractionState) IsSetMyReaction() bool {
+	return p.MyReaction != nil
+}
+
+func (p *VideoInteractionState) IsSetCollectCount() bool {
+	return p.CollectCount != nil
+}
+
+func (p *VideoInteractionState) IsSetIsCollected() bool {
+	return p.IsCollected != nil
+}
+
+func (p *VideoInteractionState) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("VideoInteractionState(%+v)", *p)
+}
+
+var fieldIDToName_VideoInteractionState = map[int16]string{
+	1: "video_id",
+	2: "is_liked",
+	3: "like_count",
+	4: "comment_count",
+	5: "reactions",
+	6: "my_reaction",
+	7: "collect_count",
+	8: "is_collected",
+}
+
+type Collection struct {
+	Id         int64  `thrift:"id,1,required" frugal:"1,required,i64" json:"id"`
+	UserId     int64  `thrift:"user_id,2,required" frugal:"2,required,i64" json:"user_id"`
+	Name       string `thrift:"name,3,required" frugal:"3,required,string" json:"name"`
+	IsPrivate  bool   `thrift:"is_private,4,required" frugal:"4,required,bool" json:"is_private"`
+	IsDefault  bool   `thrift:"is_default,5,required" frugal:"5,required,bool" json:"is_default"`
+	SortOrder  int32  `thrift:"sort_order,6,required" frugal:"6,required,i32" json:"sort_order"`
+	VideoCount int64  `thrift:"video_count,7,required" frugal:"7,required,i64" json:"video_count"`
+	CreatedAt  int64  `thrift:"created_at,8,required" frugal:"8,required,i64" json:"created_at"`
+	UpdatedAt  int64  `thrift:"updated_at,9,required" frugal:"9,required,i64" json:"updated_at"`
+}
+
+func NewCollection() *Collection {
+	return &Collection{}
+}
+
+func (p *Collection) InitDefault() {
+}
+
+func (p *Collection) GetId() (v int64) {
+	return p.Id
+}
+
+func (p *Collection) GetUserId() (v int64) {
+	return p.UserId
+}
+
+func (p *Collection) GetName() (v string) {
+	return p.Name
+}
+
+func (p *Collection) GetIsPrivate() (v bool) {
+	return p.IsPrivate
+}
+
+func (p *Collection) GetIsDefault() (v bool) {
+	return p.IsDefault
+}
+
+func (p *Collection) GetSortOrder() (v int32) {
+	return p.SortOrder
+}
+
+func (p *Collection) GetVideoCount() (v int64) {
+	return p.VideoCount
+}
+
+func (p *Collection) GetCreatedAt() (v int64) {
+	return p.CreatedAt
+}
+
+func (p *Collection) GetUpdatedAt() (v int64) {
+	return p.UpdatedAt
+}
+func (p *Collection) SetId(val int64) {
+	p.Id = val
+}
+func (p *Collection) SetUserId(val int64) {
+	p.UserId = val
+}
+func (p *Collection) SetName(val string) {
+	p.Name = val
+}
+func (p *Collection) SetIsPrivate(val bool) {
+	p.IsPrivate = val
+}
+func (p *Collection) SetIsDefault(val bool) {
+	p.IsDefault = val
+}
+func (p *Collection) SetSortOrder(val int32) {
+	p.SortOrder = val
+}
+func (p *Collection) SetVideoCount(val int64) {
+	p.VideoCount = val
+}
+func (p *Collection) SetCreatedAt(val int64) {
+	p.CreatedAt = val
+}
+func (p *Collection) SetUpdatedAt(val int64) {
+	p.UpdatedAt = val
+}
+
+func (p *Collection) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("Collection(%+v)", *p)
+}
+
+var fieldIDToName_Collection = map[int16]string{
+	1: "id",
+	2: "user_id",
+	3: "name",
+	4: "is_private",
+	5: "is_default",
+	6: "sort_order",
+	7: "video_count",
+	8: "created_at",
+	9: "updated_at",
+}
+
+type CommentEdit struct {
+	Id        int64  `thrift:"id,1,required" frugal:"1,required,i64" json:"id"`
+	CommentId int64  `thrift:"comment_id,2,required" frugal:"2,required,i64" json:"comment_id"`
+	Content   string `thrift:"content,3,required" frugal:"3,required,string" json:"content"`
+	EditedAt  int64  `thrift:"edited_at,4,required" frugal:"4,required,i64" json:"edited_at"`
+}
+
+func NewCommentEdit() *CommentEdit {
+	return &CommentEdit{}
+}
+
+func (p *CommentEdit) InitDefault() {
+}
+
+func (p *CommentEdit) GetId() (v int64) {
+	return p.Id
+}
+
+func (p *CommentEdit) GetCommentId() (v int64) {
+	return p.CommentId
+}
+
+func (p *CommentEdit) GetContent() (v string) {
+	return p.Content
+}
+
+func (p *CommentEdit) GetEditedAt() (v int64) {
+	return p.EditedAt
+}
+func (p *CommentEdit) SetId(val int64) {
+	p.Id = val
+}
+func (p *CommentEdit) SetCommentId(val int64) {
+	p.CommentId = val
+}
+func (p *CommentEdit) SetContent(val string) {
+	p.Content = val
+}
+func (p *CommentEdit) SetEditedAt(val int64) {
+	p.EditedAt = val
+}
+
+func (p *CommentEdit) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CommentEdit(%+v)", *p)
+}
+
+var fieldIDToName_CommentEdit = map[int16]string{
+	1: "id",
+	2: "comment_id",
+	3: "content",
+	4: "edited_at",
+}
+
+type PrivateMessage struct {
+	Id         int64  `thrift:"id,1,required" frugal:"1,required,i64" json:"id"`
+	SenderId   int64  `thrift:"sender_id,2,required" frugal:"2,required,i64" json:"sender_id"`
+	ReceiverId int64  `thrift:"receiver_id,3,required" frugal:"3,required,i64" json:"receiver_id"`
+	Content    string `thrift:"content,4,required" frugal:"4,required,string" json:"content"`
+	IsRead     bool   `thrift:"is_read,5,required" frugal:"5,required,bool" json:"is_read"`
+	CreatedAt  int64  `thrift:"created_at,6,required" frugal:"6,required,i64" json:"created_at"`
+	UpdatedAt  int64  `thrift:"updated_at,7,required" frugal:"7,required,i64" json:"updated_at"`
+	DeletedAt  *int64 `thrift:"deleted_at,8,optional" frugal:"8,optional,i64" json:"deleted_at,omitempty"`
+	Seq        int64  `thrift:"seq,9,required" frugal:"9,required,i64" json:"seq"`
+	ReplyToId  *int64 `thrift:"reply_to_id,10,optional" frugal:"10,optional,i64" json:"reply_to_id,omitempty"`
+	EditedAt   *int64 `thrift:"edited_at,11,optional" frugal:"11,optional,i64" json:"edited_at,omitempty"`
+	Recalled   *bool  `thrift:"recalled,12,optional" frugal:"12,optional,bool" json:"recalled,omitempty"`
+}
+
+func NewPrivateMessage() *PrivateMessage {
+	return &PrivateMessage{}
+}
+
+func (p *PrivateMessage) InitDefault() {
+}
+
+func (p *PrivateMessage) GetId() (v int64) {
+	return p.Id
+}
+
+func (p *PrivateMessage) GetSenderId() (v int64) {
+	return p.SenderId
+}
+
+func (p *PrivateMessage) GetReceiverId() (v int64) {
+	return p.ReceiverId
+}
+
+func (p *PrivateMessage) GetContent() (v string) {
+	return p.Content
+}
+
+func (p *PrivateMessage) GetIsRead() (v bool) {
+	return p.IsRead
+}
+
+func (p *PrivateMessage) GetCreatedAt() (v int64) {
+	return p.CreatedAt
+}
+
+func (p *PrivateMessage) GetUpdatedAt() (v int64) {
+	return p.UpdatedAt
+}
+
+var PrivateMessage_DeletedAt_DEFAULT int64
+
+func (p *PrivateMessage) GetDeletedAt() (v int64) {
+	if !p.IsSetDeletedAt() {
+		return PrivateMessage_DeletedAt_DEFAULT
+	}
+	return *p.DeletedAt
+}
+
+func (p *PrivateMessage) GetSeq() (v int64) {
+	return p.Seq
+}
+
+var PrivateMessage_ReplyToId_DEFAULT int64
+
+func (p *PrivateMessage) GetReplyToId() (v int64) {
+	if !p.IsSetReplyToId() {
+		return PrivateMessage_ReplyToId_DEFAULT
+	}
+	return *p.ReplyToId
+}
+
+var PrivateMessage_EditedAt_DEFAULT int64
+
+func (p *PrivateMessage) GetEditedAt() (v int64) {
+	if !p.IsSetEditedAt() {
+		return PrivateMessage_EditedAt_DEFAULT
+	}
+	return *p.EditedAt
+}
+
+var PrivateMessage_Recalled_DEFAULT bool
+
+func (p *PrivateMessage) GetRecalled() (v bool) {
+	if !p.IsSetRecalled() {
+		return PrivateMessage_Recalled_DEFAULT
+	}
+	return *p.Recalled
+}
+func (p *PrivateMessage) SetId(val int64) {
+	p.Id = val
+}
+func (p *PrivateMessage) SetSenderId(val int64) {
+	p.SenderId = val
+}
+func (p *PrivateMessage) SetReceiverId(val int64) {
+	p.ReceiverId = val
+}
+func (p *PrivateMessage) SetContent(val string) {
+	p.Content = val
+}
+func (p *PrivateMessage) SetIsRead(val bool) {
+	p.IsRead = val
+}
+func (p *PrivateMessage) SetCreatedAt(val int64) {
+	p.CreatedAt = val
+}
+func (p *PrivateMessage) SetUpdatedAt(val int64) {
+	p.UpdatedAt = val
+}
+func (p *PrivateMessage) SetDeletedAt(val *int64) {
+	p.DeletedAt = val
+}
+func (p *PrivateMessage) SetSeq(val int64) {
+	p.Seq = val
+}
+func (p *PrivateMessage) SetReplyToId(val *int64) {
+	p.ReplyToId = val
+}
+func (p *PrivateMessage) SetEditedAt(val *int64) {
+	p.EditedAt = val
+}
+func (p *PrivateMessage) SetRecalled(val *bool) {
+	p.Recalled = val
+}
+
+func (p *PrivateMessage) IsSetDeletedAt() bool {
+	return p.DeletedAt != nil
+}
+
+func (p *PrivateMessage) IsSetReplyToId() bool {
+	return p.ReplyToId != nil
+}
+
+func (p *PrivateMessage) IsSetEditedAt() bool {
+	return p.EditedAt != nil
+}
+
+func (p *PrivateMessage) IsSetRecalled() bool {
+	return p.Recalled != nil
+}
+
+func (p *PrivateMessage) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("PrivateMessage(%+v)", *p)
+}
+
+var fieldIDToName_PrivateMessage = map[int16]string{
+	1:  "id",
+	2:  "sender_id",
+	3:  "receiver_id",
+	4:  "content",
+	5:  "is_read",
+	6:  "created_at",
+	7:  "updated_at",
+	8:  "deleted_at",
+	9:  "seq",
+	10: "reply_to_id",
+	11: "edited_at",
+	12: "recalled",
+}
+
+type ChatAttachment struct {
+	Url          string  `thrift:"url,1,required" frugal:"1,required,string" json:"url"`
+	ThumbnailUrl *string `thrift:"thumbnail_url,2,optional" frugal:"2,optional,string" json:"thumbnail_url,omitempty"`
+	Name         string  `thrift:"name,3,required" frugal:"3,required,string" json:"name"`
+	ContentType  string  `thrift:"content_type,4,required" frugal:"4,required,string" json:"content_type"`
+	Size         int64   `thrift:"size,5,required" frugal:"5,required,i64" json:"size"`
+}
+
+func NewChatAttachment() *ChatAttachment {
+	return &ChatAttachment{}
+}
+
+func (p *ChatAttachment) InitDefault() {
+}
+
+func (p *ChatAttachment) GetUrl() (v string) {
+	return p.Url
+}
+
+var ChatAttachment_ThumbnailUrl_DEFAULT string
+
+func (p *ChatAttachment) GetThumbnailUrl() (v string) {
+	if !p.IsSetThumbnailUrl() {
+		return ChatAttachment_ThumbnailUrl_DEFAULT
+	}
+	return *p.ThumbnailUrl
+}
+
+func (p *ChatAttachment) GetName() (v string) {
+	return p.Name
+}
+
+func (p *ChatAttachment) GetContentType() (v string) {
+	return p.ContentType
+}
+
+func (p *ChatAttachment) GetSize() (v int64) {
+	return p.Size
+}
+func (p *ChatAttachment) SetUrl(val string) {
+	p.Url = val
+}
+func (p *ChatAttachment) SetThumbnailUrl(val *string) {
+	p.ThumbnailUrl = val
+}
+func (p *ChatAttachment) SetName(val string) {
+	p.Name = val
+}
+func (p *ChatAttachment) SetContentType(val string) {
+	p.ContentType = val
+}
+func (p *ChatAttachment) SetSize(val int64) {
+	p.Size = val
+}
+
+func (p *ChatAttachment) IsSetThumbnailUrl() bool {
+	return p.ThumbnailUrl != nil
+}
+
+func (p *ChatAttachment) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ChatAttachment(%+v)", *p)
+}
+
+var fieldIDToName_ChatAttachment = map[int16]string{
+	1: "url",
+	2: "thumbnail_url",
+	3: "name",
+	4: "content_type",
+	5: "size",
+}
+
+type ChatRoom struct {
+	Id        int64             `thrift:"id,1,required" frugal:"1,required,i64" json:"id"`
+	Name      string            `thrift:"name,2,required" frugal:"2,required,string" json:"name"`
+	CreatorId int64             `thrift:"creator_id,3,required" frugal:"3,required,i64" json:"creator_id"`
+	Type      int8              `thrift:"type,4,required" frugal:"4,required,i8" json:"type"`
+	Members   []*ChatRoomMember `thrift:"members,8,optional" frugal:"8,optional,list<ChatRoomMember>" json:"members,omitempty"`
+}
+
+func NewChatRoom() *ChatRoom {
+	return &ChatRoom{}
+}
+
+func (p *ChatRoom) InitDefault() {
+}
+
+func (p *ChatRoom) GetId() (v int64) {
 	return p.Id
 }
 
@@ -878,14 +1715,15 @@ var fieldIDToName_ChatRoom = map[int16]string{
 }
 
 type ChatRoomMember struct {
-	Id        int64   `thrift:"id,1,required" frugal:"1,required,i64" json:"id"`
-	RoomId    int64   `thrift:"room_id,2,required" frugal:"2,required,i64" json:"room_id"`
-	UserId    int64   `thrift:"user_id,3,required" frugal:"3,required,i64" json:"user_id"`
-	Nickname  *string `thrift:"nickname,4,optional" frugal:"4,optional,string" json:"nickname,omitempty"`
-	Role      int8    `thrift:"role,5,required" frugal:"5,required,i8" json:"role"`
-	CreatedAt int64   `thrift:"created_at,6,required" frugal:"6,required,i64" json:"created_at"`
-	UpdatedAt int64   `thrift:"updated_at,7,required" frugal:"7,required,i64" json:"updated_at"`
-	DeletedAt *int64  `thrift:"deleted_at,8,optional" frugal:"8,optional,i64" json:"deleted_at,omitempty"`
+	Id         int64   `thrift:"id,1,required" frugal:"1,required,i64" json:"id"`
+	RoomId     int64   `thrift:"room_id,2,required" frugal:"2,required,i64" json:"room_id"`
+	UserId     int64   `thrift:"user_id,3,required" frugal:"3,required,i64" json:"user_id"`
+	Nickname   *string `thrift:"nickname,4,optional" frugal:"4,optional,string" json:"nickname,omitempty"`
+	Role       int8    `thrift:"role,5,required" frugal:"5,required,i8" json:"role"`
+	CreatedAt  int64   `thrift:"created_at,6,required" frugal:"6,required,i64" json:"created_at"`
+	UpdatedAt  int64   `thrift:"updated_at,7,required" frugal:"7,required,i64" json:"updated_at"`
+	DeletedAt  *int64  `thrift:"deleted_at,8,optional" frugal:"8,optional,i64" json:"deleted_at,omitempty"`
+	MutedUntil *int64  `thrift:"muted_until,9,optional" frugal:"9,optional,i64" json:"muted_until,omitempty"`
 }
 
 func NewChatRoomMember() *ChatRoomMember {
@@ -936,6 +1774,15 @@ func (p *ChatRoomMember) GetDeletedAt() (v int64) {
 	}
 	return *p.DeletedAt
 }
+
+var ChatRoomMember_MutedUntil_DEFAULT int64
+
+func (p *ChatRoomMember) GetMutedUntil() (v int64) {
+	if !p.IsSetMutedUntil() {
+		return ChatRoomMember_MutedUntil_DEFAULT
+	}
+	return *p.MutedUntil
+}
 func (p *ChatRoomMember) SetId(val int64) {
 	p.Id = val
 }
@@ -954,37 +1801,386 @@ func (p *ChatRoomMember) SetRole(val int8) {
 func (p *ChatRoomMember) SetCreatedAt(val int64) {
 	p.CreatedAt = val
 }
-func (p *ChatRoomMember) SetUpdatedAt(val int64) {
-	p.UpdatedAt = val
+func (p *ChatRoomMember) SetUpdatedAt(val int64) {
+	p.UpdatedAt = val
+}
+func (p *ChatRoomMember) SetDeletedAt(val *int64) {
+	p.DeletedAt = val
+}
+func (p *ChatRoomMember) SetMutedUntil(val *int64) {
+	p.MutedUntil = val
+}
+
+func (p *ChatRoomMember) IsSetNickname() bool {
+	return p.Nickname != nil
+}
+
+func (p *ChatRoomMember) IsSetDeletedAt() bool {
+	return p.DeletedAt != nil
+}
+
+func (p *ChatRoomMember) IsSetMutedUntil() bool {
+	return p.MutedUntil != nil
+}
+
+func (p *ChatRoomMember) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ChatRoomMember(%+v)", *p)
+}
+
+var fieldIDToName_ChatRoomMember = map[int16]string{
+	1: "id",
+	2: "room_id",
+	3: "user_id",
+	4: "nickname",
+	5: "role",
+	6: "created_at",
+	7: "updated_at",
+	8: "deleted_at",
+	9: "muted_until",
+}
+
+type ChatRoomInvite struct {
+	Code      string `thrift:"code,1,required" frugal:"1,required,string" json:"code"`
+	RoomId    int64  `thrift:"room_id,2,required" frugal:"2,required,i64" json:"room_id"`
+	InviterId int64  `thrift:"inviter_id,3,required" frugal:"3,required,i64" json:"inviter_id"`
+	ExpiresAt int64  `thrift:"expires_at,4,required" frugal:"4,required,i64" json:"expires_at"`
+}
+
+func NewChatRoomInvite() *ChatRoomInvite {
+	return &ChatRoomInvite{}
+}
+
+func (p *ChatRoomInvite) InitDefault() {
+}
+
+func (p *ChatRoomInvite) GetCode() (v string) {
+	return p.Code
+}
+
+func (p *ChatRoomInvite) GetRoomId() (v int64) {
+	return p.RoomId
+}
+
+func (p *ChatRoomInvite) GetInviterId() (v int64) {
+	return p.InviterId
+}
+
+func (p *ChatRoomInvite) GetExpiresAt() (v int64) {
+	return p.ExpiresAt
+}
+func (p *ChatRoomInvite) SetCode(val string) {
+	p.Code = val
+}
+func (p *ChatRoomInvite) SetRoomId(val int64) {
+	p.RoomId = val
+}
+func (p *ChatRoomInvite) SetInviterId(val int64) {
+	p.InviterId = val
+}
+func (p *ChatRoomInvite) SetExpiresAt(val int64) {
+	p.ExpiresAt = val
+}
+
+func (p *ChatRoomInvite) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ChatRoomInvite(%+v)", *p)
+}
+
+var fieldIDToName_ChatRoomInvite = map[int16]string{
+	1: "code",
+	2: "room_id",
+	3: "inviter_id",
+	4: "expires_at",
+}
+
+type ReadCursor struct {
+	PeerId  *int64 `thrift:"peer_id,1,optional" frugal:"1,optional,i64" json:"peer_id,omitempty"`
+	RoomId  *int64 `thrift:"room_id,2,optional" frugal:"2,optional,i64" json:"room_id,omitempty"`
+	ReadSeq int64  `thrift:"read_seq,3,required" frugal:"3,required,i64" json:"read_seq"`
+}
+
+func NewReadCursor() *ReadCursor {
+	return &ReadCursor{}
+}
+
+func (p *ReadCursor) InitDefault() {
+}
+
+var ReadCursor_PeerId_DEFAULT int64
+
+func (p *ReadCursor) GetPeerId() (v int64) {
+	if !p.IsSetPeerId() {
+		return ReadCursor_PeerId_DEFAULT
+	}
+	return *p.PeerId
+}
+
+var ReadCursor_RoomId_DEFAULT int64
+
+func (p *ReadCursor) GetRoomId() (v int64) {
+	if !p.IsSetRoomId() {
+		return ReadCursor_RoomId_DEFAULT
+	}
+	return *p.RoomId
+}
+
+func (p *ReadCursor) GetReadSeq() (v int64) {
+	return p.ReadSeq
+}
+func (p *ReadCursor) SetPeerId(val *int64) {
+	p.PeerId = val
+}
+func (p *ReadCursor) SetRoomId(val *int64) {
+	p.RoomId = val
+}
+func (p *ReadCursor) SetReadSeq(val int64) {
+	p.ReadSeq = val
+}
+
+func (p *ReadCursor) IsSetPeerId() bool {
+	return p.PeerId != nil
+}
+
+func (p *ReadCursor) IsSetRoomId() bool {
+	return p.RoomId != nil
+}
+
+func (p *ReadCursor) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ReadCursor(%+v)", *p)
+}
+
+var fieldIDToName_ReadCursor = map[int16]string{
+	1: "peer_id",
+	2: "room_id",
+	3: "read_seq",
+}
+
+type UnreadCount struct {
+	PeerId *int64 `thrift:"peer_id,1,optional" frugal:"1,optional,i64" json:"peer_id,omitempty"`
+	RoomId *int64 `thrift:"room_id,2,optional" frugal:"2,optional,i64" json:"room_id,omitempty"`
+	Count  int64  `thrift:"count,3,required" frugal:"3,required,i64" json:"count"`
+}
+
+func NewUnreadCount() *UnreadCount {
+	return &UnreadCount{}
+}
+
+func (p *UnreadCount) InitDefault() {
+}
+
+var UnreadCount_PeerId_DEFAULT int64
+
+func (p *UnreadCount) GetPeerId() (v int64) {
+	if !p.IsSetPeerId() {
+		return UnreadCount_PeerId_DEFAULT
+	}
+	return *p.PeerId
+}
+
+var UnreadCount_RoomId_DEFAULT int64
+
+func (p *UnreadCount) GetRoomId() (v int64) {
+	if !p.IsSetRoomId() {
+		return UnreadCount_RoomId_DEFAULT
+	}
+	return *p.RoomId
+}
+
+func (p *UnreadCount) GetCount() (v int64) {
+	return p.Count
+}
+func (p *UnreadCount) SetPeerId(val *int64) {
+	p.PeerId = val
+}
+func (p *UnreadCount) SetRoomId(val *int64) {
+	p.RoomId = val
+}
+func (p *UnreadCount) SetCount(val int64) {
+	p.Count = val
+}
+
+func (p *UnreadCount) IsSetPeerId() bool {
+	return p.PeerId != nil
+}
+
+func (p *UnreadCount) IsSetRoomId() bool {
+	return p.RoomId != nil
+}
+
+func (p *UnreadCount) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UnreadCount(%+v)", *p)
+}
+
+var fieldIDToName_UnreadCount = map[int16]string{
+	1: "peer_id",
+	2: "room_id",
+	3: "count",
+}
+
+type Conversation struct {
+	PeerId          *int64  `thrift:"peer_id,1,optional" frugal:"1,optional,i64" json:"peer_id,omitempty"`
+	RoomId          *int64  `thrift:"room_id,2,optional" frugal:"2,optional,i64" json:"room_id,omitempty"`
+	Name            *string `thrift:"name,3,optional" frugal:"3,optional,string" json:"name,omitempty"`
+	LastMessageId   int64   `thrift:"last_message_id,4,required" frugal:"4,required,i64" json:"last_message_id"`
+	LastSenderId    int64   `thrift:"last_sender_id,5,required" frugal:"5,required,i64" json:"last_sender_id"`
+	LastMessageType int8    `thrift:"last_message_type,6,required" frugal:"6,required,i8" json:"last_message_type"`
+	LastPreview     string  `thrift:"last_preview,7,required" frugal:"7,required,string" json:"last_preview"`
+	LastActiveAt    int64   `thrift:"last_active_at,8,required" frugal:"8,required,i64" json:"last_active_at"`
+	UnreadCount     int64   `thrift:"unread_count,9,required" frugal:"9,required,i64" json:"unread_count"`
+	Muted           bool    `thrift:"muted,10,required" frugal:"10,required,bool" json:"muted"`
+	Pinned          bool    `thrift:"pinned,11,required" frugal:"11,required,bool" json:"pinned"`
+	Archived        bool    `thrift:"archived,12,required" frugal:"12,required,bool" json:"archived"`
+}
+
+func NewConversation() *Conversation {
+	return &Conversation{}
+}
+
+func (p *Conversation) InitDefault() {
+}
+
+var Conversation_PeerId_DEFAULT int64
+
+func (p *Conversation) GetPeerId() (v int64) {
+	if !p.IsSetPeerId() {
+		return Conversation_PeerId_DEFAULT
+	}
+	return *p.PeerId
+}
+
+var Conversation_RoomId_DEFAULT int64
+
+func (p *Conversation) GetRoomId() (v int64) {
+	if !p.IsSetRoomId() {
+		return Conversation_RoomId_DEFAULT
+	}
+	return *p.RoomId
+}
+
+var Conversation_Name_DEFAULT string
+
+func (p *Conversation) GetName() (v string) {
+	if !p.IsSetName() {
+		return Conversation_Name_DEFAULT
+	}
+	return *p.Name
+}
+
+func (p *Conversation) GetLastMessageId() (v int64) {
+	return p.LastMessageId
+}
+
+func (p *Conversation) GetLastSenderId() (v int64) {
+	return p.LastSenderId
+}
+
+func (p *Conversation) GetLastMessageType() (v int8) {
+	return p.LastMessageType
+}
+
+func (p *Conversation) GetLastPreview() (v string) {
+	return p.LastPreview
+}
+
+func (p *Conversation) GetLastActiveAt() (v int64) {
+	return p.LastActiveAt
+}
+
+func (p *Conversation) GetUnreadCount() (v int64) {
+	return p.UnreadCount
+}
+
+func (p *Conversation) GetMuted() (v bool) {
+	return p.Muted
+}
+
+func (p *Conversation) GetPinned() (v bool) {
+	return p.Pinned
+}
+
+func (p *Conversation) GetArchived() (v bool) {
+	return p.Archived
+}
+func (p *Conversation) SetPeerId(val *int64) {
+	p.PeerId = val
+}
+func (p *Conversation) SetRoomId(val *int64) {
+	p.RoomId = val
+}
+func (p *Conversation) SetName(val *string) {
+	p.Name = val
+}
+func (p *Conversation) SetLastMessageId(val int64) {
+	p.LastMessageId = val
+}
+func (p *Conversation) SetLastSenderId(val int64) {
+	p.LastSenderId = val
+}
+func (p *Conversation) SetLastMessageType(val int8) {
+	p.LastMessageType = val
+}
+func (p *Conversation) SetLastPreview(val string) {
+	p.LastPreview = val
+}
+func (p *Conversation) SetLastActiveAt(val int64) {
+	p.LastActiveAt = val
+}
+func (p *Conversation) SetUnreadCount(val int64) {
+	p.UnreadCount = val
+}
+func (p *Conversation) SetMuted(val bool) {
+	p.Muted = val
+}
+func (p *Conversation) SetPinned(val bool) {
+	p.Pinned = val
 }
-func (p *ChatRoomMember) SetDeletedAt(val *int64) {
-	p.DeletedAt = val
+func (p *Conversation) SetArchived(val bool) {
+	p.Archived = val
 }
 
-func (p *ChatRoomMember) IsSetNickname() bool {
-	return p.Nickname != nil
+func (p *Conversation) IsSetPeerId() bool {
+	return p.PeerId != nil
 }
 
-func (p *ChatRoomMember) IsSetDeletedAt() bool {
-	return p.DeletedAt != nil
+func (p *Conversation) IsSetRoomId() bool {
+	return p.RoomId != nil
 }
 
-func (p *ChatRoomMember) String() string {
+func (p *Conversation) IsSetName() bool {
+	return p.Name != nil
+}
+
+func (p *Conversation) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ChatRoomMember(%+v)", *p)
+	return fmt.Sprintf("Conversation(%+v)", *p)
 }
 
-var fieldIDToName_ChatRoomMember = map[int16]string{
-	1: "id",
-	2: "room_id",
-	3: "user_id",
-	4: "nickname",
-	5: "role",
-	6: "created_at",
-	7: "updated_at",
-	8: "deleted_at",
+var fieldIDToName_Conversation = map[int16]string{
+	1:  "peer_id",
+	2:  "room_id",
+	3:  "name",
+	4:  "last_message_id",
+	5:  "last_sender_id",
+	6:  "last_message_type",
+	7:  "last_preview",
+	8:  "last_active_at",
+	9:  "unread_count",
+	10: "muted",
+	11: "pinned",
+	12: "archived",
 }
 
 type ChatMessage struct {
@@ -996,6 +2192,10 @@ type ChatMessage struct {
 	CreatedAt int64  `thrift:"created_at,6,required" frugal:"6,required,i64" json:"created_at"`
 	UpdatedAt int64  `thrift:"updated_at,7,required" frugal:"7,required,i64" json:"updated_at"`
 	DeletedAt *int64 `thrift:"deleted_at,8,optional" frugal:"8,optional,i64" json:"deleted_at,omitempty"`
+	Seq       int64  `thrift:"seq,9,required" frugal:"9,required,i64" json:"seq"`
+	ReplyToId *int64 `thrift:"reply_to_id,10,optional" frugal:"10,optional,i64" json:"reply_to_id,omitempty"`
+	EditedAt  *int64 `thrift:"edited_at,11,optional" frugal:"11,optional,i64" json:"edited_at,omitempty"`
+	Recalled  *bool  `thrift:"recalled,12,optional" frugal:"12,optional,bool" json:"recalled,omitempty"`
 }
 
 func NewChatMessage() *ChatMessage {
@@ -1041,6 +2241,37 @@ func (p *ChatMessage) GetDeletedAt() (v int64) {
 	}
 	return *p.DeletedAt
 }
+
+func (p *ChatMessage) GetSeq() (v int64) {
+	return p.Seq
+}
+
+var ChatMessage_ReplyToId_DEFAULT int64
+
+func (p *ChatMessage) GetReplyToId() (v int64) {
+	if !p.IsSetReplyToId() {
+		return ChatMessage_ReplyToId_DEFAULT
+	}
+	return *p.ReplyToId
+}
+
+var ChatMessage_EditedAt_DEFAULT int64
+
+func (p *ChatMessage) GetEditedAt() (v int64) {
+	if !p.IsSetEditedAt() {
+		return ChatMessage_EditedAt_DEFAULT
+	}
+	return *p.EditedAt
+}
+
+var ChatMessage_Recalled_DEFAULT bool
+
+func (p *ChatMessage) GetRecalled() (v bool) {
+	if !p.IsSetRecalled() {
+		return ChatMessage_Recalled_DEFAULT
+	}
+	return *p.Recalled
+}
 func (p *ChatMessage) SetId(val int64) {
 	p.Id = val
 }
@@ -1065,11 +2296,35 @@ func (p *ChatMessage) SetUpdatedAt(val int64) {
 func (p *ChatMessage) SetDeletedAt(val *int64) {
 	p.DeletedAt = val
 }
+func (p *ChatMessage) SetSeq(val int64) {
+	p.Seq = val
+}
+func (p *ChatMessage) SetReplyToId(val *int64) {
+	p.ReplyToId = val
+}
+func (p *ChatMessage) SetEditedAt(val *int64) {
+	p.EditedAt = val
+}
+func (p *ChatMessage) SetRecalled(val *bool) {
+	p.Recalled = val
+}
 
 func (p *ChatMessage) IsSetDeletedAt() bool {
 	return p.DeletedAt != nil
 }
 
+func (p *ChatMessage) IsSetReplyToId() bool {
+	return p.ReplyToId != nil
+}
+
+func (p *ChatMessage) IsSetEditedAt() bool {
+	return p.EditedAt != nil
+}
+
+func (p *ChatMessage) IsSetRecalled() bool {
+	return p.Recalled != nil
+}
+
 func (p *ChatMessage) String() string {
 	if p == nil {
 		return "<nil>"
@@ -1078,14 +2333,18 @@ func (p *ChatMessage) String() string {
 }
 
 var fieldIDToName_ChatMessage = map[int16]string{
-	1: "id",
-	2: "room_id",
-	3: "sender_id",
-	4: "content",
-	5: "type",
-	6: "created_at",
-	7: "updated_at",
-	8: "deleted_at",
+	1:  "id",
+	2:  "room_id",
+	3:  "sender_id",
+	4:  "content",
+	5:  "type",
+	6:  "created_at",
+	7:  "updated_at",
+	8:  "deleted_at",
+	9:  "seq",
+	10: "reply_to_id",
+	11: "edited_at",
+	12: "recalled",
 }
 
 type Friendship struct {
@@ -1308,6 +2567,387 @@ var fieldIDToName_FriendRequest = map[int16]string{
 	8: "deleted_at",
 }
 
+type FriendRecommendation struct {
+	UserId        int64   `thrift:"user_id,1,required" frugal:"1,required,i64" json:"user_id"`
+	Score         float64 `thrift:"score,2,required" frugal:"2,required,double" json:"score"`
+	MutualFriends int64   `thrift:"mutual_friends,3,required" frugal:"3,required,i64" json:"mutual_friends"`
+	MutualFollows int64   `thrift:"mutual_follows,4,required" frugal:"4,required,i64" json:"mutual_follows"`
+	SharedLikes   int64   `thrift:"shared_likes,5,required" frugal:"5,required,i64" json:"shared_likes"`
+	CoComments    int64   `thrift:"co_comments,6,required" frugal:"6,required,i64" json:"co_comments"`
+}
+
+func NewFriendRecommendation() *FriendRecommendation {
+	return &FriendRecommendation{}
+}
+
+func (p *FriendRecommendation) InitDefault() {
+}
+
+func (p *FriendRecommendation) GetUserId() (v int64) {
+	return p.UserId
+}
+
+func (p *FriendRecommendation) GetScore() (v float64) {
+	return p.Score
+}
+
+func (p *FriendRecommendation) GetMutualFriends() (v int64) {
+	return p.MutualFriends
+}
+
+func (p *FriendRecommendation) GetMutualFollows() (v int64) {
+	return p.MutualFollows
+}
+
+func (p *FriendRecommendation) GetSharedLikes() (v int64) {
+	return p.SharedLikes
+}
+
+func (p *FriendRecommendation) GetCoComments() (v int64) {
+	return p.CoComments
+}
+func (p *FriendRecommendation) SetUserId(val int64) {
+	p.UserId = val
+}
+func (p *FriendRecommendation) SetScore(val float64) {
+	p.Score = val
+}
+func (p *FriendRecommendation) SetMutualFriends(val int64) {
+	p.MutualFriends = val
+}
+func (p *FriendRecommendation) SetMutualFollows(val int64) {
+	p.MutualFollows = val
+}
+func (p *FriendRecommendation) SetSharedLikes(val int64) {
+	p.SharedLikes = val
+}
+func (p *FriendRecommendation) SetCoComments(val int64) {
+	p.CoComments = val
+}
+
+func (p *FriendRecommendation) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("FriendRecommendation(%+v)", *p)
+}
+
+var fieldIDToName_FriendRecommendation = map[int16]string{
+	1: "user_id",
+	2: "score",
+	3: "mutual_friends",
+	4: "mutual_follows",
+	5: "shared_likes",
+	6: "co_comments",
+}
+
+type Notification struct {
+	Id         int64   `thrift:"id,1,required" frugal:"1,required,i64" json:"id"`
+	Type       int8    `thrift:"type,2,required" frugal:"2,required,i8" json:"type"`
+	TargetId   int64   `thrift:"target_id,3,required" frugal:"3,required,i64" json:"target_id"`
+	ActorIds   []int64 `thrift:"actor_ids,4,required" frugal:"4,required,list<i64>" json:"actor_ids"`
+	ActorCount int64   `thrift:"actor_count,5,required" frugal:"5,required,i64" json:"actor_count"`
+	IsRead     bool    `thrift:"is_read,6,required" frugal:"6,required,bool" json:"is_read"`
+	CreatedAt  int64   `thrift:"created_at,7,required" frugal:"7,required,i64" json:"created_at"`
+	UpdatedAt  int64   `thrift:"updated_at,8,required" frugal:"8,required,i64" json:"updated_at"`
+}
+
+func NewNotification() *Notification {
+	return &Notification{}
+}
+
+func (p *Notification) InitDefault() {
+}
+
+func (p *Notification) GetId() (v int64) {
+	return p.Id
+}
+
+func (p *Notification) GetType() (v int8) {
+	return p.Type
+}
+
+func (p *Notification) GetTargetId() (v int64) {
+	return p.TargetId
+}
+
+func (p *Notification) GetActorIds() (v []int64) {
+	return p.ActorIds
+}
+
+func (p *Notification) GetActorCount() (v int64) {
+	return p.ActorCount
+}
+
+func (p *Notification) GetIsRead() (v bool) {
+	return p.IsRead
+}
+
+func (p *Notification) GetCreatedAt() (v int64) {
+	return p.CreatedAt
+}
+
+func (p *Notification) GetUpdatedAt() (v int64) {
+	return p.UpdatedAt
+}
+func (p *Notification) SetId(val int64) {
+	p.Id = val
+}
+func (p *Notification) SetType(val int8) {
+	p.Type = val
+}
+func (p *Notification) SetTargetId(val int64) {
+	p.TargetId = val
+}
+func (p *Notification) SetActorIds(val []int64) {
+	p.ActorIds = val
+}
+func (p *Notification) SetActorCount(val int64) {
+	p.ActorCount = val
+}
+func (p *Notification) SetIsRead(val bool) {
+	p.IsRead = val
+}
+func (p *Notification) SetCreatedAt(val int64) {
+	p.CreatedAt = val
+}
+func (p *Notification) SetUpdatedAt(val int64) {
+	p.UpdatedAt = val
+}
+
+func (p *Notification) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("Notification(%+v)", *p)
+}
+
+var fieldIDToName_Notification = map[int16]string{
+	1: "id",
+	2: "type",
+	3: "target_id",
+	4: "actor_ids",
+	5: "actor_count",
+	6: "is_read",
+	7: "created_at",
+	8: "updated_at",
+}
+
+type NotificationUnreadCount struct {
+	Type  int8  `thrift:"type,1,required" frugal:"1,required,i8" json:"type"`
+	Count int64 `thrift:"count,2,required" frugal:"2,required,i64" json:"count"`
+}
+
+func NewNotificationUnreadCount() *NotificationUnreadCount {
+	return &NotificationUnreadCount{}
+}
+
+func (p *NotificationUnreadCount) InitDefault() {
+}
+
+func (p *NotificationUnreadCount) GetType() (v int8) {
+	return p.Type
+}
+
+func (p *NotificationUnreadCount) GetCount() (v int64) {
+	return p.Count
+}
+func (p *NotificationUnreadCount) SetType(val int8) {
+	p.Type = val
+}
+func (p *NotificationUnreadCount) SetCount(val int64) {
+	p.Count = val
+}
+
+func (p *NotificationUnreadCount) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("NotificationUnreadCount(%+v)", *p)
+}
+
+var fieldIDToName_NotificationUnreadCount = map[int16]string{
+	1: "type",
+	2: "count",
+}
+
+type NotificationPreference struct {
+	Type    int8 `thrift:"type,1,required" frugal:"1,required,i8" json:"type"`
+	Enabled bool `thrift:"enabled,2,required" frugal:"2,required,bool" json:"enabled"`
+}
+
+func NewNotificationPreference() *NotificationPreference {
+	return &NotificationPreference{}
+}
+
+func (p *NotificationPreference) InitDefault() {
+}
+
+func (p *NotificationPreference) GetType() (v int8) {
+	return p.Type
+}
+
+func (p *NotificationPreference) GetEnabled() (v bool) {
+	return p.Enabled
+}
+func (p *NotificationPreference) SetType(val int8) {
+	p.Type = val
+}
+func (p *NotificationPreference) SetEnabled(val bool) {
+	p.Enabled = val
+}
+
+func (p *NotificationPreference) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("NotificationPreference(%+v)", *p)
+}
+
+var fieldIDToName_NotificationPreference = map[int16]string{
+	1: "type",
+	2: "enabled",
+}
+
+type UserPresence struct {
+	UserId   int64 `thrift:"user_id,1,required" frugal:"1,required,i64" json:"user_id"`
+	Online   bool  `thrift:"online,2,required" frugal:"2,required,bool" json:"online"`
+	LastSeen int64 `thrift:"last_seen,3,required" frugal:"3,required,i64" json:"last_seen"`
+}
+
+func NewUserPresence() *UserPresence {
+	return &UserPresence{}
+}
+
+func (p *UserPresence) InitDefault() {
+}
+
+func (p *UserPresence) GetUserId() (v int64) {
+	return p.UserId
+}
+
+func (p *UserPresence) GetOnline() (v bool) {
+	return p.Online
+}
+
+func (p *UserPresence) GetLastSeen() (v int64) {
+	return p.LastSeen
+}
+func (p *UserPresence) SetUserId(val int64) {
+	p.UserId = val
+}
+func (p *UserPresence) SetOnline(val bool) {
+	p.Online = val
+}
+func (p *UserPresence) SetLastSeen(val int64) {
+	p.LastSeen = val
+}
+
+func (p *UserPresence) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserPresence(%+v)", *p)
+}
+
+var fieldIDToName_UserPresence = map[int16]string{
+	1: "user_id",
+	2: "online",
+	3: "last_seen",
+}
+
+type SearchedMessage struct {
+	Id         int64  `thrift:"id,1,required" frugal:"1,required,i64" json:"id"`
+	RoomId     int64  `thrift:"room_id,2,required" frugal:"2,required,i64" json:"room_id"`
+	SenderId   int64  `thrift:"sender_id,3,required" frugal:"3,required,i64" json:"sender_id"`
+	ReceiverId int64  `thrift:"receiver_id,4,required" frugal:"4,required,i64" json:"receiver_id"`
+	Content    string `thrift:"content,5,required" frugal:"5,required,string" json:"content"`
+	Type       int8   `thrift:"type,6,required" frugal:"6,required,i8" json:"type"`
+	Seq        int64  `thrift:"seq,7,required" frugal:"7,required,i64" json:"seq"`
+	CreatedAt  int64  `thrift:"created_at,8,required" frugal:"8,required,i64" json:"created_at"`
+}
+
+func NewSearchedMessage() *SearchedMessage {
+	return &SearchedMessage{}
+}
+
+func (p *SearchedMessage) InitDefault() {
+}
+
+func (p *SearchedMessage) GetId() (v int64) {
+	return p.Id
+}
+
+func (p *SearchedMessage) GetRoomId() (v int64) {
+	return p.RoomId
+}
+
+func (p *SearchedMessage) GetSenderId() (v int64) {
+	return p.SenderId
+}
+
+func (p *SearchedMessage) GetReceiverId() (v int64) {
+	return p.ReceiverId
+}
+
+func (p *SearchedMessage) GetContent() (v string) {
+	return p.Content
+}
+
+func (p *SearchedMessage) GetType() (v int8) {
+	return p.Type
+}
+
+func (p *SearchedMessage) GetSeq() (v int64) {
+	return p.Seq
+}
+
+func (p *SearchedMessage) GetCreatedAt() (v int64) {
+	return p.CreatedAt
+}
+func (p *SearchedMessage) SetId(val int64) {
+	p.Id = val
+}
+func (p *SearchedMessage) SetRoomId(val int64) {
+	p.RoomId = val
+}
+func (p *SearchedMessage) SetSenderId(val int64) {
+	p.SenderId = val
+}
+func (p *SearchedMessage) SetReceiverId(val int64) {
+	p.ReceiverId = val
+}
+func (p *SearchedMessage) SetContent(val string) {
+	p.Content = val
+}
+func (p *SearchedMessage) SetType(val int8) {
+	p.Type = val
+}
+func (p *SearchedMessage) SetSeq(val int64) {
+	p.Seq = val
+}
+func (p *SearchedMessage) SetCreatedAt(val int64) {
+	p.CreatedAt = val
+}
+
+func (p *SearchedMessage) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SearchedMessage(%+v)", *p)
+}
+
+var fieldIDToName_SearchedMessage = map[int16]string{
+	1: "id",
+	2: "room_id",
+	3: "sender_id",
+	4: "receiver_id",
+	5: "content",
+	6: "type",
+	7: "seq",
+	8: "created_at",
+}
+
 type SemanticSearchResultItem struct {
 	Videos         []*Video `thrift:"videos,1,required" frugal:"1,required,list<Video>" json:"videos"`
 	Summary        *string  `thrift:"summary,2,optional" frugal:"2,optional,string" json:"summary,omitempty"`
@@ -13,13 +13,14 @@ import (
 type MessageType int64
 
 const (
-	MessageType_TEXT    MessageType = 0
-	MessageType_IMAGE   MessageType = 1
-	MessageType_VIDEO   MessageType = 2
-	MessageType_FILE    MessageType = 3
-	MessageType_SYSTEM  MessageType = 4
-	MessageType_PRIVATE MessageType = 5
-	MessageType_GROUP   MessageType = 6
+	MessageType_TEXT       MessageType = 0
+	MessageType_IMAGE      MessageType = 1
+	MessageType_VIDEO      MessageType = 2
+	MessageType_FILE       MessageType = 3
+	MessageType_SYSTEM     MessageType = 4
+	MessageType_PRIVATE    MessageType = 5
+	MessageType_GROUP      MessageType = 6
+	MessageType_VIDEO_CARD MessageType = 7
 )
 
 func (p MessageType) String() string {
@@ -38,6 +39,8 @@ func (p MessageType) String() string {
 		return "PRIVATE"
 	case MessageType_GROUP:
 		return "GROUP"
+	case MessageType_VIDEO_CARD:
+		return "VIDEO_CARD"
 	}
 	return "<UNSET>"
 }
@@ -58,6 +61,8 @@ func MessageTypeFromString(s string) (MessageType, error) {
 		return MessageType_PRIVATE, nil
 	case "GROUP":
 		return MessageType_GROUP, nil
+	case "VIDEO_CARD":
+		return MessageType_VIDEO_CARD, nil
 	}
 	return MessageType(0), fmt.Errorf("not a valid MessageType string")
 }
@@ -172,9 +177,9 @@ func (p *FriendshipStatus) Value() (driver.Value, error) {
 }
 
 type SendPrivateMessageRequest struct {
-	SenderId   int64  `thrift:"sender_id,1,required" frugal:"1,required,i64" json:"sender_id"`
 	ReceiverId int64  `thrift:"receiver_id,2,required" frugal:"2,required,i64" json:"receiver_id"`
 	Content    string `thrift:"content,3,required" frugal:"3,required,string" json:"content"`
+	ReplyToId  *int64 `thrift:"reply_to_id,4,optional" frugal:"4,optional,i64" json:"reply_to_id,omitempty"`
 }
 
 func NewSendPrivateMessageRequest() *SendPrivateMessageRequest {
@@ -184,10 +189,6 @@ func NewSendPrivateMessageRequest() *SendPrivateMessageRequest {
 func (p *SendPrivateMessageRequest) InitDefault() {
 }
 
-func (p *SendPrivateMessageRequest) GetSenderId() (v int64) {
-	return p.SenderId
-}
-
 func (p *SendPrivateMessageRequest) GetReceiverId() (v int64) {
 	return p.ReceiverId
 }
@@ -195,8 +196,14 @@ func (p *SendPrivateMessageRequest) GetReceiverId() (v int64) {
 func (p *SendPrivateMessageRequest) GetContent() (v string) {
 	return p.Content
 }
-func (p *SendPrivateMessageRequest) SetSenderId(val int64) {
-	p.SenderId = val
+
+var SendPrivateMessageRequest_ReplyToId_DEFAULT int64
+
+func (p *SendPrivateMessageRequest) GetReplyToId() (v int64) {
+	if !p.IsSetReplyToId() {
+		return SendPrivateMessageRequest_ReplyToId_DEFAULT
+	}
+	return *p.ReplyToId
 }
 func (p *SendPrivateMessageRequest) SetReceiverId(val int64) {
 	p.ReceiverId = val
@@ -204,6 +211,13 @@ func (p *SendPrivateMessageRequest) SetReceiverId(val int64) {
 func (p *SendPrivateMessageRequest) SetContent(val string) {
 	p.Content = val
 }
+func (p *SendPrivateMessageRequest) SetReplyToId(val *int64) {
+	p.ReplyToId = val
+}
+
+func (p *SendPrivateMessageRequest) IsSetReplyToId() bool {
+	return p.ReplyToId != nil
+}
 
 func (p *SendPrivateMessageRequest) String() string {
 	if p == nil {
@@ -213,9 +227,9 @@ func (p *SendPrivateMessageRequest) String() string {
 }
 
 var fieldIDToName_SendPrivateMessageRequest = map[int16]string{
-	1: "sender_id",
 	2: "receiver_id",
 	3: "content",
+	4: "reply_to_id",
 }
 
 type SendPrivateMessageResponse struct {
@@ -405,7 +419,6 @@ var fieldIDToName_GetPrivateMessagesResponse = map[int16]string{
 }
 
 type CreateChatRoomRequest struct {
-	CreatorId int64   `thrift:"creator_id,1,required" frugal:"1,required,i64" json:"creator_id"`
 	Name      string  `thrift:"name,2,required" frugal:"2,required,string" json:"name"`
 	Type      int8    `thrift:"type,3,required" frugal:"3,required,i8" json:"type"`
 	MemberIds []int64 `thrift:"member_ids,4,optional" frugal:"4,optional,list<i64>" json:"member_ids,omitempty"`
@@ -418,10 +431,6 @@ func NewCreateChatRoomRequest() *CreateChatRoomRequest {
 func (p *CreateChatRoomRequest) InitDefault() {
 }
 
-func (p *CreateChatRoomRequest) GetCreatorId() (v int64) {
-	return p.CreatorId
-}
-
 func (p *CreateChatRoomRequest) GetName() (v string) {
 	return p.Name
 }
@@ -438,9 +447,6 @@ func (p *CreateChatRoomRequest) GetMemberIds() (v []int64) {
 	}
 	return p.MemberIds
 }
-func (p *CreateChatRoomRequest) SetCreatorId(val int64) {
-	p.CreatorId = val
-}
 func (p *CreateChatRoomRequest) SetName(val string) {
 	p.Name = val
 }
@@ -463,7 +469,6 @@ func (p *CreateChatRoomRequest) String() string {
 }
 
 var fieldIDToName_CreateChatRoomRequest = map[int16]string{
-	1: "creator_id",
 	2: "name",
 	3: "type",
 	4: "member_ids",
@@ -527,7 +532,6 @@ var fieldIDToName_CreateChatRoomResponse = map[int16]string{
 
 type GetChatRoomRequest struct {
 	RoomId int64 `thrift:"room_id,1,required" frugal:"1,required,i64" json:"room_id"`
-	UserId int64 `thrift:"user_id,2,required" frugal:"2,required,i64" json:"user_id"`
 }
 
 func NewGetChatRoomRequest() *GetChatRoomRequest {
@@ -540,16 +544,9 @@ func (p *GetChatRoomRequest) InitDefault() {
 func (p *GetChatRoomRequest) GetRoomId() (v int64) {
 	return p.RoomId
 }
-
-func (p *GetChatRoomRequest) GetUserId() (v int64) {
-	return p.UserId
-}
 func (p *GetChatRoomRequest) SetRoomId(val int64) {
 	p.RoomId = val
 }
-func (p *GetChatRoomRequest) SetUserId(val int64) {
-	p.UserId = val
-}
 
 func (p *GetChatRoomRequest) String() string {
 	if p == nil {
@@ -560,7 +557,6 @@ func (p *GetChatRoomRequest) String() string {
 
 var fieldIDToName_GetChatRoomRequest = map[int16]string{
 	1: "room_id",
-	2: "user_id",
 }
 
 type GetChatRoomResponse struct {
@@ -741,10 +737,10 @@ var fieldIDToName_GetUserChatRoomsResponse = map[int16]string{
 }
 
 type SendChatMessageRequest struct {
-	RoomId   int64  `thrift:"room_id,1,required" frugal:"1,required,i64" json:"room_id"`
-	SenderId int64  `thrift:"sender_id,2,required" frugal:"2,required,i64" json:"sender_id"`
-	Content  string `thrift:"content,3,required" frugal:"3,required,string" json:"content"`
-	Type     *int8  `thrift:"type,4,optional" frugal:"4,optional,i8" json:"type,omitempty"`
+	RoomId    int64  `thrift:"room_id,1,required" frugal:"1,required,i64" json:"room_id"`
+	Content   string `thrift:"content,3,required" frugal:"3,required,string" json:"content"`
+	Type      *int8  `thrift:"type,4,optional" frugal:"4,optional,i8" json:"type,omitempty"`
+	ReplyToId *int64 `thrift:"reply_to_id,5,optional" frugal:"5,optional,i64" json:"reply_to_id,omitempty"`
 }
 
 func NewSendChatMessageRequest() *SendChatMessageRequest {
@@ -758,10 +754,6 @@ func (p *SendChatMessageRequest) GetRoomId() (v int64) {
 	return p.RoomId
 }
 
-func (p *SendChatMessageRequest) GetSenderId() (v int64) {
-	return p.SenderId
-}
-
 func (p *SendChatMessageRequest) GetContent() (v string) {
 	return p.Content
 }
@@ -774,23 +766,36 @@ func (p *SendChatMessageRequest) GetType() (v int8) {
 	}
 	return *p.Type
 }
+
+var SendChatMessageRequest_ReplyToId_DEFAULT int64
+
+func (p *SendChatMessageRequest) GetReplyToId() (v int64) {
+	if !p.IsSetReplyToId() {
+		return SendChatMessageRequest_ReplyToId_DEFAULT
+	}
+	return *p.ReplyToId
+}
 func (p *SendChatMessageRequest) SetRoomId(val int64) {
 	p.RoomId = val
 }
-func (p *SendChatMessageRequest) SetSenderId(val int64) {
-	p.SenderId = val
-}
 func (p *SendChatMessageRequest) SetContent(val string) {
 	p.Content = val
 }
 func (p *SendChatMessageRequest) SetType(val *int8) {
 	p.Type = val
 }
+func (p *SendChatMessageRequest) SetReplyToId(val *int64) {
+	p.ReplyToId = val
+}
 
 func (p *SendChatMessageRequest) IsSetType() bool {
 	return p.Type != nil
 }
 
+func (p *SendChatMessageRequest) IsSetReplyToId() bool {
+	return p.ReplyToId != nil
+}
+
 func (p *SendChatMessageRequest) String() string {
 	if p == nil {
 		return "<nil>"
@@ -800,9 +805,9 @@ func (p *SendChatMessageRequest) String() string {
 
 var fieldIDToName_SendChatMessageRequest = map[int16]string{
 	1: "room_id",
-	2: "sender_id",
 	3: "content",
 	4: "type",
+	5: "reply_to_id",
 }
 
 type SendChatMessageResponse struct {
@@ -863,7 +868,6 @@ var fieldIDToName_SendChatMessageResponse = map[int16]string{
 
 type GetChatMessagesRequest struct {
 	RoomId int64  `thrift:"room_id,1,required" frugal:"1,required,i64" json:"room_id"`
-	UserId int64  `thrift:"user_id,2,required" frugal:"2,required,i64" json:"user_id"`
 	Page   *int64 `thrift:"page,3,optional" frugal:"3,optional,i64" json:"page,omitempty"`
 	Size   *int32 `thrift:"size,4,optional" frugal:"4,optional,i32" json:"size,omitempty"`
 }
@@ -879,10 +883,6 @@ func (p *GetChatMessagesRequest) GetRoomId() (v int64) {
 	return p.RoomId
 }
 
-func (p *GetChatMessagesRequest) GetUserId() (v int64) {
-	return p.UserId
-}
-
 var GetChatMessagesRequest_Page_DEFAULT int64
 
 func (p *GetChatMessagesRequest) GetPage() (v int64) {
@@ -903,9 +903,6 @@ func (p *GetChatMessagesRequest) GetSize() (v int32) {
 func (p *GetChatMessagesRequest) SetRoomId(val int64) {
 	p.RoomId = val
 }
-func (p *GetChatMessagesRequest) SetUserId(val int64) {
-	p.UserId = val
-}
 func (p *GetChatMessagesRequest) SetPage(val *int64) {
 	p.Page = val
 }
@@ -930,7 +927,6 @@ func (p *GetChatMessagesRequest) String() string {
 
 var fieldIDToName_GetChatMessagesRequest = map[int16]string{
 	1: "room_id",
-	2: "user_id",
 	3: "page",
 	4: "size",
 }
@@ -992,7 +988,6 @@ var fieldIDToName_GetChatMessagesResponse = map[int16]string{
 }
 
 type AddFriendRequest struct {
-	UserId   int64   `thrift:"user_id,1,required" frugal:"1,required,i64" json:"user_id"`
 	FriendId int64   `thrift:"friend_id,2,required" frugal:"2,required,i64" json:"friend_id"`
 	Remark   *string `thrift:"remark,3,optional" frugal:"3,optional,string" json:"remark,omitempty"`
 }
@@ -1004,10 +999,6 @@ func NewAddFriendRequest() *AddFriendRequest {
 func (p *AddFriendRequest) InitDefault() {
 }
 
-func (p *AddFriendRequest) GetUserId() (v int64) {
-	return p.UserId
-}
-
 func (p *AddFriendRequest) GetFriendId() (v int64) {
 	return p.FriendId
 }
@@ -1020,9 +1011,6 @@ func (p *AddFriendRequest) GetRemark() (v string) {
 	}
 	return *p.Remark
 }
-func (p *AddFriendRequest) SetUserId(val int64) {
-	p.UserId = val
-}
 func (p *AddFriendRequest) SetFriendId(val int64) {
 	p.FriendId = val
 }
@@ -1042,14 +1030,14 @@ func (p *AddFriendRequest) String() string {
 }
 
 var fieldIDToName_AddFriendRequest = map[int16]string{
-	1: "user_id",
 	2: "friend_id",
 	3: "remark",
 }
 
 type AddFriendResponse struct {
-	Base   *model.BaseResp   `thrift:"Base,1,required" frugal:"1,required,model.BaseResp" json:"Base"`
-	Friend *model.Friendship `thrift:"Friend,2,required" frugal:"2,required,model.Friendship" json:"Friend"`
+	Base    *model.BaseResp      `thrift:"Base,1,required" frugal:"1,required,model.BaseResp" json:"Base"`
+	Friend  *model.Friendship    `thrift:"Friend,2,required" frugal:"2,required,model.Friendship" json:"Friend"`
+	Request *model.FriendRequest `thrift:"Request,3,optional" frugal:"3,optional,model.FriendRequest" json:"Request,omitempty"`
 }
 
 func NewAddFriendResponse() *AddFriendResponse {
@@ -1076,12 +1064,24 @@ func (p *AddFriendResponse) GetFriend() (v *model.Friendship) {
 	}
 	return p.Friend
 }
+
+var AddFriendResponse_Request_DEFAULT *model.FriendRequest
+
+func (p *AddFriendResponse) GetRequest() (v *model.FriendRequest) {
+	if !p.IsSetRequest() {
+		return AddFriendResponse_Request_DEFAULT
+	}
+	return p.Request
+}
 func (p *AddFriendResponse) SetBase(val *model.BaseResp) {
 	p.Base = val
 }
 func (p *AddFriendResponse) SetFriend(val *model.Friendship) {
 	p.Friend = val
 }
+func (p *AddFriendResponse) SetRequest(val *model.FriendRequest) {
+	p.Request = val
+}
 
 func (p *AddFriendResponse) IsSetBase() bool {
 	return p.Base != nil
@@ -1091,6 +1091,10 @@ func (p *AddFriendResponse) IsSetFriend() bool {
 	return p.Friend != nil
 }
 
+func (p *AddFriendResponse) IsSetRequest() bool {
+	return p.Request != nil
+}
+
 func (p *AddFriendResponse) String() string {
 	if p == nil {
 		return "<nil>"
@@ -1101,6 +1105,7 @@ func (p *AddFriendResponse) String() string {
 var fieldIDToName_AddFriendResponse = map[int16]string{
 	1: "Base",
 	2: "Friend",
+	3: "Request",
 }
 
 type GetUserFriendsRequest struct {
@@ -1319,7 +1324,6 @@ var fieldIDToName_GetFriendshipResponse = map[int16]string{
 }
 
 type CreateFriendRequestRequest struct {
-	SenderId   int64   `thrift:"sender_id,1,required" frugal:"1,required,i64" json:"sender_id"`
 	ReceiverId int64   `thrift:"receiver_id,2,required" frugal:"2,required,i64" json:"receiver_id"`
 	Message    *string `thrift:"message,3,optional" frugal:"3,optional,string" json:"message,omitempty"`
 }
@@ -1331,10 +1335,6 @@ func NewCreateFriendRequestRequest() *CreateFriendRequestRequest {
 func (p *CreateFriendRequestRequest) InitDefault() {
 }
 
-func (p *CreateFriendRequestRequest) GetSenderId() (v int64) {
-	return p.SenderId
-}
-
 func (p *CreateFriendRequestRequest) GetReceiverId() (v int64) {
 	return p.ReceiverId
 }
@@ -1347,9 +1347,6 @@ func (p *CreateFriendRequestRequest) GetMessage() (v string) {
 	}
 	return *p.Message
 }
-func (p *CreateFriendRequestRequest) SetSenderId(val int64) {
-	p.SenderId = val
-}
 func (p *CreateFriendRequestRequest) SetReceiverId(val int64) {
 	p.ReceiverId = val
 }
@@ -1369,7 +1366,6 @@ func (p *CreateFriendRequestRequest) String() string {
 }
 
 var fieldIDToName_CreateFriendRequestRequest = map[int16]string{
-	1: "sender_id",
 	2: "receiver_id",
 	3: "message",
 }
@@ -1431,10 +1427,9 @@ var fieldIDToName_CreateFriendRequestResponse = map[int16]string{
 }
 
 type GetFriendRequestsRequest struct {
-	UserId int64  `thrift:"user_id,1,required" frugal:"1,required,i64" json:"user_id"`
-	Type   *int8  `thrift:"type,2,optional" frugal:"2,optional,i8" json:"type,omitempty"`
-	Page   *int32 `thrift:"page,3,optional" frugal:"3,optional,i32" json:"page,omitempty"`
-	Size   *int32 `thrift:"size,4,optional" frugal:"4,optional,i32" json:"size,omitempty"`
+	Type *int8  `thrift:"type,2,optional" frugal:"2,optional,i8" json:"type,omitempty"`
+	Page *int32 `thrift:"page,3,optional" frugal:"3,optional,i32" json:"page,omitempty"`
+	Size *int32 `thrift:"size,4,optional" frugal:"4,optional,i32" json:"size,omitempty"`
 }
 
 func NewGetFriendRequestsRequest() *GetFriendRequestsRequest {
@@ -1444,10 +1439,6 @@ func NewGetFriendRequestsRequest() *GetFriendRequestsRequest {
 func (p *GetFriendRequestsRequest) InitDefault() {
 }
 
-func (p *GetFriendRequestsRequest) GetUserId() (v int64) {
-	return p.UserId
-}
-
 var GetFriendRequestsRequest_Type_DEFAULT int8
 
 func (p *GetFriendRequestsRequest) GetType() (v int8) {
@@ -1474,9 +1465,6 @@ func (p *GetFriendRequestsRequest) GetSize() (v int32) {
 	}
 	return *p.Size
 }
-func (p *GetFriendRequestsRequest) SetUserId(val int64) {
-	p.UserId = val
-}
 func (p *GetFriendRequestsRequest) SetType(val *int8) {
 	p.Type = val
 }
@@ -1507,7 +1495,6 @@ func (p *GetFriendRequestsRequest) String() string {
 }
 
 var fieldIDToName_GetFriendRequestsRequest = map[int16]string{
-	1: "user_id",
 	2: "type",
 	3: "page",
 	4: "size",
@@ -1569,9 +1556,93 @@ var fieldIDToName_GetFriendRequestsResponse = map[int16]string{
 	3: "Total",
 }
 
+type GetFriendRecommendationsRequest struct {
+	Limit *int32 `thrift:"limit,2,optional" frugal:"2,optional,i32" json:"limit,omitempty"`
+}
+
+func NewGetFriendRecommendationsRequest() *GetFriendRecommendationsRequest {
+	return &GetFriendRecommendationsRequest{}
+}
+
+func (p *GetFriendRecommendationsRequest) InitDefault() {
+}
+
+var GetFriendRecommendationsRequest_Limit_DEFAULT int32
+
+func (p *GetFriendRecommendationsRequest) GetLimit() (v int32) {
+	if !p.IsSetLimit() {
+		return GetFriendRecommendationsRequest_Limit_DEFAULT
+	}
+	return *p.Limit
+}
+func (p *GetFriendRecommendationsRequest) SetLimit(val *int32) {
+	p.Limit = val
+}
+
+func (p *GetFriendRecommendationsRequest) IsSetLimit() bool {
+	return p.Limit != nil
+}
+
+func (p *GetFriendRecommendationsRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GetFriendRecommendationsRequest(%+v)", *p)
+}
+
+var fieldIDToName_GetFriendRecommendationsRequest = map[int16]string{
+	2: "limit",
+}
+
+type GetFriendRecommendationsResponse struct {
+	Base            *model.BaseResp               `thrift:"Base,1,required" frugal:"1,required,model.BaseResp" json:"Base"`
+	Recommendations []*model.FriendRecommendation `thrift:"Recommendations,2,required" frugal:"2,required,list<model.FriendRecommendation>" json:"Recommendations"`
+}
+
+func NewGetFriendRecommendationsResponse() *GetFriendRecommendationsResponse {
+	return &GetFriendRecommendationsResponse{}
+}
+
+func (p *GetFriendRecommendationsResponse) InitDefault() {
+}
+
+var GetFriendRecommendationsResponse_Base_DEFAULT *model.BaseResp
+
+func (p *GetFriendRecommendationsResponse) GetBase() (v *model.BaseResp) {
+	if !p.IsSetBase() {
+		return GetFriendRecommendationsResponse_Base_DEFAULT
+	}
+	return p.Base
+}
+
+func (p *GetFriendRecommendationsResponse) GetRecommendations() (v []*model.FriendRecommendation) {
+	return p.Recommendations
+}
+func (p *GetFriendRecommendationsResponse) SetBase(val *model.BaseResp) {
+	p.Base = val
+}
+func (p *GetFriendRecommendationsResponse) SetRecommendations(val []*model.FriendRecommendation) {
+	p.Recommendations = val
+}
+
+func (p *GetFriendRecommendationsResponse) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *GetFriendRecommendationsResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GetFriendRecommendationsResponse(%+v)", *p)
+}
+
+var fieldIDToName_GetFriendRecommendationsResponse = map[int16]string{
+	1: "Base",
+	2: "Recommendations",
+}
+
 type HandleFriendRequestRequest struct {
 	RequestId int64 `thrift:"request_id,1,required" frugal:"1,required,i64" json:"request_id"`
-	UserId    int64 `thrift:"user_id,2,required" frugal:"2,required,i64" json:"user_id"`
 	Action    int8  `thrift:"action,3,required" frugal:"3,required,i8" json:"action"`
 }
 
@@ -1586,19 +1657,12 @@ func (p *HandleFriendRequestRequest) GetRequestId() (v int64) {
 	return p.RequestId
 }
 
-func (p *HandleFriendRequestRequest) GetUserId() (v int64) {
-	return p.UserId
-}
-
 func (p *HandleFriendRequestRequest) GetAction() (v int8) {
 	return p.Action
 }
 func (p *HandleFriendRequestRequest) SetRequestId(val int64) {
 	p.RequestId = val
 }
-func (p *HandleFriendRequestRequest) SetUserId(val int64) {
-	p.UserId = val
-}
 func (p *HandleFriendRequestRequest) SetAction(val int8) {
 	p.Action = val
 }
@@ -1612,7 +1676,6 @@ func (p *HandleFriendRequestRequest) String() string {
 
 var fieldIDToName_HandleFriendRequestRequest = map[int16]string{
 	1: "request_id",
-	2: "user_id",
 	3: "action",
 }
 
@@ -1692,7 +1755,6 @@ var fieldIDToName_HandleFriendRequestResponse = map[int16]string{
 
 type MarkMessageReadRequest struct {
 	MessageId int64 `thrift:"message_id,1,required" frugal:"1,required,i64" json:"message_id"`
-	UserId    int64 `thrift:"user_id,2,required" frugal:"2,required,i64" json:"user_id"`
 }
 
 func NewMarkMessageReadRequest() *MarkMessageReadRequest {
@@ -1705,16 +1767,9 @@ func (p *MarkMessageReadRequest) InitDefault() {
 func (p *MarkMessageReadRequest) GetMessageId() (v int64) {
 	return p.MessageId
 }
-
-func (p *MarkMessageReadRequest) GetUserId() (v int64) {
-	return p.UserId
-}
 func (p *MarkMessageReadRequest) SetMessageId(val int64) {
 	p.MessageId = val
 }
-func (p *MarkMessageReadRequest) SetUserId(val int64) {
-	p.UserId = val
-}
 
 func (p *MarkMessageReadRequest) String() string {
 	if p == nil {
@@ -1725,11 +1780,11 @@ func (p *MarkMessageReadRequest) String() string {
 
 var fieldIDToName_MarkMessageReadRequest = map[int16]string{
 	1: "message_id",
-	2: "user_id",
 }
 
 type MarkMessageReadResponse struct {
-	Base *model.BaseResp `thrift:"Base,1,required" frugal:"1,required,model.BaseResp" json:"Base"`
+	Base   *model.BaseResp   `thrift:"Base,1,required" frugal:"1,required,model.BaseResp" json:"Base"`
+	Cursor *model.ReadCursor `thrift:"Cursor,2,optional" frugal:"2,optional,model.ReadCursor" json:"Cursor,omitempty"`
 }
 
 func NewMarkMessageReadResponse() *MarkMessageReadResponse {
@@ -1747,14 +1802,30 @@ func (p *MarkMessageReadResponse) GetBase() (v *model.BaseResp) {
 	}
 	return p.Base
 }
+
+var MarkMessageReadResponse_Cursor_DEFAULT *model.ReadCursor
+
+func (p *MarkMessageReadResponse) GetCursor() (v *model.ReadCursor) {
+	if !p.IsSetCursor() {
+		return MarkMessageReadResponse_Cursor_DEFAULT
+	}
+	return p.Cursor
+}
 func (p *MarkMessageReadResponse) SetBase(val *model.BaseResp) {
 	p.Base = val
 }
+func (p *MarkMessageReadResponse) SetCursor(val *model.ReadCursor) {
+	p.Cursor = val
+}
 
 func (p *MarkMessageReadResponse) IsSetBase() bool {
 	return p.Base != nil
 }
 
+func (p *MarkMessageReadResponse) IsSetCursor() bool {
+	return p.Cursor != nil
+}
+
 func (p *MarkMessageReadResponse) String() string {
 	if p == nil {
 		return "<nil>"
@@ -1764,10 +1835,10 @@ func (p *MarkMessageReadResponse) String() string {
 
 var fieldIDToName_MarkMessageReadResponse = map[int16]string{
 	1: "Base",
+	2: "Cursor",
 }
 
 type GetUnreadMessageCountRequest struct {
-	UserId int64 `thrift:"user_id,1,required" frugal:"1,required,i64" json:"user_id"`
 }
 
 func NewGetUnreadMessageCountRequest() *GetUnreadMessageCountRequest {
@@ -1777,13 +1848,6 @@ func NewGetUnreadMessageCountRequest() *GetUnreadMessageCountRequest {
 func (p *GetUnreadMessageCountRequest) InitDefault() {
 }
 
-func (p *GetUnreadMessageCountRequest) GetUserId() (v int64) {
-	return p.UserId
-}
-func (p *GetUnreadMessageCountRequest) SetUserId(val int64) {
-	p.UserId = val
-}
-
 func (p *GetUnreadMessageCountRequest) String() string {
 	if p == nil {
 		return "<nil>"
@@ -1791,9 +1855,7 @@ func (p *GetUnreadMessageCountRequest) String() string {
 	return fmt.Sprintf("GetUnreadMessageCountRequest(%+v)", *p)
 }
 
-var fieldIDToName_GetUnreadMessageCountRequest = map[int16]string{
-	1: "user_id",
-}
+var fieldIDToName_GetUnreadMessageCountRequest = map[int16]string{}
 
 type GetUnreadMessageCountResponse struct {
 	Base  *model.BaseResp `thrift:"Base,1,required" frugal:"1,required,model.BaseResp" json:"Base"`
@@ -1842,1174 +1904,6916 @@ var fieldIDToName_GetUnreadMessageCountResponse = map[int16]string{
 	2: "Count",
 }
 
-type SocialService interface {
-	SendPrivateMessage(ctx context.Context, req *SendPrivateMessageRequest) (r *SendPrivateMessageResponse, err error)
-
-	GetPrivateMessages(ctx context.Context, req *GetPrivateMessagesRequest) (r *GetPrivateMessagesResponse, err error)
-
-	CreateChatRoom(ctx context.Context, req *CreateChatRoomRequest) (r *CreateChatRoomResponse, err error)
-
-	GetChatRoom(ctx context.Context, req *GetChatRoomRequest) (r *GetChatRoomResponse, err error)
-
-	GetUserChatRooms(ctx context.Context, req *GetUserChatRoomsRequest) (r *GetUserChatRoomsResponse, err error)
+type MarkConversationsReadRequest struct {
+	Conversations []*model.ReadCursor `thrift:"conversations,2,required" frugal:"2,required,list<model.ReadCursor>" json:"conversations"`
+}
 
-	SendChatMessage(ctx context.Context, req *SendChatMessageRequest) (r *SendChatMessageResponse, err error)
+func NewMarkConversationsReadRequest() *MarkConversationsReadRequest {
+	return &MarkConversationsReadRequest{}
+}
 
-	GetChatMessages(ctx context.Context, req *GetChatMessagesRequest) (r *GetChatMessagesResponse, err error)
+func (p *MarkConversationsReadRequest) InitDefault() {
+}
 
-	AddFriend(ctx context.Context, req *AddFriendRequest) (r *AddFriendResponse, err error)
+func (p *MarkConversationsReadRequest) GetConversations() (v []*model.ReadCursor) {
+	return p.Conversations
+}
+func (p *MarkConversationsReadRequest) SetConversations(val []*model.ReadCursor) {
+	p.Conversations = val
+}
 
-	GetFriendship(ctx context.Context, req *GetFriendshipRequest) (r *GetFriendshipResponse, err error)
+func (p *MarkConversationsReadRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("MarkConversationsReadRequest(%+v)", *p)
+}
 
-	GetUserFriends(ctx context.Context, req *GetUserFriendsRequest) (r *GetUserFriendsResponse, err error)
+var fieldIDToName_MarkConversationsReadRequest = map[int16]string{
+	2: "conversations",
+}
 
-	CreateFriendRequest(ctx context.Context, req *CreateFriendRequestRequest) (r *CreateFriendRequestResponse, err error)
+type MarkConversationsReadResponse struct {
+	Base    *model.BaseResp     `thrift:"Base,1,required" frugal:"1,required,model.BaseResp" json:"Base"`
+	Cursors []*model.ReadCursor `thrift:"Cursors,2,required" frugal:"2,required,list<model.ReadCursor>" json:"Cursors"`
+}
 
-	GetFriendRequests(ctx context.Context, req *GetFriendRequestsRequest) (r *GetFriendRequestsResponse, err error)
+func NewMarkConversationsReadResponse() *MarkConversationsReadResponse {
+	return &MarkConversationsReadResponse{}
+}
 
-	HandleFriendRequest(ctx context.Context, req *HandleFriendRequestRequest) (r *HandleFriendRequestResponse, err error)
+func (p *MarkConversationsReadResponse) InitDefault() {
+}
 
-	MarkMessageRead(ctx context.Context, req *MarkMessageReadRequest) (r *MarkMessageReadResponse, err error)
+var MarkConversationsReadResponse_Base_DEFAULT *model.BaseResp
 
-	GetUnreadMessageCount(ctx context.Context, req *GetUnreadMessageCountRequest) (r *GetUnreadMessageCountResponse, err error)
+func (p *MarkConversationsReadResponse) GetBase() (v *model.BaseResp) {
+	if !p.IsSetBase() {
+		return MarkConversationsReadResponse_Base_DEFAULT
+	}
+	return p.Base
 }
 
-type SocialServiceSendPrivateMessageArgs struct {
-	Req *SendPrivateMessageRequest `thrift:"req,1" frugal:"1,default,SendPrivateMessageRequest" json:"req"`
+func (p *MarkConversationsReadResponse) GetCursors() (v []*model.ReadCursor) {
+	return p.Cursors
+}
+func (p *MarkConversationsReadResponse) SetBase(val *model.BaseResp) {
+	p.Base = val
+}
+func (p *MarkConversationsReadResponse) SetCursors(val []*model.ReadCursor) {
+	p.Cursors = val
 }
 
-func NewSocialServiceSendPrivateMessageArgs() *SocialServiceSendPrivateMessageArgs {
-	return &SocialServiceSendPrivateMessageArgs{}
+func (p *MarkConversationsReadResponse) IsSetBase() bool {
+	return p.Base != nil
 }
 
-func (p *SocialServiceSendPrivateMessageArgs) InitDefault() {
+func (p *MarkConversationsReadResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("MarkConversationsReadResponse(%+v)", *p)
 }
 
-var SocialServiceSendPrivateMessageArgs_Req_DEFAULT *SendPrivateMessageRequest
+var fieldIDToName_MarkConversationsReadResponse = map[int16]string{
+	1: "Base",
+	2: "Cursors",
+}
 
-func (p *SocialServiceSendPrivateMessageArgs) GetReq() (v *SendPrivateMessageRequest) {
-	if !p.IsSetReq() {
-		return SocialServiceSendPrivateMessageArgs_Req_DEFAULT
-	}
-	return p.Req
+type GetUnreadCountsRequest struct {
 }
-func (p *SocialServiceSendPrivateMessageArgs) SetReq(val *SendPrivateMessageRequest) {
-	p.Req = val
+
+func NewGetUnreadCountsRequest() *GetUnreadCountsRequest {
+	return &GetUnreadCountsRequest{}
 }
 
-func (p *SocialServiceSendPrivateMessageArgs) IsSetReq() bool {
-	return p.Req != nil
+func (p *GetUnreadCountsRequest) InitDefault() {
 }
 
-func (p *SocialServiceSendPrivateMessageArgs) String() string {
+func (p *GetUnreadCountsRequest) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("SocialServiceSendPrivateMessageArgs(%+v)", *p)
+	return fmt.Sprintf("GetUnreadCountsRequest(%+v)", *p)
 }
 
-var fieldIDToName_SocialServiceSendPrivateMessageArgs = map[int16]string{
-	1: "req",
-}
+var fieldIDToName_GetUnreadCountsRequest = map[int16]string{}
 
-type SocialServiceSendPrivateMessageResult struct {
-	Success *SendPrivateMessageResponse `thrift:"success,0,optional" frugal:"0,optional,SendPrivateMessageResponse" json:"success,omitempty"`
+type GetUnreadCountsResponse struct {
+	Base   *model.BaseResp      `thrift:"Base,1,required" frugal:"1,required,model.BaseResp" json:"Base"`
+	Counts []*model.UnreadCount `thrift:"Counts,2,required" frugal:"2,required,list<model.UnreadCount>" json:"Counts"`
+	Total  int64                `thrift:"Total,3,required" frugal:"3,required,i64" json:"Total"`
 }
 
-func NewSocialServiceSendPrivateMessageResult() *SocialServiceSendPrivateMessageResult {
-	return &SocialServiceSendPrivateMessageResult{}
+func NewGetUnreadCountsResponse() *GetUnreadCountsResponse {
+	return &GetUnreadCountsResponse{}
 }
 
-func (p *SocialServiceSendPrivateMessageResult) InitDefault() {
+func (p *GetUnreadCountsResponse) InitDefault() {
 }
 
-var SocialServiceSendPrivateMessageResult_Success_DEFAULT *SendPrivateMessageResponse
+var GetUnreadCountsResponse_Base_DEFAULT *model.BaseResp
 
-func (p *SocialServiceSendPrivateMessageResult) GetSuccess() (v *SendPrivateMessageResponse) {
-	if !p.IsSetSuccess() {
-		return SocialServiceSendPrivateMessageResult_Success_DEFAULT
+func (p *GetUnreadCountsResponse) GetBase() (v *model.BaseResp) {
+	if !p.IsSetBase() {
+		return GetUnreadCountsResponse_Base_DEFAULT
 	}
-	return p.Success
+	return p.Base
 }
-func (p *SocialServiceSendPrivateMessageResult) SetSuccess(x interface{}) {
-	p.Success = x.(*SendPrivateMessageResponse)
+
+func (p *GetUnreadCountsResponse) GetCounts() (v []*model.UnreadCount) {
+	return p.Counts
 }
 
-func (p *SocialServiceSendPrivateMessageResult) IsSetSuccess() bool {
-	return p.Success != nil
+func (p *GetUnreadCountsResponse) GetTotal() (v int64) {
+	return p.Total
+}
+func (p *GetUnreadCountsResponse) SetBase(val *model.BaseResp) {
+	p.Base = val
+}
+func (p *GetUnreadCountsResponse) SetCounts(val []*model.UnreadCount) {
+	p.Counts = val
+}
+func (p *GetUnreadCountsResponse) SetTotal(val int64) {
+	p.Total = val
 }
 
-func (p *SocialServiceSendPrivateMessageResult) String() string {
+func (p *GetUnreadCountsResponse) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *GetUnreadCountsResponse) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("SocialServiceSendPrivateMessageResult(%+v)", *p)
+	return fmt.Sprintf("GetUnreadCountsResponse(%+v)", *p)
 }
 
-var fieldIDToName_SocialServiceSendPrivateMessageResult = map[int16]string{
-	0: "success",
+var fieldIDToName_GetUnreadCountsResponse = map[int16]string{
+	1: "Base",
+	2: "Counts",
+	3: "Total",
 }
 
-type SocialServiceGetPrivateMessagesArgs struct {
-	Req *GetPrivateMessagesRequest `thrift:"req,1" frugal:"1,default,GetPrivateMessagesRequest" json:"req"`
+type GetInboxRequest struct {
+	Cursor   *string `thrift:"cursor,2,optional" frugal:"2,optional,string" json:"cursor,omitempty"`
+	Limit    *int32  `thrift:"limit,3,optional" frugal:"3,optional,i32" json:"limit,omitempty"`
+	Archived *bool   `thrift:"archived,4,optional" frugal:"4,optional,bool" json:"archived,omitempty"`
 }
 
-func NewSocialServiceGetPrivateMessagesArgs() *SocialServiceGetPrivateMessagesArgs {
-	return &SocialServiceGetPrivateMessagesArgs{}
+func NewGetInboxRequest() *GetInboxRequest {
+	return &GetInboxRequest{}
 }
 
-func (p *SocialServiceGetPrivateMessagesArgs) InitDefault() {
+func (p *GetInboxRequest) InitDefault() {
 }
 
-var SocialServiceGetPrivateMessagesArgs_Req_DEFAULT *GetPrivateMessagesRequest
+var GetInboxRequest_Cursor_DEFAULT string
 
-func (p *SocialServiceGetPrivateMessagesArgs) GetReq() (v *GetPrivateMessagesRequest) {
-	if !p.IsSetReq() {
-		return SocialServiceGetPrivateMessagesArgs_Req_DEFAULT
+func (p *GetInboxRequest) GetCursor() (v string) {
+	if !p.IsSetCursor() {
+		return GetInboxRequest_Cursor_DEFAULT
 	}
-	return p.Req
-}
-func (p *SocialServiceGetPrivateMessagesArgs) SetReq(val *GetPrivateMessagesRequest) {
-	p.Req = val
+	return *p.Cursor
+}
+
+var GetInboxRequest_Limit_DEFAULT int32
+
+func (p *GetInboxRequest) GetLimit() (v int32) {
+	if !p.IsSetLimit() {
+		return GetInboxRequest_Limit_DEFAULT
+	}
+	return *p.Limit
+}
+
+var GetInboxRequest_Archived_DEFAULT bool
+
+func (p *GetInboxRequest) GetArchived() (v bool) {
+	if !p.IsSetArchived() {
+		return GetInboxRequest_Archived_DEFAULT
+	}
+	return *p.Archived
+}
+func (p *GetInboxRequest) SetCursor(val *string) {
+	p.Cursor = val
+}
+func (p *GetInboxRequest) SetLimit(val *int32) {
+	p.Limit = val
+}
+func (p *GetInboxRequest) SetArchived(val *bool) {
+	p.Archived = val
+}
+
+func (p *GetInboxRequest) IsSetCursor() bool {
+	return p.Cursor != nil
+}
+
+func (p *GetInboxRequest) IsSetLimit() bool {
+	return p.Limit != nil
+}
+
+func (p *GetInboxRequest) IsSetArchived() bool {
+	return p.Archived != nil
+}
+
+func (p *GetInboxRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GetInboxRequest(%+v)", *p)
+}
+
+var fieldIDToName_GetInboxRequest = map[int16]string{
+	2: "cursor",
+	3: "limit",
+	4: "archived",
+}
+
+type GetInboxResponse struct {
+	Base          *model.BaseResp       `thrift:"Base,1,required" frugal:"1,required,model.BaseResp" json:"Base"`
+	Conversations []*model.Conversation `thrift:"Conversations,2,required" frugal:"2,required,list<model.Conversation>" json:"Conversations"`
+	NextCursor    *string               `thrift:"NextCursor,3,optional" frugal:"3,optional,string" json:"NextCursor,omitempty"`
+	HasMore       bool                  `thrift:"HasMore,4,required" frugal:"4,required,bool" json:"HasMore"`
+}
+
+func NewGetInboxResponse() *GetInboxResponse {
+	return &GetInboxResponse{}
+}
+
+func (p *GetInboxResponse) InitDefault() {
+}
+
+var GetInboxResponse_Base_DEFAULT *model.BaseResp
+
+func (p *GetInboxResponse) GetBase() (v *model.BaseResp) {
+	if !p.IsSetBase() {
+		return GetInboxResponse_Base_DEFAULT
+	}
+	return p.Base
+}
+
+func (p *GetInboxResponse) GetConversations() (v []*model.Conversation) {
+	return p.Conversations
+}
+
+var GetInboxResponse_NextCursor_DEFAULT string
+
+func (p *GetInboxResponse) GetNextCursor() (v string) {
+	if !p.IsSetNextCursor() {
+		return GetInboxResponse_NextCursor_DEFAULT
+	}
+	return *p.NextCursor
+}
+
+func (p *GetInboxResponse) GetHasMore() (v bool) {
+	return p.HasMore
+}
+func (p *GetInboxResponse) SetBase(val *model.BaseResp) {
+	p.Base = val
+}
+func (p *GetInboxResponse) SetConversations(val []*model.Conversation) {
+	p.Conversations = val
+}
+func (p *GetInboxResponse) SetNextCursor(val *string) {
+	p.NextCursor = val
+}
+func (p *GetInboxResponse) SetHasMore(val bool) {
+	p.HasMore = val
+}
+
+func (p *GetInboxResponse) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *GetInboxResponse) IsSetNextCursor() bool {
+	return p.NextCursor != nil
+}
+
+func (p *GetInboxResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GetInboxResponse(%+v)", *p)
+}
+
+var fieldIDToName_GetInboxResponse = map[int16]string{
+	1: "Base",
+	2: "Conversations",
+	3: "NextCursor",
+	4: "HasMore",
+}
+
+type UpdateConversationRequest struct {
+	PeerId   *int64 `thrift:"peer_id,2,optional" frugal:"2,optional,i64" json:"peer_id,omitempty"`
+	RoomId   *int64 `thrift:"room_id,3,optional" frugal:"3,optional,i64" json:"room_id,omitempty"`
+	Muted    *bool  `thrift:"muted,4,optional" frugal:"4,optional,bool" json:"muted,omitempty"`
+	Pinned   *bool  `thrift:"pinned,5,optional" frugal:"5,optional,bool" json:"pinned,omitempty"`
+	Archived *bool  `thrift:"archived,6,optional" frugal:"6,optional,bool" json:"archived,omitempty"`
+}
+
+func NewUpdateConversationRequest() *UpdateConversationRequest {
+	return &UpdateConversationRequest{}
+}
+
+func (p *UpdateConversationRequest) InitDefault() {
+}
+
+var UpdateConversationRequest_PeerId_DEFAULT int64
+
+func (p *UpdateConversationRequest) GetPeerId() (v int64) {
+	if !p.IsSetPeerId() {
+		return UpdateConversationRequest_PeerId_DEFAULT
+	}
+	return *p.PeerId
+}
+
+var UpdateConversationRequest_RoomId_DEFAULT int64
+
+func (p *UpdateConversationRequest) GetRoomId() (v int64) {
+	if !p.IsSetRoomId() {
+		return UpdateConversationRequest_RoomId_DEFAULT
+	}
+	return *p.RoomId
+}
+
+var UpdateConversationRequest_Muted_DEFAULT bool
+
+func (p *UpdateConversationRequest) GetMuted() (v bool) {
+	if !p.IsSetMuted() {
+		return UpdateConversationRequest_Muted_DEFAULT
+	}
+	return *p.Muted
+}
+
+var UpdateConversationRequest_Pinned_DEFAULT bool
+
+func (p *UpdateConversationRequest) GetPinned() (v bool) {
+	if !p.IsSetPinned() {
+		return UpdateConversationRequest_Pinned_DEFAULT
+	}
+	return *p.Pinned
+}
+
+var UpdateConversationRequest_Archived_DEFAULT bool
+
+func (p *UpdateConversationRequest) GetArchived() (v bool) {
+	if !p.IsSetArchived() {
+		return UpdateConversationRequest_Archived_DEFAULT
+	}
+	return *p.Archived
+}
+func (p *UpdateConversationRequest) SetPeerId(val *int64) {
+	p.PeerId = val
+}
+func (p *UpdateConversationRequest) SetRoomId(val *int64) {
+	p.RoomId = val
+}
+func (p *UpdateConversationRequest) SetMuted(val *bool) {
+	p.Muted = val
+}
+func (p *UpdateConversationRequest) SetPinned(val *bool) {
+	p.Pinned = val
+}
+func (p *UpdateConversationRequest) SetArchived(val *bool) {
+	p.Archived = val
+}
+
+func (p *UpdateConversationRequest) IsSetPeerId() bool {
+	return p.PeerId != nil
+}
+
+func (p *UpdateConversationRequest) IsSetRoomId() bool {
+	return p.RoomId != nil
+}
+
+func (p *UpdateConversationRequest) IsSetMuted() bool {
+	return p.Muted != nil
+}
+
+func (p *UpdateConversationRequest) IsSetPinned() bool {
+	return p.Pinned != nil
+}
+
+func (p *UpdateConversationRequest) IsSetArchived() bool {
+	return p.Archived != nil
+}
+
+func (p *UpdateConversationRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UpdateConversationRequest(%+v)", *p)
+}
+
+var fieldIDToName_UpdateConversationRequest = map[int16]string{
+	2: "peer_id",
+	3: "room_id",
+	4: "muted",
+	5: "pinned",
+	6: "archived",
+}
+
+type UpdateConversationResponse struct {
+	Base         *model.BaseResp     `thrift:"Base,1,required" frugal:"1,required,model.BaseResp" json:"Base"`
+	Conversation *model.Conversation `thrift:"Conversation,2,required" frugal:"2,required,model.Conversation" json:"Conversation"`
+}
+
+func NewUpdateConversationResponse() *UpdateConversationResponse {
+	return &UpdateConversationResponse{}
+}
+
+func (p *UpdateConversationResponse) InitDefault() {
+}
+
+var UpdateConversationResponse_Base_DEFAULT *model.BaseResp
+
+func (p *UpdateConversationResponse) GetBase() (v *model.BaseResp) {
+	if !p.IsSetBase() {
+		return UpdateConversationResponse_Base_DEFAULT
+	}
+	return p.Base
+}
+
+var UpdateConversationResponse_Conversation_DEFAULT *model.Conversation
+
+func (p *UpdateConversationResponse) GetConversation() (v *model.Conversation) {
+	if !p.IsSetConversation() {
+		return UpdateConversationResponse_Conversation_DEFAULT
+	}
+	return p.Conversation
+}
+func (p *UpdateConversationResponse) SetBase(val *model.BaseResp) {
+	p.Base = val
+}
+func (p *UpdateConversationResponse) SetConversation(val *model.Conversation) {
+	p.Conversation = val
+}
+
+func (p *UpdateConversationResponse) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *UpdateConversationResponse) IsSetConversation() bool {
+	return p.Conversation != nil
+}
+
+func (p *UpdateConversationResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UpdateConversationResponse(%+v)", *p)
+}
+
+var fieldIDToName_UpdateConversationResponse = map[int16]string{
+	1: "Base",
+	2: "Conversation",
+}
+
+type SyncMessagesRequest struct {
+	PeerId   *int64 `thrift:"peer_id,2,optional" frugal:"2,optional,i64" json:"peer_id,omitempty"`
+	RoomId   *int64 `thrift:"room_id,3,optional" frugal:"3,optional,i64" json:"room_id,omitempty"`
+	AfterSeq int64  `thrift:"after_seq,4,required" frugal:"4,required,i64" json:"after_seq"`
+	Limit    *int32 `thrift:"limit,5,optional" frugal:"5,optional,i32" json:"limit,omitempty"`
+}
+
+func NewSyncMessagesRequest() *SyncMessagesRequest {
+	return &SyncMessagesRequest{}
+}
+
+func (p *SyncMessagesRequest) InitDefault() {
+}
+
+var SyncMessagesRequest_PeerId_DEFAULT int64
+
+func (p *SyncMessagesRequest) GetPeerId() (v int64) {
+	if !p.IsSetPeerId() {
+		return SyncMessagesRequest_PeerId_DEFAULT
+	}
+	return *p.PeerId
+}
+
+var SyncMessagesRequest_RoomId_DEFAULT int64
+
+func (p *SyncMessagesRequest) GetRoomId() (v int64) {
+	if !p.IsSetRoomId() {
+		return SyncMessagesRequest_RoomId_DEFAULT
+	}
+	return *p.RoomId
+}
+
+func (p *SyncMessagesRequest) GetAfterSeq() (v int64) {
+	return p.AfterSeq
+}
+
+var SyncMessagesRequest_Limit_DEFAULT int32
+
+func (p *SyncMessagesRequest) GetLimit() (v int32) {
+	if !p.IsSetLimit() {
+		return SyncMessagesRequest_Limit_DEFAULT
+	}
+	return *p.Limit
+}
+func (p *SyncMessagesRequest) SetPeerId(val *int64) {
+	p.PeerId = val
+}
+func (p *SyncMessagesRequest) SetRoomId(val *int64) {
+	p.RoomId = val
+}
+func (p *SyncMessagesRequest) SetAfterSeq(val int64) {
+	p.AfterSeq = val
+}
+func (p *SyncMessagesRequest) SetLimit(val *int32) {
+	p.Limit = val
+}
+
+func (p *SyncMessagesRequest) IsSetPeerId() bool {
+	return p.PeerId != nil
+}
+
+func (p *SyncMessagesRequest) IsSetRoomId() bool {
+	return p.RoomId != nil
+}
+
+func (p *SyncMessagesRequest) IsSetLimit() bool {
+	return p.Limit != nil
+}
+
+func (p *SyncMessagesRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SyncMessagesRequest(%+v)", *p)
+}
+
+var fieldIDToName_SyncMessagesRequest = map[int16]string{
+	2: "peer_id",
+	3: "room_id",
+	4: "after_seq",
+	5: "limit",
+}
+
+type SyncMessagesResponse struct {
+	Base            *model.BaseResp         `thrift:"Base,1,required" frugal:"1,required,model.BaseResp" json:"Base"`
+	PrivateMessages []*model.PrivateMessage `thrift:"PrivateMessages,2,optional" frugal:"2,optional,list<model.PrivateMessage>" json:"PrivateMessages,omitempty"`
+	ChatMessages    []*model.ChatMessage    `thrift:"ChatMessages,3,optional" frugal:"3,optional,list<model.ChatMessage>" json:"ChatMessages,omitempty"`
+	LatestSeq       int64                   `thrift:"LatestSeq,4,required" frugal:"4,required,i64" json:"LatestSeq"`
+	HasMore         bool                    `thrift:"HasMore,5,required" frugal:"5,required,bool" json:"HasMore"`
+}
+
+func NewSyncMessagesResponse() *SyncMessagesResponse {
+	return &SyncMessagesResponse{}
+}
+
+func (p *SyncMessagesResponse) InitDefault() {
+}
+
+var SyncMessagesResponse_Base_DEFAULT *model.BaseResp
+
+func (p *SyncMessagesResponse) GetBase() (v *model.BaseResp) {
+	if !p.IsSetBase() {
+		return SyncMessagesResponse_Base_DEFAULT
+	}
+	return p.Base
+}
+
+var SyncMessagesResponse_PrivateMessages_DEFAULT []*model.PrivateMessage
+
+func (p *SyncMessagesResponse) GetPrivateMessages() (v []*model.PrivateMessage) {
+	if !p.IsSetPrivateMessages() {
+		return SyncMessagesResponse_PrivateMessages_DEFAULT
+	}
+	return p.PrivateMessages
+}
+
+var SyncMessagesResponse_ChatMessages_DEFAULT []*model.ChatMessage
+
+func (p *SyncMessagesResponse) GetChatMessages() (v []*model.ChatMessage) {
+	if !p.IsSetChatMessages() {
+		return SyncMessagesResponse_ChatMessages_DEFAULT
+	}
+	return p.ChatMessages
+}
+
+func (p *SyncMessagesResponse) GetLatestSeq() (v int64) {
+	return p.LatestSeq
+}
+
+func (p *SyncMessagesResponse) GetHasMore() (v bool) {
+	return p.HasMore
+}
+func (p *SyncMessagesResponse) SetBase(val *model.BaseResp) {
+	p.Base = val
+}
+func (p *SyncMessagesResponse) SetPrivateMessages(val []*model.PrivateMessage) {
+	p.PrivateMessages = val
+}
+func (p *SyncMessagesResponse) SetChatMessages(val []*model.ChatMessage) {
+	p.ChatMessages = val
+}
+func (p *SyncMessagesResponse) SetLatestSeq(val int64) {
+	p.LatestSeq = val
+}
+func (p *SyncMessagesResponse) SetHasMore(val bool) {
+	p.HasMore = val
+}
+
+func (p *SyncMessagesResponse) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *SyncMessagesResponse) IsSetPrivateMessages() bool {
+	return p.PrivateMessages != nil
+}
+
+func (p *SyncMessagesResponse) IsSetChatMessages() bool {
+	return p.ChatMessages != nil
+}
+
+func (p *SyncMessagesResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SyncMessagesResponse(%+v)", *p)
+}
+
+var fieldIDToName_SyncMessagesResponse = map[int16]string{
+	1: "Base",
+	2: "PrivateMessages",
+	3: "ChatMessages",
+	4: "LatestSeq",
+	5: "HasMore",
+}
+
+type AddChatRoomMembersRequest struct {
+	RoomId    int64   `thrift:"room_id,2,required" frugal:"2,required,i64" json:"room_id"`
+	MemberIds []int64 `thrift:"member_ids,3,required" frugal:"3,required,list<i64>" json:"member_ids"`
+}
+
+func NewAddChatRoomMembersRequest() *AddChatRoomMembersRequest {
+	return &AddChatRoomMembersRequest{}
+}
+
+func (p *AddChatRoomMembersRequest) InitDefault() {
+}
+
+func (p *AddChatRoomMembersRequest) GetRoomId() (v int64) {
+	return p.RoomId
+}
+
+func (p *AddChatRoomMembersRequest) GetMemberIds() (v []int64) {
+	return p.MemberIds
+}
+func (p *AddChatRoomMembersRequest) SetRoomId(val int64) {
+	p.RoomId = val
+}
+func (p *AddChatRoomMembersRequest) SetMemberIds(val []int64) {
+	p.MemberIds = val
+}
+
+func (p *AddChatRoomMembersRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("AddChatRoomMembersRequest(%+v)", *p)
+}
+
+var fieldIDToName_AddChatRoomMembersRequest = map[int16]string{
+	2: "room_id",
+	3: "member_ids",
+}
+
+type AddChatRoomMembersResponse struct {
+	Base          *model.BaseResp    `thrift:"Base,1,required" frugal:"1,required,model.BaseResp" json:"Base"`
+	AddedIds      []int64            `thrift:"AddedIds,2,required" frugal:"2,required,list<i64>" json:"AddedIds"`
+	SystemMessage *model.ChatMessage `thrift:"SystemMessage,3,optional" frugal:"3,optional,model.ChatMessage" json:"SystemMessage,omitempty"`
+}
+
+func NewAddChatRoomMembersResponse() *AddChatRoomMembersResponse {
+	return &AddChatRoomMembersResponse{}
+}
+
+func (p *AddChatRoomMembersResponse) InitDefault() {
+}
+
+var AddChatRoomMembersResponse_Base_DEFAULT *model.BaseResp
+
+func (p *AddChatRoomMembersResponse) GetBase() (v *model.BaseResp) {
+	if !p.IsSetBase() {
+		return AddChatRoomMembersResponse_Base_DEFAULT
+	}
+	return p.Base
+}
+
+func (p *AddChatRoomMembersResponse) GetAddedIds() (v []int64) {
+	return p.AddedIds
+}
+
+var AddChatRoomMembersResponse_SystemMessage_DEFAULT *model.ChatMessage
+
+func (p *AddChatRoomMembersResponse) GetSystemMessage() (v *model.ChatMessage) {
+	if !p.IsSetSystemMessage() {
+		return AddChatRoomMembersResponse_SystemMessage_DEFAULT
+	}
+	return p.SystemMessage
+}
+func (p *AddChatRoomMembersResponse) SetBase(val *model.BaseResp) {
+	p.Base = val
+}
+func (p *AddChatRoomMembersResponse) SetAddedIds(val []int64) {
+	p.AddedIds = val
+}
+func (p *AddChatRoomMembersResponse) SetSystemMessage(val *model.ChatMessage) {
+	p.SystemMessage = val
+}
+
+func (p *AddChatRoomMembersResponse) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *AddChatRoomMembersResponse) IsSetSystemMessage() bool {
+	return p.SystemMessage != nil
+}
+
+func (p *AddChatRoomMembersResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("AddChatRoomMembersResponse(%+v)", *p)
+}
+
+var fieldIDToName_AddChatRoomMembersResponse = map[int16]string{
+	1: "Base",
+	2: "AddedIds",
+	3: "SystemMessage",
+}
+
+type RemoveChatRoomMemberRequest struct {
+	RoomId int64 `thrift:"room_id,2,required" frugal:"2,required,i64" json:"room_id"`
+	UserId int64 `thrift:"user_id,3,required" frugal:"3,required,i64" json:"user_id"`
+}
+
+func NewRemoveChatRoomMemberRequest() *RemoveChatRoomMemberRequest {
+	return &RemoveChatRoomMemberRequest{}
+}
+
+func (p *RemoveChatRoomMemberRequest) InitDefault() {
+}
+
+func (p *RemoveChatRoomMemberRequest) GetRoomId() (v int64) {
+	return p.RoomId
+}
+
+func (p *RemoveChatRoomMemberRequest) GetUserId() (v int64) {
+	return p.UserId
+}
+func (p *RemoveChatRoomMemberRequest) SetRoomId(val int64) {
+	p.RoomId = val
+}
+func (p *RemoveChatRoomMemberRequest) SetUserId(val int64) {
+	p.UserId = val
+}
+
+func (p *RemoveChatRoomMemberRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("RemoveChatRoomMemberRequest(%+v)", *p)
+}
+
+var fieldIDToName_RemoveChatRoomMemberRequest = map[int16]string{
+	2: "room_id",
+	3: "user_id",
+}
+
+type RemoveChatRoomMemberResponse struct {
+	Base          *model.BaseResp    `thrift:"Base,1,required" frugal:"1,required,model.BaseResp" json:"Base"`
+	SystemMessage *model.ChatMessage `thrift:"SystemMessage,2,optional" frugal:"2,optional,model.ChatMessage" json:"SystemMessage,omitempty"`
+}
+
+func NewRemoveChatRoomMemberResponse() *RemoveChatRoomMemberResponse {
+	return &RemoveChatRoomMemberResponse{}
+}
+
+func (p *RemoveChatRoomMemberResponse) InitDefault() {
+}
+
+var RemoveChatRoomMemberResponse_Base_DEFAULT *model.BaseResp
+
+func (p *RemoveChatRoomMemberResponse) GetBase() (v *model.BaseResp) {
+	if !p.IsSetBase() {
+		return RemoveChatRoomMemberResponse_Base_DEFAULT
+	}
+	return p.Base
+}
+
+var RemoveChatRoomMemberResponse_SystemMessage_DEFAULT *model.ChatMessage
+
+func (p *RemoveChatRoomMemberResponse) GetSystemMessage() (v *model.ChatMessage) {
+	if !p.IsSetSystemMessage() {
+		return RemoveChatRoomMemberResponse_SystemMessage_DEFAULT
+	}
+	return p.SystemMessage
+}
+func (p *RemoveChatRoomMemberResponse) SetBase(val *model.BaseResp) {
+	p.Base = val
+}
+func (p *RemoveChatRoomMemberResponse) SetSystemMessage(val *model.ChatMessage) {
+	p.SystemMessage = val
+}
+
+func (p *RemoveChatRoomMemberResponse) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *RemoveChatRoomMemberResponse) IsSetSystemMessage() bool {
+	return p.SystemMessage != nil
+}
+
+func (p *RemoveChatRoomMemberResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("RemoveChatRoomMemberResponse(%+v)", *p)
+}
+
+var fieldIDToName_RemoveChatRoomMemberResponse = map[int16]string{
+	1: "Base",
+	2: "SystemMessage",
+}
+
+type LeaveChatRoomRequest struct {
+	RoomId int64 `thrift:"room_id,2,required" frugal:"2,required,i64" json:"room_id"`
+}
+
+func NewLeaveChatRoomRequest() *LeaveChatRoomRequest {
+	return &LeaveChatRoomRequest{}
+}
+
+func (p *LeaveChatRoomRequest) InitDefault() {
+}
+
+func (p *LeaveChatRoomRequest) GetRoomId() (v int64) {
+	return p.RoomId
+}
+func (p *LeaveChatRoomRequest) SetRoomId(val int64) {
+	p.RoomId = val
+}
+
+func (p *LeaveChatRoomRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("LeaveChatRoomRequest(%+v)", *p)
+}
+
+var fieldIDToName_LeaveChatRoomRequest = map[int16]string{
+	2: "room_id",
+}
+
+type LeaveChatRoomResponse struct {
+	Base          *model.BaseResp    `thrift:"Base,1,required" frugal:"1,required,model.BaseResp" json:"Base"`
+	SystemMessage *model.ChatMessage `thrift:"SystemMessage,2,optional" frugal:"2,optional,model.ChatMessage" json:"SystemMessage,omitempty"`
+}
+
+func NewLeaveChatRoomResponse() *LeaveChatRoomResponse {
+	return &LeaveChatRoomResponse{}
+}
+
+func (p *LeaveChatRoomResponse) InitDefault() {
+}
+
+var LeaveChatRoomResponse_Base_DEFAULT *model.BaseResp
+
+func (p *LeaveChatRoomResponse) GetBase() (v *model.BaseResp) {
+	if !p.IsSetBase() {
+		return LeaveChatRoomResponse_Base_DEFAULT
+	}
+	return p.Base
+}
+
+var LeaveChatRoomResponse_SystemMessage_DEFAULT *model.ChatMessage
+
+func (p *LeaveChatRoomResponse) GetSystemMessage() (v *model.ChatMessage) {
+	if !p.IsSetSystemMessage() {
+		return LeaveChatRoomResponse_SystemMessage_DEFAULT
+	}
+	return p.SystemMessage
+}
+func (p *LeaveChatRoomResponse) SetBase(val *model.BaseResp) {
+	p.Base = val
+}
+func (p *LeaveChatRoomResponse) SetSystemMessage(val *model.ChatMessage) {
+	p.SystemMessage = val
+}
+
+func (p *LeaveChatRoomResponse) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *LeaveChatRoomResponse) IsSetSystemMessage() bool {
+	return p.SystemMessage != nil
+}
+
+func (p *LeaveChatRoomResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("LeaveChatRoomResponse(%+v)", *p)
+}
+
+var fieldIDToName_LeaveChatRoomResponse = map[int16]string{
+	1: "Base",
+	2: "SystemMessage",
+}
+
+type CheckChatRoomMemberRequest struct {
+	RoomId int64 `thrift:"room_id,1,required" frugal:"1,required,i64" json:"room_id"`
+	UserId int64 `thrift:"user_id,2,required" frugal:"2,required,i64" json:"user_id"`
+}
+
+func NewCheckChatRoomMemberRequest() *CheckChatRoomMemberRequest {
+	return &CheckChatRoomMemberRequest{}
+}
+
+func (p *CheckChatRoomMemberRequest) InitDefault() {
+}
+
+func (p *CheckChatRoomMemberRequest) GetRoomId() (v int64) {
+	return p.RoomId
+}
+
+func (p *CheckChatRoomMemberRequest) GetUserId() (v int64) {
+	return p.UserId
+}
+func (p *CheckChatRoomMemberRequest) SetRoomId(val int64) {
+	p.RoomId = val
+}
+func (p *CheckChatRoomMemberRequest) SetUserId(val int64) {
+	p.UserId = val
+}
+
+func (p *CheckChatRoomMemberRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CheckChatRoomMemberRequest(%+v)", *p)
+}
+
+var fieldIDToName_CheckChatRoomMemberRequest = map[int16]string{
+	1: "room_id",
+	2: "user_id",
+}
+
+type CheckChatRoomMemberResponse struct {
+	Base     *model.BaseResp `thrift:"Base,1,required" frugal:"1,required,model.BaseResp" json:"Base"`
+	IsMember bool            `thrift:"IsMember,2,required" frugal:"2,required,bool" json:"IsMember"`
+}
+
+func NewCheckChatRoomMemberResponse() *CheckChatRoomMemberResponse {
+	return &CheckChatRoomMemberResponse{}
+}
+
+func (p *CheckChatRoomMemberResponse) InitDefault() {
+}
+
+var CheckChatRoomMemberResponse_Base_DEFAULT *model.BaseResp
+
+func (p *CheckChatRoomMemberResponse) GetBase() (v *model.BaseResp) {
+	if !p.IsSetBase() {
+		return CheckChatRoomMemberResponse_Base_DEFAULT
+	}
+	return p.Base
+}
+
+func (p *CheckChatRoomMemberResponse) GetIsMember() (v bool) {
+	return p.IsMember
+}
+func (p *CheckChatRoomMemberResponse) SetBase(val *model.BaseResp) {
+	p.Base = val
+}
+func (p *CheckChatRoomMemberResponse) SetIsMember(val bool) {
+	p.IsMember = val
+}
+
+func (p *CheckChatRoomMemberResponse) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *CheckChatRoomMemberResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CheckChatRoomMemberResponse(%+v)", *p)
+}
+
+var fieldIDToName_CheckChatRoomMemberResponse = map[int16]string{
+	1: "Base",
+	2: "IsMember",
+}
+
+type CheckPrivateChatRequest struct {
+	UserId int64 `thrift:"user_id,1,required" frugal:"1,required,i64" json:"user_id"`
+	PeerId int64 `thrift:"peer_id,2,required" frugal:"2,required,i64" json:"peer_id"`
+}
+
+func NewCheckPrivateChatRequest() *CheckPrivateChatRequest {
+	return &CheckPrivateChatRequest{}
+}
+
+func (p *CheckPrivateChatRequest) InitDefault() {
+}
+
+func (p *CheckPrivateChatRequest) GetUserId() (v int64) {
+	return p.UserId
+}
+
+func (p *CheckPrivateChatRequest) GetPeerId() (v int64) {
+	return p.PeerId
+}
+func (p *CheckPrivateChatRequest) SetUserId(val int64) {
+	p.UserId = val
+}
+func (p *CheckPrivateChatRequest) SetPeerId(val int64) {
+	p.PeerId = val
+}
+
+func (p *CheckPrivateChatRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CheckPrivateChatRequest(%+v)", *p)
+}
+
+var fieldIDToName_CheckPrivateChatRequest = map[int16]string{
+	1: "user_id",
+	2: "peer_id",
+}
+
+type CheckPrivateChatResponse struct {
+	Base    *model.BaseResp `thrift:"Base,1,required" frugal:"1,required,model.BaseResp" json:"Base"`
+	Allowed bool            `thrift:"Allowed,2,required" frugal:"2,required,bool" json:"Allowed"`
+}
+
+func NewCheckPrivateChatResponse() *CheckPrivateChatResponse {
+	return &CheckPrivateChatResponse{}
+}
+
+func (p *CheckPrivateChatResponse) InitDefault() {
+}
+
+var CheckPrivateChatResponse_Base_DEFAULT *model.BaseResp
+
+func (p *CheckPrivateChatResponse) GetBase() (v *model.BaseResp) {
+	if !p.IsSetBase() {
+		return CheckPrivateChatResponse_Base_DEFAULT
+	}
+	return p.Base
+}
+
+func (p *CheckPrivateChatResponse) GetAllowed() (v bool) {
+	return p.Allowed
+}
+func (p *CheckPrivateChatResponse) SetBase(val *model.BaseResp) {
+	p.Base = val
+}
+func (p *CheckPrivateChatResponse) SetAllowed(val bool) {
+	p.Allowed = val
+}
+
+func (p *CheckPrivateChatResponse) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *CheckPrivateChatResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CheckPrivateChatResponse(%+v)", *p)
+}
+
+var fieldIDToName_CheckPrivateChatResponse = map[int16]string{
+	1: "Base",
+	2: "Allowed",
+}
+
+type SetChatRoomAdminRequest struct {
+	RoomId  int64 `thrift:"room_id,2,required" frugal:"2,required,i64" json:"room_id"`
+	UserId  int64 `thrift:"user_id,3,required" frugal:"3,required,i64" json:"user_id"`
+	IsAdmin bool  `thrift:"is_admin,4,required" frugal:"4,required,bool" json:"is_admin"`
+}
+
+func NewSetChatRoomAdminRequest() *SetChatRoomAdminRequest {
+	return &SetChatRoomAdminRequest{}
+}
+
+func (p *SetChatRoomAdminRequest) InitDefault() {
+}
+
+func (p *SetChatRoomAdminRequest) GetRoomId() (v int64) {
+	return p.RoomId
+}
+
+func (p *SetChatRoomAdminRequest) GetUserId() (v int64) {
+	return p.UserId
+}
+
+func (p *SetChatRoomAdminRequest) GetIsAdmin() (v bool) {
+	return p.IsAdmin
+}
+func (p *SetChatRoomAdminRequest) SetRoomId(val int64) {
+	p.RoomId = val
+}
+func (p *SetChatRoomAdminRequest) SetUserId(val int64) {
+	p.UserId = val
+}
+func (p *SetChatRoomAdminRequest) SetIsAdmin(val bool) {
+	p.IsAdmin = val
+}
+
+func (p *SetChatRoomAdminRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SetChatRoomAdminRequest(%+v)", *p)
+}
+
+var fieldIDToName_SetChatRoomAdminRequest = map[int16]string{
+	2: "room_id",
+	3: "user_id",
+	4: "is_admin",
+}
+
+type SetChatRoomAdminResponse struct {
+	Base          *model.BaseResp    `thrift:"Base,1,required" frugal:"1,required,model.BaseResp" json:"Base"`
+	SystemMessage *model.ChatMessage `thrift:"SystemMessage,2,optional" frugal:"2,optional,model.ChatMessage" json:"SystemMessage,omitempty"`
+}
+
+func NewSetChatRoomAdminResponse() *SetChatRoomAdminResponse {
+	return &SetChatRoomAdminResponse{}
+}
+
+func (p *SetChatRoomAdminResponse) InitDefault() {
+}
+
+var SetChatRoomAdminResponse_Base_DEFAULT *model.BaseResp
+
+func (p *SetChatRoomAdminResponse) GetBase() (v *model.BaseResp) {
+	if !p.IsSetBase() {
+		return SetChatRoomAdminResponse_Base_DEFAULT
+	}
+	return p.Base
+}
+
+var SetChatRoomAdminResponse_SystemMessage_DEFAULT *model.ChatMessage
+
+func (p *SetChatRoomAdminResponse) GetSystemMessage() (v *model.ChatMessage) {
+	if !p.IsSetSystemMessage() {
+		return SetChatRoomAdminResponse_SystemMessage_DEFAULT
+	}
+	return p.SystemMessage
+}
+func (p *SetChatRoomAdminResponse) SetBase(val *model.BaseResp) {
+	p.Base = val
+}
+func (p *SetChatRoomAdminResponse) SetSystemMessage(val *model.ChatMessage) {
+	p.SystemMessage = val
+}
+
+func (p *SetChatRoomAdminResponse) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *SetChatRoomAdminResponse) IsSetSystemMessage() bool {
+	return p.SystemMessage != nil
+}
+
+func (p *SetChatRoomAdminResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SetChatRoomAdminResponse(%+v)", *p)
+}
+
+var fieldIDToName_SetChatRoomAdminResponse = map[int16]string{
+	1: "Base",
+	2: "SystemMessage",
+}
+
+type TransferChatRoomRequest struct {
+	RoomId      int64 `thrift:"room_id,2,required" frugal:"2,required,i64" json:"room_id"`
+	NewOwnerId_ int64 `thrift:"new_owner_id,3,required" frugal:"3,required,i64" json:"new_owner_id"`
+}
+
+func NewTransferChatRoomRequest() *TransferChatRoomRequest {
+	return &TransferChatRoomRequest{}
+}
+
+func (p *TransferChatRoomRequest) InitDefault() {
+}
+
+func (p *TransferChatRoomRequest) GetRoomId() (v int64) {
+	return p.RoomId
+}
+
+func (p *TransferChatRoomRequest) GetNewOwnerId_() (v int64) {
+	return p.NewOwnerId_
+}
+func (p *TransferChatRoomRequest) SetRoomId(val int64) {
+	p.RoomId = val
+}
+func (p *TransferChatRoomRequest) SetNewOwnerId_(val int64) {
+	p.NewOwnerId_ = val
+}
+
+func (p *TransferChatRoomRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("TransferChatRoomRequest(%+v)", *p)
+}
+
+var fieldIDToName_TransferChatRoomRequest = map[int16]string{
+	2: "room_id",
+	3: "new_owner_id",
+}
+
+type TransferChatRoomResponse struct {
+	Base          *model.BaseResp    `thrift:"Base,1,required" frugal:"1,required,model.BaseResp" json:"Base"`
+	SystemMessage *model.ChatMessage `thrift:"SystemMessage,2,optional" frugal:"2,optional,model.ChatMessage" json:"SystemMessage,omitempty"`
+}
+
+func NewTransferChatRoomResponse() *TransferChatRoomResponse {
+	return &TransferChatRoomResponse{}
+}
+
+func (p *TransferChatRoomResponse) InitDefault() {
+}
+
+var TransferChatRoomResponse_Base_DEFAULT *model.BaseResp
+
+func (p *TransferChatRoomResponse) GetBase() (v *model.BaseResp) {
+	if !p.IsSetBase() {
+		return TransferChatRoomResponse_Base_DEFAULT
+	}
+	return p.Base
+}
+
+var TransferChatRoomResponse_SystemMessage_DEFAULT *model.ChatMessage
+
+func (p *TransferChatRoomResponse) GetSystemMessage() (v *model.ChatMessage) {
+	if !p.IsSetSystemMessage() {
+		return TransferChatRoomResponse_SystemMessage_DEFAULT
+	}
+	return p.SystemMessage
+}
+func (p *TransferChatRoomResponse) SetBase(val *model.BaseResp) {
+	p.Base = val
+}
+func (p *TransferChatRoomResponse) SetSystemMessage(val *model.ChatMessage) {
+	p.SystemMessage = val
+}
+
+func (p *TransferChatRoomResponse) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *TransferChatRoomResponse) IsSetSystemMessage() bool {
+	return p.SystemMessage != nil
+}
+
+func (p *TransferChatRoomResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("TransferChatRoomResponse(%+v)", *p)
+}
+
+var fieldIDToName_TransferChatRoomResponse = map[int16]string{
+	1: "Base",
+	2: "SystemMessage",
+}
+
+type MuteChatRoomMemberRequest struct {
+	RoomId   int64 `thrift:"room_id,2,required" frugal:"2,required,i64" json:"room_id"`
+	UserId   int64 `thrift:"user_id,3,required" frugal:"3,required,i64" json:"user_id"`
+	Duration int64 `thrift:"duration,4,required" frugal:"4,required,i64" json:"duration"`
+}
+
+func NewMuteChatRoomMemberRequest() *MuteChatRoomMemberRequest {
+	return &MuteChatRoomMemberRequest{}
+}
+
+func (p *MuteChatRoomMemberRequest) InitDefault() {
+}
+
+func (p *MuteChatRoomMemberRequest) GetRoomId() (v int64) {
+	return p.RoomId
+}
+
+func (p *MuteChatRoomMemberRequest) GetUserId() (v int64) {
+	return p.UserId
+}
+
+func (p *MuteChatRoomMemberRequest) GetDuration() (v int64) {
+	return p.Duration
+}
+func (p *MuteChatRoomMemberRequest) SetRoomId(val int64) {
+	p.RoomId = val
+}
+func (p *MuteChatRoomMemberRequest) SetUserId(val int64) {
+	p.UserId = val
+}
+func (p *MuteChatRoomMemberRequest) SetDuration(val int64) {
+	p.Duration = val
+}
+
+func (p *MuteChatRoomMemberRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("MuteChatRoomMemberRequest(%+v)", *p)
+}
+
+var fieldIDToName_MuteChatRoomMemberRequest = map[int16]string{
+	2: "room_id",
+	3: "user_id",
+	4: "duration",
+}
+
+type MuteChatRoomMemberResponse struct {
+	Base          *model.BaseResp    `thrift:"Base,1,required" frugal:"1,required,model.BaseResp" json:"Base"`
+	MutedUntil    int64              `thrift:"MutedUntil,2,required" frugal:"2,required,i64" json:"MutedUntil"`
+	SystemMessage *model.ChatMessage `thrift:"SystemMessage,3,optional" frugal:"3,optional,model.ChatMessage" json:"SystemMessage,omitempty"`
+}
+
+func NewMuteChatRoomMemberResponse() *MuteChatRoomMemberResponse {
+	return &MuteChatRoomMemberResponse{}
+}
+
+func (p *MuteChatRoomMemberResponse) InitDefault() {
+}
+
+var MuteChatRoomMemberResponse_Base_DEFAULT *model.BaseResp
+
+func (p *MuteChatRoomMemberResponse) GetBase() (v *model.BaseResp) {
+	if !p.IsSetBase() {
+		return MuteChatRoomMemberResponse_Base_DEFAULT
+	}
+	return p.Base
+}
+
+func (p *MuteChatRoomMemberResponse) GetMutedUntil() (v int64) {
+	return p.MutedUntil
+}
+
+var MuteChatRoomMemberResponse_SystemMessage_DEFAULT *model.ChatMessage
+
+func (p *MuteChatRoomMemberResponse) GetSystemMessage() (v *model.ChatMessage) {
+	if !p.IsSetSystemMessage() {
+		return MuteChatRoomMemberResponse_SystemMessage_DEFAULT
+	}
+	return p.SystemMessage
+}
+func (p *MuteChatRoomMemberResponse) SetBase(val *model.BaseResp) {
+	p.Base = val
+}
+func (p *MuteChatRoomMemberResponse) SetMutedUntil(val int64) {
+	p.MutedUntil = val
+}
+func (p *MuteChatRoomMemberResponse) SetSystemMessage(val *model.ChatMessage) {
+	p.SystemMessage = val
+}
+
+func (p *MuteChatRoomMemberResponse) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *MuteChatRoomMemberResponse) IsSetSystemMessage() bool {
+	return p.SystemMessage != nil
+}
+
+func (p *MuteChatRoomMemberResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("MuteChatRoomMemberResponse(%+v)", *p)
+}
+
+var fieldIDToName_MuteChatRoomMemberResponse = map[int16]string{
+	1: "Base",
+	2: "MutedUntil",
+	3: "SystemMessage",
+}
+
+type RenameChatRoomRequest struct {
+	RoomId int64  `thrift:"room_id,2,required" frugal:"2,required,i64" json:"room_id"`
+	Name   string `thrift:"name,3,required" frugal:"3,required,string" json:"name"`
+}
+
+func NewRenameChatRoomRequest() *RenameChatRoomRequest {
+	return &RenameChatRoomRequest{}
+}
+
+func (p *RenameChatRoomRequest) InitDefault() {
+}
+
+func (p *RenameChatRoomRequest) GetRoomId() (v int64) {
+	return p.RoomId
+}
+
+func (p *RenameChatRoomRequest) GetName() (v string) {
+	return p.Name
+}
+func (p *RenameChatRoomRequest) SetRoomId(val int64) {
+	p.RoomId = val
+}
+func (p *RenameChatRoomRequest) SetName(val string) {
+	p.Name = val
+}
+
+func (p *RenameChatRoomRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("RenameChatRoomRequest(%+v)", *p)
+}
+
+var fieldIDToName_RenameChatRoomRequest = map[int16]string{
+	2: "room_id",
+	3: "name",
+}
+
+type RenameChatRoomResponse struct {
+	Base          *model.BaseResp    `thrift:"Base,1,required" frugal:"1,required,model.BaseResp" json:"Base"`
+	SystemMessage *model.ChatMessage `thrift:"SystemMessage,2,optional" frugal:"2,optional,model.ChatMessage" json:"SystemMessage,omitempty"`
+}
+
+func NewRenameChatRoomResponse() *RenameChatRoomResponse {
+	return &RenameChatRoomResponse{}
+}
+
+func (p *RenameChatRoomResponse) InitDefault() {
+}
+
+var RenameChatRoomResponse_Base_DEFAULT *model.BaseResp
+
+func (p *RenameChatRoomResponse) GetBase() (v *model.BaseResp) {
+	if !p.IsSetBase() {
+		return RenameChatRoomResponse_Base_DEFAULT
+	}
+	return p.Base
+}
+
+var RenameChatRoomResponse_SystemMessage_DEFAULT *model.ChatMessage
+
+func (p *RenameChatRoomResponse) GetSystemMessage() (v *model.ChatMessage) {
+	if !p.IsSetSystemMessage() {
+		return RenameChatRoomResponse_SystemMessage_DEFAULT
+	}
+	return p.SystemMessage
+}
+func (p *RenameChatRoomResponse) SetBase(val *model.BaseResp) {
+	p.Base = val
+}
+func (p *RenameChatRoomResponse) SetSystemMessage(val *model.ChatMessage) {
+	p.SystemMessage = val
+}
+
+func (p *RenameChatRoomResponse) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *RenameChatRoomResponse) IsSetSystemMessage() bool {
+	return p.SystemMessage != nil
+}
+
+func (p *RenameChatRoomResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("RenameChatRoomResponse(%+v)", *p)
+}
+
+var fieldIDToName_RenameChatRoomResponse = map[int16]string{
+	1: "Base",
+	2: "SystemMessage",
+}
+
+type DisbandChatRoomRequest struct {
+	RoomId int64 `thrift:"room_id,2,required" frugal:"2,required,i64" json:"room_id"`
+}
+
+func NewDisbandChatRoomRequest() *DisbandChatRoomRequest {
+	return &DisbandChatRoomRequest{}
+}
+
+func (p *DisbandChatRoomRequest) InitDefault() {
+}
+
+func (p *DisbandChatRoomRequest) GetRoomId() (v int64) {
+	return p.RoomId
+}
+func (p *DisbandChatRoomRequest) SetRoomId(val int64) {
+	p.RoomId = val
+}
+
+func (p *DisbandChatRoomRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("DisbandChatRoomRequest(%+v)", *p)
+}
+
+var fieldIDToName_DisbandChatRoomRequest = map[int16]string{
+	2: "room_id",
+}
+
+type DisbandChatRoomResponse struct {
+	Base          *model.BaseResp    `thrift:"Base,1,required" frugal:"1,required,model.BaseResp" json:"Base"`
+	MemberIds     []int64            `thrift:"MemberIds,2,required" frugal:"2,required,list<i64>" json:"MemberIds"`
+	SystemMessage *model.ChatMessage `thrift:"SystemMessage,3,optional" frugal:"3,optional,model.ChatMessage" json:"SystemMessage,omitempty"`
+}
+
+func NewDisbandChatRoomResponse() *DisbandChatRoomResponse {
+	return &DisbandChatRoomResponse{}
+}
+
+func (p *DisbandChatRoomResponse) InitDefault() {
+}
+
+var DisbandChatRoomResponse_Base_DEFAULT *model.BaseResp
+
+func (p *DisbandChatRoomResponse) GetBase() (v *model.BaseResp) {
+	if !p.IsSetBase() {
+		return DisbandChatRoomResponse_Base_DEFAULT
+	}
+	return p.Base
+}
+
+func (p *DisbandChatRoomResponse) GetMemberIds() (v []int64) {
+	return p.MemberIds
+}
+
+var DisbandChatRoomResponse_SystemMessage_DEFAULT *model.ChatMessage
+
+func (p *DisbandChatRoomResponse) GetSystemMessage() (v *model.ChatMessage) {
+	if !p.IsSetSystemMessage() {
+		return DisbandChatRoomResponse_SystemMessage_DEFAULT
+	}
+	return p.SystemMessage
+}
+func (p *DisbandChatRoomResponse) SetBase(val *model.BaseResp) {
+	p.Base = val
+}
+func (p *DisbandChatRoomResponse) SetMemberIds(val []int64) {
+	p.MemberIds = val
+}
+func (p *DisbandChatRoomResponse) SetSystemMessage(val *model.ChatMessage) {
+	p.SystemMessage = val
+}
+
+func (p *DisbandChatRoomResponse) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *DisbandChatRoomResponse) IsSetSystemMessage() bool {
+	return p.SystemMessage != nil
+}
+
+func (p *DisbandChatRoomResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("DisbandChatRoomResponse(%+v)", *p)
+}
+
+var fieldIDToName_DisbandChatRoomResponse = map[int16]string{
+	1: "Base",
+	2: "MemberIds",
+	3: "SystemMessage",
+}
+
+type CreateChatRoomInviteRequest struct {
+	RoomId int64  `thrift:"room_id,2,required" frugal:"2,required,i64" json:"room_id"`
+	Ttl    *int64 `thrift:"ttl,3,optional" frugal:"3,optional,i64" json:"ttl,omitempty"`
+}
+
+func NewCreateChatRoomInviteRequest() *CreateChatRoomInviteRequest {
+	return &CreateChatRoomInviteRequest{}
+}
+
+func (p *CreateChatRoomInviteRequest) InitDefault() {
+}
+
+func (p *CreateChatRoomInviteRequest) GetRoomId() (v int64) {
+	return p.RoomId
+}
+
+var CreateChatRoomInviteRequest_Ttl_DEFAULT int64
+
+func (p *CreateChatRoomInviteRequest) GetTtl() (v int64) {
+	if !p.IsSetTtl() {
+		return CreateChatRoomInviteRequest_Ttl_DEFAULT
+	}
+	return *p.Ttl
+}
+func (p *CreateChatRoomInviteRequest) SetRoomId(val int64) {
+	p.RoomId = val
+}
+func (p *CreateChatRoomInviteRequest) SetTtl(val *int64) {
+	p.Ttl = val
+}
+
+func (p *CreateChatRoomInviteRequest) IsSetTtl() bool {
+	return p.Ttl != nil
+}
+
+func (p *CreateChatRoomInviteRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CreateChatRoomInviteRequest(%+v)", *p)
+}
+
+var fieldIDToName_CreateChatRoomInviteRequest = map[int16]string{
+	2: "room_id",
+	3: "ttl",
+}
+
+type CreateChatRoomInviteResponse struct {
+	Base   *model.BaseResp       `thrift:"Base,1,required" frugal:"1,required,model.BaseResp" json:"Base"`
+	Invite *model.ChatRoomInvite `thrift:"Invite,2,required" frugal:"2,required,model.ChatRoomInvite" json:"Invite"`
+}
+
+func NewCreateChatRoomInviteResponse() *CreateChatRoomInviteResponse {
+	return &CreateChatRoomInviteResponse{}
+}
+
+func (p *CreateChatRoomInviteResponse) InitDefault() {
+}
+
+var CreateChatRoomInviteResponse_Base_DEFAULT *model.BaseResp
+
+func (p *CreateChatRoomInviteResponse) GetBase() (v *model.BaseResp) {
+	if !p.IsSetBase() {
+		return CreateChatRoomInviteResponse_Base_DEFAULT
+	}
+	return p.Base
+}
+
+var CreateChatRoomInviteResponse_Invite_DEFAULT *model.ChatRoomInvite
+
+func (p *CreateChatRoomInviteResponse) GetInvite() (v *model.ChatRoomInvite) {
+	if !p.IsSetInvite() {
+		return CreateChatRoomInviteResponse_Invite_DEFAULT
+	}
+	return p.Invite
+}
+func (p *CreateChatRoomInviteResponse) SetBase(val *model.BaseResp) {
+	p.Base = val
+}
+func (p *CreateChatRoomInviteResponse) SetInvite(val *model.ChatRoomInvite) {
+	p.Invite = val
+}
+
+func (p *CreateChatRoomInviteResponse) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *CreateChatRoomInviteResponse) IsSetInvite() bool {
+	return p.Invite != nil
+}
+
+func (p *CreateChatRoomInviteResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CreateChatRoomInviteResponse(%+v)", *p)
+}
+
+var fieldIDToName_CreateChatRoomInviteResponse = map[int16]string{
+	1: "Base",
+	2: "Invite",
+}
+
+type JoinChatRoomByInviteRequest struct {
+	Code string `thrift:"code,2,required" frugal:"2,required,string" json:"code"`
+}
+
+func NewJoinChatRoomByInviteRequest() *JoinChatRoomByInviteRequest {
+	return &JoinChatRoomByInviteRequest{}
+}
+
+func (p *JoinChatRoomByInviteRequest) InitDefault() {
+}
+
+func (p *JoinChatRoomByInviteRequest) GetCode() (v string) {
+	return p.Code
+}
+func (p *JoinChatRoomByInviteRequest) SetCode(val string) {
+	p.Code = val
+}
+
+func (p *JoinChatRoomByInviteRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("JoinChatRoomByInviteRequest(%+v)", *p)
+}
+
+var fieldIDToName_JoinChatRoomByInviteRequest = map[int16]string{
+	2: "code",
+}
+
+type JoinChatRoomByInviteResponse struct {
+	Base          *model.BaseResp    `thrift:"Base,1,required" frugal:"1,required,model.BaseResp" json:"Base"`
+	RoomId        int64              `thrift:"RoomId,2,required" frugal:"2,required,i64" json:"RoomId"`
+	SystemMessage *model.ChatMessage `thrift:"SystemMessage,3,optional" frugal:"3,optional,model.ChatMessage" json:"SystemMessage,omitempty"`
+}
+
+func NewJoinChatRoomByInviteResponse() *JoinChatRoomByInviteResponse {
+	return &JoinChatRoomByInviteResponse{}
+}
+
+func (p *JoinChatRoomByInviteResponse) InitDefault() {
+}
+
+var JoinChatRoomByInviteResponse_Base_DEFAULT *model.BaseResp
+
+func (p *JoinChatRoomByInviteResponse) GetBase() (v *model.BaseResp) {
+	if !p.IsSetBase() {
+		return JoinChatRoomByInviteResponse_Base_DEFAULT
+	}
+	return p.Base
+}
+
+func (p *JoinChatRoomByInviteResponse) GetRoomId() (v int64) {
+	return p.RoomId
+}
+
+var JoinChatRoomByInviteResponse_SystemMessage_DEFAULT *model.ChatMessage
+
+func (p *JoinChatRoomByInviteResponse) GetSystemMessage() (v *model.ChatMessage) {
+	if !p.IsSetSystemMessage() {
+		return JoinChatRoomByInviteResponse_SystemMessage_DEFAULT
+	}
+	return p.SystemMessage
+}
+func (p *JoinChatRoomByInviteResponse) SetBase(val *model.BaseResp) {
+	p.Base = val
+}
+func (p *JoinChatRoomByInviteResponse) SetRoomId(val int64) {
+	p.RoomId = val
+}
+func (p *JoinChatRoomByInviteResponse) SetSystemMessage(val *model.ChatMessage) {
+	p.SystemMessage = val
+}
+
+func (p *JoinChatRoomByInviteResponse) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *JoinChatRoomByInviteResponse) IsSetSystemMessage() bool {
+	return p.SystemMessage != nil
+}
+
+func (p *JoinChatRoomByInviteResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("JoinChatRoomByInviteResponse(%+v)", *p)
+}
+
+var fieldIDToName_JoinChatRoomByInviteResponse = map[int16]string{
+	1: "Base",
+	2: "RoomId",
+	3: "SystemMessage",
+}
+
+type UploadChatAttachmentRequest struct {
+	FileName    string `thrift:"file_name,2,required" frugal:"2,required,string" json:"file_name"`
+	ContentType string `thrift:"content_type,3,required" frugal:"3,required,string" json:"content_type"`
+	Data        []byte `thrift:"data,4,required" frugal:"4,required,binary" json:"data"`
+}
+
+func NewUploadChatAttachmentRequest() *UploadChatAttachmentRequest {
+	return &UploadChatAttachmentRequest{}
+}
+
+func (p *UploadChatAttachmentRequest) InitDefault() {
+}
+
+func (p *UploadChatAttachmentRequest) GetFileName() (v string) {
+	return p.FileName
+}
+
+func (p *UploadChatAttachmentRequest) GetContentType() (v string) {
+	return p.ContentType
+}
+
+func (p *UploadChatAttachmentRequest) GetData() (v []byte) {
+	return p.Data
+}
+func (p *UploadChatAttachmentRequest) SetFileName(val string) {
+	p.FileName = val
+}
+func (p *UploadChatAttachmentRequest) SetContentType(val string) {
+	p.ContentType = val
+}
+func (p *UploadChatAttachmentRequest) SetData(val []byte) {
+	p.Data = val
+}
+
+func (p *UploadChatAttachmentRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UploadChatAttachmentRequest(%+v)", *p)
+}
+
+var fieldIDToName_UploadChatAttachmentRequest = map[int16]string{
+	2: "file_name",
+	3: "content_type",
+	4: "data",
+}
+
+type UploadChatAttachmentResponse struct {
+	Base       *model.BaseResp       `thrift:"Base,1,required" frugal:"1,required,model.BaseResp" json:"Base"`
+	Attachment *model.ChatAttachment `thrift:"Attachment,2,required" frugal:"2,required,model.ChatAttachment" json:"Attachment"`
+}
+
+func NewUploadChatAttachmentResponse() *UploadChatAttachmentResponse {
+	return &UploadChatAttachmentResponse{}
+}
+
+func (p *UploadChatAttachmentResponse) InitDefault() {
+}
+
+var UploadChatAttachmentResponse_Base_DEFAULT *model.BaseResp
+
+func (p *UploadChatAttachmentResponse) GetBase() (v *model.BaseResp) {
+	if !p.IsSetBase() {
+		return UploadChatAttachmentResponse_Base_DEFAULT
+	}
+	return p.Base
+}
+
+var UploadChatAttachmentResponse_Attachment_DEFAULT *model.ChatAttachment
+
+func (p *UploadChatAttachmentResponse) GetAttachment() (v *model.ChatAttachment) {
+	if !p.IsSetAttachment() {
+		return UploadChatAttachmentResponse_Attachment_DEFAULT
+	}
+	return p.Attachment
+}
+func (p *UploadChatAttachmentResponse) SetBase(val *model.BaseResp) {
+	p.Base = val
+}
+func (p *UploadChatAttachmentResponse) SetAttachment(val *model.ChatAttachment) {
+	p.Attachment = val
+}
+
+func (p *UploadChatAttachmentResponse) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *UploadChatAttachmentResponse) IsSetAttachment() bool {
+	return p.Attachment != nil
+}
+
+func (p *UploadChatAttachmentResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UploadChatAttachmentResponse(%+v)", *p)
+}
+
+var fieldIDToName_UploadChatAttachmentResponse = map[int16]string{
+	1: "Base",
+	2: "Attachment",
+}
+
+type EditChatMessageRequest struct {
+	MessageId int64  `thrift:"message_id,2,required" frugal:"2,required,i64" json:"message_id"`
+	Content   string `thrift:"content,3,required" frugal:"3,required,string" json:"content"`
+}
+
+func NewEditChatMessageRequest() *EditChatMessageRequest {
+	return &EditChatMessageRequest{}
+}
+
+func (p *EditChatMessageRequest) InitDefault() {
+}
+
+func (p *EditChatMessageRequest) GetMessageId() (v int64) {
+	return p.MessageId
+}
+
+func (p *EditChatMessageRequest) GetContent() (v string) {
+	return p.Content
+}
+func (p *EditChatMessageRequest) SetMessageId(val int64) {
+	p.MessageId = val
+}
+func (p *EditChatMessageRequest) SetContent(val string) {
+	p.Content = val
+}
+
+func (p *EditChatMessageRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("EditChatMessageRequest(%+v)", *p)
+}
+
+var fieldIDToName_EditChatMessageRequest = map[int16]string{
+	2: "message_id",
+	3: "content",
+}
+
+type EditChatMessageResponse struct {
+	Base    *model.BaseResp    `thrift:"Base,1,required" frugal:"1,required,model.BaseResp" json:"Base"`
+	Message *model.ChatMessage `thrift:"Message,2,required" frugal:"2,required,model.ChatMessage" json:"Message"`
+}
+
+func NewEditChatMessageResponse() *EditChatMessageResponse {
+	return &EditChatMessageResponse{}
+}
+
+func (p *EditChatMessageResponse) InitDefault() {
+}
+
+var EditChatMessageResponse_Base_DEFAULT *model.BaseResp
+
+func (p *EditChatMessageResponse) GetBase() (v *model.BaseResp) {
+	if !p.IsSetBase() {
+		return EditChatMessageResponse_Base_DEFAULT
+	}
+	return p.Base
+}
+
+var EditChatMessageResponse_Message_DEFAULT *model.ChatMessage
+
+func (p *EditChatMessageResponse) GetMessage() (v *model.ChatMessage) {
+	if !p.IsSetMessage() {
+		return EditChatMessageResponse_Message_DEFAULT
+	}
+	return p.Message
+}
+func (p *EditChatMessageResponse) SetBase(val *model.BaseResp) {
+	p.Base = val
+}
+func (p *EditChatMessageResponse) SetMessage(val *model.ChatMessage) {
+	p.Message = val
+}
+
+func (p *EditChatMessageResponse) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *EditChatMessageResponse) IsSetMessage() bool {
+	return p.Message != nil
+}
+
+func (p *EditChatMessageResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("EditChatMessageResponse(%+v)", *p)
+}
+
+var fieldIDToName_EditChatMessageResponse = map[int16]string{
+	1: "Base",
+	2: "Message",
+}
+
+type RecallChatMessageRequest struct {
+	MessageId int64 `thrift:"message_id,2,required" frugal:"2,required,i64" json:"message_id"`
+}
+
+func NewRecallChatMessageRequest() *RecallChatMessageRequest {
+	return &RecallChatMessageRequest{}
+}
+
+func (p *RecallChatMessageRequest) InitDefault() {
+}
+
+func (p *RecallChatMessageRequest) GetMessageId() (v int64) {
+	return p.MessageId
+}
+func (p *RecallChatMessageRequest) SetMessageId(val int64) {
+	p.MessageId = val
+}
+
+func (p *RecallChatMessageRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("RecallChatMessageRequest(%+v)", *p)
+}
+
+var fieldIDToName_RecallChatMessageRequest = map[int16]string{
+	2: "message_id",
+}
+
+type RecallChatMessageResponse struct {
+	Base    *model.BaseResp    `thrift:"Base,1,required" frugal:"1,required,model.BaseResp" json:"Base"`
+	Message *model.ChatMessage `thrift:"Message,2,required" frugal:"2,required,model.ChatMessage" json:"Message"`
+}
+
+func NewRecallChatMessageResponse() *RecallChatMessageResponse {
+	return &RecallChatMessageResponse{}
+}
+
+func (p *RecallChatMessageResponse) InitDefault() {
+}
+
+var RecallChatMessageResponse_Base_DEFAULT *model.BaseResp
+
+func (p *RecallChatMessageResponse) GetBase() (v *model.BaseResp) {
+	if !p.IsSetBase() {
+		return RecallChatMessageResponse_Base_DEFAULT
+	}
+	return p.Base
+}
+
+var RecallChatMessageResponse_Message_DEFAULT *model.ChatMessage
+
+func (p *RecallChatMessageResponse) GetMessage() (v *model.ChatMessage) {
+	if !p.IsSetMessage() {
+		return RecallChatMessageResponse_Message_DEFAULT
+	}
+	return p.Message
+}
+func (p *RecallChatMessageResponse) SetBase(val *model.BaseResp) {
+	p.Base = val
+}
+func (p *RecallChatMessageResponse) SetMessage(val *model.ChatMessage) {
+	p.Message = val
+}
+
+func (p *RecallChatMessageResponse) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *RecallChatMessageResponse) IsSetMessage() bool {
+	return p.Message != nil
+}
+
+func (p *RecallChatMessageResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("RecallChatMessageResponse(%+v)", *p)
+}
+
+var fieldIDToName_RecallChatMessageResponse = map[int16]string{
+	1: "Base",
+	2: "Message",
+}
+
+type EditPrivateMessageRequest struct {
+	MessageId int64  `thrift:"message_id,1,required" frugal:"1,required,i64" json:"message_id"`
+	Content   string `thrift:"content,2,required" frugal:"2,required,string" json:"content"`
+}
+
+func NewEditPrivateMessageRequest() *EditPrivateMessageRequest {
+	return &EditPrivateMessageRequest{}
+}
+
+func (p *EditPrivateMessageRequest) InitDefault() {
+}
+
+func (p *EditPrivateMessageRequest) GetMessageId() (v int64) {
+	return p.MessageId
+}
+
+func (p *EditPrivateMessageRequest) GetContent() (v string) {
+	return p.Content
+}
+func (p *EditPrivateMessageRequest) SetMessageId(val int64) {
+	p.MessageId = val
+}
+func (p *EditPrivateMessageRequest) SetContent(val string) {
+	p.Content = val
+}
+
+func (p *EditPrivateMessageRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("EditPrivateMessageRequest(%+v)", *p)
+}
+
+var fieldIDToName_EditPrivateMessageRequest = map[int16]string{
+	1: "message_id",
+	2: "content",
+}
+
+type EditPrivateMessageResponse struct {
+	Base    *model.BaseResp       `thrift:"Base,1,required" frugal:"1,required,model.BaseResp" json:"Base"`
+	Message *model.PrivateMessage `thrift:"Message,2,required" frugal:"2,required,model.PrivateMessage" json:"Message"`
+}
+
+func NewEditPrivateMessageResponse() *EditPrivateMessageResponse {
+	return &EditPrivateMessageResponse{}
+}
+
+func (p *EditPrivateMessageResponse) InitDefault() {
+}
+
+var EditPrivateMessageResponse_Base_DEFAULT *model.BaseResp
+
+func (p *EditPrivateMessageResponse) GetBase() (v *model.BaseResp) {
+	if !p.IsSetBase() {
+		return EditPrivateMessageResponse_Base_DEFAULT
+	}
+	return p.Base
+}
+
+var EditPrivateMessageResponse_Message_DEFAULT *model.PrivateMessage
+
+func (p *EditPrivateMessageResponse) GetMessage() (v *model.PrivateMessage) {
+	if !p.IsSetMessage() {
+		return EditPrivateMessageResponse_Message_DEFAULT
+	}
+	return p.Message
+}
+func (p *EditPrivateMessageResponse) SetBase(val *model.BaseResp) {
+	p.Base = val
+}
+func (p *EditPrivateMessageResponse) SetMessage(val *model.PrivateMessage) {
+	p.Message = val
+}
+
+func (p *EditPrivateMessageResponse) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *EditPrivateMessageResponse) IsSetMessage() bool {
+	return p.Message != nil
+}
+
+func (p *EditPrivateMessageResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("EditPrivateMessageResponse(%+v)", *p)
+}
+
+var fieldIDToName_EditPrivateMessageResponse = map[int16]string{
+	1: "Base",
+	2: "Message",
+}
+
+type RecallPrivateMessageRequest struct {
+	MessageId int64 `thrift:"message_id,1,required" frugal:"1,required,i64" json:"message_id"`
+}
+
+func NewRecallPrivateMessageRequest() *RecallPrivateMessageRequest {
+	return &RecallPrivateMessageRequest{}
+}
+
+func (p *RecallPrivateMessageRequest) InitDefault() {
+}
+
+func (p *RecallPrivateMessageRequest) GetMessageId() (v int64) {
+	return p.MessageId
+}
+func (p *RecallPrivateMessageRequest) SetMessageId(val int64) {
+	p.MessageId = val
+}
+
+func (p *RecallPrivateMessageRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("RecallPrivateMessageRequest(%+v)", *p)
+}
+
+var fieldIDToName_RecallPrivateMessageRequest = map[int16]string{
+	1: "message_id",
+}
+
+type RecallPrivateMessageResponse struct {
+	Base    *model.BaseResp       `thrift:"Base,1,required" frugal:"1,required,model.BaseResp" json:"Base"`
+	Message *model.PrivateMessage `thrift:"Message,2,required" frugal:"2,required,model.PrivateMessage" json:"Message"`
+}
+
+func NewRecallPrivateMessageResponse() *RecallPrivateMessageResponse {
+	return &RecallPrivateMessageResponse{}
+}
+
+func (p *RecallPrivateMessageResponse) InitDefault() {
+}
+
+var RecallPrivateMessageResponse_Base_DEFAULT *model.BaseResp
+
+func (p *RecallPrivateMessageResponse) GetBase() (v *model.BaseResp) {
+	if !p.IsSetBase() {
+		return RecallPrivateMessageResponse_Base_DEFAULT
+	}
+	return p.Base
+}
+
+var RecallPrivateMessageResponse_Message_DEFAULT *model.PrivateMessage
+
+func (p *RecallPrivateMessageResponse) GetMessage() (v *model.PrivateMessage) {
+	if !p.IsSetMessage() {
+		return RecallPrivateMessageResponse_Message_DEFAULT
+	}
+	return p.Message
+}
+func (p *RecallPrivateMessageResponse) SetBase(val *model.BaseResp) {
+	p.Base = val
+}
+func (p *RecallPrivateMessageResponse) SetMessage(val *model.PrivateMessage) {
+	p.Message = val
+}
+
+func (p *RecallPrivateMessageResponse) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *RecallPrivateMessageResponse) IsSetMessage() bool {
+	return p.Message != nil
+}
+
+func (p *RecallPrivateMessageResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("RecallPrivateMessageResponse(%+v)", *p)
+}
+
+var fieldIDToName_RecallPrivateMessageResponse = map[int16]string{
+	1: "Base",
+	2: "Message",
+}
+
+type ShareVideoRequest struct {
+	RoomId    int64  `thrift:"room_id,1,required" frugal:"1,required,i64" json:"room_id"`
+	VideoId   int64  `thrift:"video_id,2,required" frugal:"2,required,i64" json:"video_id"`
+	ReplyToId *int64 `thrift:"reply_to_id,3,optional" frugal:"3,optional,i64" json:"reply_to_id,omitempty"`
+}
+
+func NewShareVideoRequest() *ShareVideoRequest {
+	return &ShareVideoRequest{}
+}
+
+func (p *ShareVideoRequest) InitDefault() {
+}
+
+func (p *ShareVideoRequest) GetRoomId() (v int64) {
+	return p.RoomId
+}
+
+func (p *ShareVideoRequest) GetVideoId() (v int64) {
+	return p.VideoId
+}
+
+var ShareVideoRequest_ReplyToId_DEFAULT int64
+
+func (p *ShareVideoRequest) GetReplyToId() (v int64) {
+	if !p.IsSetReplyToId() {
+		return ShareVideoRequest_ReplyToId_DEFAULT
+	}
+	return *p.ReplyToId
+}
+func (p *ShareVideoRequest) SetRoomId(val int64) {
+	p.RoomId = val
+}
+func (p *ShareVideoRequest) SetVideoId(val int64) {
+	p.VideoId = val
+}
+func (p *ShareVideoRequest) SetReplyToId(val *int64) {
+	p.ReplyToId = val
+}
+
+func (p *ShareVideoRequest) IsSetReplyToId() bool {
+	return p.ReplyToId != nil
+}
+
+func (p *ShareVideoRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ShareVideoRequest(%+v)", *p)
+}
+
+var fieldIDToName_ShareVideoRequest = map[int16]string{
+	1: "room_id",
+	2: "video_id",
+	3: "reply_to_id",
+}
+
+type GetNotificationsRequest struct {
+	Type *int8  `thrift:"type,2,optional" frugal:"2,optional,i8" json:"type,omitempty"`
+	Page *int32 `thrift:"page,3,optional" frugal:"3,optional,i32" json:"page,omitempty"`
+	Size *int32 `thrift:"size,4,optional" frugal:"4,optional,i32" json:"size,omitempty"`
+}
+
+func NewGetNotificationsRequest() *GetNotificationsRequest {
+	return &GetNotificationsRequest{}
+}
+
+func (p *GetNotificationsRequest) InitDefault() {
+}
+
+var GetNotificationsRequest_Type_DEFAULT int8
+
+func (p *GetNotificationsRequest) GetType() (v int8) {
+	if !p.IsSetType() {
+		return GetNotificationsRequest_Type_DEFAULT
+	}
+	return *p.Type
+}
+
+var GetNotificationsRequest_Page_DEFAULT int32
+
+func (p *GetNotificationsRequest) GetPage() (v int32) {
+	if !p.IsSetPage() {
+		return GetNotificationsRequest_Page_DEFAULT
+	}
+	return *p.Page
+}
+
+var GetNotificationsRequest_Size_DEFAULT int32
+
+func (p *GetNotificationsRequest) GetSize() (v int32) {
+	if !p.IsSetSize() {
+		return GetNotificationsRequest_Size_DEFAULT
+	}
+	return *p.Size
+}
+func (p *GetNotificationsRequest) SetType(val *int8) {
+	p.Type = val
+}
+func (p *GetNotificationsRequest) SetPage(val *int32) {
+	p.Page = val
+}
+func (p *GetNotificationsRequest) SetSize(val *int32) {
+	p.Size = val
+}
+
+func (p *GetNotificationsRequest) IsSetType() bool {
+	return p.Type != nil
+}
+
+func (p *GetNotificationsRequest) IsSetPage() bool {
+	return p.Page != nil
+}
+
+func (p *GetNotificationsRequest) IsSetSize() bool {
+	return p.Size != nil
+}
+
+func (p *GetNotificationsRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GetNotificationsRequest(%+v)", *p)
+}
+
+var fieldIDToName_GetNotificationsRequest = map[int16]string{
+	2: "type",
+	3: "page",
+	4: "size",
+}
+
+type GetNotificationsResponse struct {
+	Base          *model.BaseResp       `thrift:"Base,1,required" frugal:"1,required,model.BaseResp" json:"Base"`
+	Notifications []*model.Notification `thrift:"Notifications,2,required" frugal:"2,required,list<model.Notification>" json:"Notifications"`
+	Total         int64                 `thrift:"Total,3,required" frugal:"3,required,i64" json:"Total"`
+}
+
+func NewGetNotificationsResponse() *GetNotificationsResponse {
+	return &GetNotificationsResponse{}
+}
+
+func (p *GetNotificationsResponse) InitDefault() {
+}
+
+var GetNotificationsResponse_Base_DEFAULT *model.BaseResp
+
+func (p *GetNotificationsResponse) GetBase() (v *model.BaseResp) {
+	if !p.IsSetBase() {
+		return GetNotificationsResponse_Base_DEFAULT
+	}
+	return p.Base
+}
+
+func (p *GetNotificationsResponse) GetNotifications() (v []*model.Notification) {
+	return p.Notifications
+}
+
+func (p *GetNotificationsResponse) GetTotal() (v int64) {
+	return p.Total
+}
+func (p *GetNotificationsResponse) SetBase(val *model.BaseResp) {
+	p.Base = val
+}
+func (p *GetNotificationsResponse) SetNotifications(val []*model.Notification) {
+	p.Notifications = val
+}
+func (p *GetNotificationsResponse) SetTotal(val int64) {
+	p.Total = val
+}
+
+func (p *GetNotificationsResponse) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *GetNotificationsResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GetNotificationsResponse(%+v)", *p)
+}
+
+var fieldIDToName_GetNotificationsResponse = map[int16]string{
+	1: "Base",
+	2: "Notifications",
+	3: "Total",
+}
+
+type GetNotificationUnreadCountRequest struct {
+}
+
+func NewGetNotificationUnreadCountRequest() *GetNotificationUnreadCountRequest {
+	return &GetNotificationUnreadCountRequest{}
+}
+
+func (p *GetNotificationUnreadCountRequest) InitDefault() {
+}
+
+func (p *GetNotificationUnreadCountRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GetNotificationUnreadCountRequest(%+v)", *p)
+}
+
+var fieldIDToName_GetNotificationUnreadCountRequest = map[int16]string{}
+
+type GetNotificationUnreadCountResponse struct {
+	Base   *model.BaseResp                  `thrift:"Base,1,required" frugal:"1,required,model.BaseResp" json:"Base"`
+	Counts []*model.NotificationUnreadCount `thrift:"Counts,2,required" frugal:"2,required,list<model.NotificationUnreadCount>" json:"Counts"`
+	Total  int64                            `thrift:"Total,3,required" frugal:"3,required,i64" json:"Total"`
+}
+
+func NewGetNotificationUnreadCountResponse() *GetNotificationUnreadCountResponse {
+	return &GetNotificationUnreadCountResponse{}
+}
+
+func (p *GetNotificationUnreadCountResponse) InitDefault() {
+}
+
+var GetNotificationUnreadCountResponse_Base_DEFAULT *model.BaseResp
+
+func (p *GetNotificationUnreadCountResponse) GetBase() (v *model.BaseResp) {
+	if !p.IsSetBase() {
+		return GetNotificationUnreadCountResponse_Base_DEFAULT
+	}
+	return p.Base
+}
+
+func (p *GetNotificationUnreadCountResponse) GetCounts() (v []*model.NotificationUnreadCount) {
+	return p.Counts
+}
+
+func (p *GetNotificationUnreadCountResponse) GetTotal() (v int64) {
+	return p.Total
+}
+func (p *GetNotificationUnreadCountResponse) SetBase(val *model.BaseResp) {
+	p.Base = val
+}
+func (p *GetNotificationUnreadCountResponse) SetCounts(val []*model.NotificationUnreadCount) {
+	p.Counts = val
+}
+func (p *GetNotificationUnreadCountResponse) SetTotal(val int64) {
+	p.Total = val
+}
+
+func (p *GetNotificationUnreadCountResponse) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *GetNotificationUnreadCountResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GetNotificationUnreadCountResponse(%+v)", *p)
+}
+
+var fieldIDToName_GetNotificationUnreadCountResponse = map[int16]string{
+	1: "Base",
+	2: "Counts",
+	3: "Total",
+}
+
+type MarkNotificationsReadRequest struct {
+	NotificationIds []int64 `thrift:"notification_ids,2,optional" frugal:"2,optional,list<i64>" json:"notification_ids,omitempty"`
+	Type            *int8   `thrift:"type,3,optional" frugal:"3,optional,i8" json:"type,omitempty"`
+}
+
+func NewMarkNotificationsReadRequest() *MarkNotificationsReadRequest {
+	return &MarkNotificationsReadRequest{}
+}
+
+func (p *MarkNotificationsReadRequest) InitDefault() {
+}
+
+var MarkNotificationsReadRequest_NotificationIds_DEFAULT []int64
+
+func (p *MarkNotificationsReadRequest) GetNotificationIds() (v []int64) {
+	if !p.IsSetNotificationIds() {
+		return MarkNotificationsReadRequest_NotificationIds_DEFAULT
+	}
+	return p.NotificationIds
+}
+
+var MarkNotificationsReadRequest_Type_DEFAULT int8
+
+func (p *MarkNotificationsReadRequest) GetType() (v int8) {
+	if !p.IsSetType() {
+		return MarkNotificationsReadRequest_Type_DEFAULT
+	}
+	return *p.Type
+}
+func (p *MarkNotificationsReadRequest) SetNotificationIds(val []int64) {
+	p.NotificationIds = val
+}
+func (p *MarkNotificationsReadRequest) SetType(val *int8) {
+	p.Type = val
+}
+
+func (p *MarkNotificationsReadRequest) IsSetNotificationIds() bool {
+	return p.NotificationIds != nil
+}
+
+func (p *MarkNotificationsReadRequest) IsSetType() bool {
+	return p.Type != nil
+}
+
+func (p *MarkNotificationsReadRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("MarkNotificationsReadRequest(%+v)", *p)
+}
+
+var fieldIDToName_MarkNotificationsReadRequest = map[int16]string{
+	2: "notification_ids",
+	3: "type",
+}
+
+type MarkNotificationsReadResponse struct {
+	Base  *model.BaseResp `thrift:"Base,1,required" frugal:"1,required,model.BaseResp" json:"Base"`
+	Count int64           `thrift:"Count,2,required" frugal:"2,required,i64" json:"Count"`
+}
+
+func NewMarkNotificationsReadResponse() *MarkNotificationsReadResponse {
+	return &MarkNotificationsReadResponse{}
+}
+
+func (p *MarkNotificationsReadResponse) InitDefault() {
+}
+
+var MarkNotificationsReadResponse_Base_DEFAULT *model.BaseResp
+
+func (p *MarkNotificationsReadResponse) GetBase() (v *model.BaseResp) {
+	if !p.IsSetBase() {
+		return MarkNotificationsReadResponse_Base_DEFAULT
+	}
+	return p.Base
+}
+
+func (p *MarkNotificationsReadResponse) GetCount() (v int64) {
+	return p.Count
+}
+func (p *MarkNotificationsReadResponse) SetBase(val *model.BaseResp) {
+	p.Base = val
+}
+func (p *MarkNotificationsReadResponse) SetCount(val int64) {
+	p.Count = val
+}
+
+func (p *MarkNotificationsReadResponse) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *MarkNotificationsReadResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("MarkNotificationsReadResponse(%+v)", *p)
+}
+
+var fieldIDToName_MarkNotificationsReadResponse = map[int16]string{
+	1: "Base",
+	2: "Count",
+}
+
+type GetNotificationPreferencesRequest struct {
+}
+
+func NewGetNotificationPreferencesRequest() *GetNotificationPreferencesRequest {
+	return &GetNotificationPreferencesRequest{}
+}
+
+func (p *GetNotificationPreferencesRequest) InitDefault() {
+}
+
+func (p *GetNotificationPreferencesRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GetNotificationPreferencesRequest(%+v)", *p)
+}
+
+var fieldIDToName_GetNotificationPreferencesRequest = map[int16]string{}
+
+type GetNotificationPreferencesResponse struct {
+	Base        *model.BaseResp                 `thrift:"Base,1,required" frugal:"1,required,model.BaseResp" json:"Base"`
+	Preferences []*model.NotificationPreference `thrift:"Preferences,2,required" frugal:"2,required,list<model.NotificationPreference>" json:"Preferences"`
+}
+
+func NewGetNotificationPreferencesResponse() *GetNotificationPreferencesResponse {
+	return &GetNotificationPreferencesResponse{}
+}
+
+func (p *GetNotificationPreferencesResponse) InitDefault() {
+}
+
+var GetNotificationPreferencesResponse_Base_DEFAULT *model.BaseResp
+
+func (p *GetNotificationPreferencesResponse) GetBase() (v *model.BaseResp) {
+	if !p.IsSetBase() {
+		return GetNotificationPreferencesResponse_Base_DEFAULT
+	}
+	return p.Base
+}
+
+func (p *GetNotificationPreferencesResponse) GetPreferences() (v []*model.NotificationPreference) {
+	return p.Preferences
+}
+func (p *GetNotificationPreferencesResponse) SetBase(val *model.BaseResp) {
+	p.Base = val
+}
+func (p *GetNotificationPreferencesResponse) SetPreferences(val []*model.NotificationPreference) {
+	p.Preferences = val
+}
+
+func (p *GetNotificationPreferencesResponse) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *GetNotificationPreferencesResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GetNotificationPreferencesResponse(%+v)", *p)
+}
+
+var fieldIDToName_GetNotificationPreferencesResponse = map[int16]string{
+	1: "Base",
+	2: "Preferences",
+}
+
+type UpdateNotificationPreferencesRequest struct {
+	Preferences []*model.NotificationPreference `thrift:"preferences,2,required" frugal:"2,required,list<model.NotificationPreference>" json:"preferences"`
+}
+
+func NewUpdateNotificationPreferencesRequest() *UpdateNotificationPreferencesRequest {
+	return &UpdateNotificationPreferencesRequest{}
+}
+
+func (p *UpdateNotificationPreferencesRequest) InitDefault() {
+}
+
+func (p *UpdateNotificationPreferencesRequest) GetPreferences() (v []*model.NotificationPreference) {
+	return p.Preferences
+}
+func (p *UpdateNotificationPreferencesRequest) SetPreferences(val []*model.NotificationPreference) {
+	p.Preferences = val
+}
+
+func (p *UpdateNotificationPreferencesRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UpdateNotificationPreferencesRequest(%+v)", *p)
+}
+
+var fieldIDToName_UpdateNotificationPreferencesRequest = map[int16]string{
+	2: "preferences",
+}
+
+type UpdateNotificationPreferencesResponse struct {
+	Base        *model.BaseResp                 `thrift:"Base,1,required" frugal:"1,required,model.BaseResp" json:"Base"`
+	Preferences []*model.NotificationPreference `thrift:"Preferences,2,required" frugal:"2,required,list<model.NotificationPreference>" json:"Preferences"`
+}
+
+func NewUpdateNotificationPreferencesResponse() *UpdateNotificationPreferencesResponse {
+	return &UpdateNotificationPreferencesResponse{}
+}
+
+func (p *UpdateNotificationPreferencesResponse) InitDefault() {
+}
+
+var UpdateNotificationPreferencesResponse_Base_DEFAULT *model.BaseResp
+
+func (p *UpdateNotificationPreferencesResponse) GetBase() (v *model.BaseResp) {
+	if !p.IsSetBase() {
+		return UpdateNotificationPreferencesResponse_Base_DEFAULT
+	}
+	return p.Base
+}
+
+func (p *UpdateNotificationPreferencesResponse) GetPreferences() (v []*model.NotificationPreference) {
+	return p.Preferences
+}
+func (p *UpdateNotificationPreferencesResponse) SetBase(val *model.BaseResp) {
+	p.Base = val
+}
+func (p *UpdateNotificationPreferencesResponse) SetPreferences(val []*model.NotificationPreference) {
+	p.Preferences = val
+}
+
+func (p *UpdateNotificationPreferencesResponse) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *UpdateNotificationPreferencesResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UpdateNotificationPreferencesResponse(%+v)", *p)
+}
+
+var fieldIDToName_UpdateNotificationPreferencesResponse = map[int16]string{
+	1: "Base",
+	2: "Preferences",
+}
+
+type UpdatePresenceRequest struct {
+	UserIds []int64 `thrift:"user_ids,1,required" frugal:"1,required,list<i64>" json:"user_ids"`
+	Online  bool    `thrift:"online,2,required" frugal:"2,required,bool" json:"online"`
+}
+
+func NewUpdatePresenceRequest() *UpdatePresenceRequest {
+	return &UpdatePresenceRequest{}
+}
+
+func (p *UpdatePresenceRequest) InitDefault() {
+}
+
+func (p *UpdatePresenceRequest) GetUserIds() (v []int64) {
+	return p.UserIds
+}
+
+func (p *UpdatePresenceRequest) GetOnline() (v bool) {
+	return p.Online
+}
+func (p *UpdatePresenceRequest) SetUserIds(val []int64) {
+	p.UserIds = val
+}
+func (p *UpdatePresenceRequest) SetOnline(val bool) {
+	p.Online = val
+}
+
+func (p *UpdatePresenceRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UpdatePresenceRequest(%+v)", *p)
+}
+
+var fieldIDToName_UpdatePresenceRequest = map[int16]string{
+	1: "user_ids",
+	2: "online",
+}
+
+type UpdatePresenceResponse struct {
+	Base *model.BaseResp `thrift:"Base,1,required" frugal:"1,required,model.BaseResp" json:"Base"`
+}
+
+func NewUpdatePresenceResponse() *UpdatePresenceResponse {
+	return &UpdatePresenceResponse{}
+}
+
+func (p *UpdatePresenceResponse) InitDefault() {
+}
+
+var UpdatePresenceResponse_Base_DEFAULT *model.BaseResp
+
+func (p *UpdatePresenceResponse) GetBase() (v *model.BaseResp) {
+	if !p.IsSetBase() {
+		return UpdatePresenceResponse_Base_DEFAULT
+	}
+	return p.Base
+}
+func (p *UpdatePresenceResponse) SetBase(val *model.BaseResp) {
+	p.Base = val
+}
+
+func (p *UpdatePresenceResponse) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *UpdatePresenceResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UpdatePresenceResponse(%+v)", *p)
+}
+
+var fieldIDToName_UpdatePresenceResponse = map[int16]string{
+	1: "Base",
+}
+
+type GetPresenceRequest struct {
+	UserIds []int64 `thrift:"user_ids,2,optional" frugal:"2,optional,list<i64>" json:"user_ids,omitempty"`
+}
+
+func NewGetPresenceRequest() *GetPresenceRequest {
+	return &GetPresenceRequest{}
+}
+
+func (p *GetPresenceRequest) InitDefault() {
+}
+
+var GetPresenceRequest_UserIds_DEFAULT []int64
+
+func (p *GetPresenceRequest) GetUserIds() (v []int64) {
+	if !p.IsSetUserIds() {
+		return GetPresenceRequest_UserIds_DEFAULT
+	}
+	return p.UserIds
+}
+func (p *GetPresenceRequest) SetUserIds(val []int64) {
+	p.UserIds = val
+}
+
+func (p *GetPresenceRequest) IsSetUserIds() bool {
+	return p.UserIds != nil
+}
+
+func (p *GetPresenceRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GetPresenceRequest(%+v)", *p)
+}
+
+var fieldIDToName_GetPresenceRequest = map[int16]string{
+	2: "user_ids",
+}
+
+type GetPresenceResponse struct {
+	Base      *model.BaseResp       `thrift:"Base,1,required" frugal:"1,required,model.BaseResp" json:"Base"`
+	Presences []*model.UserPresence `thrift:"Presences,2,required" frugal:"2,required,list<model.UserPresence>" json:"Presences"`
+}
+
+func NewGetPresenceResponse() *GetPresenceResponse {
+	return &GetPresenceResponse{}
+}
+
+func (p *GetPresenceResponse) InitDefault() {
+}
+
+var GetPresenceResponse_Base_DEFAULT *model.BaseResp
+
+func (p *GetPresenceResponse) GetBase() (v *model.BaseResp) {
+	if !p.IsSetBase() {
+		return GetPresenceResponse_Base_DEFAULT
+	}
+	return p.Base
+}
+
+func (p *GetPresenceResponse) GetPresences() (v []*model.UserPresence) {
+	return p.Presences
+}
+func (p *GetPresenceResponse) SetBase(val *model.BaseResp) {
+	p.Base = val
+}
+func (p *GetPresenceResponse) SetPresences(val []*model.UserPresence) {
+	p.Presences = val
+}
+
+func (p *GetPresenceResponse) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *GetPresenceResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GetPresenceResponse(%+v)", *p)
+}
+
+var fieldIDToName_GetPresenceResponse = map[int16]string{
+	1: "Base",
+	2: "Presences",
+}
+
+type SearchMessagesRequest struct {
+	Keyword  *string `thrift:"keyword,2,optional" frugal:"2,optional,string" json:"keyword,omitempty"`
+	SenderId *int64  `thrift:"sender_id,3,optional" frugal:"3,optional,i64" json:"sender_id,omitempty"`
+	PeerId   *int64  `thrift:"peer_id,4,optional" frugal:"4,optional,i64" json:"peer_id,omitempty"`
+	RoomId   *int64  `thrift:"room_id,5,optional" frugal:"5,optional,i64" json:"room_id,omitempty"`
+	FromDate *int64  `thrift:"from_date,6,optional" frugal:"6,optional,i64" json:"from_date,omitempty"`
+	ToDate   *int64  `thrift:"to_date,7,optional" frugal:"7,optional,i64" json:"to_date,omitempty"`
+	Page     *int32  `thrift:"page,8,optional" frugal:"8,optional,i32" json:"page,omitempty"`
+	Size     *int32  `thrift:"size,9,optional" frugal:"9,optional,i32" json:"size,omitempty"`
+}
+
+func NewSearchMessagesRequest() *SearchMessagesRequest {
+	return &SearchMessagesRequest{}
+}
+
+func (p *SearchMessagesRequest) InitDefault() {
+}
+
+var SearchMessagesRequest_Keyword_DEFAULT string
+
+func (p *SearchMessagesRequest) GetKeyword() (v string) {
+	if !p.IsSetKeyword() {
+		return SearchMessagesRequest_Keyword_DEFAULT
+	}
+	return *p.Keyword
+}
+
+var SearchMessagesRequest_SenderId_DEFAULT int64
+
+func (p *SearchMessagesRequest) GetSenderId() (v int64) {
+	if !p.IsSetSenderId() {
+		return SearchMessagesRequest_SenderId_DEFAULT
+	}
+	return *p.SenderId
+}
+
+var SearchMessagesRequest_PeerId_DEFAULT int64
+
+func (p *SearchMessagesRequest) GetPeerId() (v int64) {
+	if !p.IsSetPeerId() {
+		return SearchMessagesRequest_PeerId_DEFAULT
+	}
+	return *p.PeerId
+}
+
+var SearchMessagesRequest_RoomId_DEFAULT int64
+
+func (p *SearchMessagesRequest) GetRoomId() (v int64) {
+	if !p.IsSetRoomId() {
+		return SearchMessagesRequest_RoomId_DEFAULT
+	}
+	return *p.RoomId
+}
+
+var SearchMessagesRequest_FromDate_DEFAULT int64
+
+func (p *SearchMessagesRequest) GetFromDate() (v int64) {
+	if !p.IsSetFromDate() {
+		return SearchMessagesRequest_FromDate_DEFAULT
+	}
+	return *p.FromDate
+}
+
+var SearchMessagesRequest_ToDate_DEFAULT int64
+
+func (p *SearchMessagesRequest) GetToDate() (v int64) {
+	if !p.IsSetToDate() {
+		return SearchMessagesRequest_ToDate_DEFAULT
+	}
+	return *p.ToDate
+}
+
+var SearchMessagesRequest_Page_DEFAULT int32
+
+func (p *SearchMessagesRequest) GetPage() (v int32) {
+	if !p.IsSetPage() {
+		return SearchMessagesRequest_Page_DEFAULT
+	}
+	return *p.Page
+}
+
+var SearchMessagesRequest_Size_DEFAULT int32
+
+func (p *SearchMessagesRequest) GetSize() (v int32) {
+	if !p.IsSetSize() {
+		return SearchMessagesRequest_Size_DEFAULT
+	}
+	return *p.Size
+}
+func (p *SearchMessagesRequest) SetKeyword(val *string) {
+	p.Keyword = val
+}
+func (p *SearchMessagesRequest) SetSenderId(val *int64) {
+	p.SenderId = val
+}
+func (p *SearchMessagesRequest) SetPeerId(val *int64) {
+	p.PeerId = val
+}
+func (p *SearchMessagesRequest) SetRoomId(val *int64) {
+	p.RoomId = val
+}
+func (p *SearchMessagesRequest) SetFromDate(val *int64) {
+	p.FromDate = val
+}
+func (p *SearchMessagesRequest) SetToDate(val *int64) {
+	p.ToDate = val
+}
+func (p *SearchMessagesRequest) SetPage(val *int32) {
+	p.Page = val
+}
+func (p *SearchMessagesRequest) SetSize(val *int32) {
+	p.Size = val
+}
+
+func (p *SearchMessagesRequest) IsSetKeyword() bool {
+	return p.Keyword != nil
+}
+
+func (p *SearchMessagesRequest) IsSetSenderId() bool {
+	return p.SenderId != nil
+}
+
+func (p *SearchMessagesRequest) IsSetPeerId() bool {
+	return p.PeerId != nil
+}
+
+func (p *SearchMessagesRequest) IsSetRoomId() bool {
+	return p.RoomId != nil
+}
+
+func (p *SearchMessagesRequest) IsSetFromDate() bool {
+	return p.FromDate != nil
+}
+
+func (p *SearchMessagesRequest) IsSetToDate() bool {
+	return p.ToDate != nil
+}
+
+func (p *SearchMessagesRequest) IsSetPage() bool {
+	return p.Page != nil
+}
+
+func (p *SearchMessagesRequest) IsSetSize() bool {
+	return p.Size != nil
+}
+
+func (p *SearchMessagesRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SearchMessagesRequest(%+v)", *p)
+}
+
+var fieldIDToName_SearchMessagesRequest = map[int16]string{
+	2: "keyword",
+	3: "sender_id",
+	4: "peer_id",
+	5: "room_id",
+	6: "from_date",
+	7: "to_date",
+	8: "page",
+	9: "size",
+}
+
+type SearchMessagesResponse struct {
+	Base     *model.BaseResp          `thrift:"Base,1,required" frugal:"1,required,model.BaseResp" json:"Base"`
+	Messages []*model.SearchedMessage `thrift:"Messages,2,required" frugal:"2,required,list<model.SearchedMessage>" json:"Messages"`
+	Total    int64                    `thrift:"Total,3,required" frugal:"3,required,i64" json:"Total"`
+}
+
+func NewSearchMessagesResponse() *SearchMessagesResponse {
+	return &SearchMessagesResponse{}
+}
+
+func (p *SearchMessagesResponse) InitDefault() {
+}
+
+var SearchMessagesResponse_Base_DEFAULT *model.BaseResp
+
+func (p *SearchMessagesResponse) GetBase() (v *model.BaseResp) {
+	if !p.IsSetBase() {
+		return SearchMessagesResponse_Base_DEFAULT
+	}
+	return p.Base
+}
+
+func (p *SearchMessagesResponse) GetMessages() (v []*model.SearchedMessage) {
+	return p.Messages
+}
+
+func (p *SearchMessagesResponse) GetTotal() (v int64) {
+	return p.Total
+}
+func (p *SearchMessagesResponse) SetBase(val *model.BaseResp) {
+	p.Base = val
+}
+func (p *SearchMessagesResponse) SetMessages(val []*model.SearchedMessage) {
+	p.Messages = val
+}
+func (p *SearchMessagesResponse) SetTotal(val int64) {
+	p.Total = val
+}
+
+func (p *SearchMessagesResponse) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *SearchMessagesResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SearchMessagesResponse(%+v)", *p)
+}
+
+var fieldIDToName_SearchMessagesResponse = map[int16]string{
+	1: "Base",
+	2: "Messages",
+	3: "Total",
+}
+
+type ExportChatHistoryRequest struct {
+	PeerId *int64 `thrift:"peer_id,2,optional" frugal:"2,optional,i64" json:"peer_id,omitempty"`
+	RoomId *int64 `thrift:"room_id,3,optional" frugal:"3,optional,i64" json:"room_id,omitempty"`
+}
+
+func NewExportChatHistoryRequest() *ExportChatHistoryRequest {
+	return &ExportChatHistoryRequest{}
+}
+
+func (p *ExportChatHistoryRequest) InitDefault() {
+}
+
+var ExportChatHistoryRequest_PeerId_DEFAULT int64
+
+func (p *ExportChatHistoryRequest) GetPeerId() (v int64) {
+	if !p.IsSetPeerId() {
+		return ExportChatHistoryRequest_PeerId_DEFAULT
+	}
+	return *p.PeerId
+}
+
+var ExportChatHistoryRequest_RoomId_DEFAULT int64
+
+func (p *ExportChatHistoryRequest) GetRoomId() (v int64) {
+	if !p.IsSetRoomId() {
+		return ExportChatHistoryRequest_RoomId_DEFAULT
+	}
+	return *p.RoomId
+}
+func (p *ExportChatHistoryRequest) SetPeerId(val *int64) {
+	p.PeerId = val
+}
+func (p *ExportChatHistoryRequest) SetRoomId(val *int64) {
+	p.RoomId = val
+}
+
+func (p *ExportChatHistoryRequest) IsSetPeerId() bool {
+	return p.PeerId != nil
+}
+
+func (p *ExportChatHistoryRequest) IsSetRoomId() bool {
+	return p.RoomId != nil
+}
+
+func (p *ExportChatHistoryRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ExportChatHistoryRequest(%+v)", *p)
+}
+
+var fieldIDToName_ExportChatHistoryRequest = map[int16]string{
+	2: "peer_id",
+	3: "room_id",
+}
+
+type ExportChatHistoryResponse struct {
+	Base         *model.BaseResp `thrift:"Base,1,required" frugal:"1,required,model.BaseResp" json:"Base"`
+	Url          string          `thrift:"url,2,required" frugal:"2,required,string" json:"url"`
+	MessageCount int64           `thrift:"message_count,3,required" frugal:"3,required,i64" json:"message_count"`
+}
+
+func NewExportChatHistoryResponse() *ExportChatHistoryResponse {
+	return &ExportChatHistoryResponse{}
+}
+
+func (p *ExportChatHistoryResponse) InitDefault() {
+}
+
+var ExportChatHistoryResponse_Base_DEFAULT *model.BaseResp
+
+func (p *ExportChatHistoryResponse) GetBase() (v *model.BaseResp) {
+	if !p.IsSetBase() {
+		return ExportChatHistoryResponse_Base_DEFAULT
+	}
+	return p.Base
+}
+
+func (p *ExportChatHistoryResponse) GetUrl() (v string) {
+	return p.Url
+}
+
+func (p *ExportChatHistoryResponse) GetMessageCount() (v int64) {
+	return p.MessageCount
+}
+func (p *ExportChatHistoryResponse) SetBase(val *model.BaseResp) {
+	p.Base = val
+}
+func (p *ExportChatHistoryResponse) SetUrl(val string) {
+	p.Url = val
+}
+func (p *ExportChatHistoryResponse) SetMessageCount(val int64) {
+	p.MessageCount = val
+}
+
+func (p *ExportChatHistoryResponse) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *ExportChatHistoryResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ExportChatHistoryResponse(%+v)", *p)
+}
+
+var fieldIDToName_ExportChatHistoryResponse = map[int16]string{
+	1: "Base",
+	2: "url",
+	3: "message_count",
+}
+
+type SocialService interface {
+	SendPrivateMessage(ctx context.Context, req *SendPrivateMessageRequest) (r *SendPrivateMessageResponse, err error)
+
+	GetPrivateMessages(ctx context.Context, req *GetPrivateMessagesRequest) (r *GetPrivateMessagesResponse, err error)
+
+	EditPrivateMessage(ctx context.Context, req *EditPrivateMessageRequest) (r *EditPrivateMessageResponse, err error)
+
+	RecallPrivateMessage(ctx context.Context, req *RecallPrivateMessageRequest) (r *RecallPrivateMessageResponse, err error)
+
+	CreateChatRoom(ctx context.Context, req *CreateChatRoomRequest) (r *CreateChatRoomResponse, err error)
+
+	GetChatRoom(ctx context.Context, req *GetChatRoomRequest) (r *GetChatRoomResponse, err error)
+
+	GetUserChatRooms(ctx context.Context, req *GetUserChatRoomsRequest) (r *GetUserChatRoomsResponse, err error)
+
+	SendChatMessage(ctx context.Context, req *SendChatMessageRequest) (r *SendChatMessageResponse, err error)
+
+	GetChatMessages(ctx context.Context, req *GetChatMessagesRequest) (r *GetChatMessagesResponse, err error)
+
+	UploadChatAttachment(ctx context.Context, req *UploadChatAttachmentRequest) (r *UploadChatAttachmentResponse, err error)
+
+	EditChatMessage(ctx context.Context, req *EditChatMessageRequest) (r *EditChatMessageResponse, err error)
+
+	RecallChatMessage(ctx context.Context, req *RecallChatMessageRequest) (r *RecallChatMessageResponse, err error)
+
+	AddChatRoomMembers(ctx context.Context, req *AddChatRoomMembersRequest) (r *AddChatRoomMembersResponse, err error)
+
+	RemoveChatRoomMember(ctx context.Context, req *RemoveChatRoomMemberRequest) (r *RemoveChatRoomMemberResponse, err error)
+
+	LeaveChatRoom(ctx context.Context, req *LeaveChatRoomRequest) (r *LeaveChatRoomResponse, err error)
+
+	CheckChatRoomMember(ctx context.Context, req *CheckChatRoomMemberRequest) (r *CheckChatRoomMemberResponse, err error)
+
+	CheckPrivateChat(ctx context.Context, req *CheckPrivateChatRequest) (r *CheckPrivateChatResponse, err error)
+
+	SetChatRoomAdmin(ctx context.Context, req *SetChatRoomAdminRequest) (r *SetChatRoomAdminResponse, err error)
+
+	TransferChatRoom(ctx context.Context, req *TransferChatRoomRequest) (r *TransferChatRoomResponse, err error)
+
+	MuteChatRoomMember(ctx context.Context, req *MuteChatRoomMemberRequest) (r *MuteChatRoomMemberResponse, err error)
+
+	RenameChatRoom(ctx context.Context, req *RenameChatRoomRequest) (r *RenameChatRoomResponse, err error)
+
+	DisbandChatRoom(ctx context.Context, req *DisbandChatRoomRequest) (r *DisbandChatRoomResponse, err error)
+
+	CreateChatRoomInvite(ctx context.Context, req *CreateChatRoomInviteRequest) (r *CreateChatRoomInviteResponse, err error)
+
+	JoinChatRoomByInvite(ctx context.Context, req *JoinChatRoomByInviteRequest) (r *JoinChatRoomByInviteResponse, err error)
+
+	AddFriend(ctx context.Context, req *AddFriendRequest) (r *AddFriendResponse, err error)
+
+	GetFriendship(ctx context.Context, req *GetFriendshipRequest) (r *GetFriendshipResponse, err error)
+
+	GetUserFriends(ctx context.Context, req *GetUserFriendsRequest) (r *GetUserFriendsResponse, err error)
+
+	CreateFriendRequest(ctx context.Context, req *CreateFriendRequestRequest) (r *CreateFriendRequestResponse, err error)
+
+	GetFriendRequests(ctx context.Context, req *GetFriendRequestsRequest) (r *GetFriendRequestsResponse, err error)
+
+	HandleFriendRequest(ctx context.Context, req *HandleFriendRequestRequest) (r *HandleFriendRequestResponse, err error)
+
+	GetFriendRecommendations(ctx context.Context, req *GetFriendRecommendationsRequest) (r *GetFriendRecommendationsResponse, err error)
+
+	MarkMessageRead(ctx context.Context, req *MarkMessageReadRequest) (r *MarkMessageReadResponse, err error)
+
+	GetUnreadMessageCount(ctx context.Context, req *GetUnreadMessageCountRequest) (r *GetUnreadMessageCountResponse, err error)
+
+	MarkConversationsRead(ctx context.Context, req *MarkConversationsReadRequest) (r *MarkConversationsReadResponse, err error)
+
+	GetUnreadCounts(ctx context.Context, req *GetUnreadCountsRequest) (r *GetUnreadCountsResponse, err error)
+
+	GetInbox(ctx context.Context, req *GetInboxRequest) (r *GetInboxResponse, err error)
+
+	UpdateConversation(ctx context.Context, req *UpdateConversationRequest) (r *UpdateConversationResponse, err error)
+
+	SyncMessages(ctx context.Context, req *SyncMessagesRequest) (r *SyncMessagesResponse, err error)
+
+	GetNotifications(ctx context.Context, req *GetNotificationsRequest) (r *GetNotificationsResponse, err error)
+
+	GetNotificationUnreadCount(ctx context.Context, req *GetNotificationUnreadCountRequest) (r *GetNotificationUnreadCountResponse, err error)
+
+	MarkNotificationsRead(ctx context.Context, req *MarkNotificationsReadRequest) (r *MarkNotificationsReadResponse, err error)
+
+	GetNotificationPreferences(ctx context.Context, req *GetNotificationPreferencesRequest) (r *GetNotificationPreferencesResponse, err error)
+
+	UpdateNotificationPreferences(ctx context.Context, req *UpdateNotificationPreferencesRequest) (r *UpdateNotificationPreferencesResponse, err error)
+
+	UpdatePresence(ctx context.Context, req *UpdatePresenceRequest) (r *UpdatePresenceResponse, err error)
+
+	GetPresence(ctx context.Context, req *GetPresenceRequest) (r *GetPresenceResponse, err error)
+
+	SearchMessages(ctx context.Context, req *SearchMessagesRequest) (r *SearchMessagesResponse, err error)
+
+	ExportChatHistory(ctx context.Context, req *ExportChatHistoryRequest) (r *ExportChatHistoryResponse, err error)
+}
+
+type SocialServiceSendPrivateMessageArgs struct {
+	Req *SendPrivateMessageRequest `thrift:"req,1" frugal:"1,default,SendPrivateMessageRequest" json:"req"`
+}
+
+func NewSocialServiceSendPrivateMessageArgs() *SocialServiceSendPrivateMessageArgs {
+	return &SocialServiceSendPrivateMessageArgs{}
+}
+
+func (p *SocialServiceSendPrivateMessageArgs) InitDefault() {
+}
+
+var SocialServiceSendPrivateMessageArgs_Req_DEFAULT *SendPrivateMessageRequest
+
+func (p *SocialServiceSendPrivateMessageArgs) GetReq() (v *SendPrivateMessageRequest) {
+	if !p.IsSetReq() {
+		return SocialServiceSendPrivateMessageArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *SocialServiceSendPrivateMessageArgs) SetReq(val *SendPrivateMessageRequest) {
+	p.Req = val
+}
+
+func (p *SocialServiceSendPrivateMessageArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *SocialServiceSendPrivateMessageArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SocialServiceSendPrivateMessageArgs(%+v)", *p)
+}
+
+var fieldIDToName_SocialServiceSendPrivateMessageArgs = map[int16]string{
+	1: "req",
+}
+
+type SocialServiceSendPrivateMessageResult struct {
+	Success *SendPrivateMessageResponse `thrift:"success,0,optional" frugal:"0,optional,SendPrivateMessageResponse" json:"success,omitempty"`
+}
+
+func NewSocialServiceSendPrivateMessageResult() *SocialServiceSendPrivateMessageResult {
+	return &SocialServiceSendPrivateMessageResult{}
+}
+
+func (p *SocialServiceSendPrivateMessageResult) InitDefault() {
+}
+
+var SocialServiceSendPrivateMessageResult_Success_DEFAULT *SendPrivateMessageResponse
+
+func (p *SocialServiceSendPrivateMessageResult) GetSuccess() (v *SendPrivateMessageResponse) {
+	if !p.IsSetSuccess() {
+		return SocialServiceSendPrivateMessageResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *SocialServiceSendPrivateMessageResult) SetSuccess(x interface{}) {
+	p.Success = x.(*SendPrivateMessageResponse)
+}
+
+func (p *SocialServiceSendPrivateMessageResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *SocialServiceSendPrivateMessageResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SocialServiceSendPrivateMessageResult(%+v)", *p)
+}
+
+var fieldIDToName_SocialServiceSendPrivateMessageResult = map[int16]string{
+	0: "success",
+}
+
+type SocialServiceGetPrivateMessagesArgs struct {
+	Req *GetPrivateMessagesRequest `thrift:"req,1" frugal:"1,default,GetPrivateMessagesRequest" json:"req"`
+}
+
+func NewSocialServiceGetPrivateMessagesArgs() *SocialServiceGetPrivateMessagesArgs {
+	return &SocialServiceGetPrivateMessagesArgs{}
+}
+
+func (p *SocialServiceGetPrivateMessagesArgs) InitDefault() {
+}
+
+var SocialServiceGetPrivateMessagesArgs_Req_DEFAULT *GetPrivateMessagesRequest
+
+func (p *SocialServiceGetPrivateMessagesArgs) GetReq() (v *GetPrivateMessagesRequest) {
+	if !p.IsSetReq() {
+		return SocialServiceGetPrivateMessagesArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *SocialServiceGetPrivateMessagesArgs) SetReq(val *GetPrivateMessagesRequest) {
+	p.Req = val
 }
 
 func (p *SocialServiceGetPrivateMessagesArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *SocialServiceGetPrivateMessagesArgs) String() string {
+func (p *SocialServiceGetPrivateMessagesArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SocialServiceGetPrivateMessagesArgs(%+v)", *p)
+}
+
+var fieldIDToName_SocialServiceGetPrivateMessagesArgs = map[int16]string{
+	1: "req",
+}
+
+type SocialServiceGetPrivateMessagesResult struct {
+	Success *GetPrivateMessagesResponse `thrift:"success,0,optional" frugal:"0,optional,GetPrivateMessagesResponse" json:"success,omitempty"`
+}
+
+func NewSocialServiceGetPrivateMessagesResult() *SocialServiceGetPrivateMessagesResult {
+	return &SocialServiceGetPrivateMessagesResult{}
+}
+
+func (p *SocialServiceGetPrivateMessagesResult) InitDefault() {
+}
+
+var SocialServiceGetPrivateMessagesResult_Success_DEFAULT *GetPrivateMessagesResponse
+
+func (p *SocialServiceGetPrivateMessagesResult) GetSuccess() (v *GetPrivateMessagesResponse) {
+	if !p.IsSetSuccess() {
+		return SocialServiceGetPrivateMessagesResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *SocialServiceGetPrivateMessagesResult) SetSuccess(x interface{}) {
+	p.Success = x.(*GetPrivateMessagesResponse)
+}
+
+func (p *SocialServiceGetPrivateMessagesResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *SocialServiceGetPrivateMessagesResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SocialServiceGetPrivateMessagesResult(%+v)", *p)
+}
+
+var fieldIDToName_SocialServiceGetPrivateMessagesResult = map[int16]string{
+	0: "success",
+}
+
+type SocialServiceEditPrivateMessageArgs struct {
+	Req *EditPrivateMessageRequest `thrift:"req,1" frugal:"1,default,EditPrivateMessageRequest" json:"req"`
+}
+
+func NewSocialServiceEditPrivateMessageArgs() *SocialServiceEditPrivateMessageArgs {
+	return &SocialServiceEditPrivateMessageArgs{}
+}
+
+func (p *SocialServiceEditPrivateMessageArgs) InitDefault() {
+}
+
+var SocialServiceEditPrivateMessageArgs_Req_DEFAULT *EditPrivateMessageRequest
+
+func (p *SocialServiceEditPrivateMessageArgs) GetReq() (v *EditPrivateMessageRequest) {
+	if !p.IsSetReq() {
+		return SocialServiceEditPrivateMessageArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *SocialServiceEditPrivateMessageArgs) SetReq(val *EditPrivateMessageRequest) {
+	p.Req = val
+}
+
+func (p *SocialServiceEditPrivateMessageArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *SocialServiceEditPrivateMessageArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SocialServiceEditPrivateMessageArgs(%+v)", *p)
+}
+
+var fieldIDToName_SocialServiceEditPrivateMessageArgs = map[int16]string{
+	1: "req",
+}
+
+type SocialServiceEditPrivateMessageResult struct {
+	Success *EditPrivateMessageResponse `thrift:"success,0,optional" frugal:"0,optional,EditPrivateMessageResponse" json:"success,omitempty"`
+}
+
+func NewSocialServiceEditPrivateMessageResult() *SocialServiceEditPrivateMessageResult {
+	return &SocialServiceEditPrivateMessageResult{}
+}
+
+func (p *SocialServiceEditPrivateMessageResult) InitDefault() {
+}
+
+var SocialServiceEditPrivateMessageResult_Success_DEFAULT *EditPrivateMessageResponse
+
+func (p *SocialServiceEditPrivateMessageResult) GetSuccess() (v *EditPrivateMessageResponse) {
+	if !p.IsSetSuccess() {
+		return SocialServiceEditPrivateMessageResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *SocialServiceEditPrivateMessageResult) SetSuccess(x interface{}) {
+	p.Success = x.(*EditPrivateMessageResponse)
+}
+
+func (p *SocialServiceEditPrivateMessageResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *SocialServiceEditPrivateMessageResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SocialServiceEditPrivateMessageResult(%+v)", *p)
+}
+
+var fieldIDToName_SocialServiceEditPrivateMessageResult = map[int16]string{
+	0: "success",
+}
+
+type SocialServiceRecallPrivateMessageArgs struct {
+	Req *RecallPrivateMessageRequest `thrift:"req,1" frugal:"1,default,RecallPrivateMessageRequest" json:"req"`
+}
+
+func NewSocialServiceRecallPrivateMessageArgs() *SocialServiceRecallPrivateMessageArgs {
+	return &SocialServiceRecallPrivateMessageArgs{}
+}
+
+func (p *SocialServiceRecallPrivateMessageArgs) InitDefault() {
+}
+
+var SocialServiceRecallPrivateMessageArgs_Req_DEFAULT *RecallPrivateMessageRequest
+
+func (p *SocialServiceRecallPrivateMessageArgs) GetReq() (v *RecallPrivateMessageRequest) {
+	if !p.IsSetReq() {
+		return SocialServiceRecallPrivateMessageArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *SocialServiceRecallPrivateMessageArgs) SetReq(val *RecallPrivateMessageRequest) {
+	p.Req = val
+}
+
+func (p *SocialServiceRecallPrivateMessageArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *SocialServiceRecallPrivateMessageArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SocialServiceRecallPrivateMessageArgs(%+v)", *p)
+}
+
+var fieldIDToName_SocialServiceRecallPrivateMessageArgs = map[int16]string{
+	1: "req",
+}
+
+type SocialServiceRecallPrivateMessageResult struct {
+	Success *RecallPrivateMessageResponse `thrift:"success,0,optional" frugal:"0,optional,RecallPrivateMessageResponse" json:"success,omitempty"`
+}
+
+func NewSocialServiceRecallPrivateMessageResult() *SocialServiceRecallPrivateMessageResult {
+	return &SocialServiceRecallPrivateMessageResult{}
+}
+
+func (p *SocialServiceRecallPrivateMessageResult) InitDefault() {
+}
+
+var SocialServiceRecallPrivateMessageResult_Success_DEFAULT *RecallPrivateMessageResponse
+
+func (p *SocialServiceRecallPrivateMessageResult) GetSuccess() (v *RecallPrivateMessageResponse) {
+	if !p.IsSetSuccess() {
+		return SocialServiceRecallPrivateMessageResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *SocialServiceRecallPrivateMessageResult) SetSuccess(x interface{}) {
+	p.Success = x.(*RecallPrivateMessageResponse)
+}
+
+func (p *SocialServiceRecallPrivateMessageResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *SocialServiceRecallPrivateMessageResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SocialServiceRecallPrivateMessageResult(%+v)", *p)
+}
+
+var fieldIDToName_SocialServiceRecallPrivateMessageResult = map[int16]string{
+	0: "success",
+}
+
+type SocialServiceCreateChatRoomArgs struct {
+	Req *CreateChatRoomRequest `thrift:"req,1" frugal:"1,default,CreateChatRoomRequest" json:"req"`
+}
+
+func NewSocialServiceCreateChatRoomArgs() *SocialServiceCreateChatRoomArgs {
+	return &SocialServiceCreateChatRoomArgs{}
+}
+
+func (p *SocialServiceCreateChatRoomArgs) InitDefault() {
+}
+
+var SocialServiceCreateChatRoomArgs_Req_DEFAULT *CreateChatRoomRequest
+
+func (p *SocialServiceCreateChatRoomArgs) GetReq() (v *CreateChatRoomRequest) {
+	if !p.IsSetReq() {
+		return SocialServiceCreateChatRoomArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *SocialServiceCreateChatRoomArgs) SetReq(val *CreateChatRoomRequest) {
+	p.Req = val
+}
+
+func (p *SocialServiceCreateChatRoomArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *SocialServiceCreateChatRoomArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SocialServiceCreateChatRoomArgs(%+v)", *p)
+}
+
+var fieldIDToName_SocialServiceCreateChatRoomArgs = map[int16]string{
+	1: "req",
+}
+
+type SocialServiceCreateChatRoomResult struct {
+	Success *CreateChatRoomResponse `thrift:"success,0,optional" frugal:"0,optional,CreateChatRoomResponse" json:"success,omitempty"`
+}
+
+func NewSocialServiceCreateChatRoomResult() *SocialServiceCreateChatRoomResult {
+	return &SocialServiceCreateChatRoomResult{}
+}
+
+func (p *SocialServiceCreateChatRoomResult) InitDefault() {
+}
+
+var SocialServiceCreateChatRoomResult_Success_DEFAULT *CreateChatRoomResponse
+
+func (p *SocialServiceCreateChatRoomResult) GetSuccess() (v *CreateChatRoomResponse) {
+	if !p.IsSetSuccess() {
+		return SocialServiceCreateChatRoomResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *SocialServiceCreateChatRoomResult) SetSuccess(x interface{}) {
+	p.Success = x.(*CreateChatRoomResponse)
+}
+
+func (p *SocialServiceCreateChatRoomResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *SocialServiceCreateChatRoomResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SocialServiceCreateChatRoomResult(%+v)", *p)
+}
+
+var fieldIDToName_SocialServiceCreateChatRoomResult = map[int16]string{
+	0: "success",
+}
+
+type SocialServiceGetChatRoomArgs struct {
+	Req *GetChatRoomRequest `thrift:"req,1" frugal:"1,default,GetChatRoomRequest" json:"req"`
+}
+
+func NewSocialServiceGetChatRoomArgs() *SocialServiceGetChatRoomArgs {
+	return &SocialServiceGetChatRoomArgs{}
+}
+
+func (p *SocialServiceGetChatRoomArgs) InitDefault() {
+}
+
+var SocialServiceGetChatRoomArgs_Req_DEFAULT *GetChatRoomRequest
+
+func (p *SocialServiceGetChatRoomArgs) GetReq() (v *GetChatRoomRequest) {
+	if !p.IsSetReq() {
+		return SocialServiceGetChatRoomArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *SocialServiceGetChatRoomArgs) SetReq(val *GetChatRoomRequest) {
+	p.Req = val
+}
+
+func (p *SocialServiceGetChatRoomArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *SocialServiceGetChatRoomArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SocialServiceGetChatRoomArgs(%+v)", *p)
+}
+
+var fieldIDToName_SocialServiceGetChatRoomArgs = map[int16]string{
+	1: "req",
+}
+
+type SocialServiceGetChatRoomResult struct {
+	Success *GetChatRoomResponse `thrift:"success,0,optional" frugal:"0,optional,GetChatRoomResponse" json:"success,omitempty"`
+}
+
+func NewSocialServiceGetChatRoomResult() *SocialServiceGetChatRoomResult {
+	return &SocialServiceGetChatRoomResult{}
+}
+
+func (p *SocialServiceGetChatRoomResult) InitDefault() {
+}
+
+var SocialServiceGetChatRoomResult_Success_DEFAULT *GetChatRoomResponse
+
+func (p *SocialServiceGetChatRoomResult) GetSuccess() (v *GetChatRoomResponse) {
+	if !p.IsSetSuccess() {
+		return SocialServiceGetChatRoomResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *SocialServiceGetChatRoomResult) SetSuccess(x interface{}) {
+	p.Success = x.(*GetChatRoomResponse)
+}
+
+func (p *SocialServiceGetChatRoomResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *SocialServiceGetChatRoomResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SocialServiceGetChatRoomResult(%+v)", *p)
+}
+
+var fieldIDToName_SocialServiceGetChatRoomResult = map[int16]string{
+	0: "success",
+}
+
+type SocialServiceGetUserChatRoomsArgs struct {
+	Req *GetUserChatRoomsRequest `thrift:"req,1" frugal:"1,default,GetUserChatRoomsRequest" json:"req"`
+}
+
+func NewSocialServiceGetUserChatRoomsArgs() *SocialServiceGetUserChatRoomsArgs {
+	return &SocialServiceGetUserChatRoomsArgs{}
+}
+
+func (p *SocialServiceGetUserChatRoomsArgs) InitDefault() {
+}
+
+var SocialServiceGetUserChatRoomsArgs_Req_DEFAULT *GetUserChatRoomsRequest
+
+func (p *SocialServiceGetUserChatRoomsArgs) GetReq() (v *GetUserChatRoomsRequest) {
+	if !p.IsSetReq() {
+		return SocialServiceGetUserChatRoomsArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *SocialServiceGetUserChatRoomsArgs) SetReq(val *GetUserChatRoomsRequest) {
+	p.Req = val
+}
+
+func (p *SocialServiceGetUserChatRoomsArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *SocialServiceGetUserChatRoomsArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SocialServiceGetUserChatRoomsArgs(%+v)", *p)
+}
+
+var fieldIDToName_SocialServiceGetUserChatRoomsArgs = map[int16]string{
+	1: "req",
+}
+
+type SocialServiceGetUserChatRoomsResult struct {
+	Success *GetUserChatRoomsResponse `thrift:"success,0,optional" frugal:"0,optional,GetUserChatRoomsResponse" json:"success,omitempty"`
+}
+
+func NewSocialServiceGetUserChatRoomsResult() *SocialServiceGetUserChatRoomsResult {
+	return &SocialServiceGetUserChatRoomsResult{}
+}
+
+func (p *SocialServiceGetUserChatRoomsResult) InitDefault() {
+}
+
+var SocialServiceGetUserChatRoomsResult_Success_DEFAULT *GetUserChatRoomsResponse
+
+func (p *SocialServiceGetUserChatRoomsResult) GetSuccess() (v *GetUserChatRoomsResponse) {
+	if !p.IsSetSuccess() {
+		return SocialServiceGetUserChatRoomsResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *SocialServiceGetUserChatRoomsResult) SetSuccess(x interface{}) {
+	p.Success = x.(*GetUserChatRoomsResponse)
+}
+
+func (p *SocialServiceGetUserChatRoomsResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *SocialServiceGetUserChatRoomsResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SocialServiceGetUserChatRoomsResult(%+v)", *p)
+}
+
+var fieldIDToName_SocialServiceGetUserChatRoomsResult = map[int16]string{
+	0: "success",
+}
+
+type SocialServiceSendChatMessageArgs struct {
+	Req *SendChatMessageRequest `thrift:"req,1" frugal:"1,default,SendChatMessageRequest" json:"req"`
+}
+
+func NewSocialServiceSendChatMessageArgs() *SocialServiceSendChatMessageArgs {
+	return &SocialServiceSendChatMessageArgs{}
+}
+
+func (p *SocialServiceSendChatMessageArgs) InitDefault() {
+}
+
+var SocialServiceSendChatMessageArgs_Req_DEFAULT *SendChatMessageRequest
+
+func (p *SocialServiceSendChatMessageArgs) GetReq() (v *SendChatMessageRequest) {
+	if !p.IsSetReq() {
+		return SocialServiceSendChatMessageArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *SocialServiceSendChatMessageArgs) SetReq(val *SendChatMessageRequest) {
+	p.Req = val
+}
+
+func (p *SocialServiceSendChatMessageArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *SocialServiceSendChatMessageArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SocialServiceSendChatMessageArgs(%+v)", *p)
+}
+
+var fieldIDToName_SocialServiceSendChatMessageArgs = map[int16]string{
+	1: "req",
+}
+
+type SocialServiceSendChatMessageResult struct {
+	Success *SendChatMessageResponse `thrift:"success,0,optional" frugal:"0,optional,SendChatMessageResponse" json:"success,omitempty"`
+}
+
+func NewSocialServiceSendChatMessageResult() *SocialServiceSendChatMessageResult {
+	return &SocialServiceSendChatMessageResult{}
+}
+
+func (p *SocialServiceSendChatMessageResult) InitDefault() {
+}
+
+var SocialServiceSendChatMessageResult_Success_DEFAULT *SendChatMessageResponse
+
+func (p *SocialServiceSendChatMessageResult) GetSuccess() (v *SendChatMessageResponse) {
+	if !p.IsSetSuccess() {
+		return SocialServiceSendChatMessageResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *SocialServiceSendChatMessageResult) SetSuccess(x interface{}) {
+	p.Success = x.(*SendChatMessageResponse)
+}
+
+func (p *SocialServiceSendChatMessageResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *SocialServiceSendChatMessageResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SocialServiceSendChatMessageResult(%+v)", *p)
+}
+
+var fieldIDToName_SocialServiceSendChatMessageResult = map[int16]string{
+	0: "success",
+}
+
+type SocialServiceGetChatMessagesArgs struct {
+	Req *GetChatMessagesRequest `thrift:"req,1" frugal:"1,default,GetChatMessagesRequest" json:"req"`
+}
+
+func NewSocialServiceGetChatMessagesArgs() *SocialServiceGetChatMessagesArgs {
+	return &SocialServiceGetChatMessagesArgs{}
+}
+
+func (p *SocialServiceGetChatMessagesArgs) InitDefault() {
+}
+
+var SocialServiceGetChatMessagesArgs_Req_DEFAULT *GetChatMessagesRequest
+
+func (p *SocialServiceGetChatMessagesArgs) GetReq() (v *GetChatMessagesRequest) {
+	if !p.IsSetReq() {
+		return SocialServiceGetChatMessagesArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *SocialServiceGetChatMessagesArgs) SetReq(val *GetChatMessagesRequest) {
+	p.Req = val
+}
+
+func (p *SocialServiceGetChatMessagesArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *SocialServiceGetChatMessagesArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SocialServiceGetChatMessagesArgs(%+v)", *p)
+}
+
+var fieldIDToName_SocialServiceGetChatMessagesArgs = map[int16]string{
+	1: "req",
+}
+
+type SocialServiceGetChatMessagesResult struct {
+	Success *GetChatMessagesResponse `thrift:"success,0,optional" frugal:"0,optional,GetChatMessagesResponse" json:"success,omitempty"`
+}
+
+func NewSocialServiceGetChatMessagesResult() *SocialServiceGetChatMessagesResult {
+	return &SocialServiceGetChatMessagesResult{}
+}
+
+func (p *SocialServiceGetChatMessagesResult) InitDefault() {
+}
+
+var SocialServiceGetChatMessagesResult_Success_DEFAULT *GetChatMessagesResponse
+
+func (p *SocialServiceGetChatMessagesResult) GetSuccess() (v *GetChatMessagesResponse) {
+	if !p.IsSetSuccess() {
+		return SocialServiceGetChatMessagesResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *SocialServiceGetChatMessagesResult) SetSuccess(x interface{}) {
+	p.Success = x.(*GetChatMessagesResponse)
+}
+
+func (p *SocialServiceGetChatMessagesResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *SocialServiceGetChatMessagesResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SocialServiceGetChatMessagesResult(%+v)", *p)
+}
+
+var fieldIDToName_SocialServiceGetChatMessagesResult = map[int16]string{
+	0: "success",
+}
+
+type SocialServiceUploadChatAttachmentArgs struct {
+	Req *UploadChatAttachmentRequest `thrift:"req,1" frugal:"1,default,UploadChatAttachmentRequest" json:"req"`
+}
+
+func NewSocialServiceUploadChatAttachmentArgs() *SocialServiceUploadChatAttachmentArgs {
+	return &SocialServiceUploadChatAttachmentArgs{}
+}
+
+func (p *SocialServiceUploadChatAttachmentArgs) InitDefault() {
+}
+
+var SocialServiceUploadChatAttachmentArgs_Req_DEFAULT *UploadChatAttachmentRequest
+
+func (p *SocialServiceUploadChatAttachmentArgs) GetReq() (v *UploadChatAttachmentRequest) {
+	if !p.IsSetReq() {
+		return SocialServiceUploadChatAttachmentArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *SocialServiceUploadChatAttachmentArgs) SetReq(val *UploadChatAttachmentRequest) {
+	p.Req = val
+}
+
+func (p *SocialServiceUploadChatAttachmentArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *SocialServiceUploadChatAttachmentArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SocialServiceUploadChatAttachmentArgs(%+v)", *p)
+}
+
+var fieldIDToName_SocialServiceUploadChatAttachmentArgs = map[int16]string{
+	1: "req",
+}
+
+type SocialServiceUploadChatAttachmentResult struct {
+	Success *UploadChatAttachmentResponse `thrift:"success,0,optional" frugal:"0,optional,UploadChatAttachmentResponse" json:"success,omitempty"`
+}
+
+func NewSocialServiceUploadChatAttachmentResult() *SocialServiceUploadChatAttachmentResult {
+	return &SocialServiceUploadChatAttachmentResult{}
+}
+
+func (p *SocialServiceUploadChatAttachmentResult) InitDefault() {
+}
+
+var SocialServiceUploadChatAttachmentResult_Success_DEFAULT *UploadChatAttachmentResponse
+
+func (p *SocialServiceUploadChatAttachmentResult) GetSuccess() (v *UploadChatAttachmentResponse) {
+	if !p.IsSetSuccess() {
+		return SocialServiceUploadChatAttachmentResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *SocialServiceUploadChatAttachmentResult) SetSuccess(x interface{}) {
+	p.Success = x.(*UploadChatAttachmentResponse)
+}
+
+func (p *SocialServiceUploadChatAttachmentResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *SocialServiceUploadChatAttachmentResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SocialServiceUploadChatAttachmentResult(%+v)", *p)
+}
+
+var fieldIDToName_SocialServiceUploadChatAttachmentResult = map[int16]string{
+	0: "success",
+}
+
+type SocialServiceEditChatMessageArgs struct {
+	Req *EditChatMessageRequest `thrift:"req,1" frugal:"1,default,EditChatMessageRequest" json:"req"`
+}
+
+func NewSocialServiceEditChatMessageArgs() *SocialServiceEditChatMessageArgs {
+	return &SocialServiceEditChatMessageArgs{}
+}
+
+func (p *SocialServiceEditChatMessageArgs) InitDefault() {
+}
+
+var SocialServiceEditChatMessageArgs_Req_DEFAULT *EditChatMessageRequest
+
+func (p *SocialServiceEditChatMessageArgs) GetReq() (v *EditChatMessageRequest) {
+	if !p.IsSetReq() {
+		return SocialServiceEditChatMessageArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *SocialServiceEditChatMessageArgs) SetReq(val *EditChatMessageRequest) {
+	p.Req = val
+}
+
+func (p *SocialServiceEditChatMessageArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *SocialServiceEditChatMessageArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SocialServiceEditChatMessageArgs(%+v)", *p)
+}
+
+var fieldIDToName_SocialServiceEditChatMessageArgs = map[int16]string{
+	1: "req",
+}
+
+type SocialServiceEditChatMessageResult struct {
+	Success *EditChatMessageResponse `thrift:"success,0,optional" frugal:"0,optional,EditChatMessageResponse" json:"success,omitempty"`
+}
+
+func NewSocialServiceEditChatMessageResult() *SocialServiceEditChatMessageResult {
+	return &SocialServiceEditChatMessageResult{}
+}
+
+func (p *SocialServiceEditChatMessageResult) InitDefault() {
+}
+
+var SocialServiceEditChatMessageResult_Success_DEFAULT *EditChatMessageResponse
+
+func (p *SocialServiceEditChatMessageResult) GetSuccess() (v *EditChatMessageResponse) {
+	if !p.IsSetSuccess() {
+		return SocialServiceEditChatMessageResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *SocialServiceEditChatMessageResult) SetSuccess(x interface{}) {
+	p.Success = x.(*EditChatMessageResponse)
+}
+
+func (p *SocialServiceEditChatMessageResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *SocialServiceEditChatMessageResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SocialServiceEditChatMessageResult(%+v)", *p)
+}
+
+var fieldIDToName_SocialServiceEditChatMessageResult = map[int16]string{
+	0: "success",
+}
+
+type SocialServiceRecallChatMessageArgs struct {
+	Req *RecallChatMessageRequest `thrift:"req,1" frugal:"1,default,RecallChatMessageRequest" json:"req"`
+}
+
+func NewSocialServiceRecallChatMessageArgs() *SocialServiceRecallChatMessageArgs {
+	return &SocialServiceRecallChatMessageArgs{}
+}
+
+func (p *SocialServiceRecallChatMessageArgs) InitDefault() {
+}
+
+var SocialServiceRecallChatMessageArgs_Req_DEFAULT *RecallChatMessageRequest
+
+func (p *SocialServiceRecallChatMessageArgs) GetReq() (v *RecallChatMessageRequest) {
+	if !p.IsSetReq() {
+		return SocialServiceRecallChatMessageArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *SocialServiceRecallChatMessageArgs) SetReq(val *RecallChatMessageRequest) {
+	p.Req = val
+}
+
+func (p *SocialServiceRecallChatMessageArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *SocialServiceRecallChatMessageArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SocialServiceRecallChatMessageArgs(%+v)", *p)
+}
+
+var fieldIDToName_SocialServiceRecallChatMessageArgs = map[int16]string{
+	1: "req",
+}
+
+type SocialServiceRecallChatMessageResult struct {
+	Success *RecallChatMessageResponse `thrift:"success,0,optional" frugal:"0,optional,RecallChatMessageResponse" json:"success,omitempty"`
+}
+
+func NewSocialServiceRecallChatMessageResult() *SocialServiceRecallChatMessageResult {
+	return &SocialServiceRecallChatMessageResult{}
+}
+
+func (p *SocialServiceRecallChatMessageResult) InitDefault() {
+}
+
+var SocialServiceRecallChatMessageResult_Success_DEFAULT *RecallChatMessageResponse
+
+func (p *SocialServiceRecallChatMessageResult) GetSuccess() (v *RecallChatMessageResponse) {
+	if !p.IsSetSuccess() {
+		return SocialServiceRecallChatMessageResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *SocialServiceRecallChatMessageResult) SetSuccess(x interface{}) {
+	p.Success = x.(*RecallChatMessageResponse)
+}
+
+func (p *SocialServiceRecallChatMessageResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *SocialServiceRecallChatMessageResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SocialServiceRecallChatMessageResult(%+v)", *p)
+}
+
+var fieldIDToName_SocialServiceRecallChatMessageResult = map[int16]string{
+	0: "success",
+}
+
+type SocialServiceAddChatRoomMembersArgs struct {
+	Req *AddChatRoomMembersRequest `thrift:"req,1" frugal:"1,default,AddChatRoomMembersRequest" json:"req"`
+}
+
+func NewSocialServiceAddChatRoomMembersArgs() *SocialServiceAddChatRoomMembersArgs {
+	return &SocialServiceAddChatRoomMembersArgs{}
+}
+
+func (p *SocialServiceAddChatRoomMembersArgs) InitDefault() {
+}
+
+var SocialServiceAddChatRoomMembersArgs_Req_DEFAULT *AddChatRoomMembersRequest
+
+func (p *SocialServiceAddChatRoomMembersArgs) GetReq() (v *AddChatRoomMembersRequest) {
+	if !p.IsSetReq() {
+		return SocialServiceAddChatRoomMembersArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *SocialServiceAddChatRoomMembersArgs) SetReq(val *AddChatRoomMembersRequest) {
+	p.Req = val
+}
+
+func (p *SocialServiceAddChatRoomMembersArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *SocialServiceAddChatRoomMembersArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SocialServiceAddChatRoomMembersArgs(%+v)", *p)
+}
+
+var fieldIDToName_SocialServiceAddChatRoomMembersArgs = map[int16]string{
+	1: "req",
+}
+
+type SocialServiceAddChatRoomMembersResult struct {
+	Success *AddChatRoomMembersResponse `thrift:"success,0,optional" frugal:"0,optional,AddChatRoomMembersResponse" json:"success,omitempty"`
+}
+
+func NewSocialServiceAddChatRoomMembersResult() *SocialServiceAddChatRoomMembersResult {
+	return &SocialServiceAddChatRoomMembersResult{}
+}
+
+func (p *SocialServiceAddChatRoomMembersResult) InitDefault() {
+}
+
+var SocialServiceAddChatRoomMembersResult_Success_DEFAULT *AddChatRoomMembersResponse
+
+func (p *SocialServiceAddChatRoomMembersResult) GetSuccess() (v *AddChatRoomMembersResponse) {
+	if !p.IsSetSuccess() {
+		return SocialServiceAddChatRoomMembersResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *SocialServiceAddChatRoomMembersResult) SetSuccess(x interface{}) {
+	p.Success = x.(*AddChatRoomMembersResponse)
+}
+
+func (p *SocialServiceAddChatRoomMembersResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *SocialServiceAddChatRoomMembersResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SocialServiceAddChatRoomMembersResult(%+v)", *p)
+}
+
+var fieldIDToName_SocialServiceAddChatRoomMembersResult = map[int16]string{
+	0: "success",
+}
+
+type SocialServiceRemoveChatRoomMemberArgs struct {
+	Req *RemoveChatRoomMemberRequest `thrift:"req,1" frugal:"1,default,RemoveChatRoomMemberRequest" json:"req"`
+}
+
+func NewSocialServiceRemoveChatRoomMemberArgs() *SocialServiceRemoveChatRoomMemberArgs {
+	return &SocialServiceRemoveChatRoomMemberArgs{}
+}
+
+func (p *SocialServiceRemoveChatRoomMemberArgs) InitDefault() {
+}
+
+var SocialServiceRemoveChatRoomMemberArgs_Req_DEFAULT *RemoveChatRoomMemberRequest
+
+func (p *SocialServiceRemoveChatRoomMemberArgs) GetReq() (v *RemoveChatRoomMemberRequest) {
+	if !p.IsSetReq() {
+		return SocialServiceRemoveChatRoomMemberArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *SocialServiceRemoveChatRoomMemberArgs) SetReq(val *RemoveChatRoomMemberRequest) {
+	p.Req = val
+}
+
+func (p *SocialServiceRemoveChatRoomMemberArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *SocialServiceRemoveChatRoomMemberArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SocialServiceRemoveChatRoomMemberArgs(%+v)", *p)
+}
+
+var fieldIDToName_SocialServiceRemoveChatRoomMemberArgs = map[int16]string{
+	1: "req",
+}
+
+type SocialServiceRemoveChatRoomMemberResult struct {
+	Success *RemoveChatRoomMemberResponse `thrift:"success,0,optional" frugal:"0,optional,RemoveChatRoomMemberResponse" json:"success,omitempty"`
+}
+
+func NewSocialServiceRemoveChatRoomMemberResult() *SocialServiceRemoveChatRoomMemberResult {
+	return &SocialServiceRemoveChatRoomMemberResult{}
+}
+
+func (p *SocialServiceRemoveChatRoomMemberResult) InitDefault() {
+}
+
+var SocialServiceRemoveChatRoomMemberResult_Success_DEFAULT *RemoveChatRoomMemberResponse
+
+func (p *SocialServiceRemoveChatRoomMemberResult) GetSuccess() (v *RemoveChatRoomMemberResponse) {
+	if !p.IsSetSuccess() {
+		return SocialServiceRemoveChatRoomMemberResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *SocialServiceRemoveChatRoomMemberResult) SetSuccess(x interface{}) {
+	p.Success = x.(*RemoveChatRoomMemberResponse)
+}
+
+func (p *SocialServiceRemoveChatRoomMemberResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *SocialServiceRemoveChatRoomMemberResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SocialServiceRemoveChatRoomMemberResult(%+v)", *p)
+}
+
+var fieldIDToName_SocialServiceRemoveChatRoomMemberResult = map[int16]string{
+	0: "success",
+}
+
+type SocialServiceLeaveChatRoomArgs struct {
+	Req *LeaveChatRoomRequest `thrift:"req,1" frugal:"1,default,LeaveChatRoomRequest" json:"req"`
+}
+
+func NewSocialServiceLeaveChatRoomArgs() *SocialServiceLeaveChatRoomArgs {
+	return &SocialServiceLeaveChatRoomArgs{}
+}
+
+func (p *SocialServiceLeaveChatRoomArgs) InitDefault() {
+}
+
+var SocialServiceLeaveChatRoomArgs_Req_DEFAULT *LeaveChatRoomRequest
+
+func (p *SocialServiceLeaveChatRoomArgs) GetReq() (v *LeaveChatRoomRequest) {
+	if !p.IsSetReq() {
+		return SocialServiceLeaveChatRoomArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *SocialServiceLeaveChatRoomArgs) SetReq(val *LeaveChatRoomRequest) {
+	p.Req = val
+}
+
+func (p *SocialServiceLeaveChatRoomArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *SocialServiceLeaveChatRoomArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SocialServiceLeaveChatRoomArgs(%+v)", *p)
+}
+
+var fieldIDToName_SocialServiceLeaveChatRoomArgs = map[int16]string{
+	1: "req",
+}
+
+type SocialServiceLeaveChatRoomResult struct {
+	Success *LeaveChatRoomResponse `thrift:"success,0,optional" frugal:"0,optional,LeaveChatRoomResponse" json:"success,omitempty"`
+}
+
+func NewSocialServiceLeaveChatRoomResult() *SocialServiceLeaveChatRoomResult {
+	return &SocialServiceLeaveChatRoomResult{}
+}
+
+func (p *SocialServiceLeaveChatRoomResult) InitDefault() {
+}
+
+var SocialServiceLeaveChatRoomResult_Success_DEFAULT *LeaveChatRoomResponse
+
+func (p *SocialServiceLeaveChatRoomResult) GetSuccess() (v *LeaveChatRoomResponse) {
+	if !p.IsSetSuccess() {
+		return SocialServiceLeaveChatRoomResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *SocialServiceLeaveChatRoomResult) SetSuccess(x interface{}) {
+	p.Success = x.(*LeaveChatRoomResponse)
+}
+
+func (p *SocialServiceLeaveChatRoomResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *SocialServiceLeaveChatRoomResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SocialServiceLeaveChatRoomResult(%+v)", *p)
+}
+
+var fieldIDToName_SocialServiceLeaveChatRoomResult = map[int16]string{
+	0: "success",
+}
+
+type SocialServiceCheckChatRoomMemberArgs struct {
+	Req *CheckChatRoomMemberRequest `thrift:"req,1" frugal:"1,default,CheckChatRoomMemberRequest" json:"req"`
+}
+
+func NewSocialServiceCheckChatRoomMemberArgs() *SocialServiceCheckChatRoomMemberArgs {
+	return &SocialServiceCheckChatRoomMemberArgs{}
+}
+
+func (p *SocialServiceCheckChatRoomMemberArgs) InitDefault() {
+}
+
+var SocialServiceCheckChatRoomMemberArgs_Req_DEFAULT *CheckChatRoomMemberRequest
+
+func (p *SocialServiceCheckChatRoomMemberArgs) GetReq() (v *CheckChatRoomMemberRequest) {
+	if !p.IsSetReq() {
+		return SocialServiceCheckChatRoomMemberArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *SocialServiceCheckChatRoomMemberArgs) SetReq(val *CheckChatRoomMemberRequest) {
+	p.Req = val
+}
+
+func (p *SocialServiceCheckChatRoomMemberArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *SocialServiceCheckChatRoomMemberArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SocialServiceCheckChatRoomMemberArgs(%+v)", *p)
+}
+
+var fieldIDToName_SocialServiceCheckChatRoomMemberArgs = map[int16]string{
+	1: "req",
+}
+
+type SocialServiceCheckChatRoomMemberResult struct {
+	Success *CheckChatRoomMemberResponse `thrift:"success,0,optional" frugal:"0,optional,CheckChatRoomMemberResponse" json:"success,omitempty"`
+}
+
+func NewSocialServiceCheckChatRoomMemberResult() *SocialServiceCheckChatRoomMemberResult {
+	return &SocialServiceCheckChatRoomMemberResult{}
+}
+
+func (p *SocialServiceCheckChatRoomMemberResult) InitDefault() {
+}
+
+var SocialServiceCheckChatRoomMemberResult_Success_DEFAULT *CheckChatRoomMemberResponse
+
+func (p *SocialServiceCheckChatRoomMemberResult) GetSuccess() (v *CheckChatRoomMemberResponse) {
+	if !p.IsSetSuccess() {
+		return SocialServiceCheckChatRoomMemberResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *SocialServiceCheckChatRoomMemberResult) SetSuccess(x interface{}) {
+	p.Success = x.(*CheckChatRoomMemberResponse)
+}
+
+func (p *SocialServiceCheckChatRoomMemberResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *SocialServiceCheckChatRoomMemberResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SocialServiceCheckChatRoomMemberResult(%+v)", *p)
+}
+
+var fieldIDToName_SocialServiceCheckChatRoomMemberResult = map[int16]string{
+	0: "success",
+}
+
+type SocialServiceCheckPrivateChatArgs struct {
+	Req *CheckPrivateChatRequest `thrift:"req,1" frugal:"1,default,CheckPrivateChatRequest" json:"req"`
+}
+
+func NewSocialServiceCheckPrivateChatArgs() *SocialServiceCheckPrivateChatArgs {
+	return &SocialServiceCheckPrivateChatArgs{}
+}
+
+func (p *SocialServiceCheckPrivateChatArgs) InitDefault() {
+}
+
+var SocialServiceCheckPrivateChatArgs_Req_DEFAULT *CheckPrivateChatRequest
+
+func (p *SocialServiceCheckPrivateChatArgs) GetReq() (v *CheckPrivateChatRequest) {
+	if !p.IsSetReq() {
+		return SocialServiceCheckPrivateChatArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *SocialServiceCheckPrivateChatArgs) SetReq(val *CheckPrivateChatRequest) {
+	p.Req = val
+}
+
+func (p *SocialServiceCheckPrivateChatArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *SocialServiceCheckPrivateChatArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SocialServiceCheckPrivateChatArgs(%+v)", *p)
+}
+
+var fieldIDToName_SocialServiceCheckPrivateChatArgs = map[int16]string{
+	1: "req",
+}
+
+type SocialServiceCheckPrivateChatResult struct {
+	Success *CheckPrivateChatResponse `thrift:"success,0,optional" frugal:"0,optional,CheckPrivateChatResponse" json:"success,omitempty"`
+}
+
+func NewSocialServiceCheckPrivateChatResult() *SocialServiceCheckPrivateChatResult {
+	return &SocialServiceCheckPrivateChatResult{}
+}
+
+func (p *SocialServiceCheckPrivateChatResult) InitDefault() {
+}
+
+var SocialServiceCheckPrivateChatResult_Success_DEFAULT *CheckPrivateChatResponse
+
+func (p *SocialServiceCheckPrivateChatResult) GetSuccess() (v *CheckPrivateChatResponse) {
+	if !p.IsSetSuccess() {
+		return SocialServiceCheckPrivateChatResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *SocialServiceCheckPrivateChatResult) SetSuccess(x interface{}) {
+	p.Success = x.(*CheckPrivateChatResponse)
+}
+
+func (p *SocialServiceCheckPrivateChatResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *SocialServiceCheckPrivateChatResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SocialServiceCheckPrivateChatResult(%+v)", *p)
+}
+
+var fieldIDToName_SocialServiceCheckPrivateChatResult = map[int16]string{
+	0: "success",
+}
+
+type SocialServiceSetChatRoomAdminArgs struct {
+	Req *SetChatRoomAdminRequest `thrift:"req,1" frugal:"1,default,SetChatRoomAdminRequest" json:"req"`
+}
+
+func NewSocialServiceSetChatRoomAdminArgs() *SocialServiceSetChatRoomAdminArgs {
+	return &SocialServiceSetChatRoomAdminArgs{}
+}
+
+func (p *SocialServiceSetChatRoomAdminArgs) InitDefault() {
+}
+
+var SocialServiceSetChatRoomAdminArgs_Req_DEFAULT *SetChatRoomAdminRequest
+
+func (p *SocialServiceSetChatRoomAdminArgs) GetReq() (v *SetChatRoomAdminRequest) {
+	if !p.IsSetReq() {
+		return SocialServiceSetChatRoomAdminArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *SocialServiceSetChatRoomAdminArgs) SetReq(val *SetChatRoomAdminRequest) {
+	p.Req = val
+}
+
+func (p *SocialServiceSetChatRoomAdminArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *SocialServiceSetChatRoomAdminArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SocialServiceSetChatRoomAdminArgs(%+v)", *p)
+}
+
+var fieldIDToName_SocialServiceSetChatRoomAdminArgs = map[int16]string{
+	1: "req",
+}
+
+type SocialServiceSetChatRoomAdminResult struct {
+	Success *SetChatRoomAdminResponse `thrift:"success,0,optional" frugal:"0,optional,SetChatRoomAdminResponse" json:"success,omitempty"`
+}
+
+func NewSocialServiceSetChatRoomAdminResult() *SocialServiceSetChatRoomAdminResult {
+	return &SocialServiceSetChatRoomAdminResult{}
+}
+
+func (p *SocialServiceSetChatRoomAdminResult) InitDefault() {
+}
+
+var SocialServiceSetChatRoomAdminResult_Success_DEFAULT *SetChatRoomAdminResponse
+
+func (p *SocialServiceSetChatRoomAdminResult) GetSuccess() (v *SetChatRoomAdminResponse) {
+	if !p.IsSetSuccess() {
+		return SocialServiceSetChatRoomAdminResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *SocialServiceSetChatRoomAdminResult) SetSuccess(x interface{}) {
+	p.Success = x.(*SetChatRoomAdminResponse)
+}
+
+func (p *SocialServiceSetChatRoomAdminResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *SocialServiceSetChatRoomAdminResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SocialServiceSetChatRoomAdminResult(%+v)", *p)
+}
+
+var fieldIDToName_SocialServiceSetChatRoomAdminResult = map[int16]string{
+	0: "success",
+}
+
+type SocialServiceTransferChatRoomArgs struct {
+	Req *TransferChatRoomRequest `thrift:"req,1" frugal:"1,default,TransferChatRoomRequest" json:"req"`
+}
+
+func NewSocialServiceTransferChatRoomArgs() *SocialServiceTransferChatRoomArgs {
+	return &SocialServiceTransferChatRoomArgs{}
+}
+
+func (p *SocialServiceTransferChatRoomArgs) InitDefault() {
+}
+
+var SocialServiceTransferChatRoomArgs_Req_DEFAULT *TransferChatRoomRequest
+
+func (p *SocialServiceTransferChatRoomArgs) GetReq() (v *TransferChatRoomRequest) {
+	if !p.IsSetReq() {
+		return SocialServiceTransferChatRoomArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *SocialServiceTransferChatRoomArgs) SetReq(val *TransferChatRoomRequest) {
+	p.Req = val
+}
+
+func (p *SocialServiceTransferChatRoomArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *SocialServiceTransferChatRoomArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SocialServiceTransferChatRoomArgs(%+v)", *p)
+}
+
+var fieldIDToName_SocialServiceTransferChatRoomArgs = map[int16]string{
+	1: "req",
+}
+
+type SocialServiceTransferChatRoomResult struct {
+	Success *TransferChatRoomResponse `thrift:"success,0,optional" frugal:"0,optional,TransferChatRoomResponse" json:"success,omitempty"`
+}
+
+func NewSocialServiceTransferChatRoomResult() *SocialServiceTransferChatRoomResult {
+	return &SocialServiceTransferChatRoomResult{}
+}
+
+func (p *SocialServiceTransferChatRoomResult) InitDefault() {
+}
+
+var SocialServiceTransferChatRoomResult_Success_DEFAULT *TransferChatRoomResponse
+
+func (p *SocialServiceTransferChatRoomResult) GetSuccess() (v *TransferChatRoomResponse) {
+	if !p.IsSetSuccess() {
+		return SocialServiceTransferChatRoomResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *SocialServiceTransferChatRoomResult) SetSuccess(x interface{}) {
+	p.Success = x.(*TransferChatRoomResponse)
+}
+
+func (p *SocialServiceTransferChatRoomResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *SocialServiceTransferChatRoomResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SocialServiceTransferChatRoomResult(%+v)", *p)
+}
+
+var fieldIDToName_SocialServiceTransferChatRoomResult = map[int16]string{
+	0: "success",
+}
+
+type SocialServiceMuteChatRoomMemberArgs struct {
+	Req *MuteChatRoomMemberRequest `thrift:"req,1" frugal:"1,default,MuteChatRoomMemberRequest" json:"req"`
+}
+
+func NewSocialServiceMuteChatRoomMemberArgs() *SocialServiceMuteChatRoomMemberArgs {
+	return &SocialServiceMuteChatRoomMemberArgs{}
+}
+
+func (p *SocialServiceMuteChatRoomMemberArgs) InitDefault() {
+}
+
+var SocialServiceMuteChatRoomMemberArgs_Req_DEFAULT *MuteChatRoomMemberRequest
+
+func (p *SocialServiceMuteChatRoomMemberArgs) GetReq() (v *MuteChatRoomMemberRequest) {
+	if !p.IsSetReq() {
+		return SocialServiceMuteChatRoomMemberArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *SocialServiceMuteChatRoomMemberArgs) SetReq(val *MuteChatRoomMemberRequest) {
+	p.Req = val
+}
+
+func (p *SocialServiceMuteChatRoomMemberArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *SocialServiceMuteChatRoomMemberArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SocialServiceMuteChatRoomMemberArgs(%+v)", *p)
+}
+
+var fieldIDToName_SocialServiceMuteChatRoomMemberArgs = map[int16]string{
+	1: "req",
+}
+
+type SocialServiceMuteChatRoomMemberResult struct {
+	Success *MuteChatRoomMemberResponse `thrift:"success,0,optional" frugal:"0,optional,MuteChatRoomMemberResponse" json:"success,omitempty"`
+}
+
+func NewSocialServiceMuteChatRoomMemberResult() *SocialServiceMuteChatRoomMemberResult {
+	return &SocialServiceMuteChatRoomMemberResult{}
+}
+
+func (p *SocialServiceMuteChatRoomMemberResult) InitDefault() {
+}
+
+var SocialServiceMuteChatRoomMemberResult_Success_DEFAULT *MuteChatRoomMemberResponse
+
+func (p *SocialServiceMuteChatRoomMemberResult) GetSuccess() (v *MuteChatRoomMemberResponse) {
+	if !p.IsSetSuccess() {
+		return SocialServiceMuteChatRoomMemberResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *SocialServiceMuteChatRoomMemberResult) SetSuccess(x interface{}) {
+	p.Success = x.(*MuteChatRoomMemberResponse)
+}
+
+func (p *SocialServiceMuteChatRoomMemberResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *SocialServiceMuteChatRoomMemberResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SocialServiceMuteChatRoomMemberResult(%+v)", *p)
+}
+
+var fieldIDToName_SocialServiceMuteChatRoomMemberResult = map[int16]string{
+	0: "success",
+}
+
+type SocialServiceRenameChatRoomArgs struct {
+	Req *RenameChatRoomRequest `thrift:"req,1" frugal:"1,default,RenameChatRoomRequest" json:"req"`
+}
+
+func NewSocialServiceRenameChatRoomArgs() *SocialServiceRenameChatRoomArgs {
+	return &SocialServiceRenameChatRoomArgs{}
+}
+
+func (p *SocialServiceRenameChatRoomArgs) InitDefault() {
+}
+
+var SocialServiceRenameChatRoomArgs_Req_DEFAULT *RenameChatRoomRequest
+
+func (p *SocialServiceRenameChatRoomArgs) GetReq() (v *RenameChatRoomRequest) {
+	if !p.IsSetReq() {
+		return SocialServiceRenameChatRoomArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *SocialServiceRenameChatRoomArgs) SetReq(val *RenameChatRoomRequest) {
+	p.Req = val
+}
+
+func (p *SocialServiceRenameChatRoomArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *SocialServiceRenameChatRoomArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SocialServiceRenameChatRoomArgs(%+v)", *p)
+}
+
+var fieldIDToName_SocialServiceRenameChatRoomArgs = map[int16]string{
+	1: "req",
+}
+
+type SocialServiceRenameChatRoomResult struct {
+	Success *RenameChatRoomResponse `thrift:"success,0,optional" frugal:"0,optional,RenameChatRoomResponse" json:"success,omitempty"`
+}
+
+func NewSocialServiceRenameChatRoomResult() *SocialServiceRenameChatRoomResult {
+	return &SocialServiceRenameChatRoomResult{}
+}
+
+func (p *SocialServiceRenameChatRoomResult) InitDefault() {
+}
+
+var SocialServiceRenameChatRoomResult_Success_DEFAULT *RenameChatRoomResponse
+
+func (p *SocialServiceRenameChatRoomResult) GetSuccess() (v *RenameChatRoomResponse) {
+	if !p.IsSetSuccess() {
+		return SocialServiceRenameChatRoomResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *SocialServiceRenameChatRoomResult) SetSuccess(x interface{}) {
+	p.Success = x.(*RenameChatRoomResponse)
+}
+
+func (p *SocialServiceRenameChatRoomResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *SocialServiceRenameChatRoomResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SocialServiceRenameChatRoomResult(%+v)", *p)
+}
+
+var fieldIDToName_SocialServiceRenameChatRoomResult = map[int16]string{
+	0: "success",
+}
+
+type SocialServiceDisbandChatRoomArgs struct {
+	Req *DisbandChatRoomRequest `thrift:"req,1" frugal:"1,default,DisbandChatRoomRequest" json:"req"`
+}
+
+func NewSocialServiceDisbandChatRoomArgs() *SocialServiceDisbandChatRoomArgs {
+	return &SocialServiceDisbandChatRoomArgs{}
+}
+
+func (p *SocialServiceDisbandChatRoomArgs) InitDefault() {
+}
+
+var SocialServiceDisbandChatRoomArgs_Req_DEFAULT *DisbandChatRoomRequest
+
+func (p *SocialServiceDisbandChatRoomArgs) GetReq() (v *DisbandChatRoomRequest) {
+	if !p.IsSetReq() {
+		return SocialServiceDisbandChatRoomArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *SocialServiceDisbandChatRoomArgs) SetReq(val *DisbandChatRoomRequest) {
+	p.Req = val
+}
+
+func (p *SocialServiceDisbandChatRoomArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *SocialServiceDisbandChatRoomArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SocialServiceDisbandChatRoomArgs(%+v)", *p)
+}
+
+var fieldIDToName_SocialServiceDisbandChatRoomArgs = map[int16]string{
+	1: "req",
+}
+
+type SocialServiceDisbandChatRoomResult struct {
+	Success *DisbandChatRoomResponse `thrift:"success,0,optional" frugal:"0,optional,DisbandChatRoomResponse" json:"success,omitempty"`
+}
+
+func NewSocialServiceDisbandChatRoomResult() *SocialServiceDisbandChatRoomResult {
+	return &SocialServiceDisbandChatRoomResult{}
+}
+
+func (p *SocialServiceDisbandChatRoomResult) InitDefault() {
+}
+
+var SocialServiceDisbandChatRoomResult_Success_DEFAULT *DisbandChatRoomResponse
+
+func (p *SocialServiceDisbandChatRoomResult) GetSuccess() (v *DisbandChatRoomResponse) {
+	if !p.IsSetSuccess() {
+		return SocialServiceDisbandChatRoomResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *SocialServiceDisbandChatRoomResult) SetSuccess(x interface{}) {
+	p.Success = x.(*DisbandChatRoomResponse)
+}
+
+func (p *SocialServiceDisbandChatRoomResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *SocialServiceDisbandChatRoomResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SocialServiceDisbandChatRoomResult(%+v)", *p)
+}
+
+var fieldIDToName_SocialServiceDisbandChatRoomResult = map[int16]string{
+	0: "success",
+}
+
+type SocialServiceCreateChatRoomInviteArgs struct {
+	Req *CreateChatRoomInviteRequest `thrift:"req,1" frugal:"1,default,CreateChatRoomInviteRequest" json:"req"`
+}
+
+func NewSocialServiceCreateChatRoomInviteArgs() *SocialServiceCreateChatRoomInviteArgs {
+	return &SocialServiceCreateChatRoomInviteArgs{}
+}
+
+func (p *SocialServiceCreateChatRoomInviteArgs) InitDefault() {
+}
+
+var SocialServiceCreateChatRoomInviteArgs_Req_DEFAULT *CreateChatRoomInviteRequest
+
+func (p *SocialServiceCreateChatRoomInviteArgs) GetReq() (v *CreateChatRoomInviteRequest) {
+	if !p.IsSetReq() {
+		return SocialServiceCreateChatRoomInviteArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *SocialServiceCreateChatRoomInviteArgs) SetReq(val *CreateChatRoomInviteRequest) {
+	p.Req = val
+}
+
+func (p *SocialServiceCreateChatRoomInviteArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *SocialServiceCreateChatRoomInviteArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SocialServiceCreateChatRoomInviteArgs(%+v)", *p)
+}
+
+var fieldIDToName_SocialServiceCreateChatRoomInviteArgs = map[int16]string{
+	1: "req",
+}
+
+type SocialServiceCreateChatRoomInviteResult struct {
+	Success *CreateChatRoomInviteResponse `thrift:"success,0,optional" frugal:"0,optional,CreateChatRoomInviteResponse" json:"success,omitempty"`
+}
+
+func NewSocialServiceCreateChatRoomInviteResult() *SocialServiceCreateChatRoomInviteResult {
+	return &SocialServiceCreateChatRoomInviteResult{}
+}
+
+func (p *SocialServiceCreateChatRoomInviteResult) InitDefault() {
+}
+
+var SocialServiceCreateChatRoomInviteResult_Success_DEFAULT *CreateChatRoomInviteResponse
+
+func (p *SocialServiceCreateChatRoomInviteResult) GetSuccess() (v *CreateChatRoomInviteResponse) {
+	if !p.IsSetSuccess() {
+		return SocialServiceCreateChatRoomInviteResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *SocialServiceCreateChatRoomInviteResult) SetSuccess(x interface{}) {
+	p.Success = x.(*CreateChatRoomInviteResponse)
+}
+
+func (p *SocialServiceCreateChatRoomInviteResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *SocialServiceCreateChatRoomInviteResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SocialServiceCreateChatRoomInviteResult(%+v)", *p)
+}
+
+var fieldIDToName_SocialServiceCreateChatRoomInviteResult = map[int16]string{
+	0: "success",
+}
+
+type SocialServiceJoinChatRoomByInviteArgs struct {
+	Req *JoinChatRoomByInviteRequest `thrift:"req,1" frugal:"1,default,JoinChatRoomByInviteRequest" json:"req"`
+}
+
+func NewSocialServiceJoinChatRoomByInviteArgs() *SocialServiceJoinChatRoomByInviteArgs {
+	return &SocialServiceJoinChatRoomByInviteArgs{}
+}
+
+func (p *SocialServiceJoinChatRoomByInviteArgs) InitDefault() {
+}
+
+var SocialServiceJoinChatRoomByInviteArgs_Req_DEFAULT *JoinChatRoomByInviteRequest
+
+func (p *SocialServiceJoinChatRoomByInviteArgs) GetReq() (v *JoinChatRoomByInviteRequest) {
+	if !p.IsSetReq() {
+		return SocialServiceJoinChatRoomByInviteArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *SocialServiceJoinChatRoomByInviteArgs) SetReq(val *JoinChatRoomByInviteRequest) {
+	p.Req = val
+}
+
+func (p *SocialServiceJoinChatRoomByInviteArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *SocialServiceJoinChatRoomByInviteArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SocialServiceJoinChatRoomByInviteArgs(%+v)", *p)
+}
+
+var fieldIDToName_SocialServiceJoinChatRoomByInviteArgs = map[int16]string{
+	1: "req",
+}
+
+type SocialServiceJoinChatRoomByInviteResult struct {
+	Success *JoinChatRoomByInviteResponse `thrift:"success,0,optional" frugal:"0,optional,JoinChatRoomByInviteResponse" json:"success,omitempty"`
+}
+
+func NewSocialServiceJoinChatRoomByInviteResult() *SocialServiceJoinChatRoomByInviteResult {
+	return &SocialServiceJoinChatRoomByInviteResult{}
+}
+
+func (p *SocialServiceJoinChatRoomByInviteResult) InitDefault() {
+}
+
+var SocialServiceJoinChatRoomByInviteResult_Success_DEFAULT *JoinChatRoomByInviteResponse
+
+func (p *SocialServiceJoinChatRoomByInviteResult) GetSuccess() (v *JoinChatRoomByInviteResponse) {
+	if !p.IsSetSuccess() {
+		return SocialServiceJoinChatRoomByInviteResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *SocialServiceJoinChatRoomByInviteResult) SetSuccess(x interface{}) {
+	p.Success = x.(*JoinChatRoomByInviteResponse)
+}
+
+func (p *SocialServiceJoinChatRoomByInviteResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *SocialServiceJoinChatRoomByInviteResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SocialServiceJoinChatRoomByInviteResult(%+v)", *p)
+}
+
+var fieldIDToName_SocialServiceJoinChatRoomByInviteResult = map[int16]string{
+	0: "success",
+}
+
+type SocialServiceAddFriendArgs struct {
+	Req *AddFriendRequest `thrift:"req,1" frugal:"1,default,AddFriendRequest" json:"req"`
+}
+
+func NewSocialServiceAddFriendArgs() *SocialServiceAddFriendArgs {
+	return &SocialServiceAddFriendArgs{}
+}
+
+func (p *SocialServiceAddFriendArgs) InitDefault() {
+}
+
+var SocialServiceAddFriendArgs_Req_DEFAULT *AddFriendRequest
+
+func (p *SocialServiceAddFriendArgs) GetReq() (v *AddFriendRequest) {
+	if !p.IsSetReq() {
+		return SocialServiceAddFriendArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *SocialServiceAddFriendArgs) SetReq(val *AddFriendRequest) {
+	p.Req = val
+}
+
+func (p *SocialServiceAddFriendArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *SocialServiceAddFriendArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SocialServiceAddFriendArgs(%+v)", *p)
+}
+
+var fieldIDToName_SocialServiceAddFriendArgs = map[int16]string{
+	1: "req",
+}
+
+type SocialServiceAddFriendResult struct {
+	Success *AddFriendResponse `thrift:"success,0,optional" frugal:"0,optional,AddFriendResponse" json:"success,omitempty"`
+}
+
+func NewSocialServiceAddFriendResult() *SocialServiceAddFriendResult {
+	return &SocialServiceAddFriendResult{}
+}
+
+func (p *SocialServiceAddFriendResult) InitDefault() {
+}
+
+var SocialServiceAddFriendResult_Success_DEFAULT *AddFriendResponse
+
+func (p *SocialServiceAddFriendResult) GetSuccess() (v *AddFriendResponse) {
+	if !p.IsSetSuccess() {
+		return SocialServiceAddFriendResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *SocialServiceAddFriendResult) SetSuccess(x interface{}) {
+	p.Success = x.(*AddFriendResponse)
+}
+
+func (p *SocialServiceAddFriendResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *SocialServiceAddFriendResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SocialServiceAddFriendResult(%+v)", *p)
+}
+
+var fieldIDToName_SocialServiceAddFriendResult = map[int16]string{
+	0: "success",
+}
+
+type SocialServiceGetFriendshipArgs struct {
+	Req *GetFriendshipRequest `thrift:"req,1" frugal:"1,default,GetFriendshipRequest" json:"req"`
+}
+
+func NewSocialServiceGetFriendshipArgs() *SocialServiceGetFriendshipArgs {
+	return &SocialServiceGetFriendshipArgs{}
+}
+
+func (p *SocialServiceGetFriendshipArgs) InitDefault() {
+}
+
+var SocialServiceGetFriendshipArgs_Req_DEFAULT *GetFriendshipRequest
+
+func (p *SocialServiceGetFriendshipArgs) GetReq() (v *GetFriendshipRequest) {
+	if !p.IsSetReq() {
+		return SocialServiceGetFriendshipArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *SocialServiceGetFriendshipArgs) SetReq(val *GetFriendshipRequest) {
+	p.Req = val
+}
+
+func (p *SocialServiceGetFriendshipArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *SocialServiceGetFriendshipArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SocialServiceGetFriendshipArgs(%+v)", *p)
+}
+
+var fieldIDToName_SocialServiceGetFriendshipArgs = map[int16]string{
+	1: "req",
+}
+
+type SocialServiceGetFriendshipResult struct {
+	Success *GetFriendshipResponse `thrift:"success,0,optional" frugal:"0,optional,GetFriendshipResponse" json:"success,omitempty"`
+}
+
+func NewSocialServiceGetFriendshipResult() *SocialServiceGetFriendshipResult {
+	return &SocialServiceGetFriendshipResult{}
+}
+
+func (p *SocialServiceGetFriendshipResult) InitDefault() {
+}
+
+var SocialServiceGetFriendshipResult_Success_DEFAULT *GetFriendshipResponse
+
+func (p *SocialServiceGetFriendshipResult) GetSuccess() (v *GetFriendshipResponse) {
+	if !p.IsSetSuccess() {
+		return SocialServiceGetFriendshipResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *SocialServiceGetFriendshipResult) SetSuccess(x interface{}) {
+	p.Success = x.(*GetFriendshipResponse)
+}
+
+func (p *SocialServiceGetFriendshipResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *SocialServiceGetFriendshipResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SocialServiceGetFriendshipResult(%+v)", *p)
+}
+
+var fieldIDToName_SocialServiceGetFriendshipResult = map[int16]string{
+	0: "success",
+}
+
+type SocialServiceGetUserFriendsArgs struct {
+	Req *GetUserFriendsRequest `thrift:"req,1" frugal:"1,default,GetUserFriendsRequest" json:"req"`
+}
+
+func NewSocialServiceGetUserFriendsArgs() *SocialServiceGetUserFriendsArgs {
+	return &SocialServiceGetUserFriendsArgs{}
+}
+
+func (p *SocialServiceGetUserFriendsArgs) InitDefault() {
+}
+
+var SocialServiceGetUserFriendsArgs_Req_DEFAULT *GetUserFriendsRequest
+
+func (p *SocialServiceGetUserFriendsArgs) GetReq() (v *GetUserFriendsRequest) {
+	if !p.IsSetReq() {
+		return SocialServiceGetUserFriendsArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *SocialServiceGetUserFriendsArgs) SetReq(val *GetUserFriendsRequest) {
+	p.Req = val
+}
+
+func (p *SocialServiceGetUserFriendsArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *SocialServiceGetUserFriendsArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SocialServiceGetUserFriendsArgs(%+v)", *p)
+}
+
+var fieldIDToName_SocialServiceGetUserFriendsArgs = map[int16]string{
+	1: "req",
+}
+
+type SocialServiceGetUserFriendsResult struct {
+	Success *GetUserFriendsResponse `thrift:"success,0,optional" frugal:"0,optional,GetUserFriendsResponse" json:"success,omitempty"`
+}
+
+func NewSocialServiceGetUserFriendsResult() *SocialServiceGetUserFriendsResult {
+	return &SocialServiceGetUserFriendsResult{}
+}
+
+func (p *SocialServiceGetUserFriendsResult) InitDefault() {
+}
+
+var SocialServiceGetUserFriendsResult_Success_DEFAULT *GetUserFriendsResponse
+
+func (p *SocialServiceGetUserFriendsResult) GetSuccess() (v *GetUserFriendsResponse) {
+	if !p.IsSetSuccess() {
+		return SocialServiceGetUserFriendsResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *SocialServiceGetUserFriendsResult) SetSuccess(x interface{}) {
+	p.Success = x.(*GetUserFriendsResponse)
+}
+
+func (p *SocialServiceGetUserFriendsResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *SocialServiceGetUserFriendsResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SocialServiceGetUserFriendsResult(%+v)", *p)
+}
+
+var fieldIDToName_SocialServiceGetUserFriendsResult = map[int16]string{
+	0: "success",
+}
+
+type SocialServiceCreateFriendRequestArgs struct {
+	Req *CreateFriendRequestRequest `thrift:"req,1" frugal:"1,default,CreateFriendRequestRequest" json:"req"`
+}
+
+func NewSocialServiceCreateFriendRequestArgs() *SocialServiceCreateFriendRequestArgs {
+	return &SocialServiceCreateFriendRequestArgs{}
+}
+
+func (p *SocialServiceCreateFriendRequestArgs) InitDefault() {
+}
+
+var SocialServiceCreateFriendRequestArgs_Req_DEFAULT *CreateFriendRequestRequest
+
+func (p *SocialServiceCreateFriendRequestArgs) GetReq() (v *CreateFriendRequestRequest) {
+	if !p.IsSetReq() {
+		return SocialServiceCreateFriendRequestArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *SocialServiceCreateFriendRequestArgs) SetReq(val *CreateFriendRequestRequest) {
+	p.Req = val
+}
+
+func (p *SocialServiceCreateFriendRequestArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *SocialServiceCreateFriendRequestArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SocialServiceCreateFriendRequestArgs(%+v)", *p)
+}
+
+var fieldIDToName_SocialServiceCreateFriendRequestArgs = map[int16]string{
+	1: "req",
+}
+
+type SocialServiceCreateFriendRequestResult struct {
+	Success *CreateFriendRequestResponse `thrift:"success,0,optional" frugal:"0,optional,CreateFriendRequestResponse" json:"success,omitempty"`
+}
+
+func NewSocialServiceCreateFriendRequestResult() *SocialServiceCreateFriendRequestResult {
+	return &SocialServiceCreateFriendRequestResult{}
+}
+
+func (p *SocialServiceCreateFriendRequestResult) InitDefault() {
+}
+
+var SocialServiceCreateFriendRequestResult_Success_DEFAULT *CreateFriendRequestResponse
+
+func (p *SocialServiceCreateFriendRequestResult) GetSuccess() (v *CreateFriendRequestResponse) {
+	if !p.IsSetSuccess() {
+		return SocialServiceCreateFriendRequestResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *SocialServiceCreateFriendRequestResult) SetSuccess(x interface{}) {
+	p.Success = x.(*CreateFriendRequestResponse)
+}
+
+func (p *SocialServiceCreateFriendRequestResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *SocialServiceCreateFriendRequestResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SocialServiceCreateFriendRequestResult(%+v)", *p)
+}
+
+var fieldIDToName_SocialServiceCreateFriendRequestResult = map[int16]string{
+	0: "success",
+}
+
+type SocialServiceGetFriendRequestsArgs struct {
+	Req *GetFriendRequestsRequest `thrift:"req,1" frugal:"1,default,GetFriendRequestsRequest" json:"req"`
+}
+
+func NewSocialServiceGetFriendRequestsArgs() *SocialServiceGetFriendRequestsArgs {
+	return &SocialServiceGetFriendRequestsArgs{}
+}
+
+func (p *SocialServiceGetFriendRequestsArgs) InitDefault() {
+}
+
+var SocialServiceGetFriendRequestsArgs_Req_DEFAULT *GetFriendRequestsRequest
+
+func (p *SocialServiceGetFriendRequestsArgs) GetReq() (v *GetFriendRequestsRequest) {
+	if !p.IsSetReq() {
+		return SocialServiceGetFriendRequestsArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *SocialServiceGetFriendRequestsArgs) SetReq(val *GetFriendRequestsRequest) {
+	p.Req = val
+}
+
+func (p *SocialServiceGetFriendRequestsArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *SocialServiceGetFriendRequestsArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SocialServiceGetFriendRequestsArgs(%+v)", *p)
+}
+
+var fieldIDToName_SocialServiceGetFriendRequestsArgs = map[int16]string{
+	1: "req",
+}
+
+type SocialServiceGetFriendRequestsResult struct {
+	Success *GetFriendRequestsResponse `thrift:"success,0,optional" frugal:"0,optional,GetFriendRequestsResponse" json:"success,omitempty"`
+}
+
+func NewSocialServiceGetFriendRequestsResult() *SocialServiceGetFriendRequestsResult {
+	return &SocialServiceGetFriendRequestsResult{}
+}
+
+func (p *SocialServiceGetFriendRequestsResult) InitDefault() {
+}
+
+var SocialServiceGetFriendRequestsResult_Success_DEFAULT *GetFriendRequestsResponse
+
+func (p *SocialServiceGetFriendRequestsResult) GetSuccess() (v *GetFriendRequestsResponse) {
+	if !p.IsSetSuccess() {
+		return SocialServiceGetFriendRequestsResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *SocialServiceGetFriendRequestsResult) SetSuccess(x interface{}) {
+	p.Success = x.(*GetFriendRequestsResponse)
+}
+
+func (p *SocialServiceGetFriendRequestsResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *SocialServiceGetFriendRequestsResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SocialServiceGetFriendRequestsResult(%+v)", *p)
+}
+
+var fieldIDToName_SocialServiceGetFriendRequestsResult = map[int16]string{
+	0: "success",
+}
+
+type SocialServiceHandleFriendRequestArgs struct {
+	Req *HandleFriendRequestRequest `thrift:"req,1" frugal:"1,default,HandleFriendRequestRequest" json:"req"`
+}
+
+func NewSocialServiceHandleFriendRequestArgs() *SocialServiceHandleFriendRequestArgs {
+	return &SocialServiceHandleFriendRequestArgs{}
+}
+
+func (p *SocialServiceHandleFriendRequestArgs) InitDefault() {
+}
+
+var SocialServiceHandleFriendRequestArgs_Req_DEFAULT *HandleFriendRequestRequest
+
+func (p *SocialServiceHandleFriendRequestArgs) GetReq() (v *HandleFriendRequestRequest) {
+	if !p.IsSetReq() {
+		return SocialServiceHandleFriendRequestArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *SocialServiceHandleFriendRequestArgs) SetReq(val *HandleFriendRequestRequest) {
+	p.Req = val
+}
+
+func (p *SocialServiceHandleFriendRequestArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *SocialServiceHandleFriendRequestArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SocialServiceHandleFriendRequestArgs(%+v)", *p)
+}
+
+var fieldIDToName_SocialServiceHandleFriendRequestArgs = map[int16]string{
+	1: "req",
+}
+
+type SocialServiceHandleFriendRequestResult struct {
+	Success *HandleFriendRequestResponse `thrift:"success,0,optional" frugal:"0,optional,HandleFriendRequestResponse" json:"success,omitempty"`
+}
+
+func NewSocialServiceHandleFriendRequestResult() *SocialServiceHandleFriendRequestResult {
+	return &SocialServiceHandleFriendRequestResult{}
+}
+
+func (p *SocialServiceHandleFriendRequestResult) InitDefault() {
+}
+
+var SocialServiceHandleFriendRequestResult_Success_DEFAULT *HandleFriendRequestResponse
+
+func (p *SocialServiceHandleFriendRequestResult) GetSuccess() (v *HandleFriendRequestResponse) {
+	if !p.IsSetSuccess() {
+		return SocialServiceHandleFriendRequestResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *SocialServiceHandleFriendRequestResult) SetSuccess(x interface{}) {
+	p.Success = x.(*HandleFriendRequestResponse)
+}
+
+func (p *SocialServiceHandleFriendRequestResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *SocialServiceHandleFriendRequestResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SocialServiceHandleFriendRequestResult(%+v)", *p)
+}
+
+var fieldIDToName_SocialServiceHandleFriendRequestResult = map[int16]string{
+	0: "success",
+}
+
+type SocialServiceGetFriendRecommendationsArgs struct {
+	Req *GetFriendRecommendationsRequest `thrift:"req,1" frugal:"1,default,GetFriendRecommendationsRequest" json:"req"`
+}
+
+func NewSocialServiceGetFriendRecommendationsArgs() *SocialServiceGetFriendRecommendationsArgs {
+	return &SocialServiceGetFriendRecommendationsArgs{}
+}
+
+func (p *SocialServiceGetFriendRecommendationsArgs) InitDefault() {
+}
+
+var SocialServiceGetFriendRecommendationsArgs_Req_DEFAULT *GetFriendRecommendationsRequest
+
+func (p *SocialServiceGetFriendRecommendationsArgs) GetReq() (v *GetFriendRecommendationsRequest) {
+	if !p.IsSetReq() {
+		return SocialServiceGetFriendRecommendationsArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *SocialServiceGetFriendRecommendationsArgs) SetReq(val *GetFriendRecommendationsRequest) {
+	p.Req = val
+}
+
+func (p *SocialServiceGetFriendRecommendationsArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *SocialServiceGetFriendRecommendationsArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SocialServiceGetFriendRecommendationsArgs(%+v)", *p)
+}
+
+var fieldIDToName_SocialServiceGetFriendRecommendationsArgs = map[int16]string{
+	1: "req",
+}
+
+type SocialServiceGetFriendRecommendationsResult struct {
+	Success *GetFriendRecommendationsResponse `thrift:"success,0,optional" frugal:"0,optional,GetFriendRecommendationsResponse" json:"success,omitempty"`
+}
+
+func NewSocialServiceGetFriendRecommendationsResult() *SocialServiceGetFriendRecommendationsResult {
+	return &SocialServiceGetFriendRecommendationsResult{}
+}
+
+func (p *SocialServiceGetFriendRecommendationsResult) InitDefault() {
+}
+
+var SocialServiceGetFriendRecommendationsResult_Success_DEFAULT *GetFriendRecommendationsResponse
+
+func (p *SocialServiceGetFriendRecommendationsResult) GetSuccess() (v *GetFriendRecommendationsResponse) {
+	if !p.IsSetSuccess() {
+		return SocialServiceGetFriendRecommendationsResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *SocialServiceGetFriendRecommendationsResult) SetSuccess(x interface{}) {
+	p.Success = x.(*GetFriendRecommendationsResponse)
+}
+
+func (p *SocialServiceGetFriendRecommendationsResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *SocialServiceGetFriendRecommendationsResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SocialServiceGetFriendRecommendationsResult(%+v)", *p)
+}
+
+var fieldIDToName_SocialServiceGetFriendRecommendationsResult = map[int16]string{
+	0: "success",
+}
+
+type SocialServiceMarkMessageReadArgs struct {
+	Req *MarkMessageReadRequest `thrift:"req,1" frugal:"1,default,MarkMessageReadRequest" json:"req"`
+}
+
+func NewSocialServiceMarkMessageReadArgs() *SocialServiceMarkMessageReadArgs {
+	return &SocialServiceMarkMessageReadArgs{}
+}
+
+func (p *SocialServiceMarkMessageReadArgs) InitDefault() {
+}
+
+var SocialServiceMarkMessageReadArgs_Req_DEFAULT *MarkMessageReadRequest
+
+func (p *SocialServiceMarkMessageReadArgs) GetReq() (v *MarkMessageReadRequest) {
+	if !p.IsSetReq() {
+		return SocialServiceMarkMessageReadArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *SocialServiceMarkMessageReadArgs) SetReq(val *MarkMessageReadRequest) {
+	p.Req = val
+}
+
+func (p *SocialServiceMarkMessageReadArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *SocialServiceMarkMessageReadArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("SocialServiceGetPrivateMessagesArgs(%+v)", *p)
+	return fmt.Sprintf("SocialServiceMarkMessageReadArgs(%+v)", *p)
 }
 
-var fieldIDToName_SocialServiceGetPrivateMessagesArgs = map[int16]string{
+var fieldIDToName_SocialServiceMarkMessageReadArgs = map[int16]string{
 	1: "req",
 }
 
-type SocialServiceGetPrivateMessagesResult struct {
-	Success *GetPrivateMessagesResponse `thrift:"success,0,optional" frugal:"0,optional,GetPrivateMessagesResponse" json:"success,omitempty"`
+type SocialServiceMarkMessageReadResult struct {
+	Success *MarkMessageReadResponse `thrift:"success,0,optional" frugal:"0,optional,MarkMessageReadResponse" json:"success,omitempty"`
 }
 
-func NewSocialServiceGetPrivateMessagesResult() *SocialServiceGetPrivateMessagesResult {
-	return &SocialServiceGetPrivateMessagesResult{}
+func NewSocialServiceMarkMessageReadResult() *SocialServiceMarkMessageReadResult {
+	return &SocialServiceMarkMessageReadResult{}
 }
 
-func (p *SocialServiceGetPrivateMessagesResult) InitDefault() {
+func (p *SocialServiceMarkMessageReadResult) InitDefault() {
 }
 
-var SocialServiceGetPrivateMessagesResult_Success_DEFAULT *GetPrivateMessagesResponse
+var SocialServiceMarkMessageReadResult_Success_DEFAULT *MarkMessageReadResponse
 
-func (p *SocialServiceGetPrivateMessagesResult) GetSuccess() (v *GetPrivateMessagesResponse) {
+func (p *SocialServiceMarkMessageReadResult) GetSuccess() (v *MarkMessageReadResponse) {
 	if !p.IsSetSuccess() {
-		return SocialServiceGetPrivateMessagesResult_Success_DEFAULT
+		return SocialServiceMarkMessageReadResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *SocialServiceGetPrivateMessagesResult) SetSuccess(x interface{}) {
-	p.Success = x.(*GetPrivateMessagesResponse)
+func (p *SocialServiceMarkMessageReadResult) SetSuccess(x interface{}) {
+	p.Success = x.(*MarkMessageReadResponse)
 }
 
-func (p *SocialServiceGetPrivateMessagesResult) IsSetSuccess() bool {
+func (p *SocialServiceMarkMessageReadResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *SocialServiceGetPrivateMessagesResult) String() string {
+func (p *SocialServiceMarkMessageReadResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("SocialServiceGetPrivateMessagesResult(%+v)", *p)
+	return fmt.Sprintf("SocialServiceMarkMessageReadResult(%+v)", *p)
 }
 
-var fieldIDToName_SocialServiceGetPrivateMessagesResult = map[int16]string{
+var fieldIDToName_SocialServiceMarkMessageReadResult = map[int16]string{
 	0: "success",
 }
 
-type SocialServiceCreateChatRoomArgs struct {
-	Req *CreateChatRoomRequest `thrift:"req,1" frugal:"1,default,CreateChatRoomRequest" json:"req"`
+type SocialServiceGetUnreadMessageCountArgs struct {
+	Req *GetUnreadMessageCountRequest `thrift:"req,1" frugal:"1,default,GetUnreadMessageCountRequest" json:"req"`
 }
 
-func NewSocialServiceCreateChatRoomArgs() *SocialServiceCreateChatRoomArgs {
-	return &SocialServiceCreateChatRoomArgs{}
+func NewSocialServiceGetUnreadMessageCountArgs() *SocialServiceGetUnreadMessageCountArgs {
+	return &SocialServiceGetUnreadMessageCountArgs{}
 }
 
-func (p *SocialServiceCreateChatRoomArgs) InitDefault() {
+func (p *SocialServiceGetUnreadMessageCountArgs) InitDefault() {
 }
 
-var SocialServiceCreateChatRoomArgs_Req_DEFAULT *CreateChatRoomRequest
+var SocialServiceGetUnreadMessageCountArgs_Req_DEFAULT *GetUnreadMessageCountRequest
 
-func (p *SocialServiceCreateChatRoomArgs) GetReq() (v *CreateChatRoomRequest) {
+func (p *SocialServiceGetUnreadMessageCountArgs) GetReq() (v *GetUnreadMessageCountRequest) {
 	if !p.IsSetReq() {
-		return SocialServiceCreateChatRoomArgs_Req_DEFAULT
+		return SocialServiceGetUnreadMessageCountArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *SocialServiceCreateChatRoomArgs) SetReq(val *CreateChatRoomRequest) {
+func (p *SocialServiceGetUnreadMessageCountArgs) SetReq(val *GetUnreadMessageCountRequest) {
 	p.Req = val
 }
 
-func (p *SocialServiceCreateChatRoomArgs) IsSetReq() bool {
+func (p *SocialServiceGetUnreadMessageCountArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *SocialServiceCreateChatRoomArgs) String() string {
+func (p *SocialServiceGetUnreadMessageCountArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("SocialServiceCreateChatRoomArgs(%+v)", *p)
+	return fmt.Sprintf("SocialServiceGetUnreadMessageCountArgs(%+v)", *p)
 }
 
-var fieldIDToName_SocialServiceCreateChatRoomArgs = map[int16]string{
+var fieldIDToName_SocialServiceGetUnreadMessageCountArgs = map[int16]string{
 	1: "req",
 }
 
-type SocialServiceCreateChatRoomResult struct {
-	Success *CreateChatRoomResponse `thrift:"success,0,optional" frugal:"0,optional,CreateChatRoomResponse" json:"success,omitempty"`
+type SocialServiceGetUnreadMessageCountResult struct {
+	Success *GetUnreadMessageCountResponse `thrift:"success,0,optional" frugal:"0,optional,GetUnreadMessageCountResponse" json:"success,omitempty"`
 }
 
-func NewSocialServiceCreateChatRoomResult() *SocialServiceCreateChatRoomResult {
-	return &SocialServiceCreateChatRoomResult{}
+func NewSocialServiceGetUnreadMessageCountResult() *SocialServiceGetUnreadMessageCountResult {
+	return &SocialServiceGetUnreadMessageCountResult{}
 }
 
-func (p *SocialServiceCreateChatRoomResult) InitDefault() {
+func (p *SocialServiceGetUnreadMessageCountResult) InitDefault() {
 }
 
-var SocialServiceCreateChatRoomResult_Success_DEFAULT *CreateChatRoomResponse
+var SocialServiceGetUnreadMessageCountResult_Success_DEFAULT *GetUnreadMessageCountResponse
 
-func (p *SocialServiceCreateChatRoomResult) GetSuccess() (v *CreateChatRoomResponse) {
+func (p *SocialServiceGetUnreadMessageCountResult) GetSuccess() (v *GetUnreadMessageCountResponse) {
 	if !p.IsSetSuccess() {
-		return SocialServiceCreateChatRoomResult_Success_DEFAULT
+		return SocialServiceGetUnreadMessageCountResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *SocialServiceCreateChatRoomResult) SetSuccess(x interface{}) {
-	p.Success = x.(*CreateChatRoomResponse)
+func (p *SocialServiceGetUnreadMessageCountResult) SetSuccess(x interface{}) {
+	p.Success = x.(*GetUnreadMessageCountResponse)
 }
 
-func (p *SocialServiceCreateChatRoomResult) IsSetSuccess() bool {
+func (p *SocialServiceGetUnreadMessageCountResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *SocialServiceCreateChatRoomResult) String() string {
+func (p *SocialServiceGetUnreadMessageCountResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("SocialServiceCreateChatRoomResult(%+v)", *p)
+	return fmt.Sprintf("SocialServiceGetUnreadMessageCountResult(%+v)", *p)
 }
 
-var fieldIDToName_SocialServiceCreateChatRoomResult = map[int16]string{
+var fieldIDToName_SocialServiceGetUnreadMessageCountResult = map[int16]string{
 	0: "success",
 }
 
-type SocialServiceGetChatRoomArgs struct {
-	Req *GetChatRoomRequest `thrift:"req,1" frugal:"1,default,GetChatRoomRequest" json:"req"`
+type SocialServiceMarkConversationsReadArgs struct {
+	Req *MarkConversationsReadRequest `thrift:"req,1" frugal:"1,default,MarkConversationsReadRequest" json:"req"`
 }
 
-func NewSocialServiceGetChatRoomArgs() *SocialServiceGetChatRoomArgs {
-	return &SocialServiceGetChatRoomArgs{}
+func NewSocialServiceMarkConversationsReadArgs() *SocialServiceMarkConversationsReadArgs {
+	return &SocialServiceMarkConversationsReadArgs{}
 }
 
-func (p *SocialServiceGetChatRoomArgs) InitDefault() {
+func (p *SocialServiceMarkConversationsReadArgs) InitDefault() {
 }
 
-var SocialServiceGetChatRoomArgs_Req_DEFAULT *GetChatRoomRequest
+var SocialServiceMarkConversationsReadArgs_Req_DEFAULT *MarkConversationsReadRequest
 
-func (p *SocialServiceGetChatRoomArgs) GetReq() (v *GetChatRoomRequest) {
+func (p *SocialServiceMarkConversationsReadArgs) GetReq() (v *MarkConversationsReadRequest) {
 	if !p.IsSetReq() {
-		return SocialServiceGetChatRoomArgs_Req_DEFAULT
+		return SocialServiceMarkConversationsReadArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *SocialServiceGetChatRoomArgs) SetReq(val *GetChatRoomRequest) {
+func (p *SocialServiceMarkConversationsReadArgs) SetReq(val *MarkConversationsReadRequest) {
 	p.Req = val
 }
 
-func (p *SocialServiceGetChatRoomArgs) IsSetReq() bool {
+func (p *SocialServiceMarkConversationsReadArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *SocialServiceGetChatRoomArgs) String() string {
+func (p *SocialServiceMarkConversationsReadArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("SocialServiceGetChatRoomArgs(%+v)", *p)
+	return fmt.Sprintf("SocialServiceMarkConversationsReadArgs(%+v)", *p)
 }
 
-var fieldIDToName_SocialServiceGetChatRoomArgs = map[int16]string{
+var fieldIDToName_SocialServiceMarkConversationsReadArgs = map[int16]string{
 	1: "req",
 }
 
-type SocialServiceGetChatRoomResult struct {
-	Success *GetChatRoomResponse `thrift:"success,0,optional" frugal:"0,optional,GetChatRoomResponse" json:"success,omitempty"`
+type SocialServiceMarkConversationsReadResult struct {
+	Success *MarkConversationsReadResponse `thrift:"success,0,optional" frugal:"0,optional,MarkConversationsReadResponse" json:"success,omitempty"`
 }
 
-func NewSocialServiceGetChatRoomResult() *SocialServiceGetChatRoomResult {
-	return &SocialServiceGetChatRoomResult{}
+func NewSocialServiceMarkConversationsReadResult() *SocialServiceMarkConversationsReadResult {
+	return &SocialServiceMarkConversationsReadResult{}
 }
 
-func (p *SocialServiceGetChatRoomResult) InitDefault() {
+func (p *SocialServiceMarkConversationsReadResult) InitDefault() {
 }
 
-var SocialServiceGetChatRoomResult_Success_DEFAULT *GetChatRoomResponse
+var SocialServiceMarkConversationsReadResult_Success_DEFAULT *MarkConversationsReadResponse
 
-func (p *SocialServiceGetChatRoomResult) GetSuccess() (v *GetChatRoomResponse) {
+func (p *SocialServiceMarkConversationsReadResult) GetSuccess() (v *MarkConversationsReadResponse) {
 	if !p.IsSetSuccess() {
-		return SocialServiceGetChatRoomResult_Success_DEFAULT
+		return SocialServiceMarkConversationsReadResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *SocialServiceGetChatRoomResult) SetSuccess(x interface{}) {
-	p.Success = x.(*GetChatRoomResponse)
+func (p *SocialServiceMarkConversationsReadResult) SetSuccess(x interface{}) {
+	p.Success = x.(*MarkConversationsReadResponse)
 }
 
-func (p *SocialServiceGetChatRoomResult) IsSetSuccess() bool {
+func (p *SocialServiceMarkConversationsReadResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *SocialServiceGetChatRoomResult) String() string {
+func (p *SocialServiceMarkConversationsReadResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("SocialServiceGetChatRoomResult(%+v)", *p)
+	return fmt.Sprintf("SocialServiceMarkConversationsReadResult(%+v)", *p)
 }
 
-var fieldIDToName_SocialServiceGetChatRoomResult = map[int16]string{
+var fieldIDToName_SocialServiceMarkConversationsReadResult = map[int16]string{
 	0: "success",
 }
 
-type SocialServiceGetUserChatRoomsArgs struct {
-	Req *GetUserChatRoomsRequest `thrift:"req,1" frugal:"1,default,GetUserChatRoomsRequest" json:"req"`
+type SocialServiceGetUnreadCountsArgs struct {
+	Req *GetUnreadCountsRequest `thrift:"req,1" frugal:"1,default,GetUnreadCountsRequest" json:"req"`
 }
 
-func NewSocialServiceGetUserChatRoomsArgs() *SocialServiceGetUserChatRoomsArgs {
-	return &SocialServiceGetUserChatRoomsArgs{}
+func NewSocialServiceGetUnreadCountsArgs() *SocialServiceGetUnreadCountsArgs {
+	return &SocialServiceGetUnreadCountsArgs{}
 }
 
-func (p *SocialServiceGetUserChatRoomsArgs) InitDefault() {
+func (p *SocialServiceGetUnreadCountsArgs) InitDefault() {
 }
 
-var SocialServiceGetUserChatRoomsArgs_Req_DEFAULT *GetUserChatRoomsRequest
+var SocialServiceGetUnreadCountsArgs_Req_DEFAULT *GetUnreadCountsRequest
 
-func (p *SocialServiceGetUserChatRoomsArgs) GetReq() (v *GetUserChatRoomsRequest) {
+func (p *SocialServiceGetUnreadCountsArgs) GetReq() (v *GetUnreadCountsRequest) {
 	if !p.IsSetReq() {
-		return SocialServiceGetUserChatRoomsArgs_Req_DEFAULT
+		return SocialServiceGetUnreadCountsArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *SocialServiceGetUserChatRoomsArgs) SetReq(val *GetUserChatRoomsRequest) {
+func (p *SocialServiceGetUnreadCountsArgs) SetReq(val *GetUnreadCountsRequest) {
 	p.Req = val
 }
 
-func (p *SocialServiceGetUserChatRoomsArgs) IsSetReq() bool {
+func (p *SocialServiceGetUnreadCountsArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *SocialServiceGetUserChatRoomsArgs) String() string {
+func (p *SocialServiceGetUnreadCountsArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("SocialServiceGetUserChatRoomsArgs(%+v)", *p)
+	return fmt.Sprintf("SocialServiceGetUnreadCountsArgs(%+v)", *p)
 }
 
-var fieldIDToName_SocialServiceGetUserChatRoomsArgs = map[int16]string{
+var fieldIDToName_SocialServiceGetUnreadCountsArgs = map[int16]string{
 	1: "req",
 }
 
-type SocialServiceGetUserChatRoomsResult struct {
-	Success *GetUserChatRoomsResponse `thrift:"success,0,optional" frugal:"0,optional,GetUserChatRoomsResponse" json:"success,omitempty"`
+type SocialServiceGetUnreadCountsResult struct {
+	Success *GetUnreadCountsResponse `thrift:"success,0,optional" frugal:"0,optional,GetUnreadCountsResponse" json:"success,omitempty"`
 }
 
-func NewSocialServiceGetUserChatRoomsResult() *SocialServiceGetUserChatRoomsResult {
-	return &SocialServiceGetUserChatRoomsResult{}
+func NewSocialServiceGetUnreadCountsResult() *SocialServiceGetUnreadCountsResult {
+	return &SocialServiceGetUnreadCountsResult{}
 }
 
-func (p *SocialServiceGetUserChatRoomsResult) InitDefault() {
+func (p *SocialServiceGetUnreadCountsResult) InitDefault() {
 }
 
-var SocialServiceGetUserChatRoomsResult_Success_DEFAULT *GetUserChatRoomsResponse
+var SocialServiceGetUnreadCountsResult_Success_DEFAULT *GetUnreadCountsResponse
 
-func (p *SocialServiceGetUserChatRoomsResult) GetSuccess() (v *GetUserChatRoomsResponse) {
+func (p *SocialServiceGetUnreadCountsResult) GetSuccess() (v *GetUnreadCountsResponse) {
 	if !p.IsSetSuccess() {
-		return SocialServiceGetUserChatRoomsResult_Success_DEFAULT
+		return SocialServiceGetUnreadCountsResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *SocialServiceGetUserChatRoomsResult) SetSuccess(x interface{}) {
-	p.Success = x.(*GetUserChatRoomsResponse)
+func (p *SocialServiceGetUnreadCountsResult) SetSuccess(x interface{}) {
+	p.Success = x.(*GetUnreadCountsResponse)
 }
 
-func (p *SocialServiceGetUserChatRoomsResult) IsSetSuccess() bool {
+func (p *SocialServiceGetUnreadCountsResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *SocialServiceGetUserChatRoomsResult) String() string {
+func (p *SocialServiceGetUnreadCountsResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("SocialServiceGetUserChatRoomsResult(%+v)", *p)
+	return fmt.Sprintf("SocialServiceGetUnreadCountsResult(%+v)", *p)
 }
 
-var fieldIDToName_SocialServiceGetUserChatRoomsResult = map[int16]string{
+var fieldIDToName_SocialServiceGetUnreadCountsResult = map[int16]string{
 	0: "success",
 }
 
-type SocialServiceSendChatMessageArgs struct {
-	Req *SendChatMessageRequest `thrift:"req,1" frugal:"1,default,SendChatMessageRequest" json:"req"`
+type SocialServiceGetInboxArgs struct {
+	Req *GetInboxRequest `thrift:"req,1" frugal:"1,default,GetInboxRequest" json:"req"`
 }
 
-func NewSocialServiceSendChatMessageArgs() *SocialServiceSendChatMessageArgs {
-	return &SocialServiceSendChatMessageArgs{}
+func NewSocialServiceGetInboxArgs() *SocialServiceGetInboxArgs {
+	return &SocialServiceGetInboxArgs{}
 }
 
-func (p *SocialServiceSendChatMessageArgs) InitDefault() {
+func (p *SocialServiceGetInboxArgs) InitDefault() {
 }
 
-var SocialServiceSendChatMessageArgs_Req_DEFAULT *SendChatMessageRequest
+var SocialServiceGetInboxArgs_Req_DEFAULT *GetInboxRequest
 
-func (p *SocialServiceSendChatMessageArgs) GetReq() (v *SendChatMessageRequest) {
+func (p *SocialServiceGetInboxArgs) GetReq() (v *GetInboxRequest) {
 	if !p.IsSetReq() {
-		return SocialServiceSendChatMessageArgs_Req_DEFAULT
+		return SocialServiceGetInboxArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *SocialServiceSendChatMessageArgs) SetReq(val *SendChatMessageRequest) {
+func (p *SocialServiceGetInboxArgs) SetReq(val *GetInboxRequest) {
 	p.Req = val
 }
 
-func (p *SocialServiceSendChatMessageArgs) IsSetReq() bool {
+func (p *SocialServiceGetInboxArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *SocialServiceSendChatMessageArgs) String() string {
+func (p *SocialServiceGetInboxArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("SocialServiceSendChatMessageArgs(%+v)", *p)
+	return fmt.Sprintf("SocialServiceGetInboxArgs(%+v)", *p)
 }
 
-var fieldIDToName_SocialServiceSendChatMessageArgs = map[int16]string{
+var fieldIDToName_SocialServiceGetInboxArgs = map[int16]string{
 	1: "req",
 }
 
-type SocialServiceSendChatMessageResult struct {
-	Success *SendChatMessageResponse `thrift:"success,0,optional" frugal:"0,optional,SendChatMessageResponse" json:"success,omitempty"`
+type SocialServiceGetInboxResult struct {
+	Success *GetInboxResponse `thrift:"success,0,optional" frugal:"0,optional,GetInboxResponse" json:"success,omitempty"`
+}
+
+func NewSocialServiceGetInboxResult() *SocialServiceGetInboxResult {
+	return &SocialServiceGetInboxResult{}
+}
+
+func (p *SocialServiceGetInboxResult) InitDefault() {
+}
+
+var SocialServiceGetInboxResult_Success_DEFAULT *GetInboxResponse
+
+func (p *SocialServiceGetInboxResult) GetSuccess() (v *GetInboxResponse) {
+	if !p.IsSetSuccess() {
+		return SocialServiceGetInboxResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *SocialServiceGetInboxResult) SetSuccess(x interface{}) {
+	p.Success = x.(*GetInboxResponse)
+}
+
+func (p *SocialServiceGetInboxResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *SocialServiceGetInboxResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SocialServiceGetInboxResult(%+v)", *p)
+}
+
+var fieldIDToName_SocialServiceGetInboxResult = map[int16]string{
+	0: "success",
+}
+
+type SocialServiceUpdateConversationArgs struct {
+	Req *UpdateConversationRequest `thrift:"req,1" frugal:"1,default,UpdateConversationRequest" json:"req"`
+}
+
+func NewSocialServiceUpdateConversationArgs() *SocialServiceUpdateConversationArgs {
+	return &SocialServiceUpdateConversationArgs{}
+}
+
+func (p *SocialServiceUpdateConversationArgs) InitDefault() {
+}
+
+var SocialServiceUpdateConversationArgs_Req_DEFAULT *UpdateConversationRequest
+
+func (p *SocialServiceUpdateConversationArgs) GetReq() (v *UpdateConversationRequest) {
+	if !p.IsSetReq() {
+		return SocialServiceUpdateConversationArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *SocialServiceUpdateConversationArgs) SetReq(val *UpdateConversationRequest) {
+	p.Req = val
+}
+
+func (p *SocialServiceUpdateConversationArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *SocialServiceUpdateConversationArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SocialServiceUpdateConversationArgs(%+v)", *p)
+}
+
+var fieldIDToName_SocialServiceUpdateConversationArgs = map[int16]string{
+	1: "req",
+}
+
+type SocialServiceUpdateConversationResult struct {
+	Success *UpdateConversationResponse `thrift:"success,0,optional" frugal:"0,optional,UpdateConversationResponse" json:"success,omitempty"`
+}
+
+func NewSocialServiceUpdateConversationResult() *SocialServiceUpdateConversationResult {
+	return &SocialServiceUpdateConversationResult{}
+}
+
+func (p *SocialServiceUpdateConversationResult) InitDefault() {
+}
+
+var SocialServiceUpdateConversationResult_Success_DEFAULT *UpdateConversationResponse
+
+func (p *SocialServiceUpdateConversationResult) GetSuccess() (v *UpdateConversationResponse) {
+	if !p.IsSetSuccess() {
+		return SocialServiceUpdateConversationResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *SocialServiceUpdateConversationResult) SetSuccess(x interface{}) {
+	p.Success = x.(*UpdateConversationResponse)
+}
+
+func (p *SocialServiceUpdateConversationResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *SocialServiceUpdateConversationResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SocialServiceUpdateConversationResult(%+v)", *p)
+}
+
+var fieldIDToName_SocialServiceUpdateConversationResult = map[int16]string{
+	0: "success",
+}
+
+type SocialServiceSyncMessagesArgs struct {
+	Req *SyncMessagesRequest `thrift:"req,1" frugal:"1,default,SyncMessagesRequest" json:"req"`
+}
+
+func NewSocialServiceSyncMessagesArgs() *SocialServiceSyncMessagesArgs {
+	return &SocialServiceSyncMessagesArgs{}
+}
+
+func (p *SocialServiceSyncMessagesArgs) InitDefault() {
+}
+
+var SocialServiceSyncMessagesArgs_Req_DEFAULT *SyncMessagesRequest
+
+func (p *SocialServiceSyncMessagesArgs) GetReq() (v *SyncMessagesRequest) {
+	if !p.IsSetReq() {
+		return SocialServiceSyncMessagesArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *SocialServiceSyncMessagesArgs) SetReq(val *SyncMessagesRequest) {
+	p.Req = val
+}
+
+func (p *SocialServiceSyncMessagesArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *SocialServiceSyncMessagesArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SocialServiceSyncMessagesArgs(%+v)", *p)
+}
+
+var fieldIDToName_SocialServiceSyncMessagesArgs = map[int16]string{
+	1: "req",
+}
+
+type SocialServiceSyncMessagesResult struct {
+	Success *SyncMessagesResponse `thrift:"success,0,optional" frugal:"0,optional,SyncMessagesResponse" json:"success,omitempty"`
 }
 
-func NewSocialServiceSendChatMessageResult() *SocialServiceSendChatMessageResult {
-	return &SocialServiceSendChatMessageResult{}
+func NewSocialServiceSyncMessagesResult() *SocialServiceSyncMessagesResult {
+	return &SocialServiceSyncMessagesResult{}
 }
 
-func (p *SocialServiceSendChatMessageResult) InitDefault() {
+func (p *SocialServiceSyncMessagesResult) InitDefault() {
 }
 
-var SocialServiceSendChatMessageResult_Success_DEFAULT *SendChatMessageResponse
+var SocialServiceSyncMessagesResult_Success_DEFAULT *SyncMessagesResponse
 
-func (p *SocialServiceSendChatMessageResult) GetSuccess() (v *SendChatMessageResponse) {
+func (p *SocialServiceSyncMessagesResult) GetSuccess() (v *SyncMessagesResponse) {
 	if !p.IsSetSuccess() {
-		return SocialServiceSendChatMessageResult_Success_DEFAULT
+		return SocialServiceSyncMessagesResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *SocialServiceSendChatMessageResult) SetSuccess(x interface{}) {
-	p.Success = x.(*SendChatMessageResponse)
+func (p *SocialServiceSyncMessagesResult) SetSuccess(x interface{}) {
+	p.Success = x.(*SyncMessagesResponse)
 }
 
-func (p *SocialServiceSendChatMessageResult) IsSetSuccess() bool {
+func (p *SocialServiceSyncMessagesResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *SocialServiceSendChatMessageResult) String() string {
+func (p *SocialServiceSyncMessagesResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("SocialServiceSendChatMessageResult(%+v)", *p)
+	return fmt.Sprintf("SocialServiceSyncMessagesResult(%+v)", *p)
 }
 
-var fieldIDToName_SocialServiceSendChatMessageResult = map[int16]string{
+var fieldIDToName_SocialServiceSyncMessagesResult = map[int16]string{
 	0: "success",
 }
 
-type SocialServiceGetChatMessagesArgs struct {
-	Req *GetChatMessagesRequest `thrift:"req,1" frugal:"1,default,GetChatMessagesRequest" json:"req"`
+type SocialServiceGetNotificationsArgs struct {
+	Req *GetNotificationsRequest `thrift:"req,1" frugal:"1,default,GetNotificationsRequest" json:"req"`
 }
 
-func NewSocialServiceGetChatMessagesArgs() *SocialServiceGetChatMessagesArgs {
-	return &SocialServiceGetChatMessagesArgs{}
+func NewSocialServiceGetNotificationsArgs() *SocialServiceGetNotificationsArgs {
+	return &SocialServiceGetNotificationsArgs{}
 }
 
-func (p *SocialServiceGetChatMessagesArgs) InitDefault() {
+func (p *SocialServiceGetNotificationsArgs) InitDefault() {
 }
 
-var SocialServiceGetChatMessagesArgs_Req_DEFAULT *GetChatMessagesRequest
+var SocialServiceGetNotificationsArgs_Req_DEFAULT *GetNotificationsRequest
 
-func (p *SocialServiceGetChatMessagesArgs) GetReq() (v *GetChatMessagesRequest) {
+func (p *SocialServiceGetNotificationsArgs) GetReq() (v *GetNotificationsRequest) {
 	if !p.IsSetReq() {
-		return SocialServiceGetChatMessagesArgs_Req_DEFAULT
+		return SocialServiceGetNotificationsArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *SocialServiceGetChatMessagesArgs) SetReq(val *GetChatMessagesRequest) {
+func (p *SocialServiceGetNotificationsArgs) SetReq(val *GetNotificationsRequest) {
 	p.Req = val
 }
 
-func (p *SocialServiceGetChatMessagesArgs) IsSetReq() bool {
+func (p *SocialServiceGetNotificationsArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *SocialServiceGetChatMessagesArgs) String() string {
+func (p *SocialServiceGetNotificationsArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("SocialServiceGetChatMessagesArgs(%+v)", *p)
+	return fmt.Sprintf("SocialServiceGetNotificationsArgs(%+v)", *p)
 }
 
-var fieldIDToName_SocialServiceGetChatMessagesArgs = map[int16]string{
+var fieldIDToName_SocialServiceGetNotificationsArgs = map[int16]string{
 	1: "req",
 }
 
-type SocialServiceGetChatMessagesResult struct {
-	Success *GetChatMessagesResponse `thrift:"success,0,optional" frugal:"0,optional,GetChatMessagesResponse" json:"success,omitempty"`
+type SocialServiceGetNotificationsResult struct {
+	Success *GetNotificationsResponse `thrift:"success,0,optional" frugal:"0,optional,GetNotificationsResponse" json:"success,omitempty"`
 }
 
-func NewSocialServiceGetChatMessagesResult() *SocialServiceGetChatMessagesResult {
-	return &SocialServiceGetChatMessagesResult{}
+func NewSocialServiceGetNotificationsResult() *SocialServiceGetNotificationsResult {
+	return &SocialServiceGetNotificationsResult{}
 }
 
-func (p *SocialServiceGetChatMessagesResult) InitDefault() {
+func (p *SocialServiceGetNotificationsResult) InitDefault() {
 }
 
-var SocialServiceGetChatMessagesResult_Success_DEFAULT *GetChatMessagesResponse
+var SocialServiceGetNotificationsResult_Success_DEFAULT *GetNotificationsResponse
 
-func (p *SocialServiceGetChatMessagesResult) GetSuccess() (v *GetChatMessagesResponse) {
+func (p *SocialServiceGetNotificationsResult) GetSuccess() (v *GetNotificationsResponse) {
 	if !p.IsSetSuccess() {
-		return SocialServiceGetChatMessagesResult_Success_DEFAULT
+		return SocialServiceGetNotificationsResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *SocialServiceGetChatMessagesResult) SetSuccess(x interface{}) {
-	p.Success = x.(*GetChatMessagesResponse)
+func (p *SocialServiceGetNotificationsResult) SetSuccess(x interface{}) {
+	p.Success = x.(*GetNotificationsResponse)
 }
 
-func (p *SocialServiceGetChatMessagesResult) IsSetSuccess() bool {
+func (p *SocialServiceGetNotificationsResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *SocialServiceGetChatMessagesResult) String() string {
+func (p *SocialServiceGetNotificationsResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("SocialServiceGetChatMessagesResult(%+v)", *p)
+	return fmt.Sprintf("SocialServiceGetNotificationsResult(%+v)", *p)
 }
 
-var fieldIDToName_SocialServiceGetChatMessagesResult = map[int16]string{
+var fieldIDToName_SocialServiceGetNotificationsResult = map[int16]string{
 	0: "success",
 }
 
-type SocialServiceAddFriendArgs struct {
-	Req *AddFriendRequest `thrift:"req,1" frugal:"1,default,AddFriendRequest" json:"req"`
+type SocialServiceGetNotificationUnreadCountArgs struct {
+	Req *GetNotificationUnreadCountRequest `thrift:"req,1" frugal:"1,default,GetNotificationUnreadCountRequest" json:"req"`
 }
 
-func NewSocialServiceAddFriendArgs() *SocialServiceAddFriendArgs {
-	return &SocialServiceAddFriendArgs{}
+func NewSocialServiceGetNotificationUnreadCountArgs() *SocialServiceGetNotificationUnreadCountArgs {
+	return &SocialServiceGetNotificationUnreadCountArgs{}
 }
 
-func (p *SocialServiceAddFriendArgs) InitDefault() {
+func (p *SocialServiceGetNotificationUnreadCountArgs) InitDefault() {
 }
 
-var SocialServiceAddFriendArgs_Req_DEFAULT *AddFriendRequest
+var SocialServiceGetNotificationUnreadCountArgs_Req_DEFAULT *GetNotificationUnreadCountRequest
 
-func (p *SocialServiceAddFriendArgs) GetReq() (v *AddFriendRequest) {
+func (p *SocialServiceGetNotificationUnreadCountArgs) GetReq() (v *GetNotificationUnreadCountRequest) {
 	if !p.IsSetReq() {
-		return SocialServiceAddFriendArgs_Req_DEFAULT
+		return SocialServiceGetNotificationUnreadCountArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *SocialServiceAddFriendArgs) SetReq(val *AddFriendRequest) {
+func (p *SocialServiceGetNotificationUnreadCountArgs) SetReq(val *GetNotificationUnreadCountRequest) {
 	p.Req = val
 }
 
-func (p *SocialServiceAddFriendArgs) IsSetReq() bool {
+func (p *SocialServiceGetNotificationUnreadCountArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *SocialServiceAddFriendArgs) String() string {
+func (p *SocialServiceGetNotificationUnreadCountArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("SocialServiceAddFriendArgs(%+v)", *p)
+	return fmt.Sprintf("SocialServiceGetNotificationUnreadCountArgs(%+v)", *p)
 }
 
-var fieldIDToName_SocialServiceAddFriendArgs = map[int16]string{
+var fieldIDToName_SocialServiceGetNotificationUnreadCountArgs = map[int16]string{
 	1: "req",
 }
 
-type SocialServiceAddFriendResult struct {
-	Success *AddFriendResponse `thrift:"success,0,optional" frugal:"0,optional,AddFriendResponse" json:"success,omitempty"`
+type SocialServiceGetNotificationUnreadCountResult struct {
+	Success *GetNotificationUnreadCountResponse `thrift:"success,0,optional" frugal:"0,optional,GetNotificationUnreadCountResponse" json:"success,omitempty"`
 }
 
-func NewSocialServiceAddFriendResult() *SocialServiceAddFriendResult {
-	return &SocialServiceAddFriendResult{}
+func NewSocialServiceGetNotificationUnreadCountResult() *SocialServiceGetNotificationUnreadCountResult {
+	return &SocialServiceGetNotificationUnreadCountResult{}
 }
 
-func (p *SocialServiceAddFriendResult) InitDefault() {
+func (p *SocialServiceGetNotificationUnreadCountResult) InitDefault() {
 }
 
-var SocialServiceAddFriendResult_Success_DEFAULT *AddFriendResponse
+var SocialServiceGetNotificationUnreadCountResult_Success_DEFAULT *GetNotificationUnreadCountResponse
 
-func (p *SocialServiceAddFriendResult) GetSuccess() (v *AddFriendResponse) {
+func (p *SocialServiceGetNotificationUnreadCountResult) GetSuccess() (v *GetNotificationUnreadCountResponse) {
 	if !p.IsSetSuccess() {
-		return SocialServiceAddFriendResult_Success_DEFAULT
+		return SocialServiceGetNotificationUnreadCountResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *SocialServiceAddFriendResult) SetSuccess(x interface{}) {
-	p.Success = x.(*AddFriendResponse)
+func (p *SocialServiceGetNotificationUnreadCountResult) SetSuccess(x interface{}) {
+	p.Success = x.(*GetNotificationUnreadCountResponse)
 }
 
-func (p *SocialServiceAddFriendResult) IsSetSuccess() bool {
+func (p *SocialServiceGetNotificationUnreadCountResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *SocialServiceAddFriendResult) String() string {
+func (p *SocialServiceGetNotificationUnreadCountResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("SocialServiceAddFriendResult(%+v)", *p)
+	return fmt.Sprintf("SocialServiceGetNotificationUnreadCountResult(%+v)", *p)
 }
 
-var fieldIDToName_SocialServiceAddFriendResult = map[int16]string{
+var fieldIDToName_SocialServiceGetNotificationUnreadCountResult = map[int16]string{
 	0: "success",
 }
 
-type SocialServiceGetFriendshipArgs struct {
-	Req *GetFriendshipRequest `thrift:"req,1" frugal:"1,default,GetFriendshipRequest" json:"req"`
+type SocialServiceMarkNotificationsReadArgs struct {
+	Req *MarkNotificationsReadRequest `thrift:"req,1" frugal:"1,default,MarkNotificationsReadRequest" json:"req"`
 }
 
-func NewSocialServiceGetFriendshipArgs() *SocialServiceGetFriendshipArgs {
-	return &SocialServiceGetFriendshipArgs{}
+func NewSocialServiceMarkNotificationsReadArgs() *SocialServiceMarkNotificationsReadArgs {
+	return &SocialServiceMarkNotificationsReadArgs{}
 }
 
-func (p *SocialServiceGetFriendshipArgs) InitDefault() {
+func (p *SocialServiceMarkNotificationsReadArgs) InitDefault() {
 }
 
-var SocialServiceGetFriendshipArgs_Req_DEFAULT *GetFriendshipRequest
+var SocialServiceMarkNotificationsReadArgs_Req_DEFAULT *MarkNotificationsReadRequest
 
-func (p *SocialServiceGetFriendshipArgs) GetReq() (v *GetFriendshipRequest) {
+func (p *SocialServiceMarkNotificationsReadArgs) GetReq() (v *MarkNotificationsReadRequest) {
 	if !p.IsSetReq() {
-		return SocialServiceGetFriendshipArgs_Req_DEFAULT
+		return SocialServiceMarkNotificationsReadArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *SocialServiceGetFriendshipArgs) SetReq(val *GetFriendshipRequest) {
+func (p *SocialServiceMarkNotificationsReadArgs) SetReq(val *MarkNotificationsReadRequest) {
 	p.Req = val
 }
 
-func (p *SocialServiceGetFriendshipArgs) IsSetReq() bool {
+func (p *SocialServiceMarkNotificationsReadArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *SocialServiceGetFriendshipArgs) String() string {
+func (p *SocialServiceMarkNotificationsReadArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("SocialServiceGetFriendshipArgs(%+v)", *p)
+	return fmt.Sprintf("SocialServiceMarkNotificationsReadArgs(%+v)", *p)
 }
 
-var fieldIDToName_SocialServiceGetFriendshipArgs = map[int16]string{
+var fieldIDToName_SocialServiceMarkNotificationsReadArgs = map[int16]string{
 	1: "req",
 }
 
-type SocialServiceGetFriendshipResult struct {
-	Success *GetFriendshipResponse `thrift:"success,0,optional" frugal:"0,optional,GetFriendshipResponse" json:"success,omitempty"`
+type SocialServiceMarkNotificationsReadResult struct {
+	Success *MarkNotificationsReadResponse `thrift:"success,0,optional" frugal:"0,optional,MarkNotificationsReadResponse" json:"success,omitempty"`
 }
 
-func NewSocialServiceGetFriendshipResult() *SocialServiceGetFriendshipResult {
-	return &SocialServiceGetFriendshipResult{}
+func NewSocialServiceMarkNotificationsReadResult() *SocialServiceMarkNotificationsReadResult {
+	return &SocialServiceMarkNotificationsReadResult{}
 }
 
-func (p *SocialServiceGetFriendshipResult) InitDefault() {
+func (p *SocialServiceMarkNotificationsReadResult) InitDefault() {
 }
 
-var SocialServiceGetFriendshipResult_Success_DEFAULT *GetFriendshipResponse
+var SocialServiceMarkNotificationsReadResult_Success_DEFAULT *MarkNotificationsReadResponse
 
-func (p *SocialServiceGetFriendshipResult) GetSuccess() (v *GetFriendshipResponse) {
+func (p *SocialServiceMarkNotificationsReadResult) GetSuccess() (v *MarkNotificationsReadResponse) {
 	if !p.IsSetSuccess() {
-		return SocialServiceGetFriendshipResult_Success_DEFAULT
+		return SocialServiceMarkNotificationsReadResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *SocialServiceGetFriendshipResult) SetSuccess(x interface{}) {
-	p.Success = x.(*GetFriendshipResponse)
+func (p *SocialServiceMarkNotificationsReadResult) SetSuccess(x interface{}) {
+	p.Success = x.(*MarkNotificationsReadResponse)
 }
 
-func (p *SocialServiceGetFriendshipResult) IsSetSuccess() bool {
+func (p *SocialServiceMarkNotificationsReadResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *SocialServiceGetFriendshipResult) String() string {
+func (p *SocialServiceMarkNotificationsReadResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("SocialServiceGetFriendshipResult(%+v)", *p)
+	return fmt.Sprintf("SocialServiceMarkNotificationsReadResult(%+v)", *p)
 }
 
-var fieldIDToName_SocialServiceGetFriendshipResult = map[int16]string{
+var fieldIDToName_SocialServiceMarkNotificationsReadResult = map[int16]string{
 	0: "success",
 }
 
-type SocialServiceGetUserFriendsArgs struct {
-	Req *GetUserFriendsRequest `thrift:"req,1" frugal:"1,default,GetUserFriendsRequest" json:"req"`
+type SocialServiceGetNotificationPreferencesArgs struct {
+	Req *GetNotificationPreferencesRequest `thrift:"req,1" frugal:"1,default,GetNotificationPreferencesRequest" json:"req"`
 }
 
-func NewSocialServiceGetUserFriendsArgs() *SocialServiceGetUserFriendsArgs {
-	return &SocialServiceGetUserFriendsArgs{}
+func NewSocialServiceGetNotificationPreferencesArgs() *SocialServiceGetNotificationPreferencesArgs {
+	return &SocialServiceGetNotificationPreferencesArgs{}
 }
 
-func (p *SocialServiceGetUserFriendsArgs) InitDefault() {
+func (p *SocialServiceGetNotificationPreferencesArgs) InitDefault() {
 }
 
-var SocialServiceGetUserFriendsArgs_Req_DEFAULT *GetUserFriendsRequest
+var SocialServiceGetNotificationPreferencesArgs_Req_DEFAULT *GetNotificationPreferencesRequest
 
-func (p *SocialServiceGetUserFriendsArgs) GetReq() (v *GetUserFriendsRequest) {
+func (p *SocialServiceGetNotificationPreferencesArgs) GetReq() (v *GetNotificationPreferencesRequest) {
 	if !p.IsSetReq() {
-		return SocialServiceGetUserFriendsArgs_Req_DEFAULT
+		return SocialServiceGetNotificationPreferencesArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *SocialServiceGetUserFriendsArgs) SetReq(val *GetUserFriendsRequest) {
+func (p *SocialServiceGetNotificationPreferencesArgs) SetReq(val *GetNotificationPreferencesRequest) {
 	p.Req = val
 }
 
-func (p *SocialServiceGetUserFriendsArgs) IsSetReq() bool {
+func (p *SocialServiceGetNotificationPreferencesArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *SocialServiceGetUserFriendsArgs) String() string {
+func (p *SocialServiceGetNotificationPreferencesArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("SocialServiceGetUserFriendsArgs(%+v)", *p)
+	return fmt.Sprintf("SocialServiceGetNotificationPreferencesArgs(%+v)", *p)
 }
 
-var fieldIDToName_SocialServiceGetUserFriendsArgs = map[int16]string{
+var fieldIDToName_SocialServiceGetNotificationPreferencesArgs = map[int16]string{
 	1: "req",
 }
 
-type SocialServiceGetUserFriendsResult struct {
-	Success *GetUserFriendsResponse `thrift:"success,0,optional" frugal:"0,optional,GetUserFriendsResponse" json:"success,omitempty"`
+type SocialServiceGetNotificationPreferencesResult struct {
+	Success *GetNotificationPreferencesResponse `thrift:"success,0,optional" frugal:"0,optional,GetNotificationPreferencesResponse" json:"success,omitempty"`
 }
 
-func NewSocialServiceGetUserFriendsResult() *SocialServiceGetUserFriendsResult {
-	return &SocialServiceGetUserFriendsResult{}
+func NewSocialServiceGetNotificationPreferencesResult() *SocialServiceGetNotificationPreferencesResult {
+	return &SocialServiceGetNotificationPreferencesResult{}
 }
 
-func (p *SocialServiceGetUserFriendsResult) InitDefault() {
+func (p *SocialServiceGetNotificationPreferencesResult) InitDefault() {
 }
 
-var SocialServiceGetUserFriendsResult_Success_DEFAULT *GetUserFriendsResponse
+var SocialServiceGetNotificationPreferencesResult_Success_DEFAULT *GetNotificationPreferencesResponse
 
-func (p *SocialServiceGetUserFriendsResult) GetSuccess() (v *GetUserFriendsResponse) {
+func (p *SocialServiceGetNotificationPreferencesResult) GetSuccess() (v *GetNotificationPreferencesResponse) {
 	if !p.IsSetSuccess() {
-		return SocialServiceGetUserFriendsResult_Success_DEFAULT
+		return SocialServiceGetNotificationPreferencesResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *SocialServiceGetUserFriendsResult) SetSuccess(x interface{}) {
-	p.Success = x.(*GetUserFriendsResponse)
+func (p *SocialServiceGetNotificationPreferencesResult) SetSuccess(x interface{}) {
+	p.Success = x.(*GetNotificationPreferencesResponse)
 }
 
-func (p *SocialServiceGetUserFriendsResult) IsSetSuccess() bool {
+func (p *SocialServiceGetNotificationPreferencesResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *SocialServiceGetUserFriendsResult) String() string {
+func (p *SocialServiceGetNotificationPreferencesResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("SocialServiceGetUserFriendsResult(%+v)", *p)
+	return fmt.Sprintf("SocialServiceGetNotificationPreferencesResult(%+v)", *p)
 }
 
-var fieldIDToName_SocialServiceGetUserFriendsResult = map[int16]string{
+var fieldIDToName_SocialServiceGetNotificationPreferencesResult = map[int16]string{
 	0: "success",
 }
 
-type SocialServiceCreateFriendRequestArgs struct {
-	Req *CreateFriendRequestRequest `thrift:"req,1" frugal:"1,default,CreateFriendRequestRequest" json:"req"`
+type SocialServiceUpdateNotificationPreferencesArgs struct {
+	Req *UpdateNotificationPreferencesRequest `thrift:"req,1" frugal:"1,default,UpdateNotificationPreferencesRequest" json:"req"`
 }
 
-func NewSocialServiceCreateFriendRequestArgs() *SocialServiceCreateFriendRequestArgs {
-	return &SocialServiceCreateFriendRequestArgs{}
+func NewSocialServiceUpdateNotificationPreferencesArgs() *SocialServiceUpdateNotificationPreferencesArgs {
+	return &SocialServiceUpdateNotificationPreferencesArgs{}
 }
 
-func (p *SocialServiceCreateFriendRequestArgs) InitDefault() {
+func (p *SocialServiceUpdateNotificationPreferencesArgs) InitDefault() {
 }
 
-var SocialServiceCreateFriendRequestArgs_Req_DEFAULT *CreateFriendRequestRequest
+var SocialServiceUpdateNotificationPreferencesArgs_Req_DEFAULT *UpdateNotificationPreferencesRequest
 
-func (p *SocialServiceCreateFriendRequestArgs) GetReq() (v *CreateFriendRequestRequest) {
+func (p *SocialServiceUpdateNotificationPreferencesArgs) GetReq() (v *UpdateNotificationPreferencesRequest) {
 	if !p.IsSetReq() {
-		return SocialServiceCreateFriendRequestArgs_Req_DEFAULT
+		return SocialServiceUpdateNotificationPreferencesArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *SocialServiceCreateFriendRequestArgs) SetReq(val *CreateFriendRequestRequest) {
+func (p *SocialServiceUpdateNotificationPreferencesArgs) SetReq(val *UpdateNotificationPreferencesRequest) {
 	p.Req = val
 }
 
-func (p *SocialServiceCreateFriendRequestArgs) IsSetReq() bool {
+func (p *SocialServiceUpdateNotificationPreferencesArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *SocialServiceCreateFriendRequestArgs) String() string {
+func (p *SocialServiceUpdateNotificationPreferencesArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("SocialServiceCreateFriendRequestArgs(%+v)", *p)
+	return fmt.Sprintf("SocialServiceUpdateNotificationPreferencesArgs(%+v)", *p)
 }
 
-var fieldIDToName_SocialServiceCreateFriendRequestArgs = map[int16]string{
+var fieldIDToName_SocialServiceUpdateNotificationPreferencesArgs = map[int16]string{
 	1: "req",
 }
 
-type SocialServiceCreateFriendRequestResult struct {
-	Success *CreateFriendRequestResponse `thrift:"success,0,optional" frugal:"0,optional,CreateFriendRequestResponse" json:"success,omitempty"`
+type SocialServiceUpdateNotificationPreferencesResult struct {
+	Success *UpdateNotificationPreferencesResponse `thrift:"success,0,optional" frugal:"0,optional,UpdateNotificationPreferencesResponse" json:"success,omitempty"`
 }
 
-func NewSocialServiceCreateFriendRequestResult() *SocialServiceCreateFriendRequestResult {
-	return &SocialServiceCreateFriendRequestResult{}
+func NewSocialServiceUpdateNotificationPreferencesResult() *SocialServiceUpdateNotificationPreferencesResult {
+	return &SocialServiceUpdateNotificationPreferencesResult{}
 }
 
-func (p *SocialServiceCreateFriendRequestResult) InitDefault() {
+func (p *SocialServiceUpdateNotificationPreferencesResult) InitDefault() {
 }
 
-var SocialServiceCreateFriendRequestResult_Success_DEFAULT *CreateFriendRequestResponse
+var SocialServiceUpdateNotificationPreferencesResult_Success_DEFAULT *UpdateNotificationPreferencesResponse
 
-func (p *SocialServiceCreateFriendRequestResult) GetSuccess() (v *CreateFriendRequestResponse) {
+func (p *SocialServiceUpdateNotificationPreferencesResult) GetSuccess() (v *UpdateNotificationPreferencesResponse) {
 	if !p.IsSetSuccess() {
-		return SocialServiceCreateFriendRequestResult_Success_DEFAULT
+		return SocialServiceUpdateNotificationPreferencesResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *SocialServiceCreateFriendRequestResult) SetSuccess(x interface{}) {
-	p.Success = x.(*CreateFriendRequestResponse)
+func (p *SocialServiceUpdateNotificationPreferencesResult) SetSuccess(x interface{}) {
+	p.Success = x.(*UpdateNotificationPreferencesResponse)
 }
 
-func (p *SocialServiceCreateFriendRequestResult) IsSetSuccess() bool {
+func (p *SocialServiceUpdateNotificationPreferencesResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *SocialServiceCreateFriendRequestResult) String() string {
+func (p *SocialServiceUpdateNotificationPreferencesResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("SocialServiceCreateFriendRequestResult(%+v)", *p)
+	return fmt.Sprintf("SocialServiceUpdateNotificationPreferencesResult(%+v)", *p)
 }
 
-var fieldIDToName_SocialServiceCreateFriendRequestResult = map[int16]string{
+var fieldIDToName_SocialServiceUpdateNotificationPreferencesResult = map[int16]string{
 	0: "success",
 }
 
-type SocialServiceGetFriendRequestsArgs struct {
-	Req *GetFriendRequestsRequest `thrift:"req,1" frugal:"1,default,GetFriendRequestsRequest" json:"req"`
+type SocialServiceUpdatePresenceArgs struct {
+	Req *UpdatePresenceRequest `thrift:"req,1" frugal:"1,default,UpdatePresenceRequest" json:"req"`
 }
 
-func NewSocialServiceGetFriendRequestsArgs() *SocialServiceGetFriendRequestsArgs {
-	return &SocialServiceGetFriendRequestsArgs{}
+func NewSocialServiceUpdatePresenceArgs() *SocialServiceUpdatePresenceArgs {
+	return &SocialServiceUpdatePresenceArgs{}
 }
 
-func (p *SocialServiceGetFriendRequestsArgs) InitDefault() {
+func (p *SocialServiceUpdatePresenceArgs) InitDefault() {
 }
 
-var SocialServiceGetFriendRequestsArgs_Req_DEFAULT *GetFriendRequestsRequest
+var SocialServiceUpdatePresenceArgs_Req_DEFAULT *UpdatePresenceRequest
 
-func (p *SocialServiceGetFriendRequestsArgs) GetReq() (v *GetFriendRequestsRequest) {
+func (p *SocialServiceUpdatePresenceArgs) GetReq() (v *UpdatePresenceRequest) {
 	if !p.IsSetReq() {
-		return SocialServiceGetFriendRequestsArgs_Req_DEFAULT
+		return SocialServiceUpdatePresenceArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *SocialServiceGetFriendRequestsArgs) SetReq(val *GetFriendRequestsRequest) {
+func (p *SocialServiceUpdatePresenceArgs) SetReq(val *UpdatePresenceRequest) {
 	p.Req = val
 }
 
-func (p *SocialServiceGetFriendRequestsArgs) IsSetReq() bool {
+func (p *SocialServiceUpdatePresenceArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *SocialServiceGetFriendRequestsArgs) String() string {
+func (p *SocialServiceUpdatePresenceArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("SocialServiceGetFriendRequestsArgs(%+v)", *p)
+	return fmt.Sprintf("SocialServiceUpdatePresenceArgs(%+v)", *p)
 }
 
-var fieldIDToName_SocialServiceGetFriendRequestsArgs = map[int16]string{
+var fieldIDToName_SocialServiceUpdatePresenceArgs = map[int16]string{
 	1: "req",
 }
 
-type SocialServiceGetFriendRequestsResult struct {
-	Success *GetFriendRequestsResponse `thrift:"success,0,optional" frugal:"0,optional,GetFriendRequestsResponse" json:"success,omitempty"`
+type SocialServiceUpdatePresenceResult struct {
+	Success *UpdatePresenceResponse `thrift:"success,0,optional" frugal:"0,optional,UpdatePresenceResponse" json:"success,omitempty"`
 }
 
-func NewSocialServiceGetFriendRequestsResult() *SocialServiceGetFriendRequestsResult {
-	return &SocialServiceGetFriendRequestsResult{}
+func NewSocialServiceUpdatePresenceResult() *SocialServiceUpdatePresenceResult {
+	return &SocialServiceUpdatePresenceResult{}
 }
 
-func (p *SocialServiceGetFriendRequestsResult) InitDefault() {
+func (p *SocialServiceUpdatePresenceResult) InitDefault() {
 }
 
-var SocialServiceGetFriendRequestsResult_Success_DEFAULT *GetFriendRequestsResponse
+var SocialServiceUpdatePresenceResult_Success_DEFAULT *UpdatePresenceResponse
 
-func (p *SocialServiceGetFriendRequestsResult) GetSuccess() (v *GetFriendRequestsResponse) {
+func (p *SocialServiceUpdatePresenceResult) GetSuccess() (v *UpdatePresenceResponse) {
 	if !p.IsSetSuccess() {
-		return SocialServiceGetFriendRequestsResult_Success_DEFAULT
+		return SocialServiceUpdatePresenceResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *SocialServiceGetFriendRequestsResult) SetSuccess(x interface{}) {
-	p.Success = x.(*GetFriendRequestsResponse)
+func (p *SocialServiceUpdatePresenceResult) SetSuccess(x interface{}) {
+	p.Success = x.(*UpdatePresenceResponse)
 }
 
-func (p *SocialServiceGetFriendRequestsResult) IsSetSuccess() bool {
+func (p *SocialServiceUpdatePresenceResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *SocialServiceGetFriendRequestsResult) String() string {
+func (p *SocialServiceUpdatePresenceResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("SocialServiceGetFriendRequestsResult(%+v)", *p)
+	return fmt.Sprintf("SocialServiceUpdatePresenceResult(%+v)", *p)
 }
 
-var fieldIDToName_SocialServiceGetFriendRequestsResult = map[int16]string{
+var fieldIDToName_SocialServiceUpdatePresenceResult = map[int16]string{
 	0: "success",
 }
 
-type SocialServiceHandleFriendRequestArgs struct {
-	Req *HandleFriendRequestRequest `thrift:"req,1" frugal:"1,default,HandleFriendRequestRequest" json:"req"`
+type SocialServiceGetPresenceArgs struct {
+	Req *GetPresenceRequest `thrift:"req,1" frugal:"1,default,GetPresenceRequest" json:"req"`
 }
 
-func NewSocialServiceHandleFriendRequestArgs() *SocialServiceHandleFriendRequestArgs {
-	return &SocialServiceHandleFriendRequestArgs{}
+func NewSocialServiceGetPresenceArgs() *SocialServiceGetPresenceArgs {
+	return &SocialServiceGetPresenceArgs{}
 }
 
-func (p *SocialServiceHandleFriendRequestArgs) InitDefault() {
+func (p *SocialServiceGetPresenceArgs) InitDefault() {
 }
 
-var SocialServiceHandleFriendRequestArgs_Req_DEFAULT *HandleFriendRequestRequest
+var SocialServiceGetPresenceArgs_Req_DEFAULT *GetPresenceRequest
 
-func (p *SocialServiceHandleFriendRequestArgs) GetReq() (v *HandleFriendRequestRequest) {
+func (p *SocialServiceGetPresenceArgs) GetReq() (v *GetPresenceRequest) {
 	if !p.IsSetReq() {
-		return SocialServiceHandleFriendRequestArgs_Req_DEFAULT
+		return SocialServiceGetPresenceArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *SocialServiceHandleFriendRequestArgs) SetReq(val *HandleFriendRequestRequest) {
+func (p *SocialServiceGetPresenceArgs) SetReq(val *GetPresenceRequest) {
 	p.Req = val
 }
 
-func (p *SocialServiceHandleFriendRequestArgs) IsSetReq() bool {
+func (p *SocialServiceGetPresenceArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *SocialServiceHandleFriendRequestArgs) String() string {
+func (p *SocialServiceGetPresenceArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("SocialServiceHandleFriendRequestArgs(%+v)", *p)
+	return fmt.Sprintf("SocialServiceGetPresenceArgs(%+v)", *p)
 }
 
-var fieldIDToName_SocialServiceHandleFriendRequestArgs = map[int16]string{
+var fieldIDToName_SocialServiceGetPresenceArgs = map[int16]string{
 	1: "req",
 }
 
-type SocialServiceHandleFriendRequestResult struct {
-	Success *HandleFriendRequestResponse `thrift:"success,0,optional" frugal:"0,optional,HandleFriendRequestResponse" json:"success,omitempty"`
+type SocialServiceGetPresenceResult struct {
+	Success *GetPresenceResponse `thrift:"success,0,optional" frugal:"0,optional,GetPresenceResponse" json:"success,omitempty"`
 }
 
-func NewSocialServiceHandleFriendRequestResult() *SocialServiceHandleFriendRequestResult {
-	return &SocialServiceHandleFriendRequestResult{}
+func NewSocialServiceGetPresenceResult() *SocialServiceGetPresenceResult {
+	return &SocialServiceGetPresenceResult{}
 }
 
-func (p *SocialServiceHandleFriendRequestResult) InitDefault() {
+func (p *SocialServiceGetPresenceResult) InitDefault() {
 }
 
-var SocialServiceHandleFriendRequestResult_Success_DEFAULT *HandleFriendRequestResponse
+var SocialServiceGetPresenceResult_Success_DEFAULT *GetPresenceResponse
 
-func (p *SocialServiceHandleFriendRequestResult) GetSuccess() (v *HandleFriendRequestResponse) {
+func (p *SocialServiceGetPresenceResult) GetSuccess() (v *GetPresenceResponse) {
 	if !p.IsSetSuccess() {
-		return SocialServiceHandleFriendRequestResult_Success_DEFAULT
+		return SocialServiceGetPresenceResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *SocialServiceHandleFriendRequestResult) SetSuccess(x interface{}) {
-	p.Success = x.(*HandleFriendRequestResponse)
+func (p *SocialServiceGetPresenceResult) SetSuccess(x interface{}) {
+	p.Success = x.(*GetPresenceResponse)
 }
 
-func (p *SocialServiceHandleFriendRequestResult) IsSetSuccess() bool {
+func (p *SocialServiceGetPresenceResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *SocialServiceHandleFriendRequestResult) String() string {
+func (p *SocialServiceGetPresenceResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("SocialServiceHandleFriendRequestResult(%+v)", *p)
+	return fmt.Sprintf("SocialServiceGetPresenceResult(%+v)", *p)
 }
 
-var fieldIDToName_SocialServiceHandleFriendRequestResult = map[int16]string{
+var fieldIDToName_SocialServiceGetPresenceResult = map[int16]string{
 	0: "success",
 }
 
-type SocialServiceMarkMessageReadArgs struct {
-	Req *MarkMessageReadRequest `thrift:"req,1" frugal:"1,default,MarkMessageReadRequest" json:"req"`
+type SocialServiceSearchMessagesArgs struct {
+	Req *SearchMessagesRequest `thrift:"req,1" frugal:"1,default,SearchMessagesRequest" json:"req"`
 }
 
-func NewSocialServiceMarkMessageReadArgs() *SocialServiceMarkMessageReadArgs {
-	return &SocialServiceMarkMessageReadArgs{}
+func NewSocialServiceSearchMessagesArgs() *SocialServiceSearchMessagesArgs {
+	return &SocialServiceSearchMessagesArgs{}
 }
 
-func (p *SocialServiceMarkMessageReadArgs) InitDefault() {
+func (p *SocialServiceSearchMessagesArgs) InitDefault() {
 }
 
-var SocialServiceMarkMessageReadArgs_Req_DEFAULT *MarkMessageReadRequest
+var SocialServiceSearchMessagesArgs_Req_DEFAULT *SearchMessagesRequest
 
-func (p *SocialServiceMarkMessageReadArgs) GetReq() (v *MarkMessageReadRequest) {
+func (p *SocialServiceSearchMessagesArgs) GetReq() (v *SearchMessagesRequest) {
 	if !p.IsSetReq() {
-		return SocialServiceMarkMessageReadArgs_Req_DEFAULT
+		return SocialServiceSearchMessagesArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *SocialServiceMarkMessageReadArgs) SetReq(val *MarkMessageReadRequest) {
+func (p *SocialServiceSearchMessagesArgs) SetReq(val *SearchMessagesRequest) {
 	p.Req = val
 }
 
-func (p *SocialServiceMarkMessageReadArgs) IsSetReq() bool {
+func (p *SocialServiceSearchMessagesArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *SocialServiceMarkMessageReadArgs) String() string {
+func (p *SocialServiceSearchMessagesArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("SocialServiceMarkMessageReadArgs(%+v)", *p)
+	return fmt.Sprintf("SocialServiceSearchMessagesArgs(%+v)", *p)
 }
 
-var fieldIDToName_SocialServiceMarkMessageReadArgs = map[int16]string{
+var fieldIDToName_SocialServiceSearchMessagesArgs = map[int16]string{
 	1: "req",
 }
 
-type SocialServiceMarkMessageReadResult struct {
-	Success *MarkMessageReadResponse `thrift:"success,0,optional" frugal:"0,optional,MarkMessageReadResponse" json:"success,omitempty"`
+type SocialServiceSearchMessagesResult struct {
+	Success *SearchMessagesResponse `thrift:"success,0,optional" frugal:"0,optional,SearchMessagesResponse" json:"success,omitempty"`
 }
 
-func NewSocialServiceMarkMessageReadResult() *SocialServiceMarkMessageReadResult {
-	return &SocialServiceMarkMessageReadResult{}
+func NewSocialServiceSearchMessagesResult() *SocialServiceSearchMessagesResult {
+	return &SocialServiceSearchMessagesResult{}
 }
 
-func (p *SocialServiceMarkMessageReadResult) InitDefault() {
+func (p *SocialServiceSearchMessagesResult) InitDefault() {
 }
 
-var SocialServiceMarkMessageReadResult_Success_DEFAULT *MarkMessageReadResponse
+var SocialServiceSearchMessagesResult_Success_DEFAULT *SearchMessagesResponse
 
-func (p *SocialServiceMarkMessageReadResult) GetSuccess() (v *MarkMessageReadResponse) {
+func (p *SocialServiceSearchMessagesResult) GetSuccess() (v *SearchMessagesResponse) {
 	if !p.IsSetSuccess() {
-		return SocialServiceMarkMessageReadResult_Success_DEFAULT
+		return SocialServiceSearchMessagesResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *SocialServiceMarkMessageReadResult) SetSuccess(x interface{}) {
-	p.Success = x.(*MarkMessageReadResponse)
+func (p *SocialServiceSearchMessagesResult) SetSuccess(x interface{}) {
+	p.Success = x.(*SearchMessagesResponse)
 }
 
-func (p *SocialServiceMarkMessageReadResult) IsSetSuccess() bool {
+func (p *SocialServiceSearchMessagesResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *SocialServiceMarkMessageReadResult) String() string {
+func (p *SocialServiceSearchMessagesResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("SocialServiceMarkMessageReadResult(%+v)", *p)
+	return fmt.Sprintf("SocialServiceSearchMessagesResult(%+v)", *p)
 }
 
-var fieldIDToName_SocialServiceMarkMessageReadResult = map[int16]string{
+var fieldIDToName_SocialServiceSearchMessagesResult = map[int16]string{
 	0: "success",
 }
 
-type SocialServiceGetUnreadMessageCountArgs struct {
-	Req *GetUnreadMessageCountRequest `thrift:"req,1" frugal:"1,default,GetUnreadMessageCountRequest" json:"req"`
+type SocialServiceExportChatHistoryArgs struct {
+	Req *ExportChatHistoryRequest `thrift:"req,1" frugal:"1,default,ExportChatHistoryRequest" json:"req"`
 }
 
-func NewSocialServiceGetUnreadMessageCountArgs() *SocialServiceGetUnreadMessageCountArgs {
-	return &SocialServiceGetUnreadMessageCountArgs{}
+func NewSocialServiceExportChatHistoryArgs() *SocialServiceExportChatHistoryArgs {
+	return &SocialServiceExportChatHistoryArgs{}
 }
 
-func (p *SocialServiceGetUnreadMessageCountArgs) InitDefault() {
+func (p *SocialServiceExportChatHistoryArgs) InitDefault() {
 }
 
-var SocialServiceGetUnreadMessageCountArgs_Req_DEFAULT *GetUnreadMessageCountRequest
+var SocialServiceExportChatHistoryArgs_Req_DEFAULT *ExportChatHistoryRequest
 
-func (p *SocialServiceGetUnreadMessageCountArgs) GetReq() (v *GetUnreadMessageCountRequest) {
+func (p *SocialServiceExportChatHistoryArgs) GetReq() (v *ExportChatHistoryRequest) {
 	if !p.IsSetReq() {
-		return SocialServiceGetUnreadMessageCountArgs_Req_DEFAULT
+		return SocialServiceExportChatHistoryArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *SocialServiceGetUnreadMessageCountArgs) SetReq(val *GetUnreadMessageCountRequest) {
+func (p *SocialServiceExportChatHistoryArgs) SetReq(val *ExportChatHistoryRequest) {
 	p.Req = val
 }
 
-func (p *SocialServiceGetUnreadMessageCountArgs) IsSetReq() bool {
+func (p *SocialServiceExportChatHistoryArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *SocialServiceGetUnreadMessageCountArgs) String() string {
+func (p *SocialServiceExportChatHistoryArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("SocialServiceGetUnreadMessageCountArgs(%+v)", *p)
+	return fmt.Sprintf("SocialServiceExportChatHistoryArgs(%+v)", *p)
 }
 
-var fieldIDToName_SocialServiceGetUnreadMessageCountArgs = map[int16]string{
+var fieldIDToName_SocialServiceExportChatHistoryArgs = map[int16]string{
 	1: "req",
 }
 
-type SocialServiceGetUnreadMessageCountResult struct {
-	Success *GetUnreadMessageCountResponse `thrift:"success,0,optional" frugal:"0,optional,GetUnreadMessageCountResponse" json:"success,omitempty"`
+type SocialServiceExportChatHistoryResult struct {
+	Success *ExportChatHistoryResponse `thrift:"success,0,optional" frugal:"0,optional,ExportChatHistoryResponse" json:"success,omitempty"`
 }
 
-func NewSocialServiceGetUnreadMessageCountResult() *SocialServiceGetUnreadMessageCountResult {
-	return &SocialServiceGetUnreadMessageCountResult{}
+func NewSocialServiceExportChatHistoryResult() *SocialServiceExportChatHistoryResult {
+	return &SocialServiceExportChatHistoryResult{}
 }
 
-func (p *SocialServiceGetUnreadMessageCountResult) InitDefault() {
+func (p *SocialServiceExportChatHistoryResult) InitDefault() {
 }
 
-var SocialServiceGetUnreadMessageCountResult_Success_DEFAULT *GetUnreadMessageCountResponse
+var SocialServiceExportChatHistoryResult_Success_DEFAULT *ExportChatHistoryResponse
 
-func (p *SocialServiceGetUnreadMessageCountResult) GetSuccess() (v *GetUnreadMessageCountResponse) {
+func (p *SocialServiceExportChatHistoryResult) GetSuccess() (v *ExportChatHistoryResponse) {
 	if !p.IsSetSuccess() {
-		return SocialServiceGetUnreadMessageCountResult_Success_DEFAULT
+		return SocialServiceExportChatHistoryResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *SocialServiceGetUnreadMessageCountResult) SetSuccess(x interface{}) {
-	p.Success = x.(*GetUnreadMessageCountResponse)
+func (p *SocialServiceExportChatHistoryResult) SetSuccess(x interface{}) {
+	p.Success = x.(*ExportChatHistoryResponse)
 }
 
-func (p *SocialServiceGetUnreadMessageCountResult) IsSetSuccess() bool {
+func (p *SocialServiceExportChatHistoryResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *SocialServiceGetUnreadMessageCountResult) String() string {
+func (p *SocialServiceExportChatHistoryResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("SocialServiceGetUnreadMessageCountResult(%+v)", *p)
+	return fmt.Sprintf("SocialServiceExportChatHistoryResult(%+v)", *p)
 }
 
-var fieldIDToName_SocialServiceGetUnreadMessageCountResult = map[int16]string{
+var fieldIDToName_SocialServiceExportChatHistoryResult = map[int16]string{
 	0: "success",
 }
@@ -13,19 +13,51 @@ import (
 type Client interface {
 	SendPrivateMessage(ctx context.Context, req *social.SendPrivateMessageRequest, callOptions ...callopt.Option) (r *social.SendPrivateMessageResponse, err error)
 	GetPrivateMessages(ctx context.Context, req *social.GetPrivateMessagesRequest, callOptions ...callopt.Option) (r *social.GetPrivateMessagesResponse, err error)
+	EditPrivateMessage(ctx context.Context, req *social.EditPrivateMessageRequest, callOptions ...callopt.Option) (r *social.EditPrivateMessageResponse, err error)
+	RecallPrivateMessage(ctx context.Context, req *social.RecallPrivateMessageRequest, callOptions ...callopt.Option) (r *social.RecallPrivateMessageResponse, err error)
 	CreateChatRoom(ctx context.Context, req *social.CreateChatRoomRequest, callOptions ...callopt.Option) (r *social.CreateChatRoomResponse, err error)
 	GetChatRoom(ctx context.Context, req *social.GetChatRoomRequest, callOptions ...callopt.Option) (r *social.GetChatRoomResponse, err error)
 	GetUserChatRooms(ctx context.Context, req *social.GetUserChatRoomsRequest, callOptions ...callopt.Option) (r *social.GetUserChatRoomsResponse, err error)
 	SendChatMessage(ctx context.Context, req *social.SendChatMessageRequest, callOptions ...callopt.Option) (r *social.SendChatMessageResponse, err error)
 	GetChatMessages(ctx context.Context, req *social.GetChatMessagesRequest, callOptions ...callopt.Option) (r *social.GetChatMessagesResponse, err error)
+	UploadChatAttachment(ctx context.Context, req *social.UploadChatAttachmentRequest, callOptions ...callopt.Option) (r *social.UploadChatAttachmentResponse, err error)
+	EditChatMessage(ctx context.Context, req *social.EditChatMessageRequest, callOptions ...callopt.Option) (r *social.EditChatMessageResponse, err error)
+	RecallChatMessage(ctx context.Context, req *social.RecallChatMessageRequest, callOptions ...callopt.Option) (r *social.RecallChatMessageResponse, err error)
+	AddChatRoomMembers(ctx context.Context, req *social.AddChatRoomMembersRequest, callOptions ...callopt.Option) (r *social.AddChatRoomMembersResponse, err error)
+	RemoveChatRoomMember(ctx context.Context, req *social.RemoveChatRoomMemberRequest, callOptions ...callopt.Option) (r *social.RemoveChatRoomMemberResponse, err error)
+	LeaveChatRoom(ctx context.Context, req *social.LeaveChatRoomRequest, callOptions ...callopt.Option) (r *social.LeaveChatRoomResponse, err error)
+	CheckChatRoomMember(ctx context.Context, req *social.CheckChatRoomMemberRequest, callOptions ...callopt.Option) (r *social.CheckChatRoomMemberResponse, err error)
+	CheckPrivateChat(ctx context.Context, req *social.CheckPrivateChatRequest, callOptions ...callopt.Option) (r *social.CheckPrivateChatResponse, err error)
+	SetChatRoomAdmin(ctx context.Context, req *social.SetChatRoomAdminRequest, callOptions ...callopt.Option) (r *social.SetChatRoomAdminResponse, err error)
+	TransferChatRoom(ctx context.Context, req *social.TransferChatRoomRequest, callOptions ...callopt.Option) (r *social.TransferChatRoomResponse, err error)
+	MuteChatRoomMember(ctx context.Context, req *social.MuteChatRoomMemberRequest, callOptions ...callopt.Option) (r *social.MuteChatRoomMemberResponse, err error)
+	RenameChatRoom(ctx context.Context, req *social.RenameChatRoomRequest, callOptions ...callopt.Option) (r *social.RenameChatRoomResponse, err error)
+	DisbandChatRoom(ctx context.Context, req *social.DisbandChatRoomRequest, callOptions ...callopt.Option) (r *social.DisbandChatRoomResponse, err error)
+	CreateChatRoomInvite(ctx context.Context, req *social.CreateChatRoomInviteRequest, callOptions ...callopt.Option) (r *social.CreateChatRoomInviteResponse, err error)
+	JoinChatRoomByInvite(ctx context.Context, req *social.JoinChatRoomByInviteRequest, callOptions ...callopt.Option) (r *social.JoinChatRoomByInviteResponse, err error)
 	AddFriend(ctx context.Context, req *social.AddFriendRequest, callOptions ...callopt.Option) (r *social.AddFriendResponse, err error)
 	GetFriendship(ctx context.Context, req *social.GetFriendshipRequest, callOptions ...callopt.Option) (r *social.GetFriendshipResponse, err error)
 	GetUserFriends(ctx context.Context, req *social.GetUserFriendsRequest, callOptions ...callopt.Option) (r *social.GetUserFriendsResponse, err error)
 	CreateFriendRequest(ctx context.Context, req *social.CreateFriendRequestRequest, callOptions ...callopt.Option) (r *social.CreateFriendRequestResponse, err error)
 	GetFriendRequests(ctx context.Context, req *social.GetFriendRequestsRequest, callOptions ...callopt.Option) (r *social.GetFriendRequestsResponse, err error)
 	HandleFriendRequest(ctx context.Context, req *social.HandleFriendRequestRequest, callOptions ...callopt.Option) (r *social.HandleFriendRequestResponse, err error)
+	GetFriendRecommendations(ctx context.Context, req *social.GetFriendRecommendationsRequest, callOptions ...callopt.Option) (r *social.GetFriendRecommendationsResponse, err error)
 	MarkMessageRead(ctx context.Context, req *social.MarkMessageReadRequest, callOptions ...callopt.Option) (r *social.MarkMessageReadResponse, err error)
 	GetUnreadMessageCount(ctx context.Context, req *social.GetUnreadMessageCountRequest, callOptions ...callopt.Option) (r *social.GetUnreadMessageCountResponse, err error)
+	MarkConversationsRead(ctx context.Context, req *social.MarkConversationsReadRequest, callOptions ...callopt.Option) (r *social.MarkConversationsReadResponse, err error)
+	GetUnreadCounts(ctx context.Context, req *social.GetUnreadCountsRequest, callOptions ...callopt.Option) (r *social.GetUnreadCountsResponse, err error)
+	GetInbox(ctx context.Context, req *social.GetInboxRequest, callOptions ...callopt.Option) (r *social.GetInboxResponse, err error)
+	UpdateConversation(ctx context.Context, req *social.UpdateConversationRequest, callOptions ...callopt.Option) (r *social.UpdateConversationResponse, err error)
+	SyncMessages(ctx context.Context, req *social.SyncMessagesRequest, callOptions ...callopt.Option) (r *social.SyncMessagesResponse, err error)
+	GetNotifications(ctx context.Context, req *social.GetNotificationsRequest, callOptions ...callopt.Option) (r *social.GetNotificationsResponse, err error)
+	GetNotificationUnreadCount(ctx context.Context, req *social.GetNotificationUnreadCountRequest, callOptions ...callopt.Option) (r *social.GetNotificationUnreadCountResponse, err error)
+	MarkNotificationsRead(ctx context.Context, req *social.MarkNotificationsReadRequest, callOptions ...callopt.Option) (r *social.MarkNotificationsReadResponse, err error)
+	GetNotificationPreferences(ctx context.Context, req *social.GetNotificationPreferencesRequest, callOptions ...callopt.Option) (r *social.GetNotificationPreferencesResponse, err error)
+	UpdateNotificationPreferences(ctx context.Context, req *social.UpdateNotificationPreferencesRequest, callOptions ...callopt.Option) (r *social.UpdateNotificationPreferencesResponse, err error)
+	UpdatePresence(ctx context.Context, req *social.UpdatePresenceRequest, callOptions ...callopt.Option) (r *social.UpdatePresenceResponse, err error)
+	GetPresence(ctx context.Context, req *social.GetPresenceRequest, callOptions ...callopt.Option) (r *social.GetPresenceResponse, err error)
+	SearchMessages(ctx context.Context, req *social.SearchMessagesRequest, callOptions ...callopt.Option) (r *social.SearchMessagesResponse, err error)
+	ExportChatHistory(ctx context.Context, req *social.ExportChatHistoryRequest, callOptions ...callopt.Option) (r *social.ExportChatHistoryResponse, err error)
 }
 
 // NewClient creates a client for the service defined in IDL.
@@ -67,6 +99,16 @@ func (p *kSocialServiceClient) GetPrivateMessages(ctx context.Context, req *soci
 	return p.kClient.GetPrivateMessages(ctx, req)
 }
 
+func (p *kSocialServiceClient) EditPrivateMessage(ctx context.Context, req *social.EditPrivateMessageRequest, callOptions ...callopt.Option) (r *social.EditPrivateMessageResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.EditPrivateMessage(ctx, req)
+}
+
+func (p *kSocialServiceClient) RecallPrivateMessage(ctx context.Context, req *social.RecallPrivateMessageRequest, callOptions ...callopt.Option) (r *social.RecallPrivateMessageResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.RecallPrivateMessage(ctx, req)
+}
+
 func (p *kSocialServiceClient) CreateChatRoom(ctx context.Context, req *social.CreateChatRoomRequest, callOptions ...callopt.Option) (r *social.CreateChatRoomResponse, err error) {
 	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
 	return p.kClient.CreateChatRoom(ctx, req)
@@ -92,6 +134,81 @@ func (p *kSocialServiceClient) GetChatMessages(ctx context.Context, req *social.
 	return p.kClient.GetChatMessages(ctx, req)
 }
 
+func (p *kSocialServiceClient) UploadChatAttachment(ctx context.Context, req *social.UploadChatAttachmentRequest, callOptions ...callopt.Option) (r *social.UploadChatAttachmentResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.UploadChatAttachment(ctx, req)
+}
+
+func (p *kSocialServiceClient) EditChatMessage(ctx context.Context, req *social.EditChatMessageRequest, callOptions ...callopt.Option) (r *social.EditChatMessageResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.EditChatMessage(ctx, req)
+}
+
+func (p *kSocialServiceClient) RecallChatMessage(ctx context.Context, req *social.RecallChatMessageRequest, callOptions ...callopt.Option) (r *social.RecallChatMessageResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.RecallChatMessage(ctx, req)
+}
+
+func (p *kSocialServiceClient) AddChatRoomMembers(ctx context.Context, req *social.AddChatRoomMembersRequest, callOptions ...callopt.Option) (r *social.AddChatRoomMembersResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.AddChatRoomMembers(ctx, req)
+}
+
+func (p *kSocialServiceClient) RemoveChatRoomMember(ctx context.Context, req *social.RemoveChatRoomMemberRequest, callOptions ...callopt.Option) (r *social.RemoveChatRoomMemberResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.RemoveChatRoomMember(ctx, req)
+}
+
+func (p *kSocialServiceClient) LeaveChatRoom(ctx context.Context, req *social.LeaveChatRoomRequest, callOptions ...callopt.Option) (r *social.LeaveChatRoomResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.LeaveChatRoom(ctx, req)
+}
+
+func (p *kSocialServiceClient) CheckChatRoomMember(ctx context.Context, req *social.CheckChatRoomMemberRequest, callOptions ...callopt.Option) (r *social.CheckChatRoomMemberResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.CheckChatRoomMember(ctx, req)
+}
+
+func (p *kSocialServiceClient) CheckPrivateChat(ctx context.Context, req *social.CheckPrivateChatRequest, callOptions ...callopt.Option) (r *social.CheckPrivateChatResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.CheckPrivateChat(ctx, req)
+}
+
+func (p *kSocialServiceClient) SetChatRoomAdmin(ctx context.Context, req *social.SetChatRoomAdminRequest, callOptions ...callopt.Option) (r *social.SetChatRoomAdminResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.SetChatRoomAdmin(ctx, req)
+}
+
+func (p *kSocialServiceClient) TransferChatRoom(ctx context.Context, req *social.TransferChatRoomRequest, callOptions ...callopt.Option) (r *social.TransferChatRoomResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.TransferChatRoom(ctx, req)
+}
+
+func (p *kSocialServiceClient) MuteChatRoomMember(ctx context.Context, req *social.MuteChatRoomMemberRequest, callOptions ...callopt.Option) (r *social.MuteChatRoomMemberResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.MuteChatRoomMember(ctx, req)
+}
+
+func (p *kSocialServiceClient) RenameChatRoom(ctx context.Context, req *social.RenameChatRoomRequest, callOptions ...callopt.Option) (r *social.RenameChatRoomResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.RenameChatRoom(ctx, req)
+}
+
+func (p *kSocialServiceClient) DisbandChatRoom(ctx context.Context, req *social.DisbandChatRoomRequest, callOptions ...callopt.Option) (r *social.DisbandChatRoomResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.DisbandChatRoom(ctx, req)
+}
+
+func (p *kSocialServiceClient) CreateChatRoomInvite(ctx context.Context, req *social.CreateChatRoomInviteRequest, callOptions ...callopt.Option) (r *social.CreateChatRoomInviteResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.CreateChatRoomInvite(ctx, req)
+}
+
+func (p *kSocialServiceClient) JoinChatRoomByInvite(ctx context.Context, req *social.JoinChatRoomByInviteRequest, callOptions ...callopt.Option) (r *social.JoinChatRoomByInviteResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.JoinChatRoomByInvite(ctx, req)
+}
+
 func (p *kSocialServiceClient) AddFriend(ctx context.Context, req *social.AddFriendRequest, callOptions ...callopt.Option) (r *social.AddFriendResponse, err error) {
 	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
 	return p.kClient.AddFriend(ctx, req)
@@ -122,6 +239,11 @@ func (p *kSocialServiceClient) HandleFriendRequest(ctx context.Context, req *soc
 	return p.kClient.HandleFriendRequest(ctx, req)
 }
 
+func (p *kSocialServiceClient) GetFriendRecommendations(ctx context.Context, req *social.GetFriendRecommendationsRequest, callOptions ...callopt.Option) (r *social.GetFriendRecommendationsResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.GetFriendRecommendations(ctx, req)
+}
+
 func (p *kSocialServiceClient) MarkMessageRead(ctx context.Context, req *social.MarkMessageReadRequest, callOptions ...callopt.Option) (r *social.MarkMessageReadResponse, err error) {
 	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
 	return p.kClient.MarkMessageRead(ctx, req)
@@ -131,3 +253,73 @@ func (p *kSocialServiceClient) GetUnreadMessageCount(ctx context.Context, req *s
 	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
 	return p.kClient.GetUnreadMessageCount(ctx, req)
 }
+
+func (p *kSocialServiceClient) MarkConversationsRead(ctx context.Context, req *social.MarkConversationsReadRequest, callOptions ...callopt.Option) (r *social.MarkConversationsReadResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.MarkConversationsRead(ctx, req)
+}
+
+func (p *kSocialServiceClient) GetUnreadCounts(ctx context.Context, req *social.GetUnreadCountsRequest, callOptions ...callopt.Option) (r *social.GetUnreadCountsResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.GetUnreadCounts(ctx, req)
+}
+
+func (p *kSocialServiceClient) GetInbox(ctx context.Context, req *social.GetInboxRequest, callOptions ...callopt.Option) (r *social.GetInboxResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.GetInbox(ctx, req)
+}
+
+func (p *kSocialServiceClient) UpdateConversation(ctx context.Context, req *social.UpdateConversationRequest, callOptions ...callopt.Option) (r *social.UpdateConversationResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.UpdateConversation(ctx, req)
+}
+
+func (p *kSocialServiceClient) SyncMessages(ctx context.Context, req *social.SyncMessagesRequest, callOptions ...callopt.Option) (r *social.SyncMessagesResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.SyncMessages(ctx, req)
+}
+
+func (p *kSocialServiceClient) GetNotifications(ctx context.Context, req *social.GetNotificationsRequest, callOptions ...callopt.Option) (r *social.GetNotificationsResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.GetNotifications(ctx, req)
+}
+
+func (p *kSocialServiceClient) GetNotificationUnreadCount(ctx context.Context, req *social.GetNotificationUnreadCountRequest, callOptions ...callopt.Option) (r *social.GetNotificationUnreadCountResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.GetNotificationUnreadCount(ctx, req)
+}
+
+func (p *kSocialServiceClient) MarkNotificationsRead(ctx context.Context, req *social.MarkNotificationsReadRequest, callOptions ...callopt.Option) (r *social.MarkNotificationsReadResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.MarkNotificationsRead(ctx, req)
+}
+
+func (p *kSocialServiceClient) GetNotificationPreferences(ctx context.Context, req *social.GetNotificationPreferencesRequest, callOptions ...callopt.Option) (r *social.GetNotificationPreferencesResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.GetNotificationPreferences(ctx, req)
+}
+
+func (p *kSocialServiceClient) UpdateNotificationPreferences(ctx context.Context, req *social.UpdateNotificationPreferencesRequest, callOptions ...callopt.Option) (r *social.UpdateNotificationPreferencesResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.UpdateNotificationPreferences(ctx, req)
+}
+
+func (p *kSocialServiceClient) UpdatePresence(ctx context.Context, req *social.UpdatePresenceRequest, callOptions ...callopt.Option) (r *social.UpdatePresenceResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.UpdatePresence(ctx, req)
+}
+
+func (p *kSocialServiceClient) GetPresence(ctx context.Context, req *social.GetPresenceRequest, callOptions ...callopt.Option) (r *social.GetPresenceResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.GetPresence(ctx, req)
+}
+
+func (p *kSocialServiceClient) SearchMessages(ctx context.Context, req *social.SearchMessagesRequest, callOptions ...callopt.Option) (r *social.SearchMessagesResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.SearchMessages(ctx, req)
+}
+
+func (p *kSocialServiceClient) ExportChatHistory(ctx context.Context, req *social.ExportChatHistoryRequest, callOptions ...callopt.Option) (r *social.ExportChatHistoryResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ExportChatHistory(ctx, req)
+}
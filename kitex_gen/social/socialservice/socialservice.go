@@ -27,6 +27,20 @@ var serviceMethods = map[string]kitex.MethodInfo{
 		false,
 		kitex.WithStreamingMode(kitex.StreamingNone),
 	),
+	"EditPrivateMessage": kitex.NewMethodInfo(
+		editPrivateMessageHandler,
+		newSocialServiceEditPrivateMessageArgs,
+		newSocialServiceEditPrivateMessageResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"RecallPrivateMessage": kitex.NewMethodInfo(
+		recallPrivateMessageHandler,
+		newSocialServiceRecallPrivateMessageArgs,
+		newSocialServiceRecallPrivateMessageResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
 	"CreateChatRoom": kitex.NewMethodInfo(
 		createChatRoomHandler,
 		newSocialServiceCreateChatRoomArgs,
@@ -62,6 +76,111 @@ var serviceMethods = map[string]kitex.MethodInfo{
 		false,
 		kitex.WithStreamingMode(kitex.StreamingNone),
 	),
+	"UploadChatAttachment": kitex.NewMethodInfo(
+		uploadChatAttachmentHandler,
+		newSocialServiceUploadChatAttachmentArgs,
+		newSocialServiceUploadChatAttachmentResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"EditChatMessage": kitex.NewMethodInfo(
+		editChatMessageHandler,
+		newSocialServiceEditChatMessageArgs,
+		newSocialServiceEditChatMessageResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"RecallChatMessage": kitex.NewMethodInfo(
+		recallChatMessageHandler,
+		newSocialServiceRecallChatMessageArgs,
+		newSocialServiceRecallChatMessageResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"AddChatRoomMembers": kitex.NewMethodInfo(
+		addChatRoomMembersHandler,
+		newSocialServiceAddChatRoomMembersArgs,
+		newSocialServiceAddChatRoomMembersResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"RemoveChatRoomMember": kitex.NewMethodInfo(
+		removeChatRoomMemberHandler,
+		newSocialServiceRemoveChatRoomMemberArgs,
+		newSocialServiceRemoveChatRoomMemberResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"LeaveChatRoom": kitex.NewMethodInfo(
+		leaveChatRoomHandler,
+		newSocialServiceLeaveChatRoomArgs,
+		newSocialServiceLeaveChatRoomResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"CheckChatRoomMember": kitex.NewMethodInfo(
+		checkChatRoomMemberHandler,
+		newSocialServiceCheckChatRoomMemberArgs,
+		newSocialServiceCheckChatRoomMemberResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"CheckPrivateChat": kitex.NewMethodInfo(
+		checkPrivateChatHandler,
+		newSocialServiceCheckPrivateChatArgs,
+		newSocialServiceCheckPrivateChatResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"SetChatRoomAdmin": kitex.NewMethodInfo(
+		setChatRoomAdminHandler,
+		newSocialServiceSetChatRoomAdminArgs,
+		newSocialServiceSetChatRoomAdminResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"TransferChatRoom": kitex.NewMethodInfo(
+		transferChatRoomHandler,
+		newSocialServiceTransferChatRoomArgs,
+		newSocialServiceTransferChatRoomResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"MuteChatRoomMember": kitex.NewMethodInfo(
+		muteChatRoomMemberHandler,
+		newSocialServiceMuteChatRoomMemberArgs,
+		newSocialServiceMuteChatRoomMemberResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"RenameChatRoom": kitex.NewMethodInfo(
+		renameChatRoomHandler,
+		newSocialServiceRenameChatRoomArgs,
+		newSocialServiceRenameChatRoomResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"DisbandChatRoom": kitex.NewMethodInfo(
+		disbandChatRoomHandler,
+		newSocialServiceDisbandChatRoomArgs,
+		newSocialServiceDisbandChatRoomResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"CreateChatRoomInvite": kitex.NewMethodInfo(
+		createChatRoomInviteHandler,
+		newSocialServiceCreateChatRoomInviteArgs,
+		newSocialServiceCreateChatRoomInviteResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"JoinChatRoomByInvite": kitex.NewMethodInfo(
+		joinChatRoomByInviteHandler,
+		newSocialServiceJoinChatRoomByInviteArgs,
+		newSocialServiceJoinChatRoomByInviteResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
 	"AddFriend": kitex.NewMethodInfo(
 		addFriendHandler,
 		newSocialServiceAddFriendArgs,
@@ -104,6 +223,13 @@ var serviceMethods = map[string]kitex.MethodInfo{
 		false,
 		kitex.WithStreamingMode(kitex.StreamingNone),
 	),
+	"GetFriendRecommendations": kitex.NewMethodInfo(
+		getFriendRecommendationsHandler,
+		newSocialServiceGetFriendRecommendationsArgs,
+		newSocialServiceGetFriendRecommendationsResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
 	"MarkMessageRead": kitex.NewMethodInfo(
 		markMessageReadHandler,
 		newSocialServiceMarkMessageReadArgs,
@@ -118,6 +244,104 @@ var serviceMethods = map[string]kitex.MethodInfo{
 		false,
 		kitex.WithStreamingMode(kitex.StreamingNone),
 	),
+	"MarkConversationsRead": kitex.NewMethodInfo(
+		markConversationsReadHandler,
+		newSocialServiceMarkConversationsReadArgs,
+		newSocialServiceMarkConversationsReadResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"GetUnreadCounts": kitex.NewMethodInfo(
+		getUnreadCountsHandler,
+		newSocialServiceGetUnreadCountsArgs,
+		newSocialServiceGetUnreadCountsResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"GetInbox": kitex.NewMethodInfo(
+		getInboxHandler,
+		newSocialServiceGetInboxArgs,
+		newSocialServiceGetInboxResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"UpdateConversation": kitex.NewMethodInfo(
+		updateConversationHandler,
+		newSocialServiceUpdateConversationArgs,
+		newSocialServiceUpdateConversationResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"SyncMessages": kitex.NewMethodInfo(
+		syncMessagesHandler,
+		newSocialServiceSyncMessagesArgs,
+		newSocialServiceSyncMessagesResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"GetNotifications": kitex.NewMethodInfo(
+		getNotificationsHandler,
+		newSocialServiceGetNotificationsArgs,
+		newSocialServiceGetNotificationsResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"GetNotificationUnreadCount": kitex.NewMethodInfo(
+		getNotificationUnreadCountHandler,
+		newSocialServiceGetNotificationUnreadCountArgs,
+		newSocialServiceGetNotificationUnreadCountResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"MarkNotificationsRead": kitex.NewMethodInfo(
+		markNotificationsReadHandler,
+		newSocialServiceMarkNotificationsReadArgs,
+		newSocialServiceMarkNotificationsReadResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"GetNotificationPreferences": kitex.NewMethodInfo(
+		getNotificationPreferencesHandler,
+		newSocialServiceGetNotificationPreferencesArgs,
+		newSocialServiceGetNotificationPreferencesResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"UpdateNotificationPreferences": kitex.NewMethodInfo(
+		updateNotificationPreferencesHandler,
+		newSocialServiceUpdateNotificationPreferencesArgs,
+		newSocialServiceUpdateNotificationPreferencesResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"UpdatePresence": kitex.NewMethodInfo(
+		updatePresenceHandler,
+		newSocialServiceUpdatePresenceArgs,
+		newSocialServiceUpdatePresenceResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"GetPresence": kitex.NewMethodInfo(
+		getPresenceHandler,
+		newSocialServiceGetPresenceArgs,
+		newSocialServiceGetPresenceResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"SearchMessages": kitex.NewMethodInfo(
+		searchMessagesHandler,
+		newSocialServiceSearchMessagesArgs,
+		newSocialServiceSearchMessagesResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"ExportChatHistory": kitex.NewMethodInfo(
+		exportChatHistoryHandler,
+		newSocialServiceExportChatHistoryArgs,
+		newSocialServiceExportChatHistoryResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
 }
 
 var (
@@ -220,6 +444,42 @@ func newSocialServiceGetPrivateMessagesResult() interface{} {
 	return social.NewSocialServiceGetPrivateMessagesResult()
 }
 
+func editPrivateMessageHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*social.SocialServiceEditPrivateMessageArgs)
+	realResult := result.(*social.SocialServiceEditPrivateMessageResult)
+	success, err := handler.(social.SocialService).EditPrivateMessage(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newSocialServiceEditPrivateMessageArgs() interface{} {
+	return social.NewSocialServiceEditPrivateMessageArgs()
+}
+
+func newSocialServiceEditPrivateMessageResult() interface{} {
+	return social.NewSocialServiceEditPrivateMessageResult()
+}
+
+func recallPrivateMessageHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*social.SocialServiceRecallPrivateMessageArgs)
+	realResult := result.(*social.SocialServiceRecallPrivateMessageResult)
+	success, err := handler.(social.SocialService).RecallPrivateMessage(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newSocialServiceRecallPrivateMessageArgs() interface{} {
+	return social.NewSocialServiceRecallPrivateMessageArgs()
+}
+
+func newSocialServiceRecallPrivateMessageResult() interface{} {
+	return social.NewSocialServiceRecallPrivateMessageResult()
+}
+
 func createChatRoomHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
 	realArg := arg.(*social.SocialServiceCreateChatRoomArgs)
 	realResult := result.(*social.SocialServiceCreateChatRoomResult)
@@ -310,230 +570,940 @@ func newSocialServiceGetChatMessagesResult() interface{} {
 	return social.NewSocialServiceGetChatMessagesResult()
 }
 
-func addFriendHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
-	realArg := arg.(*social.SocialServiceAddFriendArgs)
-	realResult := result.(*social.SocialServiceAddFriendResult)
-	success, err := handler.(social.SocialService).AddFriend(ctx, realArg.Req)
+func uploadChatAttachmentHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*social.SocialServiceUploadChatAttachmentArgs)
+	realResult := result.(*social.SocialServiceUploadChatAttachmentResult)
+	success, err := handler.(social.SocialService).UploadChatAttachment(ctx, realArg.Req)
 	if err != nil {
 		return err
 	}
 	realResult.Success = success
 	return nil
 }
-func newSocialServiceAddFriendArgs() interface{} {
-	return social.NewSocialServiceAddFriendArgs()
+func newSocialServiceUploadChatAttachmentArgs() interface{} {
+	return social.NewSocialServiceUploadChatAttachmentArgs()
 }
 
-func newSocialServiceAddFriendResult() interface{} {
-	return social.NewSocialServiceAddFriendResult()
+func newSocialServiceUploadChatAttachmentResult() interface{} {
+	return social.NewSocialServiceUploadChatAttachmentResult()
 }
 
-func getFriendshipHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
-	realArg := arg.(*social.SocialServiceGetFriendshipArgs)
-	realResult := result.(*social.SocialServiceGetFriendshipResult)
-	success, err := handler.(social.SocialService).GetFriendship(ctx, realArg.Req)
+func editChatMessageHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*social.SocialServiceEditChatMessageArgs)
+	realResult := result.(*social.SocialServiceEditChatMessageResult)
+	success, err := handler.(social.SocialService).EditChatMessage(ctx, realArg.Req)
 	if err != nil {
 		return err
 	}
 	realResult.Success = success
 	return nil
 }
-func newSocialServiceGetFriendshipArgs() interface{} {
-	return social.NewSocialServiceGetFriendshipArgs()
+func newSocialServiceEditChatMessageArgs() interface{} {
+	return social.NewSocialServiceEditChatMessageArgs()
 }
 
-func newSocialServiceGetFriendshipResult() interface{} {
-	return social.NewSocialServiceGetFriendshipResult()
+func newSocialServiceEditChatMessageResult() interface{} {
+	return social.NewSocialServiceEditChatMessageResult()
 }
 
-func getUserFriendsHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
-	realArg := arg.(*social.SocialServiceGetUserFriendsArgs)
-	realResult := result.(*social.SocialServiceGetUserFriendsResult)
-	success, err := handler.(social.SocialService).GetUserFriends(ctx, realArg.Req)
+func recallChatMessageHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*social.SocialServiceRecallChatMessageArgs)
+	realResult := result.(*social.SocialServiceRecallChatMessageResult)
+	success, err := handler.(social.SocialService).RecallChatMessage(ctx, realArg.Req)
 	if err != nil {
 		return err
 	}
 	realResult.Success = success
 	return nil
 }
-func newSocialServiceGetUserFriendsArgs() interface{} {
-	return social.NewSocialServiceGetUserFriendsArgs()
+func newSocialServiceRecallChatMessageArgs() interface{} {
+	return social.NewSocialServiceRecallChatMessageArgs()
 }
 
-func newSocialServiceGetUserFriendsResult() interface{} {
-	return social.NewSocialServiceGetUserFriendsResult()
+func newSocialServiceRecallChatMessageResult() interface{} {
+	return social.NewSocialServiceRecallChatMessageResult()
 }
 
-func createFriendRequestHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
-	realArg := arg.(*social.SocialServiceCreateFriendRequestArgs)
-	realResult := result.(*social.SocialServiceCreateFriendRequestResult)
-	success, err := handler.(social.SocialService).CreateFriendRequest(ctx, realArg.Req)
+func addChatRoomMembersHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*social.SocialServiceAddChatRoomMembersArgs)
+	realResult := result.(*social.SocialServiceAddChatRoomMembersResult)
+	success, err := handler.(social.SocialService).AddChatRoomMembers(ctx, realArg.Req)
 	if err != nil {
 		return err
 	}
 	realResult.Success = success
 	return nil
 }
-func newSocialServiceCreateFriendRequestArgs() interface{} {
-	return social.NewSocialServiceCreateFriendRequestArgs()
+func newSocialServiceAddChatRoomMembersArgs() interface{} {
+	return social.NewSocialServiceAddChatRoomMembersArgs()
 }
 
-func newSocialServiceCreateFriendRequestResult() interface{} {
-	return social.NewSocialServiceCreateFriendRequestResult()
+func newSocialServiceAddChatRoomMembersResult() interface{} {
+	return social.NewSocialServiceAddChatRoomMembersResult()
 }
 
-func getFriendRequestsHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
-	realArg := arg.(*social.SocialServiceGetFriendRequestsArgs)
-	realResult := result.(*social.SocialServiceGetFriendRequestsResult)
-	success, err := handler.(social.SocialService).GetFriendRequests(ctx, realArg.Req)
+func removeChatRoomMemberHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*social.SocialServiceRemoveChatRoomMemberArgs)
+	realResult := result.(*social.SocialServiceRemoveChatRoomMemberResult)
+	success, err := handler.(social.SocialService).RemoveChatRoomMember(ctx, realArg.Req)
 	if err != nil {
 		return err
 	}
 	realResult.Success = success
 	return nil
 }
-func newSocialServiceGetFriendRequestsArgs() interface{} {
-	return social.NewSocialServiceGetFriendRequestsArgs()
+func newSocialServiceRemoveChatRoomMemberArgs() interface{} {
+	return social.NewSocialServiceRemoveChatRoomMemberArgs()
 }
 
-func newSocialServiceGetFriendRequestsResult() interface{} {
-	return social.NewSocialServiceGetFriendRequestsResult()
+func newSocialServiceRemoveChatRoomMemberResult() interface{} {
+	return social.NewSocialServiceRemoveChatRoomMemberResult()
 }
 
-func handleFriendRequestHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
-	realArg := arg.(*social.SocialServiceHandleFriendRequestArgs)
-	realResult := result.(*social.SocialServiceHandleFriendRequestResult)
-	success, err := handler.(social.SocialService).HandleFriendRequest(ctx, realArg.Req)
+func leaveChatRoomHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*social.SocialServiceLeaveChatRoomArgs)
+	realResult := result.(*social.SocialServiceLeaveChatRoomResult)
+	success, err := handler.(social.SocialService).LeaveChatRoom(ctx, realArg.Req)
 	if err != nil {
 		return err
 	}
 	realResult.Success = success
 	return nil
 }
-func newSocialServiceHandleFriendRequestArgs() interface{} {
-	return social.NewSocialServiceHandleFriendRequestArgs()
+func newSocialServiceLeaveChatRoomArgs() interface{} {
+	return social.NewSocialServiceLeaveChatRoomArgs()
 }
 
-func newSocialServiceHandleFriendRequestResult() interface{} {
-	return social.NewSocialServiceHandleFriendRequestResult()
+func newSocialServiceLeaveChatRoomResult() interface{} {
+	return social.NewSocialServiceLeaveChatRoomResult()
 }
 
-func markMessageReadHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
-	realArg := arg.(*social.SocialServiceMarkMessageReadArgs)
-	realResult := result.(*social.SocialServiceMarkMessageReadResult)
-	success, err := handler.(social.SocialService).MarkMessageRead(ctx, realArg.Req)
+func checkChatRoomMemberHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*social.SocialServiceCheckChatRoomMemberArgs)
+	realResult := result.(*social.SocialServiceCheckChatRoomMemberResult)
+	success, err := handler.(social.SocialService).CheckChatRoomMember(ctx, realArg.Req)
 	if err != nil {
 		return err
 	}
 	realResult.Success = success
 	return nil
 }
-func newSocialServiceMarkMessageReadArgs() interface{} {
-	return social.NewSocialServiceMarkMessageReadArgs()
+func newSocialServiceCheckChatRoomMemberArgs() interface{} {
+	return social.NewSocialServiceCheckChatRoomMemberArgs()
 }
 
-func newSocialServiceMarkMessageReadResult() interface{} {
-	return social.NewSocialServiceMarkMessageReadResult()
+func newSocialServiceCheckChatRoomMemberResult() interface{} {
+	return social.NewSocialServiceCheckChatRoomMemberResult()
 }
 
-func getUnreadMessageCountHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
-	realArg := arg.(*social.SocialServiceGetUnreadMessageCountArgs)
-	realResult := result.(*social.SocialServiceGetUnreadMessageCountResult)
-	success, err := handler.(social.SocialService).GetUnreadMessageCount(ctx, realArg.Req)
+func checkPrivateChatHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*social.SocialServiceCheckPrivateChatArgs)
+	realResult := result.(*social.SocialServiceCheckPrivateChatResult)
+	success, err := handler.(social.SocialService).CheckPrivateChat(ctx, realArg.Req)
 	if err != nil {
 		return err
 	}
 	realResult.Success = success
 	return nil
 }
-func newSocialServiceGetUnreadMessageCountArgs() interface{} {
-	return social.NewSocialServiceGetUnreadMessageCountArgs()
+func newSocialServiceCheckPrivateChatArgs() interface{} {
+	return social.NewSocialServiceCheckPrivateChatArgs()
 }
 
-func newSocialServiceGetUnreadMessageCountResult() interface{} {
-	return social.NewSocialServiceGetUnreadMessageCountResult()
+func newSocialServiceCheckPrivateChatResult() interface{} {
+	return social.NewSocialServiceCheckPrivateChatResult()
 }
 
-type kClient struct {
-	c client.Client
+func setChatRoomAdminHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*social.SocialServiceSetChatRoomAdminArgs)
+	realResult := result.(*social.SocialServiceSetChatRoomAdminResult)
+	success, err := handler.(social.SocialService).SetChatRoomAdmin(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newSocialServiceSetChatRoomAdminArgs() interface{} {
+	return social.NewSocialServiceSetChatRoomAdminArgs()
 }
 
-func newServiceClient(c client.Client) *kClient {
-	return &kClient{
-		c: c,
-	}
+func newSocialServiceSetChatRoomAdminResult() interface{} {
+	return social.NewSocialServiceSetChatRoomAdminResult()
 }
 
-func (p *kClient) SendPrivateMessage(ctx context.Context, req *social.SendPrivateMessageRequest) (r *social.SendPrivateMessageResponse, err error) {
-	var _args social.SocialServiceSendPrivateMessageArgs
-	_args.Req = req
-	var _result social.SocialServiceSendPrivateMessageResult
-	if err = p.c.Call(ctx, "SendPrivateMessage", &_args, &_result); err != nil {
-		return
+func transferChatRoomHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*social.SocialServiceTransferChatRoomArgs)
+	realResult := result.(*social.SocialServiceTransferChatRoomResult)
+	success, err := handler.(social.SocialService).TransferChatRoom(ctx, realArg.Req)
+	if err != nil {
+		return err
 	}
-	return _result.GetSuccess(), nil
+	realResult.Success = success
+	return nil
+}
+func newSocialServiceTransferChatRoomArgs() interface{} {
+	return social.NewSocialServiceTransferChatRoomArgs()
 }
 
-func (p *kClient) GetPrivateMessages(ctx context.Context, req *social.GetPrivateMessagesRequest) (r *social.GetPrivateMessagesResponse, err error) {
-	var _args social.SocialServiceGetPrivateMessagesArgs
-	_args.Req = req
-	var _result social.SocialServiceGetPrivateMessagesResult
-	if err = p.c.Call(ctx, "GetPrivateMessages", &_args, &_result); err != nil {
-		return
-	}
-	return _result.GetSuccess(), nil
+func newSocialServiceTransferChatRoomResult() interface{} {
+	return social.NewSocialServiceTransferChatRoomResult()
 }
 
-func (p *kClient) CreateChatRoom(ctx context.Context, req *social.CreateChatRoomRequest) (r *social.CreateChatRoomResponse, err error) {
-	var _args social.SocialServiceCreateChatRoomArgs
-	_args.Req = req
-	var _result social.SocialServiceCreateChatRoomResult
-	if err = p.c.Call(ctx, "CreateChatRoom", &_args, &_result); err != nil {
-		return
+func muteChatRoomMemberHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*social.SocialServiceMuteChatRoomMemberArgs)
+	realResult := result.(*social.SocialServiceMuteChatRoomMemberResult)
+	success, err := handler.(social.SocialService).MuteChatRoomMember(ctx, realArg.Req)
+	if err != nil {
+		return err
 	}
-	return _result.GetSuccess(), nil
+	realResult.Success = success
+	return nil
+}
+func newSocialServiceMuteChatRoomMemberArgs() interface{} {
+	return social.NewSocialServiceMuteChatRoomMemberArgs()
 }
 
-func (p *kClient) GetChatRoom(ctx context.Context, req *social.GetChatRoomRequest) (r *social.GetChatRoomResponse, err error) {
-	var _args social.SocialServiceGetChatRoomArgs
-	_args.Req = req
-	var _result social.SocialServiceGetChatRoomResult
-	if err = p.c.Call(ctx, "GetChatRoom", &_args, &_result); err != nil {
-		return
-	}
-	return _result.GetSuccess(), nil
+func newSocialServiceMuteChatRoomMemberResult() interface{} {
+	return social.NewSocialServiceMuteChatRoomMemberResult()
 }
 
-func (p *kClient) GetUserChatRooms(ctx context.Context, req *social.GetUserChatRoomsRequest) (r *social.GetUserChatRoomsResponse, err error) {
-	var _args social.SocialServiceGetUserChatRoomsArgs
-	_args.Req = req
-	var _result social.SocialServiceGetUserChatRoomsResult
-	if err = p.c.Call(ctx, "GetUserChatRooms", &_args, &_result); err != nil {
-		return
+func renameChatRoomHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*social.SocialServiceRenameChatRoomArgs)
+	realResult := result.(*social.SocialServiceRenameChatRoomResult)
+	success, err := handler.(social.SocialService).RenameChatRoom(ctx, realArg.Req)
+	if err != nil {
+		return err
 	}
-	return _result.GetSuccess(), nil
+	realResult.Success = success
+	return nil
+}
+func newSocialServiceRenameChatRoomArgs() interface{} {
+	return social.NewSocialServiceRenameChatRoomArgs()
 }
 
-func (p *kClient) SendChatMessage(ctx context.Context, req *social.SendChatMessageRequest) (r *social.SendChatMessageResponse, err error) {
-	var _args social.SocialServiceSendChatMessageArgs
-	_args.Req = req
-	var _result social.SocialServiceSendChatMessageResult
-	if err = p.c.Call(ctx, "SendChatMessage", &_args, &_result); err != nil {
-		return
-	}
-	return _result.GetSuccess(), nil
+func newSocialServiceRenameChatRoomResult() interface{} {
+	return social.NewSocialServiceRenameChatRoomResult()
 }
 
-func (p *kClient) GetChatMessages(ctx context.Context, req *social.GetChatMessagesRequest) (r *social.GetChatMessagesResponse, err error) {
-	var _args social.SocialServiceGetChatMessagesArgs
-	_args.Req = req
-	var _result social.SocialServiceGetChatMessagesResult
+func disbandChatRoomHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*social.SocialServiceDisbandChatRoomArgs)
+	realResult := result.(*social.SocialServiceDisbandChatRoomResult)
+	success, err := handler.(social.SocialService).DisbandChatRoom(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newSocialServiceDisbandChatRoomArgs() interface{} {
+	return social.NewSocialServiceDisbandChatRoomArgs()
+}
+
+func newSocialServiceDisbandChatRoomResult() interface{} {
+	return social.NewSocialServiceDisbandChatRoomResult()
+}
+
+func createChatRoomInviteHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*social.SocialServiceCreateChatRoomInviteArgs)
+	realResult := result.(*social.SocialServiceCreateChatRoomInviteResult)
+	success, err := handler.(social.SocialService).CreateChatRoomInvite(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newSocialServiceCreateChatRoomInviteArgs() interface{} {
+	return social.NewSocialServiceCreateChatRoomInviteArgs()
+}
+
+func newSocialServiceCreateChatRoomInviteResult() interface{} {
+	return social.NewSocialServiceCreateChatRoomInviteResult()
+}
+
+func joinChatRoomByInviteHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*social.SocialServiceJoinChatRoomByInviteArgs)
+	realResult := result.(*social.SocialServiceJoinChatRoomByInviteResult)
+	success, err := handler.(social.SocialService).JoinChatRoomByInvite(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newSocialServiceJoinChatRoomByInviteArgs() interface{} {
+	return social.NewSocialServiceJoinChatRoomByInviteArgs()
+}
+
+func newSocialServiceJoinChatRoomByInviteResult() interface{} {
+	return social.NewSocialServiceJoinChatRoomByInviteResult()
+}
+
+func addFriendHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*social.SocialServiceAddFriendArgs)
+	realResult := result.(*social.SocialServiceAddFriendResult)
+	success, err := handler.(social.SocialService).AddFriend(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newSocialServiceAddFriendArgs() interface{} {
+	return social.NewSocialServiceAddFriendArgs()
+}
+
+func newSocialServiceAddFriendResult() interface{} {
+	return social.NewSocialServiceAddFriendResult()
+}
+
+func getFriendshipHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*social.SocialServiceGetFriendshipArgs)
+	realResult := result.(*social.SocialServiceGetFriendshipResult)
+	success, err := handler.(social.SocialService).GetFriendship(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newSocialServiceGetFriendshipArgs() interface{} {
+	return social.NewSocialServiceGetFriendshipArgs()
+}
+
+func newSocialServiceGetFriendshipResult() interface{} {
+	return social.NewSocialServiceGetFriendshipResult()
+}
+
+func getUserFriendsHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*social.SocialServiceGetUserFriendsArgs)
+	realResult := result.(*social.SocialServiceGetUserFriendsResult)
+	success, err := handler.(social.SocialService).GetUserFriends(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newSocialServiceGetUserFriendsArgs() interface{} {
+	return social.NewSocialServiceGetUserFriendsArgs()
+}
+
+func newSocialServiceGetUserFriendsResult() interface{} {
+	return social.NewSocialServiceGetUserFriendsResult()
+}
+
+func createFriendRequestHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*social.SocialServiceCreateFriendRequestArgs)
+	realResult := result.(*social.SocialServiceCreateFriendRequestResult)
+	success, err := handler.(social.SocialService).CreateFriendRequest(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newSocialServiceCreateFriendRequestArgs() interface{} {
+	return social.NewSocialServiceCreateFriendRequestArgs()
+}
+
+func newSocialServiceCreateFriendRequestResult() interface{} {
+	return social.NewSocialServiceCreateFriendRequestResult()
+}
+
+func getFriendRequestsHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*social.SocialServiceGetFriendRequestsArgs)
+	realResult := result.(*social.SocialServiceGetFriendRequestsResult)
+	success, err := handler.(social.SocialService).GetFriendRequests(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newSocialServiceGetFriendRequestsArgs() interface{} {
+	return social.NewSocialServiceGetFriendRequestsArgs()
+}
+
+func newSocialServiceGetFriendRequestsResult() interface{} {
+	return social.NewSocialServiceGetFriendRequestsResult()
+}
+
+func handleFriendRequestHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*social.SocialServiceHandleFriendRequestArgs)
+	realResult := result.(*social.SocialServiceHandleFriendRequestResult)
+	success, err := handler.(social.SocialService).HandleFriendRequest(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newSocialServiceHandleFriendRequestArgs() interface{} {
+	return social.NewSocialServiceHandleFriendRequestArgs()
+}
+
+func newSocialServiceHandleFriendRequestResult() interface{} {
+	return social.NewSocialServiceHandleFriendRequestResult()
+}
+
+func getFriendRecommendationsHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*social.SocialServiceGetFriendRecommendationsArgs)
+	realResult := result.(*social.SocialServiceGetFriendRecommendationsResult)
+	success, err := handler.(social.SocialService).GetFriendRecommendations(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newSocialServiceGetFriendRecommendationsArgs() interface{} {
+	return social.NewSocialServiceGetFriendRecommendationsArgs()
+}
+
+func newSocialServiceGetFriendRecommendationsResult() interface{} {
+	return social.NewSocialServiceGetFriendRecommendationsResult()
+}
+
+func markMessageReadHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*social.SocialServiceMarkMessageReadArgs)
+	realResult := result.(*social.SocialServiceMarkMessageReadResult)
+	success, err := handler.(social.SocialService).MarkMessageRead(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newSocialServiceMarkMessageReadArgs() interface{} {
+	return social.NewSocialServiceMarkMessageReadArgs()
+}
+
+func newSocialServiceMarkMessageReadResult() interface{} {
+	return social.NewSocialServiceMarkMessageReadResult()
+}
+
+func getUnreadMessageCountHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*social.SocialServiceGetUnreadMessageCountArgs)
+	realResult := result.(*social.SocialServiceGetUnreadMessageCountResult)
+	success, err := handler.(social.SocialService).GetUnreadMessageCount(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newSocialServiceGetUnreadMessageCountArgs() interface{} {
+	return social.NewSocialServiceGetUnreadMessageCountArgs()
+}
+
+func newSocialServiceGetUnreadMessageCountResult() interface{} {
+	return social.NewSocialServiceGetUnreadMessageCountResult()
+}
+
+func markConversationsReadHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*social.SocialServiceMarkConversationsReadArgs)
+	realResult := result.(*social.SocialServiceMarkConversationsReadResult)
+	success, err := handler.(social.SocialService).MarkConversationsRead(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newSocialServiceMarkConversationsReadArgs() interface{} {
+	return social.NewSocialServiceMarkConversationsReadArgs()
+}
+
+func newSocialServiceMarkConversationsReadResult() interface{} {
+	return social.NewSocialServiceMarkConversationsReadResult()
+}
+
+func getUnreadCountsHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*social.SocialServiceGetUnreadCountsArgs)
+	realResult := result.(*social.SocialServiceGetUnreadCountsResult)
+	success, err := handler.(social.SocialService).GetUnreadCounts(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newSocialServiceGetUnreadCountsArgs() interface{} {
+	return social.NewSocialServiceGetUnreadCountsArgs()
+}
+
+func newSocialServiceGetUnreadCountsResult() interface{} {
+	return social.NewSocialServiceGetUnreadCountsResult()
+}
+
+func getInboxHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*social.SocialServiceGetInboxArgs)
+	realResult := result.(*social.SocialServiceGetInboxResult)
+	success, err := handler.(social.SocialService).GetInbox(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newSocialServiceGetInboxArgs() interface{} {
+	return social.NewSocialServiceGetInboxArgs()
+}
+
+func newSocialServiceGetInboxResult() interface{} {
+	return social.NewSocialServiceGetInboxResult()
+}
+
+func updateConversationHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*social.SocialServiceUpdateConversationArgs)
+	realResult := result.(*social.SocialServiceUpdateConversationResult)
+	success, err := handler.(social.SocialService).UpdateConversation(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newSocialServiceUpdateConversationArgs() interface{} {
+	return social.NewSocialServiceUpdateConversationArgs()
+}
+
+func newSocialServiceUpdateConversationResult() interface{} {
+	return social.NewSocialServiceUpdateConversationResult()
+}
+
+func syncMessagesHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*social.SocialServiceSyncMessagesArgs)
+	realResult := result.(*social.SocialServiceSyncMessagesResult)
+	success, err := handler.(social.SocialService).SyncMessages(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newSocialServiceSyncMessagesArgs() interface{} {
+	return social.NewSocialServiceSyncMessagesArgs()
+}
+
+func newSocialServiceSyncMessagesResult() interface{} {
+	return social.NewSocialServiceSyncMessagesResult()
+}
+
+func getNotificationsHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*social.SocialServiceGetNotificationsArgs)
+	realResult := result.(*social.SocialServiceGetNotificationsResult)
+	success, err := handler.(social.SocialService).GetNotifications(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newSocialServiceGetNotificationsArgs() interface{} {
+	return social.NewSocialServiceGetNotificationsArgs()
+}
+
+func newSocialServiceGetNotificationsResult() interface{} {
+	return social.NewSocialServiceGetNotificationsResult()
+}
+
+func getNotificationUnreadCountHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*social.SocialServiceGetNotificationUnreadCountArgs)
+	realResult := result.(*social.SocialServiceGetNotificationUnreadCountResult)
+	success, err := handler.(social.SocialService).GetNotificationUnreadCount(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newSocialServiceGetNotificationUnreadCountArgs() interface{} {
+	return social.NewSocialServiceGetNotificationUnreadCountArgs()
+}
+
+func newSocialServiceGetNotificationUnreadCountResult() interface{} {
+	return social.NewSocialServiceGetNotificationUnreadCountResult()
+}
+
+func markNotificationsReadHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*social.SocialServiceMarkNotificationsReadArgs)
+	realResult := result.(*social.SocialServiceMarkNotificationsReadResult)
+	success, err := handler.(social.SocialService).MarkNotificationsRead(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newSocialServiceMarkNotificationsReadArgs() interface{} {
+	return social.NewSocialServiceMarkNotificationsReadArgs()
+}
+
+func newSocialServiceMarkNotificationsReadResult() interface{} {
+	return social.NewSocialServiceMarkNotificationsReadResult()
+}
+
+func getNotificationPreferencesHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*social.SocialServiceGetNotificationPreferencesArgs)
+	realResult := result.(*social.SocialServiceGetNotificationPreferencesResult)
+	success, err := handler.(social.SocialService).GetNotificationPreferences(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newSocialServiceGetNotificationPreferencesArgs() interface{} {
+	return social.NewSocialServiceGetNotificationPreferencesArgs()
+}
+
+func newSocialServiceGetNotificationPreferencesResult() interface{} {
+	return social.NewSocialServiceGetNotificationPreferencesResult()
+}
+
+func updateNotificationPreferencesHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*social.SocialServiceUpdateNotificationPreferencesArgs)
+	realResult := result.(*social.SocialServiceUpdateNotificationPreferencesResult)
+	success, err := handler.(social.SocialService).UpdateNotificationPreferences(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newSocialServiceUpdateNotificationPreferencesArgs() interface{} {
+	return social.NewSocialServiceUpdateNotificationPreferencesArgs()
+}
+
+func newSocialServiceUpdateNotificationPreferencesResult() interface{} {
+	return social.NewSocialServiceUpdateNotificationPreferencesResult()
+}
+
+func updatePresenceHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*social.SocialServiceUpdatePresenceArgs)
+	realResult := result.(*social.SocialServiceUpdatePresenceResult)
+	success, err := handler.(social.SocialService).UpdatePresence(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newSocialServiceUpdatePresenceArgs() interface{} {
+	return social.NewSocialServiceUpdatePresenceArgs()
+}
+
+func newSocialServiceUpdatePresenceResult() interface{} {
+	return social.NewSocialServiceUpdatePresenceResult()
+}
+
+func getPresenceHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*social.SocialServiceGetPresenceArgs)
+	realResult := result.(*social.SocialServiceGetPresenceResult)
+	success, err := handler.(social.SocialService).GetPresence(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newSocialServiceGetPresenceArgs() interface{} {
+	return social.NewSocialServiceGetPresenceArgs()
+}
+
+func newSocialServiceGetPresenceResult() interface{} {
+	return social.NewSocialServiceGetPresenceResult()
+}
+
+func searchMessagesHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*social.SocialServiceSearchMessagesArgs)
+	realResult := result.(*social.SocialServiceSearchMessagesResult)
+	success, err := handler.(social.SocialService).SearchMessages(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newSocialServiceSearchMessagesArgs() interface{} {
+	return social.NewSocialServiceSearchMessagesArgs()
+}
+
+func newSocialServiceSearchMessagesResult() interface{} {
+	return social.NewSocialServiceSearchMessagesResult()
+}
+
+func exportChatHistoryHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*social.SocialServiceExportChatHistoryArgs)
+	realResult := result.(*social.SocialServiceExportChatHistoryResult)
+	success, err := handler.(social.SocialService).ExportChatHistory(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newSocialServiceExportChatHistoryArgs() interface{} {
+	return social.NewSocialServiceExportChatHistoryArgs()
+}
+
+func newSocialServiceExportChatHistoryResult() interface{} {
+	return social.NewSocialServiceExportChatHistoryResult()
+}
+
+type kClient struct {
+	c client.Client
+}
+
+func newServiceClient(c client.Client) *kClient {
+	return &kClient{
+		c: c,
+	}
+}
+
+func (p *kClient) SendPrivateMessage(ctx context.Context, req *social.SendPrivateMessageRequest) (r *social.SendPrivateMessageResponse, err error) {
+	var _args social.SocialServiceSendPrivateMessageArgs
+	_args.Req = req
+	var _result social.SocialServiceSendPrivateMessageResult
+	if err = p.c.Call(ctx, "SendPrivateMessage", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) GetPrivateMessages(ctx context.Context, req *social.GetPrivateMessagesRequest) (r *social.GetPrivateMessagesResponse, err error) {
+	var _args social.SocialServiceGetPrivateMessagesArgs
+	_args.Req = req
+	var _result social.SocialServiceGetPrivateMessagesResult
+	if err = p.c.Call(ctx, "GetPrivateMessages", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) EditPrivateMessage(ctx context.Context, req *social.EditPrivateMessageRequest) (r *social.EditPrivateMessageResponse, err error) {
+	var _args social.SocialServiceEditPrivateMessageArgs
+	_args.Req = req
+	var _result social.SocialServiceEditPrivateMessageResult
+	if err = p.c.Call(ctx, "EditPrivateMessage", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) RecallPrivateMessage(ctx context.Context, req *social.RecallPrivateMessageRequest) (r *social.RecallPrivateMessageResponse, err error) {
+	var _args social.SocialServiceRecallPrivateMessageArgs
+	_args.Req = req
+	var _result social.SocialServiceRecallPrivateMessageResult
+	if err = p.c.Call(ctx, "RecallPrivateMessage", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) CreateChatRoom(ctx context.Context, req *social.CreateChatRoomRequest) (r *social.CreateChatRoomResponse, err error) {
+	var _args social.SocialServiceCreateChatRoomArgs
+	_args.Req = req
+	var _result social.SocialServiceCreateChatRoomResult
+	if err = p.c.Call(ctx, "CreateChatRoom", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) GetChatRoom(ctx context.Context, req *social.GetChatRoomRequest) (r *social.GetChatRoomResponse, err error) {
+	var _args social.SocialServiceGetChatRoomArgs
+	_args.Req = req
+	var _result social.SocialServiceGetChatRoomResult
+	if err = p.c.Call(ctx, "GetChatRoom", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) GetUserChatRooms(ctx context.Context, req *social.GetUserChatRoomsRequest) (r *social.GetUserChatRoomsResponse, err error) {
+	var _args social.SocialServiceGetUserChatRoomsArgs
+	_args.Req = req
+	var _result social.SocialServiceGetUserChatRoomsResult
+	if err = p.c.Call(ctx, "GetUserChatRooms", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) SendChatMessage(ctx context.Context, req *social.SendChatMessageRequest) (r *social.SendChatMessageResponse, err error) {
+	var _args social.SocialServiceSendChatMessageArgs
+	_args.Req = req
+	var _result social.SocialServiceSendChatMessageResult
+	if err = p.c.Call(ctx, "SendChatMessage", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) GetChatMessages(ctx context.Context, req *social.GetChatMessagesRequest) (r *social.GetChatMessagesResponse, err error) {
+	var _args social.SocialServiceGetChatMessagesArgs
+	_args.Req = req
+	var _result social.SocialServiceGetChatMessagesResult
 	if err = p.c.Call(ctx, "GetChatMessages", &_args, &_result); err != nil {
 		return
 	}
 	return _result.GetSuccess(), nil
 }
 
+func (p *kClient) UploadChatAttachment(ctx context.Context, req *social.UploadChatAttachmentRequest) (r *social.UploadChatAttachmentResponse, err error) {
+	var _args social.SocialServiceUploadChatAttachmentArgs
+	_args.Req = req
+	var _result social.SocialServiceUploadChatAttachmentResult
+	if err = p.c.Call(ctx, "UploadChatAttachment", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) EditChatMessage(ctx context.Context, req *social.EditChatMessageRequest) (r *social.EditChatMessageResponse, err error) {
+	var _args social.SocialServiceEditChatMessageArgs
+	_args.Req = req
+	var _result social.SocialServiceEditChatMessageResult
+	if err = p.c.Call(ctx, "EditChatMessage", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) RecallChatMessage(ctx context.Context, req *social.RecallChatMessageRequest) (r *social.RecallChatMessageResponse, err error) {
+	var _args social.SocialServiceRecallChatMessageArgs
+	_args.Req = req
+	var _result social.SocialServiceRecallChatMessageResult
+	if err = p.c.Call(ctx, "RecallChatMessage", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) AddChatRoomMembers(ctx context.Context, req *social.AddChatRoomMembersRequest) (r *social.AddChatRoomMembersResponse, err error) {
+	var _args social.SocialServiceAddChatRoomMembersArgs
+	_args.Req = req
+	var _result social.SocialServiceAddChatRoomMembersResult
+	if err = p.c.Call(ctx, "AddChatRoomMembers", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) RemoveChatRoomMember(ctx context.Context, req *social.RemoveChatRoomMemberRequest) (r *social.RemoveChatRoomMemberResponse, err error) {
+	var _args social.SocialServiceRemoveChatRoomMemberArgs
+	_args.Req = req
+	var _result social.SocialServiceRemoveChatRoomMemberResult
+	if err = p.c.Call(ctx, "RemoveChatRoomMember", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) LeaveChatRoom(ctx context.Context, req *social.LeaveChatRoomRequest) (r *social.LeaveChatRoomResponse, err error) {
+	var _args social.SocialServiceLeaveChatRoomArgs
+	_args.Req = req
+	var _result social.SocialServiceLeaveChatRoomResult
+	if err = p.c.Call(ctx, "LeaveChatRoom", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) CheckChatRoomMember(ctx context.Context, req *social.CheckChatRoomMemberRequest) (r *social.CheckChatRoomMemberResponse, err error) {
+	var _args social.SocialServiceCheckChatRoomMemberArgs
+	_args.Req = req
+	var _result social.SocialServiceCheckChatRoomMemberResult
+	if err = p.c.Call(ctx, "CheckChatRoomMember", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) CheckPrivateChat(ctx context.Context, req *social.CheckPrivateChatRequest) (r *social.CheckPrivateChatResponse, err error) {
+	var _args social.SocialServiceCheckPrivateChatArgs
+	_args.Req = req
+	var _result social.SocialServiceCheckPrivateChatResult
+	if err = p.c.Call(ctx, "CheckPrivateChat", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) SetChatRoomAdmin(ctx context.Context, req *social.SetChatRoomAdminRequest) (r *social.SetChatRoomAdminResponse, err error) {
+	var _args social.SocialServiceSetChatRoomAdminArgs
+	_args.Req = req
+	var _result social.SocialServiceSetChatRoomAdminResult
+	if err = p.c.Call(ctx, "SetChatRoomAdmin", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) TransferChatRoom(ctx context.Context, req *social.TransferChatRoomRequest) (r *social.TransferChatRoomResponse, err error) {
+	var _args social.SocialServiceTransferChatRoomArgs
+	_args.Req = req
+	var _result social.SocialServiceTransferChatRoomResult
+	if err = p.c.Call(ctx, "TransferChatRoom", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) MuteChatRoomMember(ctx context.Context, req *social.MuteChatRoomMemberRequest) (r *social.MuteChatRoomMemberResponse, err error) {
+	var _args social.SocialServiceMuteChatRoomMemberArgs
+	_args.Req = req
+	var _result social.SocialServiceMuteChatRoomMemberResult
+	if err = p.c.Call(ctx, "MuteChatRoomMember", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) RenameChatRoom(ctx context.Context, req *social.RenameChatRoomRequest) (r *social.RenameChatRoomResponse, err error) {
+	var _args social.SocialServiceRenameChatRoomArgs
+	_args.Req = req
+	var _result social.SocialServiceRenameChatRoomResult
+	if err = p.c.Call(ctx, "RenameChatRoom", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) DisbandChatRoom(ctx context.Context, req *social.DisbandChatRoomRequest) (r *social.DisbandChatRoomResponse, err error) {
+	var _args social.SocialServiceDisbandChatRoomArgs
+	_args.Req = req
+	var _result social.SocialServiceDisbandChatRoomResult
+	if err = p.c.Call(ctx, "DisbandChatRoom", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) CreateChatRoomInvite(ctx context.Context, req *social.CreateChatRoomInviteRequest) (r *social.CreateChatRoomInviteResponse, err error) {
+	var _args social.SocialServiceCreateChatRoomInviteArgs
+	_args.Req = req
+	var _result social.SocialServiceCreateChatRoomInviteResult
+	if err = p.c.Call(ctx, "CreateChatRoomInvite", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) JoinChatRoomByInvite(ctx context.Context, req *social.JoinChatRoomByInviteRequest) (r *social.JoinChatRoomByInviteResponse, err error) {
+	var _args social.SocialServiceJoinChatRoomByInviteArgs
+	_args.Req = req
+	var _result social.SocialServiceJoinChatRoomByInviteResult
+	if err = p.c.Call(ctx, "JoinChatRoomByInvite", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
 func (p *kClient) AddFriend(ctx context.Context, req *social.AddFriendRequest) (r *social.AddFriendResponse, err error) {
 	var _args social.SocialServiceAddFriendArgs
 	_args.Req = req
@@ -594,6 +1564,16 @@ func (p *kClient) HandleFriendRequest(ctx context.Context, req *social.HandleFri
 	return _result.GetSuccess(), nil
 }
 
+func (p *kClient) GetFriendRecommendations(ctx context.Context, req *social.GetFriendRecommendationsRequest) (r *social.GetFriendRecommendationsResponse, err error) {
+	var _args social.SocialServiceGetFriendRecommendationsArgs
+	_args.Req = req
+	var _result social.SocialServiceGetFriendRecommendationsResult
+	if err = p.c.Call(ctx, "GetFriendRecommendations", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
 func (p *kClient) MarkMessageRead(ctx context.Context, req *social.MarkMessageReadRequest) (r *social.MarkMessageReadResponse, err error) {
 	var _args social.SocialServiceMarkMessageReadArgs
 	_args.Req = req
@@ -613,3 +1593,143 @@ func (p *kClient) GetUnreadMessageCount(ctx context.Context, req *social.GetUnre
 	}
 	return _result.GetSuccess(), nil
 }
+
+func (p *kClient) MarkConversationsRead(ctx context.Context, req *social.MarkConversationsReadRequest) (r *social.MarkConversationsReadResponse, err error) {
+	var _args social.SocialServiceMarkConversationsReadArgs
+	_args.Req = req
+	var _result social.SocialServiceMarkConversationsReadResult
+	if err = p.c.Call(ctx, "MarkConversationsRead", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) GetUnreadCounts(ctx context.Context, req *social.GetUnreadCountsRequest) (r *social.GetUnreadCountsResponse, err error) {
+	var _args social.SocialServiceGetUnreadCountsArgs
+	_args.Req = req
+	var _result social.SocialServiceGetUnreadCountsResult
+	if err = p.c.Call(ctx, "GetUnreadCounts", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) GetInbox(ctx context.Context, req *social.GetInboxRequest) (r *social.GetInboxResponse, err error) {
+	var _args social.SocialServiceGetInboxArgs
+	_args.Req = req
+	var _result social.SocialServiceGetInboxResult
+	if err = p.c.Call(ctx, "GetInbox", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) UpdateConversation(ctx context.Context, req *social.UpdateConversationRequest) (r *social.UpdateConversationResponse, err error) {
+	var _args social.SocialServiceUpdateConversationArgs
+	_args.Req = req
+	var _result social.SocialServiceUpdateConversationResult
+	if err = p.c.Call(ctx, "UpdateConversation", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) SyncMessages(ctx context.Context, req *social.SyncMessagesRequest) (r *social.SyncMessagesResponse, err error) {
+	var _args social.SocialServiceSyncMessagesArgs
+	_args.Req = req
+	var _result social.SocialServiceSyncMessagesResult
+	if err = p.c.Call(ctx, "SyncMessages", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) GetNotifications(ctx context.Context, req *social.GetNotificationsRequest) (r *social.GetNotificationsResponse, err error) {
+	var _args social.SocialServiceGetNotificationsArgs
+	_args.Req = req
+	var _result social.SocialServiceGetNotificationsResult
+	if err = p.c.Call(ctx, "GetNotifications", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) GetNotificationUnreadCount(ctx context.Context, req *social.GetNotificationUnreadCountRequest) (r *social.GetNotificationUnreadCountResponse, err error) {
+	var _args social.SocialServiceGetNotificationUnreadCountArgs
+	_args.Req = req
+	var _result social.SocialServiceGetNotificationUnreadCountResult
+	if err = p.c.Call(ctx, "GetNotificationUnreadCount", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) MarkNotificationsRead(ctx context.Context, req *social.MarkNotificationsReadRequest) (r *social.MarkNotificationsReadResponse, err error) {
+	var _args social.SocialServiceMarkNotificationsReadArgs
+	_args.Req = req
+	var _result social.SocialServiceMarkNotificationsReadResult
+	if err = p.c.Call(ctx, "MarkNotificationsRead", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) GetNotificationPreferences(ctx context.Context, req *social.GetNotificationPreferencesRequest) (r *social.GetNotificationPreferencesResponse, err error) {
+	var _args social.SocialServiceGetNotificationPreferencesArgs
+	_args.Req = req
+	var _result social.SocialServiceGetNotificationPreferencesResult
+	if err = p.c.Call(ctx, "GetNotificationPreferences", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) UpdateNotificationPreferences(ctx context.Context, req *social.UpdateNotificationPreferencesRequest) (r *social.UpdateNotificationPreferencesResponse, err error) {
+	var _args social.SocialServiceUpdateNotificationPreferencesArgs
+	_args.Req = req
+	var _result social.SocialServiceUpdateNotificationPreferencesResult
+	if err = p.c.Call(ctx, "UpdateNotificationPreferences", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) UpdatePresence(ctx context.Context, req *social.UpdatePresenceRequest) (r *social.UpdatePresenceResponse, err error) {
+	var _args social.SocialServiceUpdatePresenceArgs
+	_args.Req = req
+	var _result social.SocialServiceUpdatePresenceResult
+	if err = p.c.Call(ctx, "UpdatePresence", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) GetPresence(ctx context.Context, req *social.GetPresenceRequest) (r *social.GetPresenceResponse, err error) {
+	var _args social.SocialServiceGetPresenceArgs
+	_args.Req = req
+	var _result social.SocialServiceGetPresenceResult
+	if err = p.c.Call(ctx, "GetPresence", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) SearchMessages(ctx context.Context, req *social.SearchMessagesRequest) (r *social.SearchMessagesResponse, err error) {
+	var _args social.SocialServiceSearchMessagesArgs
+	_args.Req = req
+	var _result social.SocialServiceSearchMessagesResult
+	if err = p.c.Call(ctx, "SearchMessages", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) ExportChatHistory(ctx context.Context, req *social.ExportChatHistoryRequest) (r *social.ExportChatHistoryResponse, err error) {
+	var _args social.SocialServiceExportChatHistoryArgs
+	_args.Req = req
+	var _result social.SocialServiceExportChatHistoryResult
+	if err = p.c.Call(ctx, "ExportChatHistory", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
@@ -442,394 +442,1865 @@ var fieldIDToName_RefreshTokenResponse = map[int16]string{
 	2: "token",
 }
 
+type FollowRequest struct {
+	FolloweeId int64 `thrift:"followee_id,1,required" frugal:"1,required,i64" json:"followee_id"`
+}
+
+func NewFollowRequest() *FollowRequest {
+	return &FollowRequest{}
+}
+
+func (p *FollowRequest) InitDefault() {
+}
+
+func (p *FollowRequest) GetFolloweeId() (v int64) {
+	return p.FolloweeId
+}
+func (p *FollowRequest) SetFolloweeId(val int64) {
+	p.FolloweeId = val
+}
+
+func (p *FollowRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("FollowRequest(%+v)", *p)
+}
+
+var fieldIDToName_FollowRequest = map[int16]string{
+	1: "followee_id",
+}
+
+type FollowResponse struct {
+	Base     *model.BaseResp `thrift:"Base,1,required" frugal:"1,required,model.BaseResp" json:"Base"`
+	IsMutual bool            `thrift:"is_mutual,2,required" frugal:"2,required,bool" json:"is_mutual"`
+}
+
+func NewFollowResponse() *FollowResponse {
+	return &FollowResponse{}
+}
+
+func (p *FollowResponse) InitDefault() {
+}
+
+var FollowResponse_Base_DEFAULT *model.BaseResp
+
+func (p *FollowResponse) GetBase() (v *model.BaseResp) {
+	if !p.IsSetBase() {
+		return FollowResponse_Base_DEFAULT
+	}
+	return p.Base
+}
+
+func (p *FollowResponse) GetIsMutual() (v bool) {
+	return p.IsMutual
+}
+func (p *FollowResponse) SetBase(val *model.BaseResp) {
+	p.Base = val
+}
+func (p *FollowResponse) SetIsMutual(val bool) {
+	p.IsMutual = val
+}
+
+func (p *FollowResponse) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *FollowResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("FollowResponse(%+v)", *p)
+}
+
+var fieldIDToName_FollowResponse = map[int16]string{
+	1: "Base",
+	2: "is_mutual",
+}
+
+type UnfollowRequest struct {
+	FolloweeId int64 `thrift:"followee_id,1,required" frugal:"1,required,i64" json:"followee_id"`
+}
+
+func NewUnfollowRequest() *UnfollowRequest {
+	return &UnfollowRequest{}
+}
+
+func (p *UnfollowRequest) InitDefault() {
+}
+
+func (p *UnfollowRequest) GetFolloweeId() (v int64) {
+	return p.FolloweeId
+}
+func (p *UnfollowRequest) SetFolloweeId(val int64) {
+	p.FolloweeId = val
+}
+
+func (p *UnfollowRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UnfollowRequest(%+v)", *p)
+}
+
+var fieldIDToName_UnfollowRequest = map[int16]string{
+	1: "followee_id",
+}
+
+type UnfollowResponse struct {
+	Base *model.BaseResp `thrift:"Base,1,required" frugal:"1,required,model.BaseResp" json:"Base"`
+}
+
+func NewUnfollowResponse() *UnfollowResponse {
+	return &UnfollowResponse{}
+}
+
+func (p *UnfollowResponse) InitDefault() {
+}
+
+var UnfollowResponse_Base_DEFAULT *model.BaseResp
+
+func (p *UnfollowResponse) GetBase() (v *model.BaseResp) {
+	if !p.IsSetBase() {
+		return UnfollowResponse_Base_DEFAULT
+	}
+	return p.Base
+}
+func (p *UnfollowResponse) SetBase(val *model.BaseResp) {
+	p.Base = val
+}
+
+func (p *UnfollowResponse) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *UnfollowResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UnfollowResponse(%+v)", *p)
+}
+
+var fieldIDToName_UnfollowResponse = map[int16]string{
+	1: "Base",
+}
+
+type FollowListRequest struct {
+	UserId int64  `thrift:"user_id,1,required" frugal:"1,required,i64" json:"user_id"`
+	Cursor *int64 `thrift:"cursor,2,optional" frugal:"2,optional,i64" json:"cursor,omitempty"`
+	Limit  *int32 `thrift:"limit,3,optional" frugal:"3,optional,i32" json:"limit,omitempty"`
+}
+
+func NewFollowListRequest() *FollowListRequest {
+	return &FollowListRequest{}
+}
+
+func (p *FollowListRequest) InitDefault() {
+}
+
+func (p *FollowListRequest) GetUserId() (v int64) {
+	return p.UserId
+}
+
+var FollowListRequest_Cursor_DEFAULT int64
+
+func (p *FollowListRequest) GetCursor() (v int64) {
+	if !p.IsSetCursor() {
+		return FollowListRequest_Cursor_DEFAULT
+	}
+	return *p.Cursor
+}
+
+var FollowListRequest_Limit_DEFAULT int32
+
+func (p *FollowListRequest) GetLimit() (v int32) {
+	if !p.IsSetLimit() {
+		return FollowListRequest_Limit_DEFAULT
+	}
+	return *p.Limit
+}
+func (p *FollowListRequest) SetUserId(val int64) {
+	p.UserId = val
+}
+func (p *FollowListRequest) SetCursor(val *int64) {
+	p.Cursor = val
+}
+func (p *FollowListRequest) SetLimit(val *int32) {
+	p.Limit = val
+}
+
+func (p *FollowListRequest) IsSetCursor() bool {
+	return p.Cursor != nil
+}
+
+func (p *FollowListRequest) IsSetLimit() bool {
+	return p.Limit != nil
+}
+
+func (p *FollowListRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("FollowListRequest(%+v)", *p)
+}
+
+var fieldIDToName_FollowListRequest = map[int16]string{
+	1: "user_id",
+	2: "cursor",
+	3: "limit",
+}
+
+type FollowListResponse struct {
+	Base       *model.BaseResp     `thrift:"Base,1,required" frugal:"1,required,model.BaseResp" json:"Base"`
+	Users      []*model.FollowUser `thrift:"users,2,required" frugal:"2,required,list<model.FollowUser>" json:"users"`
+	NextCursor *int64              `thrift:"next_cursor,3,optional" frugal:"3,optional,i64" json:"next_cursor,omitempty"`
+	HasMore    bool                `thrift:"has_more,4,required" frugal:"4,required,bool" json:"has_more"`
+}
+
+func NewFollowListResponse() *FollowListResponse {
+	return &FollowListResponse{}
+}
+
+func (p *FollowListResponse) InitDefault() {
+}
+
+var FollowListResponse_Base_DEFAULT *model.BaseResp
+
+func (p *FollowListResponse) GetBase() (v *model.BaseResp) {
+	if !p.IsSetBase() {
+		return FollowListResponse_Base_DEFAULT
+	}
+	return p.Base
+}
+
+func (p *FollowListResponse) GetUsers() (v []*model.FollowUser) {
+	return p.Users
+}
+
+var FollowListResponse_NextCursor_DEFAULT int64
+
+func (p *FollowListResponse) GetNextCursor() (v int64) {
+	if !p.IsSetNextCursor() {
+		return FollowListResponse_NextCursor_DEFAULT
+	}
+	return *p.NextCursor
+}
+
+func (p *FollowListResponse) GetHasMore() (v bool) {
+	return p.HasMore
+}
+func (p *FollowListResponse) SetBase(val *model.BaseResp) {
+	p.Base = val
+}
+func (p *FollowListResponse) SetUsers(val []*model.FollowUser) {
+	p.Users = val
+}
+func (p *FollowListResponse) SetNextCursor(val *int64) {
+	p.NextCursor = val
+}
+func (p *FollowListResponse) SetHasMore(val bool) {
+	p.HasMore = val
+}
+
+func (p *FollowListResponse) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *FollowListResponse) IsSetNextCursor() bool {
+	return p.NextCursor != nil
+}
+
+func (p *FollowListResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("FollowListResponse(%+v)", *p)
+}
+
+var fieldIDToName_FollowListResponse = map[int16]string{
+	1: "Base",
+	2: "users",
+	3: "next_cursor",
+	4: "has_more",
+}
+
+type BlockRequest struct {
+	UserId int64 `thrift:"user_id,1,required" frugal:"1,required,i64" json:"user_id"`
+}
+
+func NewBlockRequest() *BlockRequest {
+	return &BlockRequest{}
+}
+
+func (p *BlockRequest) InitDefault() {
+}
+
+func (p *BlockRequest) GetUserId() (v int64) {
+	return p.UserId
+}
+func (p *BlockRequest) SetUserId(val int64) {
+	p.UserId = val
+}
+
+func (p *BlockRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("BlockRequest(%+v)", *p)
+}
+
+var fieldIDToName_BlockRequest = map[int16]string{
+	1: "user_id",
+}
+
+type BlockResponse struct {
+	Base *model.BaseResp `thrift:"Base,1,required" frugal:"1,required,model.BaseResp" json:"Base"`
+}
+
+func NewBlockResponse() *BlockResponse {
+	return &BlockResponse{}
+}
+
+func (p *BlockResponse) InitDefault() {
+}
+
+var BlockResponse_Base_DEFAULT *model.BaseResp
+
+func (p *BlockResponse) GetBase() (v *model.BaseResp) {
+	if !p.IsSetBase() {
+		return BlockResponse_Base_DEFAULT
+	}
+	return p.Base
+}
+func (p *BlockResponse) SetBase(val *model.BaseResp) {
+	p.Base = val
+}
+
+func (p *BlockResponse) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *BlockResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("BlockResponse(%+v)", *p)
+}
+
+var fieldIDToName_BlockResponse = map[int16]string{
+	1: "Base",
+}
+
+type UnblockRequest struct {
+	UserId int64 `thrift:"user_id,1,required" frugal:"1,required,i64" json:"user_id"`
+}
+
+func NewUnblockRequest() *UnblockRequest {
+	return &UnblockRequest{}
+}
+
+func (p *UnblockRequest) InitDefault() {
+}
+
+func (p *UnblockRequest) GetUserId() (v int64) {
+	return p.UserId
+}
+func (p *UnblockRequest) SetUserId(val int64) {
+	p.UserId = val
+}
+
+func (p *UnblockRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UnblockRequest(%+v)", *p)
+}
+
+var fieldIDToName_UnblockRequest = map[int16]string{
+	1: "user_id",
+}
+
+type UnblockResponse struct {
+	Base *model.BaseResp `thrift:"Base,1,required" frugal:"1,required,model.BaseResp" json:"Base"`
+}
+
+func NewUnblockResponse() *UnblockResponse {
+	return &UnblockResponse{}
+}
+
+func (p *UnblockResponse) InitDefault() {
+}
+
+var UnblockResponse_Base_DEFAULT *model.BaseResp
+
+func (p *UnblockResponse) GetBase() (v *model.BaseResp) {
+	if !p.IsSetBase() {
+		return UnblockResponse_Base_DEFAULT
+	}
+	return p.Base
+}
+func (p *UnblockResponse) SetBase(val *model.BaseResp) {
+	p.Base = val
+}
+
+func (p *UnblockResponse) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *UnblockResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UnblockResponse(%+v)", *p)
+}
+
+var fieldIDToName_UnblockResponse = map[int16]string{
+	1: "Base",
+}
+
+type BlockListRequest struct {
+	Cursor *int64 `thrift:"cursor,1,optional" frugal:"1,optional,i64" json:"cursor,omitempty"`
+	Limit  *int32 `thrift:"limit,2,optional" frugal:"2,optional,i32" json:"limit,omitempty"`
+}
+
+func NewBlockListRequest() *BlockListRequest {
+	return &BlockListRequest{}
+}
+
+func (p *BlockListRequest) InitDefault() {
+}
+
+var BlockListRequest_Cursor_DEFAULT int64
+
+func (p *BlockListRequest) GetCursor() (v int64) {
+	if !p.IsSetCursor() {
+		return BlockListRequest_Cursor_DEFAULT
+	}
+	return *p.Cursor
+}
+
+var BlockListRequest_Limit_DEFAULT int32
+
+func (p *BlockListRequest) GetLimit() (v int32) {
+	if !p.IsSetLimit() {
+		return BlockListRequest_Limit_DEFAULT
+	}
+	return *p.Limit
+}
+func (p *BlockListRequest) SetCursor(val *int64) {
+	p.Cursor = val
+}
+func (p *BlockListRequest) SetLimit(val *int32) {
+	p.Limit = val
+}
+
+func (p *BlockListRequest) IsSetCursor() bool {
+	return p.Cursor != nil
+}
+
+func (p *BlockListRequest) IsSetLimit() bool {
+	return p.Limit != nil
+}
+
+func (p *BlockListRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("BlockListRequest(%+v)", *p)
+}
+
+var fieldIDToName_BlockListRequest = map[int16]string{
+	1: "cursor",
+	2: "limit",
+}
+
+type BlockListResponse struct {
+	Base       *model.BaseResp      `thrift:"Base,1,required" frugal:"1,required,model.BaseResp" json:"Base"`
+	Users      []*model.BlockedUser `thrift:"users,2,required" frugal:"2,required,list<model.BlockedUser>" json:"users"`
+	NextCursor *int64               `thrift:"next_cursor,3,optional" frugal:"3,optional,i64" json:"next_cursor,omitempty"`
+	HasMore    bool                 `thrift:"has_more,4,required" frugal:"4,required,bool" json:"has_more"`
+}
+
+func NewBlockListResponse() *BlockListResponse {
+	return &BlockListResponse{}
+}
+
+func (p *BlockListResponse) InitDefault() {
+}
+
+var BlockListResponse_Base_DEFAULT *model.BaseResp
+
+func (p *BlockListResponse) GetBase() (v *model.BaseResp) {
+	if !p.IsSetBase() {
+		return BlockListResponse_Base_DEFAULT
+	}
+	return p.Base
+}
+
+func (p *BlockListResponse) GetUsers() (v []*model.BlockedUser) {
+	return p.Users
+}
+
+var BlockListResponse_NextCursor_DEFAULT int64
+
+func (p *BlockListResponse) GetNextCursor() (v int64) {
+	if !p.IsSetNextCursor() {
+		return BlockListResponse_NextCursor_DEFAULT
+	}
+	return *p.NextCursor
+}
+
+func (p *BlockListResponse) GetHasMore() (v bool) {
+	return p.HasMore
+}
+func (p *BlockListResponse) SetBase(val *model.BaseResp) {
+	p.Base = val
+}
+func (p *BlockListResponse) SetUsers(val []*model.BlockedUser) {
+	p.Users = val
+}
+func (p *BlockListResponse) SetNextCursor(val *int64) {
+	p.NextCursor = val
+}
+func (p *BlockListResponse) SetHasMore(val bool) {
+	p.HasMore = val
+}
+
+func (p *BlockListResponse) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *BlockListResponse) IsSetNextCursor() bool {
+	return p.NextCursor != nil
+}
+
+func (p *BlockListResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("BlockListResponse(%+v)", *p)
+}
+
+var fieldIDToName_BlockListResponse = map[int16]string{
+	1: "Base",
+	2: "users",
+	3: "next_cursor",
+	4: "has_more",
+}
+
+type GetPrivacySettingsRequest struct {
+}
+
+func NewGetPrivacySettingsRequest() *GetPrivacySettingsRequest {
+	return &GetPrivacySettingsRequest{}
+}
+
+func (p *GetPrivacySettingsRequest) InitDefault() {
+}
+
+func (p *GetPrivacySettingsRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GetPrivacySettingsRequest(%+v)", *p)
+}
+
+var fieldIDToName_GetPrivacySettingsRequest = map[int16]string{}
+
+type GetPrivacySettingsResponse struct {
+	Base     *model.BaseResp        `thrift:"Base,1,required" frugal:"1,required,model.BaseResp" json:"Base"`
+	Settings *model.PrivacySettings `thrift:"settings,2,required" frugal:"2,required,model.PrivacySettings" json:"settings"`
+}
+
+func NewGetPrivacySettingsResponse() *GetPrivacySettingsResponse {
+	return &GetPrivacySettingsResponse{}
+}
+
+func (p *GetPrivacySettingsResponse) InitDefault() {
+}
+
+var GetPrivacySettingsResponse_Base_DEFAULT *model.BaseResp
+
+func (p *GetPrivacySettingsResponse) GetBase() (v *model.BaseResp) {
+	if !p.IsSetBase() {
+		return GetPrivacySettingsResponse_Base_DEFAULT
+	}
+	return p.Base
+}
+
+var GetPrivacySettingsResponse_Settings_DEFAULT *model.PrivacySettings
+
+func (p *GetPrivacySettingsResponse) GetSettings() (v *model.PrivacySettings) {
+	if !p.IsSetSettings() {
+		return GetPrivacySettingsResponse_Settings_DEFAULT
+	}
+	return p.Settings
+}
+func (p *GetPrivacySettingsResponse) SetBase(val *model.BaseResp) {
+	p.Base = val
+}
+func (p *GetPrivacySettingsResponse) SetSettings(val *model.PrivacySettings) {
+	p.Settings = val
+}
+
+func (p *GetPrivacySettingsResponse) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *GetPrivacySettingsResponse) IsSetSettings() bool {
+	return p.Settings != nil
+}
+
+func (p *GetPrivacySettingsResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GetPrivacySettingsResponse(%+v)", *p)
+}
+
+var fieldIDToName_GetPrivacySettingsResponse = map[int16]string{
+	1: "Base",
+	2: "settings",
+}
+
+type UpdatePrivacySettingsRequest struct {
+	DmPolicy              *int8 `thrift:"dm_policy,1,optional" frugal:"1,optional,i8" json:"dm_policy,omitempty"`
+	LikesVisibility       *int8 `thrift:"likes_visibility,2,optional" frugal:"2,optional,i8" json:"likes_visibility,omitempty"`
+	CollectionsVisibility *int8 `thrift:"collections_visibility,3,optional" frugal:"3,optional,i8" json:"collections_visibility,omitempty"`
+	HideOnline            *bool `thrift:"hide_online,4,optional" frugal:"4,optional,bool" json:"hide_online,omitempty"`
+}
+
+func NewUpdatePrivacySettingsRequest() *UpdatePrivacySettingsRequest {
+	return &UpdatePrivacySettingsRequest{}
+}
+
+func (p *UpdatePrivacySettingsRequest) InitDefault() {
+}
+
+var UpdatePrivacySettingsRequest_DmPolicy_DEFAULT int8
+
+func (p *UpdatePrivacySettingsRequest) GetDmPolicy() (v int8) {
+	if !p.IsSetDmPolicy() {
+		return UpdatePrivacySettingsRequest_DmPolicy_DEFAULT
+	}
+	return *p.DmPolicy
+}
+
+var UpdatePrivacySettingsRequest_LikesVisibility_DEFAULT int8
+
+func (p *UpdatePrivacySettingsRequest) GetLikesVisibility() (v int8) {
+	if !p.IsSetLikesVisibility() {
+		return UpdatePrivacySettingsRequest_LikesVisibility_DEFAULT
+	}
+	return *p.LikesVisibility
+}
+
+var UpdatePrivacySettingsRequest_CollectionsVisibility_DEFAULT int8
+
+func (p *UpdatePrivacySettingsRequest) GetCollectionsVisibility() (v int8) {
+	if !p.IsSetCollectionsVisibility() {
+		return UpdatePrivacySettingsRequest_CollectionsVisibility_DEFAULT
+	}
+	return *p.CollectionsVisibility
+}
+
+var UpdatePrivacySettingsRequest_HideOnline_DEFAULT bool
+
+func (p *UpdatePrivacySettingsRequest) GetHideOnline() (v bool) {
+	if !p.IsSetHideOnline() {
+		return UpdatePrivacySettingsRequest_HideOnline_DEFAULT
+	}
+	return *p.HideOnline
+}
+func (p *UpdatePrivacySettingsRequest) SetDmPolicy(val *int8) {
+	p.DmPolicy = val
+}
+func (p *UpdatePrivacySettingsRequest) SetLikesVisibility(val *int8) {
+	p.LikesVisibility = val
+}
+func (p *UpdatePrivacySettingsRequest) SetCollectionsVisibility(val *int8) {
+	p.CollectionsVisibility = val
+}
+func (p *UpdatePrivacySettingsRequest) SetHideOnline(val *bool) {
+	p.HideOnline = val
+}
+
+func (p *UpdatePrivacySettingsRequest) IsSetDmPolicy() bool {
+	return p.DmPolicy != nil
+}
+
+func (p *UpdatePrivacySettingsRequest) IsSetLikesVisibility() bool {
+	return p.LikesVisibility != nil
+}
+
+func (p *UpdatePrivacySettingsRequest) IsSetCollectionsVisibility() bool {
+	return p.CollectionsVisibility != nil
+}
+
+func (p *UpdatePrivacySettingsRequest) IsSetHideOnline() bool {
+	return p.HideOnline != nil
+}
+
+func (p *UpdatePrivacySettingsRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UpdatePrivacySettingsRequest(%+v)", *p)
+}
+
+var fieldIDToName_UpdatePrivacySettingsRequest = map[int16]string{
+	1: "dm_policy",
+	2: "likes_visibility",
+	3: "collections_visibility",
+	4: "hide_online",
+}
+
+type UpdatePrivacySettingsResponse struct {
+	Base     *model.BaseResp        `thrift:"Base,1,required" frugal:"1,required,model.BaseResp" json:"Base"`
+	Settings *model.PrivacySettings `thrift:"settings,2,required" frugal:"2,required,model.PrivacySettings" json:"settings"`
+}
+
+func NewUpdatePrivacySettingsResponse() *UpdatePrivacySettingsResponse {
+	return &UpdatePrivacySettingsResponse{}
+}
+
+func (p *UpdatePrivacySettingsResponse) InitDefault() {
+}
+
+var UpdatePrivacySettingsResponse_Base_DEFAULT *model.BaseResp
+
+func (p *UpdatePrivacySettingsResponse) GetBase() (v *model.BaseResp) {
+	if !p.IsSetBase() {
+		return UpdatePrivacySettingsResponse_Base_DEFAULT
+	}
+	return p.Base
+}
+
+var UpdatePrivacySettingsResponse_Settings_DEFAULT *model.PrivacySettings
+
+func (p *UpdatePrivacySettingsResponse) GetSettings() (v *model.PrivacySettings) {
+	if !p.IsSetSettings() {
+		return UpdatePrivacySettingsResponse_Settings_DEFAULT
+	}
+	return p.Settings
+}
+func (p *UpdatePrivacySettingsResponse) SetBase(val *model.BaseResp) {
+	p.Base = val
+}
+func (p *UpdatePrivacySettingsResponse) SetSettings(val *model.PrivacySettings) {
+	p.Settings = val
+}
+
+func (p *UpdatePrivacySettingsResponse) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *UpdatePrivacySettingsResponse) IsSetSettings() bool {
+	return p.Settings != nil
+}
+
+func (p *UpdatePrivacySettingsResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UpdatePrivacySettingsResponse(%+v)", *p)
+}
+
+var fieldIDToName_UpdatePrivacySettingsResponse = map[int16]string{
+	1: "Base",
+	2: "settings",
+}
+
 type UserService interface {
 	Register(ctx context.Context, req *RegisterRequest) (r *RegisterResponse, err error)
 
-	Login(ctx context.Context, req *LoginRequest) (r *LoginResponse, err error)
+	Login(ctx context.Context, req *LoginRequest) (r *LoginResponse, err error)
+
+	GetUserInfo(ctx context.Context, req *UserInfoRequest) (r *UserInfoResponse, err error)
+
+	UploadAvatar(ctx context.Context, req *UploadAvatarRequest) (r *UploadAvatarResponse, err error)
+
+	RefreshToken(ctx context.Context, req *RefreshTokenRequest) (r *RefreshTokenResponse, err error)
+
+	Follow(ctx context.Context, req *FollowRequest) (r *FollowResponse, err error)
+
+	Unfollow(ctx context.Context, req *UnfollowRequest) (r *UnfollowResponse, err error)
+
+	GetFollowing(ctx context.Context, req *FollowListRequest) (r *FollowListResponse, err error)
+
+	GetFollowers(ctx context.Context, req *FollowListRequest) (r *FollowListResponse, err error)
+
+	Block(ctx context.Context, req *BlockRequest) (r *BlockResponse, err error)
+
+	Unblock(ctx context.Context, req *UnblockRequest) (r *UnblockResponse, err error)
+
+	GetBlockList(ctx context.Context, req *BlockListRequest) (r *BlockListResponse, err error)
+
+	GetPrivacySettings(ctx context.Context, req *GetPrivacySettingsRequest) (r *GetPrivacySettingsResponse, err error)
+
+	UpdatePrivacySettings(ctx context.Context, req *UpdatePrivacySettingsRequest) (r *UpdatePrivacySettingsResponse, err error)
+}
+
+type UserServiceRegisterArgs struct {
+	Req *RegisterRequest `thrift:"req,1" frugal:"1,default,RegisterRequest" json:"req"`
+}
+
+func NewUserServiceRegisterArgs() *UserServiceRegisterArgs {
+	return &UserServiceRegisterArgs{}
+}
+
+func (p *UserServiceRegisterArgs) InitDefault() {
+}
+
+var UserServiceRegisterArgs_Req_DEFAULT *RegisterRequest
+
+func (p *UserServiceRegisterArgs) GetReq() (v *RegisterRequest) {
+	if !p.IsSetReq() {
+		return UserServiceRegisterArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *UserServiceRegisterArgs) SetReq(val *RegisterRequest) {
+	p.Req = val
+}
+
+func (p *UserServiceRegisterArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *UserServiceRegisterArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserServiceRegisterArgs(%+v)", *p)
+}
+
+var fieldIDToName_UserServiceRegisterArgs = map[int16]string{
+	1: "req",
+}
+
+type UserServiceRegisterResult struct {
+	Success *RegisterResponse `thrift:"success,0,optional" frugal:"0,optional,RegisterResponse" json:"success,omitempty"`
+}
+
+func NewUserServiceRegisterResult() *UserServiceRegisterResult {
+	return &UserServiceRegisterResult{}
+}
+
+func (p *UserServiceRegisterResult) InitDefault() {
+}
+
+var UserServiceRegisterResult_Success_DEFAULT *RegisterResponse
+
+func (p *UserServiceRegisterResult) GetSuccess() (v *RegisterResponse) {
+	if !p.IsSetSuccess() {
+		return UserServiceRegisterResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *UserServiceRegisterResult) SetSuccess(x interface{}) {
+	p.Success = x.(*RegisterResponse)
+}
+
+func (p *UserServiceRegisterResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *UserServiceRegisterResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserServiceRegisterResult(%+v)", *p)
+}
+
+var fieldIDToName_UserServiceRegisterResult = map[int16]string{
+	0: "success",
+}
+
+type UserServiceLoginArgs struct {
+	Req *LoginRequest `thrift:"req,1" frugal:"1,default,LoginRequest" json:"req"`
+}
+
+func NewUserServiceLoginArgs() *UserServiceLoginArgs {
+	return &UserServiceLoginArgs{}
+}
+
+func (p *UserServiceLoginArgs) InitDefault() {
+}
+
+var UserServiceLoginArgs_Req_DEFAULT *LoginRequest
+
+func (p *UserServiceLoginArgs) GetReq() (v *LoginRequest) {
+	if !p.IsSetReq() {
+		return UserServiceLoginArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *UserServiceLoginArgs) SetReq(val *LoginRequest) {
+	p.Req = val
+}
+
+func (p *UserServiceLoginArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *UserServiceLoginArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserServiceLoginArgs(%+v)", *p)
+}
+
+var fieldIDToName_UserServiceLoginArgs = map[int16]string{
+	1: "req",
+}
+
+type UserServiceLoginResult struct {
+	Success *LoginResponse `thrift:"success,0,optional" frugal:"0,optional,LoginResponse" json:"success,omitempty"`
+}
+
+func NewUserServiceLoginResult() *UserServiceLoginResult {
+	return &UserServiceLoginResult{}
+}
+
+func (p *UserServiceLoginResult) InitDefault() {
+}
+
+var UserServiceLoginResult_Success_DEFAULT *LoginResponse
+
+func (p *UserServiceLoginResult) GetSuccess() (v *LoginResponse) {
+	if !p.IsSetSuccess() {
+		return UserServiceLoginResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *UserServiceLoginResult) SetSuccess(x interface{}) {
+	p.Success = x.(*LoginResponse)
+}
+
+func (p *UserServiceLoginResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *UserServiceLoginResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserServiceLoginResult(%+v)", *p)
+}
+
+var fieldIDToName_UserServiceLoginResult = map[int16]string{
+	0: "success",
+}
+
+type UserServiceGetUserInfoArgs struct {
+	Req *UserInfoRequest `thrift:"req,1" frugal:"1,default,UserInfoRequest" json:"req"`
+}
+
+func NewUserServiceGetUserInfoArgs() *UserServiceGetUserInfoArgs {
+	return &UserServiceGetUserInfoArgs{}
+}
+
+func (p *UserServiceGetUserInfoArgs) InitDefault() {
+}
+
+var UserServiceGetUserInfoArgs_Req_DEFAULT *UserInfoRequest
+
+func (p *UserServiceGetUserInfoArgs) GetReq() (v *UserInfoRequest) {
+	if !p.IsSetReq() {
+		return UserServiceGetUserInfoArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *UserServiceGetUserInfoArgs) SetReq(val *UserInfoRequest) {
+	p.Req = val
+}
+
+func (p *UserServiceGetUserInfoArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *UserServiceGetUserInfoArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserServiceGetUserInfoArgs(%+v)", *p)
+}
+
+var fieldIDToName_UserServiceGetUserInfoArgs = map[int16]string{
+	1: "req",
+}
+
+type UserServiceGetUserInfoResult struct {
+	Success *UserInfoResponse `thrift:"success,0,optional" frugal:"0,optional,UserInfoResponse" json:"success,omitempty"`
+}
+
+func NewUserServiceGetUserInfoResult() *UserServiceGetUserInfoResult {
+	return &UserServiceGetUserInfoResult{}
+}
+
+func (p *UserServiceGetUserInfoResult) InitDefault() {
+}
+
+var UserServiceGetUserInfoResult_Success_DEFAULT *UserInfoResponse
+
+func (p *UserServiceGetUserInfoResult) GetSuccess() (v *UserInfoResponse) {
+	if !p.IsSetSuccess() {
+		return UserServiceGetUserInfoResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *UserServiceGetUserInfoResult) SetSuccess(x interface{}) {
+	p.Success = x.(*UserInfoResponse)
+}
+
+func (p *UserServiceGetUserInfoResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *UserServiceGetUserInfoResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserServiceGetUserInfoResult(%+v)", *p)
+}
+
+var fieldIDToName_UserServiceGetUserInfoResult = map[int16]string{
+	0: "success",
+}
+
+type UserServiceUploadAvatarArgs struct {
+	Req *UploadAvatarRequest `thrift:"req,1" frugal:"1,default,UploadAvatarRequest" json:"req"`
+}
+
+func NewUserServiceUploadAvatarArgs() *UserServiceUploadAvatarArgs {
+	return &UserServiceUploadAvatarArgs{}
+}
+
+func (p *UserServiceUploadAvatarArgs) InitDefault() {
+}
+
+var UserServiceUploadAvatarArgs_Req_DEFAULT *UploadAvatarRequest
+
+func (p *UserServiceUploadAvatarArgs) GetReq() (v *UploadAvatarRequest) {
+	if !p.IsSetReq() {
+		return UserServiceUploadAvatarArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *UserServiceUploadAvatarArgs) SetReq(val *UploadAvatarRequest) {
+	p.Req = val
+}
+
+func (p *UserServiceUploadAvatarArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *UserServiceUploadAvatarArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserServiceUploadAvatarArgs(%+v)", *p)
+}
+
+var fieldIDToName_UserServiceUploadAvatarArgs = map[int16]string{
+	1: "req",
+}
+
+type UserServiceUploadAvatarResult struct {
+	Success *UploadAvatarResponse `thrift:"success,0,optional" frugal:"0,optional,UploadAvatarResponse" json:"success,omitempty"`
+}
+
+func NewUserServiceUploadAvatarResult() *UserServiceUploadAvatarResult {
+	return &UserServiceUploadAvatarResult{}
+}
+
+func (p *UserServiceUploadAvatarResult) InitDefault() {
+}
+
+var UserServiceUploadAvatarResult_Success_DEFAULT *UploadAvatarResponse
+
+func (p *UserServiceUploadAvatarResult) GetSuccess() (v *UploadAvatarResponse) {
+	if !p.IsSetSuccess() {
+		return UserServiceUploadAvatarResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *UserServiceUploadAvatarResult) SetSuccess(x interface{}) {
+	p.Success = x.(*UploadAvatarResponse)
+}
+
+func (p *UserServiceUploadAvatarResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *UserServiceUploadAvatarResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserServiceUploadAvatarResult(%+v)", *p)
+}
+
+var fieldIDToName_UserServiceUploadAvatarResult = map[int16]string{
+	0: "success",
+}
+
+type UserServiceRefreshTokenArgs struct {
+	Req *RefreshTokenRequest `thrift:"req,1" frugal:"1,default,RefreshTokenRequest" json:"req"`
+}
+
+func NewUserServiceRefreshTokenArgs() *UserServiceRefreshTokenArgs {
+	return &UserServiceRefreshTokenArgs{}
+}
+
+func (p *UserServiceRefreshTokenArgs) InitDefault() {
+}
+
+var UserServiceRefreshTokenArgs_Req_DEFAULT *RefreshTokenRequest
+
+func (p *UserServiceRefreshTokenArgs) GetReq() (v *RefreshTokenRequest) {
+	if !p.IsSetReq() {
+		return UserServiceRefreshTokenArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *UserServiceRefreshTokenArgs) SetReq(val *RefreshTokenRequest) {
+	p.Req = val
+}
+
+func (p *UserServiceRefreshTokenArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *UserServiceRefreshTokenArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserServiceRefreshTokenArgs(%+v)", *p)
+}
+
+var fieldIDToName_UserServiceRefreshTokenArgs = map[int16]string{
+	1: "req",
+}
+
+type UserServiceRefreshTokenResult struct {
+	Success *RefreshTokenResponse `thrift:"success,0,optional" frugal:"0,optional,RefreshTokenResponse" json:"success,omitempty"`
+}
+
+func NewUserServiceRefreshTokenResult() *UserServiceRefreshTokenResult {
+	return &UserServiceRefreshTokenResult{}
+}
+
+func (p *UserServiceRefreshTokenResult) InitDefault() {
+}
+
+var UserServiceRefreshTokenResult_Success_DEFAULT *RefreshTokenResponse
+
+func (p *UserServiceRefreshTokenResult) GetSuccess() (v *RefreshTokenResponse) {
+	if !p.IsSetSuccess() {
+		return UserServiceRefreshTokenResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *UserServiceRefreshTokenResult) SetSuccess(x interface{}) {
+	p.Success = x.(*RefreshTokenResponse)
+}
+
+func (p *UserServiceRefreshTokenResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *UserServiceRefreshTokenResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserServiceRefreshTokenResult(%+v)", *p)
+}
+
+var fieldIDToName_UserServiceRefreshTokenResult = map[int16]string{
+	0: "success",
+}
+
+type UserServiceFollowArgs struct {
+	Req *FollowRequest `thrift:"req,1" frugal:"1,default,FollowRequest" json:"req"`
+}
+
+func NewUserServiceFollowArgs() *UserServiceFollowArgs {
+	return &UserServiceFollowArgs{}
+}
+
+func (p *UserServiceFollowArgs) InitDefault() {
+}
+
+var UserServiceFollowArgs_Req_DEFAULT *FollowRequest
+
+func (p *UserServiceFollowArgs) GetReq() (v *FollowRequest) {
+	if !p.IsSetReq() {
+		return UserServiceFollowArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *UserServiceFollowArgs) SetReq(val *FollowRequest) {
+	p.Req = val
+}
+
+func (p *UserServiceFollowArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *UserServiceFollowArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserServiceFollowArgs(%+v)", *p)
+}
+
+var fieldIDToName_UserServiceFollowArgs = map[int16]string{
+	1: "req",
+}
+
+type UserServiceFollowResult struct {
+	Success *FollowResponse `thrift:"success,0,optional" frugal:"0,optional,FollowResponse" json:"success,omitempty"`
+}
+
+func NewUserServiceFollowResult() *UserServiceFollowResult {
+	return &UserServiceFollowResult{}
+}
+
+func (p *UserServiceFollowResult) InitDefault() {
+}
+
+var UserServiceFollowResult_Success_DEFAULT *FollowResponse
+
+func (p *UserServiceFollowResult) GetSuccess() (v *FollowResponse) {
+	if !p.IsSetSuccess() {
+		return UserServiceFollowResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *UserServiceFollowResult) SetSuccess(x interface{}) {
+	p.Success = x.(*FollowResponse)
+}
+
+func (p *UserServiceFollowResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *UserServiceFollowResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserServiceFollowResult(%+v)", *p)
+}
+
+var fieldIDToName_UserServiceFollowResult = map[int16]string{
+	0: "success",
+}
+
+type UserServiceUnfollowArgs struct {
+	Req *UnfollowRequest `thrift:"req,1" frugal:"1,default,UnfollowRequest" json:"req"`
+}
+
+func NewUserServiceUnfollowArgs() *UserServiceUnfollowArgs {
+	return &UserServiceUnfollowArgs{}
+}
+
+func (p *UserServiceUnfollowArgs) InitDefault() {
+}
+
+var UserServiceUnfollowArgs_Req_DEFAULT *UnfollowRequest
+
+func (p *UserServiceUnfollowArgs) GetReq() (v *UnfollowRequest) {
+	if !p.IsSetReq() {
+		return UserServiceUnfollowArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *UserServiceUnfollowArgs) SetReq(val *UnfollowRequest) {
+	p.Req = val
+}
+
+func (p *UserServiceUnfollowArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *UserServiceUnfollowArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserServiceUnfollowArgs(%+v)", *p)
+}
+
+var fieldIDToName_UserServiceUnfollowArgs = map[int16]string{
+	1: "req",
+}
+
+type UserServiceUnfollowResult struct {
+	Success *UnfollowResponse `thrift:"success,0,optional" frugal:"0,optional,UnfollowResponse" json:"success,omitempty"`
+}
 
-	GetUserInfo(ctx context.Context, req *UserInfoRequest) (r *UserInfoResponse, err error)
+func NewUserServiceUnfollowResult() *UserServiceUnfollowResult {
+	return &UserServiceUnfollowResult{}
+}
 
-	UploadAvatar(ctx context.Context, req *UploadAvatarRequest) (r *UploadAvatarResponse, err error)
+func (p *UserServiceUnfollowResult) InitDefault() {
+}
 
-	RefreshToken(ctx context.Context, req *RefreshTokenRequest) (r *RefreshTokenResponse, err error)
+var UserServiceUnfollowResult_Success_DEFAULT *UnfollowResponse
+
+func (p *UserServiceUnfollowResult) GetSuccess() (v *UnfollowResponse) {
+	if !p.IsSetSuccess() {
+		return UserServiceUnfollowResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *UserServiceUnfollowResult) SetSuccess(x interface{}) {
+	p.Success = x.(*UnfollowResponse)
 }
 
-type UserServiceRegisterArgs struct {
-	Req *RegisterRequest `thrift:"req,1" frugal:"1,default,RegisterRequest" json:"req"`
+func (p *UserServiceUnfollowResult) IsSetSuccess() bool {
+	return p.Success != nil
 }
 
-func NewUserServiceRegisterArgs() *UserServiceRegisterArgs {
-	return &UserServiceRegisterArgs{}
+func (p *UserServiceUnfollowResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserServiceUnfollowResult(%+v)", *p)
 }
 
-func (p *UserServiceRegisterArgs) InitDefault() {
+var fieldIDToName_UserServiceUnfollowResult = map[int16]string{
+	0: "success",
 }
 
-var UserServiceRegisterArgs_Req_DEFAULT *RegisterRequest
+type UserServiceGetFollowingArgs struct {
+	Req *FollowListRequest `thrift:"req,1" frugal:"1,default,FollowListRequest" json:"req"`
+}
 
-func (p *UserServiceRegisterArgs) GetReq() (v *RegisterRequest) {
+func NewUserServiceGetFollowingArgs() *UserServiceGetFollowingArgs {
+	return &UserServiceGetFollowingArgs{}
+}
+
+func (p *UserServiceGetFollowingArgs) InitDefault() {
+}
+
+var UserServiceGetFollowingArgs_Req_DEFAULT *FollowListRequest
+
+func (p *UserServiceGetFollowingArgs) GetReq() (v *FollowListRequest) {
 	if !p.IsSetReq() {
-		return UserServiceRegisterArgs_Req_DEFAULT
+		return UserServiceGetFollowingArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *UserServiceRegisterArgs) SetReq(val *RegisterRequest) {
+func (p *UserServiceGetFollowingArgs) SetReq(val *FollowListRequest) {
 	p.Req = val
 }
 
-func (p *UserServiceRegisterArgs) IsSetReq() bool {
+func (p *UserServiceGetFollowingArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *UserServiceRegisterArgs) String() string {
+func (p *UserServiceGetFollowingArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("UserServiceRegisterArgs(%+v)", *p)
+	return fmt.Sprintf("UserServiceGetFollowingArgs(%+v)", *p)
 }
 
-var fieldIDToName_UserServiceRegisterArgs = map[int16]string{
+var fieldIDToName_UserServiceGetFollowingArgs = map[int16]string{
 	1: "req",
 }
 
-type UserServiceRegisterResult struct {
-	Success *RegisterResponse `thrift:"success,0,optional" frugal:"0,optional,RegisterResponse" json:"success,omitempty"`
+type UserServiceGetFollowingResult struct {
+	Success *FollowListResponse `thrift:"success,0,optional" frugal:"0,optional,FollowListResponse" json:"success,omitempty"`
 }
 
-func NewUserServiceRegisterResult() *UserServiceRegisterResult {
-	return &UserServiceRegisterResult{}
+func NewUserServiceGetFollowingResult() *UserServiceGetFollowingResult {
+	return &UserServiceGetFollowingResult{}
 }
 
-func (p *UserServiceRegisterResult) InitDefault() {
+func (p *UserServiceGetFollowingResult) InitDefault() {
 }
 
-var UserServiceRegisterResult_Success_DEFAULT *RegisterResponse
+var UserServiceGetFollowingResult_Success_DEFAULT *FollowListResponse
 
-func (p *UserServiceRegisterResult) GetSuccess() (v *RegisterResponse) {
+func (p *UserServiceGetFollowingResult) GetSuccess() (v *FollowListResponse) {
 	if !p.IsSetSuccess() {
-		return UserServiceRegisterResult_Success_DEFAULT
+		return UserServiceGetFollowingResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *UserServiceRegisterResult) SetSuccess(x interface{}) {
-	p.Success = x.(*RegisterResponse)
+func (p *UserServiceGetFollowingResult) SetSuccess(x interface{}) {
+	p.Success = x.(*FollowListResponse)
 }
 
-func (p *UserServiceRegisterResult) IsSetSuccess() bool {
+func (p *UserServiceGetFollowingResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *UserServiceRegisterResult) String() string {
+func (p *UserServiceGetFollowingResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("UserServiceRegisterResult(%+v)", *p)
+	return fmt.Sprintf("UserServiceGetFollowingResult(%+v)", *p)
 }
 
-var fieldIDToName_UserServiceRegisterResult = map[int16]string{
+var fieldIDToName_UserServiceGetFollowingResult = map[int16]string{
 	0: "success",
 }
 
-type UserServiceLoginArgs struct {
-	Req *LoginRequest `thrift:"req,1" frugal:"1,default,LoginRequest" json:"req"`
+type UserServiceGetFollowersArgs struct {
+	Req *FollowListRequest `thrift:"req,1" frugal:"1,default,FollowListRequest" json:"req"`
 }
 
-func NewUserServiceLoginArgs() *UserServiceLoginArgs {
-	return &UserServiceLoginArgs{}
+func NewUserServiceGetFollowersArgs() *UserServiceGetFollowersArgs {
+	return &UserServiceGetFollowersArgs{}
 }
 
-func (p *UserServiceLoginArgs) InitDefault() {
+func (p *UserServiceGetFollowersArgs) InitDefault() {
 }
 
-var UserServiceLoginArgs_Req_DEFAULT *LoginRequest
+var UserServiceGetFollowersArgs_Req_DEFAULT *FollowListRequest
 
-func (p *UserServiceLoginArgs) GetReq() (v *LoginRequest) {
+func (p *UserServiceGetFollowersArgs) GetReq() (v *FollowListRequest) {
 	if !p.IsSetReq() {
-		return UserServiceLoginArgs_Req_DEFAULT
+		return UserServiceGetFollowersArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *UserServiceLoginArgs) SetReq(val *LoginRequest) {
+func (p *UserServiceGetFollowersArgs) SetReq(val *FollowListRequest) {
 	p.Req = val
 }
 
-func (p *UserServiceLoginArgs) IsSetReq() bool {
+func (p *UserServiceGetFollowersArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *UserServiceLoginArgs) String() string {
+func (p *UserServiceGetFollowersArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("UserServiceLoginArgs(%+v)", *p)
+	return fmt.Sprintf("UserServiceGetFollowersArgs(%+v)", *p)
 }
 
-var fieldIDToName_UserServiceLoginArgs = map[int16]string{
+var fieldIDToName_UserServiceGetFollowersArgs = map[int16]string{
 	1: "req",
 }
 
-type UserServiceLoginResult struct {
-	Success *LoginResponse `thrift:"success,0,optional" frugal:"0,optional,LoginResponse" json:"success,omitempty"`
+type UserServiceGetFollowersResult struct {
+	Success *FollowListResponse `thrift:"success,0,optional" frugal:"0,optional,FollowListResponse" json:"success,omitempty"`
 }
 
-func NewUserServiceLoginResult() *UserServiceLoginResult {
-	return &UserServiceLoginResult{}
+func NewUserServiceGetFollowersResult() *UserServiceGetFollowersResult {
+	return &UserServiceGetFollowersResult{}
 }
 
-func (p *UserServiceLoginResult) InitDefault() {
+func (p *UserServiceGetFollowersResult) InitDefault() {
 }
 
-var UserServiceLoginResult_Success_DEFAULT *LoginResponse
+var UserServiceGetFollowersResult_Success_DEFAULT *FollowListResponse
 
-func (p *UserServiceLoginResult) GetSuccess() (v *LoginResponse) {
+func (p *UserServiceGetFollowersResult) GetSuccess() (v *FollowListResponse) {
 	if !p.IsSetSuccess() {
-		return UserServiceLoginResult_Success_DEFAULT
+		return UserServiceGetFollowersResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *UserServiceLoginResult) SetSuccess(x interface{}) {
-	p.Success = x.(*LoginResponse)
+func (p *UserServiceGetFollowersResult) SetSuccess(x interface{}) {
+	p.Success = x.(*FollowListResponse)
 }
 
-func (p *UserServiceLoginResult) IsSetSuccess() bool {
+func (p *UserServiceGetFollowersResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *UserServiceLoginResult) String() string {
+func (p *UserServiceGetFollowersResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("UserServiceLoginResult(%+v)", *p)
+	return fmt.Sprintf("UserServiceGetFollowersResult(%+v)", *p)
 }
 
-var fieldIDToName_UserServiceLoginResult = map[int16]string{
+var fieldIDToName_UserServiceGetFollowersResult = map[int16]string{
 	0: "success",
 }
 
-type UserServiceGetUserInfoArgs struct {
-	Req *UserInfoRequest `thrift:"req,1" frugal:"1,default,UserInfoRequest" json:"req"`
+type UserServiceBlockArgs struct {
+	Req *BlockRequest `thrift:"req,1" frugal:"1,default,BlockRequest" json:"req"`
 }
 
-func NewUserServiceGetUserInfoArgs() *UserServiceGetUserInfoArgs {
-	return &UserServiceGetUserInfoArgs{}
+func NewUserServiceBlockArgs() *UserServiceBlockArgs {
+	return &UserServiceBlockArgs{}
 }
 
-func (p *UserServiceGetUserInfoArgs) InitDefault() {
+func (p *UserServiceBlockArgs) InitDefault() {
 }
 
-var UserServiceGetUserInfoArgs_Req_DEFAULT *UserInfoRequest
+var UserServiceBlockArgs_Req_DEFAULT *BlockRequest
 
-func (p *UserServiceGetUserInfoArgs) GetReq() (v *UserInfoRequest) {
+func (p *UserServiceBlockArgs) GetReq() (v *BlockRequest) {
 	if !p.IsSetReq() {
-		return UserServiceGetUserInfoArgs_Req_DEFAULT
+		return UserServiceBlockArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *UserServiceGetUserInfoArgs) SetReq(val *UserInfoRequest) {
+func (p *UserServiceBlockArgs) SetReq(val *BlockRequest) {
 	p.Req = val
 }
 
-func (p *UserServiceGetUserInfoArgs) IsSetReq() bool {
+func (p *UserServiceBlockArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *UserServiceGetUserInfoArgs) String() string {
+func (p *UserServiceBlockArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("UserServiceGetUserInfoArgs(%+v)", *p)
+	return fmt.Sprintf("UserServiceBlockArgs(%+v)", *p)
 }
 
-var fieldIDToName_UserServiceGetUserInfoArgs = map[int16]string{
+var fieldIDToName_UserServiceBlockArgs = map[int16]string{
 	1: "req",
 }
 
-type UserServiceGetUserInfoResult struct {
-	Success *UserInfoResponse `thrift:"success,0,optional" frugal:"0,optional,UserInfoResponse" json:"success,omitempty"`
+type UserServiceBlockResult struct {
+	Success *BlockResponse `thrift:"success,0,optional" frugal:"0,optional,BlockResponse" json:"success,omitempty"`
 }
 
-func NewUserServiceGetUserInfoResult() *UserServiceGetUserInfoResult {
-	return &UserServiceGetUserInfoResult{}
+func NewUserServiceBlockResult() *UserServiceBlockResult {
+	return &UserServiceBlockResult{}
 }
 
-func (p *UserServiceGetUserInfoResult) InitDefault() {
+func (p *UserServiceBlockResult) InitDefault() {
 }
 
-var UserServiceGetUserInfoResult_Success_DEFAULT *UserInfoResponse
+var UserServiceBlockResult_Success_DEFAULT *BlockResponse
 
-func (p *UserServiceGetUserInfoResult) GetSuccess() (v *UserInfoResponse) {
+func (p *UserServiceBlockResult) GetSuccess() (v *BlockResponse) {
 	if !p.IsSetSuccess() {
-		return UserServiceGetUserInfoResult_Success_DEFAULT
+		return UserServiceBlockResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *UserServiceGetUserInfoResult) SetSuccess(x interface{}) {
-	p.Success = x.(*UserInfoResponse)
+func (p *UserServiceBlockResult) SetSuccess(x interface{}) {
+	p.Success = x.(*BlockResponse)
 }
 
-func (p *UserServiceGetUserInfoResult) IsSetSuccess() bool {
+func (p *UserServiceBlockResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *UserServiceGetUserInfoResult) String() string {
+func (p *UserServiceBlockResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("UserServiceGetUserInfoResult(%+v)", *p)
+	return fmt.Sprintf("UserServiceBlockResult(%+v)", *p)
 }
 
-var fieldIDToName_UserServiceGetUserInfoResult = map[int16]string{
+var fieldIDToName_UserServiceBlockResult = map[int16]string{
 	0: "success",
 }
 
-type UserServiceUploadAvatarArgs struct {
-	Req *UploadAvatarRequest `thrift:"req,1" frugal:"1,default,UploadAvatarRequest" json:"req"`
+type UserServiceUnblockArgs struct {
+	Req *UnblockRequest `thrift:"req,1" frugal:"1,default,UnblockRequest" json:"req"`
 }
 
-func NewUserServiceUploadAvatarArgs() *UserServiceUploadAvatarArgs {
-	return &UserServiceUploadAvatarArgs{}
+func NewUserServiceUnblockArgs() *UserServiceUnblockArgs {
+	return &UserServiceUnblockArgs{}
 }
 
-func (p *UserServiceUploadAvatarArgs) InitDefault() {
+func (p *UserServiceUnblockArgs) InitDefault() {
 }
 
-var UserServiceUploadAvatarArgs_Req_DEFAULT *UploadAvatarRequest
+var UserServiceUnblockArgs_Req_DEFAULT *UnblockRequest
 
-func (p *UserServiceUploadAvatarArgs) GetReq() (v *UploadAvatarRequest) {
+func (p *UserServiceUnblockArgs) GetReq() (v *UnblockRequest) {
 	if !p.IsSetReq() {
-		return UserServiceUploadAvatarArgs_Req_DEFAULT
+		return UserServiceUnblockArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *UserServiceUploadAvatarArgs) SetReq(val *UploadAvatarRequest) {
+func (p *UserServiceUnblockArgs) SetReq(val *UnblockRequest) {
 	p.Req = val
 }
 
-func (p *UserServiceUploadAvatarArgs) IsSetReq() bool {
+func (p *UserServiceUnblockArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *UserServiceUploadAvatarArgs) String() string {
+func (p *UserServiceUnblockArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("UserServiceUploadAvatarArgs(%+v)", *p)
+	return fmt.Sprintf("UserServiceUnblockArgs(%+v)", *p)
 }
 
-var fieldIDToName_UserServiceUploadAvatarArgs = map[int16]string{
+var fieldIDToName_UserServiceUnblockArgs = map[int16]string{
 	1: "req",
 }
 
-type UserServiceUploadAvatarResult struct {
-	Success *UploadAvatarResponse `thrift:"success,0,optional" frugal:"0,optional,UploadAvatarResponse" json:"success,omitempty"`
+type UserServiceUnblockResult struct {
+	Success *UnblockResponse `thrift:"success,0,optional" frugal:"0,optional,UnblockResponse" json:"success,omitempty"`
 }
 
-func NewUserServiceUploadAvatarResult() *UserServiceUploadAvatarResult {
-	return &UserServiceUploadAvatarResult{}
+func NewUserServiceUnblockResult() *UserServiceUnblockResult {
+	return &UserServiceUnblockResult{}
 }
 
-func (p *UserServiceUploadAvatarResult) InitDefault() {
+func (p *UserServiceUnblockResult) InitDefault() {
 }
 
-var UserServiceUploadAvatarResult_Success_DEFAULT *UploadAvatarResponse
+var UserServiceUnblockResult_Success_DEFAULT *UnblockResponse
 
-func (p *UserServiceUploadAvatarResult) GetSuccess() (v *UploadAvatarResponse) {
+func (p *UserServiceUnblockResult) GetSuccess() (v *UnblockResponse) {
 	if !p.IsSetSuccess() {
-		return UserServiceUploadAvatarResult_Success_DEFAULT
+		return UserServiceUnblockResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *UserServiceUploadAvatarResult) SetSuccess(x interface{}) {
-	p.Success = x.(*UploadAvatarResponse)
+func (p *UserServiceUnblockResult) SetSuccess(x interface{}) {
+	p.Success = x.(*UnblockResponse)
 }
 
-func (p *UserServiceUploadAvatarResult) IsSetSuccess() bool {
+func (p *UserServiceUnblockResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *UserServiceUploadAvatarResult) String() string {
+func (p *UserServiceUnblockResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("UserServiceUploadAvatarResult(%+v)", *p)
+	return fmt.Sprintf("UserServiceUnblockResult(%+v)", *p)
 }
 
-var fieldIDToName_UserServiceUploadAvatarResult = map[int16]string{
+var fieldIDToName_UserServiceUnblockResult = map[int16]string{
 	0: "success",
 }
 
-type UserServiceRefreshTokenArgs struct {
-	Req *RefreshTokenRequest `thrift:"req,1" frugal:"1,default,RefreshTokenRequest" json:"req"`
+type UserServiceGetBlockListArgs struct {
+	Req *BlockListRequest `thrift:"req,1" frugal:"1,default,BlockListRequest" json:"req"`
 }
 
-func NewUserServiceRefreshTokenArgs() *UserServiceRefreshTokenArgs {
-	return &UserServiceRefreshTokenArgs{}
+func NewUserServiceGetBlockListArgs() *UserServiceGetBlockListArgs {
+	return &UserServiceGetBlockListArgs{}
 }
 
-func (p *UserServiceRefreshTokenArgs) InitDefault() {
+func (p *UserServiceGetBlockListArgs) InitDefault() {
 }
 
-var UserServiceRefreshTokenArgs_Req_DEFAULT *RefreshTokenRequest
+var UserServiceGetBlockListArgs_Req_DEFAULT *BlockListRequest
 
-func (p *UserServiceRefreshTokenArgs) GetReq() (v *RefreshTokenRequest) {
+func (p *UserServiceGetBlockListArgs) GetReq() (v *BlockListRequest) {
 	if !p.IsSetReq() {
-		return UserServiceRefreshTokenArgs_Req_DEFAULT
+		return UserServiceGetBlockListArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *UserServiceRefreshTokenArgs) SetReq(val *RefreshTokenRequest) {
+func (p *UserServiceGetBlockListArgs) SetReq(val *BlockListRequest) {
 	p.Req = val
 }
 
-func (p *UserServiceRefreshTokenArgs) IsSetReq() bool {
+func (p *UserServiceGetBlockListArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *UserServiceRefreshTokenArgs) String() string {
+func (p *UserServiceGetBlockListArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("UserServiceRefreshTokenArgs(%+v)", *p)
+	return fmt.Sprintf("UserServiceGetBlockListArgs(%+v)", *p)
 }
 
-var fieldIDToName_UserServiceRefreshTokenArgs = map[int16]string{
+var fieldIDToName_UserServiceGetBlockListArgs = map[int16]string{
 	1: "req",
 }
 
-type UserServiceRefreshTokenResult struct {
-	Success *RefreshTokenResponse `thrift:"success,0,optional" frugal:"0,optional,RefreshTokenResponse" json:"success,omitempty"`
+type UserServiceGetBlockListResult struct {
+	Success *BlockListResponse `thrift:"success,0,optional" frugal:"0,optional,BlockListResponse" json:"success,omitempty"`
 }
 
-func NewUserServiceRefreshTokenResult() *UserServiceRefreshTokenResult {
-	return &UserServiceRefreshTokenResult{}
+func NewUserServiceGetBlockListResult() *UserServiceGetBlockListResult {
+	return &UserServiceGetBlockListResult{}
 }
 
-func (p *UserServiceRefreshTokenResult) InitDefault() {
+func (p *UserServiceGetBlockListResult) InitDefault() {
 }
 
-var UserServiceRefreshTokenResult_Success_DEFAULT *RefreshTokenResponse
+var UserServiceGetBlockListResult_Success_DEFAULT *BlockListResponse
 
-func (p *UserServiceRefreshTokenResult) GetSuccess() (v *RefreshTokenResponse) {
+func (p *UserServiceGetBlockListResult) GetSuccess() (v *BlockListResponse) {
 	if !p.IsSetSuccess() {
-		return UserServiceRefreshTokenResult_Success_DEFAULT
+		return UserServiceGetBlockListResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *UserServiceRefreshTokenResult) SetSuccess(x interface{}) {
-	p.Success = x.(*RefreshTokenResponse)
+func (p *UserServiceGetBlockListResult) SetSuccess(x interface{}) {
+	p.Success = x.(*BlockListResponse)
 }
 
-func (p *UserServiceRefreshTokenResult) IsSetSuccess() bool {
+func (p *UserServiceGetBlockListResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *UserServiceRefreshTokenResult) String() string {
+func (p *UserServiceGetBlockListResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("UserServiceRefreshTokenResult(%+v)", *p)
+	return fmt.Sprintf("UserServiceGetBlockListResult(%+v)", *p)
 }
 
-var fieldIDToName_UserServiceRefreshTokenResult = map[int16]string{
+var fieldIDToName_UserServiceGetBlockListResult = map[int16]string{
+	0: "success",
+}
+
+type UserServiceGetPrivacySettingsArgs struct {
+	Req *GetPrivacySettingsRequest `thrift:"req,1" frugal:"1,default,GetPrivacySettingsRequest" json:"req"`
+}
+
+func NewUserServiceGetPrivacySettingsArgs() *UserServiceGetPrivacySettingsArgs {
+	return &UserServiceGetPrivacySettingsArgs{}
+}
+
+func (p *UserServiceGetPrivacySettingsArgs) InitDefault() {
+}
+
+var UserServiceGetPrivacySettingsArgs_Req_DEFAULT *GetPrivacySettingsRequest
+
+func (p *UserServiceGetPrivacySettingsArgs) GetReq() (v *GetPrivacySettingsRequest) {
+	if !p.IsSetReq() {
+		return UserServiceGetPrivacySettingsArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *UserServiceGetPrivacySettingsArgs) SetReq(val *GetPrivacySettingsRequest) {
+	p.Req = val
+}
+
+func (p *UserServiceGetPrivacySettingsArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *UserServiceGetPrivacySettingsArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserServiceGetPrivacySettingsArgs(%+v)", *p)
+}
+
+var fieldIDToName_UserServiceGetPrivacySettingsArgs = map[int16]string{
+	1: "req",
+}
+
+type UserServiceGetPrivacySettingsResult struct {
+	Success *GetPrivacySettingsResponse `thrift:"success,0,optional" frugal:"0,optional,GetPrivacySettingsResponse" json:"success,omitempty"`
+}
+
+func NewUserServiceGetPrivacySettingsResult() *UserServiceGetPrivacySettingsResult {
+	return &UserServiceGetPrivacySettingsResult{}
+}
+
+func (p *UserServiceGetPrivacySettingsResult) InitDefault() {
+}
+
+var UserServiceGetPrivacySettingsResult_Success_DEFAULT *GetPrivacySettingsResponse
+
+func (p *UserServiceGetPrivacySettingsResult) GetSuccess() (v *GetPrivacySettingsResponse) {
+	if !p.IsSetSuccess() {
+		return UserServiceGetPrivacySettingsResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *UserServiceGetPrivacySettingsResult) SetSuccess(x interface{}) {
+	p.Success = x.(*GetPrivacySettingsResponse)
+}
+
+func (p *UserServiceGetPrivacySettingsResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *UserServiceGetPrivacySettingsResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserServiceGetPrivacySettingsResult(%+v)", *p)
+}
+
+var fieldIDToName_UserServiceGetPrivacySettingsResult = map[int16]string{
+	0: "success",
+}
+
+type UserServiceUpdatePrivacySettingsArgs struct {
+	Req *UpdatePrivacySettingsRequest `thrift:"req,1" frugal:"1,default,UpdatePrivacySettingsRequest" json:"req"`
+}
+
+func NewUserServiceUpdatePrivacySettingsArgs() *UserServiceUpdatePrivacySettingsArgs {
+	return &UserServiceUpdatePrivacySettingsArgs{}
+}
+
+func (p *UserServiceUpdatePrivacySettingsArgs) InitDefault() {
+}
+
+var UserServiceUpdatePrivacySettingsArgs_Req_DEFAULT *UpdatePrivacySettingsRequest
+
+func (p *UserServiceUpdatePrivacySettingsArgs) GetReq() (v *UpdatePrivacySettingsRequest) {
+	if !p.IsSetReq() {
+		return UserServiceUpdatePrivacySettingsArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *UserServiceUpdatePrivacySettingsArgs) SetReq(val *UpdatePrivacySettingsRequest) {
+	p.Req = val
+}
+
+func (p *UserServiceUpdatePrivacySettingsArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *UserServiceUpdatePrivacySettingsArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserServiceUpdatePrivacySettingsArgs(%+v)", *p)
+}
+
+var fieldIDToName_UserServiceUpdatePrivacySettingsArgs = map[int16]string{
+	1: "req",
+}
+
+type UserServiceUpdatePrivacySettingsResult struct {
+	Success *UpdatePrivacySettingsResponse `thrift:"success,0,optional" frugal:"0,optional,UpdatePrivacySettingsResponse" json:"success,omitempty"`
+}
+
+func NewUserServiceUpdatePrivacySettingsResult() *UserServiceUpdatePrivacySettingsResult {
+	return &UserServiceUpdatePrivacySettingsResult{}
+}
+
+func (p *UserServiceUpdatePrivacySettingsResult) InitDefault() {
+}
+
+var UserServiceUpdatePrivacySettingsResult_Success_DEFAULT *UpdatePrivacySettingsResponse
+
+func (p *UserServiceUpdatePrivacySettingsResult) GetSuccess() (v *UpdatePrivacySettingsResponse) {
+	if !p.IsSetSuccess() {
+		return UserServiceUpdatePrivacySettingsResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *UserServiceUpdatePrivacySettingsResult) SetSuccess(x interface{}) {
+	p.Success = x.(*UpdatePrivacySettingsResponse)
+}
+
+func (p *UserServiceUpdatePrivacySettingsResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *UserServiceUpdatePrivacySettingsResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserServiceUpdatePrivacySettingsResult(%+v)", *p)
+}
+
+var fieldIDToName_UserServiceUpdatePrivacySettingsResult = map[int16]string{
 	0: "success",
 }
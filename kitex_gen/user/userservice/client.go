@@ -16,6 +16,15 @@ type Client interface {
 	GetUserInfo(ctx context.Context, req *user.UserInfoRequest, callOptions ...callopt.Option) (r *user.UserInfoResponse, err error)
 	UploadAvatar(ctx context.Context, req *user.UploadAvatarRequest, callOptions ...callopt.Option) (r *user.UploadAvatarResponse, err error)
 	RefreshToken(ctx context.Context, req *user.RefreshTokenRequest, callOptions ...callopt.Option) (r *user.RefreshTokenResponse, err error)
+	Follow(ctx context.Context, req *user.FollowRequest, callOptions ...callopt.Option) (r *user.FollowResponse, err error)
+	Unfollow(ctx context.Context, req *user.UnfollowRequest, callOptions ...callopt.Option) (r *user.UnfollowResponse, err error)
+	GetFollowing(ctx context.Context, req *user.FollowListRequest, callOptions ...callopt.Option) (r *user.FollowListResponse, err error)
+	GetFollowers(ctx context.Context, req *user.FollowListRequest, callOptions ...callopt.Option) (r *user.FollowListResponse, err error)
+	Block(ctx context.Context, req *user.BlockRequest, callOptions ...callopt.Option) (r *user.BlockResponse, err error)
+	Unblock(ctx context.Context, req *user.UnblockRequest, callOptions ...callopt.Option) (r *user.UnblockResponse, err error)
+	GetBlockList(ctx context.Context, req *user.BlockListRequest, callOptions ...callopt.Option) (r *user.BlockListResponse, err error)
+	GetPrivacySettings(ctx context.Context, req *user.GetPrivacySettingsRequest, callOptions ...callopt.Option) (r *user.GetPrivacySettingsResponse, err error)
+	UpdatePrivacySettings(ctx context.Context, req *user.UpdatePrivacySettingsRequest, callOptions ...callopt.Option) (r *user.UpdatePrivacySettingsResponse, err error)
 }
 
 // NewClient creates a client for the service defined in IDL.
@@ -71,3 +80,48 @@ func (p *kUserServiceClient) RefreshToken(ctx context.Context, req *user.Refresh
 	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
 	return p.kClient.RefreshToken(ctx, req)
 }
+
+func (p *kUserServiceClient) Follow(ctx context.Context, req *user.FollowRequest, callOptions ...callopt.Option) (r *user.FollowResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.Follow(ctx, req)
+}
+
+func (p *kUserServiceClient) Unfollow(ctx context.Context, req *user.UnfollowRequest, callOptions ...callopt.Option) (r *user.UnfollowResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.Unfollow(ctx, req)
+}
+
+func (p *kUserServiceClient) GetFollowing(ctx context.Context, req *user.FollowListRequest, callOptions ...callopt.Option) (r *user.FollowListResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.GetFollowing(ctx, req)
+}
+
+func (p *kUserServiceClient) GetFollowers(ctx context.Context, req *user.FollowListRequest, callOptions ...callopt.Option) (r *user.FollowListResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.GetFollowers(ctx, req)
+}
+
+func (p *kUserServiceClient) Block(ctx context.Context, req *user.BlockRequest, callOptions ...callopt.Option) (r *user.BlockResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.Block(ctx, req)
+}
+
+func (p *kUserServiceClient) Unblock(ctx context.Context, req *user.UnblockRequest, callOptions ...callopt.Option) (r *user.UnblockResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.Unblock(ctx, req)
+}
+
+func (p *kUserServiceClient) GetBlockList(ctx context.Context, req *user.BlockListRequest, callOptions ...callopt.Option) (r *user.BlockListResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.GetBlockList(ctx, req)
+}
+
+func (p *kUserServiceClient) GetPrivacySettings(ctx context.Context, req *user.GetPrivacySettingsRequest, callOptions ...callopt.Option) (r *user.GetPrivacySettingsResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.GetPrivacySettings(ctx, req)
+}
+
+func (p *kUserServiceClient) UpdatePrivacySettings(ctx context.Context, req *user.UpdatePrivacySettingsRequest, callOptions ...callopt.Option) (r *user.UpdatePrivacySettingsResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.UpdatePrivacySettings(ctx, req)
+}
@@ -48,6 +48,69 @@ var serviceMethods = map[string]kitex.MethodInfo{
 		false,
 		kitex.WithStreamingMode(kitex.StreamingNone),
 	),
+	"Follow": kitex.NewMethodInfo(
+		followHandler,
+		newUserServiceFollowArgs,
+		newUserServiceFollowResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"Unfollow": kitex.NewMethodInfo(
+		unfollowHandler,
+		newUserServiceUnfollowArgs,
+		newUserServiceUnfollowResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"GetFollowing": kitex.NewMethodInfo(
+		getFollowingHandler,
+		newUserServiceGetFollowingArgs,
+		newUserServiceGetFollowingResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"GetFollowers": kitex.NewMethodInfo(
+		getFollowersHandler,
+		newUserServiceGetFollowersArgs,
+		newUserServiceGetFollowersResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"Block": kitex.NewMethodInfo(
+		blockHandler,
+		newUserServiceBlockArgs,
+		newUserServiceBlockResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"Unblock": kitex.NewMethodInfo(
+		unblockHandler,
+		newUserServiceUnblockArgs,
+		newUserServiceUnblockResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"GetBlockList": kitex.NewMethodInfo(
+		getBlockListHandler,
+		newUserServiceGetBlockListArgs,
+		newUserServiceGetBlockListResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"GetPrivacySettings": kitex.NewMethodInfo(
+		getPrivacySettingsHandler,
+		newUserServiceGetPrivacySettingsArgs,
+		newUserServiceGetPrivacySettingsResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"UpdatePrivacySettings": kitex.NewMethodInfo(
+		updatePrivacySettingsHandler,
+		newUserServiceUpdatePrivacySettingsArgs,
+		newUserServiceUpdatePrivacySettingsResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
 }
 
 var (
@@ -204,6 +267,168 @@ func newUserServiceRefreshTokenResult() interface{} {
 	return user.NewUserServiceRefreshTokenResult()
 }
 
+func followHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*user.UserServiceFollowArgs)
+	realResult := result.(*user.UserServiceFollowResult)
+	success, err := handler.(user.UserService).Follow(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newUserServiceFollowArgs() interface{} {
+	return user.NewUserServiceFollowArgs()
+}
+
+func newUserServiceFollowResult() interface{} {
+	return user.NewUserServiceFollowResult()
+}
+
+func unfollowHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*user.UserServiceUnfollowArgs)
+	realResult := result.(*user.UserServiceUnfollowResult)
+	success, err := handler.(user.UserService).Unfollow(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newUserServiceUnfollowArgs() interface{} {
+	return user.NewUserServiceUnfollowArgs()
+}
+
+func newUserServiceUnfollowResult() interface{} {
+	return user.NewUserServiceUnfollowResult()
+}
+
+func getFollowingHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*user.UserServiceGetFollowingArgs)
+	realResult := result.(*user.UserServiceGetFollowingResult)
+	success, err := handler.(user.UserService).GetFollowing(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newUserServiceGetFollowingArgs() interface{} {
+	return user.NewUserServiceGetFollowingArgs()
+}
+
+func newUserServiceGetFollowingResult() interface{} {
+	return user.NewUserServiceGetFollowingResult()
+}
+
+func getFollowersHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*user.UserServiceGetFollowersArgs)
+	realResult := result.(*user.UserServiceGetFollowersResult)
+	success, err := handler.(user.UserService).GetFollowers(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newUserServiceGetFollowersArgs() interface{} {
+	return user.NewUserServiceGetFollowersArgs()
+}
+
+func newUserServiceGetFollowersResult() interface{} {
+	return user.NewUserServiceGetFollowersResult()
+}
+
+func blockHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*user.UserServiceBlockArgs)
+	realResult := result.(*user.UserServiceBlockResult)
+	success, err := handler.(user.UserService).Block(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newUserServiceBlockArgs() interface{} {
+	return user.NewUserServiceBlockArgs()
+}
+
+func newUserServiceBlockResult() interface{} {
+	return user.NewUserServiceBlockResult()
+}
+
+func unblockHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*user.UserServiceUnblockArgs)
+	realResult := result.(*user.UserServiceUnblockResult)
+	success, err := handler.(user.UserService).Unblock(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newUserServiceUnblockArgs() interface{} {
+	return user.NewUserServiceUnblockArgs()
+}
+
+func newUserServiceUnblockResult() interface{} {
+	return user.NewUserServiceUnblockResult()
+}
+
+func getBlockListHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*user.UserServiceGetBlockListArgs)
+	realResult := result.(*user.UserServiceGetBlockListResult)
+	success, err := handler.(user.UserService).GetBlockList(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newUserServiceGetBlockListArgs() interface{} {
+	return user.NewUserServiceGetBlockListArgs()
+}
+
+func newUserServiceGetBlockListResult() interface{} {
+	return user.NewUserServiceGetBlockListResult()
+}
+
+func getPrivacySettingsHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*user.UserServiceGetPrivacySettingsArgs)
+	realResult := result.(*user.UserServiceGetPrivacySettingsResult)
+	success, err := handler.(user.UserService).GetPrivacySettings(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newUserServiceGetPrivacySettingsArgs() interface{} {
+	return user.NewUserServiceGetPrivacySettingsArgs()
+}
+
+func newUserServiceGetPrivacySettingsResult() interface{} {
+	return user.NewUserServiceGetPrivacySettingsResult()
+}
+
+func updatePrivacySettingsHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*user.UserServiceUpdatePrivacySettingsArgs)
+	realResult := result.(*user.UserServiceUpdatePrivacySettingsResult)
+	success, err := handler.(user.UserService).UpdatePrivacySettings(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newUserServiceUpdatePrivacySettingsArgs() interface{} {
+	return user.NewUserServiceUpdatePrivacySettingsArgs()
+}
+
+func newUserServiceUpdatePrivacySettingsResult() interface{} {
+	return user.NewUserServiceUpdatePrivacySettingsResult()
+}
+
 type kClient struct {
 	c client.Client
 }
@@ -263,3 +488,93 @@ func (p *kClient) RefreshToken(ctx context.Context, req *user.RefreshTokenReques
 	}
 	return _result.GetSuccess(), nil
 }
+
+func (p *kClient) Follow(ctx context.Context, req *user.FollowRequest) (r *user.FollowResponse, err error) {
+	var _args user.UserServiceFollowArgs
+	_args.Req = req
+	var _result user.UserServiceFollowResult
+	if err = p.c.Call(ctx, "Follow", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) Unfollow(ctx context.Context, req *user.UnfollowRequest) (r *user.UnfollowResponse, err error) {
+	var _args user.UserServiceUnfollowArgs
+	_args.Req = req
+	var _result user.UserServiceUnfollowResult
+	if err = p.c.Call(ctx, "Unfollow", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) GetFollowing(ctx context.Context, req *user.FollowListRequest) (r *user.FollowListResponse, err error) {
+	var _args user.UserServiceGetFollowingArgs
+	_args.Req = req
+	var _result user.UserServiceGetFollowingResult
+	if err = p.c.Call(ctx, "GetFollowing", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) GetFollowers(ctx context.Context, req *user.FollowListRequest) (r *user.FollowListResponse, err error) {
+	var _args user.UserServiceGetFollowersArgs
+	_args.Req = req
+	var _result user.UserServiceGetFollowersResult
+	if err = p.c.Call(ctx, "GetFollowers", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) Block(ctx context.Context, req *user.BlockRequest) (r *user.BlockResponse, err error) {
+	var _args user.UserServiceBlockArgs
+	_args.Req = req
+	var _result user.UserServiceBlockResult
+	if err = p.c.Call(ctx, "Block", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) Unblock(ctx context.Context, req *user.UnblockRequest) (r *user.UnblockResponse, err error) {
+	var _args user.UserServiceUnblockArgs
+	_args.Req = req
+	var _result user.UserServiceUnblockResult
+	if err = p.c.Call(ctx, "Unblock", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) GetBlockList(ctx context.Context, req *user.BlockListRequest) (r *user.BlockListResponse, err error) {
+	var _args user.UserServiceGetBlockListArgs
+	_args.Req = req
+	var _result user.UserServiceGetBlockListResult
+	if err = p.c.Call(ctx, "GetBlockList", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) GetPrivacySettings(ctx context.Context, req *user.GetPrivacySettingsRequest) (r *user.GetPrivacySettingsResponse, err error) {
+	var _args user.UserServiceGetPrivacySettingsArgs
+	_args.Req = req
+	var _result user.UserServiceGetPrivacySettingsResult
+	if err = p.c.Call(ctx, "GetPrivacySettings", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) UpdatePrivacySettings(ctx context.Context, req *user.UpdatePrivacySettingsRequest) (r *user.UpdatePrivacySettingsResponse, err error) {
+	var _args user.UserServiceUpdatePrivacySettingsArgs
+	_args.Req = req
+	var _result user.UserServiceUpdatePrivacySettingsResult
+	if err = p.c.Call(ctx, "UpdatePrivacySettings", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
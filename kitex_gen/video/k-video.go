@@ -3943,6 +3943,646 @@ func (p *SemanticSearchResponse) field5Length() int {
 	return l
 }
 
+func (p *FollowingFeedRequest) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.I32 {
+				l, err = p.FastReadField2(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_FollowingFeedRequest[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+}
+
+func (p *FollowingFeedRequest) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.Cursor = _field
+	return offset, nil
+}
+
+func (p *FollowingFeedRequest) FastReadField2(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *int32
+	if v, l, err := thrift.Binary.ReadI32(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.Limit = _field
+	return offset, nil
+}
+
+func (p *FollowingFeedRequest) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *FollowingFeedRequest) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField1(buf[offset:], w)
+		offset += p.fastWriteField2(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *FollowingFeedRequest) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field1Length()
+		l += p.field2Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *FollowingFeedRequest) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetCursor() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 1)
+		offset += thrift.Binary.WriteI64(buf[offset:], *p.Cursor)
+	}
+	return offset
+}
+
+func (p *FollowingFeedRequest) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetLimit() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I32, 2)
+		offset += thrift.Binary.WriteI32(buf[offset:], *p.Limit)
+	}
+	return offset
+}
+
+func (p *FollowingFeedRequest) field1Length() int {
+	l := 0
+	if p.IsSetCursor() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.I64Length()
+	}
+	return l
+}
+
+func (p *FollowingFeedRequest) field2Length() int {
+	l := 0
+	if p.IsSetLimit() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.I32Length()
+	}
+	return l
+}
+
+func (p *FollowingFeedResponse) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetBase bool = false
+	var issetVideos bool = false
+	var issetHasMore bool = false
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+				issetBase = true
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.LIST {
+				l, err = p.FastReadField2(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+				issetVideos = true
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 3:
+			if fieldTypeId == thrift.I64 {
+				l, err = p.FastReadField3(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		case 4:
+			if fieldTypeId == thrift.BOOL {
+				l, err = p.FastReadField4(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+				issetHasMore = true
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	if !issetBase {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetVideos {
+		fieldId = 2
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetHasMore {
+		fieldId = 4
+		goto RequiredFieldNotSetError
+	}
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_FollowingFeedResponse[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+RequiredFieldNotSetError:
+	return offset, thrift.NewProtocolException(thrift.INVALID_DATA, fmt.Sprintf("required field %s is not set", fieldIDToName_FollowingFeedResponse[fieldId]))
+}
+
+func (p *FollowingFeedResponse) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+	_field := model.NewBaseResp()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.Base = _field
+	return offset, nil
+}
+
+func (p *FollowingFeedResponse) FastReadField2(buf []byte) (int, error) {
+	offset := 0
+
+	_, size, l, err := thrift.Binary.ReadListBegin(buf[offset:])
+	offset += l
+	if err != nil {
+		return offset, err
+	}
+	_field := make([]*model.Video, 0, size)
+	values := make([]model.Video, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+		if l, err := _elem.FastRead(buf[offset:]); err != nil {
+			return offset, err
+		} else {
+			offset += l
+		}
+
+		_field = append(_field, _elem)
+	}
+	p.Videos = _field
+	return offset, nil
+}
+
+func (p *FollowingFeedResponse) FastReadField3(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.NextCursor = _field
+	return offset, nil
+}
+
+func (p *FollowingFeedResponse) FastReadField4(buf []byte) (int, error) {
+	offset := 0
+
+	var _field bool
+	if v, l, err := thrift.Binary.ReadBool(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = v
+	}
+	p.HasMore = _field
+	return offset, nil
+}
+
+func (p *FollowingFeedResponse) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *FollowingFeedResponse) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField3(buf[offset:], w)
+		offset += p.fastWriteField4(buf[offset:], w)
+		offset += p.fastWriteField1(buf[offset:], w)
+		offset += p.fastWriteField2(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *FollowingFeedResponse) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field1Length()
+		l += p.field2Length()
+		l += p.field3Length()
+		l += p.field4Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *FollowingFeedResponse) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
+	offset += p.Base.FastWriteNocopy(buf[offset:], w)
+	return offset
+}
+
+func (p *FollowingFeedResponse) fastWriteField2(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.LIST, 2)
+	listBeginOffset := offset
+	offset += thrift.Binary.ListBeginLength()
+	var length int
+	for _, v := range p.Videos {
+		length++
+		offset += v.FastWriteNocopy(buf[offset:], w)
+	}
+	thrift.Binary.WriteListBegin(buf[listBeginOffset:], thrift.STRUCT, length)
+	return offset
+}
+
+func (p *FollowingFeedResponse) fastWriteField3(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetNextCursor() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 3)
+		offset += thrift.Binary.WriteI64(buf[offset:], *p.NextCursor)
+	}
+	return offset
+}
+
+func (p *FollowingFeedResponse) fastWriteField4(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.BOOL, 4)
+	offset += thrift.Binary.WriteBool(buf[offset:], p.HasMore)
+	return offset
+}
+
+func (p *FollowingFeedResponse) field1Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += p.Base.BLength()
+	return l
+}
+
+func (p *FollowingFeedResponse) field2Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += thrift.Binary.ListBeginLength()
+	for _, v := range p.Videos {
+		_ = v
+		l += v.BLength()
+	}
+	return l
+}
+
+func (p *FollowingFeedResponse) field3Length() int {
+	l := 0
+	if p.IsSetNextCursor() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.I64Length()
+	}
+	return l
+}
+
+func (p *FollowingFeedResponse) field4Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += thrift.Binary.BoolLength()
+	return l
+}
+
+func (p *BackfillFeedRequest) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetCreatorId bool = false
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+				issetCreatorId = true
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	if !issetCreatorId {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_BackfillFeedRequest[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+RequiredFieldNotSetError:
+	return offset, thrift.NewProtocolException(thrift.INVALID_DATA, fmt.Sprintf("required field %s is not set", fieldIDToName_BackfillFeedRequest[fieldId]))
+}
+
+func (p *BackfillFeedRequest) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+
+	var _field int64
+	if v, l, err := thrift.Binary.ReadI64(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = v
+	}
+	p.CreatorId = _field
+	return offset, nil
+}
+
+func (p *BackfillFeedRequest) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *BackfillFeedRequest) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField1(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *BackfillFeedRequest) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field1Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *BackfillFeedRequest) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.I64, 1)
+	offset += thrift.Binary.WriteI64(buf[offset:], p.CreatorId)
+	return offset
+}
+
+func (p *BackfillFeedRequest) field1Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += thrift.Binary.I64Length()
+	return l
+}
+
+func (p *BackfillFeedResponse) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetBase bool = false
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+				issetBase = true
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	if !issetBase {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_BackfillFeedResponse[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+RequiredFieldNotSetError:
+	return offset, thrift.NewProtocolException(thrift.INVALID_DATA, fmt.Sprintf("required field %s is not set", fieldIDToName_BackfillFeedResponse[fieldId]))
+}
+
+func (p *BackfillFeedResponse) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+	_field := model.NewBaseResp()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.Base = _field
+	return offset, nil
+}
+
+func (p *BackfillFeedResponse) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *BackfillFeedResponse) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField1(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *BackfillFeedResponse) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field1Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *BackfillFeedResponse) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
+	offset += p.Base.FastWriteNocopy(buf[offset:], w)
+	return offset
+}
+
+func (p *BackfillFeedResponse) field1Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += p.Base.BLength()
+	return l
+}
+
 func (p *VideoServicePublishArgs) FastRead(buf []byte) (int, error) {
 
 	var err error
@@ -3987,14 +4627,212 @@ func (p *VideoServicePublishArgs) FastRead(buf []byte) (int, error) {
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VideoServicePublishArgs[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VideoServicePublishArgs[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+}
+
+func (p *VideoServicePublishArgs) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+	_field := NewPublishRequest()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.Req = _field
+	return offset, nil
+}
+
+func (p *VideoServicePublishArgs) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *VideoServicePublishArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField1(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *VideoServicePublishArgs) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field1Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *VideoServicePublishArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
+	offset += p.Req.FastWriteNocopy(buf[offset:], w)
+	return offset
+}
+
+func (p *VideoServicePublishArgs) field1Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += p.Req.BLength()
+	return l
+}
+
+func (p *VideoServicePublishResult) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField0(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VideoServicePublishResult[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+}
+
+func (p *VideoServicePublishResult) FastReadField0(buf []byte) (int, error) {
+	offset := 0
+	_field := NewPublishResponse()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.Success = _field
+	return offset, nil
+}
+
+func (p *VideoServicePublishResult) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *VideoServicePublishResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField0(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *VideoServicePublishResult) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field0Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *VideoServicePublishResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetSuccess() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
+		offset += p.Success.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
+func (p *VideoServicePublishResult) field0Length() int {
+	l := 0
+	if p.IsSetSuccess() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.Success.BLength()
+	}
+	return l
+}
+
+func (p *VideoServiceListArgs) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VideoServiceListArgs[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *VideoServicePublishArgs) FastReadField1(buf []byte) (int, error) {
+func (p *VideoServiceListArgs) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := NewPublishRequest()
+	_field := NewVideoListRequest()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -4004,11 +4842,11 @@ func (p *VideoServicePublishArgs) FastReadField1(buf []byte) (int, error) {
 	return offset, nil
 }
 
-func (p *VideoServicePublishArgs) FastWrite(buf []byte) int {
+func (p *VideoServiceListArgs) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *VideoServicePublishArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *VideoServiceListArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
@@ -4017,7 +4855,7 @@ func (p *VideoServicePublishArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWri
 	return offset
 }
 
-func (p *VideoServicePublishArgs) BLength() int {
+func (p *VideoServiceListArgs) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -4026,21 +4864,21 @@ func (p *VideoServicePublishArgs) BLength() int {
 	return l
 }
 
-func (p *VideoServicePublishArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *VideoServiceListArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
 	offset += p.Req.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *VideoServicePublishArgs) field1Length() int {
+func (p *VideoServiceListArgs) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.Req.BLength()
 	return l
 }
 
-func (p *VideoServicePublishResult) FastRead(buf []byte) (int, error) {
+func (p *VideoServiceListResult) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -4084,14 +4922,14 @@ func (p *VideoServicePublishResult) FastRead(buf []byte) (int, error) {
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VideoServicePublishResult[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VideoServiceListResult[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *VideoServicePublishResult) FastReadField0(buf []byte) (int, error) {
+func (p *VideoServiceListResult) FastReadField0(buf []byte) (int, error) {
 	offset := 0
-	_field := NewPublishResponse()
+	_field := NewVideoListResponse()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -4101,11 +4939,11 @@ func (p *VideoServicePublishResult) FastReadField0(buf []byte) (int, error) {
 	return offset, nil
 }
 
-func (p *VideoServicePublishResult) FastWrite(buf []byte) int {
+func (p *VideoServiceListResult) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *VideoServicePublishResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *VideoServiceListResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField0(buf[offset:], w)
@@ -4114,7 +4952,7 @@ func (p *VideoServicePublishResult) FastWriteNocopy(buf []byte, w thrift.NocopyW
 	return offset
 }
 
-func (p *VideoServicePublishResult) BLength() int {
+func (p *VideoServiceListResult) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field0Length()
@@ -4123,7 +4961,7 @@ func (p *VideoServicePublishResult) BLength() int {
 	return l
 }
 
-func (p *VideoServicePublishResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+func (p *VideoServiceListResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetSuccess() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
@@ -4132,7 +4970,7 @@ func (p *VideoServicePublishResult) fastWriteField0(buf []byte, w thrift.NocopyW
 	return offset
 }
 
-func (p *VideoServicePublishResult) field0Length() int {
+func (p *VideoServiceListResult) field0Length() int {
 	l := 0
 	if p.IsSetSuccess() {
 		l += thrift.Binary.FieldBeginLength()
@@ -4141,7 +4979,7 @@ func (p *VideoServicePublishResult) field0Length() int {
 	return l
 }
 
-func (p *VideoServiceListArgs) FastRead(buf []byte) (int, error) {
+func (p *VideoServiceDetailArgs) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -4185,14 +5023,212 @@ func (p *VideoServiceListArgs) FastRead(buf []byte) (int, error) {
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VideoServiceListArgs[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VideoServiceDetailArgs[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *VideoServiceListArgs) FastReadField1(buf []byte) (int, error) {
+func (p *VideoServiceDetailArgs) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := NewVideoListRequest()
+	_field := NewDetailRequest()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.Req = _field
+	return offset, nil
+}
+
+func (p *VideoServiceDetailArgs) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *VideoServiceDetailArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField1(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *VideoServiceDetailArgs) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field1Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *VideoServiceDetailArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
+	offset += p.Req.FastWriteNocopy(buf[offset:], w)
+	return offset
+}
+
+func (p *VideoServiceDetailArgs) field1Length() int {
+	l := 0
+	l += thrift.Binary.FieldBeginLength()
+	l += p.Req.BLength()
+	return l
+}
+
+func (p *VideoServiceDetailResult) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField0(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VideoServiceDetailResult[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+}
+
+func (p *VideoServiceDetailResult) FastReadField0(buf []byte) (int, error) {
+	offset := 0
+	_field := NewDetailResponse()
+	if l, err := _field.FastRead(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+	}
+	p.Success = _field
+	return offset, nil
+}
+
+func (p *VideoServiceDetailResult) FastWrite(buf []byte) int {
+	return p.FastWriteNocopy(buf, nil)
+}
+
+func (p *VideoServiceDetailResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p != nil {
+		offset += p.fastWriteField0(buf[offset:], w)
+	}
+	offset += thrift.Binary.WriteFieldStop(buf[offset:])
+	return offset
+}
+
+func (p *VideoServiceDetailResult) BLength() int {
+	l := 0
+	if p != nil {
+		l += p.field0Length()
+	}
+	l += thrift.Binary.FieldStopLength()
+	return l
+}
+
+func (p *VideoServiceDetailResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetSuccess() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
+		offset += p.Success.FastWriteNocopy(buf[offset:], w)
+	}
+	return offset
+}
+
+func (p *VideoServiceDetailResult) field0Length() int {
+	l := 0
+	if p.IsSetSuccess() {
+		l += thrift.Binary.FieldBeginLength()
+		l += p.Success.BLength()
+	}
+	return l
+}
+
+func (p *VideoServiceGetHotVideosArgs) FastRead(buf []byte) (int, error) {
+
+	var err error
+	var offset int
+	var l int
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	for {
+		fieldTypeId, fieldId, l, err = thrift.Binary.ReadFieldBegin(buf[offset:])
+		offset += l
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				l, err = p.FastReadField1(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
+		default:
+			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+			offset += l
+			if err != nil {
+				goto SkipFieldError
+			}
+		}
+	}
+
+	return offset, nil
+ReadFieldBeginError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VideoServiceGetHotVideosArgs[fieldId]), err)
+SkipFieldError:
+	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+}
+
+func (p *VideoServiceGetHotVideosArgs) FastReadField1(buf []byte) (int, error) {
+	offset := 0
+	_field := NewHotVideoRequest()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -4202,11 +5238,11 @@ func (p *VideoServiceListArgs) FastReadField1(buf []byte) (int, error) {
 	return offset, nil
 }
 
-func (p *VideoServiceListArgs) FastWrite(buf []byte) int {
+func (p *VideoServiceGetHotVideosArgs) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *VideoServiceListArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *VideoServiceGetHotVideosArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
@@ -4215,7 +5251,7 @@ func (p *VideoServiceListArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter
 	return offset
 }
 
-func (p *VideoServiceListArgs) BLength() int {
+func (p *VideoServiceGetHotVideosArgs) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -4224,21 +5260,21 @@ func (p *VideoServiceListArgs) BLength() int {
 	return l
 }
 
-func (p *VideoServiceListArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *VideoServiceGetHotVideosArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
 	offset += p.Req.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *VideoServiceListArgs) field1Length() int {
+func (p *VideoServiceGetHotVideosArgs) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.Req.BLength()
 	return l
 }
 
-func (p *VideoServiceListResult) FastRead(buf []byte) (int, error) {
+func (p *VideoServiceGetHotVideosResult) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -4282,14 +5318,14 @@ func (p *VideoServiceListResult) FastRead(buf []byte) (int, error) {
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VideoServiceListResult[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VideoServiceGetHotVideosResult[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *VideoServiceListResult) FastReadField0(buf []byte) (int, error) {
+func (p *VideoServiceGetHotVideosResult) FastReadField0(buf []byte) (int, error) {
 	offset := 0
-	_field := NewVideoListResponse()
+	_field := NewHotVideoResponse()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -4299,11 +5335,11 @@ func (p *VideoServiceListResult) FastReadField0(buf []byte) (int, error) {
 	return offset, nil
 }
 
-func (p *VideoServiceListResult) FastWrite(buf []byte) int {
+func (p *VideoServiceGetHotVideosResult) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *VideoServiceListResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *VideoServiceGetHotVideosResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField0(buf[offset:], w)
@@ -4312,7 +5348,7 @@ func (p *VideoServiceListResult) FastWriteNocopy(buf []byte, w thrift.NocopyWrit
 	return offset
 }
 
-func (p *VideoServiceListResult) BLength() int {
+func (p *VideoServiceGetHotVideosResult) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field0Length()
@@ -4321,7 +5357,7 @@ func (p *VideoServiceListResult) BLength() int {
 	return l
 }
 
-func (p *VideoServiceListResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+func (p *VideoServiceGetHotVideosResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetSuccess() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
@@ -4330,7 +5366,7 @@ func (p *VideoServiceListResult) fastWriteField0(buf []byte, w thrift.NocopyWrit
 	return offset
 }
 
-func (p *VideoServiceListResult) field0Length() int {
+func (p *VideoServiceGetHotVideosResult) field0Length() int {
 	l := 0
 	if p.IsSetSuccess() {
 		l += thrift.Binary.FieldBeginLength()
@@ -4339,7 +5375,7 @@ func (p *VideoServiceListResult) field0Length() int {
 	return l
 }
 
-func (p *VideoServiceDetailArgs) FastRead(buf []byte) (int, error) {
+func (p *VideoServiceDeleteArgs) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -4383,14 +5419,14 @@ func (p *VideoServiceDetailArgs) FastRead(buf []byte) (int, error) {
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VideoServiceDetailArgs[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VideoServiceDeleteArgs[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *VideoServiceDetailArgs) FastReadField1(buf []byte) (int, error) {
+func (p *VideoServiceDeleteArgs) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := NewDetailRequest()
+	_field := NewDeleteRequest()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -4400,11 +5436,11 @@ func (p *VideoServiceDetailArgs) FastReadField1(buf []byte) (int, error) {
 	return offset, nil
 }
 
-func (p *VideoServiceDetailArgs) FastWrite(buf []byte) int {
+func (p *VideoServiceDeleteArgs) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *VideoServiceDetailArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *VideoServiceDeleteArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
@@ -4413,7 +5449,7 @@ func (p *VideoServiceDetailArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWrit
 	return offset
 }
 
-func (p *VideoServiceDetailArgs) BLength() int {
+func (p *VideoServiceDeleteArgs) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -4422,21 +5458,21 @@ func (p *VideoServiceDetailArgs) BLength() int {
 	return l
 }
 
-func (p *VideoServiceDetailArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *VideoServiceDeleteArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
 	offset += p.Req.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *VideoServiceDetailArgs) field1Length() int {
+func (p *VideoServiceDeleteArgs) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.Req.BLength()
 	return l
 }
 
-func (p *VideoServiceDetailResult) FastRead(buf []byte) (int, error) {
+func (p *VideoServiceDeleteResult) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -4480,14 +5516,14 @@ func (p *VideoServiceDetailResult) FastRead(buf []byte) (int, error) {
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VideoServiceDetailResult[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VideoServiceDeleteResult[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *VideoServiceDetailResult) FastReadField0(buf []byte) (int, error) {
+func (p *VideoServiceDeleteResult) FastReadField0(buf []byte) (int, error) {
 	offset := 0
-	_field := NewDetailResponse()
+	_field := NewDeleteResponse()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -4497,11 +5533,11 @@ func (p *VideoServiceDetailResult) FastReadField0(buf []byte) (int, error) {
 	return offset, nil
 }
 
-func (p *VideoServiceDetailResult) FastWrite(buf []byte) int {
+func (p *VideoServiceDeleteResult) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *VideoServiceDetailResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *VideoServiceDeleteResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField0(buf[offset:], w)
@@ -4510,7 +5546,7 @@ func (p *VideoServiceDetailResult) FastWriteNocopy(buf []byte, w thrift.NocopyWr
 	return offset
 }
 
-func (p *VideoServiceDetailResult) BLength() int {
+func (p *VideoServiceDeleteResult) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field0Length()
@@ -4519,7 +5555,7 @@ func (p *VideoServiceDetailResult) BLength() int {
 	return l
 }
 
-func (p *VideoServiceDetailResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+func (p *VideoServiceDeleteResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetSuccess() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
@@ -4528,7 +5564,7 @@ func (p *VideoServiceDetailResult) fastWriteField0(buf []byte, w thrift.NocopyWr
 	return offset
 }
 
-func (p *VideoServiceDetailResult) field0Length() int {
+func (p *VideoServiceDeleteResult) field0Length() int {
 	l := 0
 	if p.IsSetSuccess() {
 		l += thrift.Binary.FieldBeginLength()
@@ -4537,7 +5573,7 @@ func (p *VideoServiceDetailResult) field0Length() int {
 	return l
 }
 
-func (p *VideoServiceGetHotVideosArgs) FastRead(buf []byte) (int, error) {
+func (p *VideoServiceIncrementVisitCountArgs) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -4581,14 +5617,14 @@ func (p *VideoServiceGetHotVideosArgs) FastRead(buf []byte) (int, error) {
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VideoServiceGetHotVideosArgs[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VideoServiceIncrementVisitCountArgs[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *VideoServiceGetHotVideosArgs) FastReadField1(buf []byte) (int, error) {
+func (p *VideoServiceIncrementVisitCountArgs) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := NewHotVideoRequest()
+	_field := NewIncrementVisitCountRequest()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -4598,11 +5634,11 @@ func (p *VideoServiceGetHotVideosArgs) FastReadField1(buf []byte) (int, error) {
 	return offset, nil
 }
 
-func (p *VideoServiceGetHotVideosArgs) FastWrite(buf []byte) int {
+func (p *VideoServiceIncrementVisitCountArgs) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *VideoServiceGetHotVideosArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *VideoServiceIncrementVisitCountArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
@@ -4611,7 +5647,7 @@ func (p *VideoServiceGetHotVideosArgs) FastWriteNocopy(buf []byte, w thrift.Noco
 	return offset
 }
 
-func (p *VideoServiceGetHotVideosArgs) BLength() int {
+func (p *VideoServiceIncrementVisitCountArgs) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -4620,21 +5656,21 @@ func (p *VideoServiceGetHotVideosArgs) BLength() int {
 	return l
 }
 
-func (p *VideoServiceGetHotVideosArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *VideoServiceIncrementVisitCountArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
 	offset += p.Req.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *VideoServiceGetHotVideosArgs) field1Length() int {
+func (p *VideoServiceIncrementVisitCountArgs) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.Req.BLength()
 	return l
 }
 
-func (p *VideoServiceGetHotVideosResult) FastRead(buf []byte) (int, error) {
+func (p *VideoServiceIncrementVisitCountResult) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -4678,14 +5714,14 @@ func (p *VideoServiceGetHotVideosResult) FastRead(buf []byte) (int, error) {
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VideoServiceGetHotVideosResult[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VideoServiceIncrementVisitCountResult[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *VideoServiceGetHotVideosResult) FastReadField0(buf []byte) (int, error) {
+func (p *VideoServiceIncrementVisitCountResult) FastReadField0(buf []byte) (int, error) {
 	offset := 0
-	_field := NewHotVideoResponse()
+	_field := NewIncrementVisitCountResponse()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -4695,11 +5731,11 @@ func (p *VideoServiceGetHotVideosResult) FastReadField0(buf []byte) (int, error)
 	return offset, nil
 }
 
-func (p *VideoServiceGetHotVideosResult) FastWrite(buf []byte) int {
+func (p *VideoServiceIncrementVisitCountResult) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *VideoServiceGetHotVideosResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *VideoServiceIncrementVisitCountResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField0(buf[offset:], w)
@@ -4708,7 +5744,7 @@ func (p *VideoServiceGetHotVideosResult) FastWriteNocopy(buf []byte, w thrift.No
 	return offset
 }
 
-func (p *VideoServiceGetHotVideosResult) BLength() int {
+func (p *VideoServiceIncrementVisitCountResult) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field0Length()
@@ -4717,7 +5753,7 @@ func (p *VideoServiceGetHotVideosResult) BLength() int {
 	return l
 }
 
-func (p *VideoServiceGetHotVideosResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+func (p *VideoServiceIncrementVisitCountResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetSuccess() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
@@ -4726,7 +5762,7 @@ func (p *VideoServiceGetHotVideosResult) fastWriteField0(buf []byte, w thrift.No
 	return offset
 }
 
-func (p *VideoServiceGetHotVideosResult) field0Length() int {
+func (p *VideoServiceIncrementVisitCountResult) field0Length() int {
 	l := 0
 	if p.IsSetSuccess() {
 		l += thrift.Binary.FieldBeginLength()
@@ -4735,7 +5771,7 @@ func (p *VideoServiceGetHotVideosResult) field0Length() int {
 	return l
 }
 
-func (p *VideoServiceDeleteArgs) FastRead(buf []byte) (int, error) {
+func (p *VideoServiceIncrementLikeCountArgs) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -4779,14 +5815,14 @@ func (p *VideoServiceDeleteArgs) FastRead(buf []byte) (int, error) {
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VideoServiceDeleteArgs[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VideoServiceIncrementLikeCountArgs[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *VideoServiceDeleteArgs) FastReadField1(buf []byte) (int, error) {
+func (p *VideoServiceIncrementLikeCountArgs) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := NewDeleteRequest()
+	_field := NewIncrementLikeCountRequest()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -4796,11 +5832,11 @@ func (p *VideoServiceDeleteArgs) FastReadField1(buf []byte) (int, error) {
 	return offset, nil
 }
 
-func (p *VideoServiceDeleteArgs) FastWrite(buf []byte) int {
+func (p *VideoServiceIncrementLikeCountArgs) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *VideoServiceDeleteArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *VideoServiceIncrementLikeCountArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
@@ -4809,7 +5845,7 @@ func (p *VideoServiceDeleteArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWrit
 	return offset
 }
 
-func (p *VideoServiceDeleteArgs) BLength() int {
+func (p *VideoServiceIncrementLikeCountArgs) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -4818,21 +5854,21 @@ func (p *VideoServiceDeleteArgs) BLength() int {
 	return l
 }
 
-func (p *VideoServiceDeleteArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *VideoServiceIncrementLikeCountArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
 	offset += p.Req.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *VideoServiceDeleteArgs) field1Length() int {
+func (p *VideoServiceIncrementLikeCountArgs) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.Req.BLength()
 	return l
 }
 
-func (p *VideoServiceDeleteResult) FastRead(buf []byte) (int, error) {
+func (p *VideoServiceIncrementLikeCountResult) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -4876,14 +5912,14 @@ func (p *VideoServiceDeleteResult) FastRead(buf []byte) (int, error) {
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VideoServiceDeleteResult[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VideoServiceIncrementLikeCountResult[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *VideoServiceDeleteResult) FastReadField0(buf []byte) (int, error) {
+func (p *VideoServiceIncrementLikeCountResult) FastReadField0(buf []byte) (int, error) {
 	offset := 0
-	_field := NewDeleteResponse()
+	_field := NewIncrementLikeCountResponse()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -4893,11 +5929,11 @@ func (p *VideoServiceDeleteResult) FastReadField0(buf []byte) (int, error) {
 	return offset, nil
 }
 
-func (p *VideoServiceDeleteResult) FastWrite(buf []byte) int {
+func (p *VideoServiceIncrementLikeCountResult) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *VideoServiceDeleteResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *VideoServiceIncrementLikeCountResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField0(buf[offset:], w)
@@ -4906,7 +5942,7 @@ func (p *VideoServiceDeleteResult) FastWriteNocopy(buf []byte, w thrift.NocopyWr
 	return offset
 }
 
-func (p *VideoServiceDeleteResult) BLength() int {
+func (p *VideoServiceIncrementLikeCountResult) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field0Length()
@@ -4915,7 +5951,7 @@ func (p *VideoServiceDeleteResult) BLength() int {
 	return l
 }
 
-func (p *VideoServiceDeleteResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+func (p *VideoServiceIncrementLikeCountResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetSuccess() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
@@ -4924,7 +5960,7 @@ func (p *VideoServiceDeleteResult) fastWriteField0(buf []byte, w thrift.NocopyWr
 	return offset
 }
 
-func (p *VideoServiceDeleteResult) field0Length() int {
+func (p *VideoServiceIncrementLikeCountResult) field0Length() int {
 	l := 0
 	if p.IsSetSuccess() {
 		l += thrift.Binary.FieldBeginLength()
@@ -4933,7 +5969,7 @@ func (p *VideoServiceDeleteResult) field0Length() int {
 	return l
 }
 
-func (p *VideoServiceIncrementVisitCountArgs) FastRead(buf []byte) (int, error) {
+func (p *VideoServiceSearchArgs) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -4977,14 +6013,14 @@ func (p *VideoServiceIncrementVisitCountArgs) FastRead(buf []byte) (int, error)
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VideoServiceIncrementVisitCountArgs[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VideoServiceSearchArgs[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *VideoServiceIncrementVisitCountArgs) FastReadField1(buf []byte) (int, error) {
+func (p *VideoServiceSearchArgs) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := NewIncrementVisitCountRequest()
+	_field := NewSearchRequest()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -4994,11 +6030,11 @@ func (p *VideoServiceIncrementVisitCountArgs) FastReadField1(buf []byte) (int, e
 	return offset, nil
 }
 
-func (p *VideoServiceIncrementVisitCountArgs) FastWrite(buf []byte) int {
+func (p *VideoServiceSearchArgs) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *VideoServiceIncrementVisitCountArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *VideoServiceSearchArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
@@ -5007,7 +6043,7 @@ func (p *VideoServiceIncrementVisitCountArgs) FastWriteNocopy(buf []byte, w thri
 	return offset
 }
 
-func (p *VideoServiceIncrementVisitCountArgs) BLength() int {
+func (p *VideoServiceSearchArgs) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -5016,21 +6052,21 @@ func (p *VideoServiceIncrementVisitCountArgs) BLength() int {
 	return l
 }
 
-func (p *VideoServiceIncrementVisitCountArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *VideoServiceSearchArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
 	offset += p.Req.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *VideoServiceIncrementVisitCountArgs) field1Length() int {
+func (p *VideoServiceSearchArgs) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.Req.BLength()
 	return l
 }
 
-func (p *VideoServiceIncrementVisitCountResult) FastRead(buf []byte) (int, error) {
+func (p *VideoServiceSearchResult) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -5074,14 +6110,14 @@ func (p *VideoServiceIncrementVisitCountResult) FastRead(buf []byte) (int, error
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VideoServiceIncrementVisitCountResult[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VideoServiceSearchResult[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *VideoServiceIncrementVisitCountResult) FastReadField0(buf []byte) (int, error) {
+func (p *VideoServiceSearchResult) FastReadField0(buf []byte) (int, error) {
 	offset := 0
-	_field := NewIncrementVisitCountResponse()
+	_field := NewSearchResponse()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -5091,11 +6127,11 @@ func (p *VideoServiceIncrementVisitCountResult) FastReadField0(buf []byte) (int,
 	return offset, nil
 }
 
-func (p *VideoServiceIncrementVisitCountResult) FastWrite(buf []byte) int {
+func (p *VideoServiceSearchResult) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *VideoServiceIncrementVisitCountResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *VideoServiceSearchResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField0(buf[offset:], w)
@@ -5104,7 +6140,7 @@ func (p *VideoServiceIncrementVisitCountResult) FastWriteNocopy(buf []byte, w th
 	return offset
 }
 
-func (p *VideoServiceIncrementVisitCountResult) BLength() int {
+func (p *VideoServiceSearchResult) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field0Length()
@@ -5113,7 +6149,7 @@ func (p *VideoServiceIncrementVisitCountResult) BLength() int {
 	return l
 }
 
-func (p *VideoServiceIncrementVisitCountResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+func (p *VideoServiceSearchResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetSuccess() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
@@ -5122,7 +6158,7 @@ func (p *VideoServiceIncrementVisitCountResult) fastWriteField0(buf []byte, w th
 	return offset
 }
 
-func (p *VideoServiceIncrementVisitCountResult) field0Length() int {
+func (p *VideoServiceSearchResult) field0Length() int {
 	l := 0
 	if p.IsSetSuccess() {
 		l += thrift.Binary.FieldBeginLength()
@@ -5131,7 +6167,7 @@ func (p *VideoServiceIncrementVisitCountResult) field0Length() int {
 	return l
 }
 
-func (p *VideoServiceIncrementLikeCountArgs) FastRead(buf []byte) (int, error) {
+func (p *VideoServiceSemanticSearchArgs) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -5175,14 +6211,14 @@ func (p *VideoServiceIncrementLikeCountArgs) FastRead(buf []byte) (int, error) {
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VideoServiceIncrementLikeCountArgs[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VideoServiceSemanticSearchArgs[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *VideoServiceIncrementLikeCountArgs) FastReadField1(buf []byte) (int, error) {
+func (p *VideoServiceSemanticSearchArgs) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := NewIncrementLikeCountRequest()
+	_field := NewSemanticSearchRequest()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -5192,11 +6228,11 @@ func (p *VideoServiceIncrementLikeCountArgs) FastReadField1(buf []byte) (int, er
 	return offset, nil
 }
 
-func (p *VideoServiceIncrementLikeCountArgs) FastWrite(buf []byte) int {
+func (p *VideoServiceSemanticSearchArgs) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *VideoServiceIncrementLikeCountArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *VideoServiceSemanticSearchArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
@@ -5205,7 +6241,7 @@ func (p *VideoServiceIncrementLikeCountArgs) FastWriteNocopy(buf []byte, w thrif
 	return offset
 }
 
-func (p *VideoServiceIncrementLikeCountArgs) BLength() int {
+func (p *VideoServiceSemanticSearchArgs) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -5214,21 +6250,21 @@ func (p *VideoServiceIncrementLikeCountArgs) BLength() int {
 	return l
 }
 
-func (p *VideoServiceIncrementLikeCountArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *VideoServiceSemanticSearchArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
 	offset += p.Req.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *VideoServiceIncrementLikeCountArgs) field1Length() int {
+func (p *VideoServiceSemanticSearchArgs) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.Req.BLength()
 	return l
 }
 
-func (p *VideoServiceIncrementLikeCountResult) FastRead(buf []byte) (int, error) {
+func (p *VideoServiceSemanticSearchResult) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -5272,14 +6308,14 @@ func (p *VideoServiceIncrementLikeCountResult) FastRead(buf []byte) (int, error)
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VideoServiceIncrementLikeCountResult[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VideoServiceSemanticSearchResult[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *VideoServiceIncrementLikeCountResult) FastReadField0(buf []byte) (int, error) {
+func (p *VideoServiceSemanticSearchResult) FastReadField0(buf []byte) (int, error) {
 	offset := 0
-	_field := NewIncrementLikeCountResponse()
+	_field := NewSemanticSearchResponse()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -5289,11 +6325,11 @@ func (p *VideoServiceIncrementLikeCountResult) FastReadField0(buf []byte) (int,
 	return offset, nil
 }
 
-func (p *VideoServiceIncrementLikeCountResult) FastWrite(buf []byte) int {
+func (p *VideoServiceSemanticSearchResult) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *VideoServiceIncrementLikeCountResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *VideoServiceSemanticSearchResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField0(buf[offset:], w)
@@ -5302,7 +6338,7 @@ func (p *VideoServiceIncrementLikeCountResult) FastWriteNocopy(buf []byte, w thr
 	return offset
 }
 
-func (p *VideoServiceIncrementLikeCountResult) BLength() int {
+func (p *VideoServiceSemanticSearchResult) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field0Length()
@@ -5311,7 +6347,7 @@ func (p *VideoServiceIncrementLikeCountResult) BLength() int {
 	return l
 }
 
-func (p *VideoServiceIncrementLikeCountResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+func (p *VideoServiceSemanticSearchResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetSuccess() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
@@ -5320,7 +6356,7 @@ func (p *VideoServiceIncrementLikeCountResult) fastWriteField0(buf []byte, w thr
 	return offset
 }
 
-func (p *VideoServiceIncrementLikeCountResult) field0Length() int {
+func (p *VideoServiceSemanticSearchResult) field0Length() int {
 	l := 0
 	if p.IsSetSuccess() {
 		l += thrift.Binary.FieldBeginLength()
@@ -5329,7 +6365,7 @@ func (p *VideoServiceIncrementLikeCountResult) field0Length() int {
 	return l
 }
 
-func (p *VideoServiceSearchArgs) FastRead(buf []byte) (int, error) {
+func (p *VideoServiceGetFollowingFeedArgs) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -5373,14 +6409,14 @@ func (p *VideoServiceSearchArgs) FastRead(buf []byte) (int, error) {
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VideoServiceSearchArgs[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VideoServiceGetFollowingFeedArgs[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *VideoServiceSearchArgs) FastReadField1(buf []byte) (int, error) {
+func (p *VideoServiceGetFollowingFeedArgs) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := NewSearchRequest()
+	_field := NewFollowingFeedRequest()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -5390,11 +6426,11 @@ func (p *VideoServiceSearchArgs) FastReadField1(buf []byte) (int, error) {
 	return offset, nil
 }
 
-func (p *VideoServiceSearchArgs) FastWrite(buf []byte) int {
+func (p *VideoServiceGetFollowingFeedArgs) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *VideoServiceSearchArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *VideoServiceGetFollowingFeedArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
@@ -5403,7 +6439,7 @@ func (p *VideoServiceSearchArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWrit
 	return offset
 }
 
-func (p *VideoServiceSearchArgs) BLength() int {
+func (p *VideoServiceGetFollowingFeedArgs) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -5412,21 +6448,21 @@ func (p *VideoServiceSearchArgs) BLength() int {
 	return l
 }
 
-func (p *VideoServiceSearchArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *VideoServiceGetFollowingFeedArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
 	offset += p.Req.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *VideoServiceSearchArgs) field1Length() int {
+func (p *VideoServiceGetFollowingFeedArgs) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.Req.BLength()
 	return l
 }
 
-func (p *VideoServiceSearchResult) FastRead(buf []byte) (int, error) {
+func (p *VideoServiceGetFollowingFeedResult) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -5470,14 +6506,14 @@ func (p *VideoServiceSearchResult) FastRead(buf []byte) (int, error) {
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VideoServiceSearchResult[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VideoServiceGetFollowingFeedResult[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *VideoServiceSearchResult) FastReadField0(buf []byte) (int, error) {
+func (p *VideoServiceGetFollowingFeedResult) FastReadField0(buf []byte) (int, error) {
 	offset := 0
-	_field := NewSearchResponse()
+	_field := NewFollowingFeedResponse()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -5487,11 +6523,11 @@ func (p *VideoServiceSearchResult) FastReadField0(buf []byte) (int, error) {
 	return offset, nil
 }
 
-func (p *VideoServiceSearchResult) FastWrite(buf []byte) int {
+func (p *VideoServiceGetFollowingFeedResult) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *VideoServiceSearchResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *VideoServiceGetFollowingFeedResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField0(buf[offset:], w)
@@ -5500,7 +6536,7 @@ func (p *VideoServiceSearchResult) FastWriteNocopy(buf []byte, w thrift.NocopyWr
 	return offset
 }
 
-func (p *VideoServiceSearchResult) BLength() int {
+func (p *VideoServiceGetFollowingFeedResult) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field0Length()
@@ -5509,7 +6545,7 @@ func (p *VideoServiceSearchResult) BLength() int {
 	return l
 }
 
-func (p *VideoServiceSearchResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+func (p *VideoServiceGetFollowingFeedResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetSuccess() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
@@ -5518,7 +6554,7 @@ func (p *VideoServiceSearchResult) fastWriteField0(buf []byte, w thrift.NocopyWr
 	return offset
 }
 
-func (p *VideoServiceSearchResult) field0Length() int {
+func (p *VideoServiceGetFollowingFeedResult) field0Length() int {
 	l := 0
 	if p.IsSetSuccess() {
 		l += thrift.Binary.FieldBeginLength()
@@ -5527,7 +6563,7 @@ func (p *VideoServiceSearchResult) field0Length() int {
 	return l
 }
 
-func (p *VideoServiceSemanticSearchArgs) FastRead(buf []byte) (int, error) {
+func (p *VideoServiceBackfillFeedArgs) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -5571,14 +6607,14 @@ func (p *VideoServiceSemanticSearchArgs) FastRead(buf []byte) (int, error) {
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VideoServiceSemanticSearchArgs[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VideoServiceBackfillFeedArgs[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *VideoServiceSemanticSearchArgs) FastReadField1(buf []byte) (int, error) {
+func (p *VideoServiceBackfillFeedArgs) FastReadField1(buf []byte) (int, error) {
 	offset := 0
-	_field := NewSemanticSearchRequest()
+	_field := NewBackfillFeedRequest()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -5588,11 +6624,11 @@ func (p *VideoServiceSemanticSearchArgs) FastReadField1(buf []byte) (int, error)
 	return offset, nil
 }
 
-func (p *VideoServiceSemanticSearchArgs) FastWrite(buf []byte) int {
+func (p *VideoServiceBackfillFeedArgs) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *VideoServiceSemanticSearchArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *VideoServiceBackfillFeedArgs) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField1(buf[offset:], w)
@@ -5601,7 +6637,7 @@ func (p *VideoServiceSemanticSearchArgs) FastWriteNocopy(buf []byte, w thrift.No
 	return offset
 }
 
-func (p *VideoServiceSemanticSearchArgs) BLength() int {
+func (p *VideoServiceBackfillFeedArgs) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field1Length()
@@ -5610,21 +6646,21 @@ func (p *VideoServiceSemanticSearchArgs) BLength() int {
 	return l
 }
 
-func (p *VideoServiceSemanticSearchArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
+func (p *VideoServiceBackfillFeedArgs) fastWriteField1(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 1)
 	offset += p.Req.FastWriteNocopy(buf[offset:], w)
 	return offset
 }
 
-func (p *VideoServiceSemanticSearchArgs) field1Length() int {
+func (p *VideoServiceBackfillFeedArgs) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
 	l += p.Req.BLength()
 	return l
 }
 
-func (p *VideoServiceSemanticSearchResult) FastRead(buf []byte) (int, error) {
+func (p *VideoServiceBackfillFeedResult) FastRead(buf []byte) (int, error) {
 
 	var err error
 	var offset int
@@ -5668,14 +6704,14 @@ func (p *VideoServiceSemanticSearchResult) FastRead(buf []byte) (int, error) {
 ReadFieldBeginError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VideoServiceSemanticSearchResult[fieldId]), err)
+	return offset, thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VideoServiceBackfillFeedResult[fieldId]), err)
 SkipFieldError:
 	return offset, thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 }
 
-func (p *VideoServiceSemanticSearchResult) FastReadField0(buf []byte) (int, error) {
+func (p *VideoServiceBackfillFeedResult) FastReadField0(buf []byte) (int, error) {
 	offset := 0
-	_field := NewSemanticSearchResponse()
+	_field := NewBackfillFeedResponse()
 	if l, err := _field.FastRead(buf[offset:]); err != nil {
 		return offset, err
 	} else {
@@ -5685,11 +6721,11 @@ func (p *VideoServiceSemanticSearchResult) FastReadField0(buf []byte) (int, erro
 	return offset, nil
 }
 
-func (p *VideoServiceSemanticSearchResult) FastWrite(buf []byte) int {
+func (p *VideoServiceBackfillFeedResult) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
 
-func (p *VideoServiceSemanticSearchResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
+func (p *VideoServiceBackfillFeedResult) FastWriteNocopy(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p != nil {
 		offset += p.fastWriteField0(buf[offset:], w)
@@ -5698,7 +6734,7 @@ func (p *VideoServiceSemanticSearchResult) FastWriteNocopy(buf []byte, w thrift.
 	return offset
 }
 
-func (p *VideoServiceSemanticSearchResult) BLength() int {
+func (p *VideoServiceBackfillFeedResult) BLength() int {
 	l := 0
 	if p != nil {
 		l += p.field0Length()
@@ -5707,7 +6743,7 @@ func (p *VideoServiceSemanticSearchResult) BLength() int {
 	return l
 }
 
-func (p *VideoServiceSemanticSearchResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
+func (p *VideoServiceBackfillFeedResult) fastWriteField0(buf []byte, w thrift.NocopyWriter) int {
 	offset := 0
 	if p.IsSetSuccess() {
 		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRUCT, 0)
@@ -5716,7 +6752,7 @@ func (p *VideoServiceSemanticSearchResult) fastWriteField0(buf []byte, w thrift.
 	return offset
 }
 
-func (p *VideoServiceSemanticSearchResult) field0Length() int {
+func (p *VideoServiceBackfillFeedResult) field0Length() int {
 	l := 0
 	if p.IsSetSuccess() {
 		l += thrift.Binary.FieldBeginLength()
@@ -5796,3 +6832,19 @@ func (p *VideoServiceSemanticSearchArgs) GetFirstArgument() interface{} {
 func (p *VideoServiceSemanticSearchResult) GetResult() interface{} {
 	return p.Success
 }
+
+func (p *VideoServiceGetFollowingFeedArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+func (p *VideoServiceGetFollowingFeedResult) GetResult() interface{} {
+	return p.Success
+}
+
+func (p *VideoServiceBackfillFeedArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+func (p *VideoServiceBackfillFeedResult) GetResult() interface{} {
+	return p.Success
+}
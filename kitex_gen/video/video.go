@@ -1133,6 +1133,203 @@ var fieldIDToName_SemanticSearchResponse = map[int16]string{
 	5: "summary",
 }
 
+type FollowingFeedRequest struct {
+	Cursor *int64 `thrift:"cursor,1,optional" frugal:"1,optional,i64" json:"cursor,omitempty"`
+	Limit  *int32 `thrift:"limit,2,optional" frugal:"2,optional,i32" json:"limit,omitempty"`
+}
+
+func NewFollowingFeedRequest() *FollowingFeedRequest {
+	return &FollowingFeedRequest{}
+}
+
+func (p *FollowingFeedRequest) InitDefault() {
+}
+
+var FollowingFeedRequest_Cursor_DEFAULT int64
+
+func (p *FollowingFeedRequest) GetCursor() (v int64) {
+	if !p.IsSetCursor() {
+		return FollowingFeedRequest_Cursor_DEFAULT
+	}
+	return *p.Cursor
+}
+
+var FollowingFeedRequest_Limit_DEFAULT int32
+
+func (p *FollowingFeedRequest) GetLimit() (v int32) {
+	if !p.IsSetLimit() {
+		return FollowingFeedRequest_Limit_DEFAULT
+	}
+	return *p.Limit
+}
+func (p *FollowingFeedRequest) SetCursor(val *int64) {
+	p.Cursor = val
+}
+func (p *FollowingFeedRequest) SetLimit(val *int32) {
+	p.Limit = val
+}
+
+func (p *FollowingFeedRequest) IsSetCursor() bool {
+	return p.Cursor != nil
+}
+
+func (p *FollowingFeedRequest) IsSetLimit() bool {
+	return p.Limit != nil
+}
+
+func (p *FollowingFeedRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("FollowingFeedRequest(%+v)", *p)
+}
+
+var fieldIDToName_FollowingFeedRequest = map[int16]string{
+	1: "cursor",
+	2: "limit",
+}
+
+type FollowingFeedResponse struct {
+	Base       *model.BaseResp `thrift:"Base,1,required" frugal:"1,required,model.BaseResp" json:"Base"`
+	Videos     []*model.Video  `thrift:"videos,2,required" frugal:"2,required,list<model.Video>" json:"videos"`
+	NextCursor *int64          `thrift:"next_cursor,3,optional" frugal:"3,optional,i64" json:"next_cursor,omitempty"`
+	HasMore    bool            `thrift:"has_more,4,required" frugal:"4,required,bool" json:"has_more"`
+}
+
+func NewFollowingFeedResponse() *FollowingFeedResponse {
+	return &FollowingFeedResponse{}
+}
+
+func (p *FollowingFeedResponse) InitDefault() {
+}
+
+var FollowingFeedResponse_Base_DEFAULT *model.BaseResp
+
+func (p *FollowingFeedResponse) GetBase() (v *model.BaseResp) {
+	if !p.IsSetBase() {
+		return FollowingFeedResponse_Base_DEFAULT
+	}
+	return p.Base
+}
+
+func (p *FollowingFeedResponse) GetVideos() (v []*model.Video) {
+	return p.Videos
+}
+
+var FollowingFeedResponse_NextCursor_DEFAULT int64
+
+func (p *FollowingFeedResponse) GetNextCursor() (v int64) {
+	if !p.IsSetNextCursor() {
+		return FollowingFeedResponse_NextCursor_DEFAULT
+	}
+	return *p.NextCursor
+}
+
+func (p *FollowingFeedResponse) GetHasMore() (v bool) {
+	return p.HasMore
+}
+func (p *FollowingFeedResponse) SetBase(val *model.BaseResp) {
+	p.Base = val
+}
+func (p *FollowingFeedResponse) SetVideos(val []*model.Video) {
+	p.Videos = val
+}
+func (p *FollowingFeedResponse) SetNextCursor(val *int64) {
+	p.NextCursor = val
+}
+func (p *FollowingFeedResponse) SetHasMore(val bool) {
+	p.HasMore = val
+}
+
+func (p *FollowingFeedResponse) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *FollowingFeedResponse) IsSetNextCursor() bool {
+	return p.NextCursor != nil
+}
+
+func (p *FollowingFeedResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("FollowingFeedResponse(%+v)", *p)
+}
+
+var fieldIDToName_FollowingFeedResponse = map[int16]string{
+	1: "Base",
+	2: "videos",
+	3: "next_cursor",
+	4: "has_more",
+}
+
+type BackfillFeedRequest struct {
+	CreatorId int64 `thrift:"creator_id,1,required" frugal:"1,required,i64" json:"creator_id"`
+}
+
+func NewBackfillFeedRequest() *BackfillFeedRequest {
+	return &BackfillFeedRequest{}
+}
+
+func (p *BackfillFeedRequest) InitDefault() {
+}
+
+func (p *BackfillFeedRequest) GetCreatorId() (v int64) {
+	return p.CreatorId
+}
+func (p *BackfillFeedRequest) SetCreatorId(val int64) {
+	p.CreatorId = val
+}
+
+func (p *BackfillFeedRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("BackfillFeedRequest(%+v)", *p)
+}
+
+var fieldIDToName_BackfillFeedRequest = map[int16]string{
+	1: "creator_id",
+}
+
+type BackfillFeedResponse struct {
+	Base *model.BaseResp `thrift:"Base,1,required" frugal:"1,required,model.BaseResp" json:"Base"`
+}
+
+func NewBackfillFeedResponse() *BackfillFeedResponse {
+	return &BackfillFeedResponse{}
+}
+
+func (p *BackfillFeedResponse) InitDefault() {
+}
+
+var BackfillFeedResponse_Base_DEFAULT *model.BaseResp
+
+func (p *BackfillFeedResponse) GetBase() (v *model.BaseResp) {
+	if !p.IsSetBase() {
+		return BackfillFeedResponse_Base_DEFAULT
+	}
+	return p.Base
+}
+func (p *BackfillFeedResponse) SetBase(val *model.BaseResp) {
+	p.Base = val
+}
+
+func (p *BackfillFeedResponse) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *BackfillFeedResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("BackfillFeedResponse(%+v)", *p)
+}
+
+var fieldIDToName_BackfillFeedResponse = map[int16]string{
+	1: "Base",
+}
+
 type VideoService interface {
 	Publish(ctx context.Context, req *PublishRequest) (r *PublishResponse, err error)
 
@@ -1151,6 +1348,10 @@ type VideoService interface {
 	Search(ctx context.Context, req *SearchRequest) (r *SearchResponse, err error)
 
 	SemanticSearch(ctx context.Context, req *SemanticSearchRequest) (r *SemanticSearchResponse, err error)
+
+	GetFollowingFeed(ctx context.Context, req *FollowingFeedRequest) (r *FollowingFeedResponse, err error)
+
+	BackfillFeed(ctx context.Context, req *BackfillFeedRequest) (r *BackfillFeedResponse, err error)
 }
 
 type VideoServicePublishArgs struct {
@@ -1836,3 +2037,155 @@ func (p *VideoServiceSemanticSearchResult) String() string {
 var fieldIDToName_VideoServiceSemanticSearchResult = map[int16]string{
 	0: "success",
 }
+
+type VideoServiceGetFollowingFeedArgs struct {
+	Req *FollowingFeedRequest `thrift:"req,1" frugal:"1,default,FollowingFeedRequest" json:"req"`
+}
+
+func NewVideoServiceGetFollowingFeedArgs() *VideoServiceGetFollowingFeedArgs {
+	return &VideoServiceGetFollowingFeedArgs{}
+}
+
+func (p *VideoServiceGetFollowingFeedArgs) InitDefault() {
+}
+
+var VideoServiceGetFollowingFeedArgs_Req_DEFAULT *FollowingFeedRequest
+
+func (p *VideoServiceGetFollowingFeedArgs) GetReq() (v *FollowingFeedRequest) {
+	if !p.IsSetReq() {
+		return VideoServiceGetFollowingFeedArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *VideoServiceGetFollowingFeedArgs) SetReq(val *FollowingFeedRequest) {
+	p.Req = val
+}
+
+func (p *VideoServiceGetFollowingFeedArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *VideoServiceGetFollowingFeedArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("VideoServiceGetFollowingFeedArgs(%+v)", *p)
+}
+
+var fieldIDToName_VideoServiceGetFollowingFeedArgs = map[int16]string{
+	1: "req",
+}
+
+type VideoServiceGetFollowingFeedResult struct {
+	Success *FollowingFeedResponse `thrift:"success,0,optional" frugal:"0,optional,FollowingFeedResponse" json:"success,omitempty"`
+}
+
+func NewVideoServiceGetFollowingFeedResult() *VideoServiceGetFollowingFeedResult {
+	return &VideoServiceGetFollowingFeedResult{}
+}
+
+func (p *VideoServiceGetFollowingFeedResult) InitDefault() {
+}
+
+var VideoServiceGetFollowingFeedResult_Success_DEFAULT *FollowingFeedResponse
+
+func (p *VideoServiceGetFollowingFeedResult) GetSuccess() (v *FollowingFeedResponse) {
+	if !p.IsSetSuccess() {
+		return VideoServiceGetFollowingFeedResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *VideoServiceGetFollowingFeedResult) SetSuccess(x interface{}) {
+	p.Success = x.(*FollowingFeedResponse)
+}
+
+func (p *VideoServiceGetFollowingFeedResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *VideoServiceGetFollowingFeedResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("VideoServiceGetFollowingFeedResult(%+v)", *p)
+}
+
+var fieldIDToName_VideoServiceGetFollowingFeedResult = map[int16]string{
+	0: "success",
+}
+
+type VideoServiceBackfillFeedArgs struct {
+	Req *BackfillFeedRequest `thrift:"req,1" frugal:"1,default,BackfillFeedRequest" json:"req"`
+}
+
+func NewVideoServiceBackfillFeedArgs() *VideoServiceBackfillFeedArgs {
+	return &VideoServiceBackfillFeedArgs{}
+}
+
+func (p *VideoServiceBackfillFeedArgs) InitDefault() {
+}
+
+var VideoServiceBackfillFeedArgs_Req_DEFAULT *BackfillFeedRequest
+
+func (p *VideoServiceBackfillFeedArgs) GetReq() (v *BackfillFeedRequest) {
+	if !p.IsSetReq() {
+		return VideoServiceBackfillFeedArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *VideoServiceBackfillFeedArgs) SetReq(val *BackfillFeedRequest) {
+	p.Req = val
+}
+
+func (p *VideoServiceBackfillFeedArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *VideoServiceBackfillFeedArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("VideoServiceBackfillFeedArgs(%+v)", *p)
+}
+
+var fieldIDToName_VideoServiceBackfillFeedArgs = map[int16]string{
+	1: "req",
+}
+
+type VideoServiceBackfillFeedResult struct {
+	Success *BackfillFeedResponse `thrift:"success,0,optional" frugal:"0,optional,BackfillFeedResponse" json:"success,omitempty"`
+}
+
+func NewVideoServiceBackfillFeedResult() *VideoServiceBackfillFeedResult {
+	return &VideoServiceBackfillFeedResult{}
+}
+
+func (p *VideoServiceBackfillFeedResult) InitDefault() {
+}
+
+var VideoServiceBackfillFeedResult_Success_DEFAULT *BackfillFeedResponse
+
+func (p *VideoServiceBackfillFeedResult) GetSuccess() (v *BackfillFeedResponse) {
+	if !p.IsSetSuccess() {
+		return VideoServiceBackfillFeedResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *VideoServiceBackfillFeedResult) SetSuccess(x interface{}) {
+	p.Success = x.(*BackfillFeedResponse)
+}
+
+func (p *VideoServiceBackfillFeedResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *VideoServiceBackfillFeedResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("VideoServiceBackfillFeedResult(%+v)", *p)
+}
+
+var fieldIDToName_VideoServiceBackfillFeedResult = map[int16]string{
+	0: "success",
+}
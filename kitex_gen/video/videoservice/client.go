@@ -20,6 +20,8 @@ type Client interface {
 	IncrementLikeCount(ctx context.Context, req *video.IncrementLikeCountRequest, callOptions ...callopt.Option) (r *video.IncrementLikeCountResponse, err error)
 	Search(ctx context.Context, req *video.SearchRequest, callOptions ...callopt.Option) (r *video.SearchResponse, err error)
 	SemanticSearch(ctx context.Context, req *video.SemanticSearchRequest, callOptions ...callopt.Option) (r *video.SemanticSearchResponse, err error)
+	GetFollowingFeed(ctx context.Context, req *video.FollowingFeedRequest, callOptions ...callopt.Option) (r *video.FollowingFeedResponse, err error)
+	BackfillFeed(ctx context.Context, req *video.BackfillFeedRequest, callOptions ...callopt.Option) (r *video.BackfillFeedResponse, err error)
 }
 
 // NewClient creates a client for the service defined in IDL.
@@ -95,3 +97,13 @@ func (p *kVideoServiceClient) SemanticSearch(ctx context.Context, req *video.Sem
 	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
 	return p.kClient.SemanticSearch(ctx, req)
 }
+
+func (p *kVideoServiceClient) GetFollowingFeed(ctx context.Context, req *video.FollowingFeedRequest, callOptions ...callopt.Option) (r *video.FollowingFeedResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.GetFollowingFeed(ctx, req)
+}
+
+func (p *kVideoServiceClient) BackfillFeed(ctx context.Context, req *video.BackfillFeedRequest, callOptions ...callopt.Option) (r *video.BackfillFeedResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.BackfillFeed(ctx, req)
+}
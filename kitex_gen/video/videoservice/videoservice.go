@@ -76,6 +76,20 @@ var serviceMethods = map[string]kitex.MethodInfo{
 		false,
 		kitex.WithStreamingMode(kitex.StreamingNone),
 	),
+	"GetFollowingFeed": kitex.NewMethodInfo(
+		getFollowingFeedHandler,
+		newVideoServiceGetFollowingFeedArgs,
+		newVideoServiceGetFollowingFeedResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"BackfillFeed": kitex.NewMethodInfo(
+		backfillFeedHandler,
+		newVideoServiceBackfillFeedArgs,
+		newVideoServiceBackfillFeedResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
 }
 
 var (
@@ -304,6 +318,42 @@ func newVideoServiceSemanticSearchResult() interface{} {
 	return video.NewVideoServiceSemanticSearchResult()
 }
 
+func getFollowingFeedHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*video.VideoServiceGetFollowingFeedArgs)
+	realResult := result.(*video.VideoServiceGetFollowingFeedResult)
+	success, err := handler.(video.VideoService).GetFollowingFeed(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newVideoServiceGetFollowingFeedArgs() interface{} {
+	return video.NewVideoServiceGetFollowingFeedArgs()
+}
+
+func newVideoServiceGetFollowingFeedResult() interface{} {
+	return video.NewVideoServiceGetFollowingFeedResult()
+}
+
+func backfillFeedHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*video.VideoServiceBackfillFeedArgs)
+	realResult := result.(*video.VideoServiceBackfillFeedResult)
+	success, err := handler.(video.VideoService).BackfillFeed(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newVideoServiceBackfillFeedArgs() interface{} {
+	return video.NewVideoServiceBackfillFeedArgs()
+}
+
+func newVideoServiceBackfillFeedResult() interface{} {
+	return video.NewVideoServiceBackfillFeedResult()
+}
+
 type kClient struct {
 	c client.Client
 }
@@ -403,3 +453,23 @@ func (p *kClient) SemanticSearch(ctx context.Context, req *video.SemanticSearchR
 	}
 	return _result.GetSuccess(), nil
 }
+
+func (p *kClient) GetFollowingFeed(ctx context.Context, req *video.FollowingFeedRequest) (r *video.FollowingFeedResponse, err error) {
+	var _args video.VideoServiceGetFollowingFeedArgs
+	_args.Req = req
+	var _result video.VideoServiceGetFollowingFeedResult
+	if err = p.c.Call(ctx, "GetFollowingFeed", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) BackfillFeed(ctx context.Context, req *video.BackfillFeedRequest) (r *video.BackfillFeedResponse, err error) {
+	var _args video.VideoServiceBackfillFeedArgs
+	_args.Req = req
+	var _result video.VideoServiceBackfillFeedResult
+	if err = p.c.Call(ctx, "BackfillFeed", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
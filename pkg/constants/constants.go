@@ -8,7 +8,70 @@ const (
 	FilePermission = 0o644
 
 	// WebSocket 相关
-	WebSocketPingRatio = 9.0 / 10.0
+	WebSocketPingRatio       = 9.0 / 10.0
+	WebSocketMaxFrameSize    = 64 << 10 // 单个客户端帧的最大字节数
+	WebSocketMaxDeviceIDLen  = 64       // 设备ID最大长度
+	WebSocketMaxRequestIDLen = 64       // 客户端请求ID的最大长度
+	WebSocketSendQueueSize   = 256      // 每个连接发送队列的默认容量
+	MaxChatMessageLength     = 2000     // 聊天消息最大字符数
+
+	// 临时信号，如正在输入、正在录音、正在查看会话，不落库
+	SignalTTL          = 6 * time.Second // 未收到续期或停止信号时自动停止，客户端应在此之前续期
+	SignalMinInterval  = time.Second     // 同一连接在同一会话中同类开始信号的最小转发间隔
+	SignalMaxActive    = 16              // 每个连接同时进行中的信号数上限
+	SignalPeerCacheTTL = time.Minute     // 私信对象校验结果的缓存时间
+
+	// 消息同步
+	SyncMessagesDefaultLimit = 100 // 单次同步默认条数
+	SyncMessagesMaxLimit     = 500 // 单次同步最大条数
+	RedeliverMessagesLimit   = 50  // 重连时每个会话补发的最大条数
+	MarkReadMaxBatch         = 100 // 单次批量标记已读的最大会话数
+	InboxDefaultLimit        = 20  // 收件箱默认每页条数
+	InboxMaxLimit            = 100 // 收件箱每页最大条数
+
+	// 好友申请
+	DefaultMaxFriendsPerUser      = 1000          // 未配置时每个用户的好友数上限
+	FriendRequestTTL              = 7 * 24 * 3600 // 好友申请有效期（秒），超时未处理视为过期
+	MaxFriendRequestMessageLength = 255           // 好友申请附言最大字符数
+
+	// 好友推荐
+	DefaultRecommendRefreshPeriod = time.Hour          // 未配置时推荐列表的定时刷新周期
+	DefaultRecommendSize          = 50                 // 未配置时每个用户缓存的推荐人数
+	RecommendExpire               = 24 * time.Hour     // 推荐列表缓存过期时间
+	RecommendActiveWindow         = 7 * 24 * time.Hour // 最近访问过推荐的用户才由定时任务刷新
+	RecommendRefreshBatch         = 100                // 定时任务每批刷新的用户数
+	RecommendSignalLimit          = 200                // 每种推荐信号最多取的候选人数
+	RecommendDefaultLimit         = 10                 // 推荐列表默认条数
+	RecommendMaxLimit             = 50                 // 推荐列表最大条数
+	RecommendWeightMutualFriend   = 4.0                // 共同好友的权重
+	RecommendWeightMutualFollow   = 3.0                // 共同关注的权重
+	RecommendWeightCoComment      = 2.0                // 评论过同一视频的权重
+	RecommendWeightSharedLike     = 1.0                // 点赞过同一视频的权重
+
+	// 群聊管理
+	DefaultMaxGroupMembers  = 500            // 未配置时的群聊成员上限
+	DefaultMaxGroupsPerUser = 100            // 未配置时每个用户最多加入的群聊数
+	MaxChatRoomNameLength   = 64             // 聊天室名称最大字符数
+	ChatRoomInviteTTL       = 7 * 24 * 3600  // 邀请链接默认有效期（秒）
+	ChatRoomInviteMaxTTL    = 30 * 24 * 3600 // 邀请链接最长有效期（秒）
+	ChatRoomInviteCodeBytes = 8              // 邀请码随机字节数
+	ChatRoomMaxMuteSeconds  = 30 * 24 * 3600 // 单次禁言最长时长（秒）
+
+	// 聊天消息
+	ChatMessageEditWindow     = 15 * 60            // 发送后可编辑的时长（秒）
+	ChatMessageRecallWindow   = 2 * 60             // 发送后可撤回的时长（秒）
+	DefaultChatFileMaxSize    = 10 << 20           // 未配置时聊天附件的最大字节数
+	DefaultChatFileUploadDir  = "src/storage/chat" // 未配置时聊天附件的存储目录
+	DefaultChatFileBaseURL    = "/chat"            // 未配置时聊天附件的访问地址前缀
+	ChatThumbnailMaxDimension = 320                // 缩略图最长边像素
+	ChatAttachmentMaxNameLen  = 255                // 附件原始文件名最大长度
+
+	// 聊天记录归档
+	ChatRetentionInterval  = time.Hour        // 归档任务的执行周期
+	ChatRetentionLockTTL   = 30 * time.Minute // 归档任务锁的过期时间，防止多个实例同时归档
+	ChatRetentionLockBytes = 16               // 归档任务锁持有者标识的随机字节数
+	ChatArchiveBatchSize   = 500              // 每批归档并删除的消息数
+	DefaultChatArchiveDir  = "chat/archive"   // 未配置时归档文件在对象存储中的目录
 
 	// 缓存时间
 	DayInHours   = 24
@@ -32,4 +95,72 @@ const (
 
 	// 时间转换
 	MillisecondsPerSecond = 1000
+
+	// 表情回应相关
+	ReactionCountExpire     = 24 * time.Hour  // 表情计数缓存过期时间
+	ReactionReconcilePeriod = 5 * time.Minute // 表情计数与数据库对账周期
+	ReactionReconcileBatch  = 100             // 每次对账的目标数量
+
+	// 评论审核相关
+	ModerationReasonMaxLen = 255 // 审核原因最大字符数，与 moderation_reason 列宽一致
+
+	// 视频互动状态缓存
+	LikeCountExpire       = 24 * time.Hour   // 视频点赞数过期时间
+	UserLikedVideosExpire = 24 * time.Hour   // 用户点赞视频集合过期时间
+	CommentCountExpire    = 10 * time.Minute // 视频评论数过期时间
+	MaxStateBatchSize     = 100              // 单次批量查询的视频数量上限
+
+	// 关注相关
+	FollowStatsExpire      = 24 * time.Hour // 关注计数缓存过期时间
+	FollowListDefaultLimit = 20             // 关注和粉丝列表默认每页条数
+	FollowListMaxLimit     = 100            // 关注和粉丝列表每页最大条数
+
+	// 黑名单相关
+	BlockListDefaultLimit = 20  // 黑名单默认每页条数
+	BlockListMaxLimit     = 100 // 黑名单每页最大条数
+
+	// 关注流相关
+	FeedPushThreshold = 10000              // 粉丝数达到该值的作者不再写扩散
+	FeedInboxSize     = 500                // 关注流收件箱保留的视频数
+	FeedInboxExpire   = 7 * 24 * time.Hour // 关注流收件箱过期时间
+	FeedFanOutBatch   = 500                // 写扩散时每批处理的粉丝数
+	FeedBackfillSize  = 20                 // 新关注时回填的视频数
+	FeedDefaultLimit  = 20                 // 关注流默认每页条数
+	FeedMaxLimit      = 50                 // 关注流每页最大条数
+
+	// 收藏夹相关
+	DefaultCollectionName   = "稍后再看" // 默认收藏夹名称
+	MaxCollectionsPerUser   = 100    // 每个用户的收藏夹数量上限
+	MaxCollectionNameLength = 50     // 收藏夹名称最大字符数
+
+	// 通知相关
+	NotificationTopic           = "notification"      // 通知事件主题，由互动、用户和社交服务产生
+	NotificationPushTopic       = "notification_push" // 通知推送主题，由网关消费后通过 WebSocket 推送
+	NotificationGroupID         = "notification"      // 社交服务消费通知事件的消费组
+	NotificationPushGroupID     = "notification_push" // 网关消费通知推送的消费组
+	NotificationConsumerNum     = 10                  // 通知事件的消费者数
+	NotificationConsumerChanCap = 100                 // 通知消费通道容量
+	NotificationRecentActors    = 3                   // 每条通知返回的最近触发者数
+
+	// 在线状态相关
+	PresenceTTL             = 2 * time.Minute // 在线状态过期时间，网关按心跳周期续期，须大于心跳周期
+	PresenceMaxBatch        = 200             // 单次上报或查询在线状态的最大用户数
+	PresencePushTopic       = "presence_push" // 在线状态变化推送主题，由网关消费后推送给好友
+	PresencePushGroupID     = "presence_push" // 网关消费在线状态推送的消费组
+	PresenceConsumerNum     = 10              // 在线状态推送的消费者数
+	PresenceConsumerChanCap = 100             // 在线状态推送消费通道容量
+
+	// 聊天记录搜索相关
+	MessageIndexName            = "chat_message"  // 聊天记录搜索索引
+	MessageIndexTopic           = "message_index" // 消息创建、编辑和撤回事件主题，由社交服务消费后写入索引
+	MessageIndexGroupID         = "message_index" // 社交服务消费消息索引事件的消费组
+	MessageIndexConsumerNum     = 10              // 消息索引事件的消费者数
+	MessageIndexConsumerChanCap = 100             // 消息索引事件消费通道容量
+	MessageSearchMaxKeywordLen  = 100             // 搜索关键词最大字符数
 )
+
+// DefaultReactions 未配置时可用的表情集合
+var DefaultReactions = []string{"👍", "❤️", "😂", "😮", "😢", "😡"}
+
+// DefaultChatFileTypes 未配置时允许上传的聊天附件类型
+var DefaultChatFileTypes = []string{"image/jpeg", "image/png", "image/gif", "video/mp4", "application/pdf", "application/msword"}
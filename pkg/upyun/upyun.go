@@ -2,6 +2,7 @@ package upyun
 
 import (
 	"bytes"
+	"io"
 	"log"
 	"net/http"
 	"strings"
@@ -58,6 +59,33 @@ func DeleteYun(url string) error {
 	return nil
 }
 
+// DownloadYun 又拍云下载文件
+func DownloadYun(url string) ([]byte, error) {
+	req, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, errno.UpYunFileError.WithMessage(err.Error())
+	}
+	req.SetBasicAuth(config.Upyun.Operator, config.Upyun.Password)
+	req.Header.Add("Date", time.Now().UTC().Format(http.TimeFormat))
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, errno.UpYunFileError.WithMessage(err.Error())
+	}
+	defer func() {
+		if err := res.Body.Close(); err != nil {
+			log.Printf("downloadYun close request meet error: %v", err)
+		}
+	}()
+	if res.StatusCode != http.StatusOK {
+		return nil, errno.UpYunFileError
+	}
+	data, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, errno.UpYunFileError.WithMessage(err.Error())
+	}
+	return data, nil
+}
+
 // 获取图片URL
 func GetImageUrl(url string) string {
 	return strings.Join([]string{config.Upyun.ImageDomain, strings.TrimPrefix(url, config.Upyun.UssDomain)}, "")